@@ -192,6 +192,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RollbackDomain(
+		ctx context.Context,
+		Request *admin.RollbackDomainRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.RollbackDomainResponse, error)
+
 	UpdateDomainAsyncWorkflowConfiguraton(
 		ctx context.Context,
 		Request *admin.UpdateDomainAsyncWorkflowConfiguratonRequest,
@@ -215,6 +221,12 @@ type Interface interface {
 		Request *admin.UpdateGlobalIsolationGroupsRequest,
 		opts ...yarpc.CallOption,
 	) (*admin.UpdateGlobalIsolationGroupsResponse, error)
+
+	UpdateVirtualQueue(
+		ctx context.Context,
+		Request *shared.UpdateVirtualQueueRequest,
+		opts ...yarpc.CallOption,
+	) error
 }
 
 // New builds a new client for the AdminService service.
@@ -1057,6 +1069,34 @@ func (c client) RestoreDynamicConfig(
 	return
 }
 
+func (c client) RollbackDomain(
+	ctx context.Context,
+	_Request *admin.RollbackDomainRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.RollbackDomainResponse, err error) {
+
+	var result admin.AdminService_RollbackDomain_Result
+	args := admin.AdminService_RollbackDomain_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_RollbackDomain_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) UpdateDomainAsyncWorkflowConfiguraton(
 	ctx context.Context,
 	_Request *admin.UpdateDomainAsyncWorkflowConfiguratonRequest,
@@ -1168,3 +1208,31 @@ func (c client) UpdateGlobalIsolationGroups(
 	success, err = admin.AdminService_UpdateGlobalIsolationGroups_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UpdateVirtualQueue(
+	ctx context.Context,
+	_Request *shared.UpdateVirtualQueueRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_UpdateVirtualQueue_Result
+	args := admin.AdminService_UpdateVirtualQueue_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_UpdateVirtualQueue_Helper.UnwrapResponse(&result)
+	return
+}
@@ -163,6 +163,11 @@ type Interface interface {
 		Request *admin.RestoreDynamicConfigRequest,
 	) error
 
+	RollbackDomain(
+		ctx context.Context,
+		Request *admin.RollbackDomainRequest,
+	) (*admin.RollbackDomainResponse, error)
+
 	UpdateDomainAsyncWorkflowConfiguraton(
 		ctx context.Context,
 		Request *admin.UpdateDomainAsyncWorkflowConfiguratonRequest,
@@ -182,6 +187,11 @@ type Interface interface {
 		ctx context.Context,
 		Request *admin.UpdateGlobalIsolationGroupsRequest,
 	) (*admin.UpdateGlobalIsolationGroupsResponse, error)
+
+	UpdateVirtualQueue(
+		ctx context.Context,
+		Request *shared.UpdateVirtualQueueRequest,
+	) error
 }
 
 // New prepares an implementation of the AdminService service for
@@ -543,6 +553,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RollbackDomain",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.RollbackDomain),
+					NoWire: rollbackdomain_NoWireHandler{impl},
+				},
+				Signature:    "RollbackDomain(Request *admin.RollbackDomainRequest) (*admin.RollbackDomainResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "UpdateDomainAsyncWorkflowConfiguraton",
 				HandlerSpec: thrift.HandlerSpec{
@@ -590,10 +612,22 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "UpdateGlobalIsolationGroups(Request *admin.UpdateGlobalIsolationGroupsRequest) (*admin.UpdateGlobalIsolationGroupsResponse)",
 				ThriftModule: admin.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "UpdateVirtualQueue",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UpdateVirtualQueue),
+					NoWire: updatevirtualqueue_NoWireHandler{impl},
+				},
+				Signature:    "UpdateVirtualQueue(Request *shared.UpdateVirtualQueueRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 33)
+	procedures := make([]transport.Procedure, 0, 35)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -1474,6 +1508,36 @@ func (h handler) RestoreDynamicConfig(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
+func (h handler) RollbackDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RollbackDomain_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'RollbackDomain': %w", err)
+	}
+
+	success, appErr := h.impl.RollbackDomain(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RollbackDomain_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_UpdateDomainAsyncWorkflowConfiguraton_Args
 	if err := args.FromWire(body); err != nil {
@@ -1594,6 +1658,36 @@ func (h handler) UpdateGlobalIsolationGroups(ctx context.Context, body wire.Valu
 	return response, err
 }
 
+func (h handler) UpdateVirtualQueue(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UpdateVirtualQueue_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'UpdateVirtualQueue': %w", err)
+	}
+
+	appErr := h.impl.UpdateVirtualQueue(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_UpdateVirtualQueue_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 type addsearchattribute_NoWireHandler struct{ impl Interface }
 
 func (h addsearchattribute_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2667,6 +2761,43 @@ func (h restoredynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nw
 
 }
 
+type rollbackdomain_NoWireHandler struct{ impl Interface }
+
+func (h rollbackdomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_RollbackDomain_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'RollbackDomain': %w", err)
+	}
+
+	success, appErr := h.impl.RollbackDomain(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RollbackDomain_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type updatedomainasyncworkflowconfiguraton_NoWireHandler struct{ impl Interface }
 
 func (h updatedomainasyncworkflowconfiguraton_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2814,3 +2945,40 @@ func (h updateglobalisolationgroups_NoWireHandler) HandleNoWire(ctx context.Cont
 	return response, err
 
 }
+
+type updatevirtualqueue_NoWireHandler struct{ impl Interface }
+
+func (h updatevirtualqueue_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_UpdateVirtualQueue_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'UpdateVirtualQueue': %w", err)
+	}
+
+	appErr := h.impl.UpdateVirtualQueue(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_UpdateVirtualQueue_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
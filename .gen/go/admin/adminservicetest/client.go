@@ -983,6 +983,39 @@ func (mr *_MockClientRecorder) RestoreDynamicConfig(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RestoreDynamicConfig", args...)
 }
 
+// RollbackDomain responds to a RollbackDomain call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RollbackDomain(gomock.Any(), ...).Return(...)
+//	... := client.RollbackDomain(...)
+func (m *MockClient) RollbackDomain(
+	ctx context.Context,
+	_Request *admin.RollbackDomainRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.RollbackDomainResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RollbackDomain", args...)
+	success, _ = ret[i].(*admin.RollbackDomainResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RollbackDomain(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RollbackDomain", args...)
+}
+
 // UpdateDomainAsyncWorkflowConfiguraton responds to a UpdateDomainAsyncWorkflowConfiguraton call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1112,3 +1145,34 @@ func (mr *_MockClientRecorder) UpdateGlobalIsolationGroups(
 	args := append([]interface{}{ctx, _Request}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateGlobalIsolationGroups", args...)
 }
+
+// UpdateVirtualQueue responds to a UpdateVirtualQueue call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().UpdateVirtualQueue(gomock.Any(), ...).Return(...)
+//	... := client.UpdateVirtualQueue(...)
+func (m *MockClient) UpdateVirtualQueue(
+	ctx context.Context,
+	_Request *shared.UpdateVirtualQueueRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateVirtualQueue", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateVirtualQueue(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateVirtualQueue", args...)
+}
@@ -100,6 +100,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.ListClosedWorkflowExecutionsResponse, error)
 
+	ListDomainChanges(
+		ctx context.Context,
+		ListRequest *shared.ListDomainChangesRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListDomainChangesResponse, error)
+
 	ListDomains(
 		ctx context.Context,
 		ListRequest *shared.ListDomainsRequest,
@@ -718,6 +724,34 @@ func (c client) ListClosedWorkflowExecutions(
 	return
 }
 
+func (c client) ListDomainChanges(
+	ctx context.Context,
+	_ListRequest *shared.ListDomainChangesRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListDomainChangesResponse, err error) {
+
+	var result cadence.WorkflowService_ListDomainChanges_Result
+	args := cadence.WorkflowService_ListDomainChanges_Helper.Args(_ListRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_ListDomainChanges_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ListDomains(
 	ctx context.Context,
 	_ListRequest *shared.ListDomainsRequest,
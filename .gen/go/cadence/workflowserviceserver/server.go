@@ -86,6 +86,11 @@ type Interface interface {
 		ListRequest *shared.ListClosedWorkflowExecutionsRequest,
 	) (*shared.ListClosedWorkflowExecutionsResponse, error)
 
+	ListDomainChanges(
+		ctx context.Context,
+		ListRequest *shared.ListDomainChangesRequest,
+	) (*shared.ListDomainChangesResponse, error)
+
 	ListDomains(
 		ctx context.Context,
 		ListRequest *shared.ListDomainsRequest,
@@ -431,6 +436,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ListDomainChanges",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ListDomainChanges),
+					NoWire: listdomainchanges_NoWireHandler{impl},
+				},
+				Signature:    "ListDomainChanges(ListRequest *shared.ListDomainChangesRequest) (*shared.ListDomainChangesResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ListDomains",
 				HandlerSpec: thrift.HandlerSpec{
@@ -829,7 +846,7 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 47)
+	procedures := make([]transport.Procedure, 0, 48)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -1260,6 +1277,36 @@ func (h handler) ListClosedWorkflowExecutions(ctx context.Context, body wire.Val
 	return response, err
 }
 
+func (h handler) ListDomainChanges(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListDomainChanges_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListDomainChanges': %w", err)
+	}
+
+	success, appErr := h.impl.ListDomainChanges(ctx, args.ListRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ListDomainChanges_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ListDomains(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_ListDomains_Args
 	if err := args.FromWire(body); err != nil {
@@ -2768,6 +2815,43 @@ func (h listclosedworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Con
 
 }
 
+type listdomainchanges_NoWireHandler struct{ impl Interface }
+
+func (h listdomainchanges_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_ListDomainChanges_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListDomainChanges': %w", err)
+	}
+
+	success, appErr := h.impl.ListDomainChanges(ctx, args.ListRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ListDomainChanges_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type listdomains_NoWireHandler struct{ impl Interface }
 
 func (h listdomains_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
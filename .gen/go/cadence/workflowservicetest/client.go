@@ -498,6 +498,39 @@ func (mr *_MockClientRecorder) ListClosedWorkflowExecutions(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ListClosedWorkflowExecutions", args...)
 }
 
+// ListDomainChanges responds to a ListDomainChanges call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ListDomainChanges(gomock.Any(), ...).Return(...)
+//	... := client.ListDomainChanges(...)
+func (m *MockClient) ListDomainChanges(
+	ctx context.Context,
+	_ListRequest *shared.ListDomainChangesRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListDomainChangesResponse, err error) {
+
+	args := []interface{}{ctx, _ListRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListDomainChanges", args...)
+	success, _ = ret[i].(*shared.ListDomainChangesResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListDomainChanges(
+	ctx interface{},
+	_ListRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _ListRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListDomainChanges", args...)
+}
+
 // ListDomains responds to a ListDomains call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -276,6 +276,12 @@ type Interface interface {
 		TerminateRequest *history.TerminateWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	UpdateVirtualQueue(
+		ctx context.Context,
+		Request *shared.UpdateVirtualQueueRequest,
+		opts ...yarpc.CallOption,
+	) error
 }
 
 // New builds a new client for the HistoryService service.
@@ -1510,3 +1516,31 @@ func (c client) TerminateWorkflowExecution(
 	err = history.HistoryService_TerminateWorkflowExecution_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UpdateVirtualQueue(
+	ctx context.Context,
+	_Request *shared.UpdateVirtualQueueRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_UpdateVirtualQueue_Result
+	args := history.HistoryService_UpdateVirtualQueue_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_UpdateVirtualQueue_Helper.UnwrapResponse(&result)
+	return
+}
@@ -3905,6 +3905,8 @@ type ActivityTaskScheduledEventAttributes struct {
 	DecisionTaskCompletedEventId  *int64        `json:"decisionTaskCompletedEventId,omitempty"`
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 	Header                        *Header       `json:"header,omitempty"`
+	Priority                      *int32        `json:"priority,omitempty"`
+	FairnessKey                   *string       `json:"fairnessKey,omitempty"`
 }
 
 // ToWire translates a ActivityTaskScheduledEventAttributes struct into a Thrift-level intermediate
@@ -3924,7 +3926,7 @@ type ActivityTaskScheduledEventAttributes struct {
 //	}
 func (v *ActivityTaskScheduledEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [12]wire.Field
+		fields [14]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -4026,6 +4028,22 @@ func (v *ActivityTaskScheduledEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 120, Value: w}
 		i++
 	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
+	if v.FairnessKey != nil {
+		w, err = wire.NewValueString(*(v.FairnessKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -4185,6 +4203,26 @@ func (v *ActivityTaskScheduledEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 130:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 140:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FairnessKey = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -4345,6 +4383,30 @@ func (v *ActivityTaskScheduledEventAttributes) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Priority != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 130, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Priority)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.FairnessKey != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 140, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.FairnessKey)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -4476,6 +4538,22 @@ func (v *ActivityTaskScheduledEventAttributes) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 130 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Priority = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 140 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.FairnessKey = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -4505,7 +4583,7 @@ func (v *ActivityTaskScheduledEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [12]string
+	var fields [14]string
 	i := 0
 	if v.ActivityId != nil {
 		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
@@ -4555,6 +4633,14 @@ func (v *ActivityTaskScheduledEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("Header: %v", v.Header)
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
+	if v.FairnessKey != nil {
+		fields[i] = fmt.Sprintf("FairnessKey: %v", *(v.FairnessKey))
+		i++
+	}
 
 	return fmt.Sprintf("ActivityTaskScheduledEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -4615,6 +4701,12 @@ func (v *ActivityTaskScheduledEventAttributes) Equals(rhs *ActivityTaskScheduled
 	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FairnessKey, rhs.FairnessKey) {
+		return false
+	}
 
 	return true
 }
@@ -4661,6 +4753,12 @@ func (v *ActivityTaskScheduledEventAttributes) MarshalLogObject(enc zapcore.Obje
 	if v.Header != nil {
 		err = multierr.Append(err, enc.AddObject("header", v.Header))
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
+	if v.FairnessKey != nil {
+		enc.AddString("fairnessKey", *v.FairnessKey)
+	}
 	return err
 }
 
@@ -4844,6 +4942,36 @@ func (v *ActivityTaskScheduledEventAttributes) IsSetHeader() bool {
 	return v != nil && v.Header != nil
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *ActivityTaskScheduledEventAttributes) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *ActivityTaskScheduledEventAttributes) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
+// GetFairnessKey returns the value of FairnessKey if it is set or its
+// zero value if it is unset.
+func (v *ActivityTaskScheduledEventAttributes) GetFairnessKey() (o string) {
+	if v != nil && v.FairnessKey != nil {
+		return *v.FairnessKey
+	}
+
+	return
+}
+
+// IsSetFairnessKey returns true if FairnessKey is not nil.
+func (v *ActivityTaskScheduledEventAttributes) IsSetFairnessKey() bool {
+	return v != nil && v.FairnessKey != nil
+}
+
 type ActivityTaskStartedEventAttributes struct {
 	ScheduledEventId   *int64  `json:"scheduledEventId,omitempty"`
 	Identity           *string `json:"identity,omitempty"`
@@ -6124,25 +6252,40 @@ func (v *ActivityType) IsSetName() bool {
 	return v != nil && v.Name != nil
 }
 
-// Any is a logical duplicate of google.protobuf.Any.
-//
-// The intent of the type is the same, but it is not intended to be directly
-// compatible with google.protobuf.Any or any Thrift equivalent - this blob is
-// RPC-type agnostic by design (as the underlying data may be transported over
-// proto or thrift), and the data-bytes may be in any encoding.
-//
-// This is intentionally different from DataBlob, which supports only a handful
-// of known encodings so it can be interpreted everywhere.  Any supports literally
-// any contents, and needs to be considered opaque until it is given to something
-// that is expecting it.
-//
-// See ValueType to interpret the contents.
-type Any struct {
-	ValueType *string `json:"ValueType,omitempty"`
-	Value     []byte  `json:"Value,omitempty"`
+type AndPredicateAttributes struct {
+	Predicates []*Predicate `json:"predicates,omitempty"`
 }
 
-// ToWire translates a Any struct into a Thrift-level intermediate
+type _List_Predicate_ValueList []*Predicate
+
+func (v _List_Predicate_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*Predicate', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Predicate_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Predicate_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Predicate_ValueList) Close() {}
+
+// ToWire translates a AndPredicateAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6157,39 +6300,55 @@ type Any struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //		return err
 //	}
-func (v *Any) ToWire() (wire.Value, error) {
+func (v *AndPredicateAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ValueType != nil {
-		w, err = wire.NewValueString(*(v.ValueType)), error(nil)
+	if v.Predicates != nil {
+		w, err = wire.NewValueList(_List_Predicate_ValueList(v.Predicates)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Value != nil {
-		w, err = wire.NewValueBinary(v.Value), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a Any struct from its Thrift-level
+func _Predicate_Read(w wire.Value) (*Predicate, error) {
+	var v Predicate
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Predicate_Read(l wire.ValueList) ([]*Predicate, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Predicate, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Predicate_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a AndPredicateAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a Any struct
+// An error is returned if we were unable to build a AndPredicateAttributes struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6197,29 +6356,19 @@ func (v *Any) ToWire() (wire.Value, error) {
 //		return nil, err
 //	}
 //
-//	var v Any
+//	var v AndPredicateAttributes
 //	if err := v.FromWire(x); err != nil {
 //		return nil, err
 //	}
 //	return &v, nil
-func (v *Any) FromWire(w wire.Value) error {
+func (v *AndPredicateAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ValueType = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TBinary {
-				v.Value, err = field.Value.GetBinary(), error(nil)
+			if field.Value.Type() == wire.TList {
+				v.Predicates, err = _List_Predicate_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -6231,20 +6380,41 @@ func (v *Any) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a Any struct directly into bytes, without going
+func _List_Predicate_Encode(val []*Predicate, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*Predicate', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a AndPredicateAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a Any struct could not be encoded.
-func (v *Any) Encode(sw stream.Writer) error {
+// An error is returned if a AndPredicateAttributes struct could not be encoded.
+func (v *AndPredicateAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.ValueType != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+	if v.Predicates != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.ValueType)); err != nil {
+		if err := _List_Predicate_Encode(v.Predicates, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -6252,27 +6422,51 @@ func (v *Any) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Value != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteBinary(v.Value); err != nil {
-			return err
+	return sw.WriteStructEnd()
+}
+
+func _Predicate_Decode(sr stream.Reader) (*Predicate, error) {
+	var v Predicate
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_Predicate_Decode(sr stream.Reader) ([]*Predicate, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*Predicate, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _Predicate_Decode(sr)
+		if err != nil {
+			return nil, err
 		}
+		o = append(o, v)
 	}
 
-	return sw.WriteStructEnd()
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-// Decode deserializes a Any struct directly from its Thrift-level
+// Decode deserializes a AndPredicateAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a Any struct could not be generated from the wire
+// An error is returned if a AndPredicateAttributes struct could not be generated from the wire
 // representation.
-func (v *Any) Decode(sr stream.Reader) error {
+func (v *AndPredicateAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -6285,16 +6479,292 @@ func (v *Any) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.ValueType = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TBinary:
-			v.Value, err = sr.ReadBinary()
+		case fh.ID == 10 && fh.Type == wire.TList:
+			v.Predicates, err = _List_Predicate_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AndPredicateAttributes
+// struct.
+func (v *AndPredicateAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Predicates != nil {
+		fields[i] = fmt.Sprintf("Predicates: %v", v.Predicates)
+		i++
+	}
+
+	return fmt.Sprintf("AndPredicateAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Predicate_Equals(lhs, rhs []*Predicate) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this AndPredicateAttributes match the
+// provided AndPredicateAttributes.
+//
+// This function performs a deep comparison.
+func (v *AndPredicateAttributes) Equals(rhs *AndPredicateAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Predicates == nil && rhs.Predicates == nil) || (v.Predicates != nil && rhs.Predicates != nil && _List_Predicate_Equals(v.Predicates, rhs.Predicates))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_Predicate_Zapper []*Predicate
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Predicate_Zapper.
+func (l _List_Predicate_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AndPredicateAttributes.
+func (v *AndPredicateAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Predicates != nil {
+		err = multierr.Append(err, enc.AddArray("predicates", (_List_Predicate_Zapper)(v.Predicates)))
+	}
+	return err
+}
+
+// GetPredicates returns the value of Predicates if it is set or its
+// zero value if it is unset.
+func (v *AndPredicateAttributes) GetPredicates() (o []*Predicate) {
+	if v != nil && v.Predicates != nil {
+		return v.Predicates
+	}
+
+	return
+}
+
+// IsSetPredicates returns true if Predicates is not nil.
+func (v *AndPredicateAttributes) IsSetPredicates() bool {
+	return v != nil && v.Predicates != nil
+}
+
+// Any is a logical duplicate of google.protobuf.Any.
+//
+// The intent of the type is the same, but it is not intended to be directly
+// compatible with google.protobuf.Any or any Thrift equivalent - this blob is
+// RPC-type agnostic by design (as the underlying data may be transported over
+// proto or thrift), and the data-bytes may be in any encoding.
+//
+// This is intentionally different from DataBlob, which supports only a handful
+// of known encodings so it can be interpreted everywhere.  Any supports literally
+// any contents, and needs to be considered opaque until it is given to something
+// that is expecting it.
+//
+// See ValueType to interpret the contents.
+type Any struct {
+	ValueType *string `json:"ValueType,omitempty"`
+	Value     []byte  `json:"Value,omitempty"`
+}
+
+// ToWire translates a Any struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//		return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//		return err
+//	}
+func (v *Any) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ValueType != nil {
+		w, err = wire.NewValueString(*(v.ValueType)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Value != nil {
+		w, err = wire.NewValueBinary(v.Value), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Any struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Any struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//		return nil, err
+//	}
+//
+//	var v Any
+//	if err := v.FromWire(x); err != nil {
+//		return nil, err
+//	}
+//	return &v, nil
+func (v *Any) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ValueType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.Value, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Any struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Any struct could not be encoded.
+func (v *Any) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.ValueType != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ValueType)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Value != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Value); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Any struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Any struct could not be generated from the wire
+// representation.
+func (v *Any) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ValueType = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			v.Value, err = sr.ReadBinary()
 			if err != nil {
 				return err
 			}
@@ -36670,19 +37140,46 @@ func (v *DomainCacheInfo) IsSetNumOfItemsInCacheByName() bool {
 	return v != nil && v.NumOfItemsInCacheByName != nil
 }
 
-type DomainConfiguration struct {
-	WorkflowExecutionRetentionPeriodInDays *int32                       `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
-	EmitMetric                             *bool                        `json:"emitMetric,omitempty"`
-	Isolationgroups                        *IsolationGroupConfiguration `json:"isolationgroups,omitempty"`
-	BadBinaries                            *BadBinaries                 `json:"badBinaries,omitempty"`
-	HistoryArchivalStatus                  *ArchivalStatus              `json:"historyArchivalStatus,omitempty"`
-	HistoryArchivalURI                     *string                      `json:"historyArchivalURI,omitempty"`
-	VisibilityArchivalStatus               *ArchivalStatus              `json:"visibilityArchivalStatus,omitempty"`
-	VisibilityArchivalURI                  *string                      `json:"visibilityArchivalURI,omitempty"`
-	AsyncWorkflowConfiguration             *AsyncWorkflowConfiguration  `json:"AsyncWorkflowConfiguration,omitempty"`
+type DomainChange struct {
+	ID            *string              `json:"id,omitempty"`
+	CreatedTime   *int64               `json:"createdTime,omitempty"`
+	OperationType *string              `json:"operationType,omitempty"`
+	Identity      *string              `json:"identity,omitempty"`
+	IdentityType  *string              `json:"identityType,omitempty"`
+	Comment       *string              `json:"comment,omitempty"`
+	FieldChanges  []*DomainFieldChange `json:"fieldChanges,omitempty"`
 }
 
-// ToWire translates a DomainConfiguration struct into a Thrift-level intermediate
+type _List_DomainFieldChange_ValueList []*DomainFieldChange
+
+func (v _List_DomainFieldChange_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*DomainFieldChange', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_DomainFieldChange_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_DomainFieldChange_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_DomainFieldChange_ValueList) Close() {}
+
+// ToWire translates a DomainChange struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -36697,119 +37194,103 @@ type DomainConfiguration struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //		return err
 //	}
-func (v *DomainConfiguration) ToWire() (wire.Value, error) {
+func (v *DomainChange) ToWire() (wire.Value, error) {
 	var (
-		fields [9]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.WorkflowExecutionRetentionPeriodInDays != nil {
-		w, err = wire.NewValueI32(*(v.WorkflowExecutionRetentionPeriodInDays)), error(nil)
+	if v.ID != nil {
+		w, err = wire.NewValueString(*(v.ID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.EmitMetric != nil {
-		w, err = wire.NewValueBool(*(v.EmitMetric)), error(nil)
+	if v.CreatedTime != nil {
+		w, err = wire.NewValueI64(*(v.CreatedTime)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Isolationgroups != nil {
-		w, err = v.Isolationgroups.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
-	if v.BadBinaries != nil {
-		w, err = v.BadBinaries.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
-	if v.HistoryArchivalStatus != nil {
-		w, err = v.HistoryArchivalStatus.ToWire()
+	if v.OperationType != nil {
+		w, err = wire.NewValueString(*(v.OperationType)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 80, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.HistoryArchivalURI != nil {
-		w, err = wire.NewValueString(*(v.HistoryArchivalURI)), error(nil)
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 90, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.VisibilityArchivalStatus != nil {
-		w, err = v.VisibilityArchivalStatus.ToWire()
+	if v.IdentityType != nil {
+		w, err = wire.NewValueString(*(v.IdentityType)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 100, Value: w}
+		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.VisibilityArchivalURI != nil {
-		w, err = wire.NewValueString(*(v.VisibilityArchivalURI)), error(nil)
+	if v.Comment != nil {
+		w, err = wire.NewValueString(*(v.Comment)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 110, Value: w}
+		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
-	if v.AsyncWorkflowConfiguration != nil {
-		w, err = v.AsyncWorkflowConfiguration.ToWire()
+	if v.FieldChanges != nil {
+		w, err = wire.NewValueList(_List_DomainFieldChange_ValueList(v.FieldChanges)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 120, Value: w}
+		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _IsolationGroupConfiguration_Read(w wire.Value) (*IsolationGroupConfiguration, error) {
-	var v IsolationGroupConfiguration
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _BadBinaries_Read(w wire.Value) (*BadBinaries, error) {
-	var v BadBinaries
+func _DomainFieldChange_Read(w wire.Value) (*DomainFieldChange, error) {
+	var v DomainFieldChange
 	err := v.FromWire(w)
 	return &v, err
 }
 
-func _ArchivalStatus_Read(w wire.Value) (ArchivalStatus, error) {
-	var v ArchivalStatus
-	err := v.FromWire(w)
-	return v, err
-}
+func _List_DomainFieldChange_Read(l wire.ValueList) ([]*DomainFieldChange, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
 
-func _AsyncWorkflowConfiguration_Read(w wire.Value) (*AsyncWorkflowConfiguration, error) {
-	var v AsyncWorkflowConfiguration
-	err := v.FromWire(w)
-	return &v, err
+	o := make([]*DomainFieldChange, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _DomainFieldChange_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
 }
 
-// FromWire deserializes a DomainConfiguration struct from its Thrift-level
+// FromWire deserializes a DomainChange struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DomainConfiguration struct
+// An error is returned if we were unable to build a DomainChange struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -36817,95 +37298,79 @@ func _AsyncWorkflowConfiguration_Read(w wire.Value) (*AsyncWorkflowConfiguration
 //		return nil, err
 //	}
 //
-//	var v DomainConfiguration
+//	var v DomainChange
 //	if err := v.FromWire(x); err != nil {
 //		return nil, err
 //	}
 //	return &v, nil
-func (v *DomainConfiguration) FromWire(w wire.Value) error {
+func (v *DomainChange) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.WorkflowExecutionRetentionPeriodInDays = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.EmitMetric = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TStruct {
-				v.Isolationgroups, err = _IsolationGroupConfiguration_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TStruct {
-				v.BadBinaries, err = _BadBinaries_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.CreatedTime = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 80:
-			if field.Value.Type() == wire.TI32 {
-				var x ArchivalStatus
-				x, err = _ArchivalStatus_Read(field.Value)
-				v.HistoryArchivalStatus = &x
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.OperationType = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 90:
+		case 40:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.HistoryArchivalURI = &x
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 100:
-			if field.Value.Type() == wire.TI32 {
-				var x ArchivalStatus
-				x, err = _ArchivalStatus_Read(field.Value)
-				v.VisibilityArchivalStatus = &x
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.IdentityType = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 110:
+		case 60:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.VisibilityArchivalURI = &x
+				v.Comment = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 120:
-			if field.Value.Type() == wire.TStruct {
-				v.AsyncWorkflowConfiguration, err = _AsyncWorkflowConfiguration_Read(field.Value)
+		case 70:
+			if field.Value.Type() == wire.TList {
+				v.FieldChanges, err = _List_DomainFieldChange_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -36917,44 +37382,41 @@ func (v *DomainConfiguration) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a DomainConfiguration struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a DomainConfiguration struct could not be encoded.
-func (v *DomainConfiguration) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
+func _List_DomainFieldChange_Encode(val []*DomainFieldChange, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
 		return err
 	}
 
-	if v.WorkflowExecutionRetentionPeriodInDays != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.WorkflowExecutionRetentionPeriodInDays)); err != nil {
-			return err
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*DomainFieldChange', index [%v]: value is nil", i)
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
+		if err := v.Encode(sw); err != nil {
 			return err
 		}
 	}
+	return sw.WriteListEnd()
+}
 
-	if v.EmitMetric != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBool}); err != nil {
-			return err
-		}
-		if err := sw.WriteBool(*(v.EmitMetric)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+// Encode serializes a DomainChange struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a DomainChange struct could not be encoded.
+func (v *DomainChange) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
 	}
 
-	if v.Isolationgroups != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TStruct}); err != nil {
+	if v.ID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := v.Isolationgroups.Encode(sw); err != nil {
+		if err := sw.WriteString(*(v.ID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -36962,11 +37424,11 @@ func (v *DomainConfiguration) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.BadBinaries != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TStruct}); err != nil {
+	if v.CreatedTime != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := v.BadBinaries.Encode(sw); err != nil {
+		if err := sw.WriteInt64(*(v.CreatedTime)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -36974,11 +37436,11 @@ func (v *DomainConfiguration) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.HistoryArchivalStatus != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TI32}); err != nil {
+	if v.OperationType != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := v.HistoryArchivalStatus.Encode(sw); err != nil {
+		if err := sw.WriteString(*(v.OperationType)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -36986,11 +37448,11 @@ func (v *DomainConfiguration) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.HistoryArchivalURI != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TBinary}); err != nil {
+	if v.Identity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.HistoryArchivalURI)); err != nil {
+		if err := sw.WriteString(*(v.Identity)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -36998,11 +37460,11 @@ func (v *DomainConfiguration) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.VisibilityArchivalStatus != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TI32}); err != nil {
+	if v.IdentityType != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := v.VisibilityArchivalStatus.Encode(sw); err != nil {
+		if err := sw.WriteString(*(v.IdentityType)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -37010,11 +37472,11 @@ func (v *DomainConfiguration) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.VisibilityArchivalURI != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 110, Type: wire.TBinary}); err != nil {
+	if v.Comment != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.VisibilityArchivalURI)); err != nil {
+		if err := sw.WriteString(*(v.Comment)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -37022,11 +37484,11 @@ func (v *DomainConfiguration) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.AsyncWorkflowConfiguration != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 120, Type: wire.TStruct}); err != nil {
+	if v.FieldChanges != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TList}); err != nil {
 			return err
 		}
-		if err := v.AsyncWorkflowConfiguration.Encode(sw); err != nil {
+		if err := _List_DomainFieldChange_Encode(v.FieldChanges, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -37037,36 +37499,48 @@ func (v *DomainConfiguration) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _IsolationGroupConfiguration_Decode(sr stream.Reader) (*IsolationGroupConfiguration, error) {
-	var v IsolationGroupConfiguration
+func _DomainFieldChange_Decode(sr stream.Reader) (*DomainFieldChange, error) {
+	var v DomainFieldChange
 	err := v.Decode(sr)
 	return &v, err
 }
 
-func _BadBinaries_Decode(sr stream.Reader) (*BadBinaries, error) {
-	var v BadBinaries
-	err := v.Decode(sr)
-	return &v, err
-}
+func _List_DomainFieldChange_Decode(sr stream.Reader) ([]*DomainFieldChange, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
 
-func _ArchivalStatus_Decode(sr stream.Reader) (ArchivalStatus, error) {
-	var v ArchivalStatus
-	err := v.Decode(sr)
-	return v, err
-}
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
 
-func _AsyncWorkflowConfiguration_Decode(sr stream.Reader) (*AsyncWorkflowConfiguration, error) {
-	var v AsyncWorkflowConfiguration
-	err := v.Decode(sr)
-	return &v, err
+	o := make([]*DomainFieldChange, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _DomainFieldChange_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-// Decode deserializes a DomainConfiguration struct directly from its Thrift-level
+// Decode deserializes a DomainChange struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a DomainConfiguration struct could not be generated from the wire
+// An error is returned if a DomainChange struct could not be generated from the wire
 // representation.
-func (v *DomainConfiguration) Decode(sr stream.Reader) error {
+func (v *DomainChange) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -37079,68 +37553,56 @@ func (v *DomainConfiguration) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.WorkflowExecutionRetentionPeriodInDays = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TBool:
-			var x bool
-			x, err = sr.ReadBool()
-			v.EmitMetric = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 60 && fh.Type == wire.TStruct:
-			v.Isolationgroups, err = _IsolationGroupConfiguration_Decode(sr)
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ID = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 70 && fh.Type == wire.TStruct:
-			v.BadBinaries, err = _BadBinaries_Decode(sr)
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.CreatedTime = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 80 && fh.Type == wire.TI32:
-			var x ArchivalStatus
-			x, err = _ArchivalStatus_Decode(sr)
-			v.HistoryArchivalStatus = &x
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.OperationType = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 90 && fh.Type == wire.TBinary:
+		case fh.ID == 40 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.HistoryArchivalURI = &x
+			v.Identity = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 100 && fh.Type == wire.TI32:
-			var x ArchivalStatus
-			x, err = _ArchivalStatus_Decode(sr)
-			v.VisibilityArchivalStatus = &x
+		case fh.ID == 50 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.IdentityType = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 110 && fh.Type == wire.TBinary:
+		case fh.ID == 60 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.VisibilityArchivalURI = &x
+			v.Comment = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 120 && fh.Type == wire.TStruct:
-			v.AsyncWorkflowConfiguration, err = _AsyncWorkflowConfiguration_Decode(sr)
+		case fh.ID == 70 && fh.Type == wire.TList:
+			v.FieldChanges, err = _List_DomainFieldChange_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -37167,283 +37629,256 @@ func (v *DomainConfiguration) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a DomainConfiguration
+// String returns a readable string representation of a DomainChange
 // struct.
-func (v *DomainConfiguration) String() string {
+func (v *DomainChange) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [9]string
+	var fields [7]string
 	i := 0
-	if v.WorkflowExecutionRetentionPeriodInDays != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionRetentionPeriodInDays: %v", *(v.WorkflowExecutionRetentionPeriodInDays))
-		i++
-	}
-	if v.EmitMetric != nil {
-		fields[i] = fmt.Sprintf("EmitMetric: %v", *(v.EmitMetric))
-		i++
-	}
-	if v.Isolationgroups != nil {
-		fields[i] = fmt.Sprintf("Isolationgroups: %v", v.Isolationgroups)
+	if v.ID != nil {
+		fields[i] = fmt.Sprintf("ID: %v", *(v.ID))
 		i++
 	}
-	if v.BadBinaries != nil {
-		fields[i] = fmt.Sprintf("BadBinaries: %v", v.BadBinaries)
+	if v.CreatedTime != nil {
+		fields[i] = fmt.Sprintf("CreatedTime: %v", *(v.CreatedTime))
 		i++
 	}
-	if v.HistoryArchivalStatus != nil {
-		fields[i] = fmt.Sprintf("HistoryArchivalStatus: %v", *(v.HistoryArchivalStatus))
+	if v.OperationType != nil {
+		fields[i] = fmt.Sprintf("OperationType: %v", *(v.OperationType))
 		i++
 	}
-	if v.HistoryArchivalURI != nil {
-		fields[i] = fmt.Sprintf("HistoryArchivalURI: %v", *(v.HistoryArchivalURI))
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
-	if v.VisibilityArchivalStatus != nil {
-		fields[i] = fmt.Sprintf("VisibilityArchivalStatus: %v", *(v.VisibilityArchivalStatus))
+	if v.IdentityType != nil {
+		fields[i] = fmt.Sprintf("IdentityType: %v", *(v.IdentityType))
 		i++
 	}
-	if v.VisibilityArchivalURI != nil {
-		fields[i] = fmt.Sprintf("VisibilityArchivalURI: %v", *(v.VisibilityArchivalURI))
+	if v.Comment != nil {
+		fields[i] = fmt.Sprintf("Comment: %v", *(v.Comment))
 		i++
 	}
-	if v.AsyncWorkflowConfiguration != nil {
-		fields[i] = fmt.Sprintf("AsyncWorkflowConfiguration: %v", v.AsyncWorkflowConfiguration)
+	if v.FieldChanges != nil {
+		fields[i] = fmt.Sprintf("FieldChanges: %v", v.FieldChanges)
 		i++
 	}
 
-	return fmt.Sprintf("DomainConfiguration{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DomainChange{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _ArchivalStatus_EqualsPtr(lhs, rhs *ArchivalStatus) bool {
-	if lhs != nil && rhs != nil {
+func _List_DomainFieldChange_Equals(lhs, rhs []*DomainFieldChange) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
 
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
 	}
-	return lhs == nil && rhs == nil
+
+	return true
 }
 
-// Equals returns true if all the fields of this DomainConfiguration match the
-// provided DomainConfiguration.
+// Equals returns true if all the fields of this DomainChange match the
+// provided DomainChange.
 //
 // This function performs a deep comparison.
-func (v *DomainConfiguration) Equals(rhs *DomainConfiguration) bool {
+func (v *DomainChange) Equals(rhs *DomainChange) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_I32_EqualsPtr(v.WorkflowExecutionRetentionPeriodInDays, rhs.WorkflowExecutionRetentionPeriodInDays) {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.EmitMetric, rhs.EmitMetric) {
-		return false
-	}
-	if !((v.Isolationgroups == nil && rhs.Isolationgroups == nil) || (v.Isolationgroups != nil && rhs.Isolationgroups != nil && v.Isolationgroups.Equals(rhs.Isolationgroups))) {
+	if !_String_EqualsPtr(v.ID, rhs.ID) {
 		return false
 	}
-	if !((v.BadBinaries == nil && rhs.BadBinaries == nil) || (v.BadBinaries != nil && rhs.BadBinaries != nil && v.BadBinaries.Equals(rhs.BadBinaries))) {
+	if !_I64_EqualsPtr(v.CreatedTime, rhs.CreatedTime) {
 		return false
 	}
-	if !_ArchivalStatus_EqualsPtr(v.HistoryArchivalStatus, rhs.HistoryArchivalStatus) {
+	if !_String_EqualsPtr(v.OperationType, rhs.OperationType) {
 		return false
 	}
-	if !_String_EqualsPtr(v.HistoryArchivalURI, rhs.HistoryArchivalURI) {
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
-	if !_ArchivalStatus_EqualsPtr(v.VisibilityArchivalStatus, rhs.VisibilityArchivalStatus) {
+	if !_String_EqualsPtr(v.IdentityType, rhs.IdentityType) {
 		return false
 	}
-	if !_String_EqualsPtr(v.VisibilityArchivalURI, rhs.VisibilityArchivalURI) {
+	if !_String_EqualsPtr(v.Comment, rhs.Comment) {
 		return false
 	}
-	if !((v.AsyncWorkflowConfiguration == nil && rhs.AsyncWorkflowConfiguration == nil) || (v.AsyncWorkflowConfiguration != nil && rhs.AsyncWorkflowConfiguration != nil && v.AsyncWorkflowConfiguration.Equals(rhs.AsyncWorkflowConfiguration))) {
+	if !((v.FieldChanges == nil && rhs.FieldChanges == nil) || (v.FieldChanges != nil && rhs.FieldChanges != nil && _List_DomainFieldChange_Equals(v.FieldChanges, rhs.FieldChanges))) {
 		return false
 	}
 
 	return true
 }
 
+type _List_DomainFieldChange_Zapper []*DomainFieldChange
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_DomainFieldChange_Zapper.
+func (l _List_DomainFieldChange_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DomainConfiguration.
-func (v *DomainConfiguration) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DomainChange.
+func (v *DomainChange) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.WorkflowExecutionRetentionPeriodInDays != nil {
-		enc.AddInt32("workflowExecutionRetentionPeriodInDays", *v.WorkflowExecutionRetentionPeriodInDays)
-	}
-	if v.EmitMetric != nil {
-		enc.AddBool("emitMetric", *v.EmitMetric)
-	}
-	if v.Isolationgroups != nil {
-		err = multierr.Append(err, enc.AddObject("isolationgroups", v.Isolationgroups))
+	if v.ID != nil {
+		enc.AddString("id", *v.ID)
 	}
-	if v.BadBinaries != nil {
-		err = multierr.Append(err, enc.AddObject("badBinaries", v.BadBinaries))
+	if v.CreatedTime != nil {
+		enc.AddInt64("createdTime", *v.CreatedTime)
 	}
-	if v.HistoryArchivalStatus != nil {
-		err = multierr.Append(err, enc.AddObject("historyArchivalStatus", *v.HistoryArchivalStatus))
+	if v.OperationType != nil {
+		enc.AddString("operationType", *v.OperationType)
 	}
-	if v.HistoryArchivalURI != nil {
-		enc.AddString("historyArchivalURI", *v.HistoryArchivalURI)
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
 	}
-	if v.VisibilityArchivalStatus != nil {
-		err = multierr.Append(err, enc.AddObject("visibilityArchivalStatus", *v.VisibilityArchivalStatus))
+	if v.IdentityType != nil {
+		enc.AddString("identityType", *v.IdentityType)
 	}
-	if v.VisibilityArchivalURI != nil {
-		enc.AddString("visibilityArchivalURI", *v.VisibilityArchivalURI)
+	if v.Comment != nil {
+		enc.AddString("comment", *v.Comment)
 	}
-	if v.AsyncWorkflowConfiguration != nil {
-		err = multierr.Append(err, enc.AddObject("AsyncWorkflowConfiguration", v.AsyncWorkflowConfiguration))
+	if v.FieldChanges != nil {
+		err = multierr.Append(err, enc.AddArray("fieldChanges", (_List_DomainFieldChange_Zapper)(v.FieldChanges)))
 	}
 	return err
 }
 
-// GetWorkflowExecutionRetentionPeriodInDays returns the value of WorkflowExecutionRetentionPeriodInDays if it is set or its
+// GetID returns the value of ID if it is set or its
 // zero value if it is unset.
-func (v *DomainConfiguration) GetWorkflowExecutionRetentionPeriodInDays() (o int32) {
-	if v != nil && v.WorkflowExecutionRetentionPeriodInDays != nil {
-		return *v.WorkflowExecutionRetentionPeriodInDays
+func (v *DomainChange) GetID() (o string) {
+	if v != nil && v.ID != nil {
+		return *v.ID
 	}
 
 	return
 }
 
-// IsSetWorkflowExecutionRetentionPeriodInDays returns true if WorkflowExecutionRetentionPeriodInDays is not nil.
-func (v *DomainConfiguration) IsSetWorkflowExecutionRetentionPeriodInDays() bool {
-	return v != nil && v.WorkflowExecutionRetentionPeriodInDays != nil
+// IsSetID returns true if ID is not nil.
+func (v *DomainChange) IsSetID() bool {
+	return v != nil && v.ID != nil
 }
 
-// GetEmitMetric returns the value of EmitMetric if it is set or its
+// GetCreatedTime returns the value of CreatedTime if it is set or its
 // zero value if it is unset.
-func (v *DomainConfiguration) GetEmitMetric() (o bool) {
-	if v != nil && v.EmitMetric != nil {
-		return *v.EmitMetric
+func (v *DomainChange) GetCreatedTime() (o int64) {
+	if v != nil && v.CreatedTime != nil {
+		return *v.CreatedTime
 	}
 
 	return
 }
 
-// IsSetEmitMetric returns true if EmitMetric is not nil.
-func (v *DomainConfiguration) IsSetEmitMetric() bool {
-	return v != nil && v.EmitMetric != nil
+// IsSetCreatedTime returns true if CreatedTime is not nil.
+func (v *DomainChange) IsSetCreatedTime() bool {
+	return v != nil && v.CreatedTime != nil
 }
 
-// GetIsolationgroups returns the value of Isolationgroups if it is set or its
+// GetOperationType returns the value of OperationType if it is set or its
 // zero value if it is unset.
-func (v *DomainConfiguration) GetIsolationgroups() (o *IsolationGroupConfiguration) {
-	if v != nil && v.Isolationgroups != nil {
-		return v.Isolationgroups
+func (v *DomainChange) GetOperationType() (o string) {
+	if v != nil && v.OperationType != nil {
+		return *v.OperationType
 	}
 
 	return
 }
 
-// IsSetIsolationgroups returns true if Isolationgroups is not nil.
-func (v *DomainConfiguration) IsSetIsolationgroups() bool {
-	return v != nil && v.Isolationgroups != nil
+// IsSetOperationType returns true if OperationType is not nil.
+func (v *DomainChange) IsSetOperationType() bool {
+	return v != nil && v.OperationType != nil
 }
 
-// GetBadBinaries returns the value of BadBinaries if it is set or its
+// GetIdentity returns the value of Identity if it is set or its
 // zero value if it is unset.
-func (v *DomainConfiguration) GetBadBinaries() (o *BadBinaries) {
-	if v != nil && v.BadBinaries != nil {
-		return v.BadBinaries
+func (v *DomainChange) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
 	}
 
 	return
 }
 
-// IsSetBadBinaries returns true if BadBinaries is not nil.
-func (v *DomainConfiguration) IsSetBadBinaries() bool {
-	return v != nil && v.BadBinaries != nil
+// IsSetIdentity returns true if Identity is not nil.
+func (v *DomainChange) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
 }
 
-// GetHistoryArchivalStatus returns the value of HistoryArchivalStatus if it is set or its
+// GetIdentityType returns the value of IdentityType if it is set or its
 // zero value if it is unset.
-func (v *DomainConfiguration) GetHistoryArchivalStatus() (o ArchivalStatus) {
-	if v != nil && v.HistoryArchivalStatus != nil {
-		return *v.HistoryArchivalStatus
+func (v *DomainChange) GetIdentityType() (o string) {
+	if v != nil && v.IdentityType != nil {
+		return *v.IdentityType
 	}
 
 	return
 }
 
-// IsSetHistoryArchivalStatus returns true if HistoryArchivalStatus is not nil.
-func (v *DomainConfiguration) IsSetHistoryArchivalStatus() bool {
-	return v != nil && v.HistoryArchivalStatus != nil
+// IsSetIdentityType returns true if IdentityType is not nil.
+func (v *DomainChange) IsSetIdentityType() bool {
+	return v != nil && v.IdentityType != nil
 }
 
-// GetHistoryArchivalURI returns the value of HistoryArchivalURI if it is set or its
+// GetComment returns the value of Comment if it is set or its
 // zero value if it is unset.
-func (v *DomainConfiguration) GetHistoryArchivalURI() (o string) {
-	if v != nil && v.HistoryArchivalURI != nil {
-		return *v.HistoryArchivalURI
+func (v *DomainChange) GetComment() (o string) {
+	if v != nil && v.Comment != nil {
+		return *v.Comment
 	}
 
 	return
 }
 
-// IsSetHistoryArchivalURI returns true if HistoryArchivalURI is not nil.
-func (v *DomainConfiguration) IsSetHistoryArchivalURI() bool {
-	return v != nil && v.HistoryArchivalURI != nil
+// IsSetComment returns true if Comment is not nil.
+func (v *DomainChange) IsSetComment() bool {
+	return v != nil && v.Comment != nil
 }
 
-// GetVisibilityArchivalStatus returns the value of VisibilityArchivalStatus if it is set or its
+// GetFieldChanges returns the value of FieldChanges if it is set or its
 // zero value if it is unset.
-func (v *DomainConfiguration) GetVisibilityArchivalStatus() (o ArchivalStatus) {
-	if v != nil && v.VisibilityArchivalStatus != nil {
-		return *v.VisibilityArchivalStatus
+func (v *DomainChange) GetFieldChanges() (o []*DomainFieldChange) {
+	if v != nil && v.FieldChanges != nil {
+		return v.FieldChanges
 	}
 
 	return
 }
 
-// IsSetVisibilityArchivalStatus returns true if VisibilityArchivalStatus is not nil.
-func (v *DomainConfiguration) IsSetVisibilityArchivalStatus() bool {
-	return v != nil && v.VisibilityArchivalStatus != nil
+// IsSetFieldChanges returns true if FieldChanges is not nil.
+func (v *DomainChange) IsSetFieldChanges() bool {
+	return v != nil && v.FieldChanges != nil
 }
 
-// GetVisibilityArchivalURI returns the value of VisibilityArchivalURI if it is set or its
-// zero value if it is unset.
-func (v *DomainConfiguration) GetVisibilityArchivalURI() (o string) {
-	if v != nil && v.VisibilityArchivalURI != nil {
-		return *v.VisibilityArchivalURI
-	}
-
-	return
-}
-
-// IsSetVisibilityArchivalURI returns true if VisibilityArchivalURI is not nil.
-func (v *DomainConfiguration) IsSetVisibilityArchivalURI() bool {
-	return v != nil && v.VisibilityArchivalURI != nil
-}
-
-// GetAsyncWorkflowConfiguration returns the value of AsyncWorkflowConfiguration if it is set or its
-// zero value if it is unset.
-func (v *DomainConfiguration) GetAsyncWorkflowConfiguration() (o *AsyncWorkflowConfiguration) {
-	if v != nil && v.AsyncWorkflowConfiguration != nil {
-		return v.AsyncWorkflowConfiguration
-	}
-
-	return
-}
-
-// IsSetAsyncWorkflowConfiguration returns true if AsyncWorkflowConfiguration is not nil.
-func (v *DomainConfiguration) IsSetAsyncWorkflowConfiguration() bool {
-	return v != nil && v.AsyncWorkflowConfiguration != nil
-}
-
-type DomainIDPredicateAttributes struct {
-	DomainIDs   []string `json:"domainIDs,omitempty"`
-	IsExclusive *bool    `json:"isExclusive,omitempty"`
+type DomainConfiguration struct {
+	WorkflowExecutionRetentionPeriodInDays *int32                       `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
+	EmitMetric                             *bool                        `json:"emitMetric,omitempty"`
+	Isolationgroups                        *IsolationGroupConfiguration `json:"isolationgroups,omitempty"`
+	BadBinaries                            *BadBinaries                 `json:"badBinaries,omitempty"`
+	HistoryArchivalStatus                  *ArchivalStatus              `json:"historyArchivalStatus,omitempty"`
+	HistoryArchivalURI                     *string                      `json:"historyArchivalURI,omitempty"`
+	VisibilityArchivalStatus               *ArchivalStatus              `json:"visibilityArchivalStatus,omitempty"`
+	VisibilityArchivalURI                  *string                      `json:"visibilityArchivalURI,omitempty"`
+	AsyncWorkflowConfiguration             *AsyncWorkflowConfiguration  `json:"AsyncWorkflowConfiguration,omitempty"`
 }
 
-// ToWire translates a DomainIDPredicateAttributes struct into a Thrift-level intermediate
+// ToWire translates a DomainConfiguration struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -37458,351 +37893,119 @@ type DomainIDPredicateAttributes struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //		return err
 //	}
-func (v *DomainIDPredicateAttributes) ToWire() (wire.Value, error) {
+func (v *DomainConfiguration) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [9]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainIDs != nil {
-		w, err = wire.NewValueList(_List_String_ValueList(v.DomainIDs)), error(nil)
+	if v.WorkflowExecutionRetentionPeriodInDays != nil {
+		w, err = wire.NewValueI32(*(v.WorkflowExecutionRetentionPeriodInDays)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.IsExclusive != nil {
-		w, err = wire.NewValueBool(*(v.IsExclusive)), error(nil)
+	if v.EmitMetric != nil {
+		w, err = wire.NewValueBool(*(v.EmitMetric)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-// FromWire deserializes a DomainIDPredicateAttributes struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a DomainIDPredicateAttributes struct
-// from the provided intermediate representation.
-//
-//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//	if err != nil {
-//		return nil, err
-//	}
-//
-//	var v DomainIDPredicateAttributes
-//	if err := v.FromWire(x); err != nil {
-//		return nil, err
-//	}
-//	return &v, nil
-func (v *DomainIDPredicateAttributes) FromWire(w wire.Value) error {
-	var err error
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TList {
-				v.DomainIDs, err = _List_String_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.IsExclusive = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		}
-	}
-
-	return nil
-}
-
-// Encode serializes a DomainIDPredicateAttributes struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a DomainIDPredicateAttributes struct could not be encoded.
-func (v *DomainIDPredicateAttributes) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
-		return err
-	}
-
-	if v.DomainIDs != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
-			return err
-		}
-		if err := _List_String_Encode(v.DomainIDs, sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.IsExclusive != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBool}); err != nil {
-			return err
-		}
-		if err := sw.WriteBool(*(v.IsExclusive)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	return sw.WriteStructEnd()
-}
-
-// Decode deserializes a DomainIDPredicateAttributes struct directly from its Thrift-level
-// representation, without going through an intemediary type.
-//
-// An error is returned if a DomainIDPredicateAttributes struct could not be generated from the wire
-// representation.
-func (v *DomainIDPredicateAttributes) Decode(sr stream.Reader) error {
-
-	if err := sr.ReadStructBegin(); err != nil {
-		return err
-	}
-
-	fh, ok, err := sr.ReadFieldBegin()
-	if err != nil {
-		return err
-	}
-
-	for ok {
-		switch {
-		case fh.ID == 10 && fh.Type == wire.TList:
-			v.DomainIDs, err = _List_String_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TBool:
-			var x bool
-			x, err = sr.ReadBool()
-			v.IsExclusive = &x
-			if err != nil {
-				return err
-			}
-
-		default:
-			if err := sr.Skip(fh.Type); err != nil {
-				return err
-			}
-		}
-
-		if err := sr.ReadFieldEnd(); err != nil {
-			return err
-		}
-
-		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
-			return err
+	if v.Isolationgroups != nil {
+		w, err = v.Isolationgroups.ToWire()
+		if err != nil {
+			return w, err
 		}
-	}
-
-	if err := sr.ReadStructEnd(); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// String returns a readable string representation of a DomainIDPredicateAttributes
-// struct.
-func (v *DomainIDPredicateAttributes) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-
-	var fields [2]string
-	i := 0
-	if v.DomainIDs != nil {
-		fields[i] = fmt.Sprintf("DomainIDs: %v", v.DomainIDs)
-		i++
-	}
-	if v.IsExclusive != nil {
-		fields[i] = fmt.Sprintf("IsExclusive: %v", *(v.IsExclusive))
+		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
-
-	return fmt.Sprintf("DomainIDPredicateAttributes{%v}", strings.Join(fields[:i], ", "))
-}
-
-// Equals returns true if all the fields of this DomainIDPredicateAttributes match the
-// provided DomainIDPredicateAttributes.
-//
-// This function performs a deep comparison.
-func (v *DomainIDPredicateAttributes) Equals(rhs *DomainIDPredicateAttributes) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !((v.DomainIDs == nil && rhs.DomainIDs == nil) || (v.DomainIDs != nil && rhs.DomainIDs != nil && _List_String_Equals(v.DomainIDs, rhs.DomainIDs))) {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.IsExclusive, rhs.IsExclusive) {
-		return false
-	}
-
-	return true
-}
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DomainIDPredicateAttributes.
-func (v *DomainIDPredicateAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
-	}
-	if v.DomainIDs != nil {
-		err = multierr.Append(err, enc.AddArray("domainIDs", (_List_String_Zapper)(v.DomainIDs)))
-	}
-	if v.IsExclusive != nil {
-		enc.AddBool("isExclusive", *v.IsExclusive)
-	}
-	return err
-}
-
-// GetDomainIDs returns the value of DomainIDs if it is set or its
-// zero value if it is unset.
-func (v *DomainIDPredicateAttributes) GetDomainIDs() (o []string) {
-	if v != nil && v.DomainIDs != nil {
-		return v.DomainIDs
-	}
-
-	return
-}
-
-// IsSetDomainIDs returns true if DomainIDs is not nil.
-func (v *DomainIDPredicateAttributes) IsSetDomainIDs() bool {
-	return v != nil && v.DomainIDs != nil
-}
-
-// GetIsExclusive returns the value of IsExclusive if it is set or its
-// zero value if it is unset.
-func (v *DomainIDPredicateAttributes) GetIsExclusive() (o bool) {
-	if v != nil && v.IsExclusive != nil {
-		return *v.IsExclusive
-	}
-
-	return
-}
-
-// IsSetIsExclusive returns true if IsExclusive is not nil.
-func (v *DomainIDPredicateAttributes) IsSetIsExclusive() bool {
-	return v != nil && v.IsExclusive != nil
-}
-
-type DomainInfo struct {
-	Name        *string           `json:"name,omitempty"`
-	Status      *DomainStatus     `json:"status,omitempty"`
-	Description *string           `json:"description,omitempty"`
-	OwnerEmail  *string           `json:"ownerEmail,omitempty"`
-	Data        map[string]string `json:"data,omitempty"`
-	UUID        *string           `json:"uuid,omitempty"`
-}
-
-// ToWire translates a DomainInfo struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//	x, err := v.ToWire()
-//	if err != nil {
-//		return err
-//	}
-//
-//	if err := binaryProtocol.Encode(x, writer); err != nil {
-//		return err
-//	}
-func (v *DomainInfo) ToWire() (wire.Value, error) {
-	var (
-		fields [6]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
-
-	if v.Name != nil {
-		w, err = wire.NewValueString(*(v.Name)), error(nil)
+	if v.BadBinaries != nil {
+		w, err = v.BadBinaries.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
-	if v.Status != nil {
-		w, err = v.Status.ToWire()
+	if v.HistoryArchivalStatus != nil {
+		w, err = v.HistoryArchivalStatus.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 80, Value: w}
 		i++
 	}
-	if v.Description != nil {
-		w, err = wire.NewValueString(*(v.Description)), error(nil)
+	if v.HistoryArchivalURI != nil {
+		w, err = wire.NewValueString(*(v.HistoryArchivalURI)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 90, Value: w}
 		i++
 	}
-	if v.OwnerEmail != nil {
-		w, err = wire.NewValueString(*(v.OwnerEmail)), error(nil)
+	if v.VisibilityArchivalStatus != nil {
+		w, err = v.VisibilityArchivalStatus.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 40, Value: w}
+		fields[i] = wire.Field{ID: 100, Value: w}
 		i++
 	}
-	if v.Data != nil {
-		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Data)), error(nil)
+	if v.VisibilityArchivalURI != nil {
+		w, err = wire.NewValueString(*(v.VisibilityArchivalURI)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 50, Value: w}
+		fields[i] = wire.Field{ID: 110, Value: w}
 		i++
 	}
-	if v.UUID != nil {
-		w, err = wire.NewValueString(*(v.UUID)), error(nil)
+	if v.AsyncWorkflowConfiguration != nil {
+		w, err = v.AsyncWorkflowConfiguration.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 60, Value: w}
+		fields[i] = wire.Field{ID: 120, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DomainStatus_Read(w wire.Value) (DomainStatus, error) {
-	var v DomainStatus
+func _IsolationGroupConfiguration_Read(w wire.Value) (*IsolationGroupConfiguration, error) {
+	var v IsolationGroupConfiguration
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _BadBinaries_Read(w wire.Value) (*BadBinaries, error) {
+	var v BadBinaries
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ArchivalStatus_Read(w wire.Value) (ArchivalStatus, error) {
+	var v ArchivalStatus
 	err := v.FromWire(w)
 	return v, err
 }
 
-// FromWire deserializes a DomainInfo struct from its Thrift-level
+func _AsyncWorkflowConfiguration_Read(w wire.Value) (*AsyncWorkflowConfiguration, error) {
+	var v AsyncWorkflowConfiguration
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a DomainConfiguration struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DomainInfo struct
+// An error is returned if we were unable to build a DomainConfiguration struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -37810,69 +38013,95 @@ func _DomainStatus_Read(w wire.Value) (DomainStatus, error) {
 //		return nil, err
 //	}
 //
-//	var v DomainInfo
+//	var v DomainConfiguration
 //	if err := v.FromWire(x); err != nil {
 //		return nil, err
 //	}
 //	return &v, nil
-func (v *DomainInfo) FromWire(w wire.Value) error {
+func (v *DomainConfiguration) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Name = &x
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.WorkflowExecutionRetentionPeriodInDays = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TI32 {
-				var x DomainStatus
-				x, err = _DomainStatus_Read(field.Value)
-				v.Status = &x
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.EmitMetric = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Description = &x
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.Isolationgroups, err = _IsolationGroupConfiguration_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 40:
+		case 70:
+			if field.Value.Type() == wire.TStruct {
+				v.BadBinaries, err = _BadBinaries_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI32 {
+				var x ArchivalStatus
+				x, err = _ArchivalStatus_Read(field.Value)
+				v.HistoryArchivalStatus = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.OwnerEmail = &x
+				v.HistoryArchivalURI = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 50:
-			if field.Value.Type() == wire.TMap {
-				v.Data, err = _Map_String_String_Read(field.Value.GetMap())
+		case 100:
+			if field.Value.Type() == wire.TI32 {
+				var x ArchivalStatus
+				x, err = _ArchivalStatus_Read(field.Value)
+				v.VisibilityArchivalStatus = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 60:
+		case 110:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.UUID = &x
+				v.VisibilityArchivalURI = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TStruct {
+				v.AsyncWorkflowConfiguration, err = _AsyncWorkflowConfiguration_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -37884,20 +38113,20 @@ func (v *DomainInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a DomainInfo struct directly into bytes, without going
+// Encode serializes a DomainConfiguration struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a DomainInfo struct could not be encoded.
-func (v *DomainInfo) Encode(sw stream.Writer) error {
+// An error is returned if a DomainConfiguration struct could not be encoded.
+func (v *DomainConfiguration) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Name != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+	if v.WorkflowExecutionRetentionPeriodInDays != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Name)); err != nil {
+		if err := sw.WriteInt32(*(v.WorkflowExecutionRetentionPeriodInDays)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -37905,11 +38134,11 @@ func (v *DomainInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Status != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
+	if v.EmitMetric != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBool}); err != nil {
 			return err
 		}
-		if err := v.Status.Encode(sw); err != nil {
+		if err := sw.WriteBool(*(v.EmitMetric)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -37917,11 +38146,11 @@ func (v *DomainInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Description != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+	if v.Isolationgroups != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Description)); err != nil {
+		if err := v.Isolationgroups.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -37929,11 +38158,11 @@ func (v *DomainInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.OwnerEmail != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+	if v.BadBinaries != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.OwnerEmail)); err != nil {
+		if err := v.BadBinaries.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -37941,11 +38170,11 @@ func (v *DomainInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Data != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TMap}); err != nil {
+	if v.HistoryArchivalStatus != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := _Map_String_String_Encode(v.Data, sw); err != nil {
+		if err := v.HistoryArchivalStatus.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -37953,11 +38182,11 @@ func (v *DomainInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.UUID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBinary}); err != nil {
+	if v.HistoryArchivalURI != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.UUID)); err != nil {
+		if err := sw.WriteString(*(v.HistoryArchivalURI)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -37965,21 +38194,75 @@ func (v *DomainInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	return sw.WriteStructEnd()
-}
-
-func _DomainStatus_Decode(sr stream.Reader) (DomainStatus, error) {
-	var v DomainStatus
-	err := v.Decode(sr)
-	return v, err
-}
+	if v.VisibilityArchivalStatus != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.VisibilityArchivalStatus.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
 
-// Decode deserializes a DomainInfo struct directly from its Thrift-level
-// representation, without going through an intemediary type.
-//
-// An error is returned if a DomainInfo struct could not be generated from the wire
+	if v.VisibilityArchivalURI != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 110, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.VisibilityArchivalURI)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.AsyncWorkflowConfiguration != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 120, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.AsyncWorkflowConfiguration.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _IsolationGroupConfiguration_Decode(sr stream.Reader) (*IsolationGroupConfiguration, error) {
+	var v IsolationGroupConfiguration
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _BadBinaries_Decode(sr stream.Reader) (*BadBinaries, error) {
+	var v BadBinaries
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _ArchivalStatus_Decode(sr stream.Reader) (ArchivalStatus, error) {
+	var v ArchivalStatus
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _AsyncWorkflowConfiguration_Decode(sr stream.Reader) (*AsyncWorkflowConfiguration, error) {
+	var v AsyncWorkflowConfiguration
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a DomainConfiguration struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a DomainConfiguration struct could not be generated from the wire
 // representation.
-func (v *DomainInfo) Decode(sr stream.Reader) error {
+func (v *DomainConfiguration) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -37992,48 +38275,68 @@ func (v *DomainInfo) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Name = &x
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.WorkflowExecutionRetentionPeriodInDays = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TI32:
-			var x DomainStatus
-			x, err = _DomainStatus_Decode(sr)
-			v.Status = &x
+		case fh.ID == 20 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.EmitMetric = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Description = &x
+		case fh.ID == 60 && fh.Type == wire.TStruct:
+			v.Isolationgroups, err = _IsolationGroupConfiguration_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 40 && fh.Type == wire.TBinary:
+		case fh.ID == 70 && fh.Type == wire.TStruct:
+			v.BadBinaries, err = _BadBinaries_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 80 && fh.Type == wire.TI32:
+			var x ArchivalStatus
+			x, err = _ArchivalStatus_Decode(sr)
+			v.HistoryArchivalStatus = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 90 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.OwnerEmail = &x
+			v.HistoryArchivalURI = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 50 && fh.Type == wire.TMap:
-			v.Data, err = _Map_String_String_Decode(sr)
+		case fh.ID == 100 && fh.Type == wire.TI32:
+			var x ArchivalStatus
+			x, err = _ArchivalStatus_Decode(sr)
+			v.VisibilityArchivalStatus = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 60 && fh.Type == wire.TBinary:
+		case fh.ID == 110 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.UUID = &x
+			v.VisibilityArchivalURI = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 120 && fh.Type == wire.TStruct:
+			v.AsyncWorkflowConfiguration, err = _AsyncWorkflowConfiguration_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -38060,44 +38363,56 @@ func (v *DomainInfo) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a DomainInfo
+// String returns a readable string representation of a DomainConfiguration
 // struct.
-func (v *DomainInfo) String() string {
+func (v *DomainConfiguration) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [9]string
 	i := 0
-	if v.Name != nil {
-		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+	if v.WorkflowExecutionRetentionPeriodInDays != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionRetentionPeriodInDays: %v", *(v.WorkflowExecutionRetentionPeriodInDays))
 		i++
 	}
-	if v.Status != nil {
-		fields[i] = fmt.Sprintf("Status: %v", *(v.Status))
+	if v.EmitMetric != nil {
+		fields[i] = fmt.Sprintf("EmitMetric: %v", *(v.EmitMetric))
 		i++
 	}
-	if v.Description != nil {
-		fields[i] = fmt.Sprintf("Description: %v", *(v.Description))
+	if v.Isolationgroups != nil {
+		fields[i] = fmt.Sprintf("Isolationgroups: %v", v.Isolationgroups)
 		i++
 	}
-	if v.OwnerEmail != nil {
-		fields[i] = fmt.Sprintf("OwnerEmail: %v", *(v.OwnerEmail))
+	if v.BadBinaries != nil {
+		fields[i] = fmt.Sprintf("BadBinaries: %v", v.BadBinaries)
 		i++
 	}
-	if v.Data != nil {
-		fields[i] = fmt.Sprintf("Data: %v", v.Data)
+	if v.HistoryArchivalStatus != nil {
+		fields[i] = fmt.Sprintf("HistoryArchivalStatus: %v", *(v.HistoryArchivalStatus))
 		i++
 	}
-	if v.UUID != nil {
-		fields[i] = fmt.Sprintf("UUID: %v", *(v.UUID))
+	if v.HistoryArchivalURI != nil {
+		fields[i] = fmt.Sprintf("HistoryArchivalURI: %v", *(v.HistoryArchivalURI))
+		i++
+	}
+	if v.VisibilityArchivalStatus != nil {
+		fields[i] = fmt.Sprintf("VisibilityArchivalStatus: %v", *(v.VisibilityArchivalStatus))
+		i++
+	}
+	if v.VisibilityArchivalURI != nil {
+		fields[i] = fmt.Sprintf("VisibilityArchivalURI: %v", *(v.VisibilityArchivalURI))
+		i++
+	}
+	if v.AsyncWorkflowConfiguration != nil {
+		fields[i] = fmt.Sprintf("AsyncWorkflowConfiguration: %v", v.AsyncWorkflowConfiguration)
 		i++
 	}
 
-	return fmt.Sprintf("DomainInfo{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DomainConfiguration{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _DomainStatus_EqualsPtr(lhs, rhs *DomainStatus) bool {
+func _ArchivalStatus_EqualsPtr(lhs, rhs *ArchivalStatus) bool {
 	if lhs != nil && rhs != nil {
 
 		x := *lhs
@@ -38107,32 +38422,41 @@ func _DomainStatus_EqualsPtr(lhs, rhs *DomainStatus) bool {
 	return lhs == nil && rhs == nil
 }
 
-// Equals returns true if all the fields of this DomainInfo match the
-// provided DomainInfo.
+// Equals returns true if all the fields of this DomainConfiguration match the
+// provided DomainConfiguration.
 //
 // This function performs a deep comparison.
-func (v *DomainInfo) Equals(rhs *DomainInfo) bool {
+func (v *DomainConfiguration) Equals(rhs *DomainConfiguration) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Name, rhs.Name) {
+	if !_I32_EqualsPtr(v.WorkflowExecutionRetentionPeriodInDays, rhs.WorkflowExecutionRetentionPeriodInDays) {
 		return false
 	}
-	if !_DomainStatus_EqualsPtr(v.Status, rhs.Status) {
+	if !_Bool_EqualsPtr(v.EmitMetric, rhs.EmitMetric) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Description, rhs.Description) {
+	if !((v.Isolationgroups == nil && rhs.Isolationgroups == nil) || (v.Isolationgroups != nil && rhs.Isolationgroups != nil && v.Isolationgroups.Equals(rhs.Isolationgroups))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.OwnerEmail, rhs.OwnerEmail) {
+	if !((v.BadBinaries == nil && rhs.BadBinaries == nil) || (v.BadBinaries != nil && rhs.BadBinaries != nil && v.BadBinaries.Equals(rhs.BadBinaries))) {
 		return false
 	}
-	if !((v.Data == nil && rhs.Data == nil) || (v.Data != nil && rhs.Data != nil && _Map_String_String_Equals(v.Data, rhs.Data))) {
+	if !_ArchivalStatus_EqualsPtr(v.HistoryArchivalStatus, rhs.HistoryArchivalStatus) {
 		return false
 	}
-	if !_String_EqualsPtr(v.UUID, rhs.UUID) {
+	if !_String_EqualsPtr(v.HistoryArchivalURI, rhs.HistoryArchivalURI) {
+		return false
+	}
+	if !_ArchivalStatus_EqualsPtr(v.VisibilityArchivalStatus, rhs.VisibilityArchivalStatus) {
+		return false
+	}
+	if !_String_EqualsPtr(v.VisibilityArchivalURI, rhs.VisibilityArchivalURI) {
+		return false
+	}
+	if !((v.AsyncWorkflowConfiguration == nil && rhs.AsyncWorkflowConfiguration == nil) || (v.AsyncWorkflowConfiguration != nil && rhs.AsyncWorkflowConfiguration != nil && v.AsyncWorkflowConfiguration.Equals(rhs.AsyncWorkflowConfiguration))) {
 		return false
 	}
 
@@ -38140,131 +38464,183 @@ func (v *DomainInfo) Equals(rhs *DomainInfo) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DomainInfo.
-func (v *DomainInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DomainConfiguration.
+func (v *DomainConfiguration) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Name != nil {
-		enc.AddString("name", *v.Name)
+	if v.WorkflowExecutionRetentionPeriodInDays != nil {
+		enc.AddInt32("workflowExecutionRetentionPeriodInDays", *v.WorkflowExecutionRetentionPeriodInDays)
 	}
-	if v.Status != nil {
-		err = multierr.Append(err, enc.AddObject("status", *v.Status))
+	if v.EmitMetric != nil {
+		enc.AddBool("emitMetric", *v.EmitMetric)
 	}
-	if v.Description != nil {
-		enc.AddString("description", *v.Description)
+	if v.Isolationgroups != nil {
+		err = multierr.Append(err, enc.AddObject("isolationgroups", v.Isolationgroups))
 	}
-	if v.OwnerEmail != nil {
-		enc.AddString("ownerEmail", *v.OwnerEmail)
+	if v.BadBinaries != nil {
+		err = multierr.Append(err, enc.AddObject("badBinaries", v.BadBinaries))
 	}
-	if v.Data != nil {
-		err = multierr.Append(err, enc.AddObject("data", (_Map_String_String_Zapper)(v.Data)))
+	if v.HistoryArchivalStatus != nil {
+		err = multierr.Append(err, enc.AddObject("historyArchivalStatus", *v.HistoryArchivalStatus))
 	}
-	if v.UUID != nil {
-		enc.AddString("uuid", *v.UUID)
+	if v.HistoryArchivalURI != nil {
+		enc.AddString("historyArchivalURI", *v.HistoryArchivalURI)
+	}
+	if v.VisibilityArchivalStatus != nil {
+		err = multierr.Append(err, enc.AddObject("visibilityArchivalStatus", *v.VisibilityArchivalStatus))
+	}
+	if v.VisibilityArchivalURI != nil {
+		enc.AddString("visibilityArchivalURI", *v.VisibilityArchivalURI)
+	}
+	if v.AsyncWorkflowConfiguration != nil {
+		err = multierr.Append(err, enc.AddObject("AsyncWorkflowConfiguration", v.AsyncWorkflowConfiguration))
 	}
 	return err
 }
 
-// GetName returns the value of Name if it is set or its
+// GetWorkflowExecutionRetentionPeriodInDays returns the value of WorkflowExecutionRetentionPeriodInDays if it is set or its
 // zero value if it is unset.
-func (v *DomainInfo) GetName() (o string) {
-	if v != nil && v.Name != nil {
-		return *v.Name
+func (v *DomainConfiguration) GetWorkflowExecutionRetentionPeriodInDays() (o int32) {
+	if v != nil && v.WorkflowExecutionRetentionPeriodInDays != nil {
+		return *v.WorkflowExecutionRetentionPeriodInDays
 	}
 
 	return
 }
 
-// IsSetName returns true if Name is not nil.
-func (v *DomainInfo) IsSetName() bool {
-	return v != nil && v.Name != nil
+// IsSetWorkflowExecutionRetentionPeriodInDays returns true if WorkflowExecutionRetentionPeriodInDays is not nil.
+func (v *DomainConfiguration) IsSetWorkflowExecutionRetentionPeriodInDays() bool {
+	return v != nil && v.WorkflowExecutionRetentionPeriodInDays != nil
 }
 
-// GetStatus returns the value of Status if it is set or its
+// GetEmitMetric returns the value of EmitMetric if it is set or its
 // zero value if it is unset.
-func (v *DomainInfo) GetStatus() (o DomainStatus) {
-	if v != nil && v.Status != nil {
-		return *v.Status
+func (v *DomainConfiguration) GetEmitMetric() (o bool) {
+	if v != nil && v.EmitMetric != nil {
+		return *v.EmitMetric
 	}
 
 	return
 }
 
-// IsSetStatus returns true if Status is not nil.
-func (v *DomainInfo) IsSetStatus() bool {
-	return v != nil && v.Status != nil
+// IsSetEmitMetric returns true if EmitMetric is not nil.
+func (v *DomainConfiguration) IsSetEmitMetric() bool {
+	return v != nil && v.EmitMetric != nil
 }
 
-// GetDescription returns the value of Description if it is set or its
+// GetIsolationgroups returns the value of Isolationgroups if it is set or its
 // zero value if it is unset.
-func (v *DomainInfo) GetDescription() (o string) {
-	if v != nil && v.Description != nil {
-		return *v.Description
+func (v *DomainConfiguration) GetIsolationgroups() (o *IsolationGroupConfiguration) {
+	if v != nil && v.Isolationgroups != nil {
+		return v.Isolationgroups
 	}
 
 	return
 }
 
-// IsSetDescription returns true if Description is not nil.
-func (v *DomainInfo) IsSetDescription() bool {
-	return v != nil && v.Description != nil
+// IsSetIsolationgroups returns true if Isolationgroups is not nil.
+func (v *DomainConfiguration) IsSetIsolationgroups() bool {
+	return v != nil && v.Isolationgroups != nil
 }
 
-// GetOwnerEmail returns the value of OwnerEmail if it is set or its
+// GetBadBinaries returns the value of BadBinaries if it is set or its
 // zero value if it is unset.
-func (v *DomainInfo) GetOwnerEmail() (o string) {
-	if v != nil && v.OwnerEmail != nil {
-		return *v.OwnerEmail
+func (v *DomainConfiguration) GetBadBinaries() (o *BadBinaries) {
+	if v != nil && v.BadBinaries != nil {
+		return v.BadBinaries
 	}
 
 	return
 }
 
-// IsSetOwnerEmail returns true if OwnerEmail is not nil.
-func (v *DomainInfo) IsSetOwnerEmail() bool {
-	return v != nil && v.OwnerEmail != nil
+// IsSetBadBinaries returns true if BadBinaries is not nil.
+func (v *DomainConfiguration) IsSetBadBinaries() bool {
+	return v != nil && v.BadBinaries != nil
 }
 
-// GetData returns the value of Data if it is set or its
+// GetHistoryArchivalStatus returns the value of HistoryArchivalStatus if it is set or its
 // zero value if it is unset.
-func (v *DomainInfo) GetData() (o map[string]string) {
-	if v != nil && v.Data != nil {
-		return v.Data
+func (v *DomainConfiguration) GetHistoryArchivalStatus() (o ArchivalStatus) {
+	if v != nil && v.HistoryArchivalStatus != nil {
+		return *v.HistoryArchivalStatus
 	}
 
 	return
 }
 
-// IsSetData returns true if Data is not nil.
-func (v *DomainInfo) IsSetData() bool {
-	return v != nil && v.Data != nil
+// IsSetHistoryArchivalStatus returns true if HistoryArchivalStatus is not nil.
+func (v *DomainConfiguration) IsSetHistoryArchivalStatus() bool {
+	return v != nil && v.HistoryArchivalStatus != nil
 }
 
-// GetUUID returns the value of UUID if it is set or its
+// GetHistoryArchivalURI returns the value of HistoryArchivalURI if it is set or its
 // zero value if it is unset.
-func (v *DomainInfo) GetUUID() (o string) {
-	if v != nil && v.UUID != nil {
-		return *v.UUID
+func (v *DomainConfiguration) GetHistoryArchivalURI() (o string) {
+	if v != nil && v.HistoryArchivalURI != nil {
+		return *v.HistoryArchivalURI
 	}
 
 	return
 }
 
-// IsSetUUID returns true if UUID is not nil.
-func (v *DomainInfo) IsSetUUID() bool {
-	return v != nil && v.UUID != nil
+// IsSetHistoryArchivalURI returns true if HistoryArchivalURI is not nil.
+func (v *DomainConfiguration) IsSetHistoryArchivalURI() bool {
+	return v != nil && v.HistoryArchivalURI != nil
 }
 
-type DomainNotActiveError struct {
-	Message        string   `json:"message,required"`
-	DomainName     string   `json:"domainName,required"`
-	CurrentCluster string   `json:"currentCluster,required"`
-	ActiveCluster  string   `json:"activeCluster,required"`
-	ActiveClusters []string `json:"activeClusters,required"`
+// GetVisibilityArchivalStatus returns the value of VisibilityArchivalStatus if it is set or its
+// zero value if it is unset.
+func (v *DomainConfiguration) GetVisibilityArchivalStatus() (o ArchivalStatus) {
+	if v != nil && v.VisibilityArchivalStatus != nil {
+		return *v.VisibilityArchivalStatus
+	}
+
+	return
 }
 
-// ToWire translates a DomainNotActiveError struct into a Thrift-level intermediate
+// IsSetVisibilityArchivalStatus returns true if VisibilityArchivalStatus is not nil.
+func (v *DomainConfiguration) IsSetVisibilityArchivalStatus() bool {
+	return v != nil && v.VisibilityArchivalStatus != nil
+}
+
+// GetVisibilityArchivalURI returns the value of VisibilityArchivalURI if it is set or its
+// zero value if it is unset.
+func (v *DomainConfiguration) GetVisibilityArchivalURI() (o string) {
+	if v != nil && v.VisibilityArchivalURI != nil {
+		return *v.VisibilityArchivalURI
+	}
+
+	return
+}
+
+// IsSetVisibilityArchivalURI returns true if VisibilityArchivalURI is not nil.
+func (v *DomainConfiguration) IsSetVisibilityArchivalURI() bool {
+	return v != nil && v.VisibilityArchivalURI != nil
+}
+
+// GetAsyncWorkflowConfiguration returns the value of AsyncWorkflowConfiguration if it is set or its
+// zero value if it is unset.
+func (v *DomainConfiguration) GetAsyncWorkflowConfiguration() (o *AsyncWorkflowConfiguration) {
+	if v != nil && v.AsyncWorkflowConfiguration != nil {
+		return v.AsyncWorkflowConfiguration
+	}
+
+	return
+}
+
+// IsSetAsyncWorkflowConfiguration returns true if AsyncWorkflowConfiguration is not nil.
+func (v *DomainConfiguration) IsSetAsyncWorkflowConfiguration() bool {
+	return v != nil && v.AsyncWorkflowConfiguration != nil
+}
+
+type DomainFieldChange struct {
+	Field  *string `json:"field,omitempty"`
+	Before *string `json:"before,omitempty"`
+	After  *string `json:"after,omitempty"`
+}
+
+// ToWire translates a DomainFieldChange struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -38279,57 +38655,47 @@ type DomainNotActiveError struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //		return err
 //	}
-func (v *DomainNotActiveError) ToWire() (wire.Value, error) {
+func (v *DomainFieldChange) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Message), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
-
-	w, err = wire.NewValueString(v.DomainName), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 2, Value: w}
-	i++
-
-	w, err = wire.NewValueString(v.CurrentCluster), error(nil)
-	if err != nil {
-		return w, err
+	if v.Field != nil {
+		w, err = wire.NewValueString(*(v.Field)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 3, Value: w}
-	i++
-
-	w, err = wire.NewValueString(v.ActiveCluster), error(nil)
-	if err != nil {
-		return w, err
+	if v.Before != nil {
+		w, err = wire.NewValueString(*(v.Before)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 4, Value: w}
-	i++
-
-	w, err = wire.NewValueList(_List_String_ValueList(v.ActiveClusters)), error(nil)
-	if err != nil {
-		return w, err
+	if v.After != nil {
+		w, err = wire.NewValueString(*(v.After)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 5, Value: w}
-	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a DomainNotActiveError struct from its Thrift-level
+// FromWire deserializes a DomainFieldChange struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DomainNotActiveError struct
+// An error is returned if we were unable to build a DomainFieldChange struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -38337,204 +38703,142 @@ func (v *DomainNotActiveError) ToWire() (wire.Value, error) {
 //		return nil, err
 //	}
 //
-//	var v DomainNotActiveError
+//	var v DomainFieldChange
 //	if err := v.FromWire(x); err != nil {
 //		return nil, err
 //	}
 //	return &v, nil
-func (v *DomainNotActiveError) FromWire(w wire.Value) error {
+func (v *DomainFieldChange) FromWire(w wire.Value) error {
 	var err error
 
-	messageIsSet := false
-	domainNameIsSet := false
-	currentClusterIsSet := false
-	activeClusterIsSet := false
-	activeClustersIsSet := false
-
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TBinary {
-				v.Message, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
-				messageIsSet = true
-			}
-		case 2:
+		case 10:
 			if field.Value.Type() == wire.TBinary {
-				v.DomainName, err = field.Value.GetString(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Field = &x
 				if err != nil {
 					return err
 				}
-				domainNameIsSet = true
+
 			}
-		case 3:
+		case 20:
 			if field.Value.Type() == wire.TBinary {
-				v.CurrentCluster, err = field.Value.GetString(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Before = &x
 				if err != nil {
 					return err
 				}
-				currentClusterIsSet = true
+
 			}
-		case 4:
+		case 30:
 			if field.Value.Type() == wire.TBinary {
-				v.ActiveCluster, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
-				activeClusterIsSet = true
-			}
-		case 5:
-			if field.Value.Type() == wire.TList {
-				v.ActiveClusters, err = _List_String_Read(field.Value.GetList())
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.After = &x
 				if err != nil {
 					return err
 				}
-				activeClustersIsSet = true
+
 			}
 		}
 	}
 
-	if !messageIsSet {
-		return errors.New("field Message of DomainNotActiveError is required")
-	}
+	return nil
+}
 
-	if !domainNameIsSet {
-		return errors.New("field DomainName of DomainNotActiveError is required")
+// Encode serializes a DomainFieldChange struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a DomainFieldChange struct could not be encoded.
+func (v *DomainFieldChange) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
 	}
 
-	if !currentClusterIsSet {
-		return errors.New("field CurrentCluster of DomainNotActiveError is required")
+	if v.Field != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Field)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	if !activeClusterIsSet {
-		return errors.New("field ActiveCluster of DomainNotActiveError is required")
+	if v.Before != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Before)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	if !activeClustersIsSet {
-		return errors.New("field ActiveClusters of DomainNotActiveError is required")
+	if v.After != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.After)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	return sw.WriteStructEnd()
 }
 
-// Encode serializes a DomainNotActiveError struct directly into bytes, without going
-// through an intermediary type.
+// Decode deserializes a DomainFieldChange struct directly from its Thrift-level
+// representation, without going through an intemediary type.
 //
-// An error is returned if a DomainNotActiveError struct could not be encoded.
-func (v *DomainNotActiveError) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
+// An error is returned if a DomainFieldChange struct could not be generated from the wire
+// representation.
+func (v *DomainFieldChange) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
 		return err
 	}
 
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
-		return err
-	}
-	if err := sw.WriteString(v.Message); err != nil {
-		return err
-	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
-	}
-
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
-		return err
-	}
-	if err := sw.WriteString(v.DomainName); err != nil {
-		return err
-	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
-	}
-
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TBinary}); err != nil {
-		return err
-	}
-	if err := sw.WriteString(v.CurrentCluster); err != nil {
-		return err
-	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
-	}
-
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TBinary}); err != nil {
-		return err
-	}
-	if err := sw.WriteString(v.ActiveCluster); err != nil {
-		return err
-	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
-	}
-
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TList}); err != nil {
-		return err
-	}
-	if err := _List_String_Encode(v.ActiveClusters, sw); err != nil {
-		return err
-	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
-	}
-
-	return sw.WriteStructEnd()
-}
-
-// Decode deserializes a DomainNotActiveError struct directly from its Thrift-level
-// representation, without going through an intemediary type.
-//
-// An error is returned if a DomainNotActiveError struct could not be generated from the wire
-// representation.
-func (v *DomainNotActiveError) Decode(sr stream.Reader) error {
-
-	messageIsSet := false
-	domainNameIsSet := false
-	currentClusterIsSet := false
-	activeClusterIsSet := false
-	activeClustersIsSet := false
-
-	if err := sr.ReadStructBegin(); err != nil {
-		return err
-	}
-
-	fh, ok, err := sr.ReadFieldBegin()
-	if err != nil {
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
 		return err
 	}
 
 	for ok {
 		switch {
-		case fh.ID == 1 && fh.Type == wire.TBinary:
-			v.Message, err = sr.ReadString()
-			if err != nil {
-				return err
-			}
-			messageIsSet = true
-		case fh.ID == 2 && fh.Type == wire.TBinary:
-			v.DomainName, err = sr.ReadString()
-			if err != nil {
-				return err
-			}
-			domainNameIsSet = true
-		case fh.ID == 3 && fh.Type == wire.TBinary:
-			v.CurrentCluster, err = sr.ReadString()
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Field = &x
 			if err != nil {
 				return err
 			}
-			currentClusterIsSet = true
-		case fh.ID == 4 && fh.Type == wire.TBinary:
-			v.ActiveCluster, err = sr.ReadString()
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Before = &x
 			if err != nil {
 				return err
 			}
-			activeClusterIsSet = true
-		case fh.ID == 5 && fh.Type == wire.TList:
-			v.ActiveClusters, err = _List_String_Decode(sr)
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.After = &x
 			if err != nil {
 				return err
 			}
-			activeClustersIsSet = true
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -38554,81 +38858,51 @@ func (v *DomainNotActiveError) Decode(sr stream.Reader) error {
 		return err
 	}
 
-	if !messageIsSet {
-		return errors.New("field Message of DomainNotActiveError is required")
-	}
-
-	if !domainNameIsSet {
-		return errors.New("field DomainName of DomainNotActiveError is required")
-	}
-
-	if !currentClusterIsSet {
-		return errors.New("field CurrentCluster of DomainNotActiveError is required")
-	}
-
-	if !activeClusterIsSet {
-		return errors.New("field ActiveCluster of DomainNotActiveError is required")
-	}
-
-	if !activeClustersIsSet {
-		return errors.New("field ActiveClusters of DomainNotActiveError is required")
-	}
-
 	return nil
 }
 
-// String returns a readable string representation of a DomainNotActiveError
+// String returns a readable string representation of a DomainFieldChange
 // struct.
-func (v *DomainNotActiveError) String() string {
+func (v *DomainFieldChange) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [3]string
 	i := 0
-	fields[i] = fmt.Sprintf("Message: %v", v.Message)
-	i++
-	fields[i] = fmt.Sprintf("DomainName: %v", v.DomainName)
-	i++
-	fields[i] = fmt.Sprintf("CurrentCluster: %v", v.CurrentCluster)
-	i++
-	fields[i] = fmt.Sprintf("ActiveCluster: %v", v.ActiveCluster)
-	i++
-	fields[i] = fmt.Sprintf("ActiveClusters: %v", v.ActiveClusters)
-	i++
-
-	return fmt.Sprintf("DomainNotActiveError{%v}", strings.Join(fields[:i], ", "))
-}
+	if v.Field != nil {
+		fields[i] = fmt.Sprintf("Field: %v", *(v.Field))
+		i++
+	}
+	if v.Before != nil {
+		fields[i] = fmt.Sprintf("Before: %v", *(v.Before))
+		i++
+	}
+	if v.After != nil {
+		fields[i] = fmt.Sprintf("After: %v", *(v.After))
+		i++
+	}
 
-// ErrorName is the name of this type as defined in the Thrift
-// file.
-func (*DomainNotActiveError) ErrorName() string {
-	return "DomainNotActiveError"
+	return fmt.Sprintf("DomainFieldChange{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this DomainNotActiveError match the
-// provided DomainNotActiveError.
+// Equals returns true if all the fields of this DomainFieldChange match the
+// provided DomainFieldChange.
 //
 // This function performs a deep comparison.
-func (v *DomainNotActiveError) Equals(rhs *DomainNotActiveError) bool {
+func (v *DomainFieldChange) Equals(rhs *DomainFieldChange) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !(v.Message == rhs.Message) {
-		return false
-	}
-	if !(v.DomainName == rhs.DomainName) {
+	if !_String_EqualsPtr(v.Field, rhs.Field) {
 		return false
 	}
-	if !(v.CurrentCluster == rhs.CurrentCluster) {
-		return false
-	}
-	if !(v.ActiveCluster == rhs.ActiveCluster) {
+	if !_String_EqualsPtr(v.Before, rhs.Before) {
 		return false
 	}
-	if !_List_String_Equals(v.ActiveClusters, rhs.ActiveClusters) {
+	if !_String_EqualsPtr(v.After, rhs.After) {
 		return false
 	}
 
@@ -38636,109 +38910,74 @@ func (v *DomainNotActiveError) Equals(rhs *DomainNotActiveError) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DomainNotActiveError.
-func (v *DomainNotActiveError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DomainFieldChange.
+func (v *DomainFieldChange) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	enc.AddString("message", v.Message)
-	enc.AddString("domainName", v.DomainName)
-	enc.AddString("currentCluster", v.CurrentCluster)
-	enc.AddString("activeCluster", v.ActiveCluster)
-	err = multierr.Append(err, enc.AddArray("activeClusters", (_List_String_Zapper)(v.ActiveClusters)))
+	if v.Field != nil {
+		enc.AddString("field", *v.Field)
+	}
+	if v.Before != nil {
+		enc.AddString("before", *v.Before)
+	}
+	if v.After != nil {
+		enc.AddString("after", *v.After)
+	}
 	return err
 }
 
-// GetMessage returns the value of Message if it is set or its
+// GetField returns the value of Field if it is set or its
 // zero value if it is unset.
-func (v *DomainNotActiveError) GetMessage() (o string) {
-	if v != nil {
-		o = v.Message
+func (v *DomainFieldChange) GetField() (o string) {
+	if v != nil && v.Field != nil {
+		return *v.Field
 	}
-	return
-}
 
-// GetDomainName returns the value of DomainName if it is set or its
-// zero value if it is unset.
-func (v *DomainNotActiveError) GetDomainName() (o string) {
-	if v != nil {
-		o = v.DomainName
-	}
 	return
 }
 
-// GetCurrentCluster returns the value of CurrentCluster if it is set or its
-// zero value if it is unset.
-func (v *DomainNotActiveError) GetCurrentCluster() (o string) {
-	if v != nil {
-		o = v.CurrentCluster
-	}
-	return
+// IsSetField returns true if Field is not nil.
+func (v *DomainFieldChange) IsSetField() bool {
+	return v != nil && v.Field != nil
 }
 
-// GetActiveCluster returns the value of ActiveCluster if it is set or its
+// GetBefore returns the value of Before if it is set or its
 // zero value if it is unset.
-func (v *DomainNotActiveError) GetActiveCluster() (o string) {
-	if v != nil {
-		o = v.ActiveCluster
+func (v *DomainFieldChange) GetBefore() (o string) {
+	if v != nil && v.Before != nil {
+		return *v.Before
 	}
-	return
-}
 
-// GetActiveClusters returns the value of ActiveClusters if it is set or its
-// zero value if it is unset.
-func (v *DomainNotActiveError) GetActiveClusters() (o []string) {
-	if v != nil {
-		o = v.ActiveClusters
-	}
 	return
 }
 
-// IsSetActiveClusters returns true if ActiveClusters is not nil.
-func (v *DomainNotActiveError) IsSetActiveClusters() bool {
-	return v != nil && v.ActiveClusters != nil
-}
-
-func (v *DomainNotActiveError) Error() string {
-	return v.String()
-}
-
-type DomainReplicationConfiguration struct {
-	ActiveClusterName *string                            `json:"activeClusterName,omitempty"`
-	Clusters          []*ClusterReplicationConfiguration `json:"clusters,omitempty"`
-	ActiveClusters    *ActiveClusters                    `json:"activeClusters,omitempty"`
+// IsSetBefore returns true if Before is not nil.
+func (v *DomainFieldChange) IsSetBefore() bool {
+	return v != nil && v.Before != nil
 }
 
-type _List_ClusterReplicationConfiguration_ValueList []*ClusterReplicationConfiguration
-
-func (v _List_ClusterReplicationConfiguration_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid list '[]*ClusterReplicationConfiguration', index [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
+// GetAfter returns the value of After if it is set or its
+// zero value if it is unset.
+func (v *DomainFieldChange) GetAfter() (o string) {
+	if v != nil && v.After != nil {
+		return *v.After
 	}
-	return nil
-}
 
-func (v _List_ClusterReplicationConfiguration_ValueList) Size() int {
-	return len(v)
+	return
 }
 
-func (_List_ClusterReplicationConfiguration_ValueList) ValueType() wire.Type {
-	return wire.TStruct
+// IsSetAfter returns true if After is not nil.
+func (v *DomainFieldChange) IsSetAfter() bool {
+	return v != nil && v.After != nil
 }
 
-func (_List_ClusterReplicationConfiguration_ValueList) Close() {}
+type DomainIDPredicateAttributes struct {
+	DomainIDs   []string `json:"domainIDs,omitempty"`
+	IsExclusive *bool    `json:"isExclusive,omitempty"`
+}
 
-// ToWire translates a DomainReplicationConfiguration struct into a Thrift-level intermediate
+// ToWire translates a DomainIDPredicateAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -38753,77 +38992,39 @@ func (_List_ClusterReplicationConfiguration_ValueList) Close() {}
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //		return err
 //	}
-func (v *DomainReplicationConfiguration) ToWire() (wire.Value, error) {
+func (v *DomainIDPredicateAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ActiveClusterName != nil {
-		w, err = wire.NewValueString(*(v.ActiveClusterName)), error(nil)
+	if v.DomainIDs != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.DomainIDs)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Clusters != nil {
-		w, err = wire.NewValueList(_List_ClusterReplicationConfiguration_ValueList(v.Clusters)), error(nil)
+	if v.IsExclusive != nil {
+		w, err = wire.NewValueBool(*(v.IsExclusive)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ActiveClusters != nil {
-		w, err = v.ActiveClusters.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ClusterReplicationConfiguration_Read(w wire.Value) (*ClusterReplicationConfiguration, error) {
-	var v ClusterReplicationConfiguration
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _List_ClusterReplicationConfiguration_Read(l wire.ValueList) ([]*ClusterReplicationConfiguration, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make([]*ClusterReplicationConfiguration, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _ClusterReplicationConfiguration_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
-func _ActiveClusters_Read(w wire.Value) (*ActiveClusters, error) {
-	var v ActiveClusters
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a DomainReplicationConfiguration struct from its Thrift-level
+// FromWire deserializes a DomainIDPredicateAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DomainReplicationConfiguration struct
+// An error is returned if we were unable to build a DomainIDPredicateAttributes struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -38831,37 +39032,29 @@ func _ActiveClusters_Read(w wire.Value) (*ActiveClusters, error) {
 //		return nil, err
 //	}
 //
-//	var v DomainReplicationConfiguration
+//	var v DomainIDPredicateAttributes
 //	if err := v.FromWire(x); err != nil {
 //		return nil, err
 //	}
 //	return &v, nil
-func (v *DomainReplicationConfiguration) FromWire(w wire.Value) error {
+func (v *DomainIDPredicateAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ActiveClusterName = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
 			if field.Value.Type() == wire.TList {
-				v.Clusters, err = _List_ClusterReplicationConfiguration_Read(field.Value.GetList())
+				v.DomainIDs, err = _List_String_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.ActiveClusters, err = _ActiveClusters_Read(field.Value)
+		case 20:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.IsExclusive = &x
 				if err != nil {
 					return err
 				}
@@ -38873,53 +39066,20 @@ func (v *DomainReplicationConfiguration) FromWire(w wire.Value) error {
 	return nil
 }
 
-func _List_ClusterReplicationConfiguration_Encode(val []*ClusterReplicationConfiguration, sw stream.Writer) error {
-
-	lh := stream.ListHeader{
-		Type:   wire.TStruct,
-		Length: len(val),
-	}
-	if err := sw.WriteListBegin(lh); err != nil {
-		return err
-	}
-
-	for i, v := range val {
-		if v == nil {
-			return fmt.Errorf("invalid list '[]*ClusterReplicationConfiguration', index [%v]: value is nil", i)
-		}
-		if err := v.Encode(sw); err != nil {
-			return err
-		}
-	}
-	return sw.WriteListEnd()
-}
-
-// Encode serializes a DomainReplicationConfiguration struct directly into bytes, without going
+// Encode serializes a DomainIDPredicateAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a DomainReplicationConfiguration struct could not be encoded.
-func (v *DomainReplicationConfiguration) Encode(sw stream.Writer) error {
+// An error is returned if a DomainIDPredicateAttributes struct could not be encoded.
+func (v *DomainIDPredicateAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.ActiveClusterName != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.ActiveClusterName)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.Clusters != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
+	if v.DomainIDs != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
 			return err
 		}
-		if err := _List_ClusterReplicationConfiguration_Encode(v.Clusters, sw); err != nil {
+		if err := _List_String_Encode(v.DomainIDs, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -38927,11 +39087,11 @@ func (v *DomainReplicationConfiguration) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.ActiveClusters != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
+	if v.IsExclusive != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBool}); err != nil {
 			return err
 		}
-		if err := v.ActiveClusters.Encode(sw); err != nil {
+		if err := sw.WriteBool(*(v.IsExclusive)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -38942,54 +39102,12 @@ func (v *DomainReplicationConfiguration) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _ClusterReplicationConfiguration_Decode(sr stream.Reader) (*ClusterReplicationConfiguration, error) {
-	var v ClusterReplicationConfiguration
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _List_ClusterReplicationConfiguration_Decode(sr stream.Reader) ([]*ClusterReplicationConfiguration, error) {
-	lh, err := sr.ReadListBegin()
-	if err != nil {
-		return nil, err
-	}
-
-	if lh.Type != wire.TStruct {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
-		}
-		return nil, sr.ReadListEnd()
-	}
-
-	o := make([]*ClusterReplicationConfiguration, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := _ClusterReplicationConfiguration_Decode(sr)
-		if err != nil {
-			return nil, err
-		}
-		o = append(o, v)
-	}
-
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
-	}
-	return o, err
-}
-
-func _ActiveClusters_Decode(sr stream.Reader) (*ActiveClusters, error) {
-	var v ActiveClusters
-	err := v.Decode(sr)
-	return &v, err
-}
-
-// Decode deserializes a DomainReplicationConfiguration struct directly from its Thrift-level
+// Decode deserializes a DomainIDPredicateAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a DomainReplicationConfiguration struct could not be generated from the wire
+// An error is returned if a DomainIDPredicateAttributes struct could not be generated from the wire
 // representation.
-func (v *DomainReplicationConfiguration) Decode(sr stream.Reader) error {
+func (v *DomainIDPredicateAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -39002,22 +39120,16 @@ func (v *DomainReplicationConfiguration) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.ActiveClusterName = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TList:
-			v.Clusters, err = _List_ClusterReplicationConfiguration_Decode(sr)
+		case fh.ID == 10 && fh.Type == wire.TList:
+			v.DomainIDs, err = _List_String_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TStruct:
-			v.ActiveClusters, err = _ActiveClusters_Decode(sr)
+		case fh.ID == 20 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.IsExclusive = &x
 			if err != nil {
 				return err
 			}
@@ -39044,93 +39156,1515 @@ func (v *DomainReplicationConfiguration) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a DomainReplicationConfiguration
+// String returns a readable string representation of a DomainIDPredicateAttributes
 // struct.
-func (v *DomainReplicationConfiguration) String() string {
+func (v *DomainIDPredicateAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [2]string
 	i := 0
-	if v.ActiveClusterName != nil {
-		fields[i] = fmt.Sprintf("ActiveClusterName: %v", *(v.ActiveClusterName))
-		i++
-	}
-	if v.Clusters != nil {
-		fields[i] = fmt.Sprintf("Clusters: %v", v.Clusters)
+	if v.DomainIDs != nil {
+		fields[i] = fmt.Sprintf("DomainIDs: %v", v.DomainIDs)
 		i++
 	}
-	if v.ActiveClusters != nil {
-		fields[i] = fmt.Sprintf("ActiveClusters: %v", v.ActiveClusters)
+	if v.IsExclusive != nil {
+		fields[i] = fmt.Sprintf("IsExclusive: %v", *(v.IsExclusive))
 		i++
 	}
 
-	return fmt.Sprintf("DomainReplicationConfiguration{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _List_ClusterReplicationConfiguration_Equals(lhs, rhs []*ClusterReplicationConfiguration) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
-
-	return true
+	return fmt.Sprintf("DomainIDPredicateAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this DomainReplicationConfiguration match the
-// provided DomainReplicationConfiguration.
+// Equals returns true if all the fields of this DomainIDPredicateAttributes match the
+// provided DomainIDPredicateAttributes.
 //
 // This function performs a deep comparison.
-func (v *DomainReplicationConfiguration) Equals(rhs *DomainReplicationConfiguration) bool {
+func (v *DomainIDPredicateAttributes) Equals(rhs *DomainIDPredicateAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.ActiveClusterName, rhs.ActiveClusterName) {
-		return false
-	}
-	if !((v.Clusters == nil && rhs.Clusters == nil) || (v.Clusters != nil && rhs.Clusters != nil && _List_ClusterReplicationConfiguration_Equals(v.Clusters, rhs.Clusters))) {
+	if !((v.DomainIDs == nil && rhs.DomainIDs == nil) || (v.DomainIDs != nil && rhs.DomainIDs != nil && _List_String_Equals(v.DomainIDs, rhs.DomainIDs))) {
 		return false
 	}
-	if !((v.ActiveClusters == nil && rhs.ActiveClusters == nil) || (v.ActiveClusters != nil && rhs.ActiveClusters != nil && v.ActiveClusters.Equals(rhs.ActiveClusters))) {
+	if !_Bool_EqualsPtr(v.IsExclusive, rhs.IsExclusive) {
 		return false
 	}
 
 	return true
 }
 
-type _List_ClusterReplicationConfiguration_Zapper []*ClusterReplicationConfiguration
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_ClusterReplicationConfiguration_Zapper.
-func (l _List_ClusterReplicationConfiguration_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DomainReplicationConfiguration.
-func (v *DomainReplicationConfiguration) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DomainIDPredicateAttributes.
+func (v *DomainIDPredicateAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.ActiveClusterName != nil {
-		enc.AddString("activeClusterName", *v.ActiveClusterName)
+	if v.DomainIDs != nil {
+		err = multierr.Append(err, enc.AddArray("domainIDs", (_List_String_Zapper)(v.DomainIDs)))
 	}
-	if v.Clusters != nil {
-		err = multierr.Append(err, enc.AddArray("clusters", (_List_ClusterReplicationConfiguration_Zapper)(v.Clusters)))
+	if v.IsExclusive != nil {
+		enc.AddBool("isExclusive", *v.IsExclusive)
 	}
-	if v.ActiveClusters != nil {
+	return err
+}
+
+// GetDomainIDs returns the value of DomainIDs if it is set or its
+// zero value if it is unset.
+func (v *DomainIDPredicateAttributes) GetDomainIDs() (o []string) {
+	if v != nil && v.DomainIDs != nil {
+		return v.DomainIDs
+	}
+
+	return
+}
+
+// IsSetDomainIDs returns true if DomainIDs is not nil.
+func (v *DomainIDPredicateAttributes) IsSetDomainIDs() bool {
+	return v != nil && v.DomainIDs != nil
+}
+
+// GetIsExclusive returns the value of IsExclusive if it is set or its
+// zero value if it is unset.
+func (v *DomainIDPredicateAttributes) GetIsExclusive() (o bool) {
+	if v != nil && v.IsExclusive != nil {
+		return *v.IsExclusive
+	}
+
+	return
+}
+
+// IsSetIsExclusive returns true if IsExclusive is not nil.
+func (v *DomainIDPredicateAttributes) IsSetIsExclusive() bool {
+	return v != nil && v.IsExclusive != nil
+}
+
+type DomainInfo struct {
+	Name        *string           `json:"name,omitempty"`
+	Status      *DomainStatus     `json:"status,omitempty"`
+	Description *string           `json:"description,omitempty"`
+	OwnerEmail  *string           `json:"ownerEmail,omitempty"`
+	Data        map[string]string `json:"data,omitempty"`
+	UUID        *string           `json:"uuid,omitempty"`
+}
+
+// ToWire translates a DomainInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//		return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//		return err
+//	}
+func (v *DomainInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Status != nil {
+		w, err = v.Status.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Description != nil {
+		w, err = wire.NewValueString(*(v.Description)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.OwnerEmail != nil {
+		w, err = wire.NewValueString(*(v.OwnerEmail)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Data != nil {
+		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Data)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.UUID != nil {
+		w, err = wire.NewValueString(*(v.UUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DomainStatus_Read(w wire.Value) (DomainStatus, error) {
+	var v DomainStatus
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a DomainInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DomainInfo struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//		return nil, err
+//	}
+//
+//	var v DomainInfo
+//	if err := v.FromWire(x); err != nil {
+//		return nil, err
+//	}
+//	return &v, nil
+func (v *DomainInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x DomainStatus
+				x, err = _DomainStatus_Read(field.Value)
+				v.Status = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Description = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.OwnerEmail = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TMap {
+				v.Data, err = _Map_String_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.UUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a DomainInfo struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a DomainInfo struct could not be encoded.
+func (v *DomainInfo) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Name != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Name)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Status != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.Status.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Description != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Description)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.OwnerEmail != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.OwnerEmail)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Data != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_String_Encode(v.Data, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.UUID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.UUID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _DomainStatus_Decode(sr stream.Reader) (DomainStatus, error) {
+	var v DomainStatus
+	err := v.Decode(sr)
+	return v, err
+}
+
+// Decode deserializes a DomainInfo struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a DomainInfo struct could not be generated from the wire
+// representation.
+func (v *DomainInfo) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI32:
+			var x DomainStatus
+			x, err = _DomainStatus_Decode(sr)
+			v.Status = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Description = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.OwnerEmail = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TMap:
+			v.Data, err = _Map_String_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.UUID = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DomainInfo
+// struct.
+func (v *DomainInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+	if v.Status != nil {
+		fields[i] = fmt.Sprintf("Status: %v", *(v.Status))
+		i++
+	}
+	if v.Description != nil {
+		fields[i] = fmt.Sprintf("Description: %v", *(v.Description))
+		i++
+	}
+	if v.OwnerEmail != nil {
+		fields[i] = fmt.Sprintf("OwnerEmail: %v", *(v.OwnerEmail))
+		i++
+	}
+	if v.Data != nil {
+		fields[i] = fmt.Sprintf("Data: %v", v.Data)
+		i++
+	}
+	if v.UUID != nil {
+		fields[i] = fmt.Sprintf("UUID: %v", *(v.UUID))
+		i++
+	}
+
+	return fmt.Sprintf("DomainInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _DomainStatus_EqualsPtr(lhs, rhs *DomainStatus) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this DomainInfo match the
+// provided DomainInfo.
+//
+// This function performs a deep comparison.
+func (v *DomainInfo) Equals(rhs *DomainInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+	if !_DomainStatus_EqualsPtr(v.Status, rhs.Status) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Description, rhs.Description) {
+		return false
+	}
+	if !_String_EqualsPtr(v.OwnerEmail, rhs.OwnerEmail) {
+		return false
+	}
+	if !((v.Data == nil && rhs.Data == nil) || (v.Data != nil && rhs.Data != nil && _Map_String_String_Equals(v.Data, rhs.Data))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.UUID, rhs.UUID) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DomainInfo.
+func (v *DomainInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	if v.Status != nil {
+		err = multierr.Append(err, enc.AddObject("status", *v.Status))
+	}
+	if v.Description != nil {
+		enc.AddString("description", *v.Description)
+	}
+	if v.OwnerEmail != nil {
+		enc.AddString("ownerEmail", *v.OwnerEmail)
+	}
+	if v.Data != nil {
+		err = multierr.Append(err, enc.AddObject("data", (_Map_String_String_Zapper)(v.Data)))
+	}
+	if v.UUID != nil {
+		enc.AddString("uuid", *v.UUID)
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *DomainInfo) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *DomainInfo) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// GetStatus returns the value of Status if it is set or its
+// zero value if it is unset.
+func (v *DomainInfo) GetStatus() (o DomainStatus) {
+	if v != nil && v.Status != nil {
+		return *v.Status
+	}
+
+	return
+}
+
+// IsSetStatus returns true if Status is not nil.
+func (v *DomainInfo) IsSetStatus() bool {
+	return v != nil && v.Status != nil
+}
+
+// GetDescription returns the value of Description if it is set or its
+// zero value if it is unset.
+func (v *DomainInfo) GetDescription() (o string) {
+	if v != nil && v.Description != nil {
+		return *v.Description
+	}
+
+	return
+}
+
+// IsSetDescription returns true if Description is not nil.
+func (v *DomainInfo) IsSetDescription() bool {
+	return v != nil && v.Description != nil
+}
+
+// GetOwnerEmail returns the value of OwnerEmail if it is set or its
+// zero value if it is unset.
+func (v *DomainInfo) GetOwnerEmail() (o string) {
+	if v != nil && v.OwnerEmail != nil {
+		return *v.OwnerEmail
+	}
+
+	return
+}
+
+// IsSetOwnerEmail returns true if OwnerEmail is not nil.
+func (v *DomainInfo) IsSetOwnerEmail() bool {
+	return v != nil && v.OwnerEmail != nil
+}
+
+// GetData returns the value of Data if it is set or its
+// zero value if it is unset.
+func (v *DomainInfo) GetData() (o map[string]string) {
+	if v != nil && v.Data != nil {
+		return v.Data
+	}
+
+	return
+}
+
+// IsSetData returns true if Data is not nil.
+func (v *DomainInfo) IsSetData() bool {
+	return v != nil && v.Data != nil
+}
+
+// GetUUID returns the value of UUID if it is set or its
+// zero value if it is unset.
+func (v *DomainInfo) GetUUID() (o string) {
+	if v != nil && v.UUID != nil {
+		return *v.UUID
+	}
+
+	return
+}
+
+// IsSetUUID returns true if UUID is not nil.
+func (v *DomainInfo) IsSetUUID() bool {
+	return v != nil && v.UUID != nil
+}
+
+type DomainNotActiveError struct {
+	Message        string   `json:"message,required"`
+	DomainName     string   `json:"domainName,required"`
+	CurrentCluster string   `json:"currentCluster,required"`
+	ActiveCluster  string   `json:"activeCluster,required"`
+	ActiveClusters []string `json:"activeClusters,required"`
+}
+
+// ToWire translates a DomainNotActiveError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//		return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//		return err
+//	}
+func (v *DomainNotActiveError) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.DomainName), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.CurrentCluster), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.ActiveCluster), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 4, Value: w}
+	i++
+
+	w, err = wire.NewValueList(_List_String_ValueList(v.ActiveClusters)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 5, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DomainNotActiveError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DomainNotActiveError struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//		return nil, err
+//	}
+//
+//	var v DomainNotActiveError
+//	if err := v.FromWire(x); err != nil {
+//		return nil, err
+//	}
+//	return &v, nil
+func (v *DomainNotActiveError) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+	domainNameIsSet := false
+	currentClusterIsSet := false
+	activeClusterIsSet := false
+	activeClustersIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.DomainName, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				domainNameIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				v.CurrentCluster, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				currentClusterIsSet = true
+			}
+		case 4:
+			if field.Value.Type() == wire.TBinary {
+				v.ActiveCluster, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				activeClusterIsSet = true
+			}
+		case 5:
+			if field.Value.Type() == wire.TList {
+				v.ActiveClusters, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+				activeClustersIsSet = true
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of DomainNotActiveError is required")
+	}
+
+	if !domainNameIsSet {
+		return errors.New("field DomainName of DomainNotActiveError is required")
+	}
+
+	if !currentClusterIsSet {
+		return errors.New("field CurrentCluster of DomainNotActiveError is required")
+	}
+
+	if !activeClusterIsSet {
+		return errors.New("field ActiveCluster of DomainNotActiveError is required")
+	}
+
+	if !activeClustersIsSet {
+		return errors.New("field ActiveClusters of DomainNotActiveError is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a DomainNotActiveError struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a DomainNotActiveError struct could not be encoded.
+func (v *DomainNotActiveError) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Message); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.DomainName); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.CurrentCluster); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.ActiveCluster); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TList}); err != nil {
+		return err
+	}
+	if err := _List_String_Encode(v.ActiveClusters, sw); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a DomainNotActiveError struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a DomainNotActiveError struct could not be generated from the wire
+// representation.
+func (v *DomainNotActiveError) Decode(sr stream.Reader) error {
+
+	messageIsSet := false
+	domainNameIsSet := false
+	currentClusterIsSet := false
+	activeClusterIsSet := false
+	activeClustersIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Message, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			messageIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TBinary:
+			v.DomainName, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			domainNameIsSet = true
+		case fh.ID == 3 && fh.Type == wire.TBinary:
+			v.CurrentCluster, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			currentClusterIsSet = true
+		case fh.ID == 4 && fh.Type == wire.TBinary:
+			v.ActiveCluster, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			activeClusterIsSet = true
+		case fh.ID == 5 && fh.Type == wire.TList:
+			v.ActiveClusters, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+			activeClustersIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of DomainNotActiveError is required")
+	}
+
+	if !domainNameIsSet {
+		return errors.New("field DomainName of DomainNotActiveError is required")
+	}
+
+	if !currentClusterIsSet {
+		return errors.New("field CurrentCluster of DomainNotActiveError is required")
+	}
+
+	if !activeClusterIsSet {
+		return errors.New("field ActiveCluster of DomainNotActiveError is required")
+	}
+
+	if !activeClustersIsSet {
+		return errors.New("field ActiveClusters of DomainNotActiveError is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DomainNotActiveError
+// struct.
+func (v *DomainNotActiveError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+	fields[i] = fmt.Sprintf("DomainName: %v", v.DomainName)
+	i++
+	fields[i] = fmt.Sprintf("CurrentCluster: %v", v.CurrentCluster)
+	i++
+	fields[i] = fmt.Sprintf("ActiveCluster: %v", v.ActiveCluster)
+	i++
+	fields[i] = fmt.Sprintf("ActiveClusters: %v", v.ActiveClusters)
+	i++
+
+	return fmt.Sprintf("DomainNotActiveError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*DomainNotActiveError) ErrorName() string {
+	return "DomainNotActiveError"
+}
+
+// Equals returns true if all the fields of this DomainNotActiveError match the
+// provided DomainNotActiveError.
+//
+// This function performs a deep comparison.
+func (v *DomainNotActiveError) Equals(rhs *DomainNotActiveError) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+	if !(v.DomainName == rhs.DomainName) {
+		return false
+	}
+	if !(v.CurrentCluster == rhs.CurrentCluster) {
+		return false
+	}
+	if !(v.ActiveCluster == rhs.ActiveCluster) {
+		return false
+	}
+	if !_List_String_Equals(v.ActiveClusters, rhs.ActiveClusters) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DomainNotActiveError.
+func (v *DomainNotActiveError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("message", v.Message)
+	enc.AddString("domainName", v.DomainName)
+	enc.AddString("currentCluster", v.CurrentCluster)
+	enc.AddString("activeCluster", v.ActiveCluster)
+	err = multierr.Append(err, enc.AddArray("activeClusters", (_List_String_Zapper)(v.ActiveClusters)))
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *DomainNotActiveError) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
+	}
+	return
+}
+
+// GetDomainName returns the value of DomainName if it is set or its
+// zero value if it is unset.
+func (v *DomainNotActiveError) GetDomainName() (o string) {
+	if v != nil {
+		o = v.DomainName
+	}
+	return
+}
+
+// GetCurrentCluster returns the value of CurrentCluster if it is set or its
+// zero value if it is unset.
+func (v *DomainNotActiveError) GetCurrentCluster() (o string) {
+	if v != nil {
+		o = v.CurrentCluster
+	}
+	return
+}
+
+// GetActiveCluster returns the value of ActiveCluster if it is set or its
+// zero value if it is unset.
+func (v *DomainNotActiveError) GetActiveCluster() (o string) {
+	if v != nil {
+		o = v.ActiveCluster
+	}
+	return
+}
+
+// GetActiveClusters returns the value of ActiveClusters if it is set or its
+// zero value if it is unset.
+func (v *DomainNotActiveError) GetActiveClusters() (o []string) {
+	if v != nil {
+		o = v.ActiveClusters
+	}
+	return
+}
+
+// IsSetActiveClusters returns true if ActiveClusters is not nil.
+func (v *DomainNotActiveError) IsSetActiveClusters() bool {
+	return v != nil && v.ActiveClusters != nil
+}
+
+func (v *DomainNotActiveError) Error() string {
+	return v.String()
+}
+
+type DomainReplicationConfiguration struct {
+	ActiveClusterName *string                            `json:"activeClusterName,omitempty"`
+	Clusters          []*ClusterReplicationConfiguration `json:"clusters,omitempty"`
+	ActiveClusters    *ActiveClusters                    `json:"activeClusters,omitempty"`
+}
+
+type _List_ClusterReplicationConfiguration_ValueList []*ClusterReplicationConfiguration
+
+func (v _List_ClusterReplicationConfiguration_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*ClusterReplicationConfiguration', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ClusterReplicationConfiguration_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ClusterReplicationConfiguration_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ClusterReplicationConfiguration_ValueList) Close() {}
+
+// ToWire translates a DomainReplicationConfiguration struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//		return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//		return err
+//	}
+func (v *DomainReplicationConfiguration) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ActiveClusterName != nil {
+		w, err = wire.NewValueString(*(v.ActiveClusterName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Clusters != nil {
+		w, err = wire.NewValueList(_List_ClusterReplicationConfiguration_ValueList(v.Clusters)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ActiveClusters != nil {
+		w, err = v.ActiveClusters.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ClusterReplicationConfiguration_Read(w wire.Value) (*ClusterReplicationConfiguration, error) {
+	var v ClusterReplicationConfiguration
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ClusterReplicationConfiguration_Read(l wire.ValueList) ([]*ClusterReplicationConfiguration, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ClusterReplicationConfiguration, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ClusterReplicationConfiguration_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _ActiveClusters_Read(w wire.Value) (*ActiveClusters, error) {
+	var v ActiveClusters
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a DomainReplicationConfiguration struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DomainReplicationConfiguration struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//		return nil, err
+//	}
+//
+//	var v DomainReplicationConfiguration
+//	if err := v.FromWire(x); err != nil {
+//		return nil, err
+//	}
+//	return &v, nil
+func (v *DomainReplicationConfiguration) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActiveClusterName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.Clusters, err = _List_ClusterReplicationConfiguration_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.ActiveClusters, err = _ActiveClusters_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_ClusterReplicationConfiguration_Encode(val []*ClusterReplicationConfiguration, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*ClusterReplicationConfiguration', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a DomainReplicationConfiguration struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a DomainReplicationConfiguration struct could not be encoded.
+func (v *DomainReplicationConfiguration) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.ActiveClusterName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ActiveClusterName)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Clusters != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_ClusterReplicationConfiguration_Encode(v.Clusters, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ActiveClusters != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ActiveClusters.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _ClusterReplicationConfiguration_Decode(sr stream.Reader) (*ClusterReplicationConfiguration, error) {
+	var v ClusterReplicationConfiguration
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_ClusterReplicationConfiguration_Decode(sr stream.Reader) ([]*ClusterReplicationConfiguration, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*ClusterReplicationConfiguration, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _ClusterReplicationConfiguration_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _ActiveClusters_Decode(sr stream.Reader) (*ActiveClusters, error) {
+	var v ActiveClusters
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a DomainReplicationConfiguration struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a DomainReplicationConfiguration struct could not be generated from the wire
+// representation.
+func (v *DomainReplicationConfiguration) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ActiveClusterName = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TList:
+			v.Clusters, err = _List_ClusterReplicationConfiguration_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TStruct:
+			v.ActiveClusters, err = _ActiveClusters_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DomainReplicationConfiguration
+// struct.
+func (v *DomainReplicationConfiguration) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.ActiveClusterName != nil {
+		fields[i] = fmt.Sprintf("ActiveClusterName: %v", *(v.ActiveClusterName))
+		i++
+	}
+	if v.Clusters != nil {
+		fields[i] = fmt.Sprintf("Clusters: %v", v.Clusters)
+		i++
+	}
+	if v.ActiveClusters != nil {
+		fields[i] = fmt.Sprintf("ActiveClusters: %v", v.ActiveClusters)
+		i++
+	}
+
+	return fmt.Sprintf("DomainReplicationConfiguration{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_ClusterReplicationConfiguration_Equals(lhs, rhs []*ClusterReplicationConfiguration) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this DomainReplicationConfiguration match the
+// provided DomainReplicationConfiguration.
+//
+// This function performs a deep comparison.
+func (v *DomainReplicationConfiguration) Equals(rhs *DomainReplicationConfiguration) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.ActiveClusterName, rhs.ActiveClusterName) {
+		return false
+	}
+	if !((v.Clusters == nil && rhs.Clusters == nil) || (v.Clusters != nil && rhs.Clusters != nil && _List_ClusterReplicationConfiguration_Equals(v.Clusters, rhs.Clusters))) {
+		return false
+	}
+	if !((v.ActiveClusters == nil && rhs.ActiveClusters == nil) || (v.ActiveClusters != nil && rhs.ActiveClusters != nil && v.ActiveClusters.Equals(rhs.ActiveClusters))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_ClusterReplicationConfiguration_Zapper []*ClusterReplicationConfiguration
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ClusterReplicationConfiguration_Zapper.
+func (l _List_ClusterReplicationConfiguration_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DomainReplicationConfiguration.
+func (v *DomainReplicationConfiguration) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ActiveClusterName != nil {
+		enc.AddString("activeClusterName", *v.ActiveClusterName)
+	}
+	if v.Clusters != nil {
+		err = multierr.Append(err, enc.AddArray("clusters", (_List_ClusterReplicationConfiguration_Zapper)(v.Clusters)))
+	}
+	if v.ActiveClusters != nil {
 		err = multierr.Append(err, enc.AddObject("activeClusters", v.ActiveClusters))
 	}
 	return err
@@ -53244,115 +54778,329 @@ func (v *IndexedValueType) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Decode reads off the encoded IndexedValueType directly off of the wire.
-//
-//	sReader := BinaryStreamer.Reader(reader)
-//
-//	var v IndexedValueType
-//	if err := v.Decode(sReader); err != nil {
-//	    return IndexedValueType(0), err
-//	}
-//	return v, nil
-func (v *IndexedValueType) Decode(sr stream.Reader) error {
-	i, err := sr.ReadInt32()
-	if err != nil {
+// Decode reads off the encoded IndexedValueType directly off of the wire.
+//
+//	sReader := BinaryStreamer.Reader(reader)
+//
+//	var v IndexedValueType
+//	if err := v.Decode(sReader); err != nil {
+//	    return IndexedValueType(0), err
+//	}
+//	return v, nil
+func (v *IndexedValueType) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (IndexedValueType)(i)
+	return nil
+}
+
+// String returns a readable string representation of IndexedValueType.
+func (v IndexedValueType) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "STRING"
+	case 1:
+		return "KEYWORD"
+	case 2:
+		return "INT"
+	case 3:
+		return "DOUBLE"
+	case 4:
+		return "BOOL"
+	case 5:
+		return "DATETIME"
+	}
+	return fmt.Sprintf("IndexedValueType(%d)", w)
+}
+
+// Equals returns true if this IndexedValueType value matches the provided
+// value.
+func (v IndexedValueType) Equals(rhs IndexedValueType) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes IndexedValueType into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v IndexedValueType) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"STRING\""), nil
+	case 1:
+		return ([]byte)("\"KEYWORD\""), nil
+	case 2:
+		return ([]byte)("\"INT\""), nil
+	case 3:
+		return ([]byte)("\"DOUBLE\""), nil
+	case 4:
+		return ([]byte)("\"BOOL\""), nil
+	case 5:
+		return ([]byte)("\"DATETIME\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode IndexedValueType from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *IndexedValueType) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "IndexedValueType")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "IndexedValueType")
+		}
+		*v = (IndexedValueType)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "IndexedValueType")
+	}
+}
+
+type InternalDataInconsistencyError struct {
+	Message string `json:"message,required"`
+}
+
+// ToWire translates a InternalDataInconsistencyError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//		return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//		return err
+//	}
+func (v *InternalDataInconsistencyError) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a InternalDataInconsistencyError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a InternalDataInconsistencyError struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//		return nil, err
+//	}
+//
+//	var v InternalDataInconsistencyError
+//	if err := v.FromWire(x); err != nil {
+//		return nil, err
+//	}
+//	return &v, nil
+func (v *InternalDataInconsistencyError) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of InternalDataInconsistencyError is required")
+	}
+
+	return nil
+}
+
+// Encode serializes a InternalDataInconsistencyError struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a InternalDataInconsistencyError struct could not be encoded.
+func (v *InternalDataInconsistencyError) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(v.Message); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a InternalDataInconsistencyError struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a InternalDataInconsistencyError struct could not be generated from the wire
+// representation.
+func (v *InternalDataInconsistencyError) Decode(sr stream.Reader) error {
+
+	messageIsSet := false
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Message, err = sr.ReadString()
+			if err != nil {
+				return err
+			}
+			messageIsSet = true
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
 		return err
 	}
-	*v = (IndexedValueType)(i)
+
+	if !messageIsSet {
+		return errors.New("field Message of InternalDataInconsistencyError is required")
+	}
+
 	return nil
 }
 
-// String returns a readable string representation of IndexedValueType.
-func (v IndexedValueType) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "STRING"
-	case 1:
-		return "KEYWORD"
-	case 2:
-		return "INT"
-	case 3:
-		return "DOUBLE"
-	case 4:
-		return "BOOL"
-	case 5:
-		return "DATETIME"
+// String returns a readable string representation of a InternalDataInconsistencyError
+// struct.
+func (v *InternalDataInconsistencyError) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-	return fmt.Sprintf("IndexedValueType(%d)", w)
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+
+	return fmt.Sprintf("InternalDataInconsistencyError{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if this IndexedValueType value matches the provided
-// value.
-func (v IndexedValueType) Equals(rhs IndexedValueType) bool {
-	return v == rhs
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*InternalDataInconsistencyError) ErrorName() string {
+	return "InternalDataInconsistencyError"
 }
 
-// MarshalJSON serializes IndexedValueType into JSON.
-//
-// If the enum value is recognized, its name is returned.
-// Otherwise, its integer value is returned.
+// Equals returns true if all the fields of this InternalDataInconsistencyError match the
+// provided InternalDataInconsistencyError.
 //
-// This implements json.Marshaler.
-func (v IndexedValueType) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"STRING\""), nil
-	case 1:
-		return ([]byte)("\"KEYWORD\""), nil
-	case 2:
-		return ([]byte)("\"INT\""), nil
-	case 3:
-		return ([]byte)("\"DOUBLE\""), nil
-	case 4:
-		return ([]byte)("\"BOOL\""), nil
-	case 5:
-		return ([]byte)("\"DATETIME\""), nil
+// This function performs a deep comparison.
+func (v *InternalDataInconsistencyError) Equals(rhs *InternalDataInconsistencyError) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
 	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+
+	return true
 }
 
-// UnmarshalJSON attempts to decode IndexedValueType from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *IndexedValueType) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of InternalDataInconsistencyError.
+func (v *InternalDataInconsistencyError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
 	}
+	enc.AddString("message", v.Message)
+	return err
+}
 
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
-			return err
-		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "IndexedValueType")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "IndexedValueType")
-		}
-		*v = (IndexedValueType)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "IndexedValueType")
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *InternalDataInconsistencyError) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
 	}
+	return
 }
 
-type InternalDataInconsistencyError struct {
+func (v *InternalDataInconsistencyError) Error() string {
+	return v.String()
+}
+
+type InternalServiceError struct {
 	Message string `json:"message,required"`
 }
 
-// ToWire translates a InternalDataInconsistencyError struct into a Thrift-level intermediate
+// ToWire translates a InternalServiceError struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -53367,7 +55115,7 @@ type InternalDataInconsistencyError struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //		return err
 //	}
-func (v *InternalDataInconsistencyError) ToWire() (wire.Value, error) {
+func (v *InternalServiceError) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -53385,11 +55133,11 @@ func (v *InternalDataInconsistencyError) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a InternalDataInconsistencyError struct from its Thrift-level
+// FromWire deserializes a InternalServiceError struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a InternalDataInconsistencyError struct
+// An error is returned if we were unable to build a InternalServiceError struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -53397,12 +55145,12 @@ func (v *InternalDataInconsistencyError) ToWire() (wire.Value, error) {
 //		return nil, err
 //	}
 //
-//	var v InternalDataInconsistencyError
+//	var v InternalServiceError
 //	if err := v.FromWire(x); err != nil {
 //		return nil, err
 //	}
 //	return &v, nil
-func (v *InternalDataInconsistencyError) FromWire(w wire.Value) error {
+func (v *InternalServiceError) FromWire(w wire.Value) error {
 	var err error
 
 	messageIsSet := false
@@ -53421,17 +55169,17 @@ func (v *InternalDataInconsistencyError) FromWire(w wire.Value) error {
 	}
 
 	if !messageIsSet {
-		return errors.New("field Message of InternalDataInconsistencyError is required")
+		return errors.New("field Message of InternalServiceError is required")
 	}
 
 	return nil
 }
 
-// Encode serializes a InternalDataInconsistencyError struct directly into bytes, without going
+// Encode serializes a InternalServiceError struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a InternalDataInconsistencyError struct could not be encoded.
-func (v *InternalDataInconsistencyError) Encode(sw stream.Writer) error {
+// An error is returned if a InternalServiceError struct could not be encoded.
+func (v *InternalServiceError) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -53449,12 +55197,12 @@ func (v *InternalDataInconsistencyError) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a InternalDataInconsistencyError struct directly from its Thrift-level
+// Decode deserializes a InternalServiceError struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a InternalDataInconsistencyError struct could not be generated from the wire
+// An error is returned if a InternalServiceError struct could not be generated from the wire
 // representation.
-func (v *InternalDataInconsistencyError) Decode(sr stream.Reader) error {
+func (v *InternalServiceError) Decode(sr stream.Reader) error {
 
 	messageIsSet := false
 
@@ -53495,15 +55243,15 @@ func (v *InternalDataInconsistencyError) Decode(sr stream.Reader) error {
 	}
 
 	if !messageIsSet {
-		return errors.New("field Message of InternalDataInconsistencyError is required")
+		return errors.New("field Message of InternalServiceError is required")
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a InternalDataInconsistencyError
+// String returns a readable string representation of a InternalServiceError
 // struct.
-func (v *InternalDataInconsistencyError) String() string {
+func (v *InternalServiceError) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -53513,60 +55261,403 @@ func (v *InternalDataInconsistencyError) String() string {
 	fields[i] = fmt.Sprintf("Message: %v", v.Message)
 	i++
 
-	return fmt.Sprintf("InternalDataInconsistencyError{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("InternalServiceError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*InternalServiceError) ErrorName() string {
+	return "InternalServiceError"
+}
+
+// Equals returns true if all the fields of this InternalServiceError match the
+// provided InternalServiceError.
+//
+// This function performs a deep comparison.
+func (v *InternalServiceError) Equals(rhs *InternalServiceError) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of InternalServiceError.
+func (v *InternalServiceError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("message", v.Message)
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *InternalServiceError) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
+	}
+	return
+}
+
+func (v *InternalServiceError) Error() string {
+	return v.String()
+}
+
+type IsolationGroupConfiguration struct {
+	IsolationGroups []*IsolationGroupPartition `json:"isolationGroups,omitempty"`
+}
+
+type _List_IsolationGroupPartition_ValueList []*IsolationGroupPartition
+
+func (v _List_IsolationGroupPartition_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*IsolationGroupPartition', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_IsolationGroupPartition_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_IsolationGroupPartition_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_IsolationGroupPartition_ValueList) Close() {}
+
+// ToWire translates a IsolationGroupConfiguration struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//		return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//		return err
+//	}
+func (v *IsolationGroupConfiguration) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.IsolationGroups != nil {
+		w, err = wire.NewValueList(_List_IsolationGroupPartition_ValueList(v.IsolationGroups)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _IsolationGroupPartition_Read(w wire.Value) (*IsolationGroupPartition, error) {
+	var v IsolationGroupPartition
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_IsolationGroupPartition_Read(l wire.ValueList) ([]*IsolationGroupPartition, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*IsolationGroupPartition, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _IsolationGroupPartition_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a IsolationGroupConfiguration struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a IsolationGroupConfiguration struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//		return nil, err
+//	}
+//
+//	var v IsolationGroupConfiguration
+//	if err := v.FromWire(x); err != nil {
+//		return nil, err
+//	}
+//	return &v, nil
+func (v *IsolationGroupConfiguration) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.IsolationGroups, err = _List_IsolationGroupPartition_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_IsolationGroupPartition_Encode(val []*IsolationGroupPartition, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*IsolationGroupPartition', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a IsolationGroupConfiguration struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a IsolationGroupConfiguration struct could not be encoded.
+func (v *IsolationGroupConfiguration) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.IsolationGroups != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_IsolationGroupPartition_Encode(v.IsolationGroups, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _IsolationGroupPartition_Decode(sr stream.Reader) (*IsolationGroupPartition, error) {
+	var v IsolationGroupPartition
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_IsolationGroupPartition_Decode(sr stream.Reader) ([]*IsolationGroupPartition, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*IsolationGroupPartition, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _IsolationGroupPartition_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a IsolationGroupConfiguration struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a IsolationGroupConfiguration struct could not be generated from the wire
+// representation.
+func (v *IsolationGroupConfiguration) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TList:
+			v.IsolationGroups, err = _List_IsolationGroupPartition_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a IsolationGroupConfiguration
+// struct.
+func (v *IsolationGroupConfiguration) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.IsolationGroups != nil {
+		fields[i] = fmt.Sprintf("IsolationGroups: %v", v.IsolationGroups)
+		i++
+	}
+
+	return fmt.Sprintf("IsolationGroupConfiguration{%v}", strings.Join(fields[:i], ", "))
 }
 
-// ErrorName is the name of this type as defined in the Thrift
-// file.
-func (*InternalDataInconsistencyError) ErrorName() string {
-	return "InternalDataInconsistencyError"
+func _List_IsolationGroupPartition_Equals(lhs, rhs []*IsolationGroupPartition) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
 }
 
-// Equals returns true if all the fields of this InternalDataInconsistencyError match the
-// provided InternalDataInconsistencyError.
+// Equals returns true if all the fields of this IsolationGroupConfiguration match the
+// provided IsolationGroupConfiguration.
 //
 // This function performs a deep comparison.
-func (v *InternalDataInconsistencyError) Equals(rhs *InternalDataInconsistencyError) bool {
+func (v *IsolationGroupConfiguration) Equals(rhs *IsolationGroupConfiguration) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !(v.Message == rhs.Message) {
+	if !((v.IsolationGroups == nil && rhs.IsolationGroups == nil) || (v.IsolationGroups != nil && rhs.IsolationGroups != nil && _List_IsolationGroupPartition_Equals(v.IsolationGroups, rhs.IsolationGroups))) {
 		return false
 	}
 
 	return true
 }
 
+type _List_IsolationGroupPartition_Zapper []*IsolationGroupPartition
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_IsolationGroupPartition_Zapper.
+func (l _List_IsolationGroupPartition_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of InternalDataInconsistencyError.
-func (v *InternalDataInconsistencyError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of IsolationGroupConfiguration.
+func (v *IsolationGroupConfiguration) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	enc.AddString("message", v.Message)
+	if v.IsolationGroups != nil {
+		err = multierr.Append(err, enc.AddArray("isolationGroups", (_List_IsolationGroupPartition_Zapper)(v.IsolationGroups)))
+	}
 	return err
 }
 
-// GetMessage returns the value of Message if it is set or its
+// GetIsolationGroups returns the value of IsolationGroups if it is set or its
 // zero value if it is unset.
-func (v *InternalDataInconsistencyError) GetMessage() (o string) {
-	if v != nil {
-		o = v.Message
+func (v *IsolationGroupConfiguration) GetIsolationGroups() (o []*IsolationGroupPartition) {
+	if v != nil && v.IsolationGroups != nil {
+		return v.IsolationGroups
 	}
+
 	return
 }
 
-func (v *InternalDataInconsistencyError) Error() string {
-	return v.String()
+// IsSetIsolationGroups returns true if IsolationGroups is not nil.
+func (v *IsolationGroupConfiguration) IsSetIsolationGroups() bool {
+	return v != nil && v.IsolationGroups != nil
 }
 
-type InternalServiceError struct {
-	Message string `json:"message,required"`
+type IsolationGroupMetrics struct {
+	NewTasksPerSecond *float64 `json:"newTasksPerSecond,omitempty"`
+	PollerCount       *int64   `json:"pollerCount,omitempty"`
 }
 
-// ToWire translates a InternalServiceError struct into a Thrift-level intermediate
+// ToWire translates a IsolationGroupMetrics struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -53581,29 +55672,39 @@ type InternalServiceError struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //		return err
 //	}
-func (v *InternalServiceError) ToWire() (wire.Value, error) {
+func (v *IsolationGroupMetrics) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Message), error(nil)
-	if err != nil {
-		return w, err
+	if v.NewTasksPerSecond != nil {
+		w, err = wire.NewValueDouble(*(v.NewTasksPerSecond)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.PollerCount != nil {
+		w, err = wire.NewValueI64(*(v.PollerCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a InternalServiceError struct from its Thrift-level
+// FromWire deserializes a IsolationGroupMetrics struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a InternalServiceError struct
+// An error is returned if we were unable to build a IsolationGroupMetrics struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -53611,66 +55712,84 @@ func (v *InternalServiceError) ToWire() (wire.Value, error) {
 //		return nil, err
 //	}
 //
-//	var v InternalServiceError
+//	var v IsolationGroupMetrics
 //	if err := v.FromWire(x); err != nil {
 //		return nil, err
 //	}
 //	return &v, nil
-func (v *InternalServiceError) FromWire(w wire.Value) error {
+func (v *IsolationGroupMetrics) FromWire(w wire.Value) error {
 	var err error
 
-	messageIsSet := false
-
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TBinary {
-				v.Message, err = field.Value.GetString(), error(nil)
+		case 10:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.NewTasksPerSecond = &x
 				if err != nil {
 					return err
 				}
-				messageIsSet = true
+
 			}
-		}
-	}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.PollerCount = &x
+				if err != nil {
+					return err
+				}
 
-	if !messageIsSet {
-		return errors.New("field Message of InternalServiceError is required")
+			}
+		}
 	}
 
 	return nil
 }
 
-// Encode serializes a InternalServiceError struct directly into bytes, without going
+// Encode serializes a IsolationGroupMetrics struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a InternalServiceError struct could not be encoded.
-func (v *InternalServiceError) Encode(sw stream.Writer) error {
+// An error is returned if a IsolationGroupMetrics struct could not be encoded.
+func (v *IsolationGroupMetrics) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
-		return err
-	}
-	if err := sw.WriteString(v.Message); err != nil {
-		return err
+	if v.NewTasksPerSecond != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TDouble}); err != nil {
+			return err
+		}
+		if err := sw.WriteDouble(*(v.NewTasksPerSecond)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
+
+	if v.PollerCount != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.PollerCount)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a InternalServiceError struct directly from its Thrift-level
+// Decode deserializes a IsolationGroupMetrics struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a InternalServiceError struct could not be generated from the wire
+// An error is returned if a IsolationGroupMetrics struct could not be generated from the wire
 // representation.
-func (v *InternalServiceError) Decode(sr stream.Reader) error {
-
-	messageIsSet := false
+func (v *IsolationGroupMetrics) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -53683,12 +55802,22 @@ func (v *InternalServiceError) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 1 && fh.Type == wire.TBinary:
-			v.Message, err = sr.ReadString()
+		case fh.ID == 10 && fh.Type == wire.TDouble:
+			var x float64
+			x, err = sr.ReadDouble()
+			v.NewTasksPerSecond = &x
 			if err != nil {
 				return err
 			}
-			messageIsSet = true
+
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.PollerCount = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -53708,45 +55837,54 @@ func (v *InternalServiceError) Decode(sr stream.Reader) error {
 		return err
 	}
 
-	if !messageIsSet {
-		return errors.New("field Message of InternalServiceError is required")
-	}
-
 	return nil
 }
 
-// String returns a readable string representation of a InternalServiceError
+// String returns a readable string representation of a IsolationGroupMetrics
 // struct.
-func (v *InternalServiceError) String() string {
+func (v *IsolationGroupMetrics) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
-	fields[i] = fmt.Sprintf("Message: %v", v.Message)
-	i++
+	if v.NewTasksPerSecond != nil {
+		fields[i] = fmt.Sprintf("NewTasksPerSecond: %v", *(v.NewTasksPerSecond))
+		i++
+	}
+	if v.PollerCount != nil {
+		fields[i] = fmt.Sprintf("PollerCount: %v", *(v.PollerCount))
+		i++
+	}
 
-	return fmt.Sprintf("InternalServiceError{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("IsolationGroupMetrics{%v}", strings.Join(fields[:i], ", "))
 }
 
-// ErrorName is the name of this type as defined in the Thrift
-// file.
-func (*InternalServiceError) ErrorName() string {
-	return "InternalServiceError"
+func _Double_EqualsPtr(lhs, rhs *float64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
 }
 
-// Equals returns true if all the fields of this InternalServiceError match the
-// provided InternalServiceError.
+// Equals returns true if all the fields of this IsolationGroupMetrics match the
+// provided IsolationGroupMetrics.
 //
 // This function performs a deep comparison.
-func (v *InternalServiceError) Equals(rhs *InternalServiceError) bool {
+func (v *IsolationGroupMetrics) Equals(rhs *IsolationGroupMetrics) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !(v.Message == rhs.Message) {
+	if !_Double_EqualsPtr(v.NewTasksPerSecond, rhs.NewTasksPerSecond) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.PollerCount, rhs.PollerCount) {
 		return false
 	}
 
@@ -53754,62 +55892,56 @@ func (v *InternalServiceError) Equals(rhs *InternalServiceError) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of InternalServiceError.
-func (v *InternalServiceError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of IsolationGroupMetrics.
+func (v *IsolationGroupMetrics) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	enc.AddString("message", v.Message)
+	if v.NewTasksPerSecond != nil {
+		enc.AddFloat64("newTasksPerSecond", *v.NewTasksPerSecond)
+	}
+	if v.PollerCount != nil {
+		enc.AddInt64("pollerCount", *v.PollerCount)
+	}
 	return err
 }
 
-// GetMessage returns the value of Message if it is set or its
+// GetNewTasksPerSecond returns the value of NewTasksPerSecond if it is set or its
 // zero value if it is unset.
-func (v *InternalServiceError) GetMessage() (o string) {
-	if v != nil {
-		o = v.Message
+func (v *IsolationGroupMetrics) GetNewTasksPerSecond() (o float64) {
+	if v != nil && v.NewTasksPerSecond != nil {
+		return *v.NewTasksPerSecond
 	}
-	return
-}
 
-func (v *InternalServiceError) Error() string {
-	return v.String()
+	return
 }
 
-type IsolationGroupConfiguration struct {
-	IsolationGroups []*IsolationGroupPartition `json:"isolationGroups,omitempty"`
+// IsSetNewTasksPerSecond returns true if NewTasksPerSecond is not nil.
+func (v *IsolationGroupMetrics) IsSetNewTasksPerSecond() bool {
+	return v != nil && v.NewTasksPerSecond != nil
 }
 
-type _List_IsolationGroupPartition_ValueList []*IsolationGroupPartition
-
-func (v _List_IsolationGroupPartition_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid list '[]*IsolationGroupPartition', index [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
+// GetPollerCount returns the value of PollerCount if it is set or its
+// zero value if it is unset.
+func (v *IsolationGroupMetrics) GetPollerCount() (o int64) {
+	if v != nil && v.PollerCount != nil {
+		return *v.PollerCount
 	}
-	return nil
-}
 
-func (v _List_IsolationGroupPartition_ValueList) Size() int {
-	return len(v)
+	return
 }
 
-func (_List_IsolationGroupPartition_ValueList) ValueType() wire.Type {
-	return wire.TStruct
+// IsSetPollerCount returns true if PollerCount is not nil.
+func (v *IsolationGroupMetrics) IsSetPollerCount() bool {
+	return v != nil && v.PollerCount != nil
 }
 
-func (_List_IsolationGroupPartition_ValueList) Close() {}
+type IsolationGroupPartition struct {
+	Name  *string              `json:"name,omitempty"`
+	State *IsolationGroupState `json:"state,omitempty"`
+}
 
-// ToWire translates a IsolationGroupConfiguration struct into a Thrift-level intermediate
+// ToWire translates a IsolationGroupPartition struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -53824,55 +55956,45 @@ func (_List_IsolationGroupPartition_ValueList) Close() {}
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //		return err
 //	}
-func (v *IsolationGroupConfiguration) ToWire() (wire.Value, error) {
+func (v *IsolationGroupPartition) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.IsolationGroups != nil {
-		w, err = wire.NewValueList(_List_IsolationGroupPartition_ValueList(v.IsolationGroups)), error(nil)
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.State != nil {
+		w, err = v.State.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _IsolationGroupPartition_Read(w wire.Value) (*IsolationGroupPartition, error) {
-	var v IsolationGroupPartition
+func _IsolationGroupState_Read(w wire.Value) (IsolationGroupState, error) {
+	var v IsolationGroupState
 	err := v.FromWire(w)
-	return &v, err
-}
-
-func _List_IsolationGroupPartition_Read(l wire.ValueList) ([]*IsolationGroupPartition, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make([]*IsolationGroupPartition, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _IsolationGroupPartition_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
+	return v, err
 }
 
-// FromWire deserializes a IsolationGroupConfiguration struct from its Thrift-level
+// FromWire deserializes a IsolationGroupPartition struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a IsolationGroupConfiguration struct
+// An error is returned if we were unable to build a IsolationGroupPartition struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -53880,19 +56002,31 @@ func _List_IsolationGroupPartition_Read(l wire.ValueList) ([]*IsolationGroupPart
 //		return nil, err
 //	}
 //
-//	var v IsolationGroupConfiguration
+//	var v IsolationGroupPartition
 //	if err := v.FromWire(x); err != nil {
 //		return nil, err
 //	}
 //	return &v, nil
-func (v *IsolationGroupConfiguration) FromWire(w wire.Value) error {
+func (v *IsolationGroupPartition) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TList {
-				v.IsolationGroups, err = _List_IsolationGroupPartition_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x IsolationGroupState
+				x, err = _IsolationGroupState_Read(field.Value)
+				v.State = &x
 				if err != nil {
 					return err
 				}
@@ -53904,41 +56038,32 @@ func (v *IsolationGroupConfiguration) FromWire(w wire.Value) error {
 	return nil
 }
 
-func _List_IsolationGroupPartition_Encode(val []*IsolationGroupPartition, sw stream.Writer) error {
-
-	lh := stream.ListHeader{
-		Type:   wire.TStruct,
-		Length: len(val),
-	}
-	if err := sw.WriteListBegin(lh); err != nil {
+// Encode serializes a IsolationGroupPartition struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a IsolationGroupPartition struct could not be encoded.
+func (v *IsolationGroupPartition) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	for i, v := range val {
-		if v == nil {
-			return fmt.Errorf("invalid list '[]*IsolationGroupPartition', index [%v]: value is nil", i)
+	if v.Name != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
 		}
-		if err := v.Encode(sw); err != nil {
+		if err := sw.WriteString(*(v.Name)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
 			return err
 		}
-	}
-	return sw.WriteListEnd()
-}
-
-// Encode serializes a IsolationGroupConfiguration struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a IsolationGroupConfiguration struct could not be encoded.
-func (v *IsolationGroupConfiguration) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
-		return err
 	}
 
-	if v.IsolationGroups != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
+	if v.State != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := _List_IsolationGroupPartition_Encode(v.IsolationGroups, sw); err != nil {
+		if err := v.State.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -53949,48 +56074,18 @@ func (v *IsolationGroupConfiguration) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _IsolationGroupPartition_Decode(sr stream.Reader) (*IsolationGroupPartition, error) {
-	var v IsolationGroupPartition
+func _IsolationGroupState_Decode(sr stream.Reader) (IsolationGroupState, error) {
+	var v IsolationGroupState
 	err := v.Decode(sr)
-	return &v, err
-}
-
-func _List_IsolationGroupPartition_Decode(sr stream.Reader) ([]*IsolationGroupPartition, error) {
-	lh, err := sr.ReadListBegin()
-	if err != nil {
-		return nil, err
-	}
-
-	if lh.Type != wire.TStruct {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
-		}
-		return nil, sr.ReadListEnd()
-	}
-
-	o := make([]*IsolationGroupPartition, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := _IsolationGroupPartition_Decode(sr)
-		if err != nil {
-			return nil, err
-		}
-		o = append(o, v)
-	}
-
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
-	}
-	return o, err
+	return v, err
 }
 
-// Decode deserializes a IsolationGroupConfiguration struct directly from its Thrift-level
+// Decode deserializes a IsolationGroupPartition struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a IsolationGroupConfiguration struct could not be generated from the wire
+// An error is returned if a IsolationGroupPartition struct could not be generated from the wire
 // representation.
-func (v *IsolationGroupConfiguration) Decode(sr stream.Reader) error {
+func (v *IsolationGroupPartition) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -54003,8 +56098,18 @@ func (v *IsolationGroupConfiguration) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TList:
-			v.IsolationGroups, err = _List_IsolationGroupPartition_Decode(sr)
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI32:
+			var x IsolationGroupState
+			x, err = _IsolationGroupState_Decode(sr)
+			v.State = &x
 			if err != nil {
 				return err
 			}
@@ -54024,106 +56129,326 @@ func (v *IsolationGroupConfiguration) Decode(sr stream.Reader) error {
 		}
 	}
 
-	if err := sr.ReadStructEnd(); err != nil {
-		return err
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a IsolationGroupPartition
+// struct.
+func (v *IsolationGroupPartition) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+	if v.State != nil {
+		fields[i] = fmt.Sprintf("State: %v", *(v.State))
+		i++
+	}
+
+	return fmt.Sprintf("IsolationGroupPartition{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _IsolationGroupState_EqualsPtr(lhs, rhs *IsolationGroupState) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this IsolationGroupPartition match the
+// provided IsolationGroupPartition.
+//
+// This function performs a deep comparison.
+func (v *IsolationGroupPartition) Equals(rhs *IsolationGroupPartition) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+	if !_IsolationGroupState_EqualsPtr(v.State, rhs.State) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of IsolationGroupPartition.
+func (v *IsolationGroupPartition) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	if v.State != nil {
+		err = multierr.Append(err, enc.AddObject("state", *v.State))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *IsolationGroupPartition) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
 	}
 
-	return nil
+	return
 }
 
-// String returns a readable string representation of a IsolationGroupConfiguration
-// struct.
-func (v *IsolationGroupConfiguration) String() string {
-	if v == nil {
-		return "<nil>"
-	}
+// IsSetName returns true if Name is not nil.
+func (v *IsolationGroupPartition) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
 
-	var fields [1]string
-	i := 0
-	if v.IsolationGroups != nil {
-		fields[i] = fmt.Sprintf("IsolationGroups: %v", v.IsolationGroups)
-		i++
+// GetState returns the value of State if it is set or its
+// zero value if it is unset.
+func (v *IsolationGroupPartition) GetState() (o IsolationGroupState) {
+	if v != nil && v.State != nil {
+		return *v.State
 	}
 
-	return fmt.Sprintf("IsolationGroupConfiguration{%v}", strings.Join(fields[:i], ", "))
+	return
 }
 
-func _List_IsolationGroupPartition_Equals(lhs, rhs []*IsolationGroupPartition) bool {
-	if len(lhs) != len(rhs) {
-		return false
+// IsSetState returns true if State is not nil.
+func (v *IsolationGroupPartition) IsSetState() bool {
+	return v != nil && v.State != nil
+}
+
+type IsolationGroupState int32
+
+const (
+	IsolationGroupStateInvalid IsolationGroupState = 0
+	IsolationGroupStateHealthy IsolationGroupState = 1
+	IsolationGroupStateDrained IsolationGroupState = 2
+)
+
+// IsolationGroupState_Values returns all recognized values of IsolationGroupState.
+func IsolationGroupState_Values() []IsolationGroupState {
+	return []IsolationGroupState{
+		IsolationGroupStateInvalid,
+		IsolationGroupStateHealthy,
+		IsolationGroupStateDrained,
 	}
+}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
+// UnmarshalText tries to decode IsolationGroupState from a byte slice
+// containing its name.
+//
+//	var v IsolationGroupState
+//	err := v.UnmarshalText([]byte("INVALID"))
+func (v *IsolationGroupState) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "INVALID":
+		*v = IsolationGroupStateInvalid
+		return nil
+	case "HEALTHY":
+		*v = IsolationGroupStateHealthy
+		return nil
+	case "DRAINED":
+		*v = IsolationGroupStateDrained
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "IsolationGroupState", err)
 		}
+		*v = IsolationGroupState(val)
+		return nil
 	}
-
-	return true
 }
 
-// Equals returns true if all the fields of this IsolationGroupConfiguration match the
-// provided IsolationGroupConfiguration.
+// MarshalText encodes IsolationGroupState to text.
 //
-// This function performs a deep comparison.
-func (v *IsolationGroupConfiguration) Equals(rhs *IsolationGroupConfiguration) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v IsolationGroupState) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("INVALID"), nil
+	case 1:
+		return []byte("HEALTHY"), nil
+	case 2:
+		return []byte("DRAINED"), nil
 	}
-	if !((v.IsolationGroups == nil && rhs.IsolationGroups == nil) || (v.IsolationGroups != nil && rhs.IsolationGroups != nil && _List_IsolationGroupPartition_Equals(v.IsolationGroups, rhs.IsolationGroups))) {
-		return false
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of IsolationGroupState.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v IsolationGroupState) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "INVALID")
+	case 1:
+		enc.AddString("name", "HEALTHY")
+	case 2:
+		enc.AddString("name", "DRAINED")
 	}
+	return nil
+}
 
-	return true
+// Ptr returns a pointer to this enum value.
+func (v IsolationGroupState) Ptr() *IsolationGroupState {
+	return &v
 }
 
-type _List_IsolationGroupPartition_Zapper []*IsolationGroupPartition
+// Encode encodes IsolationGroupState directly to bytes.
+//
+//	sWriter := BinaryStreamer.Writer(writer)
+//
+//	var v IsolationGroupState
+//	return v.Encode(sWriter)
+func (v IsolationGroupState) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
 
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_IsolationGroupPartition_Zapper.
-func (l _List_IsolationGroupPartition_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
-	}
-	return err
+// ToWire translates IsolationGroupState into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v IsolationGroupState) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of IsolationGroupConfiguration.
-func (v *IsolationGroupConfiguration) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
-	}
-	if v.IsolationGroups != nil {
-		err = multierr.Append(err, enc.AddArray("isolationGroups", (_List_IsolationGroupPartition_Zapper)(v.IsolationGroups)))
+// FromWire deserializes IsolationGroupState from its Thrift-level
+// representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TI32)
+//	if err != nil {
+//	    return IsolationGroupState(0), err
+//	}
+//
+//	var v IsolationGroupState
+//	if err := v.FromWire(x); err != nil {
+//	    return IsolationGroupState(0), err
+//	}
+//	return v, nil
+func (v *IsolationGroupState) FromWire(w wire.Value) error {
+	*v = (IsolationGroupState)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded IsolationGroupState directly off of the wire.
+//
+//	sReader := BinaryStreamer.Reader(reader)
+//
+//	var v IsolationGroupState
+//	if err := v.Decode(sReader); err != nil {
+//	    return IsolationGroupState(0), err
+//	}
+//	return v, nil
+func (v *IsolationGroupState) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
 	}
-	return err
+	*v = (IsolationGroupState)(i)
+	return nil
 }
 
-// GetIsolationGroups returns the value of IsolationGroups if it is set or its
-// zero value if it is unset.
-func (v *IsolationGroupConfiguration) GetIsolationGroups() (o []*IsolationGroupPartition) {
-	if v != nil && v.IsolationGroups != nil {
-		return v.IsolationGroups
+// String returns a readable string representation of IsolationGroupState.
+func (v IsolationGroupState) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "INVALID"
+	case 1:
+		return "HEALTHY"
+	case 2:
+		return "DRAINED"
 	}
+	return fmt.Sprintf("IsolationGroupState(%d)", w)
+}
 
-	return
+// Equals returns true if this IsolationGroupState value matches the provided
+// value.
+func (v IsolationGroupState) Equals(rhs IsolationGroupState) bool {
+	return v == rhs
 }
 
-// IsSetIsolationGroups returns true if IsolationGroups is not nil.
-func (v *IsolationGroupConfiguration) IsSetIsolationGroups() bool {
-	return v != nil && v.IsolationGroups != nil
+// MarshalJSON serializes IsolationGroupState into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v IsolationGroupState) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"INVALID\""), nil
+	case 1:
+		return ([]byte)("\"HEALTHY\""), nil
+	case 2:
+		return ([]byte)("\"DRAINED\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
 
-type IsolationGroupMetrics struct {
-	NewTasksPerSecond *float64 `json:"newTasksPerSecond,omitempty"`
-	PollerCount       *int64   `json:"pollerCount,omitempty"`
+// UnmarshalJSON attempts to decode IsolationGroupState from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *IsolationGroupState) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "IsolationGroupState")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "IsolationGroupState")
+		}
+		*v = (IsolationGroupState)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "IsolationGroupState")
+	}
 }
 
-// ToWire translates a IsolationGroupMetrics struct into a Thrift-level intermediate
+type LimitExceededError struct {
+	Message string `json:"message,required"`
+}
+
+// ToWire translates a LimitExceededError struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -54138,39 +56463,29 @@ type IsolationGroupMetrics struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //		return err
 //	}
-func (v *IsolationGroupMetrics) ToWire() (wire.Value, error) {
+func (v *LimitExceededError) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.NewTasksPerSecond != nil {
-		w, err = wire.NewValueDouble(*(v.NewTasksPerSecond)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.PollerCount != nil {
-		w, err = wire.NewValueI64(*(v.PollerCount)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
 	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a IsolationGroupMetrics struct from its Thrift-level
+// FromWire deserializes a LimitExceededError struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a IsolationGroupMetrics struct
+// An error is returned if we were unable to build a LimitExceededError struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -54178,84 +56493,66 @@ func (v *IsolationGroupMetrics) ToWire() (wire.Value, error) {
 //		return nil, err
 //	}
 //
-//	var v IsolationGroupMetrics
+//	var v LimitExceededError
 //	if err := v.FromWire(x); err != nil {
 //		return nil, err
 //	}
 //	return &v, nil
-func (v *IsolationGroupMetrics) FromWire(w wire.Value) error {
+func (v *LimitExceededError) FromWire(w wire.Value) error {
 	var err error
 
+	messageIsSet := false
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TDouble {
-				var x float64
-				x, err = field.Value.GetDouble(), error(nil)
-				v.NewTasksPerSecond = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.PollerCount = &x
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-
+				messageIsSet = true
 			}
 		}
 	}
 
+	if !messageIsSet {
+		return errors.New("field Message of LimitExceededError is required")
+	}
+
 	return nil
 }
 
-// Encode serializes a IsolationGroupMetrics struct directly into bytes, without going
+// Encode serializes a LimitExceededError struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a IsolationGroupMetrics struct could not be encoded.
-func (v *IsolationGroupMetrics) Encode(sw stream.Writer) error {
+// An error is returned if a LimitExceededError struct could not be encoded.
+func (v *LimitExceededError) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.NewTasksPerSecond != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TDouble}); err != nil {
-			return err
-		}
-		if err := sw.WriteDouble(*(v.NewTasksPerSecond)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
+		return err
 	}
-
-	if v.PollerCount != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.PollerCount)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
+	if err := sw.WriteString(v.Message); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a IsolationGroupMetrics struct directly from its Thrift-level
+// Decode deserializes a LimitExceededError struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a IsolationGroupMetrics struct could not be generated from the wire
+// An error is returned if a LimitExceededError struct could not be generated from the wire
 // representation.
-func (v *IsolationGroupMetrics) Decode(sr stream.Reader) error {
+func (v *LimitExceededError) Decode(sr stream.Reader) error {
+
+	messageIsSet := false
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -54268,22 +56565,12 @@ func (v *IsolationGroupMetrics) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TDouble:
-			var x float64
-			x, err = sr.ReadDouble()
-			v.NewTasksPerSecond = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.PollerCount = &x
+		case fh.ID == 1 && fh.Type == wire.TBinary:
+			v.Message, err = sr.ReadString()
 			if err != nil {
 				return err
 			}
-
+			messageIsSet = true
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -54303,54 +56590,45 @@ func (v *IsolationGroupMetrics) Decode(sr stream.Reader) error {
 		return err
 	}
 
+	if !messageIsSet {
+		return errors.New("field Message of LimitExceededError is required")
+	}
+
 	return nil
 }
 
-// String returns a readable string representation of a IsolationGroupMetrics
+// String returns a readable string representation of a LimitExceededError
 // struct.
-func (v *IsolationGroupMetrics) String() string {
+func (v *LimitExceededError) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [1]string
 	i := 0
-	if v.NewTasksPerSecond != nil {
-		fields[i] = fmt.Sprintf("NewTasksPerSecond: %v", *(v.NewTasksPerSecond))
-		i++
-	}
-	if v.PollerCount != nil {
-		fields[i] = fmt.Sprintf("PollerCount: %v", *(v.PollerCount))
-		i++
-	}
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
 
-	return fmt.Sprintf("IsolationGroupMetrics{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("LimitExceededError{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _Double_EqualsPtr(lhs, rhs *float64) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*LimitExceededError) ErrorName() string {
+	return "LimitExceededError"
 }
 
-// Equals returns true if all the fields of this IsolationGroupMetrics match the
-// provided IsolationGroupMetrics.
+// Equals returns true if all the fields of this LimitExceededError match the
+// provided LimitExceededError.
 //
 // This function performs a deep comparison.
-func (v *IsolationGroupMetrics) Equals(rhs *IsolationGroupMetrics) bool {
+func (v *LimitExceededError) Equals(rhs *LimitExceededError) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_Double_EqualsPtr(v.NewTasksPerSecond, rhs.NewTasksPerSecond) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.PollerCount, rhs.PollerCount) {
+	if !(v.Message == rhs.Message) {
 		return false
 	}
 
@@ -54358,56 +56636,36 @@ func (v *IsolationGroupMetrics) Equals(rhs *IsolationGroupMetrics) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of IsolationGroupMetrics.
-func (v *IsolationGroupMetrics) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of LimitExceededError.
+func (v *LimitExceededError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.NewTasksPerSecond != nil {
-		enc.AddFloat64("newTasksPerSecond", *v.NewTasksPerSecond)
-	}
-	if v.PollerCount != nil {
-		enc.AddInt64("pollerCount", *v.PollerCount)
-	}
+	enc.AddString("message", v.Message)
 	return err
 }
 
-// GetNewTasksPerSecond returns the value of NewTasksPerSecond if it is set or its
-// zero value if it is unset.
-func (v *IsolationGroupMetrics) GetNewTasksPerSecond() (o float64) {
-	if v != nil && v.NewTasksPerSecond != nil {
-		return *v.NewTasksPerSecond
-	}
-
-	return
-}
-
-// IsSetNewTasksPerSecond returns true if NewTasksPerSecond is not nil.
-func (v *IsolationGroupMetrics) IsSetNewTasksPerSecond() bool {
-	return v != nil && v.NewTasksPerSecond != nil
-}
-
-// GetPollerCount returns the value of PollerCount if it is set or its
+// GetMessage returns the value of Message if it is set or its
 // zero value if it is unset.
-func (v *IsolationGroupMetrics) GetPollerCount() (o int64) {
-	if v != nil && v.PollerCount != nil {
-		return *v.PollerCount
+func (v *LimitExceededError) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
 	}
-
 	return
 }
 
-// IsSetPollerCount returns true if PollerCount is not nil.
-func (v *IsolationGroupMetrics) IsSetPollerCount() bool {
-	return v != nil && v.PollerCount != nil
+func (v *LimitExceededError) Error() string {
+	return v.String()
 }
 
-type IsolationGroupPartition struct {
-	Name  *string              `json:"name,omitempty"`
-	State *IsolationGroupState `json:"state,omitempty"`
+type ListArchivedWorkflowExecutionsRequest struct {
+	Domain        *string `json:"domain,omitempty"`
+	PageSize      *int32  `json:"pageSize,omitempty"`
+	NextPageToken []byte  `json:"nextPageToken,omitempty"`
+	Query         *string `json:"query,omitempty"`
 }
 
-// ToWire translates a IsolationGroupPartition struct into a Thrift-level intermediate
+// ToWire translates a ListArchivedWorkflowExecutionsRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -54422,45 +56680,55 @@ type IsolationGroupPartition struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //		return err
 //	}
-func (v *IsolationGroupPartition) ToWire() (wire.Value, error) {
+func (v *ListArchivedWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Name != nil {
-		w, err = wire.NewValueString(*(v.Name)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.PageSize != nil {
+		w, err = wire.NewValueI32(*(v.PageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.State != nil {
-		w, err = v.State.ToWire()
+	if v.Query != nil {
+		w, err = wire.NewValueString(*(v.Query)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _IsolationGroupState_Read(w wire.Value) (IsolationGroupState, error) {
-	var v IsolationGroupState
-	err := v.FromWire(w)
-	return v, err
-}
-
-// FromWire deserializes a IsolationGroupPartition struct from its Thrift-level
+// FromWire deserializes a ListArchivedWorkflowExecutionsRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a IsolationGroupPartition struct
+// An error is returned if we were unable to build a ListArchivedWorkflowExecutionsRequest struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -54468,12 +56736,12 @@ func _IsolationGroupState_Read(w wire.Value) (IsolationGroupState, error) {
 //		return nil, err
 //	}
 //
-//	var v IsolationGroupPartition
+//	var v ListArchivedWorkflowExecutionsRequest
 //	if err := v.FromWire(x); err != nil {
 //		return nil, err
 //	}
 //	return &v, nil
-func (v *IsolationGroupPartition) FromWire(w wire.Value) error {
+func (v *ListArchivedWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -54482,7 +56750,7 @@ func (v *IsolationGroupPartition) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Name = &x
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
@@ -54490,9 +56758,27 @@ func (v *IsolationGroupPartition) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TI32 {
-				var x IsolationGroupState
-				x, err = _IsolationGroupState_Read(field.Value)
-				v.State = &x
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.PageSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Query = &x
 				if err != nil {
 					return err
 				}
@@ -54504,20 +56790,20 @@ func (v *IsolationGroupPartition) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a IsolationGroupPartition struct directly into bytes, without going
+// Encode serializes a ListArchivedWorkflowExecutionsRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a IsolationGroupPartition struct could not be encoded.
-func (v *IsolationGroupPartition) Encode(sw stream.Writer) error {
+// An error is returned if a ListArchivedWorkflowExecutionsRequest struct could not be encoded.
+func (v *ListArchivedWorkflowExecutionsRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Name != nil {
+	if v.Domain != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Name)); err != nil {
+		if err := sw.WriteString(*(v.Domain)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -54525,11 +56811,11 @@ func (v *IsolationGroupPartition) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.State != nil {
+	if v.PageSize != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := v.State.Encode(sw); err != nil {
+		if err := sw.WriteInt32(*(v.PageSize)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -54537,21 +56823,39 @@ func (v *IsolationGroupPartition) Encode(sw stream.Writer) error {
 		}
 	}
 
-	return sw.WriteStructEnd()
-}
+	if v.NextPageToken != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.NextPageToken); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
 
-func _IsolationGroupState_Decode(sr stream.Reader) (IsolationGroupState, error) {
-	var v IsolationGroupState
-	err := v.Decode(sr)
-	return v, err
+	if v.Query != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Query)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a IsolationGroupPartition struct directly from its Thrift-level
+// Decode deserializes a ListArchivedWorkflowExecutionsRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a IsolationGroupPartition struct could not be generated from the wire
+// An error is returned if a ListArchivedWorkflowExecutionsRequest struct could not be generated from the wire
 // representation.
-func (v *IsolationGroupPartition) Decode(sr stream.Reader) error {
+func (v *ListArchivedWorkflowExecutionsRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -54567,15 +56871,29 @@ func (v *IsolationGroupPartition) Decode(sr stream.Reader) error {
 		case fh.ID == 10 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.Name = &x
+			v.Domain = &x
 			if err != nil {
 				return err
 			}
 
 		case fh.ID == 20 && fh.Type == wire.TI32:
-			var x IsolationGroupState
-			x, err = _IsolationGroupState_Decode(sr)
-			v.State = &x
+			var x int32
+			x, err = sr.ReadInt32()
+			v.PageSize = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			v.NextPageToken, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Query = &x
 			if err != nil {
 				return err
 			}
@@ -54602,51 +56920,55 @@ func (v *IsolationGroupPartition) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a IsolationGroupPartition
+// String returns a readable string representation of a ListArchivedWorkflowExecutionsRequest
 // struct.
-func (v *IsolationGroupPartition) String() string {
+func (v *ListArchivedWorkflowExecutionsRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [4]string
 	i := 0
-	if v.Name != nil {
-		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.State != nil {
-		fields[i] = fmt.Sprintf("State: %v", *(v.State))
+	if v.PageSize != nil {
+		fields[i] = fmt.Sprintf("PageSize: %v", *(v.PageSize))
 		i++
 	}
-
-	return fmt.Sprintf("IsolationGroupPartition{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _IsolationGroupState_EqualsPtr(lhs, rhs *IsolationGroupState) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
 	}
-	return lhs == nil && rhs == nil
+	if v.Query != nil {
+		fields[i] = fmt.Sprintf("Query: %v", *(v.Query))
+		i++
+	}
+
+	return fmt.Sprintf("ListArchivedWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this IsolationGroupPartition match the
-// provided IsolationGroupPartition.
+// Equals returns true if all the fields of this ListArchivedWorkflowExecutionsRequest match the
+// provided ListArchivedWorkflowExecutionsRequest.
 //
 // This function performs a deep comparison.
-func (v *IsolationGroupPartition) Equals(rhs *IsolationGroupPartition) bool {
+func (v *ListArchivedWorkflowExecutionsRequest) Equals(rhs *ListArchivedWorkflowExecutionsRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Name, rhs.Name) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !_IsolationGroupState_EqualsPtr(v.State, rhs.State) {
+	if !_I32_EqualsPtr(v.PageSize, rhs.PageSize) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Query, rhs.Query) {
 		return false
 	}
 
@@ -54654,267 +56976,121 @@ func (v *IsolationGroupPartition) Equals(rhs *IsolationGroupPartition) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of IsolationGroupPartition.
-func (v *IsolationGroupPartition) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ListArchivedWorkflowExecutionsRequest.
+func (v *ListArchivedWorkflowExecutionsRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Name != nil {
-		enc.AddString("name", *v.Name)
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
 	}
-	if v.State != nil {
-		err = multierr.Append(err, enc.AddObject("state", *v.State))
+	if v.PageSize != nil {
+		enc.AddInt32("pageSize", *v.PageSize)
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	if v.Query != nil {
+		enc.AddString("query", *v.Query)
 	}
 	return err
 }
 
-// GetName returns the value of Name if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *IsolationGroupPartition) GetName() (o string) {
-	if v != nil && v.Name != nil {
-		return *v.Name
+func (v *ListArchivedWorkflowExecutionsRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// IsSetName returns true if Name is not nil.
-func (v *IsolationGroupPartition) IsSetName() bool {
-	return v != nil && v.Name != nil
+// IsSetDomain returns true if Domain is not nil.
+func (v *ListArchivedWorkflowExecutionsRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
 }
 
-// GetState returns the value of State if it is set or its
+// GetPageSize returns the value of PageSize if it is set or its
 // zero value if it is unset.
-func (v *IsolationGroupPartition) GetState() (o IsolationGroupState) {
-	if v != nil && v.State != nil {
-		return *v.State
+func (v *ListArchivedWorkflowExecutionsRequest) GetPageSize() (o int32) {
+	if v != nil && v.PageSize != nil {
+		return *v.PageSize
 	}
 
 	return
 }
 
-// IsSetState returns true if State is not nil.
-func (v *IsolationGroupPartition) IsSetState() bool {
-	return v != nil && v.State != nil
-}
-
-type IsolationGroupState int32
-
-const (
-	IsolationGroupStateInvalid IsolationGroupState = 0
-	IsolationGroupStateHealthy IsolationGroupState = 1
-	IsolationGroupStateDrained IsolationGroupState = 2
-)
-
-// IsolationGroupState_Values returns all recognized values of IsolationGroupState.
-func IsolationGroupState_Values() []IsolationGroupState {
-	return []IsolationGroupState{
-		IsolationGroupStateInvalid,
-		IsolationGroupStateHealthy,
-		IsolationGroupStateDrained,
-	}
-}
-
-// UnmarshalText tries to decode IsolationGroupState from a byte slice
-// containing its name.
-//
-//	var v IsolationGroupState
-//	err := v.UnmarshalText([]byte("INVALID"))
-func (v *IsolationGroupState) UnmarshalText(value []byte) error {
-	switch s := string(value); s {
-	case "INVALID":
-		*v = IsolationGroupStateInvalid
-		return nil
-	case "HEALTHY":
-		*v = IsolationGroupStateHealthy
-		return nil
-	case "DRAINED":
-		*v = IsolationGroupStateDrained
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "IsolationGroupState", err)
-		}
-		*v = IsolationGroupState(val)
-		return nil
-	}
-}
-
-// MarshalText encodes IsolationGroupState to text.
-//
-// If the enum value is recognized, its name is returned.
-// Otherwise, its integer value is returned.
-//
-// This implements the TextMarshaler interface.
-func (v IsolationGroupState) MarshalText() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return []byte("INVALID"), nil
-	case 1:
-		return []byte("HEALTHY"), nil
-	case 2:
-		return []byte("DRAINED"), nil
-	}
-	return []byte(strconv.FormatInt(int64(v), 10)), nil
+// IsSetPageSize returns true if PageSize is not nil.
+func (v *ListArchivedWorkflowExecutionsRequest) IsSetPageSize() bool {
+	return v != nil && v.PageSize != nil
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of IsolationGroupState.
-// Enums are logged as objects, where the value is logged with key "value", and
-// if this value's name is known, the name is logged with key "name".
-func (v IsolationGroupState) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	enc.AddInt32("value", int32(v))
-	switch int32(v) {
-	case 0:
-		enc.AddString("name", "INVALID")
-	case 1:
-		enc.AddString("name", "HEALTHY")
-	case 2:
-		enc.AddString("name", "DRAINED")
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *ListArchivedWorkflowExecutionsRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
 	}
-	return nil
-}
-
-// Ptr returns a pointer to this enum value.
-func (v IsolationGroupState) Ptr() *IsolationGroupState {
-	return &v
-}
 
-// Encode encodes IsolationGroupState directly to bytes.
-//
-//	sWriter := BinaryStreamer.Writer(writer)
-//
-//	var v IsolationGroupState
-//	return v.Encode(sWriter)
-func (v IsolationGroupState) Encode(sw stream.Writer) error {
-	return sw.WriteInt32(int32(v))
-}
-
-// ToWire translates IsolationGroupState into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// Enums are represented as 32-bit integers over the wire.
-func (v IsolationGroupState) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
+	return
 }
 
-// FromWire deserializes IsolationGroupState from its Thrift-level
-// representation.
-//
-//	x, err := binaryProtocol.Decode(reader, wire.TI32)
-//	if err != nil {
-//	    return IsolationGroupState(0), err
-//	}
-//
-//	var v IsolationGroupState
-//	if err := v.FromWire(x); err != nil {
-//	    return IsolationGroupState(0), err
-//	}
-//	return v, nil
-func (v *IsolationGroupState) FromWire(w wire.Value) error {
-	*v = (IsolationGroupState)(w.GetI32())
-	return nil
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *ListArchivedWorkflowExecutionsRequest) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
 }
 
-// Decode reads off the encoded IsolationGroupState directly off of the wire.
-//
-//	sReader := BinaryStreamer.Reader(reader)
-//
-//	var v IsolationGroupState
-//	if err := v.Decode(sReader); err != nil {
-//	    return IsolationGroupState(0), err
-//	}
-//	return v, nil
-func (v *IsolationGroupState) Decode(sr stream.Reader) error {
-	i, err := sr.ReadInt32()
-	if err != nil {
-		return err
+// GetQuery returns the value of Query if it is set or its
+// zero value if it is unset.
+func (v *ListArchivedWorkflowExecutionsRequest) GetQuery() (o string) {
+	if v != nil && v.Query != nil {
+		return *v.Query
 	}
-	*v = (IsolationGroupState)(i)
-	return nil
-}
 
-// String returns a readable string representation of IsolationGroupState.
-func (v IsolationGroupState) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "INVALID"
-	case 1:
-		return "HEALTHY"
-	case 2:
-		return "DRAINED"
-	}
-	return fmt.Sprintf("IsolationGroupState(%d)", w)
+	return
 }
 
-// Equals returns true if this IsolationGroupState value matches the provided
-// value.
-func (v IsolationGroupState) Equals(rhs IsolationGroupState) bool {
-	return v == rhs
+// IsSetQuery returns true if Query is not nil.
+func (v *ListArchivedWorkflowExecutionsRequest) IsSetQuery() bool {
+	return v != nil && v.Query != nil
 }
 
-// MarshalJSON serializes IsolationGroupState into JSON.
-//
-// If the enum value is recognized, its name is returned.
-// Otherwise, its integer value is returned.
-//
-// This implements json.Marshaler.
-func (v IsolationGroupState) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"INVALID\""), nil
-	case 1:
-		return ([]byte)("\"HEALTHY\""), nil
-	case 2:
-		return ([]byte)("\"DRAINED\""), nil
-	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+type ListArchivedWorkflowExecutionsResponse struct {
+	Executions    []*WorkflowExecutionInfo `json:"executions,omitempty"`
+	NextPageToken []byte                   `json:"nextPageToken,omitempty"`
 }
 
-// UnmarshalJSON attempts to decode IsolationGroupState from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *IsolationGroupState) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
-	}
+type _List_WorkflowExecutionInfo_ValueList []*WorkflowExecutionInfo
 
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
+func (v _List_WorkflowExecutionInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*WorkflowExecutionInfo', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
 		if err != nil {
 			return err
 		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "IsolationGroupState")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "IsolationGroupState")
+		err = f(w)
+		if err != nil {
+			return err
 		}
-		*v = (IsolationGroupState)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "IsolationGroupState")
 	}
+	return nil
 }
 
-type LimitExceededError struct {
-	Message string `json:"message,required"`
+func (v _List_WorkflowExecutionInfo_ValueList) Size() int {
+	return len(v)
 }
 
-// ToWire translates a LimitExceededError struct into a Thrift-level intermediate
+func (_List_WorkflowExecutionInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_WorkflowExecutionInfo_ValueList) Close() {}
+
+// ToWire translates a ListArchivedWorkflowExecutionsResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -54929,29 +57105,57 @@ type LimitExceededError struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //		return err
 //	}
-func (v *LimitExceededError) ToWire() (wire.Value, error) {
+func (v *ListArchivedWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Message), error(nil)
-	if err != nil {
-		return w, err
+	if v.Executions != nil {
+		w, err = wire.NewValueList(_List_WorkflowExecutionInfo_ValueList(v.Executions)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a LimitExceededError struct from its Thrift-level
+func _List_WorkflowExecutionInfo_Read(l wire.ValueList) ([]*WorkflowExecutionInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*WorkflowExecutionInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _WorkflowExecutionInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ListArchivedWorkflowExecutionsResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a LimitExceededError struct
+// An error is returned if we were unable to build a ListArchivedWorkflowExecutionsResponse struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -54959,66 +57163,131 @@ func (v *LimitExceededError) ToWire() (wire.Value, error) {
 //		return nil, err
 //	}
 //
-//	var v LimitExceededError
+//	var v ListArchivedWorkflowExecutionsResponse
 //	if err := v.FromWire(x); err != nil {
 //		return nil, err
 //	}
 //	return &v, nil
-func (v *LimitExceededError) FromWire(w wire.Value) error {
+func (v *ListArchivedWorkflowExecutionsResponse) FromWire(w wire.Value) error {
 	var err error
 
-	messageIsSet := false
-
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 1:
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Executions, err = _List_WorkflowExecutionInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
 			if field.Value.Type() == wire.TBinary {
-				v.Message, err = field.Value.GetString(), error(nil)
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
-				messageIsSet = true
+
 			}
 		}
 	}
 
-	if !messageIsSet {
-		return errors.New("field Message of LimitExceededError is required")
+	return nil
+}
+
+func _List_WorkflowExecutionInfo_Encode(val []*WorkflowExecutionInfo, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
 	}
 
-	return nil
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*WorkflowExecutionInfo', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
 }
 
-// Encode serializes a LimitExceededError struct directly into bytes, without going
+// Encode serializes a ListArchivedWorkflowExecutionsResponse struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a LimitExceededError struct could not be encoded.
-func (v *LimitExceededError) Encode(sw stream.Writer) error {
+// An error is returned if a ListArchivedWorkflowExecutionsResponse struct could not be encoded.
+func (v *ListArchivedWorkflowExecutionsResponse) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TBinary}); err != nil {
-		return err
-	}
-	if err := sw.WriteString(v.Message); err != nil {
-		return err
+	if v.Executions != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_WorkflowExecutionInfo_Encode(v.Executions, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
-	if err := sw.WriteFieldEnd(); err != nil {
-		return err
+
+	if v.NextPageToken != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.NextPageToken); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a LimitExceededError struct directly from its Thrift-level
+func _List_WorkflowExecutionInfo_Decode(sr stream.Reader) ([]*WorkflowExecutionInfo, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*WorkflowExecutionInfo, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _WorkflowExecutionInfo_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a ListArchivedWorkflowExecutionsResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a LimitExceededError struct could not be generated from the wire
+// An error is returned if a ListArchivedWorkflowExecutionsResponse struct could not be generated from the wire
 // representation.
-func (v *LimitExceededError) Decode(sr stream.Reader) error {
-
-	messageIsSet := false
+func (v *ListArchivedWorkflowExecutionsResponse) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -55031,12 +57300,18 @@ func (v *LimitExceededError) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 1 && fh.Type == wire.TBinary:
-			v.Message, err = sr.ReadString()
+		case fh.ID == 10 && fh.Type == wire.TList:
+			v.Executions, err = _List_WorkflowExecutionInfo_Decode(sr)
 			if err != nil {
 				return err
 			}
-			messageIsSet = true
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			v.NextPageToken, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -55056,82 +57331,132 @@ func (v *LimitExceededError) Decode(sr stream.Reader) error {
 		return err
 	}
 
-	if !messageIsSet {
-		return errors.New("field Message of LimitExceededError is required")
-	}
-
 	return nil
 }
 
-// String returns a readable string representation of a LimitExceededError
+// String returns a readable string representation of a ListArchivedWorkflowExecutionsResponse
 // struct.
-func (v *LimitExceededError) String() string {
+func (v *ListArchivedWorkflowExecutionsResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
-	fields[i] = fmt.Sprintf("Message: %v", v.Message)
-	i++
+	if v.Executions != nil {
+		fields[i] = fmt.Sprintf("Executions: %v", v.Executions)
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
 
-	return fmt.Sprintf("LimitExceededError{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ListArchivedWorkflowExecutionsResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// ErrorName is the name of this type as defined in the Thrift
-// file.
-func (*LimitExceededError) ErrorName() string {
-	return "LimitExceededError"
+func _List_WorkflowExecutionInfo_Equals(lhs, rhs []*WorkflowExecutionInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
 }
 
-// Equals returns true if all the fields of this LimitExceededError match the
-// provided LimitExceededError.
+// Equals returns true if all the fields of this ListArchivedWorkflowExecutionsResponse match the
+// provided ListArchivedWorkflowExecutionsResponse.
 //
 // This function performs a deep comparison.
-func (v *LimitExceededError) Equals(rhs *LimitExceededError) bool {
+func (v *ListArchivedWorkflowExecutionsResponse) Equals(rhs *ListArchivedWorkflowExecutionsResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !(v.Message == rhs.Message) {
+	if !((v.Executions == nil && rhs.Executions == nil) || (v.Executions != nil && rhs.Executions != nil && _List_WorkflowExecutionInfo_Equals(v.Executions, rhs.Executions))) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
 		return false
 	}
 
 	return true
 }
 
+type _List_WorkflowExecutionInfo_Zapper []*WorkflowExecutionInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_WorkflowExecutionInfo_Zapper.
+func (l _List_WorkflowExecutionInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of LimitExceededError.
-func (v *LimitExceededError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ListArchivedWorkflowExecutionsResponse.
+func (v *ListArchivedWorkflowExecutionsResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	enc.AddString("message", v.Message)
+	if v.Executions != nil {
+		err = multierr.Append(err, enc.AddArray("executions", (_List_WorkflowExecutionInfo_Zapper)(v.Executions)))
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
 	return err
 }
 
-// GetMessage returns the value of Message if it is set or its
+// GetExecutions returns the value of Executions if it is set or its
 // zero value if it is unset.
-func (v *LimitExceededError) GetMessage() (o string) {
-	if v != nil {
-		o = v.Message
+func (v *ListArchivedWorkflowExecutionsResponse) GetExecutions() (o []*WorkflowExecutionInfo) {
+	if v != nil && v.Executions != nil {
+		return v.Executions
 	}
+
 	return
 }
 
-func (v *LimitExceededError) Error() string {
-	return v.String()
+// IsSetExecutions returns true if Executions is not nil.
+func (v *ListArchivedWorkflowExecutionsResponse) IsSetExecutions() bool {
+	return v != nil && v.Executions != nil
 }
 
-type ListArchivedWorkflowExecutionsRequest struct {
-	Domain        *string `json:"domain,omitempty"`
-	PageSize      *int32  `json:"pageSize,omitempty"`
-	NextPageToken []byte  `json:"nextPageToken,omitempty"`
-	Query         *string `json:"query,omitempty"`
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *ListArchivedWorkflowExecutionsResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
 }
 
-// ToWire translates a ListArchivedWorkflowExecutionsRequest struct into a Thrift-level intermediate
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *ListArchivedWorkflowExecutionsResponse) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+type ListClosedWorkflowExecutionsRequest struct {
+	Domain          *string                       `json:"domain,omitempty"`
+	MaximumPageSize *int32                        `json:"maximumPageSize,omitempty"`
+	NextPageToken   []byte                        `json:"nextPageToken,omitempty"`
+	StartTimeFilter *StartTimeFilter              `json:"StartTimeFilter,omitempty"`
+	ExecutionFilter *WorkflowExecutionFilter      `json:"executionFilter,omitempty"`
+	TypeFilter      *WorkflowTypeFilter           `json:"typeFilter,omitempty"`
+	StatusFilter    *WorkflowExecutionCloseStatus `json:"statusFilter,omitempty"`
+}
+
+// ToWire translates a ListClosedWorkflowExecutionsRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -55146,9 +57471,9 @@ type ListArchivedWorkflowExecutionsRequest struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //		return err
 //	}
-func (v *ListArchivedWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
+func (v *ListClosedWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -55162,8 +57487,8 @@ func (v *ListArchivedWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.PageSize != nil {
-		w, err = wire.NewValueI32(*(v.PageSize)), error(nil)
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -55178,23 +57503,71 @@ func (v *ListArchivedWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.Query != nil {
-		w, err = wire.NewValueString(*(v.Query)), error(nil)
+	if v.StartTimeFilter != nil {
+		w, err = v.StartTimeFilter.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.ExecutionFilter != nil {
+		w, err = v.ExecutionFilter.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.TypeFilter != nil {
+		w, err = v.TypeFilter.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.StatusFilter != nil {
+		w, err = v.StatusFilter.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ListArchivedWorkflowExecutionsRequest struct from its Thrift-level
+func _StartTimeFilter_Read(w wire.Value) (*StartTimeFilter, error) {
+	var v StartTimeFilter
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionFilter_Read(w wire.Value) (*WorkflowExecutionFilter, error) {
+	var v WorkflowExecutionFilter
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowTypeFilter_Read(w wire.Value) (*WorkflowTypeFilter, error) {
+	var v WorkflowTypeFilter
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionCloseStatus_Read(w wire.Value) (WorkflowExecutionCloseStatus, error) {
+	var v WorkflowExecutionCloseStatus
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a ListClosedWorkflowExecutionsRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ListArchivedWorkflowExecutionsRequest struct
+// An error is returned if we were unable to build a ListClosedWorkflowExecutionsRequest struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -55202,12 +57575,12 @@ func (v *ListArchivedWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 //		return nil, err
 //	}
 //
-//	var v ListArchivedWorkflowExecutionsRequest
+//	var v ListClosedWorkflowExecutionsRequest
 //	if err := v.FromWire(x); err != nil {
 //		return nil, err
 //	}
 //	return &v, nil
-func (v *ListArchivedWorkflowExecutionsRequest) FromWire(w wire.Value) error {
+func (v *ListClosedWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -55226,7 +57599,7 @@ func (v *ListArchivedWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI32 {
 				var x int32
 				x, err = field.Value.GetI32(), error(nil)
-				v.PageSize = &x
+				v.MaximumPageSize = &x
 				if err != nil {
 					return err
 				}
@@ -55241,10 +57614,34 @@ func (v *ListArchivedWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Query = &x
+			if field.Value.Type() == wire.TStruct {
+				v.StartTimeFilter, err = _StartTimeFilter_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.ExecutionFilter, err = _WorkflowExecutionFilter_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.TypeFilter, err = _WorkflowTypeFilter_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x WorkflowExecutionCloseStatus
+				x, err = _WorkflowExecutionCloseStatus_Read(field.Value)
+				v.StatusFilter = &x
 				if err != nil {
 					return err
 				}
@@ -55256,11 +57653,11 @@ func (v *ListArchivedWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a ListArchivedWorkflowExecutionsRequest struct directly into bytes, without going
+// Encode serializes a ListClosedWorkflowExecutionsRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ListArchivedWorkflowExecutionsRequest struct could not be encoded.
-func (v *ListArchivedWorkflowExecutionsRequest) Encode(sw stream.Writer) error {
+// An error is returned if a ListClosedWorkflowExecutionsRequest struct could not be encoded.
+func (v *ListClosedWorkflowExecutionsRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -55277,11 +57674,11 @@ func (v *ListArchivedWorkflowExecutionsRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.PageSize != nil {
+	if v.MaximumPageSize != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt32(*(v.PageSize)); err != nil {
+		if err := sw.WriteInt32(*(v.MaximumPageSize)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -55301,11 +57698,47 @@ func (v *ListArchivedWorkflowExecutionsRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Query != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+	if v.StartTimeFilter != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Query)); err != nil {
+		if err := v.StartTimeFilter.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ExecutionFilter != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ExecutionFilter.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TypeFilter != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.TypeFilter.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StatusFilter != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.StatusFilter.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -55316,12 +57749,36 @@ func (v *ListArchivedWorkflowExecutionsRequest) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a ListArchivedWorkflowExecutionsRequest struct directly from its Thrift-level
+func _StartTimeFilter_Decode(sr stream.Reader) (*StartTimeFilter, error) {
+	var v StartTimeFilter
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _WorkflowExecutionFilter_Decode(sr stream.Reader) (*WorkflowExecutionFilter, error) {
+	var v WorkflowExecutionFilter
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _WorkflowTypeFilter_Decode(sr stream.Reader) (*WorkflowTypeFilter, error) {
+	var v WorkflowTypeFilter
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _WorkflowExecutionCloseStatus_Decode(sr stream.Reader) (WorkflowExecutionCloseStatus, error) {
+	var v WorkflowExecutionCloseStatus
+	err := v.Decode(sr)
+	return v, err
+}
+
+// Decode deserializes a ListClosedWorkflowExecutionsRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ListArchivedWorkflowExecutionsRequest struct could not be generated from the wire
+// An error is returned if a ListClosedWorkflowExecutionsRequest struct could not be generated from the wire
 // representation.
-func (v *ListArchivedWorkflowExecutionsRequest) Decode(sr stream.Reader) error {
+func (v *ListClosedWorkflowExecutionsRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -55345,7 +57802,7 @@ func (v *ListArchivedWorkflowExecutionsRequest) Decode(sr stream.Reader) error {
 		case fh.ID == 20 && fh.Type == wire.TI32:
 			var x int32
 			x, err = sr.ReadInt32()
-			v.PageSize = &x
+			v.MaximumPageSize = &x
 			if err != nil {
 				return err
 			}
@@ -55356,10 +57813,28 @@ func (v *ListArchivedWorkflowExecutionsRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
-		case fh.ID == 40 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Query = &x
+		case fh.ID == 40 && fh.Type == wire.TStruct:
+			v.StartTimeFilter, err = _StartTimeFilter_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TStruct:
+			v.ExecutionFilter, err = _WorkflowExecutionFilter_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TStruct:
+			v.TypeFilter, err = _WorkflowTypeFilter_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 70 && fh.Type == wire.TI32:
+			var x WorkflowExecutionCloseStatus
+			x, err = _WorkflowExecutionCloseStatus_Decode(sr)
+			v.StatusFilter = &x
 			if err != nil {
 				return err
 			}
@@ -55386,40 +57861,62 @@ func (v *ListArchivedWorkflowExecutionsRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a ListArchivedWorkflowExecutionsRequest
+// String returns a readable string representation of a ListClosedWorkflowExecutionsRequest
 // struct.
-func (v *ListArchivedWorkflowExecutionsRequest) String() string {
+func (v *ListClosedWorkflowExecutionsRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [7]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.PageSize != nil {
-		fields[i] = fmt.Sprintf("PageSize: %v", *(v.PageSize))
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
 		i++
 	}
 	if v.NextPageToken != nil {
 		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
 		i++
 	}
-	if v.Query != nil {
-		fields[i] = fmt.Sprintf("Query: %v", *(v.Query))
+	if v.StartTimeFilter != nil {
+		fields[i] = fmt.Sprintf("StartTimeFilter: %v", v.StartTimeFilter)
+		i++
+	}
+	if v.ExecutionFilter != nil {
+		fields[i] = fmt.Sprintf("ExecutionFilter: %v", v.ExecutionFilter)
+		i++
+	}
+	if v.TypeFilter != nil {
+		fields[i] = fmt.Sprintf("TypeFilter: %v", v.TypeFilter)
+		i++
+	}
+	if v.StatusFilter != nil {
+		fields[i] = fmt.Sprintf("StatusFilter: %v", *(v.StatusFilter))
 		i++
 	}
 
-	return fmt.Sprintf("ListArchivedWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ListClosedWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ListArchivedWorkflowExecutionsRequest match the
-// provided ListArchivedWorkflowExecutionsRequest.
+func _WorkflowExecutionCloseStatus_EqualsPtr(lhs, rhs *WorkflowExecutionCloseStatus) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this ListClosedWorkflowExecutionsRequest match the
+// provided ListClosedWorkflowExecutionsRequest.
 //
 // This function performs a deep comparison.
-func (v *ListArchivedWorkflowExecutionsRequest) Equals(rhs *ListArchivedWorkflowExecutionsRequest) bool {
+func (v *ListClosedWorkflowExecutionsRequest) Equals(rhs *ListClosedWorkflowExecutionsRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -55428,13 +57925,22 @@ func (v *ListArchivedWorkflowExecutionsRequest) Equals(rhs *ListArchivedWorkflow
 	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.PageSize, rhs.PageSize) {
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
 		return false
 	}
 	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Query, rhs.Query) {
+	if !((v.StartTimeFilter == nil && rhs.StartTimeFilter == nil) || (v.StartTimeFilter != nil && rhs.StartTimeFilter != nil && v.StartTimeFilter.Equals(rhs.StartTimeFilter))) {
+		return false
+	}
+	if !((v.ExecutionFilter == nil && rhs.ExecutionFilter == nil) || (v.ExecutionFilter != nil && rhs.ExecutionFilter != nil && v.ExecutionFilter.Equals(rhs.ExecutionFilter))) {
+		return false
+	}
+	if !((v.TypeFilter == nil && rhs.TypeFilter == nil) || (v.TypeFilter != nil && rhs.TypeFilter != nil && v.TypeFilter.Equals(rhs.TypeFilter))) {
+		return false
+	}
+	if !_WorkflowExecutionCloseStatus_EqualsPtr(v.StatusFilter, rhs.StatusFilter) {
 		return false
 	}
 
@@ -55442,29 +57948,38 @@ func (v *ListArchivedWorkflowExecutionsRequest) Equals(rhs *ListArchivedWorkflow
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ListArchivedWorkflowExecutionsRequest.
-func (v *ListArchivedWorkflowExecutionsRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ListClosedWorkflowExecutionsRequest.
+func (v *ListClosedWorkflowExecutionsRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.Domain != nil {
 		enc.AddString("domain", *v.Domain)
 	}
-	if v.PageSize != nil {
-		enc.AddInt32("pageSize", *v.PageSize)
+	if v.MaximumPageSize != nil {
+		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	if v.StartTimeFilter != nil {
+		err = multierr.Append(err, enc.AddObject("StartTimeFilter", v.StartTimeFilter))
 	}
-	if v.NextPageToken != nil {
-		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	if v.ExecutionFilter != nil {
+		err = multierr.Append(err, enc.AddObject("executionFilter", v.ExecutionFilter))
 	}
-	if v.Query != nil {
-		enc.AddString("query", *v.Query)
+	if v.TypeFilter != nil {
+		err = multierr.Append(err, enc.AddObject("typeFilter", v.TypeFilter))
+	}
+	if v.StatusFilter != nil {
+		err = multierr.Append(err, enc.AddObject("statusFilter", *v.StatusFilter))
 	}
 	return err
 }
 
 // GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *ListArchivedWorkflowExecutionsRequest) GetDomain() (o string) {
+func (v *ListClosedWorkflowExecutionsRequest) GetDomain() (o string) {
 	if v != nil && v.Domain != nil {
 		return *v.Domain
 	}
@@ -55473,28 +57988,28 @@ func (v *ListArchivedWorkflowExecutionsRequest) GetDomain() (o string) {
 }
 
 // IsSetDomain returns true if Domain is not nil.
-func (v *ListArchivedWorkflowExecutionsRequest) IsSetDomain() bool {
+func (v *ListClosedWorkflowExecutionsRequest) IsSetDomain() bool {
 	return v != nil && v.Domain != nil
 }
 
-// GetPageSize returns the value of PageSize if it is set or its
+// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
 // zero value if it is unset.
-func (v *ListArchivedWorkflowExecutionsRequest) GetPageSize() (o int32) {
-	if v != nil && v.PageSize != nil {
-		return *v.PageSize
+func (v *ListClosedWorkflowExecutionsRequest) GetMaximumPageSize() (o int32) {
+	if v != nil && v.MaximumPageSize != nil {
+		return *v.MaximumPageSize
 	}
 
 	return
 }
 
-// IsSetPageSize returns true if PageSize is not nil.
-func (v *ListArchivedWorkflowExecutionsRequest) IsSetPageSize() bool {
-	return v != nil && v.PageSize != nil
+// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
+func (v *ListClosedWorkflowExecutionsRequest) IsSetMaximumPageSize() bool {
+	return v != nil && v.MaximumPageSize != nil
 }
 
 // GetNextPageToken returns the value of NextPageToken if it is set or its
 // zero value if it is unset.
-func (v *ListArchivedWorkflowExecutionsRequest) GetNextPageToken() (o []byte) {
+func (v *ListClosedWorkflowExecutionsRequest) GetNextPageToken() (o []byte) {
 	if v != nil && v.NextPageToken != nil {
 		return v.NextPageToken
 	}
@@ -55503,60 +58018,76 @@ func (v *ListArchivedWorkflowExecutionsRequest) GetNextPageToken() (o []byte) {
 }
 
 // IsSetNextPageToken returns true if NextPageToken is not nil.
-func (v *ListArchivedWorkflowExecutionsRequest) IsSetNextPageToken() bool {
+func (v *ListClosedWorkflowExecutionsRequest) IsSetNextPageToken() bool {
 	return v != nil && v.NextPageToken != nil
 }
 
-// GetQuery returns the value of Query if it is set or its
+// GetStartTimeFilter returns the value of StartTimeFilter if it is set or its
 // zero value if it is unset.
-func (v *ListArchivedWorkflowExecutionsRequest) GetQuery() (o string) {
-	if v != nil && v.Query != nil {
-		return *v.Query
+func (v *ListClosedWorkflowExecutionsRequest) GetStartTimeFilter() (o *StartTimeFilter) {
+	if v != nil && v.StartTimeFilter != nil {
+		return v.StartTimeFilter
 	}
 
 	return
 }
 
-// IsSetQuery returns true if Query is not nil.
-func (v *ListArchivedWorkflowExecutionsRequest) IsSetQuery() bool {
-	return v != nil && v.Query != nil
+// IsSetStartTimeFilter returns true if StartTimeFilter is not nil.
+func (v *ListClosedWorkflowExecutionsRequest) IsSetStartTimeFilter() bool {
+	return v != nil && v.StartTimeFilter != nil
 }
 
-type ListArchivedWorkflowExecutionsResponse struct {
-	Executions    []*WorkflowExecutionInfo `json:"executions,omitempty"`
-	NextPageToken []byte                   `json:"nextPageToken,omitempty"`
+// GetExecutionFilter returns the value of ExecutionFilter if it is set or its
+// zero value if it is unset.
+func (v *ListClosedWorkflowExecutionsRequest) GetExecutionFilter() (o *WorkflowExecutionFilter) {
+	if v != nil && v.ExecutionFilter != nil {
+		return v.ExecutionFilter
+	}
+
+	return
 }
 
-type _List_WorkflowExecutionInfo_ValueList []*WorkflowExecutionInfo
+// IsSetExecutionFilter returns true if ExecutionFilter is not nil.
+func (v *ListClosedWorkflowExecutionsRequest) IsSetExecutionFilter() bool {
+	return v != nil && v.ExecutionFilter != nil
+}
 
-func (v _List_WorkflowExecutionInfo_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid list '[]*WorkflowExecutionInfo', index [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
+// GetTypeFilter returns the value of TypeFilter if it is set or its
+// zero value if it is unset.
+func (v *ListClosedWorkflowExecutionsRequest) GetTypeFilter() (o *WorkflowTypeFilter) {
+	if v != nil && v.TypeFilter != nil {
+		return v.TypeFilter
 	}
-	return nil
+
+	return
 }
 
-func (v _List_WorkflowExecutionInfo_ValueList) Size() int {
-	return len(v)
+// IsSetTypeFilter returns true if TypeFilter is not nil.
+func (v *ListClosedWorkflowExecutionsRequest) IsSetTypeFilter() bool {
+	return v != nil && v.TypeFilter != nil
 }
 
-func (_List_WorkflowExecutionInfo_ValueList) ValueType() wire.Type {
-	return wire.TStruct
+// GetStatusFilter returns the value of StatusFilter if it is set or its
+// zero value if it is unset.
+func (v *ListClosedWorkflowExecutionsRequest) GetStatusFilter() (o WorkflowExecutionCloseStatus) {
+	if v != nil && v.StatusFilter != nil {
+		return *v.StatusFilter
+	}
+
+	return
 }
 
-func (_List_WorkflowExecutionInfo_ValueList) Close() {}
+// IsSetStatusFilter returns true if StatusFilter is not nil.
+func (v *ListClosedWorkflowExecutionsRequest) IsSetStatusFilter() bool {
+	return v != nil && v.StatusFilter != nil
+}
 
-// ToWire translates a ListArchivedWorkflowExecutionsResponse struct into a Thrift-level intermediate
+type ListClosedWorkflowExecutionsResponse struct {
+	Executions    []*WorkflowExecutionInfo `json:"executions,omitempty"`
+	NextPageToken []byte                   `json:"nextPageToken,omitempty"`
+}
+
+// ToWire translates a ListClosedWorkflowExecutionsResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -55571,7 +58102,7 @@ func (_List_WorkflowExecutionInfo_ValueList) Close() {}
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //		return err
 //	}
-func (v *ListArchivedWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
+func (v *ListClosedWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -55599,29 +58130,11 @@ func (v *ListArchivedWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _List_WorkflowExecutionInfo_Read(l wire.ValueList) ([]*WorkflowExecutionInfo, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make([]*WorkflowExecutionInfo, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _WorkflowExecutionInfo_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
-// FromWire deserializes a ListArchivedWorkflowExecutionsResponse struct from its Thrift-level
+// FromWire deserializes a ListClosedWorkflowExecutionsResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ListArchivedWorkflowExecutionsResponse struct
+// An error is returned if we were unable to build a ListClosedWorkflowExecutionsResponse struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -55629,12 +58142,12 @@ func _List_WorkflowExecutionInfo_Read(l wire.ValueList) ([]*WorkflowExecutionInf
 //		return nil, err
 //	}
 //
-//	var v ListArchivedWorkflowExecutionsResponse
+//	var v ListClosedWorkflowExecutionsResponse
 //	if err := v.FromWire(x); err != nil {
 //		return nil, err
 //	}
 //	return &v, nil
-func (v *ListArchivedWorkflowExecutionsResponse) FromWire(w wire.Value) error {
+func (v *ListClosedWorkflowExecutionsResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -55661,32 +58174,11 @@ func (v *ListArchivedWorkflowExecutionsResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-func _List_WorkflowExecutionInfo_Encode(val []*WorkflowExecutionInfo, sw stream.Writer) error {
-
-	lh := stream.ListHeader{
-		Type:   wire.TStruct,
-		Length: len(val),
-	}
-	if err := sw.WriteListBegin(lh); err != nil {
-		return err
-	}
-
-	for i, v := range val {
-		if v == nil {
-			return fmt.Errorf("invalid list '[]*WorkflowExecutionInfo', index [%v]: value is nil", i)
-		}
-		if err := v.Encode(sw); err != nil {
-			return err
-		}
-	}
-	return sw.WriteListEnd()
-}
-
-// Encode serializes a ListArchivedWorkflowExecutionsResponse struct directly into bytes, without going
+// Encode serializes a ListClosedWorkflowExecutionsResponse struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ListArchivedWorkflowExecutionsResponse struct could not be encoded.
-func (v *ListArchivedWorkflowExecutionsResponse) Encode(sw stream.Writer) error {
+// An error is returned if a ListClosedWorkflowExecutionsResponse struct could not be encoded.
+func (v *ListClosedWorkflowExecutionsResponse) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -55718,42 +58210,12 @@ func (v *ListArchivedWorkflowExecutionsResponse) Encode(sw stream.Writer) error
 	return sw.WriteStructEnd()
 }
 
-func _List_WorkflowExecutionInfo_Decode(sr stream.Reader) ([]*WorkflowExecutionInfo, error) {
-	lh, err := sr.ReadListBegin()
-	if err != nil {
-		return nil, err
-	}
-
-	if lh.Type != wire.TStruct {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
-		}
-		return nil, sr.ReadListEnd()
-	}
-
-	o := make([]*WorkflowExecutionInfo, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := _WorkflowExecutionInfo_Decode(sr)
-		if err != nil {
-			return nil, err
-		}
-		o = append(o, v)
-	}
-
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
-	}
-	return o, err
-}
-
-// Decode deserializes a ListArchivedWorkflowExecutionsResponse struct directly from its Thrift-level
+// Decode deserializes a ListClosedWorkflowExecutionsResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ListArchivedWorkflowExecutionsResponse struct could not be generated from the wire
+// An error is returned if a ListClosedWorkflowExecutionsResponse struct could not be generated from the wire
 // representation.
-func (v *ListArchivedWorkflowExecutionsResponse) Decode(sr stream.Reader) error {
+func (v *ListClosedWorkflowExecutionsResponse) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -55800,9 +58262,9 @@ func (v *ListArchivedWorkflowExecutionsResponse) Decode(sr stream.Reader) error
 	return nil
 }
 
-// String returns a readable string representation of a ListArchivedWorkflowExecutionsResponse
+// String returns a readable string representation of a ListClosedWorkflowExecutionsResponse
 // struct.
-func (v *ListArchivedWorkflowExecutionsResponse) String() string {
+func (v *ListClosedWorkflowExecutionsResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -55818,29 +58280,14 @@ func (v *ListArchivedWorkflowExecutionsResponse) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("ListArchivedWorkflowExecutionsResponse{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _List_WorkflowExecutionInfo_Equals(lhs, rhs []*WorkflowExecutionInfo) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
-
-	return true
+	return fmt.Sprintf("ListClosedWorkflowExecutionsResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ListArchivedWorkflowExecutionsResponse match the
-// provided ListArchivedWorkflowExecutionsResponse.
+// Equals returns true if all the fields of this ListClosedWorkflowExecutionsResponse match the
+// provided ListClosedWorkflowExecutionsResponse.
 //
 // This function performs a deep comparison.
-func (v *ListArchivedWorkflowExecutionsResponse) Equals(rhs *ListArchivedWorkflowExecutionsResponse) bool {
+func (v *ListClosedWorkflowExecutionsResponse) Equals(rhs *ListClosedWorkflowExecutionsResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -55856,20 +58303,9 @@ func (v *ListArchivedWorkflowExecutionsResponse) Equals(rhs *ListArchivedWorkflo
 	return true
 }
 
-type _List_WorkflowExecutionInfo_Zapper []*WorkflowExecutionInfo
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_WorkflowExecutionInfo_Zapper.
-func (l _List_WorkflowExecutionInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ListArchivedWorkflowExecutionsResponse.
-func (v *ListArchivedWorkflowExecutionsResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ListClosedWorkflowExecutionsResponse.
+func (v *ListClosedWorkflowExecutionsResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -55884,7 +58320,7 @@ func (v *ListArchivedWorkflowExecutionsResponse) MarshalLogObject(enc zapcore.Ob
 
 // GetExecutions returns the value of Executions if it is set or its
 // zero value if it is unset.
-func (v *ListArchivedWorkflowExecutionsResponse) GetExecutions() (o []*WorkflowExecutionInfo) {
+func (v *ListClosedWorkflowExecutionsResponse) GetExecutions() (o []*WorkflowExecutionInfo) {
 	if v != nil && v.Executions != nil {
 		return v.Executions
 	}
@@ -55893,13 +58329,13 @@ func (v *ListArchivedWorkflowExecutionsResponse) GetExecutions() (o []*WorkflowE
 }
 
 // IsSetExecutions returns true if Executions is not nil.
-func (v *ListArchivedWorkflowExecutionsResponse) IsSetExecutions() bool {
+func (v *ListClosedWorkflowExecutionsResponse) IsSetExecutions() bool {
 	return v != nil && v.Executions != nil
 }
 
 // GetNextPageToken returns the value of NextPageToken if it is set or its
 // zero value if it is unset.
-func (v *ListArchivedWorkflowExecutionsResponse) GetNextPageToken() (o []byte) {
+func (v *ListClosedWorkflowExecutionsResponse) GetNextPageToken() (o []byte) {
 	if v != nil && v.NextPageToken != nil {
 		return v.NextPageToken
 	}
@@ -55908,21 +58344,16 @@ func (v *ListArchivedWorkflowExecutionsResponse) GetNextPageToken() (o []byte) {
 }
 
 // IsSetNextPageToken returns true if NextPageToken is not nil.
-func (v *ListArchivedWorkflowExecutionsResponse) IsSetNextPageToken() bool {
+func (v *ListClosedWorkflowExecutionsResponse) IsSetNextPageToken() bool {
 	return v != nil && v.NextPageToken != nil
 }
 
-type ListClosedWorkflowExecutionsRequest struct {
-	Domain          *string                       `json:"domain,omitempty"`
-	MaximumPageSize *int32                        `json:"maximumPageSize,omitempty"`
-	NextPageToken   []byte                        `json:"nextPageToken,omitempty"`
-	StartTimeFilter *StartTimeFilter              `json:"StartTimeFilter,omitempty"`
-	ExecutionFilter *WorkflowExecutionFilter      `json:"executionFilter,omitempty"`
-	TypeFilter      *WorkflowTypeFilter           `json:"typeFilter,omitempty"`
-	StatusFilter    *WorkflowExecutionCloseStatus `json:"statusFilter,omitempty"`
+type ListDomainChangesRequest struct {
+	Domain     *string            `json:"domain,omitempty"`
+	Pagination *PaginationOptions `json:"pagination,omitempty"`
 }
 
-// ToWire translates a ListClosedWorkflowExecutionsRequest struct into a Thrift-level intermediate
+// ToWire translates a ListDomainChangesRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -55937,9 +58368,9 @@ type ListClosedWorkflowExecutionsRequest struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //		return err
 //	}
-func (v *ListClosedWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
+func (v *ListDomainChangesRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -55953,87 +58384,29 @@ func (v *ListClosedWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.MaximumPageSize != nil {
-		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+	if v.Pagination != nil {
+		w, err = v.Pagination.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.StartTimeFilter != nil {
-		w, err = v.StartTimeFilter.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.ExecutionFilter != nil {
-		w, err = v.ExecutionFilter.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.TypeFilter != nil {
-		w, err = v.TypeFilter.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
-	if v.StatusFilter != nil {
-		w, err = v.StatusFilter.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _StartTimeFilter_Read(w wire.Value) (*StartTimeFilter, error) {
-	var v StartTimeFilter
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _WorkflowExecutionFilter_Read(w wire.Value) (*WorkflowExecutionFilter, error) {
-	var v WorkflowExecutionFilter
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _WorkflowTypeFilter_Read(w wire.Value) (*WorkflowTypeFilter, error) {
-	var v WorkflowTypeFilter
+func _PaginationOptions_Read(w wire.Value) (*PaginationOptions, error) {
+	var v PaginationOptions
 	err := v.FromWire(w)
 	return &v, err
 }
 
-func _WorkflowExecutionCloseStatus_Read(w wire.Value) (WorkflowExecutionCloseStatus, error) {
-	var v WorkflowExecutionCloseStatus
-	err := v.FromWire(w)
-	return v, err
-}
-
-// FromWire deserializes a ListClosedWorkflowExecutionsRequest struct from its Thrift-level
+// FromWire deserializes a ListDomainChangesRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ListClosedWorkflowExecutionsRequest struct
+// An error is returned if we were unable to build a ListDomainChangesRequest struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -56041,12 +58414,12 @@ func _WorkflowExecutionCloseStatus_Read(w wire.Value) (WorkflowExecutionCloseSta
 //		return nil, err
 //	}
 //
-//	var v ListClosedWorkflowExecutionsRequest
+//	var v ListDomainChangesRequest
 //	if err := v.FromWire(x); err != nil {
 //		return nil, err
 //	}
 //	return &v, nil
-func (v *ListClosedWorkflowExecutionsRequest) FromWire(w wire.Value) error {
+func (v *ListDomainChangesRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -56062,52 +58435,8 @@ func (v *ListClosedWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.MaximumPageSize = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
 			if field.Value.Type() == wire.TStruct {
-				v.StartTimeFilter, err = _StartTimeFilter_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TStruct {
-				v.ExecutionFilter, err = _WorkflowExecutionFilter_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TStruct {
-				v.TypeFilter, err = _WorkflowTypeFilter_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TI32 {
-				var x WorkflowExecutionCloseStatus
-				x, err = _WorkflowExecutionCloseStatus_Read(field.Value)
-				v.StatusFilter = &x
+				v.Pagination, err = _PaginationOptions_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -56119,11 +58448,11 @@ func (v *ListClosedWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a ListClosedWorkflowExecutionsRequest struct directly into bytes, without going
+// Encode serializes a ListDomainChangesRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ListClosedWorkflowExecutionsRequest struct could not be encoded.
-func (v *ListClosedWorkflowExecutionsRequest) Encode(sw stream.Writer) error {
+// An error is returned if a ListDomainChangesRequest struct could not be encoded.
+func (v *ListDomainChangesRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -56140,71 +58469,11 @@ func (v *ListClosedWorkflowExecutionsRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.MaximumPageSize != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.MaximumPageSize)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.NextPageToken != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteBinary(v.NextPageToken); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.StartTimeFilter != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.StartTimeFilter.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.ExecutionFilter != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.ExecutionFilter.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.TypeFilter != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.TypeFilter.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.StatusFilter != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TI32}); err != nil {
+	if v.Pagination != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.StatusFilter.Encode(sw); err != nil {
+		if err := v.Pagination.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -56215,36 +58484,18 @@ func (v *ListClosedWorkflowExecutionsRequest) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _StartTimeFilter_Decode(sr stream.Reader) (*StartTimeFilter, error) {
-	var v StartTimeFilter
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _WorkflowExecutionFilter_Decode(sr stream.Reader) (*WorkflowExecutionFilter, error) {
-	var v WorkflowExecutionFilter
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _WorkflowTypeFilter_Decode(sr stream.Reader) (*WorkflowTypeFilter, error) {
-	var v WorkflowTypeFilter
+func _PaginationOptions_Decode(sr stream.Reader) (*PaginationOptions, error) {
+	var v PaginationOptions
 	err := v.Decode(sr)
 	return &v, err
 }
 
-func _WorkflowExecutionCloseStatus_Decode(sr stream.Reader) (WorkflowExecutionCloseStatus, error) {
-	var v WorkflowExecutionCloseStatus
-	err := v.Decode(sr)
-	return v, err
-}
-
-// Decode deserializes a ListClosedWorkflowExecutionsRequest struct directly from its Thrift-level
+// Decode deserializes a ListDomainChangesRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ListClosedWorkflowExecutionsRequest struct could not be generated from the wire
+// An error is returned if a ListDomainChangesRequest struct could not be generated from the wire
 // representation.
-func (v *ListClosedWorkflowExecutionsRequest) Decode(sr stream.Reader) error {
+func (v *ListDomainChangesRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -56265,42 +58516,8 @@ func (v *ListClosedWorkflowExecutionsRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.MaximumPageSize = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TBinary:
-			v.NextPageToken, err = sr.ReadBinary()
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 40 && fh.Type == wire.TStruct:
-			v.StartTimeFilter, err = _StartTimeFilter_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 50 && fh.Type == wire.TStruct:
-			v.ExecutionFilter, err = _WorkflowExecutionFilter_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 60 && fh.Type == wire.TStruct:
-			v.TypeFilter, err = _WorkflowTypeFilter_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 70 && fh.Type == wire.TI32:
-			var x WorkflowExecutionCloseStatus
-			x, err = _WorkflowExecutionCloseStatus_Decode(sr)
-			v.StatusFilter = &x
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.Pagination, err = _PaginationOptions_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -56327,62 +58544,32 @@ func (v *ListClosedWorkflowExecutionsRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a ListClosedWorkflowExecutionsRequest
+// String returns a readable string representation of a ListDomainChangesRequest
 // struct.
-func (v *ListClosedWorkflowExecutionsRequest) String() string {
+func (v *ListDomainChangesRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [2]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.MaximumPageSize != nil {
-		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
-		i++
-	}
-	if v.NextPageToken != nil {
-		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
-		i++
-	}
-	if v.StartTimeFilter != nil {
-		fields[i] = fmt.Sprintf("StartTimeFilter: %v", v.StartTimeFilter)
-		i++
-	}
-	if v.ExecutionFilter != nil {
-		fields[i] = fmt.Sprintf("ExecutionFilter: %v", v.ExecutionFilter)
-		i++
-	}
-	if v.TypeFilter != nil {
-		fields[i] = fmt.Sprintf("TypeFilter: %v", v.TypeFilter)
-		i++
-	}
-	if v.StatusFilter != nil {
-		fields[i] = fmt.Sprintf("StatusFilter: %v", *(v.StatusFilter))
+	if v.Pagination != nil {
+		fields[i] = fmt.Sprintf("Pagination: %v", v.Pagination)
 		i++
 	}
 
-	return fmt.Sprintf("ListClosedWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _WorkflowExecutionCloseStatus_EqualsPtr(lhs, rhs *WorkflowExecutionCloseStatus) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("ListDomainChangesRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ListClosedWorkflowExecutionsRequest match the
-// provided ListClosedWorkflowExecutionsRequest.
+// Equals returns true if all the fields of this ListDomainChangesRequest match the
+// provided ListDomainChangesRequest.
 //
 // This function performs a deep comparison.
-func (v *ListClosedWorkflowExecutionsRequest) Equals(rhs *ListClosedWorkflowExecutionsRequest) bool {
+func (v *ListDomainChangesRequest) Equals(rhs *ListDomainChangesRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -56391,22 +58578,7 @@ func (v *ListClosedWorkflowExecutionsRequest) Equals(rhs *ListClosedWorkflowExec
 	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
-		return false
-	}
-	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
-		return false
-	}
-	if !((v.StartTimeFilter == nil && rhs.StartTimeFilter == nil) || (v.StartTimeFilter != nil && rhs.StartTimeFilter != nil && v.StartTimeFilter.Equals(rhs.StartTimeFilter))) {
-		return false
-	}
-	if !((v.ExecutionFilter == nil && rhs.ExecutionFilter == nil) || (v.ExecutionFilter != nil && rhs.ExecutionFilter != nil && v.ExecutionFilter.Equals(rhs.ExecutionFilter))) {
-		return false
-	}
-	if !((v.TypeFilter == nil && rhs.TypeFilter == nil) || (v.TypeFilter != nil && rhs.TypeFilter != nil && v.TypeFilter.Equals(rhs.TypeFilter))) {
-		return false
-	}
-	if !_WorkflowExecutionCloseStatus_EqualsPtr(v.StatusFilter, rhs.StatusFilter) {
+	if !((v.Pagination == nil && rhs.Pagination == nil) || (v.Pagination != nil && rhs.Pagination != nil && v.Pagination.Equals(rhs.Pagination))) {
 		return false
 	}
 
@@ -56414,38 +58586,23 @@ func (v *ListClosedWorkflowExecutionsRequest) Equals(rhs *ListClosedWorkflowExec
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ListClosedWorkflowExecutionsRequest.
-func (v *ListClosedWorkflowExecutionsRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ListDomainChangesRequest.
+func (v *ListDomainChangesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.Domain != nil {
 		enc.AddString("domain", *v.Domain)
 	}
-	if v.MaximumPageSize != nil {
-		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
-	}
-	if v.NextPageToken != nil {
-		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
-	}
-	if v.StartTimeFilter != nil {
-		err = multierr.Append(err, enc.AddObject("StartTimeFilter", v.StartTimeFilter))
-	}
-	if v.ExecutionFilter != nil {
-		err = multierr.Append(err, enc.AddObject("executionFilter", v.ExecutionFilter))
-	}
-	if v.TypeFilter != nil {
-		err = multierr.Append(err, enc.AddObject("typeFilter", v.TypeFilter))
-	}
-	if v.StatusFilter != nil {
-		err = multierr.Append(err, enc.AddObject("statusFilter", *v.StatusFilter))
+	if v.Pagination != nil {
+		err = multierr.Append(err, enc.AddObject("pagination", v.Pagination))
 	}
 	return err
 }
 
 // GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *ListClosedWorkflowExecutionsRequest) GetDomain() (o string) {
+func (v *ListDomainChangesRequest) GetDomain() (o string) {
 	if v != nil && v.Domain != nil {
 		return *v.Domain
 	}
@@ -56454,106 +58611,60 @@ func (v *ListClosedWorkflowExecutionsRequest) GetDomain() (o string) {
 }
 
 // IsSetDomain returns true if Domain is not nil.
-func (v *ListClosedWorkflowExecutionsRequest) IsSetDomain() bool {
+func (v *ListDomainChangesRequest) IsSetDomain() bool {
 	return v != nil && v.Domain != nil
 }
 
-// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
-// zero value if it is unset.
-func (v *ListClosedWorkflowExecutionsRequest) GetMaximumPageSize() (o int32) {
-	if v != nil && v.MaximumPageSize != nil {
-		return *v.MaximumPageSize
-	}
-
-	return
-}
-
-// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
-func (v *ListClosedWorkflowExecutionsRequest) IsSetMaximumPageSize() bool {
-	return v != nil && v.MaximumPageSize != nil
-}
-
-// GetNextPageToken returns the value of NextPageToken if it is set or its
-// zero value if it is unset.
-func (v *ListClosedWorkflowExecutionsRequest) GetNextPageToken() (o []byte) {
-	if v != nil && v.NextPageToken != nil {
-		return v.NextPageToken
-	}
-
-	return
-}
-
-// IsSetNextPageToken returns true if NextPageToken is not nil.
-func (v *ListClosedWorkflowExecutionsRequest) IsSetNextPageToken() bool {
-	return v != nil && v.NextPageToken != nil
-}
-
-// GetStartTimeFilter returns the value of StartTimeFilter if it is set or its
+// GetPagination returns the value of Pagination if it is set or its
 // zero value if it is unset.
-func (v *ListClosedWorkflowExecutionsRequest) GetStartTimeFilter() (o *StartTimeFilter) {
-	if v != nil && v.StartTimeFilter != nil {
-		return v.StartTimeFilter
+func (v *ListDomainChangesRequest) GetPagination() (o *PaginationOptions) {
+	if v != nil && v.Pagination != nil {
+		return v.Pagination
 	}
 
 	return
 }
 
-// IsSetStartTimeFilter returns true if StartTimeFilter is not nil.
-func (v *ListClosedWorkflowExecutionsRequest) IsSetStartTimeFilter() bool {
-	return v != nil && v.StartTimeFilter != nil
+// IsSetPagination returns true if Pagination is not nil.
+func (v *ListDomainChangesRequest) IsSetPagination() bool {
+	return v != nil && v.Pagination != nil
 }
 
-// GetExecutionFilter returns the value of ExecutionFilter if it is set or its
-// zero value if it is unset.
-func (v *ListClosedWorkflowExecutionsRequest) GetExecutionFilter() (o *WorkflowExecutionFilter) {
-	if v != nil && v.ExecutionFilter != nil {
-		return v.ExecutionFilter
-	}
-
-	return
+type ListDomainChangesResponse struct {
+	DomainChanges []*DomainChange `json:"domainChanges,omitempty"`
+	NextPageToken []byte          `json:"nextPageToken,omitempty"`
 }
 
-// IsSetExecutionFilter returns true if ExecutionFilter is not nil.
-func (v *ListClosedWorkflowExecutionsRequest) IsSetExecutionFilter() bool {
-	return v != nil && v.ExecutionFilter != nil
-}
+type _List_DomainChange_ValueList []*DomainChange
 
-// GetTypeFilter returns the value of TypeFilter if it is set or its
-// zero value if it is unset.
-func (v *ListClosedWorkflowExecutionsRequest) GetTypeFilter() (o *WorkflowTypeFilter) {
-	if v != nil && v.TypeFilter != nil {
-		return v.TypeFilter
+func (v _List_DomainChange_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*DomainChange', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
 	}
-
-	return
-}
-
-// IsSetTypeFilter returns true if TypeFilter is not nil.
-func (v *ListClosedWorkflowExecutionsRequest) IsSetTypeFilter() bool {
-	return v != nil && v.TypeFilter != nil
+	return nil
 }
 
-// GetStatusFilter returns the value of StatusFilter if it is set or its
-// zero value if it is unset.
-func (v *ListClosedWorkflowExecutionsRequest) GetStatusFilter() (o WorkflowExecutionCloseStatus) {
-	if v != nil && v.StatusFilter != nil {
-		return *v.StatusFilter
-	}
-
-	return
+func (v _List_DomainChange_ValueList) Size() int {
+	return len(v)
 }
 
-// IsSetStatusFilter returns true if StatusFilter is not nil.
-func (v *ListClosedWorkflowExecutionsRequest) IsSetStatusFilter() bool {
-	return v != nil && v.StatusFilter != nil
+func (_List_DomainChange_ValueList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
-type ListClosedWorkflowExecutionsResponse struct {
-	Executions    []*WorkflowExecutionInfo `json:"executions,omitempty"`
-	NextPageToken []byte                   `json:"nextPageToken,omitempty"`
-}
+func (_List_DomainChange_ValueList) Close() {}
 
-// ToWire translates a ListClosedWorkflowExecutionsResponse struct into a Thrift-level intermediate
+// ToWire translates a ListDomainChangesResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -56568,7 +58679,7 @@ type ListClosedWorkflowExecutionsResponse struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //		return err
 //	}
-func (v *ListClosedWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
+func (v *ListDomainChangesResponse) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -56576,8 +58687,8 @@ func (v *ListClosedWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.Executions != nil {
-		w, err = wire.NewValueList(_List_WorkflowExecutionInfo_ValueList(v.Executions)), error(nil)
+	if v.DomainChanges != nil {
+		w, err = wire.NewValueList(_List_DomainChange_ValueList(v.DomainChanges)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -56596,11 +58707,35 @@ func (v *ListClosedWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ListClosedWorkflowExecutionsResponse struct from its Thrift-level
+func _DomainChange_Read(w wire.Value) (*DomainChange, error) {
+	var v DomainChange
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_DomainChange_Read(l wire.ValueList) ([]*DomainChange, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*DomainChange, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _DomainChange_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ListDomainChangesResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ListClosedWorkflowExecutionsResponse struct
+// An error is returned if we were unable to build a ListDomainChangesResponse struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -56608,19 +58743,19 @@ func (v *ListClosedWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 //		return nil, err
 //	}
 //
-//	var v ListClosedWorkflowExecutionsResponse
+//	var v ListDomainChangesResponse
 //	if err := v.FromWire(x); err != nil {
 //		return nil, err
 //	}
 //	return &v, nil
-func (v *ListClosedWorkflowExecutionsResponse) FromWire(w wire.Value) error {
+func (v *ListDomainChangesResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TList {
-				v.Executions, err = _List_WorkflowExecutionInfo_Read(field.Value.GetList())
+				v.DomainChanges, err = _List_DomainChange_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -56640,20 +58775,41 @@ func (v *ListClosedWorkflowExecutionsResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a ListClosedWorkflowExecutionsResponse struct directly into bytes, without going
+func _List_DomainChange_Encode(val []*DomainChange, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*DomainChange', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a ListDomainChangesResponse struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ListClosedWorkflowExecutionsResponse struct could not be encoded.
-func (v *ListClosedWorkflowExecutionsResponse) Encode(sw stream.Writer) error {
+// An error is returned if a ListDomainChangesResponse struct could not be encoded.
+func (v *ListDomainChangesResponse) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Executions != nil {
+	if v.DomainChanges != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
 			return err
 		}
-		if err := _List_WorkflowExecutionInfo_Encode(v.Executions, sw); err != nil {
+		if err := _List_DomainChange_Encode(v.DomainChanges, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -56676,12 +58832,48 @@ func (v *ListClosedWorkflowExecutionsResponse) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a ListClosedWorkflowExecutionsResponse struct directly from its Thrift-level
+func _DomainChange_Decode(sr stream.Reader) (*DomainChange, error) {
+	var v DomainChange
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_DomainChange_Decode(sr stream.Reader) ([]*DomainChange, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*DomainChange, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _DomainChange_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a ListDomainChangesResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ListClosedWorkflowExecutionsResponse struct could not be generated from the wire
+// An error is returned if a ListDomainChangesResponse struct could not be generated from the wire
 // representation.
-func (v *ListClosedWorkflowExecutionsResponse) Decode(sr stream.Reader) error {
+func (v *ListDomainChangesResponse) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -56695,7 +58887,7 @@ func (v *ListClosedWorkflowExecutionsResponse) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 10 && fh.Type == wire.TList:
-			v.Executions, err = _List_WorkflowExecutionInfo_Decode(sr)
+			v.DomainChanges, err = _List_DomainChange_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -56728,17 +58920,17 @@ func (v *ListClosedWorkflowExecutionsResponse) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a ListClosedWorkflowExecutionsResponse
+// String returns a readable string representation of a ListDomainChangesResponse
 // struct.
-func (v *ListClosedWorkflowExecutionsResponse) String() string {
+func (v *ListDomainChangesResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [2]string
 	i := 0
-	if v.Executions != nil {
-		fields[i] = fmt.Sprintf("Executions: %v", v.Executions)
+	if v.DomainChanges != nil {
+		fields[i] = fmt.Sprintf("DomainChanges: %v", v.DomainChanges)
 		i++
 	}
 	if v.NextPageToken != nil {
@@ -56746,20 +58938,35 @@ func (v *ListClosedWorkflowExecutionsResponse) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("ListClosedWorkflowExecutionsResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ListDomainChangesResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ListClosedWorkflowExecutionsResponse match the
-// provided ListClosedWorkflowExecutionsResponse.
+func _List_DomainChange_Equals(lhs, rhs []*DomainChange) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ListDomainChangesResponse match the
+// provided ListDomainChangesResponse.
 //
 // This function performs a deep comparison.
-func (v *ListClosedWorkflowExecutionsResponse) Equals(rhs *ListClosedWorkflowExecutionsResponse) bool {
+func (v *ListDomainChangesResponse) Equals(rhs *ListDomainChangesResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Executions == nil && rhs.Executions == nil) || (v.Executions != nil && rhs.Executions != nil && _List_WorkflowExecutionInfo_Equals(v.Executions, rhs.Executions))) {
+	if !((v.DomainChanges == nil && rhs.DomainChanges == nil) || (v.DomainChanges != nil && rhs.DomainChanges != nil && _List_DomainChange_Equals(v.DomainChanges, rhs.DomainChanges))) {
 		return false
 	}
 	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
@@ -56769,14 +58976,25 @@ func (v *ListClosedWorkflowExecutionsResponse) Equals(rhs *ListClosedWorkflowExe
 	return true
 }
 
+type _List_DomainChange_Zapper []*DomainChange
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_DomainChange_Zapper.
+func (l _List_DomainChange_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ListClosedWorkflowExecutionsResponse.
-func (v *ListClosedWorkflowExecutionsResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ListDomainChangesResponse.
+func (v *ListDomainChangesResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Executions != nil {
-		err = multierr.Append(err, enc.AddArray("executions", (_List_WorkflowExecutionInfo_Zapper)(v.Executions)))
+	if v.DomainChanges != nil {
+		err = multierr.Append(err, enc.AddArray("domainChanges", (_List_DomainChange_Zapper)(v.DomainChanges)))
 	}
 	if v.NextPageToken != nil {
 		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
@@ -56784,24 +59002,24 @@ func (v *ListClosedWorkflowExecutionsResponse) MarshalLogObject(enc zapcore.Obje
 	return err
 }
 
-// GetExecutions returns the value of Executions if it is set or its
+// GetDomainChanges returns the value of DomainChanges if it is set or its
 // zero value if it is unset.
-func (v *ListClosedWorkflowExecutionsResponse) GetExecutions() (o []*WorkflowExecutionInfo) {
-	if v != nil && v.Executions != nil {
-		return v.Executions
+func (v *ListDomainChangesResponse) GetDomainChanges() (o []*DomainChange) {
+	if v != nil && v.DomainChanges != nil {
+		return v.DomainChanges
 	}
 
 	return
 }
 
-// IsSetExecutions returns true if Executions is not nil.
-func (v *ListClosedWorkflowExecutionsResponse) IsSetExecutions() bool {
-	return v != nil && v.Executions != nil
+// IsSetDomainChanges returns true if DomainChanges is not nil.
+func (v *ListDomainChangesResponse) IsSetDomainChanges() bool {
+	return v != nil && v.DomainChanges != nil
 }
 
 // GetNextPageToken returns the value of NextPageToken if it is set or its
 // zero value if it is unset.
-func (v *ListClosedWorkflowExecutionsResponse) GetNextPageToken() (o []byte) {
+func (v *ListDomainChangesResponse) GetNextPageToken() (o []byte) {
 	if v != nil && v.NextPageToken != nil {
 		return v.NextPageToken
 	}
@@ -56810,7 +59028,7 @@ func (v *ListClosedWorkflowExecutionsResponse) GetNextPageToken() (o []byte) {
 }
 
 // IsSetNextPageToken returns true if NextPageToken is not nil.
-func (v *ListClosedWorkflowExecutionsResponse) IsSetNextPageToken() bool {
+func (v *ListDomainChangesResponse) IsSetNextPageToken() bool {
 	return v != nil && v.NextPageToken != nil
 }
 
@@ -57540,12 +59758,6 @@ func _ListFailoverHistoryRequestFilters_Read(w wire.Value) (*ListFailoverHistory
 	return &v, err
 }
 
-func _PaginationOptions_Read(w wire.Value) (*PaginationOptions, error) {
-	var v PaginationOptions
-	err := v.FromWire(w)
-	return &v, err
-}
-
 // FromWire deserializes a ListFailoverHistoryRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -57632,12 +59844,6 @@ func _ListFailoverHistoryRequestFilters_Decode(sr stream.Reader) (*ListFailoverH
 	return &v, err
 }
 
-func _PaginationOptions_Decode(sr stream.Reader) (*PaginationOptions, error) {
-	var v PaginationOptions
-	err := v.Decode(sr)
-	return &v, err
-}
-
 // Decode deserializes a ListFailoverHistoryRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -60762,55 +62968,521 @@ func (v *MarkerRecordedEventAttributes) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a MarkerRecordedEventAttributes
+// String returns a readable string representation of a MarkerRecordedEventAttributes
+// struct.
+func (v *MarkerRecordedEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.MarkerName != nil {
+		fields[i] = fmt.Sprintf("MarkerName: %v", *(v.MarkerName))
+		i++
+	}
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+		i++
+	}
+	if v.DecisionTaskCompletedEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
+		i++
+	}
+	if v.Header != nil {
+		fields[i] = fmt.Sprintf("Header: %v", v.Header)
+		i++
+	}
+
+	return fmt.Sprintf("MarkerRecordedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this MarkerRecordedEventAttributes match the
+// provided MarkerRecordedEventAttributes.
+//
+// This function performs a deep comparison.
+func (v *MarkerRecordedEventAttributes) Equals(rhs *MarkerRecordedEventAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.MarkerName, rhs.MarkerName) {
+		return false
+	}
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+		return false
+	}
+	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MarkerRecordedEventAttributes.
+func (v *MarkerRecordedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.MarkerName != nil {
+		enc.AddString("markerName", *v.MarkerName)
+	}
+	if v.Details != nil {
+		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
+	}
+	if v.DecisionTaskCompletedEventId != nil {
+		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
+	}
+	if v.Header != nil {
+		err = multierr.Append(err, enc.AddObject("header", v.Header))
+	}
+	return err
+}
+
+// GetMarkerName returns the value of MarkerName if it is set or its
+// zero value if it is unset.
+func (v *MarkerRecordedEventAttributes) GetMarkerName() (o string) {
+	if v != nil && v.MarkerName != nil {
+		return *v.MarkerName
+	}
+
+	return
+}
+
+// IsSetMarkerName returns true if MarkerName is not nil.
+func (v *MarkerRecordedEventAttributes) IsSetMarkerName() bool {
+	return v != nil && v.MarkerName != nil
+}
+
+// GetDetails returns the value of Details if it is set or its
+// zero value if it is unset.
+func (v *MarkerRecordedEventAttributes) GetDetails() (o []byte) {
+	if v != nil && v.Details != nil {
+		return v.Details
+	}
+
+	return
+}
+
+// IsSetDetails returns true if Details is not nil.
+func (v *MarkerRecordedEventAttributes) IsSetDetails() bool {
+	return v != nil && v.Details != nil
+}
+
+// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
+// zero value if it is unset.
+func (v *MarkerRecordedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
+	if v != nil && v.DecisionTaskCompletedEventId != nil {
+		return *v.DecisionTaskCompletedEventId
+	}
+
+	return
+}
+
+// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
+func (v *MarkerRecordedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
+	return v != nil && v.DecisionTaskCompletedEventId != nil
+}
+
+// GetHeader returns the value of Header if it is set or its
+// zero value if it is unset.
+func (v *MarkerRecordedEventAttributes) GetHeader() (o *Header) {
+	if v != nil && v.Header != nil {
+		return v.Header
+	}
+
+	return
+}
+
+// IsSetHeader returns true if Header is not nil.
+func (v *MarkerRecordedEventAttributes) IsSetHeader() bool {
+	return v != nil && v.Header != nil
+}
+
+type Memo struct {
+	Fields map[string][]byte `json:"fields,omitempty"`
+}
+
+// ToWire translates a Memo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//		return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//		return err
+//	}
+func (v *Memo) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Fields != nil {
+		w, err = wire.NewValueMap(_Map_String_Binary_MapItemList(v.Fields)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Memo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Memo struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//		return nil, err
+//	}
+//
+//	var v Memo
+//	if err := v.FromWire(x); err != nil {
+//		return nil, err
+//	}
+//	return &v, nil
+func (v *Memo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TMap {
+				v.Fields, err = _Map_String_Binary_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a Memo struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a Memo struct could not be encoded.
+func (v *Memo) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Fields != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_Binary_Encode(v.Fields, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a Memo struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a Memo struct could not be generated from the wire
+// representation.
+func (v *Memo) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TMap:
+			v.Fields, err = _Map_String_Binary_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Memo
+// struct.
+func (v *Memo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Fields != nil {
+		fields[i] = fmt.Sprintf("Fields: %v", v.Fields)
+		i++
+	}
+
+	return fmt.Sprintf("Memo{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Memo match the
+// provided Memo.
+//
+// This function performs a deep comparison.
+func (v *Memo) Equals(rhs *Memo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Fields == nil && rhs.Fields == nil) || (v.Fields != nil && rhs.Fields != nil && _Map_String_Binary_Equals(v.Fields, rhs.Fields))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Memo.
+func (v *Memo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Fields != nil {
+		err = multierr.Append(err, enc.AddObject("fields", (_Map_String_Binary_Zapper)(v.Fields)))
+	}
+	return err
+}
+
+// GetFields returns the value of Fields if it is set or its
+// zero value if it is unset.
+func (v *Memo) GetFields() (o map[string][]byte) {
+	if v != nil && v.Fields != nil {
+		return v.Fields
+	}
+
+	return
+}
+
+// IsSetFields returns true if Fields is not nil.
+func (v *Memo) IsSetFields() bool {
+	return v != nil && v.Fields != nil
+}
+
+type NotPredicateAttributes struct {
+	Predicate *Predicate `json:"predicate,omitempty"`
+}
+
+// ToWire translates a NotPredicateAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//		return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//		return err
+//	}
+func (v *NotPredicateAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Predicate != nil {
+		w, err = v.Predicate.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a NotPredicateAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a NotPredicateAttributes struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//		return nil, err
+//	}
+//
+//	var v NotPredicateAttributes
+//	if err := v.FromWire(x); err != nil {
+//		return nil, err
+//	}
+//	return &v, nil
+func (v *NotPredicateAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TStruct {
+				v.Predicate, err = _Predicate_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a NotPredicateAttributes struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a NotPredicateAttributes struct could not be encoded.
+func (v *NotPredicateAttributes) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Predicate != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Predicate.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a NotPredicateAttributes struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a NotPredicateAttributes struct could not be generated from the wire
+// representation.
+func (v *NotPredicateAttributes) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TStruct:
+			v.Predicate, err = _Predicate_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a NotPredicateAttributes
 // struct.
-func (v *MarkerRecordedEventAttributes) String() string {
+func (v *NotPredicateAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [1]string
 	i := 0
-	if v.MarkerName != nil {
-		fields[i] = fmt.Sprintf("MarkerName: %v", *(v.MarkerName))
-		i++
-	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
-		i++
-	}
-	if v.DecisionTaskCompletedEventId != nil {
-		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
-		i++
-	}
-	if v.Header != nil {
-		fields[i] = fmt.Sprintf("Header: %v", v.Header)
+	if v.Predicate != nil {
+		fields[i] = fmt.Sprintf("Predicate: %v", v.Predicate)
 		i++
 	}
 
-	return fmt.Sprintf("MarkerRecordedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("NotPredicateAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MarkerRecordedEventAttributes match the
-// provided MarkerRecordedEventAttributes.
+// Equals returns true if all the fields of this NotPredicateAttributes match the
+// provided NotPredicateAttributes.
 //
 // This function performs a deep comparison.
-func (v *MarkerRecordedEventAttributes) Equals(rhs *MarkerRecordedEventAttributes) bool {
+func (v *NotPredicateAttributes) Equals(rhs *NotPredicateAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.MarkerName, rhs.MarkerName) {
-		return false
-	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
-		return false
-	}
-	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
+	if !((v.Predicate == nil && rhs.Predicate == nil) || (v.Predicate != nil && rhs.Predicate != nil && v.Predicate.Equals(rhs.Predicate))) {
 		return false
 	}
 
@@ -60818,91 +63490,37 @@ func (v *MarkerRecordedEventAttributes) Equals(rhs *MarkerRecordedEventAttribute
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MarkerRecordedEventAttributes.
-func (v *MarkerRecordedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of NotPredicateAttributes.
+func (v *NotPredicateAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.MarkerName != nil {
-		enc.AddString("markerName", *v.MarkerName)
-	}
-	if v.Details != nil {
-		enc.AddString("details", base64.StdEncoding.EncodeToString(v.Details))
-	}
-	if v.DecisionTaskCompletedEventId != nil {
-		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
-	}
-	if v.Header != nil {
-		err = multierr.Append(err, enc.AddObject("header", v.Header))
+	if v.Predicate != nil {
+		err = multierr.Append(err, enc.AddObject("predicate", v.Predicate))
 	}
 	return err
 }
 
-// GetMarkerName returns the value of MarkerName if it is set or its
-// zero value if it is unset.
-func (v *MarkerRecordedEventAttributes) GetMarkerName() (o string) {
-	if v != nil && v.MarkerName != nil {
-		return *v.MarkerName
-	}
-
-	return
-}
-
-// IsSetMarkerName returns true if MarkerName is not nil.
-func (v *MarkerRecordedEventAttributes) IsSetMarkerName() bool {
-	return v != nil && v.MarkerName != nil
-}
-
-// GetDetails returns the value of Details if it is set or its
-// zero value if it is unset.
-func (v *MarkerRecordedEventAttributes) GetDetails() (o []byte) {
-	if v != nil && v.Details != nil {
-		return v.Details
-	}
-
-	return
-}
-
-// IsSetDetails returns true if Details is not nil.
-func (v *MarkerRecordedEventAttributes) IsSetDetails() bool {
-	return v != nil && v.Details != nil
-}
-
-// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
-// zero value if it is unset.
-func (v *MarkerRecordedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
-	if v != nil && v.DecisionTaskCompletedEventId != nil {
-		return *v.DecisionTaskCompletedEventId
-	}
-
-	return
-}
-
-// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
-func (v *MarkerRecordedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
-	return v != nil && v.DecisionTaskCompletedEventId != nil
-}
-
-// GetHeader returns the value of Header if it is set or its
+// GetPredicate returns the value of Predicate if it is set or its
 // zero value if it is unset.
-func (v *MarkerRecordedEventAttributes) GetHeader() (o *Header) {
-	if v != nil && v.Header != nil {
-		return v.Header
+func (v *NotPredicateAttributes) GetPredicate() (o *Predicate) {
+	if v != nil && v.Predicate != nil {
+		return v.Predicate
 	}
 
 	return
 }
 
-// IsSetHeader returns true if Header is not nil.
-func (v *MarkerRecordedEventAttributes) IsSetHeader() bool {
-	return v != nil && v.Header != nil
+// IsSetPredicate returns true if Predicate is not nil.
+func (v *NotPredicateAttributes) IsSetPredicate() bool {
+	return v != nil && v.Predicate != nil
 }
 
-type Memo struct {
-	Fields map[string][]byte `json:"fields,omitempty"`
+type OrPredicateAttributes struct {
+	Predicates []*Predicate `json:"predicates,omitempty"`
 }
 
-// ToWire translates a Memo struct into a Thrift-level intermediate
+// ToWire translates a OrPredicateAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -60917,7 +63535,7 @@ type Memo struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //		return err
 //	}
-func (v *Memo) ToWire() (wire.Value, error) {
+func (v *OrPredicateAttributes) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -60925,8 +63543,8 @@ func (v *Memo) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.Fields != nil {
-		w, err = wire.NewValueMap(_Map_String_Binary_MapItemList(v.Fields)), error(nil)
+	if v.Predicates != nil {
+		w, err = wire.NewValueList(_List_Predicate_ValueList(v.Predicates)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -60937,11 +63555,11 @@ func (v *Memo) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a Memo struct from its Thrift-level
+// FromWire deserializes a OrPredicateAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a Memo struct
+// An error is returned if we were unable to build a OrPredicateAttributes struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -60949,19 +63567,19 @@ func (v *Memo) ToWire() (wire.Value, error) {
 //		return nil, err
 //	}
 //
-//	var v Memo
+//	var v OrPredicateAttributes
 //	if err := v.FromWire(x); err != nil {
 //		return nil, err
 //	}
 //	return &v, nil
-func (v *Memo) FromWire(w wire.Value) error {
+func (v *OrPredicateAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TMap {
-				v.Fields, err = _Map_String_Binary_Read(field.Value.GetMap())
+			if field.Value.Type() == wire.TList {
+				v.Predicates, err = _List_Predicate_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -60973,20 +63591,20 @@ func (v *Memo) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a Memo struct directly into bytes, without going
+// Encode serializes a OrPredicateAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a Memo struct could not be encoded.
-func (v *Memo) Encode(sw stream.Writer) error {
+// An error is returned if a OrPredicateAttributes struct could not be encoded.
+func (v *OrPredicateAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Fields != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TMap}); err != nil {
+	if v.Predicates != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
 			return err
 		}
-		if err := _Map_String_Binary_Encode(v.Fields, sw); err != nil {
+		if err := _List_Predicate_Encode(v.Predicates, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -60997,12 +63615,12 @@ func (v *Memo) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a Memo struct directly from its Thrift-level
+// Decode deserializes a OrPredicateAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a Memo struct could not be generated from the wire
+// An error is returned if a OrPredicateAttributes struct could not be generated from the wire
 // representation.
-func (v *Memo) Decode(sr stream.Reader) error {
+func (v *OrPredicateAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -61015,8 +63633,8 @@ func (v *Memo) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TMap:
-			v.Fields, err = _Map_String_Binary_Decode(sr)
+		case fh.ID == 10 && fh.Type == wire.TList:
+			v.Predicates, err = _List_Predicate_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -61043,34 +63661,34 @@ func (v *Memo) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a Memo
+// String returns a readable string representation of a OrPredicateAttributes
 // struct.
-func (v *Memo) String() string {
+func (v *OrPredicateAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.Fields != nil {
-		fields[i] = fmt.Sprintf("Fields: %v", v.Fields)
+	if v.Predicates != nil {
+		fields[i] = fmt.Sprintf("Predicates: %v", v.Predicates)
 		i++
 	}
 
-	return fmt.Sprintf("Memo{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("OrPredicateAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this Memo match the
-// provided Memo.
+// Equals returns true if all the fields of this OrPredicateAttributes match the
+// provided OrPredicateAttributes.
 //
 // This function performs a deep comparison.
-func (v *Memo) Equals(rhs *Memo) bool {
+func (v *OrPredicateAttributes) Equals(rhs *OrPredicateAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Fields == nil && rhs.Fields == nil) || (v.Fields != nil && rhs.Fields != nil && _Map_String_Binary_Equals(v.Fields, rhs.Fields))) {
+	if !((v.Predicates == nil && rhs.Predicates == nil) || (v.Predicates != nil && rhs.Predicates != nil && _List_Predicate_Equals(v.Predicates, rhs.Predicates))) {
 		return false
 	}
 
@@ -61078,30 +63696,30 @@ func (v *Memo) Equals(rhs *Memo) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of Memo.
-func (v *Memo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of OrPredicateAttributes.
+func (v *OrPredicateAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Fields != nil {
-		err = multierr.Append(err, enc.AddObject("fields", (_Map_String_Binary_Zapper)(v.Fields)))
+	if v.Predicates != nil {
+		err = multierr.Append(err, enc.AddArray("predicates", (_List_Predicate_Zapper)(v.Predicates)))
 	}
 	return err
 }
 
-// GetFields returns the value of Fields if it is set or its
+// GetPredicates returns the value of Predicates if it is set or its
 // zero value if it is unset.
-func (v *Memo) GetFields() (o map[string][]byte) {
-	if v != nil && v.Fields != nil {
-		return v.Fields
+func (v *OrPredicateAttributes) GetPredicates() (o []*Predicate) {
+	if v != nil && v.Predicates != nil {
+		return v.Predicates
 	}
 
 	return
 }
 
-// IsSetFields returns true if Fields is not nil.
-func (v *Memo) IsSetFields() bool {
-	return v != nil && v.Fields != nil
+// IsSetPredicates returns true if Predicates is not nil.
+func (v *OrPredicateAttributes) IsSetPredicates() bool {
+	return v != nil && v.Predicates != nil
 }
 
 type PaginationOptions struct {
@@ -67922,10 +70540,17 @@ func (v *PollerInfo) IsSetRatePerSecond() bool {
 }
 
 type Predicate struct {
-	PredicateType                *PredicateType                `json:"predicateType,omitempty"`
-	UniversalPredicateAttributes *UniversalPredicateAttributes `json:"universalPredicateAttributes,omitempty"`
-	EmptyPredicateAttributes     *EmptyPredicateAttributes     `json:"emptyPredicateAttributes,omitempty"`
-	DomainIDPredicateAttributes  *DomainIDPredicateAttributes  `json:"domainIDPredicateAttributes,omitempty"`
+	PredicateType                       *PredicateType                       `json:"predicateType,omitempty"`
+	UniversalPredicateAttributes        *UniversalPredicateAttributes        `json:"universalPredicateAttributes,omitempty"`
+	EmptyPredicateAttributes            *EmptyPredicateAttributes            `json:"emptyPredicateAttributes,omitempty"`
+	DomainIDPredicateAttributes         *DomainIDPredicateAttributes         `json:"domainIDPredicateAttributes,omitempty"`
+	TaskTypePredicateAttributes         *TaskTypePredicateAttributes         `json:"taskTypePredicateAttributes,omitempty"`
+	TaskListPredicateAttributes         *TaskListPredicateAttributes         `json:"taskListPredicateAttributes,omitempty"`
+	WorkflowIDPredicateAttributes       *WorkflowIDPredicateAttributes       `json:"workflowIDPredicateAttributes,omitempty"`
+	WorkflowIDPrefixPredicateAttributes *WorkflowIDPrefixPredicateAttributes `json:"workflowIDPrefixPredicateAttributes,omitempty"`
+	AndPredicateAttributes              *AndPredicateAttributes              `json:"andPredicateAttributes,omitempty"`
+	OrPredicateAttributes               *OrPredicateAttributes               `json:"orPredicateAttributes,omitempty"`
+	NotPredicateAttributes              *NotPredicateAttributes              `json:"notPredicateAttributes,omitempty"`
 }
 
 // ToWire translates a Predicate struct into a Thrift-level intermediate
@@ -67945,7 +70570,7 @@ type Predicate struct {
 //	}
 func (v *Predicate) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [11]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -67983,6 +70608,62 @@ func (v *Predicate) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.TaskTypePredicateAttributes != nil {
+		w, err = v.TaskTypePredicateAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.TaskListPredicateAttributes != nil {
+		w, err = v.TaskListPredicateAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.WorkflowIDPredicateAttributes != nil {
+		w, err = v.WorkflowIDPredicateAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.WorkflowIDPrefixPredicateAttributes != nil {
+		w, err = v.WorkflowIDPrefixPredicateAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.AndPredicateAttributes != nil {
+		w, err = v.AndPredicateAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.OrPredicateAttributes != nil {
+		w, err = v.OrPredicateAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.NotPredicateAttributes != nil {
+		w, err = v.NotPredicateAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -68011,6 +70692,48 @@ func _DomainIDPredicateAttributes_Read(w wire.Value) (*DomainIDPredicateAttribut
 	return &v, err
 }
 
+func _TaskTypePredicateAttributes_Read(w wire.Value) (*TaskTypePredicateAttributes, error) {
+	var v TaskTypePredicateAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _TaskListPredicateAttributes_Read(w wire.Value) (*TaskListPredicateAttributes, error) {
+	var v TaskListPredicateAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowIDPredicateAttributes_Read(w wire.Value) (*WorkflowIDPredicateAttributes, error) {
+	var v WorkflowIDPredicateAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowIDPrefixPredicateAttributes_Read(w wire.Value) (*WorkflowIDPrefixPredicateAttributes, error) {
+	var v WorkflowIDPrefixPredicateAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _AndPredicateAttributes_Read(w wire.Value) (*AndPredicateAttributes, error) {
+	var v AndPredicateAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _OrPredicateAttributes_Read(w wire.Value) (*OrPredicateAttributes, error) {
+	var v OrPredicateAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _NotPredicateAttributes_Read(w wire.Value) (*NotPredicateAttributes, error) {
+	var v NotPredicateAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a Predicate struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -68066,6 +70789,62 @@ func (v *Predicate) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskTypePredicateAttributes, err = _TaskTypePredicateAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskListPredicateAttributes, err = _TaskListPredicateAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowIDPredicateAttributes, err = _WorkflowIDPredicateAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowIDPrefixPredicateAttributes, err = _WorkflowIDPrefixPredicateAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TStruct {
+				v.AndPredicateAttributes, err = _AndPredicateAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TStruct {
+				v.OrPredicateAttributes, err = _OrPredicateAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TStruct {
+				v.NotPredicateAttributes, err = _NotPredicateAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -68130,6 +70909,90 @@ func (v *Predicate) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.TaskTypePredicateAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.TaskTypePredicateAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TaskListPredicateAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.TaskListPredicateAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowIDPredicateAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowIDPredicateAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowIDPrefixPredicateAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowIDPrefixPredicateAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.AndPredicateAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.AndPredicateAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.OrPredicateAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.OrPredicateAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.NotPredicateAttributes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 110, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.NotPredicateAttributes.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -68157,6 +71020,48 @@ func _DomainIDPredicateAttributes_Decode(sr stream.Reader) (*DomainIDPredicateAt
 	return &v, err
 }
 
+func _TaskTypePredicateAttributes_Decode(sr stream.Reader) (*TaskTypePredicateAttributes, error) {
+	var v TaskTypePredicateAttributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _TaskListPredicateAttributes_Decode(sr stream.Reader) (*TaskListPredicateAttributes, error) {
+	var v TaskListPredicateAttributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _WorkflowIDPredicateAttributes_Decode(sr stream.Reader) (*WorkflowIDPredicateAttributes, error) {
+	var v WorkflowIDPredicateAttributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _WorkflowIDPrefixPredicateAttributes_Decode(sr stream.Reader) (*WorkflowIDPrefixPredicateAttributes, error) {
+	var v WorkflowIDPrefixPredicateAttributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _AndPredicateAttributes_Decode(sr stream.Reader) (*AndPredicateAttributes, error) {
+	var v AndPredicateAttributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _OrPredicateAttributes_Decode(sr stream.Reader) (*OrPredicateAttributes, error) {
+	var v OrPredicateAttributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _NotPredicateAttributes_Decode(sr stream.Reader) (*NotPredicateAttributes, error) {
+	var v NotPredicateAttributes
+	err := v.Decode(sr)
+	return &v, err
+}
+
 // Decode deserializes a Predicate struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -68201,6 +71106,48 @@ func (v *Predicate) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 50 && fh.Type == wire.TStruct:
+			v.TaskTypePredicateAttributes, err = _TaskTypePredicateAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TStruct:
+			v.TaskListPredicateAttributes, err = _TaskListPredicateAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 70 && fh.Type == wire.TStruct:
+			v.WorkflowIDPredicateAttributes, err = _WorkflowIDPredicateAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 80 && fh.Type == wire.TStruct:
+			v.WorkflowIDPrefixPredicateAttributes, err = _WorkflowIDPrefixPredicateAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 90 && fh.Type == wire.TStruct:
+			v.AndPredicateAttributes, err = _AndPredicateAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 100 && fh.Type == wire.TStruct:
+			v.OrPredicateAttributes, err = _OrPredicateAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 110 && fh.Type == wire.TStruct:
+			v.NotPredicateAttributes, err = _NotPredicateAttributes_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -68230,7 +71177,7 @@ func (v *Predicate) String() string {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [11]string
 	i := 0
 	if v.PredicateType != nil {
 		fields[i] = fmt.Sprintf("PredicateType: %v", *(v.PredicateType))
@@ -68248,6 +71195,34 @@ func (v *Predicate) String() string {
 		fields[i] = fmt.Sprintf("DomainIDPredicateAttributes: %v", v.DomainIDPredicateAttributes)
 		i++
 	}
+	if v.TaskTypePredicateAttributes != nil {
+		fields[i] = fmt.Sprintf("TaskTypePredicateAttributes: %v", v.TaskTypePredicateAttributes)
+		i++
+	}
+	if v.TaskListPredicateAttributes != nil {
+		fields[i] = fmt.Sprintf("TaskListPredicateAttributes: %v", v.TaskListPredicateAttributes)
+		i++
+	}
+	if v.WorkflowIDPredicateAttributes != nil {
+		fields[i] = fmt.Sprintf("WorkflowIDPredicateAttributes: %v", v.WorkflowIDPredicateAttributes)
+		i++
+	}
+	if v.WorkflowIDPrefixPredicateAttributes != nil {
+		fields[i] = fmt.Sprintf("WorkflowIDPrefixPredicateAttributes: %v", v.WorkflowIDPrefixPredicateAttributes)
+		i++
+	}
+	if v.AndPredicateAttributes != nil {
+		fields[i] = fmt.Sprintf("AndPredicateAttributes: %v", v.AndPredicateAttributes)
+		i++
+	}
+	if v.OrPredicateAttributes != nil {
+		fields[i] = fmt.Sprintf("OrPredicateAttributes: %v", v.OrPredicateAttributes)
+		i++
+	}
+	if v.NotPredicateAttributes != nil {
+		fields[i] = fmt.Sprintf("NotPredicateAttributes: %v", v.NotPredicateAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("Predicate{%v}", strings.Join(fields[:i], ", "))
 }
@@ -68284,6 +71259,27 @@ func (v *Predicate) Equals(rhs *Predicate) bool {
 	if !((v.DomainIDPredicateAttributes == nil && rhs.DomainIDPredicateAttributes == nil) || (v.DomainIDPredicateAttributes != nil && rhs.DomainIDPredicateAttributes != nil && v.DomainIDPredicateAttributes.Equals(rhs.DomainIDPredicateAttributes))) {
 		return false
 	}
+	if !((v.TaskTypePredicateAttributes == nil && rhs.TaskTypePredicateAttributes == nil) || (v.TaskTypePredicateAttributes != nil && rhs.TaskTypePredicateAttributes != nil && v.TaskTypePredicateAttributes.Equals(rhs.TaskTypePredicateAttributes))) {
+		return false
+	}
+	if !((v.TaskListPredicateAttributes == nil && rhs.TaskListPredicateAttributes == nil) || (v.TaskListPredicateAttributes != nil && rhs.TaskListPredicateAttributes != nil && v.TaskListPredicateAttributes.Equals(rhs.TaskListPredicateAttributes))) {
+		return false
+	}
+	if !((v.WorkflowIDPredicateAttributes == nil && rhs.WorkflowIDPredicateAttributes == nil) || (v.WorkflowIDPredicateAttributes != nil && rhs.WorkflowIDPredicateAttributes != nil && v.WorkflowIDPredicateAttributes.Equals(rhs.WorkflowIDPredicateAttributes))) {
+		return false
+	}
+	if !((v.WorkflowIDPrefixPredicateAttributes == nil && rhs.WorkflowIDPrefixPredicateAttributes == nil) || (v.WorkflowIDPrefixPredicateAttributes != nil && rhs.WorkflowIDPrefixPredicateAttributes != nil && v.WorkflowIDPrefixPredicateAttributes.Equals(rhs.WorkflowIDPrefixPredicateAttributes))) {
+		return false
+	}
+	if !((v.AndPredicateAttributes == nil && rhs.AndPredicateAttributes == nil) || (v.AndPredicateAttributes != nil && rhs.AndPredicateAttributes != nil && v.AndPredicateAttributes.Equals(rhs.AndPredicateAttributes))) {
+		return false
+	}
+	if !((v.OrPredicateAttributes == nil && rhs.OrPredicateAttributes == nil) || (v.OrPredicateAttributes != nil && rhs.OrPredicateAttributes != nil && v.OrPredicateAttributes.Equals(rhs.OrPredicateAttributes))) {
+		return false
+	}
+	if !((v.NotPredicateAttributes == nil && rhs.NotPredicateAttributes == nil) || (v.NotPredicateAttributes != nil && rhs.NotPredicateAttributes != nil && v.NotPredicateAttributes.Equals(rhs.NotPredicateAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -68306,6 +71302,27 @@ func (v *Predicate) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.DomainIDPredicateAttributes != nil {
 		err = multierr.Append(err, enc.AddObject("domainIDPredicateAttributes", v.DomainIDPredicateAttributes))
 	}
+	if v.TaskTypePredicateAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("taskTypePredicateAttributes", v.TaskTypePredicateAttributes))
+	}
+	if v.TaskListPredicateAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("taskListPredicateAttributes", v.TaskListPredicateAttributes))
+	}
+	if v.WorkflowIDPredicateAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("workflowIDPredicateAttributes", v.WorkflowIDPredicateAttributes))
+	}
+	if v.WorkflowIDPrefixPredicateAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("workflowIDPrefixPredicateAttributes", v.WorkflowIDPrefixPredicateAttributes))
+	}
+	if v.AndPredicateAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("andPredicateAttributes", v.AndPredicateAttributes))
+	}
+	if v.OrPredicateAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("orPredicateAttributes", v.OrPredicateAttributes))
+	}
+	if v.NotPredicateAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("notPredicateAttributes", v.NotPredicateAttributes))
+	}
 	return err
 }
 
@@ -68369,12 +71386,124 @@ func (v *Predicate) IsSetDomainIDPredicateAttributes() bool {
 	return v != nil && v.DomainIDPredicateAttributes != nil
 }
 
+// GetTaskTypePredicateAttributes returns the value of TaskTypePredicateAttributes if it is set or its
+// zero value if it is unset.
+func (v *Predicate) GetTaskTypePredicateAttributes() (o *TaskTypePredicateAttributes) {
+	if v != nil && v.TaskTypePredicateAttributes != nil {
+		return v.TaskTypePredicateAttributes
+	}
+
+	return
+}
+
+// IsSetTaskTypePredicateAttributes returns true if TaskTypePredicateAttributes is not nil.
+func (v *Predicate) IsSetTaskTypePredicateAttributes() bool {
+	return v != nil && v.TaskTypePredicateAttributes != nil
+}
+
+// GetTaskListPredicateAttributes returns the value of TaskListPredicateAttributes if it is set or its
+// zero value if it is unset.
+func (v *Predicate) GetTaskListPredicateAttributes() (o *TaskListPredicateAttributes) {
+	if v != nil && v.TaskListPredicateAttributes != nil {
+		return v.TaskListPredicateAttributes
+	}
+
+	return
+}
+
+// IsSetTaskListPredicateAttributes returns true if TaskListPredicateAttributes is not nil.
+func (v *Predicate) IsSetTaskListPredicateAttributes() bool {
+	return v != nil && v.TaskListPredicateAttributes != nil
+}
+
+// GetWorkflowIDPredicateAttributes returns the value of WorkflowIDPredicateAttributes if it is set or its
+// zero value if it is unset.
+func (v *Predicate) GetWorkflowIDPredicateAttributes() (o *WorkflowIDPredicateAttributes) {
+	if v != nil && v.WorkflowIDPredicateAttributes != nil {
+		return v.WorkflowIDPredicateAttributes
+	}
+
+	return
+}
+
+// IsSetWorkflowIDPredicateAttributes returns true if WorkflowIDPredicateAttributes is not nil.
+func (v *Predicate) IsSetWorkflowIDPredicateAttributes() bool {
+	return v != nil && v.WorkflowIDPredicateAttributes != nil
+}
+
+// GetWorkflowIDPrefixPredicateAttributes returns the value of WorkflowIDPrefixPredicateAttributes if it is set or its
+// zero value if it is unset.
+func (v *Predicate) GetWorkflowIDPrefixPredicateAttributes() (o *WorkflowIDPrefixPredicateAttributes) {
+	if v != nil && v.WorkflowIDPrefixPredicateAttributes != nil {
+		return v.WorkflowIDPrefixPredicateAttributes
+	}
+
+	return
+}
+
+// IsSetWorkflowIDPrefixPredicateAttributes returns true if WorkflowIDPrefixPredicateAttributes is not nil.
+func (v *Predicate) IsSetWorkflowIDPrefixPredicateAttributes() bool {
+	return v != nil && v.WorkflowIDPrefixPredicateAttributes != nil
+}
+
+// GetAndPredicateAttributes returns the value of AndPredicateAttributes if it is set or its
+// zero value if it is unset.
+func (v *Predicate) GetAndPredicateAttributes() (o *AndPredicateAttributes) {
+	if v != nil && v.AndPredicateAttributes != nil {
+		return v.AndPredicateAttributes
+	}
+
+	return
+}
+
+// IsSetAndPredicateAttributes returns true if AndPredicateAttributes is not nil.
+func (v *Predicate) IsSetAndPredicateAttributes() bool {
+	return v != nil && v.AndPredicateAttributes != nil
+}
+
+// GetOrPredicateAttributes returns the value of OrPredicateAttributes if it is set or its
+// zero value if it is unset.
+func (v *Predicate) GetOrPredicateAttributes() (o *OrPredicateAttributes) {
+	if v != nil && v.OrPredicateAttributes != nil {
+		return v.OrPredicateAttributes
+	}
+
+	return
+}
+
+// IsSetOrPredicateAttributes returns true if OrPredicateAttributes is not nil.
+func (v *Predicate) IsSetOrPredicateAttributes() bool {
+	return v != nil && v.OrPredicateAttributes != nil
+}
+
+// GetNotPredicateAttributes returns the value of NotPredicateAttributes if it is set or its
+// zero value if it is unset.
+func (v *Predicate) GetNotPredicateAttributes() (o *NotPredicateAttributes) {
+	if v != nil && v.NotPredicateAttributes != nil {
+		return v.NotPredicateAttributes
+	}
+
+	return
+}
+
+// IsSetNotPredicateAttributes returns true if NotPredicateAttributes is not nil.
+func (v *Predicate) IsSetNotPredicateAttributes() bool {
+	return v != nil && v.NotPredicateAttributes != nil
+}
+
 type PredicateType int32
 
 const (
-	PredicateTypeUniversal PredicateType = 0
-	PredicateTypeEmpty     PredicateType = 1
-	PredicateTypeDomainID  PredicateType = 2
+	PredicateTypeUniversal        PredicateType = 0
+	PredicateTypeEmpty            PredicateType = 1
+	PredicateTypeDomainID         PredicateType = 2
+	PredicateTypeTaskType         PredicateType = 3
+	PredicateTypeTaskList         PredicateType = 4
+	PredicateTypeWorkflowID       PredicateType = 5
+	PredicateTypeWorkflowIDPrefix PredicateType = 6
+	PredicateTypeAnd              PredicateType = 7
+	PredicateTypeOr               PredicateType = 8
+	PredicateTypeNot              PredicateType = 9
 )
 
 // PredicateType_Values returns all recognized values of PredicateType.
@@ -68383,6 +71512,13 @@ func PredicateType_Values() []PredicateType {
 		PredicateTypeUniversal,
 		PredicateTypeEmpty,
 		PredicateTypeDomainID,
+		PredicateTypeTaskType,
+		PredicateTypeTaskList,
+		PredicateTypeWorkflowID,
+		PredicateTypeWorkflowIDPrefix,
+		PredicateTypeAnd,
+		PredicateTypeOr,
+		PredicateTypeNot,
 	}
 }
 
@@ -68402,6 +71538,27 @@ func (v *PredicateType) UnmarshalText(value []byte) error {
 	case "DomainID":
 		*v = PredicateTypeDomainID
 		return nil
+	case "TaskType":
+		*v = PredicateTypeTaskType
+		return nil
+	case "TaskList":
+		*v = PredicateTypeTaskList
+		return nil
+	case "WorkflowID":
+		*v = PredicateTypeWorkflowID
+		return nil
+	case "WorkflowIDPrefix":
+		*v = PredicateTypeWorkflowIDPrefix
+		return nil
+	case "And":
+		*v = PredicateTypeAnd
+		return nil
+	case "Or":
+		*v = PredicateTypeOr
+		return nil
+	case "Not":
+		*v = PredicateTypeNot
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -68426,6 +71583,20 @@ func (v PredicateType) MarshalText() ([]byte, error) {
 		return []byte("Empty"), nil
 	case 2:
 		return []byte("DomainID"), nil
+	case 3:
+		return []byte("TaskType"), nil
+	case 4:
+		return []byte("TaskList"), nil
+	case 5:
+		return []byte("WorkflowID"), nil
+	case 6:
+		return []byte("WorkflowIDPrefix"), nil
+	case 7:
+		return []byte("And"), nil
+	case 8:
+		return []byte("Or"), nil
+	case 9:
+		return []byte("Not"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -68443,6 +71614,20 @@ func (v PredicateType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		enc.AddString("name", "Empty")
 	case 2:
 		enc.AddString("name", "DomainID")
+	case 3:
+		enc.AddString("name", "TaskType")
+	case 4:
+		enc.AddString("name", "TaskList")
+	case 5:
+		enc.AddString("name", "WorkflowID")
+	case 6:
+		enc.AddString("name", "WorkflowIDPrefix")
+	case 7:
+		enc.AddString("name", "And")
+	case 8:
+		enc.AddString("name", "Or")
+	case 9:
+		enc.AddString("name", "Not")
 	}
 	return nil
 }
@@ -68517,6 +71702,20 @@ func (v PredicateType) String() string {
 		return "Empty"
 	case 2:
 		return "DomainID"
+	case 3:
+		return "TaskType"
+	case 4:
+		return "TaskList"
+	case 5:
+		return "WorkflowID"
+	case 6:
+		return "WorkflowIDPrefix"
+	case 7:
+		return "And"
+	case 8:
+		return "Or"
+	case 9:
+		return "Not"
 	}
 	return fmt.Sprintf("PredicateType(%d)", w)
 }
@@ -68541,6 +71740,20 @@ func (v PredicateType) MarshalJSON() ([]byte, error) {
 		return ([]byte)("\"Empty\""), nil
 	case 2:
 		return ([]byte)("\"DomainID\""), nil
+	case 3:
+		return ([]byte)("\"TaskType\""), nil
+	case 4:
+		return ([]byte)("\"TaskList\""), nil
+	case 5:
+		return ([]byte)("\"WorkflowID\""), nil
+	case 6:
+		return ([]byte)("\"WorkflowIDPrefix\""), nil
+	case 7:
+		return ([]byte)("\"And\""), nil
+	case 8:
+		return ([]byte)("\"Or\""), nil
+	case 9:
+		return ([]byte)("\"Not\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -87435,6 +90648,8 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 	Header                        *Header       `json:"header,omitempty"`
 	RequestLocalDispatch          *bool         `json:"requestLocalDispatch,omitempty"`
+	Priority                      *int32        `json:"priority,omitempty"`
+	FairnessKey                   *string       `json:"fairnessKey,omitempty"`
 }
 
 // ToWire translates a ScheduleActivityTaskDecisionAttributes struct into a Thrift-level intermediate
@@ -87454,7 +90669,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 //	}
 func (v *ScheduleActivityTaskDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [12]wire.Field
+		fields [14]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -87556,6 +90771,22 @@ func (v *ScheduleActivityTaskDecisionAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 90, Value: w}
 		i++
 	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.FairnessKey != nil {
+		w, err = wire.NewValueString(*(v.FairnessKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -87691,6 +90922,26 @@ func (v *ScheduleActivityTaskDecisionAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 100:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FairnessKey = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -87851,6 +91102,30 @@ func (v *ScheduleActivityTaskDecisionAttributes) Encode(sw stream.Writer) error
 		}
 	}
 
+	if v.Priority != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Priority)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.FairnessKey != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 110, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.FairnessKey)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -87958,6 +91233,22 @@ func (v *ScheduleActivityTaskDecisionAttributes) Decode(sr stream.Reader) error
 				return err
 			}
 
+		case fh.ID == 100 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Priority = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 110 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.FairnessKey = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -87987,7 +91278,7 @@ func (v *ScheduleActivityTaskDecisionAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [12]string
+	var fields [14]string
 	i := 0
 	if v.ActivityId != nil {
 		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
@@ -88037,6 +91328,14 @@ func (v *ScheduleActivityTaskDecisionAttributes) String() string {
 		fields[i] = fmt.Sprintf("RequestLocalDispatch: %v", *(v.RequestLocalDispatch))
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
+	if v.FairnessKey != nil {
+		fields[i] = fmt.Sprintf("FairnessKey: %v", *(v.FairnessKey))
+		i++
+	}
 
 	return fmt.Sprintf("ScheduleActivityTaskDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -88087,6 +91386,12 @@ func (v *ScheduleActivityTaskDecisionAttributes) Equals(rhs *ScheduleActivityTas
 	if !_Bool_EqualsPtr(v.RequestLocalDispatch, rhs.RequestLocalDispatch) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FairnessKey, rhs.FairnessKey) {
+		return false
+	}
 
 	return true
 }
@@ -88133,6 +91438,12 @@ func (v *ScheduleActivityTaskDecisionAttributes) MarshalLogObject(enc zapcore.Ob
 	if v.RequestLocalDispatch != nil {
 		enc.AddBool("requestLocalDispatch", *v.RequestLocalDispatch)
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
+	if v.FairnessKey != nil {
+		enc.AddString("fairnessKey", *v.FairnessKey)
+	}
 	return err
 }
 
@@ -88316,6 +91627,36 @@ func (v *ScheduleActivityTaskDecisionAttributes) IsSetRequestLocalDispatch() boo
 	return v != nil && v.RequestLocalDispatch != nil
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *ScheduleActivityTaskDecisionAttributes) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *ScheduleActivityTaskDecisionAttributes) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
+// GetFairnessKey returns the value of FairnessKey if it is set or its
+// zero value if it is unset.
+func (v *ScheduleActivityTaskDecisionAttributes) GetFairnessKey() (o string) {
+	if v != nil && v.FairnessKey != nil {
+		return *v.FairnessKey
+	}
+
+	return
+}
+
+// IsSetFairnessKey returns true if FairnessKey is not nil.
+func (v *ScheduleActivityTaskDecisionAttributes) IsSetFairnessKey() bool {
+	return v != nil && v.FairnessKey != nil
+}
+
 type SearchAttributes struct {
 	IndexedFields map[string][]byte `json:"indexedFields,omitempty"`
 }
@@ -101497,41 +104838,311 @@ func (v *TaskListPartitionMetadata) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a TaskListPartitionMetadata
+// String returns a readable string representation of a TaskListPartitionMetadata
+// struct.
+func (v *TaskListPartitionMetadata) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
+		i++
+	}
+	if v.OwnerHostName != nil {
+		fields[i] = fmt.Sprintf("OwnerHostName: %v", *(v.OwnerHostName))
+		i++
+	}
+
+	return fmt.Sprintf("TaskListPartitionMetadata{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this TaskListPartitionMetadata match the
+// provided TaskListPartitionMetadata.
+//
+// This function performs a deep comparison.
+func (v *TaskListPartitionMetadata) Equals(rhs *TaskListPartitionMetadata) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Key, rhs.Key) {
+		return false
+	}
+	if !_String_EqualsPtr(v.OwnerHostName, rhs.OwnerHostName) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TaskListPartitionMetadata.
+func (v *TaskListPartitionMetadata) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Key != nil {
+		enc.AddString("key", *v.Key)
+	}
+	if v.OwnerHostName != nil {
+		enc.AddString("ownerHostName", *v.OwnerHostName)
+	}
+	return err
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+func (v *TaskListPartitionMetadata) GetKey() (o string) {
+	if v != nil && v.Key != nil {
+		return *v.Key
+	}
+
+	return
+}
+
+// IsSetKey returns true if Key is not nil.
+func (v *TaskListPartitionMetadata) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
+// GetOwnerHostName returns the value of OwnerHostName if it is set or its
+// zero value if it is unset.
+func (v *TaskListPartitionMetadata) GetOwnerHostName() (o string) {
+	if v != nil && v.OwnerHostName != nil {
+		return *v.OwnerHostName
+	}
+
+	return
+}
+
+// IsSetOwnerHostName returns true if OwnerHostName is not nil.
+func (v *TaskListPartitionMetadata) IsSetOwnerHostName() bool {
+	return v != nil && v.OwnerHostName != nil
+}
+
+type TaskListPredicateAttributes struct {
+	TaskLists   []string `json:"taskLists,omitempty"`
+	IsExclusive *bool    `json:"isExclusive,omitempty"`
+}
+
+// ToWire translates a TaskListPredicateAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//		return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//		return err
+//	}
+func (v *TaskListPredicateAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TaskLists != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.TaskLists)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.IsExclusive != nil {
+		w, err = wire.NewValueBool(*(v.IsExclusive)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a TaskListPredicateAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TaskListPredicateAttributes struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//		return nil, err
+//	}
+//
+//	var v TaskListPredicateAttributes
+//	if err := v.FromWire(x); err != nil {
+//		return nil, err
+//	}
+//	return &v, nil
+func (v *TaskListPredicateAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.TaskLists, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.IsExclusive = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a TaskListPredicateAttributes struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a TaskListPredicateAttributes struct could not be encoded.
+func (v *TaskListPredicateAttributes) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.TaskLists != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v.TaskLists, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.IsExclusive != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.IsExclusive)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a TaskListPredicateAttributes struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a TaskListPredicateAttributes struct could not be generated from the wire
+// representation.
+func (v *TaskListPredicateAttributes) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TList:
+			v.TaskLists, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.IsExclusive = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a TaskListPredicateAttributes
 // struct.
-func (v *TaskListPartitionMetadata) String() string {
+func (v *TaskListPredicateAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [2]string
 	i := 0
-	if v.Key != nil {
-		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
+	if v.TaskLists != nil {
+		fields[i] = fmt.Sprintf("TaskLists: %v", v.TaskLists)
 		i++
 	}
-	if v.OwnerHostName != nil {
-		fields[i] = fmt.Sprintf("OwnerHostName: %v", *(v.OwnerHostName))
+	if v.IsExclusive != nil {
+		fields[i] = fmt.Sprintf("IsExclusive: %v", *(v.IsExclusive))
 		i++
 	}
 
-	return fmt.Sprintf("TaskListPartitionMetadata{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("TaskListPredicateAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this TaskListPartitionMetadata match the
-// provided TaskListPartitionMetadata.
+// Equals returns true if all the fields of this TaskListPredicateAttributes match the
+// provided TaskListPredicateAttributes.
 //
 // This function performs a deep comparison.
-func (v *TaskListPartitionMetadata) Equals(rhs *TaskListPartitionMetadata) bool {
+func (v *TaskListPredicateAttributes) Equals(rhs *TaskListPredicateAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Key, rhs.Key) {
+	if !((v.TaskLists == nil && rhs.TaskLists == nil) || (v.TaskLists != nil && rhs.TaskLists != nil && _List_String_Equals(v.TaskLists, rhs.TaskLists))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.OwnerHostName, rhs.OwnerHostName) {
+	if !_Bool_EqualsPtr(v.IsExclusive, rhs.IsExclusive) {
 		return false
 	}
 
@@ -101539,59 +105150,61 @@ func (v *TaskListPartitionMetadata) Equals(rhs *TaskListPartitionMetadata) bool
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of TaskListPartitionMetadata.
-func (v *TaskListPartitionMetadata) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of TaskListPredicateAttributes.
+func (v *TaskListPredicateAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Key != nil {
-		enc.AddString("key", *v.Key)
+	if v.TaskLists != nil {
+		err = multierr.Append(err, enc.AddArray("taskLists", (_List_String_Zapper)(v.TaskLists)))
 	}
-	if v.OwnerHostName != nil {
-		enc.AddString("ownerHostName", *v.OwnerHostName)
+	if v.IsExclusive != nil {
+		enc.AddBool("isExclusive", *v.IsExclusive)
 	}
 	return err
 }
 
-// GetKey returns the value of Key if it is set or its
+// GetTaskLists returns the value of TaskLists if it is set or its
 // zero value if it is unset.
-func (v *TaskListPartitionMetadata) GetKey() (o string) {
-	if v != nil && v.Key != nil {
-		return *v.Key
+func (v *TaskListPredicateAttributes) GetTaskLists() (o []string) {
+	if v != nil && v.TaskLists != nil {
+		return v.TaskLists
 	}
 
 	return
 }
 
-// IsSetKey returns true if Key is not nil.
-func (v *TaskListPartitionMetadata) IsSetKey() bool {
-	return v != nil && v.Key != nil
+// IsSetTaskLists returns true if TaskLists is not nil.
+func (v *TaskListPredicateAttributes) IsSetTaskLists() bool {
+	return v != nil && v.TaskLists != nil
 }
 
-// GetOwnerHostName returns the value of OwnerHostName if it is set or its
+// GetIsExclusive returns the value of IsExclusive if it is set or its
 // zero value if it is unset.
-func (v *TaskListPartitionMetadata) GetOwnerHostName() (o string) {
-	if v != nil && v.OwnerHostName != nil {
-		return *v.OwnerHostName
+func (v *TaskListPredicateAttributes) GetIsExclusive() (o bool) {
+	if v != nil && v.IsExclusive != nil {
+		return *v.IsExclusive
 	}
 
 	return
 }
 
-// IsSetOwnerHostName returns true if OwnerHostName is not nil.
-func (v *TaskListPartitionMetadata) IsSetOwnerHostName() bool {
-	return v != nil && v.OwnerHostName != nil
+// IsSetIsExclusive returns true if IsExclusive is not nil.
+func (v *TaskListPredicateAttributes) IsSetIsExclusive() bool {
+	return v != nil && v.IsExclusive != nil
 }
 
 type TaskListStatus struct {
-	BacklogCountHint      *int64                            `json:"backlogCountHint,omitempty"`
-	ReadLevel             *int64                            `json:"readLevel,omitempty"`
-	AckLevel              *int64                            `json:"ackLevel,omitempty"`
-	RatePerSecond         *float64                          `json:"ratePerSecond,omitempty"`
-	TaskIDBlock           *TaskIDBlock                      `json:"taskIDBlock,omitempty"`
-	IsolationGroupMetrics map[string]*IsolationGroupMetrics `json:"isolationGroupMetrics,omitempty"`
-	NewTasksPerSecond     *float64                          `json:"newTasksPerSecond,omitempty"`
-	Empty                 *bool                             `json:"empty,omitempty"`
+	BacklogCountHint          *int64                            `json:"backlogCountHint,omitempty"`
+	ReadLevel                 *int64                            `json:"readLevel,omitempty"`
+	AckLevel                  *int64                            `json:"ackLevel,omitempty"`
+	RatePerSecond             *float64                          `json:"ratePerSecond,omitempty"`
+	TaskIDBlock               *TaskIDBlock                      `json:"taskIDBlock,omitempty"`
+	IsolationGroupMetrics     map[string]*IsolationGroupMetrics `json:"isolationGroupMetrics,omitempty"`
+	NewTasksPerSecond         *float64                          `json:"newTasksPerSecond,omitempty"`
+	Empty                     *bool                             `json:"empty,omitempty"`
+	BacklogCountByPriority    map[int32]int64                   `json:"backlogCountByPriority,omitempty"`
+	BacklogCountByFairnessKey map[string]int64                  `json:"backlogCountByFairnessKey,omitempty"`
 }
 
 type _Map_String_IsolationGroupMetrics_MapItemList map[string]*IsolationGroupMetrics
@@ -101632,6 +105245,76 @@ func (_Map_String_IsolationGroupMetrics_MapItemList) ValueType() wire.Type {
 
 func (_Map_String_IsolationGroupMetrics_MapItemList) Close() {}
 
+type _Map_I32_I64_MapItemList map[int32]int64
+
+func (m _Map_I32_I64_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueI32(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI64(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_I32_I64_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_I32_I64_MapItemList) KeyType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_I32_I64_MapItemList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_Map_I32_I64_MapItemList) Close() {}
+
+type _Map_String_I64_MapItemList map[string]int64
+
+func (m _Map_String_I64_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI64(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_I64_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_I64_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_I64_MapItemList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_Map_String_I64_MapItemList) Close() {}
+
 // ToWire translates a TaskListStatus struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -101649,7 +105332,7 @@ func (_Map_String_IsolationGroupMetrics_MapItemList) Close() {}
 //	}
 func (v *TaskListStatus) ToWire() (wire.Value, error) {
 	var (
-		fields [8]wire.Field
+		fields [10]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -101719,6 +105402,22 @@ func (v *TaskListStatus) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
+	if v.BacklogCountByPriority != nil {
+		w, err = wire.NewValueMap(_Map_I32_I64_MapItemList(v.BacklogCountByPriority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.BacklogCountByFairnessKey != nil {
+		w, err = wire.NewValueMap(_Map_String_I64_MapItemList(v.BacklogCountByFairnessKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -101763,6 +105462,62 @@ func _Map_String_IsolationGroupMetrics_Read(m wire.MapItemList) (map[string]*Iso
 	return o, err
 }
 
+func _Map_I32_I64_Read(m wire.MapItemList) (map[int32]int64, error) {
+	if m.KeyType() != wire.TI32 {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make(map[int32]int64, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI64(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_String_I64_Read(m wire.MapItemList) (map[string]int64, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make(map[string]int64, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI64(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
 // FromWire deserializes a TaskListStatus struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -101860,6 +105615,22 @@ func (v *TaskListStatus) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 80:
+			if field.Value.Type() == wire.TMap {
+				v.BacklogCountByPriority, err = _Map_I32_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TMap {
+				v.BacklogCountByFairnessKey, err = _Map_String_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -101893,6 +105664,52 @@ func _Map_String_IsolationGroupMetrics_Encode(val map[string]*IsolationGroupMetr
 	return sw.WriteMapEnd()
 }
 
+func _Map_I32_I64_Encode(val map[int32]int64, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TI32,
+		ValueType: wire.TI64,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := sw.WriteInt32(k); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+func _Map_String_I64_Encode(val map[string]int64, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TI64,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
 // Encode serializes a TaskListStatus struct directly into bytes, without going
 // through an intermediary type.
 //
@@ -101998,6 +105815,30 @@ func (v *TaskListStatus) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.BacklogCountByPriority != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_I32_I64_Encode(v.BacklogCountByPriority, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.BacklogCountByFairnessKey != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_I64_Encode(v.BacklogCountByFairnessKey, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -102053,6 +105894,86 @@ func _Map_String_IsolationGroupMetrics_Decode(sr stream.Reader) (map[string]*Iso
 	return o, err
 }
 
+func _Map_I32_I64_Decode(sr stream.Reader) (map[int32]int64, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TI32 || mh.ValueType != wire.TI64 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[int32]int64, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt64()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func _Map_String_I64_Decode(sr stream.Reader) (map[string]int64, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TI64 {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string]int64, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadInt64()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
 // Decode deserializes a TaskListStatus struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -102131,6 +106052,18 @@ func (v *TaskListStatus) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 80 && fh.Type == wire.TMap:
+			v.BacklogCountByPriority, err = _Map_I32_I64_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 90 && fh.Type == wire.TMap:
+			v.BacklogCountByFairnessKey, err = _Map_String_I64_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -102160,7 +106093,7 @@ func (v *TaskListStatus) String() string {
 		return "<nil>"
 	}
 
-	var fields [8]string
+	var fields [10]string
 	i := 0
 	if v.BacklogCountHint != nil {
 		fields[i] = fmt.Sprintf("BacklogCountHint: %v", *(v.BacklogCountHint))
@@ -102194,6 +106127,14 @@ func (v *TaskListStatus) String() string {
 		fields[i] = fmt.Sprintf("Empty: %v", *(v.Empty))
 		i++
 	}
+	if v.BacklogCountByPriority != nil {
+		fields[i] = fmt.Sprintf("BacklogCountByPriority: %v", v.BacklogCountByPriority)
+		i++
+	}
+	if v.BacklogCountByFairnessKey != nil {
+		fields[i] = fmt.Sprintf("BacklogCountByFairnessKey: %v", v.BacklogCountByFairnessKey)
+		i++
+	}
 
 	return fmt.Sprintf("TaskListStatus{%v}", strings.Join(fields[:i], ", "))
 }
@@ -102215,6 +106156,40 @@ func _Map_String_IsolationGroupMetrics_Equals(lhs, rhs map[string]*IsolationGrou
 	return true
 }
 
+func _Map_I32_I64_Equals(lhs, rhs map[int32]int64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_String_I64_Equals(lhs, rhs map[string]int64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
 // Equals returns true if all the fields of this TaskListStatus match the
 // provided TaskListStatus.
 //
@@ -102249,6 +106224,12 @@ func (v *TaskListStatus) Equals(rhs *TaskListStatus) bool {
 	if !_Bool_EqualsPtr(v.Empty, rhs.Empty) {
 		return false
 	}
+	if !((v.BacklogCountByPriority == nil && rhs.BacklogCountByPriority == nil) || (v.BacklogCountByPriority != nil && rhs.BacklogCountByPriority != nil && _Map_I32_I64_Equals(v.BacklogCountByPriority, rhs.BacklogCountByPriority))) {
+		return false
+	}
+	if !((v.BacklogCountByFairnessKey == nil && rhs.BacklogCountByFairnessKey == nil) || (v.BacklogCountByFairnessKey != nil && rhs.BacklogCountByFairnessKey != nil && _Map_String_I64_Equals(v.BacklogCountByFairnessKey, rhs.BacklogCountByFairnessKey))) {
+		return false
+	}
 
 	return true
 }
@@ -102264,6 +106245,41 @@ func (m _Map_String_IsolationGroupMetrics_Zapper) MarshalLogObject(enc zapcore.O
 	return err
 }
 
+type _Map_I32_I64_Item_Zapper struct {
+	Key   int32
+	Value int64
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_I64_Item_Zapper.
+func (v _Map_I32_I64_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddInt32("key", v.Key)
+	enc.AddInt64("value", v.Value)
+	return err
+}
+
+type _Map_I32_I64_Zapper map[int32]int64
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_I64_Zapper.
+func (m _Map_I32_I64_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AppendObject(_Map_I32_I64_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+type _Map_String_I64_Zapper map[string]int64
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_I64_Zapper.
+func (m _Map_String_I64_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddInt64((string)(k), v)
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of TaskListStatus.
 func (v *TaskListStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -102294,6 +106310,12 @@ func (v *TaskListStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error)
 	if v.Empty != nil {
 		enc.AddBool("empty", *v.Empty)
 	}
+	if v.BacklogCountByPriority != nil {
+		err = multierr.Append(err, enc.AddArray("backlogCountByPriority", (_Map_I32_I64_Zapper)(v.BacklogCountByPriority)))
+	}
+	if v.BacklogCountByFairnessKey != nil {
+		err = multierr.Append(err, enc.AddObject("backlogCountByFairnessKey", (_Map_String_I64_Zapper)(v.BacklogCountByFairnessKey)))
+	}
 	return err
 }
 
@@ -102417,6 +106439,36 @@ func (v *TaskListStatus) IsSetEmpty() bool {
 	return v != nil && v.Empty != nil
 }
 
+// GetBacklogCountByPriority returns the value of BacklogCountByPriority if it is set or its
+// zero value if it is unset.
+func (v *TaskListStatus) GetBacklogCountByPriority() (o map[int32]int64) {
+	if v != nil && v.BacklogCountByPriority != nil {
+		return v.BacklogCountByPriority
+	}
+
+	return
+}
+
+// IsSetBacklogCountByPriority returns true if BacklogCountByPriority is not nil.
+func (v *TaskListStatus) IsSetBacklogCountByPriority() bool {
+	return v != nil && v.BacklogCountByPriority != nil
+}
+
+// GetBacklogCountByFairnessKey returns the value of BacklogCountByFairnessKey if it is set or its
+// zero value if it is unset.
+func (v *TaskListStatus) GetBacklogCountByFairnessKey() (o map[string]int64) {
+	if v != nil && v.BacklogCountByFairnessKey != nil {
+		return v.BacklogCountByFairnessKey
+	}
+
+	return
+}
+
+// IsSetBacklogCountByFairnessKey returns true if BacklogCountByFairnessKey is not nil.
+func (v *TaskListStatus) IsSetBacklogCountByFairnessKey() bool {
+	return v != nil && v.BacklogCountByFairnessKey != nil
+}
+
 type TaskListType int32
 
 const (
@@ -102882,6 +106934,276 @@ func (v *TaskRange) IsSetExclusiveMax() bool {
 	return v != nil && v.ExclusiveMax != nil
 }
 
+type TaskTypePredicateAttributes struct {
+	TaskTypes   []int32 `json:"taskTypes,omitempty"`
+	IsExclusive *bool   `json:"isExclusive,omitempty"`
+}
+
+// ToWire translates a TaskTypePredicateAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//		return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//		return err
+//	}
+func (v *TaskTypePredicateAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TaskTypes != nil {
+		w, err = wire.NewValueList(_List_I32_ValueList(v.TaskTypes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.IsExclusive != nil {
+		w, err = wire.NewValueBool(*(v.IsExclusive)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a TaskTypePredicateAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TaskTypePredicateAttributes struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//		return nil, err
+//	}
+//
+//	var v TaskTypePredicateAttributes
+//	if err := v.FromWire(x); err != nil {
+//		return nil, err
+//	}
+//	return &v, nil
+func (v *TaskTypePredicateAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.TaskTypes, err = _List_I32_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.IsExclusive = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a TaskTypePredicateAttributes struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a TaskTypePredicateAttributes struct could not be encoded.
+func (v *TaskTypePredicateAttributes) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.TaskTypes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_I32_Encode(v.TaskTypes, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.IsExclusive != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.IsExclusive)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a TaskTypePredicateAttributes struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a TaskTypePredicateAttributes struct could not be generated from the wire
+// representation.
+func (v *TaskTypePredicateAttributes) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TList:
+			v.TaskTypes, err = _List_I32_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.IsExclusive = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a TaskTypePredicateAttributes
+// struct.
+func (v *TaskTypePredicateAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.TaskTypes != nil {
+		fields[i] = fmt.Sprintf("TaskTypes: %v", v.TaskTypes)
+		i++
+	}
+	if v.IsExclusive != nil {
+		fields[i] = fmt.Sprintf("IsExclusive: %v", *(v.IsExclusive))
+		i++
+	}
+
+	return fmt.Sprintf("TaskTypePredicateAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this TaskTypePredicateAttributes match the
+// provided TaskTypePredicateAttributes.
+//
+// This function performs a deep comparison.
+func (v *TaskTypePredicateAttributes) Equals(rhs *TaskTypePredicateAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.TaskTypes == nil && rhs.TaskTypes == nil) || (v.TaskTypes != nil && rhs.TaskTypes != nil && _List_I32_Equals(v.TaskTypes, rhs.TaskTypes))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.IsExclusive, rhs.IsExclusive) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TaskTypePredicateAttributes.
+func (v *TaskTypePredicateAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TaskTypes != nil {
+		err = multierr.Append(err, enc.AddArray("taskTypes", (_List_I32_Zapper)(v.TaskTypes)))
+	}
+	if v.IsExclusive != nil {
+		enc.AddBool("isExclusive", *v.IsExclusive)
+	}
+	return err
+}
+
+// GetTaskTypes returns the value of TaskTypes if it is set or its
+// zero value if it is unset.
+func (v *TaskTypePredicateAttributes) GetTaskTypes() (o []int32) {
+	if v != nil && v.TaskTypes != nil {
+		return v.TaskTypes
+	}
+
+	return
+}
+
+// IsSetTaskTypes returns true if TaskTypes is not nil.
+func (v *TaskTypePredicateAttributes) IsSetTaskTypes() bool {
+	return v != nil && v.TaskTypes != nil
+}
+
+// GetIsExclusive returns the value of IsExclusive if it is set or its
+// zero value if it is unset.
+func (v *TaskTypePredicateAttributes) GetIsExclusive() (o bool) {
+	if v != nil && v.IsExclusive != nil {
+		return *v.IsExclusive
+	}
+
+	return
+}
+
+// IsSetIsExclusive returns true if IsExclusive is not nil.
+func (v *TaskTypePredicateAttributes) IsSetIsExclusive() bool {
+	return v != nil && v.IsExclusive != nil
+}
+
 type TerminateWorkflowExecutionRequest struct {
 	Domain              *string            `json:"domain,omitempty"`
 	WorkflowExecution   *WorkflowExecution `json:"workflowExecution,omitempty"`
@@ -105039,25 +109361,363 @@ func (v *UniversalPredicateAttributes) Equals(rhs *UniversalPredicateAttributes)
 		return false
 	}
 
-	return true
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of UniversalPredicateAttributes.
+func (v *UniversalPredicateAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
+type UpdateDomainInfo struct {
+	Description *string           `json:"description,omitempty"`
+	OwnerEmail  *string           `json:"ownerEmail,omitempty"`
+	Data        map[string]string `json:"data,omitempty"`
+}
+
+// ToWire translates a UpdateDomainInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//		return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//		return err
+//	}
+func (v *UpdateDomainInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Description != nil {
+		w, err = wire.NewValueString(*(v.Description)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.OwnerEmail != nil {
+		w, err = wire.NewValueString(*(v.OwnerEmail)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Data != nil {
+		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Data)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a UpdateDomainInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a UpdateDomainInfo struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//		return nil, err
+//	}
+//
+//	var v UpdateDomainInfo
+//	if err := v.FromWire(x); err != nil {
+//		return nil, err
+//	}
+//	return &v, nil
+func (v *UpdateDomainInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Description = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.OwnerEmail = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TMap {
+				v.Data, err = _Map_String_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a UpdateDomainInfo struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a UpdateDomainInfo struct could not be encoded.
+func (v *UpdateDomainInfo) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Description != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Description)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.OwnerEmail != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.OwnerEmail)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Data != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_String_Encode(v.Data, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a UpdateDomainInfo struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a UpdateDomainInfo struct could not be generated from the wire
+// representation.
+func (v *UpdateDomainInfo) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Description = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.OwnerEmail = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TMap:
+			v.Data, err = _Map_String_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a UpdateDomainInfo
+// struct.
+func (v *UpdateDomainInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Description != nil {
+		fields[i] = fmt.Sprintf("Description: %v", *(v.Description))
+		i++
+	}
+	if v.OwnerEmail != nil {
+		fields[i] = fmt.Sprintf("OwnerEmail: %v", *(v.OwnerEmail))
+		i++
+	}
+	if v.Data != nil {
+		fields[i] = fmt.Sprintf("Data: %v", v.Data)
+		i++
+	}
+
+	return fmt.Sprintf("UpdateDomainInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this UpdateDomainInfo match the
+// provided UpdateDomainInfo.
+//
+// This function performs a deep comparison.
+func (v *UpdateDomainInfo) Equals(rhs *UpdateDomainInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Description, rhs.Description) {
+		return false
+	}
+	if !_String_EqualsPtr(v.OwnerEmail, rhs.OwnerEmail) {
+		return false
+	}
+	if !((v.Data == nil && rhs.Data == nil) || (v.Data != nil && rhs.Data != nil && _Map_String_String_Equals(v.Data, rhs.Data))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of UpdateDomainInfo.
+func (v *UpdateDomainInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Description != nil {
+		enc.AddString("description", *v.Description)
+	}
+	if v.OwnerEmail != nil {
+		enc.AddString("ownerEmail", *v.OwnerEmail)
+	}
+	if v.Data != nil {
+		err = multierr.Append(err, enc.AddObject("data", (_Map_String_String_Zapper)(v.Data)))
+	}
+	return err
+}
+
+// GetDescription returns the value of Description if it is set or its
+// zero value if it is unset.
+func (v *UpdateDomainInfo) GetDescription() (o string) {
+	if v != nil && v.Description != nil {
+		return *v.Description
+	}
+
+	return
+}
+
+// IsSetDescription returns true if Description is not nil.
+func (v *UpdateDomainInfo) IsSetDescription() bool {
+	return v != nil && v.Description != nil
+}
+
+// GetOwnerEmail returns the value of OwnerEmail if it is set or its
+// zero value if it is unset.
+func (v *UpdateDomainInfo) GetOwnerEmail() (o string) {
+	if v != nil && v.OwnerEmail != nil {
+		return *v.OwnerEmail
+	}
+
+	return
+}
+
+// IsSetOwnerEmail returns true if OwnerEmail is not nil.
+func (v *UpdateDomainInfo) IsSetOwnerEmail() bool {
+	return v != nil && v.OwnerEmail != nil
+}
+
+// GetData returns the value of Data if it is set or its
+// zero value if it is unset.
+func (v *UpdateDomainInfo) GetData() (o map[string]string) {
+	if v != nil && v.Data != nil {
+		return v.Data
+	}
+
+	return
 }
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of UniversalPredicateAttributes.
-func (v *UniversalPredicateAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
-	}
-	return err
+// IsSetData returns true if Data is not nil.
+func (v *UpdateDomainInfo) IsSetData() bool {
+	return v != nil && v.Data != nil
 }
 
-type UpdateDomainInfo struct {
-	Description *string           `json:"description,omitempty"`
-	OwnerEmail  *string           `json:"ownerEmail,omitempty"`
-	Data        map[string]string `json:"data,omitempty"`
+type UpdateDomainRequest struct {
+	Name                     *string                         `json:"name,omitempty"`
+	UpdatedInfo              *UpdateDomainInfo               `json:"updatedInfo,omitempty"`
+	Configuration            *DomainConfiguration            `json:"configuration,omitempty"`
+	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
+	SecurityToken            *string                         `json:"securityToken,omitempty"`
+	DeleteBadBinary          *string                         `json:"deleteBadBinary,omitempty"`
+	FailoverTimeoutInSeconds *int32                          `json:"failoverTimeoutInSeconds,omitempty"`
 }
 
-// ToWire translates a UpdateDomainInfo struct into a Thrift-level intermediate
+// ToWire translates a UpdateDomainRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -105072,47 +109732,85 @@ type UpdateDomainInfo struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //		return err
 //	}
-func (v *UpdateDomainInfo) ToWire() (wire.Value, error) {
+func (v *UpdateDomainRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Description != nil {
-		w, err = wire.NewValueString(*(v.Description)), error(nil)
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.OwnerEmail != nil {
-		w, err = wire.NewValueString(*(v.OwnerEmail)), error(nil)
+	if v.UpdatedInfo != nil {
+		w, err = v.UpdatedInfo.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Data != nil {
-		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Data)), error(nil)
+	if v.Configuration != nil {
+		w, err = v.Configuration.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.ReplicationConfiguration != nil {
+		w, err = v.ReplicationConfiguration.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.SecurityToken != nil {
+		w, err = wire.NewValueString(*(v.SecurityToken)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.DeleteBadBinary != nil {
+		w, err = wire.NewValueString(*(v.DeleteBadBinary)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.FailoverTimeoutInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.FailoverTimeoutInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a UpdateDomainInfo struct from its Thrift-level
+func _UpdateDomainInfo_Read(w wire.Value) (*UpdateDomainInfo, error) {
+	var v UpdateDomainInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a UpdateDomainRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a UpdateDomainInfo struct
+// An error is returned if we were unable to build a UpdateDomainRequest struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -105120,12 +109818,12 @@ func (v *UpdateDomainInfo) ToWire() (wire.Value, error) {
 //		return nil, err
 //	}
 //
-//	var v UpdateDomainInfo
+//	var v UpdateDomainRequest
 //	if err := v.FromWire(x); err != nil {
 //		return nil, err
 //	}
 //	return &v, nil
-func (v *UpdateDomainInfo) FromWire(w wire.Value) error {
+func (v *UpdateDomainRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -105134,25 +109832,61 @@ func (v *UpdateDomainInfo) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Description = &x
+				v.Name = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.UpdatedInfo, err = _UpdateDomainInfo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.Configuration, err = _DomainConfiguration_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.ReplicationConfiguration, err = _DomainReplicationConfiguration_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.OwnerEmail = &x
+				v.SecurityToken = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
-			if field.Value.Type() == wire.TMap {
-				v.Data, err = _Map_String_String_Read(field.Value.GetMap())
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DeleteBadBinary = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.FailoverTimeoutInSeconds = &x
 				if err != nil {
 					return err
 				}
@@ -105164,20 +109898,20 @@ func (v *UpdateDomainInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a UpdateDomainInfo struct directly into bytes, without going
+// Encode serializes a UpdateDomainRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a UpdateDomainInfo struct could not be encoded.
-func (v *UpdateDomainInfo) Encode(sw stream.Writer) error {
+// An error is returned if a UpdateDomainRequest struct could not be encoded.
+func (v *UpdateDomainRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Description != nil {
+	if v.Name != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Description)); err != nil {
+		if err := sw.WriteString(*(v.Name)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -105185,11 +109919,11 @@ func (v *UpdateDomainInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.OwnerEmail != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+	if v.UpdatedInfo != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.OwnerEmail)); err != nil {
+		if err := v.UpdatedInfo.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -105197,11 +109931,59 @@ func (v *UpdateDomainInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Data != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TMap}); err != nil {
+	if v.Configuration != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := _Map_String_String_Encode(v.Data, sw); err != nil {
+		if err := v.Configuration.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ReplicationConfiguration != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.ReplicationConfiguration.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.SecurityToken != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.SecurityToken)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DeleteBadBinary != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.DeleteBadBinary)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.FailoverTimeoutInSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.FailoverTimeoutInSeconds)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -105212,12 +109994,18 @@ func (v *UpdateDomainInfo) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a UpdateDomainInfo struct directly from its Thrift-level
+func _UpdateDomainInfo_Decode(sr stream.Reader) (*UpdateDomainInfo, error) {
+	var v UpdateDomainInfo
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a UpdateDomainRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a UpdateDomainInfo struct could not be generated from the wire
+// An error is returned if a UpdateDomainRequest struct could not be generated from the wire
 // representation.
-func (v *UpdateDomainInfo) Decode(sr stream.Reader) error {
+func (v *UpdateDomainRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -105233,21 +110021,49 @@ func (v *UpdateDomainInfo) Decode(sr stream.Reader) error {
 		case fh.ID == 10 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.Description = &x
+			v.Name = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TBinary:
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.UpdatedInfo, err = _UpdateDomainInfo_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TStruct:
+			v.Configuration, err = _DomainConfiguration_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TStruct:
+			v.ReplicationConfiguration, err = _DomainReplicationConfiguration_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.OwnerEmail = &x
+			v.SecurityToken = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TMap:
-			v.Data, err = _Map_String_String_Decode(sr)
+		case fh.ID == 60 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.DeleteBadBinary = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 70 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.FailoverTimeoutInSeconds = &x
 			if err != nil {
 				return err
 			}
@@ -105274,48 +110090,76 @@ func (v *UpdateDomainInfo) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a UpdateDomainInfo
+// String returns a readable string representation of a UpdateDomainRequest
 // struct.
-func (v *UpdateDomainInfo) String() string {
+func (v *UpdateDomainRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [7]string
 	i := 0
-	if v.Description != nil {
-		fields[i] = fmt.Sprintf("Description: %v", *(v.Description))
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
 		i++
 	}
-	if v.OwnerEmail != nil {
-		fields[i] = fmt.Sprintf("OwnerEmail: %v", *(v.OwnerEmail))
+	if v.UpdatedInfo != nil {
+		fields[i] = fmt.Sprintf("UpdatedInfo: %v", v.UpdatedInfo)
 		i++
 	}
-	if v.Data != nil {
-		fields[i] = fmt.Sprintf("Data: %v", v.Data)
+	if v.Configuration != nil {
+		fields[i] = fmt.Sprintf("Configuration: %v", v.Configuration)
+		i++
+	}
+	if v.ReplicationConfiguration != nil {
+		fields[i] = fmt.Sprintf("ReplicationConfiguration: %v", v.ReplicationConfiguration)
+		i++
+	}
+	if v.SecurityToken != nil {
+		fields[i] = fmt.Sprintf("SecurityToken: %v", *(v.SecurityToken))
+		i++
+	}
+	if v.DeleteBadBinary != nil {
+		fields[i] = fmt.Sprintf("DeleteBadBinary: %v", *(v.DeleteBadBinary))
+		i++
+	}
+	if v.FailoverTimeoutInSeconds != nil {
+		fields[i] = fmt.Sprintf("FailoverTimeoutInSeconds: %v", *(v.FailoverTimeoutInSeconds))
 		i++
 	}
 
-	return fmt.Sprintf("UpdateDomainInfo{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("UpdateDomainRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this UpdateDomainInfo match the
-// provided UpdateDomainInfo.
+// Equals returns true if all the fields of this UpdateDomainRequest match the
+// provided UpdateDomainRequest.
 //
 // This function performs a deep comparison.
-func (v *UpdateDomainInfo) Equals(rhs *UpdateDomainInfo) bool {
+func (v *UpdateDomainRequest) Equals(rhs *UpdateDomainRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Description, rhs.Description) {
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
 		return false
 	}
-	if !_String_EqualsPtr(v.OwnerEmail, rhs.OwnerEmail) {
+	if !((v.UpdatedInfo == nil && rhs.UpdatedInfo == nil) || (v.UpdatedInfo != nil && rhs.UpdatedInfo != nil && v.UpdatedInfo.Equals(rhs.UpdatedInfo))) {
 		return false
 	}
-	if !((v.Data == nil && rhs.Data == nil) || (v.Data != nil && rhs.Data != nil && _Map_String_String_Equals(v.Data, rhs.Data))) {
+	if !((v.Configuration == nil && rhs.Configuration == nil) || (v.Configuration != nil && rhs.Configuration != nil && v.Configuration.Equals(rhs.Configuration))) {
+		return false
+	}
+	if !((v.ReplicationConfiguration == nil && rhs.ReplicationConfiguration == nil) || (v.ReplicationConfiguration != nil && rhs.ReplicationConfiguration != nil && v.ReplicationConfiguration.Equals(rhs.ReplicationConfiguration))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.SecurityToken, rhs.SecurityToken) {
+		return false
+	}
+	if !_String_EqualsPtr(v.DeleteBadBinary, rhs.DeleteBadBinary) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.FailoverTimeoutInSeconds, rhs.FailoverTimeoutInSeconds) {
 		return false
 	}
 
@@ -105323,79 +110167,149 @@ func (v *UpdateDomainInfo) Equals(rhs *UpdateDomainInfo) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of UpdateDomainInfo.
-func (v *UpdateDomainInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of UpdateDomainRequest.
+func (v *UpdateDomainRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Description != nil {
-		enc.AddString("description", *v.Description)
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
 	}
-	if v.OwnerEmail != nil {
-		enc.AddString("ownerEmail", *v.OwnerEmail)
+	if v.UpdatedInfo != nil {
+		err = multierr.Append(err, enc.AddObject("updatedInfo", v.UpdatedInfo))
 	}
-	if v.Data != nil {
-		err = multierr.Append(err, enc.AddObject("data", (_Map_String_String_Zapper)(v.Data)))
+	if v.Configuration != nil {
+		err = multierr.Append(err, enc.AddObject("configuration", v.Configuration))
+	}
+	if v.ReplicationConfiguration != nil {
+		err = multierr.Append(err, enc.AddObject("replicationConfiguration", v.ReplicationConfiguration))
+	}
+	if v.SecurityToken != nil {
+		enc.AddString("securityToken", *v.SecurityToken)
+	}
+	if v.DeleteBadBinary != nil {
+		enc.AddString("deleteBadBinary", *v.DeleteBadBinary)
+	}
+	if v.FailoverTimeoutInSeconds != nil {
+		enc.AddInt32("failoverTimeoutInSeconds", *v.FailoverTimeoutInSeconds)
 	}
 	return err
 }
 
-// GetDescription returns the value of Description if it is set or its
+// GetName returns the value of Name if it is set or its
 // zero value if it is unset.
-func (v *UpdateDomainInfo) GetDescription() (o string) {
-	if v != nil && v.Description != nil {
-		return *v.Description
+func (v *UpdateDomainRequest) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
 	}
 
 	return
 }
 
-// IsSetDescription returns true if Description is not nil.
-func (v *UpdateDomainInfo) IsSetDescription() bool {
-	return v != nil && v.Description != nil
+// IsSetName returns true if Name is not nil.
+func (v *UpdateDomainRequest) IsSetName() bool {
+	return v != nil && v.Name != nil
 }
 
-// GetOwnerEmail returns the value of OwnerEmail if it is set or its
+// GetUpdatedInfo returns the value of UpdatedInfo if it is set or its
 // zero value if it is unset.
-func (v *UpdateDomainInfo) GetOwnerEmail() (o string) {
-	if v != nil && v.OwnerEmail != nil {
-		return *v.OwnerEmail
+func (v *UpdateDomainRequest) GetUpdatedInfo() (o *UpdateDomainInfo) {
+	if v != nil && v.UpdatedInfo != nil {
+		return v.UpdatedInfo
 	}
 
 	return
 }
 
-// IsSetOwnerEmail returns true if OwnerEmail is not nil.
-func (v *UpdateDomainInfo) IsSetOwnerEmail() bool {
-	return v != nil && v.OwnerEmail != nil
+// IsSetUpdatedInfo returns true if UpdatedInfo is not nil.
+func (v *UpdateDomainRequest) IsSetUpdatedInfo() bool {
+	return v != nil && v.UpdatedInfo != nil
 }
 
-// GetData returns the value of Data if it is set or its
+// GetConfiguration returns the value of Configuration if it is set or its
 // zero value if it is unset.
-func (v *UpdateDomainInfo) GetData() (o map[string]string) {
-	if v != nil && v.Data != nil {
-		return v.Data
+func (v *UpdateDomainRequest) GetConfiguration() (o *DomainConfiguration) {
+	if v != nil && v.Configuration != nil {
+		return v.Configuration
 	}
 
 	return
 }
 
-// IsSetData returns true if Data is not nil.
-func (v *UpdateDomainInfo) IsSetData() bool {
-	return v != nil && v.Data != nil
+// IsSetConfiguration returns true if Configuration is not nil.
+func (v *UpdateDomainRequest) IsSetConfiguration() bool {
+	return v != nil && v.Configuration != nil
 }
 
-type UpdateDomainRequest struct {
-	Name                     *string                         `json:"name,omitempty"`
-	UpdatedInfo              *UpdateDomainInfo               `json:"updatedInfo,omitempty"`
+// GetReplicationConfiguration returns the value of ReplicationConfiguration if it is set or its
+// zero value if it is unset.
+func (v *UpdateDomainRequest) GetReplicationConfiguration() (o *DomainReplicationConfiguration) {
+	if v != nil && v.ReplicationConfiguration != nil {
+		return v.ReplicationConfiguration
+	}
+
+	return
+}
+
+// IsSetReplicationConfiguration returns true if ReplicationConfiguration is not nil.
+func (v *UpdateDomainRequest) IsSetReplicationConfiguration() bool {
+	return v != nil && v.ReplicationConfiguration != nil
+}
+
+// GetSecurityToken returns the value of SecurityToken if it is set or its
+// zero value if it is unset.
+func (v *UpdateDomainRequest) GetSecurityToken() (o string) {
+	if v != nil && v.SecurityToken != nil {
+		return *v.SecurityToken
+	}
+
+	return
+}
+
+// IsSetSecurityToken returns true if SecurityToken is not nil.
+func (v *UpdateDomainRequest) IsSetSecurityToken() bool {
+	return v != nil && v.SecurityToken != nil
+}
+
+// GetDeleteBadBinary returns the value of DeleteBadBinary if it is set or its
+// zero value if it is unset.
+func (v *UpdateDomainRequest) GetDeleteBadBinary() (o string) {
+	if v != nil && v.DeleteBadBinary != nil {
+		return *v.DeleteBadBinary
+	}
+
+	return
+}
+
+// IsSetDeleteBadBinary returns true if DeleteBadBinary is not nil.
+func (v *UpdateDomainRequest) IsSetDeleteBadBinary() bool {
+	return v != nil && v.DeleteBadBinary != nil
+}
+
+// GetFailoverTimeoutInSeconds returns the value of FailoverTimeoutInSeconds if it is set or its
+// zero value if it is unset.
+func (v *UpdateDomainRequest) GetFailoverTimeoutInSeconds() (o int32) {
+	if v != nil && v.FailoverTimeoutInSeconds != nil {
+		return *v.FailoverTimeoutInSeconds
+	}
+
+	return
+}
+
+// IsSetFailoverTimeoutInSeconds returns true if FailoverTimeoutInSeconds is not nil.
+func (v *UpdateDomainRequest) IsSetFailoverTimeoutInSeconds() bool {
+	return v != nil && v.FailoverTimeoutInSeconds != nil
+}
+
+type UpdateDomainResponse struct {
+	DomainInfo               *DomainInfo                     `json:"domainInfo,omitempty"`
 	Configuration            *DomainConfiguration            `json:"configuration,omitempty"`
 	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
-	SecurityToken            *string                         `json:"securityToken,omitempty"`
-	DeleteBadBinary          *string                         `json:"deleteBadBinary,omitempty"`
-	FailoverTimeoutInSeconds *int32                          `json:"failoverTimeoutInSeconds,omitempty"`
+	FailoverVersion          *int64                          `json:"failoverVersion,omitempty"`
+	IsGlobalDomain           *bool                           `json:"isGlobalDomain,omitempty"`
 }
 
-// ToWire translates a UpdateDomainRequest struct into a Thrift-level intermediate
+// ToWire translates a UpdateDomainResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -105410,36 +110324,28 @@ type UpdateDomainRequest struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //		return err
 //	}
-func (v *UpdateDomainRequest) ToWire() (wire.Value, error) {
+func (v *UpdateDomainResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Name != nil {
-		w, err = wire.NewValueString(*(v.Name)), error(nil)
+	if v.DomainInfo != nil {
+		w, err = v.DomainInfo.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.UpdatedInfo != nil {
-		w, err = v.UpdatedInfo.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
 	if v.Configuration != nil {
 		w, err = v.Configuration.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
 	if v.ReplicationConfiguration != nil {
@@ -105447,48 +110353,34 @@ func (v *UpdateDomainRequest) ToWire() (wire.Value, error) {
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.SecurityToken != nil {
-		w, err = wire.NewValueString(*(v.SecurityToken)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.DeleteBadBinary != nil {
-		w, err = wire.NewValueString(*(v.DeleteBadBinary)), error(nil)
+	if v.FailoverVersion != nil {
+		w, err = wire.NewValueI64(*(v.FailoverVersion)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 60, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.FailoverTimeoutInSeconds != nil {
-		w, err = wire.NewValueI32(*(v.FailoverTimeoutInSeconds)), error(nil)
+	if v.IsGlobalDomain != nil {
+		w, err = wire.NewValueBool(*(v.IsGlobalDomain)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 70, Value: w}
+		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _UpdateDomainInfo_Read(w wire.Value) (*UpdateDomainInfo, error) {
-	var v UpdateDomainInfo
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a UpdateDomainRequest struct from its Thrift-level
+// FromWire deserializes a UpdateDomainResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a UpdateDomainRequest struct
+// An error is returned if we were unable to build a UpdateDomainResponse struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -105496,21 +110388,19 @@ func _UpdateDomainInfo_Read(w wire.Value) (*UpdateDomainInfo, error) {
 //		return nil, err
 //	}
 //
-//	var v UpdateDomainRequest
+//	var v UpdateDomainResponse
 //	if err := v.FromWire(x); err != nil {
 //		return nil, err
 //	}
 //	return &v, nil
-func (v *UpdateDomainRequest) FromWire(w wire.Value) error {
+func (v *UpdateDomainResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Name = &x
+			if field.Value.Type() == wire.TStruct {
+				v.DomainInfo, err = _DomainInfo_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -105518,7 +110408,7 @@ func (v *UpdateDomainRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.UpdatedInfo, err = _UpdateDomainInfo_Read(field.Value)
+				v.Configuration, err = _DomainConfiguration_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -105526,45 +110416,27 @@ func (v *UpdateDomainRequest) FromWire(w wire.Value) error {
 			}
 		case 30:
 			if field.Value.Type() == wire.TStruct {
-				v.Configuration, err = _DomainConfiguration_Read(field.Value)
+				v.ReplicationConfiguration, err = _DomainReplicationConfiguration_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.ReplicationConfiguration, err = _DomainReplicationConfiguration_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FailoverVersion = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 50:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.SecurityToken = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DeleteBadBinary = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.FailoverTimeoutInSeconds = &x
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.IsGlobalDomain = &x
 				if err != nil {
 					return err
 				}
@@ -105576,32 +110448,20 @@ func (v *UpdateDomainRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a UpdateDomainRequest struct directly into bytes, without going
+// Encode serializes a UpdateDomainResponse struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a UpdateDomainRequest struct could not be encoded.
-func (v *UpdateDomainRequest) Encode(sw stream.Writer) error {
+// An error is returned if a UpdateDomainResponse struct could not be encoded.
+func (v *UpdateDomainResponse) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Name != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.Name)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.UpdatedInfo != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+	if v.DomainInfo != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.UpdatedInfo.Encode(sw); err != nil {
+		if err := v.DomainInfo.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -105610,7 +110470,7 @@ func (v *UpdateDomainRequest) Encode(sw stream.Writer) error {
 	}
 
 	if v.Configuration != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
 			return err
 		}
 		if err := v.Configuration.Encode(sw); err != nil {
@@ -105622,7 +110482,7 @@ func (v *UpdateDomainRequest) Encode(sw stream.Writer) error {
 	}
 
 	if v.ReplicationConfiguration != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TStruct}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
 			return err
 		}
 		if err := v.ReplicationConfiguration.Encode(sw); err != nil {
@@ -105633,23 +110493,11 @@ func (v *UpdateDomainRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.SecurityToken != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.SecurityToken)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.DeleteBadBinary != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBinary}); err != nil {
+	if v.FailoverVersion != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.DeleteBadBinary)); err != nil {
+		if err := sw.WriteInt64(*(v.FailoverVersion)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -105657,11 +110505,11 @@ func (v *UpdateDomainRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.FailoverTimeoutInSeconds != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TI32}); err != nil {
+	if v.IsGlobalDomain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TBool}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt32(*(v.FailoverTimeoutInSeconds)); err != nil {
+		if err := sw.WriteBool(*(v.IsGlobalDomain)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -105672,18 +110520,12 @@ func (v *UpdateDomainRequest) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _UpdateDomainInfo_Decode(sr stream.Reader) (*UpdateDomainInfo, error) {
-	var v UpdateDomainInfo
-	err := v.Decode(sr)
-	return &v, err
-}
-
-// Decode deserializes a UpdateDomainRequest struct directly from its Thrift-level
+// Decode deserializes a UpdateDomainResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a UpdateDomainRequest struct could not be generated from the wire
+// An error is returned if a UpdateDomainResponse struct could not be generated from the wire
 // representation.
-func (v *UpdateDomainRequest) Decode(sr stream.Reader) error {
+func (v *UpdateDomainResponse) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -105696,52 +110538,36 @@ func (v *UpdateDomainRequest) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Name = &x
+		case fh.ID == 10 && fh.Type == wire.TStruct:
+			v.DomainInfo, err = _DomainInfo_Decode(sr)
 			if err != nil {
 				return err
 			}
 
 		case fh.ID == 20 && fh.Type == wire.TStruct:
-			v.UpdatedInfo, err = _UpdateDomainInfo_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TStruct:
 			v.Configuration, err = _DomainConfiguration_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 40 && fh.Type == wire.TStruct:
+		case fh.ID == 30 && fh.Type == wire.TStruct:
 			v.ReplicationConfiguration, err = _DomainReplicationConfiguration_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 50 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.SecurityToken = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 60 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.DeleteBadBinary = &x
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.FailoverVersion = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 70 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.FailoverTimeoutInSeconds = &x
+		case fh.ID == 50 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.IsGlobalDomain = &x
 			if err != nil {
 				return err
 			}
@@ -105768,21 +110594,17 @@ func (v *UpdateDomainRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a UpdateDomainRequest
+// String returns a readable string representation of a UpdateDomainResponse
 // struct.
-func (v *UpdateDomainRequest) String() string {
+func (v *UpdateDomainResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [5]string
 	i := 0
-	if v.Name != nil {
-		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
-		i++
-	}
-	if v.UpdatedInfo != nil {
-		fields[i] = fmt.Sprintf("UpdatedInfo: %v", v.UpdatedInfo)
+	if v.DomainInfo != nil {
+		fields[i] = fmt.Sprintf("DomainInfo: %v", v.DomainInfo)
 		i++
 	}
 	if v.Configuration != nil {
@@ -105793,36 +110615,29 @@ func (v *UpdateDomainRequest) String() string {
 		fields[i] = fmt.Sprintf("ReplicationConfiguration: %v", v.ReplicationConfiguration)
 		i++
 	}
-	if v.SecurityToken != nil {
-		fields[i] = fmt.Sprintf("SecurityToken: %v", *(v.SecurityToken))
-		i++
-	}
-	if v.DeleteBadBinary != nil {
-		fields[i] = fmt.Sprintf("DeleteBadBinary: %v", *(v.DeleteBadBinary))
+	if v.FailoverVersion != nil {
+		fields[i] = fmt.Sprintf("FailoverVersion: %v", *(v.FailoverVersion))
 		i++
 	}
-	if v.FailoverTimeoutInSeconds != nil {
-		fields[i] = fmt.Sprintf("FailoverTimeoutInSeconds: %v", *(v.FailoverTimeoutInSeconds))
+	if v.IsGlobalDomain != nil {
+		fields[i] = fmt.Sprintf("IsGlobalDomain: %v", *(v.IsGlobalDomain))
 		i++
 	}
 
-	return fmt.Sprintf("UpdateDomainRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("UpdateDomainResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this UpdateDomainRequest match the
-// provided UpdateDomainRequest.
+// Equals returns true if all the fields of this UpdateDomainResponse match the
+// provided UpdateDomainResponse.
 //
 // This function performs a deep comparison.
-func (v *UpdateDomainRequest) Equals(rhs *UpdateDomainRequest) bool {
+func (v *UpdateDomainResponse) Equals(rhs *UpdateDomainResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Name, rhs.Name) {
-		return false
-	}
-	if !((v.UpdatedInfo == nil && rhs.UpdatedInfo == nil) || (v.UpdatedInfo != nil && rhs.UpdatedInfo != nil && v.UpdatedInfo.Equals(rhs.UpdatedInfo))) {
+	if !((v.DomainInfo == nil && rhs.DomainInfo == nil) || (v.DomainInfo != nil && rhs.DomainInfo != nil && v.DomainInfo.Equals(rhs.DomainInfo))) {
 		return false
 	}
 	if !((v.Configuration == nil && rhs.Configuration == nil) || (v.Configuration != nil && rhs.Configuration != nil && v.Configuration.Equals(rhs.Configuration))) {
@@ -105831,13 +110646,10 @@ func (v *UpdateDomainRequest) Equals(rhs *UpdateDomainRequest) bool {
 	if !((v.ReplicationConfiguration == nil && rhs.ReplicationConfiguration == nil) || (v.ReplicationConfiguration != nil && rhs.ReplicationConfiguration != nil && v.ReplicationConfiguration.Equals(rhs.ReplicationConfiguration))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.SecurityToken, rhs.SecurityToken) {
-		return false
-	}
-	if !_String_EqualsPtr(v.DeleteBadBinary, rhs.DeleteBadBinary) {
+	if !_I64_EqualsPtr(v.FailoverVersion, rhs.FailoverVersion) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.FailoverTimeoutInSeconds, rhs.FailoverTimeoutInSeconds) {
+	if !_Bool_EqualsPtr(v.IsGlobalDomain, rhs.IsGlobalDomain) {
 		return false
 	}
 
@@ -105845,16 +110657,13 @@ func (v *UpdateDomainRequest) Equals(rhs *UpdateDomainRequest) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of UpdateDomainRequest.
-func (v *UpdateDomainRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of UpdateDomainResponse.
+func (v *UpdateDomainResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Name != nil {
-		enc.AddString("name", *v.Name)
-	}
-	if v.UpdatedInfo != nil {
-		err = multierr.Append(err, enc.AddObject("updatedInfo", v.UpdatedInfo))
+	if v.DomainInfo != nil {
+		err = multierr.Append(err, enc.AddObject("domainInfo", v.DomainInfo))
 	}
 	if v.Configuration != nil {
 		err = multierr.Append(err, enc.AddObject("configuration", v.Configuration))
@@ -105862,51 +110671,33 @@ func (v *UpdateDomainRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err e
 	if v.ReplicationConfiguration != nil {
 		err = multierr.Append(err, enc.AddObject("replicationConfiguration", v.ReplicationConfiguration))
 	}
-	if v.SecurityToken != nil {
-		enc.AddString("securityToken", *v.SecurityToken)
-	}
-	if v.DeleteBadBinary != nil {
-		enc.AddString("deleteBadBinary", *v.DeleteBadBinary)
+	if v.FailoverVersion != nil {
+		enc.AddInt64("failoverVersion", *v.FailoverVersion)
 	}
-	if v.FailoverTimeoutInSeconds != nil {
-		enc.AddInt32("failoverTimeoutInSeconds", *v.FailoverTimeoutInSeconds)
+	if v.IsGlobalDomain != nil {
+		enc.AddBool("isGlobalDomain", *v.IsGlobalDomain)
 	}
 	return err
 }
 
-// GetName returns the value of Name if it is set or its
-// zero value if it is unset.
-func (v *UpdateDomainRequest) GetName() (o string) {
-	if v != nil && v.Name != nil {
-		return *v.Name
-	}
-
-	return
-}
-
-// IsSetName returns true if Name is not nil.
-func (v *UpdateDomainRequest) IsSetName() bool {
-	return v != nil && v.Name != nil
-}
-
-// GetUpdatedInfo returns the value of UpdatedInfo if it is set or its
+// GetDomainInfo returns the value of DomainInfo if it is set or its
 // zero value if it is unset.
-func (v *UpdateDomainRequest) GetUpdatedInfo() (o *UpdateDomainInfo) {
-	if v != nil && v.UpdatedInfo != nil {
-		return v.UpdatedInfo
+func (v *UpdateDomainResponse) GetDomainInfo() (o *DomainInfo) {
+	if v != nil && v.DomainInfo != nil {
+		return v.DomainInfo
 	}
 
 	return
 }
 
-// IsSetUpdatedInfo returns true if UpdatedInfo is not nil.
-func (v *UpdateDomainRequest) IsSetUpdatedInfo() bool {
-	return v != nil && v.UpdatedInfo != nil
+// IsSetDomainInfo returns true if DomainInfo is not nil.
+func (v *UpdateDomainResponse) IsSetDomainInfo() bool {
+	return v != nil && v.DomainInfo != nil
 }
 
 // GetConfiguration returns the value of Configuration if it is set or its
 // zero value if it is unset.
-func (v *UpdateDomainRequest) GetConfiguration() (o *DomainConfiguration) {
+func (v *UpdateDomainResponse) GetConfiguration() (o *DomainConfiguration) {
 	if v != nil && v.Configuration != nil {
 		return v.Configuration
 	}
@@ -105915,13 +110706,13 @@ func (v *UpdateDomainRequest) GetConfiguration() (o *DomainConfiguration) {
 }
 
 // IsSetConfiguration returns true if Configuration is not nil.
-func (v *UpdateDomainRequest) IsSetConfiguration() bool {
+func (v *UpdateDomainResponse) IsSetConfiguration() bool {
 	return v != nil && v.Configuration != nil
 }
 
 // GetReplicationConfiguration returns the value of ReplicationConfiguration if it is set or its
 // zero value if it is unset.
-func (v *UpdateDomainRequest) GetReplicationConfiguration() (o *DomainReplicationConfiguration) {
+func (v *UpdateDomainResponse) GetReplicationConfiguration() (o *DomainReplicationConfiguration) {
 	if v != nil && v.ReplicationConfiguration != nil {
 		return v.ReplicationConfiguration
 	}
@@ -105930,64 +110721,52 @@ func (v *UpdateDomainRequest) GetReplicationConfiguration() (o *DomainReplicatio
 }
 
 // IsSetReplicationConfiguration returns true if ReplicationConfiguration is not nil.
-func (v *UpdateDomainRequest) IsSetReplicationConfiguration() bool {
+func (v *UpdateDomainResponse) IsSetReplicationConfiguration() bool {
 	return v != nil && v.ReplicationConfiguration != nil
 }
 
-// GetSecurityToken returns the value of SecurityToken if it is set or its
-// zero value if it is unset.
-func (v *UpdateDomainRequest) GetSecurityToken() (o string) {
-	if v != nil && v.SecurityToken != nil {
-		return *v.SecurityToken
-	}
-
-	return
-}
-
-// IsSetSecurityToken returns true if SecurityToken is not nil.
-func (v *UpdateDomainRequest) IsSetSecurityToken() bool {
-	return v != nil && v.SecurityToken != nil
-}
-
-// GetDeleteBadBinary returns the value of DeleteBadBinary if it is set or its
+// GetFailoverVersion returns the value of FailoverVersion if it is set or its
 // zero value if it is unset.
-func (v *UpdateDomainRequest) GetDeleteBadBinary() (o string) {
-	if v != nil && v.DeleteBadBinary != nil {
-		return *v.DeleteBadBinary
+func (v *UpdateDomainResponse) GetFailoverVersion() (o int64) {
+	if v != nil && v.FailoverVersion != nil {
+		return *v.FailoverVersion
 	}
 
 	return
 }
 
-// IsSetDeleteBadBinary returns true if DeleteBadBinary is not nil.
-func (v *UpdateDomainRequest) IsSetDeleteBadBinary() bool {
-	return v != nil && v.DeleteBadBinary != nil
+// IsSetFailoverVersion returns true if FailoverVersion is not nil.
+func (v *UpdateDomainResponse) IsSetFailoverVersion() bool {
+	return v != nil && v.FailoverVersion != nil
 }
 
-// GetFailoverTimeoutInSeconds returns the value of FailoverTimeoutInSeconds if it is set or its
+// GetIsGlobalDomain returns the value of IsGlobalDomain if it is set or its
 // zero value if it is unset.
-func (v *UpdateDomainRequest) GetFailoverTimeoutInSeconds() (o int32) {
-	if v != nil && v.FailoverTimeoutInSeconds != nil {
-		return *v.FailoverTimeoutInSeconds
+func (v *UpdateDomainResponse) GetIsGlobalDomain() (o bool) {
+	if v != nil && v.IsGlobalDomain != nil {
+		return *v.IsGlobalDomain
 	}
 
 	return
 }
 
-// IsSetFailoverTimeoutInSeconds returns true if FailoverTimeoutInSeconds is not nil.
-func (v *UpdateDomainRequest) IsSetFailoverTimeoutInSeconds() bool {
-	return v != nil && v.FailoverTimeoutInSeconds != nil
+// IsSetIsGlobalDomain returns true if IsGlobalDomain is not nil.
+func (v *UpdateDomainResponse) IsSetIsGlobalDomain() bool {
+	return v != nil && v.IsGlobalDomain != nil
 }
 
-type UpdateDomainResponse struct {
-	DomainInfo               *DomainInfo                     `json:"domainInfo,omitempty"`
-	Configuration            *DomainConfiguration            `json:"configuration,omitempty"`
-	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
-	FailoverVersion          *int64                          `json:"failoverVersion,omitempty"`
-	IsGlobalDomain           *bool                           `json:"isGlobalDomain,omitempty"`
+type UpdateVirtualQueueRequest struct {
+	ShardID                *int32                 `json:"shardID,omitempty"`
+	ClusterName            *string                `json:"clusterName,omitempty"`
+	Type                   *int32                 `json:"type,omitempty"`
+	Operation              *VirtualQueueOperation `json:"operation,omitempty"`
+	VirtualQueueID         *int64                 `json:"virtualQueueID,omitempty"`
+	TargetVirtualQueueID   *int64                 `json:"targetVirtualQueueID,omitempty"`
+	DomainIDs              []string               `json:"domainIDs,omitempty"`
+	PauseDurationInSeconds *int32                 `json:"pauseDurationInSeconds,omitempty"`
 }
 
-// ToWire translates a UpdateDomainResponse struct into a Thrift-level intermediate
+// ToWire translates a UpdateVirtualQueueRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -106002,63 +110781,93 @@ type UpdateDomainResponse struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //		return err
 //	}
-func (v *UpdateDomainResponse) ToWire() (wire.Value, error) {
+func (v *UpdateVirtualQueueRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainInfo != nil {
-		w, err = v.DomainInfo.ToWire()
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Configuration != nil {
-		w, err = v.Configuration.ToWire()
+	if v.ClusterName != nil {
+		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ReplicationConfiguration != nil {
-		w, err = v.ReplicationConfiguration.ToWire()
+	if v.Type != nil {
+		w, err = wire.NewValueI32(*(v.Type)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.FailoverVersion != nil {
-		w, err = wire.NewValueI64(*(v.FailoverVersion)), error(nil)
+	if v.Operation != nil {
+		w, err = v.Operation.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.IsGlobalDomain != nil {
-		w, err = wire.NewValueBool(*(v.IsGlobalDomain)), error(nil)
+	if v.VirtualQueueID != nil {
+		w, err = wire.NewValueI64(*(v.VirtualQueueID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.TargetVirtualQueueID != nil {
+		w, err = wire.NewValueI64(*(v.TargetVirtualQueueID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.DomainIDs != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.DomainIDs)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.PauseDurationInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.PauseDurationInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a UpdateDomainResponse struct from its Thrift-level
+func _VirtualQueueOperation_Read(w wire.Value) (VirtualQueueOperation, error) {
+	var v VirtualQueueOperation
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a UpdateVirtualQueueRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a UpdateDomainResponse struct
+// An error is returned if we were unable to build a UpdateVirtualQueueRequest struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -106066,55 +110875,89 @@ func (v *UpdateDomainResponse) ToWire() (wire.Value, error) {
 //		return nil, err
 //	}
 //
-//	var v UpdateDomainResponse
+//	var v UpdateVirtualQueueRequest
 //	if err := v.FromWire(x); err != nil {
 //		return nil, err
 //	}
 //	return &v, nil
-func (v *UpdateDomainResponse) FromWire(w wire.Value) error {
+func (v *UpdateVirtualQueueRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TStruct {
-				v.DomainInfo, err = _DomainInfo_Read(field.Value)
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.Configuration, err = _DomainConfiguration_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ClusterName = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.ReplicationConfiguration, err = _DomainReplicationConfiguration_Read(field.Value)
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Type = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x VirtualQueueOperation
+				x, err = _VirtualQueueOperation_Read(field.Value)
+				v.Operation = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.FailoverVersion = &x
+				v.VirtualQueueID = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 50:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.IsGlobalDomain = &x
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TargetVirtualQueueID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TList {
+				v.DomainIDs, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.PauseDurationInSeconds = &x
 				if err != nil {
 					return err
 				}
@@ -106126,20 +110969,20 @@ func (v *UpdateDomainResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a UpdateDomainResponse struct directly into bytes, without going
+// Encode serializes a UpdateVirtualQueueRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a UpdateDomainResponse struct could not be encoded.
-func (v *UpdateDomainResponse) Encode(sw stream.Writer) error {
+// An error is returned if a UpdateVirtualQueueRequest struct could not be encoded.
+func (v *UpdateVirtualQueueRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.DomainInfo != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TStruct}); err != nil {
+	if v.ShardID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := v.DomainInfo.Encode(sw); err != nil {
+		if err := sw.WriteInt32(*(v.ShardID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -106147,11 +110990,11 @@ func (v *UpdateDomainResponse) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Configuration != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+	if v.ClusterName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := v.Configuration.Encode(sw); err != nil {
+		if err := sw.WriteString(*(v.ClusterName)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -106159,11 +111002,11 @@ func (v *UpdateDomainResponse) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.ReplicationConfiguration != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
+	if v.Type != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := v.ReplicationConfiguration.Encode(sw); err != nil {
+		if err := sw.WriteInt32(*(v.Type)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -106171,11 +111014,11 @@ func (v *UpdateDomainResponse) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.FailoverVersion != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+	if v.Operation != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.FailoverVersion)); err != nil {
+		if err := v.Operation.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -106183,11 +111026,47 @@ func (v *UpdateDomainResponse) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.IsGlobalDomain != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TBool}); err != nil {
+	if v.VirtualQueueID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteBool(*(v.IsGlobalDomain)); err != nil {
+		if err := sw.WriteInt64(*(v.VirtualQueueID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TargetVirtualQueueID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.TargetVirtualQueueID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DomainIDs != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v.DomainIDs, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PauseDurationInSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.PauseDurationInSeconds)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -106198,12 +111077,18 @@ func (v *UpdateDomainResponse) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a UpdateDomainResponse struct directly from its Thrift-level
+func _VirtualQueueOperation_Decode(sr stream.Reader) (VirtualQueueOperation, error) {
+	var v VirtualQueueOperation
+	err := v.Decode(sr)
+	return v, err
+}
+
+// Decode deserializes a UpdateVirtualQueueRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a UpdateDomainResponse struct could not be generated from the wire
+// An error is returned if a UpdateVirtualQueueRequest struct could not be generated from the wire
 // representation.
-func (v *UpdateDomainResponse) Decode(sr stream.Reader) error {
+func (v *UpdateVirtualQueueRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -106216,36 +111101,64 @@ func (v *UpdateDomainResponse) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TStruct:
-			v.DomainInfo, err = _DomainInfo_Decode(sr)
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ShardID = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TStruct:
-			v.Configuration, err = _DomainConfiguration_Decode(sr)
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ClusterName = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TStruct:
-			v.ReplicationConfiguration, err = _DomainReplicationConfiguration_Decode(sr)
+		case fh.ID == 30 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Type = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 40 && fh.Type == wire.TI64:
+		case fh.ID == 40 && fh.Type == wire.TI32:
+			var x VirtualQueueOperation
+			x, err = _VirtualQueueOperation_Decode(sr)
+			v.Operation = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TI64:
 			var x int64
 			x, err = sr.ReadInt64()
-			v.FailoverVersion = &x
+			v.VirtualQueueID = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 50 && fh.Type == wire.TBool:
-			var x bool
-			x, err = sr.ReadBool()
-			v.IsGlobalDomain = &x
+		case fh.ID == 60 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.TargetVirtualQueueID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 70 && fh.Type == wire.TList:
+			v.DomainIDs, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 80 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.PauseDurationInSeconds = &x
 			if err != nil {
 				return err
 			}
@@ -106272,62 +111185,93 @@ func (v *UpdateDomainResponse) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a UpdateDomainResponse
+// String returns a readable string representation of a UpdateVirtualQueueRequest
 // struct.
-func (v *UpdateDomainResponse) String() string {
+func (v *UpdateVirtualQueueRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [8]string
 	i := 0
-	if v.DomainInfo != nil {
-		fields[i] = fmt.Sprintf("DomainInfo: %v", v.DomainInfo)
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
 		i++
 	}
-	if v.Configuration != nil {
-		fields[i] = fmt.Sprintf("Configuration: %v", v.Configuration)
+	if v.ClusterName != nil {
+		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
 		i++
 	}
-	if v.ReplicationConfiguration != nil {
-		fields[i] = fmt.Sprintf("ReplicationConfiguration: %v", v.ReplicationConfiguration)
+	if v.Type != nil {
+		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
 		i++
 	}
-	if v.FailoverVersion != nil {
-		fields[i] = fmt.Sprintf("FailoverVersion: %v", *(v.FailoverVersion))
+	if v.Operation != nil {
+		fields[i] = fmt.Sprintf("Operation: %v", *(v.Operation))
 		i++
 	}
-	if v.IsGlobalDomain != nil {
-		fields[i] = fmt.Sprintf("IsGlobalDomain: %v", *(v.IsGlobalDomain))
+	if v.VirtualQueueID != nil {
+		fields[i] = fmt.Sprintf("VirtualQueueID: %v", *(v.VirtualQueueID))
+		i++
+	}
+	if v.TargetVirtualQueueID != nil {
+		fields[i] = fmt.Sprintf("TargetVirtualQueueID: %v", *(v.TargetVirtualQueueID))
+		i++
+	}
+	if v.DomainIDs != nil {
+		fields[i] = fmt.Sprintf("DomainIDs: %v", v.DomainIDs)
+		i++
+	}
+	if v.PauseDurationInSeconds != nil {
+		fields[i] = fmt.Sprintf("PauseDurationInSeconds: %v", *(v.PauseDurationInSeconds))
 		i++
 	}
 
-	return fmt.Sprintf("UpdateDomainResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("UpdateVirtualQueueRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this UpdateDomainResponse match the
-// provided UpdateDomainResponse.
+func _VirtualQueueOperation_EqualsPtr(lhs, rhs *VirtualQueueOperation) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this UpdateVirtualQueueRequest match the
+// provided UpdateVirtualQueueRequest.
 //
 // This function performs a deep comparison.
-func (v *UpdateDomainResponse) Equals(rhs *UpdateDomainResponse) bool {
+func (v *UpdateVirtualQueueRequest) Equals(rhs *UpdateVirtualQueueRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.DomainInfo == nil && rhs.DomainInfo == nil) || (v.DomainInfo != nil && rhs.DomainInfo != nil && v.DomainInfo.Equals(rhs.DomainInfo))) {
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
 		return false
 	}
-	if !((v.Configuration == nil && rhs.Configuration == nil) || (v.Configuration != nil && rhs.Configuration != nil && v.Configuration.Equals(rhs.Configuration))) {
+	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
 		return false
 	}
-	if !((v.ReplicationConfiguration == nil && rhs.ReplicationConfiguration == nil) || (v.ReplicationConfiguration != nil && rhs.ReplicationConfiguration != nil && v.ReplicationConfiguration.Equals(rhs.ReplicationConfiguration))) {
+	if !_I32_EqualsPtr(v.Type, rhs.Type) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.FailoverVersion, rhs.FailoverVersion) {
+	if !_VirtualQueueOperation_EqualsPtr(v.Operation, rhs.Operation) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.IsGlobalDomain, rhs.IsGlobalDomain) {
+	if !_I64_EqualsPtr(v.VirtualQueueID, rhs.VirtualQueueID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TargetVirtualQueueID, rhs.TargetVirtualQueueID) {
+		return false
+	}
+	if !((v.DomainIDs == nil && rhs.DomainIDs == nil) || (v.DomainIDs != nil && rhs.DomainIDs != nil && _List_String_Equals(v.DomainIDs, rhs.DomainIDs))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.PauseDurationInSeconds, rhs.PauseDurationInSeconds) {
 		return false
 	}
 
@@ -106335,102 +111279,156 @@ func (v *UpdateDomainResponse) Equals(rhs *UpdateDomainResponse) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of UpdateDomainResponse.
-func (v *UpdateDomainResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of UpdateVirtualQueueRequest.
+func (v *UpdateVirtualQueueRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.DomainInfo != nil {
-		err = multierr.Append(err, enc.AddObject("domainInfo", v.DomainInfo))
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
 	}
-	if v.Configuration != nil {
-		err = multierr.Append(err, enc.AddObject("configuration", v.Configuration))
+	if v.ClusterName != nil {
+		enc.AddString("clusterName", *v.ClusterName)
 	}
-	if v.ReplicationConfiguration != nil {
-		err = multierr.Append(err, enc.AddObject("replicationConfiguration", v.ReplicationConfiguration))
+	if v.Type != nil {
+		enc.AddInt32("type", *v.Type)
 	}
-	if v.FailoverVersion != nil {
-		enc.AddInt64("failoverVersion", *v.FailoverVersion)
+	if v.Operation != nil {
+		err = multierr.Append(err, enc.AddObject("operation", *v.Operation))
 	}
-	if v.IsGlobalDomain != nil {
-		enc.AddBool("isGlobalDomain", *v.IsGlobalDomain)
+	if v.VirtualQueueID != nil {
+		enc.AddInt64("virtualQueueID", *v.VirtualQueueID)
+	}
+	if v.TargetVirtualQueueID != nil {
+		enc.AddInt64("targetVirtualQueueID", *v.TargetVirtualQueueID)
+	}
+	if v.DomainIDs != nil {
+		err = multierr.Append(err, enc.AddArray("domainIDs", (_List_String_Zapper)(v.DomainIDs)))
+	}
+	if v.PauseDurationInSeconds != nil {
+		enc.AddInt32("pauseDurationInSeconds", *v.PauseDurationInSeconds)
 	}
 	return err
 }
 
-// GetDomainInfo returns the value of DomainInfo if it is set or its
+// GetShardID returns the value of ShardID if it is set or its
 // zero value if it is unset.
-func (v *UpdateDomainResponse) GetDomainInfo() (o *DomainInfo) {
-	if v != nil && v.DomainInfo != nil {
-		return v.DomainInfo
+func (v *UpdateVirtualQueueRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
 	}
 
 	return
 }
 
-// IsSetDomainInfo returns true if DomainInfo is not nil.
-func (v *UpdateDomainResponse) IsSetDomainInfo() bool {
-	return v != nil && v.DomainInfo != nil
+// IsSetShardID returns true if ShardID is not nil.
+func (v *UpdateVirtualQueueRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
 }
 
-// GetConfiguration returns the value of Configuration if it is set or its
+// GetClusterName returns the value of ClusterName if it is set or its
 // zero value if it is unset.
-func (v *UpdateDomainResponse) GetConfiguration() (o *DomainConfiguration) {
-	if v != nil && v.Configuration != nil {
-		return v.Configuration
+func (v *UpdateVirtualQueueRequest) GetClusterName() (o string) {
+	if v != nil && v.ClusterName != nil {
+		return *v.ClusterName
 	}
 
 	return
 }
 
-// IsSetConfiguration returns true if Configuration is not nil.
-func (v *UpdateDomainResponse) IsSetConfiguration() bool {
-	return v != nil && v.Configuration != nil
+// IsSetClusterName returns true if ClusterName is not nil.
+func (v *UpdateVirtualQueueRequest) IsSetClusterName() bool {
+	return v != nil && v.ClusterName != nil
 }
 
-// GetReplicationConfiguration returns the value of ReplicationConfiguration if it is set or its
+// GetType returns the value of Type if it is set or its
 // zero value if it is unset.
-func (v *UpdateDomainResponse) GetReplicationConfiguration() (o *DomainReplicationConfiguration) {
-	if v != nil && v.ReplicationConfiguration != nil {
-		return v.ReplicationConfiguration
+func (v *UpdateVirtualQueueRequest) GetType() (o int32) {
+	if v != nil && v.Type != nil {
+		return *v.Type
 	}
 
 	return
 }
 
-// IsSetReplicationConfiguration returns true if ReplicationConfiguration is not nil.
-func (v *UpdateDomainResponse) IsSetReplicationConfiguration() bool {
-	return v != nil && v.ReplicationConfiguration != nil
+// IsSetType returns true if Type is not nil.
+func (v *UpdateVirtualQueueRequest) IsSetType() bool {
+	return v != nil && v.Type != nil
 }
 
-// GetFailoverVersion returns the value of FailoverVersion if it is set or its
+// GetOperation returns the value of Operation if it is set or its
 // zero value if it is unset.
-func (v *UpdateDomainResponse) GetFailoverVersion() (o int64) {
-	if v != nil && v.FailoverVersion != nil {
-		return *v.FailoverVersion
+func (v *UpdateVirtualQueueRequest) GetOperation() (o VirtualQueueOperation) {
+	if v != nil && v.Operation != nil {
+		return *v.Operation
 	}
 
 	return
 }
 
-// IsSetFailoverVersion returns true if FailoverVersion is not nil.
-func (v *UpdateDomainResponse) IsSetFailoverVersion() bool {
-	return v != nil && v.FailoverVersion != nil
+// IsSetOperation returns true if Operation is not nil.
+func (v *UpdateVirtualQueueRequest) IsSetOperation() bool {
+	return v != nil && v.Operation != nil
 }
 
-// GetIsGlobalDomain returns the value of IsGlobalDomain if it is set or its
+// GetVirtualQueueID returns the value of VirtualQueueID if it is set or its
 // zero value if it is unset.
-func (v *UpdateDomainResponse) GetIsGlobalDomain() (o bool) {
-	if v != nil && v.IsGlobalDomain != nil {
-		return *v.IsGlobalDomain
+func (v *UpdateVirtualQueueRequest) GetVirtualQueueID() (o int64) {
+	if v != nil && v.VirtualQueueID != nil {
+		return *v.VirtualQueueID
 	}
 
 	return
 }
 
-// IsSetIsGlobalDomain returns true if IsGlobalDomain is not nil.
-func (v *UpdateDomainResponse) IsSetIsGlobalDomain() bool {
-	return v != nil && v.IsGlobalDomain != nil
+// IsSetVirtualQueueID returns true if VirtualQueueID is not nil.
+func (v *UpdateVirtualQueueRequest) IsSetVirtualQueueID() bool {
+	return v != nil && v.VirtualQueueID != nil
+}
+
+// GetTargetVirtualQueueID returns the value of TargetVirtualQueueID if it is set or its
+// zero value if it is unset.
+func (v *UpdateVirtualQueueRequest) GetTargetVirtualQueueID() (o int64) {
+	if v != nil && v.TargetVirtualQueueID != nil {
+		return *v.TargetVirtualQueueID
+	}
+
+	return
+}
+
+// IsSetTargetVirtualQueueID returns true if TargetVirtualQueueID is not nil.
+func (v *UpdateVirtualQueueRequest) IsSetTargetVirtualQueueID() bool {
+	return v != nil && v.TargetVirtualQueueID != nil
+}
+
+// GetDomainIDs returns the value of DomainIDs if it is set or its
+// zero value if it is unset.
+func (v *UpdateVirtualQueueRequest) GetDomainIDs() (o []string) {
+	if v != nil && v.DomainIDs != nil {
+		return v.DomainIDs
+	}
+
+	return
+}
+
+// IsSetDomainIDs returns true if DomainIDs is not nil.
+func (v *UpdateVirtualQueueRequest) IsSetDomainIDs() bool {
+	return v != nil && v.DomainIDs != nil
+}
+
+// GetPauseDurationInSeconds returns the value of PauseDurationInSeconds if it is set or its
+// zero value if it is unset.
+func (v *UpdateVirtualQueueRequest) GetPauseDurationInSeconds() (o int32) {
+	if v != nil && v.PauseDurationInSeconds != nil {
+		return *v.PauseDurationInSeconds
+	}
+
+	return
+}
+
+// IsSetPauseDurationInSeconds returns true if PauseDurationInSeconds is not nil.
+func (v *UpdateVirtualQueueRequest) IsSetPauseDurationInSeconds() bool {
+	return v != nil && v.PauseDurationInSeconds != nil
 }
 
 type UpsertWorkflowSearchAttributesDecisionAttributes struct {
@@ -107991,6 +112989,231 @@ func (v *VersionHistoryItem) IsSetVersion() bool {
 	return v != nil && v.Version != nil
 }
 
+type VirtualQueueOperation int32
+
+const (
+	VirtualQueueOperationMoveDomains VirtualQueueOperation = 0
+	VirtualQueueOperationPause       VirtualQueueOperation = 1
+	VirtualQueueOperationResume      VirtualQueueOperation = 2
+	VirtualQueueOperationMerge       VirtualQueueOperation = 3
+)
+
+// VirtualQueueOperation_Values returns all recognized values of VirtualQueueOperation.
+func VirtualQueueOperation_Values() []VirtualQueueOperation {
+	return []VirtualQueueOperation{
+		VirtualQueueOperationMoveDomains,
+		VirtualQueueOperationPause,
+		VirtualQueueOperationResume,
+		VirtualQueueOperationMerge,
+	}
+}
+
+// UnmarshalText tries to decode VirtualQueueOperation from a byte slice
+// containing its name.
+//
+//	var v VirtualQueueOperation
+//	err := v.UnmarshalText([]byte("MoveDomains"))
+func (v *VirtualQueueOperation) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "MoveDomains":
+		*v = VirtualQueueOperationMoveDomains
+		return nil
+	case "Pause":
+		*v = VirtualQueueOperationPause
+		return nil
+	case "Resume":
+		*v = VirtualQueueOperationResume
+		return nil
+	case "Merge":
+		*v = VirtualQueueOperationMerge
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "VirtualQueueOperation", err)
+		}
+		*v = VirtualQueueOperation(val)
+		return nil
+	}
+}
+
+// MarshalText encodes VirtualQueueOperation to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v VirtualQueueOperation) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("MoveDomains"), nil
+	case 1:
+		return []byte("Pause"), nil
+	case 2:
+		return []byte("Resume"), nil
+	case 3:
+		return []byte("Merge"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of VirtualQueueOperation.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v VirtualQueueOperation) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "MoveDomains")
+	case 1:
+		enc.AddString("name", "Pause")
+	case 2:
+		enc.AddString("name", "Resume")
+	case 3:
+		enc.AddString("name", "Merge")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v VirtualQueueOperation) Ptr() *VirtualQueueOperation {
+	return &v
+}
+
+// Encode encodes VirtualQueueOperation directly to bytes.
+//
+//	sWriter := BinaryStreamer.Writer(writer)
+//
+//	var v VirtualQueueOperation
+//	return v.Encode(sWriter)
+func (v VirtualQueueOperation) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates VirtualQueueOperation into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v VirtualQueueOperation) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes VirtualQueueOperation from its Thrift-level
+// representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TI32)
+//	if err != nil {
+//	    return VirtualQueueOperation(0), err
+//	}
+//
+//	var v VirtualQueueOperation
+//	if err := v.FromWire(x); err != nil {
+//	    return VirtualQueueOperation(0), err
+//	}
+//	return v, nil
+func (v *VirtualQueueOperation) FromWire(w wire.Value) error {
+	*v = (VirtualQueueOperation)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded VirtualQueueOperation directly off of the wire.
+//
+//	sReader := BinaryStreamer.Reader(reader)
+//
+//	var v VirtualQueueOperation
+//	if err := v.Decode(sReader); err != nil {
+//	    return VirtualQueueOperation(0), err
+//	}
+//	return v, nil
+func (v *VirtualQueueOperation) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (VirtualQueueOperation)(i)
+	return nil
+}
+
+// String returns a readable string representation of VirtualQueueOperation.
+func (v VirtualQueueOperation) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "MoveDomains"
+	case 1:
+		return "Pause"
+	case 2:
+		return "Resume"
+	case 3:
+		return "Merge"
+	}
+	return fmt.Sprintf("VirtualQueueOperation(%d)", w)
+}
+
+// Equals returns true if this VirtualQueueOperation value matches the provided
+// value.
+func (v VirtualQueueOperation) Equals(rhs VirtualQueueOperation) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes VirtualQueueOperation into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v VirtualQueueOperation) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"MoveDomains\""), nil
+	case 1:
+		return ([]byte)("\"Pause\""), nil
+	case 2:
+		return ([]byte)("\"Resume\""), nil
+	case 3:
+		return ([]byte)("\"Merge\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode VirtualQueueOperation from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *VirtualQueueOperation) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "VirtualQueueOperation")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "VirtualQueueOperation")
+		}
+		*v = (VirtualQueueOperation)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "VirtualQueueOperation")
+	}
+}
+
 type VirtualQueueState struct {
 	VirtualSliceStates []*VirtualSliceState `json:"virtualSliceStates,omitempty"`
 }
@@ -108387,12 +113610,6 @@ func _TaskRange_Read(w wire.Value) (*TaskRange, error) {
 	return &v, err
 }
 
-func _Predicate_Read(w wire.Value) (*Predicate, error) {
-	var v Predicate
-	err := v.FromWire(w)
-	return &v, err
-}
-
 // FromWire deserializes a VirtualSliceState struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -108479,12 +113696,6 @@ func _TaskRange_Decode(sr stream.Reader) (*TaskRange, error) {
 	return &v, err
 }
 
-func _Predicate_Decode(sr stream.Reader) (*Predicate, error) {
-	var v Predicate
-	err := v.Decode(sr)
-	return &v, err
-}
-
 // Decode deserializes a VirtualSliceState struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -118039,6 +123250,546 @@ func (v *WorkflowExecutionTimedOutEventAttributes) IsSetTimeoutType() bool {
 	return v != nil && v.TimeoutType != nil
 }
 
+type WorkflowIDPredicateAttributes struct {
+	WorkflowIDs []string `json:"workflowIDs,omitempty"`
+	IsExclusive *bool    `json:"isExclusive,omitempty"`
+}
+
+// ToWire translates a WorkflowIDPredicateAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//		return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//		return err
+//	}
+func (v *WorkflowIDPredicateAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.WorkflowIDs != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.WorkflowIDs)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.IsExclusive != nil {
+		w, err = wire.NewValueBool(*(v.IsExclusive)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a WorkflowIDPredicateAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowIDPredicateAttributes struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//		return nil, err
+//	}
+//
+//	var v WorkflowIDPredicateAttributes
+//	if err := v.FromWire(x); err != nil {
+//		return nil, err
+//	}
+//	return &v, nil
+func (v *WorkflowIDPredicateAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.WorkflowIDs, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.IsExclusive = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a WorkflowIDPredicateAttributes struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a WorkflowIDPredicateAttributes struct could not be encoded.
+func (v *WorkflowIDPredicateAttributes) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.WorkflowIDs != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v.WorkflowIDs, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.IsExclusive != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.IsExclusive)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a WorkflowIDPredicateAttributes struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a WorkflowIDPredicateAttributes struct could not be generated from the wire
+// representation.
+func (v *WorkflowIDPredicateAttributes) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TList:
+			v.WorkflowIDs, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.IsExclusive = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowIDPredicateAttributes
+// struct.
+func (v *WorkflowIDPredicateAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.WorkflowIDs != nil {
+		fields[i] = fmt.Sprintf("WorkflowIDs: %v", v.WorkflowIDs)
+		i++
+	}
+	if v.IsExclusive != nil {
+		fields[i] = fmt.Sprintf("IsExclusive: %v", *(v.IsExclusive))
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowIDPredicateAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowIDPredicateAttributes match the
+// provided WorkflowIDPredicateAttributes.
+//
+// This function performs a deep comparison.
+func (v *WorkflowIDPredicateAttributes) Equals(rhs *WorkflowIDPredicateAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.WorkflowIDs == nil && rhs.WorkflowIDs == nil) || (v.WorkflowIDs != nil && rhs.WorkflowIDs != nil && _List_String_Equals(v.WorkflowIDs, rhs.WorkflowIDs))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.IsExclusive, rhs.IsExclusive) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowIDPredicateAttributes.
+func (v *WorkflowIDPredicateAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.WorkflowIDs != nil {
+		err = multierr.Append(err, enc.AddArray("workflowIDs", (_List_String_Zapper)(v.WorkflowIDs)))
+	}
+	if v.IsExclusive != nil {
+		enc.AddBool("isExclusive", *v.IsExclusive)
+	}
+	return err
+}
+
+// GetWorkflowIDs returns the value of WorkflowIDs if it is set or its
+// zero value if it is unset.
+func (v *WorkflowIDPredicateAttributes) GetWorkflowIDs() (o []string) {
+	if v != nil && v.WorkflowIDs != nil {
+		return v.WorkflowIDs
+	}
+
+	return
+}
+
+// IsSetWorkflowIDs returns true if WorkflowIDs is not nil.
+func (v *WorkflowIDPredicateAttributes) IsSetWorkflowIDs() bool {
+	return v != nil && v.WorkflowIDs != nil
+}
+
+// GetIsExclusive returns the value of IsExclusive if it is set or its
+// zero value if it is unset.
+func (v *WorkflowIDPredicateAttributes) GetIsExclusive() (o bool) {
+	if v != nil && v.IsExclusive != nil {
+		return *v.IsExclusive
+	}
+
+	return
+}
+
+// IsSetIsExclusive returns true if IsExclusive is not nil.
+func (v *WorkflowIDPredicateAttributes) IsSetIsExclusive() bool {
+	return v != nil && v.IsExclusive != nil
+}
+
+type WorkflowIDPrefixPredicateAttributes struct {
+	Prefixes    []string `json:"prefixes,omitempty"`
+	IsExclusive *bool    `json:"isExclusive,omitempty"`
+}
+
+// ToWire translates a WorkflowIDPrefixPredicateAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//		return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//		return err
+//	}
+func (v *WorkflowIDPrefixPredicateAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Prefixes != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Prefixes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.IsExclusive != nil {
+		w, err = wire.NewValueBool(*(v.IsExclusive)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a WorkflowIDPrefixPredicateAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a WorkflowIDPrefixPredicateAttributes struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//		return nil, err
+//	}
+//
+//	var v WorkflowIDPrefixPredicateAttributes
+//	if err := v.FromWire(x); err != nil {
+//		return nil, err
+//	}
+//	return &v, nil
+func (v *WorkflowIDPrefixPredicateAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Prefixes, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.IsExclusive = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a WorkflowIDPrefixPredicateAttributes struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a WorkflowIDPrefixPredicateAttributes struct could not be encoded.
+func (v *WorkflowIDPrefixPredicateAttributes) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Prefixes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_String_Encode(v.Prefixes, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.IsExclusive != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.IsExclusive)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a WorkflowIDPrefixPredicateAttributes struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a WorkflowIDPrefixPredicateAttributes struct could not be generated from the wire
+// representation.
+func (v *WorkflowIDPrefixPredicateAttributes) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TList:
+			v.Prefixes, err = _List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.IsExclusive = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a WorkflowIDPrefixPredicateAttributes
+// struct.
+func (v *WorkflowIDPrefixPredicateAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Prefixes != nil {
+		fields[i] = fmt.Sprintf("Prefixes: %v", v.Prefixes)
+		i++
+	}
+	if v.IsExclusive != nil {
+		fields[i] = fmt.Sprintf("IsExclusive: %v", *(v.IsExclusive))
+		i++
+	}
+
+	return fmt.Sprintf("WorkflowIDPrefixPredicateAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this WorkflowIDPrefixPredicateAttributes match the
+// provided WorkflowIDPrefixPredicateAttributes.
+//
+// This function performs a deep comparison.
+func (v *WorkflowIDPrefixPredicateAttributes) Equals(rhs *WorkflowIDPrefixPredicateAttributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Prefixes == nil && rhs.Prefixes == nil) || (v.Prefixes != nil && rhs.Prefixes != nil && _List_String_Equals(v.Prefixes, rhs.Prefixes))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.IsExclusive, rhs.IsExclusive) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WorkflowIDPrefixPredicateAttributes.
+func (v *WorkflowIDPrefixPredicateAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Prefixes != nil {
+		err = multierr.Append(err, enc.AddArray("prefixes", (_List_String_Zapper)(v.Prefixes)))
+	}
+	if v.IsExclusive != nil {
+		enc.AddBool("isExclusive", *v.IsExclusive)
+	}
+	return err
+}
+
+// GetPrefixes returns the value of Prefixes if it is set or its
+// zero value if it is unset.
+func (v *WorkflowIDPrefixPredicateAttributes) GetPrefixes() (o []string) {
+	if v != nil && v.Prefixes != nil {
+		return v.Prefixes
+	}
+
+	return
+}
+
+// IsSetPrefixes returns true if Prefixes is not nil.
+func (v *WorkflowIDPrefixPredicateAttributes) IsSetPrefixes() bool {
+	return v != nil && v.Prefixes != nil
+}
+
+// GetIsExclusive returns the value of IsExclusive if it is set or its
+// zero value if it is unset.
+func (v *WorkflowIDPrefixPredicateAttributes) GetIsExclusive() (o bool) {
+	if v != nil && v.IsExclusive != nil {
+		return *v.IsExclusive
+	}
+
+	return
+}
+
+// IsSetIsExclusive returns true if IsExclusive is not nil.
+func (v *WorkflowIDPrefixPredicateAttributes) IsSetIsExclusive() bool {
+	return v != nil && v.IsExclusive != nil
+}
+
 type WorkflowIdReusePolicy int32
 
 const (
@@ -119315,8 +125066,8 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "shared",
 	Package:  "github.com/uber/cadence/.gen/go/shared",
 	FilePath: "shared.thrift",
-	SHA1:     "1c4a1b5dbc70f7fb9337def256abaf5ca341d6a2",
+	SHA1:     "e301c76030cf475fbaf340de314608c7f89ebe59",
 	Raw:      rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence\n\nexception BadRequestError {\n  1: required string message\n} (rpc.code = \"INVALID_ARGUMENT\")\n\nexception InternalServiceError {\n  1: required string message\n} (rpc.code = \"INTERNAL\")\n\nexception InternalDataInconsistencyError {\n  1: required string message\n} (rpc.code = \"DATA_LOSS\")\n\nexception DomainAlreadyExistsError {\n  1: required string message\n} (rpc.code = \"ALREADY_EXISTS\")\n\nexception WorkflowExecutionAlreadyStartedError {\n  10: optional string message\n  20: optional string startRequestId\n  30: optional string runId\n} (rpc.code = \"ALREADY_EXISTS\")\n\nexception WorkflowExecutionAlreadyCompletedError {\n  1: required string message\n} (rpc.code = \"NOT_FOUND\")\n\nexception EntityNotExistsError {\n  1: required string message\n  2: optional string currentCluster\n  3: optional string activeCluster\n  4: required list<string> activeClusters // todo(david.porter) remove as its disused\n} (rpc.code = \"NOT_FOUND\")\n\nexception ServiceBusyError {\n  1: required string message\n  2: optional string reason\n} (rpc.code = \"RESOURCE_EXHAUSTED\")\n\nexception CancellationAlreadyRequestedError {\n  1: required string message\n} (rpc.code = \"ALREADY_EXISTS\")\n\nexception QueryFailedError {\n  1: required string message\n} (rpc.code = \"INVALID_ARGUMENT\")\n\nexception DomainNotActiveError {\n  1: required string message\n  2: required string domainName\n  3: required string currentCluster\n  4: required string activeCluster\n  5: required list<string> activeClusters // todo (david.porter) remove this field as it's disused\n} (rpc.code = \"FAILED_PRECONDITION\")\n\nexception LimitExceededError {\n  1: required string message\n} (rpc.code = \"RESOURCE_EXHAUSTED\")\n\nexception AccessDeniedError {\n  1: required string message\n} (rpc.code = \"PERMISSION_DENIED\")\n\nexception RetryTaskV2Error {\n  1: required string message\n  2: optional string domainId\n  3: optional string workflowId\n  4: optional string runId\n  5: optional i64 (js.type = \"Long\") startEventId\n  6: optional i64 (js.type = \"Long\") startEventVersion\n  7: optional i64 (js.type = \"Long\") endEventId\n  8: optional i64 (js.type = \"Long\") endEventVersion\n} (rpc.code = \"ABORTED\")\n\nexception ClientVersionNotSupportedError {\n  1: required string featureVersion\n  2: required string clientImpl\n  3: required string supportedVersions\n} (rpc.code = \"FAILED_PRECONDITION\")\n\nexception FeatureNotEnabledError {\n  1: required string featureFlag\n} (rpc.code = \"FAILED_PRECONDITION\")\n\nexception CurrentBranchChangedError {\n  10: required string message\n  20: required binary currentBranchToken\n} (rpc.code = \"ABORTED\")\n\nexception RemoteSyncMatchedError {\n  10: required string message\n} (rpc.code = \"UNAVAILABLE\")\n\nexception StickyWorkerUnavailableError {\n  1: required string message\n} (rpc.code = \"UNAVAILABLE\")\n\nexception TaskListNotOwnedByHostError {\n    1: required string ownedByIdentity\n    2: required string myIdentity\n    3: required string tasklistName\n} (rpc.code = \"ABORTED\")\n\nenum WorkflowIdReusePolicy {\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running, and the last execution close state is in\n   * [terminated, cancelled, timeouted, failed].\n   */\n  AllowDuplicateFailedOnly,\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running.\n   */\n  AllowDuplicate,\n  /*\n   * do not allow start a workflow execution using the same workflow ID at all\n   */\n  RejectDuplicate,\n  /*\n   * if a workflow is running using the same workflow ID, terminate it and start a new one\n   */\n  TerminateIfRunning,\n}\n\nenum DomainStatus {\n  REGISTERED,\n  DEPRECATED,\n  DELETED,\n}\n\nenum TimeoutType {\n  START_TO_CLOSE,\n  SCHEDULE_TO_START,\n  SCHEDULE_TO_CLOSE,\n  HEARTBEAT,\n}\n\nenum ParentClosePolicy {\n  ABANDON,\n  REQUEST_CANCEL,\n  TERMINATE,\n}\n\n\n// whenever this list of decision is changed\n// do change the mutableStateBuilder.go\n// function shouldBufferEvent\n// to make sure wo do the correct event ordering\nenum DecisionType {\n  ScheduleActivityTask,\n  RequestCancelActivityTask,\n  StartTimer,\n  CompleteWorkflowExecution,\n  FailWorkflowExecution,\n  CancelTimer,\n  CancelWorkflowExecution,\n  RequestCancelExternalWorkflowExecution,\n  RecordMarker,\n  ContinueAsNewWorkflowExecution,\n  StartChildWorkflowExecution,\n  SignalExternalWorkflowExecution,\n  UpsertWorkflowSearchAttributes,\n}\n\nenum EventType {\n  WorkflowExecutionStarted,\n  WorkflowExecutionCompleted,\n  WorkflowExecutionFailed,\n  WorkflowExecutionTimedOut,\n  DecisionTaskScheduled,\n  DecisionTaskStarted,\n  DecisionTaskCompleted,\n  DecisionTaskTimedOut\n  DecisionTaskFailed,\n  ActivityTaskScheduled,\n  ActivityTaskStarted,\n  ActivityTaskCompleted,\n  ActivityTaskFailed,\n  ActivityTaskTimedOut,\n  ActivityTaskCancelRequested,\n  RequestCancelActivityTaskFailed,\n  ActivityTaskCanceled,\n  TimerStarted,\n  TimerFired,\n  CancelTimerFailed,\n  TimerCanceled,\n  WorkflowExecutionCancelRequested,\n  WorkflowExecutionCanceled,\n  RequestCancelExternalWorkflowExecutionInitiated,\n  RequestCancelExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionCancelRequested,\n  MarkerRecorded,\n  WorkflowExecutionSignaled,\n  WorkflowExecutionTerminated,\n  WorkflowExecutionContinuedAsNew,\n  StartChildWorkflowExecutionInitiated,\n  StartChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionStarted,\n  ChildWorkflowExecutionCompleted,\n  ChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionCanceled,\n  ChildWorkflowExecutionTimedOut,\n  ChildWorkflowExecutionTerminated,\n  SignalExternalWorkflowExecutionInitiated,\n  SignalExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionSignaled,\n  UpsertWorkflowSearchAttributes,\n}\n\nenum DecisionTaskFailedCause {\n  UNHANDLED_DECISION,\n  BAD_SCHEDULE_ACTIVITY_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES,\n  BAD_START_TIMER_ATTRIBUTES,\n  BAD_CANCEL_TIMER_ATTRIBUTES,\n  BAD_RECORD_MARKER_ATTRIBUTES,\n  BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CONTINUE_AS_NEW_ATTRIBUTES,\n  START_TIMER_DUPLICATE_ID,\n  RESET_STICKY_TASKLIST,\n  WORKFLOW_WORKER_UNHANDLED_FAILURE,\n  BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_START_CHILD_EXECUTION_ATTRIBUTES,\n  FORCE_CLOSE_DECISION,\n  FAILOVER_CLOSE_DECISION,\n  BAD_SIGNAL_INPUT_SIZE,\n  RESET_WORKFLOW,\n  BAD_BINARY,\n  SCHEDULE_ACTIVITY_DUPLICATE_ID,\n  BAD_SEARCH_ATTRIBUTES,\n}\n\nenum DecisionTaskTimedOutCause {\n  TIMEOUT,\n  RESET,\n}\n\nenum CancelExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n  WORKFLOW_ALREADY_COMPLETED,\n}\n\nenum SignalExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n  WORKFLOW_ALREADY_COMPLETED,\n}\n\nenum ChildWorkflowExecutionFailedCause {\n  WORKFLOW_ALREADY_RUNNING,\n}\n\n// TODO: when migrating to gRPC, add a running / none status,\n//  currently, customer is using null / nil as an indication\n//  that workflow is still running\nenum WorkflowExecutionCloseStatus {\n  COMPLETED,\n  FAILED,\n  CANCELED,\n  TERMINATED,\n  CONTINUED_AS_NEW,\n  TIMED_OUT,\n}\n\nenum WorkflowExecutionStatus {\n  PENDING,\n  STARTED,\n  COMPLETED,\n  FAILED,\n  CANCELED,\n  TERMINATED,\n  CONTINUED_AS_NEW,\n  TIMED_OUT,\n}\n\nenum QueryTaskCompletedType {\n  COMPLETED,\n  FAILED,\n}\n\nenum QueryResultType {\n  ANSWERED,\n  FAILED,\n}\n\nenum PendingActivityState {\n  SCHEDULED,\n  STARTED,\n  CANCEL_REQUESTED,\n}\n\nenum PendingDecisionState {\n  SCHEDULED,\n  STARTED,\n}\n\nenum HistoryEventFilterType {\n  ALL_EVENT,\n  CLOSE_EVENT,\n}\n\nenum TaskListKind {\n  NORMAL,\n  STICKY,\n  EPHEMERAL,\n}\n\nenum ArchivalStatus {\n  DISABLED,\n  ENABLED,\n}\n\nenum CronOverlapPolicy {\n  SKIPPED,\n  BUFFERONE,\n}\n\nenum IndexedValueType {\n  STRING,\n  KEYWORD,\n  INT,\n  DOUBLE,\n  BOOL,\n  DATETIME,\n}\n\nstruct Header {\n    10: optional map<string, binary> fields\n}\n\nstruct WorkflowType {\n  10: optional string name\n}\n\nstruct ActivityType {\n  10: optional string name\n}\n\nstruct TaskList {\n  10: optional string name\n  20: optional TaskListKind kind\n}\n\nenum EncodingType {\n  ThriftRW,\n  JSON,\n}\n\nenum QueryRejectCondition {\n  // NOT_OPEN indicates that query should be rejected if workflow is not open\n  NOT_OPEN\n  // NOT_COMPLETED_CLEANLY indicates that query should be rejected if workflow did not complete cleanly\n  NOT_COMPLETED_CLEANLY\n}\n\nenum QueryConsistencyLevel {\n  // EVENTUAL indicates that query should be eventually consistent\n  EVENTUAL\n  // STRONG indicates that any events that came before query should be reflected in workflow state before running query\n  STRONG\n}\n\nstruct DataBlob {\n  10: optional EncodingType EncodingType\n  20: optional binary Data\n}\n\nstruct TaskListMetadata {\n  10: optional double maxTasksPerSecond\n}\n\nstruct WorkflowExecution {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct Memo {\n  10: optional map<string,binary> fields\n}\n\nstruct SearchAttributes {\n  10: optional map<string,binary> indexedFields\n}\n\nstruct WorkerVersionInfo {\n  10: optional string impl\n  20: optional string featureVersion\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional WorkflowExecution execution\n  20: optional WorkflowType type\n  30: optional i64 (js.type = \"Long\") startTime\n  40: optional i64 (js.type = \"Long\") closeTime\n  50: optional WorkflowExecutionCloseStatus closeStatus\n  60: optional i64 (js.type = \"Long\") historyLength\n  70: optional string parentDomainId\n  71: optional string parentDomainName\n  72: optional i64 parentInitatedId\n  80: optional WorkflowExecution parentExecution\n  90: optional i64 (js.type = \"Long\") executionTime\n  100: optional Memo memo\n  101: optional SearchAttributes searchAttributes\n  110: optional ResetPoints autoResetPoints\n  120: optional string taskList\n  121: optional TaskList taskListInfo\n  130: optional bool isCron\n  140: optional i64 (js.type = \"Long\") updateTime\n  150: optional map<string, string> partitionConfig\n  160: optional CronOverlapPolicy cronOverlapPolicy\n  170: optional ActiveClusterSelectionPolicy activeClusterSelectionPolicy\n  180: optional string cronSchedule\n  190: optional WorkflowExecutionStatus executionStatus\n  200: optional i64 (js.type = \"Long\") scheduledExecutionTime\n}\n\nstruct WorkflowExecutionConfiguration {\n  10: optional TaskList taskList\n  20: optional i32 executionStartToCloseTimeoutSeconds\n  30: optional i32 taskStartToCloseTimeoutSeconds\n//  40: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n}\n\nstruct TransientDecisionInfo {\n  10: optional HistoryEvent scheduledEvent\n  20: optional HistoryEvent startedEvent\n}\n\nstruct ScheduleActivityTaskDecisionAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional Header header\n  90: optional bool requestLocalDispatch\n}\n\nstruct ActivityLocalDispatchInfo{\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") scheduledTimestamp\n  30: optional i64 (js.type = \"Long\") startedTimestamp\n  40: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  50: optional binary taskToken\n}\n\nstruct RequestCancelActivityTaskDecisionAttributes {\n  10: optional string activityId\n}\n\nstruct StartTimerDecisionAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n}\n\nstruct CompleteWorkflowExecutionDecisionAttributes {\n  10: optional binary result\n}\n\nstruct FailWorkflowExecutionDecisionAttributes {\n  10: optional string reason\n  20: optional binary details\n}\n\nstruct CancelTimerDecisionAttributes {\n  10: optional string timerId\n}\n\nstruct CancelWorkflowExecutionDecisionAttributes {\n  10: optional binary details\n}\n\nstruct RequestCancelExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional string runId\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional string signalName\n  40: optional binary input\n  50: optional binary control\n  60: optional bool childWorkflowOnly\n}\n\nstruct UpsertWorkflowSearchAttributesDecisionAttributes {\n  10: optional SearchAttributes searchAttributes\n}\n\nstruct RecordMarkerDecisionAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional Header header\n}\n\nstruct ContinueAsNewWorkflowExecutionDecisionAttributes {\n  10: optional WorkflowType workflowType\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n  60: optional i32 backoffStartIntervalInSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional ContinueAsNewInitiator initiator\n  90: optional string failureReason\n  100: optional binary failureDetails\n  110: optional binary lastCompletionResult\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n  160: optional i32 jitterStartSeconds\n  170: optional CronOverlapPolicy cronOverlapPolicy\n  180: optional ActiveClusterSelectionPolicy activeClusterSelectionPolicy\n}\n\nstruct StartChildWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n//  80: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81: optional ParentClosePolicy parentClosePolicy\n  90: optional binary control\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional RetryPolicy retryPolicy\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n  160: optional CronOverlapPolicy cronOverlapPolicy\n  170: optional ActiveClusterSelectionPolicy activeClusterSelectionPolicy\n}\n\nstruct Decision {\n  10:  optional DecisionType decisionType\n  20:  optional ScheduleActivityTaskDecisionAttributes scheduleActivityTaskDecisionAttributes\n  25:  optional StartTimerDecisionAttributes startTimerDecisionAttributes\n  30:  optional CompleteWorkflowExecutionDecisionAttributes completeWorkflowExecutionDecisionAttributes\n  35:  optional FailWorkflowExecutionDecisionAttributes failWorkflowExecutionDecisionAttributes\n  40:  optional RequestCancelActivityTaskDecisionAttributes requestCancelActivityTaskDecisionAttributes\n  50:  optional CancelTimerDecisionAttributes cancelTimerDecisionAttributes\n  60:  optional CancelWorkflowExecutionDecisionAttributes cancelWorkflowExecutionDecisionAttributes\n  70:  optional RequestCancelExternalWorkflowExecutionDecisionAttributes requestCancelExternalWorkflowExecutionDecisionAttributes\n  80:  optional RecordMarkerDecisionAttributes recordMarkerDecisionAttributes\n  90:  optional ContinueAsNewWorkflowExecutionDecisionAttributes continueAsNewWorkflowExecutionDecisionAttributes\n  100: optional StartChildWorkflowExecutionDecisionAttributes startChildWorkflowExecutionDecisionAttributes\n  110: optional SignalExternalWorkflowExecutionDecisionAttributes signalExternalWorkflowExecutionDecisionAttributes\n  120: optional UpsertWorkflowSearchAttributesDecisionAttributes upsertWorkflowSearchAttributesDecisionAttributes\n}\n\nstruct WorkflowExecutionStartedEventAttributes {\n  10: optional WorkflowType workflowType\n  12: optional string parentWorkflowDomain\n  14: optional WorkflowExecution parentWorkflowExecution\n  16: optional i64 (js.type = \"Long\") parentInitiatedEventId\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n//  52: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  54: optional string continuedExecutionRunId\n  55: optional ContinueAsNewInitiator initiator\n  56: optional string continuedFailureReason\n  57: optional binary continuedFailureDetails\n  58: optional binary lastCompletionResult\n  59: optional string originalExecutionRunId // This is the runID when the WorkflowExecutionStarted event is written\n  60: optional string identity\n  61: optional string firstExecutionRunId // This is the very first runID along the chain of ContinueAsNew and Reset.\n  62: optional i64 (js.type = \"Long\") firstScheduledTimeNano\n  70: optional RetryPolicy retryPolicy\n  80: optional i32 attempt\n  90: optional i64 (js.type = \"Long\") expirationTimestamp\n  100: optional string cronSchedule\n  110: optional i32 firstDecisionTaskBackoffSeconds\n  120: optional Memo memo\n  121: optional SearchAttributes searchAttributes\n  130: optional ResetPoints prevAutoResetPoints\n  140: optional Header header\n  150: optional map<string, string> partitionConfig\n  160: optional string requestId\n  170: optional CronOverlapPolicy cronOverlapPolicy\n  180: optional ActiveClusterSelectionPolicy activeClusterSelectionPolicy\n}\n\nstruct ResetPoints{\n  10: optional list<ResetPointInfo> points\n}\n\n struct ResetPointInfo{\n  10: optional string binaryChecksum\n  20: optional string runId\n  30: optional i64 firstDecisionCompletedId\n  40: optional i64 (js.type = \"Long\") createdTimeNano\n  50: optional i64 (js.type = \"Long\") expiringTimeNano //the time that the run is deleted due to retention\n  60: optional bool resettable                         // false if the resset point has pending childWFs/reqCancels/signalExternals.\n}\n\nstruct WorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n}\n\nenum ContinueAsNewInitiator {\n  Decider,\n  RetryPolicy,\n  CronSchedule,\n}\n\nstruct WorkflowExecutionContinuedAsNewEventAttributes {\n  10: optional string newExecutionRunId\n  20: optional WorkflowType workflowType\n  30: optional TaskList taskList\n  40: optional binary input\n  50: optional i32 executionStartToCloseTimeoutSeconds\n  60: optional i32 taskStartToCloseTimeoutSeconds\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  80: optional i32 backoffStartIntervalInSeconds\n  90: optional ContinueAsNewInitiator initiator\n  100: optional string failureReason\n  110: optional binary failureDetails\n  120: optional binary lastCompletionResult\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n  160: optional CronOverlapPolicy cronOverlapPolicy\n  170: optional ActiveClusterSelectionPolicy activeClusterSelectionPolicy\n}\n\nstruct DecisionTaskScheduledEventAttributes {\n  10: optional TaskList taskList\n  20: optional i32 startToCloseTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") attempt\n}\n\nstruct DecisionTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n}\n\nstruct DecisionTaskCompletedEventAttributes {\n  10: optional binary executionContext\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct DecisionTaskTimedOutEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // for reset workflow\n  40: optional string baseRunId\n  50: optional string newRunId\n  60: optional i64 (js.type = \"Long\") forkEventVersion\n  70: optional string reason\n  80: optional DecisionTaskTimedOutCause cause\n  90: optional string requestId\n}\n\nstruct DecisionTaskFailedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional DecisionTaskFailedCause cause\n  35: optional binary details\n  40: optional string identity\n  50: optional string reason\n  // for reset workflow\n  60: optional string baseRunId\n  70: optional string newRunId\n  80: optional i64 (js.type = \"Long\") forkEventVersion\n  90: optional string binaryChecksum\n  100: optional string requestId\n}\n\nstruct ActivityTaskScheduledEventAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  90: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional RetryPolicy retryPolicy\n  120: optional Header header\n}\n\nstruct ActivityTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n  40: optional i32 attempt\n  50: optional string lastFailureReason\n  60: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n}\n\nstruct ActivityTaskFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct ActivityTaskTimedOutEventAttributes {\n  05: optional binary details\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // For retry activity, it may have a failure before timeout. It's important to keep those information for debug.\n  // Client can also provide the info for making next decision\n  40: optional string lastFailureReason\n  50: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCancelRequestedEventAttributes {\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct RequestCancelActivityTaskFailedEventAttributes{\n  10: optional string activityId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ActivityTaskCanceledEventAttributes {\n  10: optional binary details\n  20: optional i64 (js.type = \"Long\") latestCancelRequestedEventId\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct TimerStartedEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct TimerFiredEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct TimerCanceledEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct CancelTimerFailedEventAttributes {\n  10: optional string timerId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct WorkflowExecutionCancelRequestedEventAttributes {\n  10: optional string cause\n  20: optional i64 (js.type = \"Long\") externalInitiatedEventId\n  30: optional WorkflowExecution externalWorkflowExecution\n  40: optional string identity\n  50: optional string requestId\n}\n\nstruct WorkflowExecutionCanceledEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional binary details\n}\n\nstruct MarkerRecordedEventAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional Header header\n}\n\nstruct WorkflowExecutionSignaledEventAttributes {\n  10: optional string signalName\n  20: optional binary input\n  30: optional string identity\n  40: optional string requestId\n}\n\nstruct WorkflowExecutionTerminatedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct RequestCancelExternalWorkflowExecutionFailedEventAttributes {\n  10: optional CancelExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionCancelRequestedEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n}\n\nstruct SignalExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional string signalName\n  50: optional binary input\n  60: optional binary control\n  70: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionFailedEventAttributes {\n  10: optional SignalExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionSignaledEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n}\n\nstruct UpsertWorkflowSearchAttributesEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional SearchAttributes searchAttributes\n}\n\nstruct StartChildWorkflowExecutionInitiatedEventAttributes {\n  10:  optional string domain\n  20:  optional string workflowId\n  30:  optional WorkflowType workflowType\n  40:  optional TaskList taskList\n  50:  optional binary input\n  60:  optional i32 executionStartToCloseTimeoutSeconds\n  70:  optional i32 taskStartToCloseTimeoutSeconds\n//  80:  optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81:  optional ParentClosePolicy parentClosePolicy\n  90:  optional binary control\n  100: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Header header\n  150: optional Memo memo\n  160: optional SearchAttributes searchAttributes\n  170: optional i32 delayStartSeconds\n  180: optional i32 jitterStartSeconds\n  190: optional i64 (js.type = \"Long\") firstRunAtTimestamp\n  200: optional CronOverlapPolicy cronOverlapPolicy\n  210: optional ActiveClusterSelectionPolicy activeClusterSelectionPolicy\n}\n\nstruct StartChildWorkflowExecutionFailedEventAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional ChildWorkflowExecutionFailedCause cause\n  50: optional binary control\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ChildWorkflowExecutionStartedEventAttributes {\n  10: optional string domain\n  20: optional i64 (js.type = \"Long\") initiatedEventId\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional Header header\n}\n\nstruct ChildWorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional WorkflowType workflowType\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionCanceledEventAttributes {\n  10: optional binary details\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTerminatedEventAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") initiatedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct HistoryEvent {\n  10:  optional i64 (js.type = \"Long\") eventId\n  20:  optional i64 (js.type = \"Long\") timestamp\n  30:  optional EventType eventType\n  35:  optional i64 (js.type = \"Long\") version\n  36:  optional i64 (js.type = \"Long\") taskId\n  40:  optional WorkflowExecutionStartedEventAttributes workflowExecutionStartedEventAttributes\n  50:  optional WorkflowExecutionCompletedEventAttributes workflowExecutionCompletedEventAttributes\n  60:  optional WorkflowExecutionFailedEventAttributes workflowExecutionFailedEventAttributes\n  70:  optional WorkflowExecutionTimedOutEventAttributes workflowExecutionTimedOutEventAttributes\n  80:  optional DecisionTaskScheduledEventAttributes decisionTaskScheduledEventAttributes\n  90:  optional DecisionTaskStartedEventAttributes decisionTaskStartedEventAttributes\n  100: optional DecisionTaskCompletedEventAttributes decisionTaskCompletedEventAttributes\n  110: optional DecisionTaskTimedOutEventAttributes decisionTaskTimedOutEventAttributes\n  120: optional DecisionTaskFailedEventAttributes decisionTaskFailedEventAttributes\n  130: optional ActivityTaskScheduledEventAttributes activityTaskScheduledEventAttributes\n  140: optional ActivityTaskStartedEventAttributes activityTaskStartedEventAttributes\n  150: optional ActivityTaskCompletedEventAttributes activityTaskCompletedEventAttributes\n  160: optional ActivityTaskFailedEventAttributes activityTaskFailedEventAttributes\n  170: optional ActivityTaskTimedOutEventAttributes activityTaskTimedOutEventAttributes\n  180: optional TimerStartedEventAttributes timerStartedEventAttributes\n  190: optional TimerFiredEventAttributes timerFiredEventAttributes\n  200: optional ActivityTaskCancelRequestedEventAttributes activityTaskCancelRequestedEventAttributes\n  210: optional RequestCancelActivityTaskFailedEventAttributes requestCancelActivityTaskFailedEventAttributes\n  220: optional ActivityTaskCanceledEventAttributes activityTaskCanceledEventAttributes\n  230: optional TimerCanceledEventAttributes timerCanceledEventAttributes\n  240: optional CancelTimerFailedEventAttributes cancelTimerFailedEventAttributes\n  250: optional MarkerRecordedEventAttributes markerRecordedEventAttributes\n  260: optional WorkflowExecutionSignaledEventAttributes workflowExecutionSignaledEventAttributes\n  270: optional WorkflowExecutionTerminatedEventAttributes workflowExecutionTerminatedEventAttributes\n  280: optional WorkflowExecutionCancelRequestedEventAttributes workflowExecutionCancelRequestedEventAttributes\n  290: optional WorkflowExecutionCanceledEventAttributes workflowExecutionCanceledEventAttributes\n  300: optional RequestCancelExternalWorkflowExecutionInitiatedEventAttributes requestCancelExternalWorkflowExecutionInitiatedEventAttributes\n  310: optional RequestCancelExternalWorkflowExecutionFailedEventAttributes requestCancelExternalWorkflowExecutionFailedEventAttributes\n  320: optional ExternalWorkflowExecutionCancelRequestedEventAttributes externalWorkflowExecutionCancelRequestedEventAttributes\n  330: optional WorkflowExecutionContinuedAsNewEventAttributes workflowExecutionContinuedAsNewEventAttributes\n  340: optional StartChildWorkflowExecutionInitiatedEventAttributes startChildWorkflowExecutionInitiatedEventAttributes\n  350: optional StartChildWorkflowExecutionFailedEventAttributes startChildWorkflowExecutionFailedEventAttributes\n  360: optional ChildWorkflowExecutionStartedEventAttributes childWorkflowExecutionStartedEventAttributes\n  370: optional ChildWorkflowExecutionCompletedEventAttributes childWorkflowExecutionCompletedEventAttributes\n  380: optional ChildWorkflowExecutionFailedEventAttributes childWorkflowExecutionFailedEventAttributes\n  390: optional ChildWorkflowExecutionCanceledEventAttributes childWorkflowExecutionCanceledEventAttributes\n  400: optional ChildWorkflowExecutionTimedOutEventAttributes childWorkflowExecutionTimedOutEventAttributes\n  410: optional ChildWorkflowExecutionTerminatedEventAttributes childWorkflowExecutionTerminatedEventAttributes\n  420: optional SignalExternalWorkflowExecutionInitiatedEventAttributes signalExternalWorkflowExecutionInitiatedEventAttributes\n  430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes\n  440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes\n  450: optional UpsertWorkflowSearchAttributesEventAttributes upsertWorkflowSearchAttributesEventAttributes\n}\n\nstruct History {\n  10: optional list<HistoryEvent> events\n}\n\nstruct WorkflowExecutionFilter {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct WorkflowTypeFilter {\n  10: optional string name\n}\n\nstruct StartTimeFilter {\n  10: optional i64 (js.type = \"Long\") earliestTime\n  20: optional i64 (js.type = \"Long\") latestTime\n}\n\nstruct DomainInfo {\n  10: optional string name\n  20: optional DomainStatus status\n  30: optional string description\n  40: optional string ownerEmail\n  // A key-value map for any customized purpose\n  50: optional map<string,string> data\n  60: optional string uuid\n}\n\nstruct DomainConfiguration {\n  10: optional i32 workflowExecutionRetentionPeriodInDays\n  20: optional bool emitMetric\n  60: optional IsolationGroupConfiguration isolationgroups\n  70: optional BadBinaries badBinaries\n  80: optional ArchivalStatus historyArchivalStatus\n  90: optional string historyArchivalURI\n  100: optional ArchivalStatus visibilityArchivalStatus\n  110: optional string visibilityArchivalURI\n  120: optional AsyncWorkflowConfiguration AsyncWorkflowConfiguration\n}\n\nstruct FailoverInfo {\n    10: optional i64 (js.type = \"Long\") failoverVersion\n    20: optional i64 (js.type = \"Long\") failoverStartTimestamp\n    30: optional i64 (js.type = \"Long\") failoverExpireTimestamp\n    40: optional i32 completedShardCount\n    50: optional list<i32> pendingShards\n}\n\nstruct BadBinaries{\n  10: optional map<string, BadBinaryInfo> binaries\n}\n\nstruct BadBinaryInfo{\n  10: optional string reason\n  20: optional string operator\n  30: optional i64 (js.type = \"Long\") createdTimeNano\n}\n\nstruct UpdateDomainInfo {\n  10: optional string description\n  20: optional string ownerEmail\n  // A key-value map for any customized purpose\n  30: optional map<string,string> data\n}\n\nstruct ClusterReplicationConfiguration {\n 10: optional string clusterName\n}\n\nstruct DomainReplicationConfiguration {\n // activeClusterName is the name of the active cluster for active-passive domain\n 10: optional string activeClusterName\n\n //  clusters is list of all active and passive clusters of domain\n 20: optional list<ClusterReplicationConfiguration> clusters\n\n // activeClusters contains active cluster(s) information for active-active domain\n 30: optional ActiveClusters activeClusters\n}\n\n// ClusterAttributeScope is a mapping of the cluster atribute to the scope's\n// current stae and failover version, indicating how recently the change was made\nstruct ClusterAttributeScope {\n  10: optional map<string, ActiveClusterInfo> clusterAttributes;\n}\n\n// activeClustersByClusterAttribute is a map of whatever subdivision of the domain chosen\n// to active cluster info for active-active domains. The key refers to the type of\n// cluster attribute and the value refers to its cluster mappings.\n//\n// For example, a request to update the domain for two locations\n//\n// UpdateDomainRequest{\n//    ReplicationConfiguration: {\n//       ActiveClusters: {\n//           ActiveClustersByClusterAttribute: {\n//             \"location\": ClusterAttributeScope{\n//                   \"Tokyo\": {ActiveClusterInfo: \"cluster0, FailoverVersion: 123},\n//                   \"Morocco\": {ActiveClusterInfo: \"cluster1\", FailoverVersion: 100},\n//             }\n//          }\n//       }\n//    }\n//  }\nstruct ActiveClusters {\n  10: optional map<string, ActiveClusterInfo> activeClustersByRegion // todo (david.porter) remove this as it's no longer used\n  11: optional map<string, ClusterAttributeScope> activeClustersByClusterAttribute\n}\n\n// ActiveClusterInfo contains the configuration of active-active domain's active\n// cluster & failover version for a specific region\nstruct ActiveClusterInfo {\n  10: optional string activeClusterName\n  20: optional i64 (js.type = \"Long\") failoverVersion\n}\n\nstruct RegisterDomainRequest {\n  10: optional string name\n  20: optional string description\n  30: optional string ownerEmail\n  40: optional i32 workflowExecutionRetentionPeriodInDays\n  50: optional bool emitMetric = true\n  60: optional list<ClusterReplicationConfiguration> clusters\n  70: optional string activeClusterName\n  // todo (david.porter) remove this field as it's not going to be used\n  75: optional map<string, string> activeClustersByRegion\n  // activeClusters is a map of cluster-attribute name to active cluster name for active-active domain\n  76: optional ActiveClusters activeClusters\n  // A key-value map for any customized purpose\n  80: optional map<string,string> data\n  90: optional string securityToken\n  120: optional bool isGlobalDomain\n  130: optional ArchivalStatus historyArchivalStatus\n  140: optional string historyArchivalURI\n  150: optional ArchivalStatus visibilityArchivalStatus\n  160: optional string visibilityArchivalURI\n}\n\nstruct ListDomainsRequest {\n  10: optional i32 pageSize\n  20: optional binary nextPageToken\n}\n\nstruct ListDomainsResponse {\n  10: optional list<DescribeDomainResponse> domains\n  20: optional binary nextPageToken\n}\n\nstruct DescribeDomainRequest {\n  10: optional string name\n  20: optional string uuid\n}\n\nstruct DescribeDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n  60: optional FailoverInfo failoverInfo\n}\n\nstruct UpdateDomainRequest {\n 10: optional string name\n 20: optional UpdateDomainInfo updatedInfo\n 30: optional DomainConfiguration configuration\n 40: optional DomainReplicationConfiguration replicationConfiguration\n 50: optional string securityToken\n 60: optional string deleteBadBinary\n 70: optional i32 failoverTimeoutInSeconds\n}\n\nstruct UpdateDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n}\n\nstruct FailoverDomainRequest {\n 10: optional string domainName\n 20: optional string domainActiveClusterName\n // only applicable to active-active domains where\n // specific cluster-attributes are being failed over\n 30: optional ActiveClusters activeClusters\n // user-requested addition \"reason\" variable created to increase transparency around failovers\n 40: optional string reason\n}\n\nstruct FailoverDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n}\n\nstruct DeprecateDomainRequest {\n 10: optional string name\n 20: optional string securityToken\n}\n\nstruct DeleteDomainRequest {\n 10: optional string name\n 20: optional string securityToken\n}\n\nstruct ListFailoverHistoryRequest {\n  // ListFailoverHistoryRequestFilters specifies the filters to apply to the request.\n  // If not provided all failover events will be returned.\n  10: optional ListFailoverHistoryRequestFilters filters\n  // PaginationOptions will be used to paginate the results.\n  // If not provided the first 5 events will be returned.\n  20: optional PaginationOptions pagination\n}\n\n// ListFailoverHistoryRequestFilters is used to filter the failover history.\n// It will be extended with additional filters (e.g ClusterAttributes) as the active-active feature is developed.\nstruct ListFailoverHistoryRequestFilters {\n  // domain_id is the id of the domain to list failover history for.\n  10: optional string domainID\n}\n\nstruct ListFailoverHistoryResponse {\n  10: optional list<FailoverEvent> failoverEvents\n  // next_page_token can be passed in a subsequent request to fetch the next set of events.\n  20: optional binary nextPageToken\n}\n\nstruct FailoverEvent {\n  // id of the failover event\n  // Can be passed with the created time to fetch a specific event.\n  10: optional string id\n  // created_time is the time the failover event was created.\n  // Can be passed with the ID to fetch a specific event.\n  20: optional i64 (js.type = \"Long\") createdTime\n  30: optional FailoverType failoverType\n  40: optional list<ClusterFailover> clusterFailovers\n}\n\nstruct ClusterFailover {\n  10: optional ActiveClusterInfo fromCluster\n  20: optional ActiveClusterInfo toCluster\n  // cluster_attribute is the scope and name for the attribute that was failed over.\n  // If the cluster_attribute is not defined this failover can be assumed to be the default ActiveCluster.\n  30: optional ClusterAttribute clusterAttribute\n}\n\nstruct StartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n//  110: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Memo memo\n  141: optional SearchAttributes searchAttributes\n  150: optional Header header\n  160: optional i32 delayStartSeconds\n  170: optional i32 jitterStartSeconds\n  180: optional i64 (js.type = \"Long\") firstRunAtTimestamp\n  190: optional CronOverlapPolicy cronOverlapPolicy\n  200: optional ActiveClusterSelectionPolicy activeClusterSelectionPolicy\n}\n\nstruct StartWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct StartWorkflowExecutionAsyncRequest {\n  10: optional StartWorkflowExecutionRequest request\n}\n\nstruct StartWorkflowExecutionAsyncResponse {\n}\n\nstruct RestartWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct DiagnoseWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string identity\n}\n\nstruct DiagnoseWorkflowExecutionResponse {\n  10: optional string domain\n  20: optional WorkflowExecution diagnosticWorkflowExecution\n}\n\nstruct PollForDecisionTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional string binaryChecksum\n}\n\nstruct PollForDecisionTaskResponse {\n  10: optional binary taskToken\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") previousStartedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n  51: optional i64 (js.type = 'Long') attempt\n  54: optional i64 (js.type = \"Long\") backlogCountHint\n  60: optional History history\n  70: optional binary nextPageToken\n  80: optional WorkflowQuery query\n  90: optional TaskList WorkflowExecutionTaskList\n  100: optional i64 (js.type = \"Long\") scheduledTimestamp\n  110: optional i64 (js.type = \"Long\") startedTimestamp\n  120: optional map<string, WorkflowQuery> queries\n  130: optional i64 (js.type = 'Long') nextEventId\n  140: optional i64 (js.type = 'Long') totalHistoryBytes\n  150: optional AutoConfigHint autoConfigHint\n}\n\nstruct StickyExecutionAttributes {\n  10: optional TaskList workerTaskList\n  20: optional i32 scheduleToStartTimeoutSeconds\n}\n\nstruct RespondDecisionTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional list<Decision> decisions\n  30: optional binary executionContext\n  40: optional string identity\n  50: optional StickyExecutionAttributes stickyAttributes\n  60: optional bool returnNewDecisionTask\n  70: optional bool forceCreateNewDecisionTask\n  80: optional string binaryChecksum\n  90: optional map<string, WorkflowQueryResult> queryResults\n}\n\nstruct RespondDecisionTaskCompletedResponse {\n  10: optional PollForDecisionTaskResponse decisionTask\n  20: optional map<string,ActivityLocalDispatchInfo> activitiesToDispatchLocally\n}\n\nstruct RespondDecisionTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional DecisionTaskFailedCause cause\n  30: optional binary details\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct PollForActivityTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional TaskListMetadata taskListMetadata\n}\n\nstruct PollForActivityTaskResponse {\n  10:  optional binary taskToken\n  20:  optional WorkflowExecution workflowExecution\n  30:  optional string activityId\n  40:  optional ActivityType activityType\n  50:  optional binary input\n  70:  optional i64 (js.type = \"Long\") scheduledTimestamp\n  80:  optional i32 scheduleToCloseTimeoutSeconds\n  90:  optional i64 (js.type = \"Long\") startedTimestamp\n  100: optional i32 startToCloseTimeoutSeconds\n  110: optional i32 heartbeatTimeoutSeconds\n  120: optional i32 attempt\n  130: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  140: optional binary heartbeatDetails\n  150: optional WorkflowType workflowType\n  160: optional string workflowDomain\n  170: optional Header header\n  180: optional AutoConfigHint autoConfigHint\n}\n\nstruct RecordActivityTaskHeartbeatRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatResponse {\n  10: optional bool cancelRequested\n}\n\nstruct RespondActivityTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional binary result\n  30: optional string identity\n}\n\nstruct RespondActivityTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional string reason\n  30: optional binary details\n  40: optional string identity\n}\n\nstruct RespondActivityTaskCanceledRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RespondActivityTaskCompletedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary result\n  60: optional string identity\n}\n\nstruct RespondActivityTaskFailedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional string reason\n  60: optional binary details\n  70: optional string identity\n}\n\nstruct RespondActivityTaskCanceledByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RequestCancelWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string identity\n  40: optional string requestId\n  50: optional string cause\n  60: optional string firstExecutionRunID\n}\n\nstruct GetWorkflowExecutionHistoryRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional i32 maximumPageSize\n  40: optional binary nextPageToken\n  50: optional bool waitForNewEvent\n  60: optional HistoryEventFilterType HistoryEventFilterType\n  70: optional bool skipArchival\n  80: optional QueryConsistencyLevel queryConsistencyLevel\n}\n\nstruct GetWorkflowExecutionHistoryResponse {\n  10: optional History history\n  11: optional list<DataBlob> rawHistory\n  20: optional binary nextPageToken\n  30: optional bool archived\n}\n\nstruct SignalWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string signalName\n  40: optional binary input\n  50: optional string identity\n  60: optional string requestId\n  70: optional binary control\n}\n\nstruct SignalWithStartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional string signalName\n  120: optional binary signalInput\n  130: optional binary control\n  140: optional RetryPolicy retryPolicy\n  150: optional string cronSchedule\n  160: optional Memo memo\n  161: optional SearchAttributes searchAttributes\n  170: optional Header header\n  180: optional i32 delayStartSeconds\n  190: optional i32 jitterStartSeconds\n  200: optional i64 (js.type = \"Long\") firstRunAtTimestamp\n  210: optional CronOverlapPolicy cronOverlapPolicy\n  220: optional ActiveClusterSelectionPolicy activeClusterSelectionPolicy\n}\n\nstruct SignalWithStartWorkflowExecutionAsyncRequest {\n  10: optional SignalWithStartWorkflowExecutionRequest request\n}\n\nstruct SignalWithStartWorkflowExecutionAsyncResponse {\n}\n\nstruct RestartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional string identity\n}\nstruct TerminateWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional binary details\n  50: optional string identity\n  60: optional string firstExecutionRunID\n}\n\nstruct ResetWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional i64 (js.type = \"Long\") decisionFinishEventId\n  50: optional string requestId\n  60: optional bool skipSignalReapply\n}\n\nstruct ResetWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct ListOpenWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n}\n\nstruct ListOpenWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListClosedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n  70: optional WorkflowExecutionCloseStatus statusFilter\n}\n\nstruct ListClosedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListArchivedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListArchivedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct CountWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional string query\n}\n\nstruct CountWorkflowExecutionsResponse {\n  10: optional i64 count\n}\n\nstruct GetSearchAttributesResponse {\n  10: optional map<string, IndexedValueType> keys\n}\n\nstruct QueryWorkflowRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional WorkflowQuery query\n  // QueryRejectCondition can used to reject the query if workflow state does not satisify condition\n  40: optional QueryRejectCondition queryRejectCondition\n  50: optional QueryConsistencyLevel queryConsistencyLevel\n}\n\nstruct QueryRejected {\n  10: optional WorkflowExecutionCloseStatus closeStatus\n}\n\nstruct QueryWorkflowResponse {\n  10: optional binary queryResult\n  20: optional QueryRejected queryRejected\n}\n\nstruct WorkflowQuery {\n  10: optional string queryType\n  20: optional binary queryArgs\n}\n\nstruct ResetStickyTaskListRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct ResetStickyTaskListResponse {\n    // The reason to keep this response is to allow returning\n    // information in the future.\n}\n\nstruct RespondQueryTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional QueryTaskCompletedType completedType\n  30: optional binary queryResult\n  40: optional string errorMessage\n  50: optional WorkerVersionInfo workerVersionInfo\n}\n\nstruct WorkflowQueryResult {\n  10: optional QueryResultType resultType\n  20: optional binary answer\n  30: optional string errorMessage\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional QueryConsistencyLevel queryConsistencyLevel\n}\n\nstruct PendingActivityInfo {\n  10: optional string activityID\n  20: optional ActivityType activityType\n  30: optional PendingActivityState state\n  40: optional binary heartbeatDetails\n  50: optional i64 (js.type = \"Long\") lastHeartbeatTimestamp\n  60: optional i64 (js.type = \"Long\") lastStartedTimestamp\n  70: optional i32 attempt\n  80: optional i32 maximumAttempts\n  90: optional i64 (js.type = \"Long\") scheduledTimestamp\n  100: optional i64 (js.type = \"Long\") expirationTimestamp\n  110: optional string lastFailureReason\n  120: optional string lastWorkerIdentity\n  130: optional binary lastFailureDetails\n  140: optional string startedWorkerIdentity\n  150: optional i64 (js.type = \"Long\") scheduleID\n}\n\nstruct PendingDecisionInfo {\n  10: optional PendingDecisionState state\n  20: optional i64 (js.type = \"Long\") scheduledTimestamp\n  30: optional i64 (js.type = \"Long\") startedTimestamp\n  40: optional i64 attempt\n  50: optional i64 (js.type = \"Long\") originalScheduledTimestamp\n  60: optional i64 (js.type = \"Long\") scheduleID\n}\n\nstruct PendingChildExecutionInfo {\n  1: optional string domain\n  10: optional string workflowID\n  20: optional string runID\n  30: optional string workflowTypName\n  40: optional i64 (js.type = \"Long\") initiatedID\n  50: optional ParentClosePolicy parentClosePolicy\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional WorkflowExecutionConfiguration executionConfiguration\n  20: optional WorkflowExecutionInfo workflowExecutionInfo\n  30: optional list<PendingActivityInfo> pendingActivities\n  40: optional list<PendingChildExecutionInfo> pendingChildren\n  50: optional PendingDecisionInfo pendingDecision\n}\n\nstruct DescribeTaskListRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional TaskListType taskListType\n  40: optional bool includeTaskListStatus\n}\n\nstruct DescribeTaskListResponse {\n  10: optional list<PollerInfo> pollers\n  20: optional TaskListStatus taskListStatus\n  // The TaskList being described\n  30: optional TaskList taskList\n}\n\nstruct GetTaskListsByDomainRequest {\n  10: optional string domainName\n}\n\nstruct GetTaskListsByDomainResponse {\n  10: optional map<string,DescribeTaskListResponse> decisionTaskListMap\n  20: optional map<string,DescribeTaskListResponse> activityTaskListMap\n}\n\nstruct ListTaskListPartitionsRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n}\n\nstruct TaskListPartitionMetadata {\n  10: optional string key\n  20: optional string ownerHostName\n}\n\nstruct ListTaskListPartitionsResponse {\n  10: optional list<TaskListPartitionMetadata> activityTaskListPartitions\n  20: optional list<TaskListPartitionMetadata> decisionTaskListPartitions\n}\n\nstruct IsolationGroupMetrics {\n  10: optional double newTasksPerSecond\n  20: optional i64 (js.type = \"Long\") pollerCount\n}\n\nstruct TaskListStatus {\n  10: optional i64 (js.type = \"Long\") backlogCountHint\n  20: optional i64 (js.type = \"Long\") readLevel\n  30: optional i64 (js.type = \"Long\") ackLevel\n  35: optional double ratePerSecond\n  40: optional TaskIDBlock taskIDBlock\n  50: optional map<string, IsolationGroupMetrics> isolationGroupMetrics\n  60: optional double newTasksPerSecond\n  70: optional bool empty\n}\n\nstruct TaskIDBlock {\n  10: optional i64 (js.type = \"Long\")  startID\n  20: optional i64 (js.type = \"Long\")  endID\n}\n\n//At least one of the parameters needs to be provided\nstruct DescribeHistoryHostRequest {\n  10: optional string               hostAddress //ip:port\n  20: optional i32                  shardIdForHost\n  30: optional WorkflowExecution    executionForHost\n}\n\nstruct RemoveTaskRequest {\n  10: optional i32                      shardID\n  20: optional i32                      type\n  30: optional i64 (js.type = \"Long\")   taskID\n  40: optional i64 (js.type = \"Long\")   visibilityTimestamp\n  50: optional string                   clusterName\n}\n\nstruct CloseShardRequest {\n  10: optional i32               shardID\n}\n\nstruct ResetQueueRequest {\n  10: optional i32    shardID\n  20: optional string clusterName\n  30: optional i32    type\n}\n\nstruct DescribeQueueRequest {\n  10: optional i32    shardID\n  20: optional string clusterName\n  30: optional i32    type\n}\n\nstruct DescribeQueueResponse {\n  10: optional list<string> processingQueueStates\n}\n\nstruct DescribeShardDistributionRequest {\n  10: optional i32 pageSize\n  20: optional i32 pageID\n}\n\nstruct DescribeShardDistributionResponse {\n  10: optional i32              numberOfShards\n\n  // ShardID to Address (ip:port) map\n  20: optional map<i32, string> shards\n}\n\nstruct DescribeHistoryHostResponse{\n  10: optional i32                  numberOfShards\n  20: optional list<i32>            shardIDs\n  30: optional DomainCacheInfo      domainCache\n  40: optional string               shardControllerStatus\n  50: optional string               address\n}\n\nstruct DomainCacheInfo{\n  10: optional i64 numOfItemsInCacheByID\n  20: optional i64 numOfItemsInCacheByName\n}\n\nenum TaskListType {\n  /*\n   * Decision type of tasklist\n   */\n  Decision,\n  /*\n   * Activity type of tasklist\n   */\n  Activity,\n}\n\nstruct PollerInfo {\n  // Unix Nano\n  10: optional i64 (js.type = \"Long\")  lastAccessTime\n  20: optional string identity\n  30: optional double ratePerSecond\n}\n\nstruct RetryPolicy {\n  // Interval of the first retry. If coefficient is 1.0 then it is used for all retries.\n  10: optional i32 initialIntervalInSeconds\n\n  // Coefficient used to calculate the next retry interval.\n  // The next retry interval is previous interval multiplied by the coefficient.\n  // Must be 1 or larger.\n  20: optional double backoffCoefficient\n\n  // Maximum interval between retries. Exponential backoff leads to interval increase.\n  // This value is the cap of the increase. Default is 100x of initial interval.\n  30: optional i32 maximumIntervalInSeconds\n\n  // Maximum number of attempts. When exceeded the retries stop even if not expired yet.\n  // Must be 1 or bigger. Default is unlimited.\n  40: optional i32 maximumAttempts\n\n  // Non-Retriable errors. Will stop retrying if error matches this list.\n  50: optional list<string> nonRetriableErrorReasons\n\n  // Expiration time for the whole retry process.\n  60: optional i32 expirationIntervalInSeconds\n}\n\n// HistoryBranchRange represents a piece of range for a branch.\nstruct HistoryBranchRange{\n  // branchID of original branch forked from\n  10: optional string branchID\n  // beinning node for the range, inclusive\n  20: optional i64 beginNodeID\n  // ending node for the range, exclusive\n  30: optional i64 endNodeID\n}\n\n// For history persistence to serialize/deserialize branch details\nstruct HistoryBranch{\n  10: optional string treeID\n  20: optional string branchID\n  30: optional list<HistoryBranchRange> ancestors\n}\n\n// VersionHistoryItem contains signal eventID and the corresponding version\nstruct VersionHistoryItem{\n  10: optional i64 (js.type = \"Long\") eventID\n  20: optional i64 (js.type = \"Long\") version\n}\n\n// VersionHistory contains the version history of a branch\nstruct VersionHistory{\n  10: optional binary branchToken\n  20: optional list<VersionHistoryItem> items\n}\n\n// VersionHistories contains all version histories from all branches\nstruct VersionHistories{\n  10: optional i32 currentVersionHistoryIndex\n  20: optional list<VersionHistory> histories\n}\n\n// ReapplyEventsRequest is the request for reapply events API\nstruct ReapplyEventsRequest{\n  10: optional string domainName\n  20: optional WorkflowExecution workflowExecution\n  30: optional DataBlob events\n}\n\n// SupportedClientVersions contains the support versions for client library\nstruct SupportedClientVersions{\n  10: optional string goSdk\n  20: optional string javaSdk\n}\n\n// ClusterInfo contains information about cadence cluster\nstruct ClusterInfo{\n  10: optional SupportedClientVersions supportedClientVersions\n}\n\nstruct RefreshWorkflowTasksRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\n// DEPRECATED: use proto definition instead\nstruct FeatureFlags {\n  10: optional bool WorkflowExecutionAlreadyCompletedErrorEnabled\n  20: optional bool AutoForwardingEnabled\n}\n\nenum CrossClusterTaskType {\n  StartChildExecution\n  CancelExecution\n  SignalExecution\n  RecordChildWorkflowExecutionComplete\n  ApplyParentClosePolicy\n}\n\nenum CrossClusterTaskFailedCause {\n  DOMAIN_NOT_ACTIVE\n  DOMAIN_NOT_EXISTS\n  WORKFLOW_ALREADY_RUNNING\n  WORKFLOW_NOT_EXISTS\n  WORKFLOW_ALREADY_COMPLETED\n  UNCATEGORIZED\n}\n\nenum GetTaskFailedCause {\n  SERVICE_BUSY\n  TIMEOUT\n  SHARD_OWNERSHIP_LOST\n  UNCATEGORIZED\n}\n\nstruct CrossClusterTaskInfo {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional CrossClusterTaskType taskType\n  50: optional i16 taskState\n  60: optional i64 (js.type = \"Long\") taskID\n  70: optional i64 (js.type = \"Long\") visibilityTimestamp\n}\n\nstruct CrossClusterStartChildExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string requestID\n  30: optional i64 (js.type = \"Long\") initiatedEventID\n  40: optional StartChildWorkflowExecutionInitiatedEventAttributes initiatedEventAttributes\n  // targetRunID is for scheduling first decision task\n  // targetWorkflowID is available in initiatedEventAttributes\n  50: optional string targetRunID\n  60: optional map<string, string> partitionConfig\n}\n\nstruct CrossClusterStartChildExecutionResponseAttributes {\n  10: optional string runID\n}\n\nstruct CrossClusterCancelExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional string requestID\n  50: optional i64 (js.type = \"Long\") initiatedEventID\n  60: optional bool childWorkflowOnly\n}\n\nstruct CrossClusterCancelExecutionResponseAttributes {\n}\n\nstruct CrossClusterSignalExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional string requestID\n  50: optional i64 (js.type = \"Long\") initiatedEventID\n  60: optional bool childWorkflowOnly\n  70: optional string signalName\n  80: optional binary signalInput\n  90: optional binary control\n}\n\nstruct CrossClusterSignalExecutionResponseAttributes {\n}\n\nstruct CrossClusterRecordChildWorkflowExecutionCompleteRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional i64 (js.type = \"Long\") initiatedEventID\n  50: optional HistoryEvent completionEvent\n}\n\nstruct CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes {\n}\n\nstruct ApplyParentClosePolicyAttributes {\n  10: optional string childDomainID\n  20: optional string childWorkflowID\n  30: optional string childRunID\n  40: optional ParentClosePolicy parentClosePolicy\n}\n\nstruct ApplyParentClosePolicyStatus {\n  10: optional bool completed\n  20: optional CrossClusterTaskFailedCause failedCause\n}\n\nstruct ApplyParentClosePolicyRequest {\n  10: optional ApplyParentClosePolicyAttributes child\n  20: optional ApplyParentClosePolicyStatus status\n}\n\nstruct CrossClusterApplyParentClosePolicyRequestAttributes {\n  10: optional list<ApplyParentClosePolicyRequest> children\n}\n\nstruct ApplyParentClosePolicyResult {\n  10: optional ApplyParentClosePolicyAttributes child\n  20: optional CrossClusterTaskFailedCause failedCause\n}\n\nstruct CrossClusterApplyParentClosePolicyResponseAttributes {\n  10: optional list<ApplyParentClosePolicyResult> childrenStatus\n}\n\nstruct CrossClusterTaskRequest {\n  10: optional CrossClusterTaskInfo taskInfo\n  20: optional CrossClusterStartChildExecutionRequestAttributes startChildExecutionAttributes\n  30: optional CrossClusterCancelExecutionRequestAttributes cancelExecutionAttributes\n  40: optional CrossClusterSignalExecutionRequestAttributes signalExecutionAttributes\n  50: optional CrossClusterRecordChildWorkflowExecutionCompleteRequestAttributes recordChildWorkflowExecutionCompleteAttributes\n  60: optional CrossClusterApplyParentClosePolicyRequestAttributes applyParentClosePolicyAttributes\n}\n\nstruct CrossClusterTaskResponse {\n  10: optional i64 (js.type = \"Long\") taskID\n  20: optional CrossClusterTaskType taskType\n  30: optional i16 taskState\n  40: optional CrossClusterTaskFailedCause failedCause\n  50: optional CrossClusterStartChildExecutionResponseAttributes startChildExecutionAttributes\n  60: optional CrossClusterCancelExecutionResponseAttributes cancelExecutionAttributes\n  70: optional CrossClusterSignalExecutionResponseAttributes signalExecutionAttributes\n  80: optional CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes recordChildWorkflowExecutionCompleteAttributes\n  90: optional CrossClusterApplyParentClosePolicyResponseAttributes applyParentClosePolicyAttributes\n}\n\nstruct GetCrossClusterTasksRequest {\n  10: optional list<i32> shardIDs\n  20: optional string targetCluster\n}\n\nstruct GetCrossClusterTasksResponse {\n  10: optional map<i32, list<CrossClusterTaskRequest>> tasksByShard\n  20: optional map<i32, GetTaskFailedCause> failedCauseByShard\n}\n\nstruct RespondCrossClusterTasksCompletedRequest {\n  10: optional i32 shardID\n  20: optional string targetCluster\n  30: optional list<CrossClusterTaskResponse> taskResponses\n  40: optional bool fetchNewTasks\n}\n\nstruct RespondCrossClusterTasksCompletedResponse {\n  10: optional list<CrossClusterTaskRequest> tasks\n}\n\nenum IsolationGroupState {\n  INVALID,\n  HEALTHY,\n  DRAINED,\n}\n\nstruct IsolationGroupPartition {\n  10: optional string name\n  20: optional IsolationGroupState state\n}\n\nstruct IsolationGroupConfiguration {\n  10: optional list<IsolationGroupPartition> isolationGroups\n}\n\nstruct AsyncWorkflowConfiguration {\n  10: optional bool enabled\n  // PredefinedQueueName is the name of the predefined queue in cadence server config's asyncWorkflowQueues\n  20: optional string predefinedQueueName\n  // queueType is the type of the queue if predefined_queue_name is not used\n  30: optional string queueType\n  // queueConfig is the configuration for the queue if predefined_queue_name is not used\n  40: optional DataBlob queueConfig\n}\n\n/**\n* Any is a logical duplicate of google.protobuf.Any.\n*\n* The intent of the type is the same, but it is not intended to be directly\n* compatible with google.protobuf.Any or any Thrift equivalent - this blob is\n* RPC-type agnostic by design (as the underlying data may be transported over\n* proto or thrift), and the data-bytes may be in any encoding.\n*\n* This is intentionally different from DataBlob, which supports only a handful\n* of known encodings so it can be interpreted everywhere.  Any supports literally\n* any contents, and needs to be considered opaque until it is given to something\n* that is expecting it.\n*\n* See ValueType to interpret the contents.\n**/\nstruct Any {\n  // Type-string describing value's contents, and intentionally avoiding the\n  // name \"type\" as it is often a special term.\n  // This should usually be a hard-coded string of some kind.\n  10: optional string ValueType\n  // Arbitrarily-encoded bytes, to be deserialized by a runtime implementation.\n  // The contents are described by ValueType.\n  20: optional binary Value\n}\n\nstruct AutoConfigHint {\n  10: optional bool enableAutoConfig\n  20: optional i64 pollerWaitTimeInMs\n}\n\nstruct QueueState {\n  10: optional map<i64, VirtualQueueState> virtualQueueStates\n  20: optional TaskKey exclusiveMaxReadLevel\n}\n\nstruct VirtualQueueState {\n  10: optional list<VirtualSliceState> virtualSliceStates\n}\n\nstruct VirtualSliceState {\n  10: optional TaskRange taskRange\n  20: optional Predicate predicate\n}\n\nstruct TaskRange {\n  10: optional TaskKey inclusiveMin\n  20: optional TaskKey exclusiveMax\n}\n\nstruct TaskKey {\n  10: optional i64 scheduledTimeNano\n  20: optional i64 taskID\n}\n\n// ActiveClusterSelectionPolicy is for active-active domains, it serves as a means to select\n// the active cluster, by specifying the attribute by which to divide the workflows\n// in that domain.\nstruct ActiveClusterSelectionPolicy {\n  1: optional ClusterAttribute clusterAttribute\n\n  10: optional ActiveClusterSelectionStrategy strategy // todo (david.porter) remove these as they're not used anymore\n  20: optional string stickyRegion                     // todo (david.porter) remove these as they're not used anymore\n  30: optional string externalEntityType               // todo (david.porter) remove these as they're not used anymore\n  40: optional string externalEntityKey                // todo (david.porter) remove these as they're not used anymore\n}\n\n// ClusterAttribute is used for subdividing workflows in a domain into their active\n// and passive clusters. Examples of this might be 'region' and 'cluster1' as\n// respective region and scope fields.\n//\n// for example, a workflow may specify this in it's start request:\n//\n//   StartWorkflowRequest{\n//     ActiveClusterSelectionPolicy: {\n//       ClusterAttribute: {\n//            Scope: \"cityID\",\n//            Name: \"Lisbon\"\n//        }\n//     }\n//   }\n//\n// and this means that this workflow will be associate with the domain's cluster attribute 'Lisbon',\n// be active in the cluster that has Lisbon active and\n// failover when that cluster-attribute is set to failover.\nstruct ClusterAttribute {\n  1: optional string scope\n  2: optional string name\n}\n\n// FailoverType describes how a failover operation will be performed.\nenum FailoverType {\n  INVALID\n  FORCE\n  GRACEFUL\n}\n\n// PaginationOptions provides common options for paginated RPCs.\nstruct PaginationOptions {\n  // page_size configures the number of results to be returned as part of each page\n  10: optional i32 pageSize\n  // next_page_token should be provided from a previous response to fetch the next page.\n  // if empty, the first page will be returned.\n  20: optional binary nextPageToken\n}\n\n// todo (david.porter) Remove this, as it's no longer needed\n// with the active/active configuration we have\nenum ActiveClusterSelectionStrategy {\n  REGION_STICKY,\n  EXTERNAL_ENTITY,\n}\n\nenum PredicateType {\n  Universal,\n  Empty,\n  DomainID,\n}\n\nstruct UniversalPredicateAttributes {}\n\nstruct EmptyPredicateAttributes {}\n\nstruct DomainIDPredicateAttributes {\n  10: optional list<string> domainIDs\n  20: optional bool isExclusive\n}\n\nstruct Predicate {\n  10: optional PredicateType predicateType\n  20: optional UniversalPredicateAttributes universalPredicateAttributes\n  30: optional EmptyPredicateAttributes emptyPredicateAttributes\n  40: optional DomainIDPredicateAttributes domainIDPredicateAttributes\n}\n"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence\n\nexception BadRequestError {\n  1: required string message\n} (rpc.code = \"INVALID_ARGUMENT\")\n\nexception InternalServiceError {\n  1: required string message\n} (rpc.code = \"INTERNAL\")\n\nexception InternalDataInconsistencyError {\n  1: required string message\n} (rpc.code = \"DATA_LOSS\")\n\nexception DomainAlreadyExistsError {\n  1: required string message\n} (rpc.code = \"ALREADY_EXISTS\")\n\nexception WorkflowExecutionAlreadyStartedError {\n  10: optional string message\n  20: optional string startRequestId\n  30: optional string runId\n} (rpc.code = \"ALREADY_EXISTS\")\n\nexception WorkflowExecutionAlreadyCompletedError {\n  1: required string message\n} (rpc.code = \"NOT_FOUND\")\n\nexception EntityNotExistsError {\n  1: required string message\n  2: optional string currentCluster\n  3: optional string activeCluster\n  4: required list<string> activeClusters // todo(david.porter) remove as its disused\n} (rpc.code = \"NOT_FOUND\")\n\nexception ServiceBusyError {\n  1: required string message\n  2: optional string reason\n} (rpc.code = \"RESOURCE_EXHAUSTED\")\n\nexception CancellationAlreadyRequestedError {\n  1: required string message\n} (rpc.code = \"ALREADY_EXISTS\")\n\nexception QueryFailedError {\n  1: required string message\n} (rpc.code = \"INVALID_ARGUMENT\")\n\nexception DomainNotActiveError {\n  1: required string message\n  2: required string domainName\n  3: required string currentCluster\n  4: required string activeCluster\n  5: required list<string> activeClusters // todo (david.porter) remove this field as it's disused\n} (rpc.code = \"FAILED_PRECONDITION\")\n\nexception LimitExceededError {\n  1: required string message\n} (rpc.code = \"RESOURCE_EXHAUSTED\")\n\nexception AccessDeniedError {\n  1: required string message\n} (rpc.code = \"PERMISSION_DENIED\")\n\nexception RetryTaskV2Error {\n  1: required string message\n  2: optional string domainId\n  3: optional string workflowId\n  4: optional string runId\n  5: optional i64 (js.type = \"Long\") startEventId\n  6: optional i64 (js.type = \"Long\") startEventVersion\n  7: optional i64 (js.type = \"Long\") endEventId\n  8: optional i64 (js.type = \"Long\") endEventVersion\n} (rpc.code = \"ABORTED\")\n\nexception ClientVersionNotSupportedError {\n  1: required string featureVersion\n  2: required string clientImpl\n  3: required string supportedVersions\n} (rpc.code = \"FAILED_PRECONDITION\")\n\nexception FeatureNotEnabledError {\n  1: required string featureFlag\n} (rpc.code = \"FAILED_PRECONDITION\")\n\nexception CurrentBranchChangedError {\n  10: required string message\n  20: required binary currentBranchToken\n} (rpc.code = \"ABORTED\")\n\nexception RemoteSyncMatchedError {\n  10: required string message\n} (rpc.code = \"UNAVAILABLE\")\n\nexception StickyWorkerUnavailableError {\n  1: required string message\n} (rpc.code = \"UNAVAILABLE\")\n\nexception TaskListNotOwnedByHostError {\n    1: required string ownedByIdentity\n    2: required string myIdentity\n    3: required string tasklistName\n} (rpc.code = \"ABORTED\")\n\nenum WorkflowIdReusePolicy {\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running, and the last execution close state is in\n   * [terminated, cancelled, timeouted, failed].\n   */\n  AllowDuplicateFailedOnly,\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running.\n   */\n  AllowDuplicate,\n  /*\n   * do not allow start a workflow execution using the same workflow ID at all\n   */\n  RejectDuplicate,\n  /*\n   * if a workflow is running using the same workflow ID, terminate it and start a new one\n   */\n  TerminateIfRunning,\n}\n\nenum DomainStatus {\n  REGISTERED,\n  DEPRECATED,\n  DELETED,\n}\n\nenum TimeoutType {\n  START_TO_CLOSE,\n  SCHEDULE_TO_START,\n  SCHEDULE_TO_CLOSE,\n  HEARTBEAT,\n}\n\nenum ParentClosePolicy {\n  ABANDON,\n  REQUEST_CANCEL,\n  TERMINATE,\n}\n\n\n// whenever this list of decision is changed\n// do change the mutableStateBuilder.go\n// function shouldBufferEvent\n// to make sure wo do the correct event ordering\nenum DecisionType {\n  ScheduleActivityTask,\n  RequestCancelActivityTask,\n  StartTimer,\n  CompleteWorkflowExecution,\n  FailWorkflowExecution,\n  CancelTimer,\n  CancelWorkflowExecution,\n  RequestCancelExternalWorkflowExecution,\n  RecordMarker,\n  ContinueAsNewWorkflowExecution,\n  StartChildWorkflowExecution,\n  SignalExternalWorkflowExecution,\n  UpsertWorkflowSearchAttributes,\n}\n\nenum EventType {\n  WorkflowExecutionStarted,\n  WorkflowExecutionCompleted,\n  WorkflowExecutionFailed,\n  WorkflowExecutionTimedOut,\n  DecisionTaskScheduled,\n  DecisionTaskStarted,\n  DecisionTaskCompleted,\n  DecisionTaskTimedOut\n  DecisionTaskFailed,\n  ActivityTaskScheduled,\n  ActivityTaskStarted,\n  ActivityTaskCompleted,\n  ActivityTaskFailed,\n  ActivityTaskTimedOut,\n  ActivityTaskCancelRequested,\n  RequestCancelActivityTaskFailed,\n  ActivityTaskCanceled,\n  TimerStarted,\n  TimerFired,\n  CancelTimerFailed,\n  TimerCanceled,\n  WorkflowExecutionCancelRequested,\n  WorkflowExecutionCanceled,\n  RequestCancelExternalWorkflowExecutionInitiated,\n  RequestCancelExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionCancelRequested,\n  MarkerRecorded,\n  WorkflowExecutionSignaled,\n  WorkflowExecutionTerminated,\n  WorkflowExecutionContinuedAsNew,\n  StartChildWorkflowExecutionInitiated,\n  StartChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionStarted,\n  ChildWorkflowExecutionCompleted,\n  ChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionCanceled,\n  ChildWorkflowExecutionTimedOut,\n  ChildWorkflowExecutionTerminated,\n  SignalExternalWorkflowExecutionInitiated,\n  SignalExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionSignaled,\n  UpsertWorkflowSearchAttributes,\n}\n\nenum DecisionTaskFailedCause {\n  UNHANDLED_DECISION,\n  BAD_SCHEDULE_ACTIVITY_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES,\n  BAD_START_TIMER_ATTRIBUTES,\n  BAD_CANCEL_TIMER_ATTRIBUTES,\n  BAD_RECORD_MARKER_ATTRIBUTES,\n  BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CONTINUE_AS_NEW_ATTRIBUTES,\n  START_TIMER_DUPLICATE_ID,\n  RESET_STICKY_TASKLIST,\n  WORKFLOW_WORKER_UNHANDLED_FAILURE,\n  BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_START_CHILD_EXECUTION_ATTRIBUTES,\n  FORCE_CLOSE_DECISION,\n  FAILOVER_CLOSE_DECISION,\n  BAD_SIGNAL_INPUT_SIZE,\n  RESET_WORKFLOW,\n  BAD_BINARY,\n  SCHEDULE_ACTIVITY_DUPLICATE_ID,\n  BAD_SEARCH_ATTRIBUTES,\n}\n\nenum DecisionTaskTimedOutCause {\n  TIMEOUT,\n  RESET,\n}\n\nenum CancelExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n  WORKFLOW_ALREADY_COMPLETED,\n}\n\nenum SignalExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n  WORKFLOW_ALREADY_COMPLETED,\n}\n\nenum ChildWorkflowExecutionFailedCause {\n  WORKFLOW_ALREADY_RUNNING,\n}\n\n// TODO: when migrating to gRPC, add a running / none status,\n//  currently, customer is using null / nil as an indication\n//  that workflow is still running\nenum WorkflowExecutionCloseStatus {\n  COMPLETED,\n  FAILED,\n  CANCELED,\n  TERMINATED,\n  CONTINUED_AS_NEW,\n  TIMED_OUT,\n}\n\nenum WorkflowExecutionStatus {\n  PENDING,\n  STARTED,\n  COMPLETED,\n  FAILED,\n  CANCELED,\n  TERMINATED,\n  CONTINUED_AS_NEW,\n  TIMED_OUT,\n}\n\nenum QueryTaskCompletedType {\n  COMPLETED,\n  FAILED,\n}\n\nenum QueryResultType {\n  ANSWERED,\n  FAILED,\n}\n\nenum PendingActivityState {\n  SCHEDULED,\n  STARTED,\n  CANCEL_REQUESTED,\n}\n\nenum PendingDecisionState {\n  SCHEDULED,\n  STARTED,\n}\n\nenum HistoryEventFilterType {\n  ALL_EVENT,\n  CLOSE_EVENT,\n}\n\nenum TaskListKind {\n  NORMAL,\n  STICKY,\n  EPHEMERAL,\n}\n\nenum ArchivalStatus {\n  DISABLED,\n  ENABLED,\n}\n\nenum CronOverlapPolicy {\n  SKIPPED,\n  BUFFERONE,\n}\n\nenum IndexedValueType {\n  STRING,\n  KEYWORD,\n  INT,\n  DOUBLE,\n  BOOL,\n  DATETIME,\n}\n\nstruct Header {\n    10: optional map<string, binary> fields\n}\n\nstruct WorkflowType {\n  10: optional string name\n}\n\nstruct ActivityType {\n  10: optional string name\n}\n\nstruct TaskList {\n  10: optional string name\n  20: optional TaskListKind kind\n}\n\nenum EncodingType {\n  ThriftRW,\n  JSON,\n}\n\nenum QueryRejectCondition {\n  // NOT_OPEN indicates that query should be rejected if workflow is not open\n  NOT_OPEN\n  // NOT_COMPLETED_CLEANLY indicates that query should be rejected if workflow did not complete cleanly\n  NOT_COMPLETED_CLEANLY\n}\n\nenum QueryConsistencyLevel {\n  // EVENTUAL indicates that query should be eventually consistent\n  EVENTUAL\n  // STRONG indicates that any events that came before query should be reflected in workflow state before running query\n  STRONG\n}\n\nstruct DataBlob {\n  10: optional EncodingType EncodingType\n  20: optional binary Data\n}\n\nstruct TaskListMetadata {\n  10: optional double maxTasksPerSecond\n}\n\nstruct WorkflowExecution {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct Memo {\n  10: optional map<string,binary> fields\n}\n\nstruct SearchAttributes {\n  10: optional map<string,binary> indexedFields\n}\n\nstruct WorkerVersionInfo {\n  10: optional string impl\n  20: optional string featureVersion\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional WorkflowExecution execution\n  20: optional WorkflowType type\n  30: optional i64 (js.type = \"Long\") startTime\n  40: optional i64 (js.type = \"Long\") closeTime\n  50: optional WorkflowExecutionCloseStatus closeStatus\n  60: optional i64 (js.type = \"Long\") historyLength\n  70: optional string parentDomainId\n  71: optional string parentDomainName\n  72: optional i64 parentInitatedId\n  80: optional WorkflowExecution parentExecution\n  90: optional i64 (js.type = \"Long\") executionTime\n  100: optional Memo memo\n  101: optional SearchAttributes searchAttributes\n  110: optional ResetPoints autoResetPoints\n  120: optional string taskList\n  121: optional TaskList taskListInfo\n  130: optional bool isCron\n  140: optional i64 (js.type = \"Long\") updateTime\n  150: optional map<string, string> partitionConfig\n  160: optional CronOverlapPolicy cronOverlapPolicy\n  170: optional ActiveClusterSelectionPolicy activeClusterSelectionPolicy\n  180: optional string cronSchedule\n  190: optional WorkflowExecutionStatus executionStatus\n  200: optional i64 (js.type = \"Long\") scheduledExecutionTime\n}\n\nstruct WorkflowExecutionConfiguration {\n  10: optional TaskList taskList\n  20: optional i32 executionStartToCloseTimeoutSeconds\n  30: optional i32 taskStartToCloseTimeoutSeconds\n//  40: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n}\n\nstruct TransientDecisionInfo {\n  10: optional HistoryEvent scheduledEvent\n  20: optional HistoryEvent startedEvent\n}\n\nstruct ScheduleActivityTaskDecisionAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional Header header\n  90: optional bool requestLocalDispatch\n  100: optional i32 priority\n  110: optional string fairnessKey\n}\n\nstruct ActivityLocalDispatchInfo{\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") scheduledTimestamp\n  30: optional i64 (js.type = \"Long\") startedTimestamp\n  40: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  50: optional binary taskToken\n}\n\nstruct RequestCancelActivityTaskDecisionAttributes {\n  10: optional string activityId\n}\n\nstruct StartTimerDecisionAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n}\n\nstruct CompleteWorkflowExecutionDecisionAttributes {\n  10: optional binary result\n}\n\nstruct FailWorkflowExecutionDecisionAttributes {\n  10: optional string reason\n  20: optional binary details\n}\n\nstruct CancelTimerDecisionAttributes {\n  10: optional string timerId\n}\n\nstruct CancelWorkflowExecutionDecisionAttributes {\n  10: optional binary details\n}\n\nstruct RequestCancelExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional string runId\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional string signalName\n  40: optional binary input\n  50: optional binary control\n  60: optional bool childWorkflowOnly\n}\n\nstruct UpsertWorkflowSearchAttributesDecisionAttributes {\n  10: optional SearchAttributes searchAttributes\n}\n\nstruct RecordMarkerDecisionAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional Header header\n}\n\nstruct ContinueAsNewWorkflowExecutionDecisionAttributes {\n  10: optional WorkflowType workflowType\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n  60: optional i32 backoffStartIntervalInSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional ContinueAsNewInitiator initiator\n  90: optional string failureReason\n  100: optional binary failureDetails\n  110: optional binary lastCompletionResult\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n  160: optional i32 jitterStartSeconds\n  170: optional CronOverlapPolicy cronOverlapPolicy\n  180: optional ActiveClusterSelectionPolicy activeClusterSelectionPolicy\n}\n\nstruct StartChildWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n//  80: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81: optional ParentClosePolicy parentClosePolicy\n  90: optional binary control\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional RetryPolicy retryPolicy\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n  160: optional CronOverlapPolicy cronOverlapPolicy\n  170: optional ActiveClusterSelectionPolicy activeClusterSelectionPolicy\n}\n\nstruct Decision {\n  10:  optional DecisionType decisionType\n  20:  optional ScheduleActivityTaskDecisionAttributes scheduleActivityTaskDecisionAttributes\n  25:  optional StartTimerDecisionAttributes startTimerDecisionAttributes\n  30:  optional CompleteWorkflowExecutionDecisionAttributes completeWorkflowExecutionDecisionAttributes\n  35:  optional FailWorkflowExecutionDecisionAttributes failWorkflowExecutionDecisionAttributes\n  40:  optional RequestCancelActivityTaskDecisionAttributes requestCancelActivityTaskDecisionAttributes\n  50:  optional CancelTimerDecisionAttributes cancelTimerDecisionAttributes\n  60:  optional CancelWorkflowExecutionDecisionAttributes cancelWorkflowExecutionDecisionAttributes\n  70:  optional RequestCancelExternalWorkflowExecutionDecisionAttributes requestCancelExternalWorkflowExecutionDecisionAttributes\n  80:  optional RecordMarkerDecisionAttributes recordMarkerDecisionAttributes\n  90:  optional ContinueAsNewWorkflowExecutionDecisionAttributes continueAsNewWorkflowExecutionDecisionAttributes\n  100: optional StartChildWorkflowExecutionDecisionAttributes startChildWorkflowExecutionDecisionAttributes\n  110: optional SignalExternalWorkflowExecutionDecisionAttributes signalExternalWorkflowExecutionDecisionAttributes\n  120: optional UpsertWorkflowSearchAttributesDecisionAttributes upsertWorkflowSearchAttributesDecisionAttributes\n}\n\nstruct WorkflowExecutionStartedEventAttributes {\n  10: optional WorkflowType workflowType\n  12: optional string parentWorkflowDomain\n  14: optional WorkflowExecution parentWorkflowExecution\n  16: optional i64 (js.type = \"Long\") parentInitiatedEventId\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n//  52: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  54: optional string continuedExecutionRunId\n  55: optional ContinueAsNewInitiator initiator\n  56: optional string continuedFailureReason\n  57: optional binary continuedFailureDetails\n  58: optional binary lastCompletionResult\n  59: optional string originalExecutionRunId // This is the runID when the WorkflowExecutionStarted event is written\n  60: optional string identity\n  61: optional string firstExecutionRunId // This is the very first runID along the chain of ContinueAsNew and Reset.\n  62: optional i64 (js.type = \"Long\") firstScheduledTimeNano\n  70: optional RetryPolicy retryPolicy\n  80: optional i32 attempt\n  90: optional i64 (js.type = \"Long\") expirationTimestamp\n  100: optional string cronSchedule\n  110: optional i32 firstDecisionTaskBackoffSeconds\n  120: optional Memo memo\n  121: optional SearchAttributes searchAttributes\n  130: optional ResetPoints prevAutoResetPoints\n  140: optional Header header\n  150: optional map<string, string> partitionConfig\n  160: optional string requestId\n  170: optional CronOverlapPolicy cronOverlapPolicy\n  180: optional ActiveClusterSelectionPolicy activeClusterSelectionPolicy\n}\n\nstruct ResetPoints{\n  10: optional list<ResetPointInfo> points\n}\n\n struct ResetPointInfo{\n  10: optional string binaryChecksum\n  20: optional string runId\n  30: optional i64 firstDecisionCompletedId\n  40: optional i64 (js.type = \"Long\") createdTimeNano\n  50: optional i64 (js.type = \"Long\") expiringTimeNano //the time that the run is deleted due to retention\n  60: optional bool resettable                         // false if the resset point has pending childWFs/reqCancels/signalExternals.\n}\n\nstruct WorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n}\n\nenum ContinueAsNewInitiator {\n  Decider,\n  RetryPolicy,\n  CronSchedule,\n}\n\nstruct WorkflowExecutionContinuedAsNewEventAttributes {\n  10: optional string newExecutionRunId\n  20: optional WorkflowType workflowType\n  30: optional TaskList taskList\n  40: optional binary input\n  50: optional i32 executionStartToCloseTimeoutSeconds\n  60: optional i32 taskStartToCloseTimeoutSeconds\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  80: optional i32 backoffStartIntervalInSeconds\n  90: optional ContinueAsNewInitiator initiator\n  100: optional string failureReason\n  110: optional binary failureDetails\n  120: optional binary lastCompletionResult\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n  160: optional CronOverlapPolicy cronOverlapPolicy\n  170: optional ActiveClusterSelectionPolicy activeClusterSelectionPolicy\n}\n\nstruct DecisionTaskScheduledEventAttributes {\n  10: optional TaskList taskList\n  20: optional i32 startToCloseTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") attempt\n}\n\nstruct DecisionTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n}\n\nstruct DecisionTaskCompletedEventAttributes {\n  10: optional binary executionContext\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct DecisionTaskTimedOutEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // for reset workflow\n  40: optional string baseRunId\n  50: optional string newRunId\n  60: optional i64 (js.type = \"Long\") forkEventVersion\n  70: optional string reason\n  80: optional DecisionTaskTimedOutCause cause\n  90: optional string requestId\n}\n\nstruct DecisionTaskFailedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional DecisionTaskFailedCause cause\n  35: optional binary details\n  40: optional string identity\n  50: optional string reason\n  // for reset workflow\n  60: optional string baseRunId\n  70: optional string newRunId\n  80: optional i64 (js.type = \"Long\") forkEventVersion\n  90: optional string binaryChecksum\n  100: optional string requestId\n}\n\nstruct ActivityTaskScheduledEventAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  90: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional RetryPolicy retryPolicy\n  120: optional Header header\n  130: optional i32 priority\n  140: optional string fairnessKey\n}\n\nstruct ActivityTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n  40: optional i32 attempt\n  50: optional string lastFailureReason\n  60: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n}\n\nstruct ActivityTaskFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct ActivityTaskTimedOutEventAttributes {\n  05: optional binary details\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // For retry activity, it may have a failure before timeout. It's important to keep those information for debug.\n  // Client can also provide the info for making next decision\n  40: optional string lastFailureReason\n  50: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCancelRequestedEventAttributes {\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct RequestCancelActivityTaskFailedEventAttributes{\n  10: optional string activityId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ActivityTaskCanceledEventAttributes {\n  10: optional binary details\n  20: optional i64 (js.type = \"Long\") latestCancelRequestedEventId\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct TimerStartedEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct TimerFiredEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct TimerCanceledEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct CancelTimerFailedEventAttributes {\n  10: optional string timerId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct WorkflowExecutionCancelRequestedEventAttributes {\n  10: optional string cause\n  20: optional i64 (js.type = \"Long\") externalInitiatedEventId\n  30: optional WorkflowExecution externalWorkflowExecution\n  40: optional string identity\n  50: optional string requestId\n}\n\nstruct WorkflowExecutionCanceledEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional binary details\n}\n\nstruct MarkerRecordedEventAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional Header header\n}\n\nstruct WorkflowExecutionSignaledEventAttributes {\n  10: optional string signalName\n  20: optional binary input\n  30: optional string identity\n  40: optional string requestId\n}\n\nstruct WorkflowExecutionTerminatedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct RequestCancelExternalWorkflowExecutionFailedEventAttributes {\n  10: optional CancelExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionCancelRequestedEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n}\n\nstruct SignalExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional string signalName\n  50: optional binary input\n  60: optional binary control\n  70: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionFailedEventAttributes {\n  10: optional SignalExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionSignaledEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n}\n\nstruct UpsertWorkflowSearchAttributesEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional SearchAttributes searchAttributes\n}\n\nstruct StartChildWorkflowExecutionInitiatedEventAttributes {\n  10:  optional string domain\n  20:  optional string workflowId\n  30:  optional WorkflowType workflowType\n  40:  optional TaskList taskList\n  50:  optional binary input\n  60:  optional i32 executionStartToCloseTimeoutSeconds\n  70:  optional i32 taskStartToCloseTimeoutSeconds\n//  80:  optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81:  optional ParentClosePolicy parentClosePolicy\n  90:  optional binary control\n  100: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Header header\n  150: optional Memo memo\n  160: optional SearchAttributes searchAttributes\n  170: optional i32 delayStartSeconds\n  180: optional i32 jitterStartSeconds\n  190: optional i64 (js.type = \"Long\") firstRunAtTimestamp\n  200: optional CronOverlapPolicy cronOverlapPolicy\n  210: optional ActiveClusterSelectionPolicy activeClusterSelectionPolicy\n}\n\nstruct StartChildWorkflowExecutionFailedEventAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional ChildWorkflowExecutionFailedCause cause\n  50: optional binary control\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ChildWorkflowExecutionStartedEventAttributes {\n  10: optional string domain\n  20: optional i64 (js.type = \"Long\") initiatedEventId\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional Header header\n}\n\nstruct ChildWorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional WorkflowType workflowType\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionCanceledEventAttributes {\n  10: optional binary details\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTerminatedEventAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") initiatedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct HistoryEvent {\n  10:  optional i64 (js.type = \"Long\") eventId\n  20:  optional i64 (js.type = \"Long\") timestamp\n  30:  optional EventType eventType\n  35:  optional i64 (js.type = \"Long\") version\n  36:  optional i64 (js.type = \"Long\") taskId\n  40:  optional WorkflowExecutionStartedEventAttributes workflowExecutionStartedEventAttributes\n  50:  optional WorkflowExecutionCompletedEventAttributes workflowExecutionCompletedEventAttributes\n  60:  optional WorkflowExecutionFailedEventAttributes workflowExecutionFailedEventAttributes\n  70:  optional WorkflowExecutionTimedOutEventAttributes workflowExecutionTimedOutEventAttributes\n  80:  optional DecisionTaskScheduledEventAttributes decisionTaskScheduledEventAttributes\n  90:  optional DecisionTaskStartedEventAttributes decisionTaskStartedEventAttributes\n  100: optional DecisionTaskCompletedEventAttributes decisionTaskCompletedEventAttributes\n  110: optional DecisionTaskTimedOutEventAttributes decisionTaskTimedOutEventAttributes\n  120: optional DecisionTaskFailedEventAttributes decisionTaskFailedEventAttributes\n  130: optional ActivityTaskScheduledEventAttributes activityTaskScheduledEventAttributes\n  140: optional ActivityTaskStartedEventAttributes activityTaskStartedEventAttributes\n  150: optional ActivityTaskCompletedEventAttributes activityTaskCompletedEventAttributes\n  160: optional ActivityTaskFailedEventAttributes activityTaskFailedEventAttributes\n  170: optional ActivityTaskTimedOutEventAttributes activityTaskTimedOutEventAttributes\n  180: optional TimerStartedEventAttributes timerStartedEventAttributes\n  190: optional TimerFiredEventAttributes timerFiredEventAttributes\n  200: optional ActivityTaskCancelRequestedEventAttributes activityTaskCancelRequestedEventAttributes\n  210: optional RequestCancelActivityTaskFailedEventAttributes requestCancelActivityTaskFailedEventAttributes\n  220: optional ActivityTaskCanceledEventAttributes activityTaskCanceledEventAttributes\n  230: optional TimerCanceledEventAttributes timerCanceledEventAttributes\n  240: optional CancelTimerFailedEventAttributes cancelTimerFailedEventAttributes\n  250: optional MarkerRecordedEventAttributes markerRecordedEventAttributes\n  260: optional WorkflowExecutionSignaledEventAttributes workflowExecutionSignaledEventAttributes\n  270: optional WorkflowExecutionTerminatedEventAttributes workflowExecutionTerminatedEventAttributes\n  280: optional WorkflowExecutionCancelRequestedEventAttributes workflowExecutionCancelRequestedEventAttributes\n  290: optional WorkflowExecutionCanceledEventAttributes workflowExecutionCanceledEventAttributes\n  300: optional RequestCancelExternalWorkflowExecutionInitiatedEventAttributes requestCancelExternalWorkflowExecutionInitiatedEventAttributes\n  310: optional RequestCancelExternalWorkflowExecutionFailedEventAttributes requestCancelExternalWorkflowExecutionFailedEventAttributes\n  320: optional ExternalWorkflowExecutionCancelRequestedEventAttributes externalWorkflowExecutionCancelRequestedEventAttributes\n  330: optional WorkflowExecutionContinuedAsNewEventAttributes workflowExecutionContinuedAsNewEventAttributes\n  340: optional StartChildWorkflowExecutionInitiatedEventAttributes startChildWorkflowExecutionInitiatedEventAttributes\n  350: optional StartChildWorkflowExecutionFailedEventAttributes startChildWorkflowExecutionFailedEventAttributes\n  360: optional ChildWorkflowExecutionStartedEventAttributes childWorkflowExecutionStartedEventAttributes\n  370: optional ChildWorkflowExecutionCompletedEventAttributes childWorkflowExecutionCompletedEventAttributes\n  380: optional ChildWorkflowExecutionFailedEventAttributes childWorkflowExecutionFailedEventAttributes\n  390: optional ChildWorkflowExecutionCanceledEventAttributes childWorkflowExecutionCanceledEventAttributes\n  400: optional ChildWorkflowExecutionTimedOutEventAttributes childWorkflowExecutionTimedOutEventAttributes\n  410: optional ChildWorkflowExecutionTerminatedEventAttributes childWorkflowExecutionTerminatedEventAttributes\n  420: optional SignalExternalWorkflowExecutionInitiatedEventAttributes signalExternalWorkflowExecutionInitiatedEventAttributes\n  430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes\n  440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes\n  450: optional UpsertWorkflowSearchAttributesEventAttributes upsertWorkflowSearchAttributesEventAttributes\n}\n\nstruct History {\n  10: optional list<HistoryEvent> events\n}\n\nstruct WorkflowExecutionFilter {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct WorkflowTypeFilter {\n  10: optional string name\n}\n\nstruct StartTimeFilter {\n  10: optional i64 (js.type = \"Long\") earliestTime\n  20: optional i64 (js.type = \"Long\") latestTime\n}\n\nstruct DomainInfo {\n  10: optional string name\n  20: optional DomainStatus status\n  30: optional string description\n  40: optional string ownerEmail\n  // A key-value map for any customized purpose\n  50: optional map<string,string> data\n  60: optional string uuid\n}\n\nstruct DomainConfiguration {\n  10: optional i32 workflowExecutionRetentionPeriodInDays\n  20: optional bool emitMetric\n  60: optional IsolationGroupConfiguration isolationgroups\n  70: optional BadBinaries badBinaries\n  80: optional ArchivalStatus historyArchivalStatus\n  90: optional string historyArchivalURI\n  100: optional ArchivalStatus visibilityArchivalStatus\n  110: optional string visibilityArchivalURI\n  120: optional AsyncWorkflowConfiguration AsyncWorkflowConfiguration\n}\n\nstruct FailoverInfo {\n    10: optional i64 (js.type = \"Long\") failoverVersion\n    20: optional i64 (js.type = \"Long\") failoverStartTimestamp\n    30: optional i64 (js.type = \"Long\") failoverExpireTimestamp\n    40: optional i32 completedShardCount\n    50: optional list<i32> pendingShards\n}\n\nstruct BadBinaries{\n  10: optional map<string, BadBinaryInfo> binaries\n}\n\nstruct BadBinaryInfo{\n  10: optional string reason\n  20: optional string operator\n  30: optional i64 (js.type = \"Long\") createdTimeNano\n}\n\nstruct UpdateDomainInfo {\n  10: optional string description\n  20: optional string ownerEmail\n  // A key-value map for any customized purpose\n  30: optional map<string,string> data\n}\n\nstruct ClusterReplicationConfiguration {\n 10: optional string clusterName\n}\n\nstruct DomainReplicationConfiguration {\n // activeClusterName is the name of the active cluster for active-passive domain\n 10: optional string activeClusterName\n\n //  clusters is list of all active and passive clusters of domain\n 20: optional list<ClusterReplicationConfiguration> clusters\n\n // activeClusters contains active cluster(s) information for active-active domain\n 30: optional ActiveClusters activeClusters\n}\n\n// ClusterAttributeScope is a mapping of the cluster atribute to the scope's\n// current stae and failover version, indicating how recently the change was made\nstruct ClusterAttributeScope {\n  10: optional map<string, ActiveClusterInfo> clusterAttributes;\n}\n\n// activeClustersByClusterAttribute is a map of whatever subdivision of the domain chosen\n// to active cluster info for active-active domains. The key refers to the type of\n// cluster attribute and the value refers to its cluster mappings.\n//\n// For example, a request to update the domain for two locations\n//\n// UpdateDomainRequest{\n//    ReplicationConfiguration: {\n//       ActiveClusters: {\n//           ActiveClustersByClusterAttribute: {\n//             \"location\": ClusterAttributeScope{\n//                   \"Tokyo\": {ActiveClusterInfo: \"cluster0, FailoverVersion: 123},\n//                   \"Morocco\": {ActiveClusterInfo: \"cluster1\", FailoverVersion: 100},\n//             }\n//          }\n//       }\n//    }\n//  }\nstruct ActiveClusters {\n  10: optional map<string, ActiveClusterInfo> activeClustersByRegion // todo (david.porter) remove this as it's no longer used\n  11: optional map<string, ClusterAttributeScope> activeClustersByClusterAttribute\n}\n\n// ActiveClusterInfo contains the configuration of active-active domain's active\n// cluster & failover version for a specific region\nstruct ActiveClusterInfo {\n  10: optional string activeClusterName\n  20: optional i64 (js.type = \"Long\") failoverVersion\n}\n\nstruct RegisterDomainRequest {\n  10: optional string name\n  20: optional string description\n  30: optional string ownerEmail\n  40: optional i32 workflowExecutionRetentionPeriodInDays\n  50: optional bool emitMetric = true\n  60: optional list<ClusterReplicationConfiguration> clusters\n  70: optional string activeClusterName\n  // todo (david.porter) remove this field as it's not going to be used\n  75: optional map<string, string> activeClustersByRegion\n  // activeClusters is a map of cluster-attribute name to active cluster name for active-active domain\n  76: optional ActiveClusters activeClusters\n  // A key-value map for any customized purpose\n  80: optional map<string,string> data\n  90: optional string securityToken\n  120: optional bool isGlobalDomain\n  130: optional ArchivalStatus historyArchivalStatus\n  140: optional string historyArchivalURI\n  150: optional ArchivalStatus visibilityArchivalStatus\n  160: optional string visibilityArchivalURI\n}\n\nstruct ListDomainsRequest {\n  10: optional i32 pageSize\n  20: optional binary nextPageToken\n}\n\nstruct ListDomainsResponse {\n  10: optional list<DescribeDomainResponse> domains\n  20: optional binary nextPageToken\n}\n\nstruct DescribeDomainRequest {\n  10: optional string name\n  20: optional string uuid\n}\n\nstruct DescribeDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n  60: optional FailoverInfo failoverInfo\n}\n\nstruct UpdateDomainRequest {\n 10: optional string name\n 20: optional UpdateDomainInfo updatedInfo\n 30: optional DomainConfiguration configuration\n 40: optional DomainReplicationConfiguration replicationConfiguration\n 50: optional string securityToken\n 60: optional string deleteBadBinary\n 70: optional i32 failoverTimeoutInSeconds\n}\n\nstruct UpdateDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n}\n\nstruct FailoverDomainRequest {\n 10: optional string domainName\n 20: optional string domainActiveClusterName\n // only applicable to active-active domains where\n // specific cluster-attributes are being failed over\n 30: optional ActiveClusters activeClusters\n // user-requested addition \"reason\" variable created to increase transparency around failovers\n 40: optional string reason\n}\n\nstruct FailoverDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n}\n\nstruct DeprecateDomainRequest {\n 10: optional string name\n 20: optional string securityToken\n}\n\nstruct DeleteDomainRequest {\n 10: optional string name\n 20: optional string securityToken\n}\n\nstruct ListFailoverHistoryRequest {\n  // ListFailoverHistoryRequestFilters specifies the filters to apply to the request.\n  // If not provided all failover events will be returned.\n  10: optional ListFailoverHistoryRequestFilters filters\n  // PaginationOptions will be used to paginate the results.\n  // If not provided the first 5 events will be returned.\n  20: optional PaginationOptions pagination\n}\n\n// ListFailoverHistoryRequestFilters is used to filter the failover history.\n// It will be extended with additional filters (e.g ClusterAttributes) as the active-active feature is developed.\nstruct ListFailoverHistoryRequestFilters {\n  // domain_id is the id of the domain to list failover history for.\n  10: optional string domainID\n}\n\nstruct ListFailoverHistoryResponse {\n  10: optional list<FailoverEvent> failoverEvents\n  // next_page_token can be passed in a subsequent request to fetch the next set of events.\n  20: optional binary nextPageToken\n}\n\nstruct FailoverEvent {\n  // id of the failover event\n  // Can be passed with the created time to fetch a specific event.\n  10: optional string id\n  // created_time is the time the failover event was created.\n  // Can be passed with the ID to fetch a specific event.\n  20: optional i64 (js.type = \"Long\") createdTime\n  30: optional FailoverType failoverType\n  40: optional list<ClusterFailover> clusterFailovers\n}\n\nstruct ClusterFailover {\n  10: optional ActiveClusterInfo fromCluster\n  20: optional ActiveClusterInfo toCluster\n  // cluster_attribute is the scope and name for the attribute that was failed over.\n  // If the cluster_attribute is not defined this failover can be assumed to be the default ActiveCluster.\n  30: optional ClusterAttribute clusterAttribute\n}\n\nstruct ListDomainChangesRequest {\n  10: optional string domain\n  // PaginationOptions will be used to paginate the results.\n  20: optional PaginationOptions pagination\n}\n\nstruct ListDomainChangesResponse {\n  10: optional list<DomainChange> domainChanges\n  // next_page_token can be passed in a subsequent request to fetch the next set of changes.\n  20: optional binary nextPageToken\n}\n\n// DomainChange is a single entry of the domain audit log.\nstruct DomainChange {\n  // id of the audit log entry\n  10: optional string id\n  20: optional i64 (js.type = \"Long\") createdTime\n  30: optional string operationType\n  40: optional string identity\n  50: optional string identityType\n  60: optional string comment\n  // field_changes lists the fields the operation changed.\n  70: optional list<DomainFieldChange> fieldChanges\n}\n\n// DomainFieldChange is the value of a domain configuration field before and after a change.\n// An empty value means the field was not set.\nstruct DomainFieldChange {\n  10: optional string field\n  20: optional string before\n  30: optional string after\n}\n\nstruct StartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n//  110: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Memo memo\n  141: optional SearchAttributes searchAttributes\n  150: optional Header header\n  160: optional i32 delayStartSeconds\n  170: optional i32 jitterStartSeconds\n  180: optional i64 (js.type = \"Long\") firstRunAtTimestamp\n  190: optional CronOverlapPolicy cronOverlapPolicy\n  200: optional ActiveClusterSelectionPolicy activeClusterSelectionPolicy\n}\n\nstruct StartWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct StartWorkflowExecutionAsyncRequest {\n  10: optional StartWorkflowExecutionRequest request\n}\n\nstruct StartWorkflowExecutionAsyncResponse {\n}\n\nstruct RestartWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct DiagnoseWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string identity\n}\n\nstruct DiagnoseWorkflowExecutionResponse {\n  10: optional string domain\n  20: optional WorkflowExecution diagnosticWorkflowExecution\n}\n\nstruct PollForDecisionTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional string binaryChecksum\n}\n\nstruct PollForDecisionTaskResponse {\n  10: optional binary taskToken\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") previousStartedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n  51: optional i64 (js.type = 'Long') attempt\n  54: optional i64 (js.type = \"Long\") backlogCountHint\n  60: optional History history\n  70: optional binary nextPageToken\n  80: optional WorkflowQuery query\n  90: optional TaskList WorkflowExecutionTaskList\n  100: optional i64 (js.type = \"Long\") scheduledTimestamp\n  110: optional i64 (js.type = \"Long\") startedTimestamp\n  120: optional map<string, WorkflowQuery> queries\n  130: optional i64 (js.type = 'Long') nextEventId\n  140: optional i64 (js.type = 'Long') totalHistoryBytes\n  150: optional AutoConfigHint autoConfigHint\n}\n\nstruct StickyExecutionAttributes {\n  10: optional TaskList workerTaskList\n  20: optional i32 scheduleToStartTimeoutSeconds\n}\n\nstruct RespondDecisionTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional list<Decision> decisions\n  30: optional binary executionContext\n  40: optional string identity\n  50: optional StickyExecutionAttributes stickyAttributes\n  60: optional bool returnNewDecisionTask\n  70: optional bool forceCreateNewDecisionTask\n  80: optional string binaryChecksum\n  90: optional map<string, WorkflowQueryResult> queryResults\n}\n\nstruct RespondDecisionTaskCompletedResponse {\n  10: optional PollForDecisionTaskResponse decisionTask\n  20: optional map<string,ActivityLocalDispatchInfo> activitiesToDispatchLocally\n}\n\nstruct RespondDecisionTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional DecisionTaskFailedCause cause\n  30: optional binary details\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct PollForActivityTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional TaskListMetadata taskListMetadata\n}\n\nstruct PollForActivityTaskResponse {\n  10:  optional binary taskToken\n  20:  optional WorkflowExecution workflowExecution\n  30:  optional string activityId\n  40:  optional ActivityType activityType\n  50:  optional binary input\n  70:  optional i64 (js.type = \"Long\") scheduledTimestamp\n  80:  optional i32 scheduleToCloseTimeoutSeconds\n  90:  optional i64 (js.type = \"Long\") startedTimestamp\n  100: optional i32 startToCloseTimeoutSeconds\n  110: optional i32 heartbeatTimeoutSeconds\n  120: optional i32 attempt\n  130: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  140: optional binary heartbeatDetails\n  150: optional WorkflowType workflowType\n  160: optional string workflowDomain\n  170: optional Header header\n  180: optional AutoConfigHint autoConfigHint\n}\n\nstruct RecordActivityTaskHeartbeatRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatResponse {\n  10: optional bool cancelRequested\n}\n\nstruct RespondActivityTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional binary result\n  30: optional string identity\n}\n\nstruct RespondActivityTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional string reason\n  30: optional binary details\n  40: optional string identity\n}\n\nstruct RespondActivityTaskCanceledRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RespondActivityTaskCompletedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary result\n  60: optional string identity\n}\n\nstruct RespondActivityTaskFailedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional string reason\n  60: optional binary details\n  70: optional string identity\n}\n\nstruct RespondActivityTaskCanceledByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RequestCancelWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string identity\n  40: optional string requestId\n  50: optional string cause\n  60: optional string firstExecutionRunID\n}\n\nstruct GetWorkflowExecutionHistoryRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional i32 maximumPageSize\n  40: optional binary nextPageToken\n  50: optional bool waitForNewEvent\n  60: optional HistoryEventFilterType HistoryEventFilterType\n  70: optional bool skipArchival\n  80: optional QueryConsistencyLevel queryConsistencyLevel\n}\n\nstruct GetWorkflowExecutionHistoryResponse {\n  10: optional History history\n  11: optional list<DataBlob> rawHistory\n  20: optional binary nextPageToken\n  30: optional bool archived\n}\n\nstruct SignalWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string signalName\n  40: optional binary input\n  50: optional string identity\n  60: optional string requestId\n  70: optional binary control\n}\n\nstruct SignalWithStartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional string signalName\n  120: optional binary signalInput\n  130: optional binary control\n  140: optional RetryPolicy retryPolicy\n  150: optional string cronSchedule\n  160: optional Memo memo\n  161: optional SearchAttributes searchAttributes\n  170: optional Header header\n  180: optional i32 delayStartSeconds\n  190: optional i32 jitterStartSeconds\n  200: optional i64 (js.type = \"Long\") firstRunAtTimestamp\n  210: optional CronOverlapPolicy cronOverlapPolicy\n  220: optional ActiveClusterSelectionPolicy activeClusterSelectionPolicy\n}\n\nstruct SignalWithStartWorkflowExecutionAsyncRequest {\n  10: optional SignalWithStartWorkflowExecutionRequest request\n}\n\nstruct SignalWithStartWorkflowExecutionAsyncResponse {\n}\n\nstruct RestartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional string identity\n}\nstruct TerminateWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional binary details\n  50: optional string identity\n  60: optional string firstExecutionRunID\n}\n\nstruct ResetWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional i64 (js.type = \"Long\") decisionFinishEventId\n  50: optional string requestId\n  60: optional bool skipSignalReapply\n}\n\nstruct ResetWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct ListOpenWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n}\n\nstruct ListOpenWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListClosedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n  70: optional WorkflowExecutionCloseStatus statusFilter\n}\n\nstruct ListClosedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListArchivedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListArchivedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct CountWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional string query\n}\n\nstruct CountWorkflowExecutionsResponse {\n  10: optional i64 count\n}\n\nstruct GetSearchAttributesResponse {\n  10: optional map<string, IndexedValueType> keys\n}\n\nstruct QueryWorkflowRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional WorkflowQuery query\n  // QueryRejectCondition can used to reject the query if workflow state does not satisify condition\n  40: optional QueryRejectCondition queryRejectCondition\n  50: optional QueryConsistencyLevel queryConsistencyLevel\n}\n\nstruct QueryRejected {\n  10: optional WorkflowExecutionCloseStatus closeStatus\n}\n\nstruct QueryWorkflowResponse {\n  10: optional binary queryResult\n  20: optional QueryRejected queryRejected\n}\n\nstruct WorkflowQuery {\n  10: optional string queryType\n  20: optional binary queryArgs\n}\n\nstruct ResetStickyTaskListRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct ResetStickyTaskListResponse {\n    // The reason to keep this response is to allow returning\n    // information in the future.\n}\n\nstruct RespondQueryTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional QueryTaskCompletedType completedType\n  30: optional binary queryResult\n  40: optional string errorMessage\n  50: optional WorkerVersionInfo workerVersionInfo\n}\n\nstruct WorkflowQueryResult {\n  10: optional QueryResultType resultType\n  20: optional binary answer\n  30: optional string errorMessage\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional QueryConsistencyLevel queryConsistencyLevel\n}\n\nstruct PendingActivityInfo {\n  10: optional string activityID\n  20: optional ActivityType activityType\n  30: optional PendingActivityState state\n  40: optional binary heartbeatDetails\n  50: optional i64 (js.type = \"Long\") lastHeartbeatTimestamp\n  60: optional i64 (js.type = \"Long\") lastStartedTimestamp\n  70: optional i32 attempt\n  80: optional i32 maximumAttempts\n  90: optional i64 (js.type = \"Long\") scheduledTimestamp\n  100: optional i64 (js.type = \"Long\") expirationTimestamp\n  110: optional string lastFailureReason\n  120: optional string lastWorkerIdentity\n  130: optional binary lastFailureDetails\n  140: optional string startedWorkerIdentity\n  150: optional i64 (js.type = \"Long\") scheduleID\n}\n\nstruct PendingDecisionInfo {\n  10: optional PendingDecisionState state\n  20: optional i64 (js.type = \"Long\") scheduledTimestamp\n  30: optional i64 (js.type = \"Long\") startedTimestamp\n  40: optional i64 attempt\n  50: optional i64 (js.type = \"Long\") originalScheduledTimestamp\n  60: optional i64 (js.type = \"Long\") scheduleID\n}\n\nstruct PendingChildExecutionInfo {\n  1: optional string domain\n  10: optional string workflowID\n  20: optional string runID\n  30: optional string workflowTypName\n  40: optional i64 (js.type = \"Long\") initiatedID\n  50: optional ParentClosePolicy parentClosePolicy\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional WorkflowExecutionConfiguration executionConfiguration\n  20: optional WorkflowExecutionInfo workflowExecutionInfo\n  30: optional list<PendingActivityInfo> pendingActivities\n  40: optional list<PendingChildExecutionInfo> pendingChildren\n  50: optional PendingDecisionInfo pendingDecision\n}\n\nstruct DescribeTaskListRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional TaskListType taskListType\n  40: optional bool includeTaskListStatus\n}\n\nstruct DescribeTaskListResponse {\n  10: optional list<PollerInfo> pollers\n  20: optional TaskListStatus taskListStatus\n  // The TaskList being described\n  30: optional TaskList taskList\n}\n\nstruct GetTaskListsByDomainRequest {\n  10: optional string domainName\n}\n\nstruct GetTaskListsByDomainResponse {\n  10: optional map<string,DescribeTaskListResponse> decisionTaskListMap\n  20: optional map<string,DescribeTaskListResponse> activityTaskListMap\n}\n\nstruct ListTaskListPartitionsRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n}\n\nstruct TaskListPartitionMetadata {\n  10: optional string key\n  20: optional string ownerHostName\n}\n\nstruct ListTaskListPartitionsResponse {\n  10: optional list<TaskListPartitionMetadata> activityTaskListPartitions\n  20: optional list<TaskListPartitionMetadata> decisionTaskListPartitions\n}\n\nstruct IsolationGroupMetrics {\n  10: optional double newTasksPerSecond\n  20: optional i64 (js.type = \"Long\") pollerCount\n}\n\nstruct TaskListStatus {\n  10: optional i64 (js.type = \"Long\") backlogCountHint\n  20: optional i64 (js.type = \"Long\") readLevel\n  30: optional i64 (js.type = \"Long\") ackLevel\n  35: optional double ratePerSecond\n  40: optional TaskIDBlock taskIDBlock\n  50: optional map<string, IsolationGroupMetrics> isolationGroupMetrics\n  60: optional double newTasksPerSecond\n  70: optional bool empty\n  80: optional map<i32, i64> backlogCountByPriority\n  90: optional map<string, i64> backlogCountByFairnessKey\n}\n\nstruct TaskIDBlock {\n  10: optional i64 (js.type = \"Long\")  startID\n  20: optional i64 (js.type = \"Long\")  endID\n}\n\n//At least one of the parameters needs to be provided\nstruct DescribeHistoryHostRequest {\n  10: optional string               hostAddress //ip:port\n  20: optional i32                  shardIdForHost\n  30: optional WorkflowExecution    executionForHost\n}\n\nstruct RemoveTaskRequest {\n  10: optional i32                      shardID\n  20: optional i32                      type\n  30: optional i64 (js.type = \"Long\")   taskID\n  40: optional i64 (js.type = \"Long\")   visibilityTimestamp\n  50: optional string                   clusterName\n}\n\nstruct CloseShardRequest {\n  10: optional i32               shardID\n}\n\nstruct ResetQueueRequest {\n  10: optional i32    shardID\n  20: optional string clusterName\n  30: optional i32    type\n}\n\nstruct DescribeQueueRequest {\n  10: optional i32    shardID\n  20: optional string clusterName\n  30: optional i32    type\n}\n\nstruct DescribeQueueResponse {\n  10: optional list<string> processingQueueStates\n}\n\nenum VirtualQueueOperation {\n  /*\n   * Move the tasks of the given domains to the target virtual queue, creating it if needed\n   */\n  MoveDomains,\n  Pause,\n  Resume,\n  /*\n   * Merge all slices of the virtual queue into the target virtual queue\n   */\n  Merge,\n}\n\nstruct UpdateVirtualQueueRequest {\n  10: optional i32                   shardID\n  20: optional string                clusterName\n  30: optional i32                   type\n  40: optional VirtualQueueOperation operation\n  50: optional i64                   virtualQueueID\n  60: optional i64                   targetVirtualQueueID\n  70: optional list<string>          domainIDs\n  80: optional i32                   pauseDurationInSeconds\n}\n\nstruct DescribeShardDistributionRequest {\n  10: optional i32 pageSize\n  20: optional i32 pageID\n}\n\nstruct DescribeShardDistributionResponse {\n  10: optional i32              numberOfShards\n\n  // ShardID to Address (ip:port) map\n  20: optional map<i32, string> shards\n}\n\nstruct DescribeHistoryHostResponse{\n  10: optional i32                  numberOfShards\n  20: optional list<i32>            shardIDs\n  30: optional DomainCacheInfo      domainCache\n  40: optional string               shardControllerStatus\n  50: optional string               address\n}\n\nstruct DomainCacheInfo{\n  10: optional i64 numOfItemsInCacheByID\n  20: optional i64 numOfItemsInCacheByName\n}\n\nenum TaskListType {\n  /*\n   * Decision type of tasklist\n   */\n  Decision,\n  /*\n   * Activity type of tasklist\n   */\n  Activity,\n}\n\nstruct PollerInfo {\n  // Unix Nano\n  10: optional i64 (js.type = \"Long\")  lastAccessTime\n  20: optional string identity\n  30: optional double ratePerSecond\n}\n\nstruct RetryPolicy {\n  // Interval of the first retry. If coefficient is 1.0 then it is used for all retries.\n  10: optional i32 initialIntervalInSeconds\n\n  // Coefficient used to calculate the next retry interval.\n  // The next retry interval is previous interval multiplied by the coefficient.\n  // Must be 1 or larger.\n  20: optional double backoffCoefficient\n\n  // Maximum interval between retries. Exponential backoff leads to interval increase.\n  // This value is the cap of the increase. Default is 100x of initial interval.\n  30: optional i32 maximumIntervalInSeconds\n\n  // Maximum number of attempts. When exceeded the retries stop even if not expired yet.\n  // Must be 1 or bigger. Default is unlimited.\n  40: optional i32 maximumAttempts\n\n  // Non-Retriable errors. Will stop retrying if error matches this list.\n  50: optional list<string> nonRetriableErrorReasons\n\n  // Expiration time for the whole retry process.\n  60: optional i32 expirationIntervalInSeconds\n}\n\n// HistoryBranchRange represents a piece of range for a branch.\nstruct HistoryBranchRange{\n  // branchID of original branch forked from\n  10: optional string branchID\n  // beinning node for the range, inclusive\n  20: optional i64 beginNodeID\n  // ending node for the range, exclusive\n  30: optional i64 endNodeID\n}\n\n// For history persistence to serialize/deserialize branch details\nstruct HistoryBranch{\n  10: optional string treeID\n  20: optional string branchID\n  30: optional list<HistoryBranchRange> ancestors\n}\n\n// VersionHistoryItem contains signal eventID and the corresponding version\nstruct VersionHistoryItem{\n  10: optional i64 (js.type = \"Long\") eventID\n  20: optional i64 (js.type = \"Long\") version\n}\n\n// VersionHistory contains the version history of a branch\nstruct VersionHistory{\n  10: optional binary branchToken\n  20: optional list<VersionHistoryItem> items\n}\n\n// VersionHistories contains all version histories from all branches\nstruct VersionHistories{\n  10: optional i32 currentVersionHistoryIndex\n  20: optional list<VersionHistory> histories\n}\n\n// ReapplyEventsRequest is the request for reapply events API\nstruct ReapplyEventsRequest{\n  10: optional string domainName\n  20: optional WorkflowExecution workflowExecution\n  30: optional DataBlob events\n}\n\n// SupportedClientVersions contains the support versions for client library\nstruct SupportedClientVersions{\n  10: optional string goSdk\n  20: optional string javaSdk\n}\n\n// ClusterInfo contains information about cadence cluster\nstruct ClusterInfo{\n  10: optional SupportedClientVersions supportedClientVersions\n}\n\nstruct RefreshWorkflowTasksRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\n// DEPRECATED: use proto definition instead\nstruct FeatureFlags {\n  10: optional bool WorkflowExecutionAlreadyCompletedErrorEnabled\n  20: optional bool AutoForwardingEnabled\n}\n\nenum CrossClusterTaskType {\n  StartChildExecution\n  CancelExecution\n  SignalExecution\n  RecordChildWorkflowExecutionComplete\n  ApplyParentClosePolicy\n}\n\nenum CrossClusterTaskFailedCause {\n  DOMAIN_NOT_ACTIVE\n  DOMAIN_NOT_EXISTS\n  WORKFLOW_ALREADY_RUNNING\n  WORKFLOW_NOT_EXISTS\n  WORKFLOW_ALREADY_COMPLETED\n  UNCATEGORIZED\n}\n\nenum GetTaskFailedCause {\n  SERVICE_BUSY\n  TIMEOUT\n  SHARD_OWNERSHIP_LOST\n  UNCATEGORIZED\n}\n\nstruct CrossClusterTaskInfo {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional CrossClusterTaskType taskType\n  50: optional i16 taskState\n  60: optional i64 (js.type = \"Long\") taskID\n  70: optional i64 (js.type = \"Long\") visibilityTimestamp\n}\n\nstruct CrossClusterStartChildExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string requestID\n  30: optional i64 (js.type = \"Long\") initiatedEventID\n  40: optional StartChildWorkflowExecutionInitiatedEventAttributes initiatedEventAttributes\n  // targetRunID is for scheduling first decision task\n  // targetWorkflowID is available in initiatedEventAttributes\n  50: optional string targetRunID\n  60: optional map<string, string> partitionConfig\n}\n\nstruct CrossClusterStartChildExecutionResponseAttributes {\n  10: optional string runID\n}\n\nstruct CrossClusterCancelExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional string requestID\n  50: optional i64 (js.type = \"Long\") initiatedEventID\n  60: optional bool childWorkflowOnly\n}\n\nstruct CrossClusterCancelExecutionResponseAttributes {\n}\n\nstruct CrossClusterSignalExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional string requestID\n  50: optional i64 (js.type = \"Long\") initiatedEventID\n  60: optional bool childWorkflowOnly\n  70: optional string signalName\n  80: optional binary signalInput\n  90: optional binary control\n}\n\nstruct CrossClusterSignalExecutionResponseAttributes {\n}\n\nstruct CrossClusterRecordChildWorkflowExecutionCompleteRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional i64 (js.type = \"Long\") initiatedEventID\n  50: optional HistoryEvent completionEvent\n}\n\nstruct CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes {\n}\n\nstruct ApplyParentClosePolicyAttributes {\n  10: optional string childDomainID\n  20: optional string childWorkflowID\n  30: optional string childRunID\n  40: optional ParentClosePolicy parentClosePolicy\n}\n\nstruct ApplyParentClosePolicyStatus {\n  10: optional bool completed\n  20: optional CrossClusterTaskFailedCause failedCause\n}\n\nstruct ApplyParentClosePolicyRequest {\n  10: optional ApplyParentClosePolicyAttributes child\n  20: optional ApplyParentClosePolicyStatus status\n}\n\nstruct CrossClusterApplyParentClosePolicyRequestAttributes {\n  10: optional list<ApplyParentClosePolicyRequest> children\n}\n\nstruct ApplyParentClosePolicyResult {\n  10: optional ApplyParentClosePolicyAttributes child\n  20: optional CrossClusterTaskFailedCause failedCause\n}\n\nstruct CrossClusterApplyParentClosePolicyResponseAttributes {\n  10: optional list<ApplyParentClosePolicyResult> childrenStatus\n}\n\nstruct CrossClusterTaskRequest {\n  10: optional CrossClusterTaskInfo taskInfo\n  20: optional CrossClusterStartChildExecutionRequestAttributes startChildExecutionAttributes\n  30: optional CrossClusterCancelExecutionRequestAttributes cancelExecutionAttributes\n  40: optional CrossClusterSignalExecutionRequestAttributes signalExecutionAttributes\n  50: optional CrossClusterRecordChildWorkflowExecutionCompleteRequestAttributes recordChildWorkflowExecutionCompleteAttributes\n  60: optional CrossClusterApplyParentClosePolicyRequestAttributes applyParentClosePolicyAttributes\n}\n\nstruct CrossClusterTaskResponse {\n  10: optional i64 (js.type = \"Long\") taskID\n  20: optional CrossClusterTaskType taskType\n  30: optional i16 taskState\n  40: optional CrossClusterTaskFailedCause failedCause\n  50: optional CrossClusterStartChildExecutionResponseAttributes startChildExecutionAttributes\n  60: optional CrossClusterCancelExecutionResponseAttributes cancelExecutionAttributes\n  70: optional CrossClusterSignalExecutionResponseAttributes signalExecutionAttributes\n  80: optional CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes recordChildWorkflowExecutionCompleteAttributes\n  90: optional CrossClusterApplyParentClosePolicyResponseAttributes applyParentClosePolicyAttributes\n}\n\nstruct GetCrossClusterTasksRequest {\n  10: optional list<i32> shardIDs\n  20: optional string targetCluster\n}\n\nstruct GetCrossClusterTasksResponse {\n  10: optional map<i32, list<CrossClusterTaskRequest>> tasksByShard\n  20: optional map<i32, GetTaskFailedCause> failedCauseByShard\n}\n\nstruct RespondCrossClusterTasksCompletedRequest {\n  10: optional i32 shardID\n  20: optional string targetCluster\n  30: optional list<CrossClusterTaskResponse> taskResponses\n  40: optional bool fetchNewTasks\n}\n\nstruct RespondCrossClusterTasksCompletedResponse {\n  10: optional list<CrossClusterTaskRequest> tasks\n}\n\nenum IsolationGroupState {\n  INVALID,\n  HEALTHY,\n  DRAINED,\n}\n\nstruct IsolationGroupPartition {\n  10: optional string name\n  20: optional IsolationGroupState state\n}\n\nstruct IsolationGroupConfiguration {\n  10: optional list<IsolationGroupPartition> isolationGroups\n}\n\nstruct AsyncWorkflowConfiguration {\n  10: optional bool enabled\n  // PredefinedQueueName is the name of the predefined queue in cadence server config's asyncWorkflowQueues\n  20: optional string predefinedQueueName\n  // queueType is the type of the queue if predefined_queue_name is not used\n  30: optional string queueType\n  // queueConfig is the configuration for the queue if predefined_queue_name is not used\n  40: optional DataBlob queueConfig\n}\n\n/**\n* Any is a logical duplicate of google.protobuf.Any.\n*\n* The intent of the type is the same, but it is not intended to be directly\n* compatible with google.protobuf.Any or any Thrift equivalent - this blob is\n* RPC-type agnostic by design (as the underlying data may be transported over\n* proto or thrift), and the data-bytes may be in any encoding.\n*\n* This is intentionally different from DataBlob, which supports only a handful\n* of known encodings so it can be interpreted everywhere.  Any supports literally\n* any contents, and needs to be considered opaque until it is given to something\n* that is expecting it.\n*\n* See ValueType to interpret the contents.\n**/\nstruct Any {\n  // Type-string describing value's contents, and intentionally avoiding the\n  // name \"type\" as it is often a special term.\n  // This should usually be a hard-coded string of some kind.\n  10: optional string ValueType\n  // Arbitrarily-encoded bytes, to be deserialized by a runtime implementation.\n  // The contents are described by ValueType.\n  20: optional binary Value\n}\n\nstruct AutoConfigHint {\n  10: optional bool enableAutoConfig\n  20: optional i64 pollerWaitTimeInMs\n}\n\nstruct QueueState {\n  10: optional map<i64, VirtualQueueState> virtualQueueStates\n  20: optional TaskKey exclusiveMaxReadLevel\n}\n\nstruct VirtualQueueState {\n  10: optional list<VirtualSliceState> virtualSliceStates\n}\n\nstruct VirtualSliceState {\n  10: optional TaskRange taskRange\n  20: optional Predicate predicate\n}\n\nstruct TaskRange {\n  10: optional TaskKey inclusiveMin\n  20: optional TaskKey exclusiveMax\n}\n\nstruct TaskKey {\n  10: optional i64 scheduledTimeNano\n  20: optional i64 taskID\n}\n\n// ActiveClusterSelectionPolicy is for active-active domains, it serves as a means to select\n// the active cluster, by specifying the attribute by which to divide the workflows\n// in that domain.\nstruct ActiveClusterSelectionPolicy {\n  1: optional ClusterAttribute clusterAttribute\n\n  10: optional ActiveClusterSelectionStrategy strategy // todo (david.porter) remove these as they're not used anymore\n  20: optional string stickyRegion                     // todo (david.porter) remove these as they're not used anymore\n  30: optional string externalEntityType               // todo (david.porter) remove these as they're not used anymore\n  40: optional string externalEntityKey                // todo (david.porter) remove these as they're not used anymore\n}\n\n// ClusterAttribute is used for subdividing workflows in a domain into their active\n// and passive clusters. Examples of this might be 'region' and 'cluster1' as\n// respective region and scope fields.\n//\n// for example, a workflow may specify this in it's start request:\n//\n//   StartWorkflowRequest{\n//     ActiveClusterSelectionPolicy: {\n//       ClusterAttribute: {\n//            Scope: \"cityID\",\n//            Name: \"Lisbon\"\n//        }\n//     }\n//   }\n//\n// and this means that this workflow will be associate with the domain's cluster attribute 'Lisbon',\n// be active in the cluster that has Lisbon active and\n// failover when that cluster-attribute is set to failover.\nstruct ClusterAttribute {\n  1: optional string scope\n  2: optional string name\n}\n\n// FailoverType describes how a failover operation will be performed.\nenum FailoverType {\n  INVALID\n  FORCE\n  GRACEFUL\n}\n\n// PaginationOptions provides common options for paginated RPCs.\nstruct PaginationOptions {\n  // page_size configures the number of results to be returned as part of each page\n  10: optional i32 pageSize\n  // next_page_token should be provided from a previous response to fetch the next page.\n  // if empty, the first page will be returned.\n  20: optional binary nextPageToken\n}\n\n// todo (david.porter) Remove this, as it's no longer needed\n// with the active/active configuration we have\nenum ActiveClusterSelectionStrategy {\n  REGION_STICKY,\n  EXTERNAL_ENTITY,\n}\n\nenum PredicateType {\n  Universal,\n  Empty,\n  DomainID,\n  TaskType,\n  TaskList,\n  WorkflowID,\n  WorkflowIDPrefix,\n  And,\n  Or,\n  Not,\n}\n\nstruct UniversalPredicateAttributes {}\n\nstruct EmptyPredicateAttributes {}\n\nstruct DomainIDPredicateAttributes {\n  10: optional list<string> domainIDs\n  20: optional bool isExclusive\n}\n\nstruct TaskTypePredicateAttributes {\n  10: optional list<i32> taskTypes\n  20: optional bool isExclusive\n}\n\nstruct TaskListPredicateAttributes {\n  10: optional list<string> taskLists\n  20: optional bool isExclusive\n}\n\nstruct WorkflowIDPredicateAttributes {\n  10: optional list<string> workflowIDs\n  20: optional bool isExclusive\n}\n\nstruct WorkflowIDPrefixPredicateAttributes {\n  10: optional list<string> prefixes\n  20: optional bool isExclusive\n}\n\nstruct AndPredicateAttributes {\n  10: optional list<Predicate> predicates\n}\n\nstruct OrPredicateAttributes {\n  10: optional list<Predicate> predicates\n}\n\nstruct NotPredicateAttributes {\n  10: optional Predicate predicate\n}\n\nstruct Predicate {\n  10: optional PredicateType predicateType\n  20: optional UniversalPredicateAttributes universalPredicateAttributes\n  30: optional EmptyPredicateAttributes emptyPredicateAttributes\n  40: optional DomainIDPredicateAttributes domainIDPredicateAttributes\n  50: optional TaskTypePredicateAttributes taskTypePredicateAttributes\n  60: optional TaskListPredicateAttributes taskListPredicateAttributes\n  70: optional WorkflowIDPredicateAttributes workflowIDPredicateAttributes\n  80: optional WorkflowIDPrefixPredicateAttributes workflowIDPrefixPredicateAttributes\n  90: optional AndPredicateAttributes andPredicateAttributes\n  100: optional OrPredicateAttributes orPredicateAttributes\n  110: optional NotPredicateAttributes notPredicateAttributes\n}\n"
@@ -28,6 +28,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type VirtualQueueOperation int32
+
+const (
+	VirtualQueueOperation_VIRTUAL_QUEUE_OPERATION_INVALID VirtualQueueOperation = 0
+	// Move the tasks of the given domains to the target virtual queue, creating it if needed.
+	VirtualQueueOperation_VIRTUAL_QUEUE_OPERATION_MOVE_DOMAINS VirtualQueueOperation = 1
+	VirtualQueueOperation_VIRTUAL_QUEUE_OPERATION_PAUSE        VirtualQueueOperation = 2
+	VirtualQueueOperation_VIRTUAL_QUEUE_OPERATION_RESUME       VirtualQueueOperation = 3
+	// Merge all slices of the virtual queue into the target virtual queue.
+	VirtualQueueOperation_VIRTUAL_QUEUE_OPERATION_MERGE VirtualQueueOperation = 4
+)
+
+var VirtualQueueOperation_name = map[int32]string{
+	0: "VIRTUAL_QUEUE_OPERATION_INVALID",
+	1: "VIRTUAL_QUEUE_OPERATION_MOVE_DOMAINS",
+	2: "VIRTUAL_QUEUE_OPERATION_PAUSE",
+	3: "VIRTUAL_QUEUE_OPERATION_RESUME",
+	4: "VIRTUAL_QUEUE_OPERATION_MERGE",
+}
+
+var VirtualQueueOperation_value = map[string]int32{
+	"VIRTUAL_QUEUE_OPERATION_INVALID":      0,
+	"VIRTUAL_QUEUE_OPERATION_MOVE_DOMAINS": 1,
+	"VIRTUAL_QUEUE_OPERATION_PAUSE":        2,
+	"VIRTUAL_QUEUE_OPERATION_RESUME":       3,
+	"VIRTUAL_QUEUE_OPERATION_MERGE":        4,
+}
+
+func (x VirtualQueueOperation) String() string {
+	return proto.EnumName(VirtualQueueOperation_name, int32(x))
+}
+
+func (VirtualQueueOperation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{0}
+}
+
 type StartWorkflowExecutionRequest struct {
 	Request                  *v1.StartWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId                 string                            `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -3981,6 +4017,148 @@ func (m *DescribeQueueResponse) GetProcessingQueueStates() []string {
 	return nil
 }
 
+type UpdateVirtualQueueRequest struct {
+	ShardId              int32                 `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	ClusterName          string                `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	TaskType             v11.TaskType          `protobuf:"varint,3,opt,name=task_type,json=taskType,proto3,enum=uber.cadence.admin.v1.TaskType" json:"task_type,omitempty"`
+	Operation            VirtualQueueOperation `protobuf:"varint,4,opt,name=operation,proto3,enum=uber.cadence.history.v1.VirtualQueueOperation" json:"operation,omitempty"`
+	VirtualQueueId       int64                 `protobuf:"varint,5,opt,name=virtual_queue_id,json=virtualQueueId,proto3" json:"virtual_queue_id,omitempty"`
+	TargetVirtualQueueId int64                 `protobuf:"varint,6,opt,name=target_virtual_queue_id,json=targetVirtualQueueId,proto3" json:"target_virtual_queue_id,omitempty"`
+	DomainIds            []string              `protobuf:"bytes,7,rep,name=domain_ids,json=domainIds,proto3" json:"domain_ids,omitempty"`
+	PauseDuration        *types.Duration       `protobuf:"bytes,8,opt,name=pause_duration,json=pauseDuration,proto3" json:"pause_duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpdateVirtualQueueRequest) Reset()         { *m = UpdateVirtualQueueRequest{} }
+func (m *UpdateVirtualQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateVirtualQueueRequest) ProtoMessage()    {}
+func (*UpdateVirtualQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{62}
+}
+func (m *UpdateVirtualQueueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateVirtualQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateVirtualQueueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateVirtualQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateVirtualQueueRequest.Merge(m, src)
+}
+func (m *UpdateVirtualQueueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateVirtualQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateVirtualQueueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateVirtualQueueRequest proto.InternalMessageInfo
+
+func (m *UpdateVirtualQueueRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *UpdateVirtualQueueRequest) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
+}
+
+func (m *UpdateVirtualQueueRequest) GetTaskType() v11.TaskType {
+	if m != nil {
+		return m.TaskType
+	}
+	return v11.TaskType_TASK_TYPE_INVALID
+}
+
+func (m *UpdateVirtualQueueRequest) GetOperation() VirtualQueueOperation {
+	if m != nil {
+		return m.Operation
+	}
+	return VirtualQueueOperation_VIRTUAL_QUEUE_OPERATION_INVALID
+}
+
+func (m *UpdateVirtualQueueRequest) GetVirtualQueueId() int64 {
+	if m != nil {
+		return m.VirtualQueueId
+	}
+	return 0
+}
+
+func (m *UpdateVirtualQueueRequest) GetTargetVirtualQueueId() int64 {
+	if m != nil {
+		return m.TargetVirtualQueueId
+	}
+	return 0
+}
+
+func (m *UpdateVirtualQueueRequest) GetDomainIds() []string {
+	if m != nil {
+		return m.DomainIds
+	}
+	return nil
+}
+
+func (m *UpdateVirtualQueueRequest) GetPauseDuration() *types.Duration {
+	if m != nil {
+		return m.PauseDuration
+	}
+	return nil
+}
+
+type UpdateVirtualQueueResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateVirtualQueueResponse) Reset()         { *m = UpdateVirtualQueueResponse{} }
+func (m *UpdateVirtualQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateVirtualQueueResponse) ProtoMessage()    {}
+func (*UpdateVirtualQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{63}
+}
+func (m *UpdateVirtualQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateVirtualQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateVirtualQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateVirtualQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateVirtualQueueResponse.Merge(m, src)
+}
+func (m *UpdateVirtualQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateVirtualQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateVirtualQueueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateVirtualQueueResponse proto.InternalMessageInfo
+
 type GetReplicationMessagesRequest struct {
 	Tokens               []*v11.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
 	ClusterName          string                  `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
@@ -3993,7 +4171,7 @@ func (m *GetReplicationMessagesRequest) Reset()         { *m = GetReplicationMes
 func (m *GetReplicationMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetReplicationMessagesRequest) ProtoMessage()    {}
 func (*GetReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{62}
+	return fileDescriptor_fee8ff76963a38ed, []int{64}
 }
 func (m *GetReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4047,7 +4225,7 @@ func (m *GetReplicationMessagesResponse) Reset()         { *m = GetReplicationMe
 func (m *GetReplicationMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetReplicationMessagesResponse) ProtoMessage()    {}
 func (*GetReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{63}
+	return fileDescriptor_fee8ff76963a38ed, []int{65}
 }
 func (m *GetReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4094,7 +4272,7 @@ func (m *GetDLQReplicationMessagesRequest) Reset()         { *m = GetDLQReplicat
 func (m *GetDLQReplicationMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDLQReplicationMessagesRequest) ProtoMessage()    {}
 func (*GetDLQReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{64}
+	return fileDescriptor_fee8ff76963a38ed, []int{66}
 }
 func (m *GetDLQReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4141,7 +4319,7 @@ func (m *GetDLQReplicationMessagesResponse) Reset()         { *m = GetDLQReplica
 func (m *GetDLQReplicationMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDLQReplicationMessagesResponse) ProtoMessage()    {}
 func (*GetDLQReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{65}
+	return fileDescriptor_fee8ff76963a38ed, []int{67}
 }
 func (m *GetDLQReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4191,7 +4369,7 @@ func (m *ReapplyEventsRequest) Reset()         { *m = ReapplyEventsRequest{} }
 func (m *ReapplyEventsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReapplyEventsRequest) ProtoMessage()    {}
 func (*ReapplyEventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{66}
+	return fileDescriptor_fee8ff76963a38ed, []int{68}
 }
 func (m *ReapplyEventsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4258,7 +4436,7 @@ func (m *ReapplyEventsResponse) Reset()         { *m = ReapplyEventsResponse{} }
 func (m *ReapplyEventsResponse) String() string { return proto.CompactTextString(m) }
 func (*ReapplyEventsResponse) ProtoMessage()    {}
 func (*ReapplyEventsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{67}
+	return fileDescriptor_fee8ff76963a38ed, []int{69}
 }
 func (m *ReapplyEventsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4300,7 +4478,7 @@ func (m *RefreshWorkflowTasksRequest) Reset()         { *m = RefreshWorkflowTask
 func (m *RefreshWorkflowTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*RefreshWorkflowTasksRequest) ProtoMessage()    {}
 func (*RefreshWorkflowTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{68}
+	return fileDescriptor_fee8ff76963a38ed, []int{70}
 }
 func (m *RefreshWorkflowTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4360,7 +4538,7 @@ func (m *RefreshWorkflowTasksResponse) Reset()         { *m = RefreshWorkflowTas
 func (m *RefreshWorkflowTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*RefreshWorkflowTasksResponse) ProtoMessage()    {}
 func (*RefreshWorkflowTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{69}
+	return fileDescriptor_fee8ff76963a38ed, []int{71}
 }
 func (m *RefreshWorkflowTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4400,7 +4578,7 @@ func (m *CountDLQMessagesRequest) Reset()         { *m = CountDLQMessagesRequest
 func (m *CountDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*CountDLQMessagesRequest) ProtoMessage()    {}
 func (*CountDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{70}
+	return fileDescriptor_fee8ff76963a38ed, []int{72}
 }
 func (m *CountDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4447,7 +4625,7 @@ func (m *CountDLQMessagesResponse) Reset()         { *m = CountDLQMessagesRespon
 func (m *CountDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*CountDLQMessagesResponse) ProtoMessage()    {}
 func (*CountDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{71}
+	return fileDescriptor_fee8ff76963a38ed, []int{73}
 }
 func (m *CountDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4499,7 +4677,7 @@ func (m *ReadDLQMessagesRequest) Reset()         { *m = ReadDLQMessagesRequest{}
 func (m *ReadDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadDLQMessagesRequest) ProtoMessage()    {}
 func (*ReadDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{72}
+	return fileDescriptor_fee8ff76963a38ed, []int{74}
 }
 func (m *ReadDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4584,7 +4762,7 @@ func (m *ReadDLQMessagesResponse) Reset()         { *m = ReadDLQMessagesResponse
 func (m *ReadDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*ReadDLQMessagesResponse) ProtoMessage()    {}
 func (*ReadDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{73}
+	return fileDescriptor_fee8ff76963a38ed, []int{75}
 }
 func (m *ReadDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4655,7 +4833,7 @@ func (m *PurgeDLQMessagesRequest) Reset()         { *m = PurgeDLQMessagesRequest
 func (m *PurgeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*PurgeDLQMessagesRequest) ProtoMessage()    {}
 func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{74}
+	return fileDescriptor_fee8ff76963a38ed, []int{76}
 }
 func (m *PurgeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4722,7 +4900,7 @@ func (m *PurgeDLQMessagesResponse) Reset()         { *m = PurgeDLQMessagesRespon
 func (m *PurgeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*PurgeDLQMessagesResponse) ProtoMessage()    {}
 func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{75}
+	return fileDescriptor_fee8ff76963a38ed, []int{77}
 }
 func (m *PurgeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4767,7 +4945,7 @@ func (m *MergeDLQMessagesRequest) Reset()         { *m = MergeDLQMessagesRequest
 func (m *MergeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*MergeDLQMessagesRequest) ProtoMessage()    {}
 func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{76}
+	return fileDescriptor_fee8ff76963a38ed, []int{78}
 }
 func (m *MergeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4849,7 +5027,7 @@ func (m *MergeDLQMessagesResponse) Reset()         { *m = MergeDLQMessagesRespon
 func (m *MergeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*MergeDLQMessagesResponse) ProtoMessage()    {}
 func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{77}
+	return fileDescriptor_fee8ff76963a38ed, []int{79}
 }
 func (m *MergeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4896,7 +5074,7 @@ func (m *NotifyFailoverMarkersRequest) Reset()         { *m = NotifyFailoverMark
 func (m *NotifyFailoverMarkersRequest) String() string { return proto.CompactTextString(m) }
 func (*NotifyFailoverMarkersRequest) ProtoMessage()    {}
 func (*NotifyFailoverMarkersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{78}
+	return fileDescriptor_fee8ff76963a38ed, []int{80}
 }
 func (m *NotifyFailoverMarkersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4942,7 +5120,7 @@ func (m *NotifyFailoverMarkersResponse) Reset()         { *m = NotifyFailoverMar
 func (m *NotifyFailoverMarkersResponse) String() string { return proto.CompactTextString(m) }
 func (*NotifyFailoverMarkersResponse) ProtoMessage()    {}
 func (*NotifyFailoverMarkersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{79}
+	return fileDescriptor_fee8ff76963a38ed, []int{81}
 }
 func (m *NotifyFailoverMarkersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4983,7 +5161,7 @@ func (m *GetCrossClusterTasksRequest) Reset()         { *m = GetCrossClusterTask
 func (m *GetCrossClusterTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCrossClusterTasksRequest) ProtoMessage()    {}
 func (*GetCrossClusterTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{80}
+	return fileDescriptor_fee8ff76963a38ed, []int{82}
 }
 func (m *GetCrossClusterTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5038,7 +5216,7 @@ func (m *GetCrossClusterTasksResponse) Reset()         { *m = GetCrossClusterTas
 func (m *GetCrossClusterTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCrossClusterTasksResponse) ProtoMessage()    {}
 func (*GetCrossClusterTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{81}
+	return fileDescriptor_fee8ff76963a38ed, []int{83}
 }
 func (m *GetCrossClusterTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5097,7 +5275,7 @@ func (m *RespondCrossClusterTasksCompletedRequest) Reset() {
 func (m *RespondCrossClusterTasksCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondCrossClusterTasksCompletedRequest) ProtoMessage()    {}
 func (*RespondCrossClusterTasksCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{82}
+	return fileDescriptor_fee8ff76963a38ed, []int{84}
 }
 func (m *RespondCrossClusterTasksCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5169,7 +5347,7 @@ func (m *RespondCrossClusterTasksCompletedResponse) String() string {
 }
 func (*RespondCrossClusterTasksCompletedResponse) ProtoMessage() {}
 func (*RespondCrossClusterTasksCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{83}
+	return fileDescriptor_fee8ff76963a38ed, []int{85}
 }
 func (m *RespondCrossClusterTasksCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5216,7 +5394,7 @@ func (m *GetFailoverInfoRequest) Reset()         { *m = GetFailoverInfoRequest{}
 func (m *GetFailoverInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFailoverInfoRequest) ProtoMessage()    {}
 func (*GetFailoverInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{84}
+	return fileDescriptor_fee8ff76963a38ed, []int{86}
 }
 func (m *GetFailoverInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5264,7 +5442,7 @@ func (m *GetFailoverInfoResponse) Reset()         { *m = GetFailoverInfoResponse
 func (m *GetFailoverInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetFailoverInfoResponse) ProtoMessage()    {}
 func (*GetFailoverInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{85}
+	return fileDescriptor_fee8ff76963a38ed, []int{87}
 }
 func (m *GetFailoverInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5326,7 +5504,7 @@ func (m *RatelimitUpdateRequest) Reset()         { *m = RatelimitUpdateRequest{}
 func (m *RatelimitUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*RatelimitUpdateRequest) ProtoMessage()    {}
 func (*RatelimitUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{86}
+	return fileDescriptor_fee8ff76963a38ed, []int{88}
 }
 func (m *RatelimitUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5382,7 +5560,7 @@ func (m *RatelimitUpdateResponse) Reset()         { *m = RatelimitUpdateResponse
 func (m *RatelimitUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*RatelimitUpdateResponse) ProtoMessage()    {}
 func (*RatelimitUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{87}
+	return fileDescriptor_fee8ff76963a38ed, []int{89}
 }
 func (m *RatelimitUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5419,6 +5597,7 @@ func (m *RatelimitUpdateResponse) GetData() *v12.Any {
 }
 
 func init() {
+	proto.RegisterEnum("uber.cadence.history.v1.VirtualQueueOperation", VirtualQueueOperation_name, VirtualQueueOperation_value)
 	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.StartWorkflowExecutionRequest")
 	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.history.v1.StartWorkflowExecutionRequest.PartitionConfigEntry")
 	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.StartWorkflowExecutionResponse")
@@ -5485,6 +5664,8 @@ func init() {
 	proto.RegisterType((*ResetQueueResponse)(nil), "uber.cadence.history.v1.ResetQueueResponse")
 	proto.RegisterType((*DescribeQueueRequest)(nil), "uber.cadence.history.v1.DescribeQueueRequest")
 	proto.RegisterType((*DescribeQueueResponse)(nil), "uber.cadence.history.v1.DescribeQueueResponse")
+	proto.RegisterType((*UpdateVirtualQueueRequest)(nil), "uber.cadence.history.v1.UpdateVirtualQueueRequest")
+	proto.RegisterType((*UpdateVirtualQueueResponse)(nil), "uber.cadence.history.v1.UpdateVirtualQueueResponse")
 	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "uber.cadence.history.v1.GetReplicationMessagesRequest")
 	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "uber.cadence.history.v1.GetReplicationMessagesResponse")
 	proto.RegisterMapType((map[int32]*v11.ReplicationMessages)(nil), "uber.cadence.history.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
@@ -5521,319 +5702,331 @@ func init() {
 }
 
 var fileDescriptor_fee8ff76963a38ed = []byte{
-	// 4981 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x4d, 0x6c, 0x1c, 0x47,
-	0x76, 0x30, 0x7a, 0x46, 0xfc, 0x7b, 0x24, 0x87, 0x64, 0x89, 0x3f, 0xc3, 0xa1, 0x44, 0x91, 0x6d,
-	0xcb, 0xa2, 0xe5, 0xf5, 0xd0, 0xa2, 0x6c, 0x49, 0x96, 0xe5, 0xd5, 0x4a, 0xa4, 0x24, 0x8f, 0x3f,
-	0xfd, 0x36, 0x69, 0xf9, 0xcb, 0x9f, 0x7b, 0x9b, 0xd3, 0x35, 0x64, 0x47, 0x3d, 0xdd, 0xa3, 0xee,
-	0x1e, 0x52, 0xf4, 0x21, 0x70, 0xe2, 0x20, 0x40, 0x16, 0x41, 0x76, 0xb3, 0x48, 0x82, 0x00, 0x01,
-	0x02, 0x04, 0x1b, 0x60, 0xb1, 0x46, 0x6e, 0x09, 0x90, 0x43, 0x92, 0x53, 0x2e, 0x7b, 0xdc, 0x6b,
-	0x6e, 0x81, 0xb1, 0x7b, 0x48, 0x80, 0xdc, 0xf6, 0x1c, 0x04, 0xf5, 0xd3, 0x3d, 0xfd, 0x53, 0x5d,
-	0x3d, 0x43, 0x06, 0x91, 0xd7, 0xf1, 0x6d, 0xba, 0xaa, 0xde, 0xab, 0x57, 0xaf, 0xde, 0x7b, 0xfd,
-	0xfe, 0x7a, 0xe0, 0x7c, 0x77, 0x17, 0x7b, 0xeb, 0x4d, 0xc3, 0xc4, 0x4e, 0x13, 0xaf, 0xef, 0x5b,
-	0x7e, 0xe0, 0x7a, 0x47, 0xeb, 0x07, 0x97, 0xd6, 0x7d, 0xec, 0x1d, 0x58, 0x4d, 0x5c, 0xef, 0x78,
-	0x6e, 0xe0, 0xa2, 0x05, 0xb2, 0xac, 0xce, 0x97, 0xd5, 0xf9, 0xb2, 0xfa, 0xc1, 0xa5, 0xda, 0xf2,
-	0x9e, 0xeb, 0xee, 0xd9, 0x78, 0x9d, 0x2e, 0xdb, 0xed, 0xb6, 0xd6, 0xcd, 0xae, 0x67, 0x04, 0x96,
-	0xeb, 0x30, 0xc0, 0xda, 0xb9, 0xf4, 0x7c, 0x60, 0xb5, 0xb1, 0x1f, 0x18, 0xed, 0x0e, 0x5f, 0x90,
-	0x41, 0x70, 0xe8, 0x19, 0x9d, 0x0e, 0xf6, 0x7c, 0x3e, 0xbf, 0x92, 0x20, 0xd0, 0xe8, 0x58, 0x84,
-	0xb8, 0xa6, 0xdb, 0x6e, 0x47, 0x5b, 0xac, 0x8a, 0x56, 0x84, 0x24, 0x72, 0x2a, 0x44, 0x4b, 0x9e,
-	0x77, 0x71, 0xb4, 0x40, 0x15, 0x2d, 0x08, 0x0c, 0xff, 0x99, 0x6d, 0xf9, 0x81, 0x6c, 0xcd, 0xa1,
-	0xeb, 0x3d, 0x6b, 0xd9, 0xee, 0x21, 0x5f, 0x73, 0x51, 0xb4, 0x86, 0xb3, 0x52, 0x4f, 0xad, 0x5d,
-	0x2b, 0x5a, 0x8b, 0x3d, 0xbe, 0xf2, 0x95, 0xe4, 0x4a, 0xb3, 0x6d, 0x39, 0x94, 0x0b, 0x76, 0xd7,
-	0x0f, 0x8a, 0x16, 0x25, 0x19, 0xb1, 0x2a, 0x5e, 0xf4, 0xbc, 0x8b, 0xbb, 0xfc, 0xaa, 0x6b, 0x17,
-	0xc4, 0x4b, 0x3c, 0xdc, 0xb1, 0xad, 0x66, 0xfc, 0x6a, 0x93, 0x37, 0xe3, 0xef, 0x1b, 0x1e, 0x36,
-	0xc9, 0x4a, 0xc3, 0x09, 0x77, 0x7b, 0x35, 0x67, 0x45, 0x92, 0xa6, 0xf3, 0x39, 0xab, 0x92, 0xec,
-	0x52, 0x7f, 0x3e, 0x0c, 0x67, 0xb7, 0x03, 0xc3, 0x0b, 0x3e, 0xe6, 0xe3, 0x77, 0x5e, 0xe0, 0x66,
-	0x97, 0xd0, 0xa3, 0xe1, 0xe7, 0x5d, 0xec, 0x07, 0xe8, 0x3e, 0x8c, 0x78, 0xec, 0x67, 0x55, 0x59,
-	0x51, 0xd6, 0xc6, 0x37, 0x36, 0xea, 0x09, 0xb1, 0x35, 0x3a, 0x56, 0xfd, 0xe0, 0x52, 0x5d, 0x8a,
-	0x44, 0x0b, 0x51, 0xa0, 0x25, 0x18, 0x33, 0xdd, 0xb6, 0x61, 0x39, 0xba, 0x65, 0x56, 0x4b, 0x2b,
-	0xca, 0xda, 0x98, 0x36, 0xca, 0x06, 0x1a, 0x26, 0xfa, 0x4d, 0x98, 0xeb, 0x18, 0x1e, 0x76, 0x02,
-	0x1d, 0x87, 0x08, 0x74, 0xcb, 0x69, 0xb9, 0xd5, 0x32, 0xdd, 0x78, 0x4d, 0xb8, 0xf1, 0x63, 0x0a,
-	0x11, 0xed, 0xd8, 0x70, 0x5a, 0xae, 0x76, 0xba, 0x93, 0x1d, 0x44, 0x55, 0x18, 0x31, 0x82, 0x00,
-	0xb7, 0x3b, 0x41, 0xf5, 0xd4, 0x8a, 0xb2, 0x36, 0xa4, 0x85, 0x8f, 0x68, 0x13, 0xa6, 0xf0, 0x8b,
-	0x8e, 0xc5, 0x54, 0x4c, 0x27, 0xba, 0x54, 0x1d, 0xa2, 0x3b, 0xd6, 0xea, 0x4c, 0x8f, 0xea, 0xa1,
-	0x1e, 0xd5, 0x77, 0x42, 0x45, 0xd3, 0x2a, 0x3d, 0x10, 0x32, 0x88, 0x5a, 0xb0, 0xd8, 0x74, 0x9d,
-	0xc0, 0x72, 0xba, 0x58, 0x37, 0x7c, 0xdd, 0xc1, 0x87, 0xba, 0xe5, 0x58, 0x81, 0x65, 0x04, 0xae,
-	0x57, 0x1d, 0x5e, 0x51, 0xd6, 0x2a, 0x1b, 0x6f, 0x08, 0x0f, 0xb0, 0xc9, 0xa1, 0x6e, 0xf9, 0x0f,
-	0xf1, 0x61, 0x23, 0x04, 0xd1, 0xe6, 0x9b, 0xc2, 0x71, 0xd4, 0x80, 0x99, 0x70, 0xc6, 0xd4, 0x5b,
-	0x86, 0x65, 0x77, 0x3d, 0x5c, 0x1d, 0xa1, 0xe4, 0x9e, 0x11, 0xe2, 0xbf, 0xcb, 0xd6, 0x68, 0xd3,
-	0x11, 0x18, 0x1f, 0x41, 0x1a, 0xcc, 0xdb, 0x86, 0x1f, 0xe8, 0x4d, 0xb7, 0xdd, 0xb1, 0x31, 0x3d,
-	0xbc, 0x87, 0xfd, 0xae, 0x1d, 0x54, 0x47, 0x25, 0xf8, 0x1e, 0x1b, 0x47, 0xb6, 0x6b, 0x98, 0xda,
-	0x2c, 0x81, 0xdd, 0x8c, 0x40, 0x35, 0x0a, 0x89, 0xfe, 0x3f, 0x2c, 0xb5, 0x2c, 0xcf, 0x0f, 0x74,
-	0x13, 0x37, 0x2d, 0x9f, 0xf2, 0xd3, 0xf0, 0x9f, 0xe9, 0xbb, 0x46, 0xf3, 0x99, 0xdb, 0x6a, 0x55,
-	0xc7, 0x28, 0xe2, 0xc5, 0x0c, 0x5f, 0xb7, 0xb8, 0x81, 0xd3, 0xaa, 0x14, 0x7a, 0x8b, 0x03, 0xef,
-	0x18, 0xfe, 0xb3, 0xdb, 0x0c, 0x14, 0x1d, 0xc0, 0x74, 0xc7, 0xf0, 0x02, 0x8b, 0xd2, 0xd9, 0x74,
-	0x9d, 0x96, 0xb5, 0x57, 0x85, 0x95, 0xf2, 0xda, 0xf8, 0xc6, 0xff, 0xab, 0xe7, 0x18, 0x52, 0xb9,
-	0x54, 0x12, 0xd1, 0x61, 0xe8, 0x36, 0x29, 0xb6, 0x3b, 0x4e, 0xe0, 0x1d, 0x69, 0x53, 0x9d, 0xe4,
-	0x68, 0xed, 0x36, 0xcc, 0x8a, 0x16, 0xa2, 0x69, 0x28, 0x3f, 0xc3, 0x47, 0x54, 0x29, 0xc6, 0x34,
-	0xf2, 0x13, 0xcd, 0xc2, 0xd0, 0x81, 0x61, 0x77, 0x31, 0x17, 0x6c, 0xf6, 0x70, 0xbd, 0x74, 0x4d,
-	0x51, 0xaf, 0xc2, 0x72, 0x1e, 0x29, 0x7e, 0xc7, 0x75, 0x7c, 0x8c, 0xe6, 0x60, 0xd8, 0xeb, 0x52,
-	0xad, 0x60, 0x08, 0x87, 0xbc, 0xae, 0xd3, 0x30, 0xd5, 0xbf, 0x29, 0xc1, 0xf2, 0xb6, 0xb5, 0xe7,
-	0x18, 0x76, 0xae, 0x82, 0x3e, 0x48, 0x2b, 0xe8, 0x65, 0xb1, 0x82, 0x4a, 0xb1, 0xf4, 0xa9, 0xa1,
-	0x2d, 0x58, 0xc2, 0x2f, 0x02, 0xec, 0x39, 0x86, 0x1d, 0x19, 0xde, 0x9e, 0xb2, 0x72, 0x3d, 0x7d,
-	0x4d, 0xb8, 0x7f, 0x76, 0xe7, 0xc5, 0x10, 0x55, 0x66, 0x0a, 0xd5, 0xe1, 0x74, 0x73, 0xdf, 0xb2,
-	0xcd, 0xde, 0x26, 0xae, 0x63, 0x1f, 0x51, 0xbd, 0x1d, 0xd5, 0x66, 0xe8, 0x54, 0x08, 0xf4, 0xc8,
-	0xb1, 0x8f, 0xd4, 0x55, 0x38, 0x97, 0x7b, 0x3e, 0xc6, 0x60, 0xf5, 0x17, 0x25, 0xb8, 0xc0, 0xd7,
-	0x58, 0xc1, 0xbe, 0xdc, 0xe6, 0x3d, 0x4d, 0xb3, 0xf4, 0x86, 0x8c, 0xa5, 0x45, 0xe8, 0xfa, 0xe4,
-	0xed, 0x67, 0x8a, 0x40, 0xc0, 0xcb, 0x54, 0xc0, 0x3f, 0xca, 0x17, 0xf0, 0xfe, 0x48, 0xf8, 0x5f,
-	0x14, 0xf5, 0x5b, 0xb0, 0x56, 0x4c, 0x94, 0x5c, 0xe8, 0xbf, 0xa7, 0xc0, 0x59, 0x0d, 0xfb, 0xf8,
-	0xc4, 0x2f, 0x25, 0x29, 0x92, 0xfe, 0xae, 0x85, 0xa8, 0x6e, 0x1e, 0x1a, 0xf9, 0x29, 0xbe, 0x28,
-	0xc1, 0xea, 0x0e, 0xf6, 0xda, 0x96, 0x63, 0x04, 0x38, 0xf7, 0x24, 0x8f, 0xd3, 0x27, 0xb9, 0x22,
-	0x3c, 0x49, 0x21, 0xa2, 0x5f, 0x71, 0x05, 0x7e, 0x15, 0x54, 0xd9, 0x11, 0xb9, 0x0e, 0xff, 0x40,
-	0x81, 0x95, 0x2d, 0xec, 0x37, 0x3d, 0x6b, 0x37, 0x9f, 0xa3, 0x8f, 0xd2, 0x1c, 0x7d, 0x47, 0x78,
-	0x9c, 0x22, 0x3c, 0x7d, 0x8a, 0xc7, 0x7f, 0x95, 0x61, 0x55, 0x82, 0x8a, 0x8b, 0x88, 0x0d, 0x0b,
-	0x3d, 0x97, 0x86, 0xa9, 0x36, 0x7f, 0xe1, 0x49, 0x6d, 0x76, 0x06, 0xe1, 0x66, 0x1c, 0x54, 0x9b,
-	0xc7, 0xc2, 0x71, 0xb4, 0x0b, 0x0b, 0xd9, 0xbb, 0x65, 0x9e, 0x54, 0x89, 0xee, 0x76, 0xb1, 0xbf,
-	0xdd, 0xa8, 0x2f, 0x35, 0x77, 0x28, 0x1a, 0x46, 0x1f, 0x03, 0xea, 0x60, 0xc7, 0xb4, 0x9c, 0x3d,
-	0xdd, 0x68, 0x06, 0xd6, 0x81, 0x15, 0x58, 0xd8, 0xe7, 0xe6, 0x2a, 0xc7, 0x51, 0x63, 0xcb, 0x6f,
-	0xb1, 0xd5, 0x47, 0x14, 0xf9, 0x4c, 0x27, 0x31, 0x68, 0x61, 0x1f, 0xfd, 0x1a, 0x4c, 0x87, 0x88,
-	0xa9, 0x98, 0x78, 0xd8, 0xa9, 0x9e, 0xa2, 0x68, 0xeb, 0x32, 0xb4, 0x9b, 0x64, 0x6d, 0x92, 0xf2,
-	0xa9, 0x4e, 0x6c, 0xca, 0xc3, 0x0e, 0xda, 0xee, 0xa1, 0x0e, 0xbd, 0x13, 0xee, 0xe8, 0x49, 0x29,
-	0x0e, 0x9d, 0x91, 0x04, 0xd2, 0x70, 0x50, 0x7d, 0x01, 0xb3, 0x4f, 0x48, 0xcc, 0x13, 0x72, 0x2f,
-	0x14, 0xc3, 0xcd, 0xb4, 0x18, 0xbe, 0x2e, 0xdc, 0x43, 0x04, 0xdb, 0xa7, 0xe8, 0xfd, 0x48, 0x81,
-	0xb9, 0x14, 0x38, 0x17, 0xb7, 0x9b, 0x30, 0x41, 0xe3, 0xb0, 0xd0, 0x9d, 0x53, 0xfa, 0x70, 0xe7,
-	0xc6, 0x29, 0x04, 0xf7, 0xe2, 0x1a, 0x50, 0x09, 0x11, 0xfc, 0x36, 0x6e, 0x06, 0xd8, 0xe4, 0x82,
-	0xa3, 0xe6, 0x9f, 0x41, 0xe3, 0x2b, 0xb5, 0xc9, 0xe7, 0xf1, 0x47, 0xf5, 0xf7, 0x15, 0xa8, 0x51,
-	0x03, 0xba, 0x1d, 0x58, 0xcd, 0x67, 0x47, 0xc4, 0xa3, 0xbb, 0x6f, 0xf9, 0x41, 0xc8, 0xa6, 0x46,
-	0x9a, 0x4d, 0xeb, 0xf9, 0x96, 0x5c, 0x88, 0xa1, 0x4f, 0x66, 0x9d, 0x85, 0x25, 0x21, 0x0e, 0x6e,
-	0x59, 0x7e, 0x56, 0x82, 0xf9, 0x7b, 0x38, 0x78, 0xd0, 0x0d, 0x8c, 0x5d, 0x1b, 0x6f, 0x07, 0x46,
-	0x80, 0x35, 0x11, 0x5a, 0x25, 0x65, 0x4f, 0x3f, 0x02, 0x24, 0x30, 0xa3, 0xa5, 0x81, 0xcc, 0xe8,
-	0x4c, 0x46, 0xc3, 0xd0, 0x65, 0x98, 0xc7, 0x2f, 0x3a, 0x94, 0x81, 0xba, 0x83, 0x5f, 0x04, 0x3a,
-	0x3e, 0x20, 0x61, 0x91, 0x65, 0x52, 0x0b, 0x5d, 0xd6, 0x4e, 0x87, 0xb3, 0x0f, 0xf1, 0x8b, 0xe0,
-	0x0e, 0x99, 0x6b, 0x98, 0xe8, 0x2d, 0x98, 0x6d, 0x76, 0x3d, 0x1a, 0x3f, 0xed, 0x7a, 0x86, 0xd3,
-	0xdc, 0xd7, 0x03, 0xf7, 0x19, 0xd5, 0x1e, 0x65, 0x6d, 0x42, 0x43, 0x7c, 0xee, 0x36, 0x9d, 0xda,
-	0x21, 0x33, 0xe8, 0x37, 0x60, 0xf6, 0x00, 0x7b, 0xd4, 0x4b, 0xe7, 0x3e, 0x85, 0x6e, 0x05, 0xb8,
-	0xcd, 0x95, 0x22, 0x2d, 0xb0, 0x24, 0x68, 0x25, 0x27, 0x78, 0xca, 0x40, 0x3e, 0x60, 0x10, 0x8d,
-	0x00, 0xb7, 0x35, 0x74, 0x90, 0x19, 0x53, 0xff, 0x61, 0x0c, 0x16, 0x32, 0x2c, 0xe5, 0x02, 0x2a,
-	0x66, 0x9b, 0x72, 0x52, 0xb6, 0xdd, 0x85, 0xc9, 0x08, 0x6d, 0x70, 0xd4, 0xc1, 0xfc, 0x22, 0x56,
-	0xa5, 0x18, 0x77, 0x8e, 0x3a, 0x58, 0x9b, 0x38, 0x8c, 0x3d, 0x21, 0x15, 0x26, 0x45, 0x5c, 0x1f,
-	0x77, 0x62, 0xdc, 0x7e, 0x0a, 0x8b, 0x1d, 0x0f, 0x1f, 0x58, 0x6e, 0xd7, 0xd7, 0x7d, 0xe2, 0xe6,
-	0x60, 0xb3, 0xb7, 0xfe, 0x14, 0xdd, 0x77, 0x29, 0x13, 0xe6, 0x34, 0x9c, 0xe0, 0xca, 0xdb, 0x4f,
-	0x89, 0xaf, 0xa4, 0xcd, 0x87, 0xd0, 0xdb, 0x0c, 0x38, 0xc4, 0xfb, 0x26, 0x9c, 0xa6, 0x41, 0x19,
-	0x8b, 0xa2, 0x22, 0x8c, 0x43, 0x94, 0x82, 0x69, 0x32, 0x75, 0x97, 0xcc, 0x84, 0xcb, 0xaf, 0xc3,
-	0x18, 0x0d, 0xb0, 0x6c, 0xcb, 0x0f, 0x68, 0x98, 0x39, 0xbe, 0x71, 0x56, 0xec, 0x41, 0x84, 0x22,
-	0x3f, 0x1a, 0xf0, 0x5f, 0xe8, 0x1e, 0x4c, 0xfb, 0x54, 0x1d, 0xf4, 0x1e, 0x8a, 0x91, 0x7e, 0x50,
-	0x54, 0xfc, 0x84, 0x16, 0xa1, 0xb7, 0x61, 0xbe, 0x69, 0x5b, 0x84, 0x52, 0xdb, 0xda, 0xf5, 0x0c,
-	0xef, 0x48, 0xe7, 0xf2, 0x40, 0x03, 0xc9, 0x31, 0x6d, 0x96, 0xcd, 0xde, 0x67, 0x93, 0x5c, 0x7e,
-	0x62, 0x50, 0x2d, 0x6c, 0x04, 0x5d, 0x0f, 0x47, 0x50, 0x63, 0x71, 0xa8, 0xbb, 0x6c, 0x32, 0x84,
-	0x3a, 0x07, 0xe3, 0x1c, 0xca, 0x6a, 0x77, 0xec, 0x2a, 0xd0, 0xa5, 0xc0, 0x86, 0x1a, 0xed, 0x8e,
-	0x8d, 0x7c, 0xb8, 0x98, 0x3e, 0x95, 0xee, 0x37, 0xf7, 0xb1, 0xd9, 0xb5, 0xb1, 0x1e, 0xb8, 0xec,
-	0xb2, 0x68, 0x94, 0xef, 0x76, 0x83, 0xea, 0x78, 0x51, 0x40, 0xfa, 0x6a, 0xf2, 0xac, 0xdb, 0x1c,
-	0xd3, 0x8e, 0x4b, 0xef, 0x6d, 0x87, 0xa1, 0x21, 0xfe, 0x0e, 0xbb, 0x2a, 0x22, 0xff, 0xbd, 0x83,
-	0x4c, 0xd0, 0x44, 0xc3, 0x0c, 0x9d, 0xda, 0x26, 0x33, 0xe1, 0x29, 0xf2, 0x74, 0x75, 0x32, 0x57,
-	0x57, 0xef, 0x43, 0x25, 0x92, 0x6d, 0x9f, 0x28, 0x53, 0xb5, 0x42, 0x93, 0x0a, 0xe7, 0x93, 0x57,
-	0xc5, 0x32, 0x3d, 0x71, 0xf9, 0x66, 0x9a, 0x17, 0x29, 0x06, 0x7d, 0x44, 0x4d, 0x98, 0x8d, 0xb0,
-	0x35, 0x6d, 0xd7, 0xc7, 0x1c, 0xe7, 0x14, 0xc5, 0x79, 0xa9, 0x4f, 0x6f, 0x84, 0x00, 0x12, 0x7c,
-	0x5d, 0x5f, 0x8b, 0xf4, 0x39, 0x1a, 0x24, 0x5a, 0x3e, 0x93, 0x34, 0x2f, 0xc4, 0x45, 0x98, 0x16,
-	0xbd, 0x70, 0x7b, 0x54, 0x27, 0x8c, 0x8b, 0x85, 0x7d, 0x6d, 0xfa, 0x20, 0x35, 0x82, 0x6e, 0xc0,
-	0x92, 0x45, 0x74, 0x2e, 0x75, 0xc7, 0xd8, 0x21, 0x76, 0xc6, 0xac, 0xce, 0x50, 0x1f, 0x73, 0xc1,
-	0xf2, 0x93, 0xa6, 0xfe, 0x0e, 0x9b, 0x46, 0xab, 0x30, 0x11, 0xda, 0x3a, 0xdf, 0xfa, 0x14, 0x57,
-	0x11, 0x53, 0x6d, 0x3e, 0xb6, 0x6d, 0x7d, 0x8a, 0xd5, 0x5f, 0x2a, 0xb0, 0xf0, 0xd8, 0xb5, 0xed,
-	0xff, 0x5b, 0x6f, 0x03, 0xf5, 0xc7, 0xa3, 0x50, 0xcd, 0x1e, 0xfb, 0x1b, 0x8b, 0xfd, 0x8d, 0xc5,
-	0xfe, 0x3a, 0x5a, 0xec, 0x3c, 0xfd, 0x98, 0xc8, 0xb5, 0xc0, 0x42, 0x73, 0x36, 0x79, 0x62, 0x73,
-	0xf6, 0xab, 0x67, 0xd8, 0xd5, 0x7f, 0x29, 0xc1, 0x8a, 0x86, 0x9b, 0xae, 0x67, 0xc6, 0x13, 0xb5,
-	0x5c, 0x2d, 0x5e, 0xa6, 0xa5, 0x3c, 0x07, 0xe3, 0x91, 0xe0, 0x44, 0x46, 0x00, 0xc2, 0xa1, 0x86,
-	0x89, 0x16, 0x60, 0x84, 0xca, 0x18, 0xd7, 0xf8, 0xb2, 0x36, 0x4c, 0x1e, 0x1b, 0x26, 0x3a, 0x0b,
-	0xc0, 0xe3, 0x88, 0x50, 0x77, 0xc7, 0xb4, 0x31, 0x3e, 0xd2, 0x30, 0x91, 0x06, 0x13, 0x1d, 0xd7,
-	0xb6, 0xf5, 0x30, 0x56, 0x19, 0x96, 0xc4, 0x2a, 0xc4, 0x86, 0xde, 0x75, 0xbd, 0x38, 0x6b, 0xc2,
-	0x58, 0x65, 0x9c, 0x20, 0xe1, 0x0f, 0xea, 0xef, 0x8d, 0xc2, 0xaa, 0x84, 0x8b, 0xdc, 0xf0, 0x66,
-	0x2c, 0xa4, 0x72, 0x3c, 0x0b, 0x29, 0xb5, 0x7e, 0xa5, 0xe3, 0x5b, 0xbf, 0x6f, 0x01, 0x0a, 0xf9,
-	0x6b, 0xa6, 0xcd, 0xef, 0x74, 0x34, 0x13, 0xae, 0x5e, 0x23, 0x06, 0x4c, 0x60, 0x7a, 0xcb, 0xc4,
-	0x42, 0x25, 0xf0, 0x66, 0x2c, 0xfa, 0x50, 0xd6, 0xa2, 0xc7, 0x4a, 0x3a, 0xc3, 0xc9, 0x92, 0xce,
-	0x35, 0xa8, 0x72, 0x93, 0xd2, 0x4b, 0x80, 0x84, 0x0e, 0xc2, 0x08, 0x75, 0x10, 0xe6, 0xd9, 0x7c,
-	0x24, 0x3b, 0xa1, 0x7f, 0xa0, 0xc1, 0x64, 0x54, 0xba, 0xa0, 0x29, 0x13, 0x56, 0x0b, 0x79, 0x33,
-	0x4f, 0x1b, 0x77, 0x3c, 0xc3, 0xf1, 0x89, 0x29, 0x4b, 0xa4, 0x09, 0x26, 0xcc, 0xd8, 0x13, 0xfa,
-	0x04, 0xce, 0x08, 0x12, 0x32, 0x3d, 0x13, 0x3e, 0xd6, 0x8f, 0x09, 0x5f, 0xcc, 0x88, 0x7b, 0x64,
-	0xcd, 0x73, 0xbc, 0x4f, 0xc8, 0xf3, 0x3e, 0x57, 0x61, 0x22, 0x61, 0xf3, 0xc6, 0xa9, 0xcd, 0x1b,
-	0xdf, 0x8d, 0x19, 0xbb, 0x5b, 0x50, 0xe9, 0x5d, 0x2b, 0x2d, 0x89, 0x4d, 0x14, 0x96, 0xc4, 0x26,
-	0x23, 0x08, 0x5a, 0x11, 0x7b, 0x1f, 0x26, 0xc2, 0xbb, 0xa6, 0x08, 0x26, 0x0b, 0x11, 0x8c, 0xf3,
-	0xf5, 0x14, 0xdc, 0x80, 0x91, 0xe7, 0x5d, 0x4c, 0x8d, 0x6c, 0x85, 0xe6, 0x7f, 0xee, 0xe5, 0x66,
-	0xc1, 0x0b, 0xb5, 0x88, 0xa6, 0x28, 0x2c, 0xec, 0xb3, 0xbc, 0x77, 0x88, 0x37, 0xe3, 0x0b, 0x4e,
-	0x65, 0x7c, 0xc1, 0xda, 0x27, 0x30, 0x11, 0x87, 0x15, 0xa4, 0xc2, 0xaf, 0xc5, 0x53, 0xe1, 0x79,
-	0x29, 0x92, 0x50, 0x31, 0x59, 0xaa, 0x24, 0x96, 0x2e, 0xef, 0x99, 0xd2, 0x30, 0x31, 0xf6, 0x8d,
-	0x29, 0xcd, 0x98, 0xd2, 0x38, 0x6b, 0x84, 0xa6, 0xf4, 0xe7, 0xe5, 0xd0, 0x94, 0x0a, 0xb9, 0xc8,
-	0x4d, 0xe9, 0x87, 0x30, 0x95, 0x32, 0x55, 0x52, 0x63, 0xca, 0x93, 0x19, 0xd4, 0xd8, 0x68, 0x95,
-	0xa4, 0x29, 0xcb, 0x08, 0x77, 0x69, 0x30, 0xe1, 0x8e, 0x59, 0xae, 0x72, 0xd2, 0x72, 0x7d, 0x02,
-	0xcb, 0x49, 0xc5, 0xd3, 0xdd, 0x96, 0x1e, 0xec, 0x5b, 0xbe, 0x1e, 0xaf, 0x5e, 0xcb, 0xb7, 0xaa,
-	0x25, 0x14, 0xf1, 0x51, 0x6b, 0x67, 0xdf, 0xf2, 0x6f, 0x71, 0xfc, 0x0d, 0x98, 0xd9, 0xc7, 0x86,
-	0x17, 0xec, 0x62, 0x23, 0xd0, 0x4d, 0x1c, 0x18, 0x96, 0xed, 0xf3, 0x84, 0x8f, 0x3c, 0x41, 0x38,
-	0x1d, 0x81, 0x6d, 0x31, 0xa8, 0xec, 0xab, 0x69, 0xf8, 0x78, 0xaf, 0xa6, 0x0b, 0x30, 0x15, 0xe1,
-	0x61, 0x62, 0x4d, 0x6d, 0xf4, 0x98, 0x16, 0x39, 0x46, 0x5b, 0x74, 0x54, 0xfd, 0x73, 0x05, 0x5e,
-	0x61, 0xb7, 0x99, 0x50, 0x76, 0x5e, 0x84, 0xee, 0xe9, 0x8b, 0x96, 0x4e, 0x2a, 0x5e, 0xcb, 0x4b,
-	0x2a, 0x16, 0xa1, 0xea, 0x33, 0xbb, 0xf8, 0x77, 0x65, 0x78, 0x55, 0x8e, 0x8d, 0x8b, 0x20, 0xee,
-	0xbd, 0xff, 0x3c, 0x3e, 0xc6, 0x49, 0xbc, 0x7e, 0x7c, 0xeb, 0xa6, 0x4d, 0xf9, 0x29, 0x49, 0xff,
-	0x91, 0x02, 0xcb, 0xbd, 0xb4, 0x3c, 0xf1, 0xa1, 0x4d, 0xcb, 0xef, 0x18, 0x41, 0x73, 0x5f, 0xb7,
-	0xdd, 0xa6, 0x61, 0xdb, 0x47, 0xd5, 0x12, 0xb5, 0xa9, 0x9f, 0x48, 0x76, 0x2d, 0x3e, 0x4e, 0xbd,
-	0x97, 0xb7, 0xdf, 0x71, 0xb7, 0xf8, 0x0e, 0xf7, 0xd9, 0x06, 0xcc, 0xd4, 0x2e, 0x19, 0xf9, 0x2b,
-	0x6a, 0xbf, 0x03, 0x2b, 0x45, 0x08, 0x04, 0xf6, 0x76, 0x2b, 0x69, 0x6f, 0xc5, 0x55, 0x81, 0xd0,
-	0x0c, 0x50, 0x5c, 0x21, 0x62, 0xfa, 0x66, 0x8e, 0xd9, 0xde, 0x1f, 0x28, 0xc4, 0xf6, 0x66, 0x8e,
-	0x79, 0xd7, 0xb0, 0xec, 0x9e, 0x2c, 0xf5, 0x59, 0x4e, 0x2a, 0xc2, 0xd3, 0xa7, 0x20, 0xbd, 0x42,
-	0xec, 0x58, 0x2e, 0x26, 0x9e, 0xac, 0xfe, 0x53, 0x05, 0xd4, 0xac, 0xb5, 0xfb, 0x20, 0x54, 0xcf,
-	0x90, 0xf2, 0x27, 0x69, 0xca, 0xaf, 0xe6, 0x50, 0x5e, 0x84, 0xa9, 0x4f, 0xda, 0x1f, 0x13, 0xe5,
-	0x94, 0xe0, 0xe2, 0xb2, 0xf9, 0x3a, 0x4c, 0x37, 0x0d, 0xa7, 0x89, 0xa3, 0x37, 0x00, 0x66, 0xef,
-	0xb4, 0x51, 0x6d, 0x8a, 0x8d, 0x6b, 0xe1, 0x70, 0x5c, 0xdf, 0xe3, 0x38, 0x4f, 0xa8, 0xef, 0x32,
-	0x54, 0x7d, 0x1e, 0xf5, 0xb5, 0x48, 0xdd, 0x73, 0x90, 0xc5, 0x0a, 0x96, 0x82, 0x85, 0x27, 0x91,
-	0xb0, 0x5c, 0x3c, 0x03, 0x4b, 0x98, 0x08, 0x53, 0x42, 0xc2, 0xb2, 0x07, 0xa4, 0xf7, 0xd3, 0xa3,
-	0xbc, 0x6f, 0x09, 0x2b, 0xc2, 0xd4, 0x27, 0xed, 0xe7, 0xc5, 0xe2, 0x10, 0xe1, 0xe2, 0xd4, 0xff,
-	0xbd, 0x02, 0xe7, 0x34, 0xdc, 0x76, 0x0f, 0x30, 0xeb, 0x44, 0xf8, 0xaa, 0xe4, 0xf1, 0x92, 0x8e,
-	0x51, 0x39, 0xe5, 0x18, 0xa9, 0x2a, 0x91, 0x95, 0x3c, 0xaa, 0xf9, 0xd1, 0xfe, 0xb1, 0x04, 0xe7,
-	0xf9, 0x11, 0xd8, 0xb1, 0x73, 0xcb, 0xe0, 0xd2, 0x03, 0x1a, 0x50, 0x49, 0xea, 0x20, 0x3f, 0xdc,
-	0xf5, 0x9c, 0xfb, 0xeb, 0x63, 0x43, 0x6d, 0x32, 0xa1, 0xbd, 0x68, 0x17, 0x16, 0xa2, 0x4e, 0x03,
-	0x61, 0x3b, 0x9f, 0xb8, 0x08, 0x7d, 0x87, 0xc3, 0xa4, 0x8a, 0xd0, 0x58, 0x34, 0x3c, 0x70, 0x97,
-	0xc1, 0x1a, 0xbc, 0x56, 0x74, 0x16, 0xce, 0xe7, 0x7f, 0x56, 0x60, 0x29, 0x4c, 0x1c, 0x09, 0x02,
-	0xf9, 0x97, 0x22, 0x3e, 0x17, 0x61, 0xc6, 0xf2, 0xf5, 0x64, 0x77, 0x1d, 0xe5, 0xe5, 0xa8, 0x36,
-	0x65, 0xf9, 0x77, 0xe3, 0x7d, 0x73, 0xea, 0x32, 0x9c, 0x11, 0x93, 0xcf, 0xcf, 0xf7, 0x39, 0x75,
-	0x58, 0x88, 0xb1, 0x4e, 0x16, 0xce, 0x33, 0xa6, 0xf5, 0x65, 0x1c, 0x74, 0x15, 0x26, 0x78, 0xeb,
-	0x24, 0x36, 0x63, 0xb9, 0xdc, 0x68, 0xac, 0x61, 0xa2, 0x8f, 0xe1, 0x74, 0x33, 0x24, 0x35, 0xb6,
-	0xf5, 0xa9, 0x81, 0xb6, 0x46, 0x11, 0x8a, 0xde, 0xde, 0xf7, 0x61, 0x3a, 0xd6, 0x0e, 0xc9, 0x82,
-	0x84, 0xa1, 0x7e, 0x83, 0x84, 0xa9, 0x1e, 0x28, 0x8b, 0x12, 0xce, 0x02, 0x84, 0xee, 0x9e, 0x65,
-	0x52, 0xf7, 0xb8, 0xac, 0x8d, 0xf1, 0x91, 0x86, 0xa9, 0x5e, 0x20, 0xca, 0x2c, 0xbd, 0x04, 0x7e,
-	0x5d, 0xff, 0x5e, 0x82, 0xaa, 0xc6, 0x7b, 0x85, 0x31, 0x45, 0xed, 0x3f, 0xdd, 0x78, 0x99, 0x57,
-	0xf4, 0x5b, 0x30, 0x27, 0xaa, 0x1c, 0x87, 0x1d, 0x20, 0x03, 0x94, 0x8e, 0x4f, 0x67, 0x4b, 0xc7,
-	0x3e, 0x7a, 0x07, 0x86, 0x29, 0xeb, 0x7d, 0x7e, 0xa3, 0xe2, 0xd4, 0xc8, 0x96, 0x11, 0x18, 0xb7,
-	0x6d, 0x77, 0x57, 0xe3, 0x8b, 0xd1, 0x26, 0x54, 0x1c, 0x7c, 0xa8, 0x7b, 0x5d, 0x7e, 0x73, 0x61,
-	0x60, 0x53, 0x00, 0x3e, 0xe1, 0xe0, 0x43, 0xad, 0xcb, 0xae, 0xcc, 0x57, 0x97, 0x60, 0x51, 0xc0,
-	0x6a, 0x7e, 0x11, 0xdf, 0x53, 0x60, 0x7e, 0xfb, 0xc8, 0x69, 0x6e, 0xef, 0x1b, 0x9e, 0xc9, 0x33,
-	0xa4, 0xfc, 0x1a, 0xce, 0x43, 0xc5, 0x77, 0xbb, 0x5e, 0x13, 0xeb, 0xbc, 0x85, 0x9c, 0xdf, 0xc5,
-	0x24, 0x1b, 0xdd, 0x64, 0x83, 0x68, 0x11, 0x46, 0x7d, 0x02, 0x1c, 0xbe, 0xdf, 0x86, 0xb4, 0x11,
-	0xfa, 0xdc, 0x30, 0x51, 0x1d, 0x4e, 0xd1, 0x58, 0xb2, 0x5c, 0x18, 0xe0, 0xd1, 0x75, 0xea, 0x22,
-	0x2c, 0x64, 0x68, 0xe1, 0x74, 0xfe, 0x74, 0x08, 0x4e, 0x93, 0xb9, 0xf0, 0x3d, 0xf9, 0x32, 0x65,
-	0xa5, 0x0a, 0x23, 0x61, 0x46, 0x8a, 0x69, 0x72, 0xf8, 0x48, 0x14, 0xbd, 0x17, 0xeb, 0x46, 0x79,
-	0x84, 0x28, 0xef, 0x40, 0x78, 0x92, 0xcd, 0x43, 0x0d, 0x0d, 0x9a, 0x87, 0x92, 0x2b, 0x61, 0x26,
-	0x92, 0x1f, 0x19, 0x2c, 0x92, 0xff, 0x90, 0x57, 0x7f, 0x7a, 0x41, 0x35, 0xc5, 0x32, 0x5a, 0x88,
-	0x65, 0x86, 0x80, 0x45, 0xee, 0x31, 0xc5, 0x75, 0x05, 0x46, 0xc2, 0x88, 0x7c, 0xac, 0x8f, 0x88,
-	0x3c, 0x5c, 0x1c, 0xcf, 0x26, 0x40, 0x32, 0x9b, 0x70, 0x13, 0x26, 0x58, 0x6d, 0x8a, 0x37, 0x8a,
-	0x8f, 0xf7, 0xd1, 0x28, 0x3e, 0x4e, 0x4b, 0x56, 0xbc, 0x47, 0xfc, 0x2d, 0xa0, 0x7d, 0xde, 0xfc,
-	0xd3, 0x09, 0xdd, 0x32, 0xb1, 0x13, 0x58, 0xc1, 0x11, 0xcd, 0x06, 0x8e, 0x69, 0x88, 0xcc, 0x7d,
-	0x4c, 0xa7, 0x1a, 0x7c, 0x06, 0x3d, 0x84, 0xa9, 0x94, 0x69, 0xe0, 0x99, 0xbf, 0xf3, 0x7d, 0x19,
-	0x05, 0xad, 0x92, 0x34, 0x08, 0xea, 0x3c, 0xcc, 0x26, 0x25, 0x99, 0x8b, 0xf8, 0x9f, 0x28, 0xb0,
-	0x14, 0x76, 0xde, 0x7d, 0x45, 0x3c, 0x3c, 0xf5, 0x8f, 0x15, 0x38, 0x23, 0xa6, 0x89, 0x07, 0x3f,
-	0x97, 0x61, 0xbe, 0xcd, 0xc6, 0x59, 0x5d, 0x46, 0xb7, 0x1c, 0xbd, 0x69, 0x34, 0xf7, 0x31, 0xa7,
-	0xf0, 0x74, 0x3b, 0x06, 0xd5, 0x70, 0x36, 0xc9, 0x14, 0x7a, 0x17, 0x16, 0x33, 0x40, 0xa6, 0x11,
-	0x18, 0xbb, 0x86, 0x1f, 0x36, 0xe0, 0xce, 0x27, 0xe1, 0xb6, 0xf8, 0xac, 0x7a, 0x06, 0x6a, 0x21,
-	0x3d, 0x9c, 0x9f, 0x1f, 0xb8, 0x51, 0xeb, 0x94, 0xfa, 0xbb, 0xa5, 0x1e, 0x0b, 0x13, 0xd3, 0x9c,
-	0xda, 0x35, 0x98, 0x76, 0xba, 0xed, 0x5d, 0xec, 0xe9, 0x6e, 0x4b, 0xa7, 0x56, 0xca, 0xa7, 0x74,
-	0x0e, 0x69, 0x15, 0x36, 0xfe, 0xa8, 0x45, 0x8d, 0x8f, 0x4f, 0x98, 0x1d, 0x5a, 0x35, 0x9f, 0xa6,
-	0x16, 0x86, 0xb4, 0x51, 0x6e, 0xd6, 0x7c, 0xd4, 0x80, 0x09, 0x7e, 0x13, 0xec, 0xa8, 0xe2, 0x2e,
-	0xd3, 0x50, 0x1c, 0x58, 0xae, 0x87, 0x9e, 0x9c, 0xfa, 0x7e, 0xe3, 0x66, 0x6f, 0x00, 0x5d, 0x81,
-	0x05, 0xb6, 0x4f, 0xd3, 0x75, 0x02, 0xcf, 0xb5, 0x6d, 0xec, 0x51, 0x9e, 0x74, 0xd9, 0x9b, 0x62,
-	0x4c, 0x9b, 0xa3, 0xd3, 0x9b, 0xd1, 0x2c, 0xb3, 0x8b, 0x54, 0x43, 0x4c, 0xd3, 0xc3, 0xbe, 0xcf,
-	0x13, 0x92, 0xe1, 0xa3, 0x5a, 0x87, 0x19, 0x56, 0xd9, 0x22, 0x70, 0xa1, 0xec, 0xc4, 0x8d, 0xb4,
-	0x92, 0x30, 0xd2, 0xea, 0x2c, 0xa0, 0xf8, 0x7a, 0x2e, 0x8c, 0xff, 0xa9, 0xc0, 0x0c, 0x73, 0xde,
-	0xe3, 0x5e, 0x62, 0x3e, 0x1a, 0x74, 0x83, 0x57, 0x81, 0xa3, 0xa2, 0x77, 0x65, 0xe3, 0x5c, 0x0e,
-	0x43, 0x08, 0x46, 0x9a, 0x35, 0xa3, 0x75, 0x60, 0x9a, 0x31, 0x8b, 0xe5, 0x5e, 0xcb, 0x89, 0xdc,
-	0xeb, 0x26, 0x4c, 0x1d, 0x58, 0xbe, 0xb5, 0x6b, 0xd9, 0x56, 0x70, 0xc4, 0x2c, 0x51, 0x71, 0xba,
-	0xb0, 0xd2, 0x03, 0xa1, 0x66, 0x68, 0x15, 0x26, 0xf8, 0x2b, 0x4c, 0x77, 0x0c, 0x6e, 0x71, 0xc7,
-	0xb4, 0x71, 0x3e, 0xf6, 0xd0, 0x68, 0x63, 0xc2, 0x85, 0xf8, 0x71, 0x39, 0x17, 0xbe, 0x4f, 0xb9,
-	0xe0, 0xe3, 0xe0, 0x49, 0x17, 0x77, 0x71, 0x1f, 0x5c, 0x48, 0xef, 0x54, 0xca, 0xec, 0x94, 0x64,
-	0x54, 0x79, 0x40, 0x46, 0x31, 0x3a, 0x7b, 0x04, 0x71, 0x3a, 0x7f, 0xa8, 0xc0, 0x6c, 0x28, 0xf7,
-	0x5f, 0x19, 0x52, 0x1f, 0xc1, 0x5c, 0x8a, 0x26, 0xae, 0x85, 0x57, 0x60, 0xa1, 0xe3, 0xb9, 0x4d,
-	0xec, 0xfb, 0x96, 0xb3, 0xa7, 0xd3, 0xaf, 0xca, 0x98, 0x1d, 0x20, 0xca, 0x58, 0x26, 0x32, 0xdf,
-	0x9b, 0xa6, 0x90, 0xd4, 0x08, 0xf8, 0xea, 0xe7, 0x0a, 0x9c, 0xbd, 0x87, 0x03, 0xad, 0xf7, 0x8d,
-	0xd9, 0x03, 0xec, 0xfb, 0xc6, 0x1e, 0x8e, 0x5c, 0x96, 0x9b, 0x30, 0x4c, 0x0b, 0x40, 0x0c, 0xd1,
-	0xf8, 0xc6, 0x85, 0x1c, 0x6a, 0x63, 0x28, 0x68, 0x75, 0x48, 0xe3, 0x60, 0x7d, 0x30, 0x85, 0xd8,
-	0x98, 0xe5, 0x3c, 0x2a, 0xf8, 0x01, 0x9f, 0x43, 0x85, 0x71, 0xbd, 0xcd, 0x67, 0x38, 0x39, 0x1f,
-	0xe6, 0x26, 0x27, 0xe5, 0x08, 0xeb, 0x54, 0x37, 0xc3, 0x51, 0x96, 0x88, 0x9c, 0xf4, 0xe3, 0x63,
-	0x35, 0x1b, 0x50, 0x76, 0x51, 0x3c, 0xd9, 0x38, 0xc4, 0x92, 0x8d, 0xdf, 0x49, 0x26, 0x1b, 0x2f,
-	0x16, 0x33, 0x28, 0x22, 0x26, 0x96, 0x68, 0x6c, 0xc3, 0xca, 0x3d, 0x1c, 0x6c, 0xdd, 0x7f, 0x22,
-	0xb9, 0x8b, 0x06, 0x00, 0x53, 0x69, 0xa7, 0xe5, 0x86, 0x0c, 0xe8, 0x63, 0x3b, 0x22, 0x48, 0xd4,
-	0x4c, 0x52, 0xd1, 0x23, 0xbf, 0x7c, 0xf5, 0x05, 0xac, 0x4a, 0xb6, 0xe3, 0x4c, 0xdf, 0x86, 0x99,
-	0xd8, 0xd7, 0x87, 0xb4, 0x18, 0x19, 0x6e, 0xfb, 0x5a, 0x7f, 0xdb, 0x6a, 0xd3, 0x5e, 0x72, 0xc0,
-	0x57, 0xff, 0x55, 0x81, 0x59, 0x0d, 0x1b, 0x9d, 0x8e, 0xcd, 0x22, 0xa2, 0xe8, 0x74, 0xf3, 0x30,
-	0xcc, 0x33, 0xfb, 0xec, 0x3d, 0xc7, 0x9f, 0xe4, 0x1f, 0x2b, 0x88, 0x5f, 0xd2, 0xe5, 0x93, 0xfa,
-	0xa3, 0xc7, 0x0b, 0x2e, 0xd4, 0x05, 0x98, 0x4b, 0x1d, 0x8d, 0x5b, 0x93, 0x9f, 0x28, 0xb0, 0xa4,
-	0xe1, 0x96, 0x87, 0xfd, 0xfd, 0xa8, 0xc8, 0x41, 0xb8, 0xf1, 0x15, 0x3c, 0xbb, 0xba, 0x0c, 0x67,
-	0xc4, 0xa4, 0xf2, 0xb3, 0xbc, 0x0b, 0x0b, 0x9b, 0x6e, 0xd7, 0x21, 0xc2, 0x93, 0x16, 0xd0, 0x65,
-	0x80, 0x96, 0xeb, 0x35, 0xf1, 0x5d, 0x1c, 0x34, 0xf7, 0x79, 0xc6, 0x36, 0x36, 0xa2, 0x1a, 0x50,
-	0xcd, 0x82, 0x72, 0x61, 0xbb, 0x03, 0x23, 0xd8, 0x09, 0x68, 0x2d, 0x97, 0x89, 0xd8, 0x1b, 0x39,
-	0x22, 0xc6, 0xbd, 0x90, 0xad, 0xfb, 0x4f, 0x28, 0x2e, 0x5e, 0xaf, 0xe5, 0xb0, 0xea, 0x4f, 0x4a,
-	0x30, 0xaf, 0x61, 0xc3, 0x14, 0x50, 0xb7, 0x01, 0xa7, 0xa2, 0xee, 0x88, 0xca, 0xc6, 0x72, 0x9e,
-	0x6f, 0x71, 0xff, 0x09, 0xb5, 0xba, 0x74, 0xad, 0x2c, 0x14, 0xcb, 0x06, 0x73, 0x65, 0x51, 0x30,
-	0xb7, 0x03, 0x55, 0xcb, 0x21, 0x2b, 0xac, 0x03, 0xac, 0x63, 0x27, 0xb2, 0x60, 0x7d, 0x76, 0x94,
-	0xcd, 0x45, 0xc0, 0x77, 0x9c, 0xd0, 0x14, 0x35, 0x4c, 0x22, 0x18, 0x1d, 0x82, 0x84, 0xd6, 0xa4,
-	0x87, 0x28, 0x61, 0xa3, 0x64, 0x60, 0xdb, 0xfa, 0x14, 0xa3, 0xd7, 0x60, 0x8a, 0xf6, 0x45, 0xd0,
-	0x15, 0xac, 0x7c, 0x3f, 0x4c, 0xcb, 0xf7, 0xb4, 0x5d, 0xe2, 0xb1, 0xb1, 0x87, 0x59, 0x37, 0xdf,
-	0xdf, 0x96, 0x60, 0x21, 0xc3, 0x2b, 0x7e, 0x1d, 0xc7, 0x61, 0x96, 0xd0, 0x5e, 0x94, 0x4e, 0x66,
-	0x2f, 0xd0, 0x77, 0x61, 0x3e, 0x83, 0x34, 0xcc, 0x11, 0x0e, 0x6a, 0x00, 0x67, 0xd3, 0xd8, 0x69,
-	0x8a, 0x50, 0xc0, 0xae, 0x53, 0x22, 0x76, 0xfd, 0x42, 0x81, 0x85, 0xc7, 0x5d, 0x6f, 0x0f, 0x7f,
-	0xbd, 0x65, 0x4b, 0xad, 0x41, 0x35, 0x7b, 0x4c, 0xae, 0xfc, 0x5f, 0x94, 0x60, 0xe1, 0x01, 0xfe,
-	0xda, 0xf3, 0xe0, 0x7f, 0x46, 0xbf, 0x6e, 0x43, 0x35, 0xcb, 0x2b, 0xae, 0x5f, 0x02, 0x1c, 0x8a,
-	0x08, 0xc7, 0x67, 0x0a, 0x9c, 0x79, 0xe8, 0x06, 0x56, 0xeb, 0x88, 0x84, 0xdb, 0xee, 0x01, 0xf6,
-	0x1e, 0x18, 0x24, 0x96, 0x8e, 0xb8, 0xfe, 0x5d, 0x98, 0x6f, 0xf1, 0x19, 0xbd, 0x4d, 0xa7, 0xf4,
-	0x84, 0xc3, 0x96, 0xa7, 0x1f, 0x49, 0x74, 0xcc, 0x67, 0x9b, 0x6d, 0x65, 0x07, 0x7d, 0xf5, 0x1c,
-	0x9c, 0xcd, 0xa1, 0x80, 0x0b, 0x85, 0x01, 0x4b, 0xf7, 0x70, 0xb0, 0xe9, 0xb9, 0xbe, 0xcf, 0x6f,
-	0x25, 0xf1, 0x72, 0x4b, 0x04, 0x7e, 0x4a, 0x2a, 0xf0, 0x3b, 0x0f, 0x95, 0xc0, 0xf0, 0xf6, 0x70,
-	0x10, 0xdd, 0x32, 0x7b, 0xcd, 0x4d, 0xb2, 0x51, 0x8e, 0x4f, 0xfd, 0x65, 0x19, 0xce, 0x88, 0xf7,
-	0xe0, 0xfc, 0x6c, 0x13, 0x3c, 0xc4, 0x34, 0xec, 0x1e, 0xb1, 0x30, 0x94, 0x1f, 0xff, 0x9e, 0xcc,
-	0x41, 0xcc, 0x45, 0x47, 0x9d, 0x6f, 0xff, 0xf6, 0x11, 0x75, 0x00, 0xd9, 0x1b, 0x66, 0x22, 0x88,
-	0x0d, 0xa1, 0xcf, 0x14, 0x98, 0x6b, 0xd1, 0x82, 0x98, 0xde, 0x34, 0xba, 0x3e, 0xee, 0x6d, 0xcb,
-	0xec, 0xdd, 0x83, 0xe3, 0x6d, 0xcb, 0x6a, 0x6c, 0x9b, 0x04, 0x63, 0x62, 0x73, 0xd4, 0xca, 0x4c,
-	0xd4, 0x3a, 0x30, 0x93, 0xa1, 0x52, 0xe0, 0x9e, 0xde, 0x49, 0xba, 0xa7, 0xeb, 0x39, 0xe2, 0x90,
-	0xa6, 0x89, 0x5f, 0x5e, 0xdc, 0x47, 0xad, 0x75, 0x60, 0x21, 0x87, 0x40, 0xc1, 0xbe, 0x37, 0xe3,
-	0xfb, 0x56, 0x72, 0xd3, 0xbd, 0xf7, 0x70, 0xd0, 0x2b, 0x2e, 0x52, 0xbc, 0x71, 0xaf, 0xf8, 0x3f,
-	0x14, 0x58, 0xe3, 0xe5, 0xbc, 0x0c, 0xd3, 0x32, 0x75, 0x08, 0x49, 0x64, 0xd6, 0x9f, 0x94, 0xa1,
-	0xa7, 0x4c, 0x88, 0xa2, 0xbe, 0x8b, 0x30, 0x57, 0xdd, 0x3f, 0xd3, 0x78, 0xb7, 0xc5, 0x64, 0x10,
-	0x7b, 0xf2, 0xd1, 0xab, 0x30, 0xd9, 0x22, 0x0e, 0xd0, 0x43, 0xcc, 0x7c, 0x29, 0x5e, 0x7e, 0x4a,
-	0x0e, 0xaa, 0x1e, 0xbc, 0xde, 0xc7, 0x59, 0x23, 0x77, 0x69, 0x28, 0xf4, 0xc7, 0x8f, 0x77, 0xad,
-	0x14, 0x5a, 0x7d, 0x87, 0x7e, 0xd3, 0x16, 0x2a, 0x36, 0x7d, 0x49, 0xf6, 0x91, 0x1b, 0x53, 0x03,
-	0xfa, 0xdd, 0x56, 0x12, 0x2c, 0x72, 0x1c, 0xe6, 0x7a, 0x65, 0x97, 0x30, 0x11, 0xd3, 0xe5, 0x7d,
-	0x54, 0x43, 0x5a, 0xaf, 0x26, 0xb3, 0xcd, 0xb2, 0x30, 0x5d, 0x87, 0xe6, 0xc5, 0xc3, 0xaf, 0x2e,
-	0x79, 0x0a, 0x89, 0xe5, 0x87, 0x26, 0xf9, 0x28, 0xcb, 0x20, 0xa9, 0x0d, 0x98, 0xd7, 0x8c, 0x00,
-	0xdb, 0x56, 0xdb, 0x0a, 0x3e, 0xea, 0x98, 0xb1, 0x44, 0xde, 0x3a, 0x9c, 0x32, 0x8d, 0xc0, 0xe0,
-	0xcc, 0x58, 0xca, 0x6b, 0xc4, 0xbc, 0xe5, 0x1c, 0x69, 0x74, 0xa1, 0xfa, 0x21, 0x2c, 0x64, 0x50,
-	0xf1, 0x03, 0x0c, 0x8a, 0x6b, 0xe3, 0x9f, 0xea, 0x00, 0xdc, 0x29, 0xbd, 0xf5, 0xb8, 0x81, 0xfe,
-	0x50, 0x81, 0x79, 0xf1, 0x47, 0xed, 0xe8, 0xca, 0xf1, 0xfe, 0x85, 0xa2, 0x76, 0x75, 0x60, 0x38,
-	0x7e, 0x96, 0x3f, 0x52, 0x60, 0x21, 0xe7, 0x5f, 0x0f, 0xd0, 0xd5, 0xa2, 0x7f, 0x0c, 0xc8, 0xa3,
-	0xe6, 0xda, 0xe0, 0x80, 0x9c, 0x9c, 0x1f, 0x2b, 0xb0, 0x52, 0xf4, 0xe5, 0x3f, 0xfa, 0xce, 0x49,
-	0xff, 0xc9, 0xa0, 0x76, 0xeb, 0x04, 0x18, 0x38, 0xa5, 0xe4, 0x12, 0xc5, 0xdf, 0xf4, 0x4b, 0x2e,
-	0x51, 0xfa, 0x5f, 0x02, 0x92, 0x4b, 0x2c, 0xf8, 0xf3, 0x80, 0x3f, 0x53, 0xa0, 0x96, 0xff, 0xe5,
-	0x3b, 0xca, 0xef, 0x0a, 0x2b, 0xfc, 0x47, 0x80, 0xda, 0x7b, 0xc7, 0x82, 0xe5, 0x74, 0xfd, 0x50,
-	0x81, 0xc5, 0xdc, 0xef, 0xda, 0xd1, 0xbb, 0xb9, 0xa8, 0x8b, 0x3e, 0xab, 0xaf, 0x5d, 0x3f, 0x0e,
-	0x28, 0x27, 0xca, 0x81, 0xc9, 0xc4, 0x07, 0xcf, 0xe8, 0xcd, 0x5c, 0x64, 0xa2, 0xef, 0xaa, 0x6b,
-	0xf5, 0x7e, 0x97, 0xf3, 0xfd, 0x3e, 0x53, 0xe0, 0xb4, 0xe0, 0xab, 0x61, 0x74, 0x59, 0x7e, 0xdb,
-	0xc2, 0xef, 0x94, 0x6b, 0x6f, 0x0f, 0x06, 0xc4, 0x49, 0x08, 0x60, 0x2a, 0xf5, 0x11, 0x2d, 0x5a,
-	0x97, 0xb9, 0x1f, 0x82, 0x4a, 0x48, 0xed, 0xad, 0xfe, 0x01, 0xf8, 0xae, 0x87, 0x30, 0x9d, 0xfe,
-	0x12, 0x0c, 0xe5, 0x63, 0xc9, 0xf9, 0x56, 0xae, 0x76, 0x69, 0x00, 0x88, 0x98, 0xd8, 0xe5, 0xf6,
-	0x3b, 0x4a, 0xc4, 0xae, 0xe8, 0x6b, 0x94, 0xda, 0x09, 0xda, 0x2b, 0xd1, 0x5f, 0x2a, 0x70, 0x46,
-	0xd6, 0x0e, 0x89, 0x6e, 0x1c, 0xb3, 0x8b, 0x92, 0x91, 0xf6, 0xfe, 0x89, 0x7a, 0x30, 0x39, 0xcb,
-	0x72, 0x7a, 0x06, 0xa5, 0x2c, 0x93, 0x77, 0x2c, 0x4a, 0x59, 0x56, 0xd0, 0xa2, 0x18, 0xbb, 0x47,
-	0x41, 0x43, 0x76, 0xe1, 0x3d, 0xe6, 0xb7, 0xc2, 0x17, 0xde, 0xa3, 0xac, 0xff, 0x3b, 0x76, 0x8f,
-	0xc2, 0xb6, 0xbd, 0xe2, 0x7b, 0x94, 0xb5, 0x0e, 0x16, 0xdf, 0xa3, 0xb4, 0x57, 0x30, 0x7e, 0x8f,
-	0xd9, 0xce, 0xbc, 0xe2, 0x7b, 0xcc, 0xed, 0x0b, 0x2c, 0xbe, 0xc7, 0xfc, 0x46, 0x40, 0xf4, 0x17,
-	0x34, 0xb7, 0x99, 0xdb, 0x72, 0x87, 0xde, 0x1b, 0xe8, 0xcc, 0xc9, 0xa6, 0xbf, 0xda, 0x8d, 0xe3,
-	0x01, 0x27, 0x48, 0xcb, 0xed, 0x37, 0x95, 0x92, 0x56, 0xd4, 0xf1, 0x2a, 0x25, 0xad, 0xb8, 0xc5,
-	0xf5, 0xaf, 0x15, 0x58, 0x96, 0x37, 0x9a, 0xa1, 0x6f, 0x4b, 0x36, 0xe8, 0xa3, 0xdb, 0xae, 0x76,
-	0xf3, 0xd8, 0xf0, 0x9c, 0xc6, 0xef, 0x2b, 0x50, 0xcd, 0x6b, 0x37, 0x44, 0xd7, 0x24, 0xd8, 0xa5,
-	0x7d, 0x95, 0xb5, 0x77, 0x8f, 0x01, 0xc9, 0x29, 0xfa, 0x5c, 0x81, 0x59, 0x51, 0xd3, 0x1a, 0xca,
-	0x7f, 0x73, 0x4a, 0x5a, 0xf4, 0x6a, 0xef, 0x0c, 0x08, 0xc5, 0xa9, 0xf8, 0x2b, 0xfa, 0xe7, 0x53,
-	0x92, 0xa6, 0x2c, 0xf4, 0x7e, 0x81, 0x6c, 0xc8, 0x3b, 0xea, 0x6a, 0xdf, 0x3e, 0x2e, 0x38, 0x27,
-	0xf0, 0x53, 0x98, 0xc9, 0xf4, 0x27, 0xa1, 0x4b, 0x12, 0xa4, 0xe2, 0xb6, 0xb1, 0xda, 0xc6, 0x20,
-	0x20, 0x3d, 0x6f, 0x24, 0xd5, 0x71, 0x24, 0xf1, 0x46, 0xc4, 0x7d, 0x52, 0x12, 0x6f, 0x24, 0xa7,
-	0x99, 0x09, 0x3d, 0x83, 0x89, 0x78, 0x07, 0x08, 0xfa, 0x96, 0x14, 0x43, 0xaa, 0xe5, 0xa9, 0xf6,
-	0x66, 0x9f, 0xab, 0x63, 0x52, 0x28, 0x6a, 0xe1, 0x90, 0x48, 0xa1, 0xa4, 0x0b, 0x45, 0x22, 0x85,
-	0xd2, 0x3e, 0x11, 0xe2, 0x79, 0x0a, 0x3a, 0x33, 0x24, 0x9e, 0x67, 0x7e, 0x9b, 0x47, 0xed, 0xed,
-	0xc1, 0x80, 0xa2, 0x4f, 0x55, 0xa0, 0xd7, 0xe8, 0x80, 0x2e, 0xe6, 0xe2, 0xc8, 0x74, 0x4f, 0xd4,
-	0xde, 0xe8, 0x6b, 0x6d, 0x6f, 0x9b, 0x5e, 0x27, 0x81, 0x64, 0x9b, 0x4c, 0x77, 0x85, 0x64, 0x9b,
-	0x6c, 0x6b, 0x02, 0xdb, 0x26, 0x6c, 0x04, 0x90, 0x6e, 0x93, 0x6a, 0x5f, 0x90, 0x6e, 0x93, 0xee,
-	0x2c, 0x20, 0x11, 0x4a, 0xa2, 0x88, 0x2f, 0x89, 0x50, 0x44, 0x0d, 0x08, 0x92, 0x08, 0x45, 0xdc,
-	0x1b, 0x40, 0x42, 0x59, 0x71, 0x31, 0x5c, 0x12, 0xca, 0x4a, 0x9b, 0x02, 0x24, 0xa1, 0x6c, 0x41,
-	0x19, 0x9f, 0x38, 0x30, 0xb9, 0x75, 0x67, 0x89, 0x03, 0x53, 0x54, 0x1a, 0x97, 0x38, 0x30, 0xc5,
-	0x65, 0x6e, 0x07, 0x26, 0x13, 0x55, 0x5b, 0xc9, 0x85, 0x88, 0x0a, 0xd7, 0x92, 0x0b, 0x11, 0x16,
-	0x83, 0xa9, 0xf9, 0x10, 0x55, 0x58, 0x91, 0x2c, 0xfc, 0xcb, 0xad, 0x1d, 0x4b, 0xcc, 0x87, 0xac,
-	0x8c, 0x4b, 0xe2, 0xb7, 0x74, 0x2d, 0x56, 0x12, 0xbf, 0xe5, 0x54, 0x7c, 0x25, 0xf1, 0x5b, 0x6e,
-	0xa1, 0x37, 0x80, 0xa9, 0x54, 0xd1, 0x51, 0xf2, 0x82, 0x10, 0x97, 0x72, 0x25, 0x2f, 0x88, 0xbc,
-	0x7a, 0x26, 0x09, 0x57, 0x53, 0x45, 0x2d, 0x59, 0xb8, 0x2a, 0x2e, 0xf3, 0xc9, 0xc2, 0xd5, 0x9c,
-	0x8a, 0x19, 0xd9, 0x38, 0x5d, 0x04, 0x92, 0x6c, 0x9c, 0x53, 0x5b, 0x93, 0x6c, 0x9c, 0x5b, 0x61,
-	0xfa, 0x03, 0x05, 0xe6, 0x84, 0x75, 0x1b, 0x94, 0x2f, 0x31, 0xb2, 0x4a, 0x53, 0xed, 0xca, 0xa0,
-	0x60, 0x31, 0x79, 0x17, 0x55, 0x3d, 0x24, 0xf2, 0x2e, 0x29, 0x27, 0x49, 0xe4, 0x5d, 0x5a, 0x20,
-	0xfa, 0x42, 0x89, 0xbe, 0x6a, 0xca, 0x4f, 0xaf, 0xa3, 0x5b, 0x45, 0xf1, 0x46, 0x61, 0x19, 0xa2,
-	0x76, 0xfb, 0x24, 0x28, 0x12, 0x29, 0x9d, 0x78, 0x7e, 0x5d, 0x9e, 0xd2, 0x11, 0x24, 0xf0, 0xe5,
-	0x29, 0x1d, 0x61, 0xea, 0x9e, 0x68, 0x66, 0x32, 0x29, 0x2e, 0xd3, 0x4c, 0x61, 0x26, 0x5e, 0xa6,
-	0x99, 0xe2, 0x7c, 0xfb, 0xed, 0x3b, 0x3f, 0xfd, 0x72, 0x59, 0xf9, 0xd9, 0x97, 0xcb, 0xca, 0xbf,
-	0x7d, 0xb9, 0xac, 0xfc, 0xfa, 0xd5, 0x3d, 0x2b, 0xd8, 0xef, 0xee, 0xd6, 0x9b, 0x6e, 0x7b, 0x3d,
-	0xf1, 0xff, 0xe4, 0xf5, 0x3d, 0xec, 0xb0, 0x3f, 0xab, 0x8f, 0xfd, 0x5b, 0xfe, 0x7b, 0xfc, 0xe7,
-	0xc1, 0xa5, 0xdd, 0x61, 0x3a, 0x77, 0xf9, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x7a, 0xca,
-	0x50, 0x59, 0x5f, 0x00, 0x00,
+	// 5177 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3d, 0x4b, 0x6c, 0x1c, 0x57,
+	0x72, 0xe9, 0x19, 0x7e, 0x8b, 0xe4, 0x90, 0x7c, 0xe2, 0x67, 0xd8, 0x94, 0x28, 0xb2, 0x25, 0xd9,
+	0x5c, 0x79, 0x3d, 0x94, 0x28, 0xeb, 0x63, 0x59, 0x5e, 0x9b, 0x22, 0x29, 0x79, 0x1c, 0x52, 0x9f,
+	0x26, 0x45, 0xe7, 0xeb, 0xde, 0xe6, 0xf4, 0x1b, 0xb2, 0xa3, 0x99, 0xee, 0x71, 0x77, 0x0f, 0x25,
+	0x1a, 0x48, 0xe0, 0x64, 0x83, 0x00, 0x59, 0x04, 0xd9, 0x64, 0x91, 0x04, 0x01, 0x02, 0x04, 0x08,
+	0x36, 0x80, 0xb1, 0x46, 0x6e, 0x09, 0x90, 0xc3, 0x22, 0xa7, 0x5c, 0xf6, 0xe8, 0x6b, 0x6e, 0x81,
+	0xb1, 0x7b, 0x48, 0x80, 0xdc, 0xf6, 0x1c, 0x04, 0xef, 0xd3, 0xbf, 0xe9, 0xd7, 0x3d, 0x3d, 0xc3,
+	0x20, 0xf6, 0x3a, 0xbe, 0xb1, 0xdf, 0xab, 0xaa, 0x57, 0xaf, 0x5e, 0x55, 0x75, 0xbd, 0xaa, 0xea,
+	0x21, 0x5c, 0x69, 0x1f, 0x62, 0x67, 0xad, 0xa6, 0x1b, 0xd8, 0xaa, 0xe1, 0xb5, 0x63, 0xd3, 0xf5,
+	0x6c, 0xe7, 0x74, 0xed, 0xe4, 0xfa, 0x9a, 0x8b, 0x9d, 0x13, 0xb3, 0x86, 0x2b, 0x2d, 0xc7, 0xf6,
+	0x6c, 0x34, 0x4f, 0xc0, 0x2a, 0x1c, 0xac, 0xc2, 0xc1, 0x2a, 0x27, 0xd7, 0xe5, 0xa5, 0x23, 0xdb,
+	0x3e, 0x6a, 0xe0, 0x35, 0x0a, 0x76, 0xd8, 0xae, 0xaf, 0x19, 0x6d, 0x47, 0xf7, 0x4c, 0xdb, 0x62,
+	0x88, 0xf2, 0xc5, 0xce, 0x79, 0xcf, 0x6c, 0x62, 0xd7, 0xd3, 0x9b, 0x2d, 0x0e, 0x90, 0x20, 0xf0,
+	0xc2, 0xd1, 0x5b, 0x2d, 0xec, 0xb8, 0x7c, 0x7e, 0x39, 0xc6, 0xa0, 0xde, 0x32, 0x09, 0x73, 0x35,
+	0xbb, 0xd9, 0x0c, 0x96, 0x58, 0x11, 0x41, 0xf8, 0x2c, 0x72, 0x2e, 0x44, 0x20, 0x1f, 0xb5, 0x71,
+	0x00, 0xa0, 0x88, 0x00, 0x3c, 0xdd, 0x7d, 0xde, 0x30, 0x5d, 0x2f, 0x0b, 0xe6, 0x85, 0xed, 0x3c,
+	0xaf, 0x37, 0xec, 0x17, 0x1c, 0xe6, 0xaa, 0x08, 0x86, 0x8b, 0x52, 0xeb, 0x80, 0x5d, 0xed, 0x06,
+	0x8b, 0x1d, 0x0e, 0x79, 0x29, 0x0e, 0x69, 0x34, 0x4d, 0x8b, 0x4a, 0xa1, 0xd1, 0x76, 0xbd, 0x6e,
+	0x40, 0x71, 0x41, 0xac, 0x88, 0x81, 0x3e, 0x6a, 0xe3, 0x36, 0x3f, 0x6a, 0xf9, 0x55, 0x31, 0x88,
+	0x83, 0x5b, 0x0d, 0xb3, 0x16, 0x3d, 0xda, 0xf8, 0xc9, 0xb8, 0xc7, 0xba, 0x83, 0x0d, 0x02, 0xa9,
+	0x5b, 0xfe, 0x6a, 0x97, 0x53, 0x20, 0xe2, 0x3c, 0x5d, 0x49, 0x81, 0x8a, 0x8b, 0x4b, 0xf9, 0xd9,
+	0x10, 0x5c, 0xd8, 0xf3, 0x74, 0xc7, 0xfb, 0x80, 0x8f, 0x6f, 0xbf, 0xc4, 0xb5, 0x36, 0xe1, 0x47,
+	0xc5, 0x1f, 0xb5, 0xb1, 0xeb, 0xa1, 0x1d, 0x18, 0x76, 0xd8, 0x9f, 0x65, 0x69, 0x59, 0x5a, 0x1d,
+	0x5b, 0x5f, 0xaf, 0xc4, 0xd4, 0x56, 0x6f, 0x99, 0x95, 0x93, 0xeb, 0x95, 0x4c, 0x22, 0xaa, 0x4f,
+	0x02, 0x2d, 0xc2, 0xa8, 0x61, 0x37, 0x75, 0xd3, 0xd2, 0x4c, 0xa3, 0x5c, 0x58, 0x96, 0x56, 0x47,
+	0xd5, 0x11, 0x36, 0x50, 0x35, 0xd0, 0x6f, 0xc1, 0x6c, 0x4b, 0x77, 0xb0, 0xe5, 0x69, 0xd8, 0x27,
+	0xa0, 0x99, 0x56, 0xdd, 0x2e, 0x17, 0xe9, 0xc2, 0xab, 0xc2, 0x85, 0x9f, 0x50, 0x8c, 0x60, 0xc5,
+	0xaa, 0x55, 0xb7, 0xd5, 0x73, 0xad, 0xe4, 0x20, 0x2a, 0xc3, 0xb0, 0xee, 0x79, 0xb8, 0xd9, 0xf2,
+	0xca, 0x03, 0xcb, 0xd2, 0xea, 0xa0, 0xea, 0x3f, 0xa2, 0x4d, 0x98, 0xc4, 0x2f, 0x5b, 0x26, 0x33,
+	0x31, 0x8d, 0xd8, 0x52, 0x79, 0x90, 0xae, 0x28, 0x57, 0x98, 0x1d, 0x55, 0x7c, 0x3b, 0xaa, 0xec,
+	0xfb, 0x86, 0xa6, 0x96, 0x42, 0x14, 0x32, 0x88, 0xea, 0xb0, 0x50, 0xb3, 0x2d, 0xcf, 0xb4, 0xda,
+	0x58, 0xd3, 0x5d, 0xcd, 0xc2, 0x2f, 0x34, 0xd3, 0x32, 0x3d, 0x53, 0xf7, 0x6c, 0xa7, 0x3c, 0xb4,
+	0x2c, 0xad, 0x96, 0xd6, 0x5f, 0x13, 0x6e, 0x60, 0x93, 0x63, 0x6d, 0xb8, 0x8f, 0xf0, 0x8b, 0xaa,
+	0x8f, 0xa2, 0xce, 0xd5, 0x84, 0xe3, 0xa8, 0x0a, 0xd3, 0xfe, 0x8c, 0xa1, 0xd5, 0x75, 0xb3, 0xd1,
+	0x76, 0x70, 0x79, 0x98, 0xb2, 0x7b, 0x5e, 0x48, 0xff, 0x01, 0x83, 0x51, 0xa7, 0x02, 0x34, 0x3e,
+	0x82, 0x54, 0x98, 0x6b, 0xe8, 0xae, 0xa7, 0xd5, 0xec, 0x66, 0xab, 0x81, 0xe9, 0xe6, 0x1d, 0xec,
+	0xb6, 0x1b, 0x5e, 0x79, 0x24, 0x83, 0xde, 0x13, 0xfd, 0xb4, 0x61, 0xeb, 0x86, 0x3a, 0x43, 0x70,
+	0x37, 0x03, 0x54, 0x95, 0x62, 0xa2, 0x5f, 0x83, 0xc5, 0xba, 0xe9, 0xb8, 0x9e, 0x66, 0xe0, 0x9a,
+	0xe9, 0x52, 0x79, 0xea, 0xee, 0x73, 0xed, 0x50, 0xaf, 0x3d, 0xb7, 0xeb, 0xf5, 0xf2, 0x28, 0x25,
+	0xbc, 0x90, 0x90, 0xeb, 0x16, 0x77, 0x70, 0x6a, 0x99, 0x62, 0x6f, 0x71, 0xe4, 0x7d, 0xdd, 0x7d,
+	0x7e, 0x9f, 0xa1, 0xa2, 0x13, 0x98, 0x6a, 0xe9, 0x8e, 0x67, 0x52, 0x3e, 0x6b, 0xb6, 0x55, 0x37,
+	0x8f, 0xca, 0xb0, 0x5c, 0x5c, 0x1d, 0x5b, 0xff, 0xd5, 0x4a, 0x8a, 0x23, 0xcd, 0xd6, 0xca, 0xca,
+	0x13, 0x9f, 0xdc, 0x26, 0xa5, 0xb6, 0x6d, 0x79, 0xce, 0xa9, 0x3a, 0xd9, 0x8a, 0x8f, 0xca, 0xf7,
+	0x61, 0x46, 0x04, 0x88, 0xa6, 0xa0, 0xf8, 0x1c, 0x9f, 0x52, 0xa3, 0x18, 0x55, 0xc9, 0x9f, 0x68,
+	0x06, 0x06, 0x4f, 0xf4, 0x46, 0x1b, 0x73, 0xc5, 0x66, 0x0f, 0x77, 0x0b, 0x77, 0x24, 0xe5, 0x36,
+	0x2c, 0xa5, 0xb1, 0xe2, 0xb6, 0x6c, 0xcb, 0xc5, 0x68, 0x16, 0x86, 0x9c, 0x36, 0xb5, 0x0a, 0x46,
+	0x70, 0xd0, 0x69, 0x5b, 0x55, 0x43, 0xf9, 0xfb, 0x02, 0x2c, 0xed, 0x99, 0x47, 0x96, 0xde, 0x48,
+	0x35, 0xd0, 0xdd, 0x4e, 0x03, 0xbd, 0x21, 0x36, 0xd0, 0x4c, 0x2a, 0x39, 0x2d, 0xb4, 0x0e, 0x8b,
+	0xf8, 0xa5, 0x87, 0x1d, 0x4b, 0x6f, 0x04, 0x8e, 0x37, 0x34, 0x56, 0x6e, 0xa7, 0xaf, 0x08, 0xd7,
+	0x4f, 0xae, 0xbc, 0xe0, 0x93, 0x4a, 0x4c, 0xa1, 0x0a, 0x9c, 0xab, 0x1d, 0x9b, 0x0d, 0x23, 0x5c,
+	0xc4, 0xb6, 0x1a, 0xa7, 0xd4, 0x6e, 0x47, 0xd4, 0x69, 0x3a, 0xe5, 0x23, 0x3d, 0xb6, 0x1a, 0xa7,
+	0xca, 0x0a, 0x5c, 0x4c, 0xdd, 0x1f, 0x13, 0xb0, 0xf2, 0xf3, 0x02, 0xbc, 0xca, 0x61, 0x4c, 0xef,
+	0x38, 0xdb, 0xe7, 0x1d, 0x74, 0x8a, 0xf4, 0x5e, 0x96, 0x48, 0xbb, 0x91, 0xcb, 0x29, 0xdb, 0x4f,
+	0x24, 0x81, 0x82, 0x17, 0xa9, 0x82, 0x3f, 0x4b, 0x57, 0xf0, 0x7c, 0x2c, 0xfc, 0x1f, 0xaa, 0xfa,
+	0x06, 0xac, 0x76, 0x67, 0x2a, 0x5b, 0xe9, 0xbf, 0x2f, 0xc1, 0x05, 0x15, 0xbb, 0xf8, 0xcc, 0x2f,
+	0xa5, 0x4c, 0x22, 0xf9, 0x8e, 0x85, 0x98, 0x6e, 0x1a, 0x99, 0xec, 0x5d, 0x7c, 0x56, 0x80, 0x95,
+	0x7d, 0xec, 0x34, 0x4d, 0x4b, 0xf7, 0x70, 0xea, 0x4e, 0x9e, 0x74, 0xee, 0xe4, 0x96, 0x70, 0x27,
+	0x5d, 0x09, 0xfd, 0x92, 0x1b, 0xf0, 0x65, 0x50, 0xb2, 0xb6, 0xc8, 0x6d, 0xf8, 0xcf, 0x24, 0x58,
+	0xde, 0xc2, 0x6e, 0xcd, 0x31, 0x0f, 0xd3, 0x25, 0xfa, 0xb8, 0x53, 0xa2, 0x37, 0x85, 0xdb, 0xe9,
+	0x46, 0x27, 0xa7, 0x7a, 0xfc, 0x77, 0x11, 0x56, 0x32, 0x48, 0x71, 0x15, 0x69, 0xc0, 0x7c, 0x18,
+	0xd2, 0x30, 0xd3, 0xe6, 0x2f, 0xbc, 0x4c, 0x9f, 0x9d, 0x20, 0xb8, 0x19, 0x45, 0x55, 0xe7, 0xb0,
+	0x70, 0x1c, 0x1d, 0xc2, 0x7c, 0xf2, 0x6c, 0x59, 0x24, 0x55, 0xa0, 0xab, 0x5d, 0xcd, 0xb7, 0x1a,
+	0x8d, 0xa5, 0x66, 0x5f, 0x88, 0x86, 0xd1, 0x07, 0x80, 0x5a, 0xd8, 0x32, 0x4c, 0xeb, 0x48, 0xd3,
+	0x6b, 0x9e, 0x79, 0x62, 0x7a, 0x26, 0x76, 0xb9, 0xbb, 0x4a, 0x09, 0xd4, 0x18, 0xf8, 0x06, 0x83,
+	0x3e, 0xa5, 0xc4, 0xa7, 0x5b, 0xb1, 0x41, 0x13, 0xbb, 0xe8, 0xd7, 0x61, 0xca, 0x27, 0x4c, 0xd5,
+	0xc4, 0xc1, 0x56, 0x79, 0x80, 0x92, 0xad, 0x64, 0x91, 0xdd, 0x24, 0xb0, 0x71, 0xce, 0x27, 0x5b,
+	0x91, 0x29, 0x07, 0x5b, 0x68, 0x2f, 0x24, 0xed, 0x47, 0x27, 0x3c, 0xd0, 0xcb, 0xe4, 0xd8, 0x0f,
+	0x46, 0x62, 0x44, 0xfd, 0x41, 0xe5, 0x25, 0xcc, 0x3c, 0x25, 0x77, 0x1e, 0x5f, 0x7a, 0xbe, 0x1a,
+	0x6e, 0x76, 0xaa, 0xe1, 0xb7, 0x84, 0x6b, 0x88, 0x70, 0x73, 0xaa, 0xde, 0x8f, 0x24, 0x98, 0xed,
+	0x40, 0xe7, 0xea, 0xf6, 0x0e, 0x8c, 0xd3, 0x7b, 0x98, 0x1f, 0xce, 0x49, 0x39, 0xc2, 0xb9, 0x31,
+	0x8a, 0xc1, 0xa3, 0xb8, 0x2a, 0x94, 0x7c, 0x02, 0xbf, 0x83, 0x6b, 0x1e, 0x36, 0xb8, 0xe2, 0x28,
+	0xe9, 0x7b, 0x50, 0x39, 0xa4, 0x3a, 0xf1, 0x51, 0xf4, 0x51, 0xf9, 0x43, 0x09, 0x64, 0xea, 0x40,
+	0xf7, 0x3c, 0xb3, 0xf6, 0xfc, 0x94, 0x44, 0x74, 0x3b, 0xa6, 0xeb, 0xf9, 0x62, 0xaa, 0x76, 0x8a,
+	0x69, 0x2d, 0xdd, 0x93, 0x0b, 0x29, 0xe4, 0x14, 0xd6, 0x05, 0x58, 0x14, 0xd2, 0xe0, 0x9e, 0xe5,
+	0xf3, 0x02, 0xcc, 0x3d, 0xc4, 0xde, 0x6e, 0xdb, 0xd3, 0x0f, 0x1b, 0x78, 0xcf, 0xd3, 0x3d, 0xac,
+	0x8a, 0xc8, 0x4a, 0x1d, 0xfe, 0xf4, 0x19, 0x20, 0x81, 0x1b, 0x2d, 0xf4, 0xe4, 0x46, 0xa7, 0x13,
+	0x16, 0x86, 0x6e, 0xc0, 0x1c, 0x7e, 0xd9, 0xa2, 0x02, 0xd4, 0x2c, 0xfc, 0xd2, 0xd3, 0xf0, 0x09,
+	0xb9, 0x16, 0x99, 0x06, 0xf5, 0xd0, 0x45, 0xf5, 0x9c, 0x3f, 0xfb, 0x08, 0xbf, 0xf4, 0xb6, 0xc9,
+	0x5c, 0xd5, 0x40, 0xd7, 0x60, 0xa6, 0xd6, 0x76, 0xe8, 0xfd, 0xe9, 0xd0, 0xd1, 0xad, 0xda, 0xb1,
+	0xe6, 0xd9, 0xcf, 0xa9, 0xf5, 0x48, 0xab, 0xe3, 0x2a, 0xe2, 0x73, 0xf7, 0xe9, 0xd4, 0x3e, 0x99,
+	0x41, 0xbf, 0x09, 0x33, 0x27, 0xd8, 0xa1, 0x51, 0x3a, 0x8f, 0x29, 0x34, 0xd3, 0xc3, 0xcd, 0xf2,
+	0xa0, 0x50, 0x61, 0xc9, 0xa5, 0x95, 0xec, 0xe0, 0x80, 0xa1, 0xbc, 0xc7, 0x30, 0xaa, 0x1e, 0x6e,
+	0xaa, 0xe8, 0x24, 0x31, 0xa6, 0xfc, 0xf3, 0x28, 0xcc, 0x27, 0x44, 0xca, 0x15, 0x54, 0x2c, 0x36,
+	0xe9, 0xac, 0x62, 0x7b, 0x00, 0x13, 0x01, 0x59, 0xef, 0xb4, 0x85, 0xf9, 0x41, 0xac, 0x64, 0x52,
+	0xdc, 0x3f, 0x6d, 0x61, 0x75, 0xfc, 0x45, 0xe4, 0x09, 0x29, 0x30, 0x21, 0x92, 0xfa, 0x98, 0x15,
+	0x91, 0xf6, 0x01, 0x2c, 0xb4, 0x1c, 0x7c, 0x62, 0xda, 0x6d, 0x57, 0x73, 0x3d, 0xdd, 0x21, 0x47,
+	0x15, 0xc0, 0x0f, 0xd0, 0x75, 0x17, 0x13, 0xd7, 0x9c, 0xaa, 0xe5, 0xdd, 0x7a, 0xe3, 0x80, 0xc4,
+	0x4a, 0xea, 0x9c, 0x8f, 0xbd, 0xc7, 0x90, 0x7d, 0xba, 0xaf, 0xc3, 0x39, 0x7a, 0x29, 0x63, 0xb7,
+	0xa8, 0x80, 0xe2, 0x20, 0xe5, 0x60, 0x8a, 0x4c, 0x3d, 0x20, 0x33, 0x3e, 0xf8, 0x5d, 0x18, 0xa5,
+	0x17, 0x2c, 0x92, 0x52, 0xa1, 0xd7, 0xcc, 0xb1, 0xf5, 0x0b, 0xe2, 0x08, 0xc2, 0x57, 0xf9, 0x11,
+	0x8f, 0xff, 0x85, 0x1e, 0xc2, 0x94, 0x4b, 0xcd, 0x41, 0x0b, 0x49, 0x0c, 0xe7, 0x21, 0x51, 0x72,
+	0x63, 0x56, 0x84, 0xde, 0x80, 0xb9, 0x5a, 0xc3, 0x24, 0x9c, 0x36, 0xcc, 0x43, 0x47, 0x77, 0x4e,
+	0x35, 0xae, 0x0f, 0xf4, 0x22, 0x39, 0xaa, 0xce, 0xb0, 0xd9, 0x1d, 0x36, 0xc9, 0xf5, 0x27, 0x82,
+	0x55, 0xc7, 0xba, 0xd7, 0x76, 0x70, 0x80, 0x35, 0x1a, 0xc5, 0x7a, 0xc0, 0x26, 0x7d, 0xac, 0x8b,
+	0x30, 0xc6, 0xb1, 0xcc, 0x66, 0xab, 0x51, 0x06, 0x0a, 0x0a, 0x6c, 0xa8, 0xda, 0x6c, 0x35, 0x90,
+	0x0b, 0x57, 0x3b, 0x77, 0xa5, 0xb9, 0xb5, 0x63, 0x6c, 0xb4, 0x1b, 0x58, 0xf3, 0x6c, 0x76, 0x58,
+	0xf4, 0x96, 0x6f, 0xb7, 0xbd, 0xf2, 0x58, 0xb7, 0x0b, 0xe9, 0xe5, 0xf8, 0x5e, 0xf7, 0x38, 0xa5,
+	0x7d, 0x9b, 0x9e, 0xdb, 0x3e, 0x23, 0x43, 0xe2, 0x1d, 0x76, 0x54, 0x44, 0xff, 0xc3, 0x8d, 0x8c,
+	0xd3, 0x44, 0xc3, 0x34, 0x9d, 0xda, 0xf3, 0xec, 0x70, 0x17, 0x69, 0xb6, 0x3a, 0x91, 0x6a, 0xab,
+	0x3b, 0x50, 0x0a, 0x74, 0xdb, 0xf5, 0x74, 0x0f, 0x97, 0x4b, 0x34, 0xa9, 0x70, 0x25, 0x7e, 0x54,
+	0x2c, 0xd3, 0x13, 0xd5, 0x6f, 0x66, 0x79, 0x13, 0x2f, 0xa2, 0x8f, 0xa8, 0x06, 0x33, 0x01, 0xb5,
+	0x5a, 0xc3, 0x76, 0x31, 0xa7, 0x39, 0x49, 0x69, 0x5e, 0xcf, 0x19, 0x8d, 0x10, 0x44, 0x42, 0xaf,
+	0xed, 0xaa, 0x81, 0x3d, 0x07, 0x83, 0xc4, 0xca, 0xa7, 0xe3, 0xee, 0x85, 0x84, 0x08, 0x53, 0xa2,
+	0x17, 0x6e, 0xc8, 0x75, 0xcc, 0xb9, 0x98, 0xd8, 0x55, 0xa7, 0x4e, 0x3a, 0x46, 0xd0, 0x3d, 0x58,
+	0x34, 0x5d, 0x8d, 0x1d, 0x4b, 0xe4, 0x8c, 0xb1, 0x45, 0xfc, 0x8c, 0x51, 0x9e, 0xa6, 0x31, 0xe6,
+	0xbc, 0xe9, 0xc6, 0x5d, 0xfd, 0x36, 0x9b, 0x46, 0x2b, 0x30, 0xee, 0xfb, 0x3a, 0xd7, 0xfc, 0x18,
+	0x97, 0x11, 0x33, 0x6d, 0x3e, 0xb6, 0x67, 0x7e, 0x8c, 0x95, 0x5f, 0x48, 0x30, 0xff, 0xc4, 0x6e,
+	0x34, 0xfe, 0x7f, 0xbd, 0x0d, 0x94, 0x4f, 0x47, 0xa0, 0x9c, 0xdc, 0xf6, 0x37, 0x1e, 0xfb, 0x1b,
+	0x8f, 0xfd, 0x75, 0xf4, 0xd8, 0x69, 0xf6, 0x31, 0x9e, 0xea, 0x81, 0x85, 0xee, 0x6c, 0xe2, 0xcc,
+	0xee, 0xec, 0x97, 0xcf, 0xb1, 0x2b, 0xff, 0x5a, 0x80, 0x65, 0x15, 0xd7, 0x6c, 0xc7, 0x88, 0x26,
+	0x6a, 0xb9, 0x59, 0x7c, 0x99, 0x9e, 0xf2, 0x22, 0x8c, 0x05, 0x8a, 0x13, 0x38, 0x01, 0xf0, 0x87,
+	0xaa, 0x06, 0x9a, 0x87, 0x61, 0xaa, 0x63, 0xdc, 0xe2, 0x8b, 0xea, 0x10, 0x79, 0xac, 0x1a, 0xe8,
+	0x02, 0x00, 0xbf, 0x47, 0xf8, 0xb6, 0x3b, 0xaa, 0x8e, 0xf2, 0x91, 0xaa, 0x81, 0x54, 0x18, 0x6f,
+	0xd9, 0x8d, 0x86, 0xc6, 0x47, 0xca, 0x43, 0x19, 0x77, 0x15, 0xe2, 0x43, 0x1f, 0xd8, 0x4e, 0x54,
+	0x34, 0xfe, 0x5d, 0x65, 0x8c, 0x10, 0xe1, 0x0f, 0xca, 0x1f, 0x8c, 0xc0, 0x4a, 0x86, 0x14, 0xb9,
+	0xe3, 0x4d, 0x78, 0x48, 0xa9, 0x3f, 0x0f, 0x99, 0xe9, 0xfd, 0x0a, 0xfd, 0x7b, 0xbf, 0x6f, 0x03,
+	0xf2, 0xe5, 0x6b, 0x74, 0xba, 0xdf, 0xa9, 0x60, 0xc6, 0x87, 0x5e, 0x25, 0x0e, 0x4c, 0xe0, 0x7a,
+	0x8b, 0x6a, 0x89, 0x8f, 0xfb, 0x90, 0x09, 0x8f, 0x3e, 0x98, 0xf4, 0xe8, 0x91, 0x92, 0xce, 0x50,
+	0xbc, 0xa4, 0x73, 0x07, 0xca, 0xdc, 0xa5, 0x84, 0x09, 0x10, 0x3f, 0x40, 0x18, 0xa6, 0x01, 0xc2,
+	0x1c, 0x9b, 0x0f, 0x74, 0xc7, 0x8f, 0x0f, 0x54, 0x98, 0x08, 0x4a, 0x17, 0x34, 0x65, 0xc2, 0x6a,
+	0x21, 0xaf, 0xa7, 0x59, 0xe3, 0xbe, 0xa3, 0x5b, 0x2e, 0x71, 0x65, 0xb1, 0x34, 0xc1, 0xb8, 0x11,
+	0x79, 0x42, 0x1f, 0xc2, 0x79, 0x41, 0x42, 0x26, 0x74, 0xe1, 0xa3, 0x79, 0x5c, 0xf8, 0x42, 0x42,
+	0xdd, 0xfd, 0xa9, 0xb4, 0xe8, 0x13, 0xd2, 0xa2, 0xcf, 0x15, 0x18, 0x8f, 0xf9, 0xbc, 0x31, 0xea,
+	0xf3, 0xc6, 0x0e, 0x23, 0xce, 0x6e, 0x03, 0x4a, 0xe1, 0xb1, 0xd2, 0x92, 0xd8, 0x78, 0xd7, 0x92,
+	0xd8, 0x44, 0x80, 0x41, 0xc6, 0xd0, 0xdb, 0x30, 0xee, 0x9f, 0x35, 0x25, 0x30, 0xd1, 0x95, 0xc0,
+	0x18, 0x87, 0xa7, 0xe8, 0x3a, 0x0c, 0x93, 0x4c, 0x02, 0x71, 0xb2, 0x25, 0x9a, 0xff, 0x79, 0x98,
+	0x9a, 0x05, 0xef, 0x6a, 0x45, 0x34, 0x45, 0x61, 0x62, 0x97, 0xe5, 0xbd, 0x7d, 0xba, 0x89, 0x58,
+	0x70, 0x32, 0x11, 0x0b, 0xca, 0x1f, 0xc2, 0x78, 0x14, 0x57, 0x90, 0x0a, 0xbf, 0x13, 0x4d, 0x85,
+	0xa7, 0xa5, 0x48, 0x7c, 0xc3, 0x64, 0xa9, 0x92, 0x48, 0xba, 0x3c, 0x74, 0xa5, 0x7e, 0x62, 0xec,
+	0x1b, 0x57, 0x9a, 0x70, 0xa5, 0x51, 0xd1, 0x08, 0x5d, 0xe9, 0xcf, 0x8a, 0xbe, 0x2b, 0x15, 0x4a,
+	0x91, 0xbb, 0xd2, 0xf7, 0x61, 0xb2, 0xc3, 0x55, 0x65, 0x3a, 0x53, 0x9e, 0xcc, 0xa0, 0xce, 0x46,
+	0x2d, 0xc5, 0x5d, 0x59, 0x42, 0xb9, 0x0b, 0xbd, 0x29, 0x77, 0xc4, 0x73, 0x15, 0xe3, 0x9e, 0xeb,
+	0x43, 0x58, 0x8a, 0x1b, 0x9e, 0x66, 0xd7, 0x35, 0xef, 0xd8, 0x74, 0xb5, 0x68, 0xf5, 0x3a, 0x7b,
+	0x29, 0x39, 0x66, 0x88, 0x8f, 0xeb, 0xfb, 0xc7, 0xa6, 0xbb, 0xc1, 0xe9, 0x57, 0x61, 0xfa, 0x18,
+	0xeb, 0x8e, 0x77, 0x88, 0x75, 0x4f, 0x33, 0xb0, 0xa7, 0x9b, 0x0d, 0xb7, 0x3c, 0x98, 0x23, 0x41,
+	0x38, 0x15, 0xa0, 0x6d, 0x31, 0xac, 0xe4, 0xab, 0x69, 0xa8, 0xbf, 0x57, 0xd3, 0xab, 0x30, 0x19,
+	0xd0, 0x61, 0x6a, 0x4d, 0x7d, 0xf4, 0xa8, 0x1a, 0x04, 0x46, 0x5b, 0x74, 0x54, 0xf9, 0x2b, 0x09,
+	0x2e, 0xb1, 0xd3, 0x8c, 0x19, 0x3b, 0x2f, 0x42, 0x87, 0xf6, 0xa2, 0x76, 0x26, 0x15, 0xef, 0xa4,
+	0x25, 0x15, 0xbb, 0x91, 0xca, 0x99, 0x5d, 0xfc, 0xc7, 0x22, 0x5c, 0xce, 0xa6, 0xc6, 0x55, 0x10,
+	0x87, 0xef, 0x3f, 0x87, 0x8f, 0x71, 0x16, 0xef, 0xf6, 0xef, 0xdd, 0xd4, 0x49, 0xb7, 0x43, 0xd3,
+	0x7f, 0x24, 0xc1, 0x52, 0x98, 0x96, 0x27, 0x31, 0xb4, 0x61, 0xba, 0x2d, 0xdd, 0xab, 0x1d, 0x6b,
+	0x0d, 0xbb, 0xa6, 0x37, 0x1a, 0xa7, 0xe5, 0x02, 0xf5, 0xa9, 0x1f, 0x66, 0xac, 0xda, 0x7d, 0x3b,
+	0x95, 0x30, 0x6f, 0xbf, 0x6f, 0x6f, 0xf1, 0x15, 0x76, 0xd8, 0x02, 0xcc, 0xd5, 0x2e, 0xea, 0xe9,
+	0x10, 0xf2, 0xef, 0xc1, 0x72, 0x37, 0x02, 0x02, 0x7f, 0xbb, 0x15, 0xf7, 0xb7, 0xe2, 0xaa, 0x80,
+	0xef, 0x06, 0x28, 0x2d, 0x9f, 0x30, 0x7d, 0x33, 0x47, 0x7c, 0x2f, 0x29, 0x27, 0x09, 0xb6, 0x49,
+	0xda, 0x23, 0xb0, 0xd1, 0x63, 0x39, 0xa9, 0x1b, 0x9d, 0x9c, 0x8a, 0x74, 0x09, 0x56, 0x32, 0x28,
+	0xf1, 0x64, 0xf5, 0x5f, 0x48, 0xa0, 0x24, 0xbd, 0xdd, 0x7b, 0xbe, 0x79, 0xfa, 0x9c, 0x3f, 0xed,
+	0xe4, 0xfc, 0x76, 0x0a, 0xe7, 0xdd, 0x28, 0xe5, 0xe4, 0xfd, 0x09, 0x5c, 0xca, 0xa4, 0xc5, 0x75,
+	0xf3, 0x5b, 0x30, 0x55, 0xd3, 0xad, 0x1a, 0x0e, 0xde, 0x00, 0x98, 0xbd, 0xd3, 0x46, 0xd4, 0x49,
+	0x36, 0xae, 0xfa, 0xc3, 0x51, 0x7b, 0x8f, 0xd2, 0x3c, 0xa3, 0xbd, 0x67, 0x91, 0xca, 0xb9, 0xd5,
+	0x57, 0xe0, 0x72, 0x36, 0xb1, 0x48, 0xc1, 0x52, 0x00, 0x78, 0x16, 0x0d, 0x4b, 0xa5, 0xd3, 0xb3,
+	0x86, 0x89, 0x28, 0xc5, 0x34, 0x2c, 0xb9, 0x41, 0x7a, 0x3e, 0xd8, 0xe8, 0x59, 0xc3, 0xba, 0x51,
+	0xca, 0xc9, 0xfb, 0x15, 0xb8, 0x94, 0x49, 0x8b, 0x73, 0xff, 0x4f, 0x12, 0x5c, 0x54, 0x71, 0xd3,
+	0x3e, 0xc1, 0xac, 0x13, 0xe1, 0xab, 0x92, 0xc7, 0x8b, 0x07, 0x46, 0xc5, 0x8e, 0xc0, 0x48, 0x51,
+	0x60, 0x39, 0x9d, 0x6b, 0xbe, 0xb5, 0x9f, 0x14, 0xe0, 0x0a, 0xdf, 0x02, 0xdb, 0x76, 0x6a, 0x19,
+	0x3c, 0x73, 0x83, 0x3a, 0x94, 0xe2, 0x36, 0x58, 0x2e, 0x88, 0x5e, 0x42, 0xc1, 0xf9, 0xe5, 0x58,
+	0x50, 0x9d, 0x88, 0x59, 0x2f, 0x29, 0x42, 0x07, 0x9d, 0x06, 0xc2, 0x76, 0x3e, 0x71, 0x11, 0x7a,
+	0x9b, 0xe3, 0x74, 0x14, 0xa1, 0xb1, 0x68, 0xb8, 0xe7, 0x2e, 0x83, 0x55, 0x78, 0xa5, 0xdb, 0x5e,
+	0xb8, 0x9c, 0xff, 0x45, 0x82, 0x45, 0x3f, 0x71, 0x24, 0xb8, 0xc8, 0x7f, 0x29, 0xea, 0x73, 0x15,
+	0xa6, 0x4d, 0x57, 0x8b, 0x77, 0xd7, 0x51, 0x59, 0x8e, 0xa8, 0x93, 0xa6, 0xfb, 0x20, 0xda, 0x37,
+	0xa7, 0x2c, 0xc1, 0x79, 0x31, 0xfb, 0x7c, 0x7f, 0xdf, 0xa3, 0x01, 0x0b, 0x71, 0xd6, 0xf1, 0xc2,
+	0x79, 0xc2, 0xb5, 0x7e, 0x19, 0x1b, 0x5d, 0x81, 0x71, 0xde, 0x3a, 0x89, 0x8d, 0x48, 0x2e, 0x37,
+	0x18, 0xab, 0x1a, 0xe8, 0x03, 0x38, 0x57, 0xf3, 0x59, 0x8d, 0x2c, 0x3d, 0xd0, 0xd3, 0xd2, 0x28,
+	0x20, 0x11, 0xae, 0xbd, 0x03, 0x53, 0x91, 0x76, 0x48, 0x76, 0x49, 0x18, 0xcc, 0x7b, 0x49, 0x98,
+	0x0c, 0x51, 0xe9, 0x00, 0xb1, 0x78, 0x3f, 0xdc, 0x33, 0x0d, 0x1a, 0x1e, 0x17, 0xd5, 0x51, 0x3e,
+	0x52, 0x35, 0x94, 0x57, 0xe1, 0x4a, 0x97, 0x43, 0xe0, 0xc7, 0xf5, 0x1f, 0x05, 0x28, 0xab, 0xbc,
+	0x57, 0x18, 0x53, 0xd2, 0xee, 0xc1, 0xfa, 0x97, 0x79, 0x44, 0xbf, 0x0d, 0xb3, 0xa2, 0xca, 0xb1,
+	0xdf, 0x01, 0xd2, 0x43, 0xe9, 0xf8, 0x5c, 0xb2, 0x74, 0xec, 0xa2, 0x9b, 0x30, 0x44, 0x45, 0xef,
+	0x96, 0x07, 0x32, 0x52, 0x23, 0x5b, 0xba, 0xa7, 0xdf, 0x6f, 0xd8, 0x87, 0x2a, 0x07, 0x46, 0x9b,
+	0x50, 0x22, 0x7d, 0xb7, 0xa4, 0x1b, 0x8b, 0xa3, 0x0f, 0xe6, 0x41, 0x1f, 0xb7, 0xf0, 0x0b, 0xb5,
+	0xcd, 0x8e, 0xcc, 0x55, 0x16, 0x61, 0x41, 0x20, 0x6a, 0x7e, 0x10, 0xdf, 0x97, 0x60, 0x6e, 0xef,
+	0xd4, 0xaa, 0xed, 0x1d, 0xeb, 0x8e, 0xc1, 0x33, 0xa4, 0xfc, 0x18, 0xae, 0x40, 0xc9, 0xb5, 0xdb,
+	0x4e, 0x0d, 0x6b, 0xbc, 0x85, 0x9c, 0x9f, 0xc5, 0x04, 0x1b, 0xdd, 0x64, 0x83, 0x68, 0x01, 0x46,
+	0x48, 0xf2, 0xc8, 0xf0, 0xdf, 0x6f, 0x83, 0xea, 0x30, 0x7d, 0xae, 0x1a, 0xa8, 0x02, 0x03, 0xf4,
+	0x2e, 0x59, 0xec, 0x7a, 0xc1, 0xa3, 0x70, 0xca, 0x02, 0xcc, 0x27, 0x78, 0xe1, 0x7c, 0xfe, 0x74,
+	0x10, 0xce, 0x91, 0x39, 0xff, 0x3d, 0xf9, 0x65, 0xea, 0x4a, 0x19, 0x86, 0xfd, 0x8c, 0x14, 0xb3,
+	0x64, 0xff, 0x91, 0x18, 0x7a, 0x78, 0xd7, 0x0d, 0xf2, 0x08, 0x41, 0xde, 0x81, 0xc8, 0x24, 0x99,
+	0x87, 0x1a, 0xec, 0x35, 0x0f, 0x95, 0x6d, 0x84, 0x89, 0x9b, 0xfc, 0x70, 0x6f, 0x37, 0xf9, 0xf7,
+	0x79, 0xf5, 0x27, 0xbc, 0x54, 0x53, 0x2a, 0x23, 0x5d, 0xa9, 0x4c, 0x13, 0xb4, 0x20, 0x3c, 0xa6,
+	0xb4, 0x6e, 0xc1, 0xb0, 0x7f, 0x23, 0x1f, 0xcd, 0x71, 0x23, 0xf7, 0x81, 0xa3, 0xd9, 0x04, 0x88,
+	0x67, 0x13, 0xde, 0x81, 0x71, 0x56, 0x9b, 0xe2, 0x8d, 0xe2, 0x63, 0x39, 0x1a, 0xc5, 0xc7, 0x68,
+	0xc9, 0x8a, 0x3d, 0x90, 0x32, 0x09, 0x25, 0xc0, 0x3e, 0x9d, 0xd0, 0x4c, 0x03, 0x5b, 0x9e, 0xe9,
+	0x9d, 0xd2, 0x6c, 0xe0, 0xa8, 0x8a, 0xc8, 0xdc, 0x07, 0x74, 0xaa, 0xca, 0x67, 0xd0, 0x23, 0x98,
+	0xec, 0x70, 0x0d, 0x3c, 0xf3, 0x77, 0x25, 0x97, 0x53, 0x50, 0x4b, 0x71, 0x87, 0xa0, 0xcc, 0xc1,
+	0x4c, 0x5c, 0x93, 0xb9, 0x8a, 0xff, 0xb9, 0x04, 0x8b, 0x7e, 0xe7, 0xdd, 0x57, 0x24, 0xc2, 0x53,
+	0xfe, 0x54, 0x82, 0xf3, 0x62, 0x9e, 0xf8, 0xe5, 0xe7, 0x06, 0xcc, 0x35, 0xd9, 0x38, 0xab, 0xcb,
+	0x68, 0xa6, 0xa5, 0xd5, 0xf4, 0xda, 0x31, 0xe6, 0x1c, 0x9e, 0x6b, 0x46, 0xb0, 0xaa, 0xd6, 0x26,
+	0x99, 0x42, 0x6f, 0xc2, 0x42, 0x02, 0xc9, 0xd0, 0x3d, 0xfd, 0x50, 0x77, 0xfd, 0x06, 0xdc, 0xb9,
+	0x38, 0xde, 0x16, 0x9f, 0x55, 0xce, 0x83, 0xec, 0xf3, 0xc3, 0xe5, 0xf9, 0x9e, 0x1d, 0xb4, 0x4e,
+	0x29, 0xbf, 0x5f, 0x80, 0x45, 0xe1, 0x34, 0xe7, 0x76, 0x15, 0xa6, 0xac, 0x76, 0xf3, 0x10, 0x3b,
+	0x24, 0x07, 0x45, 0xbd, 0x94, 0x4b, 0xf9, 0x1c, 0x54, 0x4b, 0x6c, 0xfc, 0x71, 0x9d, 0x3a, 0x1f,
+	0x97, 0x08, 0xdb, 0xf7, 0x6a, 0x2e, 0x4d, 0x2d, 0x0c, 0xaa, 0x23, 0xdc, 0xad, 0xb9, 0xa8, 0x0a,
+	0xe3, 0xfc, 0x24, 0xd8, 0x56, 0xc5, 0x5d, 0xa6, 0xbe, 0x3a, 0xb0, 0x5c, 0x0f, 0xdd, 0x39, 0x8d,
+	0xfd, 0xc6, 0x8c, 0x70, 0x00, 0xdd, 0x82, 0x79, 0xb6, 0x4e, 0xcd, 0xb6, 0x3c, 0xc7, 0x6e, 0x34,
+	0xb0, 0x43, 0x65, 0xd2, 0x66, 0x6f, 0x8a, 0x51, 0x75, 0x96, 0x4e, 0x6f, 0x06, 0xb3, 0xcc, 0x2f,
+	0x52, 0x0b, 0x31, 0x0c, 0x07, 0xbb, 0x2e, 0x4f, 0x48, 0xfa, 0x8f, 0x4a, 0x05, 0xa6, 0x59, 0x65,
+	0x8b, 0xe0, 0xf9, 0xba, 0x13, 0x75, 0xd2, 0x52, 0xcc, 0x49, 0x2b, 0x33, 0x80, 0xa2, 0xf0, 0x5c,
+	0x19, 0xff, 0x4b, 0x82, 0x69, 0x16, 0xbc, 0x47, 0xa3, 0xc4, 0x74, 0x32, 0xe8, 0x1e, 0xaf, 0x02,
+	0x07, 0x45, 0xef, 0xd2, 0xfa, 0xc5, 0x14, 0x81, 0x10, 0x8a, 0x34, 0x6b, 0x36, 0xe2, 0xf1, 0xbf,
+	0xa2, 0xb9, 0xd7, 0x62, 0x2c, 0xf7, 0xba, 0x09, 0x93, 0x27, 0xa6, 0x6b, 0x1e, 0x9a, 0x0d, 0xd3,
+	0x3b, 0x65, 0x9e, 0xa8, 0x7b, 0xba, 0xb0, 0x14, 0xa2, 0x90, 0x41, 0xe2, 0x96, 0xf9, 0x2b, 0x4c,
+	0xb3, 0x74, 0xee, 0x71, 0x47, 0xd5, 0x31, 0x3e, 0xf6, 0x48, 0x6f, 0x62, 0x22, 0x85, 0xe8, 0x76,
+	0xb9, 0x14, 0x7e, 0x40, 0xa5, 0xe0, 0x62, 0xef, 0x69, 0x1b, 0xb7, 0x71, 0x0e, 0x29, 0x74, 0xae,
+	0x54, 0x48, 0xac, 0x14, 0x17, 0x54, 0xb1, 0x47, 0x41, 0x31, 0x3e, 0x43, 0x86, 0x38, 0x9f, 0x3f,
+	0x94, 0x60, 0xc6, 0xd7, 0xfb, 0xaf, 0x0c, 0xab, 0x8f, 0x61, 0xb6, 0x83, 0x27, 0x6e, 0x85, 0xb7,
+	0x60, 0xbe, 0xe5, 0xd8, 0x35, 0xec, 0xba, 0xa4, 0x73, 0x95, 0x7e, 0x55, 0xc6, 0xfc, 0x00, 0x31,
+	0xc6, 0x22, 0xd1, 0xf9, 0x70, 0x9a, 0x62, 0x52, 0x27, 0xe0, 0x2a, 0x9f, 0x16, 0x61, 0xe1, 0x59,
+	0xcb, 0xd0, 0x3d, 0x7c, 0x60, 0x3a, 0x5e, 0x5b, 0x6f, 0x7c, 0x55, 0xb6, 0x8a, 0x76, 0x60, 0xd4,
+	0x6e, 0x61, 0xde, 0x00, 0x3d, 0x40, 0xb1, 0x2b, 0xa9, 0x89, 0xc8, 0x28, 0xf3, 0x8f, 0x7d, 0x2c,
+	0x35, 0x24, 0x40, 0xbc, 0xd4, 0x09, 0x83, 0xe1, 0xc2, 0x09, 0x8a, 0x85, 0xa5, 0x93, 0x08, 0x6e,
+	0xd5, 0x40, 0x37, 0x61, 0xde, 0xd3, 0x9d, 0x23, 0xec, 0x69, 0x09, 0x04, 0x16, 0x16, 0xcc, 0xb0,
+	0xe9, 0x83, 0x38, 0xda, 0x05, 0x80, 0xe0, 0x4d, 0xe2, 0x96, 0x87, 0xa9, 0xcc, 0x47, 0xfd, 0x57,
+	0x89, 0x8b, 0xde, 0x85, 0x52, 0x4b, 0x6f, 0xbb, 0x58, 0xf3, 0xbf, 0xd2, 0x2c, 0x8f, 0x74, 0x6b,
+	0x51, 0x98, 0xa0, 0x08, 0xfe, 0x23, 0xf1, 0xd2, 0xa2, 0x83, 0xf2, 0xef, 0x6a, 0x12, 0x5c, 0x78,
+	0x88, 0x3d, 0x35, 0xfc, 0x56, 0x70, 0x17, 0xbb, 0xae, 0x7e, 0x84, 0x83, 0xd0, 0xf3, 0x1d, 0x18,
+	0xa2, 0x85, 0x3c, 0xa6, 0x10, 0x63, 0xeb, 0xaf, 0xa6, 0x1c, 0x45, 0x84, 0x04, 0xad, 0xf2, 0xa9,
+	0x1c, 0x2d, 0xc7, 0x89, 0x93, 0x77, 0xc5, 0x52, 0x1a, 0x17, 0x5c, 0x51, 0x3f, 0x82, 0x12, 0x53,
+	0xa9, 0x26, 0x9f, 0xe1, 0xec, 0xbc, 0x9f, 0x7a, 0xb6, 0xd9, 0x04, 0x2b, 0xd4, 0xc7, 0xfa, 0xa3,
+	0x2c, 0xa1, 0x3c, 0xe1, 0x46, 0xc7, 0xe4, 0x06, 0xa0, 0x24, 0x50, 0x34, 0x69, 0x3c, 0xc8, 0x92,
+	0xc6, 0xef, 0xc6, 0x93, 0xc6, 0x57, 0xbb, 0x0b, 0x28, 0x60, 0x26, 0x92, 0x30, 0x6e, 0xc2, 0xf2,
+	0x43, 0xec, 0x6d, 0xed, 0x3c, 0xcd, 0x38, 0x8b, 0x2a, 0x00, 0x73, 0xcd, 0x56, 0xdd, 0xf6, 0x05,
+	0x90, 0x63, 0x39, 0x62, 0x25, 0xf4, 0x75, 0x37, 0xea, 0xf1, 0xbf, 0x5c, 0xe5, 0x25, 0xac, 0x64,
+	0x2c, 0xc7, 0x85, 0xbe, 0x07, 0xd3, 0x91, 0xaf, 0x48, 0x69, 0x51, 0xd9, 0x5f, 0xf6, 0x95, 0x7c,
+	0xcb, 0xaa, 0x53, 0x4e, 0x7c, 0xc0, 0x55, 0xfe, 0x4d, 0x82, 0x19, 0x15, 0xeb, 0xad, 0x56, 0x83,
+	0xdd, 0x6c, 0x83, 0xdd, 0xcd, 0xc1, 0x10, 0xaf, 0xd0, 0xb0, 0x78, 0x85, 0x3f, 0x65, 0x7f, 0x74,
+	0x22, 0x0e, 0xb6, 0x8a, 0x67, 0xbd, 0x57, 0xf4, 0x77, 0x49, 0x54, 0xe6, 0x61, 0xb6, 0x63, 0x6b,
+	0xdc, 0xce, 0x7e, 0x2c, 0x91, 0x1e, 0xf1, 0xba, 0x83, 0xdd, 0xe3, 0xa0, 0x58, 0x45, 0xa4, 0xf1,
+	0x15, 0xdc, 0x3b, 0xc9, 0xef, 0x88, 0x59, 0xe5, 0x7b, 0x79, 0x13, 0xe6, 0x37, 0xed, 0xb6, 0x45,
+	0x94, 0xa7, 0x53, 0x41, 0x97, 0x00, 0xea, 0xb6, 0x53, 0xc3, 0x0f, 0xb0, 0x57, 0x3b, 0xe6, 0x99,
+	0xf7, 0xc8, 0x88, 0xa2, 0x43, 0x39, 0x89, 0xca, 0x95, 0x6d, 0x1b, 0x86, 0xb1, 0xe5, 0xd1, 0x9a,
+	0x3c, 0x53, 0xb1, 0xd7, 0x52, 0x54, 0x8c, 0x47, 0x93, 0x5b, 0x3b, 0x4f, 0x29, 0x2d, 0x5e, 0x77,
+	0xe7, 0xb8, 0xca, 0x8f, 0x0b, 0x30, 0xa7, 0x62, 0xdd, 0x10, 0x70, 0xb7, 0x0e, 0x03, 0x41, 0x97,
+	0x4b, 0x69, 0x7d, 0x29, 0x2d, 0x46, 0xdc, 0x79, 0x4a, 0x5f, 0x29, 0x14, 0x36, 0xeb, 0x4a, 0x9d,
+	0xbc, 0x94, 0x17, 0x45, 0x97, 0xf2, 0x7d, 0x28, 0x9b, 0x16, 0x81, 0x30, 0x4f, 0xb0, 0x86, 0xad,
+	0xc0, 0x83, 0xe5, 0xec, 0x0c, 0x9c, 0x0d, 0x90, 0xb7, 0x2d, 0xdf, 0x15, 0x55, 0x0d, 0xa2, 0x18,
+	0x2d, 0x42, 0x84, 0xf6, 0x16, 0x0c, 0x52, 0xc6, 0x46, 0xc8, 0x00, 0x69, 0x2c, 0x40, 0xaf, 0xc0,
+	0x24, 0xed, 0x6f, 0xa1, 0x10, 0xac, 0x0d, 0x63, 0x88, 0xb6, 0x61, 0xd0, 0xb6, 0x97, 0x27, 0xfa,
+	0x11, 0x66, 0x5d, 0x99, 0xff, 0x50, 0x80, 0xf9, 0x84, 0xac, 0xf8, 0x71, 0xf4, 0x23, 0x2c, 0xa1,
+	0xbf, 0x28, 0x9c, 0xcd, 0x5f, 0xa0, 0xef, 0xc2, 0x5c, 0x82, 0xa8, 0x9f, 0xeb, 0xed, 0xd5, 0x01,
+	0xce, 0x74, 0x52, 0x27, 0xa3, 0x22, 0x71, 0x0d, 0x88, 0xc4, 0xf5, 0x73, 0xd2, 0xbb, 0xdb, 0x76,
+	0x8e, 0xf0, 0xd7, 0x5b, 0xb7, 0x14, 0x19, 0xca, 0xc9, 0x6d, 0x72, 0xe3, 0xff, 0xac, 0x00, 0xf3,
+	0xbb, 0xf8, 0x6b, 0x2f, 0x83, 0xff, 0x1d, 0xfb, 0xba, 0x0f, 0xe5, 0x5d, 0x2c, 0x16, 0xa4, 0x88,
+	0x86, 0x24, 0xa2, 0xf1, 0x89, 0x04, 0xe7, 0x1f, 0xd9, 0x9e, 0x59, 0x3f, 0x25, 0x69, 0x13, 0xfb,
+	0x04, 0x3b, 0xbb, 0x3a, 0xc9, 0x89, 0x04, 0x52, 0xff, 0x2e, 0xcc, 0xd5, 0xf9, 0x8c, 0xd6, 0xa4,
+	0x53, 0x5a, 0x2c, 0x60, 0x4b, 0xb3, 0x8f, 0x38, 0x39, 0xba, 0x98, 0x3a, 0x53, 0x4f, 0x0e, 0xba,
+	0xca, 0x45, 0xb8, 0x90, 0xc2, 0x01, 0x57, 0x0a, 0x1d, 0x16, 0x1f, 0x62, 0x6f, 0xd3, 0xb1, 0x5d,
+	0x97, 0x9f, 0x4a, 0xec, 0xe5, 0x16, 0xbb, 0xc0, 0x4b, 0x1d, 0x17, 0xf8, 0x2b, 0x50, 0xe2, 0x71,
+	0xb3, 0x7f, 0xca, 0xec, 0x35, 0x37, 0xc1, 0x46, 0x39, 0x3d, 0xe5, 0x17, 0x45, 0x38, 0x2f, 0x5e,
+	0x83, 0xcb, 0xb3, 0x09, 0x25, 0xe6, 0x1a, 0x0e, 0x4f, 0x59, 0x3a, 0xa1, 0x2c, 0x75, 0xe9, 0xec,
+	0xca, 0x22, 0x47, 0x6f, 0x16, 0xee, 0xfd, 0x53, 0x1a, 0x00, 0xb2, 0x37, 0xcc, 0xb8, 0x17, 0x19,
+	0x22, 0x5f, 0x54, 0xcf, 0xd6, 0x69, 0x61, 0x53, 0xab, 0xd1, 0x00, 0x3d, 0x58, 0x96, 0xf9, 0xbb,
+	0xdd, 0xfe, 0x96, 0x65, 0xb5, 0xd2, 0x4d, 0x42, 0x31, 0xb6, 0x38, 0xaa, 0x27, 0x26, 0xe4, 0x16,
+	0x4c, 0x27, 0xb8, 0x14, 0x84, 0xa7, 0xdb, 0xf1, 0xf0, 0x74, 0x2d, 0x45, 0x1d, 0x3a, 0x79, 0xe2,
+	0x87, 0x17, 0x8d, 0x51, 0xe5, 0x16, 0xcc, 0xa7, 0x30, 0x28, 0x58, 0xf7, 0x9d, 0xe8, 0xba, 0xa5,
+	0xd4, 0xb4, 0xfd, 0x43, 0xec, 0x85, 0x45, 0x62, 0x4a, 0x37, 0x1a, 0x15, 0xff, 0xa7, 0x04, 0xab,
+	0xbc, 0x2c, 0x9b, 0x10, 0x5a, 0xa2, 0x9e, 0x94, 0x71, 0xed, 0xcc, 0xa7, 0x65, 0xe8, 0x80, 0x29,
+	0x51, 0xd0, 0x3f, 0xe3, 0xd7, 0x1c, 0xf2, 0x0b, 0x8d, 0xe1, 0x11, 0xba, 0xe1, 0x93, 0x8b, 0x2e,
+	0xc3, 0x44, 0x9d, 0x04, 0x40, 0x8f, 0x30, 0x8b, 0xa5, 0x78, 0x19, 0x31, 0x3e, 0xa8, 0x38, 0xf0,
+	0xad, 0x1c, 0x7b, 0x0d, 0xc2, 0xa5, 0x41, 0x3f, 0x1e, 0xef, 0xef, 0x58, 0x29, 0xb6, 0x72, 0x93,
+	0x7e, 0x9b, 0xe8, 0x1b, 0x36, 0x7d, 0x49, 0xe6, 0xc8, 0x71, 0x2a, 0x1e, 0xcc, 0x27, 0xd0, 0x82,
+	0xc0, 0x61, 0x36, 0x2c, 0x9f, 0xf9, 0x09, 0xb5, 0x36, 0xef, 0x87, 0x1b, 0x54, 0xc3, 0xda, 0xda,
+	0x1e, 0xcb, 0xa6, 0xb5, 0x2d, 0x5a, 0xdf, 0xf0, 0xbf, 0x9e, 0xe5, 0xa9, 0x40, 0x96, 0xe7, 0x9b,
+	0xe0, 0xa3, 0x14, 0xd4, 0x55, 0xaa, 0x30, 0xa7, 0xea, 0x1e, 0x6e, 0x98, 0x4d, 0xd3, 0x63, 0x97,
+	0x5a, 0x9f, 0xd9, 0x35, 0x18, 0x30, 0x74, 0x4f, 0xe7, 0xc2, 0x58, 0x4c, 0x6b, 0xa8, 0xdd, 0xb0,
+	0x4e, 0x55, 0x0a, 0xa8, 0xbc, 0x0f, 0xf3, 0x09, 0x52, 0x7c, 0x03, 0xbd, 0xd2, 0xba, 0xfa, 0xb9,
+	0x04, 0xb3, 0xc2, 0x4c, 0x02, 0xba, 0x04, 0x17, 0x0f, 0xaa, 0xea, 0xfe, 0xb3, 0x8d, 0x1d, 0xed,
+	0xe9, 0xb3, 0xed, 0x67, 0xdb, 0xda, 0xe3, 0x27, 0xdb, 0xea, 0xc6, 0x7e, 0xf5, 0xf1, 0x23, 0xad,
+	0xfa, 0xe8, 0x60, 0x63, 0xa7, 0xba, 0x35, 0xf5, 0x2b, 0x68, 0x15, 0x2e, 0xa7, 0x01, 0xed, 0x3e,
+	0x3e, 0xd8, 0xd6, 0xb6, 0x1e, 0xef, 0x6e, 0x54, 0x1f, 0xed, 0x4d, 0x49, 0x68, 0x05, 0x2e, 0xa4,
+	0x41, 0x3e, 0xd9, 0x78, 0xb6, 0xb7, 0x3d, 0x55, 0x40, 0x0a, 0x2c, 0xa5, 0x81, 0xa8, 0xdb, 0x7b,
+	0xcf, 0x76, 0xb7, 0xa7, 0x8a, 0x59, 0x64, 0x76, 0xb7, 0xd5, 0x87, 0xdb, 0x53, 0x03, 0xeb, 0x3f,
+	0x59, 0x03, 0xe0, 0x71, 0xf6, 0xc6, 0x93, 0x2a, 0xfa, 0x63, 0x52, 0x9a, 0x12, 0xfe, 0xde, 0x02,
+	0xba, 0xd5, 0xdf, 0x0f, 0xa4, 0xc8, 0xb7, 0x7b, 0xc6, 0xe3, 0xc7, 0xf3, 0x27, 0x12, 0xcc, 0xa7,
+	0xfc, 0x20, 0x07, 0xba, 0xdd, 0xed, 0xc7, 0x2c, 0xd2, 0xb8, 0xb9, 0xd3, 0x3b, 0x22, 0x67, 0xe7,
+	0x53, 0x09, 0x96, 0xbb, 0xfd, 0x28, 0x05, 0x7a, 0xf7, 0xac, 0x3f, 0xb2, 0x21, 0x6f, 0x9c, 0x81,
+	0x02, 0xe7, 0x94, 0x1c, 0xa2, 0xf8, 0xe7, 0x26, 0x32, 0x0e, 0x31, 0xf3, 0x67, 0x2e, 0xe4, 0xdb,
+	0x3d, 0xe3, 0x71, 0x5e, 0xfe, 0x52, 0x02, 0x39, 0xfd, 0x47, 0x19, 0x50, 0x7a, 0xc3, 0x62, 0xd7,
+	0x1f, 0xab, 0x90, 0xdf, 0xea, 0x0b, 0x97, 0xf3, 0xf5, 0x43, 0x09, 0x16, 0x52, 0x7f, 0x72, 0x01,
+	0xbd, 0x99, 0x4a, 0xba, 0xdb, 0x2f, 0x3e, 0xc8, 0x77, 0xfb, 0x41, 0xe5, 0x4c, 0x59, 0x30, 0x11,
+	0xfb, 0x16, 0x1f, 0xbd, 0x9e, 0x4a, 0x4c, 0xf4, 0xc9, 0xbf, 0x5c, 0xc9, 0x0b, 0xce, 0xd7, 0xfb,
+	0x44, 0x82, 0x73, 0x82, 0x0f, 0xda, 0xd1, 0x8d, 0xec, 0xd3, 0x16, 0x7e, 0x42, 0x2f, 0xbf, 0xd1,
+	0x1b, 0x12, 0x67, 0xc1, 0x83, 0xc9, 0x8e, 0xef, 0xbb, 0xd1, 0x5a, 0x56, 0x44, 0x25, 0x28, 0xd2,
+	0xc9, 0xd7, 0xf2, 0x23, 0xf0, 0x55, 0x5f, 0xc0, 0x54, 0xe7, 0x47, 0x8a, 0x28, 0x9d, 0x4a, 0xca,
+	0x67, 0x9c, 0xf2, 0xf5, 0x1e, 0x30, 0x22, 0x6a, 0x97, 0xda, 0x8a, 0x9b, 0xa1, 0x76, 0xdd, 0x3e,
+	0x94, 0x92, 0xcf, 0xd0, 0xf9, 0x8b, 0xfe, 0x46, 0x82, 0xf3, 0xec, 0x41, 0xdc, 0xa9, 0x8b, 0xee,
+	0xf5, 0xd9, 0xe0, 0xcb, 0x58, 0x7b, 0xfb, 0x4c, 0xed, 0xc1, 0x5c, 0x64, 0x29, 0xed, 0xac, 0x99,
+	0x22, 0xcb, 0x6e, 0xa6, 0x95, 0xef, 0xf6, 0x83, 0x9a, 0x38, 0x47, 0xc1, 0xb7, 0x02, 0x5d, 0xcf,
+	0x31, 0xfd, 0x2b, 0x0d, 0xf9, 0x6e, 0x3f, 0xa8, 0xc9, 0x73, 0x14, 0x76, 0x94, 0x76, 0x3f, 0xc7,
+	0xac, 0xae, 0x56, 0xf9, 0xed, 0x3e, 0xb1, 0x93, 0xe7, 0x98, 0x6c, 0x1a, 0xed, 0x7e, 0x8e, 0xa9,
+	0x2d, 0xab, 0xf2, 0xdd, 0x7e, 0x50, 0x39, 0x53, 0x7f, 0x4d, 0xd3, 0xb5, 0xa9, 0xdd, 0xa0, 0xe8,
+	0xad, 0x9e, 0xf6, 0x1c, 0xef, 0x47, 0x95, 0xef, 0xf5, 0x87, 0x1c, 0x63, 0x2d, 0xb5, 0x15, 0x3a,
+	0x93, 0xb5, 0x6e, 0xcd, 0xd8, 0xf2, 0xbd, 0xfe, 0x90, 0x39, 0x6b, 0x7f, 0x27, 0xc1, 0x12, 0xa7,
+	0x94, 0xd2, 0x03, 0x89, 0xbe, 0x93, 0xb1, 0x40, 0x8e, 0x46, 0x50, 0xf9, 0x9d, 0xbe, 0xf1, 0x39,
+	0x8f, 0x3f, 0x90, 0xa0, 0xcc, 0xaa, 0xcb, 0xc9, 0x4e, 0x58, 0x74, 0x27, 0x83, 0x7a, 0x66, 0xcb,
+	0xaf, 0xfc, 0x66, 0x1f, 0x98, 0x9c, 0xa3, 0xef, 0x49, 0x30, 0x23, 0xea, 0xa7, 0x44, 0xe9, 0x6f,
+	0xce, 0x8c, 0xee, 0x51, 0xf9, 0x66, 0x8f, 0x58, 0x9c, 0x8b, 0xbf, 0xa5, 0xbf, 0x8b, 0x96, 0xd1,
+	0x2f, 0x88, 0xde, 0xee, 0xa2, 0x1b, 0xd9, 0xcd, 0x9e, 0xf2, 0x77, 0xfa, 0x45, 0xe7, 0x0c, 0x7e,
+	0x4c, 0xca, 0xff, 0x1d, 0xad, 0x73, 0xe8, 0x7a, 0x06, 0x51, 0x71, 0x47, 0xa3, 0xbc, 0xde, 0x0b,
+	0x4a, 0x18, 0x8d, 0x74, 0x34, 0xc3, 0x65, 0x44, 0x23, 0xe2, 0x16, 0x3e, 0xf9, 0x5a, 0x7e, 0x04,
+	0xbe, 0xea, 0x73, 0x18, 0x8f, 0x36, 0x27, 0xa1, 0x6f, 0x67, 0x52, 0xe8, 0xe8, 0xc6, 0x93, 0x5f,
+	0xcf, 0x09, 0x1d, 0xd1, 0x42, 0x51, 0x77, 0x51, 0x86, 0x16, 0x66, 0x34, 0x48, 0xc9, 0x37, 0x7b,
+	0xc4, 0x8a, 0x44, 0x9e, 0x82, 0xa6, 0xa1, 0x8c, 0xc8, 0x33, 0xbd, 0x03, 0x49, 0x7e, 0xa3, 0x37,
+	0xa4, 0xe0, 0x2b, 0x2a, 0x08, 0x7b, 0x70, 0xd0, 0xd5, 0x54, 0x1a, 0x89, 0xc6, 0x1e, 0xf9, 0xb5,
+	0x5c, 0xb0, 0xe1, 0x32, 0x61, 0x93, 0x4b, 0xc6, 0x32, 0x89, 0xc6, 0x1f, 0xf9, 0xb5, 0x5c, 0xb0,
+	0xd1, 0x65, 0xfc, 0x1e, 0x95, 0xcc, 0x65, 0x3a, 0x3a, 0x6b, 0xe4, 0xd7, 0x72, 0xc1, 0x86, 0x37,
+	0x94, 0x58, 0x7f, 0x49, 0xc6, 0x0d, 0x45, 0xd4, 0x1b, 0x23, 0x57, 0xf2, 0x82, 0xf3, 0xf5, 0x7e,
+	0x17, 0x50, 0xb2, 0xa9, 0x01, 0xa5, 0x9b, 0x76, 0x6a, 0xab, 0x8a, 0x7c, 0xa3, 0x27, 0x9c, 0xc8,
+	0x4d, 0x5a, 0xdc, 0x5e, 0x90, 0x71, 0x93, 0xce, 0x6c, 0xb3, 0x90, 0x6f, 0xf7, 0x8c, 0x17, 0x89,
+	0x9f, 0x52, 0x2b, 0xf9, 0x19, 0xf1, 0x53, 0xb7, 0x66, 0x03, 0xf9, 0x6e, 0x3f, 0xa8, 0xa1, 0x3e,
+	0xc4, 0xea, 0xe0, 0x19, 0xfa, 0x20, 0x6a, 0x05, 0x90, 0x2b, 0x79, 0xc1, 0x23, 0xde, 0x4b, 0x54,
+	0xb3, 0x46, 0x59, 0xb7, 0xcf, 0xd4, 0x6a, 0xbc, 0x7c, 0xb3, 0x47, 0xac, 0xf0, 0xfa, 0xd8, 0x59,
+	0xdd, 0xce, 0xb8, 0x3e, 0xa6, 0xd4, 0xd0, 0xe5, 0xeb, 0x3d, 0x60, 0x84, 0xef, 0xa7, 0x8e, 0x32,
+	0x6e, 0xc6, 0xfb, 0x49, 0x5c, 0x1c, 0x97, 0xaf, 0xe5, 0x47, 0x88, 0xdc, 0x96, 0x3b, 0xca, 0x84,
+	0x59, 0xb7, 0x65, 0x71, 0xe1, 0x54, 0xbe, 0xde, 0x03, 0x46, 0xb8, 0xf0, 0x2e, 0xce, 0xbd, 0xf0,
+	0x2e, 0xee, 0x75, 0xe1, 0xd4, 0x9a, 0xdd, 0x1f, 0x49, 0x30, 0x2b, 0xac, 0x84, 0xa1, 0x74, 0x8d,
+	0xc9, 0xaa, 0xdd, 0xc9, 0xb7, 0x7a, 0x45, 0x8b, 0xe8, 0xbb, 0xa8, 0x8e, 0x94, 0xa1, 0xef, 0x19,
+	0x05, 0x3a, 0xf9, 0x66, 0x8f, 0x58, 0x9c, 0x8b, 0xcf, 0xa4, 0xe0, 0x7b, 0xbf, 0xf4, 0x82, 0x05,
+	0xda, 0xe8, 0x76, 0xdd, 0xe9, 0x5a, 0xd8, 0x91, 0xef, 0x9f, 0x85, 0x44, 0x2c, 0xa3, 0x14, 0xad,
+	0x58, 0x64, 0x67, 0x94, 0x04, 0x25, 0x11, 0xf9, 0x5a, 0x7e, 0x84, 0x88, 0x65, 0xc6, 0xcb, 0x0c,
+	0x59, 0x96, 0x29, 0xac, 0x6d, 0xc8, 0xd7, 0xf2, 0x23, 0xb0, 0x55, 0xef, 0x6f, 0xff, 0xf4, 0x8b,
+	0x25, 0xe9, 0xf3, 0x2f, 0x96, 0xa4, 0x7f, 0xff, 0x62, 0x49, 0xfa, 0x8d, 0xdb, 0x47, 0xa6, 0x77,
+	0xdc, 0x3e, 0xac, 0xd4, 0xec, 0xe6, 0x5a, 0xec, 0x97, 0xfb, 0x2b, 0x47, 0xd8, 0x62, 0xff, 0xc6,
+	0x21, 0xf2, 0x7f, 0x24, 0xde, 0xe2, 0x7f, 0x9e, 0x5c, 0x3f, 0x1c, 0xa2, 0x73, 0x37, 0xfe, 0x67,
+	0x00, 0xf4, 0x89, 0xef, 0xbc, 0x73, 0x62, 0x00, 0x00,
 }
 
 func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
@@ -9275,6 +9468,113 @@ func (m *DescribeQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UpdateVirtualQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateVirtualQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateVirtualQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PauseDuration != nil {
+		{
+			size, err := m.PauseDuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.DomainIds) > 0 {
+		for iNdEx := len(m.DomainIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DomainIds[iNdEx])
+			copy(dAtA[i:], m.DomainIds[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.DomainIds[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.TargetVirtualQueueId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TargetVirtualQueueId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.VirtualQueueId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.VirtualQueueId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Operation != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Operation))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TaskType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateVirtualQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateVirtualQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateVirtualQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10126,21 +10426,21 @@ func (m *GetCrossClusterTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x12
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA93 := make([]byte, len(m.ShardIds)*10)
-		var j92 int
+		dAtA94 := make([]byte, len(m.ShardIds)*10)
+		var j93 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA93[j92] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA94[j93] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j92++
+				j93++
 			}
-			dAtA93[j92] = uint8(num)
-			j92++
+			dAtA94[j93] = uint8(num)
+			j93++
 		}
-		i -= j92
-		copy(dAtA[i:], dAtA93[:j92])
-		i = encodeVarintService(dAtA, i, uint64(j92))
+		i -= j93
+		copy(dAtA[i:], dAtA94[:j93])
+		i = encodeVarintService(dAtA, i, uint64(j93))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -10374,21 +10674,21 @@ func (m *GetFailoverInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.PendingShards) > 0 {
-		dAtA97 := make([]byte, len(m.PendingShards)*10)
-		var j96 int
+		dAtA98 := make([]byte, len(m.PendingShards)*10)
+		var j97 int
 		for _, num1 := range m.PendingShards {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA97[j96] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA98[j97] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j96++
+				j97++
 			}
-			dAtA97[j96] = uint8(num)
-			j96++
+			dAtA98[j97] = uint8(num)
+			j97++
 		}
-		i -= j96
-		copy(dAtA[i:], dAtA97[:j96])
-		i = encodeVarintService(dAtA, i, uint64(j96))
+		i -= j97
+		copy(dAtA[i:], dAtA98[:j97])
+		i = encodeVarintService(dAtA, i, uint64(j97))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -11978,29 +12278,82 @@ func (m *DescribeQueueResponse) Size() (n int) {
 	return n
 }
 
-func (m *GetReplicationMessagesRequest) Size() (n int) {
+func (m *UpdateVirtualQueueRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
 	}
 	l = len(m.ClusterName)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.TaskType != 0 {
+		n += 1 + sovService(uint64(m.TaskType))
 	}
-	return n
-}
-
-func (m *GetReplicationMessagesResponse) Size() (n int) {
+	if m.Operation != 0 {
+		n += 1 + sovService(uint64(m.Operation))
+	}
+	if m.VirtualQueueId != 0 {
+		n += 1 + sovService(uint64(m.VirtualQueueId))
+	}
+	if m.TargetVirtualQueueId != 0 {
+		n += 1 + sovService(uint64(m.TargetVirtualQueueId))
+	}
+	if len(m.DomainIds) > 0 {
+		for _, s := range m.DomainIds {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.PauseDuration != nil {
+		l = m.PauseDuration.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateVirtualQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReplicationMessagesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -21884,6 +22237,303 @@ func (m *DescribeQueueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UpdateVirtualQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateVirtualQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateVirtualQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= v11.TaskType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operation |= VirtualQueueOperation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualQueueId", wireType)
+			}
+			m.VirtualQueueId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VirtualQueueId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetVirtualQueueId", wireType)
+			}
+			m.TargetVirtualQueueId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetVirtualQueueId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainIds = append(m.DomainIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PauseDuration == nil {
+				m.PauseDuration = &types.Duration{}
+			}
+			if err := m.PauseDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateVirtualQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateVirtualQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateVirtualQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
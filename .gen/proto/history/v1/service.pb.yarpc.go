@@ -53,6 +53,7 @@ type HistoryAPIYARPCClient interface {
 	RemoveTask(context.Context, *RemoveTaskRequest, ...yarpc.CallOption) (*RemoveTaskResponse, error)
 	ResetQueue(context.Context, *ResetQueueRequest, ...yarpc.CallOption) (*ResetQueueResponse, error)
 	DescribeQueue(context.Context, *DescribeQueueRequest, ...yarpc.CallOption) (*DescribeQueueResponse, error)
+	UpdateVirtualQueue(context.Context, *UpdateVirtualQueueRequest, ...yarpc.CallOption) (*UpdateVirtualQueueResponse, error)
 	GetReplicationMessages(context.Context, *GetReplicationMessagesRequest, ...yarpc.CallOption) (*GetReplicationMessagesResponse, error)
 	GetDLQReplicationMessages(context.Context, *GetDLQReplicationMessagesRequest, ...yarpc.CallOption) (*GetDLQReplicationMessagesResponse, error)
 	ReapplyEvents(context.Context, *ReapplyEventsRequest, ...yarpc.CallOption) (*ReapplyEventsResponse, error)
@@ -117,6 +118,7 @@ type HistoryAPIYARPCServer interface {
 	RemoveTask(context.Context, *RemoveTaskRequest) (*RemoveTaskResponse, error)
 	ResetQueue(context.Context, *ResetQueueRequest) (*ResetQueueResponse, error)
 	DescribeQueue(context.Context, *DescribeQueueRequest) (*DescribeQueueResponse, error)
+	UpdateVirtualQueue(context.Context, *UpdateVirtualQueueRequest) (*UpdateVirtualQueueResponse, error)
 	GetReplicationMessages(context.Context, *GetReplicationMessagesRequest) (*GetReplicationMessagesResponse, error)
 	GetDLQReplicationMessages(context.Context, *GetDLQReplicationMessagesRequest) (*GetDLQReplicationMessagesResponse, error)
 	ReapplyEvents(context.Context, *ReapplyEventsRequest) (*ReapplyEventsResponse, error)
@@ -453,6 +455,16 @@ func buildHistoryAPIYARPCProcedures(params buildHistoryAPIYARPCProceduresParams)
 						},
 					),
 				},
+				{
+					MethodName: "UpdateVirtualQueue",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateVirtualQueue,
+							NewRequest:  newHistoryAPIServiceUpdateVirtualQueueYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 				{
 					MethodName: "GetReplicationMessages",
 					Handler: protobuf.NewUnaryHandler(
@@ -1073,6 +1085,18 @@ func (c *_HistoryAPIYARPCCaller) DescribeQueue(ctx context.Context, request *Des
 	return response, err
 }
 
+func (c *_HistoryAPIYARPCCaller) UpdateVirtualQueue(ctx context.Context, request *UpdateVirtualQueueRequest, options ...yarpc.CallOption) (*UpdateVirtualQueueResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateVirtualQueue", request, newHistoryAPIServiceUpdateVirtualQueueYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateVirtualQueueResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceUpdateVirtualQueueYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 func (c *_HistoryAPIYARPCCaller) GetReplicationMessages(ctx context.Context, request *GetReplicationMessagesRequest, options ...yarpc.CallOption) (*GetReplicationMessagesResponse, error) {
 	responseMessage, err := c.streamClient.Call(ctx, "GetReplicationMessages", request, newHistoryAPIServiceGetReplicationMessagesYARPCResponse, options...)
 	if responseMessage == nil {
@@ -1729,6 +1753,22 @@ func (h *_HistoryAPIYARPCHandler) DescribeQueue(ctx context.Context, requestMess
 	return response, err
 }
 
+func (h *_HistoryAPIYARPCHandler) UpdateVirtualQueue(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateVirtualQueueRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateVirtualQueueRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceUpdateVirtualQueueYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateVirtualQueue(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func (h *_HistoryAPIYARPCHandler) GetReplicationMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
 	var request *GetReplicationMessagesRequest
 	var ok bool
@@ -2185,6 +2225,14 @@ func newHistoryAPIServiceDescribeQueueYARPCResponse() proto.Message {
 	return &DescribeQueueResponse{}
 }
 
+func newHistoryAPIServiceUpdateVirtualQueueYARPCRequest() proto.Message {
+	return &UpdateVirtualQueueRequest{}
+}
+
+func newHistoryAPIServiceUpdateVirtualQueueYARPCResponse() proto.Message {
+	return &UpdateVirtualQueueResponse{}
+}
+
 func newHistoryAPIServiceGetReplicationMessagesYARPCRequest() proto.Message {
 	return &GetReplicationMessagesRequest{}
 }
@@ -2352,6 +2400,8 @@ var (
 	emptyHistoryAPIServiceResetQueueYARPCResponse                        = &ResetQueueResponse{}
 	emptyHistoryAPIServiceDescribeQueueYARPCRequest                      = &DescribeQueueRequest{}
 	emptyHistoryAPIServiceDescribeQueueYARPCResponse                     = &DescribeQueueResponse{}
+	emptyHistoryAPIServiceUpdateVirtualQueueYARPCRequest                 = &UpdateVirtualQueueRequest{}
+	emptyHistoryAPIServiceUpdateVirtualQueueYARPCResponse                = &UpdateVirtualQueueResponse{}
 	emptyHistoryAPIServiceGetReplicationMessagesYARPCRequest             = &GetReplicationMessagesRequest{}
 	emptyHistoryAPIServiceGetReplicationMessagesYARPCResponse            = &GetReplicationMessagesResponse{}
 	emptyHistoryAPIServiceGetDLQReplicationMessagesYARPCRequest          = &GetDLQReplicationMessagesRequest{}
@@ -2383,317 +2433,329 @@ var (
 var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 	// uber/cadence/history/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x6c, 0x1c, 0x47,
-		0x76, 0xe8, 0x19, 0xf1, 0xf7, 0x48, 0x0e, 0xc9, 0x12, 0x3f, 0xc3, 0xa1, 0x44, 0x91, 0xbd, 0x96,
-		0x44, 0xcb, 0xeb, 0xa1, 0x45, 0xd9, 0xfa, 0x59, 0x5e, 0xad, 0x44, 0x4a, 0xf2, 0x38, 0xfa, 0x36,
-		0x69, 0x39, 0x5f, 0xf7, 0x36, 0xa7, 0x6b, 0xc8, 0x8e, 0x7a, 0xba, 0x47, 0xdd, 0x3d, 0xa4, 0xe8,
-		0x43, 0xe0, 0xc4, 0x41, 0x80, 0x2c, 0x82, 0xec, 0x66, 0x91, 0x04, 0x01, 0x02, 0x04, 0x08, 0x36,
-		0xc0, 0x62, 0x8d, 0xdc, 0x12, 0x20, 0x87, 0x24, 0xa7, 0x5c, 0x72, 0xcc, 0x35, 0xf7, 0xdd, 0x43,
-		0x02, 0xe4, 0xb6, 0xe7, 0x20, 0xa8, 0x4f, 0xf7, 0xf4, 0xa7, 0xba, 0x7a, 0x86, 0x0c, 0x22, 0xaf,
-		0xe3, 0xdb, 0x74, 0x55, 0xbd, 0x57, 0xaf, 0x5e, 0xbd, 0xf7, 0xfa, 0xfd, 0x7a, 0xe0, 0x7c, 0x77,
-		0x17, 0x7b, 0xeb, 0x4d, 0xc3, 0xc4, 0x4e, 0x13, 0xaf, 0xef, 0x5b, 0x7e, 0xe0, 0x7a, 0x47, 0xeb,
-		0x07, 0x97, 0xd7, 0x7d, 0xec, 0x1d, 0x58, 0x4d, 0x5c, 0xef, 0x78, 0x6e, 0xe0, 0xa2, 0x05, 0xb2,
-		0xac, 0xce, 0x97, 0xd5, 0xf9, 0xb2, 0xfa, 0xc1, 0xe5, 0xda, 0xf2, 0x9e, 0xeb, 0xee, 0xd9, 0x78,
-		0x9d, 0x2e, 0xdb, 0xed, 0xb6, 0xd6, 0xcd, 0xae, 0x67, 0x04, 0x96, 0xeb, 0x30, 0xc0, 0xda, 0xb9,
-		0xf4, 0x7c, 0x60, 0xb5, 0xb1, 0x1f, 0x18, 0xed, 0x0e, 0x5f, 0x90, 0x41, 0x70, 0xe8, 0x19, 0x9d,
-		0x0e, 0xf6, 0x7c, 0x3e, 0xbf, 0x92, 0x20, 0xd0, 0xe8, 0x58, 0x84, 0xb8, 0xa6, 0xdb, 0x6e, 0x47,
-		0x5b, 0xac, 0x8a, 0x56, 0x84, 0x24, 0x72, 0x2a, 0x44, 0x4b, 0x5e, 0x76, 0x71, 0xb4, 0x40, 0x15,
-		0x2d, 0x08, 0x0c, 0xff, 0x85, 0x6d, 0xf9, 0x81, 0x6c, 0xcd, 0xa1, 0xeb, 0xbd, 0x68, 0xd9, 0xee,
-		0x21, 0x5f, 0x73, 0x49, 0xb4, 0x86, 0xb3, 0x52, 0x4f, 0xad, 0x5d, 0x2b, 0x5a, 0x8b, 0x3d, 0xbe,
-		0xf2, 0x5b, 0xc9, 0x95, 0x66, 0xdb, 0x72, 0x28, 0x17, 0xec, 0xae, 0x1f, 0x14, 0x2d, 0x4a, 0x32,
-		0x62, 0x55, 0xbc, 0xe8, 0x65, 0x17, 0x77, 0xf9, 0x55, 0xd7, 0x2e, 0x8a, 0x97, 0x78, 0xb8, 0x63,
-		0x5b, 0xcd, 0xf8, 0xd5, 0x26, 0x6f, 0xc6, 0xdf, 0x37, 0x3c, 0x6c, 0x92, 0x95, 0x86, 0x13, 0xee,
-		0xf6, 0x46, 0xce, 0x8a, 0x24, 0x4d, 0xe7, 0x73, 0x56, 0x25, 0xd9, 0xa5, 0xfe, 0x6c, 0x18, 0xce,
-		0x6e, 0x07, 0x86, 0x17, 0x7c, 0xc2, 0xc7, 0xef, 0xbd, 0xc2, 0xcd, 0x2e, 0xa1, 0x47, 0xc3, 0x2f,
-		0xbb, 0xd8, 0x0f, 0xd0, 0x43, 0x18, 0xf1, 0xd8, 0xcf, 0xaa, 0xb2, 0xa2, 0xac, 0x8d, 0x6f, 0x6c,
-		0xd4, 0x13, 0x62, 0x6b, 0x74, 0xac, 0xfa, 0xc1, 0xe5, 0xba, 0x14, 0x89, 0x16, 0xa2, 0x40, 0x4b,
-		0x30, 0x66, 0xba, 0x6d, 0xc3, 0x72, 0x74, 0xcb, 0xac, 0x96, 0x56, 0x94, 0xb5, 0x31, 0x6d, 0x94,
-		0x0d, 0x34, 0x4c, 0xf4, 0x9b, 0x30, 0xd7, 0x31, 0x3c, 0xec, 0x04, 0x3a, 0x0e, 0x11, 0xe8, 0x96,
-		0xd3, 0x72, 0xab, 0x65, 0xba, 0xf1, 0x9a, 0x70, 0xe3, 0xa7, 0x14, 0x22, 0xda, 0xb1, 0xe1, 0xb4,
-		0x5c, 0xed, 0x74, 0x27, 0x3b, 0x88, 0xaa, 0x30, 0x62, 0x04, 0x01, 0x6e, 0x77, 0x82, 0xea, 0xa9,
-		0x15, 0x65, 0x6d, 0x48, 0x0b, 0x1f, 0xd1, 0x26, 0x4c, 0xe1, 0x57, 0x1d, 0x8b, 0xa9, 0x98, 0x4e,
-		0x74, 0xa9, 0x3a, 0x44, 0x77, 0xac, 0xd5, 0x99, 0x1e, 0xd5, 0x43, 0x3d, 0xaa, 0xef, 0x84, 0x8a,
-		0xa6, 0x55, 0x7a, 0x20, 0x64, 0x10, 0xb5, 0x60, 0xb1, 0xe9, 0x3a, 0x81, 0xe5, 0x74, 0xb1, 0x6e,
-		0xf8, 0xba, 0x83, 0x0f, 0x75, 0xcb, 0xb1, 0x02, 0xcb, 0x08, 0x5c, 0xaf, 0x3a, 0xbc, 0xa2, 0xac,
-		0x55, 0x36, 0xde, 0x12, 0x1e, 0x60, 0x93, 0x43, 0xdd, 0xf1, 0x1f, 0xe3, 0xc3, 0x46, 0x08, 0xa2,
-		0xcd, 0x37, 0x85, 0xe3, 0xa8, 0x01, 0x33, 0xe1, 0x8c, 0xa9, 0xb7, 0x0c, 0xcb, 0xee, 0x7a, 0xb8,
-		0x3a, 0x42, 0xc9, 0x3d, 0x23, 0xc4, 0x7f, 0x9f, 0xad, 0xd1, 0xa6, 0x23, 0x30, 0x3e, 0x82, 0x34,
-		0x98, 0xb7, 0x0d, 0x3f, 0xd0, 0x9b, 0x6e, 0xbb, 0x63, 0x63, 0x7a, 0x78, 0x0f, 0xfb, 0x5d, 0x3b,
-		0xa8, 0x8e, 0x4a, 0xf0, 0x3d, 0x35, 0x8e, 0x6c, 0xd7, 0x30, 0xb5, 0x59, 0x02, 0xbb, 0x19, 0x81,
-		0x6a, 0x14, 0x12, 0xfd, 0x2a, 0x2c, 0xb5, 0x2c, 0xcf, 0x0f, 0x74, 0x13, 0x37, 0x2d, 0x9f, 0xf2,
-		0xd3, 0xf0, 0x5f, 0xe8, 0xbb, 0x46, 0xf3, 0x85, 0xdb, 0x6a, 0x55, 0xc7, 0x28, 0xe2, 0xc5, 0x0c,
-		0x5f, 0xb7, 0xb8, 0x81, 0xd3, 0xaa, 0x14, 0x7a, 0x8b, 0x03, 0xef, 0x18, 0xfe, 0x8b, 0xbb, 0x0c,
-		0x14, 0x1d, 0xc0, 0x74, 0xc7, 0xf0, 0x02, 0x8b, 0xd2, 0xd9, 0x74, 0x9d, 0x96, 0xb5, 0x57, 0x85,
-		0x95, 0xf2, 0xda, 0xf8, 0xc6, 0xaf, 0xd4, 0x73, 0x0c, 0xa9, 0x5c, 0x2a, 0x89, 0xe8, 0x30, 0x74,
-		0x9b, 0x14, 0xdb, 0x3d, 0x27, 0xf0, 0x8e, 0xb4, 0xa9, 0x4e, 0x72, 0xb4, 0x76, 0x17, 0x66, 0x45,
-		0x0b, 0xd1, 0x34, 0x94, 0x5f, 0xe0, 0x23, 0xaa, 0x14, 0x63, 0x1a, 0xf9, 0x89, 0x66, 0x61, 0xe8,
-		0xc0, 0xb0, 0xbb, 0x98, 0x0b, 0x36, 0x7b, 0xb8, 0x59, 0xba, 0xae, 0xa8, 0xd7, 0x60, 0x39, 0x8f,
-		0x14, 0xbf, 0xe3, 0x3a, 0x3e, 0x46, 0x73, 0x30, 0xec, 0x75, 0xa9, 0x56, 0x30, 0x84, 0x43, 0x5e,
-		0xd7, 0x69, 0x98, 0xea, 0xdf, 0x94, 0x60, 0x79, 0xdb, 0xda, 0x73, 0x0c, 0x3b, 0x57, 0x41, 0x1f,
-		0xa5, 0x15, 0xf4, 0x8a, 0x58, 0x41, 0xa5, 0x58, 0xfa, 0xd4, 0xd0, 0x16, 0x2c, 0xe1, 0x57, 0x01,
-		0xf6, 0x1c, 0xc3, 0x8e, 0x0c, 0x6f, 0x4f, 0x59, 0xb9, 0x9e, 0x5e, 0x10, 0xee, 0x9f, 0xdd, 0x79,
-		0x31, 0x44, 0x95, 0x99, 0x42, 0x75, 0x38, 0xdd, 0xdc, 0xb7, 0x6c, 0xb3, 0xb7, 0x89, 0xeb, 0xd8,
-		0x47, 0x54, 0x6f, 0x47, 0xb5, 0x19, 0x3a, 0x15, 0x02, 0x3d, 0x71, 0xec, 0x23, 0x75, 0x15, 0xce,
-		0xe5, 0x9e, 0x8f, 0x31, 0x58, 0xfd, 0x79, 0x09, 0x2e, 0xf2, 0x35, 0x56, 0xb0, 0x2f, 0xb7, 0x79,
-		0xcf, 0xd3, 0x2c, 0xbd, 0x25, 0x63, 0x69, 0x11, 0xba, 0x3e, 0x79, 0xfb, 0xb9, 0x22, 0x10, 0xf0,
-		0x32, 0x15, 0xf0, 0x8f, 0xf3, 0x05, 0xbc, 0x3f, 0x12, 0xfe, 0x0f, 0x45, 0xfd, 0x0e, 0xac, 0x15,
-		0x13, 0x25, 0x17, 0xfa, 0xef, 0x2b, 0x70, 0x56, 0xc3, 0x3e, 0x3e, 0xf1, 0x4b, 0x49, 0x8a, 0xa4,
-		0xbf, 0x6b, 0x21, 0xaa, 0x9b, 0x87, 0x46, 0x7e, 0x8a, 0x2f, 0x4b, 0xb0, 0xba, 0x83, 0xbd, 0xb6,
-		0xe5, 0x18, 0x01, 0xce, 0x3d, 0xc9, 0xd3, 0xf4, 0x49, 0xae, 0x0a, 0x4f, 0x52, 0x88, 0xe8, 0x97,
-		0x5c, 0x81, 0xdf, 0x00, 0x55, 0x76, 0x44, 0xae, 0xc3, 0x3f, 0x54, 0x60, 0x65, 0x0b, 0xfb, 0x4d,
-		0xcf, 0xda, 0xcd, 0xe7, 0xe8, 0x93, 0x34, 0x47, 0xdf, 0x13, 0x1e, 0xa7, 0x08, 0x4f, 0x9f, 0xe2,
-		0xf1, 0xdf, 0x65, 0x58, 0x95, 0xa0, 0xe2, 0x22, 0x62, 0xc3, 0x42, 0xcf, 0xa5, 0x61, 0xaa, 0xcd,
-		0x5f, 0x78, 0x52, 0x9b, 0x9d, 0x41, 0xb8, 0x19, 0x07, 0xd5, 0xe6, 0xb1, 0x70, 0x1c, 0xed, 0xc2,
-		0x42, 0xf6, 0x6e, 0x99, 0x27, 0x55, 0xa2, 0xbb, 0x5d, 0xea, 0x6f, 0x37, 0xea, 0x4b, 0xcd, 0x1d,
-		0x8a, 0x86, 0xd1, 0x27, 0x80, 0x3a, 0xd8, 0x31, 0x2d, 0x67, 0x4f, 0x37, 0x9a, 0x81, 0x75, 0x60,
-		0x05, 0x16, 0xf6, 0xb9, 0xb9, 0xca, 0x71, 0xd4, 0xd8, 0xf2, 0x3b, 0x6c, 0xf5, 0x11, 0x45, 0x3e,
-		0xd3, 0x49, 0x0c, 0x5a, 0xd8, 0x47, 0xbf, 0x06, 0xd3, 0x21, 0x62, 0x2a, 0x26, 0x1e, 0x76, 0xaa,
-		0xa7, 0x28, 0xda, 0xba, 0x0c, 0xed, 0x26, 0x59, 0x9b, 0xa4, 0x7c, 0xaa, 0x13, 0x9b, 0xf2, 0xb0,
-		0x83, 0xb6, 0x7b, 0xa8, 0x43, 0xef, 0x84, 0x3b, 0x7a, 0x52, 0x8a, 0x43, 0x67, 0x24, 0x81, 0x34,
-		0x1c, 0x54, 0x5f, 0xc1, 0xec, 0x33, 0x12, 0xf3, 0x84, 0xdc, 0x0b, 0xc5, 0x70, 0x33, 0x2d, 0x86,
-		0x6f, 0x0a, 0xf7, 0x10, 0xc1, 0xf6, 0x29, 0x7a, 0x3f, 0x56, 0x60, 0x2e, 0x05, 0xce, 0xc5, 0xed,
-		0x36, 0x4c, 0xd0, 0x38, 0x2c, 0x74, 0xe7, 0x94, 0x3e, 0xdc, 0xb9, 0x71, 0x0a, 0xc1, 0xbd, 0xb8,
-		0x06, 0x54, 0x42, 0x04, 0xbf, 0x8d, 0x9b, 0x01, 0x36, 0xb9, 0xe0, 0xa8, 0xf9, 0x67, 0xd0, 0xf8,
-		0x4a, 0x6d, 0xf2, 0x65, 0xfc, 0x51, 0xfd, 0x7d, 0x05, 0x6a, 0xd4, 0x80, 0x6e, 0x07, 0x56, 0xf3,
-		0xc5, 0x11, 0xf1, 0xe8, 0x1e, 0x5a, 0x7e, 0x10, 0xb2, 0xa9, 0x91, 0x66, 0xd3, 0x7a, 0xbe, 0x25,
-		0x17, 0x62, 0xe8, 0x93, 0x59, 0x67, 0x61, 0x49, 0x88, 0x83, 0x5b, 0x96, 0x7f, 0x2b, 0xc1, 0xfc,
-		0x03, 0x1c, 0x3c, 0xea, 0x06, 0xc6, 0xae, 0x8d, 0xb7, 0x03, 0x23, 0xc0, 0x9a, 0x08, 0xad, 0x92,
-		0xb2, 0xa7, 0x1f, 0x03, 0x12, 0x98, 0xd1, 0xd2, 0x40, 0x66, 0x74, 0x26, 0xa3, 0x61, 0xe8, 0x0a,
-		0xcc, 0xe3, 0x57, 0x1d, 0xca, 0x40, 0xdd, 0xc1, 0xaf, 0x02, 0x1d, 0x1f, 0x90, 0xb0, 0xc8, 0x32,
-		0xa9, 0x85, 0x2e, 0x6b, 0xa7, 0xc3, 0xd9, 0xc7, 0xf8, 0x55, 0x70, 0x8f, 0xcc, 0x35, 0x4c, 0xf4,
-		0x0e, 0xcc, 0x36, 0xbb, 0x1e, 0x8d, 0x9f, 0x76, 0x3d, 0xc3, 0x69, 0xee, 0xeb, 0x81, 0xfb, 0x82,
-		0x6a, 0x8f, 0xb2, 0x36, 0xa1, 0x21, 0x3e, 0x77, 0x97, 0x4e, 0xed, 0x90, 0x19, 0xf4, 0x1b, 0x30,
-		0x7b, 0x80, 0x3d, 0xea, 0xa5, 0x73, 0x9f, 0x42, 0xb7, 0x02, 0xdc, 0xe6, 0x4a, 0x91, 0x16, 0x58,
-		0x12, 0xb4, 0x92, 0x13, 0x3c, 0x67, 0x20, 0x1f, 0x32, 0x88, 0x46, 0x80, 0xdb, 0x1a, 0x3a, 0xc8,
-		0x8c, 0xa9, 0xff, 0x30, 0x06, 0x0b, 0x19, 0x96, 0x72, 0x01, 0x15, 0xb3, 0x4d, 0x39, 0x29, 0xdb,
-		0xee, 0xc3, 0x64, 0x84, 0x36, 0x38, 0xea, 0x60, 0x7e, 0x11, 0xab, 0x52, 0x8c, 0x3b, 0x47, 0x1d,
-		0xac, 0x4d, 0x1c, 0xc6, 0x9e, 0x90, 0x0a, 0x93, 0x22, 0xae, 0x8f, 0x3b, 0x31, 0x6e, 0x3f, 0x87,
-		0xc5, 0x8e, 0x87, 0x0f, 0x2c, 0xb7, 0xeb, 0xeb, 0x3e, 0x71, 0x73, 0xb0, 0xd9, 0x5b, 0x7f, 0x8a,
-		0xee, 0xbb, 0x94, 0x09, 0x73, 0x1a, 0x4e, 0x70, 0xf5, 0xdd, 0xe7, 0xc4, 0x57, 0xd2, 0xe6, 0x43,
-		0xe8, 0x6d, 0x06, 0x1c, 0xe2, 0x7d, 0x1b, 0x4e, 0xd3, 0xa0, 0x8c, 0x45, 0x51, 0x11, 0xc6, 0x21,
-		0x4a, 0xc1, 0x34, 0x99, 0xba, 0x4f, 0x66, 0xc2, 0xe5, 0x37, 0x61, 0x8c, 0x06, 0x58, 0xb6, 0xe5,
-		0x07, 0x34, 0xcc, 0x1c, 0xdf, 0x38, 0x2b, 0xf6, 0x20, 0x42, 0x91, 0x1f, 0x0d, 0xf8, 0x2f, 0xf4,
-		0x00, 0xa6, 0x7d, 0xaa, 0x0e, 0x7a, 0x0f, 0xc5, 0x48, 0x3f, 0x28, 0x2a, 0x7e, 0x42, 0x8b, 0xd0,
-		0xbb, 0x30, 0xdf, 0xb4, 0x2d, 0x42, 0xa9, 0x6d, 0xed, 0x7a, 0x86, 0x77, 0xa4, 0x73, 0x79, 0xa0,
-		0x81, 0xe4, 0x98, 0x36, 0xcb, 0x66, 0x1f, 0xb2, 0x49, 0x2e, 0x3f, 0x31, 0xa8, 0x16, 0x36, 0x82,
-		0xae, 0x87, 0x23, 0xa8, 0xb1, 0x38, 0xd4, 0x7d, 0x36, 0x19, 0x42, 0x9d, 0x83, 0x71, 0x0e, 0x65,
-		0xb5, 0x3b, 0x76, 0x15, 0xe8, 0x52, 0x60, 0x43, 0x8d, 0x76, 0xc7, 0x46, 0x3e, 0x5c, 0x4a, 0x9f,
-		0x4a, 0xf7, 0x9b, 0xfb, 0xd8, 0xec, 0xda, 0x58, 0x0f, 0x5c, 0x76, 0x59, 0x34, 0xca, 0x77, 0xbb,
-		0x41, 0x75, 0xbc, 0x28, 0x20, 0x7d, 0x23, 0x79, 0xd6, 0x6d, 0x8e, 0x69, 0xc7, 0xa5, 0xf7, 0xb6,
-		0xc3, 0xd0, 0x10, 0x7f, 0x87, 0x5d, 0x15, 0x91, 0xff, 0xde, 0x41, 0x26, 0x68, 0xa2, 0x61, 0x86,
-		0x4e, 0x6d, 0x93, 0x99, 0xf0, 0x14, 0x79, 0xba, 0x3a, 0x99, 0xab, 0xab, 0x0f, 0xa1, 0x12, 0xc9,
-		0xb6, 0x4f, 0x94, 0xa9, 0x5a, 0xa1, 0x49, 0x85, 0xf3, 0xc9, 0xab, 0x62, 0x99, 0x9e, 0xb8, 0x7c,
-		0x33, 0xcd, 0x8b, 0x14, 0x83, 0x3e, 0xa2, 0x26, 0xcc, 0x46, 0xd8, 0x9a, 0xb6, 0xeb, 0x63, 0x8e,
-		0x73, 0x8a, 0xe2, 0xbc, 0xdc, 0xa7, 0x37, 0x42, 0x00, 0x09, 0xbe, 0xae, 0xaf, 0x45, 0xfa, 0x1c,
-		0x0d, 0x12, 0x2d, 0x9f, 0x49, 0x9a, 0x17, 0xe2, 0x22, 0x4c, 0x8b, 0x5e, 0xb8, 0x3d, 0xaa, 0x13,
-		0xc6, 0xc5, 0xc2, 0xbe, 0x36, 0x7d, 0x90, 0x1a, 0x41, 0xb7, 0x60, 0xc9, 0x22, 0x3a, 0x97, 0xba,
-		0x63, 0xec, 0x10, 0x3b, 0x63, 0x56, 0x67, 0xa8, 0x8f, 0xb9, 0x60, 0xf9, 0x49, 0x53, 0x7f, 0x8f,
-		0x4d, 0xa3, 0x55, 0x98, 0x08, 0x6d, 0x9d, 0x6f, 0x7d, 0x86, 0xab, 0x88, 0xa9, 0x36, 0x1f, 0xdb,
-		0xb6, 0x3e, 0xc3, 0xea, 0x2f, 0x14, 0x58, 0x78, 0xea, 0xda, 0xf6, 0xff, 0xaf, 0xb7, 0x81, 0xfa,
-		0x93, 0x51, 0xa8, 0x66, 0x8f, 0xfd, 0x8d, 0xc5, 0xfe, 0xc6, 0x62, 0x7f, 0x1d, 0x2d, 0x76, 0x9e,
-		0x7e, 0x4c, 0xe4, 0x5a, 0x60, 0xa1, 0x39, 0x9b, 0x3c, 0xb1, 0x39, 0xfb, 0xe5, 0x33, 0xec, 0xea,
-		0xbf, 0x94, 0x60, 0x45, 0xc3, 0x4d, 0xd7, 0x33, 0xe3, 0x89, 0x5a, 0xae, 0x16, 0xaf, 0xd3, 0x52,
-		0x9e, 0x83, 0xf1, 0x48, 0x70, 0x22, 0x23, 0x00, 0xe1, 0x50, 0xc3, 0x44, 0x0b, 0x30, 0x42, 0x65,
-		0x8c, 0x6b, 0x7c, 0x59, 0x1b, 0x26, 0x8f, 0x0d, 0x13, 0x9d, 0x05, 0xe0, 0x71, 0x44, 0xa8, 0xbb,
-		0x63, 0xda, 0x18, 0x1f, 0x69, 0x98, 0x48, 0x83, 0x89, 0x8e, 0x6b, 0xdb, 0x7a, 0x18, 0xab, 0x0c,
-		0x4b, 0x62, 0x15, 0x62, 0x43, 0xef, 0xbb, 0x5e, 0x9c, 0x35, 0x61, 0xac, 0x32, 0x4e, 0x90, 0xf0,
-		0x07, 0xf5, 0xf7, 0x46, 0x61, 0x55, 0xc2, 0x45, 0x6e, 0x78, 0x33, 0x16, 0x52, 0x39, 0x9e, 0x85,
-		0x94, 0x5a, 0xbf, 0xd2, 0xf1, 0xad, 0xdf, 0xb7, 0x01, 0x85, 0xfc, 0x35, 0xd3, 0xe6, 0x77, 0x3a,
-		0x9a, 0x09, 0x57, 0xaf, 0x11, 0x03, 0x26, 0x30, 0xbd, 0x65, 0x62, 0xa1, 0x12, 0x78, 0x33, 0x16,
-		0x7d, 0x28, 0x6b, 0xd1, 0x63, 0x25, 0x9d, 0xe1, 0x64, 0x49, 0xe7, 0x3a, 0x54, 0xb9, 0x49, 0xe9,
-		0x25, 0x40, 0x42, 0x07, 0x61, 0x84, 0x3a, 0x08, 0xf3, 0x6c, 0x3e, 0x92, 0x9d, 0xd0, 0x3f, 0xd0,
-		0x60, 0x32, 0x2a, 0x5d, 0xd0, 0x94, 0x09, 0xab, 0x85, 0xbc, 0x9d, 0xa7, 0x8d, 0x3b, 0x9e, 0xe1,
-		0xf8, 0xc4, 0x94, 0x25, 0xd2, 0x04, 0x13, 0x66, 0xec, 0x09, 0x7d, 0x0a, 0x67, 0x04, 0x09, 0x99,
-		0x9e, 0x09, 0x1f, 0xeb, 0xc7, 0x84, 0x2f, 0x66, 0xc4, 0x3d, 0xb2, 0xe6, 0x39, 0xde, 0x27, 0xe4,
-		0x79, 0x9f, 0xab, 0x30, 0x91, 0xb0, 0x79, 0xe3, 0xd4, 0xe6, 0x8d, 0xef, 0xc6, 0x8c, 0xdd, 0x1d,
-		0xa8, 0xf4, 0xae, 0x95, 0x96, 0xc4, 0x26, 0x0a, 0x4b, 0x62, 0x93, 0x11, 0x04, 0xad, 0x88, 0x7d,
-		0x00, 0x13, 0xe1, 0x5d, 0x53, 0x04, 0x93, 0x85, 0x08, 0xc6, 0xf9, 0x7a, 0x0a, 0x6e, 0xc0, 0xc8,
-		0xcb, 0x2e, 0xa6, 0x46, 0xb6, 0x42, 0xf3, 0x3f, 0x0f, 0x72, 0xb3, 0xe0, 0x85, 0x5a, 0x44, 0x53,
-		0x14, 0x16, 0xf6, 0x59, 0xde, 0x3b, 0xc4, 0x9b, 0xf1, 0x05, 0xa7, 0x32, 0xbe, 0x60, 0xed, 0x53,
-		0x98, 0x88, 0xc3, 0x0a, 0x52, 0xe1, 0xd7, 0xe3, 0xa9, 0xf0, 0xbc, 0x14, 0x49, 0xa8, 0x98, 0x2c,
-		0x55, 0x12, 0x4b, 0x97, 0xf7, 0x4c, 0x69, 0x98, 0x18, 0xfb, 0xc6, 0x94, 0x66, 0x4c, 0x69, 0x9c,
-		0x35, 0x42, 0x53, 0xfa, 0xb3, 0x72, 0x68, 0x4a, 0x85, 0x5c, 0xe4, 0xa6, 0xf4, 0x23, 0x98, 0x4a,
-		0x99, 0x2a, 0xa9, 0x31, 0xe5, 0xc9, 0x0c, 0x6a, 0x6c, 0xb4, 0x4a, 0xd2, 0x94, 0x65, 0x84, 0xbb,
-		0x34, 0x98, 0x70, 0xc7, 0x2c, 0x57, 0x39, 0x69, 0xb9, 0x3e, 0x85, 0xe5, 0xa4, 0xe2, 0xe9, 0x6e,
-		0x4b, 0x0f, 0xf6, 0x2d, 0x5f, 0x8f, 0x57, 0xaf, 0xe5, 0x5b, 0xd5, 0x12, 0x8a, 0xf8, 0xa4, 0xb5,
-		0xb3, 0x6f, 0xf9, 0x77, 0x38, 0xfe, 0x06, 0xcc, 0xec, 0x63, 0xc3, 0x0b, 0x76, 0xb1, 0x11, 0xe8,
-		0x26, 0x0e, 0x0c, 0xcb, 0xf6, 0x79, 0xc2, 0x47, 0x9e, 0x20, 0x9c, 0x8e, 0xc0, 0xb6, 0x18, 0x54,
-		0xf6, 0xd5, 0x34, 0x7c, 0xbc, 0x57, 0xd3, 0x45, 0x98, 0x8a, 0xf0, 0x30, 0xb1, 0xa6, 0x36, 0x7a,
-		0x4c, 0x8b, 0x1c, 0xa3, 0x2d, 0x3a, 0xaa, 0xfe, 0xb9, 0x02, 0xdf, 0x62, 0xb7, 0x99, 0x50, 0x76,
-		0x5e, 0x84, 0xee, 0xe9, 0x8b, 0x96, 0x4e, 0x2a, 0x5e, 0xcf, 0x4b, 0x2a, 0x16, 0xa1, 0xea, 0x33,
-		0xbb, 0xf8, 0x77, 0x65, 0x78, 0x43, 0x8e, 0x8d, 0x8b, 0x20, 0xee, 0xbd, 0xff, 0x3c, 0x3e, 0xc6,
-		0x49, 0xbc, 0x79, 0x7c, 0xeb, 0xa6, 0x4d, 0xf9, 0x29, 0x49, 0xff, 0xb1, 0x02, 0xcb, 0xbd, 0xb4,
-		0x3c, 0xf1, 0xa1, 0x4d, 0xcb, 0xef, 0x18, 0x41, 0x73, 0x5f, 0xb7, 0xdd, 0xa6, 0x61, 0xdb, 0x47,
-		0xd5, 0x12, 0xb5, 0xa9, 0x9f, 0x4a, 0x76, 0x2d, 0x3e, 0x4e, 0xbd, 0x97, 0xb7, 0xdf, 0x71, 0xb7,
-		0xf8, 0x0e, 0x0f, 0xd9, 0x06, 0xcc, 0xd4, 0x2e, 0x19, 0xf9, 0x2b, 0x6a, 0xbf, 0x03, 0x2b, 0x45,
-		0x08, 0x04, 0xf6, 0x76, 0x2b, 0x69, 0x6f, 0xc5, 0x55, 0x81, 0xd0, 0x0c, 0x50, 0x5c, 0x21, 0x62,
-		0xfa, 0x66, 0x8e, 0xd9, 0xde, 0x1f, 0x2a, 0xc4, 0xf6, 0x66, 0x8e, 0x79, 0xdf, 0xb0, 0xec, 0x9e,
-		0x2c, 0xf5, 0x59, 0x4e, 0x2a, 0xc2, 0xd3, 0xa7, 0x20, 0x7d, 0x8b, 0xd8, 0xb1, 0x5c, 0x4c, 0x3c,
-		0x59, 0xfd, 0xa7, 0x0a, 0xa8, 0x59, 0x6b, 0xf7, 0x61, 0xa8, 0x9e, 0x21, 0xe5, 0xcf, 0xd2, 0x94,
-		0x5f, 0xcb, 0xa1, 0xbc, 0x08, 0x53, 0x9f, 0xb4, 0x3f, 0x25, 0xca, 0x29, 0xc1, 0xc5, 0x65, 0xf3,
-		0x4d, 0x98, 0x6e, 0x1a, 0x4e, 0x13, 0x47, 0x6f, 0x00, 0xcc, 0xde, 0x69, 0xa3, 0xda, 0x14, 0x1b,
-		0xd7, 0xc2, 0xe1, 0xb8, 0xbe, 0xc7, 0x71, 0x9e, 0x50, 0xdf, 0x65, 0xa8, 0xfa, 0x3c, 0xea, 0x85,
-		0x48, 0xdd, 0x73, 0x90, 0xc5, 0x0a, 0x96, 0x82, 0x85, 0x27, 0x91, 0xb0, 0x5c, 0x3c, 0x03, 0x4b,
-		0x98, 0x08, 0x53, 0x42, 0xc2, 0xb2, 0x07, 0xa4, 0xf7, 0xd3, 0xa3, 0xbc, 0x6f, 0x09, 0x2b, 0xc2,
-		0xd4, 0x27, 0xed, 0xe7, 0xc5, 0xe2, 0x10, 0xe1, 0xe2, 0xd4, 0xff, 0xbd, 0x02, 0xe7, 0x34, 0xdc,
-		0x76, 0x0f, 0x30, 0xeb, 0x44, 0xf8, 0xaa, 0xe4, 0xf1, 0x92, 0x8e, 0x51, 0x39, 0xe5, 0x18, 0xa9,
-		0x2a, 0x91, 0x95, 0x3c, 0xaa, 0xf9, 0xd1, 0xfe, 0xb1, 0x04, 0xe7, 0xf9, 0x11, 0xd8, 0xb1, 0x73,
-		0xcb, 0xe0, 0xd2, 0x03, 0x1a, 0x50, 0x49, 0xea, 0x20, 0x3f, 0xdc, 0xcd, 0x9c, 0xfb, 0xeb, 0x63,
-		0x43, 0x6d, 0x32, 0xa1, 0xbd, 0x68, 0x17, 0x16, 0xa2, 0x4e, 0x03, 0x61, 0x3b, 0x9f, 0xb8, 0x08,
-		0x7d, 0x8f, 0xc3, 0xa4, 0x8a, 0xd0, 0x58, 0x34, 0x3c, 0x70, 0x97, 0xc1, 0x1a, 0x5c, 0x28, 0x3a,
-		0x0b, 0xe7, 0xf3, 0x3f, 0x2b, 0xb0, 0x14, 0x26, 0x8e, 0x04, 0x81, 0xfc, 0x6b, 0x11, 0x9f, 0x4b,
-		0x30, 0x63, 0xf9, 0x7a, 0xb2, 0xbb, 0x8e, 0xf2, 0x72, 0x54, 0x9b, 0xb2, 0xfc, 0xfb, 0xf1, 0xbe,
-		0x39, 0x75, 0x19, 0xce, 0x88, 0xc9, 0xe7, 0xe7, 0xfb, 0x82, 0x3a, 0x2c, 0xc4, 0x58, 0x27, 0x0b,
-		0xe7, 0x19, 0xd3, 0xfa, 0x3a, 0x0e, 0xba, 0x0a, 0x13, 0xbc, 0x75, 0x12, 0x9b, 0xb1, 0x5c, 0x6e,
-		0x34, 0xd6, 0x30, 0xd1, 0x27, 0x70, 0xba, 0x19, 0x92, 0x1a, 0xdb, 0xfa, 0xd4, 0x40, 0x5b, 0xa3,
-		0x08, 0x45, 0x6f, 0xef, 0x87, 0x30, 0x1d, 0x6b, 0x87, 0x64, 0x41, 0xc2, 0x50, 0xbf, 0x41, 0xc2,
-		0x54, 0x0f, 0x94, 0x45, 0x09, 0x67, 0x01, 0x42, 0x77, 0xcf, 0x32, 0xa9, 0x7b, 0x5c, 0xd6, 0xc6,
-		0xf8, 0x48, 0xc3, 0x54, 0x2f, 0x12, 0x65, 0x96, 0x5e, 0x02, 0xbf, 0xae, 0xff, 0x28, 0x41, 0x55,
-		0xe3, 0xbd, 0xc2, 0x98, 0xa2, 0xf6, 0x9f, 0x6f, 0xbc, 0xce, 0x2b, 0xfa, 0x2d, 0x98, 0x13, 0x55,
-		0x8e, 0xc3, 0x0e, 0x90, 0x01, 0x4a, 0xc7, 0xa7, 0xb3, 0xa5, 0x63, 0x1f, 0xbd, 0x07, 0xc3, 0x94,
-		0xf5, 0x3e, 0xbf, 0x51, 0x71, 0x6a, 0x64, 0xcb, 0x08, 0x8c, 0xbb, 0xb6, 0xbb, 0xab, 0xf1, 0xc5,
-		0x68, 0x13, 0x2a, 0x0e, 0x3e, 0xd4, 0xbd, 0x2e, 0xbf, 0xb9, 0x30, 0xb0, 0x29, 0x00, 0x9f, 0x70,
-		0xf0, 0xa1, 0xd6, 0x65, 0x57, 0xe6, 0xab, 0x4b, 0xb0, 0x28, 0x60, 0x35, 0xbf, 0x88, 0xef, 0x2b,
-		0x30, 0xbf, 0x7d, 0xe4, 0x34, 0xb7, 0xf7, 0x0d, 0xcf, 0xe4, 0x19, 0x52, 0x7e, 0x0d, 0xe7, 0xa1,
-		0xe2, 0xbb, 0x5d, 0xaf, 0x89, 0x75, 0xde, 0x42, 0xce, 0xef, 0x62, 0x92, 0x8d, 0x6e, 0xb2, 0x41,
-		0xb4, 0x08, 0xa3, 0x3e, 0x01, 0x0e, 0xdf, 0x6f, 0x43, 0xda, 0x08, 0x7d, 0x6e, 0x98, 0xa8, 0x0e,
-		0xa7, 0x68, 0x2c, 0x59, 0x2e, 0x0c, 0xf0, 0xe8, 0x3a, 0x75, 0x11, 0x16, 0x32, 0xb4, 0x70, 0x3a,
-		0xff, 0x75, 0x08, 0x4e, 0x93, 0xb9, 0xf0, 0x3d, 0xf9, 0x3a, 0x65, 0xa5, 0x0a, 0x23, 0x61, 0x46,
-		0x8a, 0x69, 0x72, 0xf8, 0x48, 0x14, 0xbd, 0x17, 0xeb, 0x46, 0x79, 0x84, 0x28, 0xef, 0x40, 0x78,
-		0x92, 0xcd, 0x43, 0x0d, 0x0d, 0x9a, 0x87, 0x92, 0x2b, 0x61, 0x26, 0x92, 0x1f, 0x19, 0x2c, 0x92,
-		0xff, 0x88, 0x57, 0x7f, 0x7a, 0x41, 0x35, 0xc5, 0x32, 0x5a, 0x88, 0x65, 0x86, 0x80, 0x45, 0xee,
-		0x31, 0xc5, 0x75, 0x15, 0x46, 0xc2, 0x88, 0x7c, 0xac, 0x8f, 0x88, 0x3c, 0x5c, 0x1c, 0xcf, 0x26,
-		0x40, 0x32, 0x9b, 0x70, 0x1b, 0x26, 0x58, 0x6d, 0x8a, 0x37, 0x8a, 0x8f, 0xf7, 0xd1, 0x28, 0x3e,
-		0x4e, 0x4b, 0x56, 0xbc, 0x47, 0xfc, 0x1d, 0xa0, 0x7d, 0xde, 0xfc, 0xd3, 0x09, 0xdd, 0x32, 0xb1,
-		0x13, 0x58, 0xc1, 0x11, 0xcd, 0x06, 0x8e, 0x69, 0x88, 0xcc, 0x7d, 0x42, 0xa7, 0x1a, 0x7c, 0x06,
-		0x3d, 0x86, 0xa9, 0x94, 0x69, 0xe0, 0x99, 0xbf, 0xf3, 0x7d, 0x19, 0x05, 0xad, 0x92, 0x34, 0x08,
-		0xea, 0x3c, 0xcc, 0x26, 0x25, 0x99, 0x8b, 0xf8, 0x9f, 0x28, 0xb0, 0x14, 0x76, 0xde, 0x7d, 0x45,
-		0x3c, 0x3c, 0xf5, 0x8f, 0x15, 0x38, 0x23, 0xa6, 0x89, 0x07, 0x3f, 0x57, 0x60, 0xbe, 0xcd, 0xc6,
-		0x59, 0x5d, 0x46, 0xb7, 0x1c, 0xbd, 0x69, 0x34, 0xf7, 0x31, 0xa7, 0xf0, 0x74, 0x3b, 0x06, 0xd5,
-		0x70, 0x36, 0xc9, 0x14, 0xba, 0x01, 0x8b, 0x19, 0x20, 0xd3, 0x08, 0x8c, 0x5d, 0xc3, 0x0f, 0x1b,
-		0x70, 0xe7, 0x93, 0x70, 0x5b, 0x7c, 0x56, 0x3d, 0x03, 0xb5, 0x90, 0x1e, 0xce, 0xcf, 0x0f, 0xdd,
-		0xa8, 0x75, 0x4a, 0xfd, 0xdd, 0x52, 0x8f, 0x85, 0x89, 0x69, 0x4e, 0xed, 0x1a, 0x4c, 0x3b, 0xdd,
-		0xf6, 0x2e, 0xf6, 0x74, 0xb7, 0xa5, 0x53, 0x2b, 0xe5, 0x53, 0x3a, 0x87, 0xb4, 0x0a, 0x1b, 0x7f,
-		0xd2, 0xa2, 0xc6, 0xc7, 0x27, 0xcc, 0x0e, 0xad, 0x9a, 0x4f, 0x53, 0x0b, 0x43, 0xda, 0x28, 0x37,
-		0x6b, 0x3e, 0x6a, 0xc0, 0x04, 0xbf, 0x09, 0x76, 0x54, 0x71, 0x97, 0x69, 0x28, 0x0e, 0x2c, 0xd7,
-		0x43, 0x4f, 0x4e, 0x7d, 0xbf, 0x71, 0xb3, 0x37, 0x80, 0xae, 0xc2, 0x02, 0xdb, 0xa7, 0xe9, 0x3a,
-		0x81, 0xe7, 0xda, 0x36, 0xf6, 0x28, 0x4f, 0xba, 0xec, 0x4d, 0x31, 0xa6, 0xcd, 0xd1, 0xe9, 0xcd,
-		0x68, 0x96, 0xd9, 0x45, 0xaa, 0x21, 0xa6, 0xe9, 0x61, 0xdf, 0xe7, 0x09, 0xc9, 0xf0, 0x51, 0xad,
-		0xc3, 0x0c, 0xab, 0x6c, 0x11, 0xb8, 0x50, 0x76, 0xe2, 0x46, 0x5a, 0x49, 0x18, 0x69, 0x75, 0x16,
-		0x50, 0x7c, 0x3d, 0x17, 0xc6, 0xff, 0x52, 0x60, 0x86, 0x39, 0xef, 0x71, 0x2f, 0x31, 0x1f, 0x0d,
-		0xba, 0xc5, 0xab, 0xc0, 0x51, 0xd1, 0xbb, 0xb2, 0x71, 0x2e, 0x87, 0x21, 0x04, 0x23, 0xcd, 0x9a,
-		0xd1, 0x3a, 0x30, 0xcd, 0x98, 0xc5, 0x72, 0xaf, 0xe5, 0x44, 0xee, 0x75, 0x13, 0xa6, 0x0e, 0x2c,
-		0xdf, 0xda, 0xb5, 0x6c, 0x2b, 0x38, 0x62, 0x96, 0xa8, 0x38, 0x5d, 0x58, 0xe9, 0x81, 0x50, 0x33,
-		0xb4, 0x0a, 0x13, 0xfc, 0x15, 0xa6, 0x3b, 0x06, 0xb7, 0xb8, 0x63, 0xda, 0x38, 0x1f, 0x7b, 0x6c,
-		0xb4, 0x31, 0xe1, 0x42, 0xfc, 0xb8, 0x9c, 0x0b, 0x3f, 0xa0, 0x5c, 0xf0, 0x71, 0xf0, 0xac, 0x8b,
-		0xbb, 0xb8, 0x0f, 0x2e, 0xa4, 0x77, 0x2a, 0x65, 0x76, 0x4a, 0x32, 0xaa, 0x3c, 0x20, 0xa3, 0x18,
-		0x9d, 0x3d, 0x82, 0x38, 0x9d, 0x3f, 0x52, 0x60, 0x36, 0x94, 0xfb, 0xaf, 0x0c, 0xa9, 0x4f, 0x60,
-		0x2e, 0x45, 0x13, 0xd7, 0xc2, 0xab, 0xb0, 0xd0, 0xf1, 0xdc, 0x26, 0xf6, 0x7d, 0xcb, 0xd9, 0xd3,
-		0xe9, 0x57, 0x65, 0xcc, 0x0e, 0x10, 0x65, 0x2c, 0x13, 0x99, 0xef, 0x4d, 0x53, 0x48, 0x6a, 0x04,
-		0x7c, 0xf5, 0x0b, 0x05, 0xce, 0x3e, 0xc0, 0x81, 0xd6, 0xfb, 0xc6, 0xec, 0x11, 0xf6, 0x7d, 0x63,
-		0x0f, 0x47, 0x2e, 0xcb, 0x6d, 0x18, 0xa6, 0x05, 0x20, 0x86, 0x68, 0x7c, 0xe3, 0x62, 0x0e, 0xb5,
-		0x31, 0x14, 0xb4, 0x3a, 0xa4, 0x71, 0xb0, 0x3e, 0x98, 0x42, 0x6c, 0xcc, 0x72, 0x1e, 0x15, 0xfc,
-		0x80, 0x2f, 0xa1, 0xc2, 0xb8, 0xde, 0xe6, 0x33, 0x9c, 0x9c, 0x8f, 0x72, 0x93, 0x93, 0x72, 0x84,
-		0x75, 0xaa, 0x9b, 0xe1, 0x28, 0x4b, 0x44, 0x4e, 0xfa, 0xf1, 0xb1, 0x9a, 0x0d, 0x28, 0xbb, 0x28,
-		0x9e, 0x6c, 0x1c, 0x62, 0xc9, 0xc6, 0xef, 0x26, 0x93, 0x8d, 0x97, 0x8a, 0x19, 0x14, 0x11, 0x13,
-		0x4b, 0x34, 0xb6, 0x61, 0xe5, 0x01, 0x0e, 0xb6, 0x1e, 0x3e, 0x93, 0xdc, 0x45, 0x03, 0x80, 0xa9,
-		0xb4, 0xd3, 0x72, 0x43, 0x06, 0xf4, 0xb1, 0x1d, 0x11, 0x24, 0x6a, 0x26, 0xa9, 0xe8, 0x91, 0x5f,
-		0xbe, 0xfa, 0x0a, 0x56, 0x25, 0xdb, 0x71, 0xa6, 0x6f, 0xc3, 0x4c, 0xec, 0xeb, 0x43, 0x5a, 0x8c,
-		0x0c, 0xb7, 0xbd, 0xd0, 0xdf, 0xb6, 0xda, 0xb4, 0x97, 0x1c, 0xf0, 0xd5, 0x7f, 0x57, 0x60, 0x56,
-		0xc3, 0x46, 0xa7, 0x63, 0xb3, 0x88, 0x28, 0x3a, 0xdd, 0x3c, 0x0c, 0xf3, 0xcc, 0x3e, 0x7b, 0xcf,
-		0xf1, 0x27, 0xf9, 0xc7, 0x0a, 0xe2, 0x97, 0x74, 0xf9, 0xa4, 0xfe, 0xe8, 0xf1, 0x82, 0x0b, 0x75,
-		0x01, 0xe6, 0x52, 0x47, 0xe3, 0xd6, 0xe4, 0xa7, 0x0a, 0x2c, 0x69, 0xb8, 0xe5, 0x61, 0x7f, 0x3f,
-		0x2a, 0x72, 0x10, 0x6e, 0x7c, 0x05, 0xcf, 0xae, 0x2e, 0xc3, 0x19, 0x31, 0xa9, 0xfc, 0x2c, 0x37,
-		0x60, 0x61, 0xd3, 0xed, 0x3a, 0x44, 0x78, 0xd2, 0x02, 0xba, 0x0c, 0xd0, 0x72, 0xbd, 0x26, 0xbe,
-		0x8f, 0x83, 0xe6, 0x3e, 0xcf, 0xd8, 0xc6, 0x46, 0x54, 0x03, 0xaa, 0x59, 0x50, 0x2e, 0x6c, 0xf7,
-		0x60, 0x04, 0x3b, 0x01, 0xad, 0xe5, 0x32, 0x11, 0x7b, 0x2b, 0x47, 0xc4, 0xb8, 0x17, 0xb2, 0xf5,
-		0xf0, 0x19, 0xc5, 0xc5, 0xeb, 0xb5, 0x1c, 0x56, 0xfd, 0x69, 0x09, 0xe6, 0x35, 0x6c, 0x98, 0x02,
-		0xea, 0x36, 0xe0, 0x54, 0xd4, 0x1d, 0x51, 0xd9, 0x58, 0xce, 0xf3, 0x2d, 0x1e, 0x3e, 0xa3, 0x56,
-		0x97, 0xae, 0x95, 0x85, 0x62, 0xd9, 0x60, 0xae, 0x2c, 0x0a, 0xe6, 0x76, 0xa0, 0x6a, 0x39, 0x64,
-		0x85, 0x75, 0x80, 0x75, 0xec, 0x44, 0x16, 0xac, 0xcf, 0x8e, 0xb2, 0xb9, 0x08, 0xf8, 0x9e, 0x13,
-		0x9a, 0xa2, 0x86, 0x49, 0x04, 0xa3, 0x43, 0x90, 0xd0, 0x9a, 0xf4, 0x10, 0x25, 0x6c, 0x94, 0x0c,
-		0x6c, 0x5b, 0x9f, 0x61, 0x74, 0x01, 0xa6, 0x68, 0x5f, 0x04, 0x5d, 0xc1, 0xca, 0xf7, 0xc3, 0xb4,
-		0x7c, 0x4f, 0xdb, 0x25, 0x9e, 0x1a, 0x7b, 0x98, 0x75, 0xf3, 0xfd, 0x6d, 0x09, 0x16, 0x32, 0xbc,
-		0xe2, 0xd7, 0x71, 0x1c, 0x66, 0x09, 0xed, 0x45, 0xe9, 0x64, 0xf6, 0x02, 0x7d, 0x0f, 0xe6, 0x33,
-		0x48, 0xc3, 0x1c, 0xe1, 0xa0, 0x06, 0x70, 0x36, 0x8d, 0x9d, 0xa6, 0x08, 0x05, 0xec, 0x3a, 0x25,
-		0x62, 0xd7, 0xcf, 0x15, 0x58, 0x78, 0xda, 0xf5, 0xf6, 0xf0, 0xd7, 0x5b, 0xb6, 0xd4, 0x1a, 0x54,
-		0xb3, 0xc7, 0xe4, 0xca, 0xff, 0x65, 0x09, 0x16, 0x1e, 0xe1, 0xaf, 0x3d, 0x0f, 0xfe, 0x77, 0xf4,
-		0xeb, 0x2e, 0x54, 0xb3, 0xbc, 0xe2, 0xfa, 0x25, 0xc0, 0xa1, 0x88, 0x70, 0x7c, 0xae, 0xc0, 0x99,
-		0xc7, 0x6e, 0x60, 0xb5, 0x8e, 0x48, 0xb8, 0xed, 0x1e, 0x60, 0xef, 0x91, 0x41, 0x62, 0xe9, 0x88,
-		0xeb, 0xdf, 0x83, 0xf9, 0x16, 0x9f, 0xd1, 0xdb, 0x74, 0x4a, 0x4f, 0x38, 0x6c, 0x79, 0xfa, 0x91,
-		0x44, 0xc7, 0x7c, 0xb6, 0xd9, 0x56, 0x76, 0xd0, 0x57, 0xcf, 0xc1, 0xd9, 0x1c, 0x0a, 0xb8, 0x50,
-		0x18, 0xb0, 0xf4, 0x00, 0x07, 0x9b, 0x9e, 0xeb, 0xfb, 0xfc, 0x56, 0x12, 0x2f, 0xb7, 0x44, 0xe0,
-		0xa7, 0xa4, 0x02, 0xbf, 0xf3, 0x50, 0x09, 0x0c, 0x6f, 0x0f, 0x07, 0xd1, 0x2d, 0xb3, 0xd7, 0xdc,
-		0x24, 0x1b, 0xe5, 0xf8, 0xd4, 0x5f, 0x94, 0xe1, 0x8c, 0x78, 0x0f, 0xce, 0xcf, 0x36, 0xc1, 0x43,
-		0x4c, 0xc3, 0xee, 0x11, 0x0b, 0x43, 0xf9, 0xf1, 0x1f, 0xc8, 0x1c, 0xc4, 0x5c, 0x74, 0xd4, 0xf9,
-		0xf6, 0xef, 0x1e, 0x51, 0x07, 0x90, 0xbd, 0x61, 0x26, 0x82, 0xd8, 0x10, 0xfa, 0x5c, 0x81, 0xb9,
-		0x16, 0x2d, 0x88, 0xe9, 0x4d, 0xa3, 0xeb, 0xe3, 0xde, 0xb6, 0xcc, 0xde, 0x3d, 0x3a, 0xde, 0xb6,
-		0xac, 0xc6, 0xb6, 0x49, 0x30, 0x26, 0x36, 0x47, 0xad, 0xcc, 0x44, 0xad, 0x03, 0x33, 0x19, 0x2a,
-		0x05, 0xee, 0xe9, 0xbd, 0xa4, 0x7b, 0xba, 0x9e, 0x23, 0x0e, 0x69, 0x9a, 0xf8, 0xe5, 0xc5, 0x7d,
-		0xd4, 0x5a, 0x07, 0x16, 0x72, 0x08, 0x14, 0xec, 0x7b, 0x3b, 0xbe, 0x6f, 0x25, 0x37, 0xdd, 0xfb,
-		0x00, 0x07, 0xbd, 0xe2, 0x22, 0xc5, 0x1b, 0xf7, 0x8a, 0xff, 0x53, 0x81, 0x35, 0x5e, 0xce, 0xcb,
-		0x30, 0x2d, 0x53, 0x87, 0x90, 0x44, 0x66, 0xfd, 0x49, 0x19, 0x7a, 0xce, 0x84, 0x28, 0xea, 0xbb,
-		0x08, 0x73, 0xd5, 0xfd, 0x33, 0x8d, 0x77, 0x5b, 0x4c, 0x06, 0xb1, 0x27, 0x1f, 0xbd, 0x01, 0x93,
-		0x2d, 0xe2, 0x00, 0x3d, 0xc6, 0xcc, 0x97, 0xe2, 0xe5, 0xa7, 0xe4, 0xa0, 0xea, 0xc1, 0x9b, 0x7d,
-		0x9c, 0x35, 0x72, 0x97, 0x86, 0x42, 0x7f, 0xfc, 0x78, 0xd7, 0x4a, 0xa1, 0xd5, 0xf7, 0xe8, 0x37,
-		0x6d, 0xa1, 0x62, 0xd3, 0x97, 0x64, 0x1f, 0xb9, 0x31, 0x35, 0xa0, 0xdf, 0x6d, 0x25, 0xc1, 0x22,
-		0xc7, 0x61, 0xae, 0x57, 0x76, 0x09, 0x13, 0x31, 0x5d, 0xde, 0x47, 0x35, 0xa4, 0xf5, 0x6a, 0x32,
-		0xdb, 0x2c, 0x0b, 0xd3, 0x75, 0x68, 0x5e, 0x3c, 0xfc, 0xea, 0x92, 0xa7, 0x90, 0x58, 0x7e, 0x68,
-		0x92, 0x8f, 0xb2, 0x0c, 0x92, 0xda, 0x80, 0x79, 0xcd, 0x08, 0xb0, 0x6d, 0xb5, 0xad, 0xe0, 0xe3,
-		0x8e, 0x19, 0x4b, 0xe4, 0xad, 0xc3, 0x29, 0xd3, 0x08, 0x0c, 0xce, 0x8c, 0xa5, 0xbc, 0x46, 0xcc,
-		0x3b, 0xce, 0x91, 0x46, 0x17, 0xaa, 0x1f, 0xc1, 0x42, 0x06, 0x15, 0x3f, 0xc0, 0xa0, 0xb8, 0x36,
-		0xfe, 0xa9, 0x0e, 0xc0, 0x9d, 0xd2, 0x3b, 0x4f, 0x1b, 0xe8, 0x0f, 0x15, 0x98, 0x17, 0x7f, 0xd4,
-		0x8e, 0xae, 0x1e, 0xef, 0x5f, 0x28, 0x6a, 0xd7, 0x06, 0x86, 0xe3, 0x67, 0xf9, 0x23, 0x05, 0x16,
-		0x72, 0xfe, 0xf5, 0x00, 0x5d, 0x2b, 0xfa, 0xc7, 0x80, 0x3c, 0x6a, 0xae, 0x0f, 0x0e, 0xc8, 0xc9,
-		0xf9, 0x89, 0x02, 0x2b, 0x45, 0x5f, 0xfe, 0xa3, 0xef, 0x9e, 0xf4, 0x9f, 0x0c, 0x6a, 0x77, 0x4e,
-		0x80, 0x81, 0x53, 0x4a, 0x2e, 0x51, 0xfc, 0x4d, 0xbf, 0xe4, 0x12, 0xa5, 0xff, 0x25, 0x20, 0xb9,
-		0xc4, 0x82, 0x3f, 0x0f, 0xf8, 0x33, 0x05, 0x6a, 0xf9, 0x5f, 0xbe, 0xa3, 0xfc, 0xae, 0xb0, 0xc2,
-		0x7f, 0x04, 0xa8, 0xbd, 0x7f, 0x2c, 0x58, 0x4e, 0xd7, 0x8f, 0x14, 0x58, 0xcc, 0xfd, 0xae, 0x1d,
-		0xdd, 0xc8, 0x45, 0x5d, 0xf4, 0x59, 0x7d, 0xed, 0xe6, 0x71, 0x40, 0x39, 0x51, 0x0e, 0x4c, 0x26,
-		0x3e, 0x78, 0x46, 0x6f, 0xe7, 0x22, 0x13, 0x7d, 0x57, 0x5d, 0xab, 0xf7, 0xbb, 0x9c, 0xef, 0xf7,
-		0xb9, 0x02, 0xa7, 0x05, 0x5f, 0x0d, 0xa3, 0x2b, 0xf2, 0xdb, 0x16, 0x7e, 0xa7, 0x5c, 0x7b, 0x77,
-		0x30, 0x20, 0x4e, 0x42, 0x00, 0x53, 0xa9, 0x8f, 0x68, 0xd1, 0xba, 0xcc, 0xfd, 0x10, 0x54, 0x42,
-		0x6a, 0xef, 0xf4, 0x0f, 0xc0, 0x77, 0x3d, 0x84, 0xe9, 0xf4, 0x97, 0x60, 0x28, 0x1f, 0x4b, 0xce,
-		0xb7, 0x72, 0xb5, 0xcb, 0x03, 0x40, 0xc4, 0xc4, 0x2e, 0xb7, 0xdf, 0x51, 0x22, 0x76, 0x45, 0x5f,
-		0xa3, 0xd4, 0x4e, 0xd0, 0x5e, 0x89, 0xfe, 0x52, 0x81, 0x33, 0xb2, 0x76, 0x48, 0x74, 0xeb, 0x98,
-		0x5d, 0x94, 0x8c, 0xb4, 0x0f, 0x4e, 0xd4, 0x83, 0xc9, 0x59, 0x96, 0xd3, 0x33, 0x28, 0x65, 0x99,
-		0xbc, 0x63, 0x51, 0xca, 0xb2, 0x82, 0x16, 0xc5, 0xd8, 0x3d, 0x0a, 0x1a, 0xb2, 0x0b, 0xef, 0x31,
-		0xbf, 0x15, 0xbe, 0xf0, 0x1e, 0x65, 0xfd, 0xdf, 0xb1, 0x7b, 0x14, 0xb6, 0xed, 0x15, 0xdf, 0xa3,
-		0xac, 0x75, 0xb0, 0xf8, 0x1e, 0xa5, 0xbd, 0x82, 0xf1, 0x7b, 0xcc, 0x76, 0xe6, 0x15, 0xdf, 0x63,
-		0x6e, 0x5f, 0x60, 0xf1, 0x3d, 0xe6, 0x37, 0x02, 0xa2, 0xbf, 0xa0, 0xb9, 0xcd, 0xdc, 0x96, 0x3b,
-		0xf4, 0xfe, 0x40, 0x67, 0x4e, 0x36, 0xfd, 0xd5, 0x6e, 0x1d, 0x0f, 0x38, 0x41, 0x5a, 0x6e, 0xbf,
-		0xa9, 0x94, 0xb4, 0xa2, 0x8e, 0x57, 0x29, 0x69, 0xc5, 0x2d, 0xae, 0x7f, 0xad, 0xc0, 0xb2, 0xbc,
-		0xd1, 0x0c, 0x7d, 0x47, 0xb2, 0x41, 0x1f, 0xdd, 0x76, 0xb5, 0xdb, 0xc7, 0x86, 0xe7, 0x34, 0xfe,
-		0x40, 0x81, 0x6a, 0x5e, 0xbb, 0x21, 0xba, 0x2e, 0xc1, 0x2e, 0xed, 0xab, 0xac, 0xdd, 0x38, 0x06,
-		0x24, 0xa7, 0xe8, 0x0b, 0x05, 0x66, 0x45, 0x4d, 0x6b, 0x28, 0xff, 0xcd, 0x29, 0x69, 0xd1, 0xab,
-		0xbd, 0x37, 0x20, 0x14, 0xa7, 0xe2, 0xaf, 0xe8, 0x9f, 0x4f, 0x49, 0x9a, 0xb2, 0xd0, 0x07, 0x05,
-		0xb2, 0x21, 0xef, 0xa8, 0xab, 0x7d, 0xe7, 0xb8, 0xe0, 0x9c, 0xc0, 0xcf, 0x60, 0x26, 0xd3, 0x9f,
-		0x84, 0x2e, 0x4b, 0x90, 0x8a, 0xdb, 0xc6, 0x6a, 0x1b, 0x83, 0x80, 0xf4, 0xbc, 0x91, 0x54, 0xc7,
-		0x91, 0xc4, 0x1b, 0x11, 0xf7, 0x49, 0x49, 0xbc, 0x91, 0x9c, 0x66, 0x26, 0xf4, 0x02, 0x26, 0xe2,
-		0x1d, 0x20, 0xe8, 0xdb, 0x52, 0x0c, 0xa9, 0x96, 0xa7, 0xda, 0xdb, 0x7d, 0xae, 0x8e, 0x49, 0xa1,
-		0xa8, 0x85, 0x43, 0x22, 0x85, 0x92, 0x2e, 0x14, 0x89, 0x14, 0x4a, 0xfb, 0x44, 0x88, 0xe7, 0x29,
-		0xe8, 0xcc, 0x90, 0x78, 0x9e, 0xf9, 0x6d, 0x1e, 0xb5, 0x77, 0x07, 0x03, 0x8a, 0x3e, 0x55, 0x81,
-		0x5e, 0xa3, 0x03, 0xba, 0x94, 0x8b, 0x23, 0xd3, 0x3d, 0x51, 0x7b, 0xab, 0xaf, 0xb5, 0xbd, 0x6d,
-		0x7a, 0x9d, 0x04, 0x92, 0x6d, 0x32, 0xdd, 0x15, 0x92, 0x6d, 0xb2, 0xad, 0x09, 0x6c, 0x9b, 0xb0,
-		0x11, 0x40, 0xba, 0x4d, 0xaa, 0x7d, 0x41, 0xba, 0x4d, 0xba, 0xb3, 0x80, 0x44, 0x28, 0x89, 0x22,
-		0xbe, 0x24, 0x42, 0x11, 0x35, 0x20, 0x48, 0x22, 0x14, 0x71, 0x6f, 0x00, 0x09, 0x65, 0xc5, 0xc5,
-		0x70, 0x49, 0x28, 0x2b, 0x6d, 0x0a, 0x90, 0x84, 0xb2, 0x05, 0x65, 0x7c, 0xe2, 0xc0, 0xe4, 0xd6,
-		0x9d, 0x25, 0x0e, 0x4c, 0x51, 0x69, 0x5c, 0xe2, 0xc0, 0x14, 0x97, 0xb9, 0x1d, 0x98, 0x4c, 0x54,
-		0x6d, 0x25, 0x17, 0x22, 0x2a, 0x5c, 0x4b, 0x2e, 0x44, 0x58, 0x0c, 0xa6, 0xe6, 0x43, 0x54, 0x61,
-		0x45, 0xb2, 0xf0, 0x2f, 0xb7, 0x76, 0x2c, 0x31, 0x1f, 0xb2, 0x32, 0x2e, 0x89, 0xdf, 0xd2, 0xb5,
-		0x58, 0x49, 0xfc, 0x96, 0x53, 0xf1, 0x95, 0xc4, 0x6f, 0xb9, 0x85, 0xde, 0x00, 0xa6, 0x52, 0x45,
-		0x47, 0xc9, 0x0b, 0x42, 0x5c, 0xca, 0x95, 0xbc, 0x20, 0xf2, 0xea, 0x99, 0x24, 0x5c, 0x4d, 0x15,
-		0xb5, 0x64, 0xe1, 0xaa, 0xb8, 0xcc, 0x27, 0x0b, 0x57, 0x73, 0x2a, 0x66, 0x64, 0xe3, 0x74, 0x11,
-		0x48, 0xb2, 0x71, 0x4e, 0x6d, 0x4d, 0xb2, 0x71, 0x6e, 0x85, 0xe9, 0x0f, 0x14, 0x98, 0x13, 0xd6,
-		0x6d, 0x50, 0xbe, 0xc4, 0xc8, 0x2a, 0x4d, 0xb5, 0xab, 0x83, 0x82, 0xc5, 0xe4, 0x5d, 0x54, 0xf5,
-		0x90, 0xc8, 0xbb, 0xa4, 0x9c, 0x24, 0x91, 0x77, 0x69, 0x81, 0xe8, 0x4b, 0x25, 0xfa, 0xaa, 0x29,
-		0x3f, 0xbd, 0x8e, 0xee, 0x14, 0xc5, 0x1b, 0x85, 0x65, 0x88, 0xda, 0xdd, 0x93, 0xa0, 0x48, 0xa4,
-		0x74, 0xe2, 0xf9, 0x75, 0x79, 0x4a, 0x47, 0x90, 0xc0, 0x97, 0xa7, 0x74, 0x84, 0xa9, 0x7b, 0xa2,
-		0x99, 0xc9, 0xa4, 0xb8, 0x4c, 0x33, 0x85, 0x99, 0x78, 0x99, 0x66, 0x8a, 0xf3, 0xed, 0x77, 0x6f,
-		0xfc, 0xfa, 0xb5, 0x3d, 0x2b, 0xd8, 0xef, 0xee, 0xd6, 0x9b, 0x6e, 0x7b, 0x3d, 0xf1, 0x9f, 0xe4,
-		0xf5, 0x3d, 0xec, 0xb0, 0x3f, 0xa8, 0x8f, 0xfd, 0x43, 0xfe, 0xfb, 0xfc, 0xe7, 0xc1, 0xe5, 0xdd,
-		0x61, 0x3a, 0x77, 0xe5, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x55, 0xea, 0x8b, 0xf1, 0x4d, 0x5f,
-		0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3d, 0x4b, 0x6c, 0xe4, 0x56,
+		0x72, 0x61, 0xf7, 0xe8, 0x57, 0x92, 0x5a, 0xd2, 0x1b, 0x7d, 0x7a, 0xa8, 0xf9, 0x48, 0x9c, 0x19,
+		0x5b, 0x3b, 0x5e, 0xb7, 0x66, 0x34, 0x9e, 0x8f, 0xc7, 0xe3, 0xb5, 0x35, 0x92, 0x66, 0xdc, 0x8e,
+		0x34, 0x1f, 0x4a, 0x23, 0xe7, 0x6b, 0x2e, 0xd5, 0x7c, 0x2d, 0x31, 0xd3, 0x4d, 0xb6, 0x49, 0xb6,
+		0x66, 0x64, 0x20, 0x81, 0x93, 0x0d, 0x02, 0x64, 0x11, 0x64, 0x93, 0x45, 0x12, 0x04, 0x08, 0x10,
+		0x20, 0xd8, 0x00, 0xc6, 0x1a, 0xb9, 0x25, 0x40, 0x0e, 0x8b, 0x9c, 0x72, 0xc9, 0x71, 0xaf, 0xb9,
+		0xef, 0x1e, 0x12, 0x20, 0xb7, 0x3d, 0x07, 0xc1, 0xfb, 0xb1, 0xc9, 0xe6, 0x23, 0x9b, 0xdd, 0x0a,
+		0x62, 0xaf, 0xe3, 0x9b, 0xf8, 0x5e, 0x55, 0xbd, 0x7a, 0xf5, 0xaa, 0x8a, 0xf5, 0xaa, 0x8a, 0x2d,
+		0xb8, 0xda, 0x3e, 0xc0, 0xde, 0x6a, 0xcd, 0xb4, 0xb0, 0x53, 0xc3, 0xab, 0x47, 0xb6, 0x1f, 0xb8,
+		0xde, 0xc9, 0xea, 0xf1, 0x8d, 0x55, 0x1f, 0x7b, 0xc7, 0x76, 0x0d, 0x57, 0x5a, 0x9e, 0x1b, 0xb8,
+		0x68, 0x81, 0x80, 0x55, 0x38, 0x58, 0x85, 0x83, 0x55, 0x8e, 0x6f, 0xa8, 0x17, 0x0f, 0x5d, 0xf7,
+		0xb0, 0x81, 0x57, 0x29, 0xd8, 0x41, 0xbb, 0xbe, 0x6a, 0xb5, 0x3d, 0x33, 0xb0, 0x5d, 0x87, 0x21,
+		0xaa, 0x97, 0xba, 0xe7, 0x03, 0xbb, 0x89, 0xfd, 0xc0, 0x6c, 0xb6, 0x38, 0x40, 0x82, 0xc0, 0x4b,
+		0xcf, 0x6c, 0xb5, 0xb0, 0xe7, 0xf3, 0xf9, 0xa5, 0x18, 0x83, 0x66, 0xcb, 0x26, 0xcc, 0xd5, 0xdc,
+		0x66, 0x33, 0x5c, 0x62, 0x59, 0x06, 0x21, 0x58, 0xe4, 0x5c, 0xc8, 0x40, 0x3e, 0x69, 0xe3, 0x10,
+		0x40, 0x93, 0x01, 0x04, 0xa6, 0xff, 0xa2, 0x61, 0xfb, 0x41, 0x16, 0xcc, 0x4b, 0xd7, 0x7b, 0x51,
+		0x6f, 0xb8, 0x2f, 0x39, 0xcc, 0x35, 0x19, 0x0c, 0x17, 0xa5, 0xd1, 0x05, 0xbb, 0xd2, 0x0b, 0x16,
+		0x7b, 0x1c, 0xf2, 0x72, 0x1c, 0xd2, 0x6a, 0xda, 0x0e, 0x95, 0x42, 0xa3, 0xed, 0x07, 0xbd, 0x80,
+		0xe2, 0x82, 0x58, 0x96, 0x03, 0x7d, 0xd2, 0xc6, 0x6d, 0x7e, 0xd4, 0xea, 0xeb, 0x72, 0x10, 0x0f,
+		0xb7, 0x1a, 0x76, 0x2d, 0x7a, 0xb4, 0xf1, 0x93, 0xf1, 0x8f, 0x4c, 0x0f, 0x5b, 0x04, 0xd2, 0x74,
+		0xc4, 0x6a, 0x57, 0x52, 0x20, 0xe2, 0x3c, 0x5d, 0x4d, 0x81, 0x8a, 0x8b, 0x4b, 0xfb, 0xd9, 0x30,
+		0x5c, 0xd8, 0x0d, 0x4c, 0x2f, 0xf8, 0x88, 0x8f, 0x6f, 0xbd, 0xc2, 0xb5, 0x36, 0xe1, 0x47, 0xc7,
+		0x9f, 0xb4, 0xb1, 0x1f, 0xa0, 0x6d, 0x18, 0xf1, 0xd8, 0x9f, 0x65, 0x65, 0x49, 0x59, 0x19, 0x5f,
+		0x5b, 0xab, 0xc4, 0xd4, 0xd6, 0x6c, 0xd9, 0x95, 0xe3, 0x1b, 0x95, 0x4c, 0x22, 0xba, 0x20, 0x81,
+		0x16, 0x61, 0xcc, 0x72, 0x9b, 0xa6, 0xed, 0x18, 0xb6, 0x55, 0x2e, 0x2c, 0x29, 0x2b, 0x63, 0xfa,
+		0x28, 0x1b, 0xa8, 0x5a, 0xe8, 0xb7, 0x60, 0xae, 0x65, 0x7a, 0xd8, 0x09, 0x0c, 0x2c, 0x08, 0x18,
+		0xb6, 0x53, 0x77, 0xcb, 0x45, 0xba, 0xf0, 0x8a, 0x74, 0xe1, 0xa7, 0x14, 0x23, 0x5c, 0xb1, 0xea,
+		0xd4, 0x5d, 0xfd, 0x6c, 0x2b, 0x39, 0x88, 0xca, 0x30, 0x62, 0x06, 0x01, 0x6e, 0xb6, 0x82, 0xf2,
+		0x99, 0x25, 0x65, 0x65, 0x48, 0x17, 0x8f, 0x68, 0x03, 0xa6, 0xf0, 0xab, 0x96, 0xcd, 0x4c, 0xcc,
+		0x20, 0xb6, 0x54, 0x1e, 0xa2, 0x2b, 0xaa, 0x15, 0x66, 0x47, 0x15, 0x61, 0x47, 0x95, 0x3d, 0x61,
+		0x68, 0x7a, 0xa9, 0x83, 0x42, 0x06, 0x51, 0x1d, 0xce, 0xd5, 0x5c, 0x27, 0xb0, 0x9d, 0x36, 0x36,
+		0x4c, 0xdf, 0x70, 0xf0, 0x4b, 0xc3, 0x76, 0xec, 0xc0, 0x36, 0x03, 0xd7, 0x2b, 0x0f, 0x2f, 0x29,
+		0x2b, 0xa5, 0xb5, 0x37, 0xa4, 0x1b, 0xd8, 0xe0, 0x58, 0xeb, 0xfe, 0x63, 0xfc, 0xb2, 0x2a, 0x50,
+		0xf4, 0xf9, 0x9a, 0x74, 0x1c, 0x55, 0x61, 0x46, 0xcc, 0x58, 0x46, 0xdd, 0xb4, 0x1b, 0x6d, 0x0f,
+		0x97, 0x47, 0x28, 0xbb, 0xe7, 0xa5, 0xf4, 0x1f, 0x32, 0x18, 0x7d, 0x3a, 0x44, 0xe3, 0x23, 0x48,
+		0x87, 0xf9, 0x86, 0xe9, 0x07, 0x46, 0xcd, 0x6d, 0xb6, 0x1a, 0x98, 0x6e, 0xde, 0xc3, 0x7e, 0xbb,
+		0x11, 0x94, 0x47, 0x33, 0xe8, 0x3d, 0x35, 0x4f, 0x1a, 0xae, 0x69, 0xe9, 0xb3, 0x04, 0x77, 0x23,
+		0x44, 0xd5, 0x29, 0x26, 0xfa, 0x35, 0x58, 0xac, 0xdb, 0x9e, 0x1f, 0x18, 0x16, 0xae, 0xd9, 0x3e,
+		0x95, 0xa7, 0xe9, 0xbf, 0x30, 0x0e, 0xcc, 0xda, 0x0b, 0xb7, 0x5e, 0x2f, 0x8f, 0x51, 0xc2, 0xe7,
+		0x12, 0x72, 0xdd, 0xe4, 0x0e, 0x4e, 0x2f, 0x53, 0xec, 0x4d, 0x8e, 0xbc, 0x67, 0xfa, 0x2f, 0x1e,
+		0x30, 0x54, 0x74, 0x0c, 0xd3, 0x2d, 0xd3, 0x0b, 0x6c, 0xca, 0x67, 0xcd, 0x75, 0xea, 0xf6, 0x61,
+		0x19, 0x96, 0x8a, 0x2b, 0xe3, 0x6b, 0xbf, 0x5a, 0x49, 0x71, 0xa4, 0xd9, 0x5a, 0x59, 0x79, 0x2a,
+		0xc8, 0x6d, 0x50, 0x6a, 0x5b, 0x4e, 0xe0, 0x9d, 0xe8, 0x53, 0xad, 0xf8, 0xa8, 0xfa, 0x00, 0x66,
+		0x65, 0x80, 0x68, 0x1a, 0x8a, 0x2f, 0xf0, 0x09, 0x35, 0x8a, 0x31, 0x9d, 0xfc, 0x89, 0x66, 0x61,
+		0xe8, 0xd8, 0x6c, 0xb4, 0x31, 0x57, 0x6c, 0xf6, 0x70, 0xaf, 0x70, 0x57, 0xd1, 0xee, 0xc0, 0xc5,
+		0x34, 0x56, 0xfc, 0x96, 0xeb, 0xf8, 0x18, 0xcd, 0xc1, 0xb0, 0xd7, 0xa6, 0x56, 0xc1, 0x08, 0x0e,
+		0x79, 0x6d, 0xa7, 0x6a, 0x69, 0x7f, 0x5f, 0x80, 0x8b, 0xbb, 0xf6, 0xa1, 0x63, 0x36, 0x52, 0x0d,
+		0x74, 0xa7, 0xdb, 0x40, 0x6f, 0xca, 0x0d, 0x34, 0x93, 0x4a, 0x4e, 0x0b, 0xad, 0xc3, 0x22, 0x7e,
+		0x15, 0x60, 0xcf, 0x31, 0x1b, 0xa1, 0xe3, 0xed, 0x18, 0x2b, 0xb7, 0xd3, 0xd7, 0xa4, 0xeb, 0x27,
+		0x57, 0x3e, 0x27, 0x48, 0x25, 0xa6, 0x50, 0x05, 0xce, 0xd6, 0x8e, 0xec, 0x86, 0xd5, 0x59, 0xc4,
+		0x75, 0x1a, 0x27, 0xd4, 0x6e, 0x47, 0xf5, 0x19, 0x3a, 0x25, 0x90, 0x9e, 0x38, 0x8d, 0x13, 0x6d,
+		0x19, 0x2e, 0xa5, 0xee, 0x8f, 0x09, 0x58, 0xfb, 0x79, 0x01, 0x5e, 0xe7, 0x30, 0x76, 0x70, 0x94,
+		0xed, 0xf3, 0xf6, 0xbb, 0x45, 0x7a, 0x3f, 0x4b, 0xa4, 0xbd, 0xc8, 0xe5, 0x94, 0xed, 0x67, 0x8a,
+		0x44, 0xc1, 0x8b, 0x54, 0xc1, 0x9f, 0xa7, 0x2b, 0x78, 0x3e, 0x16, 0xfe, 0x0f, 0x55, 0x7d, 0x1d,
+		0x56, 0x7a, 0x33, 0x95, 0xad, 0xf4, 0xdf, 0x57, 0xe0, 0x82, 0x8e, 0x7d, 0x7c, 0xea, 0x97, 0x52,
+		0x26, 0x91, 0x7c, 0xc7, 0x42, 0x4c, 0x37, 0x8d, 0x4c, 0xf6, 0x2e, 0xbe, 0x28, 0xc0, 0xf2, 0x1e,
+		0xf6, 0x9a, 0xb6, 0x63, 0x06, 0x38, 0x75, 0x27, 0x4f, 0xbb, 0x77, 0x72, 0x5b, 0xba, 0x93, 0x9e,
+		0x84, 0x7e, 0xc9, 0x0d, 0xf8, 0x0a, 0x68, 0x59, 0x5b, 0xe4, 0x36, 0xfc, 0x67, 0x0a, 0x2c, 0x6d,
+		0x62, 0xbf, 0xe6, 0xd9, 0x07, 0xe9, 0x12, 0x7d, 0xd2, 0x2d, 0xd1, 0x5b, 0xd2, 0xed, 0xf4, 0xa2,
+		0x93, 0x53, 0x3d, 0xfe, 0xbb, 0x08, 0xcb, 0x19, 0xa4, 0xb8, 0x8a, 0x34, 0x60, 0xa1, 0x13, 0xd2,
+		0x30, 0xd3, 0xe6, 0x2f, 0xbc, 0x4c, 0x9f, 0x9d, 0x20, 0xb8, 0x11, 0x45, 0xd5, 0xe7, 0xb1, 0x74,
+		0x1c, 0x1d, 0xc0, 0x42, 0xf2, 0x6c, 0x59, 0x24, 0x55, 0xa0, 0xab, 0x5d, 0xcb, 0xb7, 0x1a, 0x8d,
+		0xa5, 0xe6, 0x5e, 0xca, 0x86, 0xd1, 0x47, 0x80, 0x5a, 0xd8, 0xb1, 0x6c, 0xe7, 0xd0, 0x30, 0x6b,
+		0x81, 0x7d, 0x6c, 0x07, 0x36, 0xf6, 0xb9, 0xbb, 0x4a, 0x09, 0xd4, 0x18, 0xf8, 0x3a, 0x83, 0x3e,
+		0xa1, 0xc4, 0x67, 0x5a, 0xb1, 0x41, 0x1b, 0xfb, 0xe8, 0xd7, 0x61, 0x5a, 0x10, 0xa6, 0x6a, 0xe2,
+		0x61, 0xa7, 0x7c, 0x86, 0x92, 0xad, 0x64, 0x91, 0xdd, 0x20, 0xb0, 0x71, 0xce, 0xa7, 0x5a, 0x91,
+		0x29, 0x0f, 0x3b, 0x68, 0xb7, 0x43, 0x5a, 0x44, 0x27, 0x3c, 0xd0, 0xcb, 0xe4, 0x58, 0x04, 0x23,
+		0x31, 0xa2, 0x62, 0x50, 0x7b, 0x05, 0xb3, 0xcf, 0xc8, 0x9d, 0x47, 0x48, 0x4f, 0xa8, 0xe1, 0x46,
+		0xb7, 0x1a, 0x7e, 0x4b, 0xba, 0x86, 0x0c, 0x37, 0xa7, 0xea, 0xfd, 0x48, 0x81, 0xb9, 0x2e, 0x74,
+		0xae, 0x6e, 0xef, 0xc1, 0x04, 0xbd, 0x87, 0x89, 0x70, 0x4e, 0xc9, 0x11, 0xce, 0x8d, 0x53, 0x0c,
+		0x1e, 0xc5, 0x55, 0xa1, 0x24, 0x08, 0xfc, 0x0e, 0xae, 0x05, 0xd8, 0xe2, 0x8a, 0xa3, 0xa5, 0xef,
+		0x41, 0xe7, 0x90, 0xfa, 0xe4, 0x27, 0xd1, 0x47, 0xed, 0x0f, 0x15, 0x50, 0xa9, 0x03, 0xdd, 0x0d,
+		0xec, 0xda, 0x8b, 0x13, 0x12, 0xd1, 0x6d, 0xdb, 0x7e, 0x20, 0xc4, 0x54, 0xed, 0x16, 0xd3, 0x6a,
+		0xba, 0x27, 0x97, 0x52, 0xc8, 0x29, 0xac, 0x0b, 0xb0, 0x28, 0xa5, 0xc1, 0x3d, 0xcb, 0x4f, 0x0b,
+		0x30, 0xff, 0x08, 0x07, 0x3b, 0xed, 0xc0, 0x3c, 0x68, 0xe0, 0xdd, 0xc0, 0x0c, 0xb0, 0x2e, 0x23,
+		0xab, 0x74, 0xf9, 0xd3, 0xe7, 0x80, 0x24, 0x6e, 0xb4, 0xd0, 0x97, 0x1b, 0x9d, 0x49, 0x58, 0x18,
+		0xba, 0x09, 0xf3, 0xf8, 0x55, 0x8b, 0x0a, 0xd0, 0x70, 0xf0, 0xab, 0xc0, 0xc0, 0xc7, 0xe4, 0x5a,
+		0x64, 0x5b, 0xd4, 0x43, 0x17, 0xf5, 0xb3, 0x62, 0xf6, 0x31, 0x7e, 0x15, 0x6c, 0x91, 0xb9, 0xaa,
+		0x85, 0xae, 0xc3, 0x6c, 0xad, 0xed, 0xd1, 0xfb, 0xd3, 0x81, 0x67, 0x3a, 0xb5, 0x23, 0x23, 0x70,
+		0x5f, 0x50, 0xeb, 0x51, 0x56, 0x26, 0x74, 0xc4, 0xe7, 0x1e, 0xd0, 0xa9, 0x3d, 0x32, 0x83, 0x7e,
+		0x13, 0x66, 0x8f, 0xb1, 0x47, 0xa3, 0x74, 0x1e, 0x53, 0x18, 0x76, 0x80, 0x9b, 0xe5, 0x21, 0xa9,
+		0xc2, 0x92, 0x4b, 0x2b, 0xd9, 0xc1, 0x3e, 0x43, 0xf9, 0x80, 0x61, 0x54, 0x03, 0xdc, 0xd4, 0xd1,
+		0x71, 0x62, 0x4c, 0xfb, 0xe7, 0x31, 0x58, 0x48, 0x88, 0x94, 0x2b, 0xa8, 0x5c, 0x6c, 0xca, 0x69,
+		0xc5, 0xf6, 0x10, 0x26, 0x43, 0xb2, 0xc1, 0x49, 0x0b, 0xf3, 0x83, 0x58, 0xce, 0xa4, 0xb8, 0x77,
+		0xd2, 0xc2, 0xfa, 0xc4, 0xcb, 0xc8, 0x13, 0xd2, 0x60, 0x52, 0x26, 0xf5, 0x71, 0x27, 0x22, 0xed,
+		0x7d, 0x38, 0xd7, 0xf2, 0xf0, 0xb1, 0xed, 0xb6, 0x7d, 0xc3, 0x0f, 0x4c, 0x8f, 0x1c, 0x55, 0x08,
+		0x7f, 0x86, 0xae, 0xbb, 0x98, 0xb8, 0xe6, 0x54, 0x9d, 0xe0, 0xf6, 0x5b, 0xfb, 0x24, 0x56, 0xd2,
+		0xe7, 0x05, 0xf6, 0x2e, 0x43, 0x16, 0x74, 0xdf, 0x84, 0xb3, 0xf4, 0x52, 0xc6, 0x6e, 0x51, 0x21,
+		0xc5, 0x21, 0xca, 0xc1, 0x34, 0x99, 0x7a, 0x48, 0x66, 0x04, 0xf8, 0x3d, 0x18, 0xa3, 0x17, 0x2c,
+		0x92, 0x52, 0xa1, 0xd7, 0xcc, 0xf1, 0xb5, 0x0b, 0xf2, 0x08, 0x42, 0xa8, 0xfc, 0x68, 0xc0, 0xff,
+		0x42, 0x8f, 0x60, 0xda, 0xa7, 0xe6, 0x60, 0x74, 0x48, 0x8c, 0xe4, 0x21, 0x51, 0xf2, 0x63, 0x56,
+		0x84, 0xde, 0x82, 0xf9, 0x5a, 0xc3, 0x26, 0x9c, 0x36, 0xec, 0x03, 0xcf, 0xf4, 0x4e, 0x0c, 0xae,
+		0x0f, 0xf4, 0x22, 0x39, 0xa6, 0xcf, 0xb2, 0xd9, 0x6d, 0x36, 0xc9, 0xf5, 0x27, 0x82, 0x55, 0xc7,
+		0x66, 0xd0, 0xf6, 0x70, 0x88, 0x35, 0x16, 0xc5, 0x7a, 0xc8, 0x26, 0x05, 0xd6, 0x25, 0x18, 0xe7,
+		0x58, 0x76, 0xb3, 0xd5, 0x28, 0x03, 0x05, 0x05, 0x36, 0x54, 0x6d, 0xb6, 0x1a, 0xc8, 0x87, 0x6b,
+		0xdd, 0xbb, 0x32, 0xfc, 0xda, 0x11, 0xb6, 0xda, 0x0d, 0x6c, 0x04, 0x2e, 0x3b, 0x2c, 0x7a, 0xcb,
+		0x77, 0xdb, 0x41, 0x79, 0xbc, 0xd7, 0x85, 0xf4, 0x4a, 0x7c, 0xaf, 0xbb, 0x9c, 0xd2, 0x9e, 0x4b,
+		0xcf, 0x6d, 0x8f, 0x91, 0x21, 0xf1, 0x0e, 0x3b, 0x2a, 0xa2, 0xff, 0x9d, 0x8d, 0x4c, 0xd0, 0x44,
+		0xc3, 0x0c, 0x9d, 0xda, 0x0d, 0xdc, 0xce, 0x2e, 0xd2, 0x6c, 0x75, 0x32, 0xd5, 0x56, 0xb7, 0xa1,
+		0x14, 0xea, 0xb6, 0x1f, 0x98, 0x01, 0x2e, 0x97, 0x68, 0x52, 0xe1, 0x6a, 0xfc, 0xa8, 0x58, 0xa6,
+		0x27, 0xaa, 0xdf, 0xcc, 0xf2, 0x26, 0x5f, 0x46, 0x1f, 0x51, 0x0d, 0x66, 0x43, 0x6a, 0xb5, 0x86,
+		0xeb, 0x63, 0x4e, 0x73, 0x8a, 0xd2, 0xbc, 0x91, 0x33, 0x1a, 0x21, 0x88, 0x84, 0x5e, 0xdb, 0xd7,
+		0x43, 0x7b, 0x0e, 0x07, 0x89, 0x95, 0xcf, 0xc4, 0xdd, 0x0b, 0x09, 0x11, 0xa6, 0x65, 0x2f, 0xdc,
+		0x0e, 0xd7, 0x31, 0xe7, 0x62, 0x63, 0x5f, 0x9f, 0x3e, 0xee, 0x1a, 0x41, 0xf7, 0x61, 0xd1, 0xf6,
+		0x0d, 0x76, 0x2c, 0x91, 0x33, 0xc6, 0x0e, 0xf1, 0x33, 0x56, 0x79, 0x86, 0xc6, 0x98, 0x0b, 0xb6,
+		0x1f, 0x77, 0xf5, 0x5b, 0x6c, 0x1a, 0x2d, 0xc3, 0x84, 0xf0, 0x75, 0xbe, 0xfd, 0x29, 0x2e, 0x23,
+		0x66, 0xda, 0x7c, 0x6c, 0xd7, 0xfe, 0x14, 0x6b, 0xbf, 0x50, 0x60, 0xe1, 0xa9, 0xdb, 0x68, 0xfc,
+		0xff, 0x7a, 0x1b, 0x68, 0x9f, 0x8f, 0x42, 0x39, 0xb9, 0xed, 0x6f, 0x3c, 0xf6, 0x37, 0x1e, 0xfb,
+		0xeb, 0xe8, 0xb1, 0xd3, 0xec, 0x63, 0x22, 0xd5, 0x03, 0x4b, 0xdd, 0xd9, 0xe4, 0xa9, 0xdd, 0xd9,
+		0x2f, 0x9f, 0x63, 0xd7, 0xfe, 0xb5, 0x00, 0x4b, 0x3a, 0xae, 0xb9, 0x9e, 0x15, 0x4d, 0xd4, 0x72,
+		0xb3, 0xf8, 0x32, 0x3d, 0xe5, 0x25, 0x18, 0x0f, 0x15, 0x27, 0x74, 0x02, 0x20, 0x86, 0xaa, 0x16,
+		0x5a, 0x80, 0x11, 0xaa, 0x63, 0xdc, 0xe2, 0x8b, 0xfa, 0x30, 0x79, 0xac, 0x5a, 0xe8, 0x02, 0x00,
+		0xbf, 0x47, 0x08, 0xdb, 0x1d, 0xd3, 0xc7, 0xf8, 0x48, 0xd5, 0x42, 0x3a, 0x4c, 0xb4, 0xdc, 0x46,
+		0xc3, 0xe0, 0x23, 0xe5, 0xe1, 0x8c, 0xbb, 0x0a, 0xf1, 0xa1, 0x0f, 0x5d, 0x2f, 0x2a, 0x1a, 0x71,
+		0x57, 0x19, 0x27, 0x44, 0xf8, 0x83, 0xf6, 0x07, 0xa3, 0xb0, 0x9c, 0x21, 0x45, 0xee, 0x78, 0x13,
+		0x1e, 0x52, 0x19, 0xcc, 0x43, 0x66, 0x7a, 0xbf, 0xc2, 0xe0, 0xde, 0xef, 0xdb, 0x80, 0x84, 0x7c,
+		0xad, 0x6e, 0xf7, 0x3b, 0x1d, 0xce, 0x08, 0xe8, 0x15, 0xe2, 0xc0, 0x24, 0xae, 0xb7, 0xa8, 0x97,
+		0xf8, 0xb8, 0x80, 0x4c, 0x78, 0xf4, 0xa1, 0xa4, 0x47, 0x8f, 0x94, 0x74, 0x86, 0xe3, 0x25, 0x9d,
+		0xbb, 0x50, 0xe6, 0x2e, 0xa5, 0x93, 0x00, 0x11, 0x01, 0xc2, 0x08, 0x0d, 0x10, 0xe6, 0xd9, 0x7c,
+		0xa8, 0x3b, 0x22, 0x3e, 0xd0, 0x61, 0x32, 0x2c, 0x5d, 0xd0, 0x94, 0x09, 0xab, 0x85, 0xbc, 0x99,
+		0x66, 0x8d, 0x7b, 0x9e, 0xe9, 0xf8, 0xc4, 0x95, 0xc5, 0xd2, 0x04, 0x13, 0x56, 0xe4, 0x09, 0x7d,
+		0x0c, 0xe7, 0x25, 0x09, 0x99, 0x8e, 0x0b, 0x1f, 0xcb, 0xe3, 0xc2, 0xcf, 0x25, 0xd4, 0x5d, 0x4c,
+		0xa5, 0x45, 0x9f, 0x90, 0x16, 0x7d, 0x2e, 0xc3, 0x44, 0xcc, 0xe7, 0x8d, 0x53, 0x9f, 0x37, 0x7e,
+		0x10, 0x71, 0x76, 0xeb, 0x50, 0xea, 0x1c, 0x2b, 0x2d, 0x89, 0x4d, 0xf4, 0x2c, 0x89, 0x4d, 0x86,
+		0x18, 0x64, 0x0c, 0xbd, 0x0b, 0x13, 0xe2, 0xac, 0x29, 0x81, 0xc9, 0x9e, 0x04, 0xc6, 0x39, 0x3c,
+		0x45, 0x37, 0x61, 0x84, 0x64, 0x12, 0x88, 0x93, 0x2d, 0xd1, 0xfc, 0xcf, 0xa3, 0xd4, 0x2c, 0x78,
+		0x4f, 0x2b, 0xa2, 0x29, 0x0a, 0x1b, 0xfb, 0x2c, 0xef, 0x2d, 0xe8, 0x26, 0x62, 0xc1, 0xa9, 0x44,
+		0x2c, 0xa8, 0x7e, 0x0c, 0x13, 0x51, 0x5c, 0x49, 0x2a, 0xfc, 0x6e, 0x34, 0x15, 0x9e, 0x96, 0x22,
+		0x11, 0x86, 0xc9, 0x52, 0x25, 0x91, 0x74, 0x79, 0xc7, 0x95, 0x8a, 0xc4, 0xd8, 0x37, 0xae, 0x34,
+		0xe1, 0x4a, 0xa3, 0xa2, 0x91, 0xba, 0xd2, 0x9f, 0x15, 0x85, 0x2b, 0x95, 0x4a, 0x91, 0xbb, 0xd2,
+		0x0f, 0x61, 0xaa, 0xcb, 0x55, 0x65, 0x3a, 0x53, 0x9e, 0xcc, 0xa0, 0xce, 0x46, 0x2f, 0xc5, 0x5d,
+		0x59, 0x42, 0xb9, 0x0b, 0xfd, 0x29, 0x77, 0xc4, 0x73, 0x15, 0xe3, 0x9e, 0xeb, 0x63, 0xb8, 0x18,
+		0x37, 0x3c, 0xc3, 0xad, 0x1b, 0xc1, 0x91, 0xed, 0x1b, 0xd1, 0xea, 0x75, 0xf6, 0x52, 0x6a, 0xcc,
+		0x10, 0x9f, 0xd4, 0xf7, 0x8e, 0x6c, 0x7f, 0x9d, 0xd3, 0xaf, 0xc2, 0xcc, 0x11, 0x36, 0xbd, 0xe0,
+		0x00, 0x9b, 0x81, 0x61, 0xe1, 0xc0, 0xb4, 0x1b, 0x7e, 0x79, 0x28, 0x47, 0x82, 0x70, 0x3a, 0x44,
+		0xdb, 0x64, 0x58, 0xc9, 0x57, 0xd3, 0xf0, 0x60, 0xaf, 0xa6, 0xd7, 0x61, 0x2a, 0xa4, 0xc3, 0xd4,
+		0x9a, 0xfa, 0xe8, 0x31, 0x3d, 0x0c, 0x8c, 0x36, 0xe9, 0xa8, 0xf6, 0x57, 0x0a, 0x5c, 0x66, 0xa7,
+		0x19, 0x33, 0x76, 0x5e, 0x84, 0xee, 0xd8, 0x8b, 0xde, 0x9d, 0x54, 0xbc, 0x9b, 0x96, 0x54, 0xec,
+		0x45, 0x2a, 0x67, 0x76, 0xf1, 0x1f, 0x8b, 0x70, 0x25, 0x9b, 0x1a, 0x57, 0x41, 0xdc, 0x79, 0xff,
+		0x79, 0x7c, 0x8c, 0xb3, 0x78, 0x6f, 0x70, 0xef, 0xa6, 0x4f, 0xf9, 0x5d, 0x9a, 0xfe, 0x23, 0x05,
+		0x2e, 0x76, 0xd2, 0xf2, 0x24, 0x86, 0xb6, 0x6c, 0xbf, 0x65, 0x06, 0xb5, 0x23, 0xa3, 0xe1, 0xd6,
+		0xcc, 0x46, 0xe3, 0xa4, 0x5c, 0xa0, 0x3e, 0xf5, 0xe3, 0x8c, 0x55, 0x7b, 0x6f, 0xa7, 0xd2, 0xc9,
+		0xdb, 0xef, 0xb9, 0x9b, 0x7c, 0x85, 0x6d, 0xb6, 0x00, 0x73, 0xb5, 0x8b, 0x66, 0x3a, 0x84, 0xfa,
+		0x7b, 0xb0, 0xd4, 0x8b, 0x80, 0xc4, 0xdf, 0x6e, 0xc6, 0xfd, 0xad, 0xbc, 0x2a, 0x20, 0xdc, 0x00,
+		0xa5, 0x25, 0x08, 0xd3, 0x37, 0x73, 0xc4, 0xf7, 0x92, 0x72, 0x92, 0x64, 0x9b, 0xa4, 0x3d, 0x02,
+		0x5b, 0x7d, 0x96, 0x93, 0x7a, 0xd1, 0xc9, 0xa9, 0x48, 0x97, 0x61, 0x39, 0x83, 0x12, 0x4f, 0x56,
+		0xff, 0x85, 0x02, 0x5a, 0xd2, 0xdb, 0x7d, 0x20, 0xcc, 0x53, 0x70, 0xfe, 0xac, 0x9b, 0xf3, 0x3b,
+		0x29, 0x9c, 0xf7, 0xa2, 0x94, 0x93, 0xf7, 0xa7, 0x70, 0x39, 0x93, 0x16, 0xd7, 0xcd, 0x6f, 0xc1,
+		0x74, 0xcd, 0x74, 0x6a, 0x38, 0x7c, 0x03, 0x60, 0xf6, 0x4e, 0x1b, 0xd5, 0xa7, 0xd8, 0xb8, 0x2e,
+		0x86, 0xa3, 0xf6, 0x1e, 0xa5, 0x79, 0x4a, 0x7b, 0xcf, 0x22, 0x95, 0x73, 0xab, 0xaf, 0xc1, 0x95,
+		0x6c, 0x62, 0x91, 0x82, 0xa5, 0x04, 0xf0, 0x34, 0x1a, 0x96, 0x4a, 0xa7, 0x6f, 0x0d, 0x93, 0x51,
+		0x8a, 0x69, 0x58, 0x72, 0x83, 0xf4, 0x7c, 0xb0, 0xd5, 0xb7, 0x86, 0xf5, 0xa2, 0x94, 0x93, 0xf7,
+		0xab, 0x70, 0x39, 0x93, 0x16, 0xe7, 0xfe, 0x9f, 0x14, 0xb8, 0xa4, 0xe3, 0xa6, 0x7b, 0x8c, 0x59,
+		0x27, 0xc2, 0x57, 0x25, 0x8f, 0x17, 0x0f, 0x8c, 0x8a, 0x5d, 0x81, 0x91, 0xa6, 0xc1, 0x52, 0x3a,
+		0xd7, 0x7c, 0x6b, 0x3f, 0x29, 0xc0, 0x55, 0xbe, 0x05, 0xb6, 0xed, 0xd4, 0x32, 0x78, 0xe6, 0x06,
+		0x4d, 0x28, 0xc5, 0x6d, 0xb0, 0x5c, 0x90, 0xbd, 0x84, 0xc2, 0xf3, 0xcb, 0xb1, 0xa0, 0x3e, 0x19,
+		0xb3, 0x5e, 0x52, 0x84, 0x0e, 0x3b, 0x0d, 0xa4, 0xed, 0x7c, 0xf2, 0x22, 0xf4, 0x16, 0xc7, 0xe9,
+		0x2a, 0x42, 0x63, 0xd9, 0x70, 0xdf, 0x5d, 0x06, 0x2b, 0xf0, 0x5a, 0xaf, 0xbd, 0x70, 0x39, 0xff,
+		0x8b, 0x02, 0x8b, 0x22, 0x71, 0x24, 0xb9, 0xc8, 0x7f, 0x29, 0xea, 0x73, 0x0d, 0x66, 0x6c, 0xdf,
+		0x88, 0x77, 0xd7, 0x51, 0x59, 0x8e, 0xea, 0x53, 0xb6, 0xff, 0x30, 0xda, 0x37, 0xa7, 0x5d, 0x84,
+		0xf3, 0x72, 0xf6, 0xf9, 0xfe, 0xbe, 0x47, 0x03, 0x16, 0xe2, 0xac, 0xe3, 0x85, 0xf3, 0x84, 0x6b,
+		0xfd, 0x32, 0x36, 0xba, 0x0c, 0x13, 0xbc, 0x75, 0x12, 0x5b, 0x91, 0x5c, 0x6e, 0x38, 0x56, 0xb5,
+		0xd0, 0x47, 0x70, 0xb6, 0x26, 0x58, 0x8d, 0x2c, 0x7d, 0xa6, 0xaf, 0xa5, 0x51, 0x48, 0xa2, 0xb3,
+		0xf6, 0x36, 0x4c, 0x47, 0xda, 0x21, 0xd9, 0x25, 0x61, 0x28, 0xef, 0x25, 0x61, 0xaa, 0x83, 0x4a,
+		0x07, 0x88, 0xc5, 0x8b, 0x70, 0xcf, 0xb6, 0x68, 0x78, 0x5c, 0xd4, 0xc7, 0xf8, 0x48, 0xd5, 0xd2,
+		0x5e, 0x87, 0xab, 0x3d, 0x0e, 0x81, 0x1f, 0xd7, 0x7f, 0x14, 0xa0, 0xac, 0xf3, 0x5e, 0x61, 0x4c,
+		0x49, 0xfb, 0xfb, 0x6b, 0x5f, 0xe6, 0x11, 0xfd, 0x36, 0xcc, 0xc9, 0x2a, 0xc7, 0xa2, 0x03, 0xa4,
+		0x8f, 0xd2, 0xf1, 0xd9, 0x64, 0xe9, 0xd8, 0x47, 0xb7, 0x60, 0x98, 0x8a, 0xde, 0x2f, 0x9f, 0xc9,
+		0x48, 0x8d, 0x6c, 0x9a, 0x81, 0xf9, 0xa0, 0xe1, 0x1e, 0xe8, 0x1c, 0x18, 0x6d, 0x40, 0x89, 0xf4,
+		0xdd, 0x92, 0x6e, 0x2c, 0x8e, 0x3e, 0x94, 0x07, 0x7d, 0xc2, 0xc1, 0x2f, 0xf5, 0x36, 0x3b, 0x32,
+		0x5f, 0x5b, 0x84, 0x73, 0x12, 0x51, 0xf3, 0x83, 0xf8, 0xbe, 0x02, 0xf3, 0xbb, 0x27, 0x4e, 0x6d,
+		0xf7, 0xc8, 0xf4, 0x2c, 0x9e, 0x21, 0xe5, 0xc7, 0x70, 0x15, 0x4a, 0xbe, 0xdb, 0xf6, 0x6a, 0xd8,
+		0xe0, 0x2d, 0xe4, 0xfc, 0x2c, 0x26, 0xd9, 0xe8, 0x06, 0x1b, 0x44, 0xe7, 0x60, 0x94, 0x24, 0x8f,
+		0x2c, 0xf1, 0x7e, 0x1b, 0xd2, 0x47, 0xe8, 0x73, 0xd5, 0x42, 0x15, 0x38, 0x43, 0xef, 0x92, 0xc5,
+		0x9e, 0x17, 0x3c, 0x0a, 0xa7, 0x9d, 0x83, 0x85, 0x04, 0x2f, 0x9c, 0xcf, 0x7f, 0x1b, 0x82, 0xb3,
+		0x64, 0x4e, 0xbc, 0x27, 0xbf, 0x4c, 0x5d, 0x29, 0xc3, 0x88, 0xc8, 0x48, 0x31, 0x4b, 0x16, 0x8f,
+		0xc4, 0xd0, 0x3b, 0x77, 0xdd, 0x30, 0x8f, 0x10, 0xe6, 0x1d, 0x88, 0x4c, 0x92, 0x79, 0xa8, 0xa1,
+		0x7e, 0xf3, 0x50, 0xd9, 0x46, 0x98, 0xb8, 0xc9, 0x8f, 0xf4, 0x77, 0x93, 0xff, 0x90, 0x57, 0x7f,
+		0x3a, 0x97, 0x6a, 0x4a, 0x65, 0xb4, 0x27, 0x95, 0x19, 0x82, 0x16, 0x86, 0xc7, 0x94, 0xd6, 0x6d,
+		0x18, 0x11, 0x37, 0xf2, 0xb1, 0x1c, 0x37, 0x72, 0x01, 0x1c, 0xcd, 0x26, 0x40, 0x3c, 0x9b, 0xf0,
+		0x1e, 0x4c, 0xb0, 0xda, 0x14, 0x6f, 0x14, 0x1f, 0xcf, 0xd1, 0x28, 0x3e, 0x4e, 0x4b, 0x56, 0xec,
+		0x81, 0x94, 0x49, 0x28, 0x01, 0xf6, 0xe9, 0x84, 0x61, 0x5b, 0xd8, 0x09, 0xec, 0xe0, 0x84, 0x66,
+		0x03, 0xc7, 0x74, 0x44, 0xe6, 0x3e, 0xa2, 0x53, 0x55, 0x3e, 0x83, 0x1e, 0xc3, 0x54, 0x97, 0x6b,
+		0xe0, 0x99, 0xbf, 0xab, 0xb9, 0x9c, 0x82, 0x5e, 0x8a, 0x3b, 0x04, 0x6d, 0x1e, 0x66, 0xe3, 0x9a,
+		0xcc, 0x55, 0xfc, 0xcf, 0x15, 0x58, 0x14, 0x9d, 0x77, 0x5f, 0x91, 0x08, 0x4f, 0xfb, 0x53, 0x05,
+		0xce, 0xcb, 0x79, 0xe2, 0x97, 0x9f, 0x9b, 0x30, 0xdf, 0x64, 0xe3, 0xac, 0x2e, 0x63, 0xd8, 0x8e,
+		0x51, 0x33, 0x6b, 0x47, 0x98, 0x73, 0x78, 0xb6, 0x19, 0xc1, 0xaa, 0x3a, 0x1b, 0x64, 0x0a, 0xbd,
+		0x0d, 0xe7, 0x12, 0x48, 0x96, 0x19, 0x98, 0x07, 0xa6, 0x2f, 0x1a, 0x70, 0xe7, 0xe3, 0x78, 0x9b,
+		0x7c, 0x56, 0x3b, 0x0f, 0xaa, 0xe0, 0x87, 0xcb, 0xf3, 0x03, 0x37, 0x6c, 0x9d, 0xd2, 0x7e, 0xbf,
+		0x00, 0x8b, 0xd2, 0x69, 0xce, 0xed, 0x0a, 0x4c, 0x3b, 0xed, 0xe6, 0x01, 0xf6, 0x48, 0x0e, 0x8a,
+		0x7a, 0x29, 0x9f, 0xf2, 0x39, 0xa4, 0x97, 0xd8, 0xf8, 0x93, 0x3a, 0x75, 0x3e, 0x3e, 0x11, 0xb6,
+		0xf0, 0x6a, 0x3e, 0x4d, 0x2d, 0x0c, 0xe9, 0xa3, 0xdc, 0xad, 0xf9, 0xa8, 0x0a, 0x13, 0xfc, 0x24,
+		0xd8, 0x56, 0xe5, 0x5d, 0xa6, 0x42, 0x1d, 0x58, 0xae, 0x87, 0xee, 0x9c, 0xc6, 0x7e, 0xe3, 0x56,
+		0x67, 0x00, 0xdd, 0x86, 0x05, 0xb6, 0x4e, 0xcd, 0x75, 0x02, 0xcf, 0x6d, 0x34, 0xb0, 0x47, 0x65,
+		0xd2, 0x66, 0x6f, 0x8a, 0x31, 0x7d, 0x8e, 0x4e, 0x6f, 0x84, 0xb3, 0xcc, 0x2f, 0x52, 0x0b, 0xb1,
+		0x2c, 0x0f, 0xfb, 0x3e, 0x4f, 0x48, 0x8a, 0x47, 0xad, 0x02, 0x33, 0xac, 0xb2, 0x45, 0xf0, 0x84,
+		0xee, 0x44, 0x9d, 0xb4, 0x12, 0x73, 0xd2, 0xda, 0x2c, 0xa0, 0x28, 0x3c, 0x57, 0xc6, 0xff, 0x52,
+		0x60, 0x86, 0x05, 0xef, 0xd1, 0x28, 0x31, 0x9d, 0x0c, 0xba, 0xcf, 0xab, 0xc0, 0x61, 0xd1, 0xbb,
+		0xb4, 0x76, 0x29, 0x45, 0x20, 0x84, 0x22, 0xcd, 0x9a, 0x8d, 0x06, 0xfc, 0xaf, 0x68, 0xee, 0xb5,
+		0x18, 0xcb, 0xbd, 0x6e, 0xc0, 0xd4, 0xb1, 0xed, 0xdb, 0x07, 0x76, 0xc3, 0x0e, 0x4e, 0x98, 0x27,
+		0xea, 0x9d, 0x2e, 0x2c, 0x75, 0x50, 0xc8, 0x20, 0x71, 0xcb, 0xfc, 0x15, 0x66, 0x38, 0x26, 0xf7,
+		0xb8, 0x63, 0xfa, 0x38, 0x1f, 0x7b, 0x6c, 0x36, 0x31, 0x91, 0x42, 0x74, 0xbb, 0x5c, 0x0a, 0x3f,
+		0xa0, 0x52, 0xf0, 0x71, 0xf0, 0xac, 0x8d, 0xdb, 0x38, 0x87, 0x14, 0xba, 0x57, 0x2a, 0x24, 0x56,
+		0x8a, 0x0b, 0xaa, 0xd8, 0xa7, 0xa0, 0x18, 0x9f, 0x1d, 0x86, 0x38, 0x9f, 0x3f, 0x54, 0x60, 0x56,
+		0xe8, 0xfd, 0x57, 0x86, 0xd5, 0x27, 0x30, 0xd7, 0xc5, 0x13, 0xb7, 0xc2, 0xdb, 0xb0, 0xd0, 0xf2,
+		0xdc, 0x1a, 0xf6, 0x7d, 0xd2, 0xb9, 0x4a, 0xbf, 0x2a, 0x63, 0x7e, 0x80, 0x18, 0x63, 0x91, 0xe8,
+		0x7c, 0x67, 0x9a, 0x62, 0x52, 0x27, 0xe0, 0x6b, 0x9f, 0x17, 0xe1, 0xdc, 0xf3, 0x96, 0x65, 0x06,
+		0x78, 0xdf, 0xf6, 0x82, 0xb6, 0xd9, 0xf8, 0xaa, 0x6c, 0x15, 0x6d, 0xc3, 0x98, 0xdb, 0xc2, 0xbc,
+		0x01, 0xfa, 0x0c, 0xc5, 0xae, 0xa4, 0x26, 0x22, 0xa3, 0xcc, 0x3f, 0x11, 0x58, 0x7a, 0x87, 0x00,
+		0xf1, 0x52, 0xc7, 0x0c, 0x86, 0x0b, 0x27, 0x2c, 0x16, 0x96, 0x8e, 0x23, 0xb8, 0x55, 0x0b, 0xdd,
+		0x82, 0x85, 0xc0, 0xf4, 0x0e, 0x71, 0x60, 0x24, 0x10, 0x58, 0x58, 0x30, 0xcb, 0xa6, 0xf7, 0xe3,
+		0x68, 0x17, 0x00, 0xc2, 0x37, 0x89, 0x5f, 0x1e, 0xa1, 0x32, 0x1f, 0x13, 0xaf, 0x12, 0x1f, 0xbd,
+		0x0f, 0xa5, 0x96, 0xd9, 0xf6, 0xb1, 0x21, 0xbe, 0xd2, 0x2c, 0x8f, 0xf6, 0x6a, 0x51, 0x98, 0xa4,
+		0x08, 0xe2, 0x91, 0x78, 0x69, 0xd9, 0x41, 0x89, 0xbb, 0x9a, 0x02, 0x17, 0x1e, 0xe1, 0x40, 0xef,
+		0x7c, 0x2b, 0xb8, 0x83, 0x7d, 0xdf, 0x3c, 0xc4, 0x61, 0xe8, 0xf9, 0x1e, 0x0c, 0xd3, 0x42, 0x1e,
+		0x53, 0x88, 0xf1, 0xb5, 0xd7, 0x53, 0x8e, 0x22, 0x42, 0x82, 0x56, 0xf9, 0x74, 0x8e, 0x96, 0xe3,
+		0xc4, 0xc9, 0xbb, 0xe2, 0x62, 0x1a, 0x17, 0x5c, 0x51, 0x3f, 0x81, 0x12, 0x53, 0xa9, 0x26, 0x9f,
+		0xe1, 0xec, 0x7c, 0x98, 0x7a, 0xb6, 0xd9, 0x04, 0x2b, 0xd4, 0xc7, 0x8a, 0x51, 0x96, 0x50, 0x9e,
+		0xf4, 0xa3, 0x63, 0x6a, 0x03, 0x50, 0x12, 0x28, 0x9a, 0x34, 0x1e, 0x62, 0x49, 0xe3, 0xf7, 0xe3,
+		0x49, 0xe3, 0x6b, 0xbd, 0x05, 0x14, 0x32, 0x13, 0x49, 0x18, 0x37, 0x61, 0xe9, 0x11, 0x0e, 0x36,
+		0xb7, 0x9f, 0x65, 0x9c, 0x45, 0x15, 0x80, 0xb9, 0x66, 0xa7, 0xee, 0x0a, 0x01, 0xe4, 0x58, 0x8e,
+		0x58, 0x09, 0x7d, 0xdd, 0x8d, 0x05, 0xfc, 0x2f, 0x5f, 0x7b, 0x05, 0xcb, 0x19, 0xcb, 0x71, 0xa1,
+		0xef, 0xc2, 0x4c, 0xe4, 0x2b, 0x52, 0x5a, 0x54, 0x16, 0xcb, 0xbe, 0x96, 0x6f, 0x59, 0x7d, 0xda,
+		0x8b, 0x0f, 0xf8, 0xda, 0xbf, 0x2b, 0x30, 0xab, 0x63, 0xb3, 0xd5, 0x6a, 0xb0, 0x9b, 0x6d, 0xb8,
+		0xbb, 0x79, 0x18, 0xe6, 0x15, 0x1a, 0x16, 0xaf, 0xf0, 0xa7, 0xec, 0x8f, 0x4e, 0xe4, 0xc1, 0x56,
+		0xf1, 0xb4, 0xf7, 0x8a, 0xc1, 0x2e, 0x89, 0xda, 0x02, 0xcc, 0x75, 0x6d, 0x8d, 0xdb, 0xd9, 0x8f,
+		0x15, 0xd2, 0x23, 0x5e, 0xf7, 0xb0, 0x7f, 0x14, 0x16, 0xab, 0x88, 0x34, 0xbe, 0x82, 0x7b, 0x27,
+		0xf9, 0x1d, 0x39, 0xab, 0x7c, 0x2f, 0x6f, 0xc3, 0xc2, 0x86, 0xdb, 0x76, 0x88, 0xf2, 0x74, 0x2b,
+		0xe8, 0x45, 0x80, 0xba, 0xeb, 0xd5, 0xf0, 0x43, 0x1c, 0xd4, 0x8e, 0x78, 0xe6, 0x3d, 0x32, 0xa2,
+		0x99, 0x50, 0x4e, 0xa2, 0x72, 0x65, 0xdb, 0x82, 0x11, 0xec, 0x04, 0xb4, 0x26, 0xcf, 0x54, 0xec,
+		0x8d, 0x14, 0x15, 0xe3, 0xd1, 0xe4, 0xe6, 0xf6, 0x33, 0x4a, 0x8b, 0xd7, 0xdd, 0x39, 0xae, 0xf6,
+		0xe3, 0x02, 0xcc, 0xeb, 0xd8, 0xb4, 0x24, 0xdc, 0xad, 0xc1, 0x99, 0xb0, 0xcb, 0xa5, 0xb4, 0x76,
+		0x31, 0x2d, 0x46, 0xdc, 0x7e, 0x46, 0x5f, 0x29, 0x14, 0x36, 0xeb, 0x4a, 0x9d, 0xbc, 0x94, 0x17,
+		0x65, 0x97, 0xf2, 0x3d, 0x28, 0xdb, 0x0e, 0x81, 0xb0, 0x8f, 0xb1, 0x81, 0x9d, 0xd0, 0x83, 0xe5,
+		0xec, 0x0c, 0x9c, 0x0b, 0x91, 0xb7, 0x1c, 0xe1, 0x8a, 0xaa, 0x16, 0x51, 0x8c, 0x16, 0x21, 0x42,
+		0x7b, 0x0b, 0x86, 0x28, 0x63, 0xa3, 0x64, 0x80, 0x34, 0x16, 0xa0, 0xd7, 0x60, 0x8a, 0xf6, 0xb7,
+		0x50, 0x08, 0xd6, 0x86, 0x31, 0x4c, 0xdb, 0x30, 0x68, 0xdb, 0xcb, 0x53, 0xf3, 0x10, 0xb3, 0xae,
+		0xcc, 0x7f, 0x28, 0xc0, 0x42, 0x42, 0x56, 0xfc, 0x38, 0x06, 0x11, 0x96, 0xd4, 0x5f, 0x14, 0x4e,
+		0xe7, 0x2f, 0xd0, 0x77, 0x61, 0x3e, 0x41, 0x54, 0xe4, 0x7a, 0xfb, 0x75, 0x80, 0xb3, 0xdd, 0xd4,
+		0xc9, 0xa8, 0x4c, 0x5c, 0x67, 0x64, 0xe2, 0xfa, 0x39, 0xe9, 0xdd, 0x6d, 0x7b, 0x87, 0xf8, 0xeb,
+		0xad, 0x5b, 0x9a, 0x0a, 0xe5, 0xe4, 0x36, 0xb9, 0xf1, 0x7f, 0x51, 0x80, 0x85, 0x1d, 0xfc, 0xb5,
+		0x97, 0xc1, 0xff, 0x8e, 0x7d, 0x3d, 0x80, 0xf2, 0x0e, 0x96, 0x0b, 0x52, 0x46, 0x43, 0x91, 0xd1,
+		0xf8, 0x4c, 0x81, 0xf3, 0x8f, 0xdd, 0xc0, 0xae, 0x9f, 0x90, 0xb4, 0x89, 0x7b, 0x8c, 0xbd, 0x1d,
+		0x93, 0xe4, 0x44, 0x42, 0xa9, 0x7f, 0x17, 0xe6, 0xeb, 0x7c, 0xc6, 0x68, 0xd2, 0x29, 0x23, 0x16,
+		0xb0, 0xa5, 0xd9, 0x47, 0x9c, 0x1c, 0x5d, 0x4c, 0x9f, 0xad, 0x27, 0x07, 0x7d, 0xed, 0x12, 0x5c,
+		0x48, 0xe1, 0x80, 0x2b, 0x85, 0x09, 0x8b, 0x8f, 0x70, 0xb0, 0xe1, 0xb9, 0xbe, 0xcf, 0x4f, 0x25,
+		0xf6, 0x72, 0x8b, 0x5d, 0xe0, 0x95, 0xae, 0x0b, 0xfc, 0x55, 0x28, 0xf1, 0xb8, 0x59, 0x9c, 0x32,
+		0x7b, 0xcd, 0x4d, 0xb2, 0x51, 0x4e, 0x4f, 0xfb, 0x45, 0x11, 0xce, 0xcb, 0xd7, 0xe0, 0xf2, 0x6c,
+		0x42, 0x89, 0xb9, 0x86, 0x83, 0x13, 0x96, 0x4e, 0x28, 0x2b, 0x3d, 0x3a, 0xbb, 0xb2, 0xc8, 0xd1,
+		0x9b, 0x85, 0xff, 0xe0, 0x84, 0x06, 0x80, 0xec, 0x0d, 0x33, 0x11, 0x44, 0x86, 0xc8, 0x17, 0xd5,
+		0x73, 0x75, 0x5a, 0xd8, 0x34, 0x6a, 0x34, 0x40, 0x0f, 0x97, 0x65, 0xfe, 0x6e, 0x67, 0xb0, 0x65,
+		0x59, 0xad, 0x74, 0x83, 0x50, 0x8c, 0x2d, 0x8e, 0xea, 0x89, 0x09, 0xb5, 0x05, 0x33, 0x09, 0x2e,
+		0x25, 0xe1, 0xe9, 0x56, 0x3c, 0x3c, 0x5d, 0x4d, 0x51, 0x87, 0x6e, 0x9e, 0xf8, 0xe1, 0x45, 0x63,
+		0x54, 0xb5, 0x05, 0x0b, 0x29, 0x0c, 0x4a, 0xd6, 0x7d, 0x2f, 0xba, 0x6e, 0x29, 0x35, 0x6d, 0xff,
+		0x08, 0x07, 0x9d, 0x22, 0x31, 0xa5, 0x1b, 0x8d, 0x8a, 0xff, 0x53, 0x81, 0x15, 0x5e, 0x96, 0x4d,
+		0x08, 0x2d, 0x51, 0x4f, 0xca, 0xb8, 0x76, 0xe6, 0xd3, 0x32, 0xb4, 0xcf, 0x94, 0x28, 0xec, 0x9f,
+		0x11, 0x35, 0x87, 0xfc, 0x42, 0x63, 0x78, 0x84, 0x6e, 0xe7, 0xc9, 0x47, 0x57, 0x60, 0xb2, 0x4e,
+		0x02, 0xa0, 0xc7, 0x98, 0xc5, 0x52, 0xbc, 0x8c, 0x18, 0x1f, 0xd4, 0x3c, 0xf8, 0x56, 0x8e, 0xbd,
+		0x86, 0xe1, 0xd2, 0x90, 0x88, 0xc7, 0x07, 0x3b, 0x56, 0x8a, 0xad, 0xdd, 0xa2, 0xdf, 0x26, 0x0a,
+		0xc3, 0xa6, 0x2f, 0xc9, 0x1c, 0x39, 0x4e, 0x2d, 0x80, 0x85, 0x04, 0x5a, 0x18, 0x38, 0xcc, 0x75,
+		0xca, 0x67, 0x22, 0xa1, 0xd6, 0xe6, 0xfd, 0x70, 0x43, 0x7a, 0xa7, 0xb6, 0xb6, 0xcb, 0xb2, 0x69,
+		0x6d, 0x87, 0xd6, 0x37, 0xc4, 0xd7, 0xb3, 0x3c, 0x15, 0xc8, 0xf2, 0x7c, 0x93, 0x7c, 0x94, 0x82,
+		0xfa, 0x5a, 0x15, 0xe6, 0x75, 0x33, 0xc0, 0x0d, 0xbb, 0x69, 0x07, 0xec, 0x52, 0x2b, 0x98, 0x5d,
+		0x85, 0x33, 0x96, 0x19, 0x98, 0x5c, 0x18, 0x8b, 0x69, 0x0d, 0xb5, 0xeb, 0xce, 0x89, 0x4e, 0x01,
+		0xb5, 0x0f, 0x61, 0x21, 0x41, 0x8a, 0x6f, 0xa0, 0x5f, 0x5a, 0xd7, 0x7e, 0xaa, 0xc0, 0x9c, 0x34,
+		0x93, 0x80, 0x2e, 0xc3, 0xa5, 0xfd, 0xaa, 0xbe, 0xf7, 0x7c, 0x7d, 0xdb, 0x78, 0xf6, 0x7c, 0xeb,
+		0xf9, 0x96, 0xf1, 0xe4, 0xe9, 0x96, 0xbe, 0xbe, 0x57, 0x7d, 0xf2, 0xd8, 0xa8, 0x3e, 0xde, 0x5f,
+		0xdf, 0xae, 0x6e, 0x4e, 0xff, 0x0a, 0x5a, 0x81, 0x2b, 0x69, 0x40, 0x3b, 0x4f, 0xf6, 0xb7, 0x8c,
+		0xcd, 0x27, 0x3b, 0xeb, 0xd5, 0xc7, 0xbb, 0xd3, 0x0a, 0x5a, 0x86, 0x0b, 0x69, 0x90, 0x4f, 0xd7,
+		0x9f, 0xef, 0x6e, 0x4d, 0x17, 0x90, 0x06, 0x17, 0xd3, 0x40, 0xf4, 0xad, 0xdd, 0xe7, 0x3b, 0x5b,
+		0xd3, 0xc5, 0x2c, 0x32, 0x3b, 0x5b, 0xfa, 0xa3, 0xad, 0xe9, 0x33, 0x6b, 0x3f, 0x59, 0x05, 0xe0,
+		0x71, 0xf6, 0xfa, 0xd3, 0x2a, 0xfa, 0x63, 0x52, 0x9a, 0x92, 0xfe, 0xde, 0x02, 0xba, 0x3d, 0xd8,
+		0x0f, 0xa4, 0xa8, 0x77, 0xfa, 0xc6, 0xe3, 0xc7, 0xf3, 0x27, 0x0a, 0x2c, 0xa4, 0xfc, 0x20, 0x07,
+		0xba, 0xd3, 0xeb, 0xc7, 0x2c, 0xd2, 0xb8, 0xb9, 0xdb, 0x3f, 0x22, 0x67, 0xe7, 0x73, 0x05, 0x96,
+		0x7a, 0xfd, 0x28, 0x05, 0x7a, 0xff, 0xb4, 0x3f, 0xb2, 0xa1, 0xae, 0x9f, 0x82, 0x02, 0xe7, 0x94,
+		0x1c, 0xa2, 0xfc, 0xe7, 0x26, 0x32, 0x0e, 0x31, 0xf3, 0x67, 0x2e, 0xd4, 0x3b, 0x7d, 0xe3, 0x71,
+		0x5e, 0xfe, 0x52, 0x01, 0x35, 0xfd, 0x47, 0x19, 0x50, 0x7a, 0xc3, 0x62, 0xcf, 0x1f, 0xab, 0x50,
+		0xdf, 0x19, 0x08, 0x97, 0xf3, 0xf5, 0x43, 0x05, 0xce, 0xa5, 0xfe, 0xe4, 0x02, 0x7a, 0x3b, 0x95,
+		0x74, 0xaf, 0x5f, 0x7c, 0x50, 0xef, 0x0d, 0x82, 0xca, 0x99, 0x72, 0x60, 0x32, 0xf6, 0x2d, 0x3e,
+		0x7a, 0x33, 0x95, 0x98, 0xec, 0x93, 0x7f, 0xb5, 0x92, 0x17, 0x9c, 0xaf, 0xf7, 0x99, 0x02, 0x67,
+		0x25, 0x1f, 0xb4, 0xa3, 0x9b, 0xd9, 0xa7, 0x2d, 0xfd, 0x84, 0x5e, 0x7d, 0xab, 0x3f, 0x24, 0xce,
+		0x42, 0x00, 0x53, 0x5d, 0xdf, 0x77, 0xa3, 0xd5, 0xac, 0x88, 0x4a, 0x52, 0xa4, 0x53, 0xaf, 0xe7,
+		0x47, 0xe0, 0xab, 0xbe, 0x84, 0xe9, 0xee, 0x8f, 0x14, 0x51, 0x3a, 0x95, 0x94, 0xcf, 0x38, 0xd5,
+		0x1b, 0x7d, 0x60, 0x44, 0xd4, 0x2e, 0xb5, 0x15, 0x37, 0x43, 0xed, 0x7a, 0x7d, 0x28, 0xa5, 0x9e,
+		0xa2, 0xf3, 0x17, 0xfd, 0x8d, 0x02, 0xe7, 0xd9, 0x83, 0xbc, 0x53, 0x17, 0xdd, 0x1f, 0xb0, 0xc1,
+		0x97, 0xb1, 0xf6, 0xee, 0xa9, 0xda, 0x83, 0xb9, 0xc8, 0x52, 0xda, 0x59, 0x33, 0x45, 0x96, 0xdd,
+		0x4c, 0xab, 0xde, 0x1b, 0x04, 0x35, 0x71, 0x8e, 0x92, 0x6f, 0x05, 0x7a, 0x9e, 0x63, 0xfa, 0x57,
+		0x1a, 0xea, 0xbd, 0x41, 0x50, 0x93, 0xe7, 0x28, 0xed, 0x28, 0xed, 0x7d, 0x8e, 0x59, 0x5d, 0xad,
+		0xea, 0xbb, 0x03, 0x62, 0x27, 0xcf, 0x31, 0xd9, 0x34, 0xda, 0xfb, 0x1c, 0x53, 0x5b, 0x56, 0xd5,
+		0x7b, 0x83, 0xa0, 0x72, 0xa6, 0xfe, 0x9a, 0xa6, 0x6b, 0x53, 0xbb, 0x41, 0xd1, 0x3b, 0x7d, 0xed,
+		0x39, 0xde, 0x8f, 0xaa, 0xde, 0x1f, 0x0c, 0x39, 0xc6, 0x5a, 0x6a, 0x2b, 0x74, 0x26, 0x6b, 0xbd,
+		0x9a, 0xb1, 0xd5, 0xfb, 0x83, 0x21, 0x73, 0xd6, 0xfe, 0x4e, 0x81, 0x8b, 0x9c, 0x52, 0x4a, 0x0f,
+		0x24, 0xfa, 0x4e, 0xc6, 0x02, 0x39, 0x1a, 0x41, 0xd5, 0xf7, 0x06, 0xc6, 0xe7, 0x3c, 0xfe, 0x40,
+		0x81, 0x32, 0xab, 0x2e, 0x27, 0x3b, 0x61, 0xd1, 0xdd, 0x0c, 0xea, 0x99, 0x2d, 0xbf, 0xea, 0xdb,
+		0x03, 0x60, 0x72, 0x8e, 0xbe, 0xa7, 0xc0, 0xac, 0xac, 0x9f, 0x12, 0xa5, 0xbf, 0x39, 0x33, 0xba,
+		0x47, 0xd5, 0x5b, 0x7d, 0x62, 0x71, 0x2e, 0xfe, 0x96, 0xfe, 0x2e, 0x5a, 0x46, 0xbf, 0x20, 0x7a,
+		0xb7, 0x87, 0x6e, 0x64, 0x37, 0x7b, 0xaa, 0xdf, 0x19, 0x14, 0x9d, 0x33, 0xf8, 0x29, 0x29, 0xff,
+		0x77, 0xb5, 0xce, 0xa1, 0x1b, 0x19, 0x44, 0xe5, 0x1d, 0x8d, 0xea, 0x5a, 0x3f, 0x28, 0x9d, 0x68,
+		0xa4, 0xab, 0x19, 0x2e, 0x23, 0x1a, 0x91, 0xb7, 0xf0, 0xa9, 0xd7, 0xf3, 0x23, 0xf0, 0x55, 0x5f,
+		0xc0, 0x44, 0xb4, 0x39, 0x09, 0x7d, 0x3b, 0x93, 0x42, 0x57, 0x37, 0x9e, 0xfa, 0x66, 0x4e, 0xe8,
+		0x88, 0x16, 0xca, 0xba, 0x8b, 0x32, 0xb4, 0x30, 0xa3, 0x41, 0x4a, 0xbd, 0xd5, 0x27, 0x56, 0x24,
+		0xf2, 0x94, 0x34, 0x0d, 0x65, 0x44, 0x9e, 0xe9, 0x1d, 0x48, 0xea, 0x5b, 0xfd, 0x21, 0x85, 0x5f,
+		0x51, 0x41, 0xa7, 0x07, 0x07, 0x5d, 0x4b, 0xa5, 0x91, 0x68, 0xec, 0x51, 0xdf, 0xc8, 0x05, 0xdb,
+		0x59, 0xa6, 0xd3, 0xe4, 0x92, 0xb1, 0x4c, 0xa2, 0xf1, 0x47, 0x7d, 0x23, 0x17, 0x6c, 0x74, 0x19,
+		0xd1, 0xa3, 0x92, 0xb9, 0x4c, 0x57, 0x67, 0x8d, 0xfa, 0x46, 0x2e, 0xd8, 0xce, 0x0d, 0x25, 0xd6,
+		0x5f, 0x92, 0x71, 0x43, 0x91, 0xf5, 0xc6, 0xa8, 0x95, 0xbc, 0xe0, 0x7c, 0xbd, 0xdf, 0x05, 0x94,
+		0x6c, 0x6a, 0x40, 0xe9, 0xa6, 0x9d, 0xda, 0xaa, 0xa2, 0xde, 0xec, 0x0b, 0x27, 0x72, 0x93, 0x96,
+		0xb7, 0x17, 0x64, 0xdc, 0xa4, 0x33, 0xdb, 0x2c, 0xd4, 0x3b, 0x7d, 0xe3, 0x45, 0xe2, 0xa7, 0xd4,
+		0x4a, 0x7e, 0x46, 0xfc, 0xd4, 0xab, 0xd9, 0x40, 0xbd, 0x37, 0x08, 0x6a, 0x47, 0x1f, 0x62, 0x75,
+		0xf0, 0x0c, 0x7d, 0x90, 0xb5, 0x02, 0xa8, 0x95, 0xbc, 0xe0, 0x11, 0xef, 0x25, 0xab, 0x59, 0xa3,
+		0xac, 0xdb, 0x67, 0x6a, 0x35, 0x5e, 0xbd, 0xd5, 0x27, 0x56, 0xe7, 0xfa, 0xd8, 0x5d, 0xdd, 0xce,
+		0xb8, 0x3e, 0xa6, 0xd4, 0xd0, 0xd5, 0x1b, 0x7d, 0x60, 0x74, 0xde, 0x4f, 0x5d, 0x65, 0xdc, 0x8c,
+		0xf7, 0x93, 0xbc, 0x38, 0xae, 0x5e, 0xcf, 0x8f, 0x10, 0xb9, 0x2d, 0x77, 0x95, 0x09, 0xb3, 0x6e,
+		0xcb, 0xf2, 0xc2, 0xa9, 0x7a, 0xa3, 0x0f, 0x8c, 0xce, 0xc2, 0x3b, 0x38, 0xf7, 0xc2, 0x3b, 0xb8,
+		0xdf, 0x85, 0x53, 0x6b, 0x76, 0x7f, 0xa4, 0xc0, 0x9c, 0xb4, 0x12, 0x86, 0xd2, 0x35, 0x26, 0xab,
+		0x76, 0xa7, 0xde, 0xee, 0x17, 0x2d, 0xa2, 0xef, 0xb2, 0x3a, 0x52, 0x86, 0xbe, 0x67, 0x14, 0xe8,
+		0xd4, 0x5b, 0x7d, 0x62, 0x71, 0x2e, 0xbe, 0x50, 0xc2, 0xef, 0xfd, 0xd2, 0x0b, 0x16, 0x68, 0xbd,
+		0xd7, 0x75, 0xa7, 0x67, 0x61, 0x47, 0x7d, 0x70, 0x1a, 0x12, 0xb1, 0x8c, 0x52, 0xb4, 0x62, 0x91,
+		0x9d, 0x51, 0x92, 0x94, 0x44, 0xd4, 0xeb, 0xf9, 0x11, 0x22, 0x96, 0x19, 0x2f, 0x33, 0x64, 0x59,
+		0xa6, 0xb4, 0xb6, 0xa1, 0x5e, 0xcf, 0x8f, 0xc0, 0x56, 0x7d, 0xf0, 0xf6, 0x6f, 0xdc, 0x39, 0xb4,
+		0x83, 0xa3, 0xf6, 0x41, 0xa5, 0xe6, 0x36, 0x57, 0x63, 0xbf, 0xd6, 0x5f, 0x39, 0xc4, 0x0e, 0xfb,
+		0xd7, 0x0d, 0x91, 0xff, 0x1d, 0xf1, 0x0e, 0xff, 0xf3, 0xf8, 0xc6, 0xc1, 0x30, 0x9d, 0xbb, 0xf9,
+		0x3f, 0x03, 0x00, 0x98, 0xc3, 0x29, 0xa1, 0x67, 0x62, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
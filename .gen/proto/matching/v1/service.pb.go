@@ -776,6 +776,7 @@ type AddDecisionTaskRequest struct {
 	Source                 v11.TaskSource        `protobuf:"varint,6,opt,name=source,proto3,enum=uber.cadence.shared.v1.TaskSource" json:"source,omitempty"`
 	ForwardedFrom          string                `protobuf:"bytes,7,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
 	PartitionConfig        map[string]string     `protobuf:"bytes,8,rep,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Priority               int32                 `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{}              `json:"-"`
 	XXX_unrecognized       []byte                `json:"-"`
 	XXX_sizecache          int32                 `json:"-"`
@@ -870,6 +871,13 @@ func (m *AddDecisionTaskRequest) GetPartitionConfig() map[string]string {
 	return nil
 }
 
+func (m *AddDecisionTaskRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 type AddDecisionTaskResponse struct {
 	PartitionConfig      *TaskListPartitionConfig `protobuf:"bytes,1,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
@@ -928,6 +936,8 @@ type AddActivityTaskRequest struct {
 	ForwardedFrom            string                    `protobuf:"bytes,8,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
 	ActivityTaskDispatchInfo *ActivityTaskDispatchInfo `protobuf:"bytes,9,opt,name=activityTaskDispatchInfo,proto3" json:"activityTaskDispatchInfo,omitempty"`
 	PartitionConfig          map[string]string         `protobuf:"bytes,10,rep,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Priority                 int32                     `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`
+	FairnessKey              string                    `protobuf:"bytes,12,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 	XXX_NoUnkeyedLiteral     struct{}                  `json:"-"`
 	XXX_unrecognized         []byte                    `json:"-"`
 	XXX_sizecache            int32                     `json:"-"`
@@ -1036,6 +1046,20 @@ func (m *AddActivityTaskRequest) GetPartitionConfig() map[string]string {
 	return nil
 }
 
+func (m *AddActivityTaskRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *AddActivityTaskRequest) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 type ActivityTaskDispatchInfo struct {
 	ScheduledEvent             *v1.HistoryEvent `protobuf:"bytes,1,opt,name=scheduled_event,json=scheduledEvent,proto3" json:"scheduled_event,omitempty"`
 	StartedTime                *types.Timestamp `protobuf:"bytes,2,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
@@ -1588,13 +1612,15 @@ func (m *DescribeTaskListRequest) GetDomainId() string {
 }
 
 type DescribeTaskListResponse struct {
-	Pollers              []*v1.PollerInfo            `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
-	TaskListStatus       *v1.TaskListStatus          `protobuf:"bytes,2,opt,name=task_list_status,json=taskListStatus,proto3" json:"task_list_status,omitempty"`
-	PartitionConfig      *v1.TaskListPartitionConfig `protobuf:"bytes,3,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
-	TaskList             *v1.TaskList                `protobuf:"bytes,4,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	Pollers                   []*v1.PollerInfo            `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
+	TaskListStatus            *v1.TaskListStatus          `protobuf:"bytes,2,opt,name=task_list_status,json=taskListStatus,proto3" json:"task_list_status,omitempty"`
+	PartitionConfig           *v1.TaskListPartitionConfig `protobuf:"bytes,3,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
+	TaskList                  *v1.TaskList                `protobuf:"bytes,4,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	BacklogCountByPriority    map[int32]int64             `protobuf:"bytes,5,rep,name=backlog_count_by_priority,json=backlogCountByPriority,proto3" json:"backlog_count_by_priority,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	BacklogCountByFairnessKey map[string]int64            `protobuf:"bytes,6,rep,name=backlog_count_by_fairness_key,json=backlogCountByFairnessKey,proto3" json:"backlog_count_by_fairness_key,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral      struct{}                    `json:"-"`
+	XXX_unrecognized          []byte                      `json:"-"`
+	XXX_sizecache             int32                       `json:"-"`
 }
 
 func (m *DescribeTaskListResponse) Reset()         { *m = DescribeTaskListResponse{} }
@@ -1658,6 +1684,20 @@ func (m *DescribeTaskListResponse) GetTaskList() *v1.TaskList {
 	return nil
 }
 
+func (m *DescribeTaskListResponse) GetBacklogCountByPriority() map[int32]int64 {
+	if m != nil {
+		return m.BacklogCountByPriority
+	}
+	return nil
+}
+
+func (m *DescribeTaskListResponse) GetBacklogCountByFairnessKey() map[string]int64 {
+	if m != nil {
+		return m.BacklogCountByFairnessKey
+	}
+	return nil
+}
+
 type ListTaskListPartitionsRequest struct {
 	Domain               string       `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	TaskList             *v1.TaskList `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
@@ -2118,6 +2158,8 @@ func init() {
 	proto.RegisterType((*CancelOutstandingPollResponse)(nil), "uber.cadence.matching.v1.CancelOutstandingPollResponse")
 	proto.RegisterType((*DescribeTaskListRequest)(nil), "uber.cadence.matching.v1.DescribeTaskListRequest")
 	proto.RegisterType((*DescribeTaskListResponse)(nil), "uber.cadence.matching.v1.DescribeTaskListResponse")
+	proto.RegisterMapType((map[string]int64)(nil), "uber.cadence.matching.v1.DescribeTaskListResponse.BacklogCountByFairnessKeyEntry")
+	proto.RegisterMapType((map[int32]int64)(nil), "uber.cadence.matching.v1.DescribeTaskListResponse.BacklogCountByPriorityEntry")
 	proto.RegisterType((*ListTaskListPartitionsRequest)(nil), "uber.cadence.matching.v1.ListTaskListPartitionsRequest")
 	proto.RegisterType((*ListTaskListPartitionsResponse)(nil), "uber.cadence.matching.v1.ListTaskListPartitionsResponse")
 	proto.RegisterType((*GetTaskListsByDomainRequest)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainRequest")
@@ -2135,165 +2177,173 @@ func init() {
 }
 
 var fileDescriptor_826e827d3aabf7fc = []byte{
-	// 2528 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x6f, 0x1c, 0x49,
-	0x19, 0x57, 0x8f, 0x3d, 0x7e, 0x7c, 0x63, 0x8f, 0xed, 0xb2, 0xd7, 0xe9, 0x4c, 0x62, 0xc7, 0x99,
-	0x6c, 0xb2, 0x5e, 0x58, 0xc6, 0x6b, 0xef, 0x26, 0x64, 0xb3, 0x62, 0x83, 0x1f, 0x71, 0x32, 0x68,
-	0x43, 0xb2, 0x1d, 0x6f, 0x22, 0xc1, 0x2a, 0x4d, 0x79, 0xba, 0xec, 0x69, 0xdc, 0xd3, 0xdd, 0xe9,
-	0xae, 0xb6, 0x77, 0xf6, 0xc0, 0x01, 0x01, 0x42, 0xe2, 0x0a, 0x77, 0x5e, 0xff, 0x04, 0x17, 0xce,
-	0x1c, 0x39, 0x22, 0xad, 0x90, 0x20, 0x12, 0x7f, 0x00, 0x48, 0xdc, 0x38, 0xa0, 0x7a, 0xf4, 0x4c,
-	0xf7, 0x4c, 0xf5, 0x3c, 0x6c, 0x27, 0xcb, 0x81, 0x9b, 0xbb, 0xea, 0x7b, 0xd5, 0xf7, 0xfa, 0x7d,
-	0x55, 0x63, 0xb8, 0x11, 0xed, 0x93, 0x60, 0xad, 0x86, 0x2d, 0xe2, 0xd6, 0xc8, 0x5a, 0x03, 0xd3,
-	0x5a, 0xdd, 0x76, 0x0f, 0xd7, 0x8e, 0xd7, 0xd7, 0x42, 0x12, 0x1c, 0xdb, 0x35, 0x52, 0xf1, 0x03,
-	0x8f, 0x7a, 0x48, 0x67, 0x74, 0x15, 0x49, 0x57, 0x89, 0xe9, 0x2a, 0xc7, 0xeb, 0xa5, 0xe5, 0x43,
-	0xcf, 0x3b, 0x74, 0xc8, 0x1a, 0xa7, 0xdb, 0x8f, 0x0e, 0xd6, 0xac, 0x28, 0xc0, 0xd4, 0xf6, 0x5c,
-	0xc1, 0x59, 0xba, 0xd2, 0xb9, 0x4f, 0xed, 0x06, 0x09, 0x29, 0x6e, 0xf8, 0x92, 0xa0, 0x4b, 0xc0,
-	0x49, 0x80, 0x7d, 0x9f, 0x04, 0xa1, 0xdc, 0x5f, 0x49, 0x99, 0x88, 0x7d, 0x9b, 0x59, 0x57, 0xf3,
-	0x1a, 0x8d, 0xb6, 0x0a, 0x15, 0xc5, 0x8b, 0x88, 0x04, 0x4d, 0x49, 0x50, 0x56, 0x11, 0x50, 0x1c,
-	0x1e, 0x39, 0x76, 0x48, 0x25, 0xcd, 0xaa, 0x8a, 0x46, 0x3a, 0xc1, 0x3c, 0xf1, 0x82, 0x23, 0x12,
-	0x48, 0xca, 0xaf, 0xf5, 0xa3, 0x3c, 0x70, 0xbc, 0x13, 0x49, 0x7b, 0x55, 0x45, 0x5b, 0xb7, 0x43,
-	0xea, 0xb5, 0x8c, 0x7b, 0x33, 0x45, 0x12, 0xd6, 0x71, 0x40, 0xac, 0x6e, 0xaa, 0xeb, 0x19, 0x54,
-	0xe9, 0x53, 0x94, 0x3f, 0x82, 0xb9, 0x3d, 0x1c, 0x1e, 0x7d, 0x6c, 0x87, 0xf4, 0x31, 0x0e, 0xa8,
-	0xcd, 0x02, 0x81, 0xde, 0x86, 0x59, 0x3b, 0xf4, 0x1c, 0x1e, 0x15, 0xf3, 0x30, 0xf0, 0x22, 0x3f,
-	0xd4, 0xb5, 0x95, 0x91, 0xd5, 0x49, 0x63, 0xa6, 0xb5, 0x7e, 0x9f, 0x2f, 0x97, 0xff, 0x3e, 0x0a,
-	0x17, 0xba, 0x04, 0x6c, 0x7b, 0xee, 0x81, 0x7d, 0x88, 0x74, 0x18, 0x3f, 0x26, 0x41, 0x68, 0x7b,
-	0xae, 0xae, 0xad, 0x68, 0xab, 0x23, 0x46, 0xfc, 0x89, 0x36, 0x60, 0xde, 0x8d, 0x1a, 0x66, 0x40,
-	0xb0, 0x65, 0xfa, 0x31, 0x57, 0xa8, 0xe7, 0x56, 0xb4, 0xd5, 0xfc, 0x56, 0x4e, 0xd7, 0x8c, 0x39,
-	0x37, 0x6a, 0x18, 0x04, 0x5b, 0x2d, 0x91, 0x21, 0x7a, 0x1f, 0x16, 0x18, 0xcf, 0x49, 0x60, 0x53,
-	0x92, 0x64, 0x1a, 0x69, 0x31, 0x21, 0x37, 0x6a, 0x3c, 0x63, 0xdb, 0x09, 0x2e, 0x17, 0x66, 0x3a,
-	0xb5, 0x8c, 0xae, 0x8c, 0xac, 0x16, 0x36, 0xee, 0x55, 0xb2, 0x32, 0xb4, 0x92, 0x71, 0x9e, 0x4a,
-	0xda, 0xa0, 0x7b, 0x2e, 0x0d, 0x9a, 0x46, 0x31, 0x48, 0x5b, 0xf9, 0x02, 0x66, 0xbb, 0x2c, 0xcc,
-	0x73, 0x85, 0xbb, 0xc3, 0x2b, 0xec, 0x38, 0x8c, 0xd0, 0x38, 0x73, 0x92, 0x5e, 0x2d, 0xb9, 0x30,
-	0xaf, 0xb0, 0x0c, 0xcd, 0xc2, 0xc8, 0x11, 0x69, 0x72, 0xcf, 0xe7, 0x0d, 0xf6, 0x27, 0xda, 0x84,
-	0xfc, 0x31, 0x76, 0x22, 0xc2, 0xfd, 0x5c, 0xd8, 0xf8, 0xfa, 0x10, 0x06, 0x19, 0x82, 0xf3, 0x4e,
-	0xee, 0xb6, 0x56, 0xf2, 0x60, 0x41, 0x65, 0xd8, 0x2b, 0x53, 0x58, 0xfe, 0x01, 0xcc, 0x7d, 0xec,
-	0x61, 0x6b, 0x0b, 0x3b, 0xd8, 0xad, 0x91, 0xe0, 0x81, 0xed, 0xd2, 0x10, 0x5d, 0x83, 0xe9, 0x7d,
-	0x5c, 0x3b, 0x72, 0xbc, 0x43, 0xb3, 0xe6, 0x45, 0x2e, 0x95, 0x29, 0x36, 0x25, 0x17, 0xb7, 0xd9,
-	0x1a, 0xba, 0x01, 0x33, 0x01, 0x66, 0xc1, 0x20, 0x81, 0x19, 0x92, 0x9a, 0xe7, 0x5a, 0xdc, 0x14,
-	0xcd, 0x98, 0x66, 0xcb, 0x8f, 0x49, 0xf0, 0x84, 0x2f, 0x96, 0xff, 0xa9, 0x41, 0xe9, 0xb1, 0xe7,
-	0x38, 0xbb, 0x5e, 0xb0, 0x43, 0x6a, 0x36, 0xcb, 0x51, 0x66, 0x91, 0x41, 0x5e, 0x44, 0x24, 0xa4,
-	0xa8, 0x0a, 0xe3, 0x81, 0xf8, 0x93, 0x6b, 0x29, 0x6c, 0xac, 0xa5, 0x4f, 0x82, 0x7d, 0x9b, 0x1d,
-	0x22, 0x5b, 0x82, 0x11, 0xf3, 0xa3, 0x4b, 0x30, 0x69, 0x79, 0x0d, 0x6c, 0xbb, 0xa6, 0x2d, 0x6c,
-	0x99, 0x34, 0x26, 0xc4, 0x42, 0xd5, 0x62, 0x9b, 0xbe, 0xe7, 0x38, 0x24, 0x60, 0x9b, 0x23, 0x62,
-	0x53, 0x2c, 0x54, 0x2d, 0x74, 0x1d, 0x8a, 0x07, 0x5e, 0x70, 0x82, 0x03, 0x8b, 0x58, 0xe6, 0x41,
-	0xe0, 0x35, 0xf4, 0x51, 0x4e, 0x31, 0xdd, 0x5a, 0xdd, 0x0d, 0xbc, 0x06, 0x7a, 0x0b, 0x66, 0x3a,
-	0x6a, 0x57, 0xcf, 0x73, 0xba, 0x62, 0xba, 0x74, 0xcb, 0x7f, 0x2c, 0xc0, 0x25, 0xa5, 0xc5, 0xa1,
-	0xef, 0xb9, 0x21, 0x41, 0x4b, 0x00, 0xac, 0x57, 0x98, 0xd4, 0x3b, 0x22, 0xa2, 0x80, 0xa7, 0x8c,
-	0x49, 0xb6, 0xb2, 0xc7, 0x16, 0xd0, 0xa7, 0x80, 0xe2, 0xd6, 0x65, 0x92, 0xcf, 0x49, 0x2d, 0x62,
-	0x92, 0x65, 0xa0, 0x6f, 0x28, 0xdd, 0xf3, 0x4c, 0x92, 0xdf, 0x8b, 0xa9, 0x8d, 0xb9, 0x93, 0xce,
-	0x25, 0xb4, 0x0b, 0xd3, 0x2d, 0xb1, 0xb4, 0xe9, 0x13, 0xee, 0x86, 0xc2, 0xc6, 0xd5, 0x9e, 0x12,
-	0xf7, 0x9a, 0x3e, 0x31, 0xa6, 0x4e, 0x12, 0x5f, 0xe8, 0x29, 0x5c, 0xf4, 0x03, 0x72, 0x6c, 0x7b,
-	0x51, 0x68, 0x86, 0x14, 0x07, 0x94, 0x58, 0x26, 0x39, 0x26, 0x2e, 0x65, 0xae, 0x1d, 0xe5, 0x32,
-	0x2f, 0x55, 0x04, 0x90, 0x54, 0x62, 0x20, 0xa9, 0x54, 0x5d, 0x7a, 0xeb, 0xfd, 0xa7, 0x2c, 0xef,
-	0x8c, 0xc5, 0x98, 0xfb, 0x89, 0x60, 0xbe, 0xc7, 0x78, 0xab, 0x16, 0x5a, 0x85, 0xd9, 0x2e, 0x71,
-	0x79, 0x9e, 0x79, 0xc5, 0x30, 0x4d, 0xa9, 0xc3, 0x38, 0xa6, 0x94, 0x34, 0x7c, 0xaa, 0x8f, 0xf1,
-	0x92, 0x88, 0x3f, 0x51, 0x19, 0xa6, 0x5d, 0xf2, 0x39, 0x6d, 0x0b, 0x18, 0xe7, 0x02, 0x0a, 0x6c,
-	0x31, 0xe6, 0x7e, 0x07, 0x50, 0x2a, 0xbd, 0xcd, 0xba, 0xed, 0x52, 0x7d, 0x82, 0x13, 0xce, 0x26,
-	0x73, 0x9c, 0x55, 0x03, 0xba, 0x0d, 0x7a, 0x48, 0xed, 0xda, 0x51, 0xb3, 0x1d, 0x0a, 0x93, 0xb8,
-	0x78, 0xdf, 0x21, 0x96, 0x3e, 0xb9, 0xa2, 0xad, 0x4e, 0x18, 0x8b, 0x62, 0xbf, 0xe5, 0xe8, 0x7b,
-	0x62, 0x17, 0xdd, 0x86, 0x3c, 0x07, 0x3e, 0x1d, 0xb8, 0x4f, 0xca, 0x3d, 0xfd, 0xfc, 0x09, 0xa3,
-	0x34, 0x04, 0x03, 0x32, 0x60, 0xda, 0x92, 0x79, 0x63, 0xda, 0xee, 0x81, 0xa7, 0x17, 0xb8, 0x84,
-	0x6f, 0xa4, 0x25, 0x08, 0xe0, 0xe1, 0x25, 0x1e, 0x60, 0x37, 0xb4, 0x89, 0x4b, 0xe3, 0x6c, 0xab,
-	0xba, 0x07, 0x9e, 0x31, 0x65, 0x25, 0xbe, 0xd0, 0x73, 0xb8, 0xdc, 0x9d, 0x54, 0x26, 0x4f, 0x43,
-	0x86, 0x59, 0xfa, 0x14, 0x57, 0xb1, 0xa4, 0x34, 0x32, 0x6e, 0x21, 0xc6, 0xc5, 0xae, 0xac, 0x8a,
-	0xb7, 0x50, 0x05, 0xe6, 0x85, 0xd3, 0x19, 0x52, 0x12, 0x33, 0x46, 0xa7, 0x69, 0x1e, 0x9f, 0x39,
-	0xbe, 0xf5, 0x84, 0xed, 0x3c, 0x95, 0x38, 0x75, 0x15, 0xa6, 0xf6, 0x03, 0xec, 0xd6, 0xea, 0xb2,
-	0x0a, 0x8a, 0xbc, 0x0a, 0x0a, 0x62, 0x4d, 0xd4, 0xc1, 0x26, 0x14, 0xc3, 0x5a, 0x9d, 0x58, 0x91,
-	0x43, 0x2c, 0x93, 0x8d, 0x2a, 0xfa, 0x0c, 0x37, 0xb2, 0xd4, 0x95, 0x5d, 0x7b, 0xf1, 0x1c, 0x63,
-	0x4c, 0xb7, 0x38, 0xd8, 0x1a, 0xfa, 0x16, 0x4c, 0xc5, 0x39, 0xc5, 0x05, 0xcc, 0xf6, 0x15, 0x50,
-	0x90, 0xf4, 0x9c, 0xfd, 0x33, 0x18, 0x67, 0x11, 0xb1, 0x49, 0xa8, 0xcf, 0x71, 0xa4, 0xd9, 0xca,
-	0xee, 0xb3, 0x3d, 0x0a, 0xbe, 0xf2, 0x89, 0x10, 0x22, 0x50, 0x26, 0x16, 0xc9, 0x5c, 0x46, 0x3d,
-	0x8a, 0x1d, 0x53, 0x8e, 0x17, 0xe6, 0x7e, 0x93, 0x92, 0x50, 0x47, 0x3c, 0x13, 0xe7, 0xf8, 0xd6,
-	0x03, 0xb1, 0xb3, 0xc5, 0x36, 0xd0, 0x67, 0x30, 0xdb, 0x82, 0x3e, 0xb3, 0xc6, 0x71, 0x4c, 0x9f,
-	0xe7, 0x07, 0x5a, 0x1f, 0x1a, 0x00, 0x8d, 0x19, 0xbf, 0x63, 0xa4, 0xf8, 0x3e, 0xcc, 0x3b, 0x1e,
-	0xb6, 0xcc, 0x7d, 0x89, 0x05, 0xbc, 0x2c, 0x42, 0x7d, 0xa1, 0x1f, 0xbe, 0x74, 0xe1, 0x87, 0x31,
-	0xe7, 0x74, 0x41, 0xca, 0x43, 0x98, 0xc5, 0x11, 0xf5, 0xa4, 0xd5, 0xa2, 0xe2, 0xde, 0xe0, 0x92,
-	0xaf, 0x29, 0x33, 0x6e, 0x33, 0xa2, 0x9e, 0xb0, 0x8b, 0xf1, 0x1b, 0x45, 0x9c, 0xfa, 0x2e, 0x3d,
-	0x87, 0xa9, 0xa4, 0x4b, 0x93, 0xf8, 0x38, 0x29, 0xf0, 0xf1, 0x76, 0x1a, 0x1f, 0x07, 0x2a, 0xbe,
-	0x36, 0x2c, 0x26, 0x40, 0x6b, 0xb3, 0x46, 0xed, 0x63, 0x9b, 0x36, 0x4f, 0x0f, 0x5a, 0x0a, 0x09,
-	0xff, 0x8b, 0xa0, 0xf5, 0x2b, 0x68, 0x81, 0x56, 0xda, 0xe2, 0xaf, 0x14, 0xb4, 0xae, 0x40, 0x01,
-	0x4b, 0x6b, 0xda, 0x4e, 0x80, 0x78, 0xa9, 0x6a, 0x31, 0x54, 0x6b, 0x11, 0x70, 0x54, 0x1b, 0xed,
-	0x81, 0x6a, 0xad, 0x83, 0x71, 0x54, 0xc3, 0x89, 0x2f, 0xb4, 0x01, 0x79, 0xdb, 0xf5, 0x23, 0xca,
-	0xbd, 0x53, 0xd8, 0xb8, 0xac, 0x8e, 0x28, 0x6e, 0xb2, 0xdc, 0x36, 0x04, 0xa9, 0xa2, 0x41, 0x8d,
-	0x9d, 0xb5, 0x41, 0x8d, 0x0f, 0xd7, 0xa0, 0xf6, 0xe0, 0x62, 0x2c, 0xcf, 0x64, 0xe5, 0xe5, 0x78,
-	0x21, 0xe1, 0x82, 0xbc, 0x48, 0x40, 0x5a, 0x61, 0xe3, 0x62, 0x97, 0xac, 0x1d, 0x79, 0x2b, 0x34,
-	0x16, 0x63, 0xde, 0x3d, 0x6f, 0x9b, 0x71, 0xee, 0x09, 0x46, 0xf4, 0x5d, 0x58, 0xe4, 0x4a, 0xba,
-	0x45, 0x4e, 0xf6, 0x13, 0x39, 0xcf, 0x19, 0x3b, 0xe4, 0xed, 0xc2, 0x5c, 0x9d, 0xe0, 0x80, 0xee,
-	0x13, 0x4c, 0x5b, 0xa2, 0xa0, 0x9f, 0xa8, 0xd9, 0x16, 0x4f, 0x2c, 0x27, 0x81, 0xfb, 0x85, 0x34,
-	0xee, 0x3f, 0x87, 0xe5, 0x74, 0x24, 0x4c, 0xef, 0xc0, 0xa4, 0x75, 0x3b, 0x34, 0x63, 0x86, 0xa9,
-	0xbe, 0x8e, 0x2d, 0xa5, 0x22, 0xf3, 0xe8, 0x60, 0xaf, 0x6e, 0x87, 0x9b, 0x52, 0x7e, 0x35, 0x79,
-	0x02, 0x8b, 0x50, 0x6c, 0x3b, 0x21, 0xc7, 0xb6, 0x7e, 0x99, 0xd2, 0x3e, 0xc4, 0x8e, 0xe0, 0xea,
-	0x1e, 0xc3, 0x8a, 0xa7, 0x1b, 0xc3, 0xde, 0x82, 0x99, 0x96, 0x1c, 0xd1, 0x31, 0x38, 0x3c, 0x4e,
-	0x1a, 0xc5, 0x78, 0x79, 0x87, 0xaf, 0xa2, 0xf7, 0x60, 0xac, 0x4e, 0xb0, 0x45, 0x02, 0x89, 0x7e,
-	0x97, 0x94, 0x9a, 0x1e, 0x70, 0x12, 0x43, 0x92, 0x66, 0xa1, 0xc1, 0xdc, 0xb9, 0xa0, 0xc1, 0xab,
-	0x05, 0x32, 0x15, 0xd6, 0x2c, 0x9c, 0x1a, 0x6b, 0xca, 0x7f, 0x19, 0x85, 0xc5, 0x4d, 0xcb, 0x52,
-	0x5d, 0x5e, 0x52, 0xcd, 0x5b, 0xeb, 0x68, 0xde, 0xaf, 0xa8, 0x21, 0xde, 0x81, 0xc9, 0xf6, 0xd0,
-	0x36, 0x32, 0xc8, 0xd0, 0x36, 0x41, 0xe3, 0x19, 0xed, 0x0a, 0x14, 0x5a, 0xdd, 0x42, 0xce, 0xea,
-	0x23, 0x06, 0xc4, 0x4b, 0x55, 0xab, 0xb3, 0x9d, 0xc8, 0x26, 0x20, 0x0b, 0x36, 0x3f, 0x44, 0x3b,
-	0xe1, 0xa3, 0x7d, 0x5c, 0xb6, 0x77, 0x60, 0x2c, 0xf4, 0xa2, 0xa0, 0x26, 0xda, 0x63, 0xb1, 0x13,
-	0x8c, 0x13, 0x73, 0x2c, 0x0e, 0x8f, 0x9e, 0x70, 0x4a, 0x43, 0x72, 0x28, 0x50, 0x6e, 0x5c, 0x85,
-	0x72, 0xbe, 0x22, 0xa3, 0x26, 0xfa, 0x3d, 0x46, 0xa8, 0xa3, 0x5a, 0xe9, 0x48, 0x30, 0xf9, 0x34,
-	0xd0, 0x91, 0x65, 0xa5, 0x2d, 0x58, 0x50, 0x11, 0x2a, 0x46, 0x91, 0x85, 0xe4, 0x28, 0x32, 0x99,
-	0x1c, 0x33, 0x4e, 0xe0, 0x42, 0x97, 0x0d, 0x12, 0x6d, 0x55, 0x25, 0xa2, 0x9d, 0x57, 0x89, 0x94,
-	0xff, 0x95, 0xe7, 0x39, 0xad, 0x9a, 0x6d, 0xbe, 0x8a, 0x9c, 0x66, 0x37, 0x3f, 0x1e, 0x6e, 0xb3,
-	0xad, 0x5a, 0x20, 0x7d, 0x51, 0xac, 0xef, 0xc4, 0x06, 0xa4, 0xb2, 0x7f, 0xf4, 0x4c, 0xd9, 0x9f,
-	0x1f, 0x2e, 0xfb, 0xc7, 0xce, 0x9e, 0xfd, 0xe3, 0xe7, 0x90, 0xfd, 0x13, 0xaa, 0xec, 0x77, 0x41,
-	0xc7, 0x89, 0x50, 0xee, 0xd8, 0xa1, 0xcf, 0xb2, 0x82, 0xdd, 0xfb, 0x24, 0x62, 0x6f, 0xf4, 0xa8,
-	0x82, 0x0c, 0x4e, 0x23, 0x53, 0xa6, 0xb2, 0xda, 0x60, 0x80, 0x6a, 0x53, 0xe4, 0xdb, 0x6b, 0xac,
-	0xb6, 0x2f, 0x47, 0x40, 0xcf, 0x3a, 0x2c, 0xfa, 0x0e, 0xcc, 0xb4, 0x07, 0x08, 0x7e, 0x5b, 0x95,
-	0xe5, 0xa6, 0xc6, 0x65, 0x79, 0x2f, 0xe3, 0x4f, 0x0a, 0x46, 0x7b, 0x08, 0xe4, 0xdf, 0x5d, 0x33,
-	0x5d, 0x6e, 0xb8, 0x99, 0x2e, 0x31, 0xe5, 0x8c, 0x0c, 0x3b, 0xe5, 0x8c, 0x9e, 0xff, 0x94, 0x93,
-	0x3f, 0x9f, 0x29, 0x67, 0xec, 0xdc, 0xa6, 0x9c, 0x71, 0xd5, 0x94, 0x23, 0x7b, 0xa9, 0xf2, 0xe6,
-	0xf2, 0x6a, 0x7b, 0xe9, 0x97, 0x1a, 0x2c, 0xf0, 0x0b, 0x64, 0x7c, 0x8a, 0xb8, 0x93, 0x6e, 0x77,
-	0xde, 0x12, 0xdf, 0x56, 0x1e, 0x5e, 0xc5, 0x3b, 0xe0, 0xfd, 0xf0, 0x2c, 0xb3, 0xc0, 0x60, 0xd7,
-	0xc7, 0xf2, 0x7f, 0x34, 0x78, 0xa3, 0xc3, 0x42, 0xe9, 0xd5, 0xbb, 0x30, 0xc5, 0x5f, 0xab, 0xcc,
-	0x80, 0x84, 0x91, 0x13, 0x9f, 0xb1, 0x77, 0x9e, 0x14, 0x38, 0x87, 0xc1, 0x19, 0x50, 0x15, 0x8a,
-	0xb1, 0x80, 0x1f, 0x92, 0x1a, 0x25, 0x56, 0xcf, 0xbb, 0xba, 0xb8, 0xa3, 0x4b, 0x4a, 0x63, 0xfa,
-	0x45, 0xf2, 0x13, 0x3d, 0x53, 0x44, 0x58, 0xf8, 0xe3, 0x9d, 0x9e, 0xfe, 0xe8, 0x1b, 0xdc, 0x7f,
-	0x68, 0xb0, 0x22, 0x4e, 0x6c, 0x71, 0x03, 0x18, 0xe3, 0xb6, 0xd7, 0xf0, 0x1d, 0xc2, 0xac, 0x90,
-	0x31, 0x7a, 0xd4, 0x19, 0xe8, 0x9b, 0x4a, 0xa5, 0xfd, 0xe4, 0xbc, 0x86, 0xa0, 0x5f, 0x80, 0x71,
-	0xce, 0x2b, 0x87, 0xbf, 0x49, 0x63, 0x8c, 0x7d, 0x56, 0xad, 0xf2, 0x35, 0xb8, 0xda, 0xc3, 0x3c,
-	0x11, 0xf1, 0xf2, 0x5f, 0x35, 0xb8, 0xbc, 0xcd, 0xc6, 0x78, 0xe7, 0x51, 0x44, 0x43, 0x8a, 0x5d,
-	0xcb, 0x76, 0x0f, 0x1f, 0x7b, 0x8e, 0x33, 0xd0, 0xec, 0x90, 0x7a, 0xcc, 0xc8, 0x75, 0x3c, 0x66,
-	0xdc, 0x87, 0x62, 0xeb, 0x50, 0xed, 0xc7, 0xe9, 0x62, 0x46, 0xbf, 0x88, 0x4f, 0x26, 0xfa, 0x05,
-	0x4d, 0x7c, 0x9d, 0x65, 0x40, 0x28, 0x5f, 0x81, 0xa5, 0x8c, 0xe3, 0x49, 0x07, 0xfc, 0x08, 0x2e,
-	0xec, 0x90, 0xb0, 0x16, 0xd8, 0xfb, 0xa4, 0xc5, 0x2e, 0x8f, 0xbe, 0xdb, 0x99, 0x03, 0xea, 0xc4,
-	0xcb, 0x60, 0x1f, 0x2c, 0xf4, 0xe5, 0x3f, 0xe4, 0x40, 0xef, 0x96, 0x20, 0xeb, 0xf1, 0x03, 0x18,
-	0x17, 0xee, 0x14, 0x3f, 0x28, 0x16, 0x36, 0xae, 0x64, 0x3e, 0x4a, 0x91, 0x80, 0x03, 0x7c, 0x4c,
-	0xcf, 0x6e, 0x4c, 0x6d, 0xef, 0x87, 0x14, 0xd3, 0x28, 0x94, 0xb5, 0x78, 0xad, 0xa7, 0xef, 0x9e,
-	0x70, 0x52, 0xa3, 0x48, 0x53, 0xdf, 0xaf, 0xac, 0x1a, 0xcf, 0x14, 0xdc, 0x10, 0x96, 0x78, 0x92,
-	0x74, 0xea, 0x0a, 0xe3, 0x08, 0x2e, 0xc2, 0x98, 0x04, 0x18, 0x91, 0xb9, 0xf2, 0x2b, 0xad, 0x34,
-	0x37, 0x9c, 0xd2, 0x9f, 0xe5, 0x60, 0x39, 0x4b, 0xab, 0x0c, 0xdb, 0x0b, 0x58, 0x6a, 0xbf, 0x5f,
-	0xb5, 0x82, 0x90, 0xf8, 0x89, 0x53, 0x04, 0xb3, 0x32, 0x98, 0xe7, 0x1e, 0x12, 0x8a, 0x2d, 0x4c,
-	0xb1, 0x51, 0x4a, 0x0e, 0x6f, 0x69, 0xd5, 0x4c, 0x65, 0xeb, 0xe7, 0x05, 0xa5, 0xca, 0xdc, 0xe9,
-	0x54, 0x5a, 0x89, 0x8b, 0x4c, 0x5a, 0x65, 0xf9, 0x26, 0x5c, 0xba, 0x4f, 0x5a, 0x6e, 0x08, 0xb7,
-	0x9a, 0x02, 0xb5, 0xfb, 0xf8, 0xbe, 0xfc, 0xfb, 0x51, 0xb8, 0xac, 0xe6, 0x93, 0xde, 0xfb, 0x89,
-	0x06, 0x8b, 0x8a, 0xb3, 0x34, 0xb0, 0x2f, 0xfd, 0xf6, 0x28, 0x1b, 0xe1, 0x7b, 0x09, 0xae, 0xec,
-	0x74, 0x9c, 0xe5, 0x21, 0xf6, 0xc5, 0x68, 0x3a, 0x6f, 0x75, 0xef, 0x70, 0x33, 0x14, 0x51, 0x64,
-	0x66, 0xe4, 0xce, 0x64, 0xc6, 0x66, 0x47, 0x14, 0xdb, 0x66, 0xe0, 0xee, 0x9d, 0xd2, 0x17, 0xac,
-	0x3d, 0xa8, 0xed, 0x56, 0x4c, 0xca, 0x0f, 0xd2, 0x4f, 0xe4, 0x3d, 0xae, 0x08, 0x59, 0x3d, 0x27,
-	0xf9, 0xd3, 0xf5, 0x17, 0xe9, 0xe1, 0xfa, 0x75, 0xea, 0x2e, 0xff, 0x26, 0x07, 0x6f, 0x7e, 0xea,
-	0x5b, 0x98, 0x92, 0xac, 0x56, 0x32, 0x08, 0x40, 0x9d, 0xa1, 0xd0, 0xcf, 0x0f, 0xbf, 0x54, 0xbd,
-	0x73, 0xf4, 0x3c, 0x26, 0x99, 0xb7, 0xe0, 0x7a, 0x1f, 0x17, 0x49, 0x90, 0xfb, 0x6d, 0x0e, 0xae,
-	0x1b, 0xe4, 0x20, 0x20, 0x61, 0xfd, 0xff, 0xde, 0xcc, 0xf2, 0xe6, 0x2a, 0xdc, 0xe8, 0xe7, 0x23,
-	0xe1, 0xce, 0x8d, 0x7f, 0x4f, 0x41, 0xe1, 0xa1, 0xcc, 0xe7, 0xcd, 0xc7, 0x55, 0xf4, 0x63, 0x0d,
-	0xe6, 0x15, 0x3f, 0x15, 0xa2, 0xf7, 0x87, 0xfc, 0x65, 0x91, 0x87, 0xa0, 0x74, 0xf3, 0x54, 0xbf,
-	0x47, 0x26, 0x8d, 0x48, 0x16, 0xed, 0x00, 0x46, 0x28, 0xae, 0xf0, 0x03, 0x18, 0xa1, 0xbc, 0x96,
-	0x1d, 0xc3, 0x4c, 0xc7, 0xeb, 0x17, 0x7a, 0x77, 0xd8, 0xc7, 0xba, 0xd2, 0xfa, 0x10, 0x1c, 0x29,
-	0xbd, 0xa9, 0x73, 0xbf, 0x3b, 0xec, 0xb3, 0x45, 0x1f, 0xbd, 0xca, 0xf3, 0xfa, 0x30, 0x9d, 0xba,
-	0x49, 0xa1, 0x4a, 0xb6, 0x0c, 0xd5, 0xa5, 0xb0, 0xb4, 0x36, 0x30, 0xbd, 0xd4, 0xf8, 0x4b, 0x0d,
-	0x2e, 0x66, 0x8e, 0xf5, 0xe8, 0x4e, 0xb6, 0xb8, 0x7e, 0x57, 0x95, 0xd2, 0x87, 0xa7, 0xe2, 0x95,
-	0x66, 0xfd, 0x5c, 0x83, 0x37, 0x94, 0x83, 0x36, 0xba, 0x95, 0x2d, 0xb6, 0xd7, 0xc5, 0xa3, 0xf4,
-	0xcd, 0xa1, 0xf9, 0xa4, 0x29, 0x4d, 0x98, 0xed, 0x04, 0x18, 0xb4, 0x3e, 0x0c, 0x18, 0x09, 0xfd,
-	0xa7, 0xc0, 0x2f, 0xf4, 0x0b, 0x0d, 0x16, 0xd5, 0xb3, 0x21, 0xea, 0x71, 0x9c, 0x9e, 0x33, 0x6c,
-	0xe9, 0xf6, 0xf0, 0x8c, 0xd2, 0x9a, 0x9f, 0x6a, 0xb0, 0xa0, 0x9a, 0x44, 0xd0, 0xcd, 0x61, 0x27,
-	0x17, 0x61, 0xc9, 0xad, 0xd3, 0x0d, 0x3c, 0xe8, 0xd7, 0x1a, 0x2c, 0xf5, 0xc4, 0x29, 0xf4, 0x51,
-	0xb6, 0xe4, 0x41, 0x66, 0x80, 0xd2, 0xdd, 0x53, 0xf3, 0x4b, 0x13, 0x7f, 0xa7, 0xc1, 0x72, 0xef,
-	0xe6, 0x8f, 0xee, 0xf6, 0x2a, 0x8f, 0x01, 0xa0, 0xb5, 0xf4, 0xed, 0xd3, 0x0b, 0x10, 0x56, 0x6e,
-	0xdd, 0xff, 0xd3, 0xcb, 0x65, 0xed, 0xcf, 0x2f, 0x97, 0xb5, 0xbf, 0xbd, 0x5c, 0xd6, 0xbe, 0xf7,
-	0xc1, 0xa1, 0x4d, 0xeb, 0xd1, 0x7e, 0xa5, 0xe6, 0x35, 0xd6, 0x52, 0xff, 0xbd, 0x5a, 0x39, 0x24,
-	0xae, 0xf8, 0x77, 0xdf, 0xe4, 0x7f, 0x1c, 0x7f, 0x18, 0xff, 0x7d, 0xbc, 0xbe, 0x3f, 0xc6, 0x77,
-	0xdf, 0xfb, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x48, 0x50, 0xe3, 0xd9, 0x9f, 0x2c, 0x00, 0x00,
+	// 2654 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x73, 0x1b, 0xc7,
+	0x11, 0xae, 0x05, 0x09, 0x3e, 0x1a, 0x20, 0x48, 0x0e, 0x69, 0x6a, 0x09, 0x8a, 0x14, 0x05, 0x5b,
+	0x32, 0x9d, 0x38, 0xa0, 0x09, 0x5b, 0x8e, 0x2c, 0x57, 0xec, 0xf0, 0x21, 0x4a, 0x48, 0x24, 0x4b,
+	0x5a, 0xd1, 0x52, 0x55, 0xe2, 0xd2, 0x66, 0x80, 0x1d, 0x12, 0x1b, 0x02, 0xbb, 0xab, 0xdd, 0x01,
+	0x69, 0xf8, 0x90, 0x43, 0x2a, 0x49, 0x25, 0x95, 0x63, 0x92, 0x7b, 0x5e, 0x7f, 0x20, 0x7f, 0x20,
+	0xe7, 0x1c, 0x73, 0x77, 0xa5, 0x2a, 0x51, 0x55, 0x7e, 0x40, 0xaa, 0x9c, 0x5b, 0x0e, 0xa9, 0x79,
+	0x2c, 0xb0, 0x0b, 0xcc, 0xe2, 0x41, 0x52, 0x76, 0x0e, 0xb9, 0x61, 0x66, 0xba, 0x7b, 0x7a, 0x7a,
+	0xfa, 0xf1, 0xf5, 0x2c, 0xe0, 0x7a, 0xb3, 0x42, 0xfc, 0xcd, 0x2a, 0xb6, 0x88, 0x53, 0x25, 0x9b,
+	0x0d, 0x4c, 0xab, 0x35, 0xdb, 0x39, 0xda, 0x3c, 0xd9, 0xda, 0x0c, 0x88, 0x7f, 0x62, 0x57, 0x49,
+	0xd1, 0xf3, 0x5d, 0xea, 0x22, 0x9d, 0xd1, 0x15, 0x25, 0x5d, 0x31, 0xa4, 0x2b, 0x9e, 0x6c, 0xe5,
+	0xd7, 0x8e, 0x5c, 0xf7, 0xa8, 0x4e, 0x36, 0x39, 0x5d, 0xa5, 0x79, 0xb8, 0x69, 0x35, 0x7d, 0x4c,
+	0x6d, 0xd7, 0x11, 0x9c, 0xf9, 0x2b, 0xdd, 0xeb, 0xd4, 0x6e, 0x90, 0x80, 0xe2, 0x86, 0x27, 0x09,
+	0x7a, 0x04, 0x9c, 0xfa, 0xd8, 0xf3, 0x88, 0x1f, 0xc8, 0xf5, 0xf5, 0x98, 0x8a, 0xd8, 0xb3, 0x99,
+	0x76, 0x55, 0xb7, 0xd1, 0xe8, 0x6c, 0xa1, 0xa2, 0x78, 0xde, 0x24, 0x7e, 0x4b, 0x12, 0x14, 0x54,
+	0x04, 0x14, 0x07, 0xc7, 0x75, 0x3b, 0xa0, 0x92, 0x66, 0x43, 0x45, 0x23, 0x8d, 0x60, 0x9e, 0xba,
+	0xfe, 0x31, 0xf1, 0x25, 0xe5, 0xd7, 0x06, 0x51, 0x1e, 0xd6, 0xdd, 0x53, 0x49, 0x7b, 0x55, 0x45,
+	0x5b, 0xb3, 0x03, 0xea, 0xb6, 0x95, 0x7b, 0x2d, 0x46, 0x12, 0xd4, 0xb0, 0x4f, 0xac, 0x5e, 0xaa,
+	0x6b, 0x09, 0x54, 0xf1, 0x53, 0x14, 0x3e, 0x80, 0xf9, 0x03, 0x1c, 0x1c, 0xdf, 0xb3, 0x03, 0xfa,
+	0x10, 0xfb, 0xd4, 0x66, 0x17, 0x81, 0xde, 0x80, 0x39, 0x3b, 0x70, 0xeb, 0xfc, 0x56, 0xcc, 0x23,
+	0xdf, 0x6d, 0x7a, 0x81, 0xae, 0xad, 0x8f, 0x6d, 0x4c, 0x1b, 0xb3, 0xed, 0xf9, 0x3b, 0x7c, 0xba,
+	0xf0, 0x8f, 0x71, 0xb8, 0xd4, 0x23, 0x60, 0xd7, 0x75, 0x0e, 0xed, 0x23, 0xa4, 0xc3, 0xe4, 0x09,
+	0xf1, 0x03, 0xdb, 0x75, 0x74, 0x6d, 0x5d, 0xdb, 0x18, 0x33, 0xc2, 0x21, 0x2a, 0xc1, 0x82, 0xd3,
+	0x6c, 0x98, 0x3e, 0xc1, 0x96, 0xe9, 0x85, 0x5c, 0x81, 0x9e, 0x5a, 0xd7, 0x36, 0xd2, 0x3b, 0x29,
+	0x5d, 0x33, 0xe6, 0x9d, 0x66, 0xc3, 0x20, 0xd8, 0x6a, 0x8b, 0x0c, 0xd0, 0x3b, 0xb0, 0xc8, 0x78,
+	0x4e, 0x7d, 0x9b, 0x92, 0x28, 0xd3, 0x58, 0x9b, 0x09, 0x39, 0xcd, 0xc6, 0x53, 0xb6, 0x1c, 0xe1,
+	0x72, 0x60, 0xb6, 0x7b, 0x97, 0xf1, 0xf5, 0xb1, 0x8d, 0x4c, 0xe9, 0x76, 0x31, 0xc9, 0x43, 0x8b,
+	0x09, 0xe7, 0x29, 0xc6, 0x15, 0xba, 0xed, 0x50, 0xbf, 0x65, 0xe4, 0xfc, 0xb8, 0x96, 0xcf, 0x61,
+	0xae, 0x47, 0xc3, 0x34, 0xdf, 0x70, 0x7f, 0xf4, 0x0d, 0xbb, 0x0e, 0x23, 0x76, 0x9c, 0x3d, 0x8d,
+	0xcf, 0xe6, 0x1d, 0x58, 0x50, 0x68, 0x86, 0xe6, 0x60, 0xec, 0x98, 0xb4, 0xb8, 0xe5, 0xd3, 0x06,
+	0xfb, 0x89, 0xb6, 0x21, 0x7d, 0x82, 0xeb, 0x4d, 0xc2, 0xed, 0x9c, 0x29, 0x7d, 0x7d, 0x04, 0x85,
+	0x0c, 0xc1, 0x79, 0x2b, 0x75, 0x53, 0xcb, 0xbb, 0xb0, 0xa8, 0x52, 0xec, 0xa5, 0x6d, 0x58, 0xf8,
+	0x01, 0xcc, 0xdf, 0x73, 0xb1, 0xb5, 0x83, 0xeb, 0xd8, 0xa9, 0x12, 0xff, 0xae, 0xed, 0xd0, 0x00,
+	0xbd, 0x0a, 0x33, 0x15, 0x5c, 0x3d, 0xae, 0xbb, 0x47, 0x66, 0xd5, 0x6d, 0x3a, 0x54, 0xba, 0x58,
+	0x56, 0x4e, 0xee, 0xb2, 0x39, 0x74, 0x1d, 0x66, 0x7d, 0xcc, 0x2e, 0x83, 0xf8, 0x66, 0x40, 0xaa,
+	0xae, 0x63, 0x71, 0x55, 0x34, 0x63, 0x86, 0x4d, 0x3f, 0x24, 0xfe, 0x63, 0x3e, 0x59, 0xf8, 0x97,
+	0x06, 0xf9, 0x87, 0x6e, 0xbd, 0xbe, 0xef, 0xfa, 0x7b, 0xa4, 0x6a, 0x33, 0x1f, 0x65, 0x1a, 0x19,
+	0xe4, 0x79, 0x93, 0x04, 0x14, 0x95, 0x61, 0xd2, 0x17, 0x3f, 0xf9, 0x2e, 0x99, 0xd2, 0x66, 0xfc,
+	0x24, 0xd8, 0xb3, 0xd9, 0x21, 0x92, 0x25, 0x18, 0x21, 0x3f, 0x5a, 0x81, 0x69, 0xcb, 0x6d, 0x60,
+	0xdb, 0x31, 0x6d, 0xa1, 0xcb, 0xb4, 0x31, 0x25, 0x26, 0xca, 0x16, 0x5b, 0xf4, 0xdc, 0x7a, 0x9d,
+	0xf8, 0x6c, 0x71, 0x4c, 0x2c, 0x8a, 0x89, 0xb2, 0x85, 0xae, 0x41, 0xee, 0xd0, 0xf5, 0x4f, 0xb1,
+	0x6f, 0x11, 0xcb, 0x3c, 0xf4, 0xdd, 0x86, 0x3e, 0xce, 0x29, 0x66, 0xda, 0xb3, 0xfb, 0xbe, 0xdb,
+	0x40, 0xaf, 0xc3, 0x6c, 0x57, 0xec, 0xea, 0x69, 0x4e, 0x97, 0x8b, 0x87, 0x6e, 0xe1, 0xcf, 0x19,
+	0x58, 0x51, 0x6a, 0x1c, 0x78, 0xae, 0x13, 0x10, 0xb4, 0x0a, 0xc0, 0x72, 0x85, 0x49, 0xdd, 0x63,
+	0x22, 0x02, 0x38, 0x6b, 0x4c, 0xb3, 0x99, 0x03, 0x36, 0x81, 0x3e, 0x06, 0x14, 0xa6, 0x2e, 0x93,
+	0x7c, 0x4a, 0xaa, 0x4d, 0x26, 0x59, 0x5e, 0xf4, 0x75, 0xa5, 0x79, 0x9e, 0x4a, 0xf2, 0xdb, 0x21,
+	0xb5, 0x31, 0x7f, 0xda, 0x3d, 0x85, 0xf6, 0x61, 0xa6, 0x2d, 0x96, 0xb6, 0x3c, 0xc2, 0xcd, 0x90,
+	0x29, 0x5d, 0xed, 0x2b, 0xf1, 0xa0, 0xe5, 0x11, 0x23, 0x7b, 0x1a, 0x19, 0xa1, 0x27, 0xb0, 0xec,
+	0xf9, 0xe4, 0xc4, 0x76, 0x9b, 0x81, 0x19, 0x50, 0xec, 0x53, 0x62, 0x99, 0xe4, 0x84, 0x38, 0x94,
+	0x99, 0x76, 0x9c, 0xcb, 0x5c, 0x29, 0x8a, 0x42, 0x52, 0x0c, 0x0b, 0x49, 0xb1, 0xec, 0xd0, 0x77,
+	0xdf, 0x79, 0xc2, 0xfc, 0xce, 0x58, 0x0a, 0xb9, 0x1f, 0x0b, 0xe6, 0xdb, 0x8c, 0xb7, 0x6c, 0xa1,
+	0x0d, 0x98, 0xeb, 0x11, 0x97, 0xe6, 0x9e, 0x97, 0x0b, 0xe2, 0x94, 0x3a, 0x4c, 0x62, 0x4a, 0x49,
+	0xc3, 0xa3, 0xfa, 0x04, 0x0f, 0x89, 0x70, 0x88, 0x0a, 0x30, 0xe3, 0x90, 0x4f, 0x69, 0x47, 0xc0,
+	0x24, 0x17, 0x90, 0x61, 0x93, 0x21, 0xf7, 0x9b, 0x80, 0x62, 0xee, 0x6d, 0xd6, 0x6c, 0x87, 0xea,
+	0x53, 0x9c, 0x70, 0x2e, 0xea, 0xe3, 0x2c, 0x1a, 0xd0, 0x4d, 0xd0, 0x03, 0x6a, 0x57, 0x8f, 0x5b,
+	0x9d, 0xab, 0x30, 0x89, 0x83, 0x2b, 0x75, 0x62, 0xe9, 0xd3, 0xeb, 0xda, 0xc6, 0x94, 0xb1, 0x24,
+	0xd6, 0xdb, 0x86, 0xbe, 0x2d, 0x56, 0xd1, 0x4d, 0x48, 0xf3, 0xc2, 0xa7, 0x03, 0xb7, 0x49, 0xa1,
+	0xaf, 0x9d, 0x1f, 0x31, 0x4a, 0x43, 0x30, 0x20, 0x03, 0x66, 0x2c, 0xe9, 0x37, 0xa6, 0xed, 0x1c,
+	0xba, 0x7a, 0x86, 0x4b, 0xf8, 0x46, 0x5c, 0x82, 0x28, 0x3c, 0x3c, 0xc4, 0x7d, 0xec, 0x04, 0x36,
+	0x71, 0x68, 0xe8, 0x6d, 0x65, 0xe7, 0xd0, 0x35, 0xb2, 0x56, 0x64, 0x84, 0x9e, 0xc1, 0xe5, 0x5e,
+	0xa7, 0x32, 0xb9, 0x1b, 0xb2, 0x9a, 0xa5, 0x67, 0xf9, 0x16, 0xab, 0x4a, 0x25, 0xc3, 0x14, 0x62,
+	0x2c, 0xf7, 0x78, 0x55, 0xb8, 0x84, 0x8a, 0xb0, 0x20, 0x8c, 0xce, 0x2a, 0x25, 0x31, 0xc3, 0xea,
+	0x34, 0xc3, 0xef, 0x67, 0x9e, 0x2f, 0x3d, 0x66, 0x2b, 0x4f, 0xc4, 0x02, 0xba, 0x0a, 0xd9, 0x8a,
+	0x8f, 0x9d, 0x6a, 0x4d, 0x46, 0x41, 0x8e, 0x47, 0x41, 0x46, 0xcc, 0x89, 0x38, 0xd8, 0x86, 0x5c,
+	0x50, 0xad, 0x11, 0xab, 0x59, 0x27, 0x96, 0xc9, 0xa0, 0x8a, 0x3e, 0xcb, 0x95, 0xcc, 0xf7, 0x78,
+	0xd7, 0x41, 0x88, 0x63, 0x8c, 0x99, 0x36, 0x07, 0x9b, 0x43, 0xdf, 0x82, 0x6c, 0xe8, 0x53, 0x5c,
+	0xc0, 0xdc, 0x40, 0x01, 0x19, 0x49, 0xcf, 0xd9, 0x3f, 0x81, 0x49, 0x76, 0x23, 0x36, 0x09, 0xf4,
+	0x79, 0x5e, 0x69, 0x76, 0x92, 0xf3, 0x6c, 0x9f, 0x80, 0x2f, 0x3e, 0x12, 0x42, 0x44, 0x95, 0x09,
+	0x45, 0x32, 0x93, 0x51, 0x97, 0xe2, 0xba, 0x29, 0xe1, 0x85, 0x59, 0x69, 0x51, 0x12, 0xe8, 0x88,
+	0x7b, 0xe2, 0x3c, 0x5f, 0xba, 0x2b, 0x56, 0x76, 0xd8, 0x02, 0xfa, 0x04, 0xe6, 0xda, 0xa5, 0xcf,
+	0xac, 0xf2, 0x3a, 0xa6, 0x2f, 0xf0, 0x03, 0x6d, 0x8d, 0x5c, 0x00, 0x8d, 0x59, 0x2f, 0x3e, 0x81,
+	0xbe, 0x0f, 0x0b, 0x75, 0x17, 0x5b, 0x66, 0x45, 0xd6, 0x02, 0x1e, 0x16, 0x81, 0xbe, 0x38, 0xa8,
+	0xbe, 0xf4, 0xd4, 0x0f, 0x63, 0xbe, 0xde, 0x3d, 0x85, 0xee, 0xc3, 0x1c, 0x6e, 0x52, 0x57, 0x6a,
+	0x2d, 0x22, 0xee, 0x15, 0x2e, 0xf9, 0x55, 0xa5, 0xc7, 0x6d, 0x37, 0xa9, 0x2b, 0xf4, 0x62, 0xfc,
+	0x46, 0x0e, 0xc7, 0xc6, 0xf9, 0x67, 0x90, 0x8d, 0x9a, 0x34, 0x5a, 0x1f, 0xa7, 0x45, 0x7d, 0xbc,
+	0x19, 0xaf, 0x8f, 0x43, 0x05, 0x5f, 0xa7, 0x2c, 0x46, 0x8a, 0xd6, 0x76, 0x95, 0xda, 0x27, 0x36,
+	0x6d, 0x9d, 0xbd, 0x68, 0x29, 0x24, 0xfc, 0x2f, 0x16, 0xad, 0xdf, 0x00, 0xac, 0x28, 0x35, 0xfe,
+	0x4a, 0x8b, 0xd6, 0x15, 0xc8, 0x60, 0xa9, 0x4d, 0xc7, 0x08, 0x10, 0x4e, 0x95, 0x2d, 0x56, 0xd5,
+	0xda, 0x04, 0xbc, 0xaa, 0x8d, 0xf7, 0xa9, 0x6a, 0xed, 0x83, 0xf1, 0xaa, 0x86, 0x23, 0x23, 0x54,
+	0x82, 0xb4, 0xed, 0x78, 0x4d, 0xca, 0xad, 0x93, 0x29, 0x5d, 0x56, 0xdf, 0x28, 0x6e, 0x31, 0xdf,
+	0x36, 0x04, 0xa9, 0x22, 0x41, 0x4d, 0x9c, 0x37, 0x41, 0x4d, 0x8e, 0x96, 0xa0, 0x0e, 0x60, 0x39,
+	0x94, 0x67, 0xb2, 0xf0, 0xaa, 0xbb, 0x01, 0xe1, 0x82, 0xdc, 0xa6, 0x28, 0x69, 0x99, 0xd2, 0x72,
+	0x8f, 0xac, 0x3d, 0xd9, 0x15, 0x1a, 0x4b, 0x21, 0xef, 0x81, 0xbb, 0xcb, 0x38, 0x0f, 0x04, 0x23,
+	0xfa, 0x08, 0x96, 0xf8, 0x26, 0xbd, 0x22, 0xa7, 0x07, 0x89, 0x5c, 0xe0, 0x8c, 0x5d, 0xf2, 0xf6,
+	0x61, 0xbe, 0x46, 0xb0, 0x4f, 0x2b, 0x04, 0xd3, 0xb6, 0x28, 0x18, 0x24, 0x6a, 0xae, 0xcd, 0x13,
+	0xca, 0x89, 0xd4, 0xfd, 0x4c, 0xbc, 0xee, 0x3f, 0x83, 0xb5, 0xf8, 0x4d, 0x98, 0xee, 0xa1, 0x49,
+	0x6b, 0x76, 0x60, 0x86, 0x0c, 0xd9, 0x81, 0x86, 0xcd, 0xc7, 0x6e, 0xe6, 0xc1, 0xe1, 0x41, 0xcd,
+	0x0e, 0xb6, 0xa5, 0xfc, 0x72, 0xf4, 0x04, 0x16, 0xa1, 0xd8, 0xae, 0x07, 0xfa, 0xcc, 0x10, 0x9e,
+	0xd2, 0x39, 0xc4, 0x9e, 0xe0, 0xea, 0x85, 0x61, 0xb9, 0xb3, 0xc1, 0xb0, 0xd7, 0x61, 0xb6, 0x2d,
+	0x47, 0x64, 0x0c, 0x5e, 0x1e, 0xa7, 0x8d, 0x5c, 0x38, 0xbd, 0xc7, 0x67, 0xd1, 0xdb, 0x30, 0x51,
+	0x23, 0xd8, 0x22, 0xbe, 0xac, 0x7e, 0x2b, 0xca, 0x9d, 0xee, 0x72, 0x12, 0x43, 0x92, 0x26, 0x55,
+	0x83, 0xf9, 0x0b, 0xa9, 0x06, 0x2f, 0xb7, 0x90, 0xa9, 0x6a, 0xcd, 0xe2, 0x99, 0x6b, 0x4d, 0xe1,
+	0x8b, 0x71, 0x58, 0xda, 0xb6, 0x2c, 0x55, 0xf3, 0x12, 0x4b, 0xde, 0x5a, 0x57, 0xf2, 0x7e, 0x49,
+	0x09, 0xf1, 0x16, 0x4c, 0x77, 0x40, 0xdb, 0xd8, 0x30, 0xa0, 0x6d, 0x8a, 0xca, 0x5f, 0x2c, 0x99,
+	0xb6, 0xb3, 0x85, 0xc4, 0xea, 0x63, 0x06, 0x84, 0x53, 0x65, 0xab, 0x3b, 0x9d, 0xc8, 0x24, 0x20,
+	0x03, 0x36, 0x3d, 0x42, 0x3a, 0xe1, 0xd0, 0x3e, 0x0c, 0xdb, 0x5b, 0x30, 0x11, 0xb8, 0x4d, 0xbf,
+	0x2a, 0xd2, 0x63, 0xae, 0x54, 0x48, 0xc4, 0xb1, 0x38, 0x38, 0x7e, 0xcc, 0x29, 0x0d, 0xc9, 0xa1,
+	0xa8, 0x72, 0x93, 0xaa, 0x2a, 0xe7, 0x29, 0x3c, 0x6a, 0x6a, 0xd0, 0x63, 0x84, 0xfa, 0x56, 0x8b,
+	0x5d, 0x0e, 0x26, 0x9f, 0x06, 0xba, 0xbd, 0x2c, 0x0f, 0x53, 0x9e, 0x6f, 0xbb, 0xbe, 0x4d, 0x5b,
+	0x3c, 0x2b, 0xa6, 0x8d, 0xf6, 0x38, 0xbf, 0x03, 0x8b, 0x2a, 0x21, 0x0a, 0x98, 0xb2, 0x18, 0x85,
+	0x29, 0xd3, 0x51, 0x08, 0x72, 0x0a, 0x97, 0x7a, 0xf4, 0x93, 0x95, 0x58, 0x15, 0x3e, 0xda, 0x45,
+	0x85, 0x4f, 0xe1, 0x4f, 0x13, 0xdc, 0xdf, 0x55, 0xb8, 0xe7, 0xab, 0xf0, 0x77, 0xd6, 0x15, 0x72,
+	0x57, 0x30, 0x3b, 0x5b, 0x0b, 0x14, 0x90, 0x13, 0xf3, 0x7b, 0xa1, 0x02, 0xb1, 0xc8, 0x18, 0x3f,
+	0x57, 0x64, 0xa4, 0x47, 0x8b, 0x8c, 0x89, 0xf3, 0x47, 0xc6, 0xe4, 0x05, 0x44, 0xc6, 0x94, 0x2a,
+	0x32, 0x1c, 0xd0, 0x71, 0xe4, 0x2a, 0xf7, 0xec, 0xc0, 0x63, 0x5e, 0xc1, 0x7a, 0x42, 0x59, 0xcd,
+	0x4b, 0x7d, 0x22, 0x24, 0x81, 0xd3, 0x48, 0x94, 0xa9, 0x8c, 0x44, 0x18, 0x22, 0x12, 0x15, 0xfe,
+	0x76, 0x86, 0x48, 0xcc, 0xc4, 0x23, 0x91, 0x75, 0x99, 0x87, 0xd8, 0xf6, 0x1d, 0x12, 0x04, 0x26,
+	0x0b, 0xbd, 0x2c, 0x37, 0x51, 0x26, 0x9c, 0xfb, 0x2e, 0xb9, 0x98, 0x60, 0xfd, 0x7c, 0x0c, 0xf4,
+	0x24, 0x5b, 0xa1, 0xef, 0xc0, 0x6c, 0x07, 0x9b, 0xf0, 0x46, 0x58, 0xd7, 0xfa, 0x94, 0x7c, 0xd9,
+	0xf2, 0xf1, 0xd7, 0x0a, 0xa3, 0x83, 0x2f, 0xf9, 0xb8, 0x07, 0x2e, 0xa6, 0x46, 0x83, 0x8b, 0x11,
+	0x00, 0x35, 0x36, 0x2a, 0x80, 0x1a, 0xbf, 0x78, 0x00, 0x95, 0xbe, 0x18, 0x00, 0x35, 0x71, 0x61,
+	0x00, 0x6a, 0x52, 0x05, 0xa0, 0x64, 0x2a, 0x56, 0x36, 0x45, 0x2f, 0x37, 0x15, 0x7f, 0xae, 0xc1,
+	0x22, 0xef, 0x4d, 0xc3, 0x53, 0x84, 0x89, 0x78, 0xb7, 0xbb, 0x01, 0x7d, 0x43, 0x79, 0x78, 0x15,
+	0xef, 0x90, 0xad, 0xe7, 0x79, 0x60, 0xc6, 0x70, 0x9d, 0x69, 0xe1, 0x3f, 0x1a, 0xbc, 0xd2, 0xa5,
+	0xa1, 0xb4, 0xea, 0x87, 0x90, 0xe5, 0x0f, 0x61, 0xa6, 0x4f, 0x82, 0x66, 0x3d, 0x3c, 0x63, 0x7f,
+	0x3f, 0xc9, 0x70, 0x0e, 0x83, 0x33, 0xa0, 0x32, 0xe4, 0x42, 0x01, 0x3f, 0x24, 0x55, 0x4a, 0xac,
+	0xbe, 0xcf, 0x00, 0xa2, 0xfd, 0x97, 0x94, 0xc6, 0xcc, 0xf3, 0xe8, 0x10, 0x3d, 0x55, 0xdc, 0xb0,
+	0xb0, 0xc7, 0x9b, 0x7d, 0xed, 0x31, 0xf0, 0x72, 0xff, 0xa9, 0xc1, 0xba, 0x38, 0xb1, 0xc5, 0x15,
+	0x60, 0x8c, 0xbb, 0x6e, 0xc3, 0xab, 0x13, 0xa6, 0x85, 0xbc, 0xa3, 0x07, 0xdd, 0x17, 0x7d, 0x43,
+	0xb9, 0xe9, 0x20, 0x39, 0x5f, 0xc2, 0xa5, 0x5f, 0x82, 0x49, 0xce, 0x2b, 0x71, 0xe5, 0xb4, 0x31,
+	0xc1, 0x86, 0x65, 0xab, 0xf0, 0x2a, 0x5c, 0xed, 0xa3, 0x9e, 0xb8, 0xf1, 0xc2, 0xdf, 0x34, 0xb8,
+	0xbc, 0xcb, 0x3a, 0x84, 0xfa, 0x83, 0x26, 0x0d, 0x28, 0x76, 0x2c, 0xdb, 0x39, 0x62, 0xaf, 0x11,
+	0x43, 0x41, 0x8f, 0xd8, 0x3b, 0x49, 0xaa, 0xeb, 0x9d, 0xe4, 0x0e, 0xe4, 0xda, 0x87, 0xea, 0xbc,
+	0x7b, 0xe7, 0x12, 0xf2, 0x45, 0x78, 0x32, 0x91, 0x2f, 0x68, 0x64, 0x74, 0x1e, 0x7c, 0x51, 0xb8,
+	0x02, 0xab, 0x09, 0xc7, 0x93, 0x06, 0xf8, 0x11, 0x5c, 0xda, 0x23, 0x41, 0xd5, 0xb7, 0x2b, 0xa4,
+	0xcd, 0x2e, 0x8f, 0xbe, 0xdf, 0xed, 0x03, 0x6a, 0xc7, 0x4b, 0x60, 0x1f, 0xee, 0xea, 0x0b, 0x5f,
+	0xa4, 0x41, 0xef, 0x95, 0x20, 0xe3, 0xf1, 0x3d, 0x98, 0x14, 0xe6, 0x14, 0xdf, 0x2a, 0x33, 0xa5,
+	0x2b, 0x89, 0xef, 0x5d, 0xc4, 0xe7, 0xf8, 0x20, 0xa4, 0x67, 0xcd, 0x58, 0xc7, 0xfa, 0x01, 0xc5,
+	0xb4, 0x19, 0xe8, 0xa9, 0x3e, 0xcd, 0x58, 0xb8, 0xf7, 0x63, 0x4e, 0x6a, 0xe4, 0x68, 0x6c, 0xfc,
+	0xd2, 0xa2, 0xf1, 0x5c, 0xe0, 0xf1, 0x17, 0x1a, 0x2c, 0xc7, 0xbf, 0x28, 0x54, 0x5a, 0x66, 0x1b,
+	0x92, 0x88, 0x4f, 0x94, 0x1f, 0x25, 0x97, 0x83, 0x24, 0xb3, 0x17, 0x77, 0x22, 0xdf, 0x22, 0x76,
+	0x5a, 0x0f, 0xa5, 0x40, 0x81, 0x82, 0x96, 0x2a, 0xca, 0x45, 0xf4, 0x2b, 0x0d, 0x56, 0x7b, 0x74,
+	0x89, 0x41, 0xa0, 0x09, 0xae, 0xcf, 0xa3, 0x73, 0xeb, 0xb3, 0xdf, 0xc1, 0x50, 0x42, 0xa5, 0xe5,
+	0x4a, 0xd2, 0x7a, 0xbe, 0x0c, 0x2b, 0x7d, 0x0e, 0xa3, 0xf8, 0xbc, 0x19, 0x83, 0x5a, 0x63, 0xd1,
+	0x4f, 0xa4, 0xf7, 0x60, 0xad, 0xbf, 0x1e, 0x83, 0x80, 0x5b, 0x54, 0x5a, 0x21, 0x80, 0x55, 0x1e,
+	0xdf, 0xdd, 0x6e, 0x12, 0x84, 0xc1, 0xb7, 0x04, 0x13, 0x12, 0x1b, 0x08, 0x79, 0x72, 0x14, 0xf7,
+	0x97, 0xd4, 0x68, 0xc9, 0xe0, 0x67, 0x29, 0x58, 0x4b, 0xda, 0x55, 0x46, 0xdc, 0x73, 0x58, 0xed,
+	0xbc, 0x6a, 0xb6, 0xe3, 0x27, 0xf2, 0xe1, 0x5b, 0xc4, 0x61, 0x71, 0x38, 0xa7, 0xbf, 0x4f, 0x28,
+	0xb6, 0x30, 0xc5, 0x46, 0x3e, 0x0a, 0xdb, 0xe3, 0x5b, 0xb3, 0x2d, 0xdb, 0x1f, 0x9d, 0x94, 0x5b,
+	0xa6, 0xce, 0xb6, 0xa5, 0x15, 0x69, 0x61, 0xe3, 0x5b, 0x16, 0x6e, 0xc0, 0xca, 0x1d, 0xd2, 0x36,
+	0x43, 0xb0, 0xd3, 0x12, 0x80, 0x6b, 0x80, 0xed, 0x0b, 0x7f, 0x1c, 0x87, 0xcb, 0x6a, 0x3e, 0x69,
+	0xbd, 0x9f, 0x68, 0xb0, 0xa4, 0x38, 0x4b, 0x03, 0x7b, 0xd2, 0x6e, 0x0f, 0x92, 0xbd, 0xbf, 0x9f,
+	0xe0, 0xe2, 0x5e, 0xd7, 0x59, 0xee, 0x63, 0x4f, 0xf8, 0xfe, 0x82, 0xd5, 0xbb, 0xc2, 0xd5, 0x50,
+	0xdc, 0x22, 0x53, 0x23, 0x75, 0x2e, 0x35, 0xb6, 0xbb, 0x6e, 0xb1, 0xa3, 0x06, 0xee, 0x5d, 0xc9,
+	0x7f, 0xc6, 0x32, 0xbb, 0x5a, 0x6f, 0x45, 0xac, 0xdc, 0x8d, 0x7f, 0x38, 0x29, 0x8d, 0x9e, 0x27,
+	0xa2, 0xd1, 0xfa, 0x59, 0xbc, 0x2f, 0xfa, 0x32, 0xf7, 0x2e, 0xfc, 0x2e, 0x05, 0xaf, 0x7d, 0xec,
+	0x59, 0x98, 0x92, 0xa4, 0x2a, 0x30, 0x0c, 0xb6, 0x38, 0x47, 0xa0, 0x5f, 0x1c, 0xf4, 0x50, 0x95,
+	0xbd, 0xf1, 0x8b, 0x00, 0xa1, 0xaf, 0xc3, 0xb5, 0x01, 0x26, 0x92, 0xf8, 0xe4, 0xf7, 0x29, 0xb8,
+	0x66, 0x90, 0x43, 0x9f, 0x04, 0xb5, 0xff, 0x5b, 0x33, 0xc9, 0x9a, 0x1b, 0x70, 0x7d, 0x90, 0x8d,
+	0x84, 0x39, 0x4b, 0xff, 0xce, 0x42, 0xe6, 0xbe, 0xf4, 0xe7, 0xed, 0x87, 0x65, 0xf4, 0x63, 0x0d,
+	0x16, 0x14, 0x1f, 0x90, 0xd1, 0x3b, 0x23, 0x7e, 0x6f, 0xe6, 0x57, 0x90, 0xbf, 0x71, 0xa6, 0xaf,
+	0xd4, 0x51, 0x25, 0xa2, 0x41, 0x3b, 0x84, 0x12, 0x8a, 0xc7, 0x9b, 0xfc, 0x8d, 0x11, 0xb9, 0xa4,
+	0x12, 0x27, 0x30, 0xdb, 0xf5, 0xee, 0x89, 0xde, 0x1a, 0xf5, 0x09, 0x37, 0xbf, 0x35, 0x02, 0x47,
+	0x6c, 0xdf, 0xd8, 0xb9, 0xdf, 0x1a, 0xf5, 0xc1, 0x2a, 0xbf, 0x35, 0x02, 0x87, 0xdc, 0xd7, 0x83,
+	0x99, 0x58, 0x13, 0x8c, 0x8a, 0xc9, 0x32, 0x54, 0xfd, 0x7c, 0x7e, 0x73, 0x68, 0x7a, 0xb9, 0xe3,
+	0xaf, 0x35, 0x58, 0x4e, 0xec, 0xc8, 0xd0, 0xad, 0x64, 0x71, 0x83, 0xba, 0xcc, 0xfc, 0xfb, 0x67,
+	0xe2, 0x95, 0x6a, 0xfd, 0x5c, 0x83, 0x57, 0x94, 0x3d, 0x12, 0x7a, 0x37, 0x59, 0x6c, 0xbf, 0x9e,
+	0x31, 0xff, 0xcd, 0x91, 0xf9, 0xa4, 0x2a, 0x2d, 0x98, 0xeb, 0x2e, 0x30, 0x68, 0x6b, 0x94, 0x62,
+	0x24, 0xf6, 0x3f, 0x43, 0xfd, 0x42, 0xbf, 0xd4, 0x60, 0x49, 0x8d, 0x0d, 0x51, 0x9f, 0xe3, 0xf4,
+	0xc5, 0xb0, 0xf9, 0x9b, 0xa3, 0x33, 0x4a, 0x6d, 0x7e, 0xaa, 0xc1, 0xa2, 0x0a, 0x89, 0xa0, 0x1b,
+	0xa3, 0x22, 0x17, 0xa1, 0xc9, 0xbb, 0x67, 0x03, 0x3c, 0xe8, 0xb7, 0x1a, 0xac, 0xf6, 0xad, 0x53,
+	0xe8, 0x83, 0x64, 0xc9, 0xc3, 0x60, 0x80, 0xfc, 0x87, 0x67, 0xe6, 0x97, 0x2a, 0xfe, 0x41, 0x83,
+	0xb5, 0xfe, 0xc9, 0x1f, 0x7d, 0xd8, 0x2f, 0x3c, 0x86, 0x28, 0xad, 0xf9, 0x6f, 0x9f, 0x5d, 0x80,
+	0xd0, 0x72, 0xe7, 0xce, 0x5f, 0x5e, 0xac, 0x69, 0x7f, 0x7d, 0xb1, 0xa6, 0xfd, 0xfd, 0xc5, 0x9a,
+	0xf6, 0xbd, 0xf7, 0x8e, 0x6c, 0x5a, 0x6b, 0x56, 0x8a, 0x55, 0xb7, 0xb1, 0x19, 0xfb, 0x4f, 0x73,
+	0xf1, 0x88, 0x38, 0xe2, 0x4f, 0xe0, 0xd1, 0xff, 0xa1, 0xbf, 0x1f, 0xfe, 0x3e, 0xd9, 0xaa, 0x4c,
+	0xf0, 0xd5, 0xb7, 0xff, 0x3b, 0x00, 0x11, 0xc5, 0x2c, 0xee, 0xb5, 0x2e, 0x00, 0x00,
 }
 
 func (m *TaskListPartition) Marshal() (dAtA []byte, err error) {
@@ -3110,6 +3160,11 @@ func (m *AddDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Priority != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.PartitionConfig) > 0 {
 		for k := range m.PartitionConfig {
 			v := m.PartitionConfig[k]
@@ -3255,6 +3310,18 @@ func (m *AddActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintService(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Priority != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.PartitionConfig) > 0 {
 		for k := range m.PartitionConfig {
 			v := m.PartitionConfig[k]
@@ -3869,6 +3936,38 @@ func (m *DescribeTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.BacklogCountByFairnessKey) > 0 {
+		for k := range m.BacklogCountByFairnessKey {
+			v := m.BacklogCountByFairnessKey[k]
+			baseI := i
+			i = encodeVarintService(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.BacklogCountByPriority) > 0 {
+		for k := range m.BacklogCountByPriority {
+			v := m.BacklogCountByPriority[k]
+			baseI := i
+			i = encodeVarintService(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintService(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.TaskList != nil {
 		{
 			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
@@ -4699,6 +4798,9 @@ func (m *AddDecisionTaskRequest) Size() (n int) {
 			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
 		}
 	}
+	if m.Priority != 0 {
+		n += 1 + sovService(uint64(m.Priority))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4769,6 +4871,13 @@ func (m *AddActivityTaskRequest) Size() (n int) {
 			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
 		}
 	}
+	if m.Priority != 0 {
+		n += 1 + sovService(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5005,6 +5114,22 @@ func (m *DescribeTaskListResponse) Size() (n int) {
 		l = m.TaskList.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	if len(m.BacklogCountByPriority) > 0 {
+		for k, v := range m.BacklogCountByPriority {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovService(uint64(k)) + 1 + sovService(uint64(v))
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if len(m.BacklogCountByFairnessKey) > 0 {
+		for k, v := range m.BacklogCountByFairnessKey {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + sovService(uint64(v))
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7977,6 +8102,25 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PartitionConfig[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -8520,6 +8664,57 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PartitionConfig[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -10006,6 +10201,218 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCountByPriority", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BacklogCountByPriority == nil {
+				m.BacklogCountByPriority = make(map[int32]int64)
+			}
+			var mapkey int32
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.BacklogCountByPriority[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCountByFairnessKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BacklogCountByFairnessKey == nil {
+				m.BacklogCountByFairnessKey = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.BacklogCountByFairnessKey[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
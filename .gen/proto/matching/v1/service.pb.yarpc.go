@@ -783,164 +783,171 @@ var (
 var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 	// uber/cadence/matching/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x49, 0x73, 0xdc, 0xc6,
-		0xf5, 0x2f, 0x0c, 0x39, 0x5c, 0xde, 0x90, 0x43, 0xb2, 0x49, 0x53, 0xd0, 0x48, 0x94, 0xa8, 0x91,
-		0x25, 0xd3, 0xff, 0xbf, 0x33, 0x34, 0x69, 0x49, 0x91, 0xa5, 0x8a, 0x15, 0x2e, 0xa2, 0x34, 0x29,
-		0x2b, 0x92, 0x21, 0x5a, 0xaa, 0x4a, 0x5c, 0x42, 0x9a, 0x83, 0x26, 0x07, 0x21, 0x06, 0x80, 0x80,
-		0x06, 0x69, 0xfa, 0x90, 0x43, 0x2a, 0x49, 0xa5, 0x2a, 0xd7, 0xe4, 0x9e, 0xed, 0x4b, 0xe4, 0x92,
-		0xcf, 0x91, 0x2a, 0x57, 0x0e, 0x39, 0xe4, 0x03, 0x24, 0x55, 0xb9, 0xe5, 0x90, 0xea, 0x05, 0x33,
-		0x00, 0xa6, 0x31, 0x0b, 0x49, 0xc9, 0x39, 0xe4, 0x46, 0x74, 0xbf, 0xad, 0xdf, 0xf6, 0x7b, 0xdd,
-		0x43, 0xb8, 0x19, 0xed, 0x91, 0x60, 0xb5, 0x81, 0x2d, 0xe2, 0x36, 0xc8, 0x6a, 0x0b, 0xd3, 0x46,
-		0xd3, 0x76, 0x0f, 0x56, 0x8f, 0xd6, 0x56, 0x43, 0x12, 0x1c, 0xd9, 0x0d, 0x52, 0xf3, 0x03, 0x8f,
-		0x7a, 0x48, 0x67, 0x74, 0x35, 0x49, 0x57, 0x8b, 0xe9, 0x6a, 0x47, 0x6b, 0x95, 0x2b, 0x07, 0x9e,
-		0x77, 0xe0, 0x90, 0x55, 0x4e, 0xb7, 0x17, 0xed, 0xaf, 0x5a, 0x51, 0x80, 0xa9, 0xed, 0xb9, 0x82,
-		0xb3, 0x72, 0x35, 0xbb, 0x4f, 0xed, 0x16, 0x09, 0x29, 0x6e, 0xf9, 0x92, 0xa0, 0x4b, 0xc0, 0x71,
-		0x80, 0x7d, 0x9f, 0x04, 0xa1, 0xdc, 0x5f, 0x4e, 0x99, 0x88, 0x7d, 0x9b, 0x59, 0xd7, 0xf0, 0x5a,
-		0xad, 0x8e, 0x0a, 0x15, 0xc5, 0xeb, 0x88, 0x04, 0x27, 0x92, 0xa0, 0xaa, 0x22, 0xa0, 0x38, 0x3c,
-		0x74, 0xec, 0x90, 0x4a, 0x9a, 0x15, 0x15, 0x8d, 0x74, 0x82, 0x79, 0xec, 0x05, 0x87, 0x24, 0x90,
-		0x94, 0xff, 0xd7, 0x8f, 0x72, 0xdf, 0xf1, 0x8e, 0x25, 0xed, 0x35, 0x15, 0x6d, 0xd3, 0x0e, 0xa9,
-		0xd7, 0x36, 0xee, 0xdd, 0x14, 0x49, 0xd8, 0xc4, 0x01, 0xb1, 0xba, 0xa9, 0x6e, 0xe4, 0x50, 0xa5,
-		0x4f, 0x51, 0xfd, 0x04, 0xe6, 0x76, 0x71, 0x78, 0xf8, 0xa9, 0x1d, 0xd2, 0x67, 0x38, 0xa0, 0x36,
-		0x0b, 0x04, 0x7a, 0x1f, 0x66, 0xed, 0xd0, 0x73, 0x78, 0x54, 0xcc, 0x83, 0xc0, 0x8b, 0xfc, 0x50,
-		0xd7, 0x96, 0x47, 0x56, 0x26, 0x8d, 0x99, 0xf6, 0xfa, 0x23, 0xbe, 0x5c, 0xfd, 0xdb, 0x28, 0x5c,
-		0xe8, 0x12, 0xb0, 0xe5, 0xb9, 0xfb, 0xf6, 0x01, 0xd2, 0x61, 0xfc, 0x88, 0x04, 0xa1, 0xed, 0xb9,
-		0xba, 0xb6, 0xac, 0xad, 0x8c, 0x18, 0xf1, 0x27, 0x5a, 0x87, 0x79, 0x37, 0x6a, 0x99, 0x01, 0xc1,
-		0x96, 0xe9, 0xc7, 0x5c, 0xa1, 0x5e, 0x58, 0xd6, 0x56, 0x8a, 0x9b, 0x05, 0x5d, 0x33, 0xe6, 0xdc,
-		0xa8, 0x65, 0x10, 0x6c, 0xb5, 0x45, 0x86, 0xe8, 0x16, 0x2c, 0x30, 0x9e, 0xe3, 0xc0, 0xa6, 0x24,
-		0xc9, 0x34, 0xd2, 0x66, 0x42, 0x6e, 0xd4, 0x7a, 0xc9, 0xb6, 0x13, 0x5c, 0x2e, 0xcc, 0x64, 0xb5,
-		0x8c, 0x2e, 0x8f, 0xac, 0x94, 0xd6, 0x1f, 0xd6, 0xf2, 0x32, 0xb4, 0x96, 0x73, 0x9e, 0x5a, 0xda,
-		0xa0, 0x87, 0x2e, 0x0d, 0x4e, 0x8c, 0x72, 0x90, 0xb6, 0xf2, 0x35, 0xcc, 0x76, 0x59, 0x58, 0xe4,
-		0x0a, 0x77, 0x86, 0x57, 0x98, 0x39, 0x8c, 0xd0, 0x38, 0x73, 0x9c, 0x5e, 0xad, 0xb8, 0x30, 0xaf,
-		0xb0, 0x0c, 0xcd, 0xc2, 0xc8, 0x21, 0x39, 0xe1, 0x9e, 0x2f, 0x1a, 0xec, 0x4f, 0xb4, 0x01, 0xc5,
-		0x23, 0xec, 0x44, 0x84, 0xfb, 0xb9, 0xb4, 0xfe, 0xff, 0x43, 0x18, 0x64, 0x08, 0xce, 0x7b, 0x85,
-		0xbb, 0x5a, 0xc5, 0x83, 0x05, 0x95, 0x61, 0x6f, 0x4c, 0x61, 0xf5, 0x47, 0x30, 0xf7, 0xa9, 0x87,
-		0xad, 0x4d, 0xec, 0x60, 0xb7, 0x41, 0x82, 0xc7, 0xb6, 0x4b, 0x43, 0x74, 0x1d, 0xa6, 0xf7, 0x70,
-		0xe3, 0xd0, 0xf1, 0x0e, 0xcc, 0x86, 0x17, 0xb9, 0x54, 0xa6, 0xd8, 0x94, 0x5c, 0xdc, 0x62, 0x6b,
-		0xe8, 0x26, 0xcc, 0x04, 0x98, 0x05, 0x83, 0x04, 0x66, 0x48, 0x1a, 0x9e, 0x6b, 0x71, 0x53, 0x34,
-		0x63, 0x9a, 0x2d, 0x3f, 0x23, 0xc1, 0x73, 0xbe, 0x58, 0xfd, 0x87, 0x06, 0x95, 0x67, 0x9e, 0xe3,
-		0xec, 0x78, 0xc1, 0x36, 0x69, 0xd8, 0x2c, 0x47, 0x99, 0x45, 0x06, 0x79, 0x1d, 0x91, 0x90, 0xa2,
-		0x3a, 0x8c, 0x07, 0xe2, 0x4f, 0xae, 0xa5, 0xb4, 0xbe, 0x9a, 0x3e, 0x09, 0xf6, 0x6d, 0x76, 0x88,
-		0x7c, 0x09, 0x46, 0xcc, 0x8f, 0x2e, 0xc1, 0xa4, 0xe5, 0xb5, 0xb0, 0xed, 0x9a, 0xb6, 0xb0, 0x65,
-		0xd2, 0x98, 0x10, 0x0b, 0x75, 0x8b, 0x6d, 0xfa, 0x9e, 0xe3, 0x90, 0x80, 0x6d, 0x8e, 0x88, 0x4d,
-		0xb1, 0x50, 0xb7, 0xd0, 0x0d, 0x28, 0xef, 0x7b, 0xc1, 0x31, 0x0e, 0x2c, 0x62, 0x99, 0xfb, 0x81,
-		0xd7, 0xd2, 0x47, 0x39, 0xc5, 0x74, 0x7b, 0x75, 0x27, 0xf0, 0x5a, 0xe8, 0x3d, 0x98, 0xc9, 0xd4,
-		0xae, 0x5e, 0xe4, 0x74, 0xe5, 0x74, 0xe9, 0x56, 0xff, 0x5c, 0x82, 0x4b, 0x4a, 0x8b, 0x43, 0xdf,
-		0x73, 0x43, 0x82, 0x96, 0x00, 0x58, 0xaf, 0x30, 0xa9, 0x77, 0x48, 0x44, 0x01, 0x4f, 0x19, 0x93,
-		0x6c, 0x65, 0x97, 0x2d, 0xa0, 0xcf, 0x01, 0xc5, 0xad, 0xcb, 0x24, 0x5f, 0x92, 0x46, 0xc4, 0x24,
-		0xcb, 0x40, 0xdf, 0x54, 0xba, 0xe7, 0xa5, 0x24, 0x7f, 0x18, 0x53, 0x1b, 0x73, 0xc7, 0xd9, 0x25,
-		0xb4, 0x03, 0xd3, 0x6d, 0xb1, 0xf4, 0xc4, 0x27, 0xdc, 0x0d, 0xa5, 0xf5, 0x6b, 0x3d, 0x25, 0xee,
-		0x9e, 0xf8, 0xc4, 0x98, 0x3a, 0x4e, 0x7c, 0xa1, 0x17, 0x70, 0xd1, 0x0f, 0xc8, 0x91, 0xed, 0x45,
-		0xa1, 0x19, 0x52, 0x1c, 0x50, 0x62, 0x99, 0xe4, 0x88, 0xb8, 0x94, 0xb9, 0x76, 0x94, 0xcb, 0xbc,
-		0x54, 0x13, 0x40, 0x52, 0x8b, 0x81, 0xa4, 0x56, 0x77, 0xe9, 0x9d, 0x5b, 0x2f, 0x58, 0xde, 0x19,
-		0x8b, 0x31, 0xf7, 0x73, 0xc1, 0xfc, 0x90, 0xf1, 0xd6, 0x2d, 0xb4, 0x02, 0xb3, 0x5d, 0xe2, 0x8a,
-		0x3c, 0xf3, 0xca, 0x61, 0x9a, 0x52, 0x87, 0x71, 0x4c, 0x29, 0x69, 0xf9, 0x54, 0x1f, 0xe3, 0x25,
-		0x11, 0x7f, 0xa2, 0x2a, 0x4c, 0xbb, 0xe4, 0x4b, 0xda, 0x11, 0x30, 0xce, 0x05, 0x94, 0xd8, 0x62,
-		0xcc, 0xfd, 0x01, 0xa0, 0x54, 0x7a, 0x9b, 0x4d, 0xdb, 0xa5, 0xfa, 0x04, 0x27, 0x9c, 0x4d, 0xe6,
-		0x38, 0xab, 0x06, 0x74, 0x17, 0xf4, 0x90, 0xda, 0x8d, 0xc3, 0x93, 0x4e, 0x28, 0x4c, 0xe2, 0xe2,
-		0x3d, 0x87, 0x58, 0xfa, 0xe4, 0xb2, 0xb6, 0x32, 0x61, 0x2c, 0x8a, 0xfd, 0xb6, 0xa3, 0x1f, 0x8a,
-		0x5d, 0x74, 0x17, 0x8a, 0x1c, 0xf8, 0x74, 0xe0, 0x3e, 0xa9, 0xf6, 0xf4, 0xf3, 0x67, 0x8c, 0xd2,
-		0x10, 0x0c, 0xc8, 0x80, 0x69, 0x4b, 0xe6, 0x8d, 0x69, 0xbb, 0xfb, 0x9e, 0x5e, 0xe2, 0x12, 0xbe,
-		0x95, 0x96, 0x20, 0x80, 0x87, 0x97, 0x78, 0x80, 0xdd, 0xd0, 0x26, 0x2e, 0x8d, 0xb3, 0xad, 0xee,
-		0xee, 0x7b, 0xc6, 0x94, 0x95, 0xf8, 0x42, 0xaf, 0xe0, 0x72, 0x77, 0x52, 0x99, 0x3c, 0x0d, 0x19,
-		0x66, 0xe9, 0x53, 0x5c, 0xc5, 0x92, 0xd2, 0xc8, 0xb8, 0x85, 0x18, 0x17, 0xbb, 0xb2, 0x2a, 0xde,
-		0x42, 0x35, 0x98, 0x17, 0x4e, 0x67, 0x48, 0x49, 0xcc, 0x18, 0x9d, 0xa6, 0x79, 0x7c, 0xe6, 0xf8,
-		0xd6, 0x73, 0xb6, 0xf3, 0x42, 0xe2, 0xd4, 0x35, 0x98, 0xda, 0x0b, 0xb0, 0xdb, 0x68, 0xca, 0x2a,
-		0x28, 0xf3, 0x2a, 0x28, 0x89, 0x35, 0x51, 0x07, 0x1b, 0x50, 0x0e, 0x1b, 0x4d, 0x62, 0x45, 0x0e,
-		0xb1, 0x4c, 0x36, 0xaa, 0xe8, 0x33, 0xdc, 0xc8, 0x4a, 0x57, 0x76, 0xed, 0xc6, 0x73, 0x8c, 0x31,
-		0xdd, 0xe6, 0x60, 0x6b, 0xe8, 0x3b, 0x30, 0x15, 0xe7, 0x14, 0x17, 0x30, 0xdb, 0x57, 0x40, 0x49,
-		0xd2, 0x73, 0xf6, 0x2f, 0x60, 0x9c, 0x45, 0xc4, 0x26, 0xa1, 0x3e, 0xc7, 0x91, 0x66, 0x33, 0xbf,
-		0xcf, 0xf6, 0x28, 0xf8, 0xda, 0x67, 0x42, 0x88, 0x40, 0x99, 0x58, 0x24, 0x73, 0x19, 0xf5, 0x28,
-		0x76, 0x4c, 0x39, 0x5e, 0x98, 0x7b, 0x27, 0x94, 0x84, 0x3a, 0xe2, 0x99, 0x38, 0xc7, 0xb7, 0x1e,
-		0x8b, 0x9d, 0x4d, 0xb6, 0x81, 0xbe, 0x80, 0xd9, 0x36, 0xf4, 0x99, 0x0d, 0x8e, 0x63, 0xfa, 0x3c,
-		0x3f, 0xd0, 0xda, 0xd0, 0x00, 0x68, 0xcc, 0xf8, 0x99, 0x91, 0xe2, 0x87, 0x30, 0xef, 0x78, 0xd8,
-		0x32, 0xf7, 0x24, 0x16, 0xf0, 0xb2, 0x08, 0xf5, 0x85, 0x7e, 0xf8, 0xd2, 0x85, 0x1f, 0xc6, 0x9c,
-		0xd3, 0x05, 0x29, 0x4f, 0x60, 0x16, 0x47, 0xd4, 0x93, 0x56, 0x8b, 0x8a, 0x7b, 0x87, 0x4b, 0xbe,
-		0xae, 0xcc, 0xb8, 0x8d, 0x88, 0x7a, 0xc2, 0x2e, 0xc6, 0x6f, 0x94, 0x71, 0xea, 0xbb, 0xf2, 0x0a,
-		0xa6, 0x92, 0x2e, 0x4d, 0xe2, 0xe3, 0xa4, 0xc0, 0xc7, 0xbb, 0x69, 0x7c, 0x1c, 0xa8, 0xf8, 0x3a,
-		0xb0, 0x98, 0x00, 0xad, 0x8d, 0x06, 0xb5, 0x8f, 0x6c, 0x7a, 0x72, 0x7a, 0xd0, 0x52, 0x48, 0xf8,
-		0x6f, 0x04, 0xad, 0xdf, 0x40, 0x1b, 0xb4, 0xd2, 0x16, 0x7f, 0xa3, 0xa0, 0x75, 0x15, 0x4a, 0x58,
-		0x5a, 0xd3, 0x71, 0x02, 0xc4, 0x4b, 0x75, 0x8b, 0xa1, 0x5a, 0x9b, 0x80, 0xa3, 0xda, 0x68, 0x0f,
-		0x54, 0x6b, 0x1f, 0x8c, 0xa3, 0x1a, 0x4e, 0x7c, 0xa1, 0x75, 0x28, 0xda, 0xae, 0x1f, 0x51, 0xee,
-		0x9d, 0xd2, 0xfa, 0x65, 0x75, 0x44, 0xf1, 0x09, 0xcb, 0x6d, 0x43, 0x90, 0x2a, 0x1a, 0xd4, 0xd8,
-		0x59, 0x1b, 0xd4, 0xf8, 0x70, 0x0d, 0x6a, 0x17, 0x2e, 0xc6, 0xf2, 0x4c, 0x56, 0x5e, 0x8e, 0x17,
-		0x12, 0x2e, 0xc8, 0x8b, 0x04, 0xa4, 0x95, 0xd6, 0x2f, 0x76, 0xc9, 0xda, 0x96, 0xb7, 0x42, 0x63,
-		0x31, 0xe6, 0xdd, 0xf5, 0xb6, 0x18, 0xe7, 0xae, 0x60, 0x44, 0xdf, 0x87, 0x45, 0xae, 0xa4, 0x5b,
-		0xe4, 0x64, 0x3f, 0x91, 0xf3, 0x9c, 0x31, 0x23, 0x6f, 0x07, 0xe6, 0x9a, 0x04, 0x07, 0x74, 0x8f,
-		0x60, 0xda, 0x16, 0x05, 0xfd, 0x44, 0xcd, 0xb6, 0x79, 0x62, 0x39, 0x09, 0xdc, 0x2f, 0xa5, 0x71,
-		0xff, 0x15, 0x5c, 0x49, 0x47, 0xc2, 0xf4, 0xf6, 0x4d, 0xda, 0xb4, 0x43, 0x33, 0x66, 0x98, 0xea,
-		0xeb, 0xd8, 0x4a, 0x2a, 0x32, 0x4f, 0xf7, 0x77, 0x9b, 0x76, 0xb8, 0x21, 0xe5, 0xd7, 0x93, 0x27,
-		0xb0, 0x08, 0xc5, 0xb6, 0x13, 0x72, 0x6c, 0xeb, 0x97, 0x29, 0x9d, 0x43, 0x6c, 0x0b, 0xae, 0xee,
-		0x31, 0xac, 0x7c, 0xba, 0x31, 0xec, 0x3d, 0x98, 0x69, 0xcb, 0x11, 0x1d, 0x83, 0xc3, 0xe3, 0xa4,
-		0x51, 0x8e, 0x97, 0xb7, 0xf9, 0x2a, 0xfa, 0x08, 0xc6, 0x9a, 0x04, 0x5b, 0x24, 0x90, 0xe8, 0x77,
-		0x49, 0xa9, 0xe9, 0x31, 0x27, 0x31, 0x24, 0x69, 0x1e, 0x1a, 0xcc, 0x9d, 0x0b, 0x1a, 0xbc, 0x59,
-		0x20, 0x53, 0x61, 0xcd, 0xc2, 0xa9, 0xb1, 0xa6, 0xfa, 0x97, 0x51, 0x58, 0xdc, 0xb0, 0x2c, 0xd5,
-		0xe5, 0x25, 0xd5, 0xbc, 0xb5, 0x4c, 0xf3, 0x7e, 0x43, 0x0d, 0xf1, 0x1e, 0x4c, 0x76, 0x86, 0xb6,
-		0x91, 0x41, 0x86, 0xb6, 0x09, 0x1a, 0xcf, 0x68, 0x57, 0xa1, 0xd4, 0xee, 0x16, 0x72, 0x56, 0x1f,
-		0x31, 0x20, 0x5e, 0xaa, 0x5b, 0xd9, 0x76, 0x22, 0x9b, 0x80, 0x2c, 0xd8, 0xe2, 0x10, 0xed, 0x84,
-		0x8f, 0xf6, 0x71, 0xd9, 0xde, 0x83, 0xb1, 0xd0, 0x8b, 0x82, 0x86, 0x68, 0x8f, 0xe5, 0x2c, 0x18,
-		0x27, 0xe6, 0x58, 0x1c, 0x1e, 0x3e, 0xe7, 0x94, 0x86, 0xe4, 0x50, 0xa0, 0xdc, 0xb8, 0x0a, 0xe5,
-		0x7c, 0x45, 0x46, 0x4d, 0xf4, 0x7b, 0x8c, 0x50, 0x47, 0xb5, 0x96, 0x49, 0x30, 0xf9, 0x34, 0x90,
-		0xc9, 0xb2, 0xca, 0x26, 0x2c, 0xa8, 0x08, 0x15, 0xa3, 0xc8, 0x42, 0x72, 0x14, 0x99, 0x4c, 0x8e,
-		0x19, 0xc7, 0x70, 0xa1, 0xcb, 0x06, 0x89, 0xb6, 0xaa, 0x12, 0xd1, 0xce, 0xab, 0x44, 0xaa, 0xff,
-		0x2c, 0xf2, 0x9c, 0x56, 0xcd, 0x36, 0xdf, 0x44, 0x4e, 0xb3, 0x9b, 0x1f, 0x0f, 0xb7, 0xd9, 0x51,
-		0x2d, 0x90, 0xbe, 0x2c, 0xd6, 0xb7, 0x63, 0x03, 0x52, 0xd9, 0x3f, 0x7a, 0xa6, 0xec, 0x2f, 0x0e,
-		0x97, 0xfd, 0x63, 0x67, 0xcf, 0xfe, 0xf1, 0x73, 0xc8, 0xfe, 0x09, 0x55, 0xf6, 0xbb, 0xa0, 0xe3,
-		0x44, 0x28, 0xb7, 0xed, 0xd0, 0x67, 0x59, 0xc1, 0xee, 0x7d, 0x12, 0xb1, 0xd7, 0x7b, 0x54, 0x41,
-		0x0e, 0xa7, 0x91, 0x2b, 0x53, 0x59, 0x6d, 0x30, 0x40, 0xb5, 0x29, 0xf2, 0xed, 0x2d, 0x56, 0xdb,
-		0xd7, 0x23, 0xa0, 0xe7, 0x1d, 0x16, 0x7d, 0x0f, 0x66, 0x3a, 0x03, 0x04, 0xbf, 0xad, 0xca, 0x72,
-		0x53, 0xe3, 0xb2, 0xbc, 0x97, 0xf1, 0x27, 0x05, 0xa3, 0x33, 0x04, 0xf2, 0xef, 0xae, 0x99, 0xae,
-		0x30, 0xdc, 0x4c, 0x97, 0x98, 0x72, 0x46, 0x86, 0x9d, 0x72, 0x46, 0xcf, 0x7f, 0xca, 0x29, 0x9e,
-		0xcf, 0x94, 0x33, 0x76, 0x6e, 0x53, 0xce, 0xb8, 0x6a, 0xca, 0x91, 0xbd, 0x54, 0x79, 0x73, 0x79,
-		0xb3, 0xbd, 0xf4, 0x6b, 0x0d, 0x16, 0xf8, 0x05, 0x32, 0x3e, 0x45, 0xdc, 0x49, 0xb7, 0xb2, 0xb7,
-		0xc4, 0xf7, 0x95, 0x87, 0x57, 0xf1, 0x0e, 0x78, 0x3f, 0x3c, 0xcb, 0x2c, 0x30, 0xd8, 0xf5, 0xb1,
-		0xfa, 0x6f, 0x0d, 0xde, 0xc9, 0x58, 0x28, 0xbd, 0xfa, 0x00, 0xa6, 0xf8, 0x6b, 0x95, 0x19, 0x90,
-		0x30, 0x72, 0xe2, 0x33, 0xf6, 0xce, 0x93, 0x12, 0xe7, 0x30, 0x38, 0x03, 0xaa, 0x43, 0x39, 0x16,
-		0xf0, 0x63, 0xd2, 0xa0, 0xc4, 0xea, 0x79, 0x57, 0x17, 0x77, 0x74, 0x49, 0x69, 0x4c, 0xbf, 0x4e,
-		0x7e, 0xa2, 0x97, 0x8a, 0x08, 0x0b, 0x7f, 0x7c, 0xd0, 0xd3, 0x1f, 0x7d, 0x83, 0xfb, 0x77, 0x0d,
-		0x96, 0xc5, 0x89, 0x2d, 0x6e, 0x00, 0x63, 0xdc, 0xf2, 0x5a, 0xbe, 0x43, 0x98, 0x15, 0x32, 0x46,
-		0x4f, 0xb3, 0x81, 0xbe, 0xad, 0x54, 0xda, 0x4f, 0xce, 0x5b, 0x08, 0xfa, 0x05, 0x18, 0xe7, 0xbc,
-		0x72, 0xf8, 0x9b, 0x34, 0xc6, 0xd8, 0x67, 0xdd, 0xaa, 0x5e, 0x87, 0x6b, 0x3d, 0xcc, 0x13, 0x11,
-		0xaf, 0xfe, 0x55, 0x83, 0xcb, 0x5b, 0x6c, 0x8c, 0x77, 0x9e, 0x46, 0x34, 0xa4, 0xd8, 0xb5, 0x6c,
-		0xf7, 0xe0, 0x99, 0xe7, 0x38, 0x03, 0xcd, 0x0e, 0xa9, 0xc7, 0x8c, 0x42, 0xe6, 0x31, 0xe3, 0x11,
-		0x94, 0xdb, 0x87, 0xea, 0x3c, 0x4e, 0x97, 0x73, 0xfa, 0x45, 0x7c, 0x32, 0xd1, 0x2f, 0x68, 0xe2,
-		0xeb, 0x2c, 0x03, 0x42, 0xf5, 0x2a, 0x2c, 0xe5, 0x1c, 0x4f, 0x3a, 0xe0, 0x27, 0x70, 0x61, 0x9b,
-		0x84, 0x8d, 0xc0, 0xde, 0x23, 0x6d, 0x76, 0x79, 0xf4, 0x9d, 0x6c, 0x0e, 0xa8, 0x13, 0x2f, 0x87,
-		0x7d, 0xb0, 0xd0, 0x57, 0xff, 0x54, 0x00, 0xbd, 0x5b, 0x82, 0xac, 0xc7, 0x8f, 0x61, 0x5c, 0xb8,
-		0x53, 0xfc, 0xa0, 0x58, 0x5a, 0xbf, 0x9a, 0xfb, 0x28, 0x45, 0x02, 0x0e, 0xf0, 0x31, 0x3d, 0xbb,
-		0x31, 0x75, 0xbc, 0x1f, 0x52, 0x4c, 0xa3, 0x50, 0xd6, 0xe2, 0xf5, 0x9e, 0xbe, 0x7b, 0xce, 0x49,
-		0x8d, 0x32, 0x4d, 0x7d, 0xbf, 0xb1, 0x6a, 0x3c, 0x53, 0x70, 0x43, 0x58, 0xe2, 0x49, 0x92, 0xd5,
-		0x15, 0xc6, 0x11, 0x5c, 0x84, 0x31, 0x09, 0x30, 0x22, 0x73, 0xe5, 0x57, 0x5a, 0x69, 0x61, 0x38,
-		0xa5, 0xbf, 0x28, 0xc0, 0x95, 0x3c, 0xad, 0x32, 0x6c, 0xaf, 0x61, 0xa9, 0xf3, 0x7e, 0xd5, 0x0e,
-		0x42, 0xe2, 0x27, 0x4e, 0x11, 0xcc, 0xda, 0x60, 0x9e, 0x7b, 0x42, 0x28, 0xb6, 0x30, 0xc5, 0x46,
-		0x25, 0x39, 0xbc, 0xa5, 0x55, 0x33, 0x95, 0xed, 0x9f, 0x17, 0x94, 0x2a, 0x0b, 0xa7, 0x53, 0x69,
-		0x25, 0x2e, 0x32, 0x69, 0x95, 0xd5, 0xdb, 0x70, 0xe9, 0x11, 0x69, 0xbb, 0x21, 0xdc, 0x3c, 0x11,
-		0xa8, 0xdd, 0xc7, 0xf7, 0xd5, 0x3f, 0x8e, 0xc2, 0x65, 0x35, 0x9f, 0xf4, 0xde, 0xcf, 0x34, 0x58,
-		0x54, 0x9c, 0xa5, 0x85, 0x7d, 0xe9, 0xb7, 0xa7, 0xf9, 0x08, 0xdf, 0x4b, 0x70, 0x6d, 0x3b, 0x73,
-		0x96, 0x27, 0xd8, 0x17, 0xa3, 0xe9, 0xbc, 0xd5, 0xbd, 0xc3, 0xcd, 0x50, 0x44, 0x91, 0x99, 0x51,
-		0x38, 0x93, 0x19, 0x1b, 0x99, 0x28, 0x76, 0xcc, 0xc0, 0xdd, 0x3b, 0x95, 0xaf, 0x58, 0x7b, 0x50,
-		0xdb, 0xad, 0x98, 0x94, 0x1f, 0xa7, 0x9f, 0xc8, 0x7b, 0x5c, 0x11, 0xf2, 0x7a, 0x4e, 0xf2, 0xa7,
-		0xeb, 0xaf, 0xd2, 0xc3, 0xf5, 0xdb, 0xd4, 0x5d, 0xfd, 0x5d, 0x01, 0xde, 0xfd, 0xdc, 0xb7, 0x30,
-		0x25, 0x79, 0xad, 0x64, 0x10, 0x80, 0x3a, 0x43, 0xa1, 0x9f, 0x1f, 0x7e, 0xa9, 0x7a, 0xe7, 0xe8,
-		0x79, 0x4c, 0x32, 0xef, 0xc1, 0x8d, 0x3e, 0x2e, 0x92, 0x20, 0xf7, 0xfb, 0x02, 0xdc, 0x30, 0xc8,
-		0x7e, 0x40, 0xc2, 0xe6, 0xff, 0xbc, 0x99, 0xe7, 0xcd, 0x15, 0xb8, 0xd9, 0xcf, 0x47, 0xc2, 0x9d,
-		0xeb, 0xff, 0x9a, 0x82, 0xd2, 0x13, 0x99, 0xcf, 0x1b, 0xcf, 0xea, 0xe8, 0xa7, 0x1a, 0xcc, 0x2b,
-		0x7e, 0x2a, 0x44, 0xb7, 0x86, 0xfc, 0x65, 0x91, 0x87, 0xa0, 0x72, 0xfb, 0x54, 0xbf, 0x47, 0x26,
-		0x8d, 0x48, 0x16, 0xed, 0x00, 0x46, 0x28, 0xae, 0xf0, 0x03, 0x18, 0xa1, 0xbc, 0x96, 0x1d, 0xc1,
-		0x4c, 0xe6, 0xf5, 0x0b, 0x7d, 0x38, 0xec, 0x63, 0x5d, 0x65, 0x6d, 0x08, 0x8e, 0x94, 0xde, 0xd4,
-		0xb9, 0x3f, 0x1c, 0xf6, 0xd9, 0xa2, 0x8f, 0x5e, 0xe5, 0x79, 0x7d, 0x98, 0x4e, 0xdd, 0xa4, 0x50,
-		0x2d, 0x5f, 0x86, 0xea, 0x52, 0x58, 0x59, 0x1d, 0x98, 0x5e, 0x6a, 0xfc, 0xb5, 0x06, 0x17, 0x73,
-		0xc7, 0x7a, 0x74, 0x2f, 0x5f, 0x5c, 0xbf, 0xab, 0x4a, 0xe5, 0xfe, 0xa9, 0x78, 0xa5, 0x59, 0xbf,
-		0xd4, 0xe0, 0x1d, 0xe5, 0xa0, 0x8d, 0xee, 0xe4, 0x8b, 0xed, 0x75, 0xf1, 0xa8, 0x7c, 0x7b, 0x68,
-		0x3e, 0x69, 0xca, 0x09, 0xcc, 0x66, 0x01, 0x06, 0xad, 0x0d, 0x03, 0x46, 0x42, 0xff, 0x29, 0xf0,
-		0x0b, 0xfd, 0x4a, 0x83, 0x45, 0xf5, 0x6c, 0x88, 0x7a, 0x1c, 0xa7, 0xe7, 0x0c, 0x5b, 0xb9, 0x3b,
-		0x3c, 0xa3, 0xb4, 0xe6, 0xe7, 0x1a, 0x2c, 0xa8, 0x26, 0x11, 0x74, 0x7b, 0xd8, 0xc9, 0x45, 0x58,
-		0x72, 0xe7, 0x74, 0x03, 0x0f, 0xfa, 0xad, 0x06, 0x4b, 0x3d, 0x71, 0x0a, 0x7d, 0x92, 0x2f, 0x79,
-		0x90, 0x19, 0xa0, 0xf2, 0xe0, 0xd4, 0xfc, 0xd2, 0xc4, 0x3f, 0x68, 0x70, 0xa5, 0x77, 0xf3, 0x47,
-		0x0f, 0x7a, 0x95, 0xc7, 0x00, 0xd0, 0x5a, 0xf9, 0xee, 0xe9, 0x05, 0x08, 0x2b, 0x37, 0xef, 0xff,
-		0xe0, 0xe3, 0x03, 0x9b, 0x36, 0xa3, 0xbd, 0x5a, 0xc3, 0x6b, 0xad, 0xa6, 0xfe, 0x63, 0xb5, 0x76,
-		0x40, 0x5c, 0xf1, 0x2f, 0xbe, 0xc9, 0xff, 0x32, 0xbe, 0x1f, 0xff, 0x7d, 0xb4, 0xb6, 0x37, 0xc6,
-		0x77, 0x3f, 0xfa, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xe2, 0xa5, 0xb6, 0x93, 0x2c, 0x00,
-		0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x73, 0x1b, 0xc7,
+		0xf1, 0xaf, 0x05, 0x09, 0x3e, 0x1a, 0x20, 0x48, 0x0e, 0x69, 0x6a, 0x09, 0x8a, 0x12, 0x05, 0x59,
+		0x12, 0xfd, 0xff, 0x3b, 0xa0, 0x09, 0x4b, 0x8a, 0x2c, 0x55, 0xac, 0xf0, 0x21, 0x4a, 0x48, 0x24,
+		0x4b, 0x5a, 0xd1, 0x52, 0x55, 0xe2, 0xd2, 0x66, 0x80, 0x1d, 0x12, 0x1b, 0x02, 0xbb, 0xab, 0xdd,
+		0x01, 0x69, 0xf8, 0x90, 0x43, 0x2a, 0x49, 0x25, 0x95, 0x63, 0x92, 0x7b, 0x5e, 0x5f, 0x20, 0x5f,
+		0x20, 0x5f, 0xc4, 0x95, 0x43, 0x0e, 0xf9, 0x00, 0xa9, 0x72, 0x6e, 0x39, 0xa4, 0xe6, 0xb1, 0xc0,
+		0x2e, 0x30, 0x8b, 0x07, 0x49, 0xd9, 0x39, 0xe4, 0x86, 0x99, 0xe9, 0xee, 0xe9, 0xe9, 0xe9, 0xc7,
+		0xaf, 0x67, 0x01, 0xd7, 0x9b, 0x15, 0xe2, 0x6f, 0x54, 0xb1, 0x45, 0x9c, 0x2a, 0xd9, 0x68, 0x60,
+		0x5a, 0xad, 0xd9, 0xce, 0xe1, 0xc6, 0xf1, 0xe6, 0x46, 0x40, 0xfc, 0x63, 0xbb, 0x4a, 0x8a, 0x9e,
+		0xef, 0x52, 0x17, 0xe9, 0x8c, 0xae, 0x28, 0xe9, 0x8a, 0x21, 0x5d, 0xf1, 0x78, 0x33, 0x7f, 0xe9,
+		0xd0, 0x75, 0x0f, 0xeb, 0x64, 0x83, 0xd3, 0x55, 0x9a, 0x07, 0x1b, 0x56, 0xd3, 0xc7, 0xd4, 0x76,
+		0x1d, 0xc1, 0x99, 0xbf, 0xdc, 0xbd, 0x4e, 0xed, 0x06, 0x09, 0x28, 0x6e, 0x78, 0x92, 0xa0, 0x47,
+		0xc0, 0x89, 0x8f, 0x3d, 0x8f, 0xf8, 0x81, 0x5c, 0x5f, 0x8b, 0xa9, 0x88, 0x3d, 0x9b, 0x69, 0x57,
+		0x75, 0x1b, 0x8d, 0xce, 0x16, 0x2a, 0x8a, 0x37, 0x4d, 0xe2, 0xb7, 0x24, 0x41, 0x41, 0x45, 0x40,
+		0x71, 0x70, 0x54, 0xb7, 0x03, 0x2a, 0x69, 0xd6, 0x55, 0x34, 0xd2, 0x08, 0xe6, 0x89, 0xeb, 0x1f,
+		0x11, 0x5f, 0x52, 0xfe, 0xdf, 0x20, 0xca, 0x83, 0xba, 0x7b, 0x22, 0x69, 0xaf, 0xa8, 0x68, 0x6b,
+		0x76, 0x40, 0xdd, 0xb6, 0x72, 0xef, 0xc6, 0x48, 0x82, 0x1a, 0xf6, 0x89, 0xd5, 0x4b, 0x75, 0x2d,
+		0x81, 0x2a, 0x7e, 0x8a, 0xc2, 0xc7, 0x30, 0xbf, 0x8f, 0x83, 0xa3, 0xc7, 0x76, 0x40, 0x9f, 0x61,
+		0x9f, 0xda, 0xec, 0x22, 0xd0, 0x7b, 0x30, 0x67, 0x07, 0x6e, 0x9d, 0xdf, 0x8a, 0x79, 0xe8, 0xbb,
+		0x4d, 0x2f, 0xd0, 0xb5, 0xb5, 0xb1, 0xf5, 0x69, 0x63, 0xb6, 0x3d, 0xff, 0x90, 0x4f, 0x17, 0xfe,
+		0x3e, 0x0e, 0x17, 0x7a, 0x04, 0xec, 0xb8, 0xce, 0x81, 0x7d, 0x88, 0x74, 0x98, 0x3c, 0x26, 0x7e,
+		0x60, 0xbb, 0x8e, 0xae, 0xad, 0x69, 0xeb, 0x63, 0x46, 0x38, 0x44, 0x25, 0x58, 0x70, 0x9a, 0x0d,
+		0xd3, 0x27, 0xd8, 0x32, 0xbd, 0x90, 0x2b, 0xd0, 0x53, 0x6b, 0xda, 0x7a, 0x7a, 0x3b, 0xa5, 0x6b,
+		0xc6, 0xbc, 0xd3, 0x6c, 0x18, 0x04, 0x5b, 0x6d, 0x91, 0x01, 0xba, 0x09, 0x8b, 0x8c, 0xe7, 0xc4,
+		0xb7, 0x29, 0x89, 0x32, 0x8d, 0xb5, 0x99, 0x90, 0xd3, 0x6c, 0xbc, 0x62, 0xcb, 0x11, 0x2e, 0x07,
+		0x66, 0xbb, 0x77, 0x19, 0x5f, 0x1b, 0x5b, 0xcf, 0x94, 0x1e, 0x14, 0x93, 0x3c, 0xb4, 0x98, 0x70,
+		0x9e, 0x62, 0x5c, 0xa1, 0x07, 0x0e, 0xf5, 0x5b, 0x46, 0xce, 0x8f, 0x6b, 0xf9, 0x06, 0xe6, 0x7a,
+		0x34, 0x4c, 0xf3, 0x0d, 0xf7, 0x46, 0xdf, 0xb0, 0xeb, 0x30, 0x62, 0xc7, 0xd9, 0x93, 0xf8, 0x6c,
+		0xde, 0x81, 0x05, 0x85, 0x66, 0x68, 0x0e, 0xc6, 0x8e, 0x48, 0x8b, 0x5b, 0x3e, 0x6d, 0xb0, 0x9f,
+		0x68, 0x0b, 0xd2, 0xc7, 0xb8, 0xde, 0x24, 0xdc, 0xce, 0x99, 0xd2, 0xff, 0x8f, 0xa0, 0x90, 0x21,
+		0x38, 0xef, 0xa6, 0xee, 0x68, 0x79, 0x17, 0x16, 0x55, 0x8a, 0xbd, 0xb5, 0x0d, 0x0b, 0x3f, 0x82,
+		0xf9, 0xc7, 0x2e, 0xb6, 0xb6, 0x71, 0x1d, 0x3b, 0x55, 0xe2, 0x3f, 0xb2, 0x1d, 0x1a, 0xa0, 0xab,
+		0x30, 0x53, 0xc1, 0xd5, 0xa3, 0xba, 0x7b, 0x68, 0x56, 0xdd, 0xa6, 0x43, 0xa5, 0x8b, 0x65, 0xe5,
+		0xe4, 0x0e, 0x9b, 0x43, 0xd7, 0x61, 0xd6, 0xc7, 0xec, 0x32, 0x88, 0x6f, 0x06, 0xa4, 0xea, 0x3a,
+		0x16, 0x57, 0x45, 0x33, 0x66, 0xd8, 0xf4, 0x33, 0xe2, 0xbf, 0xe0, 0x93, 0x85, 0x7f, 0x6a, 0x90,
+		0x7f, 0xe6, 0xd6, 0xeb, 0x7b, 0xae, 0xbf, 0x4b, 0xaa, 0x36, 0xf3, 0x51, 0xa6, 0x91, 0x41, 0xde,
+		0x34, 0x49, 0x40, 0x51, 0x19, 0x26, 0x7d, 0xf1, 0x93, 0xef, 0x92, 0x29, 0x6d, 0xc4, 0x4f, 0x82,
+		0x3d, 0x9b, 0x1d, 0x22, 0x59, 0x82, 0x11, 0xf2, 0xa3, 0x15, 0x98, 0xb6, 0xdc, 0x06, 0xb6, 0x1d,
+		0xd3, 0x16, 0xba, 0x4c, 0x1b, 0x53, 0x62, 0xa2, 0x6c, 0xb1, 0x45, 0xcf, 0xad, 0xd7, 0x89, 0xcf,
+		0x16, 0xc7, 0xc4, 0xa2, 0x98, 0x28, 0x5b, 0xe8, 0x1a, 0xe4, 0x0e, 0x5c, 0xff, 0x04, 0xfb, 0x16,
+		0xb1, 0xcc, 0x03, 0xdf, 0x6d, 0xe8, 0xe3, 0x9c, 0x62, 0xa6, 0x3d, 0xbb, 0xe7, 0xbb, 0x0d, 0x74,
+		0x03, 0x66, 0xbb, 0x62, 0x57, 0x4f, 0x73, 0xba, 0x5c, 0x3c, 0x74, 0x0b, 0x7f, 0xcd, 0xc0, 0x8a,
+		0x52, 0xe3, 0xc0, 0x73, 0x9d, 0x80, 0xa0, 0x55, 0x00, 0x96, 0x2b, 0x4c, 0xea, 0x1e, 0x11, 0x11,
+		0xc0, 0x59, 0x63, 0x9a, 0xcd, 0xec, 0xb3, 0x09, 0xf4, 0x29, 0xa0, 0x30, 0x75, 0x99, 0xe4, 0x73,
+		0x52, 0x6d, 0x32, 0xc9, 0xf2, 0xa2, 0xaf, 0x2b, 0xcd, 0xf3, 0x4a, 0x92, 0x3f, 0x08, 0xa9, 0x8d,
+		0xf9, 0x93, 0xee, 0x29, 0xb4, 0x07, 0x33, 0x6d, 0xb1, 0xb4, 0xe5, 0x11, 0x6e, 0x86, 0x4c, 0xe9,
+		0x4a, 0x5f, 0x89, 0xfb, 0x2d, 0x8f, 0x18, 0xd9, 0x93, 0xc8, 0x08, 0xbd, 0x84, 0x65, 0xcf, 0x27,
+		0xc7, 0xb6, 0xdb, 0x0c, 0xcc, 0x80, 0x62, 0x9f, 0x12, 0xcb, 0x24, 0xc7, 0xc4, 0xa1, 0xcc, 0xb4,
+		0xe3, 0x5c, 0xe6, 0x4a, 0x51, 0x14, 0x92, 0x62, 0x58, 0x48, 0x8a, 0x65, 0x87, 0xde, 0xbe, 0xf9,
+		0x92, 0xf9, 0x9d, 0xb1, 0x14, 0x72, 0xbf, 0x10, 0xcc, 0x0f, 0x18, 0x6f, 0xd9, 0x42, 0xeb, 0x30,
+		0xd7, 0x23, 0x2e, 0xcd, 0x3d, 0x2f, 0x17, 0xc4, 0x29, 0x75, 0x98, 0xc4, 0x94, 0x92, 0x86, 0x47,
+		0xf5, 0x09, 0x1e, 0x12, 0xe1, 0x10, 0x15, 0x60, 0xc6, 0x21, 0x9f, 0xd3, 0x8e, 0x80, 0x49, 0x2e,
+		0x20, 0xc3, 0x26, 0x43, 0xee, 0xf7, 0x01, 0xc5, 0xdc, 0xdb, 0xac, 0xd9, 0x0e, 0xd5, 0xa7, 0x38,
+		0xe1, 0x5c, 0xd4, 0xc7, 0x59, 0x34, 0xa0, 0x3b, 0xa0, 0x07, 0xd4, 0xae, 0x1e, 0xb5, 0x3a, 0x57,
+		0x61, 0x12, 0x07, 0x57, 0xea, 0xc4, 0xd2, 0xa7, 0xd7, 0xb4, 0xf5, 0x29, 0x63, 0x49, 0xac, 0xb7,
+		0x0d, 0xfd, 0x40, 0xac, 0xa2, 0x3b, 0x90, 0xe6, 0x85, 0x4f, 0x07, 0x6e, 0x93, 0x42, 0x5f, 0x3b,
+		0x3f, 0x67, 0x94, 0x86, 0x60, 0x40, 0x06, 0xcc, 0x58, 0xd2, 0x6f, 0x4c, 0xdb, 0x39, 0x70, 0xf5,
+		0x0c, 0x97, 0xf0, 0xad, 0xb8, 0x04, 0x51, 0x78, 0x78, 0x88, 0xfb, 0xd8, 0x09, 0x6c, 0xe2, 0xd0,
+		0xd0, 0xdb, 0xca, 0xce, 0x81, 0x6b, 0x64, 0xad, 0xc8, 0x08, 0xbd, 0x86, 0x8b, 0xbd, 0x4e, 0x65,
+		0x72, 0x37, 0x64, 0x35, 0x4b, 0xcf, 0xf2, 0x2d, 0x56, 0x95, 0x4a, 0x86, 0x29, 0xc4, 0x58, 0xee,
+		0xf1, 0xaa, 0x70, 0x09, 0x15, 0x61, 0x41, 0x18, 0x9d, 0x55, 0x4a, 0x62, 0x86, 0xd5, 0x69, 0x86,
+		0xdf, 0xcf, 0x3c, 0x5f, 0x7a, 0xc1, 0x56, 0x5e, 0x8a, 0x05, 0x74, 0x05, 0xb2, 0x15, 0x1f, 0x3b,
+		0xd5, 0x9a, 0x8c, 0x82, 0x1c, 0x8f, 0x82, 0x8c, 0x98, 0x13, 0x71, 0xb0, 0x05, 0xb9, 0xa0, 0x5a,
+		0x23, 0x56, 0xb3, 0x4e, 0x2c, 0x93, 0x41, 0x15, 0x7d, 0x96, 0x2b, 0x99, 0xef, 0xf1, 0xae, 0xfd,
+		0x10, 0xc7, 0x18, 0x33, 0x6d, 0x0e, 0x36, 0x87, 0xbe, 0x03, 0xd9, 0xd0, 0xa7, 0xb8, 0x80, 0xb9,
+		0x81, 0x02, 0x32, 0x92, 0x9e, 0xb3, 0x7f, 0x06, 0x93, 0xec, 0x46, 0x6c, 0x12, 0xe8, 0xf3, 0xbc,
+		0xd2, 0x6c, 0x27, 0xe7, 0xd9, 0x3e, 0x01, 0x5f, 0x7c, 0x2e, 0x84, 0x88, 0x2a, 0x13, 0x8a, 0x64,
+		0x26, 0xa3, 0x2e, 0xc5, 0x75, 0x53, 0xc2, 0x0b, 0xb3, 0xd2, 0xa2, 0x24, 0xd0, 0x11, 0xf7, 0xc4,
+		0x79, 0xbe, 0xf4, 0x48, 0xac, 0x6c, 0xb3, 0x05, 0xf4, 0x19, 0xcc, 0xb5, 0x4b, 0x9f, 0x59, 0xe5,
+		0x75, 0x4c, 0x5f, 0xe0, 0x07, 0xda, 0x1c, 0xb9, 0x00, 0x1a, 0xb3, 0x5e, 0x7c, 0x02, 0xfd, 0x10,
+		0x16, 0xea, 0x2e, 0xb6, 0xcc, 0x8a, 0xac, 0x05, 0x3c, 0x2c, 0x02, 0x7d, 0x71, 0x50, 0x7d, 0xe9,
+		0xa9, 0x1f, 0xc6, 0x7c, 0xbd, 0x7b, 0x0a, 0x3d, 0x81, 0x39, 0xdc, 0xa4, 0xae, 0xd4, 0x5a, 0x44,
+		0xdc, 0x3b, 0x5c, 0xf2, 0x55, 0xa5, 0xc7, 0x6d, 0x35, 0xa9, 0x2b, 0xf4, 0x62, 0xfc, 0x46, 0x0e,
+		0xc7, 0xc6, 0xf9, 0xd7, 0x90, 0x8d, 0x9a, 0x34, 0x5a, 0x1f, 0xa7, 0x45, 0x7d, 0xbc, 0x13, 0xaf,
+		0x8f, 0x43, 0x05, 0x5f, 0xa7, 0x2c, 0x46, 0x8a, 0xd6, 0x56, 0x95, 0xda, 0xc7, 0x36, 0x6d, 0x9d,
+		0xbe, 0x68, 0x29, 0x24, 0xfc, 0x37, 0x16, 0xad, 0xdf, 0x01, 0xac, 0x28, 0x35, 0xfe, 0x46, 0x8b,
+		0xd6, 0x65, 0xc8, 0x60, 0xa9, 0x4d, 0xc7, 0x08, 0x10, 0x4e, 0x95, 0x2d, 0x56, 0xd5, 0xda, 0x04,
+		0xbc, 0xaa, 0x8d, 0xf7, 0xa9, 0x6a, 0xed, 0x83, 0xf1, 0xaa, 0x86, 0x23, 0x23, 0x54, 0x82, 0xb4,
+		0xed, 0x78, 0x4d, 0xca, 0xad, 0x93, 0x29, 0x5d, 0x54, 0xdf, 0x28, 0x6e, 0x31, 0xdf, 0x36, 0x04,
+		0xa9, 0x22, 0x41, 0x4d, 0x9c, 0x35, 0x41, 0x4d, 0x8e, 0x96, 0xa0, 0xf6, 0x61, 0x39, 0x94, 0x67,
+		0xb2, 0xf0, 0xaa, 0xbb, 0x01, 0xe1, 0x82, 0xdc, 0xa6, 0x28, 0x69, 0x99, 0xd2, 0x72, 0x8f, 0xac,
+		0x5d, 0xd9, 0x15, 0x1a, 0x4b, 0x21, 0xef, 0xbe, 0xbb, 0xc3, 0x38, 0xf7, 0x05, 0x23, 0xfa, 0x04,
+		0x96, 0xf8, 0x26, 0xbd, 0x22, 0xa7, 0x07, 0x89, 0x5c, 0xe0, 0x8c, 0x5d, 0xf2, 0xf6, 0x60, 0xbe,
+		0x46, 0xb0, 0x4f, 0x2b, 0x04, 0xd3, 0xb6, 0x28, 0x18, 0x24, 0x6a, 0xae, 0xcd, 0x13, 0xca, 0x89,
+		0xd4, 0xfd, 0x4c, 0xbc, 0xee, 0xbf, 0x86, 0x4b, 0xf1, 0x9b, 0x30, 0xdd, 0x03, 0x93, 0xd6, 0xec,
+		0xc0, 0x0c, 0x19, 0xb2, 0x03, 0x0d, 0x9b, 0x8f, 0xdd, 0xcc, 0xd3, 0x83, 0xfd, 0x9a, 0x1d, 0x6c,
+		0x49, 0xf9, 0xe5, 0xe8, 0x09, 0x2c, 0x42, 0xb1, 0x5d, 0x0f, 0xf4, 0x99, 0x21, 0x3c, 0xa5, 0x73,
+		0x88, 0x5d, 0xc1, 0xd5, 0x0b, 0xc3, 0x72, 0xa7, 0x83, 0x61, 0x37, 0x60, 0xb6, 0x2d, 0x47, 0x64,
+		0x0c, 0x5e, 0x1e, 0xa7, 0x8d, 0x5c, 0x38, 0xbd, 0xcb, 0x67, 0xd1, 0x87, 0x30, 0x51, 0x23, 0xd8,
+		0x22, 0xbe, 0xac, 0x7e, 0x2b, 0xca, 0x9d, 0x1e, 0x71, 0x12, 0x43, 0x92, 0x26, 0x55, 0x83, 0xf9,
+		0x73, 0xa9, 0x06, 0x6f, 0xb7, 0x90, 0xa9, 0x6a, 0xcd, 0xe2, 0xa9, 0x6b, 0x4d, 0xe1, 0xab, 0x71,
+		0x58, 0xda, 0xb2, 0x2c, 0x55, 0xf3, 0x12, 0x4b, 0xde, 0x5a, 0x57, 0xf2, 0x7e, 0x4b, 0x09, 0xf1,
+		0x2e, 0x4c, 0x77, 0x40, 0xdb, 0xd8, 0x30, 0xa0, 0x6d, 0x8a, 0xca, 0x5f, 0x2c, 0x99, 0xb6, 0xb3,
+		0x85, 0xc4, 0xea, 0x63, 0x06, 0x84, 0x53, 0x65, 0xab, 0x3b, 0x9d, 0xc8, 0x24, 0x20, 0x03, 0x36,
+		0x3d, 0x42, 0x3a, 0xe1, 0xd0, 0x3e, 0x0c, 0xdb, 0xbb, 0x30, 0x11, 0xb8, 0x4d, 0xbf, 0x2a, 0xd2,
+		0x63, 0xae, 0x54, 0x48, 0xc4, 0xb1, 0x38, 0x38, 0x7a, 0xc1, 0x29, 0x0d, 0xc9, 0xa1, 0xa8, 0x72,
+		0x93, 0xaa, 0x2a, 0xe7, 0x29, 0x3c, 0x6a, 0x6a, 0xd0, 0x63, 0x84, 0xfa, 0x56, 0x8b, 0x5d, 0x0e,
+		0x26, 0x9f, 0x06, 0xba, 0xbd, 0x2c, 0x0f, 0x53, 0x9e, 0x6f, 0xbb, 0xbe, 0x4d, 0x5b, 0x3c, 0x2b,
+		0xa6, 0x8d, 0xf6, 0x38, 0xbf, 0x0d, 0x8b, 0x2a, 0x21, 0x0a, 0x98, 0xb2, 0x18, 0x85, 0x29, 0xd3,
+		0x51, 0x08, 0x72, 0x02, 0x17, 0x7a, 0xf4, 0x93, 0x95, 0x58, 0x15, 0x3e, 0xda, 0x79, 0x85, 0x4f,
+		0xe1, 0x2f, 0x13, 0xdc, 0xdf, 0x55, 0xb8, 0xe7, 0x9b, 0xf0, 0x77, 0xd6, 0x15, 0x72, 0x57, 0x30,
+		0x3b, 0x5b, 0x0b, 0x14, 0x90, 0x13, 0xf3, 0xbb, 0xa1, 0x02, 0xb1, 0xc8, 0x18, 0x3f, 0x53, 0x64,
+		0xa4, 0x47, 0x8b, 0x8c, 0x89, 0xb3, 0x47, 0xc6, 0xe4, 0x39, 0x44, 0xc6, 0x94, 0x2a, 0x32, 0x1c,
+		0xd0, 0x71, 0xe4, 0x2a, 0x77, 0xed, 0xc0, 0x63, 0x5e, 0xc1, 0x7a, 0x42, 0x59, 0xcd, 0x4b, 0x7d,
+		0x22, 0x24, 0x81, 0xd3, 0x48, 0x94, 0xa9, 0x8c, 0x44, 0x18, 0x22, 0x12, 0x15, 0xfe, 0x76, 0x8a,
+		0x48, 0xcc, 0xc4, 0x23, 0x91, 0x75, 0x99, 0x07, 0xd8, 0xf6, 0x1d, 0x12, 0x04, 0x26, 0x0b, 0xbd,
+		0x2c, 0x37, 0x51, 0x26, 0x9c, 0xfb, 0x3e, 0x39, 0x9f, 0x60, 0xfd, 0x72, 0x0c, 0xf4, 0x24, 0x5b,
+		0xa1, 0xef, 0xc1, 0x6c, 0x07, 0x9b, 0xf0, 0x46, 0x58, 0xd7, 0xfa, 0x94, 0x7c, 0xd9, 0xf2, 0xf1,
+		0xd7, 0x0a, 0xa3, 0x83, 0x2f, 0xf9, 0xb8, 0x07, 0x2e, 0xa6, 0x46, 0x83, 0x8b, 0x11, 0x00, 0x35,
+		0x36, 0x2a, 0x80, 0x1a, 0x3f, 0x7f, 0x00, 0x95, 0x3e, 0x1f, 0x00, 0x35, 0x71, 0x6e, 0x00, 0x6a,
+		0x52, 0x05, 0xa0, 0x64, 0x2a, 0x56, 0x36, 0x45, 0x6f, 0x37, 0x15, 0x7f, 0xa9, 0xc1, 0x22, 0xef,
+		0x4d, 0xc3, 0x53, 0x84, 0x89, 0x78, 0xa7, 0xbb, 0x01, 0x7d, 0x4f, 0x79, 0x78, 0x15, 0xef, 0x90,
+		0xad, 0xe7, 0x59, 0x60, 0xc6, 0x70, 0x9d, 0x69, 0xe1, 0xdf, 0x1a, 0xbc, 0xd3, 0xa5, 0xa1, 0xb4,
+		0xea, 0x7d, 0xc8, 0xf2, 0x87, 0x30, 0xd3, 0x27, 0x41, 0xb3, 0x1e, 0x9e, 0xb1, 0xbf, 0x9f, 0x64,
+		0x38, 0x87, 0xc1, 0x19, 0x50, 0x19, 0x72, 0xa1, 0x80, 0x1f, 0x93, 0x2a, 0x25, 0x56, 0xdf, 0x67,
+		0x00, 0xd1, 0xfe, 0x4b, 0x4a, 0x63, 0xe6, 0x4d, 0x74, 0x88, 0x5e, 0x29, 0x6e, 0x58, 0xd8, 0xe3,
+		0xfd, 0xbe, 0xf6, 0x18, 0x78, 0xb9, 0xff, 0xd0, 0x60, 0x4d, 0x9c, 0xd8, 0xe2, 0x0a, 0x30, 0xc6,
+		0x1d, 0xb7, 0xe1, 0xd5, 0x09, 0xd3, 0x42, 0xde, 0xd1, 0xd3, 0xee, 0x8b, 0xbe, 0xa5, 0xdc, 0x74,
+		0x90, 0x9c, 0xaf, 0xe1, 0xd2, 0x2f, 0xc0, 0x24, 0xe7, 0x95, 0xb8, 0x72, 0xda, 0x98, 0x60, 0xc3,
+		0xb2, 0x55, 0xb8, 0x0a, 0x57, 0xfa, 0xa8, 0x27, 0x6e, 0xbc, 0xf0, 0x37, 0x0d, 0x2e, 0xee, 0xb0,
+		0x0e, 0xa1, 0xfe, 0xb4, 0x49, 0x03, 0x8a, 0x1d, 0xcb, 0x76, 0x0e, 0xd9, 0x6b, 0xc4, 0x50, 0xd0,
+		0x23, 0xf6, 0x4e, 0x92, 0xea, 0x7a, 0x27, 0x79, 0x08, 0xb9, 0xf6, 0xa1, 0x3a, 0xef, 0xde, 0xb9,
+		0x84, 0x7c, 0x11, 0x9e, 0x4c, 0xe4, 0x0b, 0x1a, 0x19, 0x9d, 0x05, 0x5f, 0x14, 0x2e, 0xc3, 0x6a,
+		0xc2, 0xf1, 0xa4, 0x01, 0x7e, 0x02, 0x17, 0x76, 0x49, 0x50, 0xf5, 0xed, 0x0a, 0x69, 0xb3, 0xcb,
+		0xa3, 0xef, 0x75, 0xfb, 0x80, 0xda, 0xf1, 0x12, 0xd8, 0x87, 0xbb, 0xfa, 0xc2, 0x57, 0x69, 0xd0,
+		0x7b, 0x25, 0xc8, 0x78, 0xfc, 0x08, 0x26, 0x85, 0x39, 0xc5, 0xb7, 0xca, 0x4c, 0xe9, 0x72, 0xe2,
+		0x7b, 0x17, 0xf1, 0x39, 0x3e, 0x08, 0xe9, 0x59, 0x33, 0xd6, 0xb1, 0x7e, 0x40, 0x31, 0x6d, 0x06,
+		0x7a, 0xaa, 0x4f, 0x33, 0x16, 0xee, 0xfd, 0x82, 0x93, 0x1a, 0x39, 0x1a, 0x1b, 0xbf, 0xb5, 0x68,
+		0x3c, 0x13, 0x78, 0xfc, 0x95, 0x06, 0xcb, 0xf1, 0x2f, 0x0a, 0x95, 0x96, 0xd9, 0x86, 0x24, 0xe2,
+		0x13, 0xe5, 0x27, 0xc9, 0xe5, 0x20, 0xc9, 0xec, 0xc5, 0xed, 0xc8, 0xb7, 0x88, 0xed, 0xd6, 0x33,
+		0x29, 0x50, 0xa0, 0xa0, 0xa5, 0x8a, 0x72, 0x11, 0xfd, 0x46, 0x83, 0xd5, 0x1e, 0x5d, 0x62, 0x10,
+		0x68, 0x82, 0xeb, 0xf3, 0xfc, 0xcc, 0xfa, 0xec, 0x75, 0x30, 0x94, 0x50, 0x69, 0xb9, 0x92, 0xb4,
+		0x9e, 0x2f, 0xc3, 0x4a, 0x9f, 0xc3, 0x28, 0x3e, 0x6f, 0xc6, 0xa0, 0xd6, 0x58, 0xf4, 0x13, 0xe9,
+		0x63, 0xb8, 0xd4, 0x5f, 0x8f, 0x41, 0xc0, 0x2d, 0x2a, 0xad, 0x10, 0xc0, 0x2a, 0x8f, 0xef, 0x6e,
+		0x37, 0x09, 0xc2, 0xe0, 0x5b, 0x82, 0x09, 0x89, 0x0d, 0x84, 0x3c, 0x39, 0x8a, 0xfb, 0x4b, 0x6a,
+		0xb4, 0x64, 0xf0, 0x8b, 0x14, 0x5c, 0x4a, 0xda, 0x55, 0x46, 0xdc, 0x1b, 0x58, 0xed, 0xbc, 0x6a,
+		0xb6, 0xe3, 0x27, 0xf2, 0xe1, 0x5b, 0xc4, 0x61, 0x71, 0x38, 0xa7, 0x7f, 0x42, 0x28, 0xb6, 0x30,
+		0xc5, 0x46, 0x3e, 0x0a, 0xdb, 0xe3, 0x5b, 0xb3, 0x2d, 0xdb, 0x1f, 0x9d, 0x94, 0x5b, 0xa6, 0x4e,
+		0xb7, 0xa5, 0x15, 0x69, 0x61, 0xe3, 0x5b, 0x16, 0x6e, 0xc1, 0xca, 0x43, 0xd2, 0x36, 0x43, 0xb0,
+		0xdd, 0x12, 0x80, 0x6b, 0x80, 0xed, 0x0b, 0x7f, 0x1e, 0x87, 0x8b, 0x6a, 0x3e, 0x69, 0xbd, 0x9f,
+		0x69, 0xb0, 0xa4, 0x38, 0x4b, 0x03, 0x7b, 0xd2, 0x6e, 0x4f, 0x93, 0xbd, 0xbf, 0x9f, 0xe0, 0xe2,
+		0x6e, 0xd7, 0x59, 0x9e, 0x60, 0x4f, 0xf8, 0xfe, 0x82, 0xd5, 0xbb, 0xc2, 0xd5, 0x50, 0xdc, 0x22,
+		0x53, 0x23, 0x75, 0x26, 0x35, 0xb6, 0xba, 0x6e, 0xb1, 0xa3, 0x06, 0xee, 0x5d, 0xc9, 0x7f, 0xc1,
+		0x32, 0xbb, 0x5a, 0x6f, 0x45, 0xac, 0x3c, 0x8a, 0x7f, 0x38, 0x29, 0x8d, 0x9e, 0x27, 0xa2, 0xd1,
+		0xfa, 0x45, 0xbc, 0x2f, 0xfa, 0x3a, 0xf7, 0x2e, 0xfc, 0x21, 0x05, 0xef, 0x7e, 0xea, 0x59, 0x98,
+		0x92, 0xa4, 0x2a, 0x30, 0x0c, 0xb6, 0x38, 0x43, 0xa0, 0x9f, 0x1f, 0xf4, 0x50, 0x95, 0xbd, 0xf1,
+		0xf3, 0x00, 0xa1, 0x37, 0xe0, 0xda, 0x00, 0x13, 0x49, 0x7c, 0xf2, 0xc7, 0x14, 0x5c, 0x33, 0xc8,
+		0x81, 0x4f, 0x82, 0xda, 0xff, 0xac, 0x99, 0x64, 0xcd, 0x75, 0xb8, 0x3e, 0xc8, 0x46, 0xc2, 0x9c,
+		0xa5, 0x7f, 0x65, 0x21, 0xf3, 0x44, 0xfa, 0xf3, 0xd6, 0xb3, 0x32, 0xfa, 0xa9, 0x06, 0x0b, 0x8a,
+		0x0f, 0xc8, 0xe8, 0xe6, 0x88, 0xdf, 0x9b, 0xf9, 0x15, 0xe4, 0x6f, 0x9d, 0xea, 0x2b, 0x75, 0x54,
+		0x89, 0x68, 0xd0, 0x0e, 0xa1, 0x84, 0xe2, 0xf1, 0x26, 0x7f, 0x6b, 0x44, 0x2e, 0xa9, 0xc4, 0x31,
+		0xcc, 0x76, 0xbd, 0x7b, 0xa2, 0x0f, 0x46, 0x7d, 0xc2, 0xcd, 0x6f, 0x8e, 0xc0, 0x11, 0xdb, 0x37,
+		0x76, 0xee, 0x0f, 0x46, 0x7d, 0xb0, 0xca, 0x6f, 0x8e, 0xc0, 0x21, 0xf7, 0xf5, 0x60, 0x26, 0xd6,
+		0x04, 0xa3, 0x62, 0xb2, 0x0c, 0x55, 0x3f, 0x9f, 0xdf, 0x18, 0x9a, 0x5e, 0xee, 0xf8, 0x5b, 0x0d,
+		0x96, 0x13, 0x3b, 0x32, 0x74, 0x37, 0x59, 0xdc, 0xa0, 0x2e, 0x33, 0x7f, 0xef, 0x54, 0xbc, 0x52,
+		0xad, 0x5f, 0x6a, 0xf0, 0x8e, 0xb2, 0x47, 0x42, 0xb7, 0x93, 0xc5, 0xf6, 0xeb, 0x19, 0xf3, 0xdf,
+		0x1e, 0x99, 0x4f, 0xaa, 0xd2, 0x82, 0xb9, 0xee, 0x02, 0x83, 0x36, 0x47, 0x29, 0x46, 0x62, 0xff,
+		0x53, 0xd4, 0x2f, 0xf4, 0x6b, 0x0d, 0x96, 0xd4, 0xd8, 0x10, 0xf5, 0x39, 0x4e, 0x5f, 0x0c, 0x9b,
+		0xbf, 0x33, 0x3a, 0xa3, 0xd4, 0xe6, 0xe7, 0x1a, 0x2c, 0xaa, 0x90, 0x08, 0xba, 0x35, 0x2a, 0x72,
+		0x11, 0x9a, 0xdc, 0x3e, 0x1d, 0xe0, 0x41, 0xbf, 0xd7, 0x60, 0xb5, 0x6f, 0x9d, 0x42, 0x1f, 0x27,
+		0x4b, 0x1e, 0x06, 0x03, 0xe4, 0xef, 0x9f, 0x9a, 0x5f, 0xaa, 0xf8, 0x27, 0x0d, 0x2e, 0xf5, 0x4f,
+		0xfe, 0xe8, 0x7e, 0xbf, 0xf0, 0x18, 0xa2, 0xb4, 0xe6, 0xbf, 0x7b, 0x7a, 0x01, 0x42, 0xcb, 0xed,
+		0x7b, 0x3f, 0xf8, 0xe8, 0xd0, 0xa6, 0xb5, 0x66, 0xa5, 0x58, 0x75, 0x1b, 0x1b, 0xb1, 0xff, 0x31,
+		0x17, 0x0f, 0x89, 0x23, 0xfe, 0xf8, 0x1d, 0xfd, 0xef, 0xf9, 0xbd, 0xf0, 0xf7, 0xf1, 0x66, 0x65,
+		0x82, 0xaf, 0x7e, 0xf8, 0x9f, 0x01, 0x00, 0xb0, 0x54, 0x49, 0x85, 0xa9, 0x2e, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -387,6 +387,117 @@ func (m *ShardAssignment) GetStatus() AssignmentStatus {
 	return AssignmentStatus_ASSIGNMENT_STATUS_INVALID
 }
 
+type DrainRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ExecutorId           string   `protobuf:"bytes,2,opt,name=executor_id,json=executorId,proto3" json:"executor_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DrainRequest) Reset()         { *m = DrainRequest{} }
+func (m *DrainRequest) String() string { return proto.CompactTextString(m) }
+func (*DrainRequest) ProtoMessage()    {}
+func (*DrainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5aab034437d08cca, []int{4}
+}
+func (m *DrainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DrainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainRequest.Merge(m, src)
+}
+func (m *DrainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainRequest proto.InternalMessageInfo
+
+func (m *DrainRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *DrainRequest) GetExecutorId() string {
+	if m != nil {
+		return m.ExecutorId
+	}
+	return ""
+}
+
+type DrainResponse struct {
+	Status ExecutorStatus `protobuf:"varint,1,opt,name=status,proto3,enum=uber.cadence.sharddistributor.v1.ExecutorStatus" json:"status,omitempty"`
+	// Number of shards still assigned to the executor.
+	RemainingShards      int64    `protobuf:"varint,2,opt,name=remaining_shards,json=remainingShards,proto3" json:"remaining_shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DrainResponse) Reset()         { *m = DrainResponse{} }
+func (m *DrainResponse) String() string { return proto.CompactTextString(m) }
+func (*DrainResponse) ProtoMessage()    {}
+func (*DrainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5aab034437d08cca, []int{5}
+}
+func (m *DrainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DrainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainResponse.Merge(m, src)
+}
+func (m *DrainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainResponse proto.InternalMessageInfo
+
+func (m *DrainResponse) GetStatus() ExecutorStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ExecutorStatus_EXECUTOR_STATUS_INVALID
+}
+
+func (m *DrainResponse) GetRemainingShards() int64 {
+	if m != nil {
+		return m.RemainingShards
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("uber.cadence.sharddistributor.v1.ExecutorStatus", ExecutorStatus_name, ExecutorStatus_value)
 	proto.RegisterEnum("uber.cadence.sharddistributor.v1.ShardStatus", ShardStatus_name, ShardStatus_value)
@@ -399,6 +510,8 @@ func init() {
 	proto.RegisterType((*HeartbeatResponse)(nil), "uber.cadence.sharddistributor.v1.HeartbeatResponse")
 	proto.RegisterMapType((map[string]*ShardAssignment)(nil), "uber.cadence.sharddistributor.v1.HeartbeatResponse.ShardAssignmentsEntry")
 	proto.RegisterType((*ShardAssignment)(nil), "uber.cadence.sharddistributor.v1.ShardAssignment")
+	proto.RegisterType((*DrainRequest)(nil), "uber.cadence.sharddistributor.v1.DrainRequest")
+	proto.RegisterType((*DrainResponse)(nil), "uber.cadence.sharddistributor.v1.DrainResponse")
 }
 
 func init() {
@@ -406,54 +519,58 @@ func init() {
 }
 
 var fileDescriptor_5aab034437d08cca = []byte{
-	// 754 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x6e, 0xda, 0x4a,
-	0x14, 0xbe, 0x86, 0x24, 0xba, 0x1c, 0x14, 0xae, 0x19, 0xe5, 0x87, 0x4b, 0x7e, 0x2e, 0x8a, 0xae,
-	0xda, 0x88, 0xaa, 0xa6, 0x90, 0x4d, 0xd5, 0x6c, 0xea, 0xc4, 0x16, 0x38, 0x02, 0x3b, 0x1a, 0x1b,
-	0xd2, 0x56, 0xad, 0xac, 0x01, 0x8f, 0x08, 0x6a, 0xb0, 0xa9, 0x3d, 0xa0, 0xa6, 0xea, 0xb2, 0x6f,
-	0xd0, 0x17, 0xe9, 0x63, 0x64, 0xd9, 0x7d, 0x37, 0x55, 0x9e, 0xa4, 0xb2, 0x0d, 0x01, 0x1b, 0x2a,
-	0x9a, 0xec, 0xec, 0x73, 0xce, 0xf7, 0x7d, 0xc7, 0xe7, 0x3b, 0xe3, 0x81, 0xd2, 0xb0, 0x4d, 0xdd,
-	0x52, 0x87, 0x58, 0xd4, 0xee, 0xd0, 0x92, 0x77, 0x49, 0x5c, 0xcb, 0xea, 0x79, 0xcc, 0xed, 0xb5,
-	0x87, 0xcc, 0x71, 0x4b, 0xa3, 0x72, 0x89, 0x7e, 0xa4, 0x1d, 0xff, 0x59, 0x18, 0xb8, 0x0e, 0x73,
-	0x50, 0xc1, 0x07, 0x08, 0x63, 0x80, 0x10, 0x07, 0x08, 0xa3, 0xf2, 0xc1, 0xb7, 0x15, 0xe0, 0x6b,
-	0x94, 0xb8, 0xac, 0x4d, 0x09, 0xc3, 0xf4, 0xc3, 0x90, 0x7a, 0x0c, 0xed, 0x42, 0xca, 0x26, 0x7d,
-	0xea, 0x0d, 0x48, 0x87, 0xe6, 0xb8, 0x02, 0x77, 0x98, 0xc2, 0xd3, 0x00, 0xfa, 0x0f, 0xd2, 0x13,
-	0x19, 0xb3, 0x67, 0xe5, 0x12, 0x41, 0x1e, 0x26, 0x21, 0xc5, 0x42, 0x35, 0x58, 0xf3, 0x18, 0x61,
-	0x43, 0x2f, 0x97, 0x2c, 0x70, 0x87, 0x99, 0xca, 0x33, 0x61, 0x59, 0x1b, 0x82, 0x3c, 0x46, 0xeb,
-	0x01, 0x0e, 0x8f, 0xf1, 0xe8, 0x33, 0x6c, 0x04, 0xd5, 0x66, 0xf8, 0x6e, 0xba, 0x74, 0xe0, 0xb8,
-	0xcc, 0xcb, 0xad, 0x14, 0x92, 0x87, 0xe9, 0xca, 0xd9, 0x72, 0xde, 0xf8, 0xa7, 0x09, 0xba, 0x5f,
-	0x34, 0x56, 0x09, 0xc9, 0x64, 0x9b, 0xb9, 0xd7, 0x18, 0x79, 0x73, 0x09, 0xf4, 0x16, 0xfe, 0xee,
-	0x53, 0x46, 0x2c, 0xc2, 0x48, 0x6e, 0x35, 0x50, 0x7c, 0xf9, 0x00, 0xc5, 0xc6, 0x98, 0x22, 0xd4,
-	0xb9, 0x63, 0xcc, 0x7f, 0x82, 0xed, 0xdf, 0x34, 0x83, 0x78, 0x48, 0xbe, 0xa7, 0xd7, 0xe3, 0xc9,
-	0xfb, 0x8f, 0x48, 0x81, 0xd5, 0x11, 0xb9, 0x1a, 0xd2, 0x60, 0xda, 0xe9, 0xca, 0xd1, 0xf2, 0x3e,
-	0xe6, 0xb8, 0x71, 0xc8, 0xf0, 0x22, 0xf1, 0x9c, 0xcb, 0x1f, 0xc3, 0x7a, 0xa4, 0xad, 0x05, 0x8a,
-	0x1b, 0xb3, 0x8a, 0xa9, 0x19, 0xf0, 0xc1, 0x35, 0x64, 0xe7, 0xc8, 0x91, 0x7c, 0xe7, 0x39, 0x17,
-	0x78, 0xfe, 0xf4, 0x7e, 0x1d, 0x4e, 0x0c, 0xdf, 0x03, 0x08, 0x0d, 0xbf, 0x72, 0x48, 0xb8, 0x5a,
-	0x1c, 0x4e, 0x05, 0x91, 0xba, 0x43, 0xac, 0x83, 0x1f, 0x09, 0xc8, 0xce, 0x0c, 0xd8, 0x1b, 0x38,
-	0xb6, 0x47, 0xd1, 0x08, 0xb2, 0x21, 0x88, 0x78, 0x5e, 0xaf, 0x6b, 0xf7, 0xa9, 0xcd, 0xfc, 0x36,
-	0x7c, 0xc3, 0x94, 0x7b, 0x19, 0x16, 0xf2, 0x85, 0x8d, 0x89, 0x53, 0xae, 0xd0, 0x39, 0xde, 0x8b,
-	0x85, 0x51, 0x0b, 0x32, 0xfd, 0x5e, 0xd7, 0x25, 0xac, 0xe7, 0xd8, 0x66, 0xdf, 0xb1, 0xc2, 0x59,
-	0x65, 0x2a, 0xa5, 0xe5, 0xa2, 0x8d, 0x09, 0xae, 0xe1, 0x58, 0x14, 0xaf, 0xf7, 0x67, 0x5f, 0xf3,
-	0x23, 0xd8, 0x5c, 0xd8, 0xc2, 0x02, 0x97, 0xaa, 0xd1, 0xbd, 0x28, 0xff, 0xe1, 0xd4, 0xa7, 0xcc,
-	0xb3, 0xc6, 0xbe, 0x83, 0x7f, 0x62, 0x59, 0x74, 0x16, 0xb3, 0xb5, 0xb2, 0x5c, 0x60, 0x8a, 0x8e,
-	0x7a, 0x5b, 0xfc, 0xc2, 0x41, 0x26, 0x7a, 0xce, 0xd1, 0x0e, 0x6c, 0xcb, 0xaf, 0xe4, 0xd3, 0xa6,
-	0xa1, 0x61, 0x53, 0x37, 0x44, 0xa3, 0xa9, 0x9b, 0x8a, 0xda, 0x12, 0xeb, 0x8a, 0xc4, 0xff, 0x85,
-	0xf2, 0xb0, 0x15, 0x4f, 0x8a, 0xa7, 0x86, 0xd2, 0x92, 0x79, 0x0e, 0xed, 0x42, 0x2e, 0x9e, 0x93,
-	0xb0, 0xa8, 0xa8, 0x8a, 0x5a, 0xe5, 0x13, 0x8b, 0x68, 0x83, 0xac, 0x2c, 0xf1, 0xc9, 0x62, 0x0b,
-	0xd2, 0x33, 0x9b, 0x87, 0x72, 0xb0, 0xa1, 0xd7, 0x44, 0x2c, 0xcd, 0xeb, 0x6f, 0x01, 0x8a, 0x64,
-	0xb0, 0x2c, 0x4a, 0xaf, 0x79, 0x0e, 0x6d, 0x42, 0x36, 0x12, 0x97, 0x34, 0x55, 0xe6, 0x13, 0x45,
-	0x15, 0xf8, 0xf8, 0xa7, 0xa3, 0x3d, 0xf8, 0x57, 0xd4, 0x75, 0xa5, 0xaa, 0x36, 0x64, 0xd5, 0x98,
-	0x57, 0xd8, 0x81, 0xed, 0xf9, 0xf4, 0x58, 0xa6, 0x78, 0xc3, 0xc1, 0x7a, 0x64, 0x4d, 0xfc, 0x81,
-	0x34, 0x94, 0x2a, 0x16, 0x0d, 0x45, 0x53, 0xcd, 0x86, 0x26, 0xc9, 0x33, 0x54, 0xff, 0x43, 0x21,
-	0x96, 0xab, 0x6b, 0xa7, 0x62, 0xdd, 0x3c, 0x17, 0x75, 0xdd, 0xa8, 0x61, 0xad, 0x59, 0xad, 0xf1,
-	0x1c, 0x7a, 0x02, 0x8f, 0x97, 0x55, 0x99, 0x7a, 0x4d, 0x94, 0xb4, 0x0b, 0x3e, 0x81, 0x8a, 0xf0,
-	0x28, 0x56, 0x2c, 0x29, 0xba, 0x81, 0x95, 0x93, 0xa6, 0x21, 0x4b, 0x11, 0xe2, 0xa4, 0xef, 0x47,
-	0xac, 0x56, 0x53, 0x4f, 0x34, 0x11, 0x4b, 0xb2, 0xc4, 0xaf, 0x54, 0xbe, 0x72, 0xb0, 0x13, 0xcc,
-	0x5c, 0x9a, 0xae, 0xca, 0x64, 0x13, 0xc4, 0x73, 0x05, 0x31, 0x48, 0xdd, 0x9d, 0x42, 0x54, 0xb9,
-	0xff, 0x3f, 0x36, 0x7f, 0xf4, 0x80, 0x63, 0x7e, 0x72, 0x71, 0x73, 0xbb, 0xcf, 0x7d, 0xbf, 0xdd,
-	0xe7, 0x7e, 0xde, 0xee, 0x73, 0x6f, 0x94, 0x6e, 0x8f, 0x5d, 0x0e, 0xdb, 0x42, 0xc7, 0xe9, 0x47,
-	0xaf, 0x59, 0xa1, 0x4b, 0xed, 0x52, 0x70, 0x9d, 0x2e, 0xba, 0x71, 0x8f, 0xe3, 0xb1, 0x51, 0xb9,
-	0xbd, 0x16, 0x54, 0x1f, 0xfd, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x33, 0x53, 0x4b, 0xe1, 0xaf, 0x07,
+	// 818 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5d, 0x6f, 0xe3, 0x44,
+	0x14, 0x65, 0x92, 0x76, 0x45, 0x6e, 0x69, 0xd7, 0x1d, 0x75, 0xb7, 0x21, 0xdd, 0x2d, 0x51, 0x84,
+	0xa0, 0x04, 0x61, 0xd3, 0xf4, 0x05, 0xb1, 0x2f, 0xb8, 0xb5, 0x95, 0x78, 0xd5, 0xd8, 0xab, 0xb1,
+	0x9b, 0x05, 0x04, 0xb2, 0x26, 0xf1, 0x28, 0xb5, 0x58, 0xdb, 0xc1, 0x9e, 0x44, 0x14, 0xf1, 0xb8,
+	0x3f, 0x88, 0x9f, 0xb1, 0x8f, 0xbc, 0xf3, 0x82, 0xfa, 0x3f, 0x90, 0x90, 0x3f, 0x92, 0xd8, 0x4e,
+	0x50, 0xda, 0x6a, 0xdf, 0xec, 0x7b, 0xef, 0x39, 0xe7, 0xe6, 0x9e, 0xeb, 0xc9, 0x80, 0x34, 0x1d,
+	0xb2, 0x50, 0x1a, 0x51, 0x87, 0xf9, 0x23, 0x26, 0x45, 0xd7, 0x34, 0x74, 0x1c, 0x37, 0xe2, 0xa1,
+	0x3b, 0x9c, 0xf2, 0x20, 0x94, 0x66, 0xa7, 0x12, 0xfb, 0x8d, 0x8d, 0xe2, 0x67, 0x71, 0x12, 0x06,
+	0x3c, 0xc0, 0xcd, 0x18, 0x20, 0x66, 0x00, 0xb1, 0x0c, 0x10, 0x67, 0xa7, 0xad, 0x3f, 0xb7, 0x40,
+	0xe8, 0x31, 0x1a, 0xf2, 0x21, 0xa3, 0x9c, 0xb0, 0x5f, 0xa7, 0x2c, 0xe2, 0xf8, 0x19, 0xd4, 0x7c,
+	0xea, 0xb1, 0x68, 0x42, 0x47, 0xac, 0x8e, 0x9a, 0xe8, 0xa4, 0x46, 0x96, 0x01, 0xfc, 0x09, 0xec,
+	0xcc, 0x65, 0x6c, 0xd7, 0xa9, 0x57, 0x92, 0x3c, 0xcc, 0x43, 0x9a, 0x83, 0x7b, 0xf0, 0x28, 0xe2,
+	0x94, 0x4f, 0xa3, 0x7a, 0xb5, 0x89, 0x4e, 0xf6, 0x3a, 0x5f, 0x8b, 0x9b, 0xda, 0x10, 0xd5, 0x0c,
+	0x6d, 0x26, 0x38, 0x92, 0xe1, 0xf1, 0x1f, 0x70, 0x90, 0x54, 0xdb, 0xe9, 0xbb, 0x1d, 0xb2, 0x49,
+	0x10, 0xf2, 0xa8, 0xbe, 0xd5, 0xac, 0x9e, 0xec, 0x74, 0x5e, 0x6e, 0xe6, 0x2d, 0xff, 0x34, 0xd1,
+	0x8c, 0x8b, 0x32, 0x95, 0x94, 0x4c, 0xf5, 0x79, 0x78, 0x43, 0x70, 0xb4, 0x92, 0xc0, 0x3f, 0xc1,
+	0x87, 0x1e, 0xe3, 0xd4, 0xa1, 0x9c, 0xd6, 0xb7, 0x13, 0xc5, 0xef, 0x1e, 0xa0, 0xd8, 0xcf, 0x28,
+	0x52, 0x9d, 0x05, 0x63, 0xe3, 0x77, 0x38, 0xfc, 0x9f, 0x66, 0xb0, 0x00, 0xd5, 0x5f, 0xd8, 0x4d,
+	0x36, 0xf9, 0xf8, 0x11, 0x6b, 0xb0, 0x3d, 0xa3, 0x6f, 0xa6, 0x2c, 0x99, 0xf6, 0x4e, 0xe7, 0x6c,
+	0x73, 0x1f, 0x2b, 0xdc, 0x24, 0x65, 0xf8, 0xb6, 0xf2, 0x0d, 0x6a, 0xbc, 0x80, 0xdd, 0x42, 0x5b,
+	0x6b, 0x14, 0x0f, 0xf2, 0x8a, 0xb5, 0x1c, 0xb8, 0x75, 0x03, 0xfb, 0x2b, 0xe4, 0x58, 0x5d, 0x78,
+	0x8e, 0x12, 0xcf, 0xbf, 0xba, 0x5f, 0x87, 0x73, 0xc3, 0x9f, 0x03, 0xa4, 0x86, 0xbf, 0x09, 0x68,
+	0xba, 0x5a, 0x88, 0xd4, 0x92, 0xc8, 0x65, 0x40, 0x9d, 0xd6, 0xdf, 0x15, 0xd8, 0xcf, 0x0d, 0x38,
+	0x9a, 0x04, 0x7e, 0xc4, 0xf0, 0x0c, 0xf6, 0x53, 0x10, 0x8d, 0x22, 0x77, 0xec, 0x7b, 0xcc, 0xe7,
+	0x71, 0x1b, 0xb1, 0x61, 0xda, 0xbd, 0x0c, 0x4b, 0xf9, 0xd2, 0xc6, 0xe4, 0x25, 0x57, 0xea, 0x9c,
+	0x10, 0x95, 0xc2, 0x78, 0x00, 0x7b, 0x9e, 0x3b, 0x0e, 0x29, 0x77, 0x03, 0xdf, 0xf6, 0x02, 0x27,
+	0x9d, 0xd5, 0x5e, 0x47, 0xda, 0x2c, 0xda, 0x9f, 0xe3, 0xfa, 0x81, 0xc3, 0xc8, 0xae, 0x97, 0x7f,
+	0x6d, 0xcc, 0xe0, 0xc9, 0xda, 0x16, 0xd6, 0xb8, 0xd4, 0x2d, 0xee, 0xc5, 0xe9, 0x1d, 0xa7, 0xbe,
+	0x64, 0xce, 0x1b, 0xfb, 0x33, 0x3c, 0x2e, 0x65, 0xf1, 0xcb, 0x92, 0xad, 0x9d, 0xcd, 0x02, 0x4b,
+	0x74, 0xd1, 0xdb, 0x56, 0x1f, 0x3e, 0x52, 0x42, 0xea, 0xfa, 0xef, 0xe7, 0x94, 0x69, 0xbd, 0x45,
+	0xb0, 0x9b, 0xf1, 0x65, 0x7b, 0xd0, 0x2b, 0x35, 0xfb, 0xf0, 0x73, 0xe7, 0x0b, 0x10, 0x42, 0xe6,
+	0x51, 0xd7, 0x77, 0xfd, 0xb1, 0x9d, 0xe0, 0xa2, 0xa4, 0x83, 0x2a, 0x79, 0xbc, 0x88, 0x27, 0xa3,
+	0x8a, 0xda, 0x6f, 0x11, 0xec, 0x15, 0x59, 0xf0, 0x11, 0x1c, 0xaa, 0xdf, 0xab, 0x17, 0x57, 0x96,
+	0x41, 0x6c, 0xd3, 0x92, 0xad, 0x2b, 0xd3, 0xd6, 0xf4, 0x81, 0x7c, 0xa9, 0x29, 0xc2, 0x07, 0xb8,
+	0x01, 0x4f, 0xcb, 0x49, 0xf9, 0xc2, 0xd2, 0x06, 0xaa, 0x80, 0xf0, 0x33, 0xa8, 0x97, 0x73, 0x0a,
+	0x91, 0x35, 0x5d, 0xd3, 0xbb, 0x42, 0x65, 0x1d, 0x6d, 0x92, 0x55, 0x15, 0xa1, 0xda, 0x1e, 0xc0,
+	0x4e, 0xee, 0x7b, 0xc2, 0x75, 0x38, 0x30, 0x7b, 0x32, 0x51, 0x56, 0xf5, 0x9f, 0x02, 0x2e, 0x64,
+	0x88, 0x2a, 0x2b, 0x3f, 0x08, 0x08, 0x3f, 0x81, 0xfd, 0x42, 0x5c, 0x31, 0x74, 0x55, 0xa8, 0xb4,
+	0x75, 0x10, 0xca, 0x86, 0xe2, 0xe7, 0xf0, 0xb1, 0x6c, 0x9a, 0x5a, 0x57, 0xef, 0xab, 0xba, 0xb5,
+	0xaa, 0x70, 0x04, 0x87, 0xab, 0xe9, 0x4c, 0xa6, 0xfd, 0x0e, 0xc1, 0x6e, 0x61, 0xf9, 0xe3, 0x81,
+	0xf4, 0xb5, 0x2e, 0x91, 0x2d, 0xcd, 0xd0, 0xed, 0xbe, 0xa1, 0xa8, 0x39, 0xaa, 0x4f, 0xa1, 0x59,
+	0xca, 0x5d, 0x1a, 0x17, 0xf2, 0xa5, 0xfd, 0x4a, 0x36, 0x4d, 0xab, 0x47, 0x8c, 0xab, 0x6e, 0x4f,
+	0x40, 0xf8, 0x4b, 0xf8, 0x7c, 0x53, 0x95, 0x6d, 0xf6, 0x64, 0xc5, 0x78, 0x2d, 0x54, 0x70, 0x1b,
+	0x3e, 0x2b, 0x15, 0x2b, 0x9a, 0x69, 0x11, 0xed, 0xfc, 0xca, 0x52, 0x95, 0x02, 0x71, 0x35, 0xf6,
+	0xa3, 0x54, 0x6b, 0xe8, 0xe7, 0x86, 0x4c, 0x14, 0x55, 0x11, 0xb6, 0x3a, 0xff, 0x22, 0x38, 0x4a,
+	0x66, 0xae, 0x2c, 0x77, 0x6a, 0xbe, 0x09, 0xf2, 0x2b, 0x0d, 0x73, 0xa8, 0x2d, 0xce, 0x16, 0xdc,
+	0xb9, 0xff, 0x3f, 0x47, 0xe3, 0xec, 0x01, 0x87, 0x17, 0xbe, 0x86, 0xed, 0xe4, 0xab, 0xc0, 0xe2,
+	0x66, 0x74, 0xfe, 0x73, 0x6c, 0x48, 0x77, 0xae, 0x4f, 0x95, 0xce, 0x5f, 0xbf, 0xbb, 0x3d, 0x46,
+	0x7f, 0xdd, 0x1e, 0xa3, 0x7f, 0x6e, 0x8f, 0xd1, 0x8f, 0xda, 0xd8, 0xe5, 0xd7, 0xd3, 0xa1, 0x38,
+	0x0a, 0xbc, 0xe2, 0x35, 0x45, 0x1c, 0x33, 0x5f, 0x4a, 0xae, 0x23, 0xeb, 0x6e, 0x2c, 0x2f, 0xca,
+	0xb1, 0xd9, 0xe9, 0xf0, 0x51, 0x52, 0x7d, 0xf6, 0xdf, 0x00, 0x05, 0xad, 0x27, 0x2b, 0xef, 0x08,
 	0x00, 0x00,
 }
 
@@ -676,6 +793,84 @@ func (m *ShardAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DrainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ExecutorId) > 0 {
+		i -= len(m.ExecutorId)
+		copy(dAtA[i:], m.ExecutorId)
+		i = encodeVarintExecutor(dAtA, i, uint64(len(m.ExecutorId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintExecutor(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DrainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RemainingShards != 0 {
+		i = encodeVarintExecutor(dAtA, i, uint64(m.RemainingShards))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintExecutor(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintExecutor(dAtA []byte, offset int, v uint64) int {
 	offset -= sovExecutor(v)
 	base := offset
@@ -792,6 +987,44 @@ func (m *ShardAssignment) Size() (n int) {
 	return n
 }
 
+func (m *DrainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovExecutor(uint64(l))
+	}
+	l = len(m.ExecutorId)
+	if l > 0 {
+		n += 1 + l + sovExecutor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DrainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovExecutor(uint64(m.Status))
+	}
+	if m.RemainingShards != 0 {
+		n += 1 + sovExecutor(uint64(m.RemainingShards))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovExecutor(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1538,6 +1771,210 @@ func (m *ShardAssignment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DrainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DrainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ExecutorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingShards", wireType)
+			}
+			m.RemainingShards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingShards |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipExecutor(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
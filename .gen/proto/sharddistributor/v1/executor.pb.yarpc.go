@@ -23,6 +23,7 @@ var _ = ioutil.NopCloser
 // ShardDistributorExecutorAPIYARPCClient is the YARPC client-side interface for the ShardDistributorExecutorAPI service.
 type ShardDistributorExecutorAPIYARPCClient interface {
 	Heartbeat(context.Context, *HeartbeatRequest, ...yarpc.CallOption) (*HeartbeatResponse, error)
+	Drain(context.Context, *DrainRequest, ...yarpc.CallOption) (*DrainResponse, error)
 }
 
 func newShardDistributorExecutorAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) ShardDistributorExecutorAPIYARPCClient {
@@ -44,6 +45,7 @@ func NewShardDistributorExecutorAPIYARPCClient(clientConfig transport.ClientConf
 // ShardDistributorExecutorAPIYARPCServer is the YARPC server-side interface for the ShardDistributorExecutorAPI service.
 type ShardDistributorExecutorAPIYARPCServer interface {
 	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
+	Drain(context.Context, *DrainRequest) (*DrainResponse, error)
 }
 
 type buildShardDistributorExecutorAPIYARPCProceduresParams struct {
@@ -67,6 +69,16 @@ func buildShardDistributorExecutorAPIYARPCProcedures(params buildShardDistributo
 						},
 					),
 				},
+				{
+					MethodName: "Drain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.Drain,
+							NewRequest:  newShardDistributorExecutorAPIServiceDrainYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 			},
 			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
 			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
@@ -197,6 +209,18 @@ func (c *_ShardDistributorExecutorAPIYARPCCaller) Heartbeat(ctx context.Context,
 	return response, err
 }
 
+func (c *_ShardDistributorExecutorAPIYARPCCaller) Drain(ctx context.Context, request *DrainRequest, options ...yarpc.CallOption) (*DrainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "Drain", request, newShardDistributorExecutorAPIServiceDrainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DrainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyShardDistributorExecutorAPIServiceDrainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 type _ShardDistributorExecutorAPIYARPCHandler struct {
 	server ShardDistributorExecutorAPIYARPCServer
 }
@@ -217,6 +241,22 @@ func (h *_ShardDistributorExecutorAPIYARPCHandler) Heartbeat(ctx context.Context
 	return response, err
 }
 
+func (h *_ShardDistributorExecutorAPIYARPCHandler) Drain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DrainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DrainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyShardDistributorExecutorAPIServiceDrainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.Drain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func newShardDistributorExecutorAPIServiceHeartbeatYARPCRequest() proto.Message {
 	return &HeartbeatRequest{}
 }
@@ -225,61 +265,75 @@ func newShardDistributorExecutorAPIServiceHeartbeatYARPCResponse() proto.Message
 	return &HeartbeatResponse{}
 }
 
+func newShardDistributorExecutorAPIServiceDrainYARPCRequest() proto.Message {
+	return &DrainRequest{}
+}
+
+func newShardDistributorExecutorAPIServiceDrainYARPCResponse() proto.Message {
+	return &DrainResponse{}
+}
+
 var (
 	emptyShardDistributorExecutorAPIServiceHeartbeatYARPCRequest  = &HeartbeatRequest{}
 	emptyShardDistributorExecutorAPIServiceHeartbeatYARPCResponse = &HeartbeatResponse{}
+	emptyShardDistributorExecutorAPIServiceDrainYARPCRequest      = &DrainRequest{}
+	emptyShardDistributorExecutorAPIServiceDrainYARPCResponse     = &DrainResponse{}
 )
 
 var yarpcFileDescriptorClosure5aab034437d08cca = [][]byte{
 	// uber/cadence/sharddistributor/v1/executor.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x6e, 0xda, 0x48,
-		0x14, 0x5e, 0x43, 0x12, 0x2d, 0x07, 0x85, 0x35, 0xa3, 0xfc, 0xb0, 0x24, 0xd1, 0xa2, 0x68, 0xb5,
-		0x1b, 0x51, 0xd5, 0x14, 0x72, 0x53, 0x35, 0x37, 0x75, 0x62, 0x0b, 0x26, 0x05, 0x3b, 0x1a, 0x1b,
-		0xfa, 0xa3, 0x56, 0xd6, 0x80, 0x47, 0x04, 0x35, 0xd8, 0xd4, 0x1e, 0x50, 0x53, 0xf5, 0xb2, 0x6f,
-		0xd0, 0x17, 0xe9, 0x63, 0xf4, 0x1d, 0xfa, 0x32, 0x95, 0x6d, 0x08, 0xd8, 0x50, 0xd1, 0xe4, 0xce,
-		0x3e, 0xe7, 0x7c, 0xdf, 0x77, 0x7c, 0xbe, 0x33, 0x1e, 0xa8, 0x8c, 0xbb, 0xcc, 0xab, 0xf4, 0xa8,
-		0xcd, 0x9c, 0x1e, 0xab, 0xf8, 0xd7, 0xd4, 0xb3, 0xed, 0x81, 0xcf, 0xbd, 0x41, 0x77, 0xcc, 0x5d,
-		0xaf, 0x32, 0xa9, 0x56, 0xd8, 0x47, 0xd6, 0x0b, 0x9e, 0xa5, 0x91, 0xe7, 0x72, 0x17, 0x95, 0x02,
-		0x80, 0x34, 0x05, 0x48, 0x49, 0x80, 0x34, 0xa9, 0x1e, 0x7f, 0xdb, 0x00, 0xb1, 0xc1, 0xa8, 0xc7,
-		0xbb, 0x8c, 0x72, 0xc2, 0x3e, 0x8c, 0x99, 0xcf, 0xd1, 0x21, 0x64, 0x1c, 0x3a, 0x64, 0xfe, 0x88,
-		0xf6, 0x58, 0x41, 0x28, 0x09, 0x27, 0x19, 0x32, 0x0f, 0xa0, 0x7f, 0x20, 0x3b, 0x93, 0xb1, 0x06,
-		0x76, 0x21, 0x15, 0xe6, 0x61, 0x16, 0xc2, 0x36, 0x6a, 0xc0, 0x96, 0xcf, 0x29, 0x1f, 0xfb, 0x85,
-		0x74, 0x49, 0x38, 0xc9, 0xd5, 0x9e, 0x48, 0xeb, 0xda, 0x90, 0xd4, 0x29, 0xda, 0x08, 0x71, 0x64,
-		0x8a, 0x47, 0x9f, 0x61, 0x27, 0xac, 0xb6, 0xa2, 0x77, 0xcb, 0x63, 0x23, 0xd7, 0xe3, 0x7e, 0x61,
-		0xa3, 0x94, 0x3e, 0xc9, 0xd6, 0x2e, 0xd7, 0xf3, 0x26, 0x3f, 0x4d, 0x32, 0x82, 0xa2, 0xa9, 0x4a,
-		0x44, 0xa6, 0x3a, 0xdc, 0xbb, 0x25, 0xc8, 0x5f, 0x4a, 0xa0, 0xb7, 0xf0, 0xe7, 0x90, 0x71, 0x6a,
-		0x53, 0x4e, 0x0b, 0x9b, 0xa1, 0xe2, 0xf3, 0x07, 0x28, 0xb6, 0xa6, 0x14, 0x91, 0xce, 0x1d, 0x63,
-		0xf1, 0x13, 0xec, 0xff, 0xa2, 0x19, 0x24, 0x42, 0xfa, 0x3d, 0xbb, 0x9d, 0x4e, 0x3e, 0x78, 0x44,
-		0x18, 0x36, 0x27, 0xf4, 0x66, 0xcc, 0xc2, 0x69, 0x67, 0x6b, 0xa7, 0xeb, 0xfb, 0x58, 0xe2, 0x26,
-		0x11, 0xc3, 0xb3, 0xd4, 0x53, 0xa1, 0x78, 0x06, 0xdb, 0xb1, 0xb6, 0x56, 0x28, 0xee, 0x2c, 0x2a,
-		0x66, 0x16, 0xc0, 0xc7, 0xb7, 0x90, 0x5f, 0x22, 0x47, 0xea, 0x9d, 0xe7, 0x42, 0xe8, 0xf9, 0xe3,
-		0xfb, 0x75, 0x38, 0x33, 0xfc, 0x08, 0x20, 0x32, 0xfc, 0xc6, 0xa5, 0xd1, 0x6a, 0x09, 0x24, 0x13,
-		0x46, 0x9a, 0x2e, 0xb5, 0x8f, 0x7f, 0xa4, 0x20, 0xbf, 0x30, 0x60, 0x7f, 0xe4, 0x3a, 0x3e, 0x43,
-		0x13, 0xc8, 0x47, 0x20, 0xea, 0xfb, 0x83, 0xbe, 0x33, 0x64, 0x0e, 0x0f, 0xda, 0x08, 0x0c, 0xc3,
-		0xf7, 0x32, 0x2c, 0xe2, 0x8b, 0x1a, 0x93, 0xe7, 0x5c, 0x91, 0x73, 0xa2, 0x9f, 0x08, 0xa3, 0x0e,
-		0xe4, 0x86, 0x83, 0xbe, 0x47, 0xf9, 0xc0, 0x75, 0xac, 0xa1, 0x6b, 0x47, 0xb3, 0xca, 0xd5, 0x2a,
-		0xeb, 0x45, 0x5b, 0x33, 0x5c, 0xcb, 0xb5, 0x19, 0xd9, 0x1e, 0x2e, 0xbe, 0x16, 0x27, 0xb0, 0xbb,
-		0xb2, 0x85, 0x15, 0x2e, 0xd5, 0xe3, 0x7b, 0x51, 0xfd, 0xcd, 0xa9, 0xcf, 0x99, 0x17, 0x8d, 0x7d,
-		0x07, 0x7f, 0x25, 0xb2, 0xe8, 0x32, 0x61, 0x6b, 0x6d, 0xbd, 0xc0, 0x1c, 0x1d, 0xf7, 0xb6, 0xfc,
-		0x45, 0x80, 0x5c, 0xfc, 0x9c, 0xa3, 0x03, 0xd8, 0x57, 0x5f, 0xa9, 0x17, 0x6d, 0x53, 0x27, 0x96,
-		0x61, 0xca, 0x66, 0xdb, 0xb0, 0xb0, 0xd6, 0x91, 0x9b, 0x58, 0x11, 0xff, 0x40, 0x45, 0xd8, 0x4b,
-		0x26, 0xe5, 0x0b, 0x13, 0x77, 0x54, 0x51, 0x40, 0x87, 0x50, 0x48, 0xe6, 0x14, 0x22, 0x63, 0x0d,
-		0x6b, 0x75, 0x31, 0xb5, 0x8a, 0x36, 0xcc, 0xaa, 0x8a, 0x98, 0x2e, 0x77, 0x20, 0xbb, 0xb0, 0x79,
-		0xa8, 0x00, 0x3b, 0x46, 0x43, 0x26, 0xca, 0xb2, 0xfe, 0x1e, 0xa0, 0x58, 0x86, 0xa8, 0xb2, 0xf2,
-		0x5a, 0x14, 0xd0, 0x2e, 0xe4, 0x63, 0x71, 0x45, 0xd7, 0x54, 0x31, 0x55, 0xd6, 0x40, 0x4c, 0x7e,
-		0x3a, 0x3a, 0x82, 0xbf, 0x65, 0xc3, 0xc0, 0x75, 0xad, 0xa5, 0x6a, 0xe6, 0xb2, 0xc2, 0x01, 0xec,
-		0x2f, 0xa7, 0xa7, 0x32, 0xe5, 0xef, 0x02, 0x6c, 0xc7, 0xd6, 0x24, 0x18, 0x48, 0x0b, 0xd7, 0x89,
-		0x6c, 0x62, 0x5d, 0xb3, 0x5a, 0xba, 0xa2, 0x2e, 0x50, 0xfd, 0x0b, 0xa5, 0x44, 0xae, 0xa9, 0x5f,
-		0xc8, 0x4d, 0xeb, 0x4a, 0x36, 0x0c, 0xb3, 0x41, 0xf4, 0x76, 0xbd, 0x21, 0x0a, 0xe8, 0x11, 0xfc,
-		0xbf, 0xae, 0xca, 0x32, 0x1a, 0xb2, 0xa2, 0xbf, 0x14, 0x53, 0xa8, 0x0c, 0xff, 0x25, 0x8a, 0x15,
-		0x6c, 0x98, 0x04, 0x9f, 0xb7, 0x4d, 0x55, 0x89, 0x11, 0xa7, 0x03, 0x3f, 0x12, 0xb5, 0xba, 0x76,
-		0xae, 0xcb, 0x44, 0x51, 0x15, 0x71, 0xa3, 0xf6, 0x55, 0x80, 0x83, 0x70, 0xe6, 0xca, 0x7c, 0x55,
-		0x66, 0x9b, 0x20, 0x5f, 0x61, 0xc4, 0x21, 0x73, 0x77, 0x0a, 0x51, 0xed, 0xfe, 0xff, 0xd8, 0xe2,
-		0xe9, 0x03, 0x8e, 0xf9, 0xf9, 0x8b, 0x37, 0xb8, 0x3f, 0xe0, 0xd7, 0xe3, 0xae, 0xd4, 0x73, 0x87,
-		0xf1, 0xab, 0x55, 0xea, 0x33, 0xa7, 0x12, 0x5e, 0xa1, 0xab, 0x6e, 0xd9, 0xb3, 0x64, 0x6c, 0x52,
-		0xed, 0x6e, 0x85, 0xd5, 0xa7, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x3d, 0x1d, 0x21, 0xa3,
-		0x07, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x6d, 0x8f, 0xe2, 0x54,
+		0x14, 0xb6, 0x30, 0xb3, 0x91, 0x83, 0xb0, 0xe5, 0x66, 0x76, 0x07, 0x99, 0xdd, 0x48, 0x88, 0xd1,
+		0x11, 0x63, 0x2b, 0xcc, 0x17, 0xe3, 0x7e, 0xb1, 0x33, 0x6d, 0xa0, 0x2b, 0xb4, 0x9b, 0xdb, 0x82,
+		0x2f, 0xd1, 0x34, 0x17, 0x7a, 0xc3, 0x34, 0x6e, 0x5b, 0x6c, 0x2f, 0xc4, 0x31, 0x7e, 0xdc, 0x1f,
+		0xe4, 0xcf, 0xf0, 0x3f, 0xf8, 0x5b, 0x4c, 0x4c, 0x5f, 0x80, 0xb6, 0x60, 0x98, 0x99, 0xf8, 0xad,
+		0x3d, 0xe7, 0x3c, 0xcf, 0x73, 0x38, 0xcf, 0xe9, 0xe5, 0x82, 0xb8, 0x9a, 0xd1, 0x40, 0x9c, 0x13,
+		0x9b, 0x7a, 0x73, 0x2a, 0x86, 0xb7, 0x24, 0xb0, 0x6d, 0x27, 0x64, 0x81, 0x33, 0x5b, 0x31, 0x3f,
+		0x10, 0xd7, 0x3d, 0x91, 0xfe, 0x46, 0xe7, 0xd1, 0xb3, 0xb0, 0x0c, 0x7c, 0xe6, 0xa3, 0x76, 0x04,
+		0x10, 0x52, 0x80, 0x50, 0x04, 0x08, 0xeb, 0x5e, 0xe7, 0xcf, 0x13, 0xe0, 0x87, 0x94, 0x04, 0x6c,
+		0x46, 0x09, 0xc3, 0xf4, 0xd7, 0x15, 0x0d, 0x19, 0x7a, 0x01, 0x15, 0x8f, 0xb8, 0x34, 0x5c, 0x92,
+		0x39, 0x6d, 0x72, 0x6d, 0xee, 0xb2, 0x82, 0x77, 0x01, 0xf4, 0x11, 0x54, 0x37, 0x32, 0x96, 0x63,
+		0x37, 0x4b, 0x71, 0x1e, 0x36, 0x21, 0xd5, 0x46, 0x43, 0x78, 0x12, 0x32, 0xc2, 0x56, 0x61, 0xb3,
+		0xdc, 0xe6, 0x2e, 0xeb, 0xfd, 0x2f, 0x85, 0x63, 0x6d, 0x08, 0x4a, 0x8a, 0x36, 0x62, 0x1c, 0x4e,
+		0xf1, 0xe8, 0x0f, 0x38, 0x8b, 0xab, 0xad, 0xe4, 0xdd, 0x0a, 0xe8, 0xd2, 0x0f, 0x58, 0xd8, 0x3c,
+		0x69, 0x97, 0x2f, 0xab, 0xfd, 0xd7, 0xc7, 0x79, 0x8b, 0x3f, 0x4d, 0x30, 0xa2, 0xa2, 0x54, 0x25,
+		0x21, 0x53, 0x3c, 0x16, 0xdc, 0x61, 0x14, 0xee, 0x25, 0xd0, 0x4f, 0xf0, 0xbe, 0x4b, 0x19, 0xb1,
+		0x09, 0x23, 0xcd, 0xd3, 0x58, 0xf1, 0x9b, 0x47, 0x28, 0x8e, 0x53, 0x8a, 0x44, 0x67, 0xcb, 0xd8,
+		0xfa, 0x1d, 0xce, 0xff, 0xa3, 0x19, 0xc4, 0x43, 0xf9, 0x17, 0x7a, 0x97, 0x4e, 0x3e, 0x7a, 0x44,
+		0x2a, 0x9c, 0xae, 0xc9, 0xdb, 0x15, 0x8d, 0xa7, 0x5d, 0xed, 0x5f, 0x1d, 0xef, 0x63, 0x8f, 0x1b,
+		0x27, 0x0c, 0x5f, 0x97, 0xbe, 0xe2, 0x5a, 0xaf, 0xa0, 0x96, 0x6b, 0xeb, 0x80, 0xe2, 0x59, 0x56,
+		0xb1, 0x92, 0x01, 0x77, 0xee, 0xa0, 0xb1, 0x47, 0x8e, 0x94, 0xad, 0xe7, 0x5c, 0xec, 0xf9, 0x17,
+		0x0f, 0xeb, 0x70, 0x63, 0xf8, 0x4b, 0x80, 0xc4, 0xf0, 0xb7, 0x3e, 0x49, 0x56, 0x8b, 0xc3, 0x95,
+		0x38, 0x32, 0xf2, 0x89, 0xdd, 0xf9, 0xbb, 0x04, 0x8d, 0xcc, 0x80, 0xc3, 0xa5, 0xef, 0x85, 0x14,
+		0xad, 0xa1, 0x91, 0x80, 0x48, 0x18, 0x3a, 0x0b, 0xcf, 0xa5, 0x1e, 0x8b, 0xda, 0x88, 0x0c, 0x53,
+		0x1f, 0x64, 0x58, 0xc2, 0x97, 0x34, 0x26, 0xed, 0xb8, 0x12, 0xe7, 0xf8, 0xb0, 0x10, 0x46, 0x53,
+		0xa8, 0xbb, 0xce, 0x22, 0x20, 0xcc, 0xf1, 0x3d, 0xcb, 0xf5, 0xed, 0x64, 0x56, 0xf5, 0xbe, 0x78,
+		0x5c, 0x74, 0xbc, 0xc1, 0x8d, 0x7d, 0x9b, 0xe2, 0x9a, 0x9b, 0x7d, 0x6d, 0xad, 0xe1, 0xd9, 0xc1,
+		0x16, 0x0e, 0xb8, 0x34, 0xc8, 0xef, 0x45, 0xef, 0x9e, 0x53, 0xdf, 0x31, 0x67, 0x8d, 0xfd, 0x19,
+		0x9e, 0x16, 0xb2, 0xe8, 0x75, 0xc1, 0xd6, 0xfe, 0x71, 0x81, 0x1d, 0x3a, 0xef, 0x6d, 0x67, 0x0c,
+		0x1f, 0xc8, 0x01, 0x71, 0xbc, 0xff, 0xe7, 0x94, 0xe9, 0xbc, 0xe3, 0xa0, 0x96, 0xf2, 0xa5, 0x7b,
+		0x30, 0x2c, 0x34, 0xfb, 0xf8, 0x73, 0xe7, 0x33, 0xe0, 0x03, 0xea, 0x12, 0xc7, 0x73, 0xbc, 0x85,
+		0x15, 0xe3, 0xc2, 0xb8, 0x83, 0x32, 0x7e, 0xba, 0x8d, 0xc7, 0xa3, 0x0a, 0xbb, 0xef, 0x38, 0xa8,
+		0xe7, 0x59, 0xd0, 0x05, 0x9c, 0x2b, 0xdf, 0x2b, 0x37, 0x13, 0x53, 0xc7, 0x96, 0x61, 0x4a, 0xe6,
+		0xc4, 0xb0, 0x54, 0x6d, 0x2a, 0x8d, 0x54, 0x99, 0x7f, 0x0f, 0xb5, 0xe0, 0x79, 0x31, 0x29, 0xdd,
+		0x98, 0xea, 0x54, 0xe1, 0x39, 0xf4, 0x02, 0x9a, 0xc5, 0x9c, 0x8c, 0x25, 0x55, 0x53, 0xb5, 0x01,
+		0x5f, 0x3a, 0x44, 0x1b, 0x67, 0x15, 0x99, 0x2f, 0x77, 0xa7, 0x50, 0xcd, 0x7c, 0x4f, 0xa8, 0x09,
+		0x67, 0xc6, 0x50, 0xc2, 0xf2, 0xbe, 0xfe, 0x73, 0x40, 0xb9, 0x0c, 0x56, 0x24, 0xf9, 0x07, 0x9e,
+		0x43, 0xcf, 0xa0, 0x91, 0x8b, 0xcb, 0xba, 0xa6, 0xf0, 0xa5, 0xae, 0x06, 0x7c, 0xd1, 0x50, 0xf4,
+		0x12, 0x3e, 0x94, 0x0c, 0x43, 0x1d, 0x68, 0x63, 0x45, 0x33, 0xf7, 0x15, 0x2e, 0xe0, 0x7c, 0x3f,
+		0x9d, 0xca, 0x74, 0xff, 0xe2, 0xa0, 0x96, 0x5b, 0xfe, 0x68, 0x20, 0x63, 0x75, 0x80, 0x25, 0x53,
+		0xd5, 0x35, 0x6b, 0xac, 0xcb, 0x4a, 0x86, 0xea, 0x63, 0x68, 0x17, 0x72, 0x23, 0xfd, 0x46, 0x1a,
+		0x59, 0x6f, 0x24, 0xc3, 0x30, 0x87, 0x58, 0x9f, 0x0c, 0x86, 0x3c, 0x87, 0x3e, 0x87, 0x4f, 0x8f,
+		0x55, 0x59, 0xc6, 0x50, 0x92, 0xf5, 0xef, 0xf8, 0x12, 0xea, 0xc2, 0x27, 0x85, 0x62, 0x59, 0x35,
+		0x4c, 0xac, 0x5e, 0x4f, 0x4c, 0x45, 0xce, 0x11, 0x97, 0x23, 0x3f, 0x0a, 0xb5, 0xba, 0x76, 0xad,
+		0x4b, 0x58, 0x56, 0x64, 0xfe, 0xa4, 0xff, 0x0f, 0x07, 0x17, 0xf1, 0xcc, 0xe5, 0xdd, 0x4e, 0x6d,
+		0x36, 0x41, 0x7a, 0xa3, 0x22, 0x06, 0x95, 0xed, 0xd9, 0x82, 0xfa, 0x0f, 0xff, 0xe7, 0x68, 0x5d,
+		0x3d, 0xe2, 0xf0, 0x42, 0xb7, 0x70, 0x1a, 0x7f, 0x15, 0x48, 0x38, 0x8e, 0xce, 0x7e, 0x8e, 0x2d,
+		0xf1, 0xde, 0xf5, 0x89, 0xd2, 0xf5, 0xb7, 0x3f, 0xaa, 0x0b, 0x87, 0xdd, 0xae, 0x66, 0xc2, 0xdc,
+		0x77, 0xf3, 0x57, 0x13, 0x61, 0x41, 0x3d, 0x31, 0xbe, 0x82, 0x1c, 0xba, 0xa5, 0xbc, 0x2a, 0xc6,
+		0xd6, 0xbd, 0xd9, 0x93, 0xb8, 0xfa, 0xea, 0xdf, 0x01, 0x00, 0x58, 0x89, 0x5f, 0x59, 0xe3, 0x08,
+		0x00, 0x00,
 	},
 }
 
@@ -23,6 +23,11 @@ var _ = ioutil.NopCloser
 // ShardDistributorAPIYARPCClient is the YARPC client-side interface for the ShardDistributorAPI service.
 type ShardDistributorAPIYARPCClient interface {
 	GetShardOwner(context.Context, *GetShardOwnerRequest, ...yarpc.CallOption) (*GetShardOwnerResponse, error)
+	ListNamespaces(context.Context, *ListNamespacesRequest, ...yarpc.CallOption) (*ListNamespacesResponse, error)
+	ListExecutors(context.Context, *ListExecutorsRequest, ...yarpc.CallOption) (*ListExecutorsResponse, error)
+	DescribeShard(context.Context, *DescribeShardRequest, ...yarpc.CallOption) (*DescribeShardResponse, error)
+	TriggerRebalance(context.Context, *TriggerRebalanceRequest, ...yarpc.CallOption) (*TriggerRebalanceResponse, error)
+	MoveShard(context.Context, *MoveShardRequest, ...yarpc.CallOption) (*MoveShardResponse, error)
 	WatchNamespaceState(context.Context, *WatchNamespaceStateRequest, ...yarpc.CallOption) (ShardDistributorAPIServiceWatchNamespaceStateYARPCClient, error)
 }
 
@@ -52,6 +57,11 @@ func NewShardDistributorAPIYARPCClient(clientConfig transport.ClientConfig, opti
 // ShardDistributorAPIYARPCServer is the YARPC server-side interface for the ShardDistributorAPI service.
 type ShardDistributorAPIYARPCServer interface {
 	GetShardOwner(context.Context, *GetShardOwnerRequest) (*GetShardOwnerResponse, error)
+	ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error)
+	ListExecutors(context.Context, *ListExecutorsRequest) (*ListExecutorsResponse, error)
+	DescribeShard(context.Context, *DescribeShardRequest) (*DescribeShardResponse, error)
+	TriggerRebalance(context.Context, *TriggerRebalanceRequest) (*TriggerRebalanceResponse, error)
+	MoveShard(context.Context, *MoveShardRequest) (*MoveShardResponse, error)
 	WatchNamespaceState(*WatchNamespaceStateRequest, ShardDistributorAPIServiceWatchNamespaceStateYARPCServer) error
 }
 
@@ -82,6 +92,56 @@ func buildShardDistributorAPIYARPCProcedures(params buildShardDistributorAPIYARP
 						},
 					),
 				},
+				{
+					MethodName: "ListNamespaces",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListNamespaces,
+							NewRequest:  newShardDistributorAPIServiceListNamespacesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ListExecutors",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListExecutors,
+							NewRequest:  newShardDistributorAPIServiceListExecutorsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeShard",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeShard,
+							NewRequest:  newShardDistributorAPIServiceDescribeShardYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "TriggerRebalance",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.TriggerRebalance,
+							NewRequest:  newShardDistributorAPIServiceTriggerRebalanceYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "MoveShard",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.MoveShard,
+							NewRequest:  newShardDistributorAPIServiceMoveShardYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 			},
 			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
 			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{
@@ -222,6 +282,66 @@ func (c *_ShardDistributorAPIYARPCCaller) GetShardOwner(ctx context.Context, req
 	return response, err
 }
 
+func (c *_ShardDistributorAPIYARPCCaller) ListNamespaces(ctx context.Context, request *ListNamespacesRequest, options ...yarpc.CallOption) (*ListNamespacesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListNamespaces", request, newShardDistributorAPIServiceListNamespacesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListNamespacesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyShardDistributorAPIServiceListNamespacesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_ShardDistributorAPIYARPCCaller) ListExecutors(ctx context.Context, request *ListExecutorsRequest, options ...yarpc.CallOption) (*ListExecutorsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListExecutors", request, newShardDistributorAPIServiceListExecutorsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListExecutorsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyShardDistributorAPIServiceListExecutorsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_ShardDistributorAPIYARPCCaller) DescribeShard(ctx context.Context, request *DescribeShardRequest, options ...yarpc.CallOption) (*DescribeShardResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeShard", request, newShardDistributorAPIServiceDescribeShardYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeShardResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyShardDistributorAPIServiceDescribeShardYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_ShardDistributorAPIYARPCCaller) TriggerRebalance(ctx context.Context, request *TriggerRebalanceRequest, options ...yarpc.CallOption) (*TriggerRebalanceResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "TriggerRebalance", request, newShardDistributorAPIServiceTriggerRebalanceYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*TriggerRebalanceResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyShardDistributorAPIServiceTriggerRebalanceYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_ShardDistributorAPIYARPCCaller) MoveShard(ctx context.Context, request *MoveShardRequest, options ...yarpc.CallOption) (*MoveShardResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "MoveShard", request, newShardDistributorAPIServiceMoveShardYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*MoveShardResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyShardDistributorAPIServiceMoveShardYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 func (c *_ShardDistributorAPIYARPCCaller) WatchNamespaceState(ctx context.Context, request *WatchNamespaceStateRequest, options ...yarpc.CallOption) (ShardDistributorAPIServiceWatchNamespaceStateYARPCClient, error) {
 	stream, err := c.streamClient.CallStream(ctx, "WatchNamespaceState", options...)
 	if err != nil {
@@ -253,6 +373,86 @@ func (h *_ShardDistributorAPIYARPCHandler) GetShardOwner(ctx context.Context, re
 	return response, err
 }
 
+func (h *_ShardDistributorAPIYARPCHandler) ListNamespaces(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListNamespacesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListNamespacesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyShardDistributorAPIServiceListNamespacesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListNamespaces(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_ShardDistributorAPIYARPCHandler) ListExecutors(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListExecutorsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListExecutorsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyShardDistributorAPIServiceListExecutorsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListExecutors(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_ShardDistributorAPIYARPCHandler) DescribeShard(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeShardRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeShardRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyShardDistributorAPIServiceDescribeShardYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeShard(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_ShardDistributorAPIYARPCHandler) TriggerRebalance(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *TriggerRebalanceRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*TriggerRebalanceRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyShardDistributorAPIServiceTriggerRebalanceYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.TriggerRebalance(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_ShardDistributorAPIYARPCHandler) MoveShard(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *MoveShardRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*MoveShardRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyShardDistributorAPIServiceMoveShardYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.MoveShard(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func (h *_ShardDistributorAPIYARPCHandler) WatchNamespaceState(serverStream *protobuf.ServerStream) error {
 	requestMessage, err := serverStream.Receive(newShardDistributorAPIServiceWatchNamespaceStateYARPCRequest)
 	if requestMessage == nil {
@@ -318,47 +518,199 @@ func newShardDistributorAPIServiceWatchNamespaceStateYARPCResponse() proto.Messa
 	return &WatchNamespaceStateResponse{}
 }
 
+func newShardDistributorAPIServiceListNamespacesYARPCRequest() proto.Message {
+	return &ListNamespacesRequest{}
+}
+
+func newShardDistributorAPIServiceListNamespacesYARPCResponse() proto.Message {
+	return &ListNamespacesResponse{}
+}
+
+func newShardDistributorAPIServiceListExecutorsYARPCRequest() proto.Message {
+	return &ListExecutorsRequest{}
+}
+
+func newShardDistributorAPIServiceListExecutorsYARPCResponse() proto.Message {
+	return &ListExecutorsResponse{}
+}
+
+func newShardDistributorAPIServiceDescribeShardYARPCRequest() proto.Message {
+	return &DescribeShardRequest{}
+}
+
+func newShardDistributorAPIServiceDescribeShardYARPCResponse() proto.Message {
+	return &DescribeShardResponse{}
+}
+
+func newShardDistributorAPIServiceTriggerRebalanceYARPCRequest() proto.Message {
+	return &TriggerRebalanceRequest{}
+}
+
+func newShardDistributorAPIServiceTriggerRebalanceYARPCResponse() proto.Message {
+	return &TriggerRebalanceResponse{}
+}
+
+func newShardDistributorAPIServiceMoveShardYARPCRequest() proto.Message {
+	return &MoveShardRequest{}
+}
+
+func newShardDistributorAPIServiceMoveShardYARPCResponse() proto.Message {
+	return &MoveShardResponse{}
+}
+
 var (
 	emptyShardDistributorAPIServiceGetShardOwnerYARPCRequest        = &GetShardOwnerRequest{}
 	emptyShardDistributorAPIServiceGetShardOwnerYARPCResponse       = &GetShardOwnerResponse{}
 	emptyShardDistributorAPIServiceWatchNamespaceStateYARPCRequest  = &WatchNamespaceStateRequest{}
 	emptyShardDistributorAPIServiceWatchNamespaceStateYARPCResponse = &WatchNamespaceStateResponse{}
+	emptyShardDistributorAPIServiceListNamespacesYARPCRequest       = &ListNamespacesRequest{}
+	emptyShardDistributorAPIServiceListNamespacesYARPCResponse      = &ListNamespacesResponse{}
+	emptyShardDistributorAPIServiceListExecutorsYARPCRequest        = &ListExecutorsRequest{}
+	emptyShardDistributorAPIServiceListExecutorsYARPCResponse       = &ListExecutorsResponse{}
+	emptyShardDistributorAPIServiceDescribeShardYARPCRequest        = &DescribeShardRequest{}
+	emptyShardDistributorAPIServiceDescribeShardYARPCResponse       = &DescribeShardResponse{}
+	emptyShardDistributorAPIServiceTriggerRebalanceYARPCRequest     = &TriggerRebalanceRequest{}
+	emptyShardDistributorAPIServiceTriggerRebalanceYARPCResponse    = &TriggerRebalanceResponse{}
+	emptyShardDistributorAPIServiceMoveShardYARPCRequest            = &MoveShardRequest{}
+	emptyShardDistributorAPIServiceMoveShardYARPCResponse           = &MoveShardResponse{}
 )
 
 var yarpcFileDescriptorClosure0055bfd59dff1f95 = [][]byte{
 	// uber/cadence/sharddistributor/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xd1, 0x6e, 0xd3, 0x30,
-		0x14, 0x95, 0x53, 0x6d, 0x5a, 0xef, 0x98, 0x84, 0xbc, 0x81, 0xaa, 0x0e, 0x89, 0x2a, 0x42, 0x62,
-		0x4f, 0x0e, 0x1d, 0xd2, 0x40, 0x1b, 0x08, 0x81, 0x28, 0xa8, 0x1a, 0x6c, 0x90, 0x3d, 0x80, 0x78,
-		0x99, 0xdc, 0xe4, 0xb2, 0x46, 0xa3, 0x76, 0xb1, 0x9d, 0x40, 0xdf, 0x78, 0xe3, 0x07, 0xf8, 0x0f,
-		0x3e, 0x8c, 0x9f, 0x40, 0x71, 0x9d, 0x75, 0x89, 0xb2, 0x96, 0x8e, 0xb7, 0xe4, 0xde, 0x7b, 0x8e,
-		0x8f, 0x7d, 0x8e, 0x0d, 0x2c, 0x1d, 0xa0, 0x0a, 0x22, 0x1e, 0xa3, 0x88, 0x30, 0xd0, 0x43, 0xae,
-		0xe2, 0x38, 0xd1, 0x46, 0x25, 0x83, 0xd4, 0x48, 0x15, 0x64, 0xdd, 0x40, 0xa3, 0xca, 0x92, 0x08,
-		0xd9, 0x58, 0x49, 0x23, 0x69, 0x27, 0x9f, 0x67, 0x6e, 0x9e, 0x55, 0xe7, 0x59, 0xd6, 0xf5, 0xdf,
-		0xc3, 0xd6, 0x6b, 0x34, 0x27, 0x79, 0xe7, 0xf8, 0x9b, 0x40, 0x15, 0xe2, 0xd7, 0x14, 0xb5, 0xa1,
-		0xdb, 0xd0, 0xb4, 0xe3, 0xa7, 0xe7, 0x38, 0x69, 0x91, 0x0e, 0xd9, 0x69, 0x86, 0x6b, 0xb6, 0x70,
-		0x88, 0x13, 0x7a, 0x07, 0x9a, 0x82, 0x8f, 0x50, 0x8f, 0x79, 0x84, 0x2d, 0xcf, 0x36, 0x67, 0x05,
-		0xff, 0x0f, 0x81, 0x5b, 0x15, 0x4e, 0x3d, 0x96, 0x42, 0x23, 0xdd, 0x82, 0x15, 0x99, 0x17, 0x1c,
-		0xe1, 0xf4, 0x67, 0x3e, 0x1b, 0xe5, 0xb0, 0x36, 0x42, 0xc3, 0x63, 0x6e, 0x78, 0xab, 0xd1, 0x69,
-		0xec, 0xac, 0xef, 0xf6, 0xd8, 0xa2, 0x5d, 0xb1, 0xda, 0xe5, 0xd9, 0x5b, 0xc7, 0xd3, 0x13, 0x46,
-		0x4d, 0xc2, 0x0b, 0xda, 0xf6, 0x01, 0x6c, 0x94, 0x5a, 0xf4, 0x26, 0x34, 0x66, 0xdb, 0xce, 0x3f,
-		0x73, 0xe5, 0x19, 0xff, 0x92, 0x16, 0xfa, 0xa6, 0x3f, 0xfb, 0xde, 0x63, 0xe2, 0xef, 0xc1, 0xed,
-		0xa3, 0x42, 0xec, 0x91, 0x34, 0xaf, 0x64, 0x2a, 0xe2, 0x9e, 0x52, 0xb2, 0xb2, 0x2f, 0x52, 0x3d,
-		0xa5, 0x63, 0xa0, 0x56, 0xe2, 0x12, 0x98, 0xb2, 0x29, 0x5e, 0xd9, 0x14, 0x7f, 0x1f, 0xda, 0x1f,
-		0xb8, 0x89, 0x86, 0x17, 0x6a, 0x4e, 0x0c, 0x37, 0x58, 0xf8, 0x39, 0x5f, 0xcc, 0x39, 0x6c, 0xd7,
-		0x62, 0x9d, 0x6f, 0x6f, 0xa0, 0x89, 0xdf, 0x31, 0xca, 0x4f, 0x57, 0xb7, 0x88, 0x35, 0x81, 0x2d,
-		0x36, 0xa1, 0xe7, 0x20, 0x7d, 0xf1, 0x59, 0x86, 0x33, 0x02, 0xff, 0xa7, 0x07, 0x37, 0x2e, 0xf7,
-		0xe8, 0x5d, 0x58, 0x2f, 0xba, 0xa7, 0x49, 0xec, 0xd4, 0x41, 0x51, 0xea, 0xc7, 0xf4, 0xe3, 0xa5,
-		0x0c, 0x78, 0x76, 0xf9, 0x27, 0xcb, 0x2d, 0x7f, 0x95, 0xf5, 0xf4, 0x19, 0xac, 0x5a, 0xac, 0x76,
-		0xd9, 0xba, 0xbf, 0x98, 0xd7, 0xba, 0x16, 0x3a, 0xd8, 0xff, 0x65, 0xe7, 0x1e, 0xac, 0x58, 0xb6,
-		0xb9, 0xb7, 0x6d, 0xf7, 0xb7, 0x07, 0x9b, 0x76, 0xec, 0xe5, 0x4c, 0xc8, 0xf3, 0x77, 0x7d, 0xfa,
-		0x83, 0xc0, 0x46, 0x29, 0xe8, 0x74, 0x6f, 0xe9, 0x9b, 0x61, 0xc3, 0xd1, 0x7e, 0x74, 0xcd, 0x1b,
-		0x45, 0x7f, 0x11, 0xd8, 0xac, 0x09, 0x0e, 0xfd, 0x07, 0x7b, 0xae, 0xce, 0x6a, 0xfb, 0xe9, 0x35,
-		0xd1, 0x53, 0x51, 0x0f, 0xc8, 0x8b, 0xc3, 0x4f, 0xfd, 0xb3, 0xc4, 0x0c, 0xd3, 0x01, 0x8b, 0xe4,
-		0x28, 0x28, 0xbd, 0x99, 0xec, 0x0c, 0x45, 0x60, 0x1f, 0xc7, 0xba, 0xe7, 0xf3, 0xa0, 0x5a, 0xcb,
-		0xba, 0x83, 0x55, 0x3b, 0xfd, 0xf0, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x73, 0x0d, 0x95,
-		0x7c, 0x05, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x72, 0xdb, 0x44,
+		0x14, 0x1e, 0xd9, 0x4d, 0xa8, 0x4f, 0xe2, 0x24, 0x6c, 0x92, 0xd6, 0xa3, 0x32, 0xd3, 0x8c, 0x60,
+		0xa6, 0xb9, 0x92, 0x6b, 0x97, 0x69, 0x4a, 0x0a, 0x53, 0xca, 0x24, 0xd0, 0x4c, 0xd3, 0x84, 0x28,
+		0x65, 0x60, 0xe0, 0xc2, 0xb3, 0x96, 0xb6, 0xb6, 0xa6, 0x96, 0xd6, 0xec, 0xae, 0x0c, 0xbe, 0x63,
+		0x86, 0x0b, 0x86, 0x7b, 0x1e, 0x80, 0x7b, 0x9e, 0x01, 0x5e, 0x88, 0x97, 0x60, 0x76, 0xb5, 0xb2,
+		0x2d, 0xc5, 0xb6, 0xa4, 0x84, 0xde, 0xc9, 0x67, 0xcf, 0xf7, 0xed, 0xd9, 0xfd, 0xce, 0xcf, 0x1a,
+		0xec, 0xa8, 0x4b, 0x58, 0xd3, 0xc5, 0x1e, 0x09, 0x5d, 0xd2, 0xe4, 0x7d, 0xcc, 0x3c, 0xcf, 0xe7,
+		0x82, 0xf9, 0xdd, 0x48, 0x50, 0xd6, 0x1c, 0xb5, 0x9a, 0x9c, 0xb0, 0x91, 0xef, 0x12, 0x7b, 0xc8,
+		0xa8, 0xa0, 0x68, 0x4f, 0xfa, 0xdb, 0xda, 0xdf, 0xce, 0xfa, 0xdb, 0xa3, 0x96, 0x79, 0xbf, 0x47,
+		0x69, 0x6f, 0x40, 0x9a, 0xca, 0xbf, 0x1b, 0xbd, 0x69, 0x0a, 0x3f, 0x20, 0x5c, 0xe0, 0x60, 0x18,
+		0x53, 0x98, 0xcd, 0xdc, 0x2d, 0xc9, 0xcf, 0xc4, 0x55, 0x74, 0x0a, 0x60, 0x5d, 0xc0, 0xce, 0x57,
+		0x44, 0x5c, 0x4a, 0xc7, 0xf3, 0x9f, 0x42, 0xc2, 0x1c, 0xf2, 0x63, 0x44, 0xb8, 0x40, 0xf7, 0xa0,
+		0xa6, 0xd0, 0x9d, 0xb7, 0x64, 0xdc, 0x30, 0xf6, 0x8c, 0xfd, 0x9a, 0x73, 0x5b, 0x19, 0x5e, 0x92,
+		0x31, 0xfa, 0x00, 0x6a, 0x21, 0x0e, 0x08, 0x1f, 0x62, 0x97, 0x34, 0x2a, 0x6a, 0x71, 0x6a, 0xb0,
+		0xfe, 0x35, 0x60, 0x37, 0xc3, 0xc9, 0x87, 0x34, 0xe4, 0x04, 0xed, 0xc0, 0x0a, 0x95, 0x06, 0x4d,
+		0x18, 0xff, 0x58, 0xce, 0x86, 0x30, 0xdc, 0x0e, 0x88, 0xc0, 0x1e, 0x16, 0xb8, 0x51, 0xdd, 0xab,
+		0xee, 0xaf, 0xb5, 0x8f, 0xed, 0xbc, 0x7b, 0xb2, 0xe7, 0x6e, 0x6f, 0xbf, 0xd2, 0x3c, 0xc7, 0xa1,
+		0x60, 0x63, 0x67, 0x42, 0x6b, 0x3e, 0x85, 0x7a, 0x6a, 0x09, 0x6d, 0x41, 0x75, 0x7a, 0x6c, 0xf9,
+		0x29, 0x23, 0x1f, 0xe1, 0x41, 0x94, 0xc4, 0x17, 0xff, 0x38, 0xac, 0x3c, 0x31, 0xac, 0xc7, 0x70,
+		0xe7, 0x2c, 0x09, 0xf6, 0x8c, 0x8a, 0x2f, 0x69, 0x14, 0x7a, 0xc7, 0x8c, 0xd1, 0xcc, 0xb9, 0x8c,
+		0xec, 0x2d, 0x9d, 0x03, 0x52, 0x21, 0x96, 0xc0, 0xa4, 0x45, 0xa9, 0xa4, 0x45, 0xb1, 0x0e, 0xc1,
+		0xfc, 0x16, 0x0b, 0xb7, 0x3f, 0x89, 0xe6, 0x52, 0x60, 0x41, 0x12, 0x3d, 0x97, 0x07, 0xf3, 0x16,
+		0xee, 0xcd, 0xc5, 0x6a, 0xdd, 0x4e, 0xa1, 0x96, 0xa4, 0x0d, 0x6f, 0x18, 0x4a, 0x04, 0x3b, 0x5f,
+		0x84, 0x63, 0x0d, 0x39, 0x09, 0xdf, 0x50, 0x67, 0x4a, 0x60, 0xfd, 0x56, 0x81, 0xf5, 0xd9, 0x35,
+		0x74, 0x1f, 0xd6, 0x92, 0xd5, 0x8e, 0xef, 0xe9, 0xe8, 0x20, 0x31, 0x9d, 0x78, 0xe8, 0xbb, 0x99,
+		0x1c, 0xa8, 0xa8, 0xed, 0x3f, 0x2d, 0xb7, 0xfd, 0x22, 0xe9, 0xd1, 0x33, 0x58, 0x55, 0x58, 0xae,
+		0x73, 0xeb, 0x41, 0x3e, 0xaf, 0x52, 0xcd, 0xd1, 0xb0, 0x9b, 0xe5, 0xce, 0x47, 0xb0, 0xa2, 0xd8,
+		0x96, 0x56, 0x9b, 0x75, 0x17, 0x76, 0x4f, 0x7d, 0x2e, 0x26, 0xda, 0x70, 0xad, 0xa9, 0xe5, 0xc3,
+		0x9d, 0xec, 0x82, 0x16, 0xec, 0x1c, 0x60, 0x22, 0x6e, 0xa2, 0x58, 0x33, 0xff, 0x68, 0x13, 0x26,
+		0x25, 0xd9, 0x0c, 0x85, 0xd5, 0x87, 0x7a, 0x6a, 0x11, 0x21, 0xb8, 0x25, 0x97, 0x75, 0xb0, 0xea,
+		0x5b, 0xda, 0xc4, 0x78, 0x98, 0x9c, 0x53, 0x7d, 0x4b, 0x5b, 0x40, 0x3d, 0xd2, 0xa8, 0xc6, 0x36,
+		0xf9, 0x3d, 0x3d, 0x6d, 0x18, 0x05, 0x8d, 0x5b, 0x7b, 0xc6, 0x7e, 0x55, 0x9f, 0xf6, 0x2c, 0x0a,
+		0xac, 0x8f, 0x61, 0x47, 0x1e, 0x2a, 0x51, 0x8f, 0x17, 0x4b, 0xe0, 0x3e, 0xec, 0x66, 0x50, 0x93,
+		0x9b, 0xb8, 0x92, 0xba, 0xad, 0xe2, 0xb9, 0x73, 0x19, 0x05, 0x01, 0x66, 0xe3, 0xd9, 0xec, 0xfd,
+		0xab, 0x0a, 0x9b, 0x99, 0xe5, 0xfc, 0x04, 0x7e, 0x01, 0xab, 0x5c, 0x60, 0x11, 0x71, 0x75, 0x37,
+		0x1b, 0xed, 0x87, 0x25, 0x42, 0x50, 0x38, 0x47, 0xe3, 0xd1, 0x73, 0xd8, 0x18, 0x60, 0x2e, 0x3a,
+		0x7d, 0x82, 0x99, 0xe8, 0x12, 0x2c, 0xd4, 0xcd, 0xae, 0xb5, 0x4d, 0x3b, 0x1e, 0x0d, 0x76, 0x32,
+		0x1a, 0xec, 0xd7, 0xc9, 0x68, 0x70, 0xea, 0x12, 0xf1, 0x22, 0x01, 0xa0, 0x07, 0xb0, 0x89, 0x39,
+		0xf7, 0x7b, 0x21, 0xf1, 0x3a, 0x3a, 0xf9, 0x63, 0x11, 0x36, 0x12, 0xb3, 0x4a, 0x4a, 0x2e, 0x1d,
+		0x19, 0x19, 0x52, 0x26, 0xa6, 0x8e, 0x2b, 0xb1, 0x63, 0x62, 0xd6, 0x8e, 0x3f, 0xcc, 0xd4, 0xe7,
+		0xaa, 0xba, 0xe3, 0x67, 0xa5, 0xef, 0xf8, 0xdd, 0x74, 0xe7, 0x0b, 0xd8, 0x39, 0x22, 0xdc, 0x65,
+		0x7e, 0x97, 0xc4, 0x75, 0x5b, 0x24, 0x9b, 0x96, 0xf7, 0xd9, 0x3f, 0x2b, 0xb0, 0x9b, 0xe1, 0x5c,
+		0x3a, 0xde, 0xf0, 0x95, 0xe6, 0x55, 0x60, 0x80, 0xcd, 0xdd, 0x60, 0x61, 0x17, 0xbb, 0x00, 0x90,
+		0xe9, 0xe1, 0x73, 0xe1, 0xbb, 0x5c, 0x27, 0x44, 0xab, 0x60, 0x27, 0xbb, 0x9c, 0x00, 0x9d, 0x19,
+		0x92, 0x9b, 0xdd, 0xfa, 0x3f, 0x06, 0x6c, 0x66, 0xc8, 0xd1, 0x87, 0x50, 0xe7, 0x01, 0xa5, 0xa2,
+		0x4f, 0xbc, 0xce, 0x80, 0xe2, 0xb8, 0x4a, 0x0c, 0x67, 0x3d, 0x31, 0x9e, 0x52, 0xec, 0xa1, 0x23,
+		0xd8, 0x52, 0xd9, 0x1d, 0x0d, 0x3d, 0x2c, 0x48, 0x47, 0xbe, 0x6e, 0x1a, 0x95, 0xdc, 0xfc, 0x56,
+		0x15, 0xf1, 0x8d, 0x82, 0x48, 0x23, 0xfa, 0x5c, 0xd7, 0x48, 0x40, 0x47, 0x9a, 0x23, 0xbf, 0x46,
+		0xd6, 0x25, 0xe2, 0x15, 0x1d, 0x29, 0x06, 0xeb, 0x00, 0xee, 0xbe, 0x66, 0x7e, 0xaf, 0x47, 0x98,
+		0x43, 0xba, 0x78, 0x80, 0x43, 0xb7, 0xe0, 0x20, 0x35, 0xa1, 0x71, 0x15, 0x18, 0xab, 0x67, 0x85,
+		0xb0, 0x25, 0x37, 0xf8, 0x9f, 0xf2, 0x30, 0xdb, 0x74, 0xaa, 0xd9, 0xa6, 0x63, 0x6d, 0xc3, 0xfb,
+		0x33, 0xfb, 0xc5, 0x41, 0xb4, 0xff, 0x7e, 0x0f, 0xb6, 0x95, 0xe5, 0x68, 0x9a, 0x0b, 0xcf, 0xbf,
+		0x3e, 0x41, 0xbf, 0x18, 0x50, 0x4f, 0xbd, 0x9a, 0xd0, 0xe3, 0xd2, 0xcf, 0x2c, 0x75, 0x24, 0xf3,
+		0xe0, 0x9a, 0xcf, 0x33, 0xf4, 0x87, 0x01, 0xdb, 0x73, 0x5e, 0x21, 0xa8, 0xc0, 0xac, 0x5f, 0xfc,
+		0xf0, 0x31, 0x3f, 0xbb, 0x26, 0x3a, 0x0e, 0xea, 0xa1, 0x81, 0x7e, 0x35, 0x60, 0x23, 0x3d, 0x66,
+		0x51, 0x81, 0x23, 0xce, 0x9d, 0xd8, 0xe6, 0x93, 0xf2, 0x40, 0x7d, 0x39, 0x52, 0x9f, 0xd4, 0x84,
+		0x2b, 0xa2, 0xcf, 0xbc, 0x41, 0x6a, 0x1e, 0x94, 0xc6, 0xcd, 0x84, 0x90, 0xea, 0x4b, 0x45, 0x42,
+		0x98, 0xd7, 0x7d, 0xcd, 0x83, 0xd2, 0x38, 0x1d, 0xc2, 0xef, 0x06, 0x6c, 0x65, 0xeb, 0x0b, 0x7d,
+		0x92, 0xcf, 0xb6, 0xa0, 0x98, 0xcd, 0xc3, 0xeb, 0x40, 0x75, 0x2c, 0x02, 0x6a, 0x93, 0xf2, 0x42,
+		0xed, 0x7c, 0xa2, 0x6c, 0xed, 0x9b, 0x8f, 0x4a, 0x61, 0xe2, 0x5d, 0xbf, 0x78, 0xf9, 0xfd, 0x49,
+		0xcf, 0x17, 0xfd, 0xa8, 0x6b, 0xbb, 0x34, 0x48, 0xff, 0xdb, 0xb3, 0x7b, 0x24, 0x8c, 0xff, 0x19,
+		0xce, 0xfb, 0xe3, 0xf7, 0x34, 0x6b, 0x1b, 0xb5, 0xba, 0xab, 0xca, 0xfb, 0xd1, 0x7f, 0x03, 0x00,
+		0x2c, 0x97, 0xd4, 0xe7, 0xa9, 0x0e, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+	},
+	// uber/cadence/sharddistributor/v1/executor.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5d, 0x6f, 0xe3, 0x44,
+		0x14, 0x65, 0x92, 0x76, 0x45, 0x6e, 0x69, 0xd7, 0x1d, 0x75, 0xb7, 0x21, 0xdd, 0x2d, 0x51, 0x84,
+		0xa0, 0x04, 0x61, 0xd3, 0xf4, 0x05, 0xb1, 0x2f, 0xb8, 0xb5, 0x95, 0x78, 0xd5, 0xd8, 0xab, 0xb1,
+		0x9b, 0x05, 0x04, 0xb2, 0x26, 0xf1, 0x28, 0xb5, 0x58, 0xdb, 0xc1, 0x9e, 0x44, 0x14, 0xf1, 0xb8,
+		0x3f, 0x88, 0x9f, 0xb1, 0x8f, 0xbc, 0xf3, 0x82, 0xfa, 0x3f, 0x90, 0x90, 0x3f, 0x92, 0xd8, 0x4e,
+		0x50, 0xda, 0x6a, 0xdf, 0xec, 0x7b, 0xef, 0x39, 0xe7, 0xe6, 0x9e, 0xeb, 0xc9, 0x80, 0x34, 0x1d,
+		0xb2, 0x50, 0x1a, 0x51, 0x87, 0xf9, 0x23, 0x26, 0x45, 0xd7, 0x34, 0x74, 0x1c, 0x37, 0xe2, 0xa1,
+		0x3b, 0x9c, 0xf2, 0x20, 0x94, 0x66, 0xa7, 0x12, 0xfb, 0x8d, 0x8d, 0xe2, 0x67, 0x71, 0x12, 0x06,
+		0x3c, 0xc0, 0xcd, 0x18, 0x20, 0x66, 0x00, 0xb1, 0x0c, 0x10, 0x67, 0xa7, 0xad, 0x3f, 0xb7, 0x40,
+		0xe8, 0x31, 0x1a, 0xf2, 0x21, 0xa3, 0x9c, 0xb0, 0x5f, 0xa7, 0x2c, 0xe2, 0xf8, 0x19, 0xd4, 0x7c,
+		0xea, 0xb1, 0x68, 0x42, 0x47, 0xac, 0x8e, 0x9a, 0xe8, 0xa4, 0x46, 0x96, 0x01, 0xfc, 0x09, 0xec,
+		0xcc, 0x65, 0x6c, 0xd7, 0xa9, 0x57, 0x92, 0x3c, 0xcc, 0x43, 0x9a, 0x83, 0x7b, 0xf0, 0x28, 0xe2,
+		0x94, 0x4f, 0xa3, 0x7a, 0xb5, 0x89, 0x4e, 0xf6, 0x3a, 0x5f, 0x8b, 0x9b, 0xda, 0x10, 0xd5, 0x0c,
+		0x6d, 0x26, 0x38, 0x92, 0xe1, 0xf1, 0x1f, 0x70, 0x90, 0x54, 0xdb, 0xe9, 0xbb, 0x1d, 0xb2, 0x49,
+		0x10, 0xf2, 0xa8, 0xbe, 0xd5, 0xac, 0x9e, 0xec, 0x74, 0x5e, 0x6e, 0xe6, 0x2d, 0xff, 0x34, 0xd1,
+		0x8c, 0x8b, 0x32, 0x95, 0x94, 0x4c, 0xf5, 0x79, 0x78, 0x43, 0x70, 0xb4, 0x92, 0xc0, 0x3f, 0xc1,
+		0x87, 0x1e, 0xe3, 0xd4, 0xa1, 0x9c, 0xd6, 0xb7, 0x13, 0xc5, 0xef, 0x1e, 0xa0, 0xd8, 0xcf, 0x28,
+		0x52, 0x9d, 0x05, 0x63, 0xe3, 0x77, 0x38, 0xfc, 0x9f, 0x66, 0xb0, 0x00, 0xd5, 0x5f, 0xd8, 0x4d,
+		0x36, 0xf9, 0xf8, 0x11, 0x6b, 0xb0, 0x3d, 0xa3, 0x6f, 0xa6, 0x2c, 0x99, 0xf6, 0x4e, 0xe7, 0x6c,
+		0x73, 0x1f, 0x2b, 0xdc, 0x24, 0x65, 0xf8, 0xb6, 0xf2, 0x0d, 0x6a, 0xbc, 0x80, 0xdd, 0x42, 0x5b,
+		0x6b, 0x14, 0x0f, 0xf2, 0x8a, 0xb5, 0x1c, 0xb8, 0x75, 0x03, 0xfb, 0x2b, 0xe4, 0x58, 0x5d, 0x78,
+		0x8e, 0x12, 0xcf, 0xbf, 0xba, 0x5f, 0x87, 0x73, 0xc3, 0x9f, 0x03, 0xa4, 0x86, 0xbf, 0x09, 0x68,
+		0xba, 0x5a, 0x88, 0xd4, 0x92, 0xc8, 0x65, 0x40, 0x9d, 0xd6, 0xdf, 0x15, 0xd8, 0xcf, 0x0d, 0x38,
+		0x9a, 0x04, 0x7e, 0xc4, 0xf0, 0x0c, 0xf6, 0x53, 0x10, 0x8d, 0x22, 0x77, 0xec, 0x7b, 0xcc, 0xe7,
+		0x71, 0x1b, 0xb1, 0x61, 0xda, 0xbd, 0x0c, 0x4b, 0xf9, 0xd2, 0xc6, 0xe4, 0x25, 0x57, 0xea, 0x9c,
+		0x10, 0x95, 0xc2, 0x78, 0x00, 0x7b, 0x9e, 0x3b, 0x0e, 0x29, 0x77, 0x03, 0xdf, 0xf6, 0x02, 0x27,
+		0x9d, 0xd5, 0x5e, 0x47, 0xda, 0x2c, 0xda, 0x9f, 0xe3, 0xfa, 0x81, 0xc3, 0xc8, 0xae, 0x97, 0x7f,
+		0x6d, 0xcc, 0xe0, 0xc9, 0xda, 0x16, 0xd6, 0xb8, 0xd4, 0x2d, 0xee, 0xc5, 0xe9, 0x1d, 0xa7, 0xbe,
+		0x64, 0xce, 0x1b, 0xfb, 0x33, 0x3c, 0x2e, 0x65, 0xf1, 0xcb, 0x92, 0xad, 0x9d, 0xcd, 0x02, 0x4b,
+		0x74, 0xd1, 0xdb, 0x56, 0x1f, 0x3e, 0x52, 0x42, 0xea, 0xfa, 0xef, 0xe7, 0x94, 0x69, 0xbd, 0x45,
+		0xb0, 0x9b, 0xf1, 0x65, 0x7b, 0xd0, 0x2b, 0x35, 0xfb, 0xf0, 0x73, 0xe7, 0x0b, 0x10, 0x42, 0xe6,
+		0x51, 0xd7, 0x77, 0xfd, 0xb1, 0x9d, 0xe0, 0xa2, 0xa4, 0x83, 0x2a, 0x79, 0xbc, 0x88, 0x27, 0xa3,
+		0x8a, 0xda, 0x6f, 0x11, 0xec, 0x15, 0x59, 0xf0, 0x11, 0x1c, 0xaa, 0xdf, 0xab, 0x17, 0x57, 0x96,
+		0x41, 0x6c, 0xd3, 0x92, 0xad, 0x2b, 0xd3, 0xd6, 0xf4, 0x81, 0x7c, 0xa9, 0x29, 0xc2, 0x07, 0xb8,
+		0x01, 0x4f, 0xcb, 0x49, 0xf9, 0xc2, 0xd2, 0x06, 0xaa, 0x80, 0xf0, 0x33, 0xa8, 0x97, 0x73, 0x0a,
+		0x91, 0x35, 0x5d, 0xd3, 0xbb, 0x42, 0x65, 0x1d, 0x6d, 0x92, 0x55, 0x15, 0xa1, 0xda, 0x1e, 0xc0,
+		0x4e, 0xee, 0x7b, 0xc2, 0x75, 0x38, 0x30, 0x7b, 0x32, 0x51, 0x56, 0xf5, 0x9f, 0x02, 0x2e, 0x64,
+		0x88, 0x2a, 0x2b, 0x3f, 0x08, 0x08, 0x3f, 0x81, 0xfd, 0x42, 0x5c, 0x31, 0x74, 0x55, 0xa8, 0xb4,
+		0x75, 0x10, 0xca, 0x86, 0xe2, 0xe7, 0xf0, 0xb1, 0x6c, 0x9a, 0x5a, 0x57, 0xef, 0xab, 0xba, 0xb5,
+		0xaa, 0x70, 0x04, 0x87, 0xab, 0xe9, 0x4c, 0xa6, 0xfd, 0x0e, 0xc1, 0x6e, 0x61, 0xf9, 0xe3, 0x81,
+		0xf4, 0xb5, 0x2e, 0x91, 0x2d, 0xcd, 0xd0, 0xed, 0xbe, 0xa1, 0xa8, 0x39, 0xaa, 0x4f, 0xa1, 0x59,
+		0xca, 0x5d, 0x1a, 0x17, 0xf2, 0xa5, 0xfd, 0x4a, 0x36, 0x4d, 0xab, 0x47, 0x8c, 0xab, 0x6e, 0x4f,
+		0x40, 0xf8, 0x4b, 0xf8, 0x7c, 0x53, 0x95, 0x6d, 0xf6, 0x64, 0xc5, 0x78, 0x2d, 0x54, 0x70, 0x1b,
+		0x3e, 0x2b, 0x15, 0x2b, 0x9a, 0x69, 0x11, 0xed, 0xfc, 0xca, 0x52, 0x95, 0x02, 0x71, 0x35, 0xf6,
+		0xa3, 0x54, 0x6b, 0xe8, 0xe7, 0x86, 0x4c, 0x14, 0x55, 0x11, 0xb6, 0x3a, 0xff, 0x22, 0x38, 0x4a,
+		0x66, 0xae, 0x2c, 0x77, 0x6a, 0xbe, 0x09, 0xf2, 0x2b, 0x0d, 0x73, 0xa8, 0x2d, 0xce, 0x16, 0xdc,
+		0xb9, 0xff, 0x3f, 0x47, 0xe3, 0xec, 0x01, 0x87, 0x17, 0xbe, 0x86, 0xed, 0xe4, 0xab, 0xc0, 0xe2,
+		0x66, 0x74, 0xfe, 0x73, 0x6c, 0x48, 0x77, 0xae, 0x4f, 0x95, 0xce, 0x5f, 0xbf, 0xbb, 0x3d, 0x46,
+		0x7f, 0xdd, 0x1e, 0xa3, 0x7f, 0x6e, 0x8f, 0xd1, 0x8f, 0xda, 0xd8, 0xe5, 0xd7, 0xd3, 0xa1, 0x38,
+		0x0a, 0xbc, 0xe2, 0x35, 0x45, 0x1c, 0x33, 0x5f, 0x4a, 0xae, 0x23, 0xeb, 0x6e, 0x2c, 0x2f, 0xca,
+		0xb1, 0xd9, 0xe9, 0xf0, 0x51, 0x52, 0x7d, 0xf6, 0xdf, 0x00, 0x05, 0xad, 0x27, 0x2b, 0xef, 0x08,
+		0x00, 0x00,
 	},
 }
 
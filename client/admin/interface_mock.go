@@ -594,6 +594,26 @@ func (mr *MockClientMockRecorder) RestoreDynamicConfig(arg0, arg1 any, arg2 ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDynamicConfig", reflect.TypeOf((*MockClient)(nil).RestoreDynamicConfig), varargs...)
 }
 
+// RollbackDomain mocks base method.
+func (m *MockClient) RollbackDomain(arg0 context.Context, arg1 *types.RollbackDomainRequest, arg2 ...yarpc.CallOption) (*types.RollbackDomainResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RollbackDomain", varargs...)
+	ret0, _ := ret[0].(*types.RollbackDomainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackDomain indicates an expected call of RollbackDomain.
+func (mr *MockClientMockRecorder) RollbackDomain(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackDomain", reflect.TypeOf((*MockClient)(nil).RollbackDomain), varargs...)
+}
+
 // UpdateDomainAsyncWorkflowConfiguraton mocks base method.
 func (m *MockClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (*types.UpdateDomainAsyncWorkflowConfiguratonResponse, error) {
 	m.ctrl.T.Helper()
@@ -692,3 +712,22 @@ func (mr *MockClientMockRecorder) UpdateTaskListPartitionConfig(ctx, request any
 	varargs := append([]any{ctx, request}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListPartitionConfig", reflect.TypeOf((*MockClient)(nil).UpdateTaskListPartitionConfig), varargs...)
 }
+
+// UpdateVirtualQueue mocks base method.
+func (m *MockClient) UpdateVirtualQueue(arg0 context.Context, arg1 *types.UpdateVirtualQueueRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateVirtualQueue", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVirtualQueue indicates an expected call of UpdateVirtualQueue.
+func (mr *MockClientMockRecorder) UpdateVirtualQueue(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualQueue", reflect.TypeOf((*MockClient)(nil).UpdateVirtualQueue), varargs...)
+}
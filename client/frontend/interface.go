@@ -85,6 +85,7 @@ type Client interface {
 	UpdateDomain(context.Context, *types.UpdateDomainRequest, ...yarpc.CallOption) (*types.UpdateDomainResponse, error)
 	FailoverDomain(context.Context, *types.FailoverDomainRequest, ...yarpc.CallOption) (*types.FailoverDomainResponse, error)
 	ListFailoverHistory(context.Context, *types.ListFailoverHistoryRequest, ...yarpc.CallOption) (*types.ListFailoverHistoryResponse, error)
+	ListDomainChanges(context.Context, *types.ListDomainChangesRequest, ...yarpc.CallOption) (*types.ListDomainChangesResponse, error)
 
 	CreateSchedule(context.Context, *types.CreateScheduleRequest, ...yarpc.CallOption) (*types.CreateScheduleResponse, error)
 	DescribeSchedule(context.Context, *types.DescribeScheduleRequest, ...yarpc.CallOption) (*types.DescribeScheduleResponse, error)
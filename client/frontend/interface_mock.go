@@ -401,6 +401,26 @@ func (mr *MockClientMockRecorder) ListClosedWorkflowExecutions(arg0, arg1 any, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClosedWorkflowExecutions", reflect.TypeOf((*MockClient)(nil).ListClosedWorkflowExecutions), varargs...)
 }
 
+// ListDomainChanges mocks base method.
+func (m *MockClient) ListDomainChanges(arg0 context.Context, arg1 *types.ListDomainChangesRequest, arg2 ...yarpc.CallOption) (*types.ListDomainChangesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDomainChanges", varargs...)
+	ret0, _ := ret[0].(*types.ListDomainChangesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDomainChanges indicates an expected call of ListDomainChanges.
+func (mr *MockClientMockRecorder) ListDomainChanges(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomainChanges", reflect.TypeOf((*MockClient)(nil).ListDomainChanges), varargs...)
+}
+
 // ListDomains mocks base method.
 func (m *MockClient) ListDomains(arg0 context.Context, arg1 *types.ListDomainsRequest, arg2 ...yarpc.CallOption) (*types.ListDomainsResponse, error) {
 	m.ctrl.T.Helper()
@@ -273,6 +273,28 @@ func (c *clientImpl) DescribeQueue(
 	return response, nil
 }
 
+func (c *clientImpl) UpdateVirtualQueue(
+	ctx context.Context,
+	request *types.UpdateVirtualQueueRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromShardID(int(request.GetShardID()))
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		var err error
+		err = c.client.UpdateVirtualQueue(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+		return err
+	}
+
+	err = c.executeWithRedirect(ctx, peer, op)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (c *clientImpl) DescribeMutableState(
 	ctx context.Context,
 	request *types.DescribeMutableStateRequest,
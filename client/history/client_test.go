@@ -1162,6 +1162,19 @@ func TestClient_withNoResponse(t *testing.T) {
 					Return(nil).Times(1)
 			},
 		},
+		{
+			name: "UpdateVirtualQueue",
+			op: func(c Client) error {
+				return c.UpdateVirtualQueue(context.Background(), &types.UpdateVirtualQueueRequest{
+					ShardID: 123,
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromShardID(123).Return("test-peer", nil).Times(1)
+				c.EXPECT().UpdateVirtualQueue(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(nil).Times(1)
+			},
+		},
 		{
 			name: "RespondActivityTaskCanceled",
 			op: func(c Client) error {
@@ -38,7 +38,12 @@ import (
 //go:generate gowrap gen -g -p . -i Client -t ../templates/timeout.tmpl -o ../wrappers/timeout/sharddistributor_generated.go -v client=ShardDistributor
 
 type Client interface {
+	DescribeShard(context.Context, *types.DescribeShardRequest, ...yarpc.CallOption) (*types.DescribeShardResponse, error)
 	GetShardOwner(context.Context, *types.GetShardOwnerRequest, ...yarpc.CallOption) (*types.GetShardOwnerResponse, error)
+	ListExecutors(context.Context, *types.ListExecutorsRequest, ...yarpc.CallOption) (*types.ListExecutorsResponse, error)
+	ListNamespaces(context.Context, *types.ListNamespacesRequest, ...yarpc.CallOption) (*types.ListNamespacesResponse, error)
+	MoveShard(context.Context, *types.MoveShardRequest, ...yarpc.CallOption) (*types.MoveShardResponse, error)
+	TriggerRebalance(context.Context, *types.TriggerRebalanceRequest, ...yarpc.CallOption) (*types.TriggerRebalanceResponse, error)
 	WatchNamespaceState(context.Context, *types.WatchNamespaceStateRequest, ...yarpc.CallOption) (WatchNamespaceStateClient, error)
 }
 
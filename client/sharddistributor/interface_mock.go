@@ -43,6 +43,26 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// DescribeShard mocks base method.
+func (m *MockClient) DescribeShard(arg0 context.Context, arg1 *types.DescribeShardRequest, arg2 ...yarpc.CallOption) (*types.DescribeShardResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeShard", varargs...)
+	ret0, _ := ret[0].(*types.DescribeShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeShard indicates an expected call of DescribeShard.
+func (mr *MockClientMockRecorder) DescribeShard(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShard", reflect.TypeOf((*MockClient)(nil).DescribeShard), varargs...)
+}
+
 // GetShardOwner mocks base method.
 func (m *MockClient) GetShardOwner(arg0 context.Context, arg1 *types.GetShardOwnerRequest, arg2 ...yarpc.CallOption) (*types.GetShardOwnerResponse, error) {
 	m.ctrl.T.Helper()
@@ -63,6 +83,86 @@ func (mr *MockClientMockRecorder) GetShardOwner(arg0, arg1 any, arg2 ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardOwner", reflect.TypeOf((*MockClient)(nil).GetShardOwner), varargs...)
 }
 
+// ListExecutors mocks base method.
+func (m *MockClient) ListExecutors(arg0 context.Context, arg1 *types.ListExecutorsRequest, arg2 ...yarpc.CallOption) (*types.ListExecutorsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListExecutors", varargs...)
+	ret0, _ := ret[0].(*types.ListExecutorsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExecutors indicates an expected call of ListExecutors.
+func (mr *MockClientMockRecorder) ListExecutors(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExecutors", reflect.TypeOf((*MockClient)(nil).ListExecutors), varargs...)
+}
+
+// ListNamespaces mocks base method.
+func (m *MockClient) ListNamespaces(arg0 context.Context, arg1 *types.ListNamespacesRequest, arg2 ...yarpc.CallOption) (*types.ListNamespacesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNamespaces", varargs...)
+	ret0, _ := ret[0].(*types.ListNamespacesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNamespaces indicates an expected call of ListNamespaces.
+func (mr *MockClientMockRecorder) ListNamespaces(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaces", reflect.TypeOf((*MockClient)(nil).ListNamespaces), varargs...)
+}
+
+// MoveShard mocks base method.
+func (m *MockClient) MoveShard(arg0 context.Context, arg1 *types.MoveShardRequest, arg2 ...yarpc.CallOption) (*types.MoveShardResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveShard", varargs...)
+	ret0, _ := ret[0].(*types.MoveShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveShard indicates an expected call of MoveShard.
+func (mr *MockClientMockRecorder) MoveShard(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveShard", reflect.TypeOf((*MockClient)(nil).MoveShard), varargs...)
+}
+
+// TriggerRebalance mocks base method.
+func (m *MockClient) TriggerRebalance(arg0 context.Context, arg1 *types.TriggerRebalanceRequest, arg2 ...yarpc.CallOption) (*types.TriggerRebalanceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TriggerRebalance", varargs...)
+	ret0, _ := ret[0].(*types.TriggerRebalanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerRebalance indicates an expected call of TriggerRebalance.
+func (mr *MockClientMockRecorder) TriggerRebalance(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerRebalance", reflect.TypeOf((*MockClient)(nil).TriggerRebalance), varargs...)
+}
+
 // WatchNamespaceState mocks base method.
 func (m *MockClient) WatchNamespaceState(arg0 context.Context, arg1 *types.WatchNamespaceStateRequest, arg2 ...yarpc.CallOption) (WatchNamespaceStateClient, error) {
 	m.ctrl.T.Helper()
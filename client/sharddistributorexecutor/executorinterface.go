@@ -39,4 +39,5 @@ import (
 
 type Client interface {
 	Heartbeat(context.Context, *types.ExecutorHeartbeatRequest, ...yarpc.CallOption) (*types.ExecutorHeartbeatResponse, error)
+	Drain(context.Context, *types.ExecutorDrainRequest, ...yarpc.CallOption) (*types.ExecutorDrainResponse, error)
 }
@@ -154,6 +154,10 @@ func (g adminClient) RestoreDynamicConfig(ctx context.Context, rp1 *types.Restor
 	return proto.ToError(err)
 }
 
+func (g adminClient) RollbackDomain(ctx context.Context, rp1 *types.RollbackDomainRequest, p1 ...yarpc.CallOption) (rp2 *types.RollbackDomainResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	response, err := g.c.UpdateDomainAsyncWorkflowConfiguraton(ctx, proto.FromAdminUpdateDomainAsyncWorkflowConfiguratonRequest(request), opts...)
 	return proto.ToAdminUpdateDomainAsyncWorkflowConfiguratonResponse(response), proto.ToError(err)
@@ -178,3 +182,7 @@ func (g adminClient) UpdateTaskListPartitionConfig(ctx context.Context, request
 	response, err := g.c.UpdateTaskListPartitionConfig(ctx, proto.FromAdminUpdateTaskListPartitionConfigRequest(request), opts...)
 	return proto.ToAdminUpdateTaskListPartitionConfigResponse(response), proto.ToError(err)
 }
+
+func (g adminClient) UpdateVirtualQueue(ctx context.Context, up1 *types.UpdateVirtualQueueRequest, p1 ...yarpc.CallOption) (err error) {
+	return &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
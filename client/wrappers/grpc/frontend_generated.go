@@ -104,6 +104,10 @@ func (g frontendClient) ListClosedWorkflowExecutions(ctx context.Context, lp1 *t
 	return proto.ToListClosedWorkflowExecutionsResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) ListDomainChanges(ctx context.Context, lp1 *types.ListDomainChangesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDomainChangesResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) ListDomains(ctx context.Context, lp1 *types.ListDomainsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDomainsResponse, err error) {
 	response, err := g.c.ListDomains(ctx, proto.FromListDomainsRequest(lp1), p1...)
 	return proto.ToListDomainsResponse(response), proto.ToError(err)
@@ -232,3 +232,8 @@ func (g historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *type
 	_, err = g.c.TerminateWorkflowExecution(ctx, proto.FromHistoryTerminateWorkflowExecutionRequest(hp1), p1...)
 	return proto.ToError(err)
 }
+
+func (g historyClient) UpdateVirtualQueue(ctx context.Context, up1 *types.UpdateVirtualQueueRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.UpdateVirtualQueue(ctx, proto.FromHistoryUpdateVirtualQueueRequest(up1), p1...)
+	return proto.ToError(err)
+}
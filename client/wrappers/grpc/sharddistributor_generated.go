@@ -15,11 +15,36 @@ import (
 	"github.com/uber/cadence/common/types/mapper/proto"
 )
 
+func (g sharddistributorClient) DescribeShard(ctx context.Context, gp1 *types.DescribeShardRequest, p1 ...yarpc.CallOption) (gp2 *types.DescribeShardResponse, err error) {
+	response, err := g.c.DescribeShard(ctx, proto.FromShardDistributorDescribeShardRequest(gp1), p1...)
+	return proto.ToShardDistributorDescribeShardResponse(response), proto.ToError(err)
+}
+
 func (g sharddistributorClient) GetShardOwner(ctx context.Context, gp1 *types.GetShardOwnerRequest, p1 ...yarpc.CallOption) (gp2 *types.GetShardOwnerResponse, err error) {
 	response, err := g.c.GetShardOwner(ctx, proto.FromShardDistributorGetShardOwnerRequest(gp1), p1...)
 	return proto.ToShardDistributorGetShardOwnerResponse(response), proto.ToError(err)
 }
 
+func (g sharddistributorClient) ListExecutors(ctx context.Context, gp1 *types.ListExecutorsRequest, p1 ...yarpc.CallOption) (gp2 *types.ListExecutorsResponse, err error) {
+	response, err := g.c.ListExecutors(ctx, proto.FromShardDistributorListExecutorsRequest(gp1), p1...)
+	return proto.ToShardDistributorListExecutorsResponse(response), proto.ToError(err)
+}
+
+func (g sharddistributorClient) ListNamespaces(ctx context.Context, gp1 *types.ListNamespacesRequest, p1 ...yarpc.CallOption) (gp2 *types.ListNamespacesResponse, err error) {
+	response, err := g.c.ListNamespaces(ctx, proto.FromShardDistributorListNamespacesRequest(gp1), p1...)
+	return proto.ToShardDistributorListNamespacesResponse(response), proto.ToError(err)
+}
+
+func (g sharddistributorClient) MoveShard(ctx context.Context, gp1 *types.MoveShardRequest, p1 ...yarpc.CallOption) (gp2 *types.MoveShardResponse, err error) {
+	response, err := g.c.MoveShard(ctx, proto.FromShardDistributorMoveShardRequest(gp1), p1...)
+	return proto.ToShardDistributorMoveShardResponse(response), proto.ToError(err)
+}
+
+func (g sharddistributorClient) TriggerRebalance(ctx context.Context, gp1 *types.TriggerRebalanceRequest, p1 ...yarpc.CallOption) (gp2 *types.TriggerRebalanceResponse, err error) {
+	response, err := g.c.TriggerRebalance(ctx, proto.FromShardDistributorTriggerRebalanceRequest(gp1), p1...)
+	return proto.ToShardDistributorTriggerRebalanceResponse(response), proto.ToError(err)
+}
+
 func (g sharddistributorClient) WatchNamespaceState(ctx context.Context, wp1 *types.WatchNamespaceStateRequest, p1 ...yarpc.CallOption) (w1 sharddistributor.WatchNamespaceStateClient, err error) {
 	stream, err := g.c.WatchNamespaceState(ctx, proto.FromShardDistributorWatchNamespaceStateRequest(wp1), p1...)
 	if err != nil {
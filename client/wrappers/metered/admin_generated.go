@@ -644,6 +644,28 @@ func (c *adminClient) RestoreDynamicConfig(ctx context.Context, rp1 *types.Resto
 	return err
 }
 
+func (c *adminClient) RollbackDomain(ctx context.Context, rp1 *types.RollbackDomainRequest, p1 ...yarpc.CallOption) (rp2 *types.RollbackDomainResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.AdminClientRollbackDomainScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.AdminClientRollbackDomainScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	rp2, err = c.client.RollbackDomain(ctx, rp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return rp2, err
+}
+
 func (c *adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -753,3 +775,25 @@ func (c *adminClient) UpdateTaskListPartitionConfig(ctx context.Context, request
 	}
 	return up1, err
 }
+
+func (c *adminClient) UpdateVirtualQueue(ctx context.Context, up1 *types.UpdateVirtualQueueRequest, p1 ...yarpc.CallOption) (err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.AdminClientUpdateVirtualQueueScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.AdminClientUpdateVirtualQueueScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	err = c.client.UpdateVirtualQueue(ctx, up1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return err
+}
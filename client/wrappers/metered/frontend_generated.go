@@ -424,6 +424,28 @@ func (c *frontendClient) ListClosedWorkflowExecutions(ctx context.Context, lp1 *
 	return lp2, err
 }
 
+func (c *frontendClient) ListDomainChanges(ctx context.Context, lp1 *types.ListDomainChangesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDomainChangesResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientListDomainChangesScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientListDomainChangesScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	lp2, err = c.client.ListDomainChanges(ctx, lp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return lp2, err
+}
+
 func (c *frontendClient) ListDomains(ctx context.Context, lp1 *types.ListDomainsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDomainsResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
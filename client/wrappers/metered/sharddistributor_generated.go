@@ -28,6 +28,28 @@ func NewShardDistributorClient(client sharddistributor.Client, metricsClient met
 	}
 }
 
+func (c *sharddistributorClient) DescribeShard(ctx context.Context, gp1 *types.DescribeShardRequest, p1 ...yarpc.CallOption) (gp2 *types.DescribeShardResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientDescribeShardScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientDescribeShardScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	gp2, err = c.client.DescribeShard(ctx, gp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return gp2, err
+}
+
 func (c *sharddistributorClient) GetShardOwner(ctx context.Context, gp1 *types.GetShardOwnerRequest, p1 ...yarpc.CallOption) (gp2 *types.GetShardOwnerResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -50,6 +72,94 @@ func (c *sharddistributorClient) GetShardOwner(ctx context.Context, gp1 *types.G
 	return gp2, err
 }
 
+func (c *sharddistributorClient) ListExecutors(ctx context.Context, gp1 *types.ListExecutorsRequest, p1 ...yarpc.CallOption) (gp2 *types.ListExecutorsResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientListExecutorsScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientListExecutorsScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	gp2, err = c.client.ListExecutors(ctx, gp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return gp2, err
+}
+
+func (c *sharddistributorClient) ListNamespaces(ctx context.Context, gp1 *types.ListNamespacesRequest, p1 ...yarpc.CallOption) (gp2 *types.ListNamespacesResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientListNamespacesScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientListNamespacesScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	gp2, err = c.client.ListNamespaces(ctx, gp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return gp2, err
+}
+
+func (c *sharddistributorClient) MoveShard(ctx context.Context, gp1 *types.MoveShardRequest, p1 ...yarpc.CallOption) (gp2 *types.MoveShardResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientMoveShardScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientMoveShardScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	gp2, err = c.client.MoveShard(ctx, gp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return gp2, err
+}
+
+func (c *sharddistributorClient) TriggerRebalance(ctx context.Context, gp1 *types.TriggerRebalanceRequest, p1 ...yarpc.CallOption) (gp2 *types.TriggerRebalanceResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientTriggerRebalanceScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientTriggerRebalanceScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	gp2, err = c.client.TriggerRebalance(ctx, gp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return gp2, err
+}
+
 func (c *sharddistributorClient) WatchNamespaceState(ctx context.Context, wp1 *types.WatchNamespaceStateRequest, p1 ...yarpc.CallOption) (w1 sharddistributor.WatchNamespaceStateClient, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
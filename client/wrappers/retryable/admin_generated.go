@@ -303,6 +303,17 @@ func (c *adminClient) RestoreDynamicConfig(ctx context.Context, rp1 *types.Resto
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *adminClient) RollbackDomain(ctx context.Context, rp1 *types.RollbackDomainRequest, p1 ...yarpc.CallOption) (rp2 *types.RollbackDomainResponse, err error) {
+	var resp *types.RollbackDomainResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RollbackDomain(ctx, rp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	var resp *types.UpdateDomainAsyncWorkflowConfiguratonResponse
 	op := func(ctx context.Context) error {
@@ -353,3 +364,10 @@ func (c *adminClient) UpdateTaskListPartitionConfig(ctx context.Context, request
 	err = c.throttleRetry.Do(ctx, op)
 	return resp, err
 }
+
+func (c *adminClient) UpdateVirtualQueue(ctx context.Context, up1 *types.UpdateVirtualQueueRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.UpdateVirtualQueue(ctx, up1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
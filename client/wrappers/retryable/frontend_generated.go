@@ -221,6 +221,17 @@ func (c *frontendClient) ListClosedWorkflowExecutions(ctx context.Context, lp1 *
 	return resp, err
 }
 
+func (c *frontendClient) ListDomainChanges(ctx context.Context, lp1 *types.ListDomainChangesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDomainChangesResponse, err error) {
+	var resp *types.ListDomainChangesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListDomainChanges(ctx, lp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) ListDomains(ctx context.Context, lp1 *types.ListDomainsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDomainsResponse, err error) {
 	var resp *types.ListDomainsResponse
 	op := func(ctx context.Context) error {
@@ -31,6 +31,17 @@ func NewShardDistributorClient(client sharddistributor.Client, policy backoff.Re
 	}
 }
 
+func (c *sharddistributorClient) DescribeShard(ctx context.Context, gp1 *types.DescribeShardRequest, p1 ...yarpc.CallOption) (gp2 *types.DescribeShardResponse, err error) {
+	var resp *types.DescribeShardResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeShard(ctx, gp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *sharddistributorClient) GetShardOwner(ctx context.Context, gp1 *types.GetShardOwnerRequest, p1 ...yarpc.CallOption) (gp2 *types.GetShardOwnerResponse, err error) {
 	var resp *types.GetShardOwnerResponse
 	op := func(ctx context.Context) error {
@@ -42,6 +53,50 @@ func (c *sharddistributorClient) GetShardOwner(ctx context.Context, gp1 *types.G
 	return resp, err
 }
 
+func (c *sharddistributorClient) ListExecutors(ctx context.Context, gp1 *types.ListExecutorsRequest, p1 ...yarpc.CallOption) (gp2 *types.ListExecutorsResponse, err error) {
+	var resp *types.ListExecutorsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListExecutors(ctx, gp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *sharddistributorClient) ListNamespaces(ctx context.Context, gp1 *types.ListNamespacesRequest, p1 ...yarpc.CallOption) (gp2 *types.ListNamespacesResponse, err error) {
+	var resp *types.ListNamespacesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListNamespaces(ctx, gp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *sharddistributorClient) MoveShard(ctx context.Context, gp1 *types.MoveShardRequest, p1 ...yarpc.CallOption) (gp2 *types.MoveShardResponse, err error) {
+	var resp *types.MoveShardResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MoveShard(ctx, gp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *sharddistributorClient) TriggerRebalance(ctx context.Context, gp1 *types.TriggerRebalanceRequest, p1 ...yarpc.CallOption) (gp2 *types.TriggerRebalanceResponse, err error) {
+	var resp *types.TriggerRebalanceResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.TriggerRebalance(ctx, gp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *sharddistributorClient) WatchNamespaceState(ctx context.Context, wp1 *types.WatchNamespaceStateRequest, p1 ...yarpc.CallOption) (w1 sharddistributor.WatchNamespaceStateClient, err error) {
 	var resp sharddistributor.WatchNamespaceStateClient
 	op := func(ctx context.Context) error {
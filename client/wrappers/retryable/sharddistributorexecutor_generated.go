@@ -31,6 +31,17 @@ func NewShardDistributorExecutorClient(client sharddistributorexecutor.Client, p
 	}
 }
 
+func (c *sharddistributorexecutorClient) Drain(ctx context.Context, ep1 *types.ExecutorDrainRequest, p1 ...yarpc.CallOption) (ep2 *types.ExecutorDrainResponse, err error) {
+	var resp *types.ExecutorDrainResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.Drain(ctx, ep1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *sharddistributorexecutorClient) Heartbeat(ctx context.Context, ep1 *types.ExecutorHeartbeatRequest, p1 ...yarpc.CallOption) (ep2 *types.ExecutorHeartbeatResponse, err error) {
 	var resp *types.ExecutorHeartbeatResponse
 	op := func(ctx context.Context) error {
@@ -152,6 +152,11 @@ func (g adminClient) RestoreDynamicConfig(ctx context.Context, rp1 *types.Restor
 	return thrift.ToError(err)
 }
 
+func (g adminClient) RollbackDomain(ctx context.Context, rp1 *types.RollbackDomainRequest, p1 ...yarpc.CallOption) (rp2 *types.RollbackDomainResponse, err error) {
+	response, err := g.c.RollbackDomain(ctx, thrift.FromAdminRollbackDomainRequest(rp1), p1...)
+	return thrift.ToAdminRollbackDomainResponse(response), thrift.ToError(err)
+}
+
 func (g adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	response, err := g.c.UpdateDomainAsyncWorkflowConfiguraton(ctx, thrift.FromAdminUpdateDomainAsyncWorkflowConfiguratonRequest(request), opts...)
 	return thrift.ToAdminUpdateDomainAsyncWorkflowConfiguratonResponse(response), thrift.ToError(err)
@@ -175,3 +180,8 @@ func (g adminClient) UpdateGlobalIsolationGroups(ctx context.Context, request *t
 func (g adminClient) UpdateTaskListPartitionConfig(ctx context.Context, request *types.UpdateTaskListPartitionConfigRequest, opts ...yarpc.CallOption) (up1 *types.UpdateTaskListPartitionConfigResponse, err error) {
 	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
 }
+
+func (g adminClient) UpdateVirtualQueue(ctx context.Context, up1 *types.UpdateVirtualQueueRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.UpdateVirtualQueue(ctx, thrift.FromAdminUpdateVirtualQueueRequest(up1), p1...)
+	return thrift.ToError(err)
+}
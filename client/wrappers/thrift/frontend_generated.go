@@ -99,6 +99,11 @@ func (g frontendClient) ListClosedWorkflowExecutions(ctx context.Context, lp1 *t
 	return thrift.ToListClosedWorkflowExecutionsResponse(response), thrift.ToError(err)
 }
 
+func (g frontendClient) ListDomainChanges(ctx context.Context, lp1 *types.ListDomainChangesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDomainChangesResponse, err error) {
+	response, err := g.c.ListDomainChanges(ctx, thrift.FromListDomainChangesRequest(lp1), p1...)
+	return thrift.ToListDomainChangesResponse(response), thrift.ToError(err)
+}
+
 func (g frontendClient) ListDomains(ctx context.Context, lp1 *types.ListDomainsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDomainsResponse, err error) {
 	response, err := g.c.ListDomains(ctx, thrift.FromListDomainsRequest(lp1), p1...)
 	return thrift.ToListDomainsResponse(response), thrift.ToError(err)
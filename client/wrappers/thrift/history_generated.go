@@ -231,3 +231,8 @@ func (g historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *type
 	err = g.c.TerminateWorkflowExecution(ctx, thrift.FromHistoryTerminateWorkflowExecutionRequest(hp1), p1...)
 	return thrift.ToError(err)
 }
+
+func (g historyClient) UpdateVirtualQueue(ctx context.Context, up1 *types.UpdateVirtualQueueRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.UpdateVirtualQueue(ctx, thrift.FromHistoryUpdateVirtualQueueRequest(up1), p1...)
+	return thrift.ToError(err)
+}
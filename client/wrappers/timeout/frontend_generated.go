@@ -144,6 +144,12 @@ func (c *frontendClient) ListClosedWorkflowExecutions(ctx context.Context, lp1 *
 	return c.client.ListClosedWorkflowExecutions(ctx, lp1, p1...)
 }
 
+func (c *frontendClient) ListDomainChanges(ctx context.Context, lp1 *types.ListDomainChangesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDomainChangesResponse, err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.ListDomainChanges(ctx, lp1, p1...)
+}
+
 func (c *frontendClient) ListDomains(ctx context.Context, lp1 *types.ListDomainsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDomainsResponse, err error) {
 	ctx, cancel := createContext(ctx, c.timeout)
 	defer cancel()
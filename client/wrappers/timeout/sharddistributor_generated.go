@@ -33,12 +33,42 @@ func NewShardDistributorClient(
 	}
 }
 
+func (c *sharddistributorClient) DescribeShard(ctx context.Context, gp1 *types.DescribeShardRequest, p1 ...yarpc.CallOption) (gp2 *types.DescribeShardResponse, err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.DescribeShard(ctx, gp1, p1...)
+}
+
 func (c *sharddistributorClient) GetShardOwner(ctx context.Context, gp1 *types.GetShardOwnerRequest, p1 ...yarpc.CallOption) (gp2 *types.GetShardOwnerResponse, err error) {
 	ctx, cancel := createContext(ctx, c.timeout)
 	defer cancel()
 	return c.client.GetShardOwner(ctx, gp1, p1...)
 }
 
+func (c *sharddistributorClient) ListExecutors(ctx context.Context, gp1 *types.ListExecutorsRequest, p1 ...yarpc.CallOption) (gp2 *types.ListExecutorsResponse, err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.ListExecutors(ctx, gp1, p1...)
+}
+
+func (c *sharddistributorClient) ListNamespaces(ctx context.Context, gp1 *types.ListNamespacesRequest, p1 ...yarpc.CallOption) (gp2 *types.ListNamespacesResponse, err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.ListNamespaces(ctx, gp1, p1...)
+}
+
+func (c *sharddistributorClient) MoveShard(ctx context.Context, gp1 *types.MoveShardRequest, p1 ...yarpc.CallOption) (gp2 *types.MoveShardResponse, err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.MoveShard(ctx, gp1, p1...)
+}
+
+func (c *sharddistributorClient) TriggerRebalance(ctx context.Context, gp1 *types.TriggerRebalanceRequest, p1 ...yarpc.CallOption) (gp2 *types.TriggerRebalanceResponse, err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.TriggerRebalance(ctx, gp1, p1...)
+}
+
 func (c *sharddistributorClient) WatchNamespaceState(ctx context.Context, wp1 *types.WatchNamespaceStateRequest, p1 ...yarpc.CallOption) (w1 sharddistributor.WatchNamespaceStateClient, err error) {
 	return c.client.WatchNamespaceState(ctx, wp1, p1...)
 }
@@ -33,6 +33,12 @@ func NewShardDistributorExecutorClient(
 	}
 }
 
+func (c *sharddistributorexecutorClient) Drain(ctx context.Context, ep1 *types.ExecutorDrainRequest, p1 ...yarpc.CallOption) (ep2 *types.ExecutorDrainResponse, err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.Drain(ctx, ep1, p1...)
+}
+
 func (c *sharddistributorexecutorClient) Heartbeat(ctx context.Context, ep1 *types.ExecutorHeartbeatRequest, p1 ...yarpc.CallOption) (ep2 *types.ExecutorHeartbeatResponse, err error) {
 	ctx, cancel := createContext(ctx, c.timeout)
 	defer cancel()
@@ -45,7 +45,7 @@ import (
 	"github.com/uber/cadence/common/service"
 	shardDistributorCfg "github.com/uber/cadence/service/sharddistributor/config"
 	"github.com/uber/cadence/service/sharddistributor/sharddistributorfx"
-	"github.com/uber/cadence/service/sharddistributor/store/etcd"
+	"github.com/uber/cadence/service/sharddistributor/store/storefx"
 	"github.com/uber/cadence/tools/cassandra"
 	"github.com/uber/cadence/tools/sql"
 )
@@ -74,7 +74,7 @@ func Module(serviceName string) fx.Option {
 				return z.With(zap.String("service", service.ShardDistributor)), l.WithTags(tag.Service(service.ShardDistributor))
 			}),
 
-			etcd.Module,
+			storefx.Module,
 
 			rpcfx.Module,
 			sharddistributorfx.Module)
@@ -35,8 +35,6 @@ import (
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/testflags"
-
-	_ "github.com/uber/cadence/service/sharddistributor/store/etcd" // needed for shard distributor storage
 )
 
 func TestFxDependencies(t *testing.T) {
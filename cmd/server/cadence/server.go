@@ -43,7 +43,9 @@ import (
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/provider"
 	"github.com/uber/cadence/common/asyncworkflow/queue"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/blobstore/s3store"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
@@ -69,6 +71,10 @@ import (
 	"github.com/uber/cadence/service/worker"
 	diagnosticsInvariant "github.com/uber/cadence/service/worker/diagnostics/invariant"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/failure"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/heartbeat"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/limits"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/nondeterminism"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/pollers"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/retry"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/timeout"
 )
@@ -284,9 +290,9 @@ func (s *server) startService() common.Daemon {
 	params.PersistenceConfig.TransactionSizeLimit = dc.GetIntProperty(dynamicproperties.TransactionSizeLimit)
 	params.PersistenceConfig.ErrorInjectionRate = dc.GetFloat64Property(dynamicproperties.PersistenceErrorInjectionRate)
 	params.AuthorizationConfig = s.cfg.Authorization
-	params.BlobstoreClient, err = filestore.NewFilestoreClient(s.cfg.Blobstore.Filestore)
+	params.BlobstoreClient, err = newBlobstoreClient(s.cfg.Blobstore)
 	if err != nil {
-		s.logger.Warn("failed to create file blobstore client, will continue startup without it: %v", tag.Error(err))
+		s.logger.Warn("failed to create blobstore client, will continue startup without it: %v", tag.Error(err))
 		params.BlobstoreClient = nil
 	}
 
@@ -296,7 +302,22 @@ func (s *server) startService() common.Daemon {
 	}
 
 	params.KafkaConfig = s.cfg.Kafka
-	params.DiagnosticsInvariants = []diagnosticsInvariant.Invariant{timeout.NewInvariant(timeout.Params{Client: params.PublicClient}), failure.NewInvariant(), retry.NewInvariant()}
+	params.DiagnosticsInvariants = []diagnosticsInvariant.Invariant{
+		timeout.NewInvariant(timeout.Params{Client: params.PublicClient}),
+		failure.NewInvariant(),
+		retry.NewInvariant(),
+		pollers.NewInvariant(pollers.Params{Client: params.PublicClient, TimeSource: clock.NewRealTimeSource()}),
+		nondeterminism.NewInvariant(),
+		limits.NewInvariant(limits.Params{
+			HistoryCountLimitWarn:  dc.GetIntPropertyFilteredByDomain(dynamicproperties.HistoryCountLimitWarn),
+			HistoryCountLimitError: dc.GetIntPropertyFilteredByDomain(dynamicproperties.HistoryCountLimitError),
+			HistorySizeLimitWarn:   dc.GetIntPropertyFilteredByDomain(dynamicproperties.HistorySizeLimitWarn),
+			HistorySizeLimitError:  dc.GetIntPropertyFilteredByDomain(dynamicproperties.HistorySizeLimitError),
+			BlobSizeLimitWarn:      dc.GetIntPropertyFilteredByDomain(dynamicproperties.BlobSizeLimitWarn),
+			BlobSizeLimitError:     dc.GetIntPropertyFilteredByDomain(dynamicproperties.BlobSizeLimitError),
+		}),
+		heartbeat.NewInvariant(heartbeat.Params{TimeSource: clock.NewRealTimeSource()}),
+	}
 	params.ShardDistributorMatchingConfig = s.cfg.ShardDistributorMatchingConfig
 
 	params.Logger.Info("Starting service " + s.name)
@@ -378,6 +399,19 @@ func (*server) createShardDistributorClient(
 	return shardDistributorClient
 }
 
+// newBlobstoreClient creates the blobstore client of the configured backend.
+// Remote object storages are wrapped to retry transient errors.
+func newBlobstoreClient(cfg config.Blobstore) (blobstore.Client, error) {
+	if cfg.S3 != nil {
+		client, err := s3store.NewS3Client(cfg.S3)
+		if err != nil {
+			return nil, err
+		}
+		return blobstore.NewRetryableClient(client, common.CreateBlobstoreRetryPolicy()), nil
+	}
+	return filestore.NewFilestoreClient(cfg.Filestore)
+}
+
 // execute runs the daemon in a separate go routine
 func execute(d common.Daemon, doneC chan struct{}) {
 	d.Start()
@@ -171,6 +171,12 @@ func (c *DefaultConsumer) processMessage(msg messaging.Message) {
 
 	logTags, err := c.processRequest(logger, &request)
 	if err != nil {
+		if c.ctx.Err() != nil {
+			// the request was interrupted by shutdown rather than rejected so the message is neither acked nor nacked.
+			// it will be redelivered by the queue instead of ending up in the DLQ.
+			logger.Warn("Consumer stopped before message was processed, leaving it for redelivery", append(logTags, tag.Error(err))...)
+			return
+		}
 		logger.Error("Failed to process message", append(logTags, tag.Error(err))...)
 		if nackErr := msg.Nack(); nackErr != nil {
 			logger.Error("Failed to nack message", append(logTags, tag.Dynamic("original-error", err.Error()), tag.Error(nackErr))...)
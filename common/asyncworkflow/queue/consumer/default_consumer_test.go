@@ -93,6 +93,8 @@ type fakeMessage struct {
 	// input
 	val     []byte
 	wantAck bool
+	// wantRedelivery is set for messages which are interrupted by shutdown and left for redelivery
+	wantRedelivery bool
 
 	// output
 	acked  bool
@@ -156,12 +158,20 @@ func TestDefaultConsumer(t *testing.T) {
 		},
 		{
 			name:        "startworkflowfrontend error",
-			frontendErr: &types.InternalServiceError{Message: "oh no"},
+			frontendErr: &types.BadRequestError{Message: "oh no"},
 			msgs: []*fakeMessage{
 				{val: mustGenerateStartWorkflowExecutionRequestMsg(t, constants.EncodingTypeThriftRW, true), wantAck: false},
 			},
 			expectStartRequest: true,
 		},
+		{
+			name:        "startworkflowfrontend transient error interrupted by stop",
+			frontendErr: &types.InternalServiceError{Message: "oh no"},
+			msgs: []*fakeMessage{
+				{val: mustGenerateStartWorkflowExecutionRequestMsg(t, constants.EncodingTypeThriftRW, true), wantRedelivery: true},
+			},
+			expectStartRequest: true,
+		},
 		{
 			name:        "startworkflowfrontend WorkflowExecutionAlreadyStartedError",
 			frontendErr: &types.WorkflowExecutionAlreadyStartedError{Message: "all good, already started"},
@@ -200,12 +210,20 @@ func TestDefaultConsumer(t *testing.T) {
 		},
 		{
 			name:        "signalwithstartworkflow frontend error",
-			frontendErr: &types.InternalServiceError{Message: "oh no"},
+			frontendErr: &types.BadRequestError{Message: "oh no"},
 			msgs: []*fakeMessage{
 				{val: mustGenerateSignalWithStartWorkflowExecutionRequestMsg(t, constants.EncodingTypeThriftRW, true), wantAck: false},
 			},
 			expectSignalWithStartRequest: true,
 		},
+		{
+			name:        "signalwithstartworkflow frontend transient error interrupted by stop",
+			frontendErr: &types.InternalServiceError{Message: "oh no"},
+			msgs: []*fakeMessage{
+				{val: mustGenerateSignalWithStartWorkflowExecutionRequestMsg(t, constants.EncodingTypeThriftRW, true), wantRedelivery: true},
+			},
+			expectSignalWithStartRequest: true,
+		},
 		{
 			name:        "signalwithstartworkflow WorkflowExecutionAlreadyStartedError error",
 			frontendErr: &types.WorkflowExecutionAlreadyStartedError{Message: "All good"},
@@ -289,6 +307,12 @@ func TestDefaultConsumer(t *testing.T) {
 			}
 
 			for i, msg := range tc.msgs {
+				if msg.wantRedelivery {
+					if msg.acked || msg.nacked {
+						t.Errorf("message %d should be left for redelivery, acked: %v, nacked: %v", i, msg.acked, msg.nacked)
+					}
+					continue
+				}
 				if msg.wantAck && !msg.acked {
 					t.Errorf("message %d not acked", i)
 				}
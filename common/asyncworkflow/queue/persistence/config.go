@@ -0,0 +1,49 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	queueConfig struct {
+		// QueueID identifies the queue, queues with different IDs are stored separately
+		QueueID int `yaml:"queueID" json:"queueID"`
+	}
+)
+
+func (c *queueConfig) ID() string {
+	return fmt.Sprintf("persistence::%d", c.QueueID)
+}
+
+func (c *queueConfig) validate() error {
+	if c.QueueID < 0 || c.QueueID >= p.MaxAsyncWorkflowQueues {
+		return fmt.Errorf("queueID must be in [0, %d), got %d", p.MaxAsyncWorkflowQueues, c.QueueID)
+	}
+	return nil
+}
+
+func (c *queueConfig) queueType() p.QueueType {
+	return p.AsyncWorkflowQueueTypeStart + p.QueueType(c.QueueID)
+}
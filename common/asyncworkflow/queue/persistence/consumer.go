@@ -0,0 +1,320 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/messaging"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+)
+
+const (
+	// consumerName is the key under which the consumer offset is stored in the queue ack levels
+	consumerName = "async-workflow-consumer"
+
+	defaultPollInterval   = time.Second
+	defaultAckInterval    = 5 * time.Second
+	defaultRequestTimeout = 5 * time.Second
+	defaultBatchSize      = 100
+	defaultMaxOutstanding = 1000
+)
+
+type (
+	// consumerImpl reads messages from a persistence queue and tracks the consumer offset in the queue ack levels.
+	// Only the worker host owning the queue consumes it, ownership is decided by the membership ring.
+	consumerImpl struct {
+		queueID            string
+		queueManager       p.QueueManager
+		membershipResolver membership.Resolver
+		logger             log.Logger
+		timeSource         clock.TimeSource
+
+		pollInterval   time.Duration
+		ackInterval    time.Duration
+		batchSize      int
+		maxOutstanding int64
+
+		status   int32
+		msgChan  chan messaging.Message
+		ctx      context.Context
+		cancelFn context.CancelFunc
+		wg       sync.WaitGroup
+
+		// the fields below are only accessed by the poll loop, or by Stop after the loop has exited
+		owned             bool
+		ackManager        messaging.AckManager
+		persistedAckLevel int64
+	}
+
+	message struct {
+		id           int64
+		payload      []byte
+		ackManager   messaging.AckManager
+		queueManager p.QueueManager
+	}
+)
+
+var _ messaging.Consumer = (*consumerImpl)(nil)
+var _ messaging.Message = (*message)(nil)
+
+func newConsumer(
+	queueID string,
+	queueManager p.QueueManager,
+	membershipResolver membership.Resolver,
+	logger log.Logger,
+) *consumerImpl {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &consumerImpl{
+		queueID:            queueID,
+		queueManager:       queueManager,
+		membershipResolver: membershipResolver,
+		logger:             logger.WithTags(tag.AsyncWFQueueID(queueID)),
+		timeSource:         clock.NewRealTimeSource(),
+		pollInterval:       defaultPollInterval,
+		ackInterval:        defaultAckInterval,
+		batchSize:          defaultBatchSize,
+		maxOutstanding:     defaultMaxOutstanding,
+		status:             common.DaemonStatusInitialized,
+		msgChan:            make(chan messaging.Message, defaultBatchSize),
+		ctx:                ctx,
+		cancelFn:           cancel,
+	}
+}
+
+func (c *consumerImpl) Start() error {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return nil
+	}
+
+	c.wg.Add(1)
+	go c.run()
+	c.logger.Info("Persistence queue consumer started")
+	return nil
+}
+
+func (c *consumerImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	c.cancelFn()
+	c.wg.Wait()
+	// messages acked after the last tick are committed here so they are not redelivered after restart
+	c.commitAckLevel()
+	close(c.msgChan)
+	c.logger.Info("Persistence queue consumer stopped")
+}
+
+func (c *consumerImpl) Messages() <-chan messaging.Message {
+	return c.msgChan
+}
+
+func (c *consumerImpl) run() {
+	defer c.wg.Done()
+
+	pollTimer := c.timeSource.NewTimer(0)
+	defer pollTimer.Stop()
+	ackTicker := c.timeSource.NewTicker(c.ackInterval)
+	defer ackTicker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ackTicker.Chan():
+			c.commitAckLevel()
+		case <-pollTimer.Chan():
+			if c.poll() {
+				pollTimer.Reset(0)
+			} else {
+				pollTimer.Reset(c.pollInterval)
+			}
+		}
+	}
+}
+
+// poll reads the next batch of messages and returns true if there may be more messages to read right away
+func (c *consumerImpl) poll() bool {
+	if !c.checkOwnership() {
+		return false
+	}
+	if c.ackManager.GetBacklogCount() >= c.maxOutstanding {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(c.ctx, defaultRequestTimeout)
+	defer cancel()
+	resp, err := c.queueManager.ReadMessages(ctx, &p.ReadMessagesRequest{
+		LastMessageID: c.ackManager.GetReadLevel(),
+		MaxCount:      c.batchSize,
+	})
+	if err != nil {
+		if c.ctx.Err() == nil {
+			c.logger.Warn("Failed to read messages from persistence queue", tag.Error(err))
+		}
+		return false
+	}
+
+	for _, msg := range resp.Messages {
+		if err := c.ackManager.ReadItem(msg.ID); err != nil {
+			c.logger.Error("Failed to track message read from persistence queue", tag.TaskID(msg.ID), tag.Error(err))
+			continue
+		}
+		select {
+		case c.msgChan <- &message{
+			id:           msg.ID,
+			payload:      msg.Payload,
+			ackManager:   c.ackManager,
+			queueManager: c.queueManager,
+		}:
+		case <-c.ctx.Done():
+			return false
+		}
+	}
+	return len(resp.Messages) == c.batchSize
+}
+
+// checkOwnership returns true if this host owns the queue. The consumer offset is reloaded whenever the ownership
+// is acquired as another host may have consumed the queue in the meantime.
+func (c *consumerImpl) checkOwnership() bool {
+	owned, err := c.isOwner()
+	if err != nil {
+		c.logger.Warn("Failed to resolve persistence queue owner", tag.Error(err))
+		owned = false
+	}
+
+	switch {
+	case owned && !c.owned:
+		if err := c.loadAckLevel(); err != nil {
+			c.logger.Warn("Failed to load persistence queue ack level", tag.Error(err))
+			return false
+		}
+		c.logger.Info("Acquired persistence queue ownership", tag.AckLevel(c.persistedAckLevel))
+	case !owned && c.owned:
+		c.commitAckLevel()
+		c.logger.Info("Lost persistence queue ownership")
+	}
+	c.owned = owned
+	return owned
+}
+
+func (c *consumerImpl) isOwner() (bool, error) {
+	if c.membershipResolver == nil {
+		return true, nil
+	}
+	owner, err := c.membershipResolver.Lookup(service.Worker, c.queueID)
+	if err != nil {
+		return false, err
+	}
+	self, err := c.membershipResolver.WhoAmI()
+	if err != nil {
+		return false, err
+	}
+	return owner.Identity() == self.Identity(), nil
+}
+
+func (c *consumerImpl) loadAckLevel() error {
+	ctx, cancel := context.WithTimeout(c.ctx, defaultRequestTimeout)
+	defer cancel()
+	resp, err := c.queueManager.GetAckLevels(ctx, &p.GetAckLevelsRequest{})
+	if err != nil {
+		return err
+	}
+
+	ackManager := messaging.NewAckManager(c.logger)
+	if ackLevel, ok := resp.AckLevels[consumerName]; ok {
+		ackManager.SetAckLevel(ackLevel)
+	}
+	c.ackManager = ackManager
+	c.persistedAckLevel = ackManager.GetAckLevel()
+	return nil
+}
+
+// commitAckLevel persists the consumer offset and deletes the consumed messages
+func (c *consumerImpl) commitAckLevel() {
+	if !c.owned || c.ackManager == nil {
+		return
+	}
+	ackLevel := c.ackManager.GetAckLevel()
+	if ackLevel <= c.persistedAckLevel {
+		return
+	}
+
+	// the consumer context may already be cancelled when the last offset is committed during Stop
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
+	defer cancel()
+	if err := c.queueManager.UpdateAckLevel(ctx, &p.UpdateAckLevelRequest{
+		MessageID:   ackLevel,
+		ClusterName: consumerName,
+	}); err != nil {
+		c.logger.Warn("Failed to update persistence queue ack level", tag.AckLevel(ackLevel), tag.Error(err))
+		return
+	}
+	c.persistedAckLevel = ackLevel
+
+	// the message at the ack level is kept, message IDs would be reused if the queue became empty
+	if err := c.queueManager.DeleteMessagesBefore(ctx, &p.DeleteMessagesBeforeRequest{
+		MessageID: ackLevel,
+	}); err != nil {
+		c.logger.Warn("Failed to delete consumed persistence queue messages", tag.AckLevel(ackLevel), tag.Error(err))
+	}
+}
+
+func (m *message) Value() []byte {
+	return m.payload
+}
+
+func (m *message) Partition() int32 {
+	return 0
+}
+
+func (m *message) Offset() int64 {
+	return m.id
+}
+
+func (m *message) Ack() error {
+	m.ackManager.AckItem(m.id)
+	return nil
+}
+
+// Nack moves the message to the DLQ of the queue. The message is left unacked if that fails so it will be
+// redelivered once the queue is reloaded.
+func (m *message) Nack() error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
+	defer cancel()
+	if err := m.queueManager.EnqueueMessageToDLQ(ctx, &p.EnqueueMessageToDLQRequest{
+		MessagePayload: m.payload,
+	}); err != nil {
+		return err
+	}
+	m.ackManager.AckItem(m.id)
+	return nil
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/messaging"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+)
+
+const testQueueID = "persistence::0"
+
+func TestConsumerPollAndCommit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockQueueManager := p.NewMockQueueManager(ctrl)
+	c := newConsumer(testQueueID, mockQueueManager, nil, testlogger.New(t))
+
+	mockQueueManager.EXPECT().GetAckLevels(gomock.Any(), gomock.Any()).
+		Return(&p.GetAckLevelsResponse{AckLevels: map[string]int64{consumerName: 10}}, nil)
+	mockQueueManager.EXPECT().ReadMessages(gomock.Any(), &p.ReadMessagesRequest{LastMessageID: 10, MaxCount: defaultBatchSize}).
+		Return(&p.ReadMessagesResponse{Messages: p.QueueMessageList{
+			{ID: 11, Payload: []byte("msg-11")},
+			{ID: 12, Payload: []byte("msg-12")},
+		}}, nil)
+
+	assert.False(t, c.poll(), "a partial batch means there is nothing more to read")
+	msg1 := <-c.Messages()
+	msg2 := <-c.Messages()
+	assert.Equal(t, int64(11), msg1.Offset())
+	assert.Equal(t, []byte("msg-11"), msg1.Value())
+	assert.Equal(t, int64(12), msg2.Offset())
+
+	// nothing is committed until the first message is acked
+	c.commitAckLevel()
+
+	require.NoError(t, msg1.Ack())
+	mockQueueManager.EXPECT().UpdateAckLevel(gomock.Any(), &p.UpdateAckLevelRequest{MessageID: 11, ClusterName: consumerName}).Return(nil)
+	mockQueueManager.EXPECT().DeleteMessagesBefore(gomock.Any(), &p.DeleteMessagesBeforeRequest{MessageID: 11}).Return(nil)
+	c.commitAckLevel()
+
+	// committing again without progress is a no-op
+	c.commitAckLevel()
+
+	// a failed update is retried on the next commit
+	require.NoError(t, msg2.Ack())
+	mockQueueManager.EXPECT().UpdateAckLevel(gomock.Any(), &p.UpdateAckLevelRequest{MessageID: 12, ClusterName: consumerName}).Return(errors.New("oops"))
+	c.commitAckLevel()
+	mockQueueManager.EXPECT().UpdateAckLevel(gomock.Any(), &p.UpdateAckLevelRequest{MessageID: 12, ClusterName: consumerName}).Return(nil)
+	mockQueueManager.EXPECT().DeleteMessagesBefore(gomock.Any(), &p.DeleteMessagesBeforeRequest{MessageID: 12}).Return(nil)
+	c.commitAckLevel()
+}
+
+func TestConsumerPollBacklogLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockQueueManager := p.NewMockQueueManager(ctrl)
+	c := newConsumer(testQueueID, mockQueueManager, nil, testlogger.New(t))
+	c.maxOutstanding = 1
+
+	mockQueueManager.EXPECT().GetAckLevels(gomock.Any(), gomock.Any()).Return(&p.GetAckLevelsResponse{}, nil)
+	mockQueueManager.EXPECT().ReadMessages(gomock.Any(), &p.ReadMessagesRequest{LastMessageID: -1, MaxCount: defaultBatchSize}).
+		Return(&p.ReadMessagesResponse{Messages: p.QueueMessageList{{ID: 0}}}, nil)
+
+	assert.False(t, c.poll())
+	// the outstanding message blocks further reads
+	assert.False(t, c.poll())
+	require.NoError(t, (<-c.Messages()).Ack())
+
+	mockQueueManager.EXPECT().ReadMessages(gomock.Any(), &p.ReadMessagesRequest{LastMessageID: 0, MaxCount: defaultBatchSize}).
+		Return(&p.ReadMessagesResponse{}, nil)
+	assert.False(t, c.poll())
+}
+
+func TestConsumerOwnership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockQueueManager := p.NewMockQueueManager(ctrl)
+	mockResolver := membership.NewMockResolver(ctrl)
+	self := membership.NewHostInfo("self:1234")
+	other := membership.NewHostInfo("other:1234")
+	c := newConsumer(testQueueID, mockQueueManager, mockResolver, testlogger.New(t))
+	mockResolver.EXPECT().WhoAmI().Return(self, nil).AnyTimes()
+
+	// not the owner so nothing is read
+	mockResolver.EXPECT().Lookup(service.Worker, testQueueID).Return(other, nil)
+	assert.False(t, c.poll())
+
+	// ownership acquired, the ack level is loaded before reading
+	mockResolver.EXPECT().Lookup(service.Worker, testQueueID).Return(self, nil)
+	mockQueueManager.EXPECT().GetAckLevels(gomock.Any(), gomock.Any()).
+		Return(&p.GetAckLevelsResponse{AckLevels: map[string]int64{consumerName: 4}}, nil)
+	mockQueueManager.EXPECT().ReadMessages(gomock.Any(), &p.ReadMessagesRequest{LastMessageID: 4, MaxCount: defaultBatchSize}).
+		Return(&p.ReadMessagesResponse{Messages: p.QueueMessageList{{ID: 5}}}, nil)
+	assert.False(t, c.poll())
+	msg := <-c.Messages()
+	require.NoError(t, msg.Ack())
+
+	// ownership lost, the progress is committed
+	mockResolver.EXPECT().Lookup(service.Worker, testQueueID).Return(other, nil)
+	mockQueueManager.EXPECT().UpdateAckLevel(gomock.Any(), &p.UpdateAckLevelRequest{MessageID: 5, ClusterName: consumerName}).Return(nil)
+	mockQueueManager.EXPECT().DeleteMessagesBefore(gomock.Any(), &p.DeleteMessagesBeforeRequest{MessageID: 5}).Return(nil)
+	assert.False(t, c.poll())
+
+	// lookup failures are treated as not owning the queue
+	mockResolver.EXPECT().Lookup(service.Worker, testQueueID).Return(membership.HostInfo{}, errors.New("oops"))
+	assert.False(t, c.poll())
+
+	// ownership acquired again, the ack level is reloaded as another host may have consumed the queue
+	mockResolver.EXPECT().Lookup(service.Worker, testQueueID).Return(self, nil)
+	mockQueueManager.EXPECT().GetAckLevels(gomock.Any(), gomock.Any()).
+		Return(&p.GetAckLevelsResponse{AckLevels: map[string]int64{consumerName: 20}}, nil)
+	mockQueueManager.EXPECT().ReadMessages(gomock.Any(), &p.ReadMessagesRequest{LastMessageID: 20, MaxCount: defaultBatchSize}).
+		Return(&p.ReadMessagesResponse{}, nil)
+	assert.False(t, c.poll())
+}
+
+func TestMessageNack(t *testing.T) {
+	tests := []struct {
+		name      string
+		dlqErr    error
+		wantAcked bool
+	}{
+		{
+			name:      "moved to DLQ",
+			wantAcked: true,
+		},
+		{
+			name:   "DLQ failure leaves the message unacked",
+			dlqErr: errors.New("oops"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQueueManager := p.NewMockQueueManager(gomock.NewController(t))
+			mockQueueManager.EXPECT().EnqueueMessageToDLQ(gomock.Any(), &p.EnqueueMessageToDLQRequest{MessagePayload: []byte("payload")}).Return(tt.dlqErr)
+			ackManager := messaging.NewAckManager(testlogger.New(t))
+			require.NoError(t, ackManager.ReadItem(1))
+
+			msg := &message{id: 1, payload: []byte("payload"), ackManager: ackManager, queueManager: mockQueueManager}
+			err := msg.Nack()
+			if tt.dlqErr != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantAcked {
+				assert.Equal(t, int64(1), ackManager.GetAckLevel())
+			} else {
+				assert.Equal(t, int64(0), ackManager.GetAckLevel())
+			}
+		})
+	}
+}
+
+func TestConsumerStartStop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockQueueManager := p.NewMockQueueManager(ctrl)
+	c := newConsumer(testQueueID, mockQueueManager, nil, testlogger.New(t))
+	c.pollInterval = time.Millisecond
+
+	mockQueueManager.EXPECT().GetAckLevels(gomock.Any(), gomock.Any()).Return(&p.GetAckLevelsResponse{}, nil)
+	mockQueueManager.EXPECT().ReadMessages(gomock.Any(), &p.ReadMessagesRequest{LastMessageID: -1, MaxCount: defaultBatchSize}).
+		Return(&p.ReadMessagesResponse{Messages: p.QueueMessageList{{ID: 0, Payload: []byte("msg")}}}, nil)
+	mockQueueManager.EXPECT().ReadMessages(gomock.Any(), &p.ReadMessagesRequest{LastMessageID: 0, MaxCount: defaultBatchSize}).
+		Return(&p.ReadMessagesResponse{}, nil).AnyTimes()
+
+	require.NoError(t, c.Start())
+	msg := <-c.Messages()
+	assert.Equal(t, []byte("msg"), msg.Value())
+	require.NoError(t, msg.Ack())
+
+	// the last ack is committed on stop
+	mockQueueManager.EXPECT().UpdateAckLevel(gomock.Any(), &p.UpdateAckLevelRequest{MessageID: 0, ClusterName: consumerName}).Return(nil)
+	mockQueueManager.EXPECT().DeleteMessagesBefore(gomock.Any(), &p.DeleteMessagesBeforeRequest{MessageID: 0}).Return(nil)
+	c.Stop()
+
+	_, ok := <-c.Messages()
+	assert.False(t, ok, "messages channel should be closed")
+}
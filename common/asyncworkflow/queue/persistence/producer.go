@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/.gen/go/sqlblobs"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/messaging"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	producerImpl struct {
+		queueManager p.QueueManager
+		msgEncoder   codec.BinaryEncoder
+	}
+)
+
+func newProducer(queueManager p.QueueManager) messaging.Producer {
+	return &producerImpl{
+		queueManager: queueManager,
+		msgEncoder:   codec.NewThriftRWEncoder(),
+	}
+}
+
+// Publish enqueues an async request message
+func (pr *producerImpl) Publish(ctx context.Context, msg interface{}) error {
+	message, ok := msg.(*sqlblobs.AsyncRequestMessage)
+	if !ok {
+		return fmt.Errorf("unsupported message type %T", msg)
+	}
+	payload, err := pr.msgEncoder.Encode(message)
+	if err != nil {
+		return err
+	}
+	return pr.queueManager.EnqueueMessage(ctx, &p.EnqueueMessageRequest{
+		MessagePayload: payload,
+	})
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/.gen/go/sqlblobs"
+	"github.com/uber/cadence/common/codec"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+func TestProducerPublish(t *testing.T) {
+	msg := &sqlblobs.AsyncRequestMessage{
+		Type:    sqlblobs.AsyncRequestTypeStartWorkflowExecutionAsyncRequest.Ptr(),
+		Payload: []byte("payload"),
+	}
+	encoded, err := codec.NewThriftRWEncoder().Encode(msg)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		msg        interface{}
+		setupMocks func(*p.MockQueueManager)
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			msg:  msg,
+			setupMocks: func(m *p.MockQueueManager) {
+				m.EXPECT().EnqueueMessage(gomock.Any(), &p.EnqueueMessageRequest{MessagePayload: encoded}).Return(nil)
+			},
+		},
+		{
+			name:       "unsupported message type",
+			msg:        "not a message",
+			setupMocks: func(m *p.MockQueueManager) {},
+			wantErr:    true,
+		},
+		{
+			name: "enqueue failure",
+			msg:  msg,
+			setupMocks: func(m *p.MockQueueManager) {
+				m.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).Return(errors.New("oops"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQueueManager := p.NewMockQueueManager(gomock.NewController(t))
+			tt.setupMocks(mockQueueManager)
+
+			err := newProducer(mockQueueManager).Publish(context.Background(), tt.msg)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/uber/cadence/common/asyncworkflow/queue/consumer"
+	"github.com/uber/cadence/common/asyncworkflow/queue/provider"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	queueImpl struct {
+		config *queueConfig
+	}
+)
+
+func newQueue(decoder provider.Decoder) (provider.Queue, error) {
+	var out queueConfig
+	if err := decoder.Decode(&out); err != nil {
+		return nil, fmt.Errorf("bad config: %w", err)
+	}
+	if err := out.validate(); err != nil {
+		return nil, fmt.Errorf("bad config: %w", err)
+	}
+	return &queueImpl{
+		config: &out,
+	}, nil
+}
+
+func (q *queueImpl) ID() string {
+	return q.config.ID()
+}
+
+func (q *queueImpl) CreateConsumer(params *provider.Params) (provider.Consumer, error) {
+	queueManager, err := q.queueManager(params)
+	if err != nil {
+		return nil, err
+	}
+	params.Logger.Info("Creating async wf consumer", tag.AsyncWFQueueID(q.ID()))
+	innerConsumer := newConsumer(q.ID(), queueManager, params.MembershipResolver, params.Logger)
+	return consumer.New(q.ID(), innerConsumer, params.Logger, params.MetricsClient, params.FrontendClient), nil
+}
+
+func (q *queueImpl) CreateProducer(params *provider.Params) (messaging.Producer, error) {
+	queueManager, err := q.queueManager(params)
+	if err != nil {
+		return nil, err
+	}
+	params.Logger.Info("Creating async wf producer", tag.AsyncWFQueueID(q.ID()))
+	return newProducer(queueManager), nil
+}
+
+func (q *queueImpl) queueManager(params *provider.Params) (p.QueueManager, error) {
+	if params.QueueManagerProvider == nil {
+		return nil, errors.New("queue manager provider is required by persistence queues")
+	}
+	queueManager, err := params.QueueManagerProvider.GetQueueManager(q.config.queueType())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create queue manager: %w", err)
+	}
+	return queueManager, nil
+}
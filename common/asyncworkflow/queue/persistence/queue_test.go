@@ -0,0 +1,168 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/asyncworkflow/queue/provider"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type mockDecoder struct {
+	decodeFunc func(v any) error
+}
+
+func (m *mockDecoder) Decode(v any) error {
+	return m.decodeFunc(v)
+}
+
+func TestNewQueue(t *testing.T) {
+	tests := []struct {
+		name    string
+		decoder *mockDecoder
+		want    *queueImpl
+		wantErr bool
+	}{
+		{
+			name: "successful decoding",
+			decoder: &mockDecoder{
+				decodeFunc: func(v any) error {
+					v.(*queueConfig).QueueID = 3
+					return nil
+				},
+			},
+			want: &queueImpl{config: &queueConfig{QueueID: 3}},
+		},
+		{
+			name: "decoding failure",
+			decoder: &mockDecoder{
+				decodeFunc: func(v any) error {
+					return errors.New("decoding error")
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative queue ID",
+			decoder: &mockDecoder{
+				decodeFunc: func(v any) error {
+					v.(*queueConfig).QueueID = -1
+					return nil
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "queue ID out of range",
+			decoder: &mockDecoder{
+				decodeFunc: func(v any) error {
+					v.(*queueConfig).QueueID = p.MaxAsyncWorkflowQueues
+					return nil
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newQueue(tt.decoder)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestQueueConfig(t *testing.T) {
+	c := &queueConfig{QueueID: 7}
+	assert.Equal(t, "persistence::7", c.ID())
+	assert.Equal(t, p.AsyncWorkflowQueueTypeStart+7, c.queueType())
+}
+
+func TestCreateConsumerAndProducer(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(*provider.MockQueueManagerProvider)
+		noProvider bool
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(m *provider.MockQueueManagerProvider) {
+				m.EXPECT().GetQueueManager(p.AsyncWorkflowQueueTypeStart+1).Return(p.NewMockQueueManager(gomock.NewController(t)), nil).Times(2)
+			},
+		},
+		{
+			name:       "no queue manager provider",
+			setupMocks: func(m *provider.MockQueueManagerProvider) {},
+			noProvider: true,
+			wantErr:    true,
+		},
+		{
+			name: "queue manager error",
+			setupMocks: func(m *provider.MockQueueManagerProvider) {
+				m.EXPECT().GetQueueManager(p.AsyncWorkflowQueueTypeStart+1).Return(nil, errors.New("oops")).Times(2)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockProvider := provider.NewMockQueueManagerProvider(gomock.NewController(t))
+			tt.setupMocks(mockProvider)
+			params := &provider.Params{
+				Logger:        testlogger.New(t),
+				MetricsClient: metrics.NewNoopMetricsClient(),
+			}
+			if !tt.noProvider {
+				params.QueueManagerProvider = mockProvider
+			}
+
+			q := &queueImpl{config: &queueConfig{QueueID: 1}}
+			consumer, err := q.CreateConsumer(params)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, consumer)
+			}
+			producer, err := q.CreateProducer(params)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, producer)
+			}
+		})
+	}
+}
@@ -15,8 +15,48 @@ import (
 	gomock "go.uber.org/mock/gomock"
 
 	messaging "github.com/uber/cadence/common/messaging"
+	persistence "github.com/uber/cadence/common/persistence"
 )
 
+// MockQueueManagerProvider is a mock of QueueManagerProvider interface.
+type MockQueueManagerProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockQueueManagerProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockQueueManagerProviderMockRecorder is the mock recorder for MockQueueManagerProvider.
+type MockQueueManagerProviderMockRecorder struct {
+	mock *MockQueueManagerProvider
+}
+
+// NewMockQueueManagerProvider creates a new mock instance.
+func NewMockQueueManagerProvider(ctrl *gomock.Controller) *MockQueueManagerProvider {
+	mock := &MockQueueManagerProvider{ctrl: ctrl}
+	mock.recorder = &MockQueueManagerProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQueueManagerProvider) EXPECT() *MockQueueManagerProviderMockRecorder {
+	return m.recorder
+}
+
+// GetQueueManager mocks base method.
+func (m *MockQueueManagerProvider) GetQueueManager(arg0 persistence.QueueType) (persistence.QueueManager, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueueManager", arg0)
+	ret0, _ := ret[0].(persistence.QueueManager)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueueManager indicates an expected call of GetQueueManager.
+func (mr *MockQueueManagerProviderMockRecorder) GetQueueManager(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueueManager", reflect.TypeOf((*MockQueueManagerProvider)(nil).GetQueueManager), arg0)
+}
+
 // MockDecoder is a mock of Decoder interface.
 type MockDecoder struct {
 	ctrl     *gomock.Controller
@@ -29,8 +29,10 @@ import (
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/syncmap"
 	"github.com/uber/cadence/common/types"
 )
@@ -40,6 +42,15 @@ type (
 		Logger         log.Logger
 		MetricsClient  metrics.Client
 		FrontendClient frontend.Client
+		// QueueManagerProvider is required by the queues stored in persistence
+		QueueManagerProvider QueueManagerProvider
+		// MembershipResolver is required by the consumers which must run on a single host
+		MembershipResolver membership.Resolver
+	}
+
+	// QueueManagerProvider returns the persistence queue manager of a given queue type
+	QueueManagerProvider interface {
+		GetQueueManager(persistence.QueueType) (persistence.QueueManager, error)
 	}
 
 	Decoder interface {
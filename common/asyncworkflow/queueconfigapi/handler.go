@@ -24,7 +24,9 @@ package queueconfigapi
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/uber/cadence/common/asyncworkflow/queue"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/types"
@@ -33,12 +35,14 @@ import (
 type handlerImpl struct {
 	logger        log.Logger
 	domainHandler domain.Handler
+	queueProvider queue.Provider
 }
 
-func New(logger log.Logger, dh domain.Handler) Handler {
+func New(logger log.Logger, dh domain.Handler, queueProvider queue.Provider) Handler {
 	return &handlerImpl{
 		logger:        logger,
 		domainHandler: dh,
+		queueProvider: queueProvider,
 	}
 }
 
@@ -63,6 +67,13 @@ func (h *handlerImpl) UpdateConfiguration(ctx context.Context, req *types.Update
 		return nil, &types.BadRequestError{Message: "Request is nil."}
 	}
 
+	// reject queue configs which the frontend and workers would fail to load later on
+	if cfg := req.Configuration; cfg != nil && cfg.PredefinedQueueName == "" && cfg.QueueType != "" {
+		if _, err := h.queueProvider.GetQueue(cfg.QueueType, cfg.QueueConfig); err != nil {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("Invalid queue configuration: %v", err)}
+		}
+	}
+
 	err := h.domainHandler.UpdateAsyncWorkflowConfiguraton(ctx, *req)
 	if err != nil {
 		return nil, err
@@ -30,11 +30,21 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"github.com/uber/cadence/common/asyncworkflow/queue"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/types"
 )
 
+var testQueueConfig = &types.AsyncWorkflowConfiguration{
+	Enabled:   true,
+	QueueType: "persistence",
+	QueueConfig: &types.DataBlob{
+		EncodingType: types.EncodingTypeJSON.Ptr(),
+		Data:         []byte(`{"queueID":1}`),
+	},
+}
+
 func TestGetConfiguraton(t *testing.T) {
 	tests := map[string]struct {
 		req                 *types.GetDomainAsyncWorkflowConfiguratonRequest
@@ -119,7 +129,7 @@ func TestGetConfiguraton(t *testing.T) {
 				tc.domainHandlerMockFn(domainHandlerMock)
 			}
 
-			handler := New(testlogger.New(t), domainHandlerMock)
+			handler := New(testlogger.New(t), domainHandlerMock, nil)
 			resp, err := handler.GetConfiguraton(context.Background(), tc.req)
 
 			if tc.wantErr != (err != nil) {
@@ -135,6 +145,7 @@ func TestUpdateConfiguration(t *testing.T) {
 	tests := map[string]struct {
 		req                 *types.UpdateDomainAsyncWorkflowConfiguratonRequest
 		domainHandlerMockFn func(*domain.MockHandler)
+		queueProviderMockFn func(*queue.MockProvider)
 		wantResp            *types.UpdateDomainAsyncWorkflowConfiguratonResponse
 		wantErr             bool
 	}{
@@ -160,6 +171,42 @@ func TestUpdateConfiguration(t *testing.T) {
 			},
 			wantResp: &types.UpdateDomainAsyncWorkflowConfiguratonResponse{},
 		},
+		"Invalid queue config": {
+			req: &types.UpdateDomainAsyncWorkflowConfiguratonRequest{
+				Domain:        "test-domain",
+				Configuration: testQueueConfig,
+			},
+			queueProviderMockFn: func(m *queue.MockProvider) {
+				m.EXPECT().GetQueue("persistence", testQueueConfig.QueueConfig).Return(nil, errors.New("bad config")).Times(1)
+			},
+			wantErr: true,
+		},
+		"Success with valid queue config": {
+			req: &types.UpdateDomainAsyncWorkflowConfiguratonRequest{
+				Domain:        "test-domain",
+				Configuration: testQueueConfig,
+			},
+			queueProviderMockFn: func(m *queue.MockProvider) {
+				m.EXPECT().GetQueue("persistence", testQueueConfig.QueueConfig).Return(nil, nil).Times(1)
+			},
+			domainHandlerMockFn: func(m *domain.MockHandler) {
+				m.EXPECT().UpdateAsyncWorkflowConfiguraton(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			wantResp: &types.UpdateDomainAsyncWorkflowConfiguratonResponse{},
+		},
+		"Predefined queue config is not validated": {
+			req: &types.UpdateDomainAsyncWorkflowConfiguratonRequest{
+				Domain: "test-domain",
+				Configuration: &types.AsyncWorkflowConfiguration{
+					Enabled:             true,
+					PredefinedQueueName: "test-queue",
+				},
+			},
+			domainHandlerMockFn: func(m *domain.MockHandler) {
+				m.EXPECT().UpdateAsyncWorkflowConfiguraton(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			wantResp: &types.UpdateDomainAsyncWorkflowConfiguratonResponse{},
+		},
 	}
 
 	for name, tc := range tests {
@@ -170,7 +217,12 @@ func TestUpdateConfiguration(t *testing.T) {
 				tc.domainHandlerMockFn(domainHandlerMock)
 			}
 
-			handler := New(testlogger.New(t), domainHandlerMock)
+			queueProviderMock := queue.NewMockProvider(ctrl)
+			if tc.queueProviderMockFn != nil {
+				tc.queueProviderMockFn(queueProviderMock)
+			}
+
+			handler := New(testlogger.New(t), domainHandlerMock, queueProviderMock)
 			resp, err := handler.UpdateConfiguration(context.Background(), tc.req)
 
 			if tc.wantErr != (err != nil) {
@@ -0,0 +1,217 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// tagsMetadataKey is the user metadata entry that holds the url encoded blob tags
+	tagsMetadataKey = "Cadence-Tags"
+
+	errCodeNotFound = "NotFound"
+)
+
+type (
+	client struct {
+		s3cli     s3iface.S3API
+		bucket    string
+		keyPrefix string
+	}
+)
+
+// NewS3Client constructs a blobstore backed by S3 or an S3 compatible object storage
+func NewS3Client(cfg *config.S3Blobstore) (blobstore.Client, error) {
+	if cfg == nil {
+		return nil, errors.New("s3 blobstore config is nil")
+	}
+	if len(cfg.Bucket) == 0 {
+		return nil, errors.New("bucket not given for s3 blobstore")
+	}
+	if len(cfg.Region) == 0 {
+		return nil, errors.New("region not given for s3 blobstore")
+	}
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:         cfg.Endpoint,
+		Region:           aws.String(cfg.Region),
+		S3ForcePathStyle: aws.Bool(cfg.S3ForcePathStyle),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newClient(s3.New(sess), cfg.Bucket, cfg.KeyPrefix), nil
+}
+
+func newClient(s3cli s3iface.S3API, bucket, keyPrefix string) *client {
+	return &client{
+		s3cli:     s3cli,
+		bucket:    bucket,
+		keyPrefix: strings.Trim(keyPrefix, "/"),
+	}
+}
+
+// Put stores a blob
+func (c *client) Put(ctx context.Context, request *blobstore.PutRequest) (*blobstore.PutResponse, error) {
+	_, err := c.s3cli.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:   aws.String(c.bucket),
+		Key:      aws.String(c.objectKey(request.Key)),
+		Body:     bytes.NewReader(request.Blob.Body),
+		Metadata: encodeTags(request.Blob.Tags),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &blobstore.PutResponse{}, nil
+}
+
+// Get fetches a blob
+func (c *client) Get(ctx context.Context, request *blobstore.GetRequest) (*blobstore.GetResponse, error) {
+	result, err := c.s3cli.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.objectKey(request.Key)),
+	})
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, &types.EntityNotExistsError{Message: "blob " + request.Key + " does not exist"}
+		}
+		return nil, err
+	}
+	defer result.Body.Close()
+
+	body, err := io.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := decodeTags(result.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	return &blobstore.GetResponse{
+		Blob: blobstore.Blob{
+			Body: body,
+			Tags: tags,
+		},
+	}, nil
+}
+
+// Exists determines if a blob exists
+func (c *client) Exists(ctx context.Context, request *blobstore.ExistsRequest) (*blobstore.ExistsResponse, error) {
+	_, err := c.s3cli.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.objectKey(request.Key)),
+	})
+	if err != nil {
+		if isNotFoundError(err) {
+			return &blobstore.ExistsResponse{Exists: false}, nil
+		}
+		return nil, err
+	}
+	return &blobstore.ExistsResponse{Exists: true}, nil
+}
+
+// Delete deletes a blob
+func (c *client) Delete(ctx context.Context, request *blobstore.DeleteRequest) (*blobstore.DeleteResponse, error) {
+	_, err := c.s3cli.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.objectKey(request.Key)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &blobstore.DeleteResponse{}, nil
+}
+
+// IsRetryableError returns true if the error is retryable false otherwise
+func (c *client) IsRetryableError(err error) bool {
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) || isNotFoundError(err) {
+		return false
+	}
+	var reqErr awserr.RequestFailure
+	if errors.As(err, &reqErr) &&
+		(reqErr.StatusCode() >= http.StatusInternalServerError || reqErr.StatusCode() == http.StatusTooManyRequests) {
+		return true
+	}
+	return request.IsErrorRetryable(aerr) || request.IsErrorThrottle(aerr)
+}
+
+func (c *client) objectKey(key string) string {
+	if c.keyPrefix == "" {
+		return key
+	}
+	return c.keyPrefix + "/" + key
+}
+
+func isNotFoundError(err error) bool {
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) {
+		return false
+	}
+	return aerr.Code() == s3.ErrCodeNoSuchKey || aerr.Code() == errCodeNotFound
+}
+
+// encodeTags stores the tags in a single metadata entry since metadata keys are case-insensitive
+// and values must be ASCII
+func encodeTags(tags map[string]string) map[string]*string {
+	if len(tags) == 0 {
+		return nil
+	}
+	values := url.Values{}
+	for k, v := range tags {
+		values.Set(k, v)
+	}
+	return map[string]*string{tagsMetadataKey: aws.String(values.Encode())}
+}
+
+func decodeTags(metadata map[string]*string) (map[string]string, error) {
+	tags := make(map[string]string)
+	for k, v := range metadata {
+		if !strings.EqualFold(k, tagsMetadataKey) || v == nil {
+			continue
+		}
+		values, err := url.ParseQuery(*v)
+		if err != nil {
+			return nil, err
+		}
+		for tag := range values {
+			tags[tag] = values.Get(tag)
+		}
+	}
+	return tags, nil
+}
@@ -0,0 +1,248 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	ClientSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		fake   *fakeS3
+		client *client
+	}
+
+	// fakeS3 is an in-memory S3 that supports the calls made by the client
+	fakeS3 struct {
+		s3iface.S3API
+
+		sync.Mutex
+		bucket  string
+		objects map[string]*fakeObject
+		putErr  error
+	}
+
+	fakeObject struct {
+		body     []byte
+		metadata map[string]*string
+	}
+)
+
+func TestClientSuite(t *testing.T) {
+	suite.Run(t, new(ClientSuite))
+}
+
+func (s *ClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.fake = &fakeS3{bucket: "test-bucket", objects: make(map[string]*fakeObject)}
+	s.client = newClient(s.fake, "test-bucket", "/scanner/")
+}
+
+func (s *ClientSuite) TestNewS3Client_InvalidConfig() {
+	_, err := NewS3Client(nil)
+	s.Error(err)
+	_, err = NewS3Client(&config.S3Blobstore{Region: "us-east-1"})
+	s.Error(err)
+	_, err = NewS3Client(&config.S3Blobstore{Bucket: "test-bucket"})
+	s.Error(err)
+}
+
+func (s *ClientSuite) TestNewS3Client() {
+	c, err := NewS3Client(&config.S3Blobstore{
+		Bucket:           "test-bucket",
+		KeyPrefix:        "scanner",
+		Region:           "us-east-1",
+		Endpoint:         aws.String("http://127.0.0.1:9000"),
+		S3ForcePathStyle: true,
+	})
+	s.NoError(err)
+	s.Equal("test-bucket", c.(*client).bucket)
+	s.Equal("scanner", c.(*client).keyPrefix)
+}
+
+func (s *ClientSuite) TestCRUD() {
+	ctx := context.Background()
+	key := "corrupted_1.json"
+
+	exists, err := s.client.Exists(ctx, &blobstore.ExistsRequest{Key: key})
+	s.NoError(err)
+	s.False(exists.Exists)
+
+	_, err = s.client.Get(ctx, &blobstore.GetRequest{Key: key})
+	s.ErrorAs(err, new(*types.EntityNotExistsError))
+
+	blob := blobstore.Blob{
+		Body: []byte("body"),
+		Tags: map[string]string{"shard": "1", "Type": "a b&c=d"},
+	}
+	_, err = s.client.Put(ctx, &blobstore.PutRequest{Key: key, Blob: blob})
+	s.NoError(err)
+	s.Contains(s.fake.objects, "scanner/"+key)
+
+	exists, err = s.client.Exists(ctx, &blobstore.ExistsRequest{Key: key})
+	s.NoError(err)
+	s.True(exists.Exists)
+
+	get, err := s.client.Get(ctx, &blobstore.GetRequest{Key: key})
+	s.NoError(err)
+	s.Equal(blob, get.Blob)
+
+	_, err = s.client.Delete(ctx, &blobstore.DeleteRequest{Key: key})
+	s.NoError(err)
+	exists, err = s.client.Exists(ctx, &blobstore.ExistsRequest{Key: key})
+	s.NoError(err)
+	s.False(exists.Exists)
+}
+
+func (s *ClientSuite) TestGet_NoTags() {
+	ctx := context.Background()
+	_, err := s.client.Put(ctx, &blobstore.PutRequest{Key: "key", Blob: blobstore.Blob{Body: []byte("body")}})
+	s.NoError(err)
+
+	get, err := s.client.Get(ctx, &blobstore.GetRequest{Key: "key"})
+	s.NoError(err)
+	s.Equal([]byte("body"), get.Blob.Body)
+	s.Empty(get.Blob.Tags)
+}
+
+func (s *ClientSuite) TestRetryableClient() {
+	ctx := context.Background()
+	s.fake.putErr = awserr.NewRequestFailure(awserr.New("SlowDown", "slow down", nil), http.StatusServiceUnavailable, "")
+
+	policy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	policy.SetMaximumAttempts(2)
+	retryable := blobstore.NewRetryableClient(s.client, policy)
+	_, err := retryable.Put(ctx, &blobstore.PutRequest{Key: "key", Blob: blobstore.Blob{Body: []byte("body")}})
+	s.NoError(err)
+
+	exists, err := s.client.Exists(ctx, &blobstore.ExistsRequest{Key: "key"})
+	s.NoError(err)
+	s.True(exists.Exists)
+}
+
+func (s *ClientSuite) TestIsRetryableError() {
+	tests := []struct {
+		name      string
+		err       error
+		retryable bool
+	}{
+		{name: "nil", err: nil},
+		{name: "non aws error", err: errors.New("some error")},
+		{name: "not found", err: awserr.New(s3.ErrCodeNoSuchKey, "not found", nil)},
+		{name: "access denied", err: awserr.NewRequestFailure(awserr.New("AccessDenied", "denied", nil), http.StatusForbidden, "")},
+		{name: "server error", err: awserr.NewRequestFailure(awserr.New("InternalError", "internal", nil), http.StatusInternalServerError, ""), retryable: true},
+		{name: "throttled", err: awserr.NewRequestFailure(awserr.New("SlowDown", "slow down", nil), http.StatusServiceUnavailable, ""), retryable: true},
+		{name: "connection error", err: awserr.New(request.ErrCodeSerialization, "failed", errors.New("connection reset")), retryable: true},
+	}
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.Equal(tc.retryable, s.client.IsRetryableError(tc.err))
+		})
+	}
+}
+
+func (f *fakeS3) PutObjectWithContext(_ aws.Context, input *s3.PutObjectInput, _ ...request.Option) (*s3.PutObjectOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.checkBucket(input.Bucket); err != nil {
+		return nil, err
+	}
+	if f.putErr != nil {
+		err := f.putErr
+		f.putErr = nil
+		return nil, err
+	}
+	body, err := io.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	f.objects[*input.Key] = &fakeObject{body: body, metadata: input.Metadata}
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (f *fakeS3) GetObjectWithContext(_ aws.Context, input *s3.GetObjectInput, _ ...request.Option) (*s3.GetObjectOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.checkBucket(input.Bucket); err != nil {
+		return nil, err
+	}
+	object, ok := f.objects[*input.Key]
+	if !ok {
+		return nil, awserr.New(s3.ErrCodeNoSuchKey, "key does not exist", nil)
+	}
+	return &s3.GetObjectOutput{
+		Body:     io.NopCloser(bytes.NewReader(object.body)),
+		Metadata: object.metadata,
+	}, nil
+}
+
+func (f *fakeS3) HeadObjectWithContext(_ aws.Context, input *s3.HeadObjectInput, _ ...request.Option) (*s3.HeadObjectOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.checkBucket(input.Bucket); err != nil {
+		return nil, err
+	}
+	object, ok := f.objects[*input.Key]
+	if !ok {
+		return nil, awserr.NewRequestFailure(awserr.New(errCodeNotFound, "not found", nil), http.StatusNotFound, "")
+	}
+	return &s3.HeadObjectOutput{Metadata: object.metadata}, nil
+}
+
+func (f *fakeS3) DeleteObjectWithContext(_ aws.Context, input *s3.DeleteObjectInput, _ ...request.Option) (*s3.DeleteObjectOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.checkBucket(input.Bucket); err != nil {
+		return nil, err
+	}
+	delete(f.objects, *input.Key)
+	return &s3.DeleteObjectOutput{}, nil
+}
+
+func (f *fakeS3) checkBucket(bucket *string) error {
+	if aws.StringValue(bucket) != f.bucket {
+		return awserr.New(s3.ErrCodeNoSuchBucket, "bucket does not exist", nil)
+	}
+	return nil
+}
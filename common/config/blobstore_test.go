@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlobstoreValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       Blobstore
+		expectErr string
+	}{
+		{
+			name: "empty",
+			cfg:  Blobstore{},
+		},
+		{
+			name: "filestore",
+			cfg:  Blobstore{Filestore: &FileBlobstore{OutputDirectory: "/tmp/blobstore"}},
+		},
+		{
+			name: "s3",
+			cfg:  Blobstore{S3: &S3Blobstore{Bucket: "bucket", Region: "us-east-1"}},
+		},
+		{
+			name: "both backends",
+			cfg: Blobstore{
+				Filestore: &FileBlobstore{OutputDirectory: "/tmp/blobstore"},
+				S3:        &S3Blobstore{Bucket: "bucket", Region: "us-east-1"},
+			},
+			expectErr: "blobstore config: only one of filestore and s3 can be set",
+		},
+		{
+			name:      "s3 without bucket",
+			cfg:       Blobstore{S3: &S3Blobstore{Region: "us-east-1"}},
+			expectErr: "blobstore config: s3 bucket is required",
+		},
+		{
+			name:      "s3 without region",
+			cfg:       Blobstore{S3: &S3Blobstore{Bucket: "bucket"}},
+			expectErr: "blobstore config: s3 region is required",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			if tc.expectErr != "" {
+				assert.EqualError(t, err, tc.expectErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
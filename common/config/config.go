@@ -183,9 +183,10 @@ type (
 		TLSMode yarpctls.Mode `yaml:"TLSMode"`
 	}
 
-	// Blobstore contains the config for blobstore
+	// Blobstore contains the config for blobstore, at most one of the backends can be set
 	Blobstore struct {
 		Filestore *FileBlobstore `yaml:"filestore"`
+		S3        *S3Blobstore   `yaml:"s3"`
 	}
 
 	// FileBlobstore contains the config for a file backed blobstore
@@ -193,6 +194,19 @@ type (
 		OutputDirectory string `yaml:"outputDirectory"`
 	}
 
+	// S3Blobstore contains the config for a blobstore backed by S3 or an S3 compatible object storage, e.g. MinIO.
+	// Credentials are resolved by the default AWS credential chain, e.g. AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY.
+	S3Blobstore struct {
+		// Bucket is the bucket that blobs are written to, it must already exist
+		Bucket string `yaml:"bucket"`
+		// KeyPrefix is prepended to the key of every blob
+		KeyPrefix string `yaml:"keyPrefix"`
+		Region    string `yaml:"region"`
+		// Endpoint overrides the AWS endpoint, required for S3 compatible storages
+		Endpoint         *string `yaml:"endpoint"`
+		S3ForcePathStyle bool    `yaml:"s3ForcePathStyle"`
+	}
+
 	// Persistence contains the configuration for data store / persistence layer
 	Persistence struct {
 		// DefaultStore is the name of the default data store to use
@@ -707,6 +721,12 @@ func (c *Config) validate() error {
 	if err := c.Archival.Validate(&c.DomainDefaults.Archival); err != nil {
 		return err
 	}
+	if err := c.Blobstore.Validate(); err != nil {
+		return err
+	}
+	if err := c.ShardDistribution.Validate(); err != nil {
+		return err
+	}
 
 	return c.Authorization.Validate()
 }
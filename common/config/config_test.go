@@ -33,6 +33,7 @@ import (
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/service"
+	sdconfig "github.com/uber/cadence/service/sharddistributor/config"
 )
 
 func TestToString(t *testing.T) {
@@ -475,3 +476,15 @@ names:
 		assert.ErrorContains(t, err, `unknown histogram-migration metric name "definitely_does_not_exist"`)
 	})
 }
+
+func TestConfigErrorInShardDistributionConfig(t *testing.T) {
+	cfg := getValidMultipleDatabasseConfig()
+	cfg.ShardDistribution = sdconfig.ShardDistribution{
+		Namespaces: []sdconfig.Namespace{
+			{Name: "ns", Placement: sdconfig.Placement{Strategy: "round_robin"}},
+		},
+	}
+
+	err := cfg.ValidateAndFillDefaults()
+	require.ErrorContains(t, err, `unknown placement strategy "round_robin"`)
+}
@@ -48,6 +48,8 @@ const (
 	CustomDomain           = "CustomDomain" // to support batch workflow
 	Operator               = "Operator"     // to support batch workflow
 
+	CadenceScheduleListEntry = "CadenceScheduleListEntry" // to support ListSchedules
+
 	CustomStringField    = "CustomStringField"
 	CustomKeywordField   = "CustomKeywordField"
 	CustomIntField       = "CustomIntField"
@@ -81,6 +83,8 @@ func createDefaultIndexedKeys() map[string]interface{} {
 		BinaryChecksums:      types.IndexedValueTypeKeyword,
 		CustomDomain:         types.IndexedValueTypeString,
 		Operator:             types.IndexedValueTypeString,
+
+		CadenceScheduleListEntry: types.IndexedValueTypeKeyword,
 	}
 	for k, v := range systemIndexedKeys {
 		defaultIndexedKeys[k] = v
@@ -38,4 +38,7 @@ var (
 
 	errInvalidRetentionPeriod = &types.BadRequestError{Message: "A valid retention period is not set on request."}
 	errInvalidArchivalConfig  = &types.BadRequestError{Message: "Invalid to enable archival without specifying a uri."}
+
+	errDomainAuditLogNotAvailable = &types.BadRequestError{Message: "Domain audit log is not available, cannot roll back domain."}
+	errInvalidDomainAuditEventID  = &types.BadRequestError{Message: "Event ID is not a valid domain audit log event ID."}
 )
@@ -30,6 +30,7 @@ import (
 	"time"
 
 	guuid "github.com/google/uuid"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
@@ -89,6 +90,10 @@ type (
 			ctx context.Context,
 			updateRequest types.UpdateDomainAsyncWorkflowConfiguratonRequest,
 		) error
+		RollbackDomain(
+			ctx context.Context,
+			rollbackRequest *types.RollbackDomainRequest,
+		) (*types.RollbackDomainResponse, error)
 	}
 
 	// handlerImpl is the domain operation handler implementation
@@ -713,7 +718,10 @@ func (d *handlerImpl) updateDomainAuditLog(ctx context.Context,
 		StateBefore:   currentState,
 		StateAfter:    intendedDomainState,
 		OperationType: operationType,
-		Comment:       comment,
+		// the actor is the calling service as reported by the transport, e.g. cadence-cli
+		Identity:     yarpc.CallFromContext(ctx).Caller(),
+		IdentityType: types.GetCallerInfoFromContext(ctx).GetCallerType().String(),
+		Comment:      comment,
 	})
 	if err != nil {
 		d.logger.Error("Failed to create domain audit log",
@@ -1357,6 +1365,186 @@ func (d *handlerImpl) UpdateAsyncWorkflowConfiguraton(
 	return nil
 }
 
+// RollbackDomain restores the info and configuration of a domain to their state after the given domain audit
+// log entry. The replication config is kept, changing it is a failover. The rollback is a domain update:
+// it bumps the config version, is replicated and gets an audit log entry of its own.
+func (d *handlerImpl) RollbackDomain(
+	ctx context.Context,
+	rollbackRequest *types.RollbackDomainRequest,
+) (*types.RollbackDomainResponse, error) {
+
+	if d.domainAuditManager == nil {
+		return nil, errDomainAuditLogNotAvailable
+	}
+	eventID, err := guuid.Parse(rollbackRequest.EventID)
+	if err != nil || eventID.Version() != 7 {
+		return nil, errInvalidDomainAuditEventID
+	}
+
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 domain table
+	metadata, err := d.domainManager.GetMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	notificationVersion := metadata.NotificationVersion
+	currentState, err := d.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{Name: rollbackRequest.Domain})
+	if err != nil {
+		return nil, err
+	}
+
+	if currentState.IsGlobalDomain && !d.clusterMetadata.IsPrimaryCluster() {
+		return nil, errNotPrimaryCluster
+	}
+
+	auditLog, err := d.getDomainAuditLog(ctx, currentState.Info.ID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	targetState := auditLog.StateAfter.DeepCopy()
+	if targetState == nil || targetState.Info == nil || targetState.Config == nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Domain audit log entry %v has no domain configuration to roll back to.", eventID)}
+	}
+
+	intendedDomainState := currentState.DeepCopy()
+	intendedDomainState.Info.Description = targetState.Info.Description
+	intendedDomainState.Info.OwnerEmail = targetState.Info.OwnerEmail
+	intendedDomainState.Info.Data = targetState.Info.Data
+	// the failover history is not configuration, it has to survive the rollback
+	if failoverHistory, ok := currentState.Info.Data[constants.DomainDataKeyForFailoverHistory]; ok {
+		if intendedDomainState.Info.Data == nil {
+			intendedDomainState.Info.Data = make(map[string]string)
+		}
+		intendedDomainState.Info.Data[constants.DomainDataKeyForFailoverHistory] = failoverHistory
+	} else {
+		delete(intendedDomainState.Info.Data, constants.DomainDataKeyForFailoverHistory)
+	}
+	intendedDomainState.Config = targetState.Config
+
+	if len(persistence.DiffDomainStates(currentState, intendedDomainState)) == 0 {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Domain configuration already matches domain audit log entry %v.", eventID)}
+	}
+
+	if err = d.domainAttrValidator.validateDomainConfig(intendedDomainState.Config); err != nil {
+		return nil, err
+	}
+	if maxLength := d.config.MaxBadBinaryCount(currentState.Info.Name); len(intendedDomainState.Config.BadBinaries.Binaries) > maxLength {
+		return nil, &types.BadRequestError{
+			Message: fmt.Sprintf("Total resetBinaries cannot exceed the max limit: %v", maxLength),
+		}
+	}
+	// the archival URIs were valid when they were set, but the archivers of the cluster may have changed since
+	if uri := intendedDomainState.Config.HistoryArchivalURI; uri != "" && uri != currentState.Config.HistoryArchivalURI {
+		if err = d.validateHistoryArchivalURI(uri); err != nil {
+			return nil, err
+		}
+	}
+	if uri := intendedDomainState.Config.VisibilityArchivalURI; uri != "" && uri != currentState.Config.VisibilityArchivalURI {
+		if err = d.validateVisibilityArchivalURI(uri); err != nil {
+			return nil, err
+		}
+	}
+
+	err = d.ensureUpdateOrFailoverCooldown(currentState)
+	if err != nil {
+		return nil, err
+	}
+
+	now := d.timeSource.Now()
+	intendedDomainState.ConfigVersion++
+	intendedDomainState.LastUpdatedTime = now.UnixNano()
+	intendedDomainState.NotificationVersion = notificationVersion
+
+	updateReq := createUpdateRequest(
+		intendedDomainState.Info,
+		intendedDomainState.Config,
+		intendedDomainState.ReplicationConfig,
+		intendedDomainState.ConfigVersion,
+		intendedDomainState.FailoverVersion,
+		intendedDomainState.FailoverNotificationVersion,
+		intendedDomainState.FailoverEndTime,
+		intendedDomainState.PreviousFailoverVersion,
+		now,
+		notificationVersion,
+	)
+	err = d.domainManager.UpdateDomain(ctx, &updateReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if intendedDomainState.IsGlobalDomain {
+		if err = d.domainReplicator.HandleTransmissionTask(
+			ctx,
+			types.DomainOperationUpdate,
+			intendedDomainState.Info,
+			intendedDomainState.Config,
+			intendedDomainState.ReplicationConfig,
+			intendedDomainState.ConfigVersion,
+			intendedDomainState.FailoverVersion,
+			intendedDomainState.PreviousFailoverVersion,
+			intendedDomainState.IsGlobalDomain,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	err = d.updateDomainAuditLog(ctx, currentState, intendedDomainState, persistence.DomainAuditOperationTypeUpdate, fmt.Sprintf("domain rolled back to audit log entry %v", eventID))
+	if err != nil {
+		return nil, err
+	}
+
+	d.logger.Info("Rollback domain succeeded",
+		tag.WorkflowDomainName(intendedDomainState.Info.Name),
+		tag.WorkflowDomainID(intendedDomainState.Info.ID),
+	)
+	response := &types.RollbackDomainResponse{
+		IsGlobalDomain:  intendedDomainState.IsGlobalDomain,
+		FailoverVersion: intendedDomainState.FailoverVersion,
+	}
+	response.DomainInfo, response.Configuration, response.ReplicationConfiguration = d.createResponse(
+		intendedDomainState.Info,
+		intendedDomainState.Config,
+		intendedDomainState.ReplicationConfig,
+	)
+	return response, nil
+}
+
+// getDomainAuditLog finds an audit log entry of a domain by its event ID. The audit log is partitioned by
+// operation type and created time, the created time is embedded in the event ID.
+func (d *handlerImpl) getDomainAuditLog(
+	ctx context.Context,
+	domainID string,
+	eventID guuid.UUID,
+) (*persistence.DomainAuditLog, error) {
+
+	// the stores keep the created time in seconds
+	minCreatedTime := time.Unix(eventID.Time().UnixTime()).Truncate(time.Second)
+	maxCreatedTime := minCreatedTime.Add(time.Second)
+	for _, operationType := range []persistence.DomainAuditOperationType{
+		persistence.DomainAuditOperationTypeUpdate,
+		persistence.DomainAuditOperationTypeFailover,
+		persistence.DomainAuditOperationTypeCreate,
+		persistence.DomainAuditOperationTypeDeprecate,
+		persistence.DomainAuditOperationTypeDelete,
+	} {
+		resp, err := d.domainAuditManager.GetDomainAuditLogs(ctx, &persistence.GetDomainAuditLogsRequest{
+			DomainID:       domainID,
+			OperationType:  operationType,
+			MinCreatedTime: &minCreatedTime,
+			MaxCreatedTime: &maxCreatedTime,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, auditLog := range resp.AuditLogs {
+			if auditLog.EventID == eventID.String() {
+				return auditLog, nil
+			}
+		}
+	}
+	return nil, &types.EntityNotExistsError{Message: fmt.Sprintf("Domain audit log entry %v not found.", eventID)}
+}
+
 func (d *handlerImpl) createResponse(
 	info *persistence.DomainInfo,
 	config *persistence.DomainConfig,
@@ -129,6 +129,21 @@ func (mr *MockHandlerMockRecorder) RegisterDomain(ctx, registerRequest any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDomain", reflect.TypeOf((*MockHandler)(nil).RegisterDomain), ctx, registerRequest)
 }
 
+// RollbackDomain mocks base method.
+func (m *MockHandler) RollbackDomain(ctx context.Context, rollbackRequest *types.RollbackDomainRequest) (*types.RollbackDomainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackDomain", ctx, rollbackRequest)
+	ret0, _ := ret[0].(*types.RollbackDomainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackDomain indicates an expected call of RollbackDomain.
+func (mr *MockHandlerMockRecorder) RollbackDomain(ctx, rollbackRequest any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackDomain", reflect.TypeOf((*MockHandler)(nil).RollbackDomain), ctx, rollbackRequest)
+}
+
 // UpdateAsyncWorkflowConfiguraton mocks base method.
 func (m *MockHandler) UpdateAsyncWorkflowConfiguraton(ctx context.Context, updateRequest types.UpdateDomainAsyncWorkflowConfiguratonRequest) error {
 	m.ctrl.T.Helper()
@@ -31,6 +31,7 @@ import (
 	"testing"
 	"time"
 
+	guuid "github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
@@ -1389,6 +1390,233 @@ func TestHandler_UpdateAsyncWorkflowConfiguraton(t *testing.T) {
 	}
 }
 
+func TestHandler_RollbackDomain(t *testing.T) {
+	now := time.Unix(1761769472, 0)
+	eventID := guuid.Must(guuid.NewV7())
+	otherEventID := guuid.Must(guuid.NewV7())
+
+	currentState := func(isGlobalDomain bool) *persistence.GetDomainResponse {
+		return &persistence.GetDomainResponse{
+			Info: &persistence.DomainInfo{
+				ID:          constants.TestDomainID,
+				Name:        constants.TestDomainName,
+				Description: "new-description",
+				OwnerEmail:  "owner@cadence.io",
+				Data: map[string]string{
+					"team": "new-team",
+					commonconstants.DomainDataKeyForFailoverHistory: "current-failover-history",
+				},
+			},
+			Config: &persistence.DomainConfig{
+				Retention:   1,
+				EmitMetric:  true,
+				BadBinaries: types.BadBinaries{Binaries: map[string]*types.BadBinaryInfo{}},
+			},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+					{ClusterName: cluster.TestAlternativeClusterName},
+				},
+			},
+			IsGlobalDomain:  isGlobalDomain,
+			ConfigVersion:   5,
+			FailoverVersion: cluster.TestCurrentClusterInitialFailoverVersion,
+			LastUpdatedTime: now.Add(-time.Hour).UnixNano(),
+		}
+	}
+	// the state after the audit log entry, before the bad update
+	targetState := &persistence.GetDomainResponse{
+		Info: &persistence.DomainInfo{
+			ID:          constants.TestDomainID,
+			Name:        constants.TestDomainName,
+			Description: "old-description",
+			OwnerEmail:  "owner@cadence.io",
+			Data: map[string]string{
+				"team": "old-team",
+				commonconstants.DomainDataKeyForFailoverHistory: "old-failover-history",
+			},
+		},
+		Config: &persistence.DomainConfig{
+			Retention:  3,
+			EmitMetric: true,
+			BadBinaries: types.BadBinaries{Binaries: map[string]*types.BadBinaryInfo{
+				"bad-checksum": {Reason: "bad deployment", Operator: "oncall"},
+			}},
+		},
+		ReplicationConfig: &persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestAlternativeClusterName,
+		},
+		IsGlobalDomain: true,
+	}
+	auditLogs := func(stateAfter *persistence.GetDomainResponse) func(context.Context, *persistence.GetDomainAuditLogsRequest) (*persistence.GetDomainAuditLogsResponse, error) {
+		return func(_ context.Context, req *persistence.GetDomainAuditLogsRequest) (*persistence.GetDomainAuditLogsResponse, error) {
+			assert.Equal(t, constants.TestDomainID, req.DomainID)
+			createdTime := time.Unix(eventID.Time().UnixTime())
+			assert.False(t, createdTime.Before(*req.MinCreatedTime))
+			assert.True(t, createdTime.Before(*req.MaxCreatedTime))
+			if req.OperationType != persistence.DomainAuditOperationTypeUpdate {
+				return &persistence.GetDomainAuditLogsResponse{}, nil
+			}
+			return &persistence.GetDomainAuditLogsResponse{
+				AuditLogs: []*persistence.DomainAuditLog{
+					{EventID: otherEventID.String(), StateAfter: currentState(true)},
+					{EventID: eventID.String(), StateAfter: stateAfter},
+				},
+			}, nil
+		}
+	}
+	expectedInfo := &persistence.DomainInfo{
+		ID:          constants.TestDomainID,
+		Name:        constants.TestDomainName,
+		Description: "old-description",
+		OwnerEmail:  "owner@cadence.io",
+		Data: map[string]string{
+			"team": "old-team",
+			commonconstants.DomainDataKeyForFailoverHistory: "current-failover-history",
+		},
+	}
+
+	tests := map[string]struct {
+		request          *types.RollbackDomainRequest
+		isPrimaryCluster bool
+		noAuditManager   bool
+		setupMocks       func(m *persistence.MockDomainManager, a *persistence.MockDomainAuditManager, r *MockReplicator)
+		expectedErr      error
+	}{
+		"global domain is rolled back and replicated": {
+			request:          &types.RollbackDomainRequest{Domain: constants.TestDomainName, EventID: eventID.String()},
+			isPrimaryCluster: true,
+			setupMocks: func(m *persistence.MockDomainManager, a *persistence.MockDomainAuditManager, r *MockReplicator) {
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 10}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{Name: constants.TestDomainName}).Return(currentState(true), nil)
+				a.EXPECT().GetDomainAuditLogs(gomock.Any(), gomock.Any()).DoAndReturn(auditLogs(targetState)).AnyTimes()
+				m.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *persistence.UpdateDomainRequest) error {
+					assert.Equal(t, expectedInfo, req.Info)
+					assert.Equal(t, targetState.Config, req.Config)
+					assert.Equal(t, currentState(true).ReplicationConfig, req.ReplicationConfig, "replication config is not rolled back")
+					assert.Equal(t, int64(6), req.ConfigVersion)
+					assert.Equal(t, cluster.TestCurrentClusterInitialFailoverVersion, req.FailoverVersion)
+					assert.Equal(t, now.UnixNano(), req.LastUpdatedTime)
+					assert.Equal(t, int64(10), req.NotificationVersion)
+					return nil
+				})
+				r.EXPECT().HandleTransmissionTask(gomock.Any(), types.DomainOperationUpdate, expectedInfo, targetState.Config, currentState(true).ReplicationConfig, int64(6), cluster.TestCurrentClusterInitialFailoverVersion, gomock.Any(), true).Return(nil)
+				a.EXPECT().CreateDomainAuditLog(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *persistence.CreateDomainAuditLogRequest) (*persistence.CreateDomainAuditLogResponse, error) {
+					assert.Equal(t, persistence.DomainAuditOperationTypeUpdate, req.OperationType)
+					assert.Equal(t, currentState(true), req.StateBefore)
+					assert.Equal(t, expectedInfo, req.StateAfter.Info)
+					assert.Equal(t, targetState.Config, req.StateAfter.Config)
+					assert.Equal(t, "domain rolled back to audit log entry "+eventID.String(), req.Comment)
+					return &persistence.CreateDomainAuditLogResponse{}, nil
+				})
+			},
+		},
+		"local domain is rolled back without replication": {
+			request: &types.RollbackDomainRequest{Domain: constants.TestDomainName, EventID: eventID.String()},
+			setupMocks: func(m *persistence.MockDomainManager, a *persistence.MockDomainAuditManager, r *MockReplicator) {
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 10}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(currentState(false), nil)
+				a.EXPECT().GetDomainAuditLogs(gomock.Any(), gomock.Any()).DoAndReturn(auditLogs(targetState)).AnyTimes()
+				m.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).Return(nil)
+				a.EXPECT().CreateDomainAuditLog(gomock.Any(), gomock.Any()).Return(&persistence.CreateDomainAuditLogResponse{}, nil)
+			},
+		},
+		"audit log not available": {
+			request:        &types.RollbackDomainRequest{Domain: constants.TestDomainName, EventID: eventID.String()},
+			noAuditManager: true,
+			setupMocks:     func(m *persistence.MockDomainManager, a *persistence.MockDomainAuditManager, r *MockReplicator) {},
+			expectedErr:    errDomainAuditLogNotAvailable,
+		},
+		"invalid event ID": {
+			request:     &types.RollbackDomainRequest{Domain: constants.TestDomainName, EventID: guuid.NewString()},
+			setupMocks:  func(m *persistence.MockDomainManager, a *persistence.MockDomainAuditManager, r *MockReplicator) {},
+			expectedErr: errInvalidDomainAuditEventID,
+		},
+		"global domain from non-primary cluster": {
+			request: &types.RollbackDomainRequest{Domain: constants.TestDomainName, EventID: eventID.String()},
+			setupMocks: func(m *persistence.MockDomainManager, a *persistence.MockDomainAuditManager, r *MockReplicator) {
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(currentState(true), nil)
+			},
+			expectedErr: errNotPrimaryCluster,
+		},
+		"audit log entry not found": {
+			request:          &types.RollbackDomainRequest{Domain: constants.TestDomainName, EventID: eventID.String()},
+			isPrimaryCluster: true,
+			setupMocks: func(m *persistence.MockDomainManager, a *persistence.MockDomainAuditManager, r *MockReplicator) {
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(currentState(true), nil)
+				a.EXPECT().GetDomainAuditLogs(gomock.Any(), gomock.Any()).Return(&persistence.GetDomainAuditLogsResponse{}, nil).Times(5)
+			},
+			expectedErr: &types.EntityNotExistsError{Message: fmt.Sprintf("Domain audit log entry %v not found.", eventID)},
+		},
+		"configuration already matches the audit log entry": {
+			request:          &types.RollbackDomainRequest{Domain: constants.TestDomainName, EventID: eventID.String()},
+			isPrimaryCluster: true,
+			setupMocks: func(m *persistence.MockDomainManager, a *persistence.MockDomainAuditManager, r *MockReplicator) {
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(currentState(true), nil)
+				a.EXPECT().GetDomainAuditLogs(gomock.Any(), gomock.Any()).DoAndReturn(auditLogs(currentState(true))).AnyTimes()
+			},
+			expectedErr: &types.BadRequestError{Message: fmt.Sprintf("Domain configuration already matches domain audit log entry %v.", eventID)},
+		},
+		"update too frequent": {
+			request:          &types.RollbackDomainRequest{Domain: constants.TestDomainName, EventID: eventID.String()},
+			isPrimaryCluster: true,
+			setupMocks: func(m *persistence.MockDomainManager, a *persistence.MockDomainAuditManager, r *MockReplicator) {
+				state := currentState(true)
+				state.LastUpdatedTime = now.UnixNano()
+				m.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{}, nil)
+				m.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(state, nil)
+				a.EXPECT().GetDomainAuditLogs(gomock.Any(), gomock.Any()).DoAndReturn(auditLogs(targetState)).AnyTimes()
+			},
+			expectedErr: errDomainUpdateTooFrequent,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDomainManager := persistence.NewMockDomainManager(ctrl)
+			mockDomainAuditManager := persistence.NewMockDomainAuditManager(ctrl)
+			mockReplicator := NewMockReplicator(ctrl)
+			tc.setupMocks(mockDomainManager, mockDomainAuditManager, mockReplicator)
+
+			clusterMetadata := cluster.GetTestClusterMetadata(tc.isPrimaryCluster)
+			handler := &handlerImpl{
+				domainManager:       mockDomainManager,
+				domainAuditManager:  mockDomainAuditManager,
+				clusterMetadata:     clusterMetadata,
+				domainReplicator:    mockReplicator,
+				domainAttrValidator: newAttrValidator(clusterMetadata, 1),
+				archiverProvider:    provider.NewArchiverProvider(nil, nil),
+				timeSource:          clock.NewMockedTimeSourceAt(now),
+				config: Config{
+					MaxBadBinaryCount:        func(string) int { return 3 },
+					FailoverCoolDown:         func(string) time.Duration { return time.Minute },
+					EnableDomainAuditLogging: dynamicproperties.GetBoolPropertyFn(true),
+				},
+				logger: log.NewNoop(),
+			}
+			if tc.noAuditManager {
+				handler.domainAuditManager = nil
+			}
+
+			resp, err := handler.RollbackDomain(context.Background(), tc.request)
+			if tc.expectedErr != nil {
+				assert.Equal(t, tc.expectedErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "old-description", resp.DomainInfo.Description)
+			assert.Equal(t, int32(3), resp.Configuration.WorkflowExecutionRetentionPeriodInDays)
+			assert.Contains(t, resp.Configuration.BadBinaries.Binaries, "bad-checksum")
+			assert.Equal(t, cluster.TestCurrentClusterName, resp.ReplicationConfiguration.ActiveClusterName)
+		})
+	}
+}
+
 func TestHandler_UpdateDomain(t *testing.T) {
 	ctx := context.Background()
 	maxLength := 1
@@ -910,6 +910,20 @@ const (
 	// Default value: 0
 	// Allowed filters: N/A
 	MatchingPercentageOnboardedToShardManager
+	// MatchingPriorityStarvationPreventionInterval is the number of dispatches after which matching serves the oldest
+	// task instead of the highest priority one, so that lower priority tasks are not starved
+	// KeyName: matching.priorityStarvationPreventionInterval
+	// Value type: Int
+	// Default value: 5
+	// Allowed filters: DomainName,TasklistName,TaskType
+	MatchingPriorityStarvationPreventionInterval
+	// MatchingBacklogReadAheadLimit is the maximum number of backlog tasks matching reads past a full task buffer to find
+	// higher priority tasks. The lower priority tasks they displace are read again from persistence once there is room
+	// KeyName: matching.backlogReadAheadLimit
+	// Value type: Int
+	// Default value: 10000
+	// Allowed filters: DomainName,TasklistName,TaskType
+	MatchingBacklogReadAheadLimit
 
 	// key for history
 
@@ -1060,6 +1074,12 @@ const (
 	// Default value: 9000
 	// Allowed filters: N/A
 	QueueCriticalPendingTaskCount
+	// QueueCriticalSliceCount is the critical number of virtual slices in a virtual queue, above which the slices will be merged. 0 disables the alert
+	// KeyName: history.queueCriticalSliceCount
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: N/A
+	QueueCriticalSliceCount
 	// TimerTaskBatchSize is batch size for timer processor to process tasks
 	// KeyName: history.timerTaskBatchSize
 	// Value type: Int
@@ -2955,6 +2975,18 @@ const (
 	// Default value: 5s (5*time.Second)
 	// Allowed filters: N/A
 	QueueProcessorPollBackoffInterval
+	// QueueStuckSliceDeadline is the duration after which a virtual slice with pending tasks is considered stuck if its ack level hasn't advanced. 0 disables the alert
+	// KeyName: history.queueStuckSliceDeadline
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: N/A
+	QueueStuckSliceDeadline
+	// QueueCriticalTaskLatency is the critical latency between the scheduled time of the oldest pending task of a virtual slice and now. 0 disables the alert
+	// KeyName: history.queueCriticalTaskLatency
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: N/A
+	QueueCriticalTaskLatency
 	// VirtualSliceForceAppendInterval is the duration forcing a new virtual slice to be appended to the root virtual queue instead of being merged. It has 2 benefits: First, virtual slices won't grow infinitely, task loading for that slice can complete and its scope can be shrinked. Second, when we need to unload a virtual slice to free memory, we won't unload too many tasks.
 	// KeyName: history.virtualSliceForceAppendInterval
 	// Value type: Duration
@@ -3297,6 +3329,22 @@ const (
 	// Allowed filters: N/A
 	ValidSearchAttributes
 
+	// key for matching
+
+	// MatchingFairnessKeyWeights is the dispatch weight of each fairness key when tasks with different fairness keys share a task list
+	// KeyName: matching.fairnessKeyWeights
+	// Value type: Map
+	// Default value: empty map, every fairness key has a weight of 1
+	// Allowed filters: DomainName
+	MatchingFairnessKeyWeights
+	// MatchingFairnessKeyDispatchRPS is the maximum rate at which tasks of each fairness key are dispatched from a task list,
+	// split across the read partitions. Fairness keys without a positive rate are not limited
+	// KeyName: matching.fairnessKeyDispatchRPS
+	// Value type: Map
+	// Default value: empty map, no fairness key is limited
+	// Allowed filters: DomainName
+	MatchingFairnessKeyDispatchRPS
+
 	// key for history
 
 	// TaskSchedulerRoundRobinWeights is the priority weight for weighted round robin task scheduler
@@ -3370,6 +3418,14 @@ const (
 	// Default value: forward all headers.  (this is a problematic value, and it will be changing as we reduce to a list of known values)
 	HeaderForwardingRules
 
+	// QueueIsolationRules declares domains whose history tasks are always processed in a dedicated virtual queue
+	// KeyName: history.queueIsolationRules
+	// Value type: []interface{} containing `map[string]interface{}{"DomainID":string,"VirtualQueueID":int,"QueueTypes":[]string}` values.
+	// QueueTypes is optional and can contain "transfer" and "timer", all queue types are isolated if it's empty.
+	// Default value: empty list
+	// Allowed filters: N/A
+	QueueIsolationRules
+
 	LastListKey
 )
 
@@ -3854,6 +3910,18 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MatchingPercentageOnboardedToShardManager is the percentage of task lists that will be onboarded to the shard manager",
 		DefaultValue: 0,
 	},
+	MatchingPriorityStarvationPreventionInterval: {
+		KeyName:      "matching.priorityStarvationPreventionInterval",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingPriorityStarvationPreventionInterval is the number of dispatches after which matching serves the oldest task instead of the highest priority one, so that lower priority tasks are not starved",
+		DefaultValue: 5,
+	},
+	MatchingBacklogReadAheadLimit: {
+		KeyName:      "matching.backlogReadAheadLimit",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingBacklogReadAheadLimit is the maximum number of backlog tasks matching reads past a full task buffer to find higher priority tasks",
+		DefaultValue: 10000,
+	},
 	HistoryRPS: {
 		KeyName:      "history.rps",
 		Description:  "HistoryRPS is request rate per second for each history host",
@@ -3978,6 +4046,11 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "QueueCriticalPendingTaskCount is the critical pending task count for the queue, which is supposed to be less than QueueMaxPendingTaskCount",
 		DefaultValue: 9000,
 	},
+	QueueCriticalSliceCount: {
+		KeyName:      "history.queueCriticalSliceCount",
+		Description:  "QueueCriticalSliceCount is the critical number of virtual slices in a virtual queue, above which the slices will be merged. 0 disables the alert",
+		DefaultValue: 0,
+	},
 	TimerTaskBatchSize: {
 		KeyName:      "history.timerTaskBatchSize",
 		Description:  "TimerTaskBatchSize is batch size for timer processor to process tasks",
@@ -5610,6 +5683,16 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "QueueProcessorPollBackoffInterval is the backoff duration when queue processor is throttled",
 		DefaultValue: time.Second * 5,
 	},
+	QueueStuckSliceDeadline: {
+		KeyName:      "history.queueStuckSliceDeadline",
+		Description:  "QueueStuckSliceDeadline is the duration after which a virtual slice with pending tasks is considered stuck if its ack level hasn't advanced. 0 disables the alert",
+		DefaultValue: time.Duration(0),
+	},
+	QueueCriticalTaskLatency: {
+		KeyName:      "history.queueCriticalTaskLatency",
+		Description:  "QueueCriticalTaskLatency is the critical latency between the scheduled time of the oldest pending task of a virtual slice and now. 0 disables the alert",
+		DefaultValue: time.Duration(0),
+	},
 	VirtualSliceForceAppendInterval: {
 		KeyName:      "history.virtualSliceForceAppendInterval",
 		Description:  "VirtualSliceForceAppendInterval is the duration forcing a new virtual slice to be appended to the root virtual queue instead of being merged. It has 2 benefits: First, virtual slices won't grow infinitely, task loading for that slice can complete and its scope can be shrinked. Second, when we need to unload a virtual slice to free memory, we won't unload too many tasks.",
@@ -5907,6 +5990,18 @@ var MapKeys = map[MapKey]DynamicMap{
 		Description:  "ValidSearchAttributes is legal indexed keys that can be used in list APIs. When overriding, ensure to include the existing default attributes of the current release",
 		DefaultValue: definition.GetDefaultIndexedKeys(),
 	},
+	MatchingFairnessKeyWeights: {
+		KeyName:      "matching.fairnessKeyWeights",
+		Description:  "MatchingFairnessKeyWeights is the dispatch weight of each fairness key when tasks with different fairness keys share a task list",
+		Filters:      []Filter{DomainName},
+		DefaultValue: map[string]interface{}{},
+	},
+	MatchingFairnessKeyDispatchRPS: {
+		KeyName:      "matching.fairnessKeyDispatchRPS",
+		Description:  "MatchingFairnessKeyDispatchRPS is the maximum rate at which tasks of each fairness key are dispatched from a task list",
+		Filters:      []Filter{DomainName},
+		DefaultValue: map[string]interface{}{},
+	},
 	TaskSchedulerRoundRobinWeights: {
 		KeyName:      "history.taskSchedulerRoundRobinWeight",
 		Description:  "TaskSchedulerRoundRobinWeights is the priority weight for weighted round robin task scheduler",
@@ -5969,6 +6064,13 @@ var ListKeys = map[ListKey]DynamicList{
 			},
 		},
 	},
+	QueueIsolationRules: {
+		KeyName: "history.queueIsolationRules",
+		Description: "QueueIsolationRules declares domains whose history tasks are always processed in a dedicated virtual queue. " +
+			`Each rule is a map like {"DomainID": "...", "VirtualQueueID": 1, "QueueTypes": ["transfer", "timer"]}, ` +
+			"an empty QueueTypes applies the rule to all queue types",
+		DefaultValue: []interface{}{},
+	},
 }
 
 var _keyNames map[string]Key
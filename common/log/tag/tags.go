@@ -643,6 +643,11 @@ func ReadLevel(lv int64) Tag {
 	return newInt64("read-level", lv)
 }
 
+// AckLevel returns tag for AckLevel
+func AckLevel(lv int64) Tag {
+	return newInt64("ack-level", lv)
+}
+
 // MinLevel returns tag for MinLevel
 func MinLevel(lv int64) Tag {
 	return newInt64("min-level", lv)
@@ -1246,6 +1251,11 @@ func AlertType(alertType int) Tag {
 	return newInt("alert-type", alertType)
 }
 
+// Mitigation returns a tag for the mitigation applied to a queue alert
+func Mitigation(mitigation string) Tag {
+	return newStringTag("mitigation", mitigation)
+}
+
 // CacheID returns a tag for cache identifier
 func CacheID(cacheID string) Tag {
 	return newStringTag("cache-id", cacheID)
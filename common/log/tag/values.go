@@ -313,6 +313,7 @@ var (
 	AdminClientOperationCloseShard                            = clientOperation("admin-close-shard")
 	AdminClientOperationResetQueue                            = clientOperation("admin-reset-queue")
 	AdminClientOperationDescribeQueue                         = clientOperation("admin-describe-queue")
+	AdminClientOperationUpdateVirtualQueue                    = clientOperation("admin-update-virtual-queue")
 	AdminClientOperationDescribeWorkflowExecution             = clientOperation("admin-describe-wf-execution")
 	AdminClientOperationGetWorkflowExecutionRawHistoryV2      = clientOperation("admin-get-wf-execution-raw-history-v2")
 	AdminClientOperationDescribeCluster                       = clientOperation("admin-describe-cluster")
@@ -343,6 +344,7 @@ var (
 	AdminDeleteWorkflow                                       = clientOperation("admin-delete-workflow")
 	MaintainCorruptWorkflow                                   = clientOperation("maintain-corrupt-workflow")
 	AdminClientOperationUpdateTaskListPartitionConfig         = clientOperation("admin-update-task-list-partition-config")
+	AdminClientOperationRollbackDomain                        = clientOperation("admin-rollback-domain")
 
 	FrontendClientOperationDeleteDomain                          = clientOperation("frontend-delete-domain")
 	FrontendClientOperationDeprecateDomain                       = clientOperation("frontend-deprecate-domain")
@@ -388,6 +390,7 @@ var (
 	FrontendClientOperationUpdateDomain                          = clientOperation("frontend-update-domain")
 	FrontendClientOperationFailoverDomain                        = clientOperation("frontend-failover-domain")
 	FrontendClientOperationListFailoverHistory                   = clientOperation("frontend-list-failover-history")
+	FrontendClientOperationListDomainChanges                     = clientOperation("frontend-list-domain-changes")
 	FrontendClientOperationGetClusterInfo                        = clientOperation("frontend-get-cluster-info")
 	FrontendClientOperationListTaskListPartitions                = clientOperation("frontend-list-task-list-partitions")
 	FrontendClientOperationGetTaskListsByDomain                  = clientOperation("frontend-get-task-list-for-domain")
@@ -405,6 +408,7 @@ var (
 	HistoryClientOperationCloseShard                        = clientOperation("history-close-shard")
 	HistoryClientOperationResetQueue                        = clientOperation("history-reset-queue")
 	HistoryClientOperationDescribeQueue                     = clientOperation("history-describe-queue")
+	HistoryClientOperationUpdateVirtualQueue                = clientOperation("history-update-virtual-queue")
 	HistoryClientOperationRemoveTask                        = clientOperation("history-remove-task")
 	HistoryClientOperationDescribeMutableState              = clientOperation("history-describe-mutable-state")
 	HistoryClientOperationGetMutableState                   = clientOperation("history-get-mutable-state")
@@ -466,7 +470,13 @@ var (
 
 	ShardDistributorClientOperationGetShardOwner       = clientOperation("shard-distributor-get-shard-owner")
 	ShardDistributorClientOperationWatchNamespaceState = clientOperation("shard-distributor-watch-namespace-state")
+	ShardDistributorClientOperationListNamespaces      = clientOperation("shard-distributor-list-namespaces")
+	ShardDistributorClientOperationListExecutors       = clientOperation("shard-distributor-list-executors")
+	ShardDistributorClientOperationDescribeShard       = clientOperation("shard-distributor-describe-shard")
+	ShardDistributorClientOperationTriggerRebalance    = clientOperation("shard-distributor-trigger-rebalance")
+	ShardDistributorClientOperationMoveShard           = clientOperation("shard-distributor-move-shard")
 	ShardDistributorExecutorClientOperationHeartbeat   = clientOperation("shard-distributor-executor-heartbeat")
+	ShardDistributorExecutorClientOperationDrain       = clientOperation("shard-distributor-executor-drain")
 )
 
 // Pre-defined values for TagIDType
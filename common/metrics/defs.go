@@ -342,6 +342,8 @@ const (
 	HistoryClientResetQueueScope
 	// HistoryClientDescribeQueueScope tracks RPC calls to history service
 	HistoryClientDescribeQueueScope
+	// HistoryClientUpdateVirtualQueueScope tracks RPC calls to history service
+	HistoryClientUpdateVirtualQueueScope
 	// HistoryClientRecordActivityTaskHeartbeatScope tracks RPC calls to history service
 	HistoryClientRecordActivityTaskHeartbeatScope
 	// HistoryClientRespondDecisionTaskCompletedScope tracks RPC calls to history service
@@ -534,6 +536,8 @@ const (
 	FrontendClientFailoverDomainScope
 	// FrontendClientListFailoverHistoryScope tracks RPC calls to frontend service
 	FrontendClientListFailoverHistoryScope
+	// FrontendClientListDomainChangesScope tracks RPC calls to frontend service
+	FrontendClientListDomainChangesScope
 	// FrontendClientCreateScheduleScope tracks RPC calls to frontend service
 	FrontendClientCreateScheduleScope
 	// FrontendClientDescribeScheduleScope tracks RPC calls to frontend service
@@ -583,6 +587,8 @@ const (
 	AdminClientResetQueueScope
 	// AdminClientDescribeQueueScope tracks RPC calls to admin service
 	AdminClientDescribeQueueScope
+	// AdminClientUpdateVirtualQueueScope tracks RPC calls to admin service
+	AdminClientUpdateVirtualQueueScope
 	// AdminClientDescribeHistoryHostScope tracks RPC calls to admin service
 	AdminClientDescribeHistoryHostScope
 	// AdminClientDescribeShardDistributionScope tracks RPC calls to admin service
@@ -645,6 +651,8 @@ const (
 	AdminClientUpdateDomainAsyncWorkflowConfiguratonScope
 	// AdminClientUpdateTaskListPartitionConfigScope is the metrics scope for admin.UpdateTaskListPartitionConfig
 	AdminClientUpdateTaskListPartitionConfigScope
+	// AdminClientRollbackDomainScope is the metrics scope for admin.RollbackDomain
+	AdminClientRollbackDomainScope
 
 	// DCRedirectionDeleteDomainScope tracks RPC calls for dc redirection
 	DCRedirectionDeleteDomainScope
@@ -930,12 +938,30 @@ const (
 	// ShardDistributorClientGetShardOwnerScope tracks GetShardOwner calls made by service to shard distributor
 	ShardDistributorClientGetShardOwnerScope
 
+	// ShardDistributorClientListNamespacesScope tracks ListNamespaces calls made by service to shard distributor
+	ShardDistributorClientListNamespacesScope
+
+	// ShardDistributorClientListExecutorsScope tracks ListExecutors calls made by service to shard distributor
+	ShardDistributorClientListExecutorsScope
+
+	// ShardDistributorClientDescribeShardScope tracks DescribeShard calls made by service to shard distributor
+	ShardDistributorClientDescribeShardScope
+
+	// ShardDistributorClientTriggerRebalanceScope tracks TriggerRebalance calls made by service to shard distributor
+	ShardDistributorClientTriggerRebalanceScope
+
+	// ShardDistributorClientMoveShardScope tracks MoveShard calls made by service to shard distributor
+	ShardDistributorClientMoveShardScope
+
 	// ShardDistributorClientWatchNamespaceStateScope tracks WatchNamespaceState calls made by service to shard distributor
 	ShardDistributorClientWatchNamespaceStateScope
 
 	// ShardDistributorExecutorClientHeartbeatScope tracks Heartbeat calls made by executor to shard distributor
 	ShardDistributorExecutorClientHeartbeatScope
 
+	// ShardDistributorExecutorClientDrainScope tracks Drain calls made by executor to shard distributor
+	ShardDistributorExecutorClientDrainScope
+
 	// LoadBalancerScope is the metrics scope for Round Robin load balancer
 	LoadBalancerScope
 
@@ -982,6 +1008,8 @@ const (
 	AdminResetQueueScope
 	// AdminDescribeQueueScope is the metrics scope for admin.AdminDescribeQueueScope
 	AdminDescribeQueueScope
+	// AdminUpdateVirtualQueueScope is the metrics scope for admin.AdminUpdateVirtualQueueScope
+	AdminUpdateVirtualQueueScope
 	// AdminCountDLQMessagesScope is the metric scope for admin.AdminCountDLQMessagesScope
 	AdminCountDLQMessagesScope
 	// AdminReadDLQMessagesScope is the metric scope for admin.AdminReadDLQMessagesScope
@@ -1020,6 +1048,8 @@ const (
 	UpdateDomainAsyncWorkflowConfiguraton
 	// UpdateTaskListPartitionConfig is the scope for update task list partition config
 	UpdateTaskListPartitionConfig
+	// AdminRollbackDomainScope is the metric scope for admin.RollbackDomain
+	AdminRollbackDomainScope
 
 	NumAdminScopes
 )
@@ -1100,6 +1130,8 @@ const (
 	FrontendFailoverDomainScope
 	// FrontendListFailoverHistoryScope is the metric scope for frontend.ListFailoverHistory
 	FrontendListFailoverHistoryScope
+	// FrontendListDomainChangesScope is the metric scope for frontend.ListDomainChanges
+	FrontendListDomainChangesScope
 	// FrontendQueryWorkflowScope is the metric scope for frontend.QueryWorkflow
 	FrontendQueryWorkflowScope
 	// FrontendDescribeWorkflowExecutionScope is the metric scope for frontend.DescribeWorkflowExecution
@@ -1167,6 +1199,8 @@ const (
 	HistoryResetQueueScope
 	// HistoryDescribeQueueScope tracks DescribeQueue API calls received by service
 	HistoryDescribeQueueScope
+	// HistoryUpdateVirtualQueueScope tracks UpdateVirtualQueue API calls received by service
+	HistoryUpdateVirtualQueueScope
 	// HistoryDescribeMutabelStateScope tracks DescribeMutableState API calls received by service
 	HistoryDescribeMutabelStateScope
 	// HistoryGetMutableStateScope tracks GetMutableState API calls received by service
@@ -1527,6 +1561,12 @@ const (
 	ShardDistributorGetShardOwnerScope = iota + NumWorkerScopes
 	ShardDistributorWatchNamespaceStateScope
 	ShardDistributorHeartbeatScope
+	ShardDistributorDrainScope
+	ShardDistributorListNamespacesScope
+	ShardDistributorListExecutorsScope
+	ShardDistributorDescribeShardScope
+	ShardDistributorTriggerRebalanceScope
+	ShardDistributorMoveShardScope
 	ShardDistributorAssignLoopScope
 
 	ShardDistributorStoreGetShardOwnerScope
@@ -1542,6 +1582,7 @@ const (
 	ShardDistributorStoreSubscribeToExecutorStatusChangesScope
 	ShardDistributorStoreSubscribeToAssignmentChangesScope
 	ShardDistributorStoreDeleteAssignedStatesScope
+	ShardDistributorStoreTriggerRebalanceScope
 
 	// The scope for the shard distributor executor
 	ShardDistributorExecutorScope
@@ -1662,6 +1703,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		HistoryClientCloseShardScope:                        {operation: "HistoryClientCloseShard", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientResetQueueScope:                        {operation: "HistoryClientResetQueue", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientDescribeQueueScope:                     {operation: "HistoryClientDescribeQueue", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUpdateVirtualQueueScope:                {operation: "HistoryClientUpdateVirtualQueue", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRecordActivityTaskHeartbeatScope:       {operation: "HistoryClientRecordActivityTaskHeartbeat", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRespondDecisionTaskCompletedScope:      {operation: "HistoryClientRespondDecisionTaskCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRespondDecisionTaskFailedScope:         {operation: "HistoryClientRespondDecisionTaskFailed", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
@@ -1758,6 +1800,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		FrontendClientUpdateDomainScope:                          {operation: "FrontendClientUpdateDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientFailoverDomainScope:                        {operation: "FrontendClientFailoverDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientListFailoverHistoryScope:                   {operation: "FrontendClientListFailoverHistory", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientListDomainChangesScope:                     {operation: "FrontendClientListDomainChanges", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientListWorkflowExecutionsScope:                {operation: "FrontendClientListWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientScanWorkflowExecutionsScope:                {operation: "FrontendClientScanWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientCountWorkflowExecutionsScope:               {operation: "FrontendClientCountWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1792,6 +1835,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		AdminClientRemoveTaskScope:                            {operation: "AdminClientRemoveTask", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientResetQueueScope:                            {operation: "AdminClientResetQueue", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientDescribeQueueScope:                         {operation: "AdminClientDescribeQueue", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientUpdateVirtualQueueScope:                    {operation: "AdminClientUpdateVirtualQueue", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientCountDLQMessagesScope:                      {operation: "AdminClientCountDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientReadDLQMessagesScope:                       {operation: "AdminClientReadDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientPurgeDLQMessagesScope:                      {operation: "AdminClientPurgeDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
@@ -1815,6 +1859,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		AdminClientGetDomainAsyncWorkflowConfiguratonScope:    {operation: "AdminClientGetDomainAsyncWorkflowConfiguraton", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientUpdateDomainAsyncWorkflowConfiguratonScope: {operation: "AdminClientUpdateDomainAsyncWorkflowConfiguraton", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientUpdateTaskListPartitionConfigScope:         {operation: "AdminClientUpdateTaskListPartitionConfig", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientRollbackDomainScope:                        {operation: "AdminClientRollbackDomain", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 
 		DCRedirectionDeleteDomainScope:                          {operation: "DCRedirectionDeleteDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDeprecateDomainScope:                       {operation: "DCRedirectionDeprecateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1951,8 +1996,14 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		PartitionConfigProviderScope: {operation: "PartitionConfigProvider"},
 
 		ShardDistributorClientGetShardOwnerScope:       {operation: "ShardDistributorClientGetShardOwner"},
+		ShardDistributorClientListNamespacesScope:      {operation: "ShardDistributorClientListNamespaces"},
+		ShardDistributorClientListExecutorsScope:       {operation: "ShardDistributorClientListExecutors"},
+		ShardDistributorClientDescribeShardScope:       {operation: "ShardDistributorClientDescribeShard"},
+		ShardDistributorClientTriggerRebalanceScope:    {operation: "ShardDistributorClientTriggerRebalance"},
+		ShardDistributorClientMoveShardScope:           {operation: "ShardDistributorClientMoveShard"},
 		ShardDistributorClientWatchNamespaceStateScope: {operation: "ShardDistributorClientWatchNamespaceState"},
 		ShardDistributorExecutorClientHeartbeatScope:   {operation: "ShardDistributorExecutorHeartbeat"},
+		ShardDistributorExecutorClientDrainScope:       {operation: "ShardDistributorExecutorDrain"},
 
 		LoadBalancerScope: {operation: "RRLoadBalancer"},
 
@@ -1966,6 +2017,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		AdminCloseShardScope:                        {operation: "AdminCloseShard"},
 		AdminResetQueueScope:                        {operation: "AdminResetQueue"},
 		AdminDescribeQueueScope:                     {operation: "AdminDescribeQueue"},
+		AdminUpdateVirtualQueueScope:                {operation: "AdminUpdateVirtualQueue"},
 		AdminCountDLQMessagesScope:                  {operation: "AdminCountDLQMessages"},
 		AdminReadDLQMessagesScope:                   {operation: "AdminReadDLQMessages"},
 		AdminPurgeDLQMessagesScope:                  {operation: "AdminPurgeDLQMessages"},
@@ -1997,6 +2049,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		GetDomainAsyncWorkflowConfiguraton:          {operation: "GetDomainAsyncWorkflowConfiguraton"},
 		UpdateDomainAsyncWorkflowConfiguraton:       {operation: "UpdateDomainAsyncWorkflowConfiguraton"},
 		UpdateTaskListPartitionConfig:               {operation: "UpdateTaskListPartitionConfig"},
+		AdminRollbackDomainScope:                    {operation: "AdminRollbackDomain"},
 
 		FrontendRestartWorkflowExecutionScope:              {operation: "RestartWorkflowExecution"},
 		FrontendStartWorkflowExecutionScope:                {operation: "StartWorkflowExecution"},
@@ -2037,6 +2090,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		FrontendDeprecateDomainScope:                       {operation: "DeprecateDomain"},
 		FrontendFailoverDomainScope:                        {operation: "FailoverDomain"},
 		FrontendListFailoverHistoryScope:                   {operation: "ListFailoverHistory"},
+		FrontendListDomainChangesScope:                     {operation: "ListDomainChanges"},
 		FrontendQueryWorkflowScope:                         {operation: "QueryWorkflow"},
 		FrontendDescribeWorkflowExecutionScope:             {operation: "DescribeWorkflowExecution"},
 		FrontendDiagnoseWorkflowExecutionScope:             {operation: "DiagnoseWorkflowExecution"},
@@ -2068,6 +2122,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		HistoryRespondActivityTaskCanceledScope:                         {operation: "RespondActivityTaskCanceled"},
 		HistoryResetQueueScope:                                          {operation: "ResetQueue"},
 		HistoryDescribeQueueScope:                                       {operation: "DescribeQueue"},
+		HistoryUpdateVirtualQueueScope:                                  {operation: "UpdateVirtualQueue"},
 		HistoryDescribeMutabelStateScope:                                {operation: "DescribeMutableState"},
 		HistoryGetMutableStateScope:                                     {operation: "GetMutableState"},
 		HistoryPollMutableStateScope:                                    {operation: "PollMutableState"},
@@ -2248,6 +2303,12 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		ShardDistributorGetShardOwnerScope:                         {operation: "GetShardOwner"},
 		ShardDistributorWatchNamespaceStateScope:                   {operation: "WatchNamespaceState"},
 		ShardDistributorHeartbeatScope:                             {operation: "ExecutorHeartbeat"},
+		ShardDistributorDrainScope:                                 {operation: "ExecutorDrain"},
+		ShardDistributorListNamespacesScope:                        {operation: "ListNamespaces"},
+		ShardDistributorListExecutorsScope:                         {operation: "ListExecutors"},
+		ShardDistributorDescribeShardScope:                         {operation: "DescribeShard"},
+		ShardDistributorTriggerRebalanceScope:                      {operation: "TriggerRebalance"},
+		ShardDistributorMoveShardScope:                             {operation: "MoveShard"},
 		ShardDistributorAssignLoopScope:                            {operation: "ShardAssignLoop"},
 		ShardDistributorExecutorScope:                              {operation: "Executor"},
 		ShardDistributorStoreGetShardOwnerScope:                    {operation: "StoreGetShardOwner"},
@@ -2263,6 +2324,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		ShardDistributorStoreSubscribeToExecutorStatusChangesScope: {operation: "StoreSubscribeToExecutorStatusChanges"},
 		ShardDistributorStoreSubscribeToAssignmentChangesScope:     {operation: "StoreSubscribeToAssignmentChanges"},
 		ShardDistributorStoreDeleteAssignedStatesScope:             {operation: "StoreDeleteAssignedStates"},
+		ShardDistributorStoreTriggerRebalanceScope:                 {operation: "StoreTriggerRebalance"},
 		ShardDistributorWatchScope:                                 {operation: "Watch"},
 	},
 }
@@ -2902,6 +2964,8 @@ const (
 	VirtualQueueCountGauge
 	VirtualQueuePausedGauge
 	VirtualQueueRunningGauge
+	VirtualQueueAlertCounter
+	VirtualQueueMitigationCounter
 
 	TaskRequestsPerTaskList
 	TaskLatencyPerTaskListHistogram
@@ -3776,6 +3840,8 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		VirtualQueueCountGauge:                                       {metricName: "virtual_queue_count", metricType: Gauge},
 		VirtualQueuePausedGauge:                                      {metricName: "virtual_queue_paused", metricType: Gauge},
 		VirtualQueueRunningGauge:                                     {metricName: "virtual_queue_running", metricType: Gauge},
+		VirtualQueueAlertCounter:                                     {metricName: "virtual_queue_alert", metricType: Counter},
+		VirtualQueueMitigationCounter:                                {metricName: "virtual_queue_mitigation", metricType: Counter},
 	},
 	Matching: {
 		PollSuccessPerTaskListCounter:                           {metricName: "poll_success_per_tl", metricRollupName: "poll_success"},
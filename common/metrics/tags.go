@@ -384,6 +384,11 @@ func DecisionTag(decision string) Tag {
 	return metricWithUnknown("decision", decision)
 }
 
+// AlertTypeTag returns a new alert type tag
+func AlertTypeTag(alertType string) Tag {
+	return metricWithUnknown("alert_type", alertType)
+}
+
 // ActiveClusterLookupFnTag returns a new active cluster lookup function tag.
 func ActiveClusterLookupFnTag(fn string) Tag {
 	return metricWithUnknown("fn", fn)
@@ -63,6 +63,9 @@ type (
 		GetExecutionManager(int) (persistence.ExecutionManager, error)
 		SetExecutionManager(int, persistence.ExecutionManager)
 
+		GetQueueManager(persistence.QueueType) (persistence.QueueManager, error)
+		SetQueueManager(persistence.QueueType, persistence.QueueManager)
+
 		GetConfigStoreManager() persistence.ConfigStoreManager
 		SetConfigStoreManager(persistence.ConfigStoreManager)
 	}
@@ -78,9 +81,11 @@ type (
 		historyManager                persistence.HistoryManager
 		configStoreManager            persistence.ConfigStoreManager
 		executionManagerFactory       persistence.ExecutionManagerFactory
+		queueManagerFactory           persistence.QueueManagerFactory
 
 		sync.RWMutex
 		shardIDToExecutionManager map[int]persistence.ExecutionManager
+		queueTypeToQueueManager   map[persistence.QueueType]persistence.QueueManager
 	}
 
 	// Params contains dependencies for persistence
@@ -154,6 +159,7 @@ func NewBeanFromFactory(
 		historyMgr,
 		configStoreMgr,
 		factory,
+		factory,
 	), nil
 }
 
@@ -168,6 +174,7 @@ func NewBean(
 	historyManager persistence.HistoryManager,
 	configStoreManager persistence.ConfigStoreManager,
 	executionManagerFactory persistence.ExecutionManagerFactory,
+	queueManagerFactory persistence.QueueManagerFactory,
 ) *BeanImpl {
 	return &BeanImpl{
 		domainManager:                 domainManager,
@@ -179,8 +186,10 @@ func NewBean(
 		historyManager:                historyManager,
 		configStoreManager:            configStoreManager,
 		executionManagerFactory:       executionManagerFactory,
+		queueManagerFactory:           queueManagerFactory,
 
 		shardIDToExecutionManager: make(map[int]persistence.ExecutionManager),
+		queueTypeToQueueManager:   make(map[persistence.QueueType]persistence.QueueManager),
 	}
 }
 
@@ -366,6 +375,48 @@ func (s *BeanImpl) SetExecutionManager(
 	s.shardIDToExecutionManager[shardID] = executionManager
 }
 
+// GetQueueManager get QueueManager for a given queue type
+func (s *BeanImpl) GetQueueManager(
+	queueType persistence.QueueType,
+) (persistence.QueueManager, error) {
+
+	s.RLock()
+	queueManager, ok := s.queueTypeToQueueManager[queueType]
+	if ok {
+		s.RUnlock()
+		return queueManager, nil
+	}
+	s.RUnlock()
+
+	s.Lock()
+	defer s.Unlock()
+
+	queueManager, ok = s.queueTypeToQueueManager[queueType]
+	if ok {
+		return queueManager, nil
+	}
+
+	queueManager, err := s.queueManagerFactory.NewQueueManager(queueType)
+	if err != nil {
+		return nil, err
+	}
+
+	s.queueTypeToQueueManager[queueType] = queueManager
+	return queueManager, nil
+}
+
+// SetQueueManager set QueueManager for a given queue type
+func (s *BeanImpl) SetQueueManager(
+	queueType persistence.QueueType,
+	queueManager persistence.QueueManager,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.queueTypeToQueueManager[queueType] = queueManager
+}
+
 // GetConfigStoreManager gets ConfigStoreManager
 func (s *BeanImpl) GetConfigStoreManager() persistence.ConfigStoreManager {
 
@@ -409,4 +460,7 @@ func (s *BeanImpl) Close() {
 	for _, executionMgr := range s.shardIDToExecutionManager {
 		executionMgr.Close()
 	}
+	for _, queueMgr := range s.queueTypeToQueueManager {
+		queueMgr.Close()
+	}
 }
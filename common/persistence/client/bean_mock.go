@@ -138,6 +138,21 @@ func (mr *MockBeanMockRecorder) GetHistoryManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryManager", reflect.TypeOf((*MockBean)(nil).GetHistoryManager))
 }
 
+// GetQueueManager mocks base method.
+func (m *MockBean) GetQueueManager(arg0 persistence.QueueType) (persistence.QueueManager, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueueManager", arg0)
+	ret0, _ := ret[0].(persistence.QueueManager)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueueManager indicates an expected call of GetQueueManager.
+func (mr *MockBeanMockRecorder) GetQueueManager(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueueManager", reflect.TypeOf((*MockBean)(nil).GetQueueManager), arg0)
+}
+
 // GetShardManager mocks base method.
 func (m *MockBean) GetShardManager() persistence.ShardManager {
 	m.ctrl.T.Helper()
@@ -252,6 +267,18 @@ func (mr *MockBeanMockRecorder) SetHistoryManager(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHistoryManager", reflect.TypeOf((*MockBean)(nil).SetHistoryManager), arg0)
 }
 
+// SetQueueManager mocks base method.
+func (m *MockBean) SetQueueManager(arg0 persistence.QueueType, arg1 persistence.QueueManager) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetQueueManager", arg0, arg1)
+}
+
+// SetQueueManager indicates an expected call of SetQueueManager.
+func (mr *MockBeanMockRecorder) SetQueueManager(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQueueManager", reflect.TypeOf((*MockBean)(nil).SetQueueManager), arg0, arg1)
+}
+
 // SetShardManager mocks base method.
 func (m *MockBean) SetShardManager(arg0 persistence.ShardManager) {
 	m.ctrl.T.Helper()
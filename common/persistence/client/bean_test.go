@@ -242,6 +242,31 @@ func TestBeanCoverage(t *testing.T) {
 		g.Go(errgroupAssertSetsExecutionManager(t, 2, ex2, impl))
 		require.NoError(t, g.Wait())
 	})
+	t.Run("Queue manager getter and setter", func(t *testing.T) {
+		t.Parallel()
+		f, m, defaultMocks := beanSetup(t)
+
+		q1, q2 := persistence.NewMockQueueManager(m.mockCtrl), persistence.NewMockQueueManager(m.mockCtrl)
+		f.EXPECT().NewQueueManager(persistence.AsyncWorkflowQueueTypeStart).Return(q1, nil).Times(1)
+		f.EXPECT().NewQueueManager(persistence.AsyncWorkflowQueueTypeStart+1).Return(nil, fmt.Errorf("no queue manager")).Times(1)
+		defaultMocks()
+		impl, err := NewBeanFromFactory(f, nil, nil)
+		require.NoError(t, err)
+
+		// the `.Times(1)` ensures that re-getting does not construct a new one
+		for i := 0; i < 2; i++ {
+			v, err := impl.GetQueueManager(persistence.AsyncWorkflowQueueTypeStart)
+			require.NoError(t, err)
+			assertMocksEqual(t, q1, v)
+		}
+		_, err = impl.GetQueueManager(persistence.AsyncWorkflowQueueTypeStart + 1)
+		assert.ErrorContains(t, err, "no queue manager")
+
+		impl.SetQueueManager(persistence.AsyncWorkflowQueueTypeStart, q2)
+		v, err := impl.GetQueueManager(persistence.AsyncWorkflowQueueTypeStart)
+		require.NoError(t, err)
+		assertMocksEqual(t, q2, v)
+	})
 	t.Run("Lifecycle", func(t *testing.T) {
 		t.Parallel()
 		f, m, defaultMocks := beanSetup(t)
@@ -262,6 +287,8 @@ func TestBeanCoverage(t *testing.T) {
 		m.configManager.EXPECT().Close().Return().Times(1)
 		ex1.EXPECT().Close().Return().Times(1)
 		ex2.EXPECT().Close().Return().Times(1)
+		queueManager := persistence.NewMockQueueManager(m.mockCtrl)
+		queueManager.EXPECT().Close().Return().Times(1)
 		// which includes the execution-manager-factory itself
 		f.EXPECT().Close().Return().Times(1)
 
@@ -276,6 +303,7 @@ func TestBeanCoverage(t *testing.T) {
 		v, err = impl.GetExecutionManager(2)
 		require.NoError(t, err)
 		require.NotNil(t, v)
+		impl.SetQueueManager(persistence.AsyncWorkflowQueueTypeStart, queueManager)
 
 		// ensure everything is closed
 		impl.Close()
@@ -144,6 +144,21 @@ func (mr *MockFactoryMockRecorder) NewHistoryManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewHistoryManager", reflect.TypeOf((*MockFactory)(nil).NewHistoryManager))
 }
 
+// NewQueueManager mocks base method.
+func (m *MockFactory) NewQueueManager(queueType persistence.QueueType) (persistence.QueueManager, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewQueueManager", queueType)
+	ret0, _ := ret[0].(persistence.QueueManager)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewQueueManager indicates an expected call of NewQueueManager.
+func (mr *MockFactoryMockRecorder) NewQueueManager(queueType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewQueueManager", reflect.TypeOf((*MockFactory)(nil).NewQueueManager), queueType)
+}
+
 // NewShardManager mocks base method.
 func (m *MockFactory) NewShardManager() (persistence.ShardManager, error) {
 	m.ctrl.T.Helper()
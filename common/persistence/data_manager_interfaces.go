@@ -102,6 +102,13 @@ const (
 	DomainReplicationQueueType QueueType = iota + 1
 )
 
+// Queue types used by the persistence backed async workflow queues,
+// each queue uses its own queue type in [AsyncWorkflowQueueTypeStart, AsyncWorkflowQueueTypeStart+MaxAsyncWorkflowQueues)
+const (
+	AsyncWorkflowQueueTypeStart QueueType = 1000
+	MaxAsyncWorkflowQueues                = 1000
+)
+
 // Create Workflow Execution Mode
 const (
 	// CreateWorkflowModeBrandNew Fail if current record exists
@@ -569,6 +576,8 @@ type (
 		Expiry                        time.Time
 		CreatedTime                   time.Time
 		PartitionConfig               map[string]string
+		Priority                      int32
+		FairnessKey                   string
 	}
 
 	// TaskKey gives primary key info for a specific task
@@ -646,6 +655,8 @@ type (
 		StartedIdentity    string
 		TaskList           string
 		TaskListKind       types.TaskListKind
+		Priority           int32
+		FairnessKey        string
 		HasRetryPolicy     bool
 		InitialInterval    int32
 		BackoffCoefficient float64
@@ -1673,6 +1684,11 @@ type (
 		NewExecutionManager(shardID int) (ExecutionManager, error)
 	}
 
+	// QueueManagerFactory creates a QueueManager for a given queue type
+	QueueManagerFactory interface {
+		NewQueueManager(queueType QueueType) (QueueManager, error)
+	}
+
 	// TaskManager is used to manage tasks
 	TaskManager interface {
 		Closeable
@@ -376,7 +376,7 @@ func TestTimeTaskInfo(t *testing.T) {
 }
 
 func TestShardInfoCopy(t *testing.T) {
-	f := fuzz.New().NilChance(0.1)
+	f := fuzz.New().NilChance(0.1).MaxDepth(12) // queue state predicates are recursive
 	info := &ShardInfo{}
 	for i := 0; i < 1000; i++ {
 		f.Fuzz(info)
@@ -461,6 +461,8 @@ type (
 		StartedIdentity    string
 		TaskList           string
 		TaskListKind       types.TaskListKind
+		Priority           int32
+		FairnessKey        string
 		HasRetryPolicy     bool
 		InitialInterval    time.Duration
 		BackoffCoefficient float64
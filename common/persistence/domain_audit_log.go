@@ -1,7 +1,11 @@
 package persistence
 
 import (
+	"encoding/json"
+	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/types"
@@ -225,3 +229,139 @@ func (auditLog *DomainAuditLog) mapClusterAttributeChangeToClusterFailover(scope
 	}
 	return clusterFailovers
 }
+
+// ToDomainChange maps a DomainAuditLog to a DomainChange which lists the domain configuration
+// fields changed by the operation with their values before and after it. Map-like configuration,
+// such as data, bad binaries, isolation groups and cluster attributes, is compared per key so that
+// a single added or removed entry shows up as a single field change.
+func (auditLog *DomainAuditLog) ToDomainChange() *types.DomainChange {
+	return &types.DomainChange{
+		ID:            auditLog.EventID,
+		CreatedTime:   auditLog.CreatedTime.UnixNano(),
+		OperationType: auditLog.OperationType.String(),
+		Identity:      auditLog.Identity,
+		IdentityType:  auditLog.IdentityType,
+		Comment:       auditLog.Comment,
+		FieldChanges:  DiffDomainStates(auditLog.StateBefore, auditLog.StateAfter),
+	}
+}
+
+// DiffDomainStates returns the user facing configuration fields that differ between two states of a domain,
+// sorted by field. A nil state has no fields set.
+func DiffDomainStates(before, after *GetDomainResponse) []*types.DomainFieldChange {
+	beforeFields := flattenDomainState(before)
+	afterFields := flattenDomainState(after)
+
+	fields := make([]string, 0, len(beforeFields)+len(afterFields))
+	for field := range beforeFields {
+		fields = append(fields, field)
+	}
+	for field := range afterFields {
+		if _, ok := beforeFields[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+
+	var fieldChanges []*types.DomainFieldChange
+	for _, field := range fields {
+		if beforeFields[field] == afterFields[field] {
+			continue
+		}
+		fieldChanges = append(fieldChanges, &types.DomainFieldChange{
+			Field:  field,
+			Before: beforeFields[field],
+			After:  afterFields[field],
+		})
+	}
+	return fieldChanges
+}
+
+// flattenDomainState renders the user facing configuration of a domain as a map from a field path
+// to its value. Fields with zero values are omitted so that unset and empty compare as equal.
+func flattenDomainState(state *GetDomainResponse) map[string]string {
+	fields := make(map[string]string)
+	set := func(field, value string) {
+		if value != "" {
+			fields[field] = value
+		}
+	}
+	if state == nil {
+		return fields
+	}
+
+	if info := state.Info; info != nil {
+		set("name", info.Name)
+		set("status", domainStatusString(info.Status))
+		set("description", info.Description)
+		set("ownerEmail", info.OwnerEmail)
+		for k, v := range info.Data {
+			set("data."+k, v)
+		}
+	}
+
+	if config := state.Config; config != nil {
+		if config.Retention != 0 {
+			set("retentionDays", strconv.Itoa(int(config.Retention)))
+		}
+		set("emitMetric", strconv.FormatBool(config.EmitMetric))
+		set("historyArchivalStatus", config.HistoryArchivalStatus.String())
+		set("historyArchivalURI", config.HistoryArchivalURI)
+		set("visibilityArchivalStatus", config.VisibilityArchivalStatus.String())
+		set("visibilityArchivalURI", config.VisibilityArchivalURI)
+		for checksum, info := range config.BadBinaries.Binaries {
+			set("badBinaries."+checksum, fmt.Sprintf("reason: %v, operator: %v", info.GetReason(), info.GetOperator()))
+		}
+		for name, partition := range config.IsolationGroups {
+			set("isolationGroups."+name, isolationGroupStateString(partition.State))
+		}
+		asyncConfig := config.AsyncWorkflowConfig
+		if asyncConfig.Enabled || asyncConfig.PredefinedQueueName != "" || asyncConfig.QueueType != "" {
+			if b, err := json.Marshal(asyncConfig); err == nil {
+				set("asyncWorkflowConfig", string(b))
+			}
+		}
+	}
+
+	if replicationConfig := state.ReplicationConfig; replicationConfig != nil {
+		set("activeClusterName", replicationConfig.ActiveClusterName)
+		clusters := make([]string, 0, len(replicationConfig.Clusters))
+		for _, cluster := range replicationConfig.Clusters {
+			if cluster != nil {
+				clusters = append(clusters, cluster.ClusterName)
+			}
+		}
+		sort.Strings(clusters)
+		set("clusters", strings.Join(clusters, ","))
+		for scope, scopeConfig := range replicationConfig.GetClusterAttributeScopes() {
+			for name, info := range scopeConfig.ClusterAttributes {
+				set(fmt.Sprintf("activeClusters.%v.%v", scope, name), info.ActiveClusterName)
+			}
+		}
+	}
+	return fields
+}
+
+func domainStatusString(status int) string {
+	switch status {
+	case DomainStatusRegistered:
+		return "REGISTERED"
+	case DomainStatusDeprecated:
+		return "DEPRECATED"
+	case DomainStatusDeleted:
+		return "DELETED"
+	default:
+		return strconv.Itoa(status)
+	}
+}
+
+func isolationGroupStateString(state types.IsolationGroupState) string {
+	switch state {
+	case types.IsolationGroupStateHealthy:
+		return "HEALTHY"
+	case types.IsolationGroupStateDrained:
+		return "DRAINED"
+	default:
+		return "INVALID"
+	}
+}
@@ -766,3 +766,138 @@ func stringPtr(s string) *string {
 func int64Ptr(i int64) *int64 {
 	return &i
 }
+
+func TestDomainAuditLog_ToDomainChange(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	state := func(modify func(*GetDomainResponse)) *GetDomainResponse {
+		s := &GetDomainResponse{
+			Info: &DomainInfo{
+				ID:          "domain-1",
+				Name:        "test-domain",
+				Description: "description",
+				Data:        map[string]string{"k1": "v1"},
+			},
+			Config: &DomainConfig{
+				Retention: 3,
+				BadBinaries: types.BadBinaries{Binaries: map[string]*types.BadBinaryInfo{
+					"checksum-1": {Reason: "bug", Operator: "alice"},
+				}},
+			},
+			ReplicationConfig: &DomainReplicationConfig{
+				ActiveClusterName: "cluster-a",
+				Clusters:          []*ClusterReplicationConfig{{ClusterName: "cluster-b"}, {ClusterName: "cluster-a"}},
+			},
+		}
+		if modify != nil {
+			modify(s)
+		}
+		return s
+	}
+
+	tests := map[string]struct {
+		auditLog *DomainAuditLog
+		expected []*types.DomainFieldChange
+	}{
+		"retention and archival update": {
+			auditLog: &DomainAuditLog{
+				StateBefore: state(nil),
+				StateAfter: state(func(s *GetDomainResponse) {
+					s.Config.Retention = 7
+					s.Config.HistoryArchivalStatus = types.ArchivalStatusEnabled
+					s.Config.HistoryArchivalURI = "file:///tmp/history"
+				}),
+			},
+			expected: []*types.DomainFieldChange{
+				{Field: "historyArchivalStatus", Before: "DISABLED", After: "ENABLED"},
+				{Field: "historyArchivalURI", After: "file:///tmp/history"},
+				{Field: "retentionDays", Before: "3", After: "7"},
+			},
+		},
+		"map entries are compared per key": {
+			auditLog: &DomainAuditLog{
+				StateBefore: state(nil),
+				StateAfter: state(func(s *GetDomainResponse) {
+					s.Info.Data = map[string]string{"k1": "v2", "k2": "v1"}
+					s.Config.BadBinaries = types.BadBinaries{}
+					s.Config.IsolationGroups = types.IsolationGroupConfiguration{
+						"zone-1": {Name: "zone-1", State: types.IsolationGroupStateDrained},
+					}
+				}),
+			},
+			expected: []*types.DomainFieldChange{
+				{Field: "badBinaries.checksum-1", Before: "reason: bug, operator: alice"},
+				{Field: "data.k1", Before: "v1", After: "v2"},
+				{Field: "data.k2", After: "v1"},
+				{Field: "isolationGroups.zone-1", After: "DRAINED"},
+			},
+		},
+		"active clusters update": {
+			auditLog: &DomainAuditLog{
+				StateBefore: state(func(s *GetDomainResponse) {
+					s.ReplicationConfig.ActiveClusters = &types.ActiveClusters{AttributeScopes: map[string]types.ClusterAttributeScope{
+						"region": {ClusterAttributes: map[string]types.ActiveClusterInfo{
+							"us-east": {ActiveClusterName: "cluster-a", FailoverVersion: 1},
+						}},
+					}}
+				}),
+				StateAfter: state(func(s *GetDomainResponse) {
+					s.ReplicationConfig.ActiveClusterName = "cluster-b"
+					s.ReplicationConfig.ActiveClusters = &types.ActiveClusters{AttributeScopes: map[string]types.ClusterAttributeScope{
+						"region": {ClusterAttributes: map[string]types.ActiveClusterInfo{
+							"us-east": {ActiveClusterName: "cluster-b", FailoverVersion: 2},
+						}},
+					}}
+				}),
+			},
+			expected: []*types.DomainFieldChange{
+				{Field: "activeClusterName", Before: "cluster-a", After: "cluster-b"},
+				{Field: "activeClusters.region.us-east", Before: "cluster-a", After: "cluster-b"},
+			},
+		},
+		"domain creation lists all the set fields": {
+			auditLog: &DomainAuditLog{
+				StateAfter: state(nil),
+			},
+			expected: []*types.DomainFieldChange{
+				{Field: "activeClusterName", After: "cluster-a"},
+				{Field: "badBinaries.checksum-1", After: "reason: bug, operator: alice"},
+				{Field: "clusters", After: "cluster-a,cluster-b"},
+				{Field: "data.k1", After: "v1"},
+				{Field: "description", After: "description"},
+				{Field: "emitMetric", After: "false"},
+				{Field: "historyArchivalStatus", After: "DISABLED"},
+				{Field: "name", After: "test-domain"},
+				{Field: "retentionDays", After: "3"},
+				{Field: "status", After: "REGISTERED"},
+				{Field: "visibilityArchivalStatus", After: "DISABLED"},
+			},
+		},
+		"no change": {
+			auditLog: &DomainAuditLog{
+				StateBefore: state(nil),
+				StateAfter:  state(nil),
+			},
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			td.auditLog.EventID = "event-1"
+			td.auditLog.CreatedTime = now
+			td.auditLog.OperationType = DomainAuditOperationTypeUpdate
+			td.auditLog.Identity = "cadence-cli"
+			td.auditLog.IdentityType = "cli"
+			td.auditLog.Comment = "domain updated"
+
+			assert.Equal(t, &types.DomainChange{
+				ID:            "event-1",
+				CreatedTime:   now.UnixNano(),
+				OperationType: "Update",
+				Identity:      "cadence-cli",
+				IdentityType:  "cli",
+				Comment:       "domain updated",
+				FieldChanges:  td.expected,
+			}, td.auditLog.ToDomainChange())
+		})
+	}
+}
@@ -321,6 +321,8 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			StartedIdentity:                         v.StartedIdentity,
 			TaskList:                                v.TaskList,
 			TaskListKind:                            v.TaskListKind,
+			Priority:                                v.Priority,
+			FairnessKey:                             v.FairnessKey,
 			HasRetryPolicy:                          v.HasRetryPolicy,
 			InitialInterval:                         int32(v.InitialInterval.Seconds()),
 			BackoffCoefficient:                      v.BackoffCoefficient,
@@ -451,6 +453,8 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			StartedIdentity:                         v.StartedIdentity,
 			TaskList:                                v.TaskList,
 			TaskListKind:                            v.TaskListKind,
+			Priority:                                v.Priority,
+			FairnessKey:                             v.FairnessKey,
 			HasRetryPolicy:                          v.HasRetryPolicy,
 			InitialInterval:                         common.SecondsToDuration(int64(v.InitialInterval)),
 			BackoffCoefficient:                      v.BackoffCoefficient,
@@ -59,7 +59,7 @@ func TestGetTasksResponseEstimatePayloadSize(t *testing.T) {
 			},
 		}
 
-		assert.Equal(t, uint64(175), response.ByteSize())
+		assert.Equal(t, uint64(199), response.ByteSize())
 	})
 
 	t.Run("response with bigger payload emits a bigger value", func(t *testing.T) {
@@ -78,7 +78,7 @@ func TestGetTasksResponseEstimatePayloadSize(t *testing.T) {
 			},
 		}
 
-		assert.Equal(t, uint64(193), response.ByteSize())
+		assert.Equal(t, uint64(217), response.ByteSize())
 	})
 }
 
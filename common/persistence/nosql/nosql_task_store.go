@@ -305,6 +305,8 @@ func (t *nosqlTaskStore) CreateTasks(
 			ScheduledID:     taskRequest.Data.ScheduleID,
 			CreatedTime:     currentTimeStamp,
 			PartitionConfig: taskRequest.Data.PartitionConfig,
+			Priority:        taskRequest.Data.Priority,
+			FairnessKey:     taskRequest.Data.FairnessKey,
 		}
 
 		var ttl int
@@ -411,6 +413,8 @@ func toTaskInfo(t *nosqlplugin.TaskRow) *persistence.TaskInfo {
 		ScheduleID:      t.ScheduledID,
 		CreatedTime:     t.CreatedTime,
 		PartitionConfig: t.PartitionConfig,
+		Priority:        t.Priority,
+		FairnessKey:     t.FairnessKey,
 	}
 }
 
@@ -368,6 +368,8 @@ func (db *CDB) InsertTasks(
 				scheduleID,
 				task.CreatedTime,
 				task.PartitionConfig,
+				task.Priority,
+				task.FairnessKey,
 				timeStamp,
 			)
 		} else {
@@ -386,6 +388,8 @@ func (db *CDB) InsertTasks(
 				scheduleID,
 				task.CreatedTime,
 				task.PartitionConfig,
+				task.Priority,
+				task.FairnessKey,
 				timeStamp,
 				ttl)
 		}
@@ -508,6 +512,10 @@ func createTaskInfo(
 			info.CreatedTime = v.(time.Time)
 		case "partition_config":
 			info.PartitionConfig = v.(map[string]string)
+		case "priority":
+			info.Priority = int32(v.(int))
+		case "fairness_key":
+			info.FairnessKey = v.(string)
 		}
 	}
 
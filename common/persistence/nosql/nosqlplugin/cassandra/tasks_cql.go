@@ -38,7 +38,9 @@ const (
 		`run_id: ?, ` +
 		`schedule_id: ?,` +
 		`created_time: ?, ` +
-		`partition_config: ? ` +
+		`partition_config: ?, ` +
+		`priority: ?, ` +
+		`fairness_key: ? ` +
 		`}`
 
 	templateCreateTaskQuery = `INSERT INTO tasks (` +
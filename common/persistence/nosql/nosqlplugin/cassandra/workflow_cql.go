@@ -165,6 +165,8 @@ const (
 		`attempt: ?, ` +
 		`task_list: ?, ` +
 		`task_list_kind: ?, ` +
+		`priority: ?, ` +
+		`fairness_key: ?, ` +
 		`started_identity: ?, ` +
 		`has_retry_policy: ?, ` +
 		`init_interval: ?, ` +
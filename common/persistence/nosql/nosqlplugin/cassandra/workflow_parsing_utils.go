@@ -305,6 +305,10 @@ func parseActivityInfo(
 			info.TaskList = v.(string)
 		case "task_list_kind":
 			info.TaskListKind = types.TaskListKind(int32(v.(int)))
+		case "priority":
+			info.Priority = int32(v.(int))
+		case "fairness_key":
+			info.FairnessKey = v.(string)
 		case "started_identity":
 			info.StartedIdentity = v.(string)
 		case "has_retry_policy":
@@ -1092,6 +1092,8 @@ func updateActivityInfos(
 			a.Attempt,
 			a.TaskList,
 			int32(a.TaskListKind),
+			a.Priority,
+			a.FairnessKey,
 			a.StartedIdentity,
 			a.HasRetryPolicy,
 			int32(a.InitialInterval.Seconds()),
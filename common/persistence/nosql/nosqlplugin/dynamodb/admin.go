@@ -21,14 +21,121 @@
 
 package dynamodb
 
-import "github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
 
 var _ nosqlplugin.AdminDB = (*ddb)(nil)
 
+const (
+	testSchemaDir = "schema/dynamodb/"
+)
+
+// TableSchema is an entry of a schema file, a CreateTable request plus the optional TTL attribute
+type TableSchema struct {
+	dynamodb.CreateTableInput
+	TimeToLiveAttribute string
+}
+
+// ParseSchema parses a schema file, which is a JSON list of tables
+func ParseSchema(content []byte) ([]*TableSchema, error) {
+	var tables []*TableSchema
+	if err := json.Unmarshal(content, &tables); err != nil {
+		return nil, fmt.Errorf("parsing schema: %w", err)
+	}
+	return tables, nil
+}
+
+// CreateTable creates a table of a schema file, with its name prefixed by tablePrefix, and waits until it is active
+func CreateTable(ctx context.Context, client dynamodbiface.DynamoDBAPI, tablePrefix string, table *TableSchema) error {
+	input := table.CreateTableInput
+	input.TableName = aws.String(tablePrefix + aws.StringValue(input.TableName))
+	if _, err := client.CreateTableWithContext(ctx, &input); err != nil {
+		return err
+	}
+	if err := client.WaitUntilTableExistsWithContext(ctx, &dynamodb.DescribeTableInput{TableName: input.TableName}); err != nil {
+		return err
+	}
+	if table.TimeToLiveAttribute == "" {
+		return nil
+	}
+	_, err := client.UpdateTimeToLiveWithContext(ctx, &dynamodb.UpdateTimeToLiveInput{
+		TableName: input.TableName,
+		TimeToLiveSpecification: &dynamodb.TimeToLiveSpecification{
+			AttributeName: aws.String(table.TimeToLiveAttribute),
+			Enabled:       aws.Bool(true),
+		},
+	})
+	return err
+}
+
+// DeleteTables deletes every table whose name starts with tablePrefix and waits until they are gone
+func DeleteTables(ctx context.Context, client dynamodbiface.DynamoDBAPI, tablePrefix string) error {
+	if tablePrefix == "" {
+		return errors.New("refusing to delete tables without a keyspace")
+	}
+	var tableNames []*string
+	err := client.ListTablesPagesWithContext(ctx, &dynamodb.ListTablesInput{}, func(out *dynamodb.ListTablesOutput, lastPage bool) bool {
+		for _, name := range out.TableNames {
+			if strings.HasPrefix(aws.StringValue(name), tablePrefix) {
+				tableNames = append(tableNames, name)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	for _, name := range tableNames {
+		if _, err := client.DeleteTableWithContext(ctx, &dynamodb.DeleteTableInput{TableName: name}); err != nil {
+			return err
+		}
+	}
+	for _, name := range tableNames {
+		if err := client.WaitUntilTableNotExistsWithContext(ctx, &dynamodb.DescribeTableInput{TableName: name}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (db *ddb) SetupTestDatabase(schemaBaseDir string, replicas int) error {
-	panic("TODO")
+	if schemaBaseDir == "" {
+		var err error
+		schemaBaseDir, err = nosqlplugin.GetDefaultTestSchemaDir(testSchemaDir)
+		if err != nil {
+			return err
+		}
+	}
+
+	content, err := os.ReadFile(schemaBaseDir + "cadence/schema.json")
+	if err != nil {
+		return err
+	}
+	tables, err := ParseSchema(content)
+	if err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if err := CreateTable(context.Background(), db.client, db.tablePrefix, table); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
+// TeardownTestDatabase deletes every table that has the prefix of this database
 func (db *ddb) TeardownTestDatabase() error {
-	panic("TODO")
+	return DeleteTables(context.Background(), db.client, db.tablePrefix)
 }
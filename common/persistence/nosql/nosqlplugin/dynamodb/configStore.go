@@ -22,15 +22,64 @@ package dynamodb
 
 import (
 	"context"
-	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+const (
+	tableConfigStore = "config_store"
+
+	attrRowType = "row_type"
 )
 
 func (db *ddb) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
-	return errors.New("TODO")
+	data, err := dataAttr(row)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(db.tableName(tableConfigStore)),
+		Item: item{
+			attrRowType: numberAttr(int64(row.RowType)),
+			attrVersion: numberAttr(row.Version),
+			attrData:    data,
+		},
+		ConditionExpression:      aws.String("attribute_not_exists(#version)"),
+		ExpressionAttributeNames: newExpression().name("version", attrVersion).names,
+	})
+	if isConditionalCheckFailed(err) {
+		return nosqlplugin.NewConditionFailure("InsertConfig operation failed because of version collision")
+	}
+	return err
 }
 
 func (db *ddb) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
-	return nil, errors.New("TODO")
+	expr := newExpression().
+		name("row_type", attrRowType).
+		value("row_type", numberAttr(int64(rowType)))
+	out, err := db.client.QueryWithContext(ctx, &dynamodb.QueryInput{
+		TableName:                 aws.String(db.tableName(tableConfigStore)),
+		KeyConditionExpression:    aws.String("#row_type = :row_type"),
+		ExpressionAttributeNames:  expr.names,
+		ExpressionAttributeValues: expr.values,
+		ScanIndexForward:          aws.Bool(false),
+		Limit:                     aws.Int64(1),
+		ConsistentRead:            aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Items) == 0 {
+		return nil, errNotFound
+	}
+
+	row := &persistence.InternalConfigStoreEntry{}
+	if err := getData(out.Items[0], row); err != nil {
+		return nil, err
+	}
+	return row, nil
 }
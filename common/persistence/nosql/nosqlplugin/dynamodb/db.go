@@ -21,8 +21,14 @@
 package dynamodb
 
 import (
+	"context"
 	"errors"
-	"fmt"
+	"net"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
@@ -34,23 +40,49 @@ const (
 	PluginName = "dynamodb"
 )
 
-var (
-	errConditionFailed = errors.New("internal condition fail error")
-)
+// errNotFound is returned by the select methods when the requested item doesn't exist,
+// DynamoDB itself returns an empty item instead of an error
+var errNotFound = errors.New("item not found")
 
 // ddb represents a logical connection to DynamoDB database
 type ddb struct {
+	client dynamodbiface.DynamoDBAPI
+	cfg    *config.NoSQL
+	logger log.Logger
+	// tablePrefix is prepended to every table name so that several clusters (or test runs) can share one account
+	tablePrefix string
 }
 
 var _ nosqlplugin.DB = (*ddb)(nil)
 
 // NewDynamoDB return a new DB
 func NewDynamoDB(cfg config.NoSQL, logger log.Logger) (nosqlplugin.DB, error) {
-	return nil, fmt.Errorf("TODO")
+	return newDynamoDB(&cfg, logger)
+}
+
+func newDynamoDB(cfg *config.NoSQL, logger log.Logger) (*ddb, error) {
+	client, err := NewClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return newDynamoDBWithClient(cfg, client, logger), nil
+}
+
+func newDynamoDBWithClient(cfg *config.NoSQL, client dynamodbiface.DynamoDBAPI, logger log.Logger) *ddb {
+	prefix := ""
+	if cfg.Keyspace != "" {
+		prefix = cfg.Keyspace + "_"
+	}
+	return &ddb{
+		client:      client,
+		cfg:         cfg,
+		logger:      logger,
+		tablePrefix: prefix,
+	}
 }
 
+// Close is a noop, the AWS client doesn't hold any connection that needs to be released
 func (db *ddb) Close() {
-	panic("TODO")
 }
 
 func (db *ddb) PluginName() string {
@@ -58,21 +90,56 @@ func (db *ddb) PluginName() string {
 }
 
 func (db *ddb) IsNotFoundError(err error) bool {
-	panic("TODO")
+	return errors.Is(err, errNotFound)
 }
 
 func (db *ddb) IsTimeoutError(err error) bool {
-	panic("TODO")
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) {
+		return false
+	}
+	switch aerr.Code() {
+	case request.CanceledErrorCode:
+		return errors.Is(aerr.OrigErr(), context.DeadlineExceeded)
+	case request.ErrCodeResponseTimeout:
+		return true
+	case request.ErrCodeRequestError:
+		var netErr net.Error
+		return errors.As(aerr.OrigErr(), &netErr) && netErr.Timeout()
+	}
+	return false
 }
 
 func (db *ddb) IsThrottlingError(err error) bool {
-	panic("TODO")
+	var aerr awserr.Error
+	if errors.As(err, &aerr) {
+		switch aerr.Code() {
+		case dynamodb.ErrCodeProvisionedThroughputExceededException,
+			dynamodb.ErrCodeRequestLimitExceeded,
+			"ThrottlingException":
+			return true
+		}
+	}
+	return false
 }
 
 func (db *ddb) IsDBUnavailableError(err error) bool {
-	panic("TODO")
+	var aerr awserr.Error
+	if errors.As(err, &aerr) {
+		switch aerr.Code() {
+		case dynamodb.ErrCodeInternalServerError, "ServiceUnavailable":
+			return true
+		case request.ErrCodeRequestError:
+			// connection failures (refused, reset, DNS) surface as request errors
+			return !db.IsTimeoutError(err)
+		}
+	}
+	return false
 }
 
-func (db *ddb) IsConditionFailedError(err error) bool {
-	return err == errConditionFailed
+func (db *ddb) tableName(table string) string {
+	return db.tablePrefix + table
 }
@@ -0,0 +1,161 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/testlogger"
+)
+
+// fakeClient implements the calls used by the plugin, every other call panics on the nil embedded interface
+type fakeClient struct {
+	dynamodbiface.DynamoDBAPI
+
+	getItem       func(*dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error)
+	putItem       func(*dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error)
+	deleteItem    func(*dynamodb.DeleteItemInput) (*dynamodb.DeleteItemOutput, error)
+	query         func(*dynamodb.QueryInput) (*dynamodb.QueryOutput, error)
+	batchWrite    func(*dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error)
+	transactWrite func(*dynamodb.TransactWriteItemsInput) (*dynamodb.TransactWriteItemsOutput, error)
+}
+
+func (c *fakeClient) GetItemWithContext(_ context.Context, input *dynamodb.GetItemInput, _ ...request.Option) (*dynamodb.GetItemOutput, error) {
+	return c.getItem(input)
+}
+
+func (c *fakeClient) PutItemWithContext(_ context.Context, input *dynamodb.PutItemInput, _ ...request.Option) (*dynamodb.PutItemOutput, error) {
+	return c.putItem(input)
+}
+
+func (c *fakeClient) DeleteItemWithContext(_ context.Context, input *dynamodb.DeleteItemInput, _ ...request.Option) (*dynamodb.DeleteItemOutput, error) {
+	return c.deleteItem(input)
+}
+
+func (c *fakeClient) QueryWithContext(_ context.Context, input *dynamodb.QueryInput, _ ...request.Option) (*dynamodb.QueryOutput, error) {
+	return c.query(input)
+}
+
+func (c *fakeClient) BatchWriteItemWithContext(_ context.Context, input *dynamodb.BatchWriteItemInput, _ ...request.Option) (*dynamodb.BatchWriteItemOutput, error) {
+	return c.batchWrite(input)
+}
+
+func (c *fakeClient) TransactWriteItemsWithContext(_ context.Context, input *dynamodb.TransactWriteItemsInput, _ ...request.Option) (*dynamodb.TransactWriteItemsOutput, error) {
+	return c.transactWrite(input)
+}
+
+func newTestDB(t *testing.T, client *fakeClient) *ddb {
+	return newDynamoDBWithClient(&config.NoSQL{Keyspace: "test"}, client, testlogger.New(t))
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+var _ net.Error = timeoutError{}
+
+func TestDDBBasics(t *testing.T) {
+	db := newTestDB(t, &fakeClient{})
+	assert.Equal(t, PluginName, db.PluginName())
+	assert.Equal(t, "test_shard", db.tableName(tableShard))
+	db.Close()
+}
+
+func TestErrorCheckers(t *testing.T) {
+	db := newTestDB(t, &fakeClient{})
+	tests := []struct {
+		name            string
+		err             error
+		wantNotFound    bool
+		wantTimeout     bool
+		wantThrottling  bool
+		wantUnavailable bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:         "not found",
+			err:          fmt.Errorf("wrapped: %w", errNotFound),
+			wantNotFound: true,
+		},
+		{
+			name:        "deadline exceeded",
+			err:         context.DeadlineExceeded,
+			wantTimeout: true,
+		},
+		{
+			name:        "response timeout",
+			err:         awserr.New(request.ErrCodeResponseTimeout, "timeout", nil),
+			wantTimeout: true,
+		},
+		{
+			name:        "network timeout",
+			err:         awserr.New(request.ErrCodeRequestError, "send request failed", timeoutError{}),
+			wantTimeout: true,
+		},
+		{
+			name:            "connection refused",
+			err:             awserr.New(request.ErrCodeRequestError, "send request failed", errors.New("connection refused")),
+			wantUnavailable: true,
+		},
+		{
+			name:           "throughput exceeded",
+			err:            awserr.New(dynamodb.ErrCodeProvisionedThroughputExceededException, "slow down", nil),
+			wantThrottling: true,
+		},
+		{
+			name:           "request limit exceeded",
+			err:            awserr.New(dynamodb.ErrCodeRequestLimitExceeded, "slow down", nil),
+			wantThrottling: true,
+		},
+		{
+			name:            "internal server error",
+			err:             awserr.New(dynamodb.ErrCodeInternalServerError, "oops", nil),
+			wantUnavailable: true,
+		},
+		{
+			name: "condition failed",
+			err:  awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "condition", nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantNotFound, db.IsNotFoundError(tc.err))
+			assert.Equal(t, tc.wantTimeout, db.IsTimeoutError(tc.err))
+			assert.Equal(t, tc.wantThrottling, db.IsThrottlingError(tc.err))
+			assert.Equal(t, tc.wantUnavailable, db.IsDBUnavailableError(tc.err))
+		})
+	}
+}
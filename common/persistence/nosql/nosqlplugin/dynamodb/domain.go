@@ -22,17 +22,121 @@ package dynamodb
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// tableDomain is keyed by name, all domains share a single partition so that they can be listed
+	tableDomain = "domain"
+	// tableDomainID maps domain IDs to names and guarantees the uniqueness of the IDs
+	tableDomainID = "domain_id"
+
+	attrPartition           = "partition"
+	attrName                = "name"
+	attrDomainID            = "domain_id"
+	attrNotificationVersion = "notification_version"
+
+	constDomainPartition     = 0
+	domainMetadataRecordName = "cadence-domain-metadata"
 )
 
-// Insert a new record to domain, return error if failed or already exists
-// Return ConditionFailure if the condition doesn't meet
+// Insert a new record to domain
+// return types.DomainAlreadyExistsError error if failed or already exists
+// Must return ConditionFailure error if other condition doesn't match
 func (db *ddb) InsertDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	metadataNotificationVersion, err := db.SelectDomainMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	inserted := *row
+	inserted.NotificationVersion = metadataNotificationVersion
+	inserted.FailoverNotificationVersion = persistence.InitialFailoverNotificationVersion
+	inserted.PreviousFailoverVersion = constants.InitialPreviousFailoverVersion
+	domainItem, err := newDomainItem(&inserted)
+	if err != nil {
+		return err
+	}
+
+	idExpr := newExpression().name("domain_id", attrDomainID)
+	nameExpr := newExpression().name("name", attrName)
+	err = db.executeTransaction(ctx, []*dynamodb.TransactWriteItem{
+		{
+			Put: &dynamodb.Put{
+				TableName: aws.String(db.tableName(tableDomainID)),
+				Item: item{
+					attrDomainID: stringAttr(row.Info.ID),
+					attrName:     stringAttr(row.Info.Name),
+				},
+				ConditionExpression:      aws.String("attribute_not_exists(#domain_id)"),
+				ExpressionAttributeNames: idExpr.names,
+			},
+		},
+		{
+			Put: &dynamodb.Put{
+				TableName:                aws.String(db.tableName(tableDomain)),
+				Item:                     domainItem,
+				ConditionExpression:      aws.String("attribute_not_exists(#name)"),
+				ExpressionAttributeNames: nameExpr.names,
+			},
+		},
+		db.updateDomainMetadata(metadataNotificationVersion),
+	})
+
+	var cancelled *transactionCancelled
+	if !errors.As(err, &cancelled) {
+		return err
+	}
+	switch {
+	case cancelled.failed(0):
+		return fmt.Errorf("CreateDomain operation failed because of uuid collision")
+	case cancelled.failed(1):
+		db.logger.Warn("Domain already exists", tag.WorkflowDomainName(row.Info.Name))
+		return &types.DomainAlreadyExistsError{
+			Message: fmt.Sprintf("Domain %v already exists", row.Info.Name),
+		}
+	default:
+		db.logger.Warn("Create domain operation failed because of condition update failure on domain metadata record")
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+}
+
+// updateDomainMetadata bumps the notification version of the metadata record,
+// conditioned on the version the caller based its change on
+func (db *ddb) updateDomainMetadata(notificationVersion int64) *dynamodb.TransactWriteItem {
+	expr := newExpression().name("notification_version", attrNotificationVersion)
+	condition := "attribute_not_exists(#notification_version)"
+	var nextVersion int64 = 1
+	if notificationVersion > 0 {
+		nextVersion = notificationVersion + 1
+		condition = "#notification_version = :current_version"
+		expr.value("current_version", numberAttr(notificationVersion))
+	}
+	expr.value("next_version", numberAttr(nextVersion))
+	return &dynamodb.TransactWriteItem{
+		Update: &dynamodb.Update{
+			TableName:                 aws.String(db.tableName(tableDomain)),
+			Key:                       domainKey(domainMetadataRecordName),
+			UpdateExpression:          aws.String("SET #notification_version = :next_version"),
+			ConditionExpression:       aws.String(condition),
+			ExpressionAttributeNames:  expr.names,
+			ExpressionAttributeValues: expr.values,
+		},
+	}
 }
 
 // Update domain
@@ -40,7 +144,24 @@ func (db *ddb) UpdateDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	domainItem, err := newDomainItem(row)
+	if err != nil {
+		return err
+	}
+	err = db.executeTransaction(ctx, []*dynamodb.TransactWriteItem{
+		{
+			Put: &dynamodb.Put{
+				TableName: aws.String(db.tableName(tableDomain)),
+				Item:      domainItem,
+			},
+		},
+		db.updateDomainMetadata(row.NotificationVersion),
+	})
+	var cancelled *transactionCancelled
+	if errors.As(err, &cancelled) {
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+	return err
 }
 
 // Get one domain data, either by domainID or domainName
@@ -49,7 +170,25 @@ func (db *ddb) SelectDomain(
 	domainID *string,
 	domainName *string,
 ) (*nosqlplugin.DomainRow, error) {
-	panic("TODO")
+	if domainID != nil && domainName != nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name specified in request")
+	} else if domainID == nil && domainName == nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name are empty")
+	}
+
+	name := aws.StringValue(domainName)
+	if domainID != nil {
+		var err error
+		if name, err = db.selectDomainName(ctx, *domainID); err != nil {
+			return nil, err
+		}
+	}
+
+	it, err := db.getItem(ctx, tableDomain, domainKey(name))
+	if err != nil {
+		return nil, err
+	}
+	return newDomainRow(it)
 }
 
 // Get all domain data
@@ -58,7 +197,32 @@ func (db *ddb) SelectAllDomains(
 	pageSize int,
 	pageToken []byte,
 ) ([]*nosqlplugin.DomainRow, []byte, error) {
-	panic("TODO")
+	expr := newExpression().
+		name("partition", attrPartition).
+		value("partition", numberAttr(constDomainPartition))
+	items, nextPageToken, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:                 aws.String(db.tableName(tableDomain)),
+		KeyConditionExpression:    aws.String("#partition = :partition"),
+		ExpressionAttributeNames:  expr.names,
+		ExpressionAttributeValues: expr.values,
+		ConsistentRead:            aws.Bool(true),
+	}, pageSize, pageToken, func(it item) bool {
+		// do not include the metadata record
+		return getString(it, attrName) != domainMetadataRecordName
+	}, attrPartition, attrName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.DomainRow, 0, len(items))
+	for _, it := range items {
+		row, err := newDomainRow(it)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
 // Delete a domain, either by domainID or domainName
@@ -67,11 +231,101 @@ func (db *ddb) DeleteDomain(
 	domainID *string,
 	domainName *string,
 ) error {
-	panic("TODO")
+	if domainName == nil && domainID == nil {
+		return fmt.Errorf("must provide either domainID or domainName")
+	}
+
+	var name, id string
+	if domainName == nil {
+		id = *domainID
+		var err error
+		if name, err = db.selectDomainName(ctx, id); err != nil {
+			if db.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+	} else {
+		name = *domainName
+		it, err := db.getItem(ctx, tableDomain, domainKey(name))
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		id = getString(it, attrDomainID)
+	}
+
+	return db.executeTransaction(ctx, []*dynamodb.TransactWriteItem{
+		{
+			Delete: &dynamodb.Delete{
+				TableName: aws.String(db.tableName(tableDomain)),
+				Key:       domainKey(name),
+			},
+		},
+		{
+			Delete: &dynamodb.Delete{
+				TableName: aws.String(db.tableName(tableDomainID)),
+				Key:       item{attrDomainID: stringAttr(id)},
+			},
+		},
+	})
 }
 
 func (db *ddb) SelectDomainMetadata(
 	ctx context.Context,
 ) (int64, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableDomain, domainKey(domainMetadataRecordName))
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			// the metadata record is only created with the first domain
+			return 0, nil
+		}
+		return -1, err
+	}
+	return getNumber(it, attrNotificationVersion)
+}
+
+func (db *ddb) selectDomainName(ctx context.Context, domainID string) (string, error) {
+	it, err := db.getItem(ctx, tableDomainID, item{attrDomainID: stringAttr(domainID)})
+	if err != nil {
+		return "", err
+	}
+	return getString(it, attrName), nil
+}
+
+func domainKey(name string) item {
+	return item{
+		attrPartition: numberAttr(constDomainPartition),
+		attrName:      stringAttr(name),
+	}
+}
+
+func newDomainItem(row *nosqlplugin.DomainRow) (item, error) {
+	data, err := dataAttr(row)
+	if err != nil {
+		return nil, err
+	}
+	it := domainKey(row.Info.Name)
+	it[attrDomainID] = stringAttr(row.Info.ID)
+	it[attrNotificationVersion] = numberAttr(row.NotificationVersion)
+	it[attrData] = data
+	return it, nil
+}
+
+func newDomainRow(it item) (*nosqlplugin.DomainRow, error) {
+	row := &nosqlplugin.DomainRow{}
+	if err := getData(it, row); err != nil {
+		return nil, err
+	}
+	if row.Config == nil {
+		row.Config = &persistence.InternalDomainConfig{}
+	}
+	if row.ReplicationConfig == nil {
+		row.ReplicationConfig = &persistence.InternalDomainReplicationConfig{}
+	}
+	// CurrentTimeStamp is only meaningful on writes
+	row.CurrentTimeStamp = time.Time{}
+	return row, nil
 }
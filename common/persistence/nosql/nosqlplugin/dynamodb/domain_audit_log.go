@@ -22,16 +22,94 @@ package dynamodb
 
 import (
 	"context"
+	"strconv"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	tableDomainAuditLog = "domain_audit_log"
+
+	// attrAuditKey is the partition key made of the domain ID and the operation type
+	attrAuditKey = "audit_key"
+	// attrCreatedKey is the sort key made of the inverted creation time and the event ID, so that the latest entries come first
+	attrCreatedKey = "created_key"
 )
 
 // InsertDomainAuditLog inserts a new audit log entry for a domain operation
 func (db *ddb) InsertDomainAuditLog(ctx context.Context, row *nosqlplugin.DomainAuditLogRow) error {
-	panic("TODO: InsertDomainAuditLog not implemented")
+	data, err := dataAttr(row)
+	if err != nil {
+		return err
+	}
+	it := item{
+		attrAuditKey:   stringAttr(auditKey(row.DomainID, row.OperationType)),
+		attrCreatedKey: stringAttr(descendingInt(row.CreatedTime.UnixNano()) + "#" + row.EventID),
+		attrData:       data,
+	}
+	if ttl := ttlAttr(time.Now(), row.TTLSeconds); ttl != nil {
+		it[attrTTL] = ttl
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(db.tableName(tableDomainAuditLog)),
+		Item:      it,
+	})
+	return err
 }
 
 // SelectDomainAuditLogs returns audit log entries for a domain and operation type
 func (db *ddb) SelectDomainAuditLogs(ctx context.Context, filter *nosqlplugin.DomainAuditLogFilter) ([]*nosqlplugin.DomainAuditLogRow, []byte, error) {
-	panic("TODO: SelectDomainAuditLogs not implemented")
+	if filter.MinCreatedTime == nil || filter.MaxCreatedTime == nil {
+		return nil, nil, &types.InternalServiceError{
+			Message: "SelectDomainAuditLogs requires non-nil MinCreatedTime and MaxCreatedTime",
+		}
+	}
+
+	minNanos := filter.MinCreatedTime.UnixNano()
+	maxNanos := filter.MaxCreatedTime.UnixNano()
+	if minNanos >= maxNanos {
+		return nil, nil, nil
+	}
+
+	// created_time >= min AND created_time < max, in the inverted order of the sort key
+	expr := newExpression().
+		name("audit_key", attrAuditKey).
+		name("created_key", attrCreatedKey).
+		value("audit_key", stringAttr(auditKey(filter.DomainID, filter.OperationType))).
+		value("from", stringAttr(descendingInt(maxNanos-1))).
+		value("to", stringAttr(descendingInt(minNanos)+"$"))
+	now := time.Now()
+	items, nextPageToken, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:                 aws.String(db.tableName(tableDomainAuditLog)),
+		KeyConditionExpression:    aws.String("#audit_key = :audit_key AND #created_key BETWEEN :from AND :to"),
+		ExpressionAttributeNames:  expr.names,
+		ExpressionAttributeValues: expr.values,
+		ConsistentRead:            aws.Bool(true),
+	}, filter.PageSize, filter.NextPageToken, func(it item) bool {
+		return !isExpired(it, now)
+	}, attrAuditKey, attrCreatedKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.DomainAuditLogRow, 0, len(items))
+	for _, it := range items {
+		row := &nosqlplugin.DomainAuditLogRow{}
+		if err := getData(it, row); err != nil {
+			return nil, nil, err
+		}
+		row.TTLSeconds = 0
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
+}
+
+func auditKey(domainID string, operationType persistence.DomainAuditOperationType) string {
+	return compositeKey(domainID, strconv.Itoa(int(operationType)))
 }
@@ -22,31 +22,348 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	tableHistoryTree = "history_tree"
+	tableHistoryNode = "history_node"
+
+	attrTreeID   = "tree_id"
+	attrBranchID = "branch_id"
+	// attrBranchKey is the partition key of the nodes, made of the tree and the branch IDs
+	attrBranchKey = "branch_key"
+	// attrNodeKey sorts the nodes by node ID ascending then transaction ID descending
+	attrNodeKey      = "node_key"
+	attrNodeID       = "node_id"
+	attrTxnID        = "txn_id"
+	attrEvents       = "events"
+	attrEventsFormat = "events_encoding"
+	// attrChunks is the number of items the events of a node are split into, it is only set when there are more than one
+	attrChunks = "chunks"
+	// attrChunk is the position of an additional chunk item of a node, the node item itself holds the first chunk
+	attrChunk = "chunk"
+
+	// historyNodeChunkSize keeps every item of a node below the 400KB DynamoDB item limit,
+	// leaving room for the keys and the other attributes
+	historyNodeChunkSize = 350 * 1024
+	// maxHistoryNodeSize keeps the transaction writing the chunks of a node below the 4MB DynamoDB transaction limit
+	maxHistoryNodeSize = 10 * historyNodeChunkSize
 )
 
+type historyTreeData struct {
+	Ancestors       []*types.HistoryBranchRange
+	CreateTimestamp int64
+	Info            string
+}
+
 // InsertIntoHistoryTreeAndNode inserts one or two rows: tree row and node row(at least one of them)
 func (db *ddb) InsertIntoHistoryTreeAndNode(ctx context.Context, treeRow *nosqlplugin.HistoryTreeRow, nodeRow *nosqlplugin.HistoryNodeRow) error {
-	panic("TODO")
+	if treeRow == nil && nodeRow == nil {
+		return fmt.Errorf("require at least a tree row or a node row to insert")
+	}
+
+	var items []*dynamodb.TransactWriteItem
+	if treeRow != nil {
+		data, err := dataAttr(&historyTreeData{
+			Ancestors:       treeRow.Ancestors,
+			CreateTimestamp: treeRow.CreateTimestamp.UnixNano(),
+			Info:            treeRow.Info,
+		})
+		if err != nil {
+			return err
+		}
+		items = append(items, &dynamodb.TransactWriteItem{Put: &dynamodb.Put{
+			TableName: aws.String(db.tableName(tableHistoryTree)),
+			Item: item{
+				attrTreeID:   stringAttr(treeRow.TreeID),
+				attrBranchID: stringAttr(treeRow.BranchID),
+				attrData:     data,
+			},
+		}})
+	}
+	if nodeRow != nil {
+		nodeItems, err := db.historyNodeItems(nodeRow)
+		if err != nil {
+			return err
+		}
+		items = append(items, nodeItems...)
+	}
+
+	if len(items) == 1 {
+		// a single put doesn't need a transaction
+		_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+			TableName: items[0].Put.TableName,
+			Item:      items[0].Put.Item,
+		})
+		return err
+	}
+	return db.executeTransaction(ctx, items)
 }
 
 // SelectFromHistoryNode read nodes based on a filter
 func (db *ddb) SelectFromHistoryNode(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter) ([]*nosqlplugin.HistoryNodeRow, []byte, error) {
-	panic("TODO")
+	if filter.MinNodeID >= filter.MaxNodeID {
+		return nil, nil, nil
+	}
+	expr := newExpression().
+		name("branch_key", attrBranchKey).
+		name("node_key", attrNodeKey).
+		value("branch_key", stringAttr(compositeKey(filter.TreeID, filter.BranchID))).
+		value("from", stringAttr(sortableInt(filter.MinNodeID))).
+		value("to", stringAttr(sortableInt(filter.MaxNodeID-1)+"$"))
+	items, nextPageToken, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:                 aws.String(db.tableName(tableHistoryNode)),
+		KeyConditionExpression:    aws.String("#branch_key = :branch_key AND #node_key BETWEEN :from AND :to"),
+		ExpressionAttributeNames:  expr.names,
+		ExpressionAttributeValues: expr.values,
+		ConsistentRead:            aws.Bool(true),
+	}, filter.PageSize, filter.NextPageToken, isHistoryNodeItem, attrBranchKey, attrNodeKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryNodeRow, 0, len(items))
+	for _, it := range items {
+		nodeID, err := getNumber(it, attrNodeID)
+		if err != nil {
+			return nil, nil, err
+		}
+		txnID, err := getNumber(it, attrTxnID)
+		if err != nil {
+			return nil, nil, err
+		}
+		data, err := db.historyNodeData(ctx, it)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, &nosqlplugin.HistoryNodeRow{
+			NodeID:       nodeID,
+			TxnID:        aws.Int64(txnID),
+			Data:         data,
+			DataEncoding: getString(it, attrEventsFormat),
+		})
+	}
+	return rows, nextPageToken, nil
+}
+
+// historyNodeItems returns the items of a node row. Events larger than a single item are split into chunks,
+// the additional chunks sort right after the node item so that range deletions of nodes also remove them.
+func (db *ddb) historyNodeItems(nodeRow *nosqlplugin.HistoryNodeRow) ([]*dynamodb.TransactWriteItem, error) {
+	if len(nodeRow.Data) > maxHistoryNodeSize {
+		return nil, fmt.Errorf("history node %v of %d bytes exceeds the DynamoDB limit of %d bytes, lower the blob size limits of the domain",
+			nodeRow.NodeID, len(nodeRow.Data), maxHistoryNodeSize)
+	}
+
+	branchKey := stringAttr(compositeKey(nodeRow.TreeID, nodeRow.BranchID))
+	nodeKey := historyNodeKey(nodeRow.NodeID, aws.Int64Value(nodeRow.TxnID))
+	chunks := splitChunks(nodeRow.Data, historyNodeChunkSize)
+
+	nodeItem := item{
+		attrBranchKey:    branchKey,
+		attrNodeKey:      stringAttr(nodeKey),
+		attrNodeID:       numberAttr(nodeRow.NodeID),
+		attrTxnID:        numberAttr(aws.Int64Value(nodeRow.TxnID)),
+		attrEvents:       binaryAttr(chunks[0]),
+		attrEventsFormat: stringAttr(nodeRow.DataEncoding),
+	}
+	if len(chunks) > 1 {
+		nodeItem[attrChunks] = numberAttr(int64(len(chunks)))
+	}
+	items := []*dynamodb.TransactWriteItem{{Put: &dynamodb.Put{
+		TableName: aws.String(db.tableName(tableHistoryNode)),
+		Item:      nodeItem,
+	}}}
+	for i := 1; i < len(chunks); i++ {
+		items = append(items, &dynamodb.TransactWriteItem{Put: &dynamodb.Put{
+			TableName: aws.String(db.tableName(tableHistoryNode)),
+			Item: item{
+				attrBranchKey: branchKey,
+				attrNodeKey:   stringAttr(historyNodeChunkKey(nodeKey, i)),
+				attrChunk:     numberAttr(int64(i)),
+				attrEvents:    binaryAttr(chunks[i]),
+			},
+		}})
+	}
+	return items, nil
+}
+
+// historyNodeData returns the events of a node item, reading the additional chunks when the events were split
+func (db *ddb) historyNodeData(ctx context.Context, it item) ([]byte, error) {
+	if _, ok := it[attrChunks]; !ok {
+		return it[attrEvents].B, nil
+	}
+	chunks, err := getNumber(it, attrChunks)
+	if err != nil {
+		return nil, err
+	}
+	expr := newExpression().
+		name("branch_key", attrBranchKey).
+		name("node_key", attrNodeKey).
+		value("branch_key", it[attrBranchKey]).
+		value("prefix", stringAttr(getString(it, attrNodeKey)+"#"))
+	chunkItems, err := db.queryAll(ctx, &dynamodb.QueryInput{
+		TableName:                 aws.String(db.tableName(tableHistoryNode)),
+		KeyConditionExpression:    aws.String("#branch_key = :branch_key AND begins_with(#node_key, :prefix)"),
+		ExpressionAttributeNames:  expr.names,
+		ExpressionAttributeValues: expr.values,
+		ConsistentRead:            aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(chunkItems))+1 != chunks {
+		return nil, fmt.Errorf("history node %v has %d chunks, expected %d", getString(it, attrNodeKey), len(chunkItems)+1, chunks)
+	}
+	data := append([]byte{}, it[attrEvents].B...)
+	for _, chunk := range chunkItems {
+		data = append(data, chunk[attrEvents].B...)
+	}
+	return data, nil
+}
+
+// isHistoryNodeItem filters out the additional chunk items when reading nodes
+func isHistoryNodeItem(it item) bool {
+	_, isChunk := it[attrChunk]
+	return !isChunk
+}
+
+// splitChunks splits data into chunks of at most size bytes, there is always at least one chunk
+func splitChunks(data []byte, size int) [][]byte {
+	chunks := [][]byte{data[:min(len(data), size)]}
+	for start := size; start < len(data); start += size {
+		chunks = append(chunks, data[start:min(len(data), start+size)])
+	}
+	return chunks
 }
 
 // DeleteFromHistoryTreeAndNode delete a branch record, and a list of ranges of nodes.
 func (db *ddb) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *nosqlplugin.HistoryTreeFilter, nodeFilters []*nosqlplugin.HistoryNodeFilter) error {
-	panic("TODO")
+	// nodes are deleted first so that a failed deletion can be retried as long as the branch record exists
+	for _, nodeFilter := range nodeFilters {
+		expr := newExpression().
+			name("branch_key", attrBranchKey).
+			name("node_key", attrNodeKey).
+			value("branch_key", stringAttr(compositeKey(nodeFilter.TreeID, nodeFilter.BranchID))).
+			value("from", stringAttr(sortableInt(nodeFilter.MinNodeID)))
+		_, err := db.rangeDelete(ctx, tableHistoryNode, &dynamodb.QueryInput{
+			TableName:                 aws.String(db.tableName(tableHistoryNode)),
+			KeyConditionExpression:    aws.String("#branch_key = :branch_key AND #node_key >= :from"),
+			ExpressionAttributeNames:  expr.names,
+			ExpressionAttributeValues: expr.values,
+			ConsistentRead:            aws.Bool(true),
+		}, attrBranchKey, attrNodeKey)
+		if err != nil {
+			return err
+		}
+	}
+	return db.deleteItem(ctx, tableHistoryTree, item{
+		attrTreeID:   stringAttr(treeFilter.TreeID),
+		attrBranchID: stringAttr(aws.StringValue(treeFilter.BranchID)),
+	})
 }
 
 // SelectAllHistoryTrees will return all tree branches with pagination
 func (db *ddb) SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*nosqlplugin.HistoryTreeRow, []byte, error) {
-	panic("TODO")
+	startKey, err := decodePageToken(nextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	input := &dynamodb.ScanInput{
+		TableName:         aws.String(db.tableName(tableHistoryTree)),
+		ExclusiveStartKey: startKey,
+	}
+	if pageSize > 0 {
+		input.Limit = aws.Int64(int64(pageSize))
+	}
+	out, err := db.client.ScanWithContext(ctx, input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(out.Items))
+	for _, it := range out.Items {
+		row, err := newHistoryTreeRow(it)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	token, err := encodePageToken(out.LastEvaluatedKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rows, token, nil
 }
 
 // SelectFromHistoryTree read branch records for a tree
 func (db *ddb) SelectFromHistoryTree(ctx context.Context, filter *nosqlplugin.HistoryTreeFilter) ([]*nosqlplugin.HistoryTreeRow, error) {
-	panic("TODO")
+	expr := newExpression().
+		name("tree_id", attrTreeID).
+		value("tree_id", stringAttr(filter.TreeID))
+	items, err := db.queryAll(ctx, &dynamodb.QueryInput{
+		TableName:                 aws.String(db.tableName(tableHistoryTree)),
+		KeyConditionExpression:    aws.String("#tree_id = :tree_id"),
+		ExpressionAttributeNames:  expr.names,
+		ExpressionAttributeValues: expr.values,
+		ConsistentRead:            aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(items))
+	for _, it := range items {
+		row, err := newHistoryTreeRow(it)
+		if err != nil {
+			return nil, err
+		}
+		sortBranchAncestors(row.Ancestors)
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// historyNodeKey sorts the nodes by node ID, and the latest transaction of a node first
+func historyNodeKey(nodeID, txnID int64) string {
+	return sortableInt(nodeID) + "#" + descendingInt(txnID)
+}
+
+// historyNodeChunkKey sorts the additional chunks of a node right after the node item, in order
+func historyNodeChunkKey(nodeKey string, chunk int) string {
+	return fmt.Sprintf("%s#%03d", nodeKey, chunk)
+}
+
+func newHistoryTreeRow(it item) (*nosqlplugin.HistoryTreeRow, error) {
+	var data historyTreeData
+	if err := getData(it, &data); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.HistoryTreeRow{
+		TreeID:          getString(it, attrTreeID),
+		BranchID:        getString(it, attrBranchID),
+		Ancestors:       data.Ancestors,
+		CreateTimestamp: time.Unix(0, data.CreateTimestamp),
+		Info:            data.Info,
+	}, nil
+}
+
+// sortBranchAncestors sorts the ancestors by EndNodeID so that BeginNodeID can be set
+func sortBranchAncestors(ancestors []*types.HistoryBranchRange) {
+	if len(ancestors) == 0 {
+		return
+	}
+	sort.Slice(ancestors, func(i, j int) bool { return ancestors[i].EndNodeID < ancestors[j].EndNodeID })
+	ancestors[0].BeginNodeID = int64(1)
+	for i := 1; i < len(ancestors); i++ {
+		ancestors[i].BeginNodeID = ancestors[i-1].EndNodeID
+	}
 }
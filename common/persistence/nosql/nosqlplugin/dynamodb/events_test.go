@@ -0,0 +1,123 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+func TestSplitChunks(t *testing.T) {
+	assert.Equal(t, [][]byte{{}}, splitChunks([]byte{}, 2))
+	assert.Equal(t, [][]byte{{1, 2}}, splitChunks([]byte{1, 2}, 2))
+	assert.Equal(t, [][]byte{{1, 2}, {3, 4}, {5}}, splitChunks([]byte{1, 2, 3, 4, 5}, 2))
+}
+
+func TestHistoryNodeChunks(t *testing.T) {
+	var stored []item
+	client := &fakeClient{
+		putItem: func(input *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+			stored = append(stored, input.Item)
+			return &dynamodb.PutItemOutput{}, nil
+		},
+		transactWrite: func(input *dynamodb.TransactWriteItemsInput) (*dynamodb.TransactWriteItemsOutput, error) {
+			for _, it := range input.TransactItems {
+				stored = append(stored, it.Put.Item)
+			}
+			return &dynamodb.TransactWriteItemsOutput{}, nil
+		},
+		query: func(input *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
+			out := &dynamodb.QueryOutput{}
+			for _, it := range stored {
+				key := getString(it, attrNodeKey)
+				if prefix, ok := input.ExpressionAttributeValues[":prefix"]; ok {
+					if strings.HasPrefix(key, aws.StringValue(prefix.S)) {
+						out.Items = append(out.Items, it)
+					}
+				} else if key >= aws.StringValue(input.ExpressionAttributeValues[":from"].S) &&
+					key <= aws.StringValue(input.ExpressionAttributeValues[":to"].S) {
+					out.Items = append(out.Items, it)
+				}
+			}
+			return out, nil
+		},
+	}
+	db := newTestDB(t, client)
+
+	small := []byte("small")
+	large := bytes.Repeat([]byte("large"), historyNodeChunkSize)
+	for i, data := range [][]byte{small, large} {
+		require.NoError(t, db.InsertIntoHistoryTreeAndNode(context.Background(), nil, &nosqlplugin.HistoryNodeRow{
+			TreeID:       "tree",
+			BranchID:     "branch",
+			NodeID:       int64(i + 1),
+			TxnID:        aws.Int64(1),
+			Data:         data,
+			DataEncoding: "thriftrw",
+		}))
+	}
+	// the large node is split into 5 chunks
+	require.Len(t, stored, 6)
+	for _, it := range stored {
+		assert.LessOrEqual(t, len(it[attrEvents].B), historyNodeChunkSize)
+	}
+
+	// the chunk items of the large node are not counted as nodes of the page
+	rows, _, err := db.SelectFromHistoryNode(context.Background(), &nosqlplugin.HistoryNodeFilter{
+		TreeID:    "tree",
+		BranchID:  "branch",
+		MinNodeID: 2,
+		MaxNodeID: 3,
+		PageSize:  1,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, large, rows[0].Data)
+
+	rows, _, err = db.SelectFromHistoryNode(context.Background(), &nosqlplugin.HistoryNodeFilter{
+		TreeID:    "tree",
+		BranchID:  "branch",
+		MinNodeID: 1,
+		MaxNodeID: 3,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, small, rows[0].Data)
+	assert.Equal(t, large, rows[1].Data)
+
+	err = db.InsertIntoHistoryTreeAndNode(context.Background(), nil, &nosqlplugin.HistoryNodeRow{
+		TreeID:   "tree",
+		BranchID: "branch",
+		NodeID:   3,
+		TxnID:    aws.Int64(1),
+		Data:     make([]byte, maxHistoryNodeSize+1),
+	})
+	assert.ErrorContains(t, err, "exceeds the DynamoDB limit")
+}
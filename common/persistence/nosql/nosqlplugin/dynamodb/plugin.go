@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+type plugin struct{}
+
+var _ nosqlplugin.Plugin = (*plugin)(nil)
+
+func init() {
+	nosql.RegisterPlugin(PluginName, &plugin{})
+}
+
+// CreateDB initialize the db object
+func (p *plugin) CreateDB(cfg *config.NoSQL, logger log.Logger, dc *persistence.DynamicConfiguration) (nosqlplugin.DB, error) {
+	return newDynamoDB(cfg, logger)
+}
+
+// CreateAdminDB initialize the AdminDB object
+func (p *plugin) CreateAdminDB(cfg *config.NoSQL, logger log.Logger, dc *persistence.DynamicConfiguration) (nosqlplugin.AdminDB, error) {
+	return newDynamoDB(cfg, logger)
+}
+
+// NewClient creates a DynamoDB client from the generic NoSQL config:
+//   - Hosts (and Port) is the endpoint, leave it empty to use the regional AWS endpoint
+//   - Region is the AWS region, falls back to the AWS environment/shared config when empty
+//   - User and Password are a static access key ID and secret, the default credential chain
+//     (environment, shared config, instance role) is used when they are empty
+//   - Keyspace is used as the table name prefix
+func NewClient(cfg *config.NoSQL) (*dynamodb.DynamoDB, error) {
+	awsConfig := &aws.Config{}
+	if cfg.Region != "" {
+		awsConfig.Region = aws.String(cfg.Region)
+	}
+	if endpoint := toEndpoint(cfg.Hosts, cfg.Port); endpoint != "" {
+		awsConfig.Endpoint = aws.String(endpoint)
+	}
+	if cfg.User != "" {
+		awsConfig.Credentials = credentials.NewStaticCredentials(cfg.User, cfg.Password, "")
+	}
+	if cfg.Timeout > 0 {
+		awsConfig.HTTPClient = &http.Client{Timeout: cfg.Timeout}
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("creating AWS session: %w", err)
+	}
+	return dynamodb.New(sess), nil
+}
+
+func toEndpoint(hosts string, port int) string {
+	if hosts == "" {
+		return ""
+	}
+	// only a single endpoint is supported, DynamoDB does the load balancing behind it
+	endpoint := strings.TrimSpace(strings.Split(hosts, ",")[0])
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "http://" + endpoint
+	}
+	if port != 0 {
+		endpoint = fmt.Sprintf("%s:%d", endpoint, port)
+	}
+	return endpoint
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToEndpoint(t *testing.T) {
+	tests := []struct {
+		name  string
+		hosts string
+		port  int
+		want  string
+	}{
+		{
+			name: "regional endpoint",
+		},
+		{
+			name:  "host and port",
+			hosts: "localhost",
+			port:  8000,
+			want:  "http://localhost:8000",
+		},
+		{
+			name:  "only the first host is used",
+			hosts: " 10.0.0.1 , 10.0.0.2",
+			want:  "http://10.0.0.1",
+		},
+		{
+			name:  "scheme is kept",
+			hosts: "https://dynamodb.us-west-2.amazonaws.com",
+			want:  "https://dynamodb.us-west-2.amazonaws.com",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, toEndpoint(tc.hosts, tc.port))
+		})
+	}
+}
@@ -23,17 +23,41 @@ package dynamodb
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+const (
+	tableQueueMessage  = "queue_message"
+	tableQueueMetadata = "queue_metadata"
+
+	attrQueueType = "queue_type"
+	attrMessageID = "message_id"
+	attrPayload   = "message_payload"
+	attrVersion   = "version"
+)
+
 // Insert message into queue, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *ddb) InsertIntoQueue(
 	ctx context.Context,
 	row *nosqlplugin.QueueMessageRow,
 ) error {
-	panic("TODO")
+	it := queueMessageKey(row.QueueType, row.ID)
+	it[attrPayload] = binaryAttr(row.Payload)
+	_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                aws.String(db.tableName(tableQueueMessage)),
+		Item:                     it,
+		ConditionExpression:      aws.String("attribute_not_exists(#message_id)"),
+		ExpressionAttributeNames: newExpression().name("message_id", attrMessageID).names,
+	})
+	if isConditionalCheckFailed(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Get the ID of last message inserted into the queue
@@ -41,7 +65,17 @@ func (db *ddb) SelectLastEnqueuedMessageID(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	input := db.queueMessagesQuery(queueType, "", nil)
+	input.ScanIndexForward = aws.Bool(false)
+	input.Limit = aws.Int64(1)
+	out, err := db.client.QueryWithContext(ctx, input)
+	if err != nil {
+		return 0, err
+	}
+	if len(out.Items) == 0 {
+		return 0, errNotFound
+	}
+	return getNumber(out.Items[0], attrMessageID)
 }
 
 // Read queue messages starting from the exclusiveBeginMessageID
@@ -51,7 +85,23 @@ func (db *ddb) SelectMessagesFrom(
 	exclusiveBeginMessageID int64,
 	maxRows int,
 ) ([]*nosqlplugin.QueueMessageRow, error) {
-	panic("TODO")
+	input := db.queueMessagesQuery(queueType, "#message_id > :begin", item{
+		":begin": numberAttr(exclusiveBeginMessageID),
+	})
+	items, _, err := db.queryPage(ctx, input, maxRows, nil, nil, attrQueueType, attrMessageID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*nosqlplugin.QueueMessageRow, 0, len(items))
+	for _, it := range items {
+		row, err := newQueueMessageRow(it)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, row)
+	}
+	return result, nil
 }
 
 // Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
@@ -59,7 +109,30 @@ func (db *ddb) SelectMessagesBetween(
 	ctx context.Context,
 	request nosqlplugin.SelectMessagesBetweenRequest,
 ) (*nosqlplugin.SelectMessagesBetweenResponse, error) {
-	panic("TODO")
+	if request.ExclusiveBeginMessageID >= request.InclusiveEndMessageID {
+		return &nosqlplugin.SelectMessagesBetweenResponse{}, nil
+	}
+	input := db.queueMessagesQuery(request.QueueType, "#message_id BETWEEN :begin AND :end", item{
+		":begin": numberAttr(request.ExclusiveBeginMessageID + 1),
+		":end":   numberAttr(request.InclusiveEndMessageID),
+	})
+	items, nextPageToken, err := db.queryPage(ctx, input, request.PageSize, request.NextPageToken, nil, attrQueueType, attrMessageID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]nosqlplugin.QueueMessageRow, 0, len(items))
+	for _, it := range items {
+		row, err := newQueueMessageRow(it)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, *row)
+	}
+	return &nosqlplugin.SelectMessagesBetweenResponse{
+		Rows:          rows,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 // Delete all messages before exclusiveBeginMessageID
@@ -68,7 +141,11 @@ func (db *ddb) DeleteMessagesBefore(
 	queueType persistence.QueueType,
 	exclusiveBeginMessageID int64,
 ) error {
-	panic("TODO")
+	input := db.queueMessagesQuery(queueType, "#message_id < :end", item{
+		":end": numberAttr(exclusiveBeginMessageID),
+	})
+	_, err := db.rangeDelete(ctx, tableQueueMessage, input, attrQueueType, attrMessageID)
+	return err
 }
 
 // Delete all messages in a range between exclusiveBeginMessageID and inclusiveEndMessageID
@@ -78,7 +155,15 @@ func (db *ddb) DeleteMessagesInRange(
 	exclusiveBeginMessageID int64,
 	inclusiveEndMessageID int64,
 ) error {
-	panic("TODO")
+	if exclusiveBeginMessageID >= inclusiveEndMessageID {
+		return nil
+	}
+	input := db.queueMessagesQuery(queueType, "#message_id BETWEEN :begin AND :end", item{
+		":begin": numberAttr(exclusiveBeginMessageID + 1),
+		":end":   numberAttr(inclusiveEndMessageID),
+	})
+	_, err := db.rangeDelete(ctx, tableQueueMessage, input, attrQueueType, attrMessageID)
+	return err
 }
 
 // Delete one message
@@ -87,12 +172,30 @@ func (db *ddb) DeleteMessage(
 	queueType persistence.QueueType,
 	messageID int64,
 ) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableQueueMessage, queueMessageKey(queueType, messageID))
 }
 
 // Insert an empty metadata row, starting from a version
 func (db *ddb) InsertQueueMetadata(ctx context.Context, row nosqlplugin.QueueMetadataRow) error {
-	panic("TODO")
+	data, err := dataAttr(map[string]int64{})
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(db.tableName(tableQueueMetadata)),
+		Item: item{
+			attrQueueType: numberAttr(int64(row.QueueType)),
+			attrVersion:   numberAttr(row.Version),
+			attrData:      data,
+		},
+		ConditionExpression:      aws.String("attribute_not_exists(#queue_type)"),
+		ExpressionAttributeNames: newExpression().name("queue_type", attrQueueType).names,
+	})
+	if isConditionalCheckFailed(err) {
+		// it's ok if the metadata row already exists
+		return nil
+	}
+	return err
 }
 
 // **Conditionally** update a queue metadata row, if current version is matched(meaning current == row.Version - 1),
@@ -102,7 +205,28 @@ func (db *ddb) UpdateQueueMetadataCas(
 	ctx context.Context,
 	row nosqlplugin.QueueMetadataRow,
 ) error {
-	panic("TODO")
+	data, err := dataAttr(row.ClusterAckLevels)
+	if err != nil {
+		return err
+	}
+	expr := newExpression().
+		name("version", attrVersion).
+		value("previous_version", numberAttr(row.Version-1))
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(db.tableName(tableQueueMetadata)),
+		Item: item{
+			attrQueueType: numberAttr(int64(row.QueueType)),
+			attrVersion:   numberAttr(row.Version),
+			attrData:      data,
+		},
+		ConditionExpression:       aws.String("#version = :previous_version"),
+		ExpressionAttributeNames:  expr.names,
+		ExpressionAttributeValues: expr.values,
+	})
+	if isConditionalCheckFailed(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Read a QueueMetadata
@@ -110,12 +234,72 @@ func (db *ddb) SelectQueueMetadata(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (*nosqlplugin.QueueMetadataRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableQueueMetadata, item{attrQueueType: numberAttr(int64(queueType))})
+	if err != nil {
+		return nil, err
+	}
+	version, err := getNumber(it, attrVersion)
+	if err != nil {
+		return nil, err
+	}
+	var ackLevels map[string]int64
+	if err := getData(it, &ackLevels); err != nil {
+		return nil, err
+	}
+
+	// if record exist but ackLevels is empty, we initialize the map
+	if ackLevels == nil {
+		ackLevels = make(map[string]int64)
+	}
+	return &nosqlplugin.QueueMetadataRow{
+		QueueType:        queueType,
+		ClusterAckLevels: ackLevels,
+		Version:          version,
+	}, nil
 }
 
 func (db *ddb) GetQueueSize(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	return db.countItems(ctx, db.queueMessagesQuery(queueType, "", nil))
+}
+
+// queueMessagesQuery builds a query over the messages of a queue, rangeCondition
+// optionally restricts #message_id using the given values
+func (db *ddb) queueMessagesQuery(queueType persistence.QueueType, rangeCondition string, values item) *dynamodb.QueryInput {
+	expr := newExpression().
+		name("queue_type", attrQueueType).
+		value("queue_type", numberAttr(int64(queueType)))
+	condition := "#queue_type = :queue_type"
+	if rangeCondition != "" {
+		condition += " AND " + rangeCondition
+		expr.name("message_id", attrMessageID)
+		for k, v := range values {
+			expr.values[k] = v
+		}
+	}
+	return &dynamodb.QueryInput{
+		TableName:                 aws.String(db.tableName(tableQueueMessage)),
+		KeyConditionExpression:    aws.String(condition),
+		ExpressionAttributeNames:  expr.names,
+		ExpressionAttributeValues: expr.values,
+		// reading replication tasks needs to be strongly consistent, otherwise we could lose tasks
+		ConsistentRead: aws.Bool(true),
+	}
+}
+
+func queueMessageKey(queueType persistence.QueueType, messageID int64) item {
+	return item{
+		attrQueueType: numberAttr(int64(queueType)),
+		attrMessageID: numberAttr(messageID),
+	}
+}
+
+func newQueueMessageRow(it item) (*nosqlplugin.QueueMessageRow, error) {
+	id, err := getNumber(it, attrMessageID)
+	if err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.QueueMessageRow{ID: id, Payload: it[attrPayload].B}, nil
 }
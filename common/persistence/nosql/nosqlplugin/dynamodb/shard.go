@@ -22,29 +22,175 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+const (
+	tableShard = "shard"
+
+	attrShardID = "shard_id"
+	attrRangeID = "range_id"
+)
+
 // InsertShard creates a new shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error {
-	panic("TODO")
+	it, err := newShardItem(row)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                aws.String(db.tableName(tableShard)),
+		Item:                     it,
+		ConditionExpression:      aws.String("attribute_not_exists(#shard_id)"),
+		ExpressionAttributeNames: newExpression().name("shard_id", attrShardID).names,
+	})
+	if isConditionalCheckFailed(err) {
+		return db.conflictedShard(ctx, row.ShardID, "shard already exists")
+	}
+	return err
 }
 
 // SelectShard gets a shard
 func (db *ddb) SelectShard(ctx context.Context, shardID int, currentClusterName string) (int64, *nosqlplugin.ShardRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableShard, shardKey(shardID))
+	if err != nil {
+		return 0, nil, err
+	}
+	rangeID, err := getNumber(it, attrRangeID)
+	if err != nil {
+		return 0, nil, err
+	}
+	row := &nosqlplugin.ShardRow{InternalShardInfo: &persistence.InternalShardInfo{}}
+	if err := getData(it, row); err != nil {
+		return 0, nil, err
+	}
+
+	info := row.InternalShardInfo
+	if info.ClusterTransferAckLevel == nil {
+		info.ClusterTransferAckLevel = map[string]int64{
+			currentClusterName: info.TransferAckLevel,
+		}
+	}
+	if info.ClusterTimerAckLevel == nil {
+		info.ClusterTimerAckLevel = map[string]time.Time{
+			currentClusterName: info.TimerAckLevel,
+		}
+	}
+	if info.ClusterReplicationLevel == nil {
+		info.ClusterReplicationLevel = make(map[string]int64)
+	}
+	if info.ReplicationDLQAckLevel == nil {
+		info.ReplicationDLQAckLevel = make(map[string]int64)
+	}
+	return rangeID, row, nil
 }
 
 // UpdateRangeID updates the rangeID, return error is there is any
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) UpdateRangeID(ctx context.Context, shardID int, rangeID int64, previousRangeID int64) error {
-	panic("TODO")
+	expr := newExpression().
+		name("range_id", attrRangeID).
+		value("range_id", numberAttr(rangeID)).
+		value("previous_range_id", numberAttr(previousRangeID))
+	_, err := db.client.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 aws.String(db.tableName(tableShard)),
+		Key:                       shardKey(shardID),
+		UpdateExpression:          aws.String("SET #range_id = :range_id"),
+		ConditionExpression:       aws.String("#range_id = :previous_range_id"),
+		ExpressionAttributeNames:  expr.names,
+		ExpressionAttributeValues: expr.values,
+	})
+	if isConditionalCheckFailed(err) {
+		return db.conflictedShard(ctx, shardID, fmt.Sprintf("previous range_id %v", previousRangeID))
+	}
+	return err
 }
 
 // UpdateShard updates a shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previousRangeID int64) error {
-	panic("TODO")
+	it, err := newShardItem(row)
+	if err != nil {
+		return err
+	}
+	expr := newExpression().
+		name("range_id", attrRangeID).
+		value("previous_range_id", numberAttr(previousRangeID))
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                 aws.String(db.tableName(tableShard)),
+		Item:                      it,
+		ConditionExpression:       aws.String("#range_id = :previous_range_id"),
+		ExpressionAttributeNames:  expr.names,
+		ExpressionAttributeValues: expr.values,
+	})
+	if isConditionalCheckFailed(err) {
+		return db.conflictedShard(ctx, row.ShardID, fmt.Sprintf("previous range_id %v", previousRangeID))
+	}
+	return err
+}
+
+// shardRangeIDCondition is used by the transactions that must only apply while the shard is owned
+func (db *ddb) shardRangeIDCondition(shardID int, rangeID int64) *dynamodb.TransactWriteItem {
+	expr := newExpression().
+		name("range_id", attrRangeID).
+		value("range_id", numberAttr(rangeID))
+	return &dynamodb.TransactWriteItem{
+		ConditionCheck: &dynamodb.ConditionCheck{
+			TableName:                 aws.String(db.tableName(tableShard)),
+			Key:                       shardKey(shardID),
+			ConditionExpression:       aws.String("#range_id = :range_id"),
+			ExpressionAttributeNames:  expr.names,
+			ExpressionAttributeValues: expr.values,
+		},
+	}
+}
+
+// selectShardRangeID returns the current range_id of a shard, it's used to explain failed conditions
+func (db *ddb) selectShardRangeID(ctx context.Context, shardID int) (int64, error) {
+	it, err := db.getItem(ctx, tableShard, shardKey(shardID))
+	if err != nil {
+		return 0, err
+	}
+	return getNumber(it, attrRangeID)
+}
+
+func (db *ddb) conflictedShard(ctx context.Context, shardID int, details string) error {
+	rangeID, err := db.selectShardRangeID(ctx, shardID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return err
+	}
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: rangeID,
+		Details: fmt.Sprintf("shard_id=%v, range_id=%v, %v", shardID, rangeID, details),
+	}
+}
+
+func shardKey(shardID int) item {
+	return item{attrShardID: numberAttr(int64(shardID))}
+}
+
+func newShardItem(row *nosqlplugin.ShardRow) (item, error) {
+	info := *row.InternalShardInfo
+	info.UpdatedAt = row.CurrentTimestamp
+	data, err := dataAttr(&nosqlplugin.ShardRow{
+		InternalShardInfo: &info,
+		Data:              row.Data,
+		DataEncoding:      row.DataEncoding,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item{
+		attrShardID: numberAttr(int64(row.ShardID)),
+		attrRangeID: numberAttr(row.RangeID),
+		attrData:    data,
+	}, nil
 }
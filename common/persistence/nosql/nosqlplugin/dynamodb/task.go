@@ -1,4 +1,4 @@
-// Copyright (c) 2021 Uber Technologies, Inc.
+// Copyright (c) 2020 Uber Technologies, Inc.
 //
 // Permission is hereby granted, free of charge, to any person obtaining a copy
 // of this software and associated documentation files (the "Software"), to deal
@@ -22,20 +22,113 @@ package dynamodb
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	tableTaskList = "tasklist"
+	tableTask     = "task"
+
+	// attrTaskListKey is made of the domain ID, the tasklist name and the tasklist type
+	attrTaskListKey = "tasklist_key"
+	attrTaskID      = "task_id"
+
+	initialRangeID = 1 // Id of the first range of a new task list
+)
+
+type (
+	taskListData struct {
+		TaskListKind            int
+		AckLevel                int64
+		LastUpdatedTime         time.Time
+		AdaptivePartitionConfig *persistence.TaskListPartitionConfig
+	}
+
+	taskData struct {
+		WorkflowID      string
+		RunID           string
+		ScheduledID     int64
+		CreatedTime     time.Time
+		PartitionConfig map[string]string
+		Priority        int32
+		FairnessKey     string
+	}
 )
 
 // SelectTaskList returns a single tasklist row.
 // Return IsNotFoundError if the row doesn't exist
 func (db *ddb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter) (*nosqlplugin.TaskListRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableTaskList, taskListKey(filter))
+	if err != nil {
+		return nil, err
+	}
+	if isExpired(it, time.Now()) {
+		return nil, errNotFound
+	}
+	rangeID, err := getNumber(it, attrRangeID)
+	if err != nil {
+		return nil, err
+	}
+	var data taskListData
+	if err := getData(it, &data); err != nil {
+		return nil, err
+	}
+
+	return &nosqlplugin.TaskListRow{
+		DomainID:     filter.DomainID,
+		TaskListName: filter.TaskListName,
+		TaskListType: filter.TaskListType,
+
+		TaskListKind:            data.TaskListKind,
+		LastUpdatedTime:         data.LastUpdatedTime,
+		AckLevel:                data.AckLevel,
+		RangeID:                 rangeID,
+		AdaptivePartitionConfig: data.AdaptivePartitionConfig,
+	}, nil
 }
 
 // InsertTaskList insert a single tasklist row
-// Return IsConditionFailedError if the row already exists, and also the existing row
+// Return TaskOperationConditionFailure if the row already exists
 func (db *ddb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow) error {
-	panic("TODO")
+	it, err := newTaskListItem(&nosqlplugin.TaskListRow{
+		DomainID:                row.DomainID,
+		TaskListName:            row.TaskListName,
+		TaskListType:            row.TaskListType,
+		RangeID:                 initialRangeID,
+		TaskListKind:            row.TaskListKind,
+		AckLevel:                0,
+		LastUpdatedTime:         row.LastUpdatedTime,
+		AdaptivePartitionConfig: row.AdaptivePartitionConfig,
+	}, 0)
+	if err != nil {
+		return err
+	}
+	// an expired tasklist may not have been deleted by DynamoDB yet
+	expr := newExpression().
+		name("range_id", attrRangeID).
+		name("ttl", attrTTL).
+		value("now", numberAttr(time.Now().Unix()))
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                 aws.String(db.tableName(tableTaskList)),
+		Item:                      it,
+		ConditionExpression:       aws.String("attribute_not_exists(#range_id) OR #ttl <= :now"),
+		ExpressionAttributeNames:  expr.names,
+		ExpressionAttributeValues: expr.values,
+	})
+	if isConditionalCheckFailed(err) {
+		return db.conflictedTaskList(ctx, row, "tasklist already exists")
+	}
+	return err
 }
 
 // UpdateTaskList updates a single tasklist row
@@ -45,7 +138,7 @@ func (db *ddb) UpdateTaskList(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	return db.updateTaskList(ctx, row, 0, previousRangeID)
 }
 
 // UpdateTaskList updates a single tasklist row, and set an TTL on the record
@@ -58,45 +151,290 @@ func (db *ddb) UpdateTaskListWithTTL(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	updated := *row
+	updated.LastUpdatedTime = row.CurrentTimeStamp
+	return db.updateTaskList(ctx, &updated, ttlSeconds, previousRangeID)
+}
+
+func (db *ddb) updateTaskList(
+	ctx context.Context,
+	row *nosqlplugin.TaskListRow,
+	ttlSeconds int64,
+	previousRangeID int64,
+) error {
+	it, err := newTaskListItem(row, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	expr := newExpression().
+		name("range_id", attrRangeID).
+		value("previous_range_id", numberAttr(previousRangeID))
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                 aws.String(db.tableName(tableTaskList)),
+		Item:                      it,
+		ConditionExpression:       aws.String("#range_id = :previous_range_id"),
+		ExpressionAttributeNames:  expr.names,
+		ExpressionAttributeValues: expr.values,
+	})
+	if isConditionalCheckFailed(err) {
+		return db.conflictedTaskList(ctx, row, fmt.Sprintf("previous range_id %v", previousRangeID))
+	}
+	return err
 }
 
 // ListTaskList returns all tasklists.
 // Noop if TTL is already implemented in other methods
 func (db *ddb) ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*nosqlplugin.ListTaskListResult, error) {
-	panic("TODO")
+	return nil, &types.InternalServiceError{
+		Message: "unsupported operation",
+	}
 }
 
 // DeleteTaskList deletes a single tasklist row
 // Return TaskOperationConditionFailure if the condition doesn't meet
 func (db *ddb) DeleteTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter, previousRangeID int64) error {
-	panic("TODO")
+	expr := newExpression().
+		name("range_id", attrRangeID).
+		value("previous_range_id", numberAttr(previousRangeID))
+	_, err := db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName:                 aws.String(db.tableName(tableTaskList)),
+		Key:                       taskListKey(filter),
+		ConditionExpression:       aws.String("#range_id = :previous_range_id"),
+		ExpressionAttributeNames:  expr.names,
+		ExpressionAttributeValues: expr.values,
+	})
+	if isConditionalCheckFailed(err) {
+		return db.conflictedTaskList(ctx, &nosqlplugin.TaskListRow{
+			DomainID:     filter.DomainID,
+			TaskListName: filter.TaskListName,
+			TaskListType: filter.TaskListType,
+		}, fmt.Sprintf("previous range_id %v", previousRangeID))
+	}
+	return err
 }
 
 // InsertTasks inserts a batch of tasks
 // Return TaskOperationConditionFailure if the condition doesn't meet
+// NOTE: every transaction is conditioned on the range_id of the tasklist, but a batch that doesn't fit
+// in a single transaction is not atomic. This is safe as tasks are only read up to the acked task IDs.
 func (db *ddb) InsertTasks(
 	ctx context.Context,
 	tasksToInsert []*nosqlplugin.TaskRowForInsert,
 	tasklistCondition *nosqlplugin.TaskListRow,
 ) error {
-	panic("TODO")
+	filter := &nosqlplugin.TaskListFilter{
+		DomainID:     tasklistCondition.DomainID,
+		TaskListName: tasklistCondition.TaskListName,
+		TaskListType: tasklistCondition.TaskListType,
+	}
+	expr := newExpression().
+		name("range_id", attrRangeID).
+		value("range_id", numberAttr(tasklistCondition.RangeID))
+	rangeIDCondition := &dynamodb.TransactWriteItem{
+		ConditionCheck: &dynamodb.ConditionCheck{
+			TableName:                 aws.String(db.tableName(tableTaskList)),
+			Key:                       taskListKey(filter),
+			ConditionExpression:       aws.String("#range_id = :range_id"),
+			ExpressionAttributeNames:  expr.names,
+			ExpressionAttributeValues: expr.values,
+		},
+	}
+
+	now := time.Now()
+	items := make([]*dynamodb.TransactWriteItem, 0, len(tasksToInsert))
+	for _, task := range tasksToInsert {
+		data, err := dataAttr(&taskData{
+			WorkflowID:      task.WorkflowID,
+			RunID:           task.RunID,
+			ScheduledID:     task.ScheduledID,
+			CreatedTime:     task.CreatedTime,
+			PartitionConfig: task.PartitionConfig,
+			Priority:        task.Priority,
+			FairnessKey:     task.FairnessKey,
+		})
+		if err != nil {
+			return err
+		}
+		it := taskKey(filter, task.TaskID)
+		it[attrData] = data
+		if ttl := ttlAttr(now, int64(task.TTLSeconds)); ttl != nil {
+			it[attrTTL] = ttl
+		}
+		items = append(items, &dynamodb.TransactWriteItem{Put: &dynamodb.Put{
+			TableName: aws.String(db.tableName(tableTask)),
+			Item:      it,
+		}})
+	}
+
+	for start := 0; start < len(items) || start == 0; start += maxTransactionItems - 1 {
+		end := start + maxTransactionItems - 1
+		if end > len(items) {
+			end = len(items)
+		}
+		chunk := append(items[start:end:end], rangeIDCondition)
+		err := db.executeTransaction(ctx, chunk)
+		var cancelled *transactionCancelled
+		if errors.As(err, &cancelled) {
+			return db.conflictedTaskList(ctx, tasklistCondition, fmt.Sprintf("range_id %v", tasklistCondition.RangeID))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // SelectTasks return tasks that associated to a tasklist
 func (db *ddb) SelectTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) ([]*nosqlplugin.TaskRow, error) {
-	panic("TODO")
+	if filter.MinTaskID >= filter.MaxTaskID {
+		return nil, nil
+	}
+	now := time.Now()
+	// reading tasklist tasks needs to be strongly consistent, otherwise we could lose tasks
+	items, _, err := db.queryPage(ctx, db.tasksQuery(filter, "#task_id BETWEEN :min AND :max", item{
+		":min": numberAttr(filter.MinTaskID + 1),
+		":max": numberAttr(filter.MaxTaskID),
+	}), filter.BatchSize, nil, func(it item) bool {
+		return !isExpired(it, now)
+	}, attrTaskListKey, attrTaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]*nosqlplugin.TaskRow, 0, len(items))
+	for _, it := range items {
+		taskID, err := getNumber(it, attrTaskID)
+		if err != nil {
+			return nil, err
+		}
+		var data taskData
+		if err := getData(it, &data); err != nil {
+			return nil, err
+		}
+		task := &nosqlplugin.TaskRow{
+			DomainID:        filter.DomainID,
+			TaskListName:    filter.TaskListName,
+			TaskListType:    filter.TaskListType,
+			TaskID:          taskID,
+			WorkflowID:      data.WorkflowID,
+			RunID:           data.RunID,
+			ScheduledID:     data.ScheduledID,
+			CreatedTime:     data.CreatedTime,
+			PartitionConfig: data.PartitionConfig,
+			Priority:        data.Priority,
+			FairnessKey:     data.FairnessKey,
+		}
+		if ttl, err := getNumber(it, attrTTL); err == nil {
+			task.Expiry = time.Unix(ttl, 0)
+		}
+		response = append(response, task)
+	}
+	return response, nil
 }
 
 // SelectTasks return tasks that associated to a tasklist
 func (db *ddb) GetTasksCount(ctx context.Context, filter *nosqlplugin.TasksFilter) (int64, error) {
-	panic("TODO")
+	return db.countItems(ctx, db.tasksQuery(filter, "#task_id > :min", item{
+		":min": numberAttr(filter.MinTaskID),
+	}))
 }
 
 // DeleteTask delete a batch tasks that taskIDs less than the row
 // If TTL is not implemented, then should also return the number of rows deleted, otherwise persistence.UnknownNumRowsAffected
-// NOTE: This API ignores the `BatchSize` request parameter i.e. either all tasks leq the task_id will be deleted or an error will
-// be returned to the caller, because rowsDeleted is not supported by Cassandra
+// NOTE: unlike Cassandra, DynamoDB deletes items one by one so BatchSize is respected and the number of deleted rows is returned
 func (db *ddb) RangeDeleteTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) (rowsDeleted int, err error) {
-	panic("TODO")
+	if filter.MinTaskID >= filter.MaxTaskID {
+		return 0, nil
+	}
+	input := db.tasksQuery(filter, "#task_id BETWEEN :min AND :max", item{
+		":min": numberAttr(filter.MinTaskID + 1),
+		":max": numberAttr(filter.MaxTaskID),
+	})
+	input.ProjectionExpression = aws.String("#tasklist_key, #task_id")
+	items, _, err := db.queryPage(ctx, input, filter.BatchSize, nil, nil, attrTaskListKey, attrTaskID)
+	if err != nil {
+		return 0, err
+	}
+	keys := make([]item, 0, len(items))
+	for _, it := range items {
+		keys = append(keys, keyOf(it, attrTaskListKey, attrTaskID))
+	}
+	return db.deleteItems(ctx, tableTask, keys)
+}
+
+// tasksQuery builds a query over the tasks of a tasklist, rangeCondition restricts #task_id using the given values
+func (db *ddb) tasksQuery(filter *nosqlplugin.TasksFilter, rangeCondition string, values item) *dynamodb.QueryInput {
+	expr := newExpression().
+		name("tasklist_key", attrTaskListKey).
+		name("task_id", attrTaskID).
+		value("tasklist_key", stringAttr(taskListKeyValue(&filter.TaskListFilter)))
+	for k, v := range values {
+		expr.values[k] = v
+	}
+	return &dynamodb.QueryInput{
+		TableName:                 aws.String(db.tableName(tableTask)),
+		KeyConditionExpression:    aws.String("#tasklist_key = :tasklist_key AND " + rangeCondition),
+		ExpressionAttributeNames:  expr.names,
+		ExpressionAttributeValues: expr.values,
+		ConsistentRead:            aws.Bool(true),
+	}
+}
+
+func (db *ddb) conflictedTaskList(ctx context.Context, row *nosqlplugin.TaskListRow, details string) error {
+	var rangeID int64
+	it, err := db.getItem(ctx, tableTaskList, taskListKey(&nosqlplugin.TaskListFilter{
+		DomainID:     row.DomainID,
+		TaskListName: row.TaskListName,
+		TaskListType: row.TaskListType,
+	}))
+	switch {
+	case err == nil:
+		if rangeID, err = getNumber(it, attrRangeID); err != nil {
+			return err
+		}
+	case !db.IsNotFoundError(err):
+		return err
+	}
+	return &nosqlplugin.TaskOperationConditionFailure{
+		RangeID: rangeID,
+		Details: fmt.Sprintf("range_id=%v, %v", rangeID, details),
+	}
+}
+
+func taskListKeyValue(filter *nosqlplugin.TaskListFilter) string {
+	return compositeKey(filter.DomainID, filter.TaskListName, strconv.Itoa(filter.TaskListType))
+}
+
+func taskListKey(filter *nosqlplugin.TaskListFilter) item {
+	return item{attrTaskListKey: stringAttr(taskListKeyValue(filter))}
+}
+
+func taskKey(filter *nosqlplugin.TaskListFilter, taskID int64) item {
+	return item{
+		attrTaskListKey: stringAttr(taskListKeyValue(filter)),
+		attrTaskID:      numberAttr(taskID),
+	}
+}
+
+func newTaskListItem(row *nosqlplugin.TaskListRow, ttlSeconds int64) (item, error) {
+	data, err := dataAttr(&taskListData{
+		TaskListKind:            row.TaskListKind,
+		AckLevel:                row.AckLevel,
+		LastUpdatedTime:         row.LastUpdatedTime,
+		AdaptivePartitionConfig: row.AdaptivePartitionConfig,
+	})
+	if err != nil {
+		return nil, err
+	}
+	it := taskListKey(&nosqlplugin.TaskListFilter{
+		DomainID:     row.DomainID,
+		TaskListName: row.TaskListName,
+		TaskListType: row.TaskListType,
+	})
+	it[attrRangeID] = numberAttr(row.RangeID)
+	it[attrData] = data
+	if ttl := ttlAttr(time.Now(), ttlSeconds); ttl != nil {
+		it[attrTTL] = ttl
+	}
+	return it, nil
 }
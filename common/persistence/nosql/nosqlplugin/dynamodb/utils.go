@@ -0,0 +1,438 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// Items are laid out following NOTE 2 of the nosqlplugin interfaces: only the keys and the columns used in
+// conditions are stored as top level attributes, everything else goes into the JSON encoded `data` attribute.
+const (
+	attrData = "data"
+	// attrTTL holds the expiry as epoch seconds, it's configured as the TTL attribute of the tables that need it
+	attrTTL = "ttl"
+
+	// maxTransactionItems is the limit of items in a single TransactWriteItems call
+	maxTransactionItems = 100
+	// maxBatchWriteItems is the limit of items in a single BatchWriteItem call
+	maxBatchWriteItems = 25
+
+	conditionCheckFailedCode = "ConditionalCheckFailed"
+)
+
+type (
+	item = map[string]*dynamodb.AttributeValue
+
+	// transactionCancelled is returned by executeTransaction when at least one condition
+	// of the transaction failed, failedIndexes refers to the position of the items in the request
+	transactionCancelled struct {
+		failedIndexes []int
+	}
+)
+
+func (e *transactionCancelled) Error() string {
+	return fmt.Sprintf("transaction cancelled, conditions failed on items %v", e.failedIndexes)
+}
+
+func (e *transactionCancelled) failed(index int) bool {
+	for _, i := range e.failedIndexes {
+		if i == index {
+			return true
+		}
+	}
+	return false
+}
+
+func stringAttr(v string) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{S: aws.String(v)}
+}
+
+func numberAttr(v int64) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(v, 10))}
+}
+
+func binaryAttr(v []byte) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{B: v}
+}
+
+// dataAttr JSON encodes the non-significant columns of a row
+func dataAttr(v interface{}) (*dynamodb.AttributeValue, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("encoding item data: %w", err)
+	}
+	return binaryAttr(data), nil
+}
+
+// ttlAttr returns the TTL attribute for the given time to live, or nil if the item should not expire
+func ttlAttr(now time.Time, ttlSeconds int64) *dynamodb.AttributeValue {
+	if ttlSeconds <= 0 {
+		return nil
+	}
+	return numberAttr(now.Unix() + ttlSeconds)
+}
+
+func getString(it item, name string) string {
+	if v, ok := it[name]; ok && v.S != nil {
+		return *v.S
+	}
+	return ""
+}
+
+func getNumber(it item, name string) (int64, error) {
+	v, ok := it[name]
+	if !ok || v.N == nil {
+		return 0, fmt.Errorf("attribute %q is missing", name)
+	}
+	return strconv.ParseInt(*v.N, 10, 64)
+}
+
+func getData(it item, out interface{}) error {
+	v, ok := it[attrData]
+	if !ok {
+		return fmt.Errorf("attribute %q is missing", attrData)
+	}
+	if err := json.Unmarshal(v.B, out); err != nil {
+		return fmt.Errorf("decoding item data: %w", err)
+	}
+	return nil
+}
+
+// isExpired reports whether the TTL of an item already passed,
+// DynamoDB deletes expired items lazily so reads have to skip them
+func isExpired(it item, now time.Time) bool {
+	ttl, err := getNumber(it, attrTTL)
+	return err == nil && ttl <= now.Unix()
+}
+
+// compositeKey builds a string key out of several columns, every part is length prefixed so that
+// values containing the separator (e.g. workflow IDs) can't collide with each other
+func compositeKey(parts ...string) string {
+	var b strings.Builder
+	for _, p := range parts {
+		b.WriteString(strconv.Itoa(len(p)))
+		b.WriteByte(':')
+		b.WriteString(p)
+	}
+	return b.String()
+}
+
+// splitCompositeKey returns the parts of a key built by compositeKey
+func splitCompositeKey(key string) ([]string, error) {
+	var parts []string
+	for len(key) > 0 {
+		sep := strings.IndexByte(key, ':')
+		if sep < 0 {
+			return nil, fmt.Errorf("malformed composite key %q", key)
+		}
+		n, err := strconv.Atoi(key[:sep])
+		if err != nil || n < 0 || sep+1+n > len(key) {
+			return nil, fmt.Errorf("malformed composite key %q", key)
+		}
+		parts = append(parts, key[sep+1:sep+1+n])
+		key = key[sep+1+n:]
+	}
+	return parts, nil
+}
+
+// sortableInt formats a number so that the lexical order matches the numeric order,
+// the sign bit is flipped so that negative numbers sort before positive ones
+func sortableInt(v int64) string {
+	return fmt.Sprintf("%020d", uint64(v)^(1<<63))
+}
+
+// descendingInt formats a number so that the lexical order is the reverse of the numeric order
+func descendingInt(v int64) string {
+	return fmt.Sprintf("%020d", ^(uint64(v) ^ (1 << 63)))
+}
+
+// expression builds the placeholder maps of a condition/key/update expression,
+// names are referenced as #name and values as :name
+type expression struct {
+	names  map[string]*string
+	values item
+}
+
+func newExpression() *expression {
+	return &expression{}
+}
+
+func (e *expression) name(placeholder, attribute string) *expression {
+	if e.names == nil {
+		e.names = make(map[string]*string)
+	}
+	e.names["#"+placeholder] = aws.String(attribute)
+	return e
+}
+
+func (e *expression) value(placeholder string, v *dynamodb.AttributeValue) *expression {
+	if e.values == nil {
+		e.values = make(item)
+	}
+	e.values[":"+placeholder] = v
+	return e
+}
+
+func encodePageToken(lastEvaluatedKey item) ([]byte, error) {
+	if len(lastEvaluatedKey) == 0 {
+		return nil, nil
+	}
+	token, err := json.Marshal(lastEvaluatedKey)
+	if err != nil {
+		return nil, fmt.Errorf("encoding page token: %w", err)
+	}
+	return token, nil
+}
+
+func decodePageToken(token []byte) (item, error) {
+	if len(token) == 0 {
+		return nil, nil
+	}
+	var key item
+	if err := json.Unmarshal(token, &key); err != nil {
+		return nil, fmt.Errorf("invalid page token: %w", err)
+	}
+	return key, nil
+}
+
+// keyOf extracts the given key attributes from an item, used to build a page token
+// that resumes right after an item when a page is cut short
+func keyOf(it item, names ...string) item {
+	key := make(item, len(names))
+	for _, n := range names {
+		key[n] = it[n]
+	}
+	return key
+}
+
+func (db *ddb) getItem(ctx context.Context, table string, key item) (item, error) {
+	out, err := db.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(db.tableName(table)),
+		Key:            key,
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Item) == 0 {
+		return nil, errNotFound
+	}
+	return out.Item, nil
+}
+
+func (db *ddb) deleteItem(ctx context.Context, table string, key item) error {
+	_, err := db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(db.tableName(table)),
+		Key:       key,
+	})
+	return err
+}
+
+// queryAll runs a query to completion and returns every matching item, only use it for bounded result sets
+func (db *ddb) queryAll(ctx context.Context, input *dynamodb.QueryInput) ([]item, error) {
+	var items []item
+	for {
+		out, err := db.client.QueryWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, out.Items...)
+		if len(out.LastEvaluatedKey) == 0 {
+			return items, nil
+		}
+		input.ExclusiveStartKey = out.LastEvaluatedKey
+	}
+}
+
+// queryPage returns up to pageSize items. DynamoDB applies Limit before the filter expression,
+// so the query is repeated until the page is full or the results are exhausted. When the page
+// is cut short the token is built from the last returned item using keyNames.
+func (db *ddb) queryPage(
+	ctx context.Context,
+	input *dynamodb.QueryInput,
+	pageSize int,
+	pageToken []byte,
+	keep func(item) bool,
+	keyNames ...string,
+) ([]item, []byte, error) {
+	startKey, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	input.ExclusiveStartKey = startKey
+	var items []item
+	for {
+		if pageSize > 0 {
+			input.Limit = aws.Int64(int64(pageSize - len(items)))
+		}
+		out, err := db.client.QueryWithContext(ctx, input)
+		if err != nil {
+			return nil, nil, err
+		}
+		for i, it := range out.Items {
+			if keep != nil && !keep(it) {
+				continue
+			}
+			items = append(items, it)
+			if pageSize > 0 && len(items) == pageSize {
+				if i == len(out.Items)-1 && len(out.LastEvaluatedKey) == 0 {
+					return items, nil, nil
+				}
+				token, err := encodePageToken(keyOf(it, keyNames...))
+				return items, token, err
+			}
+		}
+		if len(out.LastEvaluatedKey) == 0 {
+			return items, nil, nil
+		}
+		input.ExclusiveStartKey = out.LastEvaluatedKey
+	}
+}
+
+// countItems returns the number of items matching a query
+func (db *ddb) countItems(ctx context.Context, input *dynamodb.QueryInput) (int64, error) {
+	input.Select = aws.String(dynamodb.SelectCount)
+	var count int64
+	for {
+		out, err := db.client.QueryWithContext(ctx, input)
+		if err != nil {
+			return 0, err
+		}
+		count += aws.Int64Value(out.Count)
+		if len(out.LastEvaluatedKey) == 0 {
+			return count, nil
+		}
+		input.ExclusiveStartKey = out.LastEvaluatedKey
+	}
+}
+
+// deleteItems deletes the given keys with batch writes and returns the number of deleted items
+func (db *ddb) deleteItems(ctx context.Context, table string, keys []item) (int, error) {
+	requests := make([]*dynamodb.WriteRequest, 0, len(keys))
+	for _, key := range keys {
+		requests = append(requests, &dynamodb.WriteRequest{DeleteRequest: &dynamodb.DeleteRequest{Key: key}})
+	}
+	return db.batchWrite(ctx, table, requests)
+}
+
+// putItems writes the given items with batch writes and returns the number of written items
+func (db *ddb) putItems(ctx context.Context, table string, items []item) (int, error) {
+	requests := make([]*dynamodb.WriteRequest, 0, len(items))
+	for _, it := range items {
+		requests = append(requests, &dynamodb.WriteRequest{PutRequest: &dynamodb.PutRequest{Item: it}})
+	}
+	return db.batchWrite(ctx, table, requests)
+}
+
+// batchWrite runs the write requests of a table in chunks of maxBatchWriteItems and returns the number of
+// requests that were written, unprocessed requests are retried until they are written
+func (db *ddb) batchWrite(ctx context.Context, table string, requests []*dynamodb.WriteRequest) (int, error) {
+	tableName := db.tableName(table)
+	for start := 0; start < len(requests); start += maxBatchWriteItems {
+		end := start + maxBatchWriteItems
+		if end > len(requests) {
+			end = len(requests)
+		}
+		pending := map[string][]*dynamodb.WriteRequest{tableName: requests[start:end]}
+		for len(pending) > 0 {
+			out, err := db.client.BatchWriteItemWithContext(ctx, &dynamodb.BatchWriteItemInput{RequestItems: pending})
+			if err != nil {
+				return start, err
+			}
+			pending = out.UnprocessedItems
+		}
+	}
+	return len(requests), nil
+}
+
+// rangeDelete deletes every item matched by the query, keyNames are the primary key attributes of the table
+func (db *ddb) rangeDelete(ctx context.Context, table string, input *dynamodb.QueryInput, keyNames ...string) (int, error) {
+	input.ProjectionExpression = aws.String(strings.Join(projectionNames(input, keyNames), ", "))
+	items, err := db.queryAll(ctx, input)
+	if err != nil {
+		return 0, err
+	}
+	keys := make([]item, 0, len(items))
+	for _, it := range items {
+		keys = append(keys, keyOf(it, keyNames...))
+	}
+	return db.deleteItems(ctx, table, keys)
+}
+
+// projectionNames registers the key attributes as expression names, so that reserved words can be projected
+func projectionNames(input *dynamodb.QueryInput, keyNames []string) []string {
+	if input.ExpressionAttributeNames == nil {
+		input.ExpressionAttributeNames = make(map[string]*string)
+	}
+	placeholders := make([]string, 0, len(keyNames))
+	for i, n := range keyNames {
+		p := fmt.Sprintf("#pk%d", i)
+		input.ExpressionAttributeNames[p] = aws.String(n)
+		placeholders = append(placeholders, p)
+	}
+	return placeholders
+}
+
+// executeTransaction runs a TransactWriteItems call. When the transaction is cancelled only because
+// of failed conditions a *transactionCancelled is returned, any other failure is returned as is.
+func (db *ddb) executeTransaction(ctx context.Context, items []*dynamodb.TransactWriteItem) error {
+	if len(items) > maxTransactionItems {
+		return fmt.Errorf("transaction has %d items which exceeds the DynamoDB limit of %d", len(items), maxTransactionItems)
+	}
+	_, err := db.client.TransactWriteItemsWithContext(ctx, &dynamodb.TransactWriteItemsInput{TransactItems: items})
+	if err == nil {
+		return nil
+	}
+	var cancelled *dynamodb.TransactionCanceledException
+	if !errors.As(err, &cancelled) {
+		return err
+	}
+	result := &transactionCancelled{}
+	for i, reason := range cancelled.CancellationReasons {
+		switch code := aws.StringValue(reason.Code); code {
+		case conditionCheckFailedCode:
+			result.failedIndexes = append(result.failedIndexes, i)
+		case "", "None":
+		default:
+			// e.g. TransactionConflict or ThrottlingError, let the caller retry
+			return err
+		}
+	}
+	if len(result.failedIndexes) == 0 {
+		return err
+	}
+	return result
+}
+
+func isConditionalCheckFailed(err error) bool {
+	var condErr *dynamodb.ConditionalCheckFailedException
+	return errors.As(err, &condErr)
+}
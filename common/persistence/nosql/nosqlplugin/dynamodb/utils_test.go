@@ -0,0 +1,186 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompositeKey(t *testing.T) {
+	assert.Equal(t, "3:abc0:2:de", compositeKey("abc", "", "de"))
+	// the separator is part of the values but the keys don't collide
+	assert.NotEqual(t, compositeKey("a:b", "c"), compositeKey("a", "b:c"))
+}
+
+func TestSplitCompositeKey(t *testing.T) {
+	parts, err := splitCompositeKey(compositeKey("a:b", "", "12:c"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a:b", "", "12:c"}, parts)
+
+	for _, key := range []string{"3:ab", "ab", "x:a", "-1:"} {
+		_, err := splitCompositeKey(key)
+		assert.Error(t, err, key)
+	}
+}
+
+func TestSortableInt(t *testing.T) {
+	values := []int64{math.MinInt64, -1000, -1, 0, 1, 42, 1000, math.MaxInt64}
+	ascending := make([]string, 0, len(values))
+	descending := make([]string, 0, len(values))
+	for _, v := range values {
+		ascending = append(ascending, sortableInt(v))
+		descending = append(descending, descendingInt(v))
+	}
+	assert.True(t, sort.StringsAreSorted(ascending), "sortableInt should keep the numeric order: %v", ascending)
+	sort.Sort(sort.Reverse(sort.StringSlice(descending)))
+	for i, v := range values {
+		assert.Equal(t, descendingInt(v), descending[i], "descendingInt should reverse the numeric order")
+	}
+}
+
+func TestPageToken(t *testing.T) {
+	token, err := encodePageToken(nil)
+	require.NoError(t, err)
+	assert.Nil(t, token)
+
+	key := item{attrShardID: numberAttr(1), attrTaskID: numberAttr(2)}
+	token, err = encodePageToken(key)
+	require.NoError(t, err)
+	decoded, err := decodePageToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, key, decoded)
+
+	_, err = decodePageToken([]byte("not json"))
+	assert.Error(t, err)
+}
+
+func TestIsExpired(t *testing.T) {
+	now := time.Unix(1000, 0)
+	assert.False(t, isExpired(item{}, now))
+	assert.False(t, isExpired(item{attrTTL: ttlAttr(now, 10)}, now))
+	assert.True(t, isExpired(item{attrTTL: numberAttr(1000)}, now))
+	assert.Nil(t, ttlAttr(now, 0))
+}
+
+func TestQueryPage(t *testing.T) {
+	pages := [][]item{
+		{{attrTaskID: numberAttr(1)}, {attrTaskID: numberAttr(2)}},
+		{{attrTaskID: numberAttr(3)}, {attrTaskID: numberAttr(4)}},
+	}
+	client := &fakeClient{
+		query: func(input *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
+			page := 0
+			if input.ExclusiveStartKey != nil {
+				page = 1
+			}
+			out := &dynamodb.QueryOutput{Items: pages[page]}
+			if page == 0 {
+				out.LastEvaluatedKey = item{attrTaskID: numberAttr(2)}
+			}
+			return out, nil
+		},
+	}
+	db := newTestDB(t, client)
+	skipOdd := func(it item) bool {
+		id, _ := getNumber(it, attrTaskID)
+		return id%2 == 0
+	}
+
+	// the filter leaves a single item on each page, so both pages are read
+	items, token, err := db.queryPage(context.Background(), &dynamodb.QueryInput{}, 2, nil, skipOdd, attrTaskID)
+	require.NoError(t, err)
+	assert.Len(t, items, 2)
+	assert.Nil(t, token)
+
+	// the page is full before the results are exhausted, the token points at the last item
+	items, token, err = db.queryPage(context.Background(), &dynamodb.QueryInput{}, 1, nil, nil, attrTaskID)
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+	key, err := decodePageToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, "1", aws.StringValue(key[attrTaskID].N))
+}
+
+func TestExecuteTransaction(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantFailed []int
+		wantErr    bool
+	}{
+		{
+			name: "success",
+		},
+		{
+			name: "conditions failed",
+			err: &dynamodb.TransactionCanceledException{CancellationReasons: []*dynamodb.CancellationReason{
+				{Code: aws.String("None")},
+				{Code: aws.String(conditionCheckFailedCode)},
+				{Code: aws.String(conditionCheckFailedCode)},
+			}},
+			wantFailed: []int{1, 2},
+			wantErr:    true,
+		},
+		{
+			name: "transaction conflict is returned as is",
+			err: &dynamodb.TransactionCanceledException{CancellationReasons: []*dynamodb.CancellationReason{
+				{Code: aws.String(conditionCheckFailedCode)},
+				{Code: aws.String("TransactionConflict")},
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "other error",
+			err:     errors.New("boom"),
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			db := newTestDB(t, &fakeClient{
+				transactWrite: func(*dynamodb.TransactWriteItemsInput) (*dynamodb.TransactWriteItemsOutput, error) {
+					return &dynamodb.TransactWriteItemsOutput{}, tc.err
+				},
+			})
+			err := db.executeTransaction(context.Background(), []*dynamodb.TransactWriteItem{{}})
+			assert.Equal(t, tc.wantErr, err != nil)
+			var cancelled *transactionCancelled
+			if errors.As(err, &cancelled) {
+				assert.Equal(t, tc.wantFailed, cancelled.failedIndexes)
+			} else {
+				assert.Nil(t, tc.wantFailed)
+			}
+		})
+	}
+
+	db := newTestDB(t, &fakeClient{})
+	assert.Error(t, db.executeTransaction(context.Background(), make([]*dynamodb.TransactWriteItem, maxTransactionItems+1)))
+}
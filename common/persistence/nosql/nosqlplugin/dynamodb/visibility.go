@@ -1,4 +1,4 @@
-// Copyright (c) 2021 Uber Technologies, Inc.
+// Copyright (c) 2020 Uber Technologies, Inc.
 //
 // Permission is hereby granted, free of charge, to any person obtaining a copy
 // of this software and associated documentation files (the "Software"), to deal
@@ -22,43 +22,221 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+const (
+	tableVisibility = "visibility"
+
+	// visibilityStartTimeIndex and visibilityCloseTimeIndex are local secondary indexes,
+	// close_time is only set on closed workflows so the close time index is sparse
+	visibilityStartTimeIndex = "start_time_index"
+	visibilityCloseTimeIndex = "close_time_index"
+
+	attrRunID            = "run_id"
+	attrWorkflowID       = "workflow_id"
+	attrWorkflowTypeName = "workflow_type_name"
+	attrStartTime        = "start_time"
+	attrCloseTime        = "close_time"
+	attrCloseStatus      = "close_status"
+)
+
+// InsertVisibility creates a new visibility record of an open workflow
+// NOTE: search attributes are ignored, same as Cassandra
 func (db *ddb) InsertVisibility(
 	ctx context.Context,
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForInsert,
 ) error {
-	panic("TODO")
+	it, err := newVisibilityItem(row.DomainID, &row.VisibilityRow, ttlSeconds, false)
+	if err != nil {
+		return err
+	}
+	// the started record may arrive after the closed one, it must not reopen the workflow
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                aws.String(db.tableName(tableVisibility)),
+		Item:                     it,
+		ConditionExpression:      aws.String("attribute_not_exists(#close_time)"),
+		ExpressionAttributeNames: newExpression().name("close_time", attrCloseTime).names,
+	})
+	if isConditionalCheckFailed(err) {
+		return nil
+	}
+	return err
 }
 
+// UpdateVisibility replaces the visibility record of a workflow with the closed one.
+// Open and closed records share a single item so UpdateOpenToClose doesn't need extra work.
 func (db *ddb) UpdateVisibility(
 	ctx context.Context,
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForUpdate,
 ) error {
-	panic("TODO")
+	if row.UpdateCloseToOpen {
+		return fmt.Errorf("not supported operation")
+	}
+	it, err := newVisibilityItem(row.DomainID, &row.VisibilityRow, ttlSeconds, true)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(db.tableName(tableVisibility)),
+		Item:      it,
+	})
+	return err
 }
 
 func (db *ddb) SelectVisibility(
 	ctx context.Context,
 	filter *nosqlplugin.VisibilityFilter,
 ) (*nosqlplugin.SelectVisibilityResponse, error) {
-	panic("TODO")
+	request := &filter.ListRequest
+	expr := newExpression().
+		name("domain_id", attrDomainID).
+		value("domain_id", stringAttr(request.DomainUUID)).
+		value("earliest", numberAttr(request.EarliestTime.UnixNano())).
+		value("latest", numberAttr(request.LatestTime.UnixNano()))
+
+	index, sortAttr := visibilityStartTimeIndex, attrStartTime
+	if filter.SortType == nosqlplugin.SortByClosedTime {
+		index, sortAttr = visibilityCloseTimeIndex, attrCloseTime
+	}
+	expr.name("sort_key", sortAttr)
+
+	var conditions []string
+	switch filter.FilterType {
+	case nosqlplugin.AllOpen, nosqlplugin.OpenByWorkflowType, nosqlplugin.OpenByWorkflowID:
+		if filter.SortType == nosqlplugin.SortByClosedTime {
+			return nil, fmt.Errorf("not supported sorting type")
+		}
+		expr.name("close_time", attrCloseTime)
+		conditions = append(conditions, "attribute_not_exists(#close_time)")
+	case nosqlplugin.AllClosed, nosqlplugin.ClosedByWorkflowType, nosqlplugin.ClosedByWorkflowID, nosqlplugin.ClosedByClosedStatus:
+		expr.name("close_time", attrCloseTime)
+		conditions = append(conditions, "attribute_exists(#close_time)")
+	default:
+		return nil, fmt.Errorf("not supported filter type")
+	}
+	switch filter.FilterType {
+	case nosqlplugin.OpenByWorkflowType, nosqlplugin.ClosedByWorkflowType:
+		expr.name("workflow_type_name", attrWorkflowTypeName).value("workflow_type_name", stringAttr(filter.WorkflowType))
+		conditions = append(conditions, "#workflow_type_name = :workflow_type_name")
+	case nosqlplugin.OpenByWorkflowID, nosqlplugin.ClosedByWorkflowID:
+		expr.name("workflow_id", attrWorkflowID).value("workflow_id", stringAttr(filter.WorkflowID))
+		conditions = append(conditions, "#workflow_id = :workflow_id")
+	case nosqlplugin.ClosedByClosedStatus:
+		expr.name("close_status", attrCloseStatus).value("close_status", numberAttr(int64(filter.CloseStatus)))
+		conditions = append(conditions, "#close_status = :close_status")
+	}
+
+	now := time.Now()
+	items, nextPageToken, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:                 aws.String(db.tableName(tableVisibility)),
+		IndexName:                 aws.String(index),
+		KeyConditionExpression:    aws.String("#domain_id = :domain_id AND #sort_key BETWEEN :earliest AND :latest"),
+		FilterExpression:          aws.String(strings.Join(conditions, " AND ")),
+		ExpressionAttributeNames:  expr.names,
+		ExpressionAttributeValues: expr.values,
+		// latest workflows first
+		ScanIndexForward: aws.Bool(false),
+	}, request.PageSize, request.NextPageToken, func(it item) bool {
+		return !isExpired(it, now)
+	}, attrDomainID, attrRunID, sortAttr)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &nosqlplugin.SelectVisibilityResponse{
+		Executions:    make([]*nosqlplugin.VisibilityRow, 0, len(items)),
+		NextPageToken: nextPageToken,
+	}
+	for _, it := range items {
+		row := &nosqlplugin.VisibilityRow{}
+		if err := getData(it, row); err != nil {
+			return nil, err
+		}
+		response.Executions = append(response.Executions, row)
+	}
+	return response, nil
 }
 
+// DeleteVisibility is a noop as the records are deleted with TTL, but the records are explicitly
+// deleted when an admin command is issued
 func (db *ddb) DeleteVisibility(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) error {
-	panic("TODO")
+	key := persistence.VisibilityAdminDeletionKey("visibilityAdminDelete")
+	if v := ctx.Value(key); v != nil && v.(bool) {
+		return db.deleteItem(ctx, tableVisibility, visibilityKey(domainID, runID))
+	}
+	return nil
 }
 
 func (db *ddb) SelectOneClosedWorkflow(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) (*nosqlplugin.VisibilityRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableVisibility, visibilityKey(domainID, runID))
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			// Special case: return nil,nil if not found(since we will deprecate it, it's not worth refactor to be consistent)
+			return nil, nil
+		}
+		return nil, err
+	}
+	if _, ok := it[attrCloseTime]; !ok || getString(it, attrWorkflowID) != workflowID {
+		return nil, nil
+	}
+	row := &nosqlplugin.VisibilityRow{}
+	if err := getData(it, row); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+func visibilityKey(domainID, runID string) item {
+	return item{
+		attrDomainID: stringAttr(domainID),
+		attrRunID:    stringAttr(runID),
+	}
+}
+
+func newVisibilityItem(domainID string, row *nosqlplugin.VisibilityRow, ttlSeconds int64, closed bool) (item, error) {
+	record := *row
+	record.DomainID = domainID
+	record.SearchAttributes = nil
+	if !closed {
+		record.CloseTime = time.Time{}
+		record.Status = nil
+		record.HistoryLength = 0
+	}
+	data, err := dataAttr(&record)
+	if err != nil {
+		return nil, err
+	}
+
+	it := visibilityKey(domainID, row.RunID)
+	it[attrWorkflowID] = stringAttr(row.WorkflowID)
+	it[attrWorkflowTypeName] = stringAttr(row.TypeName)
+	it[attrStartTime] = numberAttr(row.StartTime.UnixNano())
+	it[attrData] = data
+	if closed {
+		it[attrCloseTime] = numberAttr(row.CloseTime.UnixNano())
+		if row.Status != nil {
+			it[attrCloseStatus] = numberAttr(int64(*row.Status))
+		}
+	}
+	if ttl := ttlAttr(time.Now(), ttlSeconds); ttl != nil {
+		it[attrTTL] = ttl
+	}
+	return it, nil
 }
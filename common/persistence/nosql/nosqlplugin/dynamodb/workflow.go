@@ -23,8 +23,13 @@ package dynamodb
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
@@ -40,9 +45,39 @@ func (db *ddb) InsertWorkflowExecutionWithTasks(
 	activeClusterSelectionPolicyRow *nosqlplugin.ActiveClusterSelectionPolicyRow,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	domainID := execution.DomainID
+	workflowID := execution.WorkflowID
+
+	t := &workflowTransaction{}
+	if err := db.addActiveClusterSelectionPolicy(t, activeClusterSelectionPolicyRow); err != nil {
+		return err
+	}
+	if err := db.addWorkflowRequests(t, requests, execution.CurrentTimeStamp); err != nil {
+		return err
+	}
+	if err := db.addCurrentWorkflow(t, shardID, domainID, workflowID, currentWorkflowRequest); err != nil {
+		return err
+	}
+	if err := db.addCreatedExecution(t, shardID, execution); err != nil {
+		return err
+	}
+	if err := db.addTasks(t, shardID, domainID, workflowID, tasksByCategory); err != nil {
+		return err
+	}
+	db.addShardCondition(t, shardCondition)
+
+	err := db.executeWorkflowTransaction(ctx, t)
+	var cancelled *transactionCancelled
+	if errors.As(err, &cancelled) {
+		return db.createWorkflowConditionFailure(ctx, t, cancelled, currentWorkflowRequest, execution, shardCondition)
+	}
+	return err
 }
 
+// UpdateWorkflowExecutionWithTasks updates a workflow execution and its tasks in a single transaction
+// NOTE: the mutated execution is applied on top of the stored item. When another write changed the item
+// without moving next_event_id in between, the update is rebuilt and retried a few times.
 func (db *ddb) UpdateWorkflowExecutionWithTasks(
 	ctx context.Context,
 	requests *nosqlplugin.WorkflowRequestsWriteRequest,
@@ -54,105 +89,511 @@ func (db *ddb) UpdateWorkflowExecutionWithTasks(
 	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	var domainID, workflowID string
+	var previousNextEventIDCondition int64
+	var timeStamp time.Time
+	if mutatedExecution != nil {
+		domainID = mutatedExecution.DomainID
+		workflowID = mutatedExecution.WorkflowID
+		previousNextEventIDCondition = *mutatedExecution.PreviousNextEventIDCondition
+		timeStamp = mutatedExecution.CurrentTimeStamp
+	} else if resetExecution != nil {
+		domainID = resetExecution.DomainID
+		workflowID = resetExecution.WorkflowID
+		previousNextEventIDCondition = *resetExecution.PreviousNextEventIDCondition
+		timeStamp = resetExecution.CurrentTimeStamp
+	} else {
+		return fmt.Errorf("at least one of mutatedExecution and resetExecution should be provided")
+	}
+
+	for attempt := 0; ; attempt++ {
+		t := &workflowTransaction{}
+		if err := db.addWorkflowRequests(t, requests, timeStamp); err != nil {
+			return err
+		}
+		if err := db.addCurrentWorkflow(t, shardID, domainID, workflowID, currentWorkflowRequest); err != nil {
+			return err
+		}
+		if mutatedExecution != nil {
+			if err := db.addMutatedExecution(ctx, t, shardID, mutatedExecution); err != nil {
+				return err
+			}
+		}
+		if insertedExecution != nil {
+			if err := db.addCreatedExecution(t, shardID, insertedExecution); err != nil {
+				return err
+			}
+			if err := db.addActiveClusterSelectionPolicy(t, activeClusterSelectionPolicyRow); err != nil {
+				return err
+			}
+		}
+		if resetExecution != nil {
+			if err := db.addResetExecution(ctx, t, shardID, resetExecution); err != nil {
+				return err
+			}
+		}
+		if err := db.addTasks(t, shardID, domainID, workflowID, tasksByCategory); err != nil {
+			return err
+		}
+		db.addShardCondition(t, shardCondition)
+
+		err := db.executeWorkflowTransaction(ctx, t)
+		var cancelled *transactionCancelled
+		if !errors.As(err, &cancelled) {
+			return err
+		}
+		retry, err := db.updateWorkflowConditionFailure(ctx, t, cancelled, currentWorkflowRequest, previousNextEventIDCondition, shardCondition)
+		if !retry {
+			return err
+		}
+		if attempt == maxRecordVersionConflictRetries {
+			return fmt.Errorf("failed to update mutable state after %v attempts, the execution is changed concurrently", attempt+1)
+		}
+	}
 }
 
 func (db *ddb) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*nosqlplugin.CurrentWorkflowRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableCurrentWorkflow, currentWorkflowKey(shardID, domainID, workflowID))
+	if err != nil {
+		return nil, err
+	}
+	row := &nosqlplugin.CurrentWorkflowRow{}
+	if err := getData(it, row); err != nil {
+		return nil, err
+	}
+	row.ShardID = shardID
+	row.DomainID = domainID
+	row.WorkflowID = workflowID
+	return row, nil
 }
 
+// SelectWorkflowExecution reads the execution item and then the map entries of its generation. The record version is
+// read again afterwards, a concurrent write in between changes it and the execution is read again.
 func (db *ddb) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*nosqlplugin.WorkflowExecution, error) {
-	panic("TODO")
+	key := executionKey(shardID, domainID, workflowID, runID)
+	for attempt := 0; ; attempt++ {
+		it, err := db.getItem(ctx, tableWorkflowExecution, key)
+		if err != nil {
+			return nil, err
+		}
+		data := &executionData{}
+		if err := getData(it, data); err != nil {
+			return nil, err
+		}
+		recordVersion, err := getNumber(it, attrRecordVersion)
+		if err != nil {
+			return nil, err
+		}
+		prefix := mapGenerationPrefix(domainID, workflowID, runID, data.MapsGeneration)
+		maps, err := db.selectExecutionMaps(ctx, shardID, prefix)
+		if err != nil {
+			return nil, err
+		}
+		it, err = db.getItem(ctx, tableWorkflowExecution, key)
+		if err != nil {
+			return nil, err
+		}
+		if latest, err := getNumber(it, attrRecordVersion); err != nil || latest == recordVersion {
+			return toMutableState(data, maps), err
+		}
+		if attempt == maxRecordVersionConflictRetries {
+			return nil, fmt.Errorf("failed to read mutable state after %v attempts, the execution is changed concurrently", attempt+1)
+		}
+	}
+}
+
+// selectExecutionData reads the execution item without its map entries
+func (db *ddb) selectExecutionData(ctx context.Context, shardID int, domainID, workflowID, runID string) (*executionData, error) {
+	it, err := db.getItem(ctx, tableWorkflowExecution, executionKey(shardID, domainID, workflowID, runID))
+	if err != nil {
+		return nil, err
+	}
+	data := &executionData{}
+	if err := getData(it, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// selectExecutionMaps reads the map entries of the generation with the given prefix
+func (db *ddb) selectExecutionMaps(ctx context.Context, shardID int, prefix string) (*executionMaps, error) {
+	items, err := db.queryAll(ctx, db.executionMapsQuery(shardID, prefix))
+	if err != nil {
+		return nil, err
+	}
+	maps := newEmptyExecutionMaps()
+	for _, it := range items {
+		kind, key, err := parseMapEntryKey(it, prefix)
+		if err != nil {
+			return nil, err
+		}
+		if err := maps.add(kind, key, it); err != nil {
+			return nil, err
+		}
+	}
+	return maps, nil
 }
 
 func (db *ddb) DeleteCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID, currentRunIDCondition string) error {
-	panic("TODO")
+	expr := newExpression().
+		name("run_id", attrRunID).
+		value("run_id", stringAttr(currentRunIDCondition))
+	_, err := db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName:                 aws.String(db.tableName(tableCurrentWorkflow)),
+		Key:                       currentWorkflowKey(shardID, domainID, workflowID),
+		ConditionExpression:       aws.String("#run_id = :run_id"),
+		ExpressionAttributeNames:  expr.names,
+		ExpressionAttributeValues: expr.values,
+	})
+	if isConditionalCheckFailed(err) {
+		// same as Cassandra, the current workflow has moved on to another run
+		return nil
+	}
+	return err
 }
 
+// DeleteWorkflowExecution deletes the execution item and then the map entries of all its generations,
+// including the ones left behind by interrupted writes
 func (db *ddb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
-	panic("TODO")
+	if err := db.deleteItem(ctx, tableWorkflowExecution, executionKey(shardID, domainID, workflowID, runID)); err != nil {
+		return err
+	}
+	_, err := db.rangeDelete(ctx, tableWorkflowExecutionMap, db.executionMapsQuery(shardID, executionMapsPrefix(domainID, workflowID, runID)), attrShardID, attrMapKey)
+	return err
 }
 
 func (db *ddb) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.CurrentWorkflowExecution, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.queryPage(ctx, db.shardQuery(tableCurrentWorkflow, shardID, "", nil), pageSize, pageToken, nil, attrShardID, attrWorkflowKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.CurrentWorkflowExecution, 0, len(items))
+	for _, it := range items {
+		row := &nosqlplugin.CurrentWorkflowRow{}
+		if err := getData(it, row); err != nil {
+			return nil, nil, err
+		}
+		executions = append(executions, &persistence.CurrentWorkflowExecution{
+			DomainID:     row.DomainID,
+			WorkflowID:   row.WorkflowID,
+			RunID:        row.RunID,
+			State:        row.State,
+			CurrentRunID: row.RunID,
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *ddb) SelectAllWorkflowExecutions(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.InternalListConcreteExecutionsEntity, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.queryPage(ctx, db.shardQuery(tableWorkflowExecution, shardID, "", nil), pageSize, pageToken, nil, attrShardID, attrExecutionKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.InternalListConcreteExecutionsEntity, 0, len(items))
+	for _, it := range items {
+		data := &executionData{}
+		if err := getData(it, data); err != nil {
+			return nil, nil, err
+		}
+		executions = append(executions, &persistence.InternalListConcreteExecutionsEntity{
+			ExecutionInfo:    data.ExecutionInfo,
+			VersionHistories: data.VersionHistories,
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *ddb) IsWorkflowExecutionExists(ctx context.Context, shardID int, domainID, workflowID, runID string) (bool, error) {
-	panic("TODO")
+	out, err := db.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:                aws.String(db.tableName(tableWorkflowExecution)),
+		Key:                      executionKey(shardID, domainID, workflowID, runID),
+		ProjectionExpression:     aws.String("#execution_key"),
+		ExpressionAttributeNames: newExpression().name("execution_key", attrExecutionKey).names,
+		ConsistentRead:           aws.Bool(true),
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(out.Item) > 0, nil
 }
 
 func (db *ddb) SelectTransferTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	return db.selectHistoryTasks(ctx, tableTransferTask, shardID, pageSize, pageToken, inclusiveMinTaskID, exclusiveMaxTaskID)
 }
 
 func (db *ddb) DeleteTransferTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableTransferTask, transferTaskKey(shardID, taskID))
 }
 
 func (db *ddb) RangeDeleteTransferTasks(ctx context.Context, shardID int, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	return db.rangeDeleteHistoryTasks(ctx, tableTransferTask, shardID, inclusiveBeginTaskID, exclusiveEndTaskID)
 }
 
 func (db *ddb) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	if !inclusiveMinTime.Before(exclusiveMaxTime) {
+		return nil, nil, nil
+	}
+	// reading timer tasks needs to be strongly consistent, otherwise we could lose tasks
+	items, nextPageToken, err := db.queryPage(ctx, db.timerTasksQuery(shardID, inclusiveMinTime, exclusiveMaxTime), pageSize, pageToken, nil, attrShardID, attrTimerKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks, err := parseHistoryTasks(items)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *ddb) DeleteTimerTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableTimerTask, timerTaskKey(shardID, visibilityTimestamp, taskID))
 }
 
 func (db *ddb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
-	panic("TODO")
+	if !inclusiveMinTime.Before(exclusiveMaxTime) {
+		return nil
+	}
+	_, err := db.rangeDelete(ctx, tableTimerTask, db.timerTasksQuery(shardID, inclusiveMinTime, exclusiveMaxTime), attrShardID, attrTimerKey)
+	return err
 }
 
 func (db *ddb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	return db.selectHistoryTasks(ctx, tableReplicationTask, shardID, pageSize, pageToken, inclusiveMinTaskID, exclusiveMaxTaskID)
 }
 
 func (db *ddb) DeleteReplicationTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableReplicationTask, transferTaskKey(shardID, taskID))
 }
 
 func (db *ddb) RangeDeleteReplicationTasks(ctx context.Context, shardID int, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	input := db.shardQuery(tableReplicationTask, shardID, "#task_id < :end", item{
+		":end": numberAttr(exclusiveEndTaskID),
+	})
+	input.ExpressionAttributeNames["#task_id"] = aws.String(attrTaskID)
+	_, err := db.rangeDelete(ctx, tableReplicationTask, input, attrShardID, attrTaskID)
+	return err
 }
 
-func (db *ddb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.HistoryMigrationTask, condition nosqlplugin.ShardCondition) error {
-	panic("TODO")
+func (db *ddb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.HistoryMigrationTask, shardCondition nosqlplugin.ShardCondition) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	shardID := shardCondition.ShardID
+	items := make([]*dynamodb.TransactWriteItem, 0, len(tasks)+1)
+	for _, task := range tasks {
+		it, err := newReplicationTaskItem(shardID, task.Replication.DomainID, task.Replication.WorkflowID, task)
+		if err != nil {
+			return err
+		}
+		items = append(items, &dynamodb.TransactWriteItem{Put: &dynamodb.Put{
+			TableName: aws.String(db.tableName(tableReplicationTask)),
+			Item:      it,
+		}})
+	}
+	items = append(items, db.shardRangeIDCondition(shardID, shardCondition.RangeID))
+
+	err := db.executeTransaction(ctx, items)
+	var cancelled *transactionCancelled
+	if !errors.As(err, &cancelled) {
+		return err
+	}
+	if cancelled.failed(len(items) - 1) {
+		rangeID, err := db.selectShardRangeID(ctx, shardID)
+		if err != nil && !db.IsNotFoundError(err) {
+			return err
+		}
+		if rangeID != shardCondition.RangeID {
+			return &nosqlplugin.ShardOperationConditionFailure{
+				RangeID: rangeID,
+			}
+		}
+	}
+	// It's much safer to return ShardOperationConditionFailure(which will become ShardOwnershipLostError later) as the default to force the application to reload
+	// shard to recover from such errors
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: -1,
+		Details: cancelled.Error(),
+	}
 }
 
+// DeleteCrossClusterTask is a no-op, cross cluster tasks are never written by this plugin
 func (db *ddb) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
-	panic("TODO")
+	return nil
 }
 
 func (db *ddb) InsertReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task *nosqlplugin.HistoryMigrationTask) error {
-	panic("TODO")
+	replication := *task.Replication
+	data, err := dataAttr(&nosqlplugin.HistoryMigrationTask{
+		Replication: &replication,
+		Task:        task.Task,
+		TaskID:      replication.TaskID,
+	})
+	if err != nil {
+		return err
+	}
+	it := replicationDLQTaskKey(shardID, sourceCluster, replication.TaskID)
+	it[attrData] = data
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(db.tableName(tableReplicationDLQTask)),
+		Item:      it,
+	})
+	return err
 }
 
 func (db *ddb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	if inclusiveMinTaskID >= exclusiveMaxTaskID {
+		return nil, nil, nil
+	}
+	items, nextPageToken, err := db.queryPage(ctx, db.replicationDLQQuery(shardID, sourceCluster, "#task_id BETWEEN :min AND :max", item{
+		":min": numberAttr(inclusiveMinTaskID),
+		":max": numberAttr(exclusiveMaxTaskID - 1),
+	}), pageSize, pageToken, nil, attrDLQKey, attrTaskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks, err := parseHistoryTasks(items)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *ddb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
-	panic("TODO")
+	return db.countItems(ctx, db.replicationDLQQuery(shardID, sourceCluster, "", nil))
 }
 
 func (db *ddb) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableReplicationDLQTask, replicationDLQTaskKey(shardID, sourceCluster, taskID))
 }
 
 func (db *ddb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	if inclusiveBeginTaskID >= exclusiveEndTaskID {
+		return nil
+	}
+	_, err := db.rangeDelete(ctx, tableReplicationDLQTask, db.replicationDLQQuery(shardID, sourceCluster, "#task_id BETWEEN :min AND :max", item{
+		":min": numberAttr(inclusiveBeginTaskID),
+		":max": numberAttr(exclusiveEndTaskID - 1),
+	}), attrDLQKey, attrTaskID)
+	return err
 }
 
 func (db *ddb) SelectActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, wfID, rID string) (*nosqlplugin.ActiveClusterSelectionPolicyRow, error) {
-	panic("TODO")
+	it, err := db.getItem(ctx, tableActiveClusterSelectionPolicy, executionKey(shardID, domainID, wfID, rID))
+	if db.IsNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	policy := &persistence.DataBlob{}
+	if err := getData(it, policy); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.ActiveClusterSelectionPolicyRow{
+		ShardID:    shardID,
+		DomainID:   domainID,
+		WorkflowID: wfID,
+		RunID:      rID,
+		Policy:     policy,
+	}, nil
 }
 
 func (db *ddb) DeleteActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, wfID, rID string) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableActiveClusterSelectionPolicy, executionKey(shardID, domainID, wfID, rID))
+}
+
+// selectHistoryTasks reads the tasks keyed by task ID, i.e. transfer and replication tasks
+func (db *ddb) selectHistoryTasks(ctx context.Context, table string, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
+	if inclusiveMinTaskID >= exclusiveMaxTaskID {
+		return nil, nil, nil
+	}
+	// reading history tasks needs to be strongly consistent, otherwise we could lose tasks
+	items, nextPageToken, err := db.queryPage(ctx, db.taskIDRangeQuery(table, shardID, inclusiveMinTaskID, exclusiveMaxTaskID), pageSize, pageToken, nil, attrShardID, attrTaskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks, err := parseHistoryTasks(items)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, nextPageToken, nil
+}
+
+func (db *ddb) rangeDeleteHistoryTasks(ctx context.Context, table string, shardID int, inclusiveMinTaskID, exclusiveMaxTaskID int64) error {
+	if inclusiveMinTaskID >= exclusiveMaxTaskID {
+		return nil
+	}
+	_, err := db.rangeDelete(ctx, table, db.taskIDRangeQuery(table, shardID, inclusiveMinTaskID, exclusiveMaxTaskID), attrShardID, attrTaskID)
+	return err
+}
+
+func (db *ddb) taskIDRangeQuery(table string, shardID int, inclusiveMinTaskID, exclusiveMaxTaskID int64) *dynamodb.QueryInput {
+	input := db.shardQuery(table, shardID, "#task_id BETWEEN :min AND :max", item{
+		":min": numberAttr(inclusiveMinTaskID),
+		":max": numberAttr(exclusiveMaxTaskID - 1),
+	})
+	input.ExpressionAttributeNames["#task_id"] = aws.String(attrTaskID)
+	return input
+}
+
+func (db *ddb) timerTasksQuery(shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) *dynamodb.QueryInput {
+	input := db.shardQuery(tableTimerTask, shardID, "#timer_key BETWEEN :min AND :max", item{
+		":min": stringAttr(sortableInt(persistence.UnixNanoToDBTimestamp(inclusiveMinTime.UnixNano()))),
+		// every timer key of the exclusive max timestamp sorts after the "#" separator
+		":max": stringAttr(sortableInt(persistence.UnixNanoToDBTimestamp(exclusiveMaxTime.UnixNano())) + "#"),
+	})
+	input.ExpressionAttributeNames["#timer_key"] = aws.String(attrTimerKey)
+	return input
+}
+
+// shardQuery builds a query over the items of a shard, rangeCondition is optional
+func (db *ddb) shardQuery(table string, shardID int, rangeCondition string, values item) *dynamodb.QueryInput {
+	expr := newExpression().
+		name("shard_id", attrShardID).
+		value("shard_id", numberAttr(int64(shardID)))
+	for k, v := range values {
+		expr.values[k] = v
+	}
+	keyCondition := "#shard_id = :shard_id"
+	if rangeCondition != "" {
+		keyCondition += " AND " + rangeCondition
+	}
+	return &dynamodb.QueryInput{
+		TableName:                 aws.String(db.tableName(table)),
+		KeyConditionExpression:    aws.String(keyCondition),
+		ExpressionAttributeNames:  expr.names,
+		ExpressionAttributeValues: expr.values,
+		ConsistentRead:            aws.Bool(true),
+	}
+}
+
+func (db *ddb) executionMapsQuery(shardID int, prefix string) *dynamodb.QueryInput {
+	input := db.shardQuery(tableWorkflowExecutionMap, shardID, "begins_with(#map_key, :prefix)", item{
+		":prefix": stringAttr(prefix),
+	})
+	input.ExpressionAttributeNames["#map_key"] = aws.String(attrMapKey)
+	return input
+}
+
+func (db *ddb) replicationDLQQuery(shardID int, sourceCluster string, rangeCondition string, values item) *dynamodb.QueryInput {
+	expr := newExpression().
+		name("dlq_key", attrDLQKey).
+		value("dlq_key", stringAttr(replicationDLQKeyValue(shardID, sourceCluster)))
+	for k, v := range values {
+		expr.values[k] = v
+	}
+	keyCondition := "#dlq_key = :dlq_key"
+	if rangeCondition != "" {
+		expr.name("task_id", attrTaskID)
+		keyCondition += " AND " + rangeCondition
+	}
+	return &dynamodb.QueryInput{
+		TableName:                 aws.String(db.tableName(tableReplicationDLQTask)),
+		KeyConditionExpression:    aws.String(keyCondition),
+		ExpressionAttributeNames:  expr.names,
+		ExpressionAttributeValues: expr.values,
+		ConsistentRead:            aws.Bool(true),
+	}
 }
@@ -0,0 +1,495 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+// transactionWriteTable returns the table an item of a transaction writes to
+func transactionWriteTable(it *dynamodb.TransactWriteItem) string {
+	switch {
+	case it.Put != nil:
+		return aws.StringValue(it.Put.TableName)
+	case it.Delete != nil:
+		return aws.StringValue(it.Delete.TableName)
+	case it.ConditionCheck != nil:
+		return aws.StringValue(it.ConditionCheck.TableName)
+	}
+	return ""
+}
+
+// cancelOn returns a TransactWriteItems func that fails the conditions of the items writing to the given tables
+func cancelOn(tables ...string) func(*dynamodb.TransactWriteItemsInput) (*dynamodb.TransactWriteItemsOutput, error) {
+	return func(input *dynamodb.TransactWriteItemsInput) (*dynamodb.TransactWriteItemsOutput, error) {
+		var reasons []*dynamodb.CancellationReason
+		cancelled := false
+		for _, it := range input.TransactItems {
+			code := "None"
+			for _, table := range tables {
+				if transactionWriteTable(it) == "test_"+table {
+					code = conditionCheckFailedCode
+					cancelled = true
+				}
+			}
+			reasons = append(reasons, &dynamodb.CancellationReason{Code: aws.String(code)})
+		}
+		if !cancelled {
+			return &dynamodb.TransactWriteItemsOutput{}, nil
+		}
+		return nil, &dynamodb.TransactionCanceledException{CancellationReasons: reasons}
+	}
+}
+
+// storedItems returns a GetItem func serving one item per table
+func storedItems(items map[string]item) func(*dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+	return func(input *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+		return &dynamodb.GetItemOutput{Item: items[strings.TrimPrefix(aws.StringValue(input.TableName), "test_")]}, nil
+	}
+}
+
+func testWorkflowExecutionRequest() *nosqlplugin.WorkflowExecutionRequest {
+	return &nosqlplugin.WorkflowExecutionRequest{
+		InternalWorkflowExecutionInfo: persistence.InternalWorkflowExecutionInfo{
+			DomainID:        "domain",
+			WorkflowID:      "workflow",
+			RunID:           "run",
+			CreateRequestID: "create-request",
+			NextEventID:     3,
+		},
+		LastWriteVersion: 10,
+		MapsWriteMode:    nosqlplugin.WorkflowExecutionMapsWriteModeCreate,
+	}
+}
+
+func TestInsertWorkflowExecutionWithTasks(t *testing.T) {
+	storedCurrent, err := dataAttr(&nosqlplugin.CurrentWorkflowRow{RunID: "other-run", CreateRequestID: "other-request", State: 1, LastWriteVersion: 7})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		writeMode     nosqlplugin.CurrentWorkflowWriteMode
+		transactWrite func(*dynamodb.TransactWriteItemsInput) (*dynamodb.TransactWriteItemsOutput, error)
+		stored        map[string]item
+		wantErr       error
+	}{
+		{
+			name:          "success",
+			writeMode:     nosqlplugin.CurrentWorkflowWriteModeInsert,
+			transactWrite: cancelOn(),
+		},
+		{
+			name:          "shard ownership lost",
+			writeMode:     nosqlplugin.CurrentWorkflowWriteModeInsert,
+			transactWrite: cancelOn(tableShard),
+			stored:        map[string]item{tableShard: {attrRangeID: numberAttr(6)}},
+			wantErr:       &nosqlplugin.WorkflowOperationConditionFailure{ShardRangeIDNotMatch: common.Int64Ptr(6)},
+		},
+		{
+			name:          "duplicate request",
+			writeMode:     nosqlplugin.CurrentWorkflowWriteModeInsert,
+			transactWrite: cancelOn(tableWorkflowRequest, tableCurrentWorkflow),
+			stored:        map[string]item{tableWorkflowRequest: {attrRunID: stringAttr("other-run")}},
+			wantErr: &nosqlplugin.WorkflowOperationConditionFailure{DuplicateRequest: &nosqlplugin.DuplicateRequest{
+				RequestType: persistence.WorkflowRequestTypeStart,
+				RunID:       "other-run",
+			}},
+		},
+		{
+			name:          "current workflow exists",
+			writeMode:     nosqlplugin.CurrentWorkflowWriteModeInsert,
+			transactWrite: cancelOn(tableCurrentWorkflow),
+			stored:        map[string]item{tableCurrentWorkflow: {attrData: storedCurrent}},
+			wantErr: &nosqlplugin.WorkflowOperationConditionFailure{WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+				OtherInfo:        "Workflow execution already running. WorkflowId: workflow, RunId: other-run",
+				CreateRequestID:  "other-request",
+				RunID:            "other-run",
+				State:            1,
+				LastWriteVersion: 7,
+			}},
+		},
+		{
+			name:          "current workflow run ID mismatch",
+			writeMode:     nosqlplugin.CurrentWorkflowWriteModeUpdate,
+			transactWrite: cancelOn(tableCurrentWorkflow),
+			stored:        map[string]item{tableCurrentWorkflow: {attrData: storedCurrent}},
+			wantErr: &nosqlplugin.WorkflowOperationConditionFailure{CurrentWorkflowConditionFailInfo: common.StringPtr(
+				"Workflow execution creation condition failed by mismatch runID. WorkflowId: workflow, Expected Current RunID: previous-run, Actual Current RunID: other-run",
+			)},
+		},
+		{
+			name:          "execution exists",
+			writeMode:     nosqlplugin.CurrentWorkflowWriteModeInsert,
+			transactWrite: cancelOn(tableWorkflowExecution),
+			stored:        map[string]item{tableWorkflowExecution: {attrLastWriteVersion: numberAttr(12)}},
+			wantErr: &nosqlplugin.WorkflowOperationConditionFailure{WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+				OtherInfo:        "Workflow execution already running. WorkflowId: workflow, RunId: run",
+				CreateRequestID:  "create-request",
+				RunID:            "run",
+				LastWriteVersion: 12,
+			}},
+		},
+		{
+			name:      "other error",
+			writeMode: nosqlplugin.CurrentWorkflowWriteModeInsert,
+			transactWrite: func(*dynamodb.TransactWriteItemsInput) (*dynamodb.TransactWriteItemsOutput, error) {
+				return nil, errors.New("boom")
+			},
+			wantErr: errors.New("boom"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var written []string
+			db := newTestDB(t, &fakeClient{
+				getItem: storedItems(tc.stored),
+				transactWrite: func(input *dynamodb.TransactWriteItemsInput) (*dynamodb.TransactWriteItemsOutput, error) {
+					for _, it := range input.TransactItems {
+						written = append(written, transactionWriteTable(it))
+					}
+					return tc.transactWrite(input)
+				},
+			})
+			currentWorkflowRequest := &nosqlplugin.CurrentWorkflowWriteRequest{
+				WriteMode: tc.writeMode,
+				Row:       nosqlplugin.CurrentWorkflowRow{RunID: "run", WorkflowID: "workflow"},
+			}
+			if tc.writeMode == nosqlplugin.CurrentWorkflowWriteModeUpdate {
+				currentWorkflowRequest.Condition = &nosqlplugin.CurrentWorkflowWriteCondition{CurrentRunID: common.StringPtr("previous-run")}
+			}
+
+			err := db.InsertWorkflowExecutionWithTasks(
+				context.Background(),
+				&nosqlplugin.WorkflowRequestsWriteRequest{
+					Rows: []*nosqlplugin.WorkflowRequestRow{{
+						ShardID:     1,
+						DomainID:    "domain",
+						WorkflowID:  "workflow",
+						RequestType: persistence.WorkflowRequestTypeStart,
+						RequestID:   "create-request",
+						RunID:       "run",
+					}},
+					WriteMode: nosqlplugin.WorkflowRequestWriteModeInsert,
+				},
+				currentWorkflowRequest,
+				testWorkflowExecutionRequest(),
+				map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask{
+					persistence.HistoryTaskCategoryTransfer: {{Transfer: &nosqlplugin.TransferTask{TaskID: 1}}},
+					persistence.HistoryTaskCategoryTimer:    {{Timer: &nosqlplugin.TimerTask{TaskID: 2}}},
+				},
+				nil,
+				&nosqlplugin.ShardCondition{ShardID: 1, RangeID: 5},
+			)
+			assert.Equal(t, tc.wantErr, err)
+			assert.ElementsMatch(t, []string{
+				"test_workflow_request",
+				"test_current_workflow",
+				"test_workflow_execution",
+				"test_transfer_task",
+				"test_timer_task",
+				"test_shard",
+			}, written)
+		})
+	}
+}
+
+func TestUpdateWorkflowExecutionWithTasks(t *testing.T) {
+	stored := func(nextEventID, recordVersion int64) item {
+		data, err := dataAttr(&executionData{})
+		require.NoError(t, err)
+		return item{
+			attrNextEventID:   numberAttr(nextEventID),
+			attrRecordVersion: numberAttr(recordVersion),
+			attrData:          data,
+		}
+	}
+	mutated := func() *nosqlplugin.WorkflowExecutionRequest {
+		execution := testWorkflowExecutionRequest()
+		execution.MapsWriteMode = nosqlplugin.WorkflowExecutionMapsWriteModeUpdate
+		execution.PreviousNextEventIDCondition = common.Int64Ptr(2)
+		return execution
+	}
+	currentWorkflowRequest := &nosqlplugin.CurrentWorkflowWriteRequest{WriteMode: nosqlplugin.CurrentWorkflowWriteModeNoop}
+	shardCondition := &nosqlplugin.ShardCondition{ShardID: 1, RangeID: 5}
+
+	t.Run("retried on a concurrent change of the record", func(t *testing.T) {
+		reads := 0
+		var versions []string
+		db := newTestDB(t, &fakeClient{
+			getItem: func(*dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+				reads++
+				return &dynamodb.GetItemOutput{Item: stored(2, int64(reads))}, nil
+			},
+			transactWrite: func(input *dynamodb.TransactWriteItemsInput) (*dynamodb.TransactWriteItemsOutput, error) {
+				put := input.TransactItems[0].Put
+				versions = append(versions, aws.StringValue(put.ExpressionAttributeValues[":db_record_version"].N))
+				if len(versions) == 1 {
+					return cancelOn(tableWorkflowExecution)(input)
+				}
+				return &dynamodb.TransactWriteItemsOutput{}, nil
+			},
+		})
+		err := db.UpdateWorkflowExecutionWithTasks(context.Background(), nil, currentWorkflowRequest, mutated(), nil, nil, nil, nil, shardCondition)
+		assert.NoError(t, err)
+		// first read, read on failure, read of the retry
+		assert.Equal(t, 3, reads)
+		assert.Equal(t, []string{"1", "3"}, versions)
+	})
+
+	t.Run("next event ID mismatch", func(t *testing.T) {
+		db := newTestDB(t, &fakeClient{
+			getItem: func(*dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+				return &dynamodb.GetItemOutput{Item: stored(4, 1)}, nil
+			},
+			transactWrite: cancelOn(tableWorkflowExecution),
+		})
+		err := db.UpdateWorkflowExecutionWithTasks(context.Background(), nil, currentWorkflowRequest, mutated(), nil, nil, nil, nil, shardCondition)
+		assert.Equal(t, &nosqlplugin.WorkflowOperationConditionFailure{UnknownConditionFailureDetails: common.StringPtr(
+			"Failed to update mutable state. previousNextEventIDCondition: 2, actualNextEventID: 4, Request Current RunID: run",
+		)}, err)
+	})
+
+	t.Run("maps rewritten as a new generation", func(t *testing.T) {
+		data, err := dataAttr(&executionData{MapsGeneration: 7, BufferedEventBatches: 1})
+		require.NoError(t, err)
+		oldPrefix := mapGenerationPrefix("domain", "workflow", "run", 7)
+		timer, err := newMapEntryItem(1, oldPrefix, mapWrite{kind: mapKindTimer, key: "t1", value: &persistence.TimerInfo{TimerID: "t1"}})
+		require.NoError(t, err)
+		buffered, err := newMapEntryItem(1, oldPrefix, mapWrite{kind: mapKindBufferedEvents, key: sortableInt(0), value: &persistence.DataBlob{Data: []byte("b")}})
+		require.NoError(t, err)
+
+		var prewritten, deleted []item
+		var transaction []*dynamodb.TransactWriteItem
+		db := newTestDB(t, &fakeClient{
+			getItem: func(*dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+				return &dynamodb.GetItemOutput{Item: item{
+					attrNextEventID:   numberAttr(2),
+					attrRecordVersion: numberAttr(1),
+					attrData:          data,
+				}}, nil
+			},
+			query: func(input *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
+				assert.Equal(t, oldPrefix, aws.StringValue(input.ExpressionAttributeValues[":prefix"].S))
+				return &dynamodb.QueryOutput{Items: []item{buffered, timer}}, nil
+			},
+			batchWrite: func(input *dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error) {
+				for _, r := range input.RequestItems["test_workflow_execution_map"] {
+					if r.PutRequest != nil {
+						prewritten = append(prewritten, r.PutRequest.Item)
+					} else {
+						deleted = append(deleted, r.DeleteRequest.Key)
+					}
+				}
+				return &dynamodb.BatchWriteItemOutput{}, nil
+			},
+			transactWrite: func(input *dynamodb.TransactWriteItemsInput) (*dynamodb.TransactWriteItemsOutput, error) {
+				transaction = input.TransactItems
+				return &dynamodb.TransactWriteItemsOutput{}, nil
+			},
+		})
+		execution := mutated()
+		execution.ActivityInfos = make(map[int64]*persistence.InternalActivityInfo)
+		for i := int64(0); i < maxInlineMapWrites; i++ {
+			execution.ActivityInfos[i] = &persistence.InternalActivityInfo{ScheduleID: i}
+		}
+		execution.EventBufferWriteMode = nosqlplugin.EventBufferWriteModeAppend
+		execution.NewBufferedEventBatch = &persistence.DataBlob{Data: []byte("b2")}
+
+		err = db.UpdateWorkflowExecutionWithTasks(context.Background(), nil, currentWorkflowRequest, execution, nil, nil, nil, nil, shardCondition)
+		require.NoError(t, err)
+		// only the execution item and the shard condition are part of the transaction
+		require.Len(t, transaction, 2)
+		written := &executionData{}
+		require.NoError(t, getData(transaction[0].Put.Item, written))
+		assert.NotEqual(t, int64(7), written.MapsGeneration)
+		assert.Equal(t, int64(2), written.BufferedEventBatches)
+
+		newPrefix := mapGenerationPrefix("domain", "workflow", "run", written.MapsGeneration)
+		maps := newEmptyExecutionMaps()
+		for _, it := range prewritten {
+			kind, key, err := parseMapEntryKey(it, newPrefix)
+			require.NoError(t, err)
+			require.NoError(t, maps.add(kind, key, it))
+		}
+		assert.Len(t, maps.ActivityInfos, maxInlineMapWrites)
+		assert.Equal(t, map[string]*persistence.TimerInfo{"t1": {TimerID: "t1"}}, maps.TimerInfos)
+		assert.Equal(t, []*persistence.DataBlob{{Data: []byte("b")}, {Data: []byte("b2")}}, maps.BufferedEvents)
+		// the replaced generation is deleted after the commit
+		assert.ElementsMatch(t, []item{
+			keyOf(timer, attrShardID, attrMapKey),
+			keyOf(buffered, attrShardID, attrMapKey),
+		}, deleted)
+	})
+
+	t.Run("missing executions", func(t *testing.T) {
+		db := newTestDB(t, &fakeClient{})
+		err := db.UpdateWorkflowExecutionWithTasks(context.Background(), nil, currentWorkflowRequest, nil, nil, nil, nil, nil, shardCondition)
+		assert.Error(t, err)
+	})
+}
+
+func TestExecuteWorkflowTransaction(t *testing.T) {
+	newTransaction := func(db *ddb, transfers int) *workflowTransaction {
+		tx := &workflowTransaction{}
+		db.addShardCondition(tx, &nosqlplugin.ShardCondition{ShardID: 1, RangeID: 5})
+		tasks := map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask{
+			persistence.HistoryTaskCategoryTimer: {{Timer: &nosqlplugin.TimerTask{TaskID: 1}}},
+		}
+		for i := 0; i < transfers; i++ {
+			tasks[persistence.HistoryTaskCategoryTransfer] = append(tasks[persistence.HistoryTaskCategoryTransfer],
+				&nosqlplugin.HistoryMigrationTask{Transfer: &nosqlplugin.TransferTask{TaskID: int64(i + 2)}})
+		}
+		require.NoError(t, db.addTasks(tx, 1, "domain", "workflow", tasks))
+		return tx
+	}
+
+	t.Run("tasks that don't fit are written ahead", func(t *testing.T) {
+		var calls []string
+		var transaction []string
+		db := newTestDB(t, &fakeClient{
+			batchWrite: func(input *dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error) {
+				calls = append(calls, "batchWrite")
+				requests := input.RequestItems["test_transfer_task"]
+				assert.LessOrEqual(t, len(requests), maxBatchWriteItems)
+				for _, r := range requests {
+					assert.NotNil(t, r.PutRequest)
+				}
+				return &dynamodb.BatchWriteItemOutput{}, nil
+			},
+			transactWrite: func(input *dynamodb.TransactWriteItemsInput) (*dynamodb.TransactWriteItemsOutput, error) {
+				calls = append(calls, "transactWrite")
+				for _, it := range input.TransactItems {
+					transaction = append(transaction, transactionWriteTable(it))
+				}
+				return &dynamodb.TransactWriteItemsOutput{}, nil
+			},
+		})
+		require.NoError(t, db.executeWorkflowTransaction(context.Background(), newTransaction(db, 150)))
+		// the 52 transfer tasks that don't fit are written in three batches ahead of the transaction
+		assert.Equal(t, []string{"batchWrite", "batchWrite", "batchWrite", "transactWrite"}, calls)
+		require.Len(t, transaction, maxTransactionItems)
+		assert.Equal(t, []string{"test_shard", "test_timer_task"}, transaction[:2])
+	})
+
+	t.Run("items written ahead are deleted when the transaction is cancelled", func(t *testing.T) {
+		var deleted []item
+		db := newTestDB(t, &fakeClient{
+			batchWrite: func(input *dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error) {
+				for _, r := range input.RequestItems["test_transfer_task"] {
+					if r.DeleteRequest != nil {
+						deleted = append(deleted, r.DeleteRequest.Key)
+					}
+				}
+				return &dynamodb.BatchWriteItemOutput{}, nil
+			},
+			transactWrite: cancelOn(tableShard),
+		})
+		err := db.executeWorkflowTransaction(context.Background(), newTransaction(db, 100))
+		var cancelled *transactionCancelled
+		assert.ErrorAs(t, err, &cancelled)
+		assert.Equal(t, []item{transferTaskKey(1, 100), transferTaskKey(1, 101)}, deleted)
+	})
+
+	t.Run("items written ahead are kept on other errors", func(t *testing.T) {
+		db := newTestDB(t, &fakeClient{
+			batchWrite: func(input *dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error) {
+				for _, r := range input.RequestItems["test_transfer_task"] {
+					assert.Nil(t, r.DeleteRequest)
+				}
+				return &dynamodb.BatchWriteItemOutput{}, nil
+			},
+			transactWrite: func(*dynamodb.TransactWriteItemsInput) (*dynamodb.TransactWriteItemsOutput, error) {
+				return nil, errors.New("timeout")
+			},
+		})
+		assert.EqualError(t, db.executeWorkflowTransaction(context.Background(), newTransaction(db, 100)), "timeout")
+	})
+}
+
+func TestSelectWorkflowExecution(t *testing.T) {
+	stored := func(recordVersion int64) item {
+		data, err := dataAttr(&executionData{
+			ExecutionInfo:  &persistence.InternalWorkflowExecutionInfo{RunID: "run"},
+			MapsGeneration: 3,
+		})
+		require.NoError(t, err)
+		return item{attrRecordVersion: numberAttr(recordVersion), attrData: data}
+	}
+	prefix := mapGenerationPrefix("domain", "workflow", "run", 3)
+	activity, err := newMapEntryItem(1, prefix, mapWrite{kind: mapKindActivity, key: "5", value: &persistence.InternalActivityInfo{ScheduleID: 5}})
+	require.NoError(t, err)
+
+	reads := 0
+	db := newTestDB(t, &fakeClient{
+		getItem: func(*dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+			reads++
+			// a concurrent write between the first two reads
+			if reads == 1 {
+				return &dynamodb.GetItemOutput{Item: stored(1)}, nil
+			}
+			return &dynamodb.GetItemOutput{Item: stored(2)}, nil
+		},
+		query: func(input *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
+			assert.Equal(t, prefix, aws.StringValue(input.ExpressionAttributeValues[":prefix"].S))
+			return &dynamodb.QueryOutput{Items: []item{activity}}, nil
+		},
+	})
+	state, err := db.SelectWorkflowExecution(context.Background(), 1, "domain", "workflow", "run")
+	require.NoError(t, err)
+	assert.Equal(t, 4, reads)
+	assert.Equal(t, "run", state.ExecutionInfo.RunID)
+	assert.Equal(t, map[int64]*persistence.InternalActivityInfo{5: {ScheduleID: 5}}, state.ActivityInfos)
+	assert.Equal(t, []*persistence.DataBlob{}, state.BufferedEvents)
+}
+
+func TestDeleteWorkflowExecution(t *testing.T) {
+	var calls []string
+	db := newTestDB(t, &fakeClient{
+		deleteItem: func(input *dynamodb.DeleteItemInput) (*dynamodb.DeleteItemOutput, error) {
+			calls = append(calls, "deleteItem "+aws.StringValue(input.TableName))
+			return &dynamodb.DeleteItemOutput{}, nil
+		},
+		query: func(input *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
+			calls = append(calls, "query "+aws.StringValue(input.TableName))
+			// every generation of the execution
+			assert.Equal(t, compositeKey("domain", "workflow", "run"), aws.StringValue(input.ExpressionAttributeValues[":prefix"].S))
+			return &dynamodb.QueryOutput{Items: []item{mapEntryKey(1, mapGenerationPrefix("domain", "workflow", "run", 3), mapKindTimer, "t1")}}, nil
+		},
+		batchWrite: func(input *dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error) {
+			calls = append(calls, "batchWrite")
+			return &dynamodb.BatchWriteItemOutput{}, nil
+		},
+	})
+	require.NoError(t, db.DeleteWorkflowExecution(context.Background(), 1, "domain", "workflow", "run"))
+	assert.Equal(t, []string{"deleteItem test_workflow_execution", "query test_workflow_execution_map", "batchWrite"}, calls)
+}
@@ -0,0 +1,1169 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+const (
+	tableCurrentWorkflow              = "current_workflow"
+	tableWorkflowExecution            = "workflow_execution"
+	tableWorkflowExecutionMap         = "workflow_execution_map"
+	tableTransferTask                 = "transfer_task"
+	tableTimerTask                    = "timer_task"
+	tableReplicationTask              = "replication_task"
+	tableReplicationDLQTask           = "replication_dlq_task"
+	tableWorkflowRequest              = "workflow_request"
+	tableActiveClusterSelectionPolicy = "active_cluster_selection_policy"
+
+	// attrWorkflowKey is compositeKey(domainID, workflowID)
+	attrWorkflowKey = "workflow_key"
+	// attrExecutionKey is compositeKey(domainID, workflowID, runID)
+	attrExecutionKey = "execution_key"
+	// attrTimerKey is sortableInt(visibility timestamp in milliseconds)#sortableInt(taskID)
+	attrTimerKey = "timer_key"
+	// attrDLQKey is compositeKey(shardID, sourceCluster)
+	attrDLQKey = "dlq_key"
+	// attrRequestKey is compositeKey(domainID, workflowID, requestType, requestID)
+	attrRequestKey = "request_key"
+	// attrMapKey is compositeKey(domainID, workflowID, runID, generation, kind, key)
+	attrMapKey = "map_key"
+
+	attrState            = "state"
+	attrNextEventID      = "next_event_id"
+	attrLastWriteVersion = "last_write_version"
+	// attrRecordVersion is bumped on every write of a workflow_execution item,
+	// it guards the read-modify-write of the generation and the buffered event count of the execution
+	attrRecordVersion = "db_record_version"
+
+	workflowRequestTTLInSeconds = 10800
+
+	// maxRecordVersionConflictRetries bounds how many times an update is retried when the execution item
+	// was changed concurrently by a write that didn't move next_event_id
+	maxRecordVersionConflictRetries = 3
+
+	// maxInlineMapWrites bounds the map entry writes of an execution that are part of the transaction, an execution
+	// with more changes gets a new generation of entries written ahead of the transaction instead. The mutated,
+	// inserted and reset executions of an update fit in a transaction along with its other conditional items.
+	maxInlineMapWrites = 25
+)
+
+// kinds of the entries of the workflow_execution_map table
+const (
+	mapKindActivity        = "activity"
+	mapKindTimer           = "timer"
+	mapKindChildExecution  = "child_execution"
+	mapKindRequestCancel   = "request_cancel"
+	mapKindSignal          = "signal"
+	mapKindSignalRequested = "signal_requested"
+	mapKindBufferedEvents  = "buffered_events"
+)
+
+type (
+	// executionData is the JSON encoded data of a workflow_execution item. The maps and the buffered events are
+	// items of the workflow_execution_map table, one per entry, so that the mutable state isn't bound to the size
+	// of a single item. The entries are keyed by a generation that changes whenever they are rewritten as a whole.
+	executionData struct {
+		ExecutionInfo    *persistence.InternalWorkflowExecutionInfo
+		VersionHistories *persistence.DataBlob
+		Checksum         checksum.Checksum
+		MapsGeneration   int64
+		// BufferedEventBatches is the number of buffered event entries, they are keyed by their position
+		BufferedEventBatches int64
+	}
+
+	// executionMaps are the maps and the buffered events of an execution
+	executionMaps struct {
+		ActivityInfos       map[int64]*persistence.InternalActivityInfo
+		TimerInfos          map[string]*persistence.TimerInfo
+		ChildExecutionInfos map[int64]*persistence.InternalChildExecutionInfo
+		RequestCancelInfos  map[int64]*persistence.RequestCancelInfo
+		SignalInfos         map[int64]*persistence.SignalInfo
+		SignalRequestedIDs  map[string]struct{}
+		BufferedEvents      []*persistence.DataBlob
+	}
+
+	// mapWrite is an upsert or a deletion of a map entry
+	mapWrite struct {
+		kind   string
+		key    string
+		value  interface{}
+		delete bool
+	}
+
+	// putWrite is an unconditional put, it's written ahead of the transaction when it doesn't fit in it
+	putWrite struct {
+		table    string
+		item     item
+		keyNames []string
+	}
+
+	// mapGeneration identifies the map entries of one generation of an execution
+	mapGeneration struct {
+		shardID int
+		prefix  string
+	}
+
+	// writeKind tells what an item of a workflow transaction is about, it's used to explain failed conditions
+	writeKind int
+
+	workflowWrite struct {
+		kind      writeKind
+		request   *nosqlplugin.WorkflowRequestRow
+		execution *nosqlplugin.WorkflowExecutionRequest
+	}
+
+	// workflowTransaction collects the items of a workflow create/update
+	workflowTransaction struct {
+		items  []*dynamodb.TransactWriteItem
+		writes []workflowWrite
+		// tasks are added to the transaction as long as it has room, the rest is written ahead of it
+		tasks []putWrite
+		// prewrites are the map entries of new generations, they are written ahead of the transaction
+		prewrites []putWrite
+		// obsoleteMaps are the generations replaced by the transaction, they are deleted once it commits
+		obsoleteMaps []mapGeneration
+	}
+)
+
+const (
+	writeKindOther writeKind = iota
+	writeKindShard
+	writeKindRequest
+	writeKindCurrentWorkflow
+	writeKindExecution
+)
+
+func (t *workflowTransaction) add(w workflowWrite, it *dynamodb.TransactWriteItem) {
+	t.items = append(t.items, it)
+	t.writes = append(t.writes, w)
+}
+
+// failedWrites returns the writes whose condition failed, in the order they were added
+func (t *workflowTransaction) failedWrites(cancelled *transactionCancelled) []workflowWrite {
+	var failed []workflowWrite
+	for i, w := range t.writes {
+		if cancelled.failed(i) {
+			failed = append(failed, w)
+		}
+	}
+	return failed
+}
+
+func (db *ddb) addWorkflowRequests(t *workflowTransaction, requests *nosqlplugin.WorkflowRequestsWriteRequest, now time.Time) error {
+	if requests == nil {
+		return nil
+	}
+	for _, row := range requests.Rows {
+		put := &dynamodb.Put{
+			TableName: aws.String(db.tableName(tableWorkflowRequest)),
+			Item: item{
+				attrShardID:    numberAttr(int64(row.ShardID)),
+				attrRequestKey: stringAttr(workflowRequestKeyValue(row.DomainID, row.WorkflowID, row.RequestType, row.RequestID)),
+				attrRunID:      stringAttr(row.RunID),
+				attrVersion:    numberAttr(row.Version),
+				attrTTL:        ttlAttr(now, workflowRequestTTLInSeconds),
+			},
+		}
+		switch requests.WriteMode {
+		case nosqlplugin.WorkflowRequestWriteModeInsert:
+			put.ConditionExpression = aws.String("attribute_not_exists(#request_key)")
+			put.ExpressionAttributeNames = newExpression().name("request_key", attrRequestKey).names
+		case nosqlplugin.WorkflowRequestWriteModeUpsert:
+		default:
+			return fmt.Errorf("unknown workflow request write mode %v", requests.WriteMode)
+		}
+		t.add(workflowWrite{kind: writeKindRequest, request: row}, &dynamodb.TransactWriteItem{Put: put})
+	}
+	return nil
+}
+
+func (db *ddb) addCurrentWorkflow(
+	t *workflowTransaction,
+	shardID int,
+	domainID string,
+	workflowID string,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+) error {
+	row := request.Row
+	row.ShardID = shardID
+	row.DomainID = domainID
+	row.WorkflowID = workflowID
+	data, err := dataAttr(&row)
+	if err != nil {
+		return err
+	}
+	put := &dynamodb.Put{
+		TableName: aws.String(db.tableName(tableCurrentWorkflow)),
+		Item: item{
+			attrShardID:          numberAttr(int64(shardID)),
+			attrWorkflowKey:      stringAttr(compositeKey(domainID, workflowID)),
+			attrRunID:            stringAttr(row.RunID),
+			attrLastWriteVersion: numberAttr(row.LastWriteVersion),
+			attrState:            numberAttr(int64(row.State)),
+			attrData:             data,
+		},
+	}
+
+	switch request.WriteMode {
+	case nosqlplugin.CurrentWorkflowWriteModeNoop:
+		return nil
+	case nosqlplugin.CurrentWorkflowWriteModeInsert:
+		put.ConditionExpression = aws.String("attribute_not_exists(#workflow_key)")
+		put.ExpressionAttributeNames = newExpression().name("workflow_key", attrWorkflowKey).names
+	case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+		if request.Condition == nil || request.Condition.GetCurrentRunID() == "" {
+			return fmt.Errorf("CurrentWorkflowWriteModeUpdate require Condition.CurrentRunID")
+		}
+		expr := newExpression().
+			name("run_id", attrRunID).
+			value("run_id", stringAttr(*request.Condition.CurrentRunID))
+		condition := "#run_id = :run_id"
+		if request.Condition.LastWriteVersion != nil && request.Condition.State != nil {
+			expr.name("last_write_version", attrLastWriteVersion).
+				name("state", attrState).
+				value("last_write_version", numberAttr(*request.Condition.LastWriteVersion)).
+				value("state", numberAttr(int64(*request.Condition.State)))
+			condition += " AND #last_write_version = :last_write_version AND #state = :state"
+		}
+		put.ConditionExpression = aws.String(condition)
+		put.ExpressionAttributeNames = expr.names
+		put.ExpressionAttributeValues = expr.values
+	default:
+		return fmt.Errorf("unknown mode %v", request.WriteMode)
+	}
+	t.add(workflowWrite{kind: writeKindCurrentWorkflow}, &dynamodb.TransactWriteItem{Put: put})
+	return nil
+}
+
+// addCreatedExecution writes a brand new execution, used for both the created and the inserted executions
+func (db *ddb) addCreatedExecution(t *workflowTransaction, shardID int, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeNone {
+		return fmt.Errorf("should only support EventBufferWriteModeNone")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeCreate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeCreate")
+	}
+	data := newExecutionData(execution, time.Now().UnixNano())
+	it, err := newExecutionItem(shardID, execution, data, 0)
+	if err != nil {
+		return err
+	}
+	t.add(workflowWrite{kind: writeKindExecution, execution: execution}, &dynamodb.TransactWriteItem{Put: &dynamodb.Put{
+		TableName:                aws.String(db.tableName(tableWorkflowExecution)),
+		Item:                     it,
+		ConditionExpression:      aws.String("attribute_not_exists(#execution_key)"),
+		ExpressionAttributeNames: newExpression().name("execution_key", attrExecutionKey).names,
+	}})
+	return db.addMapWrites(t, shardID, mapGenerationPrefix(execution.DomainID, execution.WorkflowID, execution.RunID, data.MapsGeneration), newExecutionMaps(execution).writes())
+}
+
+// addResetExecution overrides the whole execution including the maps, the event buffer is cleared
+func (db *ddb) addResetExecution(ctx context.Context, t *workflowTransaction, shardID int, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeClear {
+		return fmt.Errorf("should only support EventBufferWriteModeClear")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeReset {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeReset")
+	}
+	// the stored execution is only read to find the generation of map entries that the reset replaces
+	stored, err := db.selectExecutionData(ctx, shardID, execution.DomainID, execution.WorkflowID, execution.RunID)
+	switch {
+	case err == nil:
+		t.obsoleteMaps = append(t.obsoleteMaps, mapGeneration{
+			shardID: shardID,
+			prefix:  mapGenerationPrefix(execution.DomainID, execution.WorkflowID, execution.RunID, stored.MapsGeneration),
+		})
+	case !db.IsNotFoundError(err):
+		return err
+	}
+
+	// the stored record version is not relied on here, a timestamp makes sure it never goes back
+	// to a value that a concurrent mutation could have read
+	now := time.Now().UnixNano()
+	data := newExecutionData(execution, now)
+	it, err := newExecutionItem(shardID, execution, data, now)
+	if err != nil {
+		return err
+	}
+	expr := newExpression().
+		name("next_event_id", attrNextEventID).
+		value("next_event_id", numberAttr(*execution.PreviousNextEventIDCondition))
+	t.add(workflowWrite{kind: writeKindExecution, execution: execution}, &dynamodb.TransactWriteItem{Put: &dynamodb.Put{
+		TableName:                 aws.String(db.tableName(tableWorkflowExecution)),
+		Item:                      it,
+		ConditionExpression:       aws.String("#next_event_id = :next_event_id"),
+		ExpressionAttributeNames:  expr.names,
+		ExpressionAttributeValues: expr.values,
+	}})
+	return db.addMapWrites(t, shardID, mapGenerationPrefix(execution.DomainID, execution.WorkflowID, execution.RunID, data.MapsGeneration), newExecutionMaps(execution).writes())
+}
+
+// addMutatedExecution applies the mutation to the stored execution. The number of buffered event entries and the
+// generation of the map entries are read from the execution item, so besides next_event_id the write is conditioned
+// on the record version that was read. A mutation changing too many entries rewrites the maps as a new generation.
+func (db *ddb) addMutatedExecution(ctx context.Context, t *workflowTransaction, shardID int, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeUpdate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeUpdate")
+	}
+
+	key := executionKey(shardID, execution.DomainID, execution.WorkflowID, execution.RunID)
+	data := &executionData{}
+	var recordVersion int64
+	stored, err := db.getItem(ctx, tableWorkflowExecution, key)
+	switch {
+	case err == nil:
+		if err := getData(stored, data); err != nil {
+			return err
+		}
+		if recordVersion, err = getNumber(stored, attrRecordVersion); err != nil {
+			return err
+		}
+	case db.IsNotFoundError(err):
+		// the condition below fails and is reported as a next_event_id mismatch
+	default:
+		return err
+	}
+
+	prefix := mapGenerationPrefix(execution.DomainID, execution.WorkflowID, execution.RunID, data.MapsGeneration)
+	writes := mutationMapWrites(execution, data.BufferedEventBatches)
+	if len(writes) > maxInlineMapWrites {
+		maps, err := db.selectExecutionMaps(ctx, shardID, prefix)
+		if err != nil {
+			return err
+		}
+		maps.merge(execution)
+		t.obsoleteMaps = append(t.obsoleteMaps, mapGeneration{shardID: shardID, prefix: prefix})
+		data.MapsGeneration = time.Now().UnixNano()
+		data.BufferedEventBatches = int64(len(maps.BufferedEvents))
+		prefix = mapGenerationPrefix(execution.DomainID, execution.WorkflowID, execution.RunID, data.MapsGeneration)
+		writes = maps.writes()
+	} else {
+		data.mergeBufferedEvents(execution)
+	}
+	data.setExecution(execution)
+
+	it, err := newExecutionItem(shardID, execution, data, recordVersion+1)
+	if err != nil {
+		return err
+	}
+	expr := newExpression().
+		name("next_event_id", attrNextEventID).
+		name("db_record_version", attrRecordVersion).
+		value("next_event_id", numberAttr(*execution.PreviousNextEventIDCondition)).
+		value("db_record_version", numberAttr(recordVersion))
+	t.add(workflowWrite{kind: writeKindExecution, execution: execution}, &dynamodb.TransactWriteItem{Put: &dynamodb.Put{
+		TableName:                 aws.String(db.tableName(tableWorkflowExecution)),
+		Item:                      it,
+		ConditionExpression:       aws.String("#next_event_id = :next_event_id AND #db_record_version = :db_record_version"),
+		ExpressionAttributeNames:  expr.names,
+		ExpressionAttributeValues: expr.values,
+	}})
+	return db.addMapWrites(t, shardID, prefix, writes)
+}
+
+// addMapWrites adds the map entry writes of an execution to the transaction. When there are more than
+// maxInlineMapWrites of them they must be upserts of a new generation, which are written ahead of the transaction.
+func (db *ddb) addMapWrites(t *workflowTransaction, shardID int, prefix string, writes []mapWrite) error {
+	prewrite := len(writes) > maxInlineMapWrites
+	for _, w := range writes {
+		if w.delete {
+			if prewrite {
+				return fmt.Errorf("deletion of map entry %v %q can't be written ahead of the transaction", w.kind, w.key)
+			}
+			t.add(workflowWrite{kind: writeKindOther}, &dynamodb.TransactWriteItem{Delete: &dynamodb.Delete{
+				TableName: aws.String(db.tableName(tableWorkflowExecutionMap)),
+				Key:       mapEntryKey(shardID, prefix, w.kind, w.key),
+			}})
+			continue
+		}
+		it, err := newMapEntryItem(shardID, prefix, w)
+		if err != nil {
+			return err
+		}
+		if prewrite {
+			t.prewrites = append(t.prewrites, putWrite{table: tableWorkflowExecutionMap, item: it, keyNames: []string{attrShardID, attrMapKey}})
+			continue
+		}
+		t.add(workflowWrite{kind: writeKindOther}, &dynamodb.TransactWriteItem{Put: &dynamodb.Put{
+			TableName: aws.String(db.tableName(tableWorkflowExecutionMap)),
+			Item:      it,
+		}})
+	}
+	return nil
+}
+
+func (db *ddb) addActiveClusterSelectionPolicy(t *workflowTransaction, row *nosqlplugin.ActiveClusterSelectionPolicyRow) error {
+	if row == nil {
+		return nil
+	}
+	data, err := dataAttr(row.Policy)
+	if err != nil {
+		return err
+	}
+	it := executionKey(row.ShardID, row.DomainID, row.WorkflowID, row.RunID)
+	it[attrData] = data
+	t.add(workflowWrite{kind: writeKindOther}, &dynamodb.TransactWriteItem{Put: &dynamodb.Put{
+		TableName: aws.String(db.tableName(tableActiveClusterSelectionPolicy)),
+		Item:      it,
+	}})
+	return nil
+}
+
+func (db *ddb) addTasks(
+	t *workflowTransaction,
+	shardID int,
+	domainID string,
+	workflowID string,
+	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
+) error {
+	for c, tasks := range tasksByCategory {
+		for _, task := range tasks {
+			w := putWrite{keyNames: []string{attrShardID, attrTaskID}}
+			var err error
+			switch c.ID() {
+			case persistence.HistoryTaskCategoryIDTransfer:
+				w.table = tableTransferTask
+				w.item, err = newTransferTaskItem(shardID, domainID, workflowID, task)
+			case persistence.HistoryTaskCategoryIDTimer:
+				w.table = tableTimerTask
+				w.keyNames = []string{attrShardID, attrTimerKey}
+				w.item, err = newTimerTaskItem(shardID, domainID, workflowID, task)
+			case persistence.HistoryTaskCategoryIDReplication:
+				w.table = tableReplicationTask
+				w.item, err = newReplicationTaskItem(shardID, domainID, workflowID, task)
+			default:
+				return fmt.Errorf("history task category %v is not supported by the dynamodb plugin", c.Name())
+			}
+			if err != nil {
+				return err
+			}
+			if w.table == tableTimerTask {
+				// timer tasks are read up to the current time rather than up to what the shard has written,
+				// they go first so that they are the last ones to be written ahead of the transaction
+				t.tasks = append([]putWrite{w}, t.tasks...)
+			} else {
+				t.tasks = append(t.tasks, w)
+			}
+		}
+	}
+	return nil
+}
+
+// executeWorkflowTransaction runs the transaction of a workflow create/update. The tasks that don't fit in the transaction
+// and the map entries of new generations are written ahead of it. They are not visible until the transaction commits:
+// a generation is only read once the execution item refers to it and the shard only reads immediate tasks up to the
+// task IDs of its successful writes. They are deleted again when the transaction is cancelled.
+func (db *ddb) executeWorkflowTransaction(ctx context.Context, t *workflowTransaction) error {
+	items := t.items
+	prewrites := t.prewrites
+	for _, w := range t.tasks {
+		if len(items) < maxTransactionItems {
+			items = append(items, &dynamodb.TransactWriteItem{Put: &dynamodb.Put{
+				TableName: aws.String(db.tableName(w.table)),
+				Item:      w.item,
+			}})
+			continue
+		}
+		prewrites = append(prewrites, w)
+	}
+	if err := db.putWrites(ctx, prewrites); err != nil {
+		return err
+	}
+
+	err := db.executeTransaction(ctx, items)
+	var cancelled *transactionCancelled
+	if errors.As(err, &cancelled) {
+		// any other error may still have committed the transaction, the writes are kept then
+		if err := db.deleteWrites(ctx, prewrites); err != nil {
+			db.logger.Warn("Failed to delete the items written ahead of a cancelled workflow transaction", tag.Error(err))
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, g := range t.obsoleteMaps {
+		if _, err := db.rangeDelete(ctx, tableWorkflowExecutionMap, db.executionMapsQuery(g.shardID, g.prefix), attrShardID, attrMapKey); err != nil {
+			// nothing refers to the entries anymore, they are deleted along with the execution at the latest
+			db.logger.Warn("Failed to delete replaced workflow execution map entries", tag.Error(err))
+		}
+	}
+	return nil
+}
+
+// putWrites writes the given items with batch writes
+func (db *ddb) putWrites(ctx context.Context, writes []putWrite) error {
+	tables, byTable := groupWritesByTable(writes)
+	for _, table := range tables {
+		items := make([]item, 0, len(byTable[table]))
+		for _, w := range byTable[table] {
+			items = append(items, w.item)
+		}
+		if _, err := db.putItems(ctx, table, items); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteWrites deletes the items of the given writes with batch writes
+func (db *ddb) deleteWrites(ctx context.Context, writes []putWrite) error {
+	tables, byTable := groupWritesByTable(writes)
+	for _, table := range tables {
+		keys := make([]item, 0, len(byTable[table]))
+		for _, w := range byTable[table] {
+			keys = append(keys, keyOf(w.item, w.keyNames...))
+		}
+		if _, err := db.deleteItems(ctx, table, keys); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// groupWritesByTable returns the tables in the order they are first written to, along with their writes
+func groupWritesByTable(writes []putWrite) ([]string, map[string][]putWrite) {
+	var tables []string
+	byTable := make(map[string][]putWrite)
+	for _, w := range writes {
+		if _, ok := byTable[w.table]; !ok {
+			tables = append(tables, w.table)
+		}
+		byTable[w.table] = append(byTable[w.table], w)
+	}
+	return tables, byTable
+}
+
+func (db *ddb) addShardCondition(t *workflowTransaction, shardCondition *nosqlplugin.ShardCondition) {
+	t.add(workflowWrite{kind: writeKindShard}, db.shardRangeIDCondition(shardCondition.ShardID, shardCondition.RangeID))
+}
+
+// createWorkflowConditionFailure explains why the transaction of InsertWorkflowExecutionWithTasks was cancelled,
+// the checks follow the same precedence as the Cassandra implementation
+func (db *ddb) createWorkflowConditionFailure(
+	ctx context.Context,
+	t *workflowTransaction,
+	cancelled *transactionCancelled,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	failed := t.failedWrites(cancelled)
+	if err := db.shardConditionFailure(ctx, failed, shardCondition); err != nil {
+		return err
+	}
+	if err := db.duplicateRequestFailure(ctx, failed); err != nil {
+		return err
+	}
+
+	if hasFailedWrite(failed, writeKindCurrentWorkflow) {
+		current, err := db.SelectCurrentWorkflow(ctx, shardCondition.ShardID, execution.DomainID, execution.WorkflowID)
+		if err != nil && !db.IsNotFoundError(err) {
+			return err
+		}
+		workflowID := currentWorkflowRequest.Row.WorkflowID
+		if currentWorkflowRequest.WriteMode == nosqlplugin.CurrentWorkflowWriteModeInsert {
+			if current == nil {
+				msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v", workflowID)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					CurrentWorkflowConditionFailInfo: &msg,
+				}
+			}
+			msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v", workflowID, current.RunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+					OtherInfo:        msg,
+					CreateRequestID:  current.CreateRequestID,
+					RunID:            current.RunID,
+					State:            current.State,
+					CloseStatus:      current.CloseStatus,
+					LastWriteVersion: current.LastWriteVersion,
+				},
+			}
+		}
+
+		condition := currentWorkflowRequest.Condition
+		var msg string
+		switch {
+		case current == nil || current.RunID != condition.GetCurrentRunID():
+			var actualRunID string
+			if current != nil {
+				actualRunID = current.RunID
+			}
+			msg = fmt.Sprintf("Workflow execution creation condition failed by mismatch runID. WorkflowId: %v, Expected Current RunID: %v, Actual Current RunID: %v",
+				workflowID, condition.GetCurrentRunID(), actualRunID)
+		case condition.LastWriteVersion != nil && current.LastWriteVersion != *condition.LastWriteVersion:
+			msg = fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, Expected Version: %v, Actual Version: %v",
+				workflowID, *condition.LastWriteVersion, current.LastWriteVersion)
+		case condition.State != nil && current.State != *condition.State:
+			msg = fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, Expected State: %v, Actual State: %v",
+				workflowID, *condition.State, current.State)
+		}
+		if msg != "" {
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				CurrentWorkflowConditionFailInfo: &msg,
+			}
+		}
+	}
+
+	if hasFailedWrite(failed, writeKindExecution) {
+		lastWriteVersion := execution.LastWriteVersion
+		stored, err := db.getItem(ctx, tableWorkflowExecution, executionKey(shardCondition.ShardID, execution.DomainID, execution.WorkflowID, execution.RunID))
+		switch {
+		case err == nil:
+			if lastWriteVersion, err = getNumber(stored, attrLastWriteVersion); err != nil {
+				return err
+			}
+		case !db.IsNotFoundError(err):
+			return err
+		}
+		msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v", execution.WorkflowID, execution.RunID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+				OtherInfo:        msg,
+				CreateRequestID:  execution.CreateRequestID,
+				RunID:            execution.RunID,
+				State:            execution.State,
+				CloseStatus:      execution.CloseStatus,
+				LastWriteVersion: lastWriteVersion,
+			},
+		}
+	}
+
+	msg := fmt.Sprintf("Failed to operate on workflow execution.  Request RangeID: %v, columns: (%v)",
+		shardCondition.RangeID, cancelled.Error())
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		UnknownConditionFailureDetails: &msg,
+	}
+}
+
+// updateWorkflowConditionFailure explains why the transaction of UpdateWorkflowExecutionWithTasks was cancelled.
+// retry is true when the only failure is a concurrent change of the mutated execution that kept next_event_id,
+// in that case the update can be rebuilt on top of the latest record.
+func (db *ddb) updateWorkflowConditionFailure(
+	ctx context.Context,
+	t *workflowTransaction,
+	cancelled *transactionCancelled,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	previousNextEventIDCondition int64,
+	shardCondition *nosqlplugin.ShardCondition,
+) (retry bool, err error) {
+	failed := t.failedWrites(cancelled)
+	if err := db.shardConditionFailure(ctx, failed, shardCondition); err != nil {
+		return false, err
+	}
+	if err := db.duplicateRequestFailure(ctx, failed); err != nil {
+		return false, err
+	}
+
+	requestConditionalRunID := ""
+	if currentWorkflowRequest.Condition != nil {
+		requestConditionalRunID = currentWorkflowRequest.Condition.GetCurrentRunID()
+	}
+	if hasFailedWrite(failed, writeKindCurrentWorkflow) {
+		row := currentWorkflowRequest.Row
+		var actualCurrRunID string
+		current, err := db.SelectCurrentWorkflow(ctx, shardCondition.ShardID, row.DomainID, row.WorkflowID)
+		switch {
+		case err == nil:
+			actualCurrRunID = current.RunID
+		case !db.IsNotFoundError(err):
+			return false, err
+		}
+		if actualCurrRunID != requestConditionalRunID {
+			msg := fmt.Sprintf("Failed to update mutable state. requestConditionalRunID: %v, Actual Value: %v",
+				requestConditionalRunID, actualCurrRunID)
+			return false, &nosqlplugin.WorkflowOperationConditionFailure{
+				CurrentWorkflowConditionFailInfo: &msg,
+			}
+		}
+	}
+
+	retry = len(failed) > 0
+	for _, w := range failed {
+		if w.kind != writeKindExecution || w.execution.PreviousNextEventIDCondition == nil {
+			retry = false
+			continue
+		}
+		execution := w.execution
+		actualNextEventID := int64(-1)
+		stored, err := db.getItem(ctx, tableWorkflowExecution, executionKey(shardCondition.ShardID, execution.DomainID, execution.WorkflowID, execution.RunID))
+		switch {
+		case err == nil:
+			if actualNextEventID, err = getNumber(stored, attrNextEventID); err != nil {
+				return false, err
+			}
+		case !db.IsNotFoundError(err):
+			return false, err
+		}
+		if actualNextEventID != *execution.PreviousNextEventIDCondition {
+			msg := fmt.Sprintf("Failed to update mutable state. previousNextEventIDCondition: %v, actualNextEventID: %v, Request Current RunID: %v",
+				*execution.PreviousNextEventIDCondition, actualNextEventID, execution.RunID)
+			return false, &nosqlplugin.WorkflowOperationConditionFailure{
+				UnknownConditionFailureDetails: &msg,
+			}
+		}
+		if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeUpdate {
+			retry = false
+		}
+	}
+	if retry {
+		return true, nil
+	}
+
+	msg := fmt.Sprintf("Failed to update mutable state. ShardID: %v, RangeID: %v, previousNextEventIDCondition: %v, requestConditionalRunID: %v, columns: (%v)",
+		shardCondition.ShardID, shardCondition.RangeID, previousNextEventIDCondition, requestConditionalRunID, cancelled.Error())
+	return false, &nosqlplugin.WorkflowOperationConditionFailure{
+		UnknownConditionFailureDetails: &msg,
+	}
+}
+
+func (db *ddb) shardConditionFailure(ctx context.Context, failed []workflowWrite, shardCondition *nosqlplugin.ShardCondition) error {
+	if !hasFailedWrite(failed, writeKindShard) {
+		return nil
+	}
+	rangeID, err := db.selectShardRangeID(ctx, shardCondition.ShardID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return err
+	}
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		ShardRangeIDNotMatch: &rangeID,
+	}
+}
+
+func (db *ddb) duplicateRequestFailure(ctx context.Context, failed []workflowWrite) error {
+	for _, w := range failed {
+		if w.kind != writeKindRequest {
+			continue
+		}
+		row := w.request
+		stored, err := db.getItem(ctx, tableWorkflowRequest, item{
+			attrShardID:    numberAttr(int64(row.ShardID)),
+			attrRequestKey: stringAttr(workflowRequestKeyValue(row.DomainID, row.WorkflowID, row.RequestType, row.RequestID)),
+		})
+		if err != nil {
+			return err
+		}
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			DuplicateRequest: &nosqlplugin.DuplicateRequest{
+				RequestType: row.RequestType,
+				RunID:       getString(stored, attrRunID),
+			},
+		}
+	}
+	return nil
+}
+
+func hasFailedWrite(failed []workflowWrite, kind writeKind) bool {
+	for _, w := range failed {
+		if w.kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func newExecutionData(execution *nosqlplugin.WorkflowExecutionRequest, mapsGeneration int64) *executionData {
+	data := &executionData{MapsGeneration: mapsGeneration}
+	data.setExecution(execution)
+	return data
+}
+
+func (d *executionData) setExecution(execution *nosqlplugin.WorkflowExecutionRequest) {
+	info := execution.InternalWorkflowExecutionInfo
+	d.ExecutionInfo = &info
+	d.VersionHistories = execution.VersionHistories
+	d.Checksum = checksum.Checksum{}
+	if execution.Checksums != nil {
+		d.Checksum = *execution.Checksums
+	}
+}
+
+// mergeBufferedEvents counts the buffered event entries after the mutation's writes from mutationMapWrites
+func (d *executionData) mergeBufferedEvents(execution *nosqlplugin.WorkflowExecutionRequest) {
+	switch execution.EventBufferWriteMode {
+	case nosqlplugin.EventBufferWriteModeClear:
+		d.BufferedEventBatches = 0
+	case nosqlplugin.EventBufferWriteModeAppend:
+		d.BufferedEventBatches++
+	}
+}
+
+func newEmptyExecutionMaps() *executionMaps {
+	return &executionMaps{
+		ActivityInfos:       make(map[int64]*persistence.InternalActivityInfo),
+		TimerInfos:          make(map[string]*persistence.TimerInfo),
+		ChildExecutionInfos: make(map[int64]*persistence.InternalChildExecutionInfo),
+		RequestCancelInfos:  make(map[int64]*persistence.RequestCancelInfo),
+		SignalInfos:         make(map[int64]*persistence.SignalInfo),
+		SignalRequestedIDs:  make(map[string]struct{}),
+		BufferedEvents:      make([]*persistence.DataBlob, 0),
+	}
+}
+
+// newExecutionMaps returns the maps of a created or reset execution, which has no buffered events
+func newExecutionMaps(execution *nosqlplugin.WorkflowExecutionRequest) *executionMaps {
+	m := newEmptyExecutionMaps()
+	m.merge(execution)
+	return m
+}
+
+// merge applies the changes of a mutation
+func (m *executionMaps) merge(execution *nosqlplugin.WorkflowExecutionRequest) {
+	m.ActivityInfos = mergeMap(m.ActivityInfos, execution.ActivityInfos, execution.ActivityInfoKeysToDelete)
+	m.TimerInfos = mergeMap(m.TimerInfos, execution.TimerInfos, execution.TimerInfoKeysToDelete)
+	m.ChildExecutionInfos = mergeMap(m.ChildExecutionInfos, execution.ChildWorkflowInfos, execution.ChildWorkflowInfoKeysToDelete)
+	m.RequestCancelInfos = mergeMap(m.RequestCancelInfos, execution.RequestCancelInfos, execution.RequestCancelInfoKeysToDelete)
+	m.SignalInfos = mergeMap(m.SignalInfos, execution.SignalInfos, execution.SignalInfoKeysToDelete)
+	m.SignalRequestedIDs = mergeMap(m.SignalRequestedIDs, signalRequestedIDSet(execution.SignalRequestedIDs), execution.SignalRequestedIDsKeysToDelete)
+
+	switch execution.EventBufferWriteMode {
+	case nosqlplugin.EventBufferWriteModeClear:
+		m.BufferedEvents = make([]*persistence.DataBlob, 0)
+	case nosqlplugin.EventBufferWriteModeAppend:
+		m.BufferedEvents = append(m.BufferedEvents, execution.NewBufferedEventBatch)
+	}
+}
+
+// writes returns the upserts of every entry
+func (m *executionMaps) writes() []mapWrite {
+	var writes []mapWrite
+	writes = appendMapWrites(writes, mapKindActivity, m.ActivityInfos, nil)
+	writes = appendMapWrites(writes, mapKindTimer, m.TimerInfos, nil)
+	writes = appendMapWrites(writes, mapKindChildExecution, m.ChildExecutionInfos, nil)
+	writes = appendMapWrites(writes, mapKindRequestCancel, m.RequestCancelInfos, nil)
+	writes = appendMapWrites(writes, mapKindSignal, m.SignalInfos, nil)
+	writes = appendMapWrites(writes, mapKindSignalRequested, m.SignalRequestedIDs, nil)
+	for i, batch := range m.BufferedEvents {
+		writes = append(writes, mapWrite{kind: mapKindBufferedEvents, key: sortableInt(int64(i)), value: batch})
+	}
+	return writes
+}
+
+// add decodes a map entry item, buffered event entries have to be added in the order of their keys
+func (m *executionMaps) add(kind, key string, it item) error {
+	switch kind {
+	case mapKindActivity:
+		return addMapEntry(m.ActivityInfos, parseInt64Key, key, it)
+	case mapKindTimer:
+		return addMapEntry(m.TimerInfos, parseStringKey, key, it)
+	case mapKindChildExecution:
+		return addMapEntry(m.ChildExecutionInfos, parseInt64Key, key, it)
+	case mapKindRequestCancel:
+		return addMapEntry(m.RequestCancelInfos, parseInt64Key, key, it)
+	case mapKindSignal:
+		return addMapEntry(m.SignalInfos, parseInt64Key, key, it)
+	case mapKindSignalRequested:
+		m.SignalRequestedIDs[key] = struct{}{}
+		return nil
+	case mapKindBufferedEvents:
+		batch := &persistence.DataBlob{}
+		if err := getData(it, batch); err != nil {
+			return err
+		}
+		m.BufferedEvents = append(m.BufferedEvents, batch)
+		return nil
+	default:
+		return fmt.Errorf("unknown workflow execution map entry kind %q", kind)
+	}
+}
+
+// mutationMapWrites returns the map entry writes of a WorkflowExecutionMapsWriteModeUpdate mutation,
+// bufferedEventBatches is the number of buffered event entries before the mutation
+func mutationMapWrites(execution *nosqlplugin.WorkflowExecutionRequest, bufferedEventBatches int64) []mapWrite {
+	var writes []mapWrite
+	writes = appendMapWrites(writes, mapKindActivity, execution.ActivityInfos, execution.ActivityInfoKeysToDelete)
+	writes = appendMapWrites(writes, mapKindTimer, execution.TimerInfos, execution.TimerInfoKeysToDelete)
+	writes = appendMapWrites(writes, mapKindChildExecution, execution.ChildWorkflowInfos, execution.ChildWorkflowInfoKeysToDelete)
+	writes = appendMapWrites(writes, mapKindRequestCancel, execution.RequestCancelInfos, execution.RequestCancelInfoKeysToDelete)
+	writes = appendMapWrites(writes, mapKindSignal, execution.SignalInfos, execution.SignalInfoKeysToDelete)
+	writes = appendMapWrites(writes, mapKindSignalRequested, signalRequestedIDSet(execution.SignalRequestedIDs), execution.SignalRequestedIDsKeysToDelete)
+
+	switch execution.EventBufferWriteMode {
+	case nosqlplugin.EventBufferWriteModeClear:
+		for i := int64(0); i < bufferedEventBatches; i++ {
+			writes = append(writes, mapWrite{kind: mapKindBufferedEvents, key: sortableInt(i), delete: true})
+		}
+	case nosqlplugin.EventBufferWriteModeAppend:
+		writes = append(writes, mapWrite{kind: mapKindBufferedEvents, key: sortableInt(bufferedEventBatches), value: execution.NewBufferedEventBatch})
+	}
+	return writes
+}
+
+// appendMapWrites adds the deletions and the upserts of a map, a key that is both deleted and upserted is only
+// upserted since a transaction can't have several operations on the same item
+func appendMapWrites[K comparable, V any](writes []mapWrite, kind string, upserts map[K]V, deletes []K) []mapWrite {
+	deleted := make(map[K]struct{}, len(deletes))
+	for _, k := range deletes {
+		if _, ok := upserts[k]; ok {
+			continue
+		}
+		if _, ok := deleted[k]; ok {
+			continue
+		}
+		deleted[k] = struct{}{}
+		writes = append(writes, mapWrite{kind: kind, key: fmt.Sprint(k), delete: true})
+	}
+	for k, v := range upserts {
+		writes = append(writes, mapWrite{kind: kind, key: fmt.Sprint(k), value: v})
+	}
+	return writes
+}
+
+func addMapEntry[K comparable, V any](m map[K]V, parseKey func(string) (K, error), key string, it item) error {
+	k, err := parseKey(key)
+	if err != nil {
+		return err
+	}
+	var v V
+	if err := getData(it, &v); err != nil {
+		return err
+	}
+	m[k] = v
+	return nil
+}
+
+func parseInt64Key(key string) (int64, error) {
+	return strconv.ParseInt(key, 10, 64)
+}
+
+func parseStringKey(key string) (string, error) {
+	return key, nil
+}
+
+func signalRequestedIDSet(ids []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	return set
+}
+
+// mergeMap deletes the given keys and then upserts the given entries,
+// a key that is both deleted and upserted is kept
+func mergeMap[K comparable, V any](current, upserts map[K]V, deletes []K) map[K]V {
+	if current == nil {
+		current = make(map[K]V, len(upserts))
+	}
+	for _, k := range deletes {
+		delete(current, k)
+	}
+	for k, v := range upserts {
+		current[k] = v
+	}
+	return current
+}
+
+func toMutableState(data *executionData, maps *executionMaps) *nosqlplugin.WorkflowExecution {
+	return &nosqlplugin.WorkflowExecution{
+		ExecutionInfo:       data.ExecutionInfo,
+		VersionHistories:    data.VersionHistories,
+		ActivityInfos:       maps.ActivityInfos,
+		TimerInfos:          maps.TimerInfos,
+		ChildExecutionInfos: maps.ChildExecutionInfos,
+		RequestCancelInfos:  maps.RequestCancelInfos,
+		SignalInfos:         maps.SignalInfos,
+		SignalRequestedIDs:  maps.SignalRequestedIDs,
+		BufferedEvents:      maps.BufferedEvents,
+		Checksum:            data.Checksum,
+	}
+}
+
+func newExecutionItem(shardID int, execution *nosqlplugin.WorkflowExecutionRequest, data *executionData, recordVersion int64) (item, error) {
+	encoded, err := dataAttr(data)
+	if err != nil {
+		return nil, err
+	}
+	it := executionKey(shardID, execution.DomainID, execution.WorkflowID, execution.RunID)
+	it[attrNextEventID] = numberAttr(execution.NextEventID)
+	it[attrLastWriteVersion] = numberAttr(execution.LastWriteVersion)
+	it[attrRecordVersion] = numberAttr(recordVersion)
+	it[attrData] = encoded
+	return it, nil
+}
+
+func newMapEntryItem(shardID int, prefix string, w mapWrite) (item, error) {
+	data, err := dataAttr(w.value)
+	if err != nil {
+		return nil, err
+	}
+	it := mapEntryKey(shardID, prefix, w.kind, w.key)
+	it[attrData] = data
+	return it, nil
+}
+
+func mapEntryKey(shardID int, prefix, kind, key string) item {
+	return item{
+		attrShardID: numberAttr(int64(shardID)),
+		attrMapKey:  stringAttr(prefix + compositeKey(kind, key)),
+	}
+}
+
+// executionMapsPrefix is the prefix of the map entries of every generation of an execution
+func executionMapsPrefix(domainID, workflowID, runID string) string {
+	return compositeKey(domainID, workflowID, runID)
+}
+
+// mapGenerationPrefix is the prefix of the map entries of one generation of an execution
+func mapGenerationPrefix(domainID, workflowID, runID string, generation int64) string {
+	return executionMapsPrefix(domainID, workflowID, runID) + compositeKey(strconv.FormatInt(generation, 10))
+}
+
+// parseMapEntryKey returns the kind and the key of a map entry item of the generation with the given prefix
+func parseMapEntryKey(it item, prefix string) (string, string, error) {
+	mapKey := getString(it, attrMapKey)
+	parts, err := splitCompositeKey(strings.TrimPrefix(mapKey, prefix))
+	if err != nil || len(parts) != 2 || !strings.HasPrefix(mapKey, prefix) {
+		return "", "", fmt.Errorf("malformed workflow execution map key %q", mapKey)
+	}
+	return parts[0], parts[1], nil
+}
+
+func executionKey(shardID int, domainID, workflowID, runID string) item {
+	return item{
+		attrShardID:      numberAttr(int64(shardID)),
+		attrExecutionKey: stringAttr(compositeKey(domainID, workflowID, runID)),
+	}
+}
+
+func currentWorkflowKey(shardID int, domainID, workflowID string) item {
+	return item{
+		attrShardID:     numberAttr(int64(shardID)),
+		attrWorkflowKey: stringAttr(compositeKey(domainID, workflowID)),
+	}
+}
+
+func workflowRequestKeyValue(domainID, workflowID string, requestType persistence.WorkflowRequestType, requestID string) string {
+	return compositeKey(domainID, workflowID, strconv.Itoa(int(requestType)), requestID)
+}
+
+func transferTaskKey(shardID int, taskID int64) item {
+	return item{
+		attrShardID: numberAttr(int64(shardID)),
+		attrTaskID:  numberAttr(taskID),
+	}
+}
+
+func timerTaskKeyValue(visibilityTimestamp time.Time, taskID int64) string {
+	return sortableInt(persistence.UnixNanoToDBTimestamp(visibilityTimestamp.UnixNano())) + "#" + sortableInt(taskID)
+}
+
+func timerTaskKey(shardID int, visibilityTimestamp time.Time, taskID int64) item {
+	return item{
+		attrShardID:  numberAttr(int64(shardID)),
+		attrTimerKey: stringAttr(timerTaskKeyValue(visibilityTimestamp, taskID)),
+	}
+}
+
+func replicationDLQKeyValue(shardID int, sourceCluster string) string {
+	return compositeKey(strconv.Itoa(shardID), sourceCluster)
+}
+
+func replicationDLQTaskKey(shardID int, sourceCluster string, taskID int64) item {
+	return item{
+		attrDLQKey: stringAttr(replicationDLQKeyValue(shardID, sourceCluster)),
+		attrTaskID: numberAttr(taskID),
+	}
+}
+
+func newTransferTaskItem(shardID int, domainID, workflowID string, task *nosqlplugin.HistoryMigrationTask) (item, error) {
+	transfer := *task.Transfer
+	transfer.DomainID = domainID
+	transfer.WorkflowID = workflowID
+	data, err := dataAttr(&nosqlplugin.HistoryMigrationTask{
+		Transfer: &transfer,
+		Task:     task.Task,
+		TaskID:   transfer.TaskID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	it := transferTaskKey(shardID, transfer.TaskID)
+	it[attrData] = data
+	return it, nil
+}
+
+func newTimerTaskItem(shardID int, domainID, workflowID string, task *nosqlplugin.HistoryMigrationTask) (item, error) {
+	timer := *task.Timer
+	timer.DomainID = domainID
+	timer.WorkflowID = workflowID
+	data, err := dataAttr(&nosqlplugin.HistoryMigrationTask{
+		Timer:  &timer,
+		Task:   task.Task,
+		TaskID: timer.TaskID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	it := timerTaskKey(shardID, timer.VisibilityTimestamp, timer.TaskID)
+	it[attrData] = data
+	return it, nil
+}
+
+func newReplicationTaskItem(shardID int, domainID, workflowID string, task *nosqlplugin.HistoryMigrationTask) (item, error) {
+	replication := *task.Replication
+	replication.DomainID = domainID
+	replication.WorkflowID = workflowID
+	data, err := dataAttr(&nosqlplugin.HistoryMigrationTask{
+		Replication: &replication,
+		Task:        task.Task,
+		TaskID:      replication.TaskID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	it := transferTaskKey(shardID, replication.TaskID)
+	it[attrData] = data
+	return it, nil
+}
+
+// parseHistoryTasks decodes task items, timers are truncated to milliseconds which is the precision they are keyed by
+func parseHistoryTasks(items []item) ([]*nosqlplugin.HistoryMigrationTask, error) {
+	tasks := make([]*nosqlplugin.HistoryMigrationTask, 0, len(items))
+	for _, it := range items {
+		task := &nosqlplugin.HistoryMigrationTask{}
+		if err := getData(it, task); err != nil {
+			return nil, err
+		}
+		if task.Timer != nil {
+			ts := time.Unix(0, persistence.DBTimestampToUnixNano(persistence.UnixNanoToDBTimestamp(task.Timer.VisibilityTimestamp.UnixNano())))
+			task.Timer.VisibilityTimestamp = ts
+			task.ScheduledTime = ts
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
@@ -0,0 +1,254 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+func TestExecutionMapsMerge(t *testing.T) {
+	maps := newEmptyExecutionMaps()
+	maps.ActivityInfos = map[int64]*persistence.InternalActivityInfo{
+		1: {ScheduleID: 1},
+		2: {ScheduleID: 2},
+	}
+	maps.TimerInfos = map[string]*persistence.TimerInfo{"t1": {TimerID: "t1"}}
+	maps.SignalRequestedIDs = map[string]struct{}{"s1": {}, "s2": {}}
+	maps.BufferedEvents = []*persistence.DataBlob{{Data: []byte("b1")}}
+	maps.merge(&nosqlplugin.WorkflowExecutionRequest{
+		InternalWorkflowExecutionInfo: persistence.InternalWorkflowExecutionInfo{RunID: "run", NextEventID: 10},
+		MapsWriteMode:                 nosqlplugin.WorkflowExecutionMapsWriteModeUpdate,
+		ActivityInfos: map[int64]*persistence.InternalActivityInfo{
+			2: {ScheduleID: 2, Attempt: 1},
+			3: {ScheduleID: 3},
+		},
+		ActivityInfoKeysToDelete:       []int64{1, 2},
+		TimerInfoKeysToDelete:          []string{"t1"},
+		SignalInfos:                    map[int64]*persistence.SignalInfo{5: {InitiatedID: 5}},
+		SignalRequestedIDs:             []string{"s3"},
+		SignalRequestedIDsKeysToDelete: []string{"s1"},
+		EventBufferWriteMode:           nosqlplugin.EventBufferWriteModeAppend,
+		NewBufferedEventBatch:          &persistence.DataBlob{Data: []byte("b2")},
+	})
+
+	state := toMutableState(&executionData{ExecutionInfo: &persistence.InternalWorkflowExecutionInfo{RunID: "run"}}, maps)
+	assert.Equal(t, "run", state.ExecutionInfo.RunID)
+	assert.Equal(t, map[int64]*persistence.InternalActivityInfo{
+		2: {ScheduleID: 2, Attempt: 1},
+		3: {ScheduleID: 3},
+	}, state.ActivityInfos)
+	assert.Empty(t, state.TimerInfos)
+	assert.NotNil(t, state.ChildExecutionInfos)
+	assert.NotNil(t, state.RequestCancelInfos)
+	assert.Equal(t, map[int64]*persistence.SignalInfo{5: {InitiatedID: 5}}, state.SignalInfos)
+	assert.Equal(t, map[string]struct{}{"s2": {}, "s3": {}}, state.SignalRequestedIDs)
+	assert.Equal(t, []*persistence.DataBlob{{Data: []byte("b1")}, {Data: []byte("b2")}}, state.BufferedEvents)
+
+	maps.merge(&nosqlplugin.WorkflowExecutionRequest{
+		MapsWriteMode:        nosqlplugin.WorkflowExecutionMapsWriteModeUpdate,
+		EventBufferWriteMode: nosqlplugin.EventBufferWriteModeClear,
+	})
+	assert.Equal(t, []*persistence.DataBlob{}, maps.BufferedEvents)
+}
+
+func TestExecutionMapsRoundTrip(t *testing.T) {
+	maps := newExecutionMaps(&nosqlplugin.WorkflowExecutionRequest{
+		ActivityInfos:        map[int64]*persistence.InternalActivityInfo{1: {ScheduleID: 1}},
+		TimerInfos:           map[string]*persistence.TimerInfo{"t:1": {TimerID: "t:1"}},
+		ChildWorkflowInfos:   map[int64]*persistence.InternalChildExecutionInfo{2: {InitiatedID: 2}},
+		RequestCancelInfos:   map[int64]*persistence.RequestCancelInfo{3: {InitiatedID: 3}},
+		SignalInfos:          map[int64]*persistence.SignalInfo{4: {InitiatedID: 4}},
+		SignalRequestedIDs:   []string{"s1"},
+		MapsWriteMode:        nosqlplugin.WorkflowExecutionMapsWriteModeCreate,
+		EventBufferWriteMode: nosqlplugin.EventBufferWriteModeNone,
+	})
+	for i := 0; i < 11; i++ {
+		maps.BufferedEvents = append(maps.BufferedEvents, &persistence.DataBlob{Data: []byte{byte(i)}})
+	}
+
+	prefix := mapGenerationPrefix("domain", "workflow", "run", 7)
+	var items []item
+	for _, w := range maps.writes() {
+		it, err := newMapEntryItem(1, prefix, w)
+		require.NoError(t, err)
+		items = append(items, it)
+	}
+	// the entries are read in the order of their keys
+	sort.Slice(items, func(i, j int) bool {
+		return getString(items[i], attrMapKey) < getString(items[j], attrMapKey)
+	})
+
+	read := newEmptyExecutionMaps()
+	for _, it := range items {
+		kind, key, err := parseMapEntryKey(it, prefix)
+		require.NoError(t, err)
+		require.NoError(t, read.add(kind, key, it))
+	}
+	assert.Equal(t, maps, read)
+
+	_, _, err := parseMapEntryKey(items[0], mapGenerationPrefix("domain", "workflow", "run", 8))
+	assert.Error(t, err)
+}
+
+func TestMutationMapWrites(t *testing.T) {
+	writes := mutationMapWrites(&nosqlplugin.WorkflowExecutionRequest{
+		MapsWriteMode:                  nosqlplugin.WorkflowExecutionMapsWriteModeUpdate,
+		ActivityInfos:                  map[int64]*persistence.InternalActivityInfo{2: {ScheduleID: 2}},
+		ActivityInfoKeysToDelete:       []int64{1, 1, 2},
+		SignalRequestedIDsKeysToDelete: []string{"s1"},
+		EventBufferWriteMode:           nosqlplugin.EventBufferWriteModeClear,
+	}, 2)
+	assert.Equal(t, []mapWrite{
+		{kind: mapKindActivity, key: "1", delete: true},
+		{kind: mapKindActivity, key: "2", value: &persistence.InternalActivityInfo{ScheduleID: 2}},
+		{kind: mapKindSignalRequested, key: "s1", delete: true},
+		{kind: mapKindBufferedEvents, key: sortableInt(0), delete: true},
+		{kind: mapKindBufferedEvents, key: sortableInt(1), delete: true},
+	}, writes)
+
+	batch := &persistence.DataBlob{Data: []byte("b")}
+	execution := &nosqlplugin.WorkflowExecutionRequest{
+		MapsWriteMode:         nosqlplugin.WorkflowExecutionMapsWriteModeUpdate,
+		EventBufferWriteMode:  nosqlplugin.EventBufferWriteModeAppend,
+		NewBufferedEventBatch: batch,
+	}
+	assert.Equal(t, []mapWrite{{kind: mapKindBufferedEvents, key: sortableInt(2), value: batch}}, mutationMapWrites(execution, 2))
+	data := &executionData{BufferedEventBatches: 2}
+	data.mergeBufferedEvents(execution)
+	assert.Equal(t, int64(3), data.BufferedEventBatches)
+}
+
+func TestAddCurrentWorkflow(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       *nosqlplugin.CurrentWorkflowWriteRequest
+		wantCondition string
+		wantNoItem    bool
+		wantErr       bool
+	}{
+		{
+			name:       "noop",
+			request:    &nosqlplugin.CurrentWorkflowWriteRequest{WriteMode: nosqlplugin.CurrentWorkflowWriteModeNoop},
+			wantNoItem: true,
+		},
+		{
+			name:          "insert",
+			request:       &nosqlplugin.CurrentWorkflowWriteRequest{WriteMode: nosqlplugin.CurrentWorkflowWriteModeInsert},
+			wantCondition: "attribute_not_exists(#workflow_key)",
+		},
+		{
+			name: "update by run ID",
+			request: &nosqlplugin.CurrentWorkflowWriteRequest{
+				WriteMode: nosqlplugin.CurrentWorkflowWriteModeUpdate,
+				Condition: &nosqlplugin.CurrentWorkflowWriteCondition{CurrentRunID: common.StringPtr("run")},
+			},
+			wantCondition: "#run_id = :run_id",
+		},
+		{
+			name: "update by run ID, version and state",
+			request: &nosqlplugin.CurrentWorkflowWriteRequest{
+				WriteMode: nosqlplugin.CurrentWorkflowWriteModeUpdate,
+				Condition: &nosqlplugin.CurrentWorkflowWriteCondition{
+					CurrentRunID:     common.StringPtr("run"),
+					LastWriteVersion: common.Int64Ptr(10),
+					State:            common.IntPtr(1),
+				},
+			},
+			wantCondition: "#run_id = :run_id AND #last_write_version = :last_write_version AND #state = :state",
+		},
+		{
+			name:    "update without run ID",
+			request: &nosqlplugin.CurrentWorkflowWriteRequest{WriteMode: nosqlplugin.CurrentWorkflowWriteModeUpdate},
+			wantErr: true,
+		},
+		{
+			name:    "unknown mode",
+			request: &nosqlplugin.CurrentWorkflowWriteRequest{WriteMode: 100},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			db := newTestDB(t, &fakeClient{})
+			tx := &workflowTransaction{}
+			err := db.addCurrentWorkflow(tx, 1, "domain", "workflow", tc.request)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantNoItem {
+				assert.Empty(t, tx.items)
+				return
+			}
+			require.Len(t, tx.items, 1)
+			put := tx.items[0].Put
+			assert.Equal(t, "test_current_workflow", aws.StringValue(put.TableName))
+			assert.Equal(t, tc.wantCondition, aws.StringValue(put.ConditionExpression))
+			assert.Equal(t, compositeKey("domain", "workflow"), getString(put.Item, attrWorkflowKey))
+		})
+	}
+}
+
+func TestTimerTaskKeyRange(t *testing.T) {
+	db := newTestDB(t, &fakeClient{})
+	start := time.Unix(1700000000, 0)
+	input := db.timerTasksQuery(1, start, start.Add(time.Second))
+	min := aws.StringValue(input.ExpressionAttributeValues[":min"].S)
+	max := aws.StringValue(input.ExpressionAttributeValues[":max"].S)
+
+	inRange := func(ts time.Time, taskID int64) bool {
+		key := timerTaskKeyValue(ts, taskID)
+		return key >= min && key <= max
+	}
+	assert.True(t, inRange(start, 1))
+	assert.True(t, inRange(start.Add(999*time.Millisecond), 1<<40))
+	assert.False(t, inRange(start.Add(-time.Millisecond), 1))
+	assert.False(t, inRange(start.Add(time.Second), 1))
+}
+
+func TestParseHistoryTasks(t *testing.T) {
+	ts := time.Unix(1700000000, 123456789)
+	it, err := newTimerTaskItem(1, "domain", "workflow", &nosqlplugin.HistoryMigrationTask{
+		Timer: &nosqlplugin.TimerTask{TaskID: 5, RunID: "run", VisibilityTimestamp: ts},
+	})
+	require.NoError(t, err)
+
+	tasks, err := parseHistoryTasks([]item{it})
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	task := tasks[0]
+	assert.Equal(t, int64(5), task.TaskID)
+	assert.Equal(t, "domain", task.Timer.DomainID)
+	assert.Equal(t, "workflow", task.Timer.WorkflowID)
+	assert.True(t, time.Unix(1700000000, 123000000).Equal(task.ScheduledTime))
+	assert.True(t, task.ScheduledTime.Equal(task.Timer.VisibilityTimestamp))
+}
@@ -22,8 +22,22 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+const (
+	domainMetadataRecordName = "cadence-domain-metadata"
 )
 
 // Insert a new record to domain, return error if failed or already exists
@@ -32,7 +46,73 @@ func (db *mdb) InsertDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	metadataNotificationVersion, err := db.SelectDomainMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	inserted := *row
+	inserted.NotificationVersion = metadataNotificationVersion
+	inserted.FailoverNotificationVersion = persistence.InitialFailoverNotificationVersion
+	inserted.PreviousFailoverVersion = constants.InitialPreviousFailoverVersion
+	doc, err := newDomainEntry(&inserted)
+	if err != nil {
+		return err
+	}
+
+	return db.transaction(ctx, func(sessionCtx mongo.SessionContext) error {
+		collection := db.collection(cadence.DomainCollectionName)
+		count, err := collection.CountDocuments(sessionCtx, bson.D{{Key: "name", Value: row.Info.Name}})
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			db.logger.Warn("Domain already exists", tag.WorkflowDomainName(row.Info.Name))
+			return &types.DomainAlreadyExistsError{
+				Message: fmt.Sprintf("Domain %v already exists", row.Info.Name),
+			}
+		}
+		if _, err := collection.InsertOne(sessionCtx, doc); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return fmt.Errorf("CreateDomain operation failed because of uuid collision")
+			}
+			return err
+		}
+		return db.updateDomainMetadata(sessionCtx, metadataNotificationVersion)
+	})
+}
+
+// updateDomainMetadata bumps the notification version of the metadata record,
+// conditioned on the version the caller based its change on
+func (db *mdb) updateDomainMetadata(sessionCtx mongo.SessionContext, notificationVersion int64) error {
+	collection := db.collection(cadence.DomainMetadataCollectionName)
+	if notificationVersion <= 0 {
+		// the metadata record is only created with the first domain
+		_, err := collection.InsertOne(sessionCtx, &cadence.DomainMetadataCollectionEntry{
+			ID:                  domainMetadataRecordName,
+			NotificationVersion: 1,
+		})
+		if mongo.IsDuplicateKeyError(err) {
+			return db.domainMetadataConditionFailure()
+		}
+		return err
+	}
+	result, err := collection.UpdateOne(sessionCtx,
+		bson.D{{Key: fieldID, Value: domainMetadataRecordName}, {Key: "notificationversion", Value: notificationVersion}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "notificationversion", Value: notificationVersion + 1}}}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.domainMetadataConditionFailure()
+	}
+	return nil
+}
+
+func (db *mdb) domainMetadataConditionFailure() error {
+	db.logger.Warn("Domain operation failed because of condition update failure on domain metadata record")
+	return nosqlplugin.NewConditionFailure("domain")
 }
 
 // Update domain
@@ -40,7 +120,17 @@ func (db *mdb) UpdateDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	doc, err := newDomainEntry(row)
+	if err != nil {
+		return err
+	}
+	return db.transaction(ctx, func(sessionCtx mongo.SessionContext) error {
+		_, err := db.collection(cadence.DomainCollectionName).ReplaceOne(sessionCtx, bson.D{{Key: fieldID, Value: doc.ID}}, doc)
+		if err != nil {
+			return err
+		}
+		return db.updateDomainMetadata(sessionCtx, row.NotificationVersion)
+	})
 }
 
 // Get one domain data, either by domainID or domainName
@@ -49,7 +139,22 @@ func (db *mdb) SelectDomain(
 	domainID *string,
 	domainName *string,
 ) (*nosqlplugin.DomainRow, error) {
-	panic("TODO")
+	var filter bson.D
+	if domainID != nil && domainName != nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name specified in request")
+	} else if domainID != nil {
+		filter = bson.D{{Key: fieldID, Value: *domainID}}
+	} else if domainName != nil {
+		filter = bson.D{{Key: "name", Value: *domainName}}
+	} else {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name are empty")
+	}
+
+	var doc cadence.DomainCollectionEntry
+	if err := db.collection(cadence.DomainCollectionName).FindOne(ctx, filter).Decode(&doc); err != nil {
+		return nil, err
+	}
+	return newDomainRow(&doc)
 }
 
 // Get all domain data
@@ -58,7 +163,25 @@ func (db *mdb) SelectAllDomains(
 	pageSize int,
 	pageToken []byte,
 ) ([]*nosqlplugin.DomainRow, []byte, error) {
-	panic("TODO")
+	docs, nextPageToken, err := findPage(ctx, db.collection(cadence.DomainCollectionName), bson.D{},
+		[]sortKey{{field: "name", order: 1}}, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.DomainRow, 0, len(docs))
+	for _, raw := range docs {
+		var doc cadence.DomainCollectionEntry
+		if err := bson.Unmarshal(raw, &doc); err != nil {
+			return nil, nil, err
+		}
+		row, err := newDomainRow(&doc)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
 // Delete a domain, either by domainID or domainName
@@ -67,11 +190,59 @@ func (db *mdb) DeleteDomain(
 	domainID *string,
 	domainName *string,
 ) error {
-	panic("TODO")
+	var filter bson.D
+	switch {
+	case domainName != nil:
+		filter = bson.D{{Key: "name", Value: *domainName}}
+	case domainID != nil:
+		filter = bson.D{{Key: fieldID, Value: *domainID}}
+	default:
+		return fmt.Errorf("must provide either domainID or domainName")
+	}
+	_, err := db.collection(cadence.DomainCollectionName).DeleteOne(ctx, filter)
+	return err
 }
 
 func (db *mdb) SelectDomainMetadata(
 	ctx context.Context,
 ) (int64, error) {
-	panic("TODO")
+	var doc cadence.DomainMetadataCollectionEntry
+	err := db.collection(cadence.DomainMetadataCollectionName).FindOne(ctx, bson.D{{Key: fieldID, Value: domainMetadataRecordName}}).Decode(&doc)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			// the metadata record is only created with the first domain
+			return 0, nil
+		}
+		return -1, err
+	}
+	return doc.NotificationVersion, nil
+}
+
+func newDomainEntry(row *nosqlplugin.DomainRow) (*cadence.DomainCollectionEntry, error) {
+	data, err := encodeData(row)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.DomainCollectionEntry{
+		ID:                  row.Info.ID,
+		Name:                row.Info.Name,
+		NotificationVersion: row.NotificationVersion,
+		Data:                data,
+	}, nil
+}
+
+func newDomainRow(doc *cadence.DomainCollectionEntry) (*nosqlplugin.DomainRow, error) {
+	row := &nosqlplugin.DomainRow{}
+	if err := decodeData(doc.Data, row); err != nil {
+		return nil, err
+	}
+	if row.Config == nil {
+		row.Config = &persistence.InternalDomainConfig{}
+	}
+	if row.ReplicationConfig == nil {
+		row.ReplicationConfig = &persistence.InternalDomainReplicationConfig{}
+	}
+	// CurrentTimeStamp is only meaningful on writes
+	row.CurrentTimeStamp = time.Time{}
+	return row, nil
 }
@@ -22,17 +22,76 @@ package mongodb
 
 import (
 	"context"
-	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
 // InsertDomainAuditLog inserts a new audit log entry for a domain operation
 func (db *mdb) InsertDomainAuditLog(ctx context.Context, row *nosqlplugin.DomainAuditLogRow) error {
-	return fmt.Errorf("InsertDomainAuditLog not implemented")
+	data, err := encodeData(row)
+	if err != nil {
+		return err
+	}
+	doc := &cadence.DomainAuditLogCollectionEntry{
+		DomainID:      row.DomainID,
+		OperationType: int(row.OperationType),
+		CreatedTime:   row.CreatedTime.UnixNano(),
+		EventID:       row.EventID,
+		Data:          data,
+		ExpiresAt:     expiresAt(time.Now(), row.TTLSeconds),
+	}
+	filter := bson.D{
+		{Key: "domainid", Value: doc.DomainID},
+		{Key: "operationtype", Value: doc.OperationType},
+		{Key: "createdtime", Value: doc.CreatedTime},
+		{Key: "eventid", Value: doc.EventID},
+	}
+	_, err = db.collection(cadence.DomainAuditLogCollectionName).ReplaceOne(ctx, filter, doc, options.Replace().SetUpsert(true))
+	return err
 }
 
 // SelectDomainAuditLogs returns audit log entries for a domain and operation type
 func (db *mdb) SelectDomainAuditLogs(ctx context.Context, filter *nosqlplugin.DomainAuditLogFilter) ([]*nosqlplugin.DomainAuditLogRow, []byte, error) {
-	return nil, nil, fmt.Errorf("SelectDomainAuditLogs not implemented")
+	if filter.MinCreatedTime == nil || filter.MaxCreatedTime == nil {
+		return nil, nil, &types.InternalServiceError{
+			Message: "SelectDomainAuditLogs requires non-nil MinCreatedTime and MaxCreatedTime",
+		}
+	}
+
+	query := bson.D{
+		{Key: "domainid", Value: filter.DomainID},
+		{Key: "operationtype", Value: int(filter.OperationType)},
+		{Key: "createdtime", Value: bson.D{
+			{Key: "$gte", Value: filter.MinCreatedTime.UnixNano()},
+			{Key: "$lt", Value: filter.MaxCreatedTime.UnixNano()},
+		}},
+		notExpired(time.Now()),
+	}
+	// latest entries first
+	docs, nextPageToken, err := findPage(ctx, db.collection(cadence.DomainAuditLogCollectionName), query,
+		[]sortKey{{field: "createdtime", order: -1}, {field: "eventid", order: -1}}, filter.PageSize, filter.NextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.DomainAuditLogRow, 0, len(docs))
+	for _, raw := range docs {
+		var doc cadence.DomainAuditLogCollectionEntry
+		if err := bson.Unmarshal(raw, &doc); err != nil {
+			return nil, nil, err
+		}
+		row := &nosqlplugin.DomainAuditLogRow{}
+		if err := decodeData(doc.Data, row); err != nil {
+			return nil, nil, err
+		}
+		row.TTLSeconds = 0
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
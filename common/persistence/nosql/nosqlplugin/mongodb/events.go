@@ -22,31 +22,203 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+type historyTreeData struct {
+	Ancestors       []*types.HistoryBranchRange
+	CreateTimestamp int64
+	Info            string
+}
+
 // InsertIntoHistoryTreeAndNode inserts one or two rows: tree row and node row(at least one of them)
 func (db *mdb) InsertIntoHistoryTreeAndNode(ctx context.Context, treeRow *nosqlplugin.HistoryTreeRow, nodeRow *nosqlplugin.HistoryNodeRow) error {
-	panic("TODO")
+	if treeRow == nil && nodeRow == nil {
+		return fmt.Errorf("require at least a tree row or a node row to insert")
+	}
+
+	var treeDoc *cadence.HistoryTreeCollectionEntry
+	if treeRow != nil {
+		data, err := encodeData(&historyTreeData{
+			Ancestors:       treeRow.Ancestors,
+			CreateTimestamp: treeRow.CreateTimestamp.UnixNano(),
+			Info:            treeRow.Info,
+		})
+		if err != nil {
+			return err
+		}
+		treeDoc = &cadence.HistoryTreeCollectionEntry{
+			TreeID:   treeRow.TreeID,
+			BranchID: treeRow.BranchID,
+			Data:     data,
+		}
+	}
+	var nodeDoc *cadence.HistoryNodeCollectionEntry
+	if nodeRow != nil {
+		nodeDoc = &cadence.HistoryNodeCollectionEntry{
+			TreeID:       nodeRow.TreeID,
+			BranchID:     nodeRow.BranchID,
+			NodeID:       nodeRow.NodeID,
+			TxnID:        common.Int64Default(nodeRow.TxnID),
+			Data:         nodeRow.Data,
+			DataEncoding: nodeRow.DataEncoding,
+		}
+	}
+
+	insert := func(ctx context.Context) error {
+		upsert := options.Replace().SetUpsert(true)
+		if treeDoc != nil {
+			_, err := db.collection(cadence.HistoryTreeCollectionName).ReplaceOne(ctx,
+				historyBranchFilter(treeDoc.TreeID, treeDoc.BranchID), treeDoc, upsert)
+			if err != nil {
+				return err
+			}
+		}
+		if nodeDoc != nil {
+			filter := append(historyBranchFilter(nodeDoc.TreeID, nodeDoc.BranchID),
+				bson.E{Key: "nodeid", Value: nodeDoc.NodeID},
+				bson.E{Key: "txnid", Value: nodeDoc.TxnID},
+			)
+			_, err := db.collection(cadence.HistoryNodeCollectionName).ReplaceOne(ctx, filter, nodeDoc, upsert)
+			return err
+		}
+		return nil
+	}
+	if treeDoc == nil || nodeDoc == nil {
+		// a single write doesn't need a transaction
+		return insert(ctx)
+	}
+	return db.transaction(ctx, func(sessionCtx mongo.SessionContext) error {
+		return insert(sessionCtx)
+	})
 }
 
 // SelectFromHistoryNode read nodes based on a filter
 func (db *mdb) SelectFromHistoryNode(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter) ([]*nosqlplugin.HistoryNodeRow, []byte, error) {
-	panic("TODO")
+	query := append(historyBranchFilter(filter.TreeID, filter.BranchID), bson.E{Key: "nodeid", Value: bson.D{
+		{Key: "$gte", Value: filter.MinNodeID},
+		{Key: "$lt", Value: filter.MaxNodeID},
+	}})
+	docs, nextPageToken, err := findPage(ctx, db.collection(cadence.HistoryNodeCollectionName), query,
+		[]sortKey{{field: "nodeid", order: 1}, {field: "txnid", order: -1}}, filter.PageSize, filter.NextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryNodeRow, 0, len(docs))
+	for _, raw := range docs {
+		var doc cadence.HistoryNodeCollectionEntry
+		if err := bson.Unmarshal(raw, &doc); err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, &nosqlplugin.HistoryNodeRow{
+			NodeID:       doc.NodeID,
+			TxnID:        common.Int64Ptr(doc.TxnID),
+			Data:         doc.Data,
+			DataEncoding: doc.DataEncoding,
+		})
+	}
+	return rows, nextPageToken, nil
 }
 
 // DeleteFromHistoryTreeAndNode delete a branch record, and a list of ranges of nodes.
 func (db *mdb) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *nosqlplugin.HistoryTreeFilter, nodeFilters []*nosqlplugin.HistoryNodeFilter) error {
-	panic("TODO")
+	// nodes are deleted first so that a failed deletion can be retried as long as the branch record exists
+	for _, nodeFilter := range nodeFilters {
+		query := append(historyBranchFilter(nodeFilter.TreeID, nodeFilter.BranchID),
+			bson.E{Key: "nodeid", Value: bson.D{{Key: "$gte", Value: nodeFilter.MinNodeID}}})
+		if _, err := db.collection(cadence.HistoryNodeCollectionName).DeleteMany(ctx, query); err != nil {
+			return err
+		}
+	}
+	_, err := db.collection(cadence.HistoryTreeCollectionName).DeleteOne(ctx,
+		historyBranchFilter(treeFilter.TreeID, common.StringDefault(treeFilter.BranchID)))
+	return err
 }
 
 // SelectAllHistoryTrees will return all tree branches with pagination
 func (db *mdb) SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*nosqlplugin.HistoryTreeRow, []byte, error) {
-	panic("TODO")
+	docs, nextPageToken, err := findPage(ctx, db.collection(cadence.HistoryTreeCollectionName), bson.D{},
+		[]sortKey{{field: "treeid", order: 1}, {field: "branchid", order: 1}}, pageSize, nextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(docs))
+	for _, raw := range docs {
+		var doc cadence.HistoryTreeCollectionEntry
+		if err := bson.Unmarshal(raw, &doc); err != nil {
+			return nil, nil, err
+		}
+		row, err := newHistoryTreeRow(&doc)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
 // SelectFromHistoryTree read branch records for a tree
 func (db *mdb) SelectFromHistoryTree(ctx context.Context, filter *nosqlplugin.HistoryTreeFilter) ([]*nosqlplugin.HistoryTreeRow, error) {
-	panic("TODO")
+	cursor, err := db.collection(cadence.HistoryTreeCollectionName).Find(ctx, bson.D{{Key: "treeid", Value: filter.TreeID}})
+	if err != nil {
+		return nil, err
+	}
+	var docs []cadence.HistoryTreeCollectionEntry
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(docs))
+	for i := range docs {
+		row, err := newHistoryTreeRow(&docs[i])
+		if err != nil {
+			return nil, err
+		}
+		sortBranchAncestors(row.Ancestors)
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func historyBranchFilter(treeID, branchID string) bson.D {
+	return bson.D{{Key: "treeid", Value: treeID}, {Key: "branchid", Value: branchID}}
+}
+
+func newHistoryTreeRow(doc *cadence.HistoryTreeCollectionEntry) (*nosqlplugin.HistoryTreeRow, error) {
+	var data historyTreeData
+	if err := decodeData(doc.Data, &data); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.HistoryTreeRow{
+		TreeID:          doc.TreeID,
+		BranchID:        doc.BranchID,
+		Ancestors:       data.Ancestors,
+		CreateTimestamp: time.Unix(0, data.CreateTimestamp),
+		Info:            data.Info,
+	}, nil
+}
+
+// sortBranchAncestors sorts the ancestors by EndNodeID so that BeginNodeID can be set
+func sortBranchAncestors(ancestors []*types.HistoryBranchRange) {
+	if len(ancestors) == 0 {
+		return
+	}
+	sort.Slice(ancestors, func(i, j int) bool { return ancestors[i].EndNodeID < ancestors[j].EndNodeID })
+	ancestors[0].BeginNodeID = int64(1)
+	for i := 1; i < len(ancestors); i++ {
+		ancestors[i].BeginNodeID = ancestors[i-1].EndNodeID
+	}
 }
@@ -22,19 +22,33 @@ package mongodb
 
 import (
 	"context"
-	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+var queueMessageSort = []sortKey{{field: "messageid", order: 1}}
+
 // Insert message into queue, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *mdb) InsertIntoQueue(
 	ctx context.Context,
 	row *nosqlplugin.QueueMessageRow,
 ) error {
-	panic("TODO")
+	_, err := db.collection(cadence.QueueMessageCollectionName).InsertOne(ctx, &cadence.QueueMessageCollectionEntry{
+		QueueType: int(row.QueueType),
+		MessageID: row.ID,
+		Payload:   row.Payload,
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Get the ID of last message inserted into the queue
@@ -42,7 +56,17 @@ func (db *mdb) SelectLastEnqueuedMessageID(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	var doc cadence.QueueMessageCollectionEntry
+	err := db.collection(cadence.QueueMessageCollectionName).FindOne(ctx,
+		queueMessagesFilter(queueType),
+		options.FindOne().
+			SetSort(bson.D{{Key: "messageid", Value: -1}}).
+			SetProjection(bson.D{{Key: "messageid", Value: 1}}),
+	).Decode(&doc)
+	if err != nil {
+		return 0, err
+	}
+	return doc.MessageID, nil
 }
 
 // Read queue messages starting from the exclusiveBeginMessageID
@@ -52,7 +76,21 @@ func (db *mdb) SelectMessagesFrom(
 	exclusiveBeginMessageID int64,
 	maxRows int,
 ) ([]*nosqlplugin.QueueMessageRow, error) {
-	panic("TODO")
+	filter := append(queueMessagesFilter(queueType), bson.E{Key: "messageid", Value: bson.D{{Key: "$gt", Value: exclusiveBeginMessageID}}})
+	docs, _, err := findPage(ctx, db.collection(cadence.QueueMessageCollectionName), filter, queueMessageSort, maxRows, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*nosqlplugin.QueueMessageRow, 0, len(docs))
+	for _, raw := range docs {
+		row, err := newQueueMessageRow(raw)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, row)
+	}
+	return result, nil
 }
 
 // Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
@@ -60,7 +98,27 @@ func (db *mdb) SelectMessagesBetween(
 	ctx context.Context,
 	request nosqlplugin.SelectMessagesBetweenRequest,
 ) (*nosqlplugin.SelectMessagesBetweenResponse, error) {
-	panic("TODO")
+	filter := append(queueMessagesFilter(request.QueueType), bson.E{Key: "messageid", Value: bson.D{
+		{Key: "$gt", Value: request.ExclusiveBeginMessageID},
+		{Key: "$lte", Value: request.InclusiveEndMessageID},
+	}})
+	docs, nextPageToken, err := findPage(ctx, db.collection(cadence.QueueMessageCollectionName), filter, queueMessageSort, request.PageSize, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]nosqlplugin.QueueMessageRow, 0, len(docs))
+	for _, raw := range docs {
+		row, err := newQueueMessageRow(raw)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, *row)
+	}
+	return &nosqlplugin.SelectMessagesBetweenResponse{
+		Rows:          rows,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 // Delete all messages before exclusiveBeginMessageID
@@ -69,7 +127,9 @@ func (db *mdb) DeleteMessagesBefore(
 	queueType persistence.QueueType,
 	exclusiveBeginMessageID int64,
 ) error {
-	panic("TODO")
+	filter := append(queueMessagesFilter(queueType), bson.E{Key: "messageid", Value: bson.D{{Key: "$lt", Value: exclusiveBeginMessageID}}})
+	_, err := db.collection(cadence.QueueMessageCollectionName).DeleteMany(ctx, filter)
+	return err
 }
 
 // Delete all messages in a range between exclusiveBeginMessageID and inclusiveEndMessageID
@@ -79,7 +139,12 @@ func (db *mdb) DeleteMessagesInRange(
 	exclusiveBeginMessageID int64,
 	inclusiveEndMessageID int64,
 ) error {
-	panic("TODO")
+	filter := append(queueMessagesFilter(queueType), bson.E{Key: "messageid", Value: bson.D{
+		{Key: "$gt", Value: exclusiveBeginMessageID},
+		{Key: "$lte", Value: inclusiveEndMessageID},
+	}})
+	_, err := db.collection(cadence.QueueMessageCollectionName).DeleteMany(ctx, filter)
+	return err
 }
 
 // Delete one message
@@ -88,13 +153,27 @@ func (db *mdb) DeleteMessage(
 	queueType persistence.QueueType,
 	messageID int64,
 ) error {
-	panic("TODO")
+	filter := append(queueMessagesFilter(queueType), bson.E{Key: "messageid", Value: messageID})
+	_, err := db.collection(cadence.QueueMessageCollectionName).DeleteOne(ctx, filter)
+	return err
 }
 
 // Insert an empty metadata row, starting from a version
 func (db *mdb) InsertQueueMetadata(ctx context.Context, row nosqlplugin.QueueMetadataRow) error {
-	fmt.Println("not implemented, ignore the eror for testing")
-	return nil
+	data, err := encodeData(map[string]int64{})
+	if err != nil {
+		return err
+	}
+	_, err = db.collection(cadence.QueueMetadataCollectionName).InsertOne(ctx, &cadence.QueueMetadataCollectionEntry{
+		QueueType: int(row.QueueType),
+		Version:   row.Version,
+		Data:      data,
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		// it's ok if the metadata row already exists
+		return nil
+	}
+	return err
 }
 
 // **Conditionally** update a queue metadata row, if current version is matched(meaning current == row.Version - 1),
@@ -104,7 +183,21 @@ func (db *mdb) UpdateQueueMetadataCas(
 	ctx context.Context,
 	row nosqlplugin.QueueMetadataRow,
 ) error {
-	panic("TODO")
+	data, err := encodeData(row.ClusterAckLevels)
+	if err != nil {
+		return err
+	}
+	result, err := db.collection(cadence.QueueMetadataCollectionName).UpdateOne(ctx,
+		bson.D{{Key: fieldID, Value: int(row.QueueType)}, {Key: "version", Value: row.Version - 1}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "version", Value: row.Version}, {Key: "data", Value: data}}}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return nil
 }
 
 // Read a QueueMetadata
@@ -112,13 +205,46 @@ func (db *mdb) SelectQueueMetadata(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (*nosqlplugin.QueueMetadataRow, error) {
-	fmt.Println("not implemented, ignore the eror for testing")
-	return nil, nil
+	var doc cadence.QueueMetadataCollectionEntry
+	err := db.collection(cadence.QueueMetadataCollectionName).FindOne(ctx, bson.D{{Key: fieldID, Value: int(queueType)}}).Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+	var ackLevels map[string]int64
+	if err := decodeData(doc.Data, &ackLevels); err != nil {
+		return nil, err
+	}
+
+	// if record exist but ackLevels is empty, we initialize the map
+	if ackLevels == nil {
+		ackLevels = make(map[string]int64)
+	}
+	return &nosqlplugin.QueueMetadataRow{
+		QueueType:        queueType,
+		ClusterAckLevels: ackLevels,
+		Version:          doc.Version,
+	}, nil
 }
 
 func (db *mdb) GetQueueSize(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	return db.collection(cadence.QueueMessageCollectionName).CountDocuments(ctx, queueMessagesFilter(queueType))
+}
+
+func queueMessagesFilter(queueType persistence.QueueType) bson.D {
+	return bson.D{{Key: "queuetype", Value: int(queueType)}}
+}
+
+func newQueueMessageRow(raw bson.Raw) (*nosqlplugin.QueueMessageRow, error) {
+	var doc cadence.QueueMessageCollectionEntry
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.QueueMessageRow{
+		QueueType: persistence.QueueType(doc.QueueType),
+		ID:        doc.MessageID,
+		Payload:   doc.Payload,
+	}, nil
 }
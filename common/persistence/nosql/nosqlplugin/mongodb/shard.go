@@ -22,31 +22,157 @@ package mongodb
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
 // InsertShard creates a new shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *mdb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error {
-	log.Println("not implemented...ignore the error for testing...")
-	return nil
+	doc, err := newShardEntry(row)
+	if err != nil {
+		return err
+	}
+	_, err = db.collection(cadence.ShardCollectionName).InsertOne(ctx, doc)
+	if mongo.IsDuplicateKeyError(err) {
+		return db.conflictedShard(ctx, row.ShardID, "shard already exists")
+	}
+	return err
 }
 
 // SelectShard gets a shard
 func (db *mdb) SelectShard(ctx context.Context, shardID int, currentClusterName string) (int64, *nosqlplugin.ShardRow, error) {
-	panic("TODO")
+	var doc cadence.ShardCollectionEntry
+	err := db.collection(cadence.ShardCollectionName).FindOne(ctx, bson.D{{Key: fieldID, Value: shardID}}).Decode(&doc)
+	if err != nil {
+		return 0, nil, err
+	}
+	row := &nosqlplugin.ShardRow{InternalShardInfo: &persistence.InternalShardInfo{}}
+	if err := decodeData(doc.Data, row); err != nil {
+		return 0, nil, err
+	}
+
+	info := row.InternalShardInfo
+	if info.ClusterTransferAckLevel == nil {
+		info.ClusterTransferAckLevel = map[string]int64{
+			currentClusterName: info.TransferAckLevel,
+		}
+	}
+	if info.ClusterTimerAckLevel == nil {
+		info.ClusterTimerAckLevel = map[string]time.Time{
+			currentClusterName: info.TimerAckLevel,
+		}
+	}
+	if info.ClusterReplicationLevel == nil {
+		info.ClusterReplicationLevel = make(map[string]int64)
+	}
+	if info.ReplicationDLQAckLevel == nil {
+		info.ReplicationDLQAckLevel = make(map[string]int64)
+	}
+	return doc.RangeID, row, nil
 }
 
 // UpdateRangeID updates the rangeID, return error is there is any
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *mdb) UpdateRangeID(ctx context.Context, shardID int, rangeID int64, previousRangeID int64) error {
-	panic("TODO")
+	result, err := db.collection(cadence.ShardCollectionName).UpdateOne(ctx,
+		bson.D{{Key: fieldID, Value: shardID}, {Key: "rangeid", Value: previousRangeID}},
+		bson.D{
+			{Key: "$set", Value: bson.D{{Key: "rangeid", Value: rangeID}}},
+			{Key: "$inc", Value: bson.D{{Key: "revision", Value: 1}}},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.conflictedShard(ctx, shardID, fmt.Sprintf("previous range_id %v", previousRangeID))
+	}
+	return nil
 }
 
 // UpdateShard updates a shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *mdb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previousRangeID int64) error {
-	panic("TODO")
+	doc, err := newShardEntry(row)
+	if err != nil {
+		return err
+	}
+	result, err := db.collection(cadence.ShardCollectionName).UpdateOne(ctx,
+		bson.D{{Key: fieldID, Value: row.ShardID}, {Key: "rangeid", Value: previousRangeID}},
+		bson.D{
+			{Key: "$set", Value: bson.D{{Key: "rangeid", Value: doc.RangeID}, {Key: "data", Value: doc.Data}}},
+			{Key: "$inc", Value: bson.D{{Key: "revision", Value: 1}}},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.conflictedShard(ctx, row.ShardID, fmt.Sprintf("previous range_id %v", previousRangeID))
+	}
+	return nil
+}
+
+// lockShard is used by the transactions that must only apply while the shard is owned. Bumping the revision
+// makes the transaction conflict with any concurrent write of the shard, including the range ID updates.
+func (db *mdb) lockShard(sessionCtx mongo.SessionContext, shardID int, rangeID int64) error {
+	result, err := db.collection(cadence.ShardCollectionName).UpdateOne(sessionCtx,
+		bson.D{{Key: fieldID, Value: shardID}, {Key: "rangeid", Value: rangeID}},
+		bson.D{{Key: "$inc", Value: bson.D{{Key: "revision", Value: 1}}}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return &conditionFailed{kind: writeKindShard, details: fmt.Sprintf("shard_id=%v, range_id %v", shardID, rangeID)}
+	}
+	return nil
+}
+
+// selectShardRangeID returns the current range_id of a shard, it's used to explain failed conditions
+func (db *mdb) selectShardRangeID(ctx context.Context, shardID int) (int64, error) {
+	var doc cadence.ShardCollectionEntry
+	err := db.collection(cadence.ShardCollectionName).FindOne(ctx,
+		bson.D{{Key: fieldID, Value: shardID}},
+		options.FindOne().SetProjection(bson.D{{Key: "rangeid", Value: 1}}),
+	).Decode(&doc)
+	return doc.RangeID, err
+}
+
+func (db *mdb) conflictedShard(ctx context.Context, shardID int, details string) error {
+	rangeID, err := db.selectShardRangeID(ctx, shardID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return err
+	}
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: rangeID,
+		Details: fmt.Sprintf("shard_id=%v, range_id=%v, %v", shardID, rangeID, details),
+	}
+}
+
+func newShardEntry(row *nosqlplugin.ShardRow) (*cadence.ShardCollectionEntry, error) {
+	info := *row.InternalShardInfo
+	info.UpdatedAt = row.CurrentTimestamp
+	data, err := encodeData(&nosqlplugin.ShardRow{
+		InternalShardInfo: &info,
+		Data:              row.Data,
+		DataEncoding:      row.DataEncoding,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.ShardCollectionEntry{
+		ShardID: row.ShardID,
+		RangeID: row.RangeID,
+		Data:    data,
+	}, nil
 }
@@ -22,20 +22,101 @@ package mongodb
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+const (
+	initialRangeID = 1 // Id of the first range of a new task list
+)
+
+type (
+	taskListData struct {
+		TaskListKind            int
+		AckLevel                int64
+		LastUpdatedTime         time.Time
+		AdaptivePartitionConfig *persistence.TaskListPartitionConfig
+	}
+
+	taskData struct {
+		WorkflowID      string
+		RunID           string
+		ScheduledID     int64
+		CreatedTime     time.Time
+		PartitionConfig map[string]string
+		Priority        int32
+		FairnessKey     string
+	}
 )
 
 // SelectTaskList returns a single tasklist row.
 // Return IsNotFoundError if the row doesn't exist
 func (db *mdb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter) (*nosqlplugin.TaskListRow, error) {
-	panic("TODO")
+	var doc cadence.TaskListCollectionEntry
+	err := db.collection(cadence.TaskListCollectionName).FindOne(ctx, append(taskListFilter(filter), notExpired(time.Now()))).Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+	var data taskListData
+	if err := decodeData(doc.Data, &data); err != nil {
+		return nil, err
+	}
+
+	return &nosqlplugin.TaskListRow{
+		DomainID:     filter.DomainID,
+		TaskListName: filter.TaskListName,
+		TaskListType: filter.TaskListType,
+
+		TaskListKind:            data.TaskListKind,
+		LastUpdatedTime:         data.LastUpdatedTime,
+		AckLevel:                data.AckLevel,
+		RangeID:                 doc.RangeID,
+		AdaptivePartitionConfig: data.AdaptivePartitionConfig,
+	}, nil
 }
 
 // InsertTaskList insert a single tasklist row
-// Return IsConditionFailedError if the row already exists, and also the existing row
+// Return TaskOperationConditionFailure if the row already exists
 func (db *mdb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow) error {
-	panic("TODO")
+	doc, err := newTaskListEntry(&nosqlplugin.TaskListRow{
+		DomainID:                row.DomainID,
+		TaskListName:            row.TaskListName,
+		TaskListType:            row.TaskListType,
+		RangeID:                 initialRangeID,
+		TaskListKind:            row.TaskListKind,
+		AckLevel:                0,
+		LastUpdatedTime:         row.LastUpdatedTime,
+		AdaptivePartitionConfig: row.AdaptivePartitionConfig,
+	}, 0)
+	if err != nil {
+		return err
+	}
+	collection := db.collection(cadence.TaskListCollectionName)
+	_, err = collection.InsertOne(ctx, doc)
+	if !mongo.IsDuplicateKeyError(err) {
+		return err
+	}
+
+	// an expired tasklist may not have been removed by MongoDB yet
+	filter := append(taskListFilter(taskListFilterOf(row)), bson.E{Key: fieldExpiresAt, Value: bson.D{{Key: "$lte", Value: time.Now()}}})
+	result, err := collection.ReplaceOne(ctx, filter, doc)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.conflictedTaskList(ctx, row, "tasklist already exists")
+	}
+	return nil
 }
 
 // UpdateTaskList updates a single tasklist row
@@ -45,7 +126,7 @@ func (db *mdb) UpdateTaskList(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	return db.updateTaskList(ctx, row, 0, previousRangeID)
 }
 
 // UpdateTaskList updates a single tasklist row, and set an TTL on the record
@@ -58,19 +139,73 @@ func (db *mdb) UpdateTaskListWithTTL(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	updated := *row
+	updated.LastUpdatedTime = row.CurrentTimeStamp
+	return db.updateTaskList(ctx, &updated, ttlSeconds, previousRangeID)
+}
+
+func (db *mdb) updateTaskList(
+	ctx context.Context,
+	row *nosqlplugin.TaskListRow,
+	ttlSeconds int64,
+	previousRangeID int64,
+) error {
+	doc, err := newTaskListEntry(row, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	set := bson.D{{Key: "rangeid", Value: doc.RangeID}, {Key: "data", Value: doc.Data}}
+	var unset bson.D
+	if doc.ExpiresAt != nil {
+		set = append(set, bson.E{Key: fieldExpiresAt, Value: *doc.ExpiresAt})
+	} else {
+		// same as Cassandra, a write without TTL makes the tasklist permanent again
+		unset = bson.D{{Key: fieldExpiresAt, Value: ""}}
+	}
+	update := bson.D{
+		{Key: "$set", Value: set},
+		{Key: "$inc", Value: bson.D{{Key: "revision", Value: 1}}},
+	}
+	if unset != nil {
+		update = append(update, bson.E{Key: "$unset", Value: unset})
+	}
+	result, err := db.collection(cadence.TaskListCollectionName).UpdateOne(ctx,
+		append(taskListFilter(taskListFilterOf(row)), bson.E{Key: "rangeid", Value: previousRangeID}),
+		update,
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.conflictedTaskList(ctx, row, fmt.Sprintf("previous range_id %v", previousRangeID))
+	}
+	return nil
 }
 
 // ListTaskList returns all tasklists.
 // Noop if TTL is already implemented in other methods
 func (db *mdb) ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*nosqlplugin.ListTaskListResult, error) {
-	panic("TODO")
+	return nil, &types.InternalServiceError{
+		Message: "unsupported operation",
+	}
 }
 
 // DeleteTaskList deletes a single tasklist row
 // Return TaskOperationConditionFailure if the condition doesn't meet
 func (db *mdb) DeleteTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter, previousRangeID int64) error {
-	panic("TODO")
+	result, err := db.collection(cadence.TaskListCollectionName).DeleteOne(ctx,
+		append(taskListFilter(filter), bson.E{Key: "rangeid", Value: previousRangeID}))
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return db.conflictedTaskList(ctx, &nosqlplugin.TaskListRow{
+			DomainID:     filter.DomainID,
+			TaskListName: filter.TaskListName,
+			TaskListType: filter.TaskListType,
+		}, fmt.Sprintf("previous range_id %v", previousRangeID))
+	}
+	return nil
 }
 
 // InsertTasks inserts a batch of tasks
@@ -80,22 +215,193 @@ func (db *mdb) InsertTasks(
 	tasksToInsert []*nosqlplugin.TaskRowForInsert,
 	tasklistCondition *nosqlplugin.TaskListRow,
 ) error {
-	panic("TODO")
+	filter := taskListFilterOf(tasklistCondition)
+	now := time.Now()
+	models := make([]mongo.WriteModel, 0, len(tasksToInsert))
+	for _, task := range tasksToInsert {
+		data, err := encodeData(&taskData{
+			WorkflowID:      task.WorkflowID,
+			RunID:           task.RunID,
+			ScheduledID:     task.ScheduledID,
+			CreatedTime:     task.CreatedTime,
+			PartitionConfig: task.PartitionConfig,
+			Priority:        task.Priority,
+			FairnessKey:     task.FairnessKey,
+		})
+		if err != nil {
+			return err
+		}
+		doc := &cadence.TaskCollectionEntry{
+			DomainID:     filter.DomainID,
+			TaskListName: filter.TaskListName,
+			TaskListType: filter.TaskListType,
+			TaskID:       task.TaskID,
+			Data:         data,
+			ExpiresAt:    expiresAt(now, int64(task.TTLSeconds)),
+		}
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(append(taskListFilter(filter), bson.E{Key: "taskid", Value: task.TaskID})).
+			SetReplacement(doc).
+			SetUpsert(true))
+	}
+
+	err := db.transaction(ctx, func(sessionCtx mongo.SessionContext) error {
+		// bumping the revision makes the transaction conflict with the concurrent updates of the range ID
+		result, err := db.collection(cadence.TaskListCollectionName).UpdateOne(sessionCtx,
+			append(taskListFilter(filter), bson.E{Key: "rangeid", Value: tasklistCondition.RangeID}),
+			bson.D{{Key: "$inc", Value: bson.D{{Key: "revision", Value: 1}}}},
+		)
+		if err != nil {
+			return err
+		}
+		if result.MatchedCount == 0 {
+			return &conditionFailed{details: fmt.Sprintf("range_id %v", tasklistCondition.RangeID)}
+		}
+		if len(models) == 0 {
+			return nil
+		}
+		_, err = db.collection(cadence.TaskCollectionName).BulkWrite(sessionCtx, models)
+		return err
+	})
+	var failed *conditionFailed
+	if errors.As(err, &failed) {
+		return db.conflictedTaskList(ctx, tasklistCondition, failed.details)
+	}
+	return err
 }
 
 // SelectTasks return tasks that associated to a tasklist
 func (db *mdb) SelectTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) ([]*nosqlplugin.TaskRow, error) {
-	panic("TODO")
+	opts := options.Find().SetSort(bson.D{{Key: "taskid", Value: 1}})
+	if filter.BatchSize > 0 {
+		opts.SetLimit(int64(filter.BatchSize))
+	}
+	cursor, err := db.collection(cadence.TaskCollectionName).Find(ctx,
+		append(tasksFilter(filter), notExpired(time.Now())), opts)
+	if err != nil {
+		return nil, err
+	}
+	var docs []cadence.TaskCollectionEntry
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	response := make([]*nosqlplugin.TaskRow, 0, len(docs))
+	for _, doc := range docs {
+		var data taskData
+		if err := decodeData(doc.Data, &data); err != nil {
+			return nil, err
+		}
+		task := &nosqlplugin.TaskRow{
+			DomainID:        filter.DomainID,
+			TaskListName:    filter.TaskListName,
+			TaskListType:    filter.TaskListType,
+			TaskID:          doc.TaskID,
+			WorkflowID:      data.WorkflowID,
+			RunID:           data.RunID,
+			ScheduledID:     data.ScheduledID,
+			CreatedTime:     data.CreatedTime,
+			PartitionConfig: data.PartitionConfig,
+			Priority:        data.Priority,
+			FairnessKey:     data.FairnessKey,
+		}
+		if doc.ExpiresAt != nil {
+			task.Expiry = *doc.ExpiresAt
+		}
+		response = append(response, task)
+	}
+	return response, nil
 }
 
+// GetTasksCount returns the number of tasks of a tasklist that are after MinTaskID
 func (db *mdb) GetTasksCount(ctx context.Context, filter *nosqlplugin.TasksFilter) (int64, error) {
-	panic("TODO")
+	return db.collection(cadence.TaskCollectionName).CountDocuments(ctx, append(taskListFilter(&filter.TaskListFilter),
+		bson.E{Key: "taskid", Value: bson.D{{Key: "$gt", Value: filter.MinTaskID}}}))
 }
 
 // DeleteTask delete a batch tasks that taskIDs less than the row
 // If TTL is not implemented, then should also return the number of rows deleted, otherwise persistence.UnknownNumRowsAffected
-// NOTE: This API ignores the `BatchSize` request parameter i.e. either all tasks leq the task_id will be deleted or an error will
-// be returned to the caller, because rowsDeleted is not supported by Cassandra
+// NOTE: unlike Cassandra, BatchSize is respected by deleting up to the BatchSize-th task and the number of deleted rows is returned
 func (db *mdb) RangeDeleteTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) (rowsDeleted int, err error) {
-	panic("TODO")
+	collection := db.collection(cadence.TaskCollectionName)
+	maxTaskID := filter.MaxTaskID
+	if filter.BatchSize > 0 {
+		var last cadence.TaskCollectionEntry
+		err := collection.FindOne(ctx, tasksFilter(filter), options.FindOne().
+			SetSort(bson.D{{Key: "taskid", Value: 1}}).
+			SetSkip(int64(filter.BatchSize-1)).
+			SetProjection(bson.D{{Key: "taskid", Value: 1}}),
+		).Decode(&last)
+		switch {
+		case err == nil:
+			maxTaskID = last.TaskID
+		case !db.IsNotFoundError(err):
+			return 0, err
+		}
+	}
+	result, err := collection.DeleteMany(ctx, tasksFilter(&nosqlplugin.TasksFilter{
+		TaskListFilter: filter.TaskListFilter,
+		MinTaskID:      filter.MinTaskID,
+		MaxTaskID:      maxTaskID,
+	}))
+	if err != nil {
+		return 0, err
+	}
+	return int(result.DeletedCount), nil
+}
+
+func (db *mdb) conflictedTaskList(ctx context.Context, row *nosqlplugin.TaskListRow, details string) error {
+	var doc cadence.TaskListCollectionEntry
+	err := db.collection(cadence.TaskListCollectionName).FindOne(ctx, taskListFilter(taskListFilterOf(row))).Decode(&doc)
+	if err != nil && !db.IsNotFoundError(err) {
+		return err
+	}
+	return &nosqlplugin.TaskOperationConditionFailure{
+		RangeID: doc.RangeID,
+		Details: fmt.Sprintf("range_id=%v, %v", doc.RangeID, details),
+	}
+}
+
+func taskListFilterOf(row *nosqlplugin.TaskListRow) *nosqlplugin.TaskListFilter {
+	return &nosqlplugin.TaskListFilter{
+		DomainID:     row.DomainID,
+		TaskListName: row.TaskListName,
+		TaskListType: row.TaskListType,
+	}
+}
+
+func taskListFilter(filter *nosqlplugin.TaskListFilter) bson.D {
+	return bson.D{
+		{Key: "domainid", Value: filter.DomainID},
+		{Key: "tasklistname", Value: filter.TaskListName},
+		{Key: "tasklisttype", Value: filter.TaskListType},
+	}
+}
+
+// tasksFilter matches the tasks of a tasklist with MinTaskID < task_id <= MaxTaskID
+func tasksFilter(filter *nosqlplugin.TasksFilter) bson.D {
+	return append(taskListFilter(&filter.TaskListFilter), bson.E{Key: "taskid", Value: bson.D{
+		{Key: "$gt", Value: filter.MinTaskID},
+		{Key: "$lte", Value: filter.MaxTaskID},
+	}})
+}
+
+func newTaskListEntry(row *nosqlplugin.TaskListRow, ttlSeconds int64) (*cadence.TaskListCollectionEntry, error) {
+	data, err := encodeData(&taskListData{
+		TaskListKind:            row.TaskListKind,
+		AckLevel:                row.AckLevel,
+		LastUpdatedTime:         row.LastUpdatedTime,
+		AdaptivePartitionConfig: row.AdaptivePartitionConfig,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.TaskListCollectionEntry{
+		DomainID:     row.DomainID,
+		TaskListName: row.TaskListName,
+		TaskListType: row.TaskListType,
+		RangeID:      row.RangeID,
+		Data:         data,
+		ExpiresAt:    expiresAt(time.Now(), ttlSeconds),
+	}, nil
 }
@@ -0,0 +1,210 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+// Documents are laid out following NOTE 2 of the nosqlplugin interfaces: only the keys and the fields used in
+// conditions or queries are stored as document fields, everything else goes into the JSON encoded `data` field.
+// JSON is used instead of embedded documents because MongoDB doesn't accept "." or "$" in the keys of a document,
+// and most of the maps of the persistence layer are keyed by numbers or user provided strings.
+const (
+	fieldID        = "_id"
+	fieldExpiresAt = "expiresat"
+)
+
+type (
+	// sortKey is a field of a sort order, order is 1 for ascending and -1 for descending
+	sortKey struct {
+		field string
+		order int
+	}
+
+	// conditionFailed aborts a transaction when one of its conditions doesn't hold,
+	// the caller reads the latest state to explain the failure after the transaction is aborted
+	conditionFailed struct {
+		kind    writeKind
+		details string
+		// request or execution is set when the condition of the write of a workflow request or of an execution failed
+		request   *nosqlplugin.WorkflowRequestRow
+		execution *nosqlplugin.WorkflowExecutionRequest
+	}
+)
+
+func (e *conditionFailed) Error() string {
+	return fmt.Sprintf("condition failed: %v", e.details)
+}
+
+// encodeData JSON encodes the non-significant columns of a row
+func encodeData(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("encoding document data: %w", err)
+	}
+	return data, nil
+}
+
+func decodeData(data []byte, out interface{}) error {
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("decoding document data: %w", err)
+	}
+	return nil
+}
+
+// expiresAt returns the expiry of a document for the given time to live, or nil if the document should not expire
+func expiresAt(now time.Time, ttlSeconds int64) *time.Time {
+	if ttlSeconds <= 0 {
+		return nil
+	}
+	t := now.Add(time.Duration(ttlSeconds) * time.Second)
+	return &t
+}
+
+// notExpired matches the documents without expiry as well as the ones that are not expired yet,
+// the TTL monitor of MongoDB removes expired documents only periodically
+func notExpired(now time.Time) bson.E {
+	return bson.E{Key: fieldExpiresAt, Value: bson.D{{Key: "$not", Value: bson.D{{Key: "$lte", Value: now}}}}}
+}
+
+// sortDocument converts the sort keys to the document expected by the find options
+func sortDocument(sort []sortKey) bson.D {
+	d := make(bson.D, 0, len(sort))
+	for _, k := range sort {
+		d = append(d, bson.E{Key: k.field, Value: k.order})
+	}
+	return d
+}
+
+// findPage returns up to pageSize documents matching the filter in the given sort order, the sort keys must
+// identify a document uniquely. The page token holds the sort key values of the last returned document,
+// the next page resumes right after it. A page size that is not positive returns every document.
+func findPage(
+	ctx context.Context,
+	collection *mongo.Collection,
+	filter bson.D,
+	sort []sortKey,
+	pageSize int,
+	pageToken []byte,
+) ([]bson.Raw, []byte, error) {
+	if len(pageToken) > 0 {
+		after, err := afterPageToken(sort, pageToken)
+		if err != nil {
+			return nil, nil, err
+		}
+		filter = bson.D{{Key: "$and", Value: bson.A{filter, after}}}
+	}
+
+	opts := options.Find().SetSort(sortDocument(sort))
+	if pageSize > 0 {
+		// one more document tells whether there is a next page
+		opts.SetLimit(int64(pageSize) + 1)
+	}
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []bson.Raw
+	for cursor.Next(ctx) {
+		if pageSize > 0 && len(docs) == pageSize {
+			token, err := newPageToken(sort, docs[len(docs)-1])
+			return docs, token, err
+		}
+		docs = append(docs, append(bson.Raw(nil), cursor.Current...))
+	}
+	return docs, nil, cursor.Err()
+}
+
+func newPageToken(sort []sortKey, last bson.Raw) ([]byte, error) {
+	values := make(bson.D, 0, len(sort))
+	for _, k := range sort {
+		values = append(values, bson.E{Key: k.field, Value: last.Lookup(k.field)})
+	}
+	token, err := bson.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("encoding page token: %w", err)
+	}
+	return token, nil
+}
+
+// afterPageToken builds the filter of the documents that sort after the one the token was built from,
+// e.g. for (a asc, b desc) it is: a > token.a OR (a = token.a AND b < token.b)
+func afterPageToken(sort []sortKey, pageToken []byte) (bson.D, error) {
+	token := bson.Raw(pageToken)
+	if err := token.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid page token: %w", err)
+	}
+	or := make(bson.A, 0, len(sort))
+	for i, k := range sort {
+		value, err := token.LookupErr(k.field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid page token: %w", err)
+		}
+		condition := make(bson.D, 0, i+1)
+		for _, prev := range sort[:i] {
+			condition = append(condition, bson.E{Key: prev.field, Value: token.Lookup(prev.field)})
+		}
+		op := "$gt"
+		if k.order < 0 {
+			op = "$lt"
+		}
+		condition = append(condition, bson.E{Key: k.field, Value: bson.D{{Key: op, Value: value}}})
+		or = append(or, condition)
+	}
+	return bson.D{{Key: "$or", Value: or}}, nil
+}
+
+// transaction runs fn in a multi-document transaction, it requires MongoDB to be deployed as a replica set.
+// fn may run more than once as the transaction is retried on transient errors, e.g. write conflicts with
+// concurrent transactions, so it must not have side effects other than the database operations.
+func (db *mdb) transaction(ctx context.Context, fn func(sessionCtx mongo.SessionContext) error) error {
+	session, err := db.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	opts := options.Transaction().
+		SetReadConcern(readconcern.Snapshot()).
+		SetWriteConcern(writeconcern.New(writeconcern.WMajority()))
+	_, err = session.WithTransaction(ctx, func(sessionCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessionCtx)
+	}, opts)
+	return err
+}
+
+func (db *mdb) collection(name string) *mongo.Collection {
+	return db.dbConn.Collection(name)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestPageToken(t *testing.T) {
+	sort := []sortKey{{field: "a", order: 1}, {field: "b", order: -1}}
+	last, err := bson.Marshal(bson.D{{Key: "_id", Value: "x"}, {Key: "b", Value: "y"}, {Key: "a", Value: int64(3)}})
+	require.NoError(t, err)
+
+	token, err := newPageToken(sort, last)
+	require.NoError(t, err)
+	after, err := afterPageToken(sort, token)
+	require.NoError(t, err)
+
+	expected := bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: "a", Value: bson.D{{Key: "$gt", Value: bson.Raw(token).Lookup("a")}}}},
+		bson.D{
+			{Key: "a", Value: bson.Raw(token).Lookup("a")},
+			{Key: "b", Value: bson.D{{Key: "$lt", Value: bson.Raw(token).Lookup("b")}}},
+		},
+	}}}
+	assert.Equal(t, expected, after)
+	assert.Equal(t, int64(3), bson.Raw(token).Lookup("a").Int64())
+	assert.Equal(t, "y", bson.Raw(token).Lookup("b").StringValue())
+
+	_, err = afterPageToken(sort, []byte("not bson"))
+	assert.Error(t, err)
+	_, err = afterPageToken([]sortKey{{field: "c", order: 1}}, token)
+	assert.Error(t, err)
+}
+
+func TestSortDocument(t *testing.T) {
+	assert.Equal(t, bson.D{{Key: "a", Value: 1}, {Key: "b", Value: -1}},
+		sortDocument([]sortKey{{field: "a", order: 1}, {field: "b", order: -1}}))
+}
+
+func TestExpiresAt(t *testing.T) {
+	now := time.Unix(1000, 0)
+	assert.Nil(t, expiresAt(now, 0))
+	assert.Equal(t, time.Unix(1010, 0), *expiresAt(now, 10))
+}
+
+func TestEncodeDecodeData(t *testing.T) {
+	type row struct {
+		Name  string
+		Value int64
+	}
+	data, err := encodeData(&row{Name: "n", Value: 1})
+	require.NoError(t, err)
+	var decoded row
+	require.NoError(t, decodeData(data, &decoded))
+	assert.Equal(t, row{Name: "n", Value: 1}, decoded)
+	assert.Error(t, decodeData([]byte("{"), &decoded))
+}
@@ -22,43 +22,198 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+// InsertVisibility creates a new visibility record of an open workflow
+// NOTE: search attributes are ignored, same as Cassandra
 func (db *mdb) InsertVisibility(
 	ctx context.Context,
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForInsert,
 ) error {
-	panic("TODO")
+	doc, err := newVisibilityEntry(row.DomainID, &row.VisibilityRow, ttlSeconds, false)
+	if err != nil {
+		return err
+	}
+	// the started record may arrive after the closed one, it must not reopen the workflow
+	filter := append(visibilityFilter(row.DomainID, row.RunID), bson.E{Key: "closetime", Value: bson.D{{Key: "$exists", Value: false}}})
+	_, err = db.collection(cadence.VisibilityCollectionName).ReplaceOne(ctx, filter, doc, options.Replace().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		// the closed record exists
+		return nil
+	}
+	return err
 }
 
+// UpdateVisibility replaces the visibility record of a workflow with the closed one.
+// Open and closed records share a single document so UpdateOpenToClose doesn't need extra work.
 func (db *mdb) UpdateVisibility(
 	ctx context.Context,
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForUpdate,
 ) error {
-	panic("TODO")
+	if row.UpdateCloseToOpen {
+		return fmt.Errorf("not supported operation")
+	}
+	doc, err := newVisibilityEntry(row.DomainID, &row.VisibilityRow, ttlSeconds, true)
+	if err != nil {
+		return err
+	}
+	_, err = db.collection(cadence.VisibilityCollectionName).ReplaceOne(ctx,
+		visibilityFilter(row.DomainID, row.RunID), doc, options.Replace().SetUpsert(true))
+	return err
 }
 
 func (db *mdb) SelectVisibility(
 	ctx context.Context,
 	filter *nosqlplugin.VisibilityFilter,
 ) (*nosqlplugin.SelectVisibilityResponse, error) {
-	panic("TODO")
+	request := &filter.ListRequest
+	sortField := "starttime"
+	if filter.SortType == nosqlplugin.SortByClosedTime {
+		sortField = "closetime"
+	}
+	query := bson.D{
+		{Key: "domainid", Value: request.DomainUUID},
+		{Key: sortField, Value: bson.D{
+			{Key: "$gte", Value: request.EarliestTime.UnixNano()},
+			{Key: "$lte", Value: request.LatestTime.UnixNano()},
+		}},
+		notExpired(time.Now()),
+	}
+
+	switch filter.FilterType {
+	case nosqlplugin.AllOpen, nosqlplugin.OpenByWorkflowType, nosqlplugin.OpenByWorkflowID:
+		if filter.SortType == nosqlplugin.SortByClosedTime {
+			return nil, fmt.Errorf("not supported sorting type")
+		}
+		query = append(query, bson.E{Key: "closetime", Value: bson.D{{Key: "$exists", Value: false}}})
+	case nosqlplugin.AllClosed, nosqlplugin.ClosedByWorkflowType, nosqlplugin.ClosedByWorkflowID, nosqlplugin.ClosedByClosedStatus:
+		if sortField != "closetime" {
+			query = append(query, bson.E{Key: "closetime", Value: bson.D{{Key: "$exists", Value: true}}})
+		}
+	default:
+		return nil, fmt.Errorf("not supported filter type")
+	}
+	switch filter.FilterType {
+	case nosqlplugin.OpenByWorkflowType, nosqlplugin.ClosedByWorkflowType:
+		query = append(query, bson.E{Key: "workflowtypename", Value: filter.WorkflowType})
+	case nosqlplugin.OpenByWorkflowID, nosqlplugin.ClosedByWorkflowID:
+		query = append(query, bson.E{Key: "workflowid", Value: filter.WorkflowID})
+	case nosqlplugin.ClosedByClosedStatus:
+		query = append(query, bson.E{Key: "closestatus", Value: int(filter.CloseStatus)})
+	}
+
+	// latest workflows first
+	docs, nextPageToken, err := findPage(ctx, db.collection(cadence.VisibilityCollectionName), query,
+		[]sortKey{{field: sortField, order: -1}, {field: "runid", order: -1}}, request.PageSize, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &nosqlplugin.SelectVisibilityResponse{
+		Executions:    make([]*nosqlplugin.VisibilityRow, 0, len(docs)),
+		NextPageToken: nextPageToken,
+	}
+	for _, raw := range docs {
+		row, err := newVisibilityRow(raw)
+		if err != nil {
+			return nil, err
+		}
+		response.Executions = append(response.Executions, row)
+	}
+	return response, nil
 }
 
+// DeleteVisibility is a noop as the records are deleted with TTL, but the records are explicitly
+// deleted when an admin command is issued
 func (db *mdb) DeleteVisibility(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) error {
-	panic("TODO")
+	key := persistence.VisibilityAdminDeletionKey("visibilityAdminDelete")
+	if v := ctx.Value(key); v != nil && v.(bool) {
+		_, err := db.collection(cadence.VisibilityCollectionName).DeleteOne(ctx, visibilityFilter(domainID, runID))
+		return err
+	}
+	return nil
 }
 
 func (db *mdb) SelectOneClosedWorkflow(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) (*nosqlplugin.VisibilityRow, error) {
-	panic("TODO")
+	filter := append(visibilityFilter(domainID, runID),
+		bson.E{Key: "workflowid", Value: workflowID},
+		bson.E{Key: "closetime", Value: bson.D{{Key: "$exists", Value: true}}},
+	)
+	raw, err := db.collection(cadence.VisibilityCollectionName).FindOne(ctx, filter).DecodeBytes()
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			// Special case: return nil,nil if not found(since we will deprecate it, it's not worth refactor to be consistent)
+			return nil, nil
+		}
+		return nil, err
+	}
+	return newVisibilityRow(raw)
+}
+
+func visibilityFilter(domainID, runID string) bson.D {
+	return bson.D{{Key: "domainid", Value: domainID}, {Key: "runid", Value: runID}}
+}
+
+func newVisibilityEntry(domainID string, row *nosqlplugin.VisibilityRow, ttlSeconds int64, closed bool) (*cadence.VisibilityCollectionEntry, error) {
+	record := *row
+	record.DomainID = domainID
+	record.SearchAttributes = nil
+	if !closed {
+		record.CloseTime = time.Time{}
+		record.Status = nil
+		record.HistoryLength = 0
+	}
+	data, err := encodeData(&record)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &cadence.VisibilityCollectionEntry{
+		DomainID:         domainID,
+		RunID:            row.RunID,
+		WorkflowID:       row.WorkflowID,
+		WorkflowTypeName: row.TypeName,
+		StartTime:        row.StartTime.UnixNano(),
+		Data:             data,
+		ExpiresAt:        expiresAt(time.Now(), ttlSeconds),
+	}
+	if closed {
+		closeTime := row.CloseTime.UnixNano()
+		doc.CloseTime = &closeTime
+		if row.Status != nil {
+			status := int(*row.Status)
+			doc.CloseStatus = &status
+		}
+	}
+	return doc, nil
+}
+
+func newVisibilityRow(raw bson.Raw) (*nosqlplugin.VisibilityRow, error) {
+	var doc cadence.VisibilityCollectionEntry
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	row := &nosqlplugin.VisibilityRow{}
+	if err := decodeData(doc.Data, row); err != nil {
+		return nil, err
+	}
+	return row, nil
 }
@@ -23,14 +23,28 @@ package mongodb
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
 var _ nosqlplugin.WorkflowCRUD = (*mdb)(nil)
 
+var (
+	currentWorkflowSort = []sortKey{{field: "domainid", order: 1}, {field: "workflowid", order: 1}}
+	executionSort       = []sortKey{{field: "domainid", order: 1}, {field: "workflowid", order: 1}, {field: "runid", order: 1}}
+	taskIDSort          = []sortKey{{field: "taskid", order: 1}}
+	timerTaskSort       = []sortKey{{field: "visibilitytimestamp", order: 1}, {field: "taskid", order: 1}}
+)
+
 func (db *mdb) InsertWorkflowExecutionWithTasks(
 	ctx context.Context,
 	requests *nosqlplugin.WorkflowRequestsWriteRequest,
@@ -40,7 +54,35 @@ func (db *mdb) InsertWorkflowExecutionWithTasks(
 	activeClusterSelectionPolicyRow *nosqlplugin.ActiveClusterSelectionPolicyRow,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	domainID := execution.DomainID
+	workflowID := execution.WorkflowID
+
+	t := newWorkflowTransaction(shardCondition)
+	if err := t.addActiveClusterSelectionPolicy(activeClusterSelectionPolicyRow); err != nil {
+		return err
+	}
+	if err := t.addWorkflowRequests(requests, execution.CurrentTimeStamp); err != nil {
+		return err
+	}
+	if err := t.addCurrentWorkflow(shardID, domainID, workflowID, currentWorkflowRequest); err != nil {
+		return err
+	}
+	if err := t.addCreatedExecution(execution); err != nil {
+		return err
+	}
+	if err := t.addTasks(shardID, domainID, workflowID, tasksByCategory); err != nil {
+		return err
+	}
+
+	err := db.transaction(ctx, func(sessionCtx mongo.SessionContext) error {
+		return t.execute(sessionCtx, db)
+	})
+	var failed *conditionFailed
+	if errors.As(err, &failed) {
+		return db.createWorkflowConditionFailure(ctx, failed, currentWorkflowRequest, execution, shardCondition)
+	}
+	return err
 }
 
 func (db *mdb) UpdateWorkflowExecutionWithTasks(
@@ -54,105 +96,357 @@ func (db *mdb) UpdateWorkflowExecutionWithTasks(
 	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	var domainID, workflowID string
+	var previousNextEventIDCondition int64
+	var timeStamp time.Time
+	if mutatedExecution != nil {
+		domainID = mutatedExecution.DomainID
+		workflowID = mutatedExecution.WorkflowID
+		previousNextEventIDCondition = *mutatedExecution.PreviousNextEventIDCondition
+		timeStamp = mutatedExecution.CurrentTimeStamp
+	} else if resetExecution != nil {
+		domainID = resetExecution.DomainID
+		workflowID = resetExecution.WorkflowID
+		previousNextEventIDCondition = *resetExecution.PreviousNextEventIDCondition
+		timeStamp = resetExecution.CurrentTimeStamp
+	} else {
+		return fmt.Errorf("at least one of mutatedExecution and resetExecution should be provided")
+	}
+
+	t := newWorkflowTransaction(shardCondition)
+	if err := t.addWorkflowRequests(requests, timeStamp); err != nil {
+		return err
+	}
+	if err := t.addCurrentWorkflow(shardID, domainID, workflowID, currentWorkflowRequest); err != nil {
+		return err
+	}
+	if mutatedExecution != nil {
+		if err := t.addMutatedExecution(mutatedExecution); err != nil {
+			return err
+		}
+	}
+	if insertedExecution != nil {
+		if err := t.addCreatedExecution(insertedExecution); err != nil {
+			return err
+		}
+		if err := t.addActiveClusterSelectionPolicy(activeClusterSelectionPolicyRow); err != nil {
+			return err
+		}
+	}
+	if resetExecution != nil {
+		if err := t.addResetExecution(resetExecution); err != nil {
+			return err
+		}
+	}
+	if err := t.addTasks(shardID, domainID, workflowID, tasksByCategory); err != nil {
+		return err
+	}
+
+	err := db.transaction(ctx, func(sessionCtx mongo.SessionContext) error {
+		return t.execute(sessionCtx, db)
+	})
+	var failed *conditionFailed
+	if errors.As(err, &failed) {
+		return db.updateWorkflowConditionFailure(ctx, failed, currentWorkflowRequest, previousNextEventIDCondition, shardCondition)
+	}
+	return err
 }
 
 func (db *mdb) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*nosqlplugin.CurrentWorkflowRow, error) {
-	panic("TODO")
+	var doc cadence.CurrentWorkflowCollectionEntry
+	err := db.collection(cadence.CurrentWorkflowCollectionName).FindOne(ctx, currentWorkflowFilter(shardID, domainID, workflowID)).Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+	row := &nosqlplugin.CurrentWorkflowRow{}
+	if err := decodeData(doc.Data, row); err != nil {
+		return nil, err
+	}
+	row.ShardID = shardID
+	row.DomainID = domainID
+	row.WorkflowID = workflowID
+	return row, nil
 }
 
-func (db *mdb) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*nosqlplugin.WorkflowExecution, error) {
-	panic("TODO")
+func (db *mdb) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.CurrentWorkflowExecution, []byte, error) {
+	docs, nextPageToken, err := findPage(ctx, db.collection(cadence.CurrentWorkflowCollectionName),
+		bson.D{{Key: "shardid", Value: shardID}}, currentWorkflowSort, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.CurrentWorkflowExecution, 0, len(docs))
+	for _, raw := range docs {
+		var doc cadence.CurrentWorkflowCollectionEntry
+		if err := bson.Unmarshal(raw, &doc); err != nil {
+			return nil, nil, err
+		}
+		executions = append(executions, &persistence.CurrentWorkflowExecution{
+			DomainID:     doc.DomainID,
+			WorkflowID:   doc.WorkflowID,
+			RunID:        doc.RunID,
+			State:        doc.State,
+			CurrentRunID: doc.RunID,
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *mdb) DeleteCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID, currentRunIDCondition string) error {
-	panic("TODO")
+	// same as Cassandra, nothing is deleted when the current workflow has moved on to another run
+	_, err := db.collection(cadence.CurrentWorkflowCollectionName).DeleteOne(ctx,
+		append(currentWorkflowFilter(shardID, domainID, workflowID), bson.E{Key: "runid", Value: currentRunIDCondition}))
+	return err
 }
 
-func (db *mdb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
-	panic("TODO")
-}
-
-func (db *mdb) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.CurrentWorkflowExecution, []byte, error) {
-	panic("TODO")
+func (db *mdb) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*nosqlplugin.WorkflowExecution, error) {
+	var doc cadence.WorkflowExecutionCollectionEntry
+	err := db.collection(cadence.WorkflowExecutionCollectionName).FindOne(ctx, executionFilter(shardID, domainID, workflowID, runID)).Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+	data := &executionData{}
+	if err := decodeData(doc.Data, data); err != nil {
+		return nil, err
+	}
+	return data.toMutableState(), nil
 }
 
 func (db *mdb) SelectAllWorkflowExecutions(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.InternalListConcreteExecutionsEntity, []byte, error) {
-	panic("TODO")
+	docs, nextPageToken, err := findPage(ctx, db.collection(cadence.WorkflowExecutionCollectionName),
+		bson.D{{Key: "shardid", Value: shardID}}, executionSort, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.InternalListConcreteExecutionsEntity, 0, len(docs))
+	for _, raw := range docs {
+		var doc cadence.WorkflowExecutionCollectionEntry
+		if err := bson.Unmarshal(raw, &doc); err != nil {
+			return nil, nil, err
+		}
+		data := &executionData{}
+		if err := decodeData(doc.Data, data); err != nil {
+			return nil, nil, err
+		}
+		executions = append(executions, &persistence.InternalListConcreteExecutionsEntity{
+			ExecutionInfo:    data.ExecutionInfo,
+			VersionHistories: data.VersionHistories,
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *mdb) IsWorkflowExecutionExists(ctx context.Context, shardID int, domainID, workflowID, runID string) (bool, error) {
-	panic("TODO")
+	count, err := db.collection(cadence.WorkflowExecutionCollectionName).CountDocuments(ctx, executionFilter(shardID, domainID, workflowID, runID))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (db *mdb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
+	_, err := db.collection(cadence.WorkflowExecutionCollectionName).DeleteOne(ctx, executionFilter(shardID, domainID, workflowID, runID))
+	return err
 }
 
 func (db *mdb) SelectTransferTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	return db.selectHistoryTasks(ctx, cadence.TransferTaskCollectionName, taskIDRangeFilter(shardID, inclusiveMinTaskID, exclusiveMaxTaskID), pageSize, pageToken)
 }
 
 func (db *mdb) DeleteTransferTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.TransferTaskCollectionName).DeleteOne(ctx, taskIDFilter(shardID, taskID))
+	return err
 }
 
 func (db *mdb) RangeDeleteTransferTasks(ctx context.Context, shardID int, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.TransferTaskCollectionName).DeleteMany(ctx, taskIDRangeFilter(shardID, inclusiveBeginTaskID, exclusiveEndTaskID))
+	return err
 }
 
 func (db *mdb) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	return db.selectHistoryTasks(ctx, cadence.TimerTaskCollectionName, timerRangeFilter(shardID, inclusiveMinTime, exclusiveMaxTime), pageSize, pageToken)
 }
 
 func (db *mdb) DeleteTimerTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
-	panic("TODO")
+	_, err := db.collection(cadence.TimerTaskCollectionName).DeleteOne(ctx, timerTaskFilter(shardID, visibilityTimestamp, taskID))
+	return err
 }
 
 func (db *mdb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
-	panic("TODO")
+	_, err := db.collection(cadence.TimerTaskCollectionName).DeleteMany(ctx, timerRangeFilter(shardID, inclusiveMinTime, exclusiveMaxTime))
+	return err
 }
 
 func (db *mdb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	return db.selectHistoryTasks(ctx, cadence.ReplicationTaskCollectionName, taskIDRangeFilter(shardID, inclusiveMinTaskID, exclusiveMaxTaskID), pageSize, pageToken)
 }
 
 func (db *mdb) DeleteReplicationTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.ReplicationTaskCollectionName).DeleteOne(ctx, taskIDFilter(shardID, taskID))
+	return err
 }
 
 func (db *mdb) RangeDeleteReplicationTasks(ctx context.Context, shardID int, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.ReplicationTaskCollectionName).DeleteMany(ctx, bson.D{
+		{Key: "shardid", Value: shardID},
+		{Key: "taskid", Value: bson.D{{Key: "$lt", Value: exclusiveEndTaskID}}},
+	})
+	return err
 }
 
-func (db *mdb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.HistoryMigrationTask, condition nosqlplugin.ShardCondition) error {
-	panic("TODO")
+func (db *mdb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.HistoryMigrationTask, shardCondition nosqlplugin.ShardCondition) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	shardID := shardCondition.ShardID
+	models := make([]mongo.WriteModel, 0, len(tasks))
+	for _, task := range tasks {
+		model, err := newReplicationTaskModel(shardID, task.Replication.DomainID, task.Replication.WorkflowID, task)
+		if err != nil {
+			return err
+		}
+		models = append(models, model)
+	}
+
+	err := db.transaction(ctx, func(sessionCtx mongo.SessionContext) error {
+		if err := db.lockShard(sessionCtx, shardID, shardCondition.RangeID); err != nil {
+			return err
+		}
+		_, err := db.collection(cadence.ReplicationTaskCollectionName).BulkWrite(sessionCtx, models)
+		return err
+	})
+	var failed *conditionFailed
+	if !errors.As(err, &failed) {
+		return err
+	}
+	rangeID, err := db.selectShardRangeID(ctx, shardID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return err
+	}
+	if rangeID != shardCondition.RangeID {
+		return &nosqlplugin.ShardOperationConditionFailure{
+			RangeID: rangeID,
+		}
+	}
+	// It's much safer to return ShardOperationConditionFailure(which will become ShardOwnershipLostError later) as the default to force the application to reload
+	// shard to recover from such errors
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: -1,
+		Details: failed.Error(),
+	}
 }
 
+// DeleteCrossClusterTask is a no-op, cross cluster tasks are never written by this plugin
 func (db *mdb) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
-	panic("TODO")
+	return nil
 }
 
 func (db *mdb) InsertReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task *nosqlplugin.HistoryMigrationTask) error {
-	panic("TODO")
+	replication := *task.Replication
+	data, err := encodeData(&nosqlplugin.HistoryMigrationTask{
+		Replication: &replication,
+		Task:        task.Task,
+		TaskID:      replication.TaskID,
+	})
+	if err != nil {
+		return err
+	}
+	doc := &cadence.ReplicationDLQTaskCollectionEntry{
+		ShardID:       shardID,
+		SourceCluster: sourceCluster,
+		TaskID:        replication.TaskID,
+		Data:          data,
+	}
+	_, err = db.collection(cadence.ReplicationDLQTaskCollectionName).ReplaceOne(ctx,
+		replicationDLQTaskFilter(shardID, sourceCluster, replication.TaskID), doc, options.Replace().SetUpsert(true))
+	return err
 }
 
 func (db *mdb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	return db.selectHistoryTasks(ctx, cadence.ReplicationDLQTaskCollectionName,
+		replicationDLQRangeFilter(shardID, sourceCluster, inclusiveMinTaskID, exclusiveMaxTaskID), pageSize, pageToken)
 }
 
 func (db *mdb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
-	panic("TODO")
+	return db.collection(cadence.ReplicationDLQTaskCollectionName).CountDocuments(ctx, bson.D{
+		{Key: "shardid", Value: shardID},
+		{Key: "sourcecluster", Value: sourceCluster},
+	})
 }
 
 func (db *mdb) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.ReplicationDLQTaskCollectionName).DeleteOne(ctx, replicationDLQTaskFilter(shardID, sourceCluster, taskID))
+	return err
 }
 
 func (db *mdb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	_, err := db.collection(cadence.ReplicationDLQTaskCollectionName).DeleteMany(ctx,
+		replicationDLQRangeFilter(shardID, sourceCluster, inclusiveBeginTaskID, exclusiveEndTaskID))
+	return err
 }
 
 func (db *mdb) SelectActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, wfID, rID string) (*nosqlplugin.ActiveClusterSelectionPolicyRow, error) {
-	panic("TODO")
+	var doc cadence.ActiveClusterSelectionPolicyCollectionEntry
+	err := db.collection(cadence.ActiveClusterSelectionPolicyCollectionName).FindOne(ctx, executionFilter(shardID, domainID, wfID, rID)).Decode(&doc)
+	if db.IsNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	policy := &persistence.DataBlob{}
+	if err := decodeData(doc.Data, policy); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.ActiveClusterSelectionPolicyRow{
+		ShardID:    shardID,
+		DomainID:   domainID,
+		WorkflowID: wfID,
+		RunID:      rID,
+		Policy:     policy,
+	}, nil
+}
+
+func (db *mdb) DeleteActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
+	_, err := db.collection(cadence.ActiveClusterSelectionPolicyCollectionName).DeleteOne(ctx, executionFilter(shardID, domainID, workflowID, runID))
+	return err
+}
+
+func (db *mdb) selectHistoryTasks(ctx context.Context, collection string, filter bson.D, pageSize int, pageToken []byte) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
+	sort := taskIDSort
+	if collection == cadence.TimerTaskCollectionName {
+		sort = timerTaskSort
+	}
+	docs, nextPageToken, err := findPage(ctx, db.collection(collection), filter, sort, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks, err := parseHistoryTasks(docs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, nextPageToken, nil
+}
+
+func taskIDRangeFilter(shardID int, inclusiveMinTaskID, exclusiveMaxTaskID int64) bson.D {
+	return bson.D{
+		{Key: "shardid", Value: shardID},
+		{Key: "taskid", Value: bson.D{{Key: "$gte", Value: inclusiveMinTaskID}, {Key: "$lt", Value: exclusiveMaxTaskID}}},
+	}
+}
+
+func timerRangeFilter(shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) bson.D {
+	return bson.D{
+		{Key: "shardid", Value: shardID},
+		{Key: "visibilitytimestamp", Value: bson.D{{Key: "$gte", Value: inclusiveMinTime}, {Key: "$lt", Value: exclusiveMaxTime}}},
+	}
 }
 
-func (db *mdb) DeleteActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, wfID, rID string) error {
-	panic("TODO")
+func replicationDLQRangeFilter(shardID int, sourceCluster string, inclusiveMinTaskID, exclusiveMaxTaskID int64) bson.D {
+	return bson.D{
+		{Key: "shardid", Value: shardID},
+		{Key: "sourcecluster", Value: sourceCluster},
+		{Key: "taskid", Value: bson.D{{Key: "$gte", Value: inclusiveMinTaskID}, {Key: "$lt", Value: exclusiveMaxTaskID}}},
+	}
 }
@@ -0,0 +1,832 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+const (
+	workflowRequestTTLInSeconds = 10800
+)
+
+type (
+	// executionData is the JSON encoded data of a workflow_execution document. The maps and the buffered events
+	// are part of the document so that the mutable state is read and written atomically.
+	executionData struct {
+		ExecutionInfo       *persistence.InternalWorkflowExecutionInfo
+		VersionHistories    *persistence.DataBlob
+		Checksum            checksum.Checksum
+		ActivityInfos       map[int64]*persistence.InternalActivityInfo
+		TimerInfos          map[string]*persistence.TimerInfo
+		ChildExecutionInfos map[int64]*persistence.InternalChildExecutionInfo
+		RequestCancelInfos  map[int64]*persistence.RequestCancelInfo
+		SignalInfos         map[int64]*persistence.SignalInfo
+		SignalRequestedIDs  []string
+		BufferedEvents      []*persistence.DataBlob
+	}
+
+	// writeKind tells which write of a transaction failed its condition, it's used to explain the failure
+	writeKind int
+
+	// workflowTransaction holds the writes of a workflow create/update. Everything that doesn't depend on the
+	// stored documents is validated and encoded before the transaction starts, since the transaction may be retried.
+	workflowTransaction struct {
+		shardCondition *nosqlplugin.ShardCondition
+
+		requests         []*nosqlplugin.WorkflowRequestRow
+		requestWriteMode nosqlplugin.WorkflowRequestWriteMode
+		requestsExpireAt *time.Time
+
+		currentWorkflow    *cadence.CurrentWorkflowCollectionEntry
+		currentWorkflowReq *nosqlplugin.CurrentWorkflowWriteRequest
+
+		// mutatedExecution is merged on top of the stored document inside the transaction
+		mutatedExecution  *nosqlplugin.WorkflowExecutionRequest
+		createdExecutions []*nosqlplugin.WorkflowExecutionRequest
+		resetExecution    *nosqlplugin.WorkflowExecutionRequest
+
+		tasks                        map[string][]mongo.WriteModel
+		activeClusterSelectionPolicy *cadence.ActiveClusterSelectionPolicyCollectionEntry
+	}
+)
+
+const (
+	writeKindOther writeKind = iota
+	writeKindShard
+	writeKindRequest
+	writeKindCurrentWorkflow
+	writeKindExecution
+)
+
+func newWorkflowTransaction(shardCondition *nosqlplugin.ShardCondition) *workflowTransaction {
+	return &workflowTransaction{
+		shardCondition: shardCondition,
+		tasks:          make(map[string][]mongo.WriteModel),
+	}
+}
+
+func (t *workflowTransaction) addWorkflowRequests(requests *nosqlplugin.WorkflowRequestsWriteRequest, now time.Time) error {
+	if requests == nil {
+		return nil
+	}
+	switch requests.WriteMode {
+	case nosqlplugin.WorkflowRequestWriteModeInsert, nosqlplugin.WorkflowRequestWriteModeUpsert:
+	default:
+		return fmt.Errorf("unknown workflow request write mode %v", requests.WriteMode)
+	}
+	t.requests = requests.Rows
+	t.requestWriteMode = requests.WriteMode
+	t.requestsExpireAt = expiresAt(now, workflowRequestTTLInSeconds)
+	return nil
+}
+
+func (t *workflowTransaction) addCurrentWorkflow(
+	shardID int,
+	domainID string,
+	workflowID string,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+) error {
+	switch request.WriteMode {
+	case nosqlplugin.CurrentWorkflowWriteModeNoop:
+		return nil
+	case nosqlplugin.CurrentWorkflowWriteModeInsert:
+	case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+		if request.Condition == nil || request.Condition.GetCurrentRunID() == "" {
+			return fmt.Errorf("CurrentWorkflowWriteModeUpdate require Condition.CurrentRunID")
+		}
+	default:
+		return fmt.Errorf("unknown mode %v", request.WriteMode)
+	}
+
+	row := request.Row
+	row.ShardID = shardID
+	row.DomainID = domainID
+	row.WorkflowID = workflowID
+	data, err := encodeData(&row)
+	if err != nil {
+		return err
+	}
+	t.currentWorkflow = &cadence.CurrentWorkflowCollectionEntry{
+		ShardID:          shardID,
+		DomainID:         domainID,
+		WorkflowID:       workflowID,
+		RunID:            row.RunID,
+		State:            row.State,
+		LastWriteVersion: row.LastWriteVersion,
+		Data:             data,
+	}
+	t.currentWorkflowReq = request
+	return nil
+}
+
+// addCreatedExecution writes a brand new execution, used for both the created and the inserted executions
+func (t *workflowTransaction) addCreatedExecution(execution *nosqlplugin.WorkflowExecutionRequest) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeNone {
+		return fmt.Errorf("should only support EventBufferWriteModeNone")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeCreate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeCreate")
+	}
+	t.createdExecutions = append(t.createdExecutions, execution)
+	return nil
+}
+
+// addResetExecution overrides the whole execution including the maps, the event buffer is cleared
+func (t *workflowTransaction) addResetExecution(execution *nosqlplugin.WorkflowExecutionRequest) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeClear {
+		return fmt.Errorf("should only support EventBufferWriteModeClear")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeReset {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeReset")
+	}
+	t.resetExecution = execution
+	return nil
+}
+
+// addMutatedExecution merges the mutation into the stored execution
+func (t *workflowTransaction) addMutatedExecution(execution *nosqlplugin.WorkflowExecutionRequest) error {
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeUpdate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeUpdate")
+	}
+	t.mutatedExecution = execution
+	return nil
+}
+
+func (t *workflowTransaction) addActiveClusterSelectionPolicy(row *nosqlplugin.ActiveClusterSelectionPolicyRow) error {
+	if row == nil {
+		return nil
+	}
+	data, err := encodeData(row.Policy)
+	if err != nil {
+		return err
+	}
+	t.activeClusterSelectionPolicy = &cadence.ActiveClusterSelectionPolicyCollectionEntry{
+		ShardID:    row.ShardID,
+		DomainID:   row.DomainID,
+		WorkflowID: row.WorkflowID,
+		RunID:      row.RunID,
+		Data:       data,
+	}
+	return nil
+}
+
+func (t *workflowTransaction) addTasks(
+	shardID int,
+	domainID string,
+	workflowID string,
+	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
+) error {
+	for c, tasks := range tasksByCategory {
+		for _, task := range tasks {
+			var (
+				collection string
+				model      mongo.WriteModel
+				err        error
+			)
+			switch c.ID() {
+			case persistence.HistoryTaskCategoryIDTransfer:
+				collection = cadence.TransferTaskCollectionName
+				model, err = newTransferTaskModel(shardID, domainID, workflowID, task)
+			case persistence.HistoryTaskCategoryIDTimer:
+				collection = cadence.TimerTaskCollectionName
+				model, err = newTimerTaskModel(shardID, domainID, workflowID, task)
+			case persistence.HistoryTaskCategoryIDReplication:
+				collection = cadence.ReplicationTaskCollectionName
+				model, err = newReplicationTaskModel(shardID, domainID, workflowID, task)
+			default:
+				return fmt.Errorf("history task category %v is not supported by the mongodb plugin", c.Name())
+			}
+			if err != nil {
+				return err
+			}
+			t.tasks[collection] = append(t.tasks[collection], model)
+		}
+	}
+	return nil
+}
+
+// execute applies the writes in the same precedence as the conditions of the Cassandra implementation are
+// checked: shard, workflow requests, current workflow and then the executions. The first failed condition
+// aborts the transaction with a *conditionFailed.
+func (t *workflowTransaction) execute(sessionCtx mongo.SessionContext, db *mdb) error {
+	shardID := t.shardCondition.ShardID
+	if err := db.lockShard(sessionCtx, shardID, t.shardCondition.RangeID); err != nil {
+		return err
+	}
+	for _, row := range t.requests {
+		if err := db.writeWorkflowRequest(sessionCtx, row, t.requestWriteMode, t.requestsExpireAt); err != nil {
+			return err
+		}
+	}
+	if t.currentWorkflow != nil {
+		if err := db.writeCurrentWorkflow(sessionCtx, t.currentWorkflow, t.currentWorkflowReq); err != nil {
+			return err
+		}
+	}
+	if t.mutatedExecution != nil {
+		if err := db.mutateExecution(sessionCtx, shardID, t.mutatedExecution); err != nil {
+			return err
+		}
+	}
+	for _, execution := range t.createdExecutions {
+		if err := db.createExecution(sessionCtx, shardID, execution); err != nil {
+			return err
+		}
+	}
+	if t.resetExecution != nil {
+		if err := db.resetExecution(sessionCtx, shardID, t.resetExecution); err != nil {
+			return err
+		}
+	}
+	for collection, models := range t.tasks {
+		if _, err := db.collection(collection).BulkWrite(sessionCtx, models); err != nil {
+			return err
+		}
+	}
+	if t.activeClusterSelectionPolicy != nil {
+		policy := t.activeClusterSelectionPolicy
+		_, err := db.collection(cadence.ActiveClusterSelectionPolicyCollectionName).ReplaceOne(sessionCtx,
+			executionFilter(policy.ShardID, policy.DomainID, policy.WorkflowID, policy.RunID), policy,
+			options.Replace().SetUpsert(true))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *mdb) writeWorkflowRequest(
+	sessionCtx mongo.SessionContext,
+	row *nosqlplugin.WorkflowRequestRow,
+	writeMode nosqlplugin.WorkflowRequestWriteMode,
+	expiry *time.Time,
+) error {
+	doc := &cadence.WorkflowRequestCollectionEntry{
+		ShardID:     row.ShardID,
+		DomainID:    row.DomainID,
+		WorkflowID:  row.WorkflowID,
+		RequestType: int(row.RequestType),
+		RequestID:   row.RequestID,
+		RunID:       row.RunID,
+		Version:     row.Version,
+		ExpiresAt:   expiry,
+	}
+	filter := workflowRequestFilter(row)
+	if writeMode == nosqlplugin.WorkflowRequestWriteModeInsert {
+		// only an expired request that isn't removed by MongoDB yet can be replaced,
+		// otherwise the upsert fails on the unique index
+		filter = append(filter, bson.E{Key: fieldExpiresAt, Value: bson.D{{Key: "$lte", Value: time.Now()}}})
+	}
+	_, err := db.collection(cadence.WorkflowRequestCollectionName).ReplaceOne(sessionCtx, filter, doc, options.Replace().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		return &conditionFailed{kind: writeKindRequest, request: row, details: "duplicate request"}
+	}
+	return err
+}
+
+func (db *mdb) writeCurrentWorkflow(
+	sessionCtx mongo.SessionContext,
+	doc *cadence.CurrentWorkflowCollectionEntry,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+) error {
+	collection := db.collection(cadence.CurrentWorkflowCollectionName)
+	if request.WriteMode == nosqlplugin.CurrentWorkflowWriteModeInsert {
+		_, err := collection.InsertOne(sessionCtx, doc)
+		if mongo.IsDuplicateKeyError(err) {
+			return &conditionFailed{kind: writeKindCurrentWorkflow, details: "current workflow exists"}
+		}
+		return err
+	}
+
+	condition := request.Condition
+	filter := append(currentWorkflowFilter(doc.ShardID, doc.DomainID, doc.WorkflowID), bson.E{Key: "runid", Value: *condition.CurrentRunID})
+	if condition.LastWriteVersion != nil && condition.State != nil {
+		filter = append(filter,
+			bson.E{Key: "lastwriteversion", Value: *condition.LastWriteVersion},
+			bson.E{Key: "state", Value: *condition.State},
+		)
+	}
+	result, err := collection.ReplaceOne(sessionCtx, filter, doc)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return &conditionFailed{kind: writeKindCurrentWorkflow, details: "current workflow condition"}
+	}
+	return nil
+}
+
+func (db *mdb) createExecution(sessionCtx mongo.SessionContext, shardID int, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	doc, err := newExecutionEntry(shardID, execution, newExecutionData(execution))
+	if err != nil {
+		return err
+	}
+	_, err = db.collection(cadence.WorkflowExecutionCollectionName).InsertOne(sessionCtx, doc)
+	if mongo.IsDuplicateKeyError(err) {
+		return &conditionFailed{kind: writeKindExecution, execution: execution, details: "execution exists"}
+	}
+	return err
+}
+
+func (db *mdb) resetExecution(sessionCtx mongo.SessionContext, shardID int, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	doc, err := newExecutionEntry(shardID, execution, newExecutionData(execution))
+	if err != nil {
+		return err
+	}
+	return db.replaceExecution(sessionCtx, doc, execution)
+}
+
+// mutateExecution reads the stored execution and writes it back with the mutation merged in, the
+// transaction makes sure that the document is not changed by another write in between
+func (db *mdb) mutateExecution(sessionCtx mongo.SessionContext, shardID int, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	var stored cadence.WorkflowExecutionCollectionEntry
+	err := db.collection(cadence.WorkflowExecutionCollectionName).FindOne(sessionCtx,
+		append(executionFilter(shardID, execution.DomainID, execution.WorkflowID, execution.RunID),
+			bson.E{Key: "nexteventid", Value: *execution.PreviousNextEventIDCondition}),
+	).Decode(&stored)
+	if db.IsNotFoundError(err) {
+		return &conditionFailed{kind: writeKindExecution, execution: execution, details: "next_event_id condition"}
+	}
+	if err != nil {
+		return err
+	}
+	data := &executionData{}
+	if err := decodeData(stored.Data, data); err != nil {
+		return err
+	}
+	data.merge(execution)
+
+	doc, err := newExecutionEntry(shardID, execution, data)
+	if err != nil {
+		return err
+	}
+	return db.replaceExecution(sessionCtx, doc, execution)
+}
+
+func (db *mdb) replaceExecution(
+	sessionCtx mongo.SessionContext,
+	doc *cadence.WorkflowExecutionCollectionEntry,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) error {
+	result, err := db.collection(cadence.WorkflowExecutionCollectionName).ReplaceOne(sessionCtx,
+		append(executionFilter(doc.ShardID, doc.DomainID, doc.WorkflowID, doc.RunID),
+			bson.E{Key: "nexteventid", Value: *execution.PreviousNextEventIDCondition}),
+		doc,
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return &conditionFailed{kind: writeKindExecution, execution: execution, details: "next_event_id condition"}
+	}
+	return nil
+}
+
+// createWorkflowConditionFailure explains why the transaction of InsertWorkflowExecutionWithTasks was aborted
+func (db *mdb) createWorkflowConditionFailure(
+	ctx context.Context,
+	failed *conditionFailed,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	switch failed.kind {
+	case writeKindShard:
+		return db.shardConditionFailure(ctx, shardCondition)
+	case writeKindRequest:
+		return db.duplicateRequestFailure(ctx, failed.request)
+	case writeKindCurrentWorkflow:
+		current, err := db.SelectCurrentWorkflow(ctx, shardCondition.ShardID, execution.DomainID, execution.WorkflowID)
+		if err != nil && !db.IsNotFoundError(err) {
+			return err
+		}
+		workflowID := currentWorkflowRequest.Row.WorkflowID
+		if currentWorkflowRequest.WriteMode == nosqlplugin.CurrentWorkflowWriteModeInsert {
+			if current == nil {
+				msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v", workflowID)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					CurrentWorkflowConditionFailInfo: &msg,
+				}
+			}
+			msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v", workflowID, current.RunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+					OtherInfo:        msg,
+					CreateRequestID:  current.CreateRequestID,
+					RunID:            current.RunID,
+					State:            current.State,
+					CloseStatus:      current.CloseStatus,
+					LastWriteVersion: current.LastWriteVersion,
+				},
+			}
+		}
+
+		condition := currentWorkflowRequest.Condition
+		var msg string
+		switch {
+		case current == nil || current.RunID != condition.GetCurrentRunID():
+			var actualRunID string
+			if current != nil {
+				actualRunID = current.RunID
+			}
+			msg = fmt.Sprintf("Workflow execution creation condition failed by mismatch runID. WorkflowId: %v, Expected Current RunID: %v, Actual Current RunID: %v",
+				workflowID, condition.GetCurrentRunID(), actualRunID)
+		case condition.LastWriteVersion != nil && current.LastWriteVersion != *condition.LastWriteVersion:
+			msg = fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, Expected Version: %v, Actual Version: %v",
+				workflowID, *condition.LastWriteVersion, current.LastWriteVersion)
+		case condition.State != nil && current.State != *condition.State:
+			msg = fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, Expected State: %v, Actual State: %v",
+				workflowID, *condition.State, current.State)
+		}
+		if msg != "" {
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				CurrentWorkflowConditionFailInfo: &msg,
+			}
+		}
+	case writeKindExecution:
+		lastWriteVersion := execution.LastWriteVersion
+		var stored cadence.WorkflowExecutionCollectionEntry
+		err := db.collection(cadence.WorkflowExecutionCollectionName).FindOne(ctx,
+			executionFilter(shardCondition.ShardID, execution.DomainID, execution.WorkflowID, execution.RunID),
+		).Decode(&stored)
+		switch {
+		case err == nil:
+			lastWriteVersion = stored.LastWriteVersion
+		case !db.IsNotFoundError(err):
+			return err
+		}
+		msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v", execution.WorkflowID, execution.RunID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+				OtherInfo:        msg,
+				CreateRequestID:  execution.CreateRequestID,
+				RunID:            execution.RunID,
+				State:            execution.State,
+				CloseStatus:      execution.CloseStatus,
+				LastWriteVersion: lastWriteVersion,
+			},
+		}
+	}
+
+	msg := fmt.Sprintf("Failed to operate on workflow execution.  Request RangeID: %v, details: (%v)",
+		shardCondition.RangeID, failed.details)
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		UnknownConditionFailureDetails: &msg,
+	}
+}
+
+// updateWorkflowConditionFailure explains why the transaction of UpdateWorkflowExecutionWithTasks was aborted
+func (db *mdb) updateWorkflowConditionFailure(
+	ctx context.Context,
+	failed *conditionFailed,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	previousNextEventIDCondition int64,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	requestConditionalRunID := ""
+	if currentWorkflowRequest.Condition != nil {
+		requestConditionalRunID = currentWorkflowRequest.Condition.GetCurrentRunID()
+	}
+
+	switch failed.kind {
+	case writeKindShard:
+		return db.shardConditionFailure(ctx, shardCondition)
+	case writeKindRequest:
+		return db.duplicateRequestFailure(ctx, failed.request)
+	case writeKindCurrentWorkflow:
+		row := currentWorkflowRequest.Row
+		var actualCurrRunID string
+		current, err := db.SelectCurrentWorkflow(ctx, shardCondition.ShardID, row.DomainID, row.WorkflowID)
+		switch {
+		case err == nil:
+			actualCurrRunID = current.RunID
+		case !db.IsNotFoundError(err):
+			return err
+		}
+		if actualCurrRunID != requestConditionalRunID {
+			msg := fmt.Sprintf("Failed to update mutable state. requestConditionalRunID: %v, Actual Value: %v",
+				requestConditionalRunID, actualCurrRunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				CurrentWorkflowConditionFailInfo: &msg,
+			}
+		}
+	case writeKindExecution:
+		execution := failed.execution
+		if execution.PreviousNextEventIDCondition == nil {
+			// an inserted execution already exists
+			break
+		}
+		actualNextEventID := int64(-1)
+		var stored cadence.WorkflowExecutionCollectionEntry
+		err := db.collection(cadence.WorkflowExecutionCollectionName).FindOne(ctx,
+			executionFilter(shardCondition.ShardID, execution.DomainID, execution.WorkflowID, execution.RunID),
+		).Decode(&stored)
+		switch {
+		case err == nil:
+			actualNextEventID = stored.NextEventID
+		case !db.IsNotFoundError(err):
+			return err
+		}
+		msg := fmt.Sprintf("Failed to update mutable state. previousNextEventIDCondition: %v, actualNextEventID: %v, Request Current RunID: %v",
+			*execution.PreviousNextEventIDCondition, actualNextEventID, execution.RunID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			UnknownConditionFailureDetails: &msg,
+		}
+	}
+
+	msg := fmt.Sprintf("Failed to update mutable state. ShardID: %v, RangeID: %v, previousNextEventIDCondition: %v, requestConditionalRunID: %v, details: (%v)",
+		shardCondition.ShardID, shardCondition.RangeID, previousNextEventIDCondition, requestConditionalRunID, failed.details)
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		UnknownConditionFailureDetails: &msg,
+	}
+}
+
+func (db *mdb) shardConditionFailure(ctx context.Context, shardCondition *nosqlplugin.ShardCondition) error {
+	rangeID, err := db.selectShardRangeID(ctx, shardCondition.ShardID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return err
+	}
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		ShardRangeIDNotMatch: &rangeID,
+	}
+}
+
+func (db *mdb) duplicateRequestFailure(ctx context.Context, row *nosqlplugin.WorkflowRequestRow) error {
+	var stored cadence.WorkflowRequestCollectionEntry
+	err := db.collection(cadence.WorkflowRequestCollectionName).FindOne(ctx, workflowRequestFilter(row)).Decode(&stored)
+	if err != nil {
+		return err
+	}
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		DuplicateRequest: &nosqlplugin.DuplicateRequest{
+			RequestType: row.RequestType,
+			RunID:       stored.RunID,
+		},
+	}
+}
+
+func newExecutionData(execution *nosqlplugin.WorkflowExecutionRequest) *executionData {
+	data := &executionData{
+		ActivityInfos:       execution.ActivityInfos,
+		TimerInfos:          execution.TimerInfos,
+		ChildExecutionInfos: execution.ChildWorkflowInfos,
+		RequestCancelInfos:  execution.RequestCancelInfos,
+		SignalInfos:         execution.SignalInfos,
+		SignalRequestedIDs:  execution.SignalRequestedIDs,
+	}
+	data.setExecution(execution)
+	return data
+}
+
+func (d *executionData) setExecution(execution *nosqlplugin.WorkflowExecutionRequest) {
+	info := execution.InternalWorkflowExecutionInfo
+	d.ExecutionInfo = &info
+	d.VersionHistories = execution.VersionHistories
+	d.Checksum = checksum.Checksum{}
+	if execution.Checksums != nil {
+		d.Checksum = *execution.Checksums
+	}
+}
+
+// merge applies a WorkflowExecutionMapsWriteModeUpdate mutation
+func (d *executionData) merge(execution *nosqlplugin.WorkflowExecutionRequest) {
+	d.setExecution(execution)
+	d.ActivityInfos = mergeMap(d.ActivityInfos, execution.ActivityInfos, execution.ActivityInfoKeysToDelete)
+	d.TimerInfos = mergeMap(d.TimerInfos, execution.TimerInfos, execution.TimerInfoKeysToDelete)
+	d.ChildExecutionInfos = mergeMap(d.ChildExecutionInfos, execution.ChildWorkflowInfos, execution.ChildWorkflowInfoKeysToDelete)
+	d.RequestCancelInfos = mergeMap(d.RequestCancelInfos, execution.RequestCancelInfos, execution.RequestCancelInfoKeysToDelete)
+	d.SignalInfos = mergeMap(d.SignalInfos, execution.SignalInfos, execution.SignalInfoKeysToDelete)
+
+	signalRequestedIDs := make(map[string]struct{}, len(d.SignalRequestedIDs))
+	for _, id := range d.SignalRequestedIDs {
+		signalRequestedIDs[id] = struct{}{}
+	}
+	upserted := make(map[string]struct{}, len(execution.SignalRequestedIDs))
+	for _, id := range execution.SignalRequestedIDs {
+		upserted[id] = struct{}{}
+	}
+	signalRequestedIDs = mergeMap(signalRequestedIDs, upserted, execution.SignalRequestedIDsKeysToDelete)
+	d.SignalRequestedIDs = make([]string, 0, len(signalRequestedIDs))
+	for id := range signalRequestedIDs {
+		d.SignalRequestedIDs = append(d.SignalRequestedIDs, id)
+	}
+
+	switch execution.EventBufferWriteMode {
+	case nosqlplugin.EventBufferWriteModeClear:
+		d.BufferedEvents = nil
+	case nosqlplugin.EventBufferWriteModeAppend:
+		d.BufferedEvents = append(d.BufferedEvents, execution.NewBufferedEventBatch)
+	}
+}
+
+// mergeMap deletes the given keys and then upserts the given entries,
+// a key that is both deleted and upserted is kept
+func mergeMap[K comparable, V any](current, upserts map[K]V, deletes []K) map[K]V {
+	if current == nil {
+		current = make(map[K]V, len(upserts))
+	}
+	for _, k := range deletes {
+		delete(current, k)
+	}
+	for k, v := range upserts {
+		current[k] = v
+	}
+	return current
+}
+
+func (d *executionData) toMutableState() *nosqlplugin.WorkflowExecution {
+	state := &nosqlplugin.WorkflowExecution{
+		ExecutionInfo:       d.ExecutionInfo,
+		VersionHistories:    d.VersionHistories,
+		ActivityInfos:       mergeMap(d.ActivityInfos, nil, nil),
+		TimerInfos:          mergeMap(d.TimerInfos, nil, nil),
+		ChildExecutionInfos: mergeMap(d.ChildExecutionInfos, nil, nil),
+		RequestCancelInfos:  mergeMap(d.RequestCancelInfos, nil, nil),
+		SignalInfos:         mergeMap(d.SignalInfos, nil, nil),
+		SignalRequestedIDs:  make(map[string]struct{}, len(d.SignalRequestedIDs)),
+		BufferedEvents:      d.BufferedEvents,
+		Checksum:            d.Checksum,
+	}
+	for _, id := range d.SignalRequestedIDs {
+		state.SignalRequestedIDs[id] = struct{}{}
+	}
+	if state.BufferedEvents == nil {
+		state.BufferedEvents = make([]*persistence.DataBlob, 0)
+	}
+	return state
+}
+
+func newExecutionEntry(shardID int, execution *nosqlplugin.WorkflowExecutionRequest, data *executionData) (*cadence.WorkflowExecutionCollectionEntry, error) {
+	encoded, err := encodeData(data)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.WorkflowExecutionCollectionEntry{
+		ShardID:          shardID,
+		DomainID:         execution.DomainID,
+		WorkflowID:       execution.WorkflowID,
+		RunID:            execution.RunID,
+		NextEventID:      execution.NextEventID,
+		LastWriteVersion: execution.LastWriteVersion,
+		Data:             encoded,
+	}, nil
+}
+
+func currentWorkflowFilter(shardID int, domainID, workflowID string) bson.D {
+	return bson.D{
+		{Key: "shardid", Value: shardID},
+		{Key: "domainid", Value: domainID},
+		{Key: "workflowid", Value: workflowID},
+	}
+}
+
+func executionFilter(shardID int, domainID, workflowID, runID string) bson.D {
+	return append(currentWorkflowFilter(shardID, domainID, workflowID), bson.E{Key: "runid", Value: runID})
+}
+
+func workflowRequestFilter(row *nosqlplugin.WorkflowRequestRow) bson.D {
+	return bson.D{
+		{Key: "shardid", Value: row.ShardID},
+		{Key: "domainid", Value: row.DomainID},
+		{Key: "workflowid", Value: row.WorkflowID},
+		{Key: "requesttype", Value: int(row.RequestType)},
+		{Key: "requestid", Value: row.RequestID},
+	}
+}
+
+func taskIDFilter(shardID int, taskID int64) bson.D {
+	return bson.D{{Key: "shardid", Value: shardID}, {Key: "taskid", Value: taskID}}
+}
+
+// timerTaskFilter matches a timer task, the visibility timestamp is stored with milliseconds precision
+func timerTaskFilter(shardID int, visibilityTimestamp time.Time, taskID int64) bson.D {
+	return bson.D{
+		{Key: "shardid", Value: shardID},
+		{Key: "visibilitytimestamp", Value: visibilityTimestamp},
+		{Key: "taskid", Value: taskID},
+	}
+}
+
+func replicationDLQTaskFilter(shardID int, sourceCluster string, taskID int64) bson.D {
+	return bson.D{
+		{Key: "shardid", Value: shardID},
+		{Key: "sourcecluster", Value: sourceCluster},
+		{Key: "taskid", Value: taskID},
+	}
+}
+
+// newHistoryTaskModel upserts a history task, Cassandra overwrites the rows of existing tasks as well
+func newHistoryTaskModel(filter bson.D, doc *cadence.HistoryTaskCollectionEntry) mongo.WriteModel {
+	return mongo.NewReplaceOneModel().SetFilter(filter).SetReplacement(doc).SetUpsert(true)
+}
+
+func newTransferTaskModel(shardID int, domainID, workflowID string, task *nosqlplugin.HistoryMigrationTask) (mongo.WriteModel, error) {
+	transfer := *task.Transfer
+	transfer.DomainID = domainID
+	transfer.WorkflowID = workflowID
+	data, err := encodeData(&nosqlplugin.HistoryMigrationTask{
+		Transfer: &transfer,
+		Task:     task.Task,
+		TaskID:   transfer.TaskID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newHistoryTaskModel(taskIDFilter(shardID, transfer.TaskID), &cadence.HistoryTaskCollectionEntry{
+		ShardID: shardID,
+		TaskID:  transfer.TaskID,
+		Data:    data,
+	}), nil
+}
+
+func newTimerTaskModel(shardID int, domainID, workflowID string, task *nosqlplugin.HistoryMigrationTask) (mongo.WriteModel, error) {
+	timer := *task.Timer
+	timer.DomainID = domainID
+	timer.WorkflowID = workflowID
+	data, err := encodeData(&nosqlplugin.HistoryMigrationTask{
+		Timer:  &timer,
+		Task:   task.Task,
+		TaskID: timer.TaskID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	visibilityTimestamp := timer.VisibilityTimestamp
+	return newHistoryTaskModel(timerTaskFilter(shardID, visibilityTimestamp, timer.TaskID), &cadence.HistoryTaskCollectionEntry{
+		ShardID:             shardID,
+		VisibilityTimestamp: &visibilityTimestamp,
+		TaskID:              timer.TaskID,
+		Data:                data,
+	}), nil
+}
+
+func newReplicationTaskModel(shardID int, domainID, workflowID string, task *nosqlplugin.HistoryMigrationTask) (mongo.WriteModel, error) {
+	replication := *task.Replication
+	replication.DomainID = domainID
+	replication.WorkflowID = workflowID
+	data, err := encodeData(&nosqlplugin.HistoryMigrationTask{
+		Replication: &replication,
+		Task:        task.Task,
+		TaskID:      replication.TaskID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newHistoryTaskModel(taskIDFilter(shardID, replication.TaskID), &cadence.HistoryTaskCollectionEntry{
+		ShardID: shardID,
+		TaskID:  replication.TaskID,
+		Data:    data,
+	}), nil
+}
+
+// parseHistoryTasks decodes task documents, timers are truncated to milliseconds which is the precision they are stored with
+func parseHistoryTasks(docs []bson.Raw) ([]*nosqlplugin.HistoryMigrationTask, error) {
+	tasks := make([]*nosqlplugin.HistoryMigrationTask, 0, len(docs))
+	for _, raw := range docs {
+		var doc cadence.HistoryTaskCollectionEntry
+		if err := bson.Unmarshal(raw, &doc); err != nil {
+			return nil, err
+		}
+		task := &nosqlplugin.HistoryMigrationTask{}
+		if err := decodeData(doc.Data, task); err != nil {
+			return nil, err
+		}
+		if task.Timer != nil {
+			ts := time.Unix(0, persistence.DBTimestampToUnixNano(persistence.UnixNanoToDBTimestamp(task.Timer.VisibilityTimestamp.UnixNano())))
+			task.Timer.VisibilityTimestamp = ts
+			task.ScheduledTime = ts
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
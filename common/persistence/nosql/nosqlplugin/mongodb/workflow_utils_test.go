@@ -0,0 +1,214 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+func TestExecutionDataMerge(t *testing.T) {
+	data := &executionData{
+		ActivityInfos: map[int64]*persistence.InternalActivityInfo{
+			1: {ScheduleID: 1},
+			2: {ScheduleID: 2},
+		},
+		TimerInfos:         map[string]*persistence.TimerInfo{"t1": {TimerID: "t1"}},
+		SignalRequestedIDs: []string{"s1", "s2"},
+		BufferedEvents:     []*persistence.DataBlob{{Data: []byte("b1")}},
+	}
+	data.merge(&nosqlplugin.WorkflowExecutionRequest{
+		InternalWorkflowExecutionInfo: persistence.InternalWorkflowExecutionInfo{RunID: "run", NextEventID: 10},
+		MapsWriteMode:                 nosqlplugin.WorkflowExecutionMapsWriteModeUpdate,
+		ActivityInfos: map[int64]*persistence.InternalActivityInfo{
+			2: {ScheduleID: 2, Attempt: 1},
+			3: {ScheduleID: 3},
+		},
+		ActivityInfoKeysToDelete:       []int64{1, 2},
+		TimerInfoKeysToDelete:          []string{"t1"},
+		SignalInfos:                    map[int64]*persistence.SignalInfo{5: {InitiatedID: 5}},
+		SignalRequestedIDs:             []string{"s3"},
+		SignalRequestedIDsKeysToDelete: []string{"s1"},
+		EventBufferWriteMode:           nosqlplugin.EventBufferWriteModeAppend,
+		NewBufferedEventBatch:          &persistence.DataBlob{Data: []byte("b2")},
+	})
+
+	state := data.toMutableState()
+	assert.Equal(t, "run", state.ExecutionInfo.RunID)
+	assert.Equal(t, map[int64]*persistence.InternalActivityInfo{
+		2: {ScheduleID: 2, Attempt: 1},
+		3: {ScheduleID: 3},
+	}, state.ActivityInfos)
+	assert.Empty(t, state.TimerInfos)
+	assert.NotNil(t, state.ChildExecutionInfos)
+	assert.NotNil(t, state.RequestCancelInfos)
+	assert.Equal(t, map[int64]*persistence.SignalInfo{5: {InitiatedID: 5}}, state.SignalInfos)
+	assert.Equal(t, map[string]struct{}{"s2": {}, "s3": {}}, state.SignalRequestedIDs)
+	assert.Equal(t, []*persistence.DataBlob{{Data: []byte("b1")}, {Data: []byte("b2")}}, state.BufferedEvents)
+
+	data.merge(&nosqlplugin.WorkflowExecutionRequest{
+		MapsWriteMode:        nosqlplugin.WorkflowExecutionMapsWriteModeUpdate,
+		EventBufferWriteMode: nosqlplugin.EventBufferWriteModeClear,
+	})
+	assert.Equal(t, []*persistence.DataBlob{}, data.toMutableState().BufferedEvents)
+}
+
+func TestAddCurrentWorkflow(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   *nosqlplugin.CurrentWorkflowWriteRequest
+		wantWrite bool
+		wantErr   bool
+	}{
+		{
+			name:    "noop",
+			request: &nosqlplugin.CurrentWorkflowWriteRequest{WriteMode: nosqlplugin.CurrentWorkflowWriteModeNoop},
+		},
+		{
+			name:      "insert",
+			request:   &nosqlplugin.CurrentWorkflowWriteRequest{WriteMode: nosqlplugin.CurrentWorkflowWriteModeInsert},
+			wantWrite: true,
+		},
+		{
+			name: "update",
+			request: &nosqlplugin.CurrentWorkflowWriteRequest{
+				WriteMode: nosqlplugin.CurrentWorkflowWriteModeUpdate,
+				Condition: &nosqlplugin.CurrentWorkflowWriteCondition{CurrentRunID: common.StringPtr("run")},
+			},
+			wantWrite: true,
+		},
+		{
+			name:    "update without run ID",
+			request: &nosqlplugin.CurrentWorkflowWriteRequest{WriteMode: nosqlplugin.CurrentWorkflowWriteModeUpdate},
+			wantErr: true,
+		},
+		{
+			name:    "unknown mode",
+			request: &nosqlplugin.CurrentWorkflowWriteRequest{WriteMode: 100},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.request.Row = nosqlplugin.CurrentWorkflowRow{RunID: "new-run", State: 1, LastWriteVersion: 10}
+			tx := newWorkflowTransaction(&nosqlplugin.ShardCondition{ShardID: 1})
+			err := tx.addCurrentWorkflow(1, "domain", "workflow", tc.request)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if !tc.wantWrite {
+				assert.Nil(t, tx.currentWorkflow)
+				return
+			}
+			require.NotNil(t, tx.currentWorkflow)
+			assert.Equal(t, "domain", tx.currentWorkflow.DomainID)
+			assert.Equal(t, "workflow", tx.currentWorkflow.WorkflowID)
+			assert.Equal(t, "new-run", tx.currentWorkflow.RunID)
+			assert.Equal(t, int64(10), tx.currentWorkflow.LastWriteVersion)
+		})
+	}
+}
+
+func TestWorkflowTransactionValidation(t *testing.T) {
+	tx := newWorkflowTransaction(&nosqlplugin.ShardCondition{ShardID: 1})
+
+	assert.EqualError(t, tx.addCreatedExecution(&nosqlplugin.WorkflowExecutionRequest{
+		EventBufferWriteMode: nosqlplugin.EventBufferWriteModeAppend,
+	}), "should only support EventBufferWriteModeNone")
+	assert.EqualError(t, tx.addCreatedExecution(&nosqlplugin.WorkflowExecutionRequest{
+		MapsWriteMode: nosqlplugin.WorkflowExecutionMapsWriteModeUpdate,
+	}), "should only support WorkflowExecutionMapsWriteModeCreate")
+	assert.EqualError(t, tx.addResetExecution(&nosqlplugin.WorkflowExecutionRequest{
+		EventBufferWriteMode: nosqlplugin.EventBufferWriteModeClear,
+		MapsWriteMode:        nosqlplugin.WorkflowExecutionMapsWriteModeUpdate,
+	}), "should only support WorkflowExecutionMapsWriteModeReset")
+	assert.EqualError(t, tx.addMutatedExecution(&nosqlplugin.WorkflowExecutionRequest{
+		MapsWriteMode: nosqlplugin.WorkflowExecutionMapsWriteModeReset,
+	}), "should only support WorkflowExecutionMapsWriteModeUpdate")
+	assert.EqualError(t, tx.addWorkflowRequests(&nosqlplugin.WorkflowRequestsWriteRequest{WriteMode: 100}, time.Now()),
+		"unknown workflow request write mode 100")
+
+	now := time.Unix(1000, 0)
+	require.NoError(t, tx.addWorkflowRequests(&nosqlplugin.WorkflowRequestsWriteRequest{
+		Rows:      []*nosqlplugin.WorkflowRequestRow{{RequestID: "request"}},
+		WriteMode: nosqlplugin.WorkflowRequestWriteModeInsert,
+	}, now))
+	assert.Len(t, tx.requests, 1)
+	assert.Equal(t, now.Add(workflowRequestTTLInSeconds*time.Second), *tx.requestsExpireAt)
+}
+
+func TestAddTasks(t *testing.T) {
+	tx := newWorkflowTransaction(&nosqlplugin.ShardCondition{ShardID: 1})
+	err := tx.addTasks(1, "domain", "workflow", map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask{
+		persistence.HistoryTaskCategoryTransfer: {
+			{Transfer: &nosqlplugin.TransferTask{TaskID: 1}},
+			{Transfer: &nosqlplugin.TransferTask{TaskID: 2}},
+		},
+		persistence.HistoryTaskCategoryTimer: {
+			{Timer: &nosqlplugin.TimerTask{TaskID: 3, VisibilityTimestamp: time.Unix(1000, 0)}},
+		},
+		persistence.HistoryTaskCategoryReplication: {
+			{Replication: &nosqlplugin.ReplicationTask{TaskID: 4}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Len(t, tx.tasks[cadence.TransferTaskCollectionName], 2)
+	assert.Len(t, tx.tasks[cadence.TimerTaskCollectionName], 1)
+	assert.Len(t, tx.tasks[cadence.ReplicationTaskCollectionName], 1)
+
+	// tasks of an unknown category are not silently dropped
+	err = tx.addTasks(1, "domain", "workflow", map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask{
+		{}: {{Transfer: &nosqlplugin.TransferTask{TaskID: 5}}},
+	})
+	assert.ErrorContains(t, err, "not supported")
+}
+
+func TestParseHistoryTasks(t *testing.T) {
+	ts := time.Unix(1700000000, 123456789)
+	model, err := newTimerTaskModel(1, "domain", "workflow", &nosqlplugin.HistoryMigrationTask{
+		Timer: &nosqlplugin.TimerTask{TaskID: 5, RunID: "run", VisibilityTimestamp: ts},
+	})
+	require.NoError(t, err)
+	doc, err := bson.Marshal(model.(*mongo.ReplaceOneModel).Replacement)
+	require.NoError(t, err)
+
+	tasks, err := parseHistoryTasks([]bson.Raw{doc})
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	task := tasks[0]
+	assert.Equal(t, int64(5), task.TaskID)
+	assert.Equal(t, "domain", task.Timer.DomainID)
+	assert.Equal(t, "workflow", task.Timer.WorkflowID)
+	assert.True(t, time.Unix(1700000000, 123000000).Equal(task.ScheduledTime))
+	assert.True(t, task.ScheduledTime.Equal(task.Timer.VisibilityTimestamp))
+}
@@ -189,6 +189,8 @@ type (
 		Expiry          time.Time
 		CreatedTime     time.Time
 		PartitionConfig map[string]string
+		Priority        int32
+		FairnessKey     string
 	}
 
 	// TaskListFilter is for filtering tasklist
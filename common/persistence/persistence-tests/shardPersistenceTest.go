@@ -358,6 +358,53 @@ func (s *ShardPersistenceSuite) TestCreateGetUpdateGetShard() {
 							},
 						},
 					},
+					1: &types.VirtualQueueState{
+						VirtualSliceStates: []*types.VirtualSliceState{
+							{
+								TaskRange: &types.TaskRange{
+									InclusiveMin: &types.TaskKey{
+										TaskID: currentClusterTransferAck + 1,
+									},
+									ExclusiveMax: &types.TaskKey{
+										TaskID: currentClusterTransferAck + 10,
+									},
+								},
+								Predicate: &types.Predicate{
+									PredicateType: types.PredicateTypeAnd,
+									AndPredicateAttributes: &types.AndPredicateAttributes{
+										Predicates: []*types.Predicate{
+											{
+												PredicateType: types.PredicateTypeDomainID,
+												DomainIDPredicateAttributes: &types.DomainIDPredicateAttributes{
+													DomainIDs:   []string{"domain1"},
+													IsExclusive: common.Ptr(false),
+												},
+											},
+											{
+												PredicateType: types.PredicateTypeWorkflowID,
+												WorkflowIDPredicateAttributes: &types.WorkflowIDPredicateAttributes{
+													WorkflowIDs: []string{"hot-workflow"},
+													IsExclusive: common.Ptr(false),
+												},
+											},
+											{
+												PredicateType: types.PredicateTypeNot,
+												NotPredicateAttributes: &types.NotPredicateAttributes{
+													Predicate: &types.Predicate{
+														PredicateType: types.PredicateTypeTaskType,
+														TaskTypePredicateAttributes: &types.TaskTypePredicateAttributes{
+															TaskTypes:   []int32{1},
+															IsExclusive: common.Ptr(false),
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
 				},
 				ExclusiveMaxReadLevel: &types.TaskKey{
 					TaskID: currentClusterTransferAck + 10,
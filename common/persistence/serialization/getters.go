@@ -1002,6 +1002,22 @@ func (a *ActivityInfo) GetTaskListKind() (o types.TaskListKind) {
 	return
 }
 
+// GetPriority internal sql blob getter
+func (a *ActivityInfo) GetPriority() (o int32) {
+	if a != nil {
+		return a.Priority
+	}
+	return
+}
+
+// GetFairnessKey internal sql blob getter
+func (a *ActivityInfo) GetFairnessKey() (o string) {
+	if a != nil {
+		return a.FairnessKey
+	}
+	return
+}
+
 // GetStartedEventEncoding internal sql blob getter
 func (a *ActivityInfo) GetStartedEventEncoding() (o string) {
 	if a != nil {
@@ -1434,6 +1450,22 @@ func (t *TaskInfo) GetPartitionConfig() (o map[string]string) {
 	return
 }
 
+// GetPriority internal sql blob getter
+func (t *TaskInfo) GetPriority() (o int32) {
+	if t != nil {
+		return t.Priority
+	}
+	return
+}
+
+// GetFairnessKey internal sql blob getter
+func (t *TaskInfo) GetFairnessKey() (o string) {
+	if t != nil {
+		return t.FairnessKey
+	}
+	return
+}
+
 // GetKind internal sql blob getter
 func (t *TaskListInfo) GetKind() (o int16) {
 	if t != nil {
@@ -153,7 +153,9 @@ var expectedNil = map[string]map[string]any{
 	"*serialization.TaskInfo": {
 		"GetCreatedTimestamp": zeroUnix,
 		"GetExpiryTimestamp":  zeroUnix,
+		"GetFairnessKey":      "",
 		"GetPartitionConfig":  map[string]string(nil),
+		"GetPriority":         int32(0),
 		"GetRunID":            []uint8(nil),
 		"GetScheduleID":       int64(0),
 		"GetWorkflowID":       "",
@@ -198,8 +200,10 @@ var expectedNil = map[string]map[string]any{
 		"GetAttempt":                  int32(0),
 		"GetCancelRequestID":          int64(0),
 		"GetCancelRequested":          false,
+		"GetFairnessKey":              "",
 		"GetHasRetryPolicy":           false,
 		"GetHeartbeatTimeout":         time.Duration(0),
+		"GetPriority":                 int32(0),
 		"GetRequestID":                "",
 		"GetRetryBackoffCoefficient":  float64(0),
 		"GetRetryExpirationTimestamp": zeroUnix,
@@ -401,7 +405,9 @@ var expectedEmpty = map[string]map[string]any{
 	"*serialization.TaskInfo": {
 		"GetCreatedTimestamp": time.Time{},
 		"GetExpiryTimestamp":  time.Time{},
+		"GetFairnessKey":      "",
 		"GetPartitionConfig":  map[string]string(nil),
+		"GetPriority":         int32(0),
 		"GetRunID":            []uint8(nil),
 		"GetScheduleID":       int64(0),
 		"GetWorkflowID":       "",
@@ -446,8 +452,10 @@ var expectedEmpty = map[string]map[string]any{
 		"GetAttempt":                  int32(0),
 		"GetCancelRequestID":          int64(0),
 		"GetCancelRequested":          false,
+		"GetFairnessKey":              "",
 		"GetHasRetryPolicy":           false,
 		"GetHeartbeatTimeout":         time.Duration(0),
+		"GetPriority":                 int32(0),
 		"GetRequestID":                "",
 		"GetRetryBackoffCoefficient":  float64(0),
 		"GetRetryExpirationTimestamp": time.Time{},
@@ -651,7 +659,9 @@ var expectedNonEmpty = map[string]map[string]any{
 	"*serialization.TaskInfo": {
 		"GetCreatedTimestamp": taskInfoCreateTime,
 		"GetExpiryTimestamp":  taskInfoExpiryTime,
+		"GetFairnessKey":      "tenant",
 		"GetPartitionConfig":  map[string]string{"key": "value"},
+		"GetPriority":         int32(5),
 		"GetRunID":            []byte(taskInfoRunID),
 		"GetScheduleID":       int64(1),
 		"GetWorkflowID":       "workflowID",
@@ -696,8 +706,10 @@ var expectedNonEmpty = map[string]map[string]any{
 		"GetAttempt":                  int32(6),
 		"GetCancelRequestID":          int64(4),
 		"GetCancelRequested":          true,
+		"GetFairnessKey":              "fairnessKey",
 		"GetHasRetryPolicy":           true,
 		"GetHeartbeatTimeout":         time.Duration(4),
+		"GetPriority":                 int32(9),
 		"GetRequestID":                "requestID",
 		"GetRetryBackoffCoefficient":  float64(8),
 		"GetRetryExpirationTimestamp": activeInfoRetryExpirationTime,
@@ -209,6 +209,8 @@ func TestGettersForInfos(t *testing.T) {
 			PartitionConfig: map[string]string{
 				"key": "value",
 			},
+			Priority:    5,
+			FairnessKey: "tenant",
 		},
 		&TimerInfo{
 			Version:         1,
@@ -266,6 +268,8 @@ func TestGettersForInfos(t *testing.T) {
 			Attempt:                  6,
 			TaskList:                 "taskList",
 			TaskListKind:             types.TaskListKindSticky,
+			Priority:                 9,
+			FairnessKey:              "fairnessKey",
 			StartedIdentity:          "startedIdentity",
 			HasRetryPolicy:           true,
 			RetryInitialInterval:     time.Duration(5),
@@ -203,6 +203,8 @@ type (
 		Attempt                  int32
 		TaskList                 string
 		TaskListKind             types.TaskListKind
+		Priority                 int32
+		FairnessKey              string
 		StartedIdentity          string
 		HasRetryPolicy           bool
 		RetryInitialInterval     time.Duration
@@ -267,6 +269,8 @@ type (
 		ExpiryTimestamp  time.Time
 		CreatedTimestamp time.Time
 		PartitionConfig  map[string]string
+		Priority         int32
+		FairnessKey      string
 	}
 
 	TaskListPartition struct {
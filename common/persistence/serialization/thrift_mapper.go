@@ -392,6 +392,8 @@ func activityInfoToThrift(info *ActivityInfo) *sqlblobs.ActivityInfo {
 		Attempt:                       &info.Attempt,
 		TaskList:                      &info.TaskList,
 		TaskListKind:                  thrift.FromTaskListKind(&info.TaskListKind),
+		Priority:                      &info.Priority,
+		FairnessKey:                   &info.FairnessKey,
 		StartedIdentity:               &info.StartedIdentity,
 		HasRetryPolicy:                &info.HasRetryPolicy,
 		RetryInitialIntervalSeconds:   durationToSecondsInt32Ptr(info.RetryInitialInterval),
@@ -432,6 +434,8 @@ func activityInfoFromThrift(info *sqlblobs.ActivityInfo) *ActivityInfo {
 		Attempt:                  info.GetAttempt(),
 		TaskList:                 info.GetTaskList(),
 		TaskListKind:             taskListKindFromThrift(info.TaskListKind),
+		Priority:                 info.GetPriority(),
+		FairnessKey:              info.GetFairnessKey(),
 		StartedIdentity:          info.GetStartedIdentity(),
 		HasRetryPolicy:           info.GetHasRetryPolicy(),
 		RetryInitialInterval:     common.SecondsToDuration(int64(info.GetRetryInitialIntervalSeconds())),
@@ -575,6 +579,8 @@ func taskInfoToThrift(info *TaskInfo) *sqlblobs.TaskInfo {
 		ExpiryTimeNanos:  timeToUnixNanoPtr(info.ExpiryTimestamp),
 		CreatedTimeNanos: timeToUnixNanoPtr(info.CreatedTimestamp),
 		PartitionConfig:  info.PartitionConfig,
+		Priority:         common.Int32Ptr(info.Priority),
+		FairnessKey:      common.StringPtr(info.FairnessKey),
 	}
 }
 
@@ -589,6 +595,8 @@ func taskInfoFromThrift(info *sqlblobs.TaskInfo) *TaskInfo {
 		ExpiryTimestamp:  timeFromUnixNano(info.GetExpiryTimeNanos()),
 		CreatedTimestamp: timeFromUnixNano(info.GetCreatedTimeNanos()),
 		PartitionConfig:  info.PartitionConfig,
+		Priority:         info.GetPriority(),
+		FairnessKey:      info.GetFairnessKey(),
 	}
 }
 
@@ -379,6 +379,8 @@ func TestActivityInfo(t *testing.T) {
 		Attempt:                  int32(rand.Intn(1000)),
 		TaskList:                 "TaskList",
 		TaskListKind:             types.TaskListKindEphemeral,
+		Priority:                 int32(rand.Intn(1000)),
+		FairnessKey:              "FairnessKey",
 		StartedIdentity:          "StartedIdentity",
 		HasRetryPolicy:           true,
 		RetryInitialInterval:     time.Minute * time.Duration(rand.Intn(10)),
@@ -24,12 +24,20 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/jmoiron/sqlx"
+
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 )
 
 var supportedPlugins = map[string]sqlplugin.Plugin{}
 
+// ConnPlugin is implemented by the SQL plugins that can open a plain connection pool to their database,
+// it is used by components that run their own queries instead of the persistence CRUD API
+type ConnPlugin interface {
+	CreateDBConn(cfg *config.SQL) (*sqlx.DB, error)
+}
+
 // RegisterPlugin will register a SQL plugin
 func RegisterPlugin(pluginName string, plugin sqlplugin.Plugin) {
 	if _, ok := supportedPlugins[pluginName]; ok {
@@ -85,3 +93,24 @@ func NewSQLAdminDB(cfg *config.SQL) (sqlplugin.AdminDB, error) {
 
 	return plugin.CreateAdminDB(cfg)
 }
+
+// NewSQLDBConn opens a plain connection pool to the single SQL database of cfg,
+// the caller is responsible for closing it
+func NewSQLDBConn(cfg *config.SQL) (*sqlx.DB, error) {
+	plugin, ok := supportedPlugins[cfg.PluginName]
+
+	if !ok {
+		return nil, fmt.Errorf("not supported plugin %v, only supported: %v", cfg.PluginName, supportedPlugins)
+	}
+
+	connPlugin, ok := plugin.(ConnPlugin)
+	if !ok {
+		return nil, fmt.Errorf("plugin %v does not support plain database connections", cfg.PluginName)
+	}
+
+	if cfg.UseMultipleDatabases {
+		return nil, fmt.Errorf("plain database connections do not support useMultipleDatabases")
+	}
+
+	return connPlugin.CreateDBConn(cfg)
+}
@@ -25,6 +25,7 @@ package sql
 import (
 	"testing"
 
+	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/uber/cadence/common/config"
@@ -74,3 +75,33 @@ func TestPluginRegistration(t *testing.T) {
 	_, err = NewSQLDB(&config.SQL{PluginName: "fake3"})
 	assert.Error(t, err, "NewSQLDB failed to return an error with an unregistered plugin")
 }
+
+type fakeConnPlugin struct {
+	fakePlugin
+}
+
+func (f *fakeConnPlugin) CreateDBConn(cfg *config.SQL) (*sqlx.DB, error) {
+	return &sqlx.DB{}, nil
+}
+
+func TestNewSQLDBConn(t *testing.T) {
+	supportedPlugins["fake-conn"] = &fakeConnPlugin{}
+	supportedPlugins["fake-no-conn"] = &fakePlugin{}
+	t.Cleanup(func() {
+		delete(supportedPlugins, "fake-conn")
+		delete(supportedPlugins, "fake-no-conn")
+	})
+
+	db, err := NewSQLDBConn(&config.SQL{PluginName: "fake-conn"})
+	assert.NoError(t, err)
+	assert.NotNil(t, db)
+
+	_, err = NewSQLDBConn(&config.SQL{PluginName: "fake-conn", UseMultipleDatabases: true})
+	assert.ErrorContains(t, err, "useMultipleDatabases")
+
+	_, err = NewSQLDBConn(&config.SQL{PluginName: "fake-no-conn"})
+	assert.ErrorContains(t, err, "does not support plain database connections")
+
+	_, err = NewSQLDBConn(&config.SQL{PluginName: "unknown"})
+	assert.ErrorContains(t, err, "not supported plugin")
+}
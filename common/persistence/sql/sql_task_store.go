@@ -446,6 +446,8 @@ func (m *sqlTaskStore) CreateTasks(
 			ExpiryTimestamp:  expiryTime,
 			CreatedTimestamp: request.CurrentTimeStamp,
 			PartitionConfig:  v.Data.PartitionConfig,
+			Priority:         v.Data.Priority,
+			FairnessKey:      v.Data.FairnessKey,
 		})
 		if err != nil {
 			return nil, err
@@ -533,6 +535,8 @@ func (m *sqlTaskStore) GetTasks(
 			Expiry:          info.GetExpiryTimestamp(),
 			CreatedTime:     info.GetCreatedTimestamp(),
 			PartitionConfig: info.GetPartitionConfig(),
+			Priority:        info.GetPriority(),
+			FairnessKey:     info.GetFairnessKey(),
 		}
 	}
 
@@ -62,6 +62,7 @@ var dsnAttrOverrides = map[string]string{
 type plugin struct{}
 
 var _ sqlplugin.Plugin = (*plugin)(nil)
+var _ sql.ConnPlugin = (*plugin)(nil)
 
 func init() {
 	sql.RegisterPlugin(PluginName, &plugin{})
@@ -77,6 +78,11 @@ func (p *plugin) CreateAdminDB(cfg *config.SQL) (sqlplugin.AdminDB, error) {
 	return p.createDB(cfg)
 }
 
+// CreateDBConn opens a plain connection pool to the database
+func (p *plugin) CreateDBConn(cfg *config.SQL) (*sqlx.DB, error) {
+	return p.createSingleDBConn(cfg)
+}
+
 func (p *plugin) createDB(cfg *config.SQL) (*DB, error) {
 	conns, err := sqldriver.CreateDBConnections(cfg, func(cfg *config.SQL) (*sqlx.DB, error) {
 		return p.createSingleDBConn(cfg)
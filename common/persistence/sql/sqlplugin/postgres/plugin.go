@@ -47,6 +47,7 @@ const (
 type plugin struct{}
 
 var _ sqlplugin.Plugin = (*plugin)(nil)
+var _ sql.ConnPlugin = (*plugin)(nil)
 
 func init() {
 	sql.RegisterPlugin(PluginName, &plugin{})
@@ -74,6 +75,11 @@ func (d *plugin) CreateAdminDB(cfg *config.SQL) (sqlplugin.AdminDB, error) {
 	return newDB(conns, nil, sqlplugin.DbShardUndefined, cfg.NumShards)
 }
 
+// CreateDBConn opens a plain connection pool to the database
+func (d *plugin) CreateDBConn(cfg *config.SQL) (*sqlx.DB, error) {
+	return d.createSingleDBConn(cfg)
+}
+
 // CreateDBConnection creates a returns a reference to a logical connection to the
 // underlying SQL database. The returned object is to tied to a single
 // SQL database and the object can be used to perform CRUD operations on
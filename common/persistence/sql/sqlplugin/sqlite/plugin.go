@@ -49,6 +49,7 @@ const (
 type plugin struct{}
 
 var _ sqlplugin.Plugin = (*plugin)(nil)
+var _ sql.ConnPlugin = (*plugin)(nil)
 
 func init() {
 	sql.RegisterPlugin(PluginName, &plugin{})
@@ -64,6 +65,12 @@ func (p *plugin) CreateAdminDB(cfg *config.SQL) (sqlplugin.AdminDB, error) {
 	return p.createDB(cfg)
 }
 
+// CreateDBConn opens a plain connection pool to the database,
+// unlike CreateDB it is not shared with other users of the same database file
+func (p *plugin) CreateDBConn(cfg *config.SQL) (*sqlx.DB, error) {
+	return p.createDBConn(cfg)
+}
+
 // createDB create a new instance of DB
 func (p *plugin) createDB(cfg *config.SQL) (*DB, error) {
 	conns, err := sqldriver.CreateDBConnections(cfg, p.createSingleDBConn)
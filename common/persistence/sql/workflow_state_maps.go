@@ -72,6 +72,8 @@ func updateActivityInfos(
 				Attempt:                  activityInfo.Attempt,
 				TaskList:                 activityInfo.TaskList,
 				TaskListKind:             activityInfo.TaskListKind,
+				Priority:                 activityInfo.Priority,
+				FairnessKey:              activityInfo.FairnessKey,
 				StartedIdentity:          activityInfo.StartedIdentity,
 				HasRetryPolicy:           activityInfo.HasRetryPolicy,
 				RetryInitialInterval:     activityInfo.InitialInterval,
@@ -171,6 +173,8 @@ func getActivityInfoMap(
 			StartedIdentity:          decoded.GetStartedIdentity(),
 			TaskList:                 decoded.GetTaskList(),
 			TaskListKind:             decoded.GetTaskListKind(),
+			Priority:                 decoded.GetPriority(),
+			FairnessKey:              decoded.GetFairnessKey(),
 			HasRetryPolicy:           decoded.GetHasRetryPolicy(),
 			InitialInterval:          decoded.GetRetryInitialInterval(),
 			BackoffCoefficient:       decoded.GetRetryBackoffCoefficient(),
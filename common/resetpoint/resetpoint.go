@@ -0,0 +1,329 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package resetpoint finds the event to reset a workflow to for the reset types
+// supported by the CLI and the reset batch type of the batcher.
+package resetpoint
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// TypeFirstDecisionCompleted resets to the first DecisionTaskCompleted event
+	TypeFirstDecisionCompleted = "FirstDecisionCompleted"
+	// TypeLastDecisionCompleted resets to the last DecisionTaskCompleted event, moved back by DecisionOffset
+	TypeLastDecisionCompleted = "LastDecisionCompleted"
+	// TypeLastContinuedAsNew resets to the last DecisionTaskCompleted event of the run that continued as new into the current one
+	TypeLastContinuedAsNew = "LastContinuedAsNew"
+	// TypeBadBinary resets to the auto reset point of BadBinaryChecksum
+	TypeBadBinary = "BadBinary"
+	// TypeDecisionCompletedTime resets to the first DecisionTaskCompleted event at or after EarliestTime
+	TypeDecisionCompletedTime = "DecisionCompletedTime"
+	// TypeFirstDecisionScheduled resets to the first DecisionTaskScheduled event
+	TypeFirstDecisionScheduled = "FirstDecisionScheduled"
+	// TypeLastDecisionScheduled resets to the last DecisionTaskScheduled event, moved back by DecisionOffset
+	TypeLastDecisionScheduled = "LastDecisionScheduled"
+
+	historyPageSize = 1000
+)
+
+// AllTypes is the supported reset types
+var AllTypes = []string{
+	TypeFirstDecisionCompleted,
+	TypeLastDecisionCompleted,
+	TypeLastContinuedAsNew,
+	TypeBadBinary,
+	TypeDecisionCompletedTime,
+	TypeFirstDecisionScheduled,
+	TypeLastDecisionScheduled,
+}
+
+// Params is the parameters to find the reset point of a workflow
+type Params struct {
+	// Type is where to reset the workflow to, one of AllTypes
+	Type string
+	// DecisionOffset moves the reset point of LastDecisionCompleted and LastDecisionScheduled back by the number of decisions.
+	// Only zero or negative values are supported.
+	DecisionOffset int
+	// BadBinaryChecksum is required for TypeBadBinary
+	BadBinaryChecksum string
+	// EarliestTime in unix nanoseconds is required for TypeDecisionCompletedTime
+	EarliestTime int64
+}
+
+// Get returns the base run and the decision finish event ID to reset the workflow to
+func Get(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+	params Params,
+) (baseRunID string, decisionFinishEventID int64, err error) {
+	baseRunID = runID
+	switch params.Type {
+	case TypeFirstDecisionCompleted:
+		decisionFinishEventID, err = getFirstEventIDByType(ctx, client, domain, workflowID, runID, types.EventTypeDecisionTaskCompleted)
+	case TypeLastDecisionCompleted:
+		decisionFinishEventID, err = getLastEventIDByType(ctx, client, domain, workflowID, runID, types.EventTypeDecisionTaskCompleted, params.DecisionOffset)
+	case TypeLastContinuedAsNew:
+		baseRunID, decisionFinishEventID, err = getLastContinuedAsNewResetPoint(ctx, client, domain, workflowID, runID)
+	case TypeBadBinary:
+		decisionFinishEventID, err = getBadBinaryResetPoint(ctx, client, domain, workflowID, runID, params.BadBinaryChecksum)
+	case TypeDecisionCompletedTime:
+		decisionFinishEventID, err = getEarliestDecisionCompletedEventID(ctx, client, domain, workflowID, runID, params.EarliestTime)
+	case TypeFirstDecisionScheduled:
+		decisionFinishEventID, err = getFirstEventIDByType(ctx, client, domain, workflowID, runID, types.EventTypeDecisionTaskScheduled)
+		// decisionFinishEventID is exclusive in reset API
+		decisionFinishEventID++
+	case TypeLastDecisionScheduled:
+		decisionFinishEventID, err = getLastEventIDByType(ctx, client, domain, workflowID, runID, types.EventTypeDecisionTaskScheduled, params.DecisionOffset)
+		// decisionFinishEventID is exclusive in reset API
+		decisionFinishEventID++
+	default:
+		return "", 0, &types.BadRequestError{Message: fmt.Sprintf("not supported reset type: %v", params.Type)}
+	}
+	if err != nil {
+		return "", 0, err
+	}
+	return baseRunID, decisionFinishEventID, nil
+}
+
+// IsLastDecisionFailedWithNonDeterminism returns whether the last decision of the run failed with a non deterministic error
+func IsLastDecisionFailedWithNonDeterminism(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+) (bool, error) {
+	var decisionFailed *types.HistoryEvent
+	err := iterateHistory(ctx, client, domain, workflowID, runID, func(e *types.HistoryEvent) bool {
+		switch e.GetEventType() {
+		case types.EventTypeDecisionTaskFailed:
+			decisionFailed = e
+		case types.EventTypeDecisionTaskCompleted:
+			decisionFailed = nil
+		}
+		return true
+	})
+	if err != nil || decisionFailed == nil {
+		return false, err
+	}
+	attr := decisionFailed.GetDecisionTaskFailedEventAttributes()
+	return attr.GetCause() == types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure ||
+		strings.Contains(string(attr.GetDetails()), "nondeterministic"), nil
+}
+
+func getFirstEventIDByType(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+	eventType types.EventType,
+) (int64, error) {
+	var eventID int64
+	err := iterateHistory(ctx, client, domain, workflowID, runID, func(e *types.HistoryEvent) bool {
+		if e.GetEventType() == eventType {
+			eventID = e.ID
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return 0, err
+	}
+	if eventID == 0 {
+		return 0, noResetPointError(eventType)
+	}
+	return eventID, nil
+}
+
+func getLastEventIDByType(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+	eventType types.EventType,
+	decisionOffset int,
+) (int64, error) {
+	// remember the last |decisionOffset|+1 events of the type, the first one is the reset point
+	size := 1 - decisionOffset
+	eventIDs := make([]int64, 0, size)
+	err := iterateHistory(ctx, client, domain, workflowID, runID, func(e *types.HistoryEvent) bool {
+		if e.GetEventType() == eventType {
+			eventIDs = append(eventIDs, e.ID)
+			if len(eventIDs) > size {
+				eventIDs = eventIDs[1:]
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(eventIDs) == 0 {
+		return 0, noResetPointError(eventType)
+	}
+	return eventIDs[0], nil
+}
+
+func getLastContinuedAsNewResetPoint(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+) (string, int64, error) {
+	resp, err := client.GetWorkflowExecutionHistory(ctx, &types.GetWorkflowExecutionHistoryRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      runID,
+		},
+		MaximumPageSize: 1,
+	})
+	if err != nil {
+		return "", 0, err
+	}
+	events := resp.GetHistory().GetEvents()
+	if len(events) == 0 {
+		return "", 0, &types.BadRequestError{Message: "workflow history is empty"}
+	}
+	baseRunID := events[0].GetWorkflowExecutionStartedEventAttributes().GetContinuedExecutionRunID()
+	if baseRunID == "" {
+		return "", 0, &types.BadRequestError{Message: "workflow is not continued as new from another run"}
+	}
+	decisionFinishEventID, err := getLastEventIDByType(ctx, client, domain, workflowID, baseRunID, types.EventTypeDecisionTaskCompleted, 0)
+	if err != nil {
+		return "", 0, err
+	}
+	return baseRunID, decisionFinishEventID, nil
+}
+
+func getBadBinaryResetPoint(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+	binaryChecksum string,
+) (int64, error) {
+	resp, err := client.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      runID,
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+	var points []*types.ResetPointInfo
+	if info := resp.GetWorkflowExecutionInfo(); info != nil && info.AutoResetPoints != nil {
+		points = info.AutoResetPoints.Points
+	}
+	now := time.Now().UnixNano()
+	for _, p := range points {
+		if p.GetBinaryChecksum() != binaryChecksum || !p.GetResettable() {
+			continue
+		}
+		if p.GetExpiringTimeNano() > 0 && now > p.GetExpiringTimeNano() {
+			// reset point has expired and the history may have been deleted
+			continue
+		}
+		return p.GetFirstDecisionCompletedID(), nil
+	}
+	return 0, &types.BadRequestError{Message: fmt.Sprintf("no reset point found for binary checksum %v", binaryChecksum)}
+}
+
+func getEarliestDecisionCompletedEventID(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+	earliestTime int64,
+) (int64, error) {
+	var eventID int64
+	err := iterateHistory(ctx, client, domain, workflowID, runID, func(e *types.HistoryEvent) bool {
+		if e.GetEventType() == types.EventTypeDecisionTaskCompleted && e.GetTimestamp() >= earliestTime {
+			eventID = e.ID
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return 0, err
+	}
+	if eventID == 0 {
+		return 0, noResetPointError(types.EventTypeDecisionTaskCompleted)
+	}
+	return eventID, nil
+}
+
+// iterateHistory calls fn for the history events of the workflow in order until fn returns false
+func iterateHistory(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+	fn func(*types.HistoryEvent) bool,
+) error {
+	req := &types.GetWorkflowExecutionHistoryRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      runID,
+		},
+		MaximumPageSize: historyPageSize,
+	}
+	for {
+		resp, err := client.GetWorkflowExecutionHistory(ctx, req)
+		if err != nil {
+			return err
+		}
+		for _, e := range resp.GetHistory().GetEvents() {
+			if !fn(e) {
+				return nil
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+}
+
+func noResetPointError(eventType types.EventType) error {
+	return &types.BadRequestError{Message: fmt.Sprintf("no %v event found to reset to", eventType)}
+}
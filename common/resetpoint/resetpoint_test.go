@@ -0,0 +1,254 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package resetpoint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestGet(t *testing.T) {
+	history := []*types.HistoryEvent{
+		{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr(), Timestamp: common.Int64Ptr(100),
+			WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{ContinuedExecutionRunID: "prev-rid"}},
+		{ID: 2, EventType: types.EventTypeDecisionTaskScheduled.Ptr(), Timestamp: common.Int64Ptr(200)},
+		{ID: 3, EventType: types.EventTypeDecisionTaskStarted.Ptr(), Timestamp: common.Int64Ptr(300)},
+		{ID: 4, EventType: types.EventTypeDecisionTaskCompleted.Ptr(), Timestamp: common.Int64Ptr(400)},
+		{ID: 5, EventType: types.EventTypeDecisionTaskScheduled.Ptr(), Timestamp: common.Int64Ptr(500)},
+		{ID: 6, EventType: types.EventTypeDecisionTaskStarted.Ptr(), Timestamp: common.Int64Ptr(600)},
+		{ID: 7, EventType: types.EventTypeDecisionTaskCompleted.Ptr(), Timestamp: common.Int64Ptr(700)},
+	}
+	prevHistory := []*types.HistoryEvent{
+		{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()},
+		{ID: 4, EventType: types.EventTypeDecisionTaskCompleted.Ptr()},
+		{ID: 8, EventType: types.EventTypeDecisionTaskCompleted.Ptr()},
+	}
+
+	tests := []struct {
+		name          string
+		params        Params
+		autoResetInfo *types.ResetPoints
+		wantRunID     string
+		wantEventID   int64
+		wantErr       bool
+	}{
+		{
+			name:        "first decision completed",
+			params:      Params{Type: TypeFirstDecisionCompleted},
+			wantRunID:   "rid",
+			wantEventID: 4,
+		},
+		{
+			name:        "last decision completed",
+			params:      Params{Type: TypeLastDecisionCompleted},
+			wantRunID:   "rid",
+			wantEventID: 7,
+		},
+		{
+			name:        "last decision completed with offset",
+			params:      Params{Type: TypeLastDecisionCompleted, DecisionOffset: -1},
+			wantRunID:   "rid",
+			wantEventID: 4,
+		},
+		{
+			name:        "last decision completed with offset beyond the first decision",
+			params:      Params{Type: TypeLastDecisionCompleted, DecisionOffset: -5},
+			wantRunID:   "rid",
+			wantEventID: 4,
+		},
+		{
+			name:        "first decision scheduled",
+			params:      Params{Type: TypeFirstDecisionScheduled},
+			wantRunID:   "rid",
+			wantEventID: 3,
+		},
+		{
+			name:        "last decision scheduled",
+			params:      Params{Type: TypeLastDecisionScheduled},
+			wantRunID:   "rid",
+			wantEventID: 6,
+		},
+		{
+			name:        "decision completed time",
+			params:      Params{Type: TypeDecisionCompletedTime, EarliestTime: 450},
+			wantRunID:   "rid",
+			wantEventID: 7,
+		},
+		{
+			name:    "decision completed time after the last decision",
+			params:  Params{Type: TypeDecisionCompletedTime, EarliestTime: 800},
+			wantErr: true,
+		},
+		{
+			name:        "last continued as new",
+			params:      Params{Type: TypeLastContinuedAsNew},
+			wantRunID:   "prev-rid",
+			wantEventID: 8,
+		},
+		{
+			name:   "bad binary",
+			params: Params{Type: TypeBadBinary, BadBinaryChecksum: "bad"},
+			autoResetInfo: &types.ResetPoints{Points: []*types.ResetPointInfo{
+				{BinaryChecksum: "good", FirstDecisionCompletedID: 4, Resettable: true},
+				{BinaryChecksum: "bad", FirstDecisionCompletedID: 7, Resettable: true},
+			}},
+			wantRunID:   "rid",
+			wantEventID: 7,
+		},
+		{
+			name:   "bad binary not resettable",
+			params: Params{Type: TypeBadBinary, BadBinaryChecksum: "bad"},
+			autoResetInfo: &types.ResetPoints{Points: []*types.ResetPointInfo{
+				{BinaryChecksum: "bad", FirstDecisionCompletedID: 7, Resettable: false},
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown reset type",
+			params:  Params{Type: "unknown"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := frontend.NewMockClient(ctrl)
+			client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, req *types.GetWorkflowExecutionHistoryRequest, _ ...interface{}) (*types.GetWorkflowExecutionHistoryResponse, error) {
+					events := history
+					if req.Execution.RunID == "prev-rid" {
+						events = prevHistory
+					}
+					if req.MaximumPageSize > 0 && int(req.MaximumPageSize) < len(events) {
+						events = events[:req.MaximumPageSize]
+					}
+					return &types.GetWorkflowExecutionHistoryResponse{History: &types.History{Events: events}}, nil
+				}).AnyTimes()
+			client.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
+				WorkflowExecutionInfo: &types.WorkflowExecutionInfo{AutoResetPoints: tt.autoResetInfo},
+			}, nil).AnyTimes()
+
+			runID, eventID, err := Get(context.Background(), client, "test-domain", "wid", "rid", tt.params)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantRunID, runID)
+			assert.Equal(t, tt.wantEventID, eventID)
+		})
+	}
+}
+
+func TestGet_Pagination(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := frontend.NewMockClient(ctrl)
+	client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
+		Domain:          "test-domain",
+		Execution:       &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+		MaximumPageSize: historyPageSize,
+	}).Return(&types.GetWorkflowExecutionHistoryResponse{
+		History:       &types.History{Events: []*types.HistoryEvent{{ID: 4, EventType: types.EventTypeDecisionTaskCompleted.Ptr()}}},
+		NextPageToken: []byte("next"),
+	}, nil)
+	client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
+		Domain:          "test-domain",
+		Execution:       &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+		MaximumPageSize: historyPageSize,
+		NextPageToken:   []byte("next"),
+	}).Return(&types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{Events: []*types.HistoryEvent{{ID: 7, EventType: types.EventTypeDecisionTaskCompleted.Ptr()}}},
+	}, nil)
+
+	runID, eventID, err := Get(context.Background(), client, "test-domain", "wid", "rid", Params{Type: TypeLastDecisionCompleted})
+	assert.NoError(t, err)
+	assert.Equal(t, "rid", runID)
+	assert.Equal(t, int64(7), eventID)
+}
+
+func TestIsLastDecisionFailedWithNonDeterminism(t *testing.T) {
+	tests := []struct {
+		name    string
+		history []*types.HistoryEvent
+		want    bool
+	}{
+		{
+			name: "last decision completed",
+			history: []*types.HistoryEvent{
+				{ID: 4, EventType: types.EventTypeDecisionTaskFailed.Ptr(), DecisionTaskFailedEventAttributes: &types.DecisionTaskFailedEventAttributes{
+					Cause: types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.Ptr(),
+				}},
+				{ID: 7, EventType: types.EventTypeDecisionTaskCompleted.Ptr()},
+			},
+		},
+		{
+			name: "last decision failed with unhandled failure",
+			history: []*types.HistoryEvent{
+				{ID: 4, EventType: types.EventTypeDecisionTaskFailed.Ptr(), DecisionTaskFailedEventAttributes: &types.DecisionTaskFailedEventAttributes{
+					Cause: types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.Ptr(),
+				}},
+			},
+			want: true,
+		},
+		{
+			name: "last decision failed with nondeterministic details",
+			history: []*types.HistoryEvent{
+				{ID: 4, EventType: types.EventTypeDecisionTaskFailed.Ptr(), DecisionTaskFailedEventAttributes: &types.DecisionTaskFailedEventAttributes{
+					Cause:   types.DecisionTaskFailedCauseBadBinary.Ptr(),
+					Details: []byte("nondeterministic workflow"),
+				}},
+			},
+			want: true,
+		},
+		{
+			name: "last decision failed with another error",
+			history: []*types.HistoryEvent{
+				{ID: 4, EventType: types.EventTypeDecisionTaskFailed.Ptr(), DecisionTaskFailedEventAttributes: &types.DecisionTaskFailedEventAttributes{
+					Cause: types.DecisionTaskFailedCauseBadBinary.Ptr(),
+				}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := frontend.NewMockClient(ctrl)
+			client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
+				History: &types.History{Events: tt.history},
+			}, nil)
+
+			got, err := IsLastDecisionFailedWithNonDeterminism(context.Background(), client, "test-domain", "wid", "rid")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
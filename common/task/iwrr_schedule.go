@@ -65,6 +65,16 @@ func newIWRRSchedule[K comparable, V any](items map[K]weightedContainer[V]) *iwr
 	}
 }
 
+// NewWeightedSchedule creates an IWRR schedule over the given keys, where each key
+// is returned as many times per iteration as its weight. Keys with weight <= 0 are ignored
+func NewWeightedSchedule[K comparable](weights map[K]int) Schedule[K] {
+	items := make(map[K]weightedContainer[K], len(weights))
+	for key, weight := range weights {
+		items[key] = weightedContainer[K]{weight: weight, item: key}
+	}
+	return newIWRRSchedule(items)
+}
+
 // NewIterator creates a new stateful iterator for this schedule
 func (s *iwrrSchedule[V]) NewIterator() Iterator[V] {
 	if len(s.items) == 0 {
@@ -348,3 +348,26 @@ func TestIWRRSchedule_StatelessSchedule_MultipleIterators(t *testing.T) {
 	require.True(t, ok2)
 	assert.Equal(t, item1, i2)
 }
+
+func TestNewWeightedSchedule(t *testing.T) {
+	schedule := NewWeightedSchedule(map[string]int{
+		"a": 3,
+		"b": 1,
+		"c": 0,
+	})
+
+	// Keys with non-positive weight are ignored
+	assert.Equal(t, 4, schedule.Len())
+
+	// IWRR for weights [3, 1]: [a, a, a, b]
+	iter := schedule.NewIterator()
+	var keys []string
+	for {
+		key, ok := iter.TryNext()
+		if !ok {
+			break
+		}
+		keys = append(keys, key)
+	}
+	assert.Equal(t, []string{"a", "a", "a", "b"}, keys)
+}
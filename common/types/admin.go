@@ -474,3 +474,17 @@ type UpdateTaskListPartitionConfigRequest struct {
 }
 
 type UpdateTaskListPartitionConfigResponse struct{}
+
+// RollbackDomainRequest restores the mutable configuration of a domain to its state after the domain audit log entry EventID
+type RollbackDomainRequest struct {
+	Domain  string
+	EventID string
+}
+
+type RollbackDomainResponse struct {
+	DomainInfo               *DomainInfo
+	Configuration            *DomainConfiguration
+	ReplicationConfiguration *DomainReplicationConfiguration
+	FailoverVersion          int64
+	IsGlobalDomain           bool
+}
@@ -1534,7 +1534,7 @@ func TestGetFailoverInfoResponse_GetPendingShards(t *testing.T) {
 }
 
 func TestQueueState_Copy(t *testing.T) {
-	f := fuzz.New().NilChance(0.1)
+	f := fuzz.New().NilChance(0.1).MaxDepth(10) // predicates are recursive
 	info := &QueueState{}
 	for i := 0; i < 1000; i++ {
 		f.Fuzz(info)
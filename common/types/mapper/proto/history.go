@@ -1622,3 +1622,68 @@ func ToHistoryRatelimitUpdateResponse(t *historyv1.RatelimitUpdateResponse) *typ
 		Any: ToAny(t.Data),
 	}
 }
+
+func FromHistoryUpdateVirtualQueueRequest(t *types.UpdateVirtualQueueRequest) *historyv1.UpdateVirtualQueueRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UpdateVirtualQueueRequest{
+		ShardId:              t.ShardID,
+		ClusterName:          t.ClusterName,
+		TaskType:             FromTaskType(t.Type),
+		Operation:            FromVirtualQueueOperation(t.Operation),
+		VirtualQueueId:       t.VirtualQueueID,
+		TargetVirtualQueueId: t.TargetVirtualQueueID,
+		DomainIds:            t.DomainIDs,
+		PauseDuration:        secondsToDuration(common.Int32Ptr(t.PauseDurationInSeconds)),
+	}
+}
+
+func ToHistoryUpdateVirtualQueueRequest(t *historyv1.UpdateVirtualQueueRequest) *types.UpdateVirtualQueueRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateVirtualQueueRequest{
+		ShardID:                t.ShardId,
+		ClusterName:            t.ClusterName,
+		Type:                   ToTaskType(t.TaskType),
+		Operation:              ToVirtualQueueOperation(t.Operation),
+		VirtualQueueID:         t.VirtualQueueId,
+		TargetVirtualQueueID:   t.TargetVirtualQueueId,
+		DomainIDs:              t.DomainIds,
+		PauseDurationInSeconds: common.Int32Default(durationToSeconds(t.PauseDuration)),
+	}
+}
+
+func FromVirtualQueueOperation(t *types.VirtualQueueOperation) historyv1.VirtualQueueOperation {
+	if t == nil {
+		return historyv1.VirtualQueueOperation_VIRTUAL_QUEUE_OPERATION_INVALID
+	}
+	switch *t {
+	case types.VirtualQueueOperationMoveDomains:
+		return historyv1.VirtualQueueOperation_VIRTUAL_QUEUE_OPERATION_MOVE_DOMAINS
+	case types.VirtualQueueOperationPause:
+		return historyv1.VirtualQueueOperation_VIRTUAL_QUEUE_OPERATION_PAUSE
+	case types.VirtualQueueOperationResume:
+		return historyv1.VirtualQueueOperation_VIRTUAL_QUEUE_OPERATION_RESUME
+	case types.VirtualQueueOperationMerge:
+		return historyv1.VirtualQueueOperation_VIRTUAL_QUEUE_OPERATION_MERGE
+	}
+	return historyv1.VirtualQueueOperation_VIRTUAL_QUEUE_OPERATION_INVALID
+}
+
+func ToVirtualQueueOperation(t historyv1.VirtualQueueOperation) *types.VirtualQueueOperation {
+	switch t {
+	case historyv1.VirtualQueueOperation_VIRTUAL_QUEUE_OPERATION_INVALID:
+		return nil
+	case historyv1.VirtualQueueOperation_VIRTUAL_QUEUE_OPERATION_MOVE_DOMAINS:
+		return types.VirtualQueueOperationMoveDomains.Ptr()
+	case historyv1.VirtualQueueOperation_VIRTUAL_QUEUE_OPERATION_PAUSE:
+		return types.VirtualQueueOperationPause.Ptr()
+	case historyv1.VirtualQueueOperation_VIRTUAL_QUEUE_OPERATION_RESUME:
+		return types.VirtualQueueOperationResume.Ptr()
+	case historyv1.VirtualQueueOperation_VIRTUAL_QUEUE_OPERATION_MERGE:
+		return types.VirtualQueueOperationMerge.Ptr()
+	}
+	return nil
+}
@@ -241,6 +241,20 @@ func TestHistoryResetQueueRequest(t *testing.T) {
 		assert.Equal(t, item, ToHistoryResetQueueRequest(FromHistoryResetQueueRequest(item)))
 	}
 }
+func TestHistoryUpdateVirtualQueueRequest(t *testing.T) {
+	for _, item := range []*types.UpdateVirtualQueueRequest{nil, {}, &testdata.HistoryUpdateVirtualQueueRequest} {
+		assert.Equal(t, item, ToHistoryUpdateVirtualQueueRequest(FromHistoryUpdateVirtualQueueRequest(item)))
+	}
+	for _, item := range []*types.VirtualQueueOperation{
+		nil,
+		types.VirtualQueueOperationMoveDomains.Ptr(),
+		types.VirtualQueueOperationPause.Ptr(),
+		types.VirtualQueueOperationResume.Ptr(),
+		types.VirtualQueueOperationMerge.Ptr(),
+	} {
+		assert.Equal(t, item, ToVirtualQueueOperation(FromVirtualQueueOperation(item)))
+	}
+}
 func TestHistoryResetStickyTaskListRequest(t *testing.T) {
 	for _, item := range []*types.HistoryResetStickyTaskListRequest{nil, {}, &testdata.HistoryResetStickyTaskListRequest} {
 		assert.Equal(t, item, ToHistoryResetStickyTaskListRequest(FromHistoryResetStickyTaskListRequest(item)))
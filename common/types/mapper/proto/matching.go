@@ -41,6 +41,8 @@ func FromMatchingAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matchi
 		ForwardedFrom:            t.ForwardedFrom,
 		ActivityTaskDispatchInfo: FromActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:          t.PartitionConfig,
+		Priority:                 t.Priority,
+		FairnessKey:              t.FairnessKey,
 	}
 }
 
@@ -59,6 +61,8 @@ func ToMatchingAddActivityTaskRequest(t *matchingv1.AddActivityTaskRequest) *typ
 		ForwardedFrom:                 t.ForwardedFrom,
 		ActivityTaskDispatchInfo:      ToActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      t.Priority,
+		FairnessKey:                   t.FairnessKey,
 	}
 }
 
@@ -75,6 +79,7 @@ func FromMatchingAddDecisionTaskRequest(t *types.AddDecisionTaskRequest) *matchi
 		Source:                 FromTaskSource(t.Source),
 		ForwardedFrom:          t.ForwardedFrom,
 		PartitionConfig:        t.PartitionConfig,
+		Priority:               t.Priority,
 	}
 }
 
@@ -91,6 +96,7 @@ func ToMatchingAddDecisionTaskRequest(t *matchingv1.AddDecisionTaskRequest) *typ
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.ForwardedFrom,
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      t.Priority,
 	}
 }
 
@@ -217,10 +223,12 @@ func FromMatchingDescribeTaskListResponse(t *types.DescribeTaskListResponse) *ma
 		return nil
 	}
 	return &matchingv1.DescribeTaskListResponse{
-		Pollers:         FromPollerInfoArray(t.Pollers),
-		TaskListStatus:  FromTaskListStatus(t.TaskListStatus),
-		PartitionConfig: FromAPITaskListPartitionConfig(t.PartitionConfig),
-		TaskList:        FromTaskList(t.TaskList),
+		Pollers:                   FromPollerInfoArray(t.Pollers),
+		TaskListStatus:            FromTaskListStatus(t.TaskListStatus),
+		PartitionConfig:           FromAPITaskListPartitionConfig(t.PartitionConfig),
+		TaskList:                  FromTaskList(t.TaskList),
+		BacklogCountByPriority:    t.TaskListStatus.GetBacklogCountByPriority(),
+		BacklogCountByFairnessKey: t.TaskListStatus.GetBacklogCountByFairnessKey(),
 	}
 }
 
@@ -228,9 +236,15 @@ func ToMatchingDescribeTaskListResponse(t *matchingv1.DescribeTaskListResponse)
 	if t == nil {
 		return nil
 	}
+	// the api TaskListStatus has no per-priority or per-fairness-key backlog, so matching carries them alongside the status
+	taskListStatus := ToTaskListStatus(t.TaskListStatus)
+	if taskListStatus != nil {
+		taskListStatus.BacklogCountByPriority = t.BacklogCountByPriority
+		taskListStatus.BacklogCountByFairnessKey = t.BacklogCountByFairnessKey
+	}
 	return &types.DescribeTaskListResponse{
 		Pollers:         ToPollerInfoArray(t.Pollers),
-		TaskListStatus:  ToTaskListStatus(t.TaskListStatus),
+		TaskListStatus:  taskListStatus,
 		PartitionConfig: ToAPITaskListPartitionConfig(t.PartitionConfig),
 		TaskList:        ToTaskList(t.TaskList),
 	}
@@ -72,6 +72,26 @@ func TestMatchingDescribeTaskListResponse(t *testing.T) {
 	}
 }
 
+func TestMatchingDescribeTaskListResponseBacklogCountByPriority(t *testing.T) {
+	item := &types.DescribeTaskListResponse{
+		TaskListStatus: &types.TaskListStatus{
+			BacklogCountHint:       12,
+			BacklogCountByPriority: map[int32]int64{0: 10, 5: 2},
+		},
+	}
+	assert.Equal(t, item, ToMatchingDescribeTaskListResponse(FromMatchingDescribeTaskListResponse(item)))
+}
+
+func TestMatchingDescribeTaskListResponseBacklogCountByFairnessKey(t *testing.T) {
+	item := &types.DescribeTaskListResponse{
+		TaskListStatus: &types.TaskListStatus{
+			BacklogCountHint:          12,
+			BacklogCountByFairnessKey: map[string]int64{"tenant-a": 10, "tenant-b": 2},
+		},
+	}
+	assert.Equal(t, item, ToMatchingDescribeTaskListResponse(FromMatchingDescribeTaskListResponse(item)))
+}
+
 func TestMatchingDescribeTaskListResponseMap(t *testing.T) {
 	for _, item := range []map[string]*types.DescribeTaskListResponse{nil, {}, testdata.DescribeTaskListResponseMap} {
 		assert.Equal(t, item, ToMatchingDescribeTaskListResponseMap(FromMatchingDescribeTaskListResponseMap(item)))
@@ -86,6 +86,8 @@ func ToScheduleCatchUpPolicy(p apiv1.ScheduleCatchUpPolicy) types.ScheduleCatchU
 
 // --- Core type mappers ---
 
+// FromScheduleSpec maps the fields carried by the IDL. The frontend rejects additional
+// cron expressions, intervals, excluded dates and time zone until the IDL spec is extended.
 func FromScheduleSpec(t *types.ScheduleSpec) *apiv1.ScheduleSpec {
 	if t == nil {
 		return nil
@@ -144,6 +146,8 @@ func ToStartWorkflowAction(t *apiv1.ScheduleAction_StartWorkflowAction) *types.S
 	}
 }
 
+// FromScheduleAction maps the actions carried by the IDL. The frontend rejects
+// SignalWithStartWorkflow actions until the IDL action is extended.
 func FromScheduleAction(t *types.ScheduleAction) *apiv1.ScheduleAction {
 	if t == nil {
 		return nil
@@ -282,6 +286,8 @@ func ToBackfillInfoArray(t []*apiv1.BackfillInfo) []*types.BackfillInfo {
 	return v
 }
 
+// FromScheduleInfo maps the fields carried by the IDL. RecentActions is only
+// populated for in-process callers until the IDL info is extended.
 func FromScheduleInfo(t *types.ScheduleInfo) *apiv1.ScheduleInfo {
 	if t == nil {
 		return nil
@@ -310,6 +316,8 @@ func ToScheduleInfo(t *apiv1.ScheduleInfo) *types.ScheduleInfo {
 	}
 }
 
+// FromScheduleListEntry maps the fields carried by the IDL. Spec and Info are
+// only populated for in-process callers until the IDL entry is extended.
 func FromScheduleListEntry(t *types.ScheduleListEntry) *apiv1.ScheduleListEntry {
 	if t == nil {
 		return nil
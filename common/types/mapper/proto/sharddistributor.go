@@ -79,19 +79,7 @@ func FromShardDistributorExecutorHeartbeatRequest(t *types.ExecutorHeartbeatRequ
 	}
 
 	// Convert the ExecutorStatus enum
-	var status sharddistributorv1.ExecutorStatus
-	switch t.GetStatus() {
-	case types.ExecutorStatusINVALID:
-		status = sharddistributorv1.ExecutorStatus_EXECUTOR_STATUS_INVALID
-	case types.ExecutorStatusACTIVE:
-		status = sharddistributorv1.ExecutorStatus_EXECUTOR_STATUS_ACTIVE
-	case types.ExecutorStatusDRAINING:
-		status = sharddistributorv1.ExecutorStatus_EXECUTOR_STATUS_DRAINING
-	case types.ExecutorStatusDRAINED:
-		status = sharddistributorv1.ExecutorStatus_EXECUTOR_STATUS_DRAINED
-	default:
-		status = sharddistributorv1.ExecutorStatus_EXECUTOR_STATUS_INVALID
-	}
+	status := fromExecutorStatus(t.GetStatus())
 
 	// Convert the ShardStatusReports
 	var shardStatusReports map[string]*sharddistributorv1.ShardStatusReport
@@ -133,19 +121,7 @@ func ToShardDistributorExecutorHeartbeatRequest(t *sharddistributorv1.HeartbeatR
 	}
 
 	// Convert the ExecutorStatus enum
-	var status types.ExecutorStatus
-	switch t.GetStatus() {
-	case sharddistributorv1.ExecutorStatus_EXECUTOR_STATUS_INVALID:
-		status = types.ExecutorStatusINVALID
-	case sharddistributorv1.ExecutorStatus_EXECUTOR_STATUS_ACTIVE:
-		status = types.ExecutorStatusACTIVE
-	case sharddistributorv1.ExecutorStatus_EXECUTOR_STATUS_DRAINING:
-		status = types.ExecutorStatusDRAINING
-	case sharddistributorv1.ExecutorStatus_EXECUTOR_STATUS_DRAINED:
-		status = types.ExecutorStatusDRAINED
-	default:
-		status = types.ExecutorStatusINVALID
-	}
+	status := toExecutorStatus(t.GetStatus())
 
 	// Convert the ShardStatusReports
 	var shardStatusReports map[string]*types.ShardStatusReport
@@ -243,6 +219,72 @@ func ToShardDistributorExecutorHeartbeatResponse(t *sharddistributorv1.Heartbeat
 	}
 }
 
+func FromShardDistributorExecutorDrainRequest(t *types.ExecutorDrainRequest) *sharddistributorv1.DrainRequest {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.DrainRequest{
+		Namespace:  t.GetNamespace(),
+		ExecutorId: t.GetExecutorID(),
+	}
+}
+
+func ToShardDistributorExecutorDrainRequest(t *sharddistributorv1.DrainRequest) *types.ExecutorDrainRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ExecutorDrainRequest{
+		Namespace:  t.GetNamespace(),
+		ExecutorID: t.GetExecutorId(),
+	}
+}
+
+func FromShardDistributorExecutorDrainResponse(t *types.ExecutorDrainResponse) *sharddistributorv1.DrainResponse {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.DrainResponse{
+		Status:          fromExecutorStatus(t.GetStatus()),
+		RemainingShards: t.GetRemainingShards(),
+	}
+}
+
+func ToShardDistributorExecutorDrainResponse(t *sharddistributorv1.DrainResponse) *types.ExecutorDrainResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ExecutorDrainResponse{
+		Status:          toExecutorStatus(t.GetStatus()),
+		RemainingShards: t.GetRemainingShards(),
+	}
+}
+
+func fromExecutorStatus(status types.ExecutorStatus) sharddistributorv1.ExecutorStatus {
+	switch status {
+	case types.ExecutorStatusACTIVE:
+		return sharddistributorv1.ExecutorStatus_EXECUTOR_STATUS_ACTIVE
+	case types.ExecutorStatusDRAINING:
+		return sharddistributorv1.ExecutorStatus_EXECUTOR_STATUS_DRAINING
+	case types.ExecutorStatusDRAINED:
+		return sharddistributorv1.ExecutorStatus_EXECUTOR_STATUS_DRAINED
+	default:
+		return sharddistributorv1.ExecutorStatus_EXECUTOR_STATUS_INVALID
+	}
+}
+
+func toExecutorStatus(status sharddistributorv1.ExecutorStatus) types.ExecutorStatus {
+	switch status {
+	case sharddistributorv1.ExecutorStatus_EXECUTOR_STATUS_ACTIVE:
+		return types.ExecutorStatusACTIVE
+	case sharddistributorv1.ExecutorStatus_EXECUTOR_STATUS_DRAINING:
+		return types.ExecutorStatusDRAINING
+	case sharddistributorv1.ExecutorStatus_EXECUTOR_STATUS_DRAINED:
+		return types.ExecutorStatusDRAINED
+	default:
+		return types.ExecutorStatusINVALID
+	}
+}
+
 func getMigrationModeFromProto(protoMigrationMode sharddistributorv1.MigrationMode) types.MigrationMode {
 	var mode types.MigrationMode
 	switch protoMigrationMode {
@@ -357,3 +399,263 @@ func ToShardDistributorWatchNamespaceStateResponse(t *sharddistributorv1.WatchNa
 		Executors: executors,
 	}
 }
+
+// FromShardDistributorListNamespacesRequest converts a types.ListNamespacesRequest to a sharddistributor.ListNamespacesRequest
+func FromShardDistributorListNamespacesRequest(t *types.ListNamespacesRequest) *sharddistributorv1.ListNamespacesRequest {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.ListNamespacesRequest{}
+}
+
+// ToShardDistributorListNamespacesRequest converts a sharddistributor.ListNamespacesRequest to a types.ListNamespacesRequest
+func ToShardDistributorListNamespacesRequest(t *sharddistributorv1.ListNamespacesRequest) *types.ListNamespacesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListNamespacesRequest{}
+}
+
+// FromShardDistributorListNamespacesResponse converts a types.ListNamespacesResponse to a sharddistributor.ListNamespacesResponse
+func FromShardDistributorListNamespacesResponse(t *types.ListNamespacesResponse) *sharddistributorv1.ListNamespacesResponse {
+	if t == nil {
+		return nil
+	}
+	var namespaces []*sharddistributorv1.NamespaceInfo
+	for _, namespace := range t.GetNamespaces() {
+		namespaces = append(namespaces, &sharddistributorv1.NamespaceInfo{
+			Name:     namespace.GetName(),
+			Type:     namespace.GetType(),
+			Mode:     namespace.GetMode(),
+			ShardNum: namespace.GetShardNum(),
+		})
+	}
+	return &sharddistributorv1.ListNamespacesResponse{
+		Namespaces: namespaces,
+	}
+}
+
+// ToShardDistributorListNamespacesResponse converts a sharddistributor.ListNamespacesResponse to a types.ListNamespacesResponse
+func ToShardDistributorListNamespacesResponse(t *sharddistributorv1.ListNamespacesResponse) *types.ListNamespacesResponse {
+	if t == nil {
+		return nil
+	}
+	var namespaces []*types.NamespaceInfo
+	for _, namespace := range t.GetNamespaces() {
+		namespaces = append(namespaces, &types.NamespaceInfo{
+			Name:     namespace.GetName(),
+			Type:     namespace.GetType(),
+			Mode:     namespace.GetMode(),
+			ShardNum: namespace.GetShardNum(),
+		})
+	}
+	return &types.ListNamespacesResponse{
+		Namespaces: namespaces,
+	}
+}
+
+// FromShardDistributorListExecutorsRequest converts a types.ListExecutorsRequest to a sharddistributor.ListExecutorsRequest
+func FromShardDistributorListExecutorsRequest(t *types.ListExecutorsRequest) *sharddistributorv1.ListExecutorsRequest {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.ListExecutorsRequest{
+		Namespace: t.GetNamespace(),
+	}
+}
+
+// ToShardDistributorListExecutorsRequest converts a sharddistributor.ListExecutorsRequest to a types.ListExecutorsRequest
+func ToShardDistributorListExecutorsRequest(t *sharddistributorv1.ListExecutorsRequest) *types.ListExecutorsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListExecutorsRequest{
+		Namespace: t.GetNamespace(),
+	}
+}
+
+// FromShardDistributorListExecutorsResponse converts a types.ListExecutorsResponse to a sharddistributor.ListExecutorsResponse
+func FromShardDistributorListExecutorsResponse(t *types.ListExecutorsResponse) *sharddistributorv1.ListExecutorsResponse {
+	if t == nil {
+		return nil
+	}
+	var executors []*sharddistributorv1.ExecutorSummary
+	for _, executor := range t.GetExecutors() {
+		executors = append(executors, &sharddistributorv1.ExecutorSummary{
+			ExecutorId:     executor.GetExecutorID(),
+			Status:         fromExecutorStatus(executor.GetStatus()),
+			LastHeartbeat:  unixNanoToTime(executor.LastHeartbeat),
+			AssignedShards: executor.GetAssignedShards(),
+			ReportedShards: executor.GetReportedShards(),
+			Metadata:       executor.GetMetadata(),
+		})
+	}
+	return &sharddistributorv1.ListExecutorsResponse{
+		Executors: executors,
+	}
+}
+
+// ToShardDistributorListExecutorsResponse converts a sharddistributor.ListExecutorsResponse to a types.ListExecutorsResponse
+func ToShardDistributorListExecutorsResponse(t *sharddistributorv1.ListExecutorsResponse) *types.ListExecutorsResponse {
+	if t == nil {
+		return nil
+	}
+	var executors []*types.ExecutorSummary
+	for _, executor := range t.GetExecutors() {
+		executors = append(executors, &types.ExecutorSummary{
+			ExecutorID:     executor.GetExecutorId(),
+			Status:         toExecutorStatus(executor.GetStatus()),
+			LastHeartbeat:  timeToUnixNano(executor.GetLastHeartbeat()),
+			AssignedShards: executor.GetAssignedShards(),
+			ReportedShards: executor.GetReportedShards(),
+			Metadata:       executor.GetMetadata(),
+		})
+	}
+	return &types.ListExecutorsResponse{
+		Executors: executors,
+	}
+}
+
+// FromShardDistributorDescribeShardRequest converts a types.DescribeShardRequest to a sharddistributor.DescribeShardRequest
+func FromShardDistributorDescribeShardRequest(t *types.DescribeShardRequest) *sharddistributorv1.DescribeShardRequest {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.DescribeShardRequest{
+		Namespace: t.GetNamespace(),
+		ShardKey:  t.GetShardKey(),
+	}
+}
+
+// ToShardDistributorDescribeShardRequest converts a sharddistributor.DescribeShardRequest to a types.DescribeShardRequest
+func ToShardDistributorDescribeShardRequest(t *sharddistributorv1.DescribeShardRequest) *types.DescribeShardRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeShardRequest{
+		Namespace: t.GetNamespace(),
+		ShardKey:  t.GetShardKey(),
+	}
+}
+
+// FromShardDistributorDescribeShardResponse converts a types.DescribeShardResponse to a sharddistributor.DescribeShardResponse
+func FromShardDistributorDescribeShardResponse(t *types.DescribeShardResponse) *sharddistributorv1.DescribeShardResponse {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.DescribeShardResponse{
+		Owner:      t.GetOwner(),
+		Metadata:   t.GetMetadata(),
+		Statistics: fromShardStatistics(t.GetStatistics()),
+	}
+}
+
+// ToShardDistributorDescribeShardResponse converts a sharddistributor.DescribeShardResponse to a types.DescribeShardResponse
+func ToShardDistributorDescribeShardResponse(t *sharddistributorv1.DescribeShardResponse) *types.DescribeShardResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeShardResponse{
+		Owner:      t.GetOwner(),
+		Metadata:   t.GetMetadata(),
+		Statistics: toShardStatistics(t.GetStatistics()),
+	}
+}
+
+func fromShardStatistics(t *types.ShardStatistics) *sharddistributorv1.ShardStatistics {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.ShardStatistics{
+		SmoothedLoad:   t.GetSmoothedLoad(),
+		LastUpdateTime: unixNanoToTime(t.LastUpdateTime),
+		LastMoveTime:   unixNanoToTime(t.LastMoveTime),
+	}
+}
+
+func toShardStatistics(t *sharddistributorv1.ShardStatistics) *types.ShardStatistics {
+	if t == nil {
+		return nil
+	}
+	return &types.ShardStatistics{
+		SmoothedLoad:   t.GetSmoothedLoad(),
+		LastUpdateTime: timeToUnixNano(t.GetLastUpdateTime()),
+		LastMoveTime:   timeToUnixNano(t.GetLastMoveTime()),
+	}
+}
+
+// FromShardDistributorTriggerRebalanceRequest converts a types.TriggerRebalanceRequest to a sharddistributor.TriggerRebalanceRequest
+func FromShardDistributorTriggerRebalanceRequest(t *types.TriggerRebalanceRequest) *sharddistributorv1.TriggerRebalanceRequest {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.TriggerRebalanceRequest{
+		Namespace: t.GetNamespace(),
+	}
+}
+
+// ToShardDistributorTriggerRebalanceRequest converts a sharddistributor.TriggerRebalanceRequest to a types.TriggerRebalanceRequest
+func ToShardDistributorTriggerRebalanceRequest(t *sharddistributorv1.TriggerRebalanceRequest) *types.TriggerRebalanceRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.TriggerRebalanceRequest{
+		Namespace: t.GetNamespace(),
+	}
+}
+
+// FromShardDistributorTriggerRebalanceResponse converts a types.TriggerRebalanceResponse to a sharddistributor.TriggerRebalanceResponse
+func FromShardDistributorTriggerRebalanceResponse(t *types.TriggerRebalanceResponse) *sharddistributorv1.TriggerRebalanceResponse {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.TriggerRebalanceResponse{}
+}
+
+// ToShardDistributorTriggerRebalanceResponse converts a sharddistributor.TriggerRebalanceResponse to a types.TriggerRebalanceResponse
+func ToShardDistributorTriggerRebalanceResponse(t *sharddistributorv1.TriggerRebalanceResponse) *types.TriggerRebalanceResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.TriggerRebalanceResponse{}
+}
+
+// FromShardDistributorMoveShardRequest converts a types.MoveShardRequest to a sharddistributor.MoveShardRequest
+func FromShardDistributorMoveShardRequest(t *types.MoveShardRequest) *sharddistributorv1.MoveShardRequest {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.MoveShardRequest{
+		Namespace:  t.GetNamespace(),
+		ShardKey:   t.GetShardKey(),
+		ExecutorId: t.GetExecutorID(),
+	}
+}
+
+// ToShardDistributorMoveShardRequest converts a sharddistributor.MoveShardRequest to a types.MoveShardRequest
+func ToShardDistributorMoveShardRequest(t *sharddistributorv1.MoveShardRequest) *types.MoveShardRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MoveShardRequest{
+		Namespace:  t.GetNamespace(),
+		ShardKey:   t.GetShardKey(),
+		ExecutorID: t.GetExecutorId(),
+	}
+}
+
+// FromShardDistributorMoveShardResponse converts a types.MoveShardResponse to a sharddistributor.MoveShardResponse
+func FromShardDistributorMoveShardResponse(t *types.MoveShardResponse) *sharddistributorv1.MoveShardResponse {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.MoveShardResponse{}
+}
+
+// ToShardDistributorMoveShardResponse converts a sharddistributor.MoveShardResponse to a types.MoveShardResponse
+func ToShardDistributorMoveShardResponse(t *sharddistributorv1.MoveShardResponse) *types.MoveShardResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MoveShardResponse{}
+}
@@ -55,6 +55,18 @@ func TestToShardDistributorExecutorHeartbeatResponse(t *testing.T) {
 	}
 }
 
+func TestFromShardDistributorExecutorDrainRequest(t *testing.T) {
+	for _, item := range []*types.ExecutorDrainRequest{nil, {}, &testdata.ShardDistributorExecutorDrainRequest} {
+		assert.Equal(t, item, ToShardDistributorExecutorDrainRequest(FromShardDistributorExecutorDrainRequest(item)))
+	}
+}
+
+func TestToShardDistributorExecutorDrainResponse(t *testing.T) {
+	for _, item := range []*types.ExecutorDrainResponse{nil, {}, &testdata.ShardDistributorExecutorDrainResponse} {
+		assert.Equal(t, item, ToShardDistributorExecutorDrainResponse(FromShardDistributorExecutorDrainResponse(item)))
+	}
+}
+
 func TestFromShardDistributorWatchNamespaceStateRequest(t *testing.T) {
 	for _, item := range []*types.WatchNamespaceStateRequest{nil, {}, &testdata.ShardDistributorWatchNamespaceStateRequest} {
 		assert.Equal(t, item, ToShardDistributorWatchNamespaceStateRequest(FromShardDistributorWatchNamespaceStateRequest(item)))
@@ -66,3 +78,63 @@ func TestFromShardDistributorWatchNamespaceStateResponse(t *testing.T) {
 		assert.Equal(t, item, ToShardDistributorWatchNamespaceStateResponse(FromShardDistributorWatchNamespaceStateResponse(item)))
 	}
 }
+
+func TestFromShardDistributorListNamespacesRequest(t *testing.T) {
+	for _, item := range []*types.ListNamespacesRequest{nil, {}} {
+		assert.Equal(t, item, ToShardDistributorListNamespacesRequest(FromShardDistributorListNamespacesRequest(item)))
+	}
+}
+
+func TestFromShardDistributorListNamespacesResponse(t *testing.T) {
+	for _, item := range []*types.ListNamespacesResponse{nil, {}, &testdata.ShardDistributorListNamespacesResponse} {
+		assert.Equal(t, item, ToShardDistributorListNamespacesResponse(FromShardDistributorListNamespacesResponse(item)))
+	}
+}
+
+func TestFromShardDistributorListExecutorsRequest(t *testing.T) {
+	for _, item := range []*types.ListExecutorsRequest{nil, {}, &testdata.ShardDistributorListExecutorsRequest} {
+		assert.Equal(t, item, ToShardDistributorListExecutorsRequest(FromShardDistributorListExecutorsRequest(item)))
+	}
+}
+
+func TestFromShardDistributorListExecutorsResponse(t *testing.T) {
+	for _, item := range []*types.ListExecutorsResponse{nil, {}, &testdata.ShardDistributorListExecutorsResponse} {
+		assert.Equal(t, item, ToShardDistributorListExecutorsResponse(FromShardDistributorListExecutorsResponse(item)))
+	}
+}
+
+func TestFromShardDistributorDescribeShardRequest(t *testing.T) {
+	for _, item := range []*types.DescribeShardRequest{nil, {}, &testdata.ShardDistributorDescribeShardRequest} {
+		assert.Equal(t, item, ToShardDistributorDescribeShardRequest(FromShardDistributorDescribeShardRequest(item)))
+	}
+}
+
+func TestFromShardDistributorDescribeShardResponse(t *testing.T) {
+	for _, item := range []*types.DescribeShardResponse{nil, {}, &testdata.ShardDistributorDescribeShardResponse} {
+		assert.Equal(t, item, ToShardDistributorDescribeShardResponse(FromShardDistributorDescribeShardResponse(item)))
+	}
+}
+
+func TestFromShardDistributorTriggerRebalanceRequest(t *testing.T) {
+	for _, item := range []*types.TriggerRebalanceRequest{nil, {}, &testdata.ShardDistributorTriggerRebalanceRequest} {
+		assert.Equal(t, item, ToShardDistributorTriggerRebalanceRequest(FromShardDistributorTriggerRebalanceRequest(item)))
+	}
+}
+
+func TestFromShardDistributorTriggerRebalanceResponse(t *testing.T) {
+	for _, item := range []*types.TriggerRebalanceResponse{nil, {}} {
+		assert.Equal(t, item, ToShardDistributorTriggerRebalanceResponse(FromShardDistributorTriggerRebalanceResponse(item)))
+	}
+}
+
+func TestFromShardDistributorMoveShardRequest(t *testing.T) {
+	for _, item := range []*types.MoveShardRequest{nil, {}, &testdata.ShardDistributorMoveShardRequest} {
+		assert.Equal(t, item, ToShardDistributorMoveShardRequest(FromShardDistributorMoveShardRequest(item)))
+	}
+}
+
+func TestFromShardDistributorMoveShardResponse(t *testing.T) {
+	for _, item := range []*types.MoveShardResponse{nil, {}} {
+		assert.Equal(t, item, ToShardDistributorMoveShardResponse(FromShardDistributorMoveShardResponse(item)))
+	}
+}
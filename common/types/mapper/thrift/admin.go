@@ -992,5 +992,51 @@ func ToAdminUpdateDomainAsyncWorkflowConfiguratonResponse(in *admin.UpdateDomain
 	return &types.UpdateDomainAsyncWorkflowConfiguratonResponse{}
 }
 
+func FromAdminRollbackDomainRequest(t *types.RollbackDomainRequest) *admin.RollbackDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.RollbackDomainRequest{
+		Domain:  &t.Domain,
+		EventID: &t.EventID,
+	}
+}
+
+func ToAdminRollbackDomainRequest(t *admin.RollbackDomainRequest) *types.RollbackDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RollbackDomainRequest{
+		Domain:  t.GetDomain(),
+		EventID: t.GetEventID(),
+	}
+}
+
+func FromAdminRollbackDomainResponse(t *types.RollbackDomainResponse) *admin.RollbackDomainResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.RollbackDomainResponse{
+		DomainInfo:               FromDomainInfo(t.DomainInfo),
+		Configuration:            FromDomainConfiguration(t.Configuration),
+		ReplicationConfiguration: FromDomainReplicationConfiguration(t.ReplicationConfiguration),
+		FailoverVersion:          &t.FailoverVersion,
+		IsGlobalDomain:           &t.IsGlobalDomain,
+	}
+}
+
+func ToAdminRollbackDomainResponse(t *admin.RollbackDomainResponse) *types.RollbackDomainResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.RollbackDomainResponse{
+		DomainInfo:               ToDomainInfo(t.DomainInfo),
+		Configuration:            ToDomainConfiguration(t.Configuration),
+		ReplicationConfiguration: ToDomainReplicationConfiguration(t.ReplicationConfiguration),
+		FailoverVersion:          t.GetFailoverVersion(),
+		IsGlobalDomain:           t.GetIsGlobalDomain(),
+	}
+}
+
 func strPtr(s string) *string                                             { return &s }
 func igStatePtr(s shared.IsolationGroupState) *shared.IsolationGroupState { return &s }
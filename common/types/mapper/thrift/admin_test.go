@@ -205,6 +205,24 @@ func TestAdminResetQueueRequest(t *testing.T) {
 	}
 }
 
+func TestAdminUpdateVirtualQueueRequest(t *testing.T) {
+	for _, item := range []*types.UpdateVirtualQueueRequest{nil, {}, &testdata.AdminUpdateVirtualQueueRequest} {
+		assert.Equal(t, item, ToAdminUpdateVirtualQueueRequest(FromAdminUpdateVirtualQueueRequest(item)))
+	}
+}
+
+func TestVirtualQueueOperation(t *testing.T) {
+	for _, item := range []*types.VirtualQueueOperation{
+		nil,
+		types.VirtualQueueOperationMoveDomains.Ptr(),
+		types.VirtualQueueOperationPause.Ptr(),
+		types.VirtualQueueOperationResume.Ptr(),
+		types.VirtualQueueOperationMerge.Ptr(),
+	} {
+		assert.Equal(t, item, ToVirtualQueueOperation(FromVirtualQueueOperation(item)))
+	}
+}
+
 func TestAdminGetCrossClusterTasksRequest(t *testing.T) {
 	for _, item := range []*types.GetCrossClusterTasksRequest{nil, {}, &testdata.AdminGetCrossClusterTasksRequest} {
 		assert.Equal(t, item, ToAdminGetCrossClusterTasksRequest(FromAdminGetCrossClusterTasksRequest(item)))
@@ -975,3 +993,15 @@ func TestToAdminUpdateDomainAsyncWorkflowConfiguratonResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestAdminRollbackDomainRequest(t *testing.T) {
+	for _, item := range []*types.RollbackDomainRequest{nil, {}, &testdata.AdminRollbackDomainRequest} {
+		assert.Equal(t, item, ToAdminRollbackDomainRequest(FromAdminRollbackDomainRequest(item)))
+	}
+}
+
+func TestAdminRollbackDomainResponse(t *testing.T) {
+	for _, item := range []*types.RollbackDomainResponse{nil, {}, &testdata.AdminRollbackDomainResponse} {
+		assert.Equal(t, item, ToAdminRollbackDomainResponse(FromAdminRollbackDomainResponse(item)))
+	}
+}
@@ -90,6 +90,8 @@ var (
 	ToHistorySignalWithStartWorkflowExecutionResponse    = ToStartWorkflowExecutionResponse
 	FromHistoryStartWorkflowExecutionResponse            = FromStartWorkflowExecutionResponse
 	ToHistoryStartWorkflowExecutionResponse              = ToStartWorkflowExecutionResponse
+	FromHistoryUpdateVirtualQueueRequest                 = FromAdminUpdateVirtualQueueRequest
+	ToHistoryUpdateVirtualQueueRequest                   = ToAdminUpdateVirtualQueueRequest
 )
 
 // FromHistoryDescribeMutableStateRequest converts internal DescribeMutableStateRequest type to thrift
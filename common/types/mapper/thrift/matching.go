@@ -54,6 +54,8 @@ func FromMatchingAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matchi
 		ForwardedFrom:                 &t.ForwardedFrom,
 		ActivityTaskDispatchInfo:      FromActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      &t.Priority,
+		FairnessKey:                   &t.FairnessKey,
 	}
 }
 
@@ -73,6 +75,8 @@ func ToMatchingAddActivityTaskRequest(t *matching.AddActivityTaskRequest) *types
 		ForwardedFrom:                 t.GetForwardedFrom(),
 		ActivityTaskDispatchInfo:      ToActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      t.GetPriority(),
+		FairnessKey:                   t.GetFairnessKey(),
 	}
 }
 
@@ -121,6 +125,7 @@ func FromMatchingAddDecisionTaskRequest(t *types.AddDecisionTaskRequest) *matchi
 		Source:                        FromTaskSource(t.Source),
 		ForwardedFrom:                 &t.ForwardedFrom,
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      &t.Priority,
 	}
 }
 
@@ -138,6 +143,7 @@ func ToMatchingAddDecisionTaskRequest(t *matching.AddDecisionTaskRequest) *types
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.GetForwardedFrom(),
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      t.GetPriority(),
 	}
 }
 
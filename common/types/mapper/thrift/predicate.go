@@ -16,6 +16,27 @@ func FromPredicateType(t types.PredicateType) *shared.PredicateType {
 	case types.PredicateTypeDomainID:
 		v = shared.PredicateTypeDomainID
 		return &v
+	case types.PredicateTypeTaskType:
+		v = shared.PredicateTypeTaskType
+		return &v
+	case types.PredicateTypeTaskList:
+		v = shared.PredicateTypeTaskList
+		return &v
+	case types.PredicateTypeWorkflowID:
+		v = shared.PredicateTypeWorkflowID
+		return &v
+	case types.PredicateTypeWorkflowIDPrefix:
+		v = shared.PredicateTypeWorkflowIDPrefix
+		return &v
+	case types.PredicateTypeAnd:
+		v = shared.PredicateTypeAnd
+		return &v
+	case types.PredicateTypeOr:
+		v = shared.PredicateTypeOr
+		return &v
+	case types.PredicateTypeNot:
+		v = shared.PredicateTypeNot
+		return &v
 	}
 	// default to universal
 	return &v
@@ -32,6 +53,20 @@ func ToPredicateType(t *shared.PredicateType) types.PredicateType {
 		return types.PredicateTypeEmpty
 	case shared.PredicateTypeDomainID:
 		return types.PredicateTypeDomainID
+	case shared.PredicateTypeTaskType:
+		return types.PredicateTypeTaskType
+	case shared.PredicateTypeTaskList:
+		return types.PredicateTypeTaskList
+	case shared.PredicateTypeWorkflowID:
+		return types.PredicateTypeWorkflowID
+	case shared.PredicateTypeWorkflowIDPrefix:
+		return types.PredicateTypeWorkflowIDPrefix
+	case shared.PredicateTypeAnd:
+		return types.PredicateTypeAnd
+	case shared.PredicateTypeOr:
+		return types.PredicateTypeOr
+	case shared.PredicateTypeNot:
+		return types.PredicateTypeNot
 	}
 	// default to universal
 	return types.PredicateTypeUniversal
@@ -85,15 +120,156 @@ func ToDomainIDPredicateAttributes(a *shared.DomainIDPredicateAttributes) *types
 	}
 }
 
+func FromTaskTypePredicateAttributes(a *types.TaskTypePredicateAttributes) *shared.TaskTypePredicateAttributes {
+	if a == nil {
+		return nil
+	}
+	return &shared.TaskTypePredicateAttributes{
+		TaskTypes:   a.TaskTypes,
+		IsExclusive: a.IsExclusive,
+	}
+}
+
+func ToTaskTypePredicateAttributes(a *shared.TaskTypePredicateAttributes) *types.TaskTypePredicateAttributes {
+	if a == nil {
+		return nil
+	}
+	return &types.TaskTypePredicateAttributes{
+		TaskTypes:   a.TaskTypes,
+		IsExclusive: a.IsExclusive,
+	}
+}
+
+func FromTaskListPredicateAttributes(a *types.TaskListPredicateAttributes) *shared.TaskListPredicateAttributes {
+	if a == nil {
+		return nil
+	}
+	return &shared.TaskListPredicateAttributes{
+		TaskLists:   a.TaskLists,
+		IsExclusive: a.IsExclusive,
+	}
+}
+
+func ToTaskListPredicateAttributes(a *shared.TaskListPredicateAttributes) *types.TaskListPredicateAttributes {
+	if a == nil {
+		return nil
+	}
+	return &types.TaskListPredicateAttributes{
+		TaskLists:   a.TaskLists,
+		IsExclusive: a.IsExclusive,
+	}
+}
+
+func FromWorkflowIDPredicateAttributes(a *types.WorkflowIDPredicateAttributes) *shared.WorkflowIDPredicateAttributes {
+	if a == nil {
+		return nil
+	}
+	return &shared.WorkflowIDPredicateAttributes{
+		WorkflowIDs: a.WorkflowIDs,
+		IsExclusive: a.IsExclusive,
+	}
+}
+
+func ToWorkflowIDPredicateAttributes(a *shared.WorkflowIDPredicateAttributes) *types.WorkflowIDPredicateAttributes {
+	if a == nil {
+		return nil
+	}
+	return &types.WorkflowIDPredicateAttributes{
+		WorkflowIDs: a.WorkflowIDs,
+		IsExclusive: a.IsExclusive,
+	}
+}
+
+func FromWorkflowIDPrefixPredicateAttributes(a *types.WorkflowIDPrefixPredicateAttributes) *shared.WorkflowIDPrefixPredicateAttributes {
+	if a == nil {
+		return nil
+	}
+	return &shared.WorkflowIDPrefixPredicateAttributes{
+		Prefixes:    a.Prefixes,
+		IsExclusive: a.IsExclusive,
+	}
+}
+
+func ToWorkflowIDPrefixPredicateAttributes(a *shared.WorkflowIDPrefixPredicateAttributes) *types.WorkflowIDPrefixPredicateAttributes {
+	if a == nil {
+		return nil
+	}
+	return &types.WorkflowIDPrefixPredicateAttributes{
+		Prefixes:    a.Prefixes,
+		IsExclusive: a.IsExclusive,
+	}
+}
+
+func FromAndPredicateAttributes(a *types.AndPredicateAttributes) *shared.AndPredicateAttributes {
+	if a == nil {
+		return nil
+	}
+	return &shared.AndPredicateAttributes{
+		Predicates: FromPredicateArray(a.Predicates),
+	}
+}
+
+func ToAndPredicateAttributes(a *shared.AndPredicateAttributes) *types.AndPredicateAttributes {
+	if a == nil {
+		return nil
+	}
+	return &types.AndPredicateAttributes{
+		Predicates: ToPredicateArray(a.Predicates),
+	}
+}
+
+func FromOrPredicateAttributes(a *types.OrPredicateAttributes) *shared.OrPredicateAttributes {
+	if a == nil {
+		return nil
+	}
+	return &shared.OrPredicateAttributes{
+		Predicates: FromPredicateArray(a.Predicates),
+	}
+}
+
+func ToOrPredicateAttributes(a *shared.OrPredicateAttributes) *types.OrPredicateAttributes {
+	if a == nil {
+		return nil
+	}
+	return &types.OrPredicateAttributes{
+		Predicates: ToPredicateArray(a.Predicates),
+	}
+}
+
+func FromNotPredicateAttributes(a *types.NotPredicateAttributes) *shared.NotPredicateAttributes {
+	if a == nil {
+		return nil
+	}
+	return &shared.NotPredicateAttributes{
+		Predicate: FromPredicate(a.Predicate),
+	}
+}
+
+func ToNotPredicateAttributes(a *shared.NotPredicateAttributes) *types.NotPredicateAttributes {
+	if a == nil {
+		return nil
+	}
+	return &types.NotPredicateAttributes{
+		Predicate: ToPredicate(a.Predicate),
+	}
+}
+
 func FromPredicate(p *types.Predicate) *shared.Predicate {
 	if p == nil {
 		return nil
 	}
 	return &shared.Predicate{
-		PredicateType:                FromPredicateType(p.PredicateType),
-		UniversalPredicateAttributes: FromUniversalPredicateAttributes(p.UniversalPredicateAttributes),
-		EmptyPredicateAttributes:     FromEmptyPredicateAttributes(p.EmptyPredicateAttributes),
-		DomainIDPredicateAttributes:  FromDomainIDPredicateAttributes(p.DomainIDPredicateAttributes),
+		PredicateType:                       FromPredicateType(p.PredicateType),
+		UniversalPredicateAttributes:        FromUniversalPredicateAttributes(p.UniversalPredicateAttributes),
+		EmptyPredicateAttributes:            FromEmptyPredicateAttributes(p.EmptyPredicateAttributes),
+		DomainIDPredicateAttributes:         FromDomainIDPredicateAttributes(p.DomainIDPredicateAttributes),
+		TaskTypePredicateAttributes:         FromTaskTypePredicateAttributes(p.TaskTypePredicateAttributes),
+		TaskListPredicateAttributes:         FromTaskListPredicateAttributes(p.TaskListPredicateAttributes),
+		WorkflowIDPredicateAttributes:       FromWorkflowIDPredicateAttributes(p.WorkflowIDPredicateAttributes),
+		WorkflowIDPrefixPredicateAttributes: FromWorkflowIDPrefixPredicateAttributes(p.WorkflowIDPrefixPredicateAttributes),
+		AndPredicateAttributes:              FromAndPredicateAttributes(p.AndPredicateAttributes),
+		OrPredicateAttributes:               FromOrPredicateAttributes(p.OrPredicateAttributes),
+		NotPredicateAttributes:              FromNotPredicateAttributes(p.NotPredicateAttributes),
 	}
 }
 
@@ -102,9 +278,38 @@ func ToPredicate(p *shared.Predicate) *types.Predicate {
 		return nil
 	}
 	return &types.Predicate{
-		PredicateType:                ToPredicateType(p.PredicateType),
-		UniversalPredicateAttributes: ToUniversalPredicateAttributes(p.UniversalPredicateAttributes),
-		EmptyPredicateAttributes:     ToEmptyPredicateAttributes(p.EmptyPredicateAttributes),
-		DomainIDPredicateAttributes:  ToDomainIDPredicateAttributes(p.DomainIDPredicateAttributes),
+		PredicateType:                       ToPredicateType(p.PredicateType),
+		UniversalPredicateAttributes:        ToUniversalPredicateAttributes(p.UniversalPredicateAttributes),
+		EmptyPredicateAttributes:            ToEmptyPredicateAttributes(p.EmptyPredicateAttributes),
+		DomainIDPredicateAttributes:         ToDomainIDPredicateAttributes(p.DomainIDPredicateAttributes),
+		TaskTypePredicateAttributes:         ToTaskTypePredicateAttributes(p.TaskTypePredicateAttributes),
+		TaskListPredicateAttributes:         ToTaskListPredicateAttributes(p.TaskListPredicateAttributes),
+		WorkflowIDPredicateAttributes:       ToWorkflowIDPredicateAttributes(p.WorkflowIDPredicateAttributes),
+		WorkflowIDPrefixPredicateAttributes: ToWorkflowIDPrefixPredicateAttributes(p.WorkflowIDPrefixPredicateAttributes),
+		AndPredicateAttributes:              ToAndPredicateAttributes(p.AndPredicateAttributes),
+		OrPredicateAttributes:               ToOrPredicateAttributes(p.OrPredicateAttributes),
+		NotPredicateAttributes:              ToNotPredicateAttributes(p.NotPredicateAttributes),
+	}
+}
+
+func FromPredicateArray(p []*types.Predicate) []*shared.Predicate {
+	if p == nil {
+		return nil
+	}
+	result := make([]*shared.Predicate, 0, len(p))
+	for _, v := range p {
+		result = append(result, FromPredicate(v))
+	}
+	return result
+}
+
+func ToPredicateArray(p []*shared.Predicate) []*types.Predicate {
+	if p == nil {
+		return nil
+	}
+	result := make([]*types.Predicate, 0, len(p))
+	for _, v := range p {
+		result = append(result, ToPredicate(v))
 	}
+	return result
 }
@@ -39,6 +39,20 @@ func predicateTypeFuzzGenerator(t *types.PredicateType, c fuzz.Continue) {
 		*t = types.PredicateTypeEmpty
 	case 2:
 		*t = types.PredicateTypeDomainID
+	case 3:
+		*t = types.PredicateTypeTaskType
+	case 4:
+		*t = types.PredicateTypeTaskList
+	case 5:
+		*t = types.PredicateTypeWorkflowID
+	case 6:
+		*t = types.PredicateTypeWorkflowIDPrefix
+	case 7:
+		*t = types.PredicateTypeAnd
+	case 8:
+		*t = types.PredicateTypeOr
+	case 9:
+		*t = types.PredicateTypeNot
 	default:
 		panic("invalid predicate type")
 	}
@@ -52,6 +66,20 @@ func predicateTypeSharedFuzzGenerator(t **shared.PredicateType, c fuzz.Continue)
 		*t = common.Ptr(shared.PredicateTypeEmpty)
 	case 2:
 		*t = common.Ptr(shared.PredicateTypeDomainID)
+	case 3:
+		*t = common.Ptr(shared.PredicateTypeTaskType)
+	case 4:
+		*t = common.Ptr(shared.PredicateTypeTaskList)
+	case 5:
+		*t = common.Ptr(shared.PredicateTypeWorkflowID)
+	case 6:
+		*t = common.Ptr(shared.PredicateTypeWorkflowIDPrefix)
+	case 7:
+		*t = common.Ptr(shared.PredicateTypeAnd)
+	case 8:
+		*t = common.Ptr(shared.PredicateTypeOr)
+	case 9:
+		*t = common.Ptr(shared.PredicateTypeNot)
 	default:
 		panic("invalid predicate type")
 	}
@@ -68,6 +96,27 @@ func predicateFuzzGenerator(t *types.Predicate, c fuzz.Continue) {
 	case 2:
 		t.PredicateType = types.PredicateTypeDomainID
 		c.Fuzz(&t.DomainIDPredicateAttributes)
+	case 3:
+		t.PredicateType = types.PredicateTypeTaskType
+		c.Fuzz(&t.TaskTypePredicateAttributes)
+	case 4:
+		t.PredicateType = types.PredicateTypeTaskList
+		c.Fuzz(&t.TaskListPredicateAttributes)
+	case 5:
+		t.PredicateType = types.PredicateTypeWorkflowID
+		c.Fuzz(&t.WorkflowIDPredicateAttributes)
+	case 6:
+		t.PredicateType = types.PredicateTypeWorkflowIDPrefix
+		c.Fuzz(&t.WorkflowIDPrefixPredicateAttributes)
+	case 7:
+		t.PredicateType = types.PredicateTypeAnd
+		c.Fuzz(&t.AndPredicateAttributes)
+	case 8:
+		t.PredicateType = types.PredicateTypeOr
+		c.Fuzz(&t.OrPredicateAttributes)
+	case 9:
+		t.PredicateType = types.PredicateTypeNot
+		c.Fuzz(&t.NotPredicateAttributes)
 	default:
 		panic("invalid predicate type")
 	}
@@ -84,6 +133,27 @@ func predicateSharedFuzzGenerator(t *shared.Predicate, c fuzz.Continue) {
 	case 2:
 		t.PredicateType = common.Ptr(shared.PredicateTypeDomainID)
 		c.Fuzz(&t.DomainIDPredicateAttributes)
+	case 3:
+		t.PredicateType = common.Ptr(shared.PredicateTypeTaskType)
+		c.Fuzz(&t.TaskTypePredicateAttributes)
+	case 4:
+		t.PredicateType = common.Ptr(shared.PredicateTypeTaskList)
+		c.Fuzz(&t.TaskListPredicateAttributes)
+	case 5:
+		t.PredicateType = common.Ptr(shared.PredicateTypeWorkflowID)
+		c.Fuzz(&t.WorkflowIDPredicateAttributes)
+	case 6:
+		t.PredicateType = common.Ptr(shared.PredicateTypeWorkflowIDPrefix)
+		c.Fuzz(&t.WorkflowIDPrefixPredicateAttributes)
+	case 7:
+		t.PredicateType = common.Ptr(shared.PredicateTypeAnd)
+		c.Fuzz(&t.AndPredicateAttributes)
+	case 8:
+		t.PredicateType = common.Ptr(shared.PredicateTypeOr)
+		c.Fuzz(&t.OrPredicateAttributes)
+	case 9:
+		t.PredicateType = common.Ptr(shared.PredicateTypeNot)
+		c.Fuzz(&t.NotPredicateAttributes)
 	default:
 		panic("invalid predicate type")
 	}
@@ -246,6 +316,22 @@ func TestFuzzPredicate_NilHandling(t *testing.T) {
 	assert.Nil(t, ToPredicate(nil))
 	assert.Nil(t, FromDomainIDPredicateAttributes(nil))
 	assert.Nil(t, ToDomainIDPredicateAttributes(nil))
+	assert.Nil(t, FromTaskTypePredicateAttributes(nil))
+	assert.Nil(t, ToTaskTypePredicateAttributes(nil))
+	assert.Nil(t, FromTaskListPredicateAttributes(nil))
+	assert.Nil(t, ToTaskListPredicateAttributes(nil))
+	assert.Nil(t, FromWorkflowIDPredicateAttributes(nil))
+	assert.Nil(t, ToWorkflowIDPredicateAttributes(nil))
+	assert.Nil(t, FromWorkflowIDPrefixPredicateAttributes(nil))
+	assert.Nil(t, ToWorkflowIDPrefixPredicateAttributes(nil))
+	assert.Nil(t, FromAndPredicateAttributes(nil))
+	assert.Nil(t, ToAndPredicateAttributes(nil))
+	assert.Nil(t, FromOrPredicateAttributes(nil))
+	assert.Nil(t, ToOrPredicateAttributes(nil))
+	assert.Nil(t, FromNotPredicateAttributes(nil))
+	assert.Nil(t, ToNotPredicateAttributes(nil))
+	assert.Nil(t, FromPredicateArray(nil))
+	assert.Nil(t, ToPredicateArray(nil))
 }
 
 func TestPredicateType_InvalidValues(t *testing.T) {
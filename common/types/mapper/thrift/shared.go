@@ -208,6 +208,8 @@ func FromActivityTaskScheduledEventAttributes(t *types.ActivityTaskScheduledEven
 		DecisionTaskCompletedEventId:  &t.DecisionTaskCompletedEventID,
 		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
 		Header:                        FromHeader(t.Header),
+		Priority:                      &t.Priority,
+		FairnessKey:                   &t.FairnessKey,
 	}
 }
 
@@ -229,6 +231,8 @@ func ToActivityTaskScheduledEventAttributes(t *shared.ActivityTaskScheduledEvent
 		DecisionTaskCompletedEventID:  t.GetDecisionTaskCompletedEventId(),
 		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
 		Header:                        ToHeader(t.Header),
+		Priority:                      t.GetPriority(),
+		FairnessKey:                   t.GetFairnessKey(),
 	}
 }
 
@@ -3292,6 +3296,154 @@ func ToListFailoverHistoryResponse(t *shared.ListFailoverHistoryResponse) *types
 	}
 }
 
+// ToListDomainChangesRequest converts thrift ListDomainChangesRequest type to internal
+func ToListDomainChangesRequest(t *shared.ListDomainChangesRequest) *types.ListDomainChangesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListDomainChangesRequest{
+		Domain:     t.GetDomain(),
+		Pagination: ToPaginationOptions(t.Pagination),
+	}
+}
+
+// FromListDomainChangesRequest converts internal ListDomainChangesRequest type to thrift
+func FromListDomainChangesRequest(t *types.ListDomainChangesRequest) *shared.ListDomainChangesRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListDomainChangesRequest{
+		Domain:     &t.Domain,
+		Pagination: FromPaginationOptions(t.Pagination),
+	}
+}
+
+// FromListDomainChangesResponse converts internal ListDomainChangesResponse type to thrift
+func FromListDomainChangesResponse(t *types.ListDomainChangesResponse) *shared.ListDomainChangesResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListDomainChangesResponse{
+		DomainChanges: FromDomainChangeArray(t.DomainChanges),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListDomainChangesResponse converts thrift ListDomainChangesResponse type to internal
+func ToListDomainChangesResponse(t *shared.ListDomainChangesResponse) *types.ListDomainChangesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListDomainChangesResponse{
+		DomainChanges: ToDomainChangeArray(t.DomainChanges),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToDomainChange converts thrift DomainChange type to internal
+func ToDomainChange(t *shared.DomainChange) *types.DomainChange {
+	if t == nil {
+		return nil
+	}
+	return &types.DomainChange{
+		ID:            t.GetID(),
+		CreatedTime:   t.GetCreatedTime(),
+		OperationType: t.GetOperationType(),
+		Identity:      t.GetIdentity(),
+		IdentityType:  t.GetIdentityType(),
+		Comment:       t.GetComment(),
+		FieldChanges:  ToDomainFieldChangeArray(t.FieldChanges),
+	}
+}
+
+// FromDomainChange converts internal DomainChange type to thrift
+func FromDomainChange(t *types.DomainChange) *shared.DomainChange {
+	if t == nil {
+		return nil
+	}
+	return &shared.DomainChange{
+		ID:            &t.ID,
+		CreatedTime:   &t.CreatedTime,
+		OperationType: &t.OperationType,
+		Identity:      &t.Identity,
+		IdentityType:  &t.IdentityType,
+		Comment:       &t.Comment,
+		FieldChanges:  FromDomainFieldChangeArray(t.FieldChanges),
+	}
+}
+
+// ToDomainChangeArray converts thrift DomainChange array type to internal
+func ToDomainChangeArray(t []*shared.DomainChange) []*types.DomainChange {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DomainChange, len(t))
+	for i := range t {
+		v[i] = ToDomainChange(t[i])
+	}
+	return v
+}
+
+// FromDomainChangeArray converts internal DomainChange array type to thrift
+func FromDomainChangeArray(t []*types.DomainChange) []*shared.DomainChange {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.DomainChange, len(t))
+	for i := range t {
+		v[i] = FromDomainChange(t[i])
+	}
+	return v
+}
+
+// ToDomainFieldChange converts thrift DomainFieldChange type to internal
+func ToDomainFieldChange(t *shared.DomainFieldChange) *types.DomainFieldChange {
+	if t == nil {
+		return nil
+	}
+	return &types.DomainFieldChange{
+		Field:  t.GetField(),
+		Before: t.GetBefore(),
+		After:  t.GetAfter(),
+	}
+}
+
+// FromDomainFieldChange converts internal DomainFieldChange type to thrift
+func FromDomainFieldChange(t *types.DomainFieldChange) *shared.DomainFieldChange {
+	if t == nil {
+		return nil
+	}
+	return &shared.DomainFieldChange{
+		Field:  &t.Field,
+		Before: &t.Before,
+		After:  &t.After,
+	}
+}
+
+// ToDomainFieldChangeArray converts thrift DomainFieldChange array type to internal
+func ToDomainFieldChangeArray(t []*shared.DomainFieldChange) []*types.DomainFieldChange {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DomainFieldChange, len(t))
+	for i := range t {
+		v[i] = ToDomainFieldChange(t[i])
+	}
+	return v
+}
+
+// FromDomainFieldChangeArray converts internal DomainFieldChange array type to thrift
+func FromDomainFieldChangeArray(t []*types.DomainFieldChange) []*shared.DomainFieldChange {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.DomainFieldChange, len(t))
+	for i := range t {
+		v[i] = FromDomainFieldChange(t[i])
+	}
+	return v
+}
+
 // ToListFailoverHistoryRequestFilters converts thrift ListFailoverHistoryRequestFilters type to internal
 func ToListFailoverHistoryRequestFilters(t *shared.ListFailoverHistoryRequestFilters) *types.ListFailoverHistoryRequestFilters {
 	if t == nil {
@@ -5292,6 +5444,8 @@ func FromScheduleActivityTaskDecisionAttributes(t *types.ScheduleActivityTaskDec
 		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
 		Header:                        FromHeader(t.Header),
 		RequestLocalDispatch:          &t.RequestLocalDispatch,
+		Priority:                      &t.Priority,
+		FairnessKey:                   &t.FairnessKey,
 	}
 }
 
@@ -5313,6 +5467,8 @@ func ToScheduleActivityTaskDecisionAttributes(t *shared.ScheduleActivityTaskDeci
 		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
 		Header:                        ToHeader(t.Header),
 		RequestLocalDispatch:          t.GetRequestLocalDispatch(),
+		Priority:                      t.GetPriority(),
+		FairnessKey:                   t.GetFairnessKey(),
 	}
 }
 
@@ -6090,14 +6246,16 @@ func FromTaskListStatus(t *types.TaskListStatus) *shared.TaskListStatus {
 		return nil
 	}
 	return &shared.TaskListStatus{
-		BacklogCountHint:      &t.BacklogCountHint,
-		ReadLevel:             &t.ReadLevel,
-		AckLevel:              &t.AckLevel,
-		RatePerSecond:         &t.RatePerSecond,
-		TaskIDBlock:           FromTaskIDBlock(t.TaskIDBlock),
-		IsolationGroupMetrics: FromIsolationGroupMetricsMap(t.IsolationGroupMetrics),
-		NewTasksPerSecond:     &t.NewTasksPerSecond,
-		Empty:                 &t.Empty,
+		BacklogCountHint:          &t.BacklogCountHint,
+		ReadLevel:                 &t.ReadLevel,
+		AckLevel:                  &t.AckLevel,
+		RatePerSecond:             &t.RatePerSecond,
+		TaskIDBlock:               FromTaskIDBlock(t.TaskIDBlock),
+		IsolationGroupMetrics:     FromIsolationGroupMetricsMap(t.IsolationGroupMetrics),
+		NewTasksPerSecond:         &t.NewTasksPerSecond,
+		Empty:                     &t.Empty,
+		BacklogCountByPriority:    t.BacklogCountByPriority,
+		BacklogCountByFairnessKey: t.BacklogCountByFairnessKey,
 	}
 }
 
@@ -6107,14 +6265,16 @@ func ToTaskListStatus(t *shared.TaskListStatus) *types.TaskListStatus {
 		return nil
 	}
 	return &types.TaskListStatus{
-		BacklogCountHint:      t.GetBacklogCountHint(),
-		ReadLevel:             t.GetReadLevel(),
-		AckLevel:              t.GetAckLevel(),
-		RatePerSecond:         t.GetRatePerSecond(),
-		TaskIDBlock:           ToTaskIDBlock(t.TaskIDBlock),
-		IsolationGroupMetrics: ToIsolationGroupMetricsMap(t.GetIsolationGroupMetrics()),
-		NewTasksPerSecond:     t.GetNewTasksPerSecond(),
-		Empty:                 t.GetEmpty(),
+		BacklogCountHint:          t.GetBacklogCountHint(),
+		ReadLevel:                 t.GetReadLevel(),
+		AckLevel:                  t.GetAckLevel(),
+		RatePerSecond:             t.GetRatePerSecond(),
+		TaskIDBlock:               ToTaskIDBlock(t.TaskIDBlock),
+		IsolationGroupMetrics:     ToIsolationGroupMetricsMap(t.GetIsolationGroupMetrics()),
+		NewTasksPerSecond:         t.GetNewTasksPerSecond(),
+		Empty:                     t.GetEmpty(),
+		BacklogCountByPriority:    t.BacklogCountByPriority,
+		BacklogCountByFairnessKey: t.BacklogCountByFairnessKey,
 	}
 }
 
@@ -6451,6 +6611,40 @@ func ToUpdateDomainResponse(t *shared.UpdateDomainResponse) *types.UpdateDomainR
 	}
 }
 
+// FromAdminUpdateVirtualQueueRequest converts internal UpdateVirtualQueueRequest type to thrift
+func FromAdminUpdateVirtualQueueRequest(t *types.UpdateVirtualQueueRequest) *shared.UpdateVirtualQueueRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateVirtualQueueRequest{
+		ShardID:                &t.ShardID,
+		ClusterName:            &t.ClusterName,
+		Type:                   t.Type,
+		Operation:              FromVirtualQueueOperation(t.Operation),
+		VirtualQueueID:         &t.VirtualQueueID,
+		TargetVirtualQueueID:   &t.TargetVirtualQueueID,
+		DomainIDs:              t.DomainIDs,
+		PauseDurationInSeconds: &t.PauseDurationInSeconds,
+	}
+}
+
+// ToAdminUpdateVirtualQueueRequest converts thrift UpdateVirtualQueueRequest type to internal
+func ToAdminUpdateVirtualQueueRequest(t *shared.UpdateVirtualQueueRequest) *types.UpdateVirtualQueueRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateVirtualQueueRequest{
+		ShardID:                t.GetShardID(),
+		ClusterName:            t.GetClusterName(),
+		Type:                   t.Type,
+		Operation:              ToVirtualQueueOperation(t.Operation),
+		VirtualQueueID:         t.GetVirtualQueueID(),
+		TargetVirtualQueueID:   t.GetTargetVirtualQueueID(),
+		DomainIDs:              t.DomainIDs,
+		PauseDurationInSeconds: t.GetPauseDurationInSeconds(),
+	}
+}
+
 // FromUpsertWorkflowSearchAttributesDecisionAttributes converts internal UpsertWorkflowSearchAttributesDecisionAttributes type to thrift
 func FromUpsertWorkflowSearchAttributesDecisionAttributes(t *types.UpsertWorkflowSearchAttributesDecisionAttributes) *shared.UpsertWorkflowSearchAttributesDecisionAttributes {
 	if t == nil {
@@ -6559,6 +6753,42 @@ func ToVersionHistoryItem(t *shared.VersionHistoryItem) *types.VersionHistoryIte
 	}
 }
 
+// FromVirtualQueueOperation converts internal VirtualQueueOperation type to thrift
+func FromVirtualQueueOperation(t *types.VirtualQueueOperation) *shared.VirtualQueueOperation {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.VirtualQueueOperationMoveDomains:
+		return shared.VirtualQueueOperationMoveDomains.Ptr()
+	case types.VirtualQueueOperationPause:
+		return shared.VirtualQueueOperationPause.Ptr()
+	case types.VirtualQueueOperationResume:
+		return shared.VirtualQueueOperationResume.Ptr()
+	case types.VirtualQueueOperationMerge:
+		return shared.VirtualQueueOperationMerge.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
+// ToVirtualQueueOperation converts thrift VirtualQueueOperation type to internal
+func ToVirtualQueueOperation(t *shared.VirtualQueueOperation) *types.VirtualQueueOperation {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.VirtualQueueOperationMoveDomains:
+		return types.VirtualQueueOperationMoveDomains.Ptr()
+	case shared.VirtualQueueOperationPause:
+		return types.VirtualQueueOperationPause.Ptr()
+	case shared.VirtualQueueOperationResume:
+		return types.VirtualQueueOperationResume.Ptr()
+	case shared.VirtualQueueOperationMerge:
+		return types.VirtualQueueOperationMerge.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
 // FromWorkerVersionInfo converts internal WorkerVersionInfo type to thrift
 func FromWorkerVersionInfo(t *types.WorkerVersionInfo) *shared.WorkerVersionInfo {
 	if t == nil {
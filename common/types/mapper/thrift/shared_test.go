@@ -425,6 +425,7 @@ func TestActivityTaskScheduledEventAttributesConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.ActivityTaskScheduledEventAttributes,
+		{ActivityID: testdata.ActivityID, Priority: 5, FairnessKey: "tenant"},
 	}
 
 	for _, original := range testCases {
@@ -2682,6 +2683,7 @@ func TestScheduleActivityTaskDecisionAttributesConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.ScheduleActivityTaskDecisionAttributes,
+		{ActivityID: testdata.ActivityID, Priority: 5, FairnessKey: "tenant"},
 	}
 
 	for _, original := range testCases {
@@ -3060,6 +3062,8 @@ func TestTaskListStatusConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.TaskListStatus,
+		{BacklogCountHint: testdata.BacklogCountHint, BacklogCountByPriority: map[int32]int64{0: 10, 5: 2}},
+		{BacklogCountHint: testdata.BacklogCountHint, BacklogCountByFairnessKey: map[string]int64{"tenant-a": 10, "tenant-b": 2}},
 	}
 
 	for _, original := range testCases {
@@ -3718,3 +3722,15 @@ func TestListFailoverHistoryResponseConversion(t *testing.T) {
 		assert.Equal(t, &response, toResponse)
 	}
 }
+
+func TestListDomainChangesRequestConversion(t *testing.T) {
+	for _, item := range []*types.ListDomainChangesRequest{nil, {}, &testdata.ListDomainChangesRequest} {
+		assert.Equal(t, item, ToListDomainChangesRequest(FromListDomainChangesRequest(item)))
+	}
+}
+
+func TestListDomainChangesResponseConversion(t *testing.T) {
+	for _, item := range []*types.ListDomainChangesResponse{nil, {}, &testdata.ListDomainChangesResponse} {
+		assert.Equal(t, item, ToListDomainChangesResponse(FromListDomainChangesResponse(item)))
+	}
+}
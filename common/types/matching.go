@@ -38,6 +38,8 @@ type AddActivityTaskRequest struct {
 	ForwardedFrom                 string                    `json:"forwardedFrom,omitempty"`
 	ActivityTaskDispatchInfo      *ActivityTaskDispatchInfo `json:"activityTaskDispatchInfo,omitempty"`
 	PartitionConfig               map[string]string
+	Priority                      int32  `json:"priority,omitempty"`
+	FairnessKey                   string `json:"fairnessKey,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -112,6 +114,22 @@ func (v *AddActivityTaskRequest) GetPartitionConfig() (o map[string]string) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetFairnessKey is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
 // ActivityTaskDispatchInfo is an internal type (TBD...)
 type ActivityTaskDispatchInfo struct {
 	ScheduledEvent                  *HistoryEvent `json:"scheduledEvent,omitempty"`
@@ -133,6 +151,7 @@ type AddDecisionTaskRequest struct {
 	Source                        *TaskSource        `json:"source,omitempty"`
 	ForwardedFrom                 string             `json:"forwardedFrom,omitempty"`
 	PartitionConfig               map[string]string
+	Priority                      int32 `json:"priority,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -199,6 +218,14 @@ func (v *AddDecisionTaskRequest) GetPartitionConfig() (o map[string]string) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
 type AddActivityTaskResponse struct {
 	PartitionConfig *TaskListPartitionConfig
 }
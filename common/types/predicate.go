@@ -10,6 +10,13 @@ const (
 	PredicateTypeUniversal PredicateType = iota
 	PredicateTypeEmpty
 	PredicateTypeDomainID
+	PredicateTypeTaskType
+	PredicateTypeTaskList
+	PredicateTypeWorkflowID
+	PredicateTypeWorkflowIDPrefix
+	PredicateTypeAnd
+	PredicateTypeOr
+	PredicateTypeNot
 
 	NumPredicateTypes
 )
@@ -54,11 +61,145 @@ func (d *DomainIDPredicateAttributes) GetIsExclusive() bool {
 	return *d.IsExclusive
 }
 
+type TaskTypePredicateAttributes struct {
+	TaskTypes   []int32
+	IsExclusive *bool
+}
+
+func (t *TaskTypePredicateAttributes) Copy() *TaskTypePredicateAttributes {
+	if t == nil {
+		return nil
+	}
+	return &TaskTypePredicateAttributes{
+		TaskTypes:   t.TaskTypes,
+		IsExclusive: t.IsExclusive,
+	}
+}
+
+func (t *TaskTypePredicateAttributes) GetIsExclusive() bool {
+	if t.IsExclusive == nil {
+		return false
+	}
+	return *t.IsExclusive
+}
+
+type TaskListPredicateAttributes struct {
+	TaskLists   []string
+	IsExclusive *bool
+}
+
+func (t *TaskListPredicateAttributes) Copy() *TaskListPredicateAttributes {
+	if t == nil {
+		return nil
+	}
+	return &TaskListPredicateAttributes{
+		TaskLists:   t.TaskLists,
+		IsExclusive: t.IsExclusive,
+	}
+}
+
+func (t *TaskListPredicateAttributes) GetIsExclusive() bool {
+	if t.IsExclusive == nil {
+		return false
+	}
+	return *t.IsExclusive
+}
+
+type WorkflowIDPredicateAttributes struct {
+	WorkflowIDs []string
+	IsExclusive *bool
+}
+
+func (w *WorkflowIDPredicateAttributes) Copy() *WorkflowIDPredicateAttributes {
+	if w == nil {
+		return nil
+	}
+	return &WorkflowIDPredicateAttributes{
+		WorkflowIDs: w.WorkflowIDs,
+		IsExclusive: w.IsExclusive,
+	}
+}
+
+func (w *WorkflowIDPredicateAttributes) GetIsExclusive() bool {
+	if w.IsExclusive == nil {
+		return false
+	}
+	return *w.IsExclusive
+}
+
+type WorkflowIDPrefixPredicateAttributes struct {
+	Prefixes    []string
+	IsExclusive *bool
+}
+
+func (w *WorkflowIDPrefixPredicateAttributes) Copy() *WorkflowIDPrefixPredicateAttributes {
+	if w == nil {
+		return nil
+	}
+	return &WorkflowIDPrefixPredicateAttributes{
+		Prefixes:    w.Prefixes,
+		IsExclusive: w.IsExclusive,
+	}
+}
+
+func (w *WorkflowIDPrefixPredicateAttributes) GetIsExclusive() bool {
+	if w.IsExclusive == nil {
+		return false
+	}
+	return *w.IsExclusive
+}
+
+type AndPredicateAttributes struct {
+	Predicates []*Predicate
+}
+
+func (a *AndPredicateAttributes) Copy() *AndPredicateAttributes {
+	if a == nil {
+		return nil
+	}
+	return &AndPredicateAttributes{
+		Predicates: copyPredicates(a.Predicates),
+	}
+}
+
+type OrPredicateAttributes struct {
+	Predicates []*Predicate
+}
+
+func (o *OrPredicateAttributes) Copy() *OrPredicateAttributes {
+	if o == nil {
+		return nil
+	}
+	return &OrPredicateAttributes{
+		Predicates: copyPredicates(o.Predicates),
+	}
+}
+
+type NotPredicateAttributes struct {
+	Predicate *Predicate
+}
+
+func (n *NotPredicateAttributes) Copy() *NotPredicateAttributes {
+	if n == nil {
+		return nil
+	}
+	return &NotPredicateAttributes{
+		Predicate: n.Predicate.Copy(),
+	}
+}
+
 type Predicate struct {
-	PredicateType                PredicateType
-	UniversalPredicateAttributes *UniversalPredicateAttributes
-	EmptyPredicateAttributes     *EmptyPredicateAttributes
-	DomainIDPredicateAttributes  *DomainIDPredicateAttributes
+	PredicateType                       PredicateType
+	UniversalPredicateAttributes        *UniversalPredicateAttributes
+	EmptyPredicateAttributes            *EmptyPredicateAttributes
+	DomainIDPredicateAttributes         *DomainIDPredicateAttributes
+	TaskTypePredicateAttributes         *TaskTypePredicateAttributes
+	TaskListPredicateAttributes         *TaskListPredicateAttributes
+	WorkflowIDPredicateAttributes       *WorkflowIDPredicateAttributes
+	WorkflowIDPrefixPredicateAttributes *WorkflowIDPrefixPredicateAttributes
+	AndPredicateAttributes              *AndPredicateAttributes
+	OrPredicateAttributes               *OrPredicateAttributes
+	NotPredicateAttributes              *NotPredicateAttributes
 }
 
 func (p *Predicate) Copy() *Predicate {
@@ -66,11 +207,29 @@ func (p *Predicate) Copy() *Predicate {
 		return nil
 	}
 	return &Predicate{
-		PredicateType:                p.PredicateType,
-		UniversalPredicateAttributes: p.UniversalPredicateAttributes.Copy(),
-		EmptyPredicateAttributes:     p.EmptyPredicateAttributes.Copy(),
-		DomainIDPredicateAttributes:  p.DomainIDPredicateAttributes.Copy(),
+		PredicateType:                       p.PredicateType,
+		UniversalPredicateAttributes:        p.UniversalPredicateAttributes.Copy(),
+		EmptyPredicateAttributes:            p.EmptyPredicateAttributes.Copy(),
+		DomainIDPredicateAttributes:         p.DomainIDPredicateAttributes.Copy(),
+		TaskTypePredicateAttributes:         p.TaskTypePredicateAttributes.Copy(),
+		TaskListPredicateAttributes:         p.TaskListPredicateAttributes.Copy(),
+		WorkflowIDPredicateAttributes:       p.WorkflowIDPredicateAttributes.Copy(),
+		WorkflowIDPrefixPredicateAttributes: p.WorkflowIDPrefixPredicateAttributes.Copy(),
+		AndPredicateAttributes:              p.AndPredicateAttributes.Copy(),
+		OrPredicateAttributes:               p.OrPredicateAttributes.Copy(),
+		NotPredicateAttributes:              p.NotPredicateAttributes.Copy(),
+	}
+}
+
+func copyPredicates(predicates []*Predicate) []*Predicate {
+	if predicates == nil {
+		return nil
+	}
+	result := make([]*Predicate, 0, len(predicates))
+	for _, p := range predicates {
+		result = append(result, p.Copy())
 	}
+	return result
 }
 
 func (p *Predicate) GetDomainIDPredicateAttributes() *DomainIDPredicateAttributes {
@@ -82,3 +241,73 @@ func (p *Predicate) GetDomainIDPredicateAttributes() *DomainIDPredicateAttribute
 	}
 	return p.DomainIDPredicateAttributes
 }
+
+func (p *Predicate) GetTaskTypePredicateAttributes() *TaskTypePredicateAttributes {
+	if p == nil {
+		return nil
+	}
+	if p.PredicateType != PredicateTypeTaskType {
+		return nil
+	}
+	return p.TaskTypePredicateAttributes
+}
+
+func (p *Predicate) GetTaskListPredicateAttributes() *TaskListPredicateAttributes {
+	if p == nil {
+		return nil
+	}
+	if p.PredicateType != PredicateTypeTaskList {
+		return nil
+	}
+	return p.TaskListPredicateAttributes
+}
+
+func (p *Predicate) GetWorkflowIDPredicateAttributes() *WorkflowIDPredicateAttributes {
+	if p == nil {
+		return nil
+	}
+	if p.PredicateType != PredicateTypeWorkflowID {
+		return nil
+	}
+	return p.WorkflowIDPredicateAttributes
+}
+
+func (p *Predicate) GetWorkflowIDPrefixPredicateAttributes() *WorkflowIDPrefixPredicateAttributes {
+	if p == nil {
+		return nil
+	}
+	if p.PredicateType != PredicateTypeWorkflowIDPrefix {
+		return nil
+	}
+	return p.WorkflowIDPrefixPredicateAttributes
+}
+
+func (p *Predicate) GetAndPredicateAttributes() *AndPredicateAttributes {
+	if p == nil {
+		return nil
+	}
+	if p.PredicateType != PredicateTypeAnd {
+		return nil
+	}
+	return p.AndPredicateAttributes
+}
+
+func (p *Predicate) GetOrPredicateAttributes() *OrPredicateAttributes {
+	if p == nil {
+		return nil
+	}
+	if p.PredicateType != PredicateTypeOr {
+		return nil
+	}
+	return p.OrPredicateAttributes
+}
+
+func (p *Predicate) GetNotPredicateAttributes() *NotPredicateAttributes {
+	if p == nil {
+		return nil
+	}
+	if p.PredicateType != PredicateTypeNot {
+		return nil
+	}
+	return p.NotPredicateAttributes
+}
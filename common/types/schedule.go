@@ -142,11 +142,19 @@ func (e ScheduleCatchUpPolicy) MarshalText() ([]byte, error) {
 // --- Core Types ---
 
 // ScheduleSpec defines when a schedule should trigger.
+// The schedule fires at the union of the times matched by CronExpression,
+// AdditionalCronExpressions and Intervals, minus any time that falls on one
+// of the ExcludedDates. Cron expressions and excluded dates are evaluated in
+// TimeZone, which defaults to UTC.
 type ScheduleSpec struct {
-	CronExpression string        `json:"cronExpression,omitempty"`
-	StartTime      time.Time     `json:"startTime,omitempty"`
-	EndTime        time.Time     `json:"endTime,omitempty"`
-	Jitter         time.Duration `json:"jitter,omitempty"`
+	CronExpression            string                  `json:"cronExpression,omitempty"`
+	AdditionalCronExpressions []string                `json:"additionalCronExpressions,omitempty"`
+	Intervals                 []*ScheduleIntervalSpec `json:"intervals,omitempty"`
+	ExcludedDates             []string                `json:"excludedDates,omitempty"` // calendar dates in YYYY-MM-DD format
+	TimeZone                  string                  `json:"timeZone,omitempty"`      // IANA time zone name, e.g. America/New_York
+	StartTime                 time.Time               `json:"startTime,omitempty"`
+	EndTime                   time.Time               `json:"endTime,omitempty"`
+	Jitter                    time.Duration           `json:"jitter,omitempty"`
 }
 
 func (v *ScheduleSpec) GetCronExpression() (o string) {
@@ -156,6 +164,34 @@ func (v *ScheduleSpec) GetCronExpression() (o string) {
 	return
 }
 
+func (v *ScheduleSpec) GetAdditionalCronExpressions() (o []string) {
+	if v != nil {
+		return v.AdditionalCronExpressions
+	}
+	return
+}
+
+func (v *ScheduleSpec) GetIntervals() (o []*ScheduleIntervalSpec) {
+	if v != nil {
+		return v.Intervals
+	}
+	return
+}
+
+func (v *ScheduleSpec) GetExcludedDates() (o []string) {
+	if v != nil {
+		return v.ExcludedDates
+	}
+	return
+}
+
+func (v *ScheduleSpec) GetTimeZone() (o string) {
+	if v != nil {
+		return v.TimeZone
+	}
+	return
+}
+
 func (v *ScheduleSpec) GetStartTime() (o time.Time) {
 	if v != nil {
 		return v.StartTime
@@ -177,6 +213,28 @@ func (v *ScheduleSpec) GetJitter() (o time.Duration) {
 	return
 }
 
+// ScheduleIntervalSpec matches times that are a multiple of Every since the
+// Unix epoch, shifted by Offset. For example Every=90m with Offset=10m fires at
+// 00:10, 01:40, 03:10 UTC and so on.
+type ScheduleIntervalSpec struct {
+	Every  time.Duration `json:"every,omitempty"`
+	Offset time.Duration `json:"offset,omitempty"`
+}
+
+func (v *ScheduleIntervalSpec) GetEvery() (o time.Duration) {
+	if v != nil {
+		return v.Every
+	}
+	return
+}
+
+func (v *ScheduleIntervalSpec) GetOffset() (o time.Duration) {
+	if v != nil {
+		return v.Offset
+	}
+	return
+}
+
 // StartWorkflowAction defines a workflow to start when the schedule triggers.
 type StartWorkflowAction struct {
 	WorkflowType                        *WorkflowType     `json:"workflowType,omitempty"`
@@ -225,10 +283,105 @@ func (v *StartWorkflowAction) GetTaskStartToCloseTimeoutSeconds() (o int32) {
 	return
 }
 
+// SignalWithStartWorkflowAction defines a workflow to signal when the schedule triggers.
+// The workflow is started first if it is not running, which suits long-lived entity
+// workflows that should receive every fire instead of a fresh run per fire.
+type SignalWithStartWorkflowAction struct {
+	WorkflowID                          string            `json:"workflowId,omitempty"`
+	WorkflowType                        *WorkflowType     `json:"workflowType,omitempty"`
+	TaskList                            *TaskList         `json:"taskList,omitempty"`
+	Input                               []byte            `json:"-"` // Potential PII
+	ExecutionStartToCloseTimeoutSeconds *int32            `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32            `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	RetryPolicy                         *RetryPolicy      `json:"retryPolicy,omitempty"`
+	Memo                                *Memo             `json:"-"` // Filtering PII
+	SearchAttributes                    *SearchAttributes `json:"-"` // Filtering PII
+	SignalName                          string            `json:"signalName,omitempty"`
+	SignalInput                         []byte            `json:"-"` // Potential PII
+}
+
+func (v *SignalWithStartWorkflowAction) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+func (v *SignalWithStartWorkflowAction) GetWorkflowType() *WorkflowType {
+	if v != nil {
+		return v.WorkflowType
+	}
+	return nil
+}
+
+func (v *SignalWithStartWorkflowAction) GetTaskList() *TaskList {
+	if v != nil {
+		return v.TaskList
+	}
+	return nil
+}
+
+func (v *SignalWithStartWorkflowAction) GetInput() (o []byte) {
+	if v != nil {
+		return v.Input
+	}
+	return
+}
+
+func (v *SignalWithStartWorkflowAction) GetExecutionStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.ExecutionStartToCloseTimeoutSeconds != nil {
+		return *v.ExecutionStartToCloseTimeoutSeconds
+	}
+	return
+}
+
+func (v *SignalWithStartWorkflowAction) GetTaskStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.TaskStartToCloseTimeoutSeconds != nil {
+		return *v.TaskStartToCloseTimeoutSeconds
+	}
+	return
+}
+
+func (v *SignalWithStartWorkflowAction) GetRetryPolicy() *RetryPolicy {
+	if v != nil {
+		return v.RetryPolicy
+	}
+	return nil
+}
+
+func (v *SignalWithStartWorkflowAction) GetMemo() *Memo {
+	if v != nil {
+		return v.Memo
+	}
+	return nil
+}
+
+func (v *SignalWithStartWorkflowAction) GetSearchAttributes() *SearchAttributes {
+	if v != nil {
+		return v.SearchAttributes
+	}
+	return nil
+}
+
+func (v *SignalWithStartWorkflowAction) GetSignalName() (o string) {
+	if v != nil {
+		return v.SignalName
+	}
+	return
+}
+
+func (v *SignalWithStartWorkflowAction) GetSignalInput() (o []byte) {
+	if v != nil {
+		return v.SignalInput
+	}
+	return
+}
+
 // ScheduleAction defines the action to take when the schedule triggers.
 // Exactly one action field must be set.
 type ScheduleAction struct {
-	StartWorkflow *StartWorkflowAction `json:"startWorkflow,omitempty"`
+	StartWorkflow           *StartWorkflowAction           `json:"startWorkflow,omitempty"`
+	SignalWithStartWorkflow *SignalWithStartWorkflowAction `json:"signalWithStartWorkflow,omitempty"`
 }
 
 func (v *ScheduleAction) GetStartWorkflow() *StartWorkflowAction {
@@ -238,6 +391,21 @@ func (v *ScheduleAction) GetStartWorkflow() *StartWorkflowAction {
 	return nil
 }
 
+func (v *ScheduleAction) GetSignalWithStartWorkflow() *SignalWithStartWorkflowAction {
+	if v != nil {
+		return v.SignalWithStartWorkflow
+	}
+	return nil
+}
+
+// GetWorkflowType returns the workflow type of whichever action is set.
+func (v *ScheduleAction) GetWorkflowType() *WorkflowType {
+	if wt := v.GetStartWorkflow().GetWorkflowType(); wt != nil {
+		return wt
+	}
+	return v.GetSignalWithStartWorkflow().GetWorkflowType()
+}
+
 // SchedulePolicies configures schedule behavior.
 type SchedulePolicies struct {
 	OverlapPolicy    ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
@@ -382,6 +550,50 @@ func (v *BackfillInfo) GetRunsTotal() (o int32) {
 	return
 }
 
+// ScheduleActionResult records a workflow started by the schedule.
+type ScheduleActionResult struct {
+	ScheduledTime time.Time                     `json:"scheduledTime,omitempty"`
+	ActualTime    time.Time                     `json:"actualTime,omitempty"`
+	WorkflowID    string                        `json:"workflowId,omitempty"`
+	RunID         string                        `json:"runId,omitempty"`
+	CloseStatus   *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"` // nil while running or not yet known
+}
+
+func (v *ScheduleActionResult) GetScheduledTime() (o time.Time) {
+	if v != nil {
+		return v.ScheduledTime
+	}
+	return
+}
+
+func (v *ScheduleActionResult) GetActualTime() (o time.Time) {
+	if v != nil {
+		return v.ActualTime
+	}
+	return
+}
+
+func (v *ScheduleActionResult) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+func (v *ScheduleActionResult) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+func (v *ScheduleActionResult) GetCloseStatus() (o WorkflowExecutionCloseStatus) {
+	if v != nil && v.CloseStatus != nil {
+		return *v.CloseStatus
+	}
+	return
+}
+
 // ScheduleInfo provides runtime information about the schedule.
 type ScheduleInfo struct {
 	LastRunTime      time.Time       `json:"lastRunTime,omitempty"`
@@ -390,6 +602,8 @@ type ScheduleInfo struct {
 	CreateTime       time.Time       `json:"createTime,omitempty"`
 	LastUpdateTime   time.Time       `json:"lastUpdateTime,omitempty"`
 	OngoingBackfills []*BackfillInfo `json:"ongoingBackfills,omitempty"`
+	// RecentActions holds the most recent workflows started by the schedule, oldest first.
+	RecentActions []*ScheduleActionResult `json:"recentActions,omitempty"`
 }
 
 func (v *ScheduleInfo) GetLastRunTime() (o time.Time) {
@@ -434,6 +648,13 @@ func (v *ScheduleInfo) GetOngoingBackfills() (o []*BackfillInfo) {
 	return
 }
 
+func (v *ScheduleInfo) GetRecentActions() (o []*ScheduleActionResult) {
+	if v != nil {
+		return v.RecentActions
+	}
+	return
+}
+
 func (v *StartWorkflowAction) GetInput() (o []byte) {
 	if v != nil {
 		return v.Input
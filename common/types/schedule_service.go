@@ -30,6 +30,8 @@ type ScheduleListEntry struct {
 	WorkflowType   *WorkflowType  `json:"workflowType,omitempty"`
 	State          *ScheduleState `json:"state,omitempty"`
 	CronExpression string         `json:"cronExpression,omitempty"`
+	Spec           *ScheduleSpec  `json:"spec,omitempty"`
+	Info           *ScheduleInfo  `json:"info,omitempty"`
 }
 
 func (v *ScheduleListEntry) GetScheduleID() (o string) {
@@ -60,6 +62,20 @@ func (v *ScheduleListEntry) GetCronExpression() (o string) {
 	return
 }
 
+func (v *ScheduleListEntry) GetSpec() *ScheduleSpec {
+	if v != nil {
+		return v.Spec
+	}
+	return nil
+}
+
+func (v *ScheduleListEntry) GetInfo() *ScheduleInfo {
+	if v != nil {
+		return v.Info
+	}
+	return nil
+}
+
 // CreateScheduleRequest is the request to create a new schedule.
 type CreateScheduleRequest struct {
 	Domain           string            `json:"domain,omitempty"`
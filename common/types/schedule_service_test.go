@@ -33,21 +33,29 @@ func TestScheduleListEntry_NilGetters(t *testing.T) {
 	assert.Nil(t, v.GetWorkflowType())
 	assert.Nil(t, v.GetState())
 	assert.Equal(t, "", v.GetCronExpression())
+	assert.Nil(t, v.GetSpec())
+	assert.Nil(t, v.GetInfo())
 }
 
 func TestScheduleListEntry_Getters(t *testing.T) {
 	wt := &WorkflowType{Name: "test-wf"}
 	st := &ScheduleState{Paused: true}
+	spec := &ScheduleSpec{CronExpression: "*/5 * * * *"}
+	info := &ScheduleInfo{TotalRuns: 3}
 	v := &ScheduleListEntry{
 		ScheduleID:     "sched-1",
 		WorkflowType:   wt,
 		State:          st,
 		CronExpression: "*/5 * * * *",
+		Spec:           spec,
+		Info:           info,
 	}
 	assert.Equal(t, "sched-1", v.GetScheduleID())
 	assert.Equal(t, wt, v.GetWorkflowType())
 	assert.Equal(t, st, v.GetState())
 	assert.Equal(t, "*/5 * * * *", v.GetCronExpression())
+	assert.Equal(t, spec, v.GetSpec())
+	assert.Equal(t, info, v.GetInfo())
 }
 
 func TestCreateScheduleRequest_NilGetters(t *testing.T) {
@@ -22,6 +22,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -175,3 +176,114 @@ func TestScheduleCatchUpPolicy_Ptr(t *testing.T) {
 	ptr := val.Ptr()
 	assert.Equal(t, &val, ptr)
 }
+
+func TestScheduleSpec_NilGetters(t *testing.T) {
+	var v *ScheduleSpec
+	assert.Equal(t, "", v.GetCronExpression())
+	assert.Nil(t, v.GetAdditionalCronExpressions())
+	assert.Nil(t, v.GetIntervals())
+	assert.Nil(t, v.GetExcludedDates())
+	assert.Equal(t, "", v.GetTimeZone())
+
+	var interval *ScheduleIntervalSpec
+	assert.Equal(t, time.Duration(0), interval.GetEvery())
+	assert.Equal(t, time.Duration(0), interval.GetOffset())
+}
+
+func TestScheduleSpec_Getters(t *testing.T) {
+	interval := &ScheduleIntervalSpec{Every: 90 * time.Minute, Offset: 10 * time.Minute}
+	v := &ScheduleSpec{
+		CronExpression:            "0 9 * * 1-5",
+		AdditionalCronExpressions: []string{"0 17 * * 1-5"},
+		Intervals:                 []*ScheduleIntervalSpec{interval},
+		ExcludedDates:             []string{"2026-12-25"},
+		TimeZone:                  "America/New_York",
+	}
+	assert.Equal(t, "0 9 * * 1-5", v.GetCronExpression())
+	assert.Equal(t, []string{"0 17 * * 1-5"}, v.GetAdditionalCronExpressions())
+	assert.Equal(t, []*ScheduleIntervalSpec{interval}, v.GetIntervals())
+	assert.Equal(t, []string{"2026-12-25"}, v.GetExcludedDates())
+	assert.Equal(t, "America/New_York", v.GetTimeZone())
+	assert.Equal(t, 90*time.Minute, interval.GetEvery())
+	assert.Equal(t, 10*time.Minute, interval.GetOffset())
+}
+
+func TestScheduleActionResult_Getters(t *testing.T) {
+	var nilResult *ScheduleActionResult
+	assert.Equal(t, time.Time{}, nilResult.GetScheduledTime())
+	assert.Equal(t, time.Time{}, nilResult.GetActualTime())
+	assert.Equal(t, "", nilResult.GetWorkflowID())
+	assert.Equal(t, "", nilResult.GetRunID())
+	assert.Equal(t, WorkflowExecutionCloseStatus(0), nilResult.GetCloseStatus())
+
+	scheduled := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	result := &ScheduleActionResult{
+		ScheduledTime: scheduled,
+		ActualTime:    scheduled.Add(time.Second),
+		WorkflowID:    "wf",
+		RunID:         "run",
+		CloseStatus:   WorkflowExecutionCloseStatusFailed.Ptr(),
+	}
+	assert.Equal(t, scheduled, result.GetScheduledTime())
+	assert.Equal(t, scheduled.Add(time.Second), result.GetActualTime())
+	assert.Equal(t, "wf", result.GetWorkflowID())
+	assert.Equal(t, "run", result.GetRunID())
+	assert.Equal(t, WorkflowExecutionCloseStatusFailed, result.GetCloseStatus())
+
+	var nilInfo *ScheduleInfo
+	assert.Nil(t, nilInfo.GetRecentActions())
+	assert.Equal(t, []*ScheduleActionResult{result}, (&ScheduleInfo{RecentActions: []*ScheduleActionResult{result}}).GetRecentActions())
+}
+
+func TestSignalWithStartWorkflowAction_Getters(t *testing.T) {
+	var nilAction *SignalWithStartWorkflowAction
+	assert.Equal(t, "", nilAction.GetWorkflowID())
+	assert.Nil(t, nilAction.GetWorkflowType())
+	assert.Nil(t, nilAction.GetTaskList())
+	assert.Nil(t, nilAction.GetInput())
+	assert.Equal(t, int32(0), nilAction.GetExecutionStartToCloseTimeoutSeconds())
+	assert.Equal(t, int32(0), nilAction.GetTaskStartToCloseTimeoutSeconds())
+	assert.Nil(t, nilAction.GetRetryPolicy())
+	assert.Nil(t, nilAction.GetMemo())
+	assert.Nil(t, nilAction.GetSearchAttributes())
+	assert.Equal(t, "", nilAction.GetSignalName())
+	assert.Nil(t, nilAction.GetSignalInput())
+
+	execTimeout, taskTimeout := int32(60), int32(10)
+	action := &SignalWithStartWorkflowAction{
+		WorkflowID:                          "entity-wf",
+		WorkflowType:                        &WorkflowType{Name: "entity"},
+		TaskList:                            &TaskList{Name: "tl"},
+		Input:                               []byte("input"),
+		ExecutionStartToCloseTimeoutSeconds: &execTimeout,
+		TaskStartToCloseTimeoutSeconds:      &taskTimeout,
+		RetryPolicy:                         &RetryPolicy{MaximumAttempts: 3},
+		Memo:                                &Memo{},
+		SearchAttributes:                    &SearchAttributes{},
+		SignalName:                          "tick",
+		SignalInput:                         []byte("signal-input"),
+	}
+	assert.Equal(t, "entity-wf", action.GetWorkflowID())
+	assert.Equal(t, &WorkflowType{Name: "entity"}, action.GetWorkflowType())
+	assert.Equal(t, &TaskList{Name: "tl"}, action.GetTaskList())
+	assert.Equal(t, []byte("input"), action.GetInput())
+	assert.Equal(t, int32(60), action.GetExecutionStartToCloseTimeoutSeconds())
+	assert.Equal(t, int32(10), action.GetTaskStartToCloseTimeoutSeconds())
+	assert.Equal(t, &RetryPolicy{MaximumAttempts: 3}, action.GetRetryPolicy())
+	assert.Equal(t, &Memo{}, action.GetMemo())
+	assert.Equal(t, &SearchAttributes{}, action.GetSearchAttributes())
+	assert.Equal(t, "tick", action.GetSignalName())
+	assert.Equal(t, []byte("signal-input"), action.GetSignalInput())
+}
+
+func TestScheduleAction_GetWorkflowType(t *testing.T) {
+	var nilAction *ScheduleAction
+	assert.Nil(t, nilAction.GetSignalWithStartWorkflow())
+	assert.Nil(t, nilAction.GetWorkflowType())
+
+	start := &ScheduleAction{StartWorkflow: &StartWorkflowAction{WorkflowType: &WorkflowType{Name: "start"}}}
+	assert.Equal(t, &WorkflowType{Name: "start"}, start.GetWorkflowType())
+
+	signal := &ScheduleAction{SignalWithStartWorkflow: &SignalWithStartWorkflowAction{WorkflowType: &WorkflowType{Name: "entity"}}}
+	assert.Equal(t, &WorkflowType{Name: "entity"}, signal.GetWorkflowType())
+}
@@ -220,6 +220,46 @@ const (
 	AssignmentStatusREADY   AssignmentStatus = 1
 )
 
+type ExecutorDrainRequest struct {
+	Namespace  string
+	ExecutorID string
+}
+
+func (v *ExecutorDrainRequest) GetNamespace() (o string) {
+	if v != nil {
+		return v.Namespace
+	}
+	return
+}
+
+func (v *ExecutorDrainRequest) GetExecutorID() (o string) {
+	if v != nil {
+		return v.ExecutorID
+	}
+	return
+}
+
+type ExecutorDrainResponse struct {
+	// Status is DRAINING while the executor still owns shards, and DRAINED once all of them are handed over.
+	Status ExecutorStatus
+	// RemainingShards is the number of shards still assigned to the executor.
+	RemainingShards int64
+}
+
+func (v *ExecutorDrainResponse) GetStatus() (o ExecutorStatus) {
+	if v != nil {
+		return v.Status
+	}
+	return
+}
+
+func (v *ExecutorDrainResponse) GetRemainingShards() (o int64) {
+	if v != nil {
+		return v.RemainingShards
+	}
+	return
+}
+
 // HandoverType is used to indicate the type of handover that occurred during shard reassignment.
 // Type is persisted to the DB with a string value mapping.
 // Beware - if we want to change the name - it should be backward compatible and should be done in two steps.
@@ -321,3 +361,249 @@ func (v *Shard) GetShardKey() (o string) {
 	}
 	return
 }
+
+type ListNamespacesRequest struct{}
+
+type ListNamespacesResponse struct {
+	Namespaces []*NamespaceInfo
+}
+
+func (v *ListNamespacesResponse) GetNamespaces() (o []*NamespaceInfo) {
+	if v != nil {
+		return v.Namespaces
+	}
+	return
+}
+
+type NamespaceInfo struct {
+	Name string
+	// Type is either fixed or ephemeral.
+	Type string
+	Mode string
+	// ShardNum is only defined for fixed namespaces.
+	ShardNum int64
+}
+
+func (v *NamespaceInfo) GetName() (o string) {
+	if v != nil {
+		return v.Name
+	}
+	return
+}
+
+func (v *NamespaceInfo) GetType() (o string) {
+	if v != nil {
+		return v.Type
+	}
+	return
+}
+
+func (v *NamespaceInfo) GetMode() (o string) {
+	if v != nil {
+		return v.Mode
+	}
+	return
+}
+
+func (v *NamespaceInfo) GetShardNum() (o int64) {
+	if v != nil {
+		return v.ShardNum
+	}
+	return
+}
+
+type ListExecutorsRequest struct {
+	Namespace string
+}
+
+func (v *ListExecutorsRequest) GetNamespace() (o string) {
+	if v != nil {
+		return v.Namespace
+	}
+	return
+}
+
+type ListExecutorsResponse struct {
+	Executors []*ExecutorSummary
+}
+
+func (v *ListExecutorsResponse) GetExecutors() (o []*ExecutorSummary) {
+	if v != nil {
+		return v.Executors
+	}
+	return
+}
+
+type ExecutorSummary struct {
+	ExecutorID string
+	Status     ExecutorStatus
+	// LastHeartbeat is the time of the last heartbeat in unix nanoseconds.
+	LastHeartbeat *int64
+	// AssignedShards is the number of shards the leader assigned to the executor.
+	AssignedShards int64
+	// ReportedShards is the number of shards the executor reported in its last heartbeat.
+	ReportedShards int64
+	Metadata       map[string]string
+}
+
+func (v *ExecutorSummary) GetExecutorID() (o string) {
+	if v != nil {
+		return v.ExecutorID
+	}
+	return
+}
+
+func (v *ExecutorSummary) GetStatus() (o ExecutorStatus) {
+	if v != nil {
+		return v.Status
+	}
+	return
+}
+
+func (v *ExecutorSummary) GetLastHeartbeat() (o int64) {
+	if v != nil && v.LastHeartbeat != nil {
+		return *v.LastHeartbeat
+	}
+	return
+}
+
+func (v *ExecutorSummary) GetAssignedShards() (o int64) {
+	if v != nil {
+		return v.AssignedShards
+	}
+	return
+}
+
+func (v *ExecutorSummary) GetReportedShards() (o int64) {
+	if v != nil {
+		return v.ReportedShards
+	}
+	return
+}
+
+func (v *ExecutorSummary) GetMetadata() (o map[string]string) {
+	if v != nil {
+		return v.Metadata
+	}
+	return
+}
+
+type DescribeShardRequest struct {
+	Namespace string
+	ShardKey  string
+}
+
+func (v *DescribeShardRequest) GetNamespace() (o string) {
+	if v != nil {
+		return v.Namespace
+	}
+	return
+}
+
+func (v *DescribeShardRequest) GetShardKey() (o string) {
+	if v != nil {
+		return v.ShardKey
+	}
+	return
+}
+
+type DescribeShardResponse struct {
+	Owner    string
+	Metadata map[string]string
+	// Statistics are only kept for namespaces balanced by shard load, they are nil otherwise.
+	Statistics *ShardStatistics
+}
+
+func (v *DescribeShardResponse) GetOwner() (o string) {
+	if v != nil {
+		return v.Owner
+	}
+	return
+}
+
+func (v *DescribeShardResponse) GetMetadata() (o map[string]string) {
+	if v != nil {
+		return v.Metadata
+	}
+	return
+}
+
+func (v *DescribeShardResponse) GetStatistics() (o *ShardStatistics) {
+	if v != nil {
+		return v.Statistics
+	}
+	return
+}
+
+type ShardStatistics struct {
+	// SmoothedLoad is the exponentially weighted moving average of the shard load.
+	SmoothedLoad float64
+	// LastUpdateTime is the time of the heartbeat that last updated the load in unix nanoseconds.
+	LastUpdateTime *int64
+	// LastMoveTime is the time the shard was last reassigned in unix nanoseconds.
+	LastMoveTime *int64
+}
+
+func (v *ShardStatistics) GetSmoothedLoad() (o float64) {
+	if v != nil {
+		return v.SmoothedLoad
+	}
+	return
+}
+
+func (v *ShardStatistics) GetLastUpdateTime() (o int64) {
+	if v != nil && v.LastUpdateTime != nil {
+		return *v.LastUpdateTime
+	}
+	return
+}
+
+func (v *ShardStatistics) GetLastMoveTime() (o int64) {
+	if v != nil && v.LastMoveTime != nil {
+		return *v.LastMoveTime
+	}
+	return
+}
+
+type TriggerRebalanceRequest struct {
+	Namespace string
+}
+
+func (v *TriggerRebalanceRequest) GetNamespace() (o string) {
+	if v != nil {
+		return v.Namespace
+	}
+	return
+}
+
+type TriggerRebalanceResponse struct{}
+
+type MoveShardRequest struct {
+	Namespace string
+	ShardKey  string
+	// ExecutorID is the executor the shard is moved to.
+	ExecutorID string
+}
+
+func (v *MoveShardRequest) GetNamespace() (o string) {
+	if v != nil {
+		return v.Namespace
+	}
+	return
+}
+
+func (v *MoveShardRequest) GetShardKey() (o string) {
+	if v != nil {
+		return v.ShardKey
+	}
+	return
+}
+
+func (v *MoveShardRequest) GetExecutorID() (o string) {
+	if v != nil {
+		return v.ExecutorID
+	}
+	return
+}
+
+type MoveShardResponse struct{}
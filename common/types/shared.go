@@ -167,6 +167,8 @@ type ActivityTaskScheduledEventAttributes struct {
 	DecisionTaskCompletedEventID  int64         `json:"decisionTaskCompletedEventId,omitempty"`
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 	Header                        *Header       `json:"header,omitempty"`
+	Priority                      int32         `json:"priority,omitempty"`
+	FairnessKey                   string        `json:"fairnessKey,omitempty"`
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -233,6 +235,22 @@ func (v *ActivityTaskScheduledEventAttributes) GetHeartbeatTimeoutSeconds() (o i
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetFairnessKey is an internal getter (TBD...)
+func (v *ActivityTaskScheduledEventAttributes) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
 // Size returns the approximate memory used in bytes
 func (v *ActivityTaskScheduledEventAttributes) ByteSize() uint64 {
 	return 0
@@ -1927,6 +1945,109 @@ func (v *ListFailoverHistoryResponse) GetNextPageToken() (o []byte) {
 	return
 }
 
+// ListDomainChangesRequest is an internal type (TBD...)
+type ListDomainChangesRequest struct {
+	Domain     string             `json:"domain,omitempty"`
+	Pagination *PaginationOptions `json:"pagination,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ListDomainChangesRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetPagination is an internal getter (TBD...)
+func (v *ListDomainChangesRequest) GetPagination() (o *PaginationOptions) {
+	if v != nil && v.Pagination != nil {
+		return v.Pagination
+	}
+	return
+}
+
+// ListDomainChangesResponse is an internal type (TBD...)
+type ListDomainChangesResponse struct {
+	DomainChanges []*DomainChange `json:"domainChanges,omitempty"`
+	NextPageToken []byte          `json:"nextPageToken,omitempty"`
+}
+
+// GetDomainChanges is an internal getter (TBD...)
+func (v *ListDomainChangesResponse) GetDomainChanges() (o []*DomainChange) {
+	if v != nil && v.DomainChanges != nil {
+		return v.DomainChanges
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ListDomainChangesResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// DomainChange is a single entry of the domain audit log, with the fields the operation changed
+type DomainChange struct {
+	ID            string               `json:"id,omitempty"`
+	CreatedTime   int64                `json:"createdTime,omitempty"`
+	OperationType string               `json:"operationType,omitempty"`
+	Identity      string               `json:"identity,omitempty"`
+	IdentityType  string               `json:"identityType,omitempty"`
+	Comment       string               `json:"comment,omitempty"`
+	FieldChanges  []*DomainFieldChange `json:"fieldChanges,omitempty"`
+}
+
+// GetID is an internal getter (TBD...)
+func (v *DomainChange) GetID() (o string) {
+	if v != nil {
+		return v.ID
+	}
+	return
+}
+
+// GetCreatedTime is an internal getter (TBD...)
+func (v *DomainChange) GetCreatedTime() (o int64) {
+	if v != nil {
+		return v.CreatedTime
+	}
+	return
+}
+
+// GetOperationType is an internal getter (TBD...)
+func (v *DomainChange) GetOperationType() (o string) {
+	if v != nil {
+		return v.OperationType
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *DomainChange) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetFieldChanges is an internal getter (TBD...)
+func (v *DomainChange) GetFieldChanges() (o []*DomainFieldChange) {
+	if v != nil && v.FieldChanges != nil {
+		return v.FieldChanges
+	}
+	return
+}
+
+// DomainFieldChange is the value of a domain configuration field before and after a change,
+// an empty value means the field was not set
+type DomainFieldChange struct {
+	Field  string `json:"field,omitempty"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
 // PaginationOptions is an internal type (TBD...)
 type PaginationOptions struct {
 	PageSize      *int32 `json:"pageSize,omitempty"`
@@ -6724,6 +6845,8 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 	Header                        *Header       `json:"header,omitempty"`
 	RequestLocalDispatch          bool          `json:"requestLocalDispatch,omitempty"`
+	Priority                      int32         `json:"priority,omitempty"`
+	FairnessKey                   string        `json:"fairnessKey,omitempty"`
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -6782,6 +6905,22 @@ func (v *ScheduleActivityTaskDecisionAttributes) GetHeartbeatTimeoutSeconds() (o
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetFairnessKey is an internal getter (TBD...)
+func (v *ScheduleActivityTaskDecisionAttributes) GetFairnessKey() (o string) {
+	if v != nil {
+		return v.FairnessKey
+	}
+	return
+}
+
 // SearchAttributes is an internal type (TBD...)
 type SearchAttributes struct {
 	IndexedFields map[string][]byte `json:"indexedFields,omitempty"`
@@ -7744,14 +7883,16 @@ type IsolationGroupMetrics struct {
 
 // TaskListStatus is an internal type (TBD...)
 type TaskListStatus struct {
-	BacklogCountHint      int64                             `json:"backlogCountHint,omitempty"`
-	ReadLevel             int64                             `json:"readLevel,omitempty"`
-	AckLevel              int64                             `json:"ackLevel,omitempty"`
-	RatePerSecond         float64                           `json:"ratePerSecond,omitempty"`
-	TaskIDBlock           *TaskIDBlock                      `json:"taskIDBlock,omitempty"`
-	IsolationGroupMetrics map[string]*IsolationGroupMetrics `json:"isolationGroupMetrics,omitempty"`
-	NewTasksPerSecond     float64                           `json:"newTasksPerSecond,omitempty"`
-	Empty                 bool                              `json:"empty,omitempty"`
+	BacklogCountHint          int64                             `json:"backlogCountHint,omitempty"`
+	ReadLevel                 int64                             `json:"readLevel,omitempty"`
+	AckLevel                  int64                             `json:"ackLevel,omitempty"`
+	RatePerSecond             float64                           `json:"ratePerSecond,omitempty"`
+	TaskIDBlock               *TaskIDBlock                      `json:"taskIDBlock,omitempty"`
+	IsolationGroupMetrics     map[string]*IsolationGroupMetrics `json:"isolationGroupMetrics,omitempty"`
+	NewTasksPerSecond         float64                           `json:"newTasksPerSecond,omitempty"`
+	Empty                     bool                              `json:"empty,omitempty"`
+	BacklogCountByPriority    map[int32]int64                   `json:"backlogCountByPriority,omitempty"`
+	BacklogCountByFairnessKey map[string]int64                  `json:"backlogCountByFairnessKey,omitempty"`
 }
 
 // GetBacklogCountHint is an internal getter (TBD...)
@@ -7794,6 +7935,22 @@ func (v *TaskListStatus) GetTaskIDBlock() (o *TaskIDBlock) {
 	return
 }
 
+// GetBacklogCountByPriority is an internal getter (TBD...)
+func (v *TaskListStatus) GetBacklogCountByPriority() (o map[int32]int64) {
+	if v != nil && v.BacklogCountByPriority != nil {
+		return v.BacklogCountByPriority
+	}
+	return
+}
+
+// GetBacklogCountByFairnessKey is an internal getter (TBD...)
+func (v *TaskListStatus) GetBacklogCountByFairnessKey() (o map[string]int64) {
+	if v != nil && v.BacklogCountByFairnessKey != nil {
+		return v.BacklogCountByFairnessKey
+	}
+	return
+}
+
 // TaskListType is an internal type (TBD...)
 type TaskListType int32
 
@@ -8166,6 +8323,82 @@ func (v *UpdateDomainResponse) GetIsGlobalDomain() (o bool) {
 	return
 }
 
+// UpdateVirtualQueueRequest is an internal type (TBD...)
+type UpdateVirtualQueueRequest struct {
+	ShardID                int32                  `json:"shardID,omitempty"`
+	ClusterName            string                 `json:"clusterName,omitempty"`
+	Type                   *int32                 `json:"type,omitempty"`
+	Operation              *VirtualQueueOperation `json:"operation,omitempty"`
+	VirtualQueueID         int64                  `json:"virtualQueueID,omitempty"`
+	TargetVirtualQueueID   int64                  `json:"targetVirtualQueueID,omitempty"`
+	DomainIDs              []string               `json:"domainIDs,omitempty"`
+	PauseDurationInSeconds int32                  `json:"pauseDurationInSeconds,omitempty"`
+}
+
+// GetShardID is an internal getter (TBD...)
+func (v *UpdateVirtualQueueRequest) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+// GetClusterName is an internal getter (TBD...)
+func (v *UpdateVirtualQueueRequest) GetClusterName() (o string) {
+	if v != nil {
+		return v.ClusterName
+	}
+	return
+}
+
+// GetType is an internal getter (TBD...)
+func (v *UpdateVirtualQueueRequest) GetType() (o int32) {
+	if v != nil && v.Type != nil {
+		return *v.Type
+	}
+	return
+}
+
+// GetOperation is an internal getter (TBD...)
+func (v *UpdateVirtualQueueRequest) GetOperation() (o VirtualQueueOperation) {
+	if v != nil && v.Operation != nil {
+		return *v.Operation
+	}
+	return
+}
+
+// GetVirtualQueueID is an internal getter (TBD...)
+func (v *UpdateVirtualQueueRequest) GetVirtualQueueID() (o int64) {
+	if v != nil {
+		return v.VirtualQueueID
+	}
+	return
+}
+
+// GetTargetVirtualQueueID is an internal getter (TBD...)
+func (v *UpdateVirtualQueueRequest) GetTargetVirtualQueueID() (o int64) {
+	if v != nil {
+		return v.TargetVirtualQueueID
+	}
+	return
+}
+
+// GetDomainIDs is an internal getter (TBD...)
+func (v *UpdateVirtualQueueRequest) GetDomainIDs() (o []string) {
+	if v != nil && v.DomainIDs != nil {
+		return v.DomainIDs
+	}
+	return
+}
+
+// GetPauseDurationInSeconds is an internal getter (TBD...)
+func (v *UpdateVirtualQueueRequest) GetPauseDurationInSeconds() (o int32) {
+	if v != nil {
+		return v.PauseDurationInSeconds
+	}
+	return
+}
+
 // UpsertWorkflowSearchAttributesDecisionAttributes is an internal type (TBD...)
 type UpsertWorkflowSearchAttributesDecisionAttributes struct {
 	SearchAttributes *SearchAttributes `json:"searchAttributes,omitempty"`
@@ -8267,6 +8500,71 @@ func (v *VersionHistoryItem) ByteSize() uint64 {
 	return uint64(unsafe.Sizeof(*v))
 }
 
+// VirtualQueueOperation is an internal type (TBD...)
+type VirtualQueueOperation int32
+
+// Ptr is a helper function for getting pointer value
+func (e VirtualQueueOperation) Ptr() *VirtualQueueOperation {
+	return &e
+}
+
+// String returns a readable string representation of VirtualQueueOperation.
+func (e VirtualQueueOperation) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "MoveDomains"
+	case 1:
+		return "Pause"
+	case 2:
+		return "Resume"
+	case 3:
+		return "Merge"
+	}
+	return fmt.Sprintf("VirtualQueueOperation(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *VirtualQueueOperation) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "MOVEDOMAINS":
+		*e = VirtualQueueOperationMoveDomains
+		return nil
+	case "PAUSE":
+		*e = VirtualQueueOperationPause
+		return nil
+	case "RESUME":
+		*e = VirtualQueueOperationResume
+		return nil
+	case "MERGE":
+		*e = VirtualQueueOperationMerge
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "VirtualQueueOperation", err)
+		}
+		*e = VirtualQueueOperation(val)
+		return nil
+	}
+}
+
+// MarshalText encodes VirtualQueueOperation to text.
+func (e VirtualQueueOperation) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// VirtualQueueOperationMoveDomains is an option for VirtualQueueOperation
+	VirtualQueueOperationMoveDomains VirtualQueueOperation = iota
+	// VirtualQueueOperationPause is an option for VirtualQueueOperation
+	VirtualQueueOperationPause
+	// VirtualQueueOperationResume is an option for VirtualQueueOperation
+	VirtualQueueOperationResume
+	// VirtualQueueOperationMerge is an option for VirtualQueueOperation
+	VirtualQueueOperationMerge
+)
+
 // WorkerVersionInfo is an internal type (TBD...)
 type WorkerVersionInfo struct {
 	Impl           string `json:"impl,omitempty"`
@@ -53,6 +53,7 @@ const (
 
 	FailoverEventID = "failover-event-123"
 	FailoverTime    = int64(1234567890000000000)
+	DomainChangeID  = "domain-change-123"
 )
 
 var (
@@ -176,4 +177,23 @@ var (
 		FailoverEvents: []*types.FailoverEvent{&FailoverEvent},
 		NextPageToken:  []byte("next-page-token"),
 	}
+	DomainChange = types.DomainChange{
+		ID:            DomainChangeID,
+		CreatedTime:   FailoverTime,
+		OperationType: "UpdateDomain",
+		Identity:      Identity,
+		IdentityType:  "user",
+		Comment:       "raise retention",
+		FieldChanges: []*types.DomainFieldChange{
+			{Field: "retention", Before: "1", After: "7"},
+		},
+	}
+	ListDomainChangesRequest = types.ListDomainChangesRequest{
+		Domain:     DomainName,
+		Pagination: &PaginationOptions,
+	}
+	ListDomainChangesResponse = types.ListDomainChangesResponse{
+		DomainChanges: []*types.DomainChange{&DomainChange},
+		NextPageToken: []byte("next-page-token"),
+	}
 )
@@ -234,6 +234,16 @@ var (
 		ClusterName: ClusterName1,
 		Type:        common.Int32Ptr(QueueType),
 	}
+	AdminUpdateVirtualQueueRequest = types.UpdateVirtualQueueRequest{
+		ShardID:                ShardID,
+		ClusterName:            ClusterName1,
+		Type:                   common.Int32Ptr(QueueType),
+		Operation:              types.VirtualQueueOperationMoveDomains.Ptr(),
+		VirtualQueueID:         0,
+		TargetVirtualQueueID:   1,
+		DomainIDs:              []string{DomainID},
+		PauseDurationInSeconds: Duration1,
+	}
 	AdminGetCrossClusterTasksRequest               = GetCrossClusterTasksRequest
 	AdminGetCrossClusterTasksResponse              = GetCrossClusterTasksResponse
 	AdminRespondCrossClusterTasksCompletedRequest  = RespondCrossClusterTasksCompletedRequest
@@ -244,6 +254,17 @@ var (
 			&DynamicConfigFilter,
 		},
 	}
+	AdminRollbackDomainRequest = types.RollbackDomainRequest{
+		Domain:  DomainName,
+		EventID: DomainChangeID,
+	}
+	AdminRollbackDomainResponse = types.RollbackDomainResponse{
+		DomainInfo:               &DomainInfo,
+		Configuration:            &DomainConfiguration,
+		ReplicationConfiguration: &DomainReplicationConfiguration,
+		FailoverVersion:          FailoverVersion1,
+		IsGlobalDomain:           true,
+	}
 	AdminUpdateDomainIsolationGroupsRequest = types.UpdateDomainIsolationGroupsRequest{
 		Domain:          DomainName,
 		IsolationGroups: IsolationGroupConfiguration,
@@ -298,6 +298,7 @@ var (
 		ExternalWorkflowExecution: &WorkflowExecution,
 		ChildWorkflowOnly:         true,
 	}
+	HistoryUpdateVirtualQueueRequest                 = AdminUpdateVirtualQueueRequest
 	HistoryGetCrossClusterTasksRequest               = GetCrossClusterTasksRequest
 	HistoryGetCrossClusterTasksResponse              = GetCrossClusterTasksResponse
 	HistoryRespondCrossClusterTasksCompletedRequest  = RespondCrossClusterTasksCompletedRequest
@@ -26,8 +26,10 @@ import (
 )
 
 const (
-	ForwardedFrom = "ForwardedFrom"
-	PollerID      = "PollerID"
+	ForwardedFrom   = "ForwardedFrom"
+	PollerID        = "PollerID"
+	TaskPriority    = 5
+	TaskFairnessKey = "tenant"
 )
 
 var (
@@ -61,6 +63,8 @@ var (
 		Source:                        types.TaskSourceDbBacklog.Ptr(),
 		ForwardedFrom:                 ForwardedFrom,
 		PartitionConfig:               PartitionConfig,
+		Priority:                      TaskPriority,
+		FairnessKey:                   TaskFairnessKey,
 	}
 	MatchingAddDecisionTaskRequest = types.AddDecisionTaskRequest{
 		DomainUUID:                    DomainID,
@@ -71,6 +75,7 @@ var (
 		Source:                        types.TaskSourceDbBacklog.Ptr(),
 		ForwardedFrom:                 ForwardedFrom,
 		PartitionConfig:               PartitionConfig,
+		Priority:                      TaskPriority,
 	}
 	MatchingAddActivityTaskResponse = types.AddActivityTaskResponse{
 		PartitionConfig: &TaskListPartitionConfig,
@@ -63,6 +63,14 @@ var (
 			},
 		},
 	}
+	ShardDistributorExecutorDrainRequest = types.ExecutorDrainRequest{
+		Namespace:  "namespace",
+		ExecutorID: "executor-id",
+	}
+	ShardDistributorExecutorDrainResponse = types.ExecutorDrainResponse{
+		Status:          types.ExecutorStatusDRAINING,
+		RemainingShards: 3,
+	}
 	ShardDistributorWatchNamespaceStateRequest = types.WatchNamespaceStateRequest{
 		Namespace: "namespace",
 	}
@@ -80,4 +88,52 @@ var (
 			},
 		},
 	}
+	ShardDistributorListNamespacesResponse = types.ListNamespacesResponse{
+		Namespaces: []*types.NamespaceInfo{
+			{Name: "namespace-1", Type: "fixed", Mode: "onboarded", ShardNum: 32},
+			{Name: "namespace-2", Type: "ephemeral", Mode: "onboarded"},
+		},
+	}
+	ShardDistributorListExecutorsRequest = types.ListExecutorsRequest{
+		Namespace: "namespace",
+	}
+	ShardDistributorListExecutorsResponse = types.ListExecutorsResponse{
+		Executors: []*types.ExecutorSummary{
+			{
+				ExecutorID:     "executor-1",
+				Status:         types.ExecutorStatusACTIVE,
+				LastHeartbeat:  &Timestamp1,
+				AssignedShards: 2,
+				ReportedShards: 2,
+				Metadata:       map[string]string{"key-1": "value-1"},
+			},
+			{
+				ExecutorID:     "executor-2",
+				Status:         types.ExecutorStatusDRAINING,
+				LastHeartbeat:  &Timestamp2,
+				AssignedShards: 1,
+			},
+		},
+	}
+	ShardDistributorDescribeShardRequest = types.DescribeShardRequest{
+		Namespace: "namespace",
+		ShardKey:  "shard-key",
+	}
+	ShardDistributorDescribeShardResponse = types.DescribeShardResponse{
+		Owner:    "owner",
+		Metadata: map[string]string{"key-1": "value-1"},
+		Statistics: &types.ShardStatistics{
+			SmoothedLoad:   0.5,
+			LastUpdateTime: &Timestamp1,
+			LastMoveTime:   &Timestamp2,
+		},
+	}
+	ShardDistributorTriggerRebalanceRequest = types.TriggerRebalanceRequest{
+		Namespace: "namespace",
+	}
+	ShardDistributorMoveShardRequest = types.MoveShardRequest{
+		Namespace:  "namespace",
+		ShardKey:   "shard-key",
+		ExecutorID: "executor-id",
+	}
 )
@@ -95,6 +95,10 @@ const (
 	domainCacheMaxInterval        = 5 * time.Second
 	domainCacheExpirationInterval = 2 * time.Minute
 
+	blobstoreOperationInitialInterval    = 100 * time.Millisecond
+	blobstoreOperationMaxInterval        = 5 * time.Second
+	blobstoreOperationExpirationInterval = 30 * time.Second
+
 	contextExpireThreshold = 10 * time.Millisecond
 
 	// FailureReasonCompleteResultExceedsLimit is failureReason for complete result exceeds limit
@@ -255,6 +259,15 @@ func CreateDomainCacheRetryPolicy() backoff.RetryPolicy {
 	return policy
 }
 
+// CreateBlobstoreRetryPolicy creates a retry policy for blobstore operations
+func CreateBlobstoreRetryPolicy() backoff.RetryPolicy {
+	policy := backoff.NewExponentialRetryPolicy(blobstoreOperationInitialInterval)
+	policy.SetMaximumInterval(blobstoreOperationMaxInterval)
+	policy.SetExpirationInterval(blobstoreOperationExpirationInterval)
+
+	return policy
+}
+
 // IsValidIDLength checks if id is valid according to its length
 func IsValidIDLength(
 	id string,
@@ -20,15 +20,42 @@
 
 package cadence
 
+import "time"
+
 // below are the names of all mongoDB collections
 const (
-	ClusterConfigCollectionName = "cluster_config"
+	ClusterConfigCollectionName                = "cluster_config"
+	ShardCollectionName                        = "shard"
+	DomainCollectionName                       = "domain"
+	DomainMetadataCollectionName               = "domain_metadata"
+	DomainAuditLogCollectionName               = "domain_audit_log"
+	QueueMessageCollectionName                 = "queue_message"
+	QueueMetadataCollectionName                = "queue_metadata"
+	TaskListCollectionName                     = "task_list"
+	TaskCollectionName                         = "task"
+	HistoryTreeCollectionName                  = "history_tree"
+	HistoryNodeCollectionName                  = "history_node"
+	VisibilityCollectionName                   = "visibility"
+	CurrentWorkflowCollectionName              = "current_workflow"
+	WorkflowExecutionCollectionName            = "workflow_execution"
+	TransferTaskCollectionName                 = "transfer_task"
+	TimerTaskCollectionName                    = "timer_task"
+	ReplicationTaskCollectionName              = "replication_task"
+	ReplicationDLQTaskCollectionName           = "replication_dlq_task"
+	WorkflowRequestCollectionName              = "workflow_request"
+	ActiveClusterSelectionPolicyCollectionName = "active_cluster_selection_policy"
 )
 
 // NOTE1: MongoDB collection is schemaless -- there is no schema file for collection. We use Go lang structs to define the collection fields.
 
 // NOTE2: MongoDB doesn't allow using camel case or underscore in the field names
 
+// NOTE3: Following nosqlplugin interfaces, only the keys and the fields used in conditions or queries are stored as
+// document fields, the rest of a row is JSON encoded into the data field.
+
+// NOTE4: ExpiresAt fields are covered by TTL indexes(expireAfterSeconds=0). MongoDB removes expired documents
+// periodically, so the reads must skip the documents that are expired but not removed yet.
+
 // ClusterConfigCollectionEntry is the schema of configStore
 // IMPORTANT: making change to this struct is changing the MongoDB collection schema. Please make sure it's backward compatible(e.g., don't delete the field, or change the annotation value).
 type ClusterConfigCollectionEntry struct {
@@ -39,3 +66,165 @@ type ClusterConfigCollectionEntry struct {
 	DataEncoding         string `json:"dataencoding"`
 	UnixTimestampSeconds int64  `json:"unixtimestampseconds"`
 }
+
+// ShardCollectionEntry is the schema of shard
+// Revision is bumped by every transaction that is conditioned on the shard, so that those transactions conflict
+// with each other as well as with the shard updates.
+type ShardCollectionEntry struct {
+	ShardID  int    `bson:"_id"`
+	RangeID  int64  `bson:"rangeid"`
+	Revision int64  `bson:"revision"`
+	Data     []byte `bson:"data"`
+}
+
+// DomainCollectionEntry is the schema of domain, name has an unique index
+type DomainCollectionEntry struct {
+	ID                  string `bson:"_id"`
+	Name                string `bson:"name"`
+	NotificationVersion int64  `bson:"notificationversion"`
+	Data                []byte `bson:"data"`
+}
+
+// DomainMetadataCollectionEntry is the schema of domain_metadata, there is only one document
+type DomainMetadataCollectionEntry struct {
+	ID                  string `bson:"_id"`
+	NotificationVersion int64  `bson:"notificationversion"`
+}
+
+// DomainAuditLogCollectionEntry is the schema of domain_audit_log
+type DomainAuditLogCollectionEntry struct {
+	DomainID      string     `bson:"domainid"`
+	OperationType int        `bson:"operationtype"`
+	CreatedTime   int64      `bson:"createdtime"` // in nanoseconds
+	EventID       string     `bson:"eventid"`
+	Data          []byte     `bson:"data"`
+	ExpiresAt     *time.Time `bson:"expiresat,omitempty"`
+}
+
+// QueueMessageCollectionEntry is the schema of queue_message
+type QueueMessageCollectionEntry struct {
+	QueueType int    `bson:"queuetype"`
+	MessageID int64  `bson:"messageid"`
+	Payload   []byte `bson:"payload"`
+}
+
+// QueueMetadataCollectionEntry is the schema of queue_metadata
+type QueueMetadataCollectionEntry struct {
+	QueueType int    `bson:"_id"`
+	Version   int64  `bson:"version"`
+	Data      []byte `bson:"data"`
+}
+
+// TaskListCollectionEntry is the schema of task_list
+// Revision is bumped by InsertTasks so that inserting tasks conflicts with the concurrent updates of the range ID
+type TaskListCollectionEntry struct {
+	DomainID     string     `bson:"domainid"`
+	TaskListName string     `bson:"tasklistname"`
+	TaskListType int        `bson:"tasklisttype"`
+	RangeID      int64      `bson:"rangeid"`
+	Revision     int64      `bson:"revision"`
+	Data         []byte     `bson:"data"`
+	ExpiresAt    *time.Time `bson:"expiresat,omitempty"`
+}
+
+// TaskCollectionEntry is the schema of task
+type TaskCollectionEntry struct {
+	DomainID     string     `bson:"domainid"`
+	TaskListName string     `bson:"tasklistname"`
+	TaskListType int        `bson:"tasklisttype"`
+	TaskID       int64      `bson:"taskid"`
+	Data         []byte     `bson:"data"`
+	ExpiresAt    *time.Time `bson:"expiresat,omitempty"`
+}
+
+// HistoryTreeCollectionEntry is the schema of history_tree
+type HistoryTreeCollectionEntry struct {
+	TreeID   string `bson:"treeid"`
+	BranchID string `bson:"branchid"`
+	Data     []byte `bson:"data"`
+}
+
+// HistoryNodeCollectionEntry is the schema of history_node
+type HistoryNodeCollectionEntry struct {
+	TreeID       string `bson:"treeid"`
+	BranchID     string `bson:"branchid"`
+	NodeID       int64  `bson:"nodeid"`
+	TxnID        int64  `bson:"txnid"`
+	Data         []byte `bson:"data"`
+	DataEncoding string `bson:"dataencoding"`
+}
+
+// VisibilityCollectionEntry is the schema of visibility, open and closed records share the same document.
+// CloseTime and CloseStatus are only set on closed records.
+type VisibilityCollectionEntry struct {
+	DomainID         string     `bson:"domainid"`
+	RunID            string     `bson:"runid"`
+	WorkflowID       string     `bson:"workflowid"`
+	WorkflowTypeName string     `bson:"workflowtypename"`
+	StartTime        int64      `bson:"starttime"` // in nanoseconds
+	CloseTime        *int64     `bson:"closetime,omitempty"`
+	CloseStatus      *int       `bson:"closestatus,omitempty"`
+	Data             []byte     `bson:"data"`
+	ExpiresAt        *time.Time `bson:"expiresat,omitempty"`
+}
+
+// CurrentWorkflowCollectionEntry is the schema of current_workflow
+type CurrentWorkflowCollectionEntry struct {
+	ShardID          int    `bson:"shardid"`
+	DomainID         string `bson:"domainid"`
+	WorkflowID       string `bson:"workflowid"`
+	RunID            string `bson:"runid"`
+	State            int    `bson:"state"`
+	LastWriteVersion int64  `bson:"lastwriteversion"`
+	Data             []byte `bson:"data"`
+}
+
+// WorkflowExecutionCollectionEntry is the schema of workflow_execution,
+// the mutable state including its maps and buffered events is encoded in data
+type WorkflowExecutionCollectionEntry struct {
+	ShardID          int    `bson:"shardid"`
+	DomainID         string `bson:"domainid"`
+	WorkflowID       string `bson:"workflowid"`
+	RunID            string `bson:"runid"`
+	NextEventID      int64  `bson:"nexteventid"`
+	LastWriteVersion int64  `bson:"lastwriteversion"`
+	Data             []byte `bson:"data"`
+}
+
+// HistoryTaskCollectionEntry is the schema of transfer_task, timer_task and replication_task.
+// VisibilityTimestamp is only set on timer tasks.
+type HistoryTaskCollectionEntry struct {
+	ShardID             int        `bson:"shardid"`
+	VisibilityTimestamp *time.Time `bson:"visibilitytimestamp,omitempty"`
+	TaskID              int64      `bson:"taskid"`
+	Data                []byte     `bson:"data"`
+}
+
+// ReplicationDLQTaskCollectionEntry is the schema of replication_dlq_task
+type ReplicationDLQTaskCollectionEntry struct {
+	ShardID       int    `bson:"shardid"`
+	SourceCluster string `bson:"sourcecluster"`
+	TaskID        int64  `bson:"taskid"`
+	Data          []byte `bson:"data"`
+}
+
+// WorkflowRequestCollectionEntry is the schema of workflow_request
+type WorkflowRequestCollectionEntry struct {
+	ShardID     int        `bson:"shardid"`
+	DomainID    string     `bson:"domainid"`
+	WorkflowID  string     `bson:"workflowid"`
+	RequestType int        `bson:"requesttype"`
+	RequestID   string     `bson:"requestid"`
+	RunID       string     `bson:"runid"`
+	Version     int64      `bson:"version"`
+	ExpiresAt   *time.Time `bson:"expiresat,omitempty"`
+}
+
+// ActiveClusterSelectionPolicyCollectionEntry is the schema of active_cluster_selection_policy
+type ActiveClusterSelectionPolicyCollectionEntry struct {
+	ShardID    int    `bson:"shardid"`
+	DomainID   string `bson:"domainid"`
+	WorkflowID string `bson:"workflowid"`
+	RunID      string `bson:"runid"`
+	Data       []byte `bson:"data"`
+}
@@ -22,5 +22,5 @@ package mysql
 
 import "embed"
 
-//go:embed v8/cadence/* v8/visibility/*
+//go:embed v8/cadence/* v8/visibility/* v8/sharddistributor/*
 var SchemaFS embed.FS
@@ -0,0 +1 @@
+CREATE DATABASE cadence_sharddistributor character set utf8;
\ No newline at end of file
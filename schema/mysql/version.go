@@ -27,3 +27,6 @@ const Version = "0.7"
 
 // VisibilityVersion is the MySQL visibility database release version
 const VisibilityVersion = "0.8"
+
+// ShardDistributorVersion is the MySQL shard distributor database release version
+const ShardDistributorVersion = "0.2"
@@ -0,0 +1,62 @@
+-- namespaces holds a revision counter per namespace. Every write to the assignments of a namespace
+-- increments it first, which serializes writers and gives assigned states a monotonic revision.
+CREATE TABLE shard_distributor_namespaces
+(
+    namespace VARCHAR(255) NOT NULL,
+    --
+    revision  BIGINT       NOT NULL,
+    PRIMARY KEY (namespace)
+);
+
+CREATE TABLE shard_distributor_executors
+(
+    namespace       VARCHAR(255) NOT NULL,
+    executor_id     VARCHAR(255) NOT NULL,
+    --
+    last_heartbeat  VARCHAR(64)  NOT NULL,
+    status          VARCHAR(64)  NOT NULL,
+    reported_shards BYTEA        NOT NULL,
+    metadata        BYTEA        NOT NULL,
+    PRIMARY KEY (namespace, executor_id)
+);
+
+CREATE TABLE shard_distributor_assigned_states
+(
+    namespace   VARCHAR(255) NOT NULL,
+    executor_id VARCHAR(255) NOT NULL,
+    --
+    data        BYTEA        NOT NULL,
+    revision    BIGINT       NOT NULL,
+    PRIMARY KEY (namespace, executor_id)
+);
+
+CREATE TABLE shard_distributor_shard_stats
+(
+    namespace   VARCHAR(255) NOT NULL,
+    executor_id VARCHAR(255) NOT NULL,
+    --
+    data        BYTEA        NOT NULL,
+    PRIMARY KEY (namespace, executor_id)
+);
+
+CREATE TABLE shard_distributor_shards
+(
+    namespace   VARCHAR(255) NOT NULL,
+    shard_id    VARCHAR(255) NOT NULL,
+    --
+    executor_id VARCHAR(255) NOT NULL,
+    PRIMARY KEY (namespace, shard_id)
+);
+
+CREATE INDEX shard_distributor_shards_by_executor ON shard_distributor_shards (namespace, executor_id);
+
+CREATE TABLE shard_distributor_leaders
+(
+    namespace  VARCHAR(255) NOT NULL,
+    --
+    leader_id  VARCHAR(255) NOT NULL,
+    hostname   VARCHAR(255) NOT NULL,
+    term       BIGINT       NOT NULL,
+    expires_at BIGINT       NOT NULL,
+    PRIMARY KEY (namespace)
+);
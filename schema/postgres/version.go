@@ -29,3 +29,6 @@ const Version = "0.7"
 // VisibilityVersion is the Postgres visibility database release version
 // Cadence supports both MySQL and Postgres officially, so upgrade should be perform for both MySQL and Postgres
 const VisibilityVersion = "0.8"
+
+// ShardDistributorVersion is the Postgres shard distributor database release version
+const ShardDistributorVersion = "0.2"
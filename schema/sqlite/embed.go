@@ -22,5 +22,5 @@ package sqlite
 
 import "embed"
 
-//go:embed cadence/* visibility/*
+//go:embed cadence/* visibility/* sharddistributor/*
 var SchemaFS embed.FS
@@ -0,0 +1 @@
+ALTER TABLE shard_distributor_namespaces ADD COLUMN rebalance_requests BIGINT NOT NULL DEFAULT 0;
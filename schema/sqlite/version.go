@@ -27,3 +27,6 @@ const Version = "0.2"
 
 // VisibilityVersion is the SQLite visibility database release version
 const VisibilityVersion = "0.1"
+
+// ShardDistributorVersion is the SQLite shard distributor database release version
+const ShardDistributorVersion = "0.2"
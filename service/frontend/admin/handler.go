@@ -78,6 +78,7 @@ type (
 		throttleRetry         *backoff.ThrottleRetry
 		isolationGroups       isolationgroupapi.Handler
 		asyncWFQueueConfigs   queueconfigapi.Handler
+		domainHandler         domain.Handler
 	}
 
 	workflowQueryTemplate struct {
@@ -153,7 +154,8 @@ func NewHandler(
 			backoff.WithRetryableError(common.IsServiceTransientError),
 		),
 		isolationGroups:     isolationgroupapi.New(resource.GetLogger(), resource.GetIsolationGroupStore(), domainHandler),
-		asyncWFQueueConfigs: queueconfigapi.New(resource.GetLogger(), domainHandler),
+		asyncWFQueueConfigs: queueconfigapi.New(resource.GetLogger(), domainHandler, resource.GetAsyncWorkflowQueueProvider()),
+		domainHandler:       domainHandler,
 	}
 }
 
@@ -658,6 +660,29 @@ func (adh *adminHandlerImpl) DescribeQueue(
 	return adh.GetHistoryClient().DescribeQueue(ctx, request)
 }
 
+// UpdateVirtualQueue moves domains between, pauses, resumes or merges virtual queues
+func (adh *adminHandlerImpl) UpdateVirtualQueue(
+	ctx context.Context,
+	request *types.UpdateVirtualQueueRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminUpdateVirtualQueueScope)
+	defer sw.Stop()
+
+	if request == nil || request.Type == nil || request.Operation == nil {
+		return adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.GetClusterName() == "" {
+		return adh.error(validate.ErrClusterNameNotSet, scope)
+	}
+
+	if err := adh.GetHistoryClient().UpdateVirtualQueue(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
 // DescribeShardDistribution returns information about history shard distribution
 func (adh *adminHandlerImpl) DescribeShardDistribution(
 	ctx context.Context,
@@ -1826,6 +1851,27 @@ func (adh *adminHandlerImpl) UpdateTaskListPartitionConfig(ctx context.Context,
 	return &types.UpdateTaskListPartitionConfigResponse{}, nil
 }
 
+// RollbackDomain restores the configuration of a domain to its state after a domain audit log entry
+func (adh *adminHandlerImpl) RollbackDomain(ctx context.Context, request *types.RollbackDomainRequest) (_ *types.RollbackDomainResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminRollbackDomainScope)
+	defer sw.Stop()
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.Domain == "" {
+		return nil, adh.error(validate.ErrDomainNotSet, scope)
+	}
+	if request.EventID == "" {
+		return nil, adh.error(&types.BadRequestError{Message: "Event ID not set on request."}, scope)
+	}
+	resp, err := adh.domainHandler.RollbackDomain(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
 func convertFromDataBlob(blob *types.DataBlob) (interface{}, error) {
 	switch *blob.EncodingType {
 	case types.EncodingTypeJSON:
@@ -1099,6 +1099,66 @@ func Test_GetDomainAsyncWorkflowConfiguraton(t *testing.T) {
 	}
 }
 
+func Test_RollbackDomain(t *testing.T) {
+	request := &types.RollbackDomainRequest{Domain: "test-domain", EventID: "event-id"}
+	tests := map[string]struct {
+		domainHandlerMockFn func(mock *domain.MockHandler)
+		input               *types.RollbackDomainRequest
+		wantResp            *types.RollbackDomainResponse
+		wantErr             error
+	}{
+		"success": {
+			input: request,
+			domainHandlerMockFn: func(mock *domain.MockHandler) {
+				mock.EXPECT().RollbackDomain(gomock.Any(), request).Return(&types.RollbackDomainResponse{IsGlobalDomain: true}, nil).Times(1)
+			},
+			wantResp: &types.RollbackDomainResponse{IsGlobalDomain: true},
+		},
+		"nil request": {
+			input:   nil,
+			wantErr: validate.ErrRequestNotSet,
+		},
+		"domain not set": {
+			input:   &types.RollbackDomainRequest{EventID: "event-id"},
+			wantErr: validate.ErrDomainNotSet,
+		},
+		"event ID not set": {
+			input:   &types.RollbackDomainRequest{Domain: "test-domain"},
+			wantErr: &types.BadRequestError{Message: "Event ID not set on request."},
+		},
+		"domain handler failed": {
+			input: request,
+			domainHandlerMockFn: func(mock *domain.MockHandler) {
+				mock.EXPECT().RollbackDomain(gomock.Any(), request).Return(nil, errors.New("failed")).Times(1)
+			},
+			wantErr: &types.InternalServiceError{Message: "failed"},
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			goMock := gomock.NewController(t)
+			domainHandlerMock := domain.NewMockHandler(goMock)
+			if td.domainHandlerMockFn != nil {
+				td.domainHandlerMockFn(domainHandlerMock)
+			}
+
+			handler := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:        testlogger.New(t),
+					MetricsClient: metrics.NewNoopMetricsClient(),
+				},
+				domainHandler: domainHandlerMock,
+			}
+
+			res, err := handler.RollbackDomain(context.Background(), td.input)
+
+			assert.Equal(t, td.wantResp, res)
+			assert.Equal(t, td.wantErr, err)
+		})
+	}
+}
+
 func Test_UpdateDomainAsyncWorkflowConfiguraton(t *testing.T) {
 	tests := map[string]struct {
 		queueCfgHandlerMockFn func(mock *queueconfigapi.MockHandler)
@@ -1354,6 +1414,101 @@ func Test_ResetQueue(t *testing.T) {
 	}
 }
 
+func Test_UpdateVirtualQueue(t *testing.T) {
+	tests := map[string]struct {
+		input         *types.UpdateVirtualQueueRequest
+		hcHandlerFunc func(mock *history.MockClient)
+		wantErr       bool
+	}{
+		"nil request": {
+			input: nil,
+			hcHandlerFunc: func(mock *history.MockClient) {
+			},
+			wantErr: true,
+		},
+		"missing operation request": {
+			input: &types.UpdateVirtualQueueRequest{
+				ShardID:     1,
+				ClusterName: "test-cluster",
+				Type:        common.Int32Ptr(1),
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+			},
+			wantErr: true,
+		},
+		"missing cluster name request": {
+			input: &types.UpdateVirtualQueueRequest{
+				ShardID:   1,
+				Type:      common.Int32Ptr(1),
+				Operation: types.VirtualQueueOperationPause.Ptr(),
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+			},
+			wantErr: true,
+		},
+		"normal request": {
+			input: &types.UpdateVirtualQueueRequest{
+				ShardID:        1,
+				ClusterName:    "test-cluster",
+				Type:           common.Int32Ptr(1),
+				Operation:      types.VirtualQueueOperationMerge.Ptr(),
+				VirtualQueueID: 1,
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().UpdateVirtualQueue(gomock.Any(), &types.UpdateVirtualQueueRequest{
+					ShardID:        1,
+					ClusterName:    "test-cluster",
+					Type:           common.Int32Ptr(1),
+					Operation:      types.VirtualQueueOperationMerge.Ptr(),
+					VirtualQueueID: 1,
+				}).Return(nil).Times(1)
+			},
+			wantErr: false,
+		},
+		"normal request return error": {
+			input: &types.UpdateVirtualQueueRequest{
+				ShardID:     1,
+				ClusterName: "test-cluster",
+				Type:        common.Int32Ptr(1),
+				Operation:   types.VirtualQueueOperationResume.Ptr(),
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().UpdateVirtualQueue(gomock.Any(), &types.UpdateVirtualQueueRequest{
+					ShardID:     1,
+					ClusterName: "test-cluster",
+					Type:        common.Int32Ptr(1),
+					Operation:   types.VirtualQueueOperationResume.Ptr(),
+				}).Return(assert.AnError).Times(1)
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			goMock := gomock.NewController(t)
+			hcMock := history.NewMockClient(goMock)
+			if td.hcHandlerFunc != nil {
+				td.hcHandlerFunc(hcMock)
+			}
+			handler := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:        testlogger.New(t),
+					MetricsClient: metrics.NewNoopMetricsClient(),
+					HistoryClient: hcMock,
+				},
+			}
+
+			err := handler.UpdateVirtualQueue(context.Background(), td.input)
+			if td.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_DescribeQueue(t *testing.T) {
 	tests := map[string]struct {
 		input         *types.DescribeQueueRequest
@@ -56,6 +56,7 @@ type Handler interface {
 	RemoveTask(context.Context, *types.RemoveTaskRequest) error
 	ResendReplicationTasks(context.Context, *types.ResendReplicationTasksRequest) error
 	ResetQueue(context.Context, *types.ResetQueueRequest) error
+	UpdateVirtualQueue(context.Context, *types.UpdateVirtualQueueRequest) error
 	GetCrossClusterTasks(context.Context, *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error)
 	RespondCrossClusterTasksCompleted(context.Context, *types.RespondCrossClusterTasksCompletedRequest) (*types.RespondCrossClusterTasksCompletedResponse, error)
 	GetDynamicConfig(context.Context, *types.GetDynamicConfigRequest) (*types.GetDynamicConfigResponse, error)
@@ -71,4 +72,5 @@ type Handler interface {
 	GetDomainAsyncWorkflowConfiguraton(context.Context, *types.GetDomainAsyncWorkflowConfiguratonRequest) (*types.GetDomainAsyncWorkflowConfiguratonResponse, error)
 	UpdateDomainAsyncWorkflowConfiguraton(context.Context, *types.UpdateDomainAsyncWorkflowConfiguratonRequest) (*types.UpdateDomainAsyncWorkflowConfiguratonResponse, error)
 	UpdateTaskListPartitionConfig(context.Context, *types.UpdateTaskListPartitionConfigRequest) (*types.UpdateTaskListPartitionConfigResponse, error)
+	RollbackDomain(context.Context, *types.RollbackDomainRequest) (*types.RollbackDomainResponse, error)
 }
@@ -483,6 +483,21 @@ func (mr *MockHandlerMockRecorder) RestoreDynamicConfig(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDynamicConfig", reflect.TypeOf((*MockHandler)(nil).RestoreDynamicConfig), arg0, arg1)
 }
 
+// RollbackDomain mocks base method.
+func (m *MockHandler) RollbackDomain(arg0 context.Context, arg1 *types.RollbackDomainRequest) (*types.RollbackDomainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackDomain", arg0, arg1)
+	ret0, _ := ret[0].(*types.RollbackDomainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackDomain indicates an expected call of RollbackDomain.
+func (mr *MockHandlerMockRecorder) RollbackDomain(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackDomain", reflect.TypeOf((*MockHandler)(nil).RollbackDomain), arg0, arg1)
+}
+
 // Start mocks base method.
 func (m *MockHandler) Start() {
 	m.ctrl.T.Helper()
@@ -580,3 +595,17 @@ func (mr *MockHandlerMockRecorder) UpdateTaskListPartitionConfig(arg0, arg1 any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListPartitionConfig", reflect.TypeOf((*MockHandler)(nil).UpdateTaskListPartitionConfig), arg0, arg1)
 }
+
+// UpdateVirtualQueue mocks base method.
+func (m *MockHandler) UpdateVirtualQueue(arg0 context.Context, arg1 *types.UpdateVirtualQueueRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVirtualQueue", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVirtualQueue indicates an expected call of UpdateVirtualQueue.
+func (mr *MockHandlerMockRecorder) UpdateVirtualQueue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualQueue", reflect.TypeOf((*MockHandler)(nil).UpdateVirtualQueue), arg0, arg1)
+}
@@ -25,7 +25,11 @@ package api
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+	"time"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -276,3 +280,156 @@ func (wh *WorkflowHandler) ListFailoverHistory(ctx context.Context, request *typ
 		NextPageToken:  auditLogsResp.NextPageToken,
 	}, nil
 }
+
+// ListDomainChanges lists the changes to the configuration of a domain recorded in the domain audit log,
+// most recent first. The audit log is stored per operation type, so the operations are merged by their
+// created time and a page never splits the changes made at the same time. The next page token is the
+// created time the next page starts before.
+func (wh *WorkflowHandler) ListDomainChanges(ctx context.Context, request *types.ListDomainChangesRequest) (*types.ListDomainChangesResponse, error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+	if request.GetDomain() == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+
+	domainAuditManager := wh.GetPersistenceBean().GetDomainAuditManager()
+	if domainAuditManager == nil {
+		return nil, &types.InternalServiceError{Message: "DomainAuditManager not available"}
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := 50
+	if request.GetPagination().GetPageSize() > 0 {
+		pageSize = int(request.GetPagination().GetPageSize())
+	}
+
+	var maxCreatedTime *time.Time
+	if token := request.GetPagination().GetNextPageToken(); len(token) > 0 {
+		nanos, err := strconv.ParseInt(string(token), 10, 64)
+		if err != nil {
+			return nil, &types.BadRequestError{Message: "Invalid next page token."}
+		}
+		maxCreatedTime = common.TimePtr(time.Unix(0, nanos))
+	}
+
+	auditLogs, completeAfter, err := listDomainAuditLogs(ctx, domainAuditManager, domainID, nil, maxCreatedTime, pageSize)
+	if err != nil {
+		wh.GetLogger().Error("Failed to get domain audit logs", tag.WorkflowDomainID(domainID), tag.Error(err))
+		return nil, err
+	}
+	auditLogs, nextCreatedTime := paginateDomainAuditLogs(auditLogs, completeAfter, pageSize)
+	if len(auditLogs) == 0 && nextCreatedTime != nil {
+		// more changes than a page were made at the same time, return all of them
+		groupTime := nextCreatedTime.Add(-domainChangesCursorPrecision)
+		auditLogs, _, err = listDomainAuditLogs(ctx, domainAuditManager, domainID, &groupTime, nextCreatedTime, 0)
+		if err != nil {
+			wh.GetLogger().Error("Failed to get domain audit logs", tag.WorkflowDomainID(domainID), tag.Error(err))
+			return nil, err
+		}
+		nextCreatedTime = &groupTime
+	}
+
+	response := &types.ListDomainChangesResponse{
+		DomainChanges: make([]*types.DomainChange, 0, len(auditLogs)),
+	}
+	for _, auditLog := range auditLogs {
+		response.DomainChanges = append(response.DomainChanges, auditLog.ToDomainChange())
+	}
+	if nextCreatedTime != nil {
+		response.NextPageToken = []byte(strconv.FormatInt(nextCreatedTime.UnixNano(), 10))
+	}
+	return response, nil
+}
+
+const (
+	// domainChangesCursorPrecision is the lowest time precision of the audit log stores,
+	// the exclusive upper bound of a page is moved by it to include the changes at the bound
+	domainChangesCursorPrecision = time.Millisecond
+)
+
+var domainChangesOperationTypes = []persistence.DomainAuditOperationType{
+	persistence.DomainAuditOperationTypeCreate,
+	persistence.DomainAuditOperationTypeUpdate,
+	persistence.DomainAuditOperationTypeFailover,
+	persistence.DomainAuditOperationTypeDeprecate,
+	persistence.DomainAuditOperationTypeDelete,
+}
+
+// listDomainAuditLogs reads the first page of the audit log of every operation type created in
+// [minCreatedTime, maxCreatedTime) and merges them, most recent first. Since the unread entries of an
+// operation type are not newer than the last entry read, only the merged entries created after the
+// returned time are known to be complete, it is nil if every operation type was read to the end.
+func listDomainAuditLogs(
+	ctx context.Context,
+	domainAuditManager persistence.DomainAuditManager,
+	domainID string,
+	minCreatedTime *time.Time,
+	maxCreatedTime *time.Time,
+	pageSize int,
+) ([]*persistence.DomainAuditLog, *time.Time, error) {
+	var auditLogs []*persistence.DomainAuditLog
+	var completeAfter *time.Time
+	for _, operationType := range domainChangesOperationTypes {
+		resp, err := domainAuditManager.GetDomainAuditLogs(ctx, &persistence.GetDomainAuditLogsRequest{
+			DomainID:       domainID,
+			OperationType:  operationType,
+			MinCreatedTime: minCreatedTime,
+			MaxCreatedTime: maxCreatedTime,
+			PageSize:       pageSize,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		auditLogs = append(auditLogs, resp.AuditLogs...)
+		if len(resp.NextPageToken) == 0 || len(resp.AuditLogs) == 0 {
+			continue
+		}
+		oldest := resp.AuditLogs[len(resp.AuditLogs)-1].CreatedTime
+		if completeAfter == nil || oldest.After(*completeAfter) {
+			completeAfter = &oldest
+		}
+	}
+	sort.SliceStable(auditLogs, func(i, j int) bool {
+		if !auditLogs[i].CreatedTime.Equal(auditLogs[j].CreatedTime) {
+			return auditLogs[i].CreatedTime.After(auditLogs[j].CreatedTime)
+		}
+		return auditLogs[i].EventID < auditLogs[j].EventID
+	})
+	return auditLogs, completeAfter, nil
+}
+
+// paginateDomainAuditLogs returns the first page of the sorted audit logs and the exclusive upper bound
+// of the created time of the next page, which is nil if there is no next page. Entries created at the
+// same time are either all in the page or all left to the next page.
+func paginateDomainAuditLogs(
+	auditLogs []*persistence.DomainAuditLog,
+	completeAfter *time.Time,
+	pageSize int,
+) ([]*persistence.DomainAuditLog, *time.Time) {
+	end := 0
+	for end < len(auditLogs) && end < pageSize && (completeAfter == nil || auditLogs[end].CreatedTime.After(*completeAfter)) {
+		end++
+	}
+	if end == len(auditLogs) && completeAfter == nil {
+		return auditLogs, nil
+	}
+
+	// the next page starts from the first entry left out, or from the incomplete entries
+	cutTime := completeAfter
+	if end < len(auditLogs) && (completeAfter == nil || auditLogs[end].CreatedTime.After(*completeAfter)) {
+		cutTime = &auditLogs[end].CreatedTime
+	}
+	for end > 0 && !auditLogs[end-1].CreatedTime.After(*cutTime) {
+		end--
+	}
+	next := cutTime.Add(domainChangesCursorPrecision)
+	return auditLogs[:end], &next
+}
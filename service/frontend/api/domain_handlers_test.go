@@ -26,6 +26,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -559,3 +560,166 @@ func TestListFailoverHistory(t *testing.T) {
 		})
 	}
 }
+
+func TestListDomainChanges(t *testing.T) {
+	domainID := "test-domain-id"
+	base := time.Unix(1700000000, 0)
+	at := func(seconds int) time.Time { return base.Add(time.Duration(seconds) * time.Second) }
+	auditLog := func(eventID string, createdTime time.Time, operationType persistence.DomainAuditOperationType) *persistence.DomainAuditLog {
+		return &persistence.DomainAuditLog{
+			EventID:       eventID,
+			DomainID:      domainID,
+			CreatedTime:   createdTime,
+			OperationType: operationType,
+			StateAfter:    &persistence.GetDomainResponse{Info: &persistence.DomainInfo{Description: eventID}},
+		}
+	}
+	// fakeAuditLog serves the audit logs of each operation type, most recent first
+	fakeAuditLog := func(auditLogs ...*persistence.DomainAuditLog) func(context.Context, *persistence.GetDomainAuditLogsRequest) (*persistence.GetDomainAuditLogsResponse, error) {
+		return func(_ context.Context, req *persistence.GetDomainAuditLogsRequest) (*persistence.GetDomainAuditLogsResponse, error) {
+			assert.Equal(t, domainID, req.DomainID)
+			resp := &persistence.GetDomainAuditLogsResponse{}
+			for _, l := range auditLogs {
+				if l.OperationType != req.OperationType ||
+					(req.MinCreatedTime != nil && l.CreatedTime.Before(*req.MinCreatedTime)) ||
+					(req.MaxCreatedTime != nil && !l.CreatedTime.Before(*req.MaxCreatedTime)) {
+					continue
+				}
+				if req.PageSize > 0 && len(resp.AuditLogs) == req.PageSize {
+					resp.NextPageToken = []byte("more")
+					break
+				}
+				resp.AuditLogs = append(resp.AuditLogs, l)
+			}
+			return resp, nil
+		}
+	}
+	eventIDs := func(resp *types.ListDomainChangesResponse) []string {
+		var ids []string
+		for _, change := range resp.GetDomainChanges() {
+			ids = append(ids, change.GetID())
+		}
+		return ids
+	}
+	listAll := func(t *testing.T, wh *WorkflowHandler, pageSize int32) [][]string {
+		var pages [][]string
+		var token []byte
+		for {
+			resp, err := wh.ListDomainChanges(context.Background(), &types.ListDomainChangesRequest{
+				Domain:     "test-domain",
+				Pagination: &types.PaginationOptions{PageSize: &pageSize, NextPageToken: token},
+			})
+			assert.NoError(t, err)
+			pages = append(pages, eventIDs(resp))
+			if token = resp.GetNextPageToken(); len(token) == 0 {
+				return pages
+			}
+			if len(pages) > 10 {
+				t.Fatal("too many pages")
+			}
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		auditLogs []*persistence.DomainAuditLog
+		pageSize  int32
+		expected  [][]string
+	}{
+		{
+			name: "operations are merged by created time",
+			auditLogs: []*persistence.DomainAuditLog{
+				auditLog("create", at(1), persistence.DomainAuditOperationTypeCreate),
+				auditLog("update", at(3), persistence.DomainAuditOperationTypeUpdate),
+				auditLog("failover", at(2), persistence.DomainAuditOperationTypeFailover),
+			},
+			pageSize: 10,
+			expected: [][]string{{"update", "failover", "create"}},
+		},
+		{
+			name: "entries not read from every operation are left to the next page",
+			auditLogs: []*persistence.DomainAuditLog{
+				auditLog("update-5", at(5), persistence.DomainAuditOperationTypeUpdate),
+				auditLog("update-4", at(4), persistence.DomainAuditOperationTypeUpdate),
+				auditLog("update-2", at(2), persistence.DomainAuditOperationTypeUpdate),
+				auditLog("failover-3", at(3), persistence.DomainAuditOperationTypeFailover),
+			},
+			pageSize: 2,
+			expected: [][]string{{"update-5"}, {"update-4", "failover-3"}, {"update-2"}},
+		},
+		{
+			name: "changes made at the same time are never split",
+			auditLogs: []*persistence.DomainAuditLog{
+				auditLog("update-2", at(2), persistence.DomainAuditOperationTypeUpdate),
+				auditLog("a-update-1", at(1), persistence.DomainAuditOperationTypeUpdate),
+				auditLog("b-failover-1", at(1), persistence.DomainAuditOperationTypeFailover),
+			},
+			pageSize: 1,
+			expected: [][]string{{"update-2"}, {"a-update-1", "b-failover-1"}, nil},
+		},
+		{
+			name:     "no changes",
+			pageSize: 10,
+			expected: [][]string{nil},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wh, deps := setupMocksForWorkflowHandler(t)
+			deps.mockDomainCache.EXPECT().GetDomainID("test-domain").Return(domainID, nil).AnyTimes()
+			deps.mockResource.DomainAuditMgr.EXPECT().GetDomainAuditLogs(gomock.Any(), gomock.Any()).DoAndReturn(fakeAuditLog(tc.auditLogs...)).AnyTimes()
+
+			assert.Equal(t, tc.expected, listAll(t, wh, tc.pageSize))
+		})
+	}
+
+	t.Run("field changes and actor", func(t *testing.T) {
+		wh, deps := setupMocksForWorkflowHandler(t)
+		deps.mockDomainCache.EXPECT().GetDomainID("test-domain").Return(domainID, nil)
+		l := auditLog("update", at(1), persistence.DomainAuditOperationTypeUpdate)
+		l.Identity = "cadence-cli"
+		l.IdentityType = "cli"
+		deps.mockResource.DomainAuditMgr.EXPECT().GetDomainAuditLogs(gomock.Any(), gomock.Any()).DoAndReturn(fakeAuditLog(l)).Times(5)
+
+		resp, err := wh.ListDomainChanges(context.Background(), &types.ListDomainChangesRequest{Domain: "test-domain"})
+		assert.NoError(t, err)
+		assert.Equal(t, &types.ListDomainChangesResponse{
+			DomainChanges: []*types.DomainChange{{
+				ID:            "update",
+				CreatedTime:   at(1).UnixNano(),
+				OperationType: "Update",
+				Identity:      "cadence-cli",
+				IdentityType:  "cli",
+				FieldChanges: []*types.DomainFieldChange{
+					{Field: "description", After: "update"},
+					{Field: "status", After: "REGISTERED"},
+				},
+			}},
+		}, resp)
+	})
+
+	t.Run("domain not set", func(t *testing.T) {
+		wh, _ := setupMocksForWorkflowHandler(t)
+		_, err := wh.ListDomainChanges(context.Background(), &types.ListDomainChangesRequest{})
+		assert.ErrorContains(t, err, "Domain not set")
+	})
+
+	t.Run("invalid next page token", func(t *testing.T) {
+		wh, deps := setupMocksForWorkflowHandler(t)
+		deps.mockDomainCache.EXPECT().GetDomainID("test-domain").Return(domainID, nil)
+		_, err := wh.ListDomainChanges(context.Background(), &types.ListDomainChangesRequest{
+			Domain:     "test-domain",
+			Pagination: &types.PaginationOptions{NextPageToken: []byte("invalid")},
+		})
+		assert.ErrorContains(t, err, "Invalid next page token")
+	})
+
+	t.Run("get domain audit logs fails", func(t *testing.T) {
+		wh, deps := setupMocksForWorkflowHandler(t)
+		deps.mockDomainCache.EXPECT().GetDomainID("test-domain").Return(domainID, nil)
+		deps.mockResource.DomainAuditMgr.EXPECT().GetDomainAuditLogs(gomock.Any(), gomock.Any()).Return(nil, errors.New("persistence error"))
+		_, err := wh.ListDomainChanges(context.Background(), &types.ListDomainChangesRequest{Domain: "test-domain"})
+		assert.ErrorContains(t, err, "persistence error")
+	})
+}
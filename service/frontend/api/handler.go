@@ -145,6 +145,7 @@ func NewWorkflowHandler(
 		producerManager: NewProducerManager(
 			resource.GetDomainCache(),
 			resource.GetAsyncWorkflowQueueProvider(),
+			resource.GetPersistenceBean(),
 			resource.GetLogger(),
 			resource.GetMetricsClient(),
 		),
@@ -25,23 +25,34 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/frontend/validate"
 	"github.com/uber/cadence/service/worker/scheduler"
 )
 
 const (
-	scheduleWorkflowIDPrefix          = "cadence-scheduler:"
 	schedulerWorkflowExecutionTimeout = 10 * 365 * 24 * time.Hour // ~10 years
 	schedulerWorkflowDecisionTimeout  = 10 * time.Second
 )
 
-func scheduleWorkflowID(scheduleID string) string {
-	return scheduleWorkflowIDPrefix + scheduleID
+// errSignalWithStartNotSupported rejects SignalWithStartWorkflow actions until the IDL
+// can carry them. Schedules created with one in-process could not be described or
+// updated over gRPC without losing their action.
+var errSignalWithStartNotSupported = &types.BadRequestError{Message: "SignalWithStartWorkflow actions are not supported yet."}
+
+// errScheduleSpecNotSupported rejects the spec fields the IDL cannot carry yet, for
+// the same reason as errSignalWithStartNotSupported. Only the primary cron
+// expression, start and end time and jitter are accepted.
+var errScheduleSpecNotSupported = &types.BadRequestError{
+	Message: "AdditionalCronExpressions, Intervals, ExcludedDates and TimeZone are not supported yet.",
 }
 
 func (wh *WorkflowHandler) CreateSchedule(
@@ -66,11 +77,20 @@ func (wh *WorkflowHandler) CreateSchedule(
 	if request.GetSpec() == nil {
 		return nil, &types.BadRequestError{Message: "Spec is not set on request."}
 	}
-	if request.GetSpec().GetCronExpression() == "" {
-		return nil, &types.BadRequestError{Message: "CronExpression is not set on request."}
+	if err := scheduler.ValidateScheduleSpec(request.GetSpec()); err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Invalid Spec: %v.", err)}
+	}
+	if !isScheduleSpecSupported(request.GetSpec()) {
+		return nil, errScheduleSpecNotSupported
+	}
+	if request.GetAction() == nil {
+		return nil, &types.BadRequestError{Message: "Action is not set on request."}
 	}
-	if request.GetAction() == nil || request.GetAction().GetStartWorkflow() == nil {
-		return nil, &types.BadRequestError{Message: "Action.StartWorkflow is not set on request."}
+	if err := scheduler.ValidateScheduleAction(request.GetAction()); err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Invalid Action: %v.", err)}
+	}
+	if request.GetAction().GetSignalWithStartWorkflow() != nil {
+		return nil, errSignalWithStartNotSupported
 	}
 
 	workflowInput := scheduler.SchedulerWorkflowInput{
@@ -88,7 +108,7 @@ func (wh *WorkflowHandler) CreateSchedule(
 		return nil, &types.InternalServiceError{Message: fmt.Sprintf("failed to serialize scheduler workflow input: %v", err)}
 	}
 
-	wfID := scheduleWorkflowID(scheduleID)
+	wfID := scheduler.WorkflowID(scheduleID)
 	requestID := uuid.New().String()
 	reusePolicy := types.WorkflowIDReusePolicyRejectDuplicate
 	executionTimeout := int32(schedulerWorkflowExecutionTimeout.Seconds())
@@ -140,27 +160,9 @@ func (wh *WorkflowHandler) DescribeSchedule(
 		return nil, &types.BadRequestError{Message: "ScheduleID is not set on request."}
 	}
 
-	wfID := scheduleWorkflowID(scheduleID)
-	queryResp, err := wh.QueryWorkflow(ctx, &types.QueryWorkflowRequest{
-		Domain: domainName,
-		Execution: &types.WorkflowExecution{
-			WorkflowID: wfID,
-		},
-		Query: &types.WorkflowQuery{
-			QueryType: scheduler.QueryTypeDescribe,
-		},
-	})
+	desc, err := wh.describeScheduleWorkflow(ctx, domainName, scheduleID)
 	if err != nil {
-		return nil, normalizeScheduleError(err, scheduleID, domainName)
-	}
-
-	if queryResp == nil || queryResp.GetQueryResult() == nil {
-		return nil, &types.InternalServiceError{Message: "empty query result from scheduler workflow"}
-	}
-
-	var desc scheduler.ScheduleDescription
-	if err := json.Unmarshal(queryResp.GetQueryResult(), &desc); err != nil {
-		return nil, &types.InternalServiceError{Message: fmt.Sprintf("failed to deserialize scheduler describe response: %v", err)}
+		return nil, err
 	}
 
 	return &types.DescribeScheduleResponse{
@@ -180,13 +182,44 @@ func (wh *WorkflowHandler) DescribeSchedule(
 			}(),
 		},
 		Info: &types.ScheduleInfo{
-			LastRunTime: desc.LastRunTime,
-			NextRunTime: desc.NextRunTime,
-			TotalRuns:   desc.TotalRuns,
+			LastRunTime:   desc.LastRunTime,
+			NextRunTime:   desc.NextRunTime,
+			TotalRuns:     desc.TotalRuns,
+			RecentActions: desc.RecentActions,
 		},
 	}, nil
 }
 
+// describeScheduleWorkflow queries the scheduler workflow for its current configuration and state.
+func (wh *WorkflowHandler) describeScheduleWorkflow(
+	ctx context.Context,
+	domainName string,
+	scheduleID string,
+) (*scheduler.ScheduleDescription, error) {
+	queryResp, err := wh.QueryWorkflow(ctx, &types.QueryWorkflowRequest{
+		Domain: domainName,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: scheduler.WorkflowID(scheduleID),
+		},
+		Query: &types.WorkflowQuery{
+			QueryType: scheduler.QueryTypeDescribe,
+		},
+	})
+	if err != nil {
+		return nil, normalizeScheduleError(err, scheduleID, domainName)
+	}
+
+	if queryResp == nil || queryResp.GetQueryResult() == nil {
+		return nil, &types.InternalServiceError{Message: "empty query result from scheduler workflow"}
+	}
+
+	var desc scheduler.ScheduleDescription
+	if err := json.Unmarshal(queryResp.GetQueryResult(), &desc); err != nil {
+		return nil, &types.InternalServiceError{Message: fmt.Sprintf("failed to deserialize scheduler describe response: %v", err)}
+	}
+	return &desc, nil
+}
+
 func (wh *WorkflowHandler) UpdateSchedule(
 	ctx context.Context,
 	request *types.UpdateScheduleRequest,
@@ -209,6 +242,22 @@ func (wh *WorkflowHandler) UpdateSchedule(
 	if request.GetSpec() == nil && request.GetAction() == nil && request.GetPolicies() == nil {
 		return nil, &types.BadRequestError{Message: "At least one of Spec, Action, or Policies must be set on request."}
 	}
+	if request.GetSpec() != nil {
+		if err := scheduler.ValidateScheduleSpec(request.GetSpec()); err != nil {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("Invalid Spec: %v.", err)}
+		}
+		if !isScheduleSpecSupported(request.GetSpec()) {
+			return nil, errScheduleSpecNotSupported
+		}
+	}
+	if request.GetAction() != nil {
+		if err := scheduler.ValidateScheduleAction(request.GetAction()); err != nil {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("Invalid Action: %v.", err)}
+		}
+		if request.GetAction().GetSignalWithStartWorkflow() != nil {
+			return nil, errSignalWithStartNotSupported
+		}
+	}
 
 	signal := scheduler.UpdateSignal{
 		Spec:     request.GetSpec(),
@@ -368,7 +417,97 @@ func (wh *WorkflowHandler) ListSchedules(
 		return nil, validate.ErrDomainNotSet
 	}
 
-	return nil, &types.BadRequestError{Message: "ListSchedules is not yet implemented."}
+	var (
+		executions    []*types.WorkflowExecutionInfo
+		nextPageToken []byte
+	)
+	if common.IsAdvancedVisibilityReadingEnabled(wh.config.ReadVisibilityStoreName(domainName) != "db", wh.config.IsAdvancedVisConfigExist) {
+		resp, err := wh.ListWorkflowExecutions(ctx, &types.ListWorkflowExecutionsRequest{
+			Domain:        domainName,
+			PageSize:      request.GetPageSize(),
+			NextPageToken: request.GetNextPageToken(),
+			Query:         fmt.Sprintf("WorkflowType = '%s' AND CloseTime = missing", scheduler.WorkflowTypeName),
+		})
+		if err != nil {
+			return nil, err
+		}
+		executions, nextPageToken = resp.GetExecutions(), resp.NextPageToken
+	} else {
+		// Without advanced visibility, fall back to scanning open executions of
+		// the scheduler workflow type and keeping the ones in the schedule ID space.
+		resp, err := wh.ListOpenWorkflowExecutions(ctx, &types.ListOpenWorkflowExecutionsRequest{
+			Domain:          domainName,
+			MaximumPageSize: request.GetPageSize(),
+			NextPageToken:   request.GetNextPageToken(),
+			StartTimeFilter: &types.StartTimeFilter{
+				EarliestTime: common.Int64Ptr(0),
+				LatestTime:   common.Int64Ptr(wh.GetTimeSource().Now().UnixNano()),
+			},
+			TypeFilter: &types.WorkflowTypeFilter{Name: scheduler.WorkflowTypeName},
+		})
+		if err != nil {
+			return nil, err
+		}
+		executions, nextPageToken = resp.GetExecutions(), resp.NextPageToken
+	}
+
+	schedules := make([]*types.ScheduleListEntry, 0, len(executions))
+	for _, execution := range executions {
+		wfID := execution.GetExecution().GetWorkflowID()
+		if !strings.HasPrefix(wfID, scheduler.WorkflowIDPrefix) {
+			continue
+		}
+		schedules = append(schedules, wh.scheduleListEntry(ctx, domainName, execution))
+	}
+
+	return &types.ListSchedulesResponse{
+		Schedules:     schedules,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// scheduleListEntry decodes the list entry the scheduler workflow records in its
+// search attributes. Schedules whose entry is not recorded, e.g. when visibility
+// does not store search attributes, are described by querying their workflow,
+// and listed with their ID only if that fails too.
+func (wh *WorkflowHandler) scheduleListEntry(
+	ctx context.Context,
+	domainName string,
+	execution *types.WorkflowExecutionInfo,
+) *types.ScheduleListEntry {
+	wfID := execution.GetExecution().GetWorkflowID()
+	scheduleID := strings.TrimPrefix(wfID, scheduler.WorkflowIDPrefix)
+
+	if encoded, ok := execution.GetSearchAttributes().GetIndexedFields()[definition.CadenceScheduleListEntry]; ok {
+		var entryJSON string
+		entry := &types.ScheduleListEntry{}
+		err := json.Unmarshal(encoded, &entryJSON)
+		if err == nil {
+			err = json.Unmarshal([]byte(entryJSON), entry)
+		}
+		if err == nil {
+			entry.ScheduleID = scheduleID
+			return entry
+		}
+		wh.GetLogger().Warn("failed to decode schedule list entry",
+			tag.WorkflowDomainName(domainName),
+			tag.WorkflowID(wfID),
+			tag.Error(err),
+		)
+	}
+
+	desc, err := wh.describeScheduleWorkflow(ctx, domainName, scheduleID)
+	if err != nil {
+		wh.GetLogger().Warn("failed to describe schedule for list entry",
+			tag.WorkflowDomainName(domainName),
+			tag.WorkflowID(wfID),
+			tag.Error(err),
+		)
+		return &types.ScheduleListEntry{ScheduleID: scheduleID}
+	}
+	entry := desc.ListEntry()
+	entry.ScheduleID = scheduleID
+	return entry
 }
 
 func (wh *WorkflowHandler) signalScheduleWorkflow(
@@ -387,7 +526,7 @@ func (wh *WorkflowHandler) signalScheduleWorkflow(
 		}
 	}
 
-	wfID := scheduleWorkflowID(scheduleID)
+	wfID := scheduler.WorkflowID(scheduleID)
 	err = wh.SignalWorkflowExecution(ctx, &types.SignalWorkflowExecutionRequest{
 		Domain: domainName,
 		WorkflowExecution: &types.WorkflowExecution{
@@ -412,3 +551,8 @@ func normalizeScheduleError(err error, scheduleID, domainName string) error {
 	}
 	return err
 }
+
+func isScheduleSpecSupported(spec *types.ScheduleSpec) bool {
+	return len(spec.GetAdditionalCronExpressions()) == 0 && len(spec.GetIntervals()) == 0 &&
+		len(spec.GetExcludedDates()) == 0 && spec.GetTimeZone() == ""
+}
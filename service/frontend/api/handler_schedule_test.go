@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/yarpc"
@@ -35,9 +36,11 @@ import (
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
+	"github.com/uber/cadence/common/definition"
 	dc "github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
@@ -137,6 +140,16 @@ func TestCreateSchedule(t *testing.T) {
 			mockFn:  func(f *scheduleTestFixture) {},
 			wantErr: true,
 		},
+		"invalid time zone": {
+			request: &types.CreateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "s1",
+				Spec:       &types.ScheduleSpec{CronExpression: "* * * * *", TimeZone: "Not/AZone"},
+				Action:     validRequest.Action,
+			},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
 		"nil action": {
 			request: &types.CreateScheduleRequest{
 				Domain:     testDomain,
@@ -146,6 +159,41 @@ func TestCreateSchedule(t *testing.T) {
 			mockFn:  func(f *scheduleTestFixture) {},
 			wantErr: true,
 		},
+		"invalid signal with start action": {
+			request: &types.CreateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "s1",
+				Spec:       &types.ScheduleSpec{CronExpression: "* * * * *"},
+				Action: &types.ScheduleAction{
+					SignalWithStartWorkflow: &types.SignalWithStartWorkflowAction{
+						WorkflowID:   "entity",
+						WorkflowType: &types.WorkflowType{Name: "my-workflow"},
+						TaskList:     &types.TaskList{Name: "my-tasklist"},
+					},
+				},
+			},
+			mockFn:      func(f *scheduleTestFixture) {},
+			wantErr:     true,
+			wantErrType: &types.BadRequestError{},
+		},
+		"signal with start action not supported": {
+			request: &types.CreateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "s1",
+				Spec:       &types.ScheduleSpec{CronExpression: "* * * * *"},
+				Action: &types.ScheduleAction{
+					SignalWithStartWorkflow: &types.SignalWithStartWorkflowAction{
+						WorkflowID:   "entity",
+						WorkflowType: &types.WorkflowType{Name: "my-workflow"},
+						TaskList:     &types.TaskList{Name: "my-tasklist"},
+						SignalName:   "tick",
+					},
+				},
+			},
+			mockFn:      func(f *scheduleTestFixture) {},
+			wantErr:     true,
+			wantErrType: &types.BadRequestError{},
+		},
 		"domain not found": {
 			request: validRequest,
 			mockFn: func(f *scheduleTestFixture) {
@@ -193,6 +241,30 @@ func TestCreateSchedule(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		"interval spec not supported": {
+			request: &types.CreateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "my-schedule",
+				Spec: &types.ScheduleSpec{
+					Intervals: []*types.ScheduleIntervalSpec{{Every: 90 * time.Minute, Offset: 10 * time.Minute}},
+				},
+				Action: validRequest.Action,
+			},
+			mockFn:      func(f *scheduleTestFixture) {},
+			wantErr:     true,
+			wantErrType: &types.BadRequestError{},
+		},
+		"time zone not supported": {
+			request: &types.CreateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "my-schedule",
+				Spec:       &types.ScheduleSpec{CronExpression: "0 9 * * *", TimeZone: "America/New_York"},
+				Action:     validRequest.Action,
+			},
+			mockFn:      func(f *scheduleTestFixture) {},
+			wantErr:     true,
+			wantErrType: &types.BadRequestError{},
+		},
 	}
 
 	for name, tt := range tests {
@@ -205,6 +277,9 @@ func TestCreateSchedule(t *testing.T) {
 			if tt.wantErr {
 				assert.Error(t, err)
 				assert.Nil(t, resp)
+				if tt.wantErrType != nil {
+					assert.IsType(t, tt.wantErrType, err)
+				}
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, resp)
@@ -227,6 +302,15 @@ func TestDescribeSchedule(t *testing.T) {
 	}
 	descBytes, _ := json.Marshal(descResult)
 
+	scheduledTime := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	descWithActions := descResult
+	descWithActions.RecentActions = []*types.ScheduleActionResult{
+		{ScheduledTime: scheduledTime, ActualTime: scheduledTime.Add(time.Second), WorkflowID: "wf-1", RunID: "run-1", CloseStatus: types.WorkflowExecutionCloseStatusFailed.Ptr()},
+		{ScheduledTime: scheduledTime.Add(10 * time.Minute), WorkflowID: "wf-2", RunID: "run-2", CloseStatus: types.WorkflowExecutionCloseStatusTimedOut.Ptr()},
+		{ScheduledTime: scheduledTime.Add(20 * time.Minute), WorkflowID: "wf-3", RunID: "run-3"},
+	}
+	descWithActionsBytes, _ := json.Marshal(descWithActions)
+
 	validRequest := &types.DescribeScheduleRequest{
 		Domain:     testDomain,
 		ScheduleID: "my-schedule",
@@ -302,6 +386,26 @@ func TestDescribeSchedule(t *testing.T) {
 				assert.Equal(t, "maintenance", resp.State.PauseInfo.Reason)
 				assert.Equal(t, "admin", resp.State.PauseInfo.PausedBy)
 				assert.Equal(t, int64(42), resp.Info.TotalRuns)
+				assert.Nil(t, resp.Info.RecentActions)
+			},
+		},
+		"success with recent actions": {
+			request: validRequest,
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.historyClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).
+					Return(&types.HistoryQueryWorkflowResponse{
+						Response: &types.QueryWorkflowResponse{QueryResult: descWithActionsBytes},
+					}, nil)
+			},
+			wantErr: false,
+			check: func(t *testing.T, resp *types.DescribeScheduleResponse) {
+				require.Len(t, resp.Info.RecentActions, 3)
+				assert.Equal(t, "run-1", resp.Info.RecentActions[0].RunID)
+				assert.Equal(t, scheduledTime.Add(time.Second), resp.Info.RecentActions[0].ActualTime)
+				assert.Equal(t, types.WorkflowExecutionCloseStatusFailed.Ptr(), resp.Info.RecentActions[0].CloseStatus)
+				assert.Equal(t, types.WorkflowExecutionCloseStatusTimedOut.Ptr(), resp.Info.RecentActions[1].CloseStatus)
+				assert.Nil(t, resp.Info.RecentActions[2].CloseStatus)
 			},
 		},
 	}
@@ -457,6 +561,49 @@ func TestUpdateSchedule(t *testing.T) {
 			mockFn:  func(f *scheduleTestFixture) {},
 			wantErr: true,
 		},
+		"invalid spec": {
+			request: &types.UpdateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "s1",
+				Spec:       &types.ScheduleSpec{CronExpression: "not a cron"},
+			},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"invalid action": {
+			request: &types.UpdateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "s1",
+				Action:     &types.ScheduleAction{},
+			},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"excluded dates not supported": {
+			request: &types.UpdateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "s1",
+				Spec:       &types.ScheduleSpec{CronExpression: "0 9 * * *", ExcludedDates: []string{"2026-12-25"}},
+			},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"signal with start action not supported": {
+			request: &types.UpdateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "s1",
+				Action: &types.ScheduleAction{
+					SignalWithStartWorkflow: &types.SignalWithStartWorkflowAction{
+						WorkflowID:   "entity",
+						WorkflowType: &types.WorkflowType{Name: "my-workflow"},
+						TaskList:     &types.TaskList{Name: "my-tasklist"},
+						SignalName:   "tick",
+					},
+				},
+			},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
 		"success with spec update": {
 			request: &types.UpdateScheduleRequest{
 				Domain:     testDomain,
@@ -623,33 +770,160 @@ func TestBackfillSchedule(t *testing.T) {
 }
 
 func TestListSchedules(t *testing.T) {
+	listEntry := &types.ScheduleListEntry{
+		ScheduleID:     "my-schedule",
+		WorkflowType:   &types.WorkflowType{Name: "wf"},
+		State:          &types.ScheduleState{Paused: true, PauseInfo: &types.SchedulePauseInfo{Reason: "maintenance"}},
+		CronExpression: "*/10 * * * *",
+		Spec:           &types.ScheduleSpec{CronExpression: "*/10 * * * *"},
+		Info: &types.ScheduleInfo{
+			LastRunTime: time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
+			NextRunTime: time.Date(2026, 1, 1, 10, 10, 0, 0, time.UTC),
+			TotalRuns:   7,
+		},
+	}
+	listEntryJSON, _ := json.Marshal(listEntry)
+	encodedListEntry, _ := json.Marshal(string(listEntryJSON))
+	descBytes, _ := json.Marshal(scheduler.ScheduleDescription{
+		ScheduleID:  "my-schedule",
+		Domain:      testDomain,
+		Spec:        *listEntry.Spec,
+		Action:      types.ScheduleAction{StartWorkflow: &types.StartWorkflowAction{WorkflowType: listEntry.WorkflowType}},
+		Paused:      true,
+		PauseReason: "maintenance",
+		LastRunTime: listEntry.Info.LastRunTime,
+		NextRunTime: listEntry.Info.NextRunTime,
+		TotalRuns:   listEntry.Info.TotalRuns,
+	})
+
+	schedulerExecution := func(workflowID string, encodedEntry []byte) *types.WorkflowExecutionInfo {
+		execution := &types.WorkflowExecutionInfo{
+			Execution: &types.WorkflowExecution{WorkflowID: workflowID, RunID: "run"},
+			Type:      &types.WorkflowType{Name: scheduler.WorkflowTypeName},
+		}
+		if encodedEntry != nil {
+			execution.SearchAttributes = &types.SearchAttributes{
+				IndexedFields: map[string][]byte{definition.CadenceScheduleListEntry: encodedEntry},
+			}
+		}
+		return execution
+	}
+
 	tests := map[string]struct {
-		request *types.ListSchedulesRequest
-		mockFn  func(*scheduleTestFixture)
-		wantErr bool
+		request            *types.ListSchedulesRequest
+		advancedVisibility bool
+		mockFn             func(*scheduleTestFixture)
+		wantErr            bool
+		check              func(*testing.T, *types.ListSchedulesResponse)
 	}{
 		"nil request": {
 			request: nil,
 			mockFn:  func(f *scheduleTestFixture) {},
 			wantErr: true,
 		},
-		"unimplemented": {
-			request: &types.ListSchedulesRequest{Domain: testDomain},
+		"empty domain": {
+			request: &types.ListSchedulesRequest{},
 			mockFn:  func(f *scheduleTestFixture) {},
 			wantErr: true,
 		},
+		"basic visibility error": {
+			request: &types.ListSchedulesRequest{Domain: testDomain, PageSize: 10},
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.mockResource.VisibilityMgr.On("ListOpenWorkflowExecutionsByType", mock.Anything, mock.Anything).
+					Return(nil, errors.New("visibility unavailable")).Once()
+			},
+			wantErr: true,
+		},
+		"basic visibility scans scheduler workflows by ID prefix and describes them": {
+			request: &types.ListSchedulesRequest{Domain: testDomain, PageSize: 10, NextPageToken: []byte("token")},
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.historyClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.HistoryQueryWorkflowRequest, _ ...yarpc.CallOption) (*types.HistoryQueryWorkflowResponse, error) {
+						assert.Equal(t, "cadence-scheduler:my-schedule", req.Request.Execution.WorkflowID)
+						assert.Equal(t, scheduler.QueryTypeDescribe, req.Request.Query.QueryType)
+						return &types.HistoryQueryWorkflowResponse{
+							Response: &types.QueryWorkflowResponse{QueryResult: descBytes},
+						}, nil
+					})
+				f.mockResource.VisibilityMgr.On("ListOpenWorkflowExecutionsByType", mock.Anything, mock.MatchedBy(
+					func(req *persistence.ListWorkflowExecutionsByTypeRequest) bool {
+						return req.WorkflowTypeName == scheduler.WorkflowTypeName &&
+							req.PageSize == 10 &&
+							string(req.NextPageToken) == "token"
+					})).
+					Return(&persistence.ListWorkflowExecutionsResponse{
+						Executions: []*types.WorkflowExecutionInfo{
+							schedulerExecution("cadence-scheduler:my-schedule", nil),
+							schedulerExecution("not-a-schedule", nil),
+						},
+						NextPageToken: []byte("next"),
+					}, nil).Once()
+			},
+			check: func(t *testing.T, resp *types.ListSchedulesResponse) {
+				assert.Equal(t, []byte("next"), resp.NextPageToken)
+				require.Len(t, resp.Schedules, 1)
+				assert.Equal(t, listEntry, resp.Schedules[0])
+			},
+		},
+		"advanced visibility queries by workflow type": {
+			request:            &types.ListSchedulesRequest{Domain: testDomain, PageSize: 10},
+			advancedVisibility: true,
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.mockResource.VisibilityMgr.On("ListWorkflowExecutions", mock.Anything, mock.MatchedBy(
+					func(req *persistence.ListWorkflowExecutionsByQueryRequest) bool {
+						return req.Query == "WorkflowType = 'cadence-scheduler' and CloseTime = missing" && req.PageSize == 10
+					})).
+					Return(&persistence.ListWorkflowExecutionsResponse{
+						Executions: []*types.WorkflowExecutionInfo{schedulerExecution("cadence-scheduler:my-schedule", encodedListEntry)},
+					}, nil).Once()
+			},
+			check: func(t *testing.T, resp *types.ListSchedulesResponse) {
+				assert.Nil(t, resp.NextPageToken)
+				require.Len(t, resp.Schedules, 1)
+				assert.Equal(t, listEntry, resp.Schedules[0])
+			},
+		},
+		"malformed list entry still lists the schedule when it cannot be described": {
+			request:            &types.ListSchedulesRequest{Domain: testDomain, PageSize: 10},
+			advancedVisibility: true,
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.historyClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("query failed"))
+				f.mockResource.VisibilityMgr.On("ListWorkflowExecutions", mock.Anything, mock.Anything).
+					Return(&persistence.ListWorkflowExecutionsResponse{
+						Executions: []*types.WorkflowExecutionInfo{schedulerExecution("cadence-scheduler:broken", []byte("{"))},
+					}, nil).Once()
+			},
+			check: func(t *testing.T, resp *types.ListSchedulesResponse) {
+				require.Len(t, resp.Schedules, 1)
+				assert.Equal(t, &types.ScheduleListEntry{ScheduleID: "broken"}, resp.Schedules[0])
+			},
+		},
 	}
 
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			f := newScheduleTestFixture(t)
 			defer f.finish()
+			if tt.advancedVisibility {
+				f.handler.config.IsAdvancedVisConfigExist = true
+				f.handler.config.ReadVisibilityStoreName = dynamicproperties.GetStringPropertyFnFilteredByDomain("es")
+			}
 			tt.mockFn(f)
 
 			resp, err := f.handler.ListSchedules(context.Background(), tt.request)
 			if tt.wantErr {
 				assert.Error(t, err)
 				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, resp)
+				}
 			}
 		})
 	}
@@ -710,11 +984,6 @@ func TestNormalizeScheduleError(t *testing.T) {
 	})
 }
 
-func TestScheduleWorkflowID(t *testing.T) {
-	assert.Equal(t, "cadence-scheduler:my-schedule", scheduleWorkflowID("my-schedule"))
-	assert.Equal(t, "cadence-scheduler:", scheduleWorkflowID(""))
-}
-
 func TestCreateSchedule_ShuttingDown(t *testing.T) {
 	f := newScheduleTestFixture(t)
 	defer f.finish()
@@ -95,5 +95,6 @@ type (
 		UnpauseSchedule(context.Context, *types.UnpauseScheduleRequest) (*types.UnpauseScheduleResponse, error)
 		BackfillSchedule(context.Context, *types.BackfillScheduleRequest) (*types.BackfillScheduleResponse, error)
 		ListSchedules(context.Context, *types.ListSchedulesRequest) (*types.ListSchedulesResponse, error)
+		ListDomainChanges(context.Context, *types.ListDomainChangesRequest) (*types.ListDomainChangesResponse, error)
 	}
 )
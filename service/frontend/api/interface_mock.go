@@ -325,6 +325,21 @@ func (mr *MockHandlerMockRecorder) ListClosedWorkflowExecutions(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClosedWorkflowExecutions", reflect.TypeOf((*MockHandler)(nil).ListClosedWorkflowExecutions), arg0, arg1)
 }
 
+// ListDomainChanges mocks base method.
+func (m *MockHandler) ListDomainChanges(arg0 context.Context, arg1 *types.ListDomainChangesRequest) (*types.ListDomainChangesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDomainChanges", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListDomainChangesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDomainChanges indicates an expected call of ListDomainChanges.
+func (mr *MockHandlerMockRecorder) ListDomainChanges(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomainChanges", reflect.TypeOf((*MockHandler)(nil).ListDomainChanges), arg0, arg1)
+}
+
 // ListDomains mocks base method.
 func (m *MockHandler) ListDomains(arg0 context.Context, arg1 *types.ListDomainsRequest) (*types.ListDomainsResponse, error) {
 	m.ctrl.T.Helper()
@@ -45,10 +45,11 @@ type (
 	}
 
 	producerManagerImpl struct {
-		domainCache   cache.DomainCache
-		provider      queue.Provider
-		logger        log.Logger
-		metricsClient metrics.Client
+		domainCache          cache.DomainCache
+		provider             queue.Provider
+		queueManagerProvider provider.QueueManagerProvider
+		logger               log.Logger
+		metricsClient        metrics.Client
 
 		producerCache cache.Cache
 	}
@@ -57,14 +58,16 @@ type (
 func NewProducerManager(
 	domainCache cache.DomainCache,
 	provider queue.Provider,
+	queueManagerProvider provider.QueueManagerProvider,
 	logger log.Logger,
 	metricsClient metrics.Client,
 ) ProducerManager {
 	return &producerManagerImpl{
-		domainCache:   domainCache,
-		provider:      provider,
-		logger:        logger,
-		metricsClient: metricsClient,
+		domainCache:          domainCache,
+		provider:             provider,
+		queueManagerProvider: queueManagerProvider,
+		logger:               logger,
+		metricsClient:        metricsClient,
 		producerCache: cache.New(&cache.Options{
 			TTL:             time.Minute * 5,
 			InitialCapacity: 5,
@@ -107,7 +110,11 @@ func (q *producerManagerImpl) GetProducerByDomain(
 		return val.(messaging.Producer), nil
 	}
 
-	producer, err := queue.CreateProducer(&provider.Params{Logger: q.logger, MetricsClient: q.metricsClient})
+	producer, err := queue.CreateProducer(&provider.Params{
+		Logger:               q.logger,
+		MetricsClient:        q.metricsClient,
+		QueueManagerProvider: q.queueManagerProvider,
+	})
 	if err != nil {
 		return nil, err
 	}
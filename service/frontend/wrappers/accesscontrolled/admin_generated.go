@@ -517,6 +517,22 @@ func (a *adminHandler) RestoreDynamicConfig(ctx context.Context, rp1 *types.Rest
 	return a.handler.RestoreDynamicConfig(ctx, rp1)
 }
 
+func (a *adminHandler) RollbackDomain(ctx context.Context, rp1 *types.RollbackDomainRequest) (rp2 *types.RollbackDomainResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "RollbackDomain",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(rp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.RollbackDomain(ctx, rp1)
+}
+
 func (a *adminHandler) Start() {
 	a.handler.Start()
 }
@@ -604,3 +620,19 @@ func (a *adminHandler) UpdateTaskListPartitionConfig(ctx context.Context, up1 *t
 	}
 	return a.handler.UpdateTaskListPartitionConfig(ctx, up1)
 }
+
+func (a *adminHandler) UpdateVirtualQueue(ctx context.Context, up1 *types.UpdateVirtualQueueRequest) (err error) {
+	attr := &authorization.Attributes{
+		APIName:     "UpdateVirtualQueue",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(up1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+	return a.handler.UpdateVirtualQueue(ctx, up1)
+}
@@ -323,6 +323,24 @@ func (a *apiHandler) ListClosedWorkflowExecutions(ctx context.Context, lp1 *type
 	return a.handler.ListClosedWorkflowExecutions(ctx, lp1)
 }
 
+func (a *apiHandler) ListDomainChanges(ctx context.Context, lp1 *types.ListDomainChangesRequest) (lp2 *types.ListDomainChangesResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendListDomainChangesScope, lp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "ListDomainChanges",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(lp1),
+		DomainName:  lp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.ListDomainChanges(ctx, lp1)
+}
+
 func (a *apiHandler) ListDomains(ctx context.Context, lp1 *types.ListDomainsRequest) (lp2 *types.ListDomainsResponse, err error) {
 	return a.handler.ListDomains(ctx, lp1)
 }
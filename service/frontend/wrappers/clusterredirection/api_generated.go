@@ -536,6 +536,10 @@ func (handler *clusterRedirectionHandler) ListClosedWorkflowExecutions(ctx conte
 	return lp2, err
 }
 
+func (handler *clusterRedirectionHandler) ListDomainChanges(ctx context.Context, lp1 *types.ListDomainChangesRequest) (lp2 *types.ListDomainChangesResponse, err error) {
+	return handler.frontendHandler.ListDomainChanges(ctx, lp1)
+}
+
 func (handler *clusterRedirectionHandler) ListDomains(ctx context.Context, lp1 *types.ListDomainsRequest) (lp2 *types.ListDomainsResponse, err error) {
 	return handler.frontendHandler.ListDomains(ctx, lp1)
 }
@@ -404,6 +404,12 @@ func toBackfillScheduleRequestTags(req *types.BackfillScheduleRequest) []tag.Tag
 	}
 }
 
+func toListDomainChangesRequestTags(req *types.ListDomainChangesRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+	}
+}
+
 func toListSchedulesRequestTags(req *types.ListSchedulesRequest) []tag.Tag {
 	return []tag.Tag{
 		tag.WorkflowDomainName(req.GetDomain()),
@@ -285,6 +285,10 @@ func (h *apiHandler) ListClosedWorkflowExecutions(ctx context.Context, lp1 *type
 	return h.wrapped.ListClosedWorkflowExecutions(ctx, lp1)
 }
 
+func (h *apiHandler) ListDomainChanges(ctx context.Context, lp1 *types.ListDomainChangesRequest) (lp2 *types.ListDomainChangesResponse, err error) {
+	return h.wrapped.ListDomainChanges(ctx, lp1)
+}
+
 func (h *apiHandler) ListDomains(ctx context.Context, lp1 *types.ListDomainsRequest) (lp2 *types.ListDomainsResponse, err error) {
 	return h.wrapped.ListDomains(ctx, lp1)
 }
@@ -158,6 +158,11 @@ func (g AdminHandler) RestoreDynamicConfig(ctx context.Context, Request *admin.R
 	return thrift.FromError(err)
 }
 
+func (g AdminHandler) RollbackDomain(ctx context.Context, Request *admin.RollbackDomainRequest) (rp1 *admin.RollbackDomainResponse, err error) {
+	response, err := g.h.RollbackDomain(ctx, thrift.ToAdminRollbackDomainRequest(Request))
+	return thrift.FromAdminRollbackDomainResponse(response), thrift.FromError(err)
+}
+
 func (g AdminHandler) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, Request *admin.UpdateDomainAsyncWorkflowConfiguratonRequest) (up1 *admin.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	response, err := g.h.UpdateDomainAsyncWorkflowConfiguraton(ctx, thrift.ToAdminUpdateDomainAsyncWorkflowConfiguratonRequest(Request))
 	return thrift.FromAdminUpdateDomainAsyncWorkflowConfiguratonResponse(response), thrift.FromError(err)
@@ -177,3 +182,8 @@ func (g AdminHandler) UpdateGlobalIsolationGroups(ctx context.Context, Request *
 	response, err := g.h.UpdateGlobalIsolationGroups(ctx, thrift.ToAdminUpdateGlobalIsolationGroupsRequest(Request))
 	return thrift.FromAdminUpdateGlobalIsolationGroupsResponse(response), thrift.FromError(err)
 }
+
+func (g AdminHandler) UpdateVirtualQueue(ctx context.Context, Request *shared.UpdateVirtualQueueRequest) (err error) {
+	err = g.h.UpdateVirtualQueue(ctx, thrift.ToAdminUpdateVirtualQueueRequest(Request))
+	return thrift.FromError(err)
+}
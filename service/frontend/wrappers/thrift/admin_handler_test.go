@@ -139,11 +139,22 @@ func TestAdminThriftHandler(t *testing.T) {
 		err := th.ResendReplicationTasks(ctx, &admin.ResendReplicationTasksRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("RollbackDomain", func(t *testing.T) {
+		h.EXPECT().RollbackDomain(ctx, &types.RollbackDomainRequest{}).Return(&types.RollbackDomainResponse{}, internalErr).Times(1)
+		resp, err := th.RollbackDomain(ctx, &admin.RollbackDomainRequest{})
+		assert.Equal(t, admin.RollbackDomainResponse{IsGlobalDomain: common.BoolPtr(false), FailoverVersion: common.Int64Ptr(0)}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("ResetQueue", func(t *testing.T) {
 		h.EXPECT().ResetQueue(ctx, &types.ResetQueueRequest{}).Return(internalErr).Times(1)
 		err := th.ResetQueue(ctx, &shared.ResetQueueRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("UpdateVirtualQueue", func(t *testing.T) {
+		h.EXPECT().UpdateVirtualQueue(ctx, &types.UpdateVirtualQueueRequest{}).Return(internalErr).Times(1)
+		err := th.UpdateVirtualQueue(ctx, &shared.UpdateVirtualQueueRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("GetCrossClusterTasks", func(t *testing.T) {
 		h.EXPECT().GetCrossClusterTasks(ctx, &types.GetCrossClusterTasksRequest{}).Return(&types.GetCrossClusterTasksResponse{}, internalErr).Times(1)
 		resp, err := th.GetCrossClusterTasks(ctx, &shared.GetCrossClusterTasksRequest{})
@@ -81,6 +81,11 @@ func (g APIHandler) ListClosedWorkflowExecutions(ctx context.Context, ListReques
 	return thrift.FromListClosedWorkflowExecutionsResponse(response), thrift.FromError(err)
 }
 
+func (g APIHandler) ListDomainChanges(ctx context.Context, ListRequest *shared.ListDomainChangesRequest) (lp1 *shared.ListDomainChangesResponse, err error) {
+	response, err := g.h.ListDomainChanges(ctx, thrift.ToListDomainChangesRequest(ListRequest))
+	return thrift.FromListDomainChangesResponse(response), thrift.FromError(err)
+}
+
 func (g APIHandler) ListDomains(ctx context.Context, ListRequest *shared.ListDomainsRequest) (lp1 *shared.ListDomainsResponse, err error) {
 	response, err := g.h.ListDomains(ctx, thrift.ToListDomainsRequest(ListRequest))
 	return thrift.FromListDomainsResponse(response), thrift.FromError(err)
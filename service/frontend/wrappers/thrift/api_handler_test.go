@@ -110,6 +110,12 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, shared.ListClosedWorkflowExecutionsResponse{}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("ListDomainChanges", func(t *testing.T) {
+		h.EXPECT().ListDomainChanges(ctx, &types.ListDomainChangesRequest{}).Return(&types.ListDomainChangesResponse{}, internalErr).Times(1)
+		resp, err := th.ListDomainChanges(ctx, &shared.ListDomainChangesRequest{})
+		assert.Equal(t, shared.ListDomainChangesResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("ListDomains", func(t *testing.T) {
 		h.EXPECT().ListDomains(ctx, &types.ListDomainsRequest{}).Return(&types.ListDomainsResponse{}, internalErr).Times(1)
 		resp, err := th.ListDomains(ctx, &shared.ListDomainsRequest{})
@@ -173,6 +173,14 @@ func (h *versionCheckHandler) ListClosedWorkflowExecutions(ctx context.Context,
 	return h.frontendHandler.ListClosedWorkflowExecutions(ctx, lp1)
 }
 
+func (h *versionCheckHandler) ListDomainChanges(ctx context.Context, lp1 *types.ListDomainChangesRequest) (lp2 *types.ListDomainChangesResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.ListDomainChanges(ctx, lp1)
+}
+
 func (h *versionCheckHandler) ListDomains(ctx context.Context, lp1 *types.ListDomainsRequest) (lp2 *types.ListDomainsResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -119,7 +119,11 @@ type Config struct {
 	EnableTransferQueueV2PendingTaskCountAlert dynamicproperties.BoolPropertyFnWithShardIDFilter
 	QueueCriticalPendingTaskCount              dynamicproperties.IntPropertyFn
 	QueueMaxVirtualQueueCount                  dynamicproperties.IntPropertyFn
+	QueueCriticalSliceCount                    dynamicproperties.IntPropertyFn
+	QueueStuckSliceDeadline                    dynamicproperties.DurationPropertyFn
+	QueueCriticalTaskLatency                   dynamicproperties.DurationPropertyFn
 	VirtualSliceForceAppendInterval            dynamicproperties.DurationPropertyFn
+	QueueIsolationRules                        dynamicproperties.ListPropertyFn
 
 	// QueueProcessor settings
 	QueueProcessorEnableSplit                          dynamicproperties.BoolPropertyFn
@@ -427,7 +431,11 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		EnableTransferQueueV2PendingTaskCountAlert: dc.GetBoolPropertyFilteredByShardID(dynamicproperties.EnableTransferQueueV2PendingTaskCountAlert),
 		QueueCriticalPendingTaskCount:              dc.GetIntProperty(dynamicproperties.QueueCriticalPendingTaskCount),
 		QueueMaxVirtualQueueCount:                  dc.GetIntProperty(dynamicproperties.QueueMaxVirtualQueueCount),
+		QueueCriticalSliceCount:                    dc.GetIntProperty(dynamicproperties.QueueCriticalSliceCount),
+		QueueStuckSliceDeadline:                    dc.GetDurationProperty(dynamicproperties.QueueStuckSliceDeadline),
+		QueueCriticalTaskLatency:                   dc.GetDurationProperty(dynamicproperties.QueueCriticalTaskLatency),
 		VirtualSliceForceAppendInterval:            dc.GetDurationProperty(dynamicproperties.VirtualSliceForceAppendInterval),
+		QueueIsolationRules:                        dc.GetListProperty(dynamicproperties.QueueIsolationRules),
 
 		QueueProcessorEnableSplit:                          dc.GetBoolProperty(dynamicproperties.QueueProcessorEnableSplit),
 		QueueProcessorSplitMaxLevel:                        dc.GetIntProperty(dynamicproperties.QueueProcessorSplitMaxLevel),
@@ -274,7 +274,11 @@ func TestNewConfig(t *testing.T) {
 		"EnableTransferQueueV2PendingTaskCountAlert":           {dynamicproperties.EnableTransferQueueV2PendingTaskCountAlert, true},
 		"QueueCriticalPendingTaskCount":                        {dynamicproperties.QueueCriticalPendingTaskCount, 100},
 		"QueueMaxVirtualQueueCount":                            {dynamicproperties.QueueMaxVirtualQueueCount, 101},
+		"QueueCriticalSliceCount":                              {dynamicproperties.QueueCriticalSliceCount, 102},
+		"QueueStuckSliceDeadline":                              {dynamicproperties.QueueStuckSliceDeadline, time.Second},
+		"QueueCriticalTaskLatency":                             {dynamicproperties.QueueCriticalTaskLatency, time.Second},
 		"VirtualSliceForceAppendInterval":                      {dynamicproperties.VirtualSliceForceAppendInterval, time.Second},
+		"QueueIsolationRules":                                  {dynamicproperties.QueueIsolationRules, []interface{}{map[string]interface{}{"DomainID": "domain-id", "VirtualQueueID": 1}}},
 		"ReplicationTaskProcessorLatencyLogThreshold":          {dynamicproperties.ReplicationTaskProcessorLatencyLogThreshold, time.Duration(0)},
 		"EnableCleanupOrphanedHistoryBranchOnWorkflowCreation": {dynamicproperties.EnableCleanupOrphanedHistoryBranchOnWorkflowCreation, true},
 		"EnableHierarchicalWeightedRoundRobinTaskScheduler":    {dynamicproperties.EnableHierarchicalWeightedRoundRobinTaskScheduler, true},
@@ -371,6 +375,8 @@ func getValue(f *reflect.Value) interface{} {
 			return fn("domain")
 		case dynamicproperties.BoolPropertyFnWithShardIDFilter:
 			return fn(0)
+		case dynamicproperties.ListPropertyFn:
+			return fn()
 		case func() []string:
 			return fn()
 		default:
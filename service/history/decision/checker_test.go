@@ -654,6 +654,43 @@ func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_NoRetryPolic
 	s.Equal(expectedAttributesAfterValidation, attributes)
 }
 
+func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_NegativePriority() {
+	attributes := &types.ScheduleActivityTaskDecisionAttributes{
+		ActivityID: "some random activityID",
+		ActivityType: &types.ActivityType{
+			Name: "some random activity type",
+		},
+		Domain: s.testDomainID,
+		TaskList: &types.TaskList{
+			Name: "some random task list",
+		},
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(3),
+		Priority:                      -1,
+	}
+
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomainID},
+		nil,
+		cluster.TestCurrentClusterName,
+	)
+	targetDomainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testTargetDomainID},
+		nil,
+		cluster.TestCurrentClusterName,
+	)
+	s.mockDomainCache.EXPECT().GetDomainByID(s.testDomainID).Return(domainEntry, nil).Times(1)
+	s.mockDomainCache.EXPECT().GetDomainByID(s.testTargetDomainID).Return(targetDomainEntry, nil).Times(1)
+
+	err := s.validator.validateActivityScheduleAttributes(
+		s.testDomainID,
+		s.testTargetDomainID,
+		attributes,
+		&persistence.WorkflowExecutionInfo{WorkflowTimeout: 5},
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+	)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
 func (s *attrValidatorSuite) TestValidateActivityScheduleAttributes_WithRetryPolicy_ScheduleToStartRetryable() {
 	s.mockDomainCache.EXPECT().GetDomainName(s.testDomainID).Return("some random domain name", nil).Times(1)
 
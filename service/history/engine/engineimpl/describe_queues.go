@@ -23,6 +23,7 @@ package engineimpl
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/uber/cadence/common/persistence"
@@ -58,10 +59,18 @@ func (e *historyEngineImpl) describeQueue(
 		return nil, err
 	}
 
-	serializedStates := make([]string, 0, len(resp.GetStateActionResult.States))
+	serializedStates := make([]string, 0, len(resp.GetStateActionResult.States)+len(resp.GetStateActionResult.VirtualQueues))
 	for _, state := range resp.GetStateActionResult.States {
 		serializedStates = append(serializedStates, e.serializeQueueState(state))
 	}
+	// queues backed by virtual queues report one entry per virtual queue
+	for _, virtualQueue := range resp.GetStateActionResult.VirtualQueues {
+		serializedState, err := json.Marshal(virtualQueue)
+		if err != nil {
+			return nil, err
+		}
+		serializedStates = append(serializedStates, string(serializedState))
+	}
 	return &types.DescribeQueueResponse{
 		ProcessingQueueStates: serializedStates,
 	}, nil
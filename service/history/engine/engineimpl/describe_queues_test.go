@@ -0,0 +1,110 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/queue"
+)
+
+func TestDescribeQueue(t *testing.T) {
+	tests := []struct {
+		name        string
+		result      *queue.ActionResult
+		err         error
+		expectedErr error
+	}{
+		{
+			name: "virtual queues",
+			result: &queue.ActionResult{
+				ActionType: queue.ActionTypeGetState,
+				GetStateActionResult: &queue.GetStateActionResult{
+					VirtualQueues: []*queue.VirtualQueueInfo{
+						{
+							QueueID: 1,
+							Paused:  true,
+							Slices: []*queue.VirtualSliceInfo{
+								{
+									State: &types.VirtualSliceState{
+										TaskRange: &types.TaskRange{
+											InclusiveMin: &types.TaskKey{TaskID: 1},
+											ExclusiveMax: &types.TaskKey{TaskID: 10},
+										},
+										Predicate: &types.Predicate{PredicateType: types.PredicateTypeUniversal},
+									},
+									PendingTaskCount: 3,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "handle action failed",
+			err:         errors.New("some error"),
+			expectedErr: errors.New("some error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockProcessor := queue.NewMockProcessor(ctrl)
+			mockProcessor.EXPECT().HandleAction(gomock.Any(), "cluster", queue.NewGetStateAction()).Return(tt.result, tt.err)
+			engine := &historyEngineImpl{
+				queueProcessors: map[persistence.HistoryTaskCategory]queue.Processor{
+					persistence.HistoryTaskCategoryTransfer: mockProcessor,
+				},
+			}
+
+			resp, err := engine.DescribeTransferQueue(context.Background(), "cluster")
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, resp.ProcessingQueueStates, len(tt.result.GetStateActionResult.VirtualQueues))
+			for i, state := range resp.ProcessingQueueStates {
+				var virtualQueue queue.VirtualQueueInfo
+				assert.NoError(t, json.Unmarshal([]byte(state), &virtualQueue))
+				assert.Equal(t, tt.result.GetStateActionResult.VirtualQueues[i], &virtualQueue)
+			}
+		})
+	}
+}
+
+func TestDescribeQueue_UnknownCategory(t *testing.T) {
+	engine := &historyEngineImpl{
+		queueProcessors: map[persistence.HistoryTaskCategory]queue.Processor{},
+	}
+	_, err := engine.DescribeTimerQueue(context.Background(), "cluster")
+	assert.Error(t, err)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+// Portions of the Software are attributed to Copyright (c) 2021 Temporal Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+
+package engineimpl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/queue"
+)
+
+func (e *historyEngineImpl) UpdateTransferVirtualQueue(
+	ctx context.Context,
+	request *types.UpdateVirtualQueueRequest,
+) error {
+	return e.updateVirtualQueue(ctx, persistence.HistoryTaskCategoryTransfer, request)
+}
+
+func (e *historyEngineImpl) UpdateTimerVirtualQueue(
+	ctx context.Context,
+	request *types.UpdateVirtualQueueRequest,
+) error {
+	return e.updateVirtualQueue(ctx, persistence.HistoryTaskCategoryTimer, request)
+}
+
+func (e *historyEngineImpl) updateVirtualQueue(
+	ctx context.Context,
+	category persistence.HistoryTaskCategory,
+	request *types.UpdateVirtualQueueRequest,
+) error {
+	queueProcessor, ok := e.queueProcessors[category]
+	if !ok {
+		return fmt.Errorf("queue processor not found for category %v", category)
+	}
+	if request.Operation == nil {
+		return &types.BadRequestError{Message: "virtual queue operation is not set"}
+	}
+
+	var operation queue.VirtualQueueOperation
+	switch request.GetOperation() {
+	case types.VirtualQueueOperationMoveDomains:
+		operation = queue.VirtualQueueOperationMoveDomains
+	case types.VirtualQueueOperationPause:
+		operation = queue.VirtualQueueOperationPause
+	case types.VirtualQueueOperationResume:
+		operation = queue.VirtualQueueOperationResume
+	case types.VirtualQueueOperationMerge:
+		operation = queue.VirtualQueueOperationMerge
+	default:
+		return &types.BadRequestError{Message: fmt.Sprintf("unknown virtual queue operation: %v", request.GetOperation())}
+	}
+
+	_, err := queueProcessor.HandleAction(ctx, request.GetClusterName(), queue.NewUpdateVirtualQueueAction(&queue.UpdateVirtualQueueAttributes{
+		Operation:     operation,
+		QueueID:       request.GetVirtualQueueID(),
+		TargetQueueID: request.GetTargetVirtualQueueID(),
+		DomainIDs:     request.GetDomainIDs(),
+		PauseDuration: time.Duration(request.GetPauseDurationInSeconds()) * time.Second,
+	}))
+	return err
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+// Portions of the Software are attributed to Copyright (c) 2021 Temporal Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+
+package engineimpl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/queue"
+)
+
+func TestUpdateVirtualQueue(t *testing.T) {
+	tests := []struct {
+		name               string
+		request            *types.UpdateVirtualQueueRequest
+		expectedAttributes *queue.UpdateVirtualQueueAttributes
+		err                error
+		expectedErr        bool
+	}{
+		{
+			name: "move domains",
+			request: &types.UpdateVirtualQueueRequest{
+				ClusterName:          "cluster",
+				Operation:            types.VirtualQueueOperationMoveDomains.Ptr(),
+				VirtualQueueID:       0,
+				TargetVirtualQueueID: 2,
+				DomainIDs:            []string{"domain-id"},
+			},
+			expectedAttributes: &queue.UpdateVirtualQueueAttributes{
+				Operation:     queue.VirtualQueueOperationMoveDomains,
+				QueueID:       0,
+				TargetQueueID: 2,
+				DomainIDs:     []string{"domain-id"},
+			},
+		},
+		{
+			name: "pause",
+			request: &types.UpdateVirtualQueueRequest{
+				ClusterName:            "cluster",
+				Operation:              types.VirtualQueueOperationPause.Ptr(),
+				VirtualQueueID:         2,
+				PauseDurationInSeconds: 30,
+			},
+			expectedAttributes: &queue.UpdateVirtualQueueAttributes{
+				Operation:     queue.VirtualQueueOperationPause,
+				QueueID:       2,
+				PauseDuration: 30 * time.Second,
+			},
+		},
+		{
+			name: "resume",
+			request: &types.UpdateVirtualQueueRequest{
+				ClusterName:    "cluster",
+				Operation:      types.VirtualQueueOperationResume.Ptr(),
+				VirtualQueueID: 2,
+			},
+			expectedAttributes: &queue.UpdateVirtualQueueAttributes{
+				Operation: queue.VirtualQueueOperationResume,
+				QueueID:   2,
+			},
+		},
+		{
+			name: "merge",
+			request: &types.UpdateVirtualQueueRequest{
+				ClusterName:          "cluster",
+				Operation:            types.VirtualQueueOperationMerge.Ptr(),
+				VirtualQueueID:       2,
+				TargetVirtualQueueID: 0,
+			},
+			expectedAttributes: &queue.UpdateVirtualQueueAttributes{
+				Operation:     queue.VirtualQueueOperationMerge,
+				QueueID:       2,
+				TargetQueueID: 0,
+			},
+		},
+		{
+			name: "handle action failed",
+			request: &types.UpdateVirtualQueueRequest{
+				ClusterName:    "cluster",
+				Operation:      types.VirtualQueueOperationResume.Ptr(),
+				VirtualQueueID: 2,
+			},
+			expectedAttributes: &queue.UpdateVirtualQueueAttributes{
+				Operation: queue.VirtualQueueOperationResume,
+				QueueID:   2,
+			},
+			err:         errors.New("some error"),
+			expectedErr: true,
+		},
+		{
+			name: "operation not set",
+			request: &types.UpdateVirtualQueueRequest{
+				ClusterName: "cluster",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "unknown operation",
+			request: &types.UpdateVirtualQueueRequest{
+				ClusterName: "cluster",
+				Operation:   types.VirtualQueueOperation(100).Ptr(),
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockProcessor := queue.NewMockProcessor(ctrl)
+			if tt.expectedAttributes != nil {
+				mockProcessor.EXPECT().HandleAction(gomock.Any(), "cluster", queue.NewUpdateVirtualQueueAction(tt.expectedAttributes)).Return(&queue.ActionResult{}, tt.err)
+			}
+			engine := &historyEngineImpl{
+				queueProcessors: map[persistence.HistoryTaskCategory]queue.Processor{
+					persistence.HistoryTaskCategoryTransfer: mockProcessor,
+				},
+			}
+
+			err := engine.UpdateTransferVirtualQueue(context.Background(), tt.request)
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpdateVirtualQueue_UnknownCategory(t *testing.T) {
+	engine := &historyEngineImpl{
+		queueProcessors: map[persistence.HistoryTaskCategory]queue.Processor{},
+	}
+	err := engine.UpdateTimerVirtualQueue(context.Background(), &types.UpdateVirtualQueueRequest{
+		Operation: types.VirtualQueueOperationResume.Ptr(),
+	})
+	assert.Error(t, err)
+}
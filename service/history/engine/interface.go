@@ -74,6 +74,8 @@ type (
 		ResetTimerQueue(ctx context.Context, clusterName string) error
 		DescribeTransferQueue(ctx context.Context, clusterName string) (*types.DescribeQueueResponse, error)
 		DescribeTimerQueue(ctx context.Context, clusterName string) (*types.DescribeQueueResponse, error)
+		UpdateTransferVirtualQueue(ctx context.Context, request *types.UpdateVirtualQueueRequest) error
+		UpdateTimerVirtualQueue(ctx context.Context, request *types.UpdateVirtualQueueRequest) error
 
 		NotifyNewHistoryEvent(event *events.Notification)
 		NotifyNewTransferTasks(info *hcommon.NotifyTaskInfo)
@@ -667,3 +667,31 @@ func (mr *MockEngineMockRecorder) TerminateWorkflowExecution(ctx, request any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).TerminateWorkflowExecution), ctx, request)
 }
+
+// UpdateTimerVirtualQueue mocks base method.
+func (m *MockEngine) UpdateTimerVirtualQueue(ctx context.Context, request *types.UpdateVirtualQueueRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimerVirtualQueue", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTimerVirtualQueue indicates an expected call of UpdateTimerVirtualQueue.
+func (mr *MockEngineMockRecorder) UpdateTimerVirtualQueue(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimerVirtualQueue", reflect.TypeOf((*MockEngine)(nil).UpdateTimerVirtualQueue), ctx, request)
+}
+
+// UpdateTransferVirtualQueue mocks base method.
+func (m *MockEngine) UpdateTransferVirtualQueue(ctx context.Context, request *types.UpdateVirtualQueueRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransferVirtualQueue", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTransferVirtualQueue indicates an expected call of UpdateTransferVirtualQueue.
+func (mr *MockEngineMockRecorder) UpdateTransferVirtualQueue(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransferVirtualQueue", reflect.TypeOf((*MockEngine)(nil).UpdateTransferVirtualQueue), ctx, request)
+}
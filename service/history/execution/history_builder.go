@@ -234,6 +234,8 @@ func (b *HistoryBuilder) AddActivityTaskScheduledEvent(decisionCompletedEventID
 		DecisionTaskCompletedEventID:  decisionCompletedEventID,
 		RetryPolicy:                   attributes.RetryPolicy,
 		Domain:                        domain,
+		Priority:                      attributes.Priority,
+		FairnessKey:                   attributes.FairnessKey,
 	}
 
 	return b.addEventToHistory(event)
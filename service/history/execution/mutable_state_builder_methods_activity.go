@@ -309,6 +309,8 @@ func (e *mutableStateBuilder) ReplicateActivityTaskScheduledEvent(
 		TimerTaskStatus:          TimerTaskStatusNone,
 		TaskList:                 attributes.TaskList.GetName(),
 		TaskListKind:             attributes.TaskList.GetKind(),
+		Priority:                 attributes.Priority,
+		FairnessKey:              attributes.FairnessKey,
 		HasRetryPolicy:           attributes.RetryPolicy != nil,
 	}
 
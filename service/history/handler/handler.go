@@ -867,6 +867,38 @@ func (h *handlerImpl) DescribeQueue(
 	return resp, nil
 }
 
+// UpdateVirtualQueue moves domains between, pauses, resumes or merges virtual queues
+func (h *handlerImpl) UpdateVirtualQueue(
+	ctx context.Context,
+	request *types.UpdateVirtualQueueRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUpdateVirtualQueueScope)
+	defer sw.Stop()
+
+	engine, err := h.controller.GetEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return h.error(err, scope, "", "", "")
+	}
+
+	switch taskType := commonconstants.TaskType(request.GetType()); taskType {
+	case commonconstants.TaskTypeTransfer:
+		err = engine.UpdateTransferVirtualQueue(ctx, request)
+	case commonconstants.TaskTypeTimer:
+		err = engine.UpdateTimerVirtualQueue(ctx, request)
+	default:
+		err = constants.ErrInvalidTaskType
+	}
+
+	if err != nil {
+		return h.error(err, scope, "", "", "")
+	}
+	return nil
+}
+
 // DescribeMutableState - returns the internal analysis of workflow execution state
 func (h *handlerImpl) DescribeMutableState(
 	ctx context.Context,
@@ -1351,6 +1351,80 @@ func (s *handlerSuite) TestResetQueue() {
 	}
 }
 
+func (s *handlerSuite) TestUpdateVirtualQueue() {
+	testInput := map[string]struct {
+		request       *types.UpdateVirtualQueueRequest
+		expectedError bool
+		mockFn        func()
+	}{
+		"getEngine error": {
+			request: &types.UpdateVirtualQueueRequest{
+				ShardID: 0,
+			},
+			expectedError: true,
+			mockFn: func() {
+				s.mockShardController.EXPECT().GetEngineForShard(0).Return(nil, errors.New("error")).Times(1)
+			},
+		},
+		"transfer task": {
+			request: &types.UpdateVirtualQueueRequest{
+				ShardID: 0,
+				Type:    common.Int32Ptr(int32(commonconstants.TaskTypeTransfer)),
+			},
+			expectedError: false,
+			mockFn: func() {
+				s.mockShardController.EXPECT().GetEngineForShard(0).Return(s.mockEngine, nil).Times(1)
+				s.mockEngine.EXPECT().UpdateTransferVirtualQueue(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		"timer task": {
+			request: &types.UpdateVirtualQueueRequest{
+				ShardID: 0,
+				Type:    common.Int32Ptr(int32(commonconstants.TaskTypeTimer)),
+			},
+			expectedError: false,
+			mockFn: func() {
+				s.mockShardController.EXPECT().GetEngineForShard(0).Return(s.mockEngine, nil).Times(1)
+				s.mockEngine.EXPECT().UpdateTimerVirtualQueue(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		"engine error": {
+			request: &types.UpdateVirtualQueueRequest{
+				ShardID: 0,
+				Type:    common.Int32Ptr(int32(commonconstants.TaskTypeTimer)),
+			},
+			expectedError: true,
+			mockFn: func() {
+				s.mockShardController.EXPECT().GetEngineForShard(0).Return(s.mockEngine, nil).Times(1)
+				s.mockEngine.EXPECT().UpdateTimerVirtualQueue(gomock.Any(), gomock.Any()).Return(&types.EntityNotExistsError{}).Times(1)
+			},
+		},
+		"invalid task": {
+			request: &types.UpdateVirtualQueueRequest{
+				ShardID: 0,
+				Type:    common.Int32Ptr(int32(100)),
+			},
+			expectedError: true,
+			mockFn: func() {
+				s.mockShardController.EXPECT().GetEngineForShard(0).Return(s.mockEngine, nil).Times(1)
+			},
+		},
+	}
+
+	for name, input := range testInput {
+		s.Run(name, func() {
+			input.mockFn()
+			err := s.handler.UpdateVirtualQueue(context.Background(), input.request)
+			if input.expectedError {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+		})
+
+	}
+}
+
 func (s *handlerSuite) TestDescribeQueue() {
 	testInput := map[string]struct {
 		request       *types.DescribeQueueRequest
@@ -85,6 +85,7 @@ type Handler interface {
 	SyncActivity(context.Context, *types.SyncActivityRequest) error
 	SyncShardStatus(context.Context, *types.SyncShardStatusRequest) error
 	TerminateWorkflowExecution(context.Context, *types.HistoryTerminateWorkflowExecutionRequest) error
+	UpdateVirtualQueue(context.Context, *types.UpdateVirtualQueueRequest) error
 	GetFailoverInfo(context.Context, *types.GetFailoverInfoRequest) (*types.GetFailoverInfoResponse, error)
 	RatelimitUpdate(context.Context, *types.RatelimitUpdateRequest) (*types.RatelimitUpdateResponse, error)
 }
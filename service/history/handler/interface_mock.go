@@ -735,3 +735,17 @@ func (mr *MockHandlerMockRecorder) TerminateWorkflowExecution(arg0, arg1 any) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).TerminateWorkflowExecution), arg0, arg1)
 }
+
+// UpdateVirtualQueue mocks base method.
+func (m *MockHandler) UpdateVirtualQueue(arg0 context.Context, arg1 *types.UpdateVirtualQueueRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVirtualQueue", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVirtualQueue indicates an expected call of UpdateVirtualQueue.
+func (mr *MockHandlerMockRecorder) UpdateVirtualQueue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualQueue", reflect.TypeOf((*MockHandler)(nil).UpdateVirtualQueue), arg0, arg1)
+}
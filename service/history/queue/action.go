@@ -20,7 +20,11 @@
 
 package queue
 
-import "github.com/uber/cadence/common/types"
+import (
+	"time"
+
+	"github.com/uber/cadence/common/types"
+)
 
 type (
 	// ActionType specifies the type of the Action
@@ -28,21 +32,23 @@ type (
 
 	// Action specifies the Action should be performed
 	Action struct {
-		ActionType               ActionType
-		ResetActionAttributes    *ResetActionAttributes
-		GetStateActionAttributes *GetStateActionAttributes
-		GetTasksAttributes       *GetTasksAttributes
-		UpdateTaskAttributes     *UpdateTasksAttributes
+		ActionType                   ActionType
+		ResetActionAttributes        *ResetActionAttributes
+		GetStateActionAttributes     *GetStateActionAttributes
+		GetTasksAttributes           *GetTasksAttributes
+		UpdateTaskAttributes         *UpdateTasksAttributes
+		UpdateVirtualQueueAttributes *UpdateVirtualQueueAttributes
 		// add attributes for other action types here
 	}
 
 	// ActionResult is the result for performing an Action
 	ActionResult struct {
-		ActionType           ActionType
-		ResetActionResult    *ResetActionResult
-		GetStateActionResult *GetStateActionResult
-		GetTasksResult       *GetTasksResult
-		UpdateTaskResult     *UpdateTasksResult
+		ActionType               ActionType
+		ResetActionResult        *ResetActionResult
+		GetStateActionResult     *GetStateActionResult
+		GetTasksResult           *GetTasksResult
+		UpdateTaskResult         *UpdateTasksResult
+		UpdateVirtualQueueResult *UpdateVirtualQueueResult
 	}
 
 	// ResetActionAttributes contains the parameter for performing Reset Action
@@ -55,6 +61,20 @@ type (
 	// GetStateActionResult is the result for performing GetState Action
 	GetStateActionResult struct {
 		States []ProcessingQueueState
+		// VirtualQueues is only populated by queues backed by virtual queues
+		VirtualQueues []*VirtualQueueInfo
+	}
+
+	// VirtualQueueInfo describes a virtual queue and its slices
+	VirtualQueueInfo struct {
+		QueueID int64
+		Paused  bool
+		Slices  []*VirtualSliceInfo
+	}
+	// VirtualSliceInfo describes the range, predicate and pending task count of a virtual slice
+	VirtualSliceInfo struct {
+		State            *types.VirtualSliceState
+		PendingTaskCount int
 	}
 
 	// GetTasksAttributes contains the parameter to get tasks
@@ -70,6 +90,22 @@ type (
 	// UpdateTasksResult is the result for performing UpdateTask Action
 	UpdateTasksResult struct {
 	}
+
+	// VirtualQueueOperation specifies the operation to perform on a virtual queue
+	VirtualQueueOperation int
+	// UpdateVirtualQueueAttributes contains the parameter to update a virtual queue
+	UpdateVirtualQueueAttributes struct {
+		Operation VirtualQueueOperation
+		QueueID   int64
+		// TargetQueueID is required by VirtualQueueOperationMoveDomains and VirtualQueueOperationMerge
+		TargetQueueID int64
+		// DomainIDs is required by VirtualQueueOperationMoveDomains
+		DomainIDs []string
+		// PauseDuration is required by VirtualQueueOperationPause
+		PauseDuration time.Duration
+	}
+	// UpdateVirtualQueueResult is the result for performing UpdateVirtualQueue Action
+	UpdateVirtualQueueResult struct{}
 )
 
 const (
@@ -81,9 +117,22 @@ const (
 	ActionTypeGetTasks
 	// ActionTypeUpdateTask is the ActionType to update outstanding task
 	ActionTypeUpdateTask
+	// ActionTypeUpdateVirtualQueue is the ActionType to split, pause, resume or merge virtual queues
+	ActionTypeUpdateVirtualQueue
 	// add more ActionType here
 )
 
+const (
+	// VirtualQueueOperationMoveDomains moves the tasks of the given domains to the target virtual queue
+	VirtualQueueOperationMoveDomains VirtualQueueOperation = iota + 1
+	// VirtualQueueOperationPause pauses the virtual queue for the given duration
+	VirtualQueueOperationPause
+	// VirtualQueueOperationResume resumes a paused virtual queue
+	VirtualQueueOperationResume
+	// VirtualQueueOperationMerge merges the slices of the virtual queue into the target virtual queue
+	VirtualQueueOperationMerge
+)
+
 // NewResetAction creates a new action for reseting processing queue states
 func NewResetAction() *Action {
 	return &Action{
@@ -120,3 +169,14 @@ func NewUpdateTasksAction(
 		},
 	}
 }
+
+// NewUpdateVirtualQueueAction creates a queue action for updating virtual queues,
+// it's only supported by queues backed by virtual queues
+func NewUpdateVirtualQueueAction(
+	attributes *UpdateVirtualQueueAttributes,
+) *Action {
+	return &Action{
+		ActionType:                   ActionTypeUpdateVirtualQueue,
+		UpdateVirtualQueueAttributes: attributes,
+	}
+}
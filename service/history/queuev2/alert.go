@@ -1,10 +1,19 @@
 package queuev2
 
+import (
+	"time"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
 type (
 	// Alert is created by a Monitor when some statistics of the Queue is abnormal
 	Alert struct {
 		AlertType                            AlertType
 		AlertAttributesQueuePendingTaskCount *AlertAttributesQueuePendingTaskCount
+		AlertAttributesStuckSlice            *AlertAttributesStuckSlice
+		AlertAttributesSliceCount            *AlertAttributesSliceCount
+		AlertAttributesTaskLatency           *AlertAttributesTaskLatency
 	}
 
 	AlertType int
@@ -13,9 +22,48 @@ type (
 		CurrentPendingTaskCount  int
 		CriticalPendingTaskCount int
 	}
+
+	// AlertAttributesStuckSlice is set when the ack level of a virtual slice with pending tasks hasn't advanced for too long
+	AlertAttributesStuckSlice struct {
+		Slice               VirtualSlice
+		InclusiveMinTaskKey persistence.HistoryTaskKey
+		StuckDuration       time.Duration
+	}
+
+	// AlertAttributesSliceCount is set when a virtual queue has too many virtual slices
+	AlertAttributesSliceCount struct {
+		VirtualQueueID     int64
+		CurrentSliceCount  int
+		CriticalSliceCount int
+	}
+
+	// AlertAttributesTaskLatency is set when the oldest pending task of a virtual slice has been scheduled for too long without being acked
+	AlertAttributesTaskLatency struct {
+		Slice               VirtualSlice
+		CurrentTaskLatency  time.Duration
+		CriticalTaskLatency time.Duration
+	}
 )
 
 const (
 	AlertTypeUnspecified AlertType = iota
 	AlertTypeQueuePendingTaskCount
+	AlertTypeStuckSlice
+	AlertTypeSliceCount
+	AlertTypeTaskLatency
 )
+
+func (t AlertType) String() string {
+	switch t {
+	case AlertTypeQueuePendingTaskCount:
+		return "queue_pending_task_count"
+	case AlertTypeStuckSlice:
+		return "stuck_slice"
+	case AlertTypeSliceCount:
+		return "slice_count"
+	case AlertTypeTaskLatency:
+		return "task_latency"
+	default:
+		return "unspecified"
+	}
+}
@@ -101,6 +101,25 @@ func FromPersistencePredicate(predicate *types.Predicate) Predicate {
 		return NewEmptyPredicate()
 	case types.PredicateTypeDomainID:
 		return NewDomainIDPredicate(predicate.GetDomainIDPredicateAttributes().DomainIDs, predicate.GetDomainIDPredicateAttributes().GetIsExclusive())
+	case types.PredicateTypeTaskType:
+		attributes := predicate.GetTaskTypePredicateAttributes()
+		taskTypes := make([]int, 0, len(attributes.TaskTypes))
+		for _, taskType := range attributes.TaskTypes {
+			taskTypes = append(taskTypes, int(taskType))
+		}
+		return NewTaskTypePredicate(taskTypes, attributes.GetIsExclusive())
+	case types.PredicateTypeTaskList:
+		return NewTaskListPredicate(predicate.GetTaskListPredicateAttributes().TaskLists, predicate.GetTaskListPredicateAttributes().GetIsExclusive())
+	case types.PredicateTypeWorkflowID:
+		return NewWorkflowIDPredicate(predicate.GetWorkflowIDPredicateAttributes().WorkflowIDs, predicate.GetWorkflowIDPredicateAttributes().GetIsExclusive())
+	case types.PredicateTypeWorkflowIDPrefix:
+		return NewWorkflowIDPrefixPredicate(predicate.GetWorkflowIDPrefixPredicateAttributes().Prefixes, predicate.GetWorkflowIDPrefixPredicateAttributes().GetIsExclusive())
+	case types.PredicateTypeAnd:
+		return &andPredicate{predicates: fromPersistencePredicates(predicate.GetAndPredicateAttributes().Predicates)}
+	case types.PredicateTypeOr:
+		return &orPredicate{predicates: fromPersistencePredicates(predicate.GetOrPredicateAttributes().Predicates)}
+	case types.PredicateTypeNot:
+		return &notPredicate{predicate: FromPersistencePredicate(predicate.GetNotPredicateAttributes().Predicate)}
 	default:
 		panic(fmt.Sprintf("unknown predicate type: %v", predicate.PredicateType))
 	}
@@ -116,7 +135,48 @@ func ToPersistencePredicate(predicate Predicate) *types.Predicate {
 		domainIDs := maps.Keys(p.domainIDs)
 		slices.Sort(domainIDs)
 		return &types.Predicate{PredicateType: types.PredicateTypeDomainID, DomainIDPredicateAttributes: &types.DomainIDPredicateAttributes{DomainIDs: domainIDs, IsExclusive: &p.isExclusive}}
+	case *taskTypePredicate:
+		taskTypes := make([]int32, 0, len(p.taskTypes))
+		for taskType := range p.taskTypes {
+			taskTypes = append(taskTypes, int32(taskType))
+		}
+		slices.Sort(taskTypes)
+		return &types.Predicate{PredicateType: types.PredicateTypeTaskType, TaskTypePredicateAttributes: &types.TaskTypePredicateAttributes{TaskTypes: taskTypes, IsExclusive: &p.isExclusive}}
+	case *taskListPredicate:
+		taskLists := maps.Keys(p.taskLists)
+		slices.Sort(taskLists)
+		return &types.Predicate{PredicateType: types.PredicateTypeTaskList, TaskListPredicateAttributes: &types.TaskListPredicateAttributes{TaskLists: taskLists, IsExclusive: &p.isExclusive}}
+	case *workflowIDPredicate:
+		workflowIDs := maps.Keys(p.workflowIDs)
+		slices.Sort(workflowIDs)
+		return &types.Predicate{PredicateType: types.PredicateTypeWorkflowID, WorkflowIDPredicateAttributes: &types.WorkflowIDPredicateAttributes{WorkflowIDs: workflowIDs, IsExclusive: &p.isExclusive}}
+	case *workflowIDPrefixPredicate:
+		prefixes := maps.Keys(p.prefixes)
+		slices.Sort(prefixes)
+		return &types.Predicate{PredicateType: types.PredicateTypeWorkflowIDPrefix, WorkflowIDPrefixPredicateAttributes: &types.WorkflowIDPrefixPredicateAttributes{Prefixes: prefixes, IsExclusive: &p.isExclusive}}
+	case *andPredicate:
+		return &types.Predicate{PredicateType: types.PredicateTypeAnd, AndPredicateAttributes: &types.AndPredicateAttributes{Predicates: toPersistencePredicates(p.predicates)}}
+	case *orPredicate:
+		return &types.Predicate{PredicateType: types.PredicateTypeOr, OrPredicateAttributes: &types.OrPredicateAttributes{Predicates: toPersistencePredicates(p.predicates)}}
+	case *notPredicate:
+		return &types.Predicate{PredicateType: types.PredicateTypeNot, NotPredicateAttributes: &types.NotPredicateAttributes{Predicate: ToPersistencePredicate(p.predicate)}}
 	default:
 		panic(fmt.Sprintf("unknown predicate type: %T", p))
 	}
 }
+
+func fromPersistencePredicates(predicates []*types.Predicate) []Predicate {
+	result := make([]Predicate, 0, len(predicates))
+	for _, p := range predicates {
+		result = append(result, FromPersistencePredicate(p))
+	}
+	return result
+}
+
+func toPersistencePredicates(predicates []Predicate) []*types.Predicate {
+	result := make([]*types.Predicate, 0, len(predicates))
+	for _, p := range predicates {
+		result = append(result, ToPersistencePredicate(p))
+	}
+	return result
+}
@@ -44,11 +44,82 @@ func predicateFuzzGenerator(t *types.Predicate, c fuzz.Continue) {
 	case 2:
 		t.PredicateType = types.PredicateTypeDomainID
 		c.Fuzz(&t.DomainIDPredicateAttributes)
+	case 3:
+		t.PredicateType = types.PredicateTypeTaskType
+		c.Fuzz(&t.TaskTypePredicateAttributes)
+	case 4:
+		t.PredicateType = types.PredicateTypeTaskList
+		t.TaskListPredicateAttributes = &types.TaskListPredicateAttributes{}
+		t.TaskListPredicateAttributes.TaskLists, t.TaskListPredicateAttributes.IsExclusive = fuzzSortedStringSet(c)
+	case 5:
+		t.PredicateType = types.PredicateTypeWorkflowID
+		t.WorkflowIDPredicateAttributes = &types.WorkflowIDPredicateAttributes{}
+		t.WorkflowIDPredicateAttributes.WorkflowIDs, t.WorkflowIDPredicateAttributes.IsExclusive = fuzzSortedStringSet(c)
+	case 6:
+		t.PredicateType = types.PredicateTypeWorkflowIDPrefix
+		t.WorkflowIDPrefixPredicateAttributes = &types.WorkflowIDPrefixPredicateAttributes{}
+		t.WorkflowIDPrefixPredicateAttributes.Prefixes, t.WorkflowIDPrefixPredicateAttributes.IsExclusive = fuzzSortedStringSet(c)
+	case 7:
+		t.PredicateType = types.PredicateTypeAnd
+		t.AndPredicateAttributes = &types.AndPredicateAttributes{Predicates: fuzzLeafPredicates(c)}
+	case 8:
+		t.PredicateType = types.PredicateTypeOr
+		t.OrPredicateAttributes = &types.OrPredicateAttributes{Predicates: fuzzLeafPredicates(c)}
+	case 9:
+		t.PredicateType = types.PredicateTypeNot
+		t.NotPredicateAttributes = &types.NotPredicateAttributes{Predicate: &types.Predicate{
+			PredicateType:               types.PredicateTypeDomainID,
+			DomainIDPredicateAttributes: &types.DomainIDPredicateAttributes{},
+		}}
+		t.NotPredicateAttributes.Predicate.DomainIDPredicateAttributes.DomainIDs, t.NotPredicateAttributes.Predicate.DomainIDPredicateAttributes.IsExclusive = fuzzSortedStringSet(c)
 	default:
 		panic("invalid predicate type")
 	}
 }
 
+// fuzzLeafPredicates generates the operands of a composite predicate,
+// composites are not nested to keep the generated predicates small
+func fuzzLeafPredicates(c fuzz.Continue) []*types.Predicate {
+	count := c.Intn(3) + 2
+	predicates := make([]*types.Predicate, 0, count)
+	for len(predicates) < count {
+		var p types.Predicate
+		predicateFuzzGenerator(&p, c)
+		switch p.PredicateType {
+		case types.PredicateTypeAnd, types.PredicateTypeOr, types.PredicateTypeNot:
+			continue
+		}
+		predicates = append(predicates, &p)
+	}
+	return predicates
+}
+
+func fuzzSortedStringSet(c fuzz.Continue) ([]string, *bool) {
+	var attributes types.DomainIDPredicateAttributes
+	domainIDPredicateAttributesFuzzGenerator(&attributes, c)
+	return attributes.DomainIDs, attributes.IsExclusive
+}
+
+func taskTypePredicateAttributesFuzzGenerator(t *types.TaskTypePredicateAttributes, c fuzz.Continue) {
+	count := c.Intn(5) + 1
+
+	seen := make(map[int32]struct{})
+	t.TaskTypes = make([]int32, 0, count)
+	for len(t.TaskTypes) < count {
+		taskType := int32(c.Intn(20))
+		if _, exists := seen[taskType]; !exists {
+			seen[taskType] = struct{}{}
+			t.TaskTypes = append(t.TaskTypes, taskType)
+		}
+	}
+
+	sort.Slice(t.TaskTypes, func(i, j int) bool { return t.TaskTypes[i] < t.TaskTypes[j] })
+
+	var b bool
+	c.Fuzz(&b)
+	t.IsExclusive = &b
+}
+
 func domainIDPredicateAttributesFuzzGenerator(t *types.DomainIDPredicateAttributes, c fuzz.Continue) {
 	const maxCount = 10 // adjust as needed
 	count := rand.Intn(maxCount) + 1
@@ -75,7 +146,7 @@ func domainIDPredicateAttributesFuzzGenerator(t *types.DomainIDPredicateAttribut
 }
 
 func TestConvertVirtualSliceState(t *testing.T) {
-	f := fuzz.New().NilChance(0).Funcs(predicateFuzzGenerator, domainIDPredicateAttributesFuzzGenerator)
+	f := fuzz.New().NilChance(0).Funcs(predicateFuzzGenerator, domainIDPredicateAttributesFuzzGenerator, taskTypePredicateAttributesFuzzGenerator)
 	for i := 0; i < 1000; i++ {
 		var s types.VirtualSliceState
 		f.Fuzz(&s)
@@ -86,7 +157,7 @@ func TestConvertVirtualSliceState(t *testing.T) {
 }
 
 func TestConvertVirtualQueueState(t *testing.T) {
-	f := fuzz.New().NilChance(0).Funcs(predicateFuzzGenerator, domainIDPredicateAttributesFuzzGenerator)
+	f := fuzz.New().NilChance(0).Funcs(predicateFuzzGenerator, domainIDPredicateAttributesFuzzGenerator, taskTypePredicateAttributesFuzzGenerator)
 	for i := 0; i < 1000; i++ {
 		var s types.VirtualQueueState
 		f.Fuzz(&s)
@@ -97,7 +168,7 @@ func TestConvertVirtualQueueState(t *testing.T) {
 }
 
 func TestConvertQueueState(t *testing.T) {
-	f := fuzz.New().NilChance(0).Funcs(predicateFuzzGenerator, domainIDPredicateAttributesFuzzGenerator)
+	f := fuzz.New().NilChance(0).Funcs(predicateFuzzGenerator, domainIDPredicateAttributesFuzzGenerator, taskTypePredicateAttributesFuzzGenerator)
 	for i := 0; i < 1000; i++ {
 		var s types.QueueState
 		f.Fuzz(&s)
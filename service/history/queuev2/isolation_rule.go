@@ -0,0 +1,171 @@
+package queuev2
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+type (
+	// IsolationRule declares that the tasks of a domain are always processed in a dedicated virtual queue
+	IsolationRule struct {
+		DomainID       string
+		VirtualQueueID int64
+	}
+
+	// IsolationRulesFn returns the isolation rules that currently apply to a queue
+	IsolationRulesFn func() []IsolationRule
+
+	isolationRulesProvider struct {
+		rulesFn   dynamicproperties.ListPropertyFn
+		queueType string
+		logger    log.Logger
+
+		sync.Mutex
+		lastValue []interface{}
+		lastRules []IsolationRule
+	}
+)
+
+// NewIsolationRulesFn creates an IsolationRulesFn from the list of isolation rules in dynamic config.
+// Only the rules that apply to the given queue type are returned, invalid rules are logged and ignored.
+func NewIsolationRulesFn(
+	rulesFn dynamicproperties.ListPropertyFn,
+	queueType string,
+	logger log.Logger,
+) IsolationRulesFn {
+	if rulesFn == nil {
+		return func() []IsolationRule { return nil }
+	}
+	p := &isolationRulesProvider{
+		rulesFn:   rulesFn,
+		queueType: queueType,
+		logger:    logger,
+	}
+	return p.getRules
+}
+
+func (p *isolationRulesProvider) getRules() []IsolationRule {
+	value := p.rulesFn()
+
+	p.Lock()
+	defer p.Unlock()
+	// the value is only parsed when it's changed, so that invalid rules are not logged every time
+	if p.lastRules != nil && reflect.DeepEqual(value, p.lastValue) {
+		return p.lastRules
+	}
+
+	rules := make([]IsolationRule, 0, len(value))
+	isolatedDomains := make(map[string]struct{})
+	for _, v := range value {
+		rule, queueTypes, err := parseIsolationRule(v)
+		if err != nil {
+			p.logger.Error("invalid queue isolation rule", tag.Error(err), tag.Dynamic("rule", v))
+			continue
+		}
+		if len(queueTypes) > 0 && !slices.Contains(queueTypes, p.queueType) {
+			continue
+		}
+		if _, ok := isolatedDomains[rule.DomainID]; ok {
+			p.logger.Error("duplicate queue isolation rule for domain, only the first one is applied", tag.WorkflowDomainID(rule.DomainID), tag.Dynamic("rule", v))
+			continue
+		}
+		isolatedDomains[rule.DomainID] = struct{}{}
+		rules = append(rules, rule)
+	}
+	p.lastValue = value
+	p.lastRules = rules
+	return rules
+}
+
+func parseIsolationRule(value interface{}) (IsolationRule, []string, error) {
+	switch v := value.(type) {
+	case IsolationRule: // correctly typed value, applied to all queue types
+		if err := validateIsolationRule(v); err != nil {
+			return IsolationRule{}, nil, err
+		}
+		return v, nil, nil
+	case map[string]interface{}: // loaded from generic deserialization, compatible with encoding/json
+		domainID, ok := v["DomainID"].(string)
+		if !ok {
+			return IsolationRule{}, nil, fmt.Errorf("invalid generic type for DomainID: %T", v["DomainID"])
+		}
+		queueID, err := toInt64(v["VirtualQueueID"])
+		if err != nil {
+			return IsolationRule{}, nil, fmt.Errorf("invalid VirtualQueueID: %w", err)
+		}
+		queueTypes, err := toStringSlice(v["QueueTypes"])
+		if err != nil {
+			return IsolationRule{}, nil, fmt.Errorf("invalid QueueTypes: %w", err)
+		}
+		rule := IsolationRule{DomainID: domainID, VirtualQueueID: queueID}
+		if err := validateIsolationRule(rule); err != nil {
+			return IsolationRule{}, nil, err
+		}
+		return rule, queueTypes, nil
+	default:
+		return IsolationRule{}, nil, fmt.Errorf("unrecognized queue isolation rule type: %T", v)
+	}
+}
+
+func validateIsolationRule(rule IsolationRule) error {
+	if rule.DomainID == "" {
+		return fmt.Errorf("domain ID must not be empty")
+	}
+	if rule.VirtualQueueID <= rootQueueID {
+		return fmt.Errorf("virtual queue ID must be greater than %d, got %d", rootQueueID, rule.VirtualQueueID)
+	}
+	return nil
+}
+
+func toInt64(value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case float64:
+		if v != math.Trunc(v) {
+			return 0, fmt.Errorf("not an integer: %v", v)
+		}
+		return int64(v), nil
+	default:
+		return 0, fmt.Errorf("unrecognized type: %T", v)
+	}
+}
+
+func toStringSlice(value interface{}) ([]string, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []string:
+		return v, nil
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, e := range v {
+			s, ok := e.(string)
+			if !ok {
+				return nil, fmt.Errorf("unrecognized element type: %T", e)
+			}
+			result = append(result, s)
+		}
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unrecognized type: %T", v)
+	}
+}
+
+// isolatedDomainsPerQueue groups the isolated domains by their dedicated virtual queue
+func isolatedDomainsPerQueue(rules []IsolationRule) map[int64][]string {
+	domainsPerQueue := make(map[int64][]string)
+	for _, rule := range rules {
+		domainsPerQueue[rule.VirtualQueueID] = append(domainsPerQueue[rule.VirtualQueueID], rule.DomainID)
+	}
+	return domainsPerQueue
+}
@@ -0,0 +1,110 @@
+package queuev2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+)
+
+func TestNewIsolationRulesFn(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     []interface{}
+		queueType string
+		expected  []IsolationRule
+	}{
+		{
+			name:      "empty rules",
+			value:     []interface{}{},
+			queueType: "transfer",
+			expected:  []IsolationRule{},
+		},
+		{
+			name: "typed and generic rules",
+			value: []interface{}{
+				IsolationRule{DomainID: "domain1", VirtualQueueID: 1},
+				map[string]interface{}{"DomainID": "domain2", "VirtualQueueID": 2},
+				map[string]interface{}{"DomainID": "domain3", "VirtualQueueID": float64(3), "QueueTypes": []interface{}{"transfer", "timer"}},
+			},
+			queueType: "timer",
+			expected: []IsolationRule{
+				{DomainID: "domain1", VirtualQueueID: 1},
+				{DomainID: "domain2", VirtualQueueID: 2},
+				{DomainID: "domain3", VirtualQueueID: 3},
+			},
+		},
+		{
+			name: "rules of other queue types are filtered out",
+			value: []interface{}{
+				map[string]interface{}{"DomainID": "domain1", "VirtualQueueID": 1, "QueueTypes": []interface{}{"timer"}},
+				map[string]interface{}{"DomainID": "domain2", "VirtualQueueID": 2, "QueueTypes": []string{"transfer"}},
+			},
+			queueType: "transfer",
+			expected: []IsolationRule{
+				{DomainID: "domain2", VirtualQueueID: 2},
+			},
+		},
+		{
+			name: "invalid rules are ignored",
+			value: []interface{}{
+				"domain1",
+				map[string]interface{}{"VirtualQueueID": 1},
+				map[string]interface{}{"DomainID": "domain2"},
+				map[string]interface{}{"DomainID": "domain3", "VirtualQueueID": 0},
+				map[string]interface{}{"DomainID": "domain4", "VirtualQueueID": 1.5},
+				map[string]interface{}{"DomainID": "domain5", "VirtualQueueID": 1, "QueueTypes": "transfer"},
+				map[string]interface{}{"DomainID": "domain6", "VirtualQueueID": int64(6)},
+			},
+			queueType: "transfer",
+			expected: []IsolationRule{
+				{DomainID: "domain6", VirtualQueueID: 6},
+			},
+		},
+		{
+			name: "only the first rule of a domain is applied",
+			value: []interface{}{
+				map[string]interface{}{"DomainID": "domain1", "VirtualQueueID": 1},
+				map[string]interface{}{"DomainID": "domain1", "VirtualQueueID": 2},
+			},
+			queueType: "transfer",
+			expected: []IsolationRule{
+				{DomainID: "domain1", VirtualQueueID: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rulesFn := NewIsolationRulesFn(func(opts ...dynamicproperties.FilterOption) []interface{} {
+				return tt.value
+			}, tt.queueType, log.NewNoop())
+
+			assert.Equal(t, tt.expected, rulesFn())
+			// the parsed rules are reused until the value changes
+			assert.Equal(t, tt.expected, rulesFn())
+		})
+	}
+}
+
+func TestNewIsolationRulesFn_ValueChanged(t *testing.T) {
+	value := []interface{}{
+		map[string]interface{}{"DomainID": "domain1", "VirtualQueueID": 1},
+	}
+	rulesFn := NewIsolationRulesFn(func(opts ...dynamicproperties.FilterOption) []interface{} {
+		return value
+	}, "transfer", log.NewNoop())
+	assert.Equal(t, []IsolationRule{{DomainID: "domain1", VirtualQueueID: 1}}, rulesFn())
+
+	value = []interface{}{
+		map[string]interface{}{"DomainID": "domain1", "VirtualQueueID": 2},
+	}
+	assert.Equal(t, []IsolationRule{{DomainID: "domain1", VirtualQueueID: 2}}, rulesFn())
+}
+
+func TestNewIsolationRulesFn_NilProperty(t *testing.T) {
+	rulesFn := NewIsolationRulesFn(nil, "transfer", log.NewNoop())
+	assert.Nil(t, rulesFn())
+}
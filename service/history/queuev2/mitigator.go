@@ -2,7 +2,6 @@
 package queuev2
 
 import (
-	"maps"
 	"slices"
 	"time"
 
@@ -17,6 +16,14 @@ import (
 const (
 	targetLoadFactor           = 0.8
 	clearSliceThrottleDuration = 10 * time.Second
+	// taskLatencyThrottleDuration is how long a virtual queue stops loading new tasks when its tasks are not acked in time,
+	// so that the tasks already loaded can be drained by the task scheduler
+	taskLatencyThrottleDuration = 10 * time.Second
+
+	mitigationSkipped         = "skipped"
+	mitigationDomainIsolation = "domain_isolation"
+	mitigationSliceMerge      = "slice_merge"
+	mitigationThrottledPause  = "throttled_pause"
 )
 
 type (
@@ -26,6 +33,9 @@ type (
 
 	MitigatorOptions struct {
 		MaxVirtualQueueCount dynamicproperties.IntPropertyFn
+		// IsolationRules declares the domains that have a dedicated virtual queue, the mitigator never moves those domains
+		// and never moves other domains to those virtual queues
+		IsolationRules IsolationRulesFn
 	}
 
 	mitigatorImpl struct {
@@ -35,7 +45,8 @@ type (
 		metricsScope        metrics.Scope
 		options             *MitigatorOptions
 
-		handlers map[AlertType]func(Alert)
+		// handlers mitigate an alert and return the mitigation that has been applied
+		handlers map[AlertType]func(Alert) string
 	}
 
 	pendingTaskStats struct {
@@ -60,25 +71,33 @@ func NewMitigator(
 		metricsScope:        metricsScope,
 		options:             options,
 	}
-	m.handlers = map[AlertType]func(Alert){
+	m.handlers = map[AlertType]func(Alert) string{
 		AlertTypeQueuePendingTaskCount: m.handleQueuePendingTaskCount,
+		AlertTypeStuckSlice:            m.handleStuckSlice,
+		AlertTypeSliceCount:            m.handleSliceCount,
+		AlertTypeTaskLatency:           m.handleTaskLatency,
 	}
 	return m
 }
 
 func (m *mitigatorImpl) Mitigate(alert Alert) {
+	scope := m.metricsScope.Tagged(metrics.AlertTypeTag(alert.AlertType.String()))
+	scope.IncCounter(metrics.VirtualQueueAlertCounter)
+
 	handler, ok := m.handlers[alert.AlertType]
 	if !ok {
 		m.logger.Error("unknown queue alert type", tag.AlertType(int(alert.AlertType)))
 		return
 	}
-	handler(alert)
+	m.logger.Info("received queue alert", tag.AlertType(int(alert.AlertType)))
+	mitigation := handler(alert)
 
 	m.monitor.ResolveAlert(alert.AlertType)
-	m.logger.Info("mitigated queue alert", tag.AlertType(int(alert.AlertType)))
+	scope.Tagged(metrics.DecisionTag(mitigation)).IncCounter(metrics.VirtualQueueMitigationCounter)
+	m.logger.Info("mitigated queue alert", tag.AlertType(int(alert.AlertType)), tag.Mitigation(mitigation))
 }
 
-func (m *mitigatorImpl) handleQueuePendingTaskCount(alert Alert) {
+func (m *mitigatorImpl) handleQueuePendingTaskCount(alert Alert) string {
 	// First, try cleaning up tasks that has already been acknowledged to see if we can reduce the pending task count
 	virtualQueues := m.virtualQueueManager.VirtualQueues()
 	for _, virtualQueue := range virtualQueues {
@@ -86,7 +105,7 @@ func (m *mitigatorImpl) handleQueuePendingTaskCount(alert Alert) {
 	}
 	if m.monitor.GetTotalPendingTaskCount() <= alert.AlertAttributesQueuePendingTaskCount.CriticalPendingTaskCount {
 		m.logger.Debug("mitigating queue alert, skip mitigation because the alert is no longer valid")
-		return
+		return mitigationSkipped
 	}
 	// Second, getting the stats of pending tasks. We need:
 	stats := m.collectPendingTaskStats()
@@ -128,6 +147,85 @@ func (m *mitigatorImpl) handleQueuePendingTaskCount(alert Alert) {
 		}
 		m.logger.Debug("mitigating queue alert, get queue state after mitigation", tag.Dynamic("queue-state", state))
 	}
+	return mitigationDomainIsolation
+}
+
+// handleStuckSlice moves the domain with the most pending tasks of the stuck slice to the next virtual queue,
+// so that the other domains in the slice are not blocked by it
+func (m *mitigatorImpl) handleStuckSlice(alert Alert) string {
+	attributes := alert.AlertAttributesStuckSlice
+	virtualQueues := m.virtualQueueManager.VirtualQueues()
+	queueID, _, ok := findVirtualQueueOfSlice(virtualQueues, attributes.Slice)
+	if !ok {
+		m.logger.Info("mitigating stuck slice alert, skip mitigation because the slice no longer exists")
+		return mitigationSkipped
+	}
+
+	isolatedDomains := m.isolatedDomains()
+	domainToIsolate := ""
+	maxPendingTaskCount := 0
+	for domainID, count := range attributes.Slice.PendingTaskStats().PendingTaskCountPerDomain {
+		if _, ok := isolatedDomains[domainID]; ok {
+			continue
+		}
+		if count > maxPendingTaskCount || (count == maxPendingTaskCount && domainID < domainToIsolate) {
+			domainToIsolate = domainID
+			maxPendingTaskCount = count
+		}
+	}
+	if maxPendingTaskCount == 0 {
+		m.logger.Info("mitigating stuck slice alert, skip mitigation because the slice has no pending task of domains that are not isolated", tag.VirtualQueueID(queueID))
+		return mitigationSkipped
+	}
+
+	m.logger.Info("mitigating stuck slice alert, isolating domain",
+		tag.VirtualQueueID(queueID),
+		tag.WorkflowDomainID(domainToIsolate),
+		tag.PendingTaskCount(maxPendingTaskCount),
+		tag.Dynamic("inclusive-min-task-key", attributes.InclusiveMinTaskKey),
+		tag.Dynamic("stuck-duration", attributes.StuckDuration),
+	)
+	m.processQueueSplitsAndClear(virtualQueues, map[VirtualSlice][]string{attributes.Slice: {domainToIsolate}})
+	return mitigationDomainIsolation
+}
+
+// handleSliceCount merges the adjacent slices of the virtual queue with too many slices
+func (m *mitigatorImpl) handleSliceCount(alert Alert) string {
+	attributes := alert.AlertAttributesSliceCount
+	virtualQueue, ok := m.virtualQueueManager.VirtualQueues()[attributes.VirtualQueueID]
+	if !ok {
+		m.logger.Info("mitigating slice count alert, skip mitigation because the virtual queue no longer exists", tag.VirtualQueueID(attributes.VirtualQueueID))
+		return mitigationSkipped
+	}
+
+	sliceCount := virtualQueue.CompactSlices()
+	m.logger.Info("mitigating slice count alert, merged slices",
+		tag.VirtualQueueID(attributes.VirtualQueueID),
+		tag.Dynamic("slice-count-before", attributes.CurrentSliceCount),
+		tag.Dynamic("slice-count-after", sliceCount),
+		tag.Dynamic("critical-slice-count", attributes.CriticalSliceCount),
+	)
+	return mitigationSliceMerge
+}
+
+// handleTaskLatency pauses the virtual queue of the slice with high task latency, so that no new task is loaded
+// while the task scheduler is working on the tasks that have already been loaded
+func (m *mitigatorImpl) handleTaskLatency(alert Alert) string {
+	attributes := alert.AlertAttributesTaskLatency
+	queueID, virtualQueue, ok := findVirtualQueueOfSlice(m.virtualQueueManager.VirtualQueues(), attributes.Slice)
+	if !ok {
+		m.logger.Info("mitigating task latency alert, skip mitigation because the slice no longer exists")
+		return mitigationSkipped
+	}
+
+	m.logger.Info("mitigating task latency alert, pausing virtual queue",
+		tag.VirtualQueueID(queueID),
+		tag.Dynamic("task-latency", attributes.CurrentTaskLatency),
+		tag.Dynamic("critical-task-latency", attributes.CriticalTaskLatency),
+		tag.Dynamic("pause-duration", taskLatencyThrottleDuration),
+	)
+	virtualQueue.Pause(taskLatencyThrottleDuration)
+	return mitigationThrottledPause
 }
 
 // The stats of pending tasks are used to calculate the domains to clear. We need:
@@ -164,11 +262,19 @@ func (m *mitigatorImpl) collectPendingTaskStats() pendingTaskStats {
 func (m *mitigatorImpl) findDomainsToClear(stats pendingTaskStats, targetCount int) map[VirtualSlice][]string {
 	domainsToClear := make(map[VirtualSlice][]string)
 
+	// domains with an isolation rule already have a dedicated virtual queue, they are never moved
+	isolatedDomains := m.isolatedDomains()
+	candidates := make([]string, 0, len(stats.pendingTaskCountPerDomain))
+	for domain := range stats.pendingTaskCountPerDomain {
+		if _, ok := isolatedDomains[domain]; !ok {
+			candidates = append(candidates, domain)
+		}
+	}
 	pq := collection.NewPriorityQueue(
 		func(a, b string) bool {
 			return stats.pendingTaskCountPerDomain[a] > stats.pendingTaskCountPerDomain[b]
 		},
-		slices.Collect(maps.Keys(stats.pendingTaskCountPerDomain))...,
+		candidates...,
 	)
 
 	for stats.totalPendingTaskCount > targetCount && !pq.IsEmpty() {
@@ -197,9 +303,15 @@ func (m *mitigatorImpl) findDomainsToClear(stats pendingTaskStats, targetCount i
 }
 
 func (m *mitigatorImpl) processQueueSplitsAndClear(virtualQueues map[int64]VirtualQueue, domainsToClear map[VirtualSlice][]string) {
-	maxQueueID := m.options.MaxVirtualQueueCount() - 1
+	maxQueueID := int64(m.options.MaxVirtualQueueCount() - 1)
+	// the dedicated virtual queues of isolated domains are skipped when moving slices to the next virtual queue
+	reservedQueueIDs := isolatedDomainsPerQueue(m.isolationRules())
 	for queueID, vq := range virtualQueues {
-		if queueID >= int64(maxQueueID) {
+		nextQueueID := queueID + 1
+		for _, ok := reservedQueueIDs[nextQueueID]; ok; _, ok = reservedQueueIDs[nextQueueID] {
+			nextQueueID++
+		}
+		if nextQueueID > maxQueueID {
 			// Clear slices in the last queue
 			cleared := false
 			vq.ClearSlices(func(slice VirtualSlice) bool {
@@ -232,9 +344,38 @@ func (m *mitigatorImpl) processQueueSplitsAndClear(virtualQueues map[int64]Virtu
 		})
 
 		if len(slicesToMove) > 0 {
-			nextQueue := m.virtualQueueManager.GetOrCreateVirtualQueue(queueID + 1)
+			nextQueue := m.virtualQueueManager.GetOrCreateVirtualQueue(nextQueueID)
 			nextQueue.Pause(clearSliceThrottleDuration)
 			nextQueue.MergeSlices(slicesToMove...)
 		}
 	}
 }
+
+func (m *mitigatorImpl) isolationRules() []IsolationRule {
+	if m.options.IsolationRules == nil {
+		return nil
+	}
+	return m.options.IsolationRules()
+}
+
+func (m *mitigatorImpl) isolatedDomains() map[string]struct{} {
+	rules := m.isolationRules()
+	domains := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		domains[rule.DomainID] = struct{}{}
+	}
+	return domains
+}
+
+func findVirtualQueueOfSlice(virtualQueues map[int64]VirtualQueue, slice VirtualSlice) (int64, VirtualQueue, bool) {
+	for queueID, vq := range virtualQueues {
+		found := false
+		vq.IterateSlices(func(s VirtualSlice) {
+			found = found || s == slice
+		})
+		if found {
+			return queueID, vq, true
+		}
+	}
+	return 0, nil, false
+}
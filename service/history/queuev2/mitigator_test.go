@@ -2,6 +2,7 @@ package queuev2
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -44,9 +45,11 @@ func TestNewMitigator(t *testing.T) {
 
 	// Verify handlers are properly initialized
 	assert.NotNil(t, impl.handlers)
-	assert.Len(t, impl.handlers, 1)
-	_, exists := impl.handlers[AlertTypeQueuePendingTaskCount]
-	assert.True(t, exists)
+	assert.Len(t, impl.handlers, 4)
+	for _, alertType := range []AlertType{AlertTypeQueuePendingTaskCount, AlertTypeStuckSlice, AlertTypeSliceCount, AlertTypeTaskLatency} {
+		_, exists := impl.handlers[alertType]
+		assert.True(t, exists)
+	}
 }
 
 func TestMitigator_Mitigate_KnownAlertType(t *testing.T) {
@@ -71,8 +74,9 @@ func TestMitigator_Mitigate_KnownAlertType(t *testing.T) {
 	impl, ok := mitigator.(*mitigatorImpl)
 	require.True(t, ok)
 	handlerCalled := false
-	impl.handlers[AlertTypeQueuePendingTaskCount] = func(alert Alert) {
+	impl.handlers[AlertTypeQueuePendingTaskCount] = func(alert Alert) string {
 		handlerCalled = true
+		return mitigationDomainIsolation
 	}
 
 	alert := Alert{
@@ -399,9 +403,10 @@ func TestMitigator_collectPendingTaskStats(t *testing.T) {
 
 func TestMitigator_findDomainsToClear(t *testing.T) {
 	tests := []struct {
-		name        string
-		setupStats  func(*gomock.Controller) (pendingTaskStats, map[VirtualSlice][]string)
-		targetCount int
+		name           string
+		setupStats     func(*gomock.Controller) (pendingTaskStats, map[VirtualSlice][]string)
+		targetCount    int
+		isolationRules []IsolationRule
 	}{
 		{
 			name: "target count zero - clear everything",
@@ -436,6 +441,40 @@ func TestMitigator_findDomainsToClear(t *testing.T) {
 			},
 			targetCount: 0,
 		},
+		{
+			name: "isolated domains are not cleared",
+			setupStats: func(ctrl *gomock.Controller) (pendingTaskStats, map[VirtualSlice][]string) {
+				mockSlice1 := NewMockVirtualSlice(ctrl)
+				mockSlice2 := NewMockVirtualSlice(ctrl)
+				mockSlice3 := NewMockVirtualSlice(ctrl)
+				stats := pendingTaskStats{
+					totalPendingTaskCount: 142,
+					pendingTaskCountPerDomain: map[string]int{
+						"domain1": 35,
+						"domain2": 45,
+						"domain3": 62,
+					},
+					pendingTaskCountPerDomainPerSlice: map[VirtualSlice]map[string]int{
+						mockSlice1: {"domain1": 21, "domain2": 34, "domain3": 55},
+						mockSlice2: {"domain1": 13, "domain2": 8, "domain3": 5},
+						mockSlice3: {"domain1": 1, "domain2": 3, "domain3": 2},
+					},
+					slicesPerDomain: map[string][]VirtualSlice{
+						"domain1": {mockSlice1, mockSlice2, mockSlice3},
+						"domain2": {mockSlice2, mockSlice3, mockSlice1},
+						"domain3": {mockSlice3, mockSlice1, mockSlice2},
+					},
+				}
+				expectedResult := map[VirtualSlice][]string{
+					mockSlice1: {"domain1", "domain2"},
+					mockSlice2: {"domain2", "domain1"},
+					mockSlice3: {"domain2", "domain1"},
+				}
+				return stats, expectedResult
+			},
+			targetCount:    0,
+			isolationRules: []IsolationRule{{DomainID: "domain3", VirtualQueueID: 5}},
+		},
 	}
 
 	for _, tt := range tests {
@@ -446,6 +485,7 @@ func TestMitigator_findDomainsToClear(t *testing.T) {
 			metricsScope := metrics.NoopScope
 			options := &MitigatorOptions{
 				MaxVirtualQueueCount: dynamicproperties.GetIntPropertyFn(10),
+				IsolationRules:       func() []IsolationRule { return tt.isolationRules },
 			}
 
 			mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
@@ -733,3 +773,266 @@ func TestMitigator_processQueueSplitsAndClear(t *testing.T) {
 		})
 	}
 }
+
+func TestMitigator_handleStuckSlice(t *testing.T) {
+	tests := []struct {
+		name                 string
+		maxVirtualQueueCount int
+		isolationRules       []IsolationRule
+		setupMocks           func(*gomock.Controller, *MockVirtualQueueManager, *MockVirtualSlice)
+		expectedMitigation   string
+	}{
+		{
+			name: "slice no longer exists",
+			setupMocks: func(ctrl *gomock.Controller, manager *MockVirtualQueueManager, stuckSlice *MockVirtualSlice) {
+				vq := NewMockVirtualQueue(ctrl)
+				vq.EXPECT().IterateSlices(gomock.Any()).Do(func(f func(VirtualSlice)) {
+					f(NewMockVirtualSlice(ctrl))
+				})
+				manager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{rootQueueID: vq})
+			},
+			expectedMitigation: mitigationSkipped,
+		},
+		{
+			name: "slice has no pending task",
+			setupMocks: func(ctrl *gomock.Controller, manager *MockVirtualQueueManager, stuckSlice *MockVirtualSlice) {
+				vq := NewMockVirtualQueue(ctrl)
+				vq.EXPECT().IterateSlices(gomock.Any()).Do(func(f func(VirtualSlice)) {
+					f(stuckSlice)
+				})
+				manager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{rootQueueID: vq})
+				stuckSlice.EXPECT().PendingTaskStats().Return(PendingTaskStats{PendingTaskCountPerDomain: map[string]int{}})
+			},
+			expectedMitigation: mitigationSkipped,
+		},
+		{
+			name: "domain with the most pending tasks is isolated",
+			setupMocks: func(ctrl *gomock.Controller, manager *MockVirtualQueueManager, stuckSlice *MockVirtualSlice) {
+				vq := NewMockVirtualQueue(ctrl)
+				vq.EXPECT().IterateSlices(gomock.Any()).Do(func(f func(VirtualSlice)) {
+					f(stuckSlice)
+				})
+				manager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{rootQueueID: vq})
+				stuckSlice.EXPECT().PendingTaskStats().Return(PendingTaskStats{PendingTaskCountPerDomain: map[string]int{"domain1": 1, "domain2": 5, "domain3": 5}})
+
+				splitSlice := NewMockVirtualSlice(ctrl)
+				remainingSlice := NewMockVirtualSlice(ctrl)
+				stuckSlice.EXPECT().TrySplitByPredicate(NewDomainIDPredicate([]string{"domain2"}, false)).Return(splitSlice, remainingSlice, true)
+				splitSlice.EXPECT().Clear()
+				vq.EXPECT().SplitSlices(gomock.Any()).Do(func(f func(VirtualSlice) ([]VirtualSlice, bool)) {
+					remaining, split := f(stuckSlice)
+					assert.True(t, split)
+					assert.Equal(t, []VirtualSlice{remainingSlice}, remaining)
+				})
+
+				nextQueue := NewMockVirtualQueue(ctrl)
+				manager.EXPECT().GetOrCreateVirtualQueue(int64(rootQueueID + 1)).Return(nextQueue)
+				nextQueue.EXPECT().Pause(clearSliceThrottleDuration)
+				nextQueue.EXPECT().MergeSlices(splitSlice)
+			},
+			expectedMitigation: mitigationDomainIsolation,
+		},
+		{
+			name:           "slice only has pending tasks of isolated domains",
+			isolationRules: []IsolationRule{{DomainID: "domain2", VirtualQueueID: 3}},
+			setupMocks: func(ctrl *gomock.Controller, manager *MockVirtualQueueManager, stuckSlice *MockVirtualSlice) {
+				vq := NewMockVirtualQueue(ctrl)
+				vq.EXPECT().IterateSlices(gomock.Any()).Do(func(f func(VirtualSlice)) {
+					f(stuckSlice)
+				})
+				manager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{3: vq})
+				stuckSlice.EXPECT().PendingTaskStats().Return(PendingTaskStats{PendingTaskCountPerDomain: map[string]int{"domain2": 5}})
+			},
+			expectedMitigation: mitigationSkipped,
+		},
+		{
+			name:                 "isolated domain is skipped and the dedicated queue is not used as the next queue",
+			maxVirtualQueueCount: 3,
+			isolationRules:       []IsolationRule{{DomainID: "domain2", VirtualQueueID: 1}},
+			setupMocks: func(ctrl *gomock.Controller, manager *MockVirtualQueueManager, stuckSlice *MockVirtualSlice) {
+				vq := NewMockVirtualQueue(ctrl)
+				vq.EXPECT().IterateSlices(gomock.Any()).Do(func(f func(VirtualSlice)) {
+					f(stuckSlice)
+				})
+				manager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{rootQueueID: vq})
+				stuckSlice.EXPECT().PendingTaskStats().Return(PendingTaskStats{PendingTaskCountPerDomain: map[string]int{"domain1": 1, "domain2": 5}})
+
+				splitSlice := NewMockVirtualSlice(ctrl)
+				remainingSlice := NewMockVirtualSlice(ctrl)
+				stuckSlice.EXPECT().TrySplitByPredicate(NewDomainIDPredicate([]string{"domain1"}, false)).Return(splitSlice, remainingSlice, true)
+				splitSlice.EXPECT().Clear()
+				vq.EXPECT().SplitSlices(gomock.Any()).Do(func(f func(VirtualSlice) ([]VirtualSlice, bool)) {
+					remaining, split := f(stuckSlice)
+					assert.True(t, split)
+					assert.Equal(t, []VirtualSlice{remainingSlice}, remaining)
+				})
+
+				nextQueue := NewMockVirtualQueue(ctrl)
+				manager.EXPECT().GetOrCreateVirtualQueue(int64(2)).Return(nextQueue)
+				nextQueue.EXPECT().Pause(clearSliceThrottleDuration)
+				nextQueue.EXPECT().MergeSlices(splitSlice)
+			},
+			expectedMitigation: mitigationDomainIsolation,
+		},
+		{
+			name:           "slice is cleared in place when the only next queue is a dedicated queue",
+			isolationRules: []IsolationRule{{DomainID: "domain2", VirtualQueueID: 1}},
+			setupMocks: func(ctrl *gomock.Controller, manager *MockVirtualQueueManager, stuckSlice *MockVirtualSlice) {
+				vq := NewMockVirtualQueue(ctrl)
+				vq.EXPECT().IterateSlices(gomock.Any()).Do(func(f func(VirtualSlice)) {
+					f(stuckSlice)
+				})
+				manager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{rootQueueID: vq})
+				stuckSlice.EXPECT().PendingTaskStats().Return(PendingTaskStats{PendingTaskCountPerDomain: map[string]int{"domain1": 1, "domain2": 5}})
+
+				vq.EXPECT().ClearSlices(gomock.Any()).Do(func(f func(VirtualSlice) bool) {
+					assert.True(t, f(stuckSlice))
+				})
+				vq.EXPECT().Pause(clearSliceThrottleDuration)
+			},
+			expectedMitigation: mitigationDomainIsolation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+			stuckSlice := NewMockVirtualSlice(ctrl)
+			tt.setupMocks(ctrl, mockVirtualQueueManager, stuckSlice)
+
+			maxVirtualQueueCount := tt.maxVirtualQueueCount
+			if maxVirtualQueueCount == 0 {
+				maxVirtualQueueCount = 2
+			}
+			mitigator := &mitigatorImpl{
+				virtualQueueManager: mockVirtualQueueManager,
+				monitor:             NewMockMonitor(ctrl),
+				logger:              testlogger.New(t),
+				metricsScope:        metrics.NoopScope,
+				options: &MitigatorOptions{
+					MaxVirtualQueueCount: dynamicproperties.GetIntPropertyFn(maxVirtualQueueCount),
+					IsolationRules:       func() []IsolationRule { return tt.isolationRules },
+				},
+			}
+
+			mitigation := mitigator.handleStuckSlice(Alert{
+				AlertType: AlertTypeStuckSlice,
+				AlertAttributesStuckSlice: &AlertAttributesStuckSlice{
+					Slice:               stuckSlice,
+					InclusiveMinTaskKey: persistence.NewImmediateTaskKey(1),
+					StuckDuration:       time.Minute,
+				},
+			})
+			assert.Equal(t, tt.expectedMitigation, mitigation)
+		})
+	}
+}
+
+func TestMitigator_handleSliceCount(t *testing.T) {
+	tests := []struct {
+		name               string
+		setupMocks         func(*gomock.Controller, *MockVirtualQueueManager)
+		expectedMitigation string
+	}{
+		{
+			name: "virtual queue no longer exists",
+			setupMocks: func(ctrl *gomock.Controller, manager *MockVirtualQueueManager) {
+				manager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{})
+			},
+			expectedMitigation: mitigationSkipped,
+		},
+		{
+			name: "slices are merged",
+			setupMocks: func(ctrl *gomock.Controller, manager *MockVirtualQueueManager) {
+				vq := NewMockVirtualQueue(ctrl)
+				vq.EXPECT().CompactSlices().Return(3)
+				manager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{1: vq})
+			},
+			expectedMitigation: mitigationSliceMerge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+			tt.setupMocks(ctrl, mockVirtualQueueManager)
+
+			mitigator := &mitigatorImpl{
+				virtualQueueManager: mockVirtualQueueManager,
+				monitor:             NewMockMonitor(ctrl),
+				logger:              testlogger.New(t),
+				metricsScope:        metrics.NoopScope,
+				options:             &MitigatorOptions{},
+			}
+
+			mitigation := mitigator.handleSliceCount(Alert{
+				AlertType: AlertTypeSliceCount,
+				AlertAttributesSliceCount: &AlertAttributesSliceCount{
+					VirtualQueueID:     1,
+					CurrentSliceCount:  101,
+					CriticalSliceCount: 100,
+				},
+			})
+			assert.Equal(t, tt.expectedMitigation, mitigation)
+		})
+	}
+}
+
+func TestMitigator_handleTaskLatency(t *testing.T) {
+	tests := []struct {
+		name               string
+		setupMocks         func(*gomock.Controller, *MockVirtualQueueManager, *MockVirtualSlice)
+		expectedMitigation string
+	}{
+		{
+			name: "slice no longer exists",
+			setupMocks: func(ctrl *gomock.Controller, manager *MockVirtualQueueManager, slice *MockVirtualSlice) {
+				vq := NewMockVirtualQueue(ctrl)
+				vq.EXPECT().IterateSlices(gomock.Any())
+				manager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{rootQueueID: vq})
+			},
+			expectedMitigation: mitigationSkipped,
+		},
+		{
+			name: "virtual queue of the slice is paused",
+			setupMocks: func(ctrl *gomock.Controller, manager *MockVirtualQueueManager, slice *MockVirtualSlice) {
+				vq := NewMockVirtualQueue(ctrl)
+				vq.EXPECT().IterateSlices(gomock.Any()).Do(func(f func(VirtualSlice)) {
+					f(slice)
+				})
+				vq.EXPECT().Pause(taskLatencyThrottleDuration)
+				manager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{1: vq})
+			},
+			expectedMitigation: mitigationThrottledPause,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+			slice := NewMockVirtualSlice(ctrl)
+			tt.setupMocks(ctrl, mockVirtualQueueManager, slice)
+
+			mitigator := &mitigatorImpl{
+				virtualQueueManager: mockVirtualQueueManager,
+				monitor:             NewMockMonitor(ctrl),
+				logger:              testlogger.New(t),
+				metricsScope:        metrics.NoopScope,
+				options:             &MitigatorOptions{},
+			}
+
+			mitigation := mitigator.handleTaskLatency(Alert{
+				AlertType: AlertTypeTaskLatency,
+				AlertAttributesTaskLatency: &AlertAttributesTaskLatency{
+					Slice:               slice,
+					CurrentTaskLatency:  time.Minute,
+					CriticalTaskLatency: time.Second,
+				},
+			})
+			assert.Equal(t, tt.expectedMitigation, mitigation)
+		})
+	}
+}
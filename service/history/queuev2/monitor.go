@@ -3,7 +3,9 @@ package queuev2
 
 import (
 	"sync"
+	"time"
 
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/persistence"
 )
@@ -15,6 +17,11 @@ type (
 		GetTotalPendingTaskCount() int
 		GetSlicePendingTaskCount(VirtualSlice) int
 		SetSlicePendingTaskCount(VirtualSlice, int)
+		// SetSliceProgress records the inclusive min task key (the ack level) and the oldest pending task time
+		// of a slice, which are used to detect stuck slices and high task latency
+		SetSliceProgress(VirtualSlice, persistence.HistoryTaskKey, time.Time)
+		// SetVirtualQueueSliceCount records the number of slices in a virtual queue
+		SetVirtualQueueSliceCount(int64, int)
 		RemoveSlice(VirtualSlice)
 		ResolveAlert(AlertType)
 	}
@@ -22,29 +29,47 @@ type (
 	MonitorOptions struct {
 		EnablePendingTaskCountAlert func() bool
 		CriticalPendingTaskCount    dynamicproperties.IntPropertyFn
+		// the following alerts are disabled if the value is not positive
+		StuckSliceDeadline  dynamicproperties.DurationPropertyFn
+		CriticalSliceCount  dynamicproperties.IntPropertyFn
+		CriticalTaskLatency dynamicproperties.DurationPropertyFn
 	}
 
 	monitorImpl struct {
 		sync.Mutex
 
-		category persistence.HistoryTaskCategory
-		options  *MonitorOptions
+		category   persistence.HistoryTaskCategory
+		timeSource clock.TimeSource
+		options    *MonitorOptions
 
 		subscriber            chan<- *Alert
 		pendingAlerts         map[AlertType]struct{}
 		totalPendingTaskCount int
 		slicePendingTaskCount map[VirtualSlice]int
+		sliceProgress         map[VirtualSlice]*sliceProgress
+	}
+
+	// sliceProgress tracks the ack level of a slice rather than its read level. The read level keeps moving
+	// while new tasks are loaded behind a task that never completes, and it stops on its own once all tasks
+	// of the slice are loaded or loading is throttled by the pending task count, which has its own alert.
+	// Only a task that doesn't complete holds back the ack level, and that is what the mitigation of the
+	// stuck slice alert addresses by moving the domain with the most pending tasks out of the slice.
+	sliceProgress struct {
+		inclusiveMinTaskKey persistence.HistoryTaskKey
+		lastAdvancedTime    time.Time
 	}
 )
 
-func NewMonitor(category persistence.HistoryTaskCategory, options *MonitorOptions) Monitor {
+func NewMonitor(category persistence.HistoryTaskCategory, timeSource clock.TimeSource, options *MonitorOptions) Monitor {
 	return &monitorImpl{
-		category: category,
-		options:  options,
+		category:   category,
+		timeSource: timeSource,
+		options:    options,
 
 		pendingAlerts:         make(map[AlertType]struct{}),
 		totalPendingTaskCount: 0,
 		slicePendingTaskCount: make(map[VirtualSlice]int),
+		sliceProgress:         make(map[VirtualSlice]*sliceProgress),
 	}
 }
 
@@ -93,6 +118,66 @@ func (m *monitorImpl) SetSlicePendingTaskCount(slice VirtualSlice, count int) {
 	}
 }
 
+func (m *monitorImpl) SetSliceProgress(slice VirtualSlice, inclusiveMinTaskKey persistence.HistoryTaskKey, oldestPendingTaskTime time.Time) {
+	m.Lock()
+	defer m.Unlock()
+
+	now := m.timeSource.Now()
+	progress, ok := m.sliceProgress[slice]
+	// a slice without pending tasks is not waiting for anything, so it's not considered stuck
+	if !ok || progress.inclusiveMinTaskKey.Compare(inclusiveMinTaskKey) != 0 || m.slicePendingTaskCount[slice] == 0 {
+		progress = &sliceProgress{
+			inclusiveMinTaskKey: inclusiveMinTaskKey,
+			lastAdvancedTime:    now,
+		}
+		m.sliceProgress[slice] = progress
+	}
+
+	stuckSliceDeadline := m.options.StuckSliceDeadline()
+	if stuckDuration := now.Sub(progress.lastAdvancedTime); stuckSliceDeadline > 0 && stuckDuration > stuckSliceDeadline {
+		m.sendAlertLocked(&Alert{
+			AlertType: AlertTypeStuckSlice,
+			AlertAttributesStuckSlice: &AlertAttributesStuckSlice{
+				Slice:               slice,
+				InclusiveMinTaskKey: inclusiveMinTaskKey,
+				StuckDuration:       stuckDuration,
+			},
+		})
+	}
+
+	if oldestPendingTaskTime.IsZero() {
+		return
+	}
+	criticalTaskLatency := m.options.CriticalTaskLatency()
+	if taskLatency := now.Sub(oldestPendingTaskTime); criticalTaskLatency > 0 && taskLatency > criticalTaskLatency {
+		m.sendAlertLocked(&Alert{
+			AlertType: AlertTypeTaskLatency,
+			AlertAttributesTaskLatency: &AlertAttributesTaskLatency{
+				Slice:               slice,
+				CurrentTaskLatency:  taskLatency,
+				CriticalTaskLatency: criticalTaskLatency,
+			},
+		})
+	}
+}
+
+func (m *monitorImpl) SetVirtualQueueSliceCount(queueID int64, count int) {
+	m.Lock()
+	defer m.Unlock()
+
+	criticalSliceCount := m.options.CriticalSliceCount()
+	if criticalSliceCount > 0 && count > criticalSliceCount {
+		m.sendAlertLocked(&Alert{
+			AlertType: AlertTypeSliceCount,
+			AlertAttributesSliceCount: &AlertAttributesSliceCount{
+				VirtualQueueID:     queueID,
+				CurrentSliceCount:  count,
+				CriticalSliceCount: criticalSliceCount,
+			},
+		})
+	}
+}
+
 func (m *monitorImpl) RemoveSlice(slice VirtualSlice) {
 	m.Lock()
 	defer m.Unlock()
@@ -101,6 +186,7 @@ func (m *monitorImpl) RemoveSlice(slice VirtualSlice) {
 		m.totalPendingTaskCount -= currentSliceCount
 		delete(m.slicePendingTaskCount, slice)
 	}
+	delete(m.sliceProgress, slice)
 }
 
 func (m *monitorImpl) ResolveAlert(alertType AlertType) {
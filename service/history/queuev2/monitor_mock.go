@@ -11,8 +11,11 @@ package queuev2
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
+
+	persistence "github.com/uber/cadence/common/persistence"
 )
 
 // MockMonitor is a mock of Monitor interface.
@@ -103,6 +106,30 @@ func (mr *MockMonitorMockRecorder) SetSlicePendingTaskCount(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSlicePendingTaskCount", reflect.TypeOf((*MockMonitor)(nil).SetSlicePendingTaskCount), arg0, arg1)
 }
 
+// SetSliceProgress mocks base method.
+func (m *MockMonitor) SetSliceProgress(arg0 VirtualSlice, arg1 persistence.HistoryTaskKey, arg2 time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSliceProgress", arg0, arg1, arg2)
+}
+
+// SetSliceProgress indicates an expected call of SetSliceProgress.
+func (mr *MockMonitorMockRecorder) SetSliceProgress(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSliceProgress", reflect.TypeOf((*MockMonitor)(nil).SetSliceProgress), arg0, arg1, arg2)
+}
+
+// SetVirtualQueueSliceCount mocks base method.
+func (m *MockMonitor) SetVirtualQueueSliceCount(arg0 int64, arg1 int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetVirtualQueueSliceCount", arg0, arg1)
+}
+
+// SetVirtualQueueSliceCount indicates an expected call of SetVirtualQueueSliceCount.
+func (mr *MockMonitorMockRecorder) SetVirtualQueueSliceCount(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVirtualQueueSliceCount", reflect.TypeOf((*MockMonitor)(nil).SetVirtualQueueSliceCount), arg0, arg1)
+}
+
 // Subscribe mocks base method.
 func (m *MockMonitor) Subscribe(arg0 chan<- *Alert) {
 	m.ctrl.T.Helper()
@@ -2,15 +2,17 @@ package queuev2
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/persistence"
 )
 
 func TestMonitorPendingTaskCount(t *testing.T) {
-	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, &MonitorOptions{
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, clock.NewMockedTimeSource(), &MonitorOptions{
 		CriticalPendingTaskCount:    dynamicproperties.GetIntPropertyFn(100),
 		EnablePendingTaskCountAlert: func() bool { return true },
 	})
@@ -68,8 +70,103 @@ func TestMonitorPendingTaskCount(t *testing.T) {
 	assert.True(t, ok)
 }
 
+func TestMonitorStuckSlice(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, timeSource, &MonitorOptions{
+		StuckSliceDeadline:  dynamicproperties.GetDurationPropertyFn(time.Minute),
+		CriticalTaskLatency: dynamicproperties.GetDurationPropertyFn(0),
+	})
+	alertCh := make(chan *Alert, alertChSize)
+	monitor.Subscribe(alertCh)
+
+	slice := &virtualSliceImpl{}
+	monitor.SetSlicePendingTaskCount(slice, 10)
+	monitor.SetSliceProgress(slice, persistence.NewImmediateTaskKey(1), time.Time{})
+
+	// read level advanced, no alert
+	timeSource.Advance(time.Minute * 2)
+	monitor.SetSliceProgress(slice, persistence.NewImmediateTaskKey(2), time.Time{})
+	assert.Empty(t, alertCh)
+
+	// read level not advanced before the deadline, no alert
+	timeSource.Advance(time.Second * 30)
+	monitor.SetSliceProgress(slice, persistence.NewImmediateTaskKey(2), time.Time{})
+	assert.Empty(t, alertCh)
+
+	// read level not advanced after the deadline
+	timeSource.Advance(time.Minute)
+	monitor.SetSliceProgress(slice, persistence.NewImmediateTaskKey(2), time.Time{})
+	alert := <-alertCh
+	assert.Equal(t, &Alert{
+		AlertType: AlertTypeStuckSlice,
+		AlertAttributesStuckSlice: &AlertAttributesStuckSlice{
+			Slice:               slice,
+			InclusiveMinTaskKey: persistence.NewImmediateTaskKey(2),
+			StuckDuration:       time.Second * 90,
+		},
+	}, alert)
+
+	// a slice without pending tasks is never stuck
+	monitor.ResolveAlert(AlertTypeStuckSlice)
+	monitor.SetSlicePendingTaskCount(slice, 0)
+	timeSource.Advance(time.Minute * 2)
+	monitor.SetSliceProgress(slice, persistence.NewImmediateTaskKey(2), time.Time{})
+	assert.Empty(t, alertCh)
+
+	monitor.RemoveSlice(slice)
+	assert.Empty(t, monitor.(*monitorImpl).sliceProgress)
+}
+
+func TestMonitorTaskLatency(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, timeSource, &MonitorOptions{
+		StuckSliceDeadline:  dynamicproperties.GetDurationPropertyFn(0),
+		CriticalTaskLatency: dynamicproperties.GetDurationPropertyFn(time.Minute),
+	})
+	alertCh := make(chan *Alert, alertChSize)
+	monitor.Subscribe(alertCh)
+
+	slice := &virtualSliceImpl{}
+	monitor.SetSlicePendingTaskCount(slice, 10)
+	monitor.SetSliceProgress(slice, persistence.NewImmediateTaskKey(1), timeSource.Now().Add(-time.Second*30))
+	assert.Empty(t, alertCh)
+
+	monitor.SetSliceProgress(slice, persistence.NewImmediateTaskKey(1), timeSource.Now().Add(-time.Minute*2))
+	alert := <-alertCh
+	assert.Equal(t, &Alert{
+		AlertType: AlertTypeTaskLatency,
+		AlertAttributesTaskLatency: &AlertAttributesTaskLatency{
+			Slice:               slice,
+			CurrentTaskLatency:  time.Minute * 2,
+			CriticalTaskLatency: time.Minute,
+		},
+	}, alert)
+}
+
+func TestMonitorSliceCount(t *testing.T) {
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, clock.NewMockedTimeSource(), &MonitorOptions{
+		CriticalSliceCount: dynamicproperties.GetIntPropertyFn(10),
+	})
+	alertCh := make(chan *Alert, alertChSize)
+	monitor.Subscribe(alertCh)
+
+	monitor.SetVirtualQueueSliceCount(1, 10)
+	assert.Empty(t, alertCh)
+
+	monitor.SetVirtualQueueSliceCount(1, 11)
+	alert := <-alertCh
+	assert.Equal(t, &Alert{
+		AlertType: AlertTypeSliceCount,
+		AlertAttributesSliceCount: &AlertAttributesSliceCount{
+			VirtualQueueID:     1,
+			CurrentSliceCount:  11,
+			CriticalSliceCount: 10,
+		},
+	}, alert)
+}
+
 func TestMonitorSubscribeAndUnsubscribe(t *testing.T) {
-	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, &MonitorOptions{})
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, clock.NewMockedTimeSource(), &MonitorOptions{})
 
 	alertCh := make(chan *Alert, alertChSize)
 	monitor.Subscribe(alertCh)
@@ -82,7 +179,7 @@ func TestMonitorSubscribeAndUnsubscribe(t *testing.T) {
 }
 
 func TestMonitorResolveAlert(t *testing.T) {
-	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, &MonitorOptions{})
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, clock.NewMockedTimeSource(), &MonitorOptions{})
 
 	monitor.(*monitorImpl).pendingAlerts[AlertTypeQueuePendingTaskCount] = struct{}{}
 	assert.Equal(t, 1, len(monitor.(*monitorImpl).pendingAlerts))
@@ -26,6 +26,7 @@ package queuev2
 
 import (
 	"maps"
+	"strings"
 
 	"github.com/uber/cadence/common/persistence"
 )
@@ -46,6 +47,41 @@ type (
 		isExclusive bool
 	}
 
+	taskTypePredicate struct {
+		taskTypes   map[int]struct{}
+		isExclusive bool
+	}
+
+	taskListPredicate struct {
+		taskLists   map[string]struct{}
+		isExclusive bool
+	}
+
+	workflowIDPredicate struct {
+		workflowIDs map[string]struct{}
+		isExclusive bool
+	}
+
+	workflowIDPrefixPredicate struct {
+		prefixes    map[string]struct{}
+		isExclusive bool
+	}
+
+	// andPredicate is satisfied by a task that satisfies all of its predicates
+	andPredicate struct {
+		predicates []Predicate
+	}
+
+	// orPredicate is satisfied by a task that satisfies any of its predicates
+	orPredicate struct {
+		predicates []Predicate
+	}
+
+	// notPredicate is satisfied by a task that does not satisfy its predicate
+	notPredicate struct {
+		predicate Predicate
+	}
+
 	universalPredicate struct{}
 
 	emptyPredicate struct{}
@@ -114,3 +150,225 @@ func (p *domainIDPredicate) Equals(other Predicate) bool {
 	}
 	return p.isExclusive == o.isExclusive && maps.Equal(p.domainIDs, o.domainIDs)
 }
+
+func NewTaskTypePredicate(taskTypes []int, isExclusive bool) Predicate {
+	taskTypeSet := make(map[int]struct{})
+	for _, taskType := range taskTypes {
+		taskTypeSet[taskType] = struct{}{}
+	}
+	return &taskTypePredicate{
+		taskTypes:   taskTypeSet,
+		isExclusive: isExclusive,
+	}
+}
+
+func (p *taskTypePredicate) IsEmpty() bool {
+	return len(p.taskTypes) == 0 && !p.isExclusive
+}
+
+func (p *taskTypePredicate) Check(task persistence.Task) bool {
+	if _, ok := p.taskTypes[task.GetTaskType()]; ok {
+		return !p.isExclusive
+	}
+	return p.isExclusive
+}
+
+func (p *taskTypePredicate) Equals(other Predicate) bool {
+	o, ok := other.(*taskTypePredicate)
+	if !ok {
+		return false
+	}
+	return p.isExclusive == o.isExclusive && maps.Equal(p.taskTypes, o.taskTypes)
+}
+
+// NewTaskListPredicate returns a predicate on the task list a task was originally scheduled on,
+// so that sticky decision tasks are matched by their normal task list
+func NewTaskListPredicate(taskLists []string, isExclusive bool) Predicate {
+	taskListSet := make(map[string]struct{})
+	for _, taskList := range taskLists {
+		taskListSet[taskList] = struct{}{}
+	}
+	return &taskListPredicate{
+		taskLists:   taskListSet,
+		isExclusive: isExclusive,
+	}
+}
+
+func (p *taskListPredicate) IsEmpty() bool {
+	return len(p.taskLists) == 0 && !p.isExclusive
+}
+
+func (p *taskListPredicate) Check(task persistence.Task) bool {
+	if _, ok := p.taskLists[task.GetOriginalTaskList()]; ok {
+		return !p.isExclusive
+	}
+	return p.isExclusive
+}
+
+func (p *taskListPredicate) Equals(other Predicate) bool {
+	o, ok := other.(*taskListPredicate)
+	if !ok {
+		return false
+	}
+	return p.isExclusive == o.isExclusive && maps.Equal(p.taskLists, o.taskLists)
+}
+
+func NewWorkflowIDPredicate(workflowIDs []string, isExclusive bool) Predicate {
+	workflowIDSet := make(map[string]struct{})
+	for _, workflowID := range workflowIDs {
+		workflowIDSet[workflowID] = struct{}{}
+	}
+	return &workflowIDPredicate{
+		workflowIDs: workflowIDSet,
+		isExclusive: isExclusive,
+	}
+}
+
+func (p *workflowIDPredicate) IsEmpty() bool {
+	return len(p.workflowIDs) == 0 && !p.isExclusive
+}
+
+func (p *workflowIDPredicate) Check(task persistence.Task) bool {
+	if _, ok := p.workflowIDs[task.GetWorkflowID()]; ok {
+		return !p.isExclusive
+	}
+	return p.isExclusive
+}
+
+func (p *workflowIDPredicate) Equals(other Predicate) bool {
+	o, ok := other.(*workflowIDPredicate)
+	if !ok {
+		return false
+	}
+	return p.isExclusive == o.isExclusive && maps.Equal(p.workflowIDs, o.workflowIDs)
+}
+
+func NewWorkflowIDPrefixPredicate(prefixes []string, isExclusive bool) Predicate {
+	prefixSet := make(map[string]struct{})
+	for _, prefix := range prefixes {
+		prefixSet[prefix] = struct{}{}
+	}
+	return &workflowIDPrefixPredicate{
+		prefixes:    prefixSet,
+		isExclusive: isExclusive,
+	}
+}
+
+func (p *workflowIDPrefixPredicate) IsEmpty() bool {
+	if p.isExclusive {
+		// an empty prefix matches every workflow ID, so excluding it excludes everything
+		_, ok := p.prefixes[""]
+		return ok
+	}
+	return len(p.prefixes) == 0
+}
+
+func (p *workflowIDPrefixPredicate) Check(task persistence.Task) bool {
+	workflowID := task.GetWorkflowID()
+	for prefix := range p.prefixes {
+		if strings.HasPrefix(workflowID, prefix) {
+			return !p.isExclusive
+		}
+	}
+	return p.isExclusive
+}
+
+func (p *workflowIDPrefixPredicate) Equals(other Predicate) bool {
+	o, ok := other.(*workflowIDPrefixPredicate)
+	if !ok {
+		return false
+	}
+	return p.isExclusive == o.isExclusive && maps.Equal(p.prefixes, o.prefixes)
+}
+
+func (p *andPredicate) IsEmpty() bool {
+	for _, predicate := range p.predicates {
+		if predicate.IsEmpty() {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *andPredicate) Check(task persistence.Task) bool {
+	for _, predicate := range p.predicates {
+		if !predicate.Check(task) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *andPredicate) Equals(other Predicate) bool {
+	o, ok := other.(*andPredicate)
+	if !ok {
+		return false
+	}
+	return equalPredicateSets(p.predicates, o.predicates)
+}
+
+func (p *orPredicate) IsEmpty() bool {
+	for _, predicate := range p.predicates {
+		if !predicate.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *orPredicate) Check(task persistence.Task) bool {
+	for _, predicate := range p.predicates {
+		if predicate.Check(task) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *orPredicate) Equals(other Predicate) bool {
+	o, ok := other.(*orPredicate)
+	if !ok {
+		return false
+	}
+	return equalPredicateSets(p.predicates, o.predicates)
+}
+
+func (p *notPredicate) IsEmpty() bool {
+	_, ok := p.predicate.(*universalPredicate)
+	return ok
+}
+
+func (p *notPredicate) Check(task persistence.Task) bool {
+	return !p.predicate.Check(task)
+}
+
+func (p *notPredicate) Equals(other Predicate) bool {
+	o, ok := other.(*notPredicate)
+	if !ok {
+		return false
+	}
+	return p.predicate.Equals(o.predicate)
+}
+
+// equalPredicateSets returns true if both lists contain the same predicates regardless of order,
+// the lists are expected to be free of duplicates
+func equalPredicateSets(predicates1, predicates2 []Predicate) bool {
+	if len(predicates1) != len(predicates2) {
+		return false
+	}
+	for _, p1 := range predicates1 {
+		if !containsPredicate(predicates2, p1) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsPredicate(predicates []Predicate, predicate Predicate) bool {
+	for _, p := range predicates {
+		if p.Equals(predicate) {
+			return true
+		}
+	}
+	return false
+}
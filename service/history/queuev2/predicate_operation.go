@@ -17,166 +17,456 @@ func Not(predicate Predicate) Predicate {
 			domainIDs:   p.domainIDs,
 			isExclusive: isExclusive,
 		}
+	case *taskTypePredicate:
+		return newTaskTypePredicateFromSet(p.taskTypes, !p.isExclusive)
+	case *taskListPredicate:
+		return newTaskListPredicateFromSet(p.taskLists, !p.isExclusive)
+	case *workflowIDPredicate:
+		return newWorkflowIDPredicateFromSet(p.workflowIDs, !p.isExclusive)
+	case *workflowIDPrefixPredicate:
+		return &workflowIDPrefixPredicate{
+			prefixes:    p.prefixes,
+			isExclusive: !p.isExclusive,
+		}
+	case *notPredicate:
+		return p.predicate
+	case *andPredicate, *orPredicate:
+		return &notPredicate{predicate: p}
 	default:
 		panic(fmt.Sprintf("unknown predicate type: %T", p))
 	}
 }
 
 func And(p1, p2 Predicate) Predicate {
-	switch p1 := p1.(type) {
+	switch p1.(type) {
 	case *universalPredicate:
 		return p2
 	case *emptyPredicate:
 		return p1
-	case *domainIDPredicate:
-		switch p2 := p2.(type) {
-		case *universalPredicate:
-			return p1
-		case *emptyPredicate:
-			return p2
-		case *domainIDPredicate:
-			if p1.isExclusive {
-				if p2.isExclusive {
-					domainIDs := unionStringSet(p1.domainIDs, p2.domainIDs)
-					if len(domainIDs) == 0 {
-						return &emptyPredicate{}
-					}
-					return &domainIDPredicate{
-						domainIDs:   domainIDs,
-						isExclusive: true,
-					}
-				}
-				domainIDs := map[string]struct{}{}
-				for domainID := range p2.domainIDs {
-					if _, ok := p1.domainIDs[domainID]; !ok {
-						domainIDs[domainID] = struct{}{}
-					}
-				}
-				if len(domainIDs) == 0 {
-					return &emptyPredicate{}
-				}
-				return &domainIDPredicate{
-					domainIDs:   domainIDs,
-					isExclusive: false,
-				}
-			}
-			if p2.isExclusive {
-				domainIDs := map[string]struct{}{}
-				for domainID := range p1.domainIDs {
-					if _, ok := p2.domainIDs[domainID]; !ok {
-						domainIDs[domainID] = struct{}{}
-					}
-				}
-				if len(domainIDs) == 0 {
-					return &emptyPredicate{}
-				}
-				return &domainIDPredicate{
-					domainIDs:   domainIDs,
-					isExclusive: false,
-				}
-			}
-			domainIDs := intersectStringSet(p1.domainIDs, p2.domainIDs)
-			if len(domainIDs) == 0 {
-				return &emptyPredicate{}
+	}
+	switch p2.(type) {
+	case *universalPredicate:
+		return p1
+	case *emptyPredicate:
+		return p2
+	}
+	if result, ok := andSamePredicateType(p1, p2); ok {
+		return result
+	}
+	if p1.Equals(Not(p2)) {
+		return &emptyPredicate{}
+	}
+	// (c or x) and (c or y) = c or (x and y)
+	if common, rest1, rest2 := factorPredicates(flattenOr(p1), flattenOr(p2)); len(common) > 0 {
+		return Or(orAll(common), And(orAll(rest1), orAll(rest2)))
+	}
+
+	// the predicates can't be simplified into one, so compose them, merging
+	// the predicates of the same type and dropping duplicates along the way
+	var predicates []Predicate
+	for _, p := range append(flattenAnd(p1), flattenAnd(p2)...) {
+		merged := false
+		for i, existing := range predicates {
+			result, ok := andSamePredicateType(existing, p)
+			if !ok {
+				continue
 			}
-			return &domainIDPredicate{
-				domainIDs:   domainIDs,
-				isExclusive: false,
+			switch result.(type) {
+			case *emptyPredicate:
+				return result
+			case *universalPredicate:
+				predicates = append(predicates[:i], predicates[i+1:]...)
+			default:
+				predicates[i] = result
 			}
-
-		default:
-			panic(fmt.Sprintf("unknown predicate type: %T", p2))
+			merged = true
+			break
+		}
+		if merged || containsPredicate(predicates, p) {
+			continue
+		}
+		if containsPredicate(predicates, Not(p)) {
+			return &emptyPredicate{}
 		}
+		predicates = append(predicates, p)
+	}
+	switch len(predicates) {
+	case 0:
+		return &universalPredicate{}
+	case 1:
+		return predicates[0]
 	default:
-		panic(fmt.Sprintf("unknown predicate type: %T", p1))
+		return &andPredicate{predicates: predicates}
 	}
 }
 
 func Or(p1, p2 Predicate) Predicate {
-	switch p1 := p1.(type) {
+	switch p1.(type) {
 	case *universalPredicate:
 		return p1
 	case *emptyPredicate:
 		return p2
-	case *domainIDPredicate:
-		switch p2 := p2.(type) {
-		case *universalPredicate:
-			return p2
-		case *emptyPredicate:
-			return p1
-		case *domainIDPredicate:
-			if p1.isExclusive {
-				if p2.isExclusive {
-					domainIDs := intersectStringSet(p1.domainIDs, p2.domainIDs)
-					if len(domainIDs) == 0 {
-						return &universalPredicate{}
-					}
-					return &domainIDPredicate{
-						domainIDs:   domainIDs,
-						isExclusive: true,
-					}
-				}
-				domainIDs := diffStringSet(p1.domainIDs, p2.domainIDs)
-				if len(domainIDs) == 0 {
-					return &universalPredicate{}
-				}
-				return &domainIDPredicate{
-					domainIDs:   domainIDs,
-					isExclusive: true,
-				}
+	}
+	switch p2.(type) {
+	case *universalPredicate:
+		return p2
+	case *emptyPredicate:
+		return p1
+	}
+	if result, ok := orSamePredicateType(p1, p2); ok {
+		return result
+	}
+	if p1.Equals(Not(p2)) {
+		return &universalPredicate{}
+	}
+	// (c and x) or (c and y) = c and (x or y), this is what merges back the two halves of a split predicate
+	if common, rest1, rest2 := factorPredicates(flattenAnd(p1), flattenAnd(p2)); len(common) > 0 {
+		return And(andAll(common), Or(andAll(rest1), andAll(rest2)))
+	}
+
+	// the predicates can't be simplified into one, so compose them, merging
+	// the predicates of the same type and dropping duplicates along the way
+	var predicates []Predicate
+	for _, p := range append(flattenOr(p1), flattenOr(p2)...) {
+		merged := false
+		for i, existing := range predicates {
+			result, ok := orSamePredicateType(existing, p)
+			if !ok {
+				continue
 			}
-			if p2.isExclusive {
-				domainIDs := diffStringSet(p2.domainIDs, p1.domainIDs)
-				if len(domainIDs) == 0 {
-					return &universalPredicate{}
-				}
-				return &domainIDPredicate{
-					domainIDs:   domainIDs,
-					isExclusive: true,
-				}
+			switch result.(type) {
+			case *universalPredicate:
+				return result
+			case *emptyPredicate:
+				predicates = append(predicates[:i], predicates[i+1:]...)
+			default:
+				predicates[i] = result
 			}
-			domainIDs := unionStringSet(p1.domainIDs, p2.domainIDs)
+			merged = true
+			break
+		}
+		if merged || containsPredicate(predicates, p) {
+			continue
+		}
+		if containsPredicate(predicates, Not(p)) {
+			return &universalPredicate{}
+		}
+		predicates = append(predicates, p)
+	}
+	switch len(predicates) {
+	case 0:
+		return &emptyPredicate{}
+	case 1:
+		return predicates[0]
+	default:
+		return &orPredicate{predicates: predicates}
+	}
+}
+
+// andSamePredicateType returns the conjunction of two predicates of the same set based type
+// as a single predicate, the second return value is false if the predicates can't be combined
+func andSamePredicateType(p1, p2 Predicate) (Predicate, bool) {
+	switch p1 := p1.(type) {
+	case *domainIDPredicate:
+		if p2, ok := p2.(*domainIDPredicate); ok {
+			return andDomainIDPredicates(p1, p2), true
+		}
+	case *taskTypePredicate:
+		if p2, ok := p2.(*taskTypePredicate); ok {
+			taskTypes, isExclusive := andSets(p1.taskTypes, p1.isExclusive, p2.taskTypes, p2.isExclusive)
+			return newTaskTypePredicateFromSet(taskTypes, isExclusive), true
+		}
+	case *taskListPredicate:
+		if p2, ok := p2.(*taskListPredicate); ok {
+			taskLists, isExclusive := andSets(p1.taskLists, p1.isExclusive, p2.taskLists, p2.isExclusive)
+			return newTaskListPredicateFromSet(taskLists, isExclusive), true
+		}
+	case *workflowIDPredicate:
+		if p2, ok := p2.(*workflowIDPredicate); ok {
+			workflowIDs, isExclusive := andSets(p1.workflowIDs, p1.isExclusive, p2.workflowIDs, p2.isExclusive)
+			return newWorkflowIDPredicateFromSet(workflowIDs, isExclusive), true
+		}
+	case *workflowIDPrefixPredicate:
+		// only exclusions can be combined, excluding a prefix from matches is not a prefix match anymore
+		if p2, ok := p2.(*workflowIDPrefixPredicate); ok && p1.isExclusive && p2.isExclusive {
+			return &workflowIDPrefixPredicate{
+				prefixes:    unionSet(p1.prefixes, p2.prefixes),
+				isExclusive: true,
+			}, true
+		}
+	}
+	return nil, false
+}
+
+// orSamePredicateType returns the disjunction of two predicates of the same set based type
+// as a single predicate, the second return value is false if the predicates can't be combined
+func orSamePredicateType(p1, p2 Predicate) (Predicate, bool) {
+	switch p1 := p1.(type) {
+	case *domainIDPredicate:
+		if p2, ok := p2.(*domainIDPredicate); ok {
+			return orDomainIDPredicates(p1, p2), true
+		}
+	case *taskTypePredicate:
+		if p2, ok := p2.(*taskTypePredicate); ok {
+			taskTypes, isExclusive := orSets(p1.taskTypes, p1.isExclusive, p2.taskTypes, p2.isExclusive)
+			return newTaskTypePredicateFromSet(taskTypes, isExclusive), true
+		}
+	case *taskListPredicate:
+		if p2, ok := p2.(*taskListPredicate); ok {
+			taskLists, isExclusive := orSets(p1.taskLists, p1.isExclusive, p2.taskLists, p2.isExclusive)
+			return newTaskListPredicateFromSet(taskLists, isExclusive), true
+		}
+	case *workflowIDPredicate:
+		if p2, ok := p2.(*workflowIDPredicate); ok {
+			workflowIDs, isExclusive := orSets(p1.workflowIDs, p1.isExclusive, p2.workflowIDs, p2.isExclusive)
+			return newWorkflowIDPredicateFromSet(workflowIDs, isExclusive), true
+		}
+	case *workflowIDPrefixPredicate:
+		// only inclusions can be combined, see andSamePredicateType
+		if p2, ok := p2.(*workflowIDPrefixPredicate); ok && !p1.isExclusive && !p2.isExclusive {
+			return &workflowIDPrefixPredicate{
+				prefixes:    unionSet(p1.prefixes, p2.prefixes),
+				isExclusive: false,
+			}, true
+		}
+	}
+	return nil, false
+}
+
+func andDomainIDPredicates(p1, p2 *domainIDPredicate) Predicate {
+	if p1.isExclusive {
+		if p2.isExclusive {
+			domainIDs := unionSet(p1.domainIDs, p2.domainIDs)
 			if len(domainIDs) == 0 {
 				return &emptyPredicate{}
 			}
 			return &domainIDPredicate{
 				domainIDs:   domainIDs,
-				isExclusive: false,
+				isExclusive: true,
 			}
-		default:
-			panic(fmt.Sprintf("unknown predicate type: %T", p2))
 		}
+		domainIDs := map[string]struct{}{}
+		for domainID := range p2.domainIDs {
+			if _, ok := p1.domainIDs[domainID]; !ok {
+				domainIDs[domainID] = struct{}{}
+			}
+		}
+		if len(domainIDs) == 0 {
+			return &emptyPredicate{}
+		}
+		return &domainIDPredicate{
+			domainIDs:   domainIDs,
+			isExclusive: false,
+		}
+	}
+	if p2.isExclusive {
+		domainIDs := map[string]struct{}{}
+		for domainID := range p1.domainIDs {
+			if _, ok := p2.domainIDs[domainID]; !ok {
+				domainIDs[domainID] = struct{}{}
+			}
+		}
+		if len(domainIDs) == 0 {
+			return &emptyPredicate{}
+		}
+		return &domainIDPredicate{
+			domainIDs:   domainIDs,
+			isExclusive: false,
+		}
+	}
+	domainIDs := intersectSet(p1.domainIDs, p2.domainIDs)
+	if len(domainIDs) == 0 {
+		return &emptyPredicate{}
+	}
+	return &domainIDPredicate{
+		domainIDs:   domainIDs,
+		isExclusive: false,
+	}
+}
+
+func orDomainIDPredicates(p1, p2 *domainIDPredicate) Predicate {
+	if p1.isExclusive {
+		if p2.isExclusive {
+			domainIDs := intersectSet(p1.domainIDs, p2.domainIDs)
+			if len(domainIDs) == 0 {
+				return &universalPredicate{}
+			}
+			return &domainIDPredicate{
+				domainIDs:   domainIDs,
+				isExclusive: true,
+			}
+		}
+		domainIDs := diffSet(p1.domainIDs, p2.domainIDs)
+		if len(domainIDs) == 0 {
+			return &universalPredicate{}
+		}
+		return &domainIDPredicate{
+			domainIDs:   domainIDs,
+			isExclusive: true,
+		}
+	}
+	if p2.isExclusive {
+		domainIDs := diffSet(p2.domainIDs, p1.domainIDs)
+		if len(domainIDs) == 0 {
+			return &universalPredicate{}
+		}
+		return &domainIDPredicate{
+			domainIDs:   domainIDs,
+			isExclusive: true,
+		}
+	}
+	domainIDs := unionSet(p1.domainIDs, p2.domainIDs)
+	if len(domainIDs) == 0 {
+		return &emptyPredicate{}
+	}
+	return &domainIDPredicate{
+		domainIDs:   domainIDs,
+		isExclusive: false,
+	}
+}
+
+func newTaskTypePredicateFromSet(taskTypes map[int]struct{}, isExclusive bool) Predicate {
+	if len(taskTypes) == 0 {
+		return emptySetPredicate(isExclusive)
+	}
+	return &taskTypePredicate{
+		taskTypes:   taskTypes,
+		isExclusive: isExclusive,
+	}
+}
+
+func newTaskListPredicateFromSet(taskLists map[string]struct{}, isExclusive bool) Predicate {
+	if len(taskLists) == 0 {
+		return emptySetPredicate(isExclusive)
+	}
+	return &taskListPredicate{
+		taskLists:   taskLists,
+		isExclusive: isExclusive,
+	}
+}
+
+func newWorkflowIDPredicateFromSet(workflowIDs map[string]struct{}, isExclusive bool) Predicate {
+	if len(workflowIDs) == 0 {
+		return emptySetPredicate(isExclusive)
+	}
+	return &workflowIDPredicate{
+		workflowIDs: workflowIDs,
+		isExclusive: isExclusive,
+	}
+}
+
+// emptySetPredicate returns the predicate equivalent to a set based predicate with an empty set
+func emptySetPredicate(isExclusive bool) Predicate {
+	if isExclusive {
+		return &universalPredicate{}
+	}
+	return &emptyPredicate{}
+}
+
+// factorPredicates splits the operands of two composite predicates into the operands
+// they have in common and the remaining operands of each of them
+func factorPredicates(predicates1, predicates2 []Predicate) ([]Predicate, []Predicate, []Predicate) {
+	var common, rest1, rest2 []Predicate
+	for _, p := range predicates1 {
+		if containsPredicate(predicates2, p) {
+			common = append(common, p)
+		} else {
+			rest1 = append(rest1, p)
+		}
+	}
+	for _, p := range predicates2 {
+		if !containsPredicate(common, p) {
+			rest2 = append(rest2, p)
+		}
+	}
+	return common, rest1, rest2
+}
+
+func andAll(predicates []Predicate) Predicate {
+	var result Predicate = &universalPredicate{}
+	for _, p := range predicates {
+		result = And(result, p)
+	}
+	return result
+}
+
+func orAll(predicates []Predicate) Predicate {
+	var result Predicate = &emptyPredicate{}
+	for _, p := range predicates {
+		result = Or(result, p)
+	}
+	return result
+}
+
+func flattenAnd(predicate Predicate) []Predicate {
+	if p, ok := predicate.(*andPredicate); ok {
+		return p.predicates
+	}
+	return []Predicate{predicate}
+}
+
+func flattenOr(predicate Predicate) []Predicate {
+	if p, ok := predicate.(*orPredicate); ok {
+		return p.predicates
+	}
+	return []Predicate{predicate}
+}
+
+// andSets returns the set matching the keys matched by both sets, each set
+// matches the keys it contains, or the keys it doesn't contain if it's exclusive
+func andSets[K comparable](set1 map[K]struct{}, isExclusive1 bool, set2 map[K]struct{}, isExclusive2 bool) (map[K]struct{}, bool) {
+	switch {
+	case isExclusive1 && isExclusive2:
+		return unionSet(set1, set2), true
+	case isExclusive1:
+		return diffSet(set2, set1), false
+	case isExclusive2:
+		return diffSet(set1, set2), false
+	default:
+		return intersectSet(set1, set2), false
+	}
+}
+
+// orSets returns the set matching the keys matched by either set, see andSets
+func orSets[K comparable](set1 map[K]struct{}, isExclusive1 bool, set2 map[K]struct{}, isExclusive2 bool) (map[K]struct{}, bool) {
+	switch {
+	case isExclusive1 && isExclusive2:
+		return intersectSet(set1, set2), true
+	case isExclusive1:
+		return diffSet(set1, set2), true
+	case isExclusive2:
+		return diffSet(set2, set1), true
 	default:
-		panic(fmt.Sprintf("unknown predicate type: %T", p1))
+		return unionSet(set1, set2), false
 	}
 }
 
-func unionStringSet(set1, set2 map[string]struct{}) map[string]struct{} {
-	result := make(map[string]struct{})
-	for domainID := range set1 {
-		result[domainID] = struct{}{}
+func unionSet[K comparable](set1, set2 map[K]struct{}) map[K]struct{} {
+	result := make(map[K]struct{})
+	for key := range set1 {
+		result[key] = struct{}{}
 	}
-	for domainID := range set2 {
-		result[domainID] = struct{}{}
+	for key := range set2 {
+		result[key] = struct{}{}
 	}
 	return result
 }
 
-func intersectStringSet(set1, set2 map[string]struct{}) map[string]struct{} {
-	result := make(map[string]struct{})
-	for domainID := range set1 {
-		if _, ok := set2[domainID]; ok {
-			result[domainID] = struct{}{}
+func intersectSet[K comparable](set1, set2 map[K]struct{}) map[K]struct{} {
+	result := make(map[K]struct{})
+	for key := range set1 {
+		if _, ok := set2[key]; ok {
+			result[key] = struct{}{}
 		}
 	}
 	return result
 }
 
-func diffStringSet(set1, set2 map[string]struct{}) map[string]struct{} {
-	result := make(map[string]struct{})
-	for domainID := range set1 {
-		if _, ok := set2[domainID]; !ok {
-			result[domainID] = struct{}{}
+func diffSet[K comparable](set1, set2 map[K]struct{}) map[K]struct{} {
+	result := make(map[K]struct{})
+	for key := range set1 {
+		if _, ok := set2[key]; !ok {
+			result[key] = struct{}{}
 		}
 	}
 	return result
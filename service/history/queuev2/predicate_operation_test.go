@@ -866,3 +866,245 @@ func TestOr_LogicalCorrectness_FuzzTesting(t *testing.T) {
 		}
 	}
 }
+
+func TestNot_ExtendedPredicates(t *testing.T) {
+	and := &andPredicate{predicates: []Predicate{
+		NewWorkflowIDPredicate([]string{"wf1"}, false),
+		NewTaskTypePredicate([]int{persistence.TransferTaskTypeActivityTask}, false),
+	}}
+
+	tests := []struct {
+		name     string
+		input    Predicate
+		expected Predicate
+	}{
+		{
+			name:     "taskTypePredicate flips isExclusive",
+			input:    NewTaskTypePredicate([]int{1, 2}, false),
+			expected: NewTaskTypePredicate([]int{1, 2}, true),
+		},
+		{
+			name:     "taskTypePredicate with empty set returns universalPredicate",
+			input:    NewTaskTypePredicate(nil, false),
+			expected: NewUniversalPredicate(),
+		},
+		{
+			name:     "taskListPredicate with empty exclusive set returns emptyPredicate",
+			input:    NewTaskListPredicate(nil, true),
+			expected: NewEmptyPredicate(),
+		},
+		{
+			name:     "workflowIDPredicate flips isExclusive",
+			input:    NewWorkflowIDPredicate([]string{"wf1"}, true),
+			expected: NewWorkflowIDPredicate([]string{"wf1"}, false),
+		},
+		{
+			name:     "workflowIDPrefixPredicate flips isExclusive",
+			input:    NewWorkflowIDPrefixPredicate([]string{"hot-"}, false),
+			expected: NewWorkflowIDPrefixPredicate([]string{"hot-"}, true),
+		},
+		{
+			name:     "andPredicate is wrapped",
+			input:    and,
+			expected: &notPredicate{predicate: and},
+		},
+		{
+			name:     "notPredicate is unwrapped",
+			input:    &notPredicate{predicate: and},
+			expected: and,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Not(tt.input)
+			assert.True(t, tt.expected.Equals(result),
+				"expected %+v, got %+v", tt.expected, result)
+		})
+	}
+}
+
+func TestAndOr_ExtendedPredicates(t *testing.T) {
+	hotWorkflow := NewWorkflowIDPredicate([]string{"hot"}, false)
+	activityTasks := NewTaskTypePredicate([]int{persistence.TransferTaskTypeActivityTask}, false)
+	domain := NewDomainIDPredicate([]string{"domain1"}, false)
+
+	tests := []struct {
+		name        string
+		p1          Predicate
+		p2          Predicate
+		expectedAnd Predicate
+		expectedOr  Predicate
+	}{
+		{
+			name:        "same type predicates are combined",
+			p1:          NewTaskTypePredicate([]int{1, 2}, false),
+			p2:          NewTaskTypePredicate([]int{2, 3}, false),
+			expectedAnd: NewTaskTypePredicate([]int{2}, false),
+			expectedOr:  NewTaskTypePredicate([]int{1, 2, 3}, false),
+		},
+		{
+			name:        "same type inclusive and exclusive predicates are combined",
+			p1:          NewTaskListPredicate([]string{"tl1", "tl2"}, false),
+			p2:          NewTaskListPredicate([]string{"tl2", "tl3"}, true),
+			expectedAnd: NewTaskListPredicate([]string{"tl1"}, false),
+			expectedOr:  NewTaskListPredicate([]string{"tl3"}, true),
+		},
+		{
+			name:        "disjoint same type predicates",
+			p1:          NewWorkflowIDPredicate([]string{"wf1"}, false),
+			p2:          NewWorkflowIDPredicate([]string{"wf1"}, true),
+			expectedAnd: NewEmptyPredicate(),
+			expectedOr:  NewUniversalPredicate(),
+		},
+		{
+			name:        "inclusive prefixes are combined by Or only",
+			p1:          NewWorkflowIDPrefixPredicate([]string{"a"}, false),
+			p2:          NewWorkflowIDPrefixPredicate([]string{"b"}, false),
+			expectedAnd: &andPredicate{predicates: []Predicate{NewWorkflowIDPrefixPredicate([]string{"a"}, false), NewWorkflowIDPrefixPredicate([]string{"b"}, false)}},
+			expectedOr:  NewWorkflowIDPrefixPredicate([]string{"a", "b"}, false),
+		},
+		{
+			name:        "different types are composed",
+			p1:          hotWorkflow,
+			p2:          activityTasks,
+			expectedAnd: &andPredicate{predicates: []Predicate{hotWorkflow, activityTasks}},
+			expectedOr:  &orPredicate{predicates: []Predicate{hotWorkflow, activityTasks}},
+		},
+		{
+			name:        "composites are flattened and deduplicated",
+			p1:          &andPredicate{predicates: []Predicate{hotWorkflow, activityTasks}},
+			p2:          &andPredicate{predicates: []Predicate{activityTasks, domain}},
+			expectedAnd: &andPredicate{predicates: []Predicate{hotWorkflow, activityTasks, domain}},
+			// the common operand is factored out
+			expectedOr: &andPredicate{predicates: []Predicate{
+				activityTasks,
+				&orPredicate{predicates: []Predicate{hotWorkflow, domain}},
+			}},
+		},
+		{
+			name:        "same type predicates inside composites are combined",
+			p1:          &andPredicate{predicates: []Predicate{hotWorkflow, NewDomainIDPredicate([]string{"domain1", "domain2"}, false)}},
+			p2:          NewDomainIDPredicate([]string{"domain2"}, true),
+			expectedAnd: &andPredicate{predicates: []Predicate{hotWorkflow, domain}},
+			expectedOr: &orPredicate{predicates: []Predicate{
+				&andPredicate{predicates: []Predicate{hotWorkflow, NewDomainIDPredicate([]string{"domain1", "domain2"}, false)}},
+				NewDomainIDPredicate([]string{"domain2"}, true),
+			}},
+		},
+		{
+			name:        "complementary composites",
+			p1:          &andPredicate{predicates: []Predicate{hotWorkflow, activityTasks}},
+			p2:          &notPredicate{predicate: &andPredicate{predicates: []Predicate{hotWorkflow, activityTasks}}},
+			expectedAnd: NewEmptyPredicate(),
+			expectedOr:  NewUniversalPredicate(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			and := And(tt.p1, tt.p2)
+			assert.True(t, tt.expectedAnd.Equals(and), "And: expected %+v, got %+v", tt.expectedAnd, and)
+			or := Or(tt.p1, tt.p2)
+			assert.True(t, tt.expectedOr.Equals(or), "Or: expected %+v, got %+v", tt.expectedOr, or)
+		})
+	}
+}
+
+func TestSplitByPredicate_ExtendedPredicates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tasks := []*persistence.MockTask{
+		newTestPredicateTask(ctrl, "domain1", persistence.TransferTaskTypeActivityTask, "tl1", "hot"),
+		newTestPredicateTask(ctrl, "domain1", persistence.TransferTaskTypeDecisionTask, "tl1", "hot"),
+		newTestPredicateTask(ctrl, "domain1", persistence.TransferTaskTypeActivityTask, "tl2", "cold"),
+		newTestPredicateTask(ctrl, "domain2", persistence.TransferTaskTypeActivityTask, "tl1", "hot"),
+	}
+
+	// carve a single hot workflow's activity tasks out of a domain's slice
+	slice := NewDomainIDPredicate([]string{"domain1"}, false)
+	hot := And(NewWorkflowIDPredicate([]string{"hot"}, false), NewTaskTypePredicate([]int{persistence.TransferTaskTypeActivityTask}, false))
+	pass := And(slice, hot)
+	fail := And(slice, Not(hot))
+
+	for _, task := range tasks {
+		assert.Equal(t, slice.Check(task), pass.Check(task) || fail.Check(task))
+		assert.False(t, pass.Check(task) && fail.Check(task))
+	}
+	assert.True(t, slice.Equals(Or(pass, fail)), "merging the split predicates restores the original predicate, got %+v", Or(pass, fail))
+}
+
+func extendedPredicateFuzzGenerator(p *Predicate, c fuzz.Continue) {
+	strings := func(values ...string) []string {
+		var result []string
+		for _, v := range values {
+			if c.RandBool() {
+				result = append(result, v)
+			}
+		}
+		return result
+	}
+	leaf := func() Predicate {
+		switch c.Intn(5) {
+		case 0:
+			return NewDomainIDPredicate(strings("domain1", "domain2"), c.RandBool())
+		case 1:
+			var taskTypes []int
+			for _, taskType := range []int{persistence.TransferTaskTypeActivityTask, persistence.TransferTaskTypeDecisionTask} {
+				if c.RandBool() {
+					taskTypes = append(taskTypes, taskType)
+				}
+			}
+			return NewTaskTypePredicate(taskTypes, c.RandBool())
+		case 2:
+			return NewTaskListPredicate(strings("tl1", "tl2"), c.RandBool())
+		case 3:
+			return NewWorkflowIDPredicate(strings("wf-a1", "wf-b1"), c.RandBool())
+		default:
+			return NewWorkflowIDPrefixPredicate(strings("wf-a", "wf-b"), c.RandBool())
+		}
+	}
+	switch c.Intn(4) {
+	case 0:
+		*p = leaf()
+	case 1:
+		*p = And(leaf(), leaf())
+	case 2:
+		*p = Or(leaf(), leaf())
+	default:
+		*p = Not(And(leaf(), leaf()))
+	}
+}
+
+func TestAndOr_ExtendedPredicates_FuzzTesting(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	var tasks []*persistence.MockTask
+	for _, domainID := range []string{"domain1", "domain2"} {
+		for _, taskType := range []int{persistence.TransferTaskTypeActivityTask, persistence.TransferTaskTypeDecisionTask} {
+			for _, taskList := range []string{"tl1", "tl2"} {
+				for _, workflowID := range []string{"wf-a1", "wf-b1", "wf-c1"} {
+					tasks = append(tasks, newTestPredicateTask(ctrl, domainID, taskType, taskList, workflowID))
+				}
+			}
+		}
+	}
+
+	f := fuzz.New().Funcs(extendedPredicateFuzzGenerator)
+	for i := 0; i < 500; i++ {
+		var p1, p2 Predicate
+		f.Fuzz(&p1)
+		f.Fuzz(&p2)
+
+		and := And(p1, p2)
+		or := Or(p1, p2)
+		not := Not(p1)
+		assert.True(t, and.Equals(And(p2, p1)), "And should be commutative, p1=%+v, p2=%+v", p1, p2)
+		assert.True(t, or.Equals(Or(p2, p1)), "Or should be commutative, p1=%+v, p2=%+v", p1, p2)
+
+		for _, task := range tasks {
+			assert.Equal(t, p1.Check(task) && p2.Check(task), and.Check(task), "iteration %d: And(p1, p2), p1=%+v, p2=%+v", i, p1, p2)
+			assert.Equal(t, p1.Check(task) || p2.Check(task), or.Check(task), "iteration %d: Or(p1, p2), p1=%+v, p2=%+v", i, p1, p2)
+			assert.Equal(t, !p1.Check(task), not.Check(task), "iteration %d: Not(p1), p1=%+v", i, p1)
+		}
+	}
+}
@@ -322,3 +322,137 @@ func TestPredicateIntegration(t *testing.T) {
 	assert.False(t, domainExclusive.Check(task))
 	assert.False(t, domainExclusive.IsEmpty())
 }
+
+func newTestPredicateTask(ctrl *gomock.Controller, domainID string, taskType int, taskList, workflowID string) *persistence.MockTask {
+	task := persistence.NewMockTask(ctrl)
+	task.EXPECT().GetDomainID().Return(domainID).AnyTimes()
+	task.EXPECT().GetTaskType().Return(taskType).AnyTimes()
+	task.EXPECT().GetOriginalTaskList().Return(taskList).AnyTimes()
+	task.EXPECT().GetWorkflowID().Return(workflowID).AnyTimes()
+	return task
+}
+
+func TestTaskTypePredicate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	activityTask := newTestPredicateTask(ctrl, "domain1", persistence.TransferTaskTypeActivityTask, "tl1", "wf1")
+	decisionTask := newTestPredicateTask(ctrl, "domain1", persistence.TransferTaskTypeDecisionTask, "tl1", "wf1")
+
+	inclusive := NewTaskTypePredicate([]int{persistence.TransferTaskTypeActivityTask}, false)
+	assert.True(t, inclusive.Check(activityTask))
+	assert.False(t, inclusive.Check(decisionTask))
+	assert.False(t, inclusive.IsEmpty())
+
+	exclusive := NewTaskTypePredicate([]int{persistence.TransferTaskTypeActivityTask}, true)
+	assert.False(t, exclusive.Check(activityTask))
+	assert.True(t, exclusive.Check(decisionTask))
+	assert.False(t, exclusive.IsEmpty())
+
+	assert.True(t, NewTaskTypePredicate(nil, false).IsEmpty())
+	assert.False(t, NewTaskTypePredicate(nil, true).IsEmpty())
+
+	assert.True(t, inclusive.Equals(NewTaskTypePredicate([]int{persistence.TransferTaskTypeActivityTask, persistence.TransferTaskTypeActivityTask}, false)))
+	assert.False(t, inclusive.Equals(exclusive))
+	assert.False(t, inclusive.Equals(NewDomainIDPredicate([]string{"domain1"}, false)))
+}
+
+func TestTaskListPredicate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	task1 := newTestPredicateTask(ctrl, "domain1", persistence.TransferTaskTypeDecisionTask, "tl1", "wf1")
+	task2 := newTestPredicateTask(ctrl, "domain1", persistence.TransferTaskTypeDecisionTask, "tl2", "wf1")
+
+	inclusive := NewTaskListPredicate([]string{"tl1"}, false)
+	assert.True(t, inclusive.Check(task1))
+	assert.False(t, inclusive.Check(task2))
+
+	exclusive := NewTaskListPredicate([]string{"tl1"}, true)
+	assert.False(t, exclusive.Check(task1))
+	assert.True(t, exclusive.Check(task2))
+
+	assert.True(t, NewTaskListPredicate(nil, false).IsEmpty())
+	assert.True(t, inclusive.Equals(NewTaskListPredicate([]string{"tl1"}, false)))
+	assert.False(t, inclusive.Equals(exclusive))
+	assert.False(t, inclusive.Equals(NewWorkflowIDPredicate([]string{"tl1"}, false)))
+}
+
+func TestWorkflowIDPredicate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	task1 := newTestPredicateTask(ctrl, "domain1", persistence.TransferTaskTypeActivityTask, "tl1", "wf1")
+	task2 := newTestPredicateTask(ctrl, "domain1", persistence.TransferTaskTypeActivityTask, "tl1", "wf2")
+
+	inclusive := NewWorkflowIDPredicate([]string{"wf1"}, false)
+	assert.True(t, inclusive.Check(task1))
+	assert.False(t, inclusive.Check(task2))
+
+	exclusive := NewWorkflowIDPredicate([]string{"wf1"}, true)
+	assert.False(t, exclusive.Check(task1))
+	assert.True(t, exclusive.Check(task2))
+
+	assert.True(t, NewWorkflowIDPredicate(nil, false).IsEmpty())
+	assert.True(t, inclusive.Equals(NewWorkflowIDPredicate([]string{"wf1"}, false)))
+	assert.False(t, inclusive.Equals(exclusive))
+}
+
+func TestWorkflowIDPrefixPredicate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	task1 := newTestPredicateTask(ctrl, "domain1", persistence.TransferTaskTypeActivityTask, "tl1", "hot-entity-1")
+	task2 := newTestPredicateTask(ctrl, "domain1", persistence.TransferTaskTypeActivityTask, "tl1", "cold-entity-1")
+
+	inclusive := NewWorkflowIDPrefixPredicate([]string{"hot-", "warm-"}, false)
+	assert.True(t, inclusive.Check(task1))
+	assert.False(t, inclusive.Check(task2))
+	assert.False(t, inclusive.IsEmpty())
+
+	exclusive := NewWorkflowIDPrefixPredicate([]string{"hot-"}, true)
+	assert.False(t, exclusive.Check(task1))
+	assert.True(t, exclusive.Check(task2))
+	assert.False(t, exclusive.IsEmpty())
+
+	assert.True(t, NewWorkflowIDPrefixPredicate(nil, false).IsEmpty())
+	assert.False(t, NewWorkflowIDPrefixPredicate(nil, true).IsEmpty())
+	// the empty prefix matches every workflow ID
+	assert.False(t, NewWorkflowIDPrefixPredicate([]string{""}, false).IsEmpty())
+	assert.True(t, NewWorkflowIDPrefixPredicate([]string{""}, true).IsEmpty())
+
+	assert.True(t, inclusive.Equals(NewWorkflowIDPrefixPredicate([]string{"warm-", "hot-"}, false)))
+	assert.False(t, inclusive.Equals(NewWorkflowIDPrefixPredicate([]string{"hot-", "warm-"}, true)))
+	assert.False(t, inclusive.Equals(NewWorkflowIDPredicate([]string{"hot-", "warm-"}, false)))
+}
+
+func TestCompositePredicates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	hotActivity := newTestPredicateTask(ctrl, "domain1", persistence.TransferTaskTypeActivityTask, "tl1", "hot")
+	hotDecision := newTestPredicateTask(ctrl, "domain1", persistence.TransferTaskTypeDecisionTask, "tl1", "hot")
+	coldActivity := newTestPredicateTask(ctrl, "domain1", persistence.TransferTaskTypeActivityTask, "tl1", "cold")
+
+	workflowID := NewWorkflowIDPredicate([]string{"hot"}, false)
+	taskType := NewTaskTypePredicate([]int{persistence.TransferTaskTypeActivityTask}, false)
+
+	and := &andPredicate{predicates: []Predicate{workflowID, taskType}}
+	assert.True(t, and.Check(hotActivity))
+	assert.False(t, and.Check(hotDecision))
+	assert.False(t, and.Check(coldActivity))
+	assert.False(t, and.IsEmpty())
+	assert.True(t, (&andPredicate{predicates: []Predicate{workflowID, NewEmptyPredicate()}}).IsEmpty())
+
+	or := &orPredicate{predicates: []Predicate{workflowID, taskType}}
+	assert.True(t, or.Check(hotActivity))
+	assert.True(t, or.Check(hotDecision))
+	assert.True(t, or.Check(coldActivity))
+	assert.False(t, or.IsEmpty())
+	assert.True(t, (&orPredicate{predicates: []Predicate{NewEmptyPredicate(), NewEmptyPredicate()}}).IsEmpty())
+
+	not := &notPredicate{predicate: and}
+	assert.False(t, not.Check(hotActivity))
+	assert.True(t, not.Check(hotDecision))
+	assert.True(t, not.Check(coldActivity))
+	assert.False(t, not.IsEmpty())
+	assert.True(t, (&notPredicate{predicate: NewUniversalPredicate()}).IsEmpty())
+
+	// the order of the composed predicates doesn't matter
+	assert.True(t, and.Equals(&andPredicate{predicates: []Predicate{taskType, workflowID}}))
+	assert.True(t, or.Equals(&orPredicate{predicates: []Predicate{taskType, workflowID}}))
+	assert.True(t, not.Equals(&notPredicate{predicate: &andPredicate{predicates: []Predicate{taskType, workflowID}}}))
+	assert.False(t, and.Equals(or))
+	assert.False(t, and.Equals(&andPredicate{predicates: []Predicate{workflowID}}))
+	assert.False(t, not.Equals(and))
+}
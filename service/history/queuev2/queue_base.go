@@ -24,6 +24,8 @@ package queuev2
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/uber/cadence/common"
@@ -35,6 +37,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/queue"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/task"
@@ -66,6 +69,10 @@ type (
 		CriticalPendingTaskCount    dynamicproperties.IntPropertyFn
 		EnablePendingTaskCountAlert func() bool
 		MaxVirtualQueueCount        dynamicproperties.IntPropertyFn
+		StuckSliceDeadline          dynamicproperties.DurationPropertyFn
+		CriticalSliceCount          dynamicproperties.IntPropertyFn
+		CriticalTaskLatency         dynamicproperties.DurationPropertyFn
+		IsolationRules              dynamicproperties.ListPropertyFn
 
 		EnableValidator        dynamicproperties.BoolPropertyFn
 		ValidationInterval     dynamicproperties.DurationPropertyFn
@@ -146,11 +153,16 @@ func newQueueBase(
 	)
 	monitor := NewMonitor(
 		category,
+		timeSource,
 		&MonitorOptions{
 			CriticalPendingTaskCount:    options.CriticalPendingTaskCount,
 			EnablePendingTaskCountAlert: options.EnablePendingTaskCountAlert,
+			StuckSliceDeadline:          options.StuckSliceDeadline,
+			CriticalSliceCount:          options.CriticalSliceCount,
+			CriticalTaskLatency:         options.CriticalTaskLatency,
 		},
 	)
+	isolationRules := NewIsolationRulesFn(options.IsolationRules, category.Name(), logger)
 	virtualQueueManager := NewVirtualQueueManager(
 		taskProcessor,
 		rescheduler,
@@ -180,6 +192,7 @@ func newQueueBase(
 				PollBackoffIntervalJitterCoefficient: options.PollBackoffIntervalJitterCoefficient,
 			},
 			VirtualSliceForceAppendInterval: options.VirtualSliceForceAppendInterval,
+			IsolationRules:                  isolationRules,
 		},
 		queueState.VirtualQueueStates,
 	)
@@ -190,6 +203,7 @@ func newQueueBase(
 		metricsScope,
 		&MitigatorOptions{
 			MaxVirtualQueueCount: options.MaxVirtualQueueCount,
+			IsolationRules:       isolationRules,
 		},
 	)
 	q := &queueBase{
@@ -249,7 +263,85 @@ func (q *queueBase) FailoverDomain(domainIDs map[string]struct{}) {
 }
 
 func (q *queueBase) HandleAction(ctx context.Context, clusterName string, action *queue.Action) (*queue.ActionResult, error) {
-	return nil, nil
+	switch action.ActionType {
+	case queue.ActionTypeGetState:
+		return &queue.ActionResult{
+			ActionType: action.ActionType,
+			GetStateActionResult: &queue.GetStateActionResult{
+				VirtualQueues: q.describeVirtualQueues(),
+			},
+		}, nil
+	case queue.ActionTypeUpdateVirtualQueue:
+		if err := q.updateVirtualQueue(action.UpdateVirtualQueueAttributes); err != nil {
+			return nil, err
+		}
+		return &queue.ActionResult{
+			ActionType:               action.ActionType,
+			UpdateVirtualQueueResult: &queue.UpdateVirtualQueueResult{},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown queue action type: %v", action.ActionType)
+	}
+}
+
+func (q *queueBase) describeVirtualQueues() []*queue.VirtualQueueInfo {
+	virtualQueues := q.virtualQueueManager.VirtualQueues()
+	queueIDs := make([]int64, 0, len(virtualQueues))
+	for queueID := range virtualQueues {
+		queueIDs = append(queueIDs, queueID)
+	}
+	slices.Sort(queueIDs)
+
+	infos := make([]*queue.VirtualQueueInfo, 0, len(queueIDs))
+	for _, queueID := range queueIDs {
+		vq := virtualQueues[queueID]
+		info := &queue.VirtualQueueInfo{
+			QueueID: queueID,
+			Paused:  vq.IsPaused(),
+		}
+		vq.IterateSlices(func(slice VirtualSlice) {
+			info.Slices = append(info.Slices, &queue.VirtualSliceInfo{
+				State:            ToPersistenceVirtualSliceState(slice.GetState()),
+				PendingTaskCount: slice.GetPendingTaskCount(),
+			})
+		})
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+func (q *queueBase) updateVirtualQueue(attributes *queue.UpdateVirtualQueueAttributes) error {
+	if attributes == nil {
+		return &types.BadRequestError{Message: "update virtual queue attributes are not set"}
+	}
+	q.logger.Info("updating virtual queue",
+		tag.VirtualQueueID(attributes.QueueID),
+		tag.Dynamic("operation", attributes.Operation),
+		tag.Dynamic("target-virtual-queue-id", attributes.TargetQueueID),
+		tag.WorkflowDomainIDs(attributes.DomainIDs),
+	)
+	switch attributes.Operation {
+	case queue.VirtualQueueOperationMoveDomains:
+		return q.virtualQueueManager.MoveDomains(attributes.QueueID, attributes.TargetQueueID, attributes.DomainIDs)
+	case queue.VirtualQueueOperationMerge:
+		return q.virtualQueueManager.MergeVirtualQueue(attributes.QueueID, attributes.TargetQueueID)
+	case queue.VirtualQueueOperationPause, queue.VirtualQueueOperationResume:
+		vq, ok := q.virtualQueueManager.VirtualQueues()[attributes.QueueID]
+		if !ok {
+			return &types.EntityNotExistsError{Message: fmt.Sprintf("virtual queue %d does not exist", attributes.QueueID)}
+		}
+		if attributes.Operation == queue.VirtualQueueOperationResume {
+			vq.Resume()
+			return nil
+		}
+		if attributes.PauseDuration <= 0 {
+			return &types.BadRequestError{Message: "pause duration must be positive"}
+		}
+		vq.Pause(attributes.PauseDuration)
+		return nil
+	default:
+		return &types.BadRequestError{Message: fmt.Sprintf("unknown virtual queue operation: %v", attributes.Operation)}
+	}
 }
 
 func (q *queueBase) LockTaskProcessing() {}
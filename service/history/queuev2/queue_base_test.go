@@ -2,6 +2,7 @@ package queuev2
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/queue"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/task"
 )
@@ -550,3 +552,185 @@ func TestNewQueueBase(t *testing.T) {
 		},
 	}, states)
 }
+
+func TestQueueBase_HandleAction(t *testing.T) {
+	testRange := Range{
+		InclusiveMinTaskKey: persistence.NewImmediateTaskKey(1),
+		ExclusiveMaxTaskKey: persistence.NewImmediateTaskKey(10),
+	}
+
+	tests := []struct {
+		name           string
+		action         *queue.Action
+		setupMocks     func(*gomock.Controller, *MockVirtualQueueManager)
+		expectedResult *queue.ActionResult
+		expectedErr    error
+	}{
+		{
+			name:   "get state",
+			action: queue.NewGetStateAction(),
+			setupMocks: func(ctrl *gomock.Controller, mockVirtualQueueManager *MockVirtualQueueManager) {
+				rootQueue := NewMockVirtualQueue(ctrl)
+				rootQueue.EXPECT().IsPaused().Return(false)
+				rootQueue.EXPECT().IterateSlices(gomock.Any()).Do(func(f func(VirtualSlice)) {
+					f(NewVirtualSlice(VirtualSliceState{Range: testRange, Predicate: NewDomainIDPredicate([]string{"domain1"}, true)}, nil, nil, NewPendingTaskTracker(), nil))
+				})
+				nonRootQueue := NewMockVirtualQueue(ctrl)
+				nonRootQueue.EXPECT().IsPaused().Return(true)
+				nonRootQueue.EXPECT().IterateSlices(gomock.Any()).Do(func(f func(VirtualSlice)) {
+					f(NewVirtualSlice(VirtualSliceState{Range: testRange, Predicate: NewDomainIDPredicate([]string{"domain1"}, false)}, nil, nil, NewPendingTaskTracker(), nil))
+				})
+				mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{
+					rootQueueID: rootQueue,
+					1:           nonRootQueue,
+				})
+			},
+			expectedResult: &queue.ActionResult{
+				ActionType: queue.ActionTypeGetState,
+				GetStateActionResult: &queue.GetStateActionResult{
+					VirtualQueues: []*queue.VirtualQueueInfo{
+						{
+							QueueID: rootQueueID,
+							Slices: []*queue.VirtualSliceInfo{
+								{State: ToPersistenceVirtualSliceState(VirtualSliceState{Range: testRange, Predicate: NewDomainIDPredicate([]string{"domain1"}, true)})},
+							},
+						},
+						{
+							QueueID: 1,
+							Paused:  true,
+							Slices: []*queue.VirtualSliceInfo{
+								{State: ToPersistenceVirtualSliceState(VirtualSliceState{Range: testRange, Predicate: NewDomainIDPredicate([]string{"domain1"}, false)})},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "move domains",
+			action: queue.NewUpdateVirtualQueueAction(&queue.UpdateVirtualQueueAttributes{
+				Operation:     queue.VirtualQueueOperationMoveDomains,
+				QueueID:       rootQueueID,
+				TargetQueueID: 1,
+				DomainIDs:     []string{"domain1"},
+			}),
+			setupMocks: func(ctrl *gomock.Controller, mockVirtualQueueManager *MockVirtualQueueManager) {
+				mockVirtualQueueManager.EXPECT().MoveDomains(int64(rootQueueID), int64(1), []string{"domain1"}).Return(nil)
+			},
+			expectedResult: &queue.ActionResult{
+				ActionType:               queue.ActionTypeUpdateVirtualQueue,
+				UpdateVirtualQueueResult: &queue.UpdateVirtualQueueResult{},
+			},
+		},
+		{
+			name: "merge failed",
+			action: queue.NewUpdateVirtualQueueAction(&queue.UpdateVirtualQueueAttributes{
+				Operation:     queue.VirtualQueueOperationMerge,
+				QueueID:       1,
+				TargetQueueID: rootQueueID,
+			}),
+			setupMocks: func(ctrl *gomock.Controller, mockVirtualQueueManager *MockVirtualQueueManager) {
+				mockVirtualQueueManager.EXPECT().MergeVirtualQueue(int64(1), int64(rootQueueID)).Return(&types.EntityNotExistsError{Message: "virtual queue 1 does not exist"})
+			},
+			expectedErr: &types.EntityNotExistsError{Message: "virtual queue 1 does not exist"},
+		},
+		{
+			name: "pause",
+			action: queue.NewUpdateVirtualQueueAction(&queue.UpdateVirtualQueueAttributes{
+				Operation:     queue.VirtualQueueOperationPause,
+				QueueID:       1,
+				PauseDuration: time.Minute,
+			}),
+			setupMocks: func(ctrl *gomock.Controller, mockVirtualQueueManager *MockVirtualQueueManager) {
+				vq := NewMockVirtualQueue(ctrl)
+				vq.EXPECT().Pause(time.Minute)
+				mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{1: vq})
+			},
+			expectedResult: &queue.ActionResult{
+				ActionType:               queue.ActionTypeUpdateVirtualQueue,
+				UpdateVirtualQueueResult: &queue.UpdateVirtualQueueResult{},
+			},
+		},
+		{
+			name: "pause without duration",
+			action: queue.NewUpdateVirtualQueueAction(&queue.UpdateVirtualQueueAttributes{
+				Operation: queue.VirtualQueueOperationPause,
+				QueueID:   1,
+			}),
+			setupMocks: func(ctrl *gomock.Controller, mockVirtualQueueManager *MockVirtualQueueManager) {
+				mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{1: NewMockVirtualQueue(ctrl)})
+			},
+			expectedErr: &types.BadRequestError{Message: "pause duration must be positive"},
+		},
+		{
+			name: "resume",
+			action: queue.NewUpdateVirtualQueueAction(&queue.UpdateVirtualQueueAttributes{
+				Operation: queue.VirtualQueueOperationResume,
+				QueueID:   1,
+			}),
+			setupMocks: func(ctrl *gomock.Controller, mockVirtualQueueManager *MockVirtualQueueManager) {
+				vq := NewMockVirtualQueue(ctrl)
+				vq.EXPECT().Resume()
+				mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{1: vq})
+			},
+			expectedResult: &queue.ActionResult{
+				ActionType:               queue.ActionTypeUpdateVirtualQueue,
+				UpdateVirtualQueueResult: &queue.UpdateVirtualQueueResult{},
+			},
+		},
+		{
+			name: "resume queue that does not exist",
+			action: queue.NewUpdateVirtualQueueAction(&queue.UpdateVirtualQueueAttributes{
+				Operation: queue.VirtualQueueOperationResume,
+				QueueID:   2,
+			}),
+			setupMocks: func(ctrl *gomock.Controller, mockVirtualQueueManager *MockVirtualQueueManager) {
+				mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{})
+			},
+			expectedErr: &types.EntityNotExistsError{Message: "virtual queue 2 does not exist"},
+		},
+		{
+			name: "unknown operation",
+			action: queue.NewUpdateVirtualQueueAction(&queue.UpdateVirtualQueueAttributes{
+				QueueID: 1,
+			}),
+			setupMocks:  func(ctrl *gomock.Controller, mockVirtualQueueManager *MockVirtualQueueManager) {},
+			expectedErr: &types.BadRequestError{Message: "unknown virtual queue operation: 0"},
+		},
+		{
+			name:        "missing attributes",
+			action:      queue.NewUpdateVirtualQueueAction(nil),
+			setupMocks:  func(ctrl *gomock.Controller, mockVirtualQueueManager *MockVirtualQueueManager) {},
+			expectedErr: &types.BadRequestError{Message: "update virtual queue attributes are not set"},
+		},
+		{
+			name:        "unknown action type",
+			action:      queue.NewResetAction(),
+			setupMocks:  func(ctrl *gomock.Controller, mockVirtualQueueManager *MockVirtualQueueManager) {},
+			expectedErr: fmt.Errorf("unknown queue action type: %v", queue.ActionTypeReset),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+			tt.setupMocks(ctrl, mockVirtualQueueManager)
+
+			queueBase := &queueBase{
+				logger:              testlogger.New(t),
+				metricsScope:        metrics.NoopScope,
+				category:            persistence.HistoryTaskCategoryTransfer,
+				virtualQueueManager: mockVirtualQueueManager,
+			}
+
+			result, err := queueBase.HandleAction(context.Background(), "cluster", tt.action)
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
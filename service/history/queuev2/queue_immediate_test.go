@@ -82,6 +82,9 @@ func TestImmediateQueue_LifeCycle(t *testing.T) {
 		VirtualSliceForceAppendInterval:      dynamicproperties.GetDurationPropertyFn(time.Second * 10),
 		EnablePendingTaskCountAlert:          func() bool { return true },
 		MaxVirtualQueueCount:                 dynamicproperties.GetIntPropertyFn(2),
+		StuckSliceDeadline:                   dynamicproperties.GetDurationPropertyFn(0),
+		CriticalSliceCount:                   dynamicproperties.GetIntPropertyFn(0),
+		CriticalTaskLatency:                  dynamicproperties.GetDurationPropertyFn(0),
 	}
 
 	queue := NewImmediateQueue(
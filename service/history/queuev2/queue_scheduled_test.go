@@ -83,6 +83,9 @@ func TestScheduledQueue_LifeCycle(t *testing.T) {
 		CriticalPendingTaskCount:             dynamicproperties.GetIntPropertyFn(90),
 		EnablePendingTaskCountAlert:          func() bool { return true },
 		MaxVirtualQueueCount:                 dynamicproperties.GetIntPropertyFn(2),
+		StuckSliceDeadline:                   dynamicproperties.GetDurationPropertyFn(0),
+		CriticalSliceCount:                   dynamicproperties.GetIntPropertyFn(0),
+		CriticalTaskLatency:                  dynamicproperties.GetDurationPropertyFn(0),
 	}
 
 	queue := NewScheduledQueue(
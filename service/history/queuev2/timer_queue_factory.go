@@ -156,6 +156,10 @@ func (f *timerQueueFactory) createQueuev2(
 			CriticalPendingTaskCount:             config.QueueCriticalPendingTaskCount,
 			EnablePendingTaskCountAlert:          func() bool { return config.EnableTimerQueueV2PendingTaskCountAlert(shard.GetShardID()) },
 			MaxVirtualQueueCount:                 config.QueueMaxVirtualQueueCount,
+			StuckSliceDeadline:                   config.QueueStuckSliceDeadline,
+			CriticalSliceCount:                   config.QueueCriticalSliceCount,
+			CriticalTaskLatency:                  config.QueueCriticalTaskLatency,
+			IsolationRules:                       config.QueueIsolationRules,
 		},
 	)
 }
@@ -165,6 +165,10 @@ func (f *transferQueueFactory) createQueuev2(
 			CriticalPendingTaskCount:             config.QueueCriticalPendingTaskCount,
 			EnablePendingTaskCountAlert:          func() bool { return config.EnableTransferQueueV2PendingTaskCountAlert(shard.GetShardID()) },
 			MaxVirtualQueueCount:                 config.QueueMaxVirtualQueueCount,
+			StuckSliceDeadline:                   config.QueueStuckSliceDeadline,
+			CriticalSliceCount:                   config.QueueCriticalSliceCount,
+			CriticalTaskLatency:                  config.QueueCriticalTaskLatency,
+			IsolationRules:                       config.QueueIsolationRules,
 		},
 	)
 }
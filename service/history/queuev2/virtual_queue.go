@@ -59,6 +59,8 @@ type (
 		MergeWithLastSlice(VirtualSlice)
 		// AppendSlices append the incoming slices to the virtual queue, this is used when we want to add a new slice to the root virtual queue to prevent infinite growth of the virtual slice
 		AppendSlices(...VirtualSlice)
+		// CompactSlices merges adjacent slices that can be merged and returns the number of slices after compaction, this is used when the virtual queue has too many slices
+		CompactSlices() int
 		// IterateSlices iterate over the slices in the virtual queue
 		IterateSlices(func(VirtualSlice))
 		// ClearSlices calls the Clear method of the slices that satisfy the predicate function
@@ -67,6 +69,10 @@ type (
 		SplitSlices(func(VirtualSlice) (remaining []VirtualSlice, split bool))
 		// Pause pauses the virtual queue for a while
 		Pause(time.Duration)
+		// Resume resumes the virtual queue immediately if it's paused
+		Resume()
+		// IsPaused returns whether the virtual queue is paused
+		IsPaused() bool
 	}
 
 	VirtualQueueOptions struct {
@@ -198,6 +204,7 @@ func (q *virtualQueueImpl) UpdateAndGetState() []VirtualSliceState {
 		} else {
 			states = append(states, state)
 			q.monitor.SetSlicePendingTaskCount(slice, slice.GetPendingTaskCount())
+			q.monitor.SetSliceProgress(slice, state.Range.InclusiveMinTaskKey, slice.PendingTaskStats().OldestPendingTaskTime)
 		}
 	}
 	return states
@@ -263,6 +270,21 @@ func (q *virtualQueueImpl) AppendSlices(incomingSlices ...VirtualSlice) {
 	q.resetNextReadSliceLocked()
 }
 
+func (q *virtualQueueImpl) CompactSlices() int {
+	q.Lock()
+	defer q.Unlock()
+
+	compactedSlices := list.New()
+	for e := q.virtualSlices.Front(); e != nil; e = e.Next() {
+		q.appendOrMergeSlice(compactedSlices, e.Value.(VirtualSlice))
+	}
+
+	q.virtualSlices.Init()
+	q.virtualSlices = compactedSlices
+	q.resetNextReadSliceLocked()
+	return q.virtualSlices.Len()
+}
+
 func (q *virtualQueueImpl) IterateSlices(f func(VirtualSlice)) {
 	q.RLock()
 	defer q.RUnlock()
@@ -317,6 +339,14 @@ func (q *virtualQueueImpl) Pause(duration time.Duration) {
 	q.pauseController.Pause(duration)
 }
 
+func (q *virtualQueueImpl) Resume() {
+	q.pauseController.Resume()
+}
+
+func (q *virtualQueueImpl) IsPaused() bool {
+	return q.pauseController.IsPaused()
+}
+
 func (q *virtualQueueImpl) notify() {
 	select {
 	case q.notifyCh <- struct{}{}:
@@ -24,6 +24,9 @@
 package queuev2
 
 import (
+	"fmt"
+	"maps"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -35,6 +38,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/task"
 )
 
@@ -47,6 +51,8 @@ type (
 		RootQueueOptions                *VirtualQueueOptions
 		NonRootQueueOptions             *VirtualQueueOptions
 		VirtualSliceForceAppendInterval dynamicproperties.DurationPropertyFn
+		// IsolationRules declares the domains whose tasks are always processed in a dedicated virtual queue
+		IsolationRules IsolationRulesFn
 	}
 	VirtualQueueManager interface {
 		common.Daemon
@@ -55,7 +61,14 @@ type (
 		UpdateAndGetState() map[int64][]VirtualSliceState
 		// Add a new virtual slice to the root queue. This is used when new tasks are generated and max read level is updated.
 		// By default, all new tasks belong to the root queue, so we need to add a new virtual slice to the root queue.
+		// The tasks of the domains with an isolation rule are added to their dedicated virtual queues instead.
 		AddNewVirtualSliceToRootQueue(VirtualSlice)
+		// MoveDomains splits the tasks of the given domains out of the slices of the source virtual queue and merges them
+		// into the target virtual queue. The target virtual queue is created if it doesn't exist.
+		MoveDomains(sourceQueueID, targetQueueID int64, domainIDs []string) error
+		// MergeVirtualQueue merges all slices of the source virtual queue into the target virtual queue and removes the source virtual queue.
+		// The root queue can only be used as a target.
+		MergeVirtualQueue(sourceQueueID, targetQueueID int64) error
 	}
 
 	virtualQueueManagerImpl struct {
@@ -136,6 +149,8 @@ func (m *virtualQueueManagerImpl) Start() {
 		return
 	}
 
+	m.applyIsolationRules()
+
 	m.RLock()
 	defer m.RUnlock()
 
@@ -160,7 +175,8 @@ func (m *virtualQueueManagerImpl) Stop() {
 func (m *virtualQueueManagerImpl) VirtualQueues() map[int64]VirtualQueue {
 	m.RLock()
 	defer m.RUnlock()
-	return m.virtualQueues
+	// return a copy so that callers can iterate it while virtual queues are being created or removed
+	return maps.Clone(m.virtualQueues)
 }
 
 func (m *virtualQueueManagerImpl) GetOrCreateVirtualQueue(queueID int64) VirtualQueue {
@@ -190,6 +206,7 @@ func (m *virtualQueueManagerImpl) UpdateAndGetState() map[int64][]VirtualSliceSt
 		state := vq.UpdateAndGetState()
 		if len(state) > 0 {
 			virtualQueueStates[key] = state
+			m.monitor.SetVirtualQueueSliceCount(key, len(state))
 		} else if key != rootQueueID {
 			vq.Stop()
 			delete(m.virtualQueues, key)
@@ -199,10 +216,38 @@ func (m *virtualQueueManagerImpl) UpdateAndGetState() map[int64][]VirtualSliceSt
 }
 
 func (m *virtualQueueManagerImpl) AddNewVirtualSliceToRootQueue(s VirtualSlice) {
+	now := m.timeSource.Now()
+	// TODO: we should set a limit on the number of virtual slices to prevent the size of queue state from being too large to be stored in database
+	forceAppend := now.After(m.nextForceNewSliceTime)
+	if forceAppend {
+		m.nextForceNewSliceTime = now.Add(m.queueManagerOptions.VirtualSliceForceAppendInterval())
+	}
+
+	domainsPerQueue := isolatedDomainsPerQueue(m.isolationRules())
+	for _, queueID := range slices.Sorted(maps.Keys(domainsPerQueue)) {
+		predicate := NewDomainIDPredicate(domainsPerQueue[queueID], false)
+		isolatedSlice, remainingSlice, ok := s.TrySplitByPredicate(predicate)
+		if !ok {
+			if predicate.Equals(s.GetState().Predicate) {
+				// the slice only contains tasks of the isolated domains
+				m.appendOrMergeSlice(m.GetOrCreateVirtualQueue(queueID), s, forceAppend)
+				return
+			}
+			continue
+		}
+		if !isolatedSlice.GetState().Predicate.IsEmpty() {
+			m.appendOrMergeSlice(m.GetOrCreateVirtualQueue(queueID), isolatedSlice, forceAppend)
+		}
+		if remainingSlice.GetState().Predicate.IsEmpty() {
+			return
+		}
+		s = remainingSlice
+	}
+
 	m.RLock()
 	if vq, ok := m.virtualQueues[rootQueueID]; ok {
 		m.RUnlock()
-		m.appendOrMergeSlice(vq, s)
+		m.appendOrMergeSlice(vq, s, forceAppend)
 		return
 	}
 	m.RUnlock()
@@ -210,7 +255,7 @@ func (m *virtualQueueManagerImpl) AddNewVirtualSliceToRootQueue(s VirtualSlice)
 	m.Lock()
 	defer m.Unlock()
 	if vq, ok := m.virtualQueues[rootQueueID]; ok {
-		m.appendOrMergeSlice(vq, s)
+		m.appendOrMergeSlice(vq, s, forceAppend)
 		return
 	}
 
@@ -218,16 +263,134 @@ func (m *virtualQueueManagerImpl) AddNewVirtualSliceToRootQueue(s VirtualSlice)
 	m.virtualQueues[rootQueueID].Start()
 }
 
-func (m *virtualQueueManagerImpl) appendOrMergeSlice(vq VirtualQueue, s VirtualSlice) {
-	now := m.timeSource.Now()
+func (m *virtualQueueManagerImpl) MoveDomains(sourceQueueID, targetQueueID int64, domainIDs []string) error {
+	if len(domainIDs) == 0 {
+		return &types.BadRequestError{Message: "at least one domain must be specified"}
+	}
+	if err := validateSourceAndTargetQueueIDs(sourceQueueID, targetQueueID); err != nil {
+		return err
+	}
+	m.RLock()
+	source, ok := m.virtualQueues[sourceQueueID]
+	m.RUnlock()
+	if !ok {
+		return &types.EntityNotExistsError{Message: fmt.Sprintf("virtual queue %d does not exist", sourceQueueID)}
+	}
+
+	predicate := NewDomainIDPredicate(domainIDs, false)
+	var slicesToMove []VirtualSlice
+	source.SplitSlices(func(slice VirtualSlice) ([]VirtualSlice, bool) {
+		splitSlice, remainingSlice, ok := slice.TrySplitByPredicate(predicate)
+		if !ok {
+			if !predicate.Equals(slice.GetState().Predicate) {
+				return nil, false
+			}
+			// the slice only contains tasks of the given domains, move it as a whole
+			slicesToMove = append(slicesToMove, slice)
+			return nil, true
+		}
+		if splitSlice.GetState().Predicate.IsEmpty() {
+			return nil, false
+		}
+		slicesToMove = append(slicesToMove, splitSlice)
+		if remainingSlice.GetState().Predicate.IsEmpty() {
+			return nil, true
+		}
+		return []VirtualSlice{remainingSlice}, true
+	})
+	if len(slicesToMove) == 0 {
+		return nil
+	}
+
+	m.GetOrCreateVirtualQueue(targetQueueID).MergeSlices(slicesToMove...)
+	m.logger.Info("moved domains to virtual queue",
+		tag.VirtualQueueID(targetQueueID),
+		tag.WorkflowDomainIDs(domainIDs),
+		tag.Dynamic("source-virtual-queue-id", sourceQueueID),
+		tag.Counter(len(slicesToMove)),
+	)
+	return nil
+}
+
+func (m *virtualQueueManagerImpl) MergeVirtualQueue(sourceQueueID, targetQueueID int64) error {
+	if sourceQueueID == rootQueueID {
+		return &types.BadRequestError{Message: "root virtual queue cannot be merged into another virtual queue"}
+	}
+	if err := validateSourceAndTargetQueueIDs(sourceQueueID, targetQueueID); err != nil {
+		return err
+	}
+	m.Lock()
+	source, ok := m.virtualQueues[sourceQueueID]
+	if !ok {
+		m.Unlock()
+		return &types.EntityNotExistsError{Message: fmt.Sprintf("virtual queue %d does not exist", sourceQueueID)}
+	}
+	delete(m.virtualQueues, sourceQueueID)
+	m.Unlock()
+
+	var slicesToMerge []VirtualSlice
+	source.SplitSlices(func(slice VirtualSlice) ([]VirtualSlice, bool) {
+		slicesToMerge = append(slicesToMerge, slice)
+		return nil, true
+	})
+	source.Stop()
+
+	if len(slicesToMerge) > 0 {
+		m.GetOrCreateVirtualQueue(targetQueueID).MergeSlices(slicesToMerge...)
+	}
+	m.logger.Info("merged virtual queue",
+		tag.VirtualQueueID(targetQueueID),
+		tag.Dynamic("source-virtual-queue-id", sourceQueueID),
+		tag.Counter(len(slicesToMerge)),
+	)
+	return nil
+}
+
+func validateSourceAndTargetQueueIDs(sourceQueueID, targetQueueID int64) error {
+	if sourceQueueID < 0 || targetQueueID < 0 {
+		return &types.BadRequestError{Message: "virtual queue ID must not be negative"}
+	}
+	if sourceQueueID == targetQueueID {
+		return &types.BadRequestError{Message: "source and target virtual queue must be different"}
+	}
+	return nil
+}
+
+func (m *virtualQueueManagerImpl) appendOrMergeSlice(vq VirtualQueue, s VirtualSlice, forceAppend bool) {
 	newVirtualSliceState := s.GetState()
-	// TODO: we should set a limit on the number of virtual slices to prevent the size of queue state from being too large to be stored in database
-	if now.After(m.nextForceNewSliceTime) {
-		m.logger.Debug("append new slice to virtual queue", tag.Dynamic("currentTime", now), tag.Dynamic("nextForceNewSliceTime", m.nextForceNewSliceTime), tag.Dynamic("inclusiveMinTaskKey", newVirtualSliceState.Range.InclusiveMinTaskKey), tag.Dynamic("exclusiveMaxTaskKey", newVirtualSliceState.Range.ExclusiveMaxTaskKey))
+	if forceAppend {
+		m.logger.Debug("append new slice to virtual queue", tag.Dynamic("nextForceNewSliceTime", m.nextForceNewSliceTime), tag.Dynamic("inclusiveMinTaskKey", newVirtualSliceState.Range.InclusiveMinTaskKey), tag.Dynamic("exclusiveMaxTaskKey", newVirtualSliceState.Range.ExclusiveMaxTaskKey))
 		vq.AppendSlices(s)
-		m.nextForceNewSliceTime = now.Add(m.queueManagerOptions.VirtualSliceForceAppendInterval())
 		return
 	}
-	m.logger.Debug("merge slice to virtual queue", tag.Dynamic("currentTime", now), tag.Dynamic("nextForceNewSliceTime", m.nextForceNewSliceTime), tag.Dynamic("inclusiveMinTaskKey", newVirtualSliceState.Range.InclusiveMinTaskKey), tag.Dynamic("exclusiveMaxTaskKey", newVirtualSliceState.Range.ExclusiveMaxTaskKey))
+	m.logger.Debug("merge slice to virtual queue", tag.Dynamic("nextForceNewSliceTime", m.nextForceNewSliceTime), tag.Dynamic("inclusiveMinTaskKey", newVirtualSliceState.Range.InclusiveMinTaskKey), tag.Dynamic("exclusiveMaxTaskKey", newVirtualSliceState.Range.ExclusiveMaxTaskKey))
 	vq.MergeWithLastSlice(s)
 }
+
+func (m *virtualQueueManagerImpl) isolationRules() []IsolationRule {
+	if m.queueManagerOptions.IsolationRules == nil {
+		return nil
+	}
+	return m.queueManagerOptions.IsolationRules()
+}
+
+// applyIsolationRules moves the tasks of the isolated domains loaded from the persisted queue state
+// to their dedicated virtual queues, so that the rules apply regardless of where the domains were before the shard is loaded
+func (m *virtualQueueManagerImpl) applyIsolationRules() {
+	domainsPerQueue := isolatedDomainsPerQueue(m.isolationRules())
+	if len(domainsPerQueue) == 0 {
+		return
+	}
+
+	sourceQueueIDs := slices.Sorted(maps.Keys(m.VirtualQueues()))
+	for _, targetQueueID := range slices.Sorted(maps.Keys(domainsPerQueue)) {
+		for _, sourceQueueID := range sourceQueueIDs {
+			if sourceQueueID == targetQueueID {
+				continue
+			}
+			if err := m.MoveDomains(sourceQueueID, targetQueueID, domainsPerQueue[targetQueueID]); err != nil {
+				m.logger.Error("failed to apply queue isolation rules", tag.Error(err), tag.VirtualQueueID(targetQueueID), tag.Dynamic("source-virtual-queue-id", sourceQueueID))
+			}
+		}
+	}
+}
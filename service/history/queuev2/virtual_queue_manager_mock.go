@@ -65,6 +65,34 @@ func (mr *MockVirtualQueueManagerMockRecorder) GetOrCreateVirtualQueue(arg0 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrCreateVirtualQueue", reflect.TypeOf((*MockVirtualQueueManager)(nil).GetOrCreateVirtualQueue), arg0)
 }
 
+// MergeVirtualQueue mocks base method.
+func (m *MockVirtualQueueManager) MergeVirtualQueue(arg0, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeVirtualQueue", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeVirtualQueue indicates an expected call of MergeVirtualQueue.
+func (mr *MockVirtualQueueManagerMockRecorder) MergeVirtualQueue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeVirtualQueue", reflect.TypeOf((*MockVirtualQueueManager)(nil).MergeVirtualQueue), arg0, arg1)
+}
+
+// MoveDomains mocks base method.
+func (m *MockVirtualQueueManager) MoveDomains(arg0, arg1 int64, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveDomains", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveDomains indicates an expected call of MoveDomains.
+func (mr *MockVirtualQueueManagerMockRecorder) MoveDomains(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveDomains", reflect.TypeOf((*MockVirtualQueueManager)(nil).MoveDomains), arg0, arg1, arg2)
+}
+
 // Start mocks base method.
 func (m *MockVirtualQueueManager) Start() {
 	m.ctrl.T.Helper()
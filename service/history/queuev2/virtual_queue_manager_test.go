@@ -13,6 +13,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/task"
 )
 
@@ -447,6 +448,10 @@ func TestVirtualQueueManager_UpdateAndGetState(t *testing.T) {
 
 			// Set up mock expectations
 			tt.setupMockQueues(mockQueues)
+			mockMonitor := NewMockMonitor(ctrl)
+			for queueID, states := range tt.expectedStates {
+				mockMonitor.EXPECT().SetVirtualQueueSliceCount(queueID, len(states))
+			}
 
 			// Create manager instance
 			manager := &virtualQueueManagerImpl{
@@ -456,6 +461,7 @@ func TestVirtualQueueManager_UpdateAndGetState(t *testing.T) {
 				queueReader:     mockQueueReader,
 				logger:          mockLogger,
 				metricsScope:    mockMetricsScope,
+				monitor:         mockMonitor,
 				queueManagerOptions: &VirtualQueueManagerOptions{
 					RootQueueOptions: &VirtualQueueOptions{},
 					NonRootQueueOptions: &VirtualQueueOptions{
@@ -611,3 +617,429 @@ func TestVirtualQueueManager_AddNewVirtualSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestVirtualQueueManager_MoveDomains(t *testing.T) {
+	testRange := Range{
+		InclusiveMinTaskKey: persistence.NewImmediateTaskKey(1),
+		ExclusiveMaxTaskKey: persistence.NewImmediateTaskKey(10),
+	}
+
+	tests := []struct {
+		name              string
+		sourceQueueID     int64
+		targetQueueID     int64
+		domainIDs         []string
+		sourceSlices      []VirtualSliceState
+		targetExists      bool
+		expectedErr       error
+		expectedRemaining []VirtualSliceState
+		expectedMoved     []VirtualSliceState
+	}{
+		{
+			name:          "no domain specified",
+			sourceQueueID: rootQueueID,
+			targetQueueID: 1,
+			expectedErr:   &types.BadRequestError{Message: "at least one domain must be specified"},
+		},
+		{
+			name:          "same source and target queue",
+			sourceQueueID: 1,
+			targetQueueID: 1,
+			domainIDs:     []string{"domain1"},
+			expectedErr:   &types.BadRequestError{Message: "source and target virtual queue must be different"},
+		},
+		{
+			name:          "source queue does not exist",
+			sourceQueueID: 2,
+			targetQueueID: 1,
+			domainIDs:     []string{"domain1"},
+			expectedErr:   &types.EntityNotExistsError{Message: "virtual queue 2 does not exist"},
+		},
+		{
+			name:          "split universal slice into a new queue",
+			sourceQueueID: rootQueueID,
+			targetQueueID: 1,
+			domainIDs:     []string{"domain1"},
+			sourceSlices: []VirtualSliceState{
+				{Range: testRange, Predicate: NewUniversalPredicate()},
+			},
+			expectedRemaining: []VirtualSliceState{
+				{Range: testRange, Predicate: NewDomainIDPredicate([]string{"domain1"}, true)},
+			},
+			expectedMoved: []VirtualSliceState{
+				{Range: testRange, Predicate: NewDomainIDPredicate([]string{"domain1"}, false)},
+			},
+		},
+		{
+			name:          "split domain slice into an existing queue",
+			sourceQueueID: rootQueueID,
+			targetQueueID: 1,
+			domainIDs:     []string{"domain1"},
+			sourceSlices: []VirtualSliceState{
+				{Range: testRange, Predicate: NewDomainIDPredicate([]string{"domain1", "domain2"}, false)},
+			},
+			targetExists: true,
+			expectedRemaining: []VirtualSliceState{
+				{Range: testRange, Predicate: NewDomainIDPredicate([]string{"domain2"}, false)},
+			},
+			expectedMoved: []VirtualSliceState{
+				{Range: testRange, Predicate: NewDomainIDPredicate([]string{"domain1"}, false)},
+			},
+		},
+		{
+			name:          "move the whole slice if it only contains the given domains",
+			sourceQueueID: 1,
+			targetQueueID: rootQueueID,
+			domainIDs:     []string{"domain1"},
+			sourceSlices: []VirtualSliceState{
+				{Range: testRange, Predicate: NewDomainIDPredicate([]string{"domain1"}, false)},
+			},
+			targetExists:      true,
+			expectedRemaining: []VirtualSliceState{},
+			expectedMoved: []VirtualSliceState{
+				{Range: testRange, Predicate: NewDomainIDPredicate([]string{"domain1"}, false)},
+			},
+		},
+		{
+			name:          "slices without tasks of the given domains are kept",
+			sourceQueueID: rootQueueID,
+			targetQueueID: 1,
+			domainIDs:     []string{"domain1"},
+			sourceSlices: []VirtualSliceState{
+				{Range: testRange, Predicate: NewDomainIDPredicate([]string{"domain2"}, false)},
+			},
+			expectedRemaining: []VirtualSliceState{
+				{Range: testRange, Predicate: NewDomainIDPredicate([]string{"domain2"}, false)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockTaskInitializer := func(t persistence.Task) task.Task {
+				return task.NewMockTask(ctrl)
+			}
+			mockQueueReader := NewMockQueueReader(ctrl)
+			logger := log.NewNoop()
+
+			virtualQueues := make(map[int64]VirtualQueue)
+			var remaining []VirtualSliceState
+			if tt.sourceSlices != nil {
+				sourceQueue := NewMockVirtualQueue(ctrl)
+				sourceQueue.EXPECT().SplitSlices(gomock.Any()).Do(func(f func(VirtualSlice) ([]VirtualSlice, bool)) {
+					remaining = []VirtualSliceState{}
+					for _, state := range tt.sourceSlices {
+						slice := NewVirtualSlice(state, mockTaskInitializer, mockQueueReader, NewPendingTaskTracker(), logger)
+						remainingSlices, split := f(slice)
+						if !split {
+							remainingSlices = []VirtualSlice{slice}
+						}
+						for _, s := range remainingSlices {
+							remaining = append(remaining, s.GetState())
+						}
+					}
+				})
+				virtualQueues[tt.sourceQueueID] = sourceQueue
+			}
+
+			var moved []VirtualSliceState
+			targetQueue := NewMockVirtualQueue(ctrl)
+			if tt.expectedMoved != nil {
+				targetQueue.EXPECT().MergeSlices(gomock.Any()).Do(func(slices ...VirtualSlice) {
+					for _, s := range slices {
+						moved = append(moved, s.GetState())
+					}
+				})
+			}
+			if tt.targetExists {
+				virtualQueues[tt.targetQueueID] = targetQueue
+			}
+
+			manager := &virtualQueueManagerImpl{
+				taskInitializer: mockTaskInitializer,
+				queueReader:     mockQueueReader,
+				logger:          logger,
+				metricsScope:    metrics.NoopScope,
+				status:          common.DaemonStatusStarted,
+				virtualQueues:   virtualQueues,
+				createVirtualQueueFn: func(queueID int64, s ...VirtualSlice) VirtualQueue {
+					assert.Equal(t, tt.targetQueueID, queueID)
+					targetQueue.EXPECT().Start()
+					return targetQueue
+				},
+			}
+
+			err := manager.MoveDomains(tt.sourceQueueID, tt.targetQueueID, tt.domainIDs)
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedRemaining, remaining)
+			assert.Equal(t, tt.expectedMoved, moved)
+			if tt.expectedMoved != nil {
+				assert.Contains(t, manager.virtualQueues, tt.targetQueueID)
+			} else {
+				assert.NotContains(t, manager.virtualQueues, tt.targetQueueID)
+			}
+		})
+	}
+}
+
+func TestVirtualQueueManager_MergeVirtualQueue(t *testing.T) {
+	tests := []struct {
+		name          string
+		sourceQueueID int64
+		targetQueueID int64
+		sourceExists  bool
+		sourceSlices  int
+		expectedErr   error
+	}{
+		{
+			name:          "root queue cannot be merged",
+			sourceQueueID: rootQueueID,
+			targetQueueID: 1,
+			sourceExists:  true,
+			expectedErr:   &types.BadRequestError{Message: "root virtual queue cannot be merged into another virtual queue"},
+		},
+		{
+			name:          "negative queue ID",
+			sourceQueueID: 1,
+			targetQueueID: -1,
+			sourceExists:  true,
+			expectedErr:   &types.BadRequestError{Message: "virtual queue ID must not be negative"},
+		},
+		{
+			name:          "source queue does not exist",
+			sourceQueueID: 2,
+			targetQueueID: rootQueueID,
+			expectedErr:   &types.EntityNotExistsError{Message: "virtual queue 2 does not exist"},
+		},
+		{
+			name:          "merge slices into the root queue",
+			sourceQueueID: 1,
+			targetQueueID: rootQueueID,
+			sourceExists:  true,
+			sourceSlices:  2,
+		},
+		{
+			name:          "merge empty queue",
+			sourceQueueID: 1,
+			targetQueueID: rootQueueID,
+			sourceExists:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			targetQueue := NewMockVirtualQueue(ctrl)
+			virtualQueues := map[int64]VirtualQueue{
+				tt.targetQueueID: targetQueue,
+			}
+			sourceQueue := NewMockVirtualQueue(ctrl)
+			if tt.sourceExists {
+				virtualQueues[tt.sourceQueueID] = sourceQueue
+			}
+			if tt.expectedErr == nil {
+				var slices []VirtualSlice
+				for i := 0; i < tt.sourceSlices; i++ {
+					slices = append(slices, NewMockVirtualSlice(ctrl))
+				}
+				sourceQueue.EXPECT().SplitSlices(gomock.Any()).Do(func(f func(VirtualSlice) ([]VirtualSlice, bool)) {
+					for _, s := range slices {
+						remaining, split := f(s)
+						assert.True(t, split)
+						assert.Empty(t, remaining)
+					}
+				})
+				sourceQueue.EXPECT().Stop()
+				if len(slices) > 0 {
+					targetQueue.EXPECT().MergeSlices(slices[0], slices[1])
+				}
+			}
+
+			manager := &virtualQueueManagerImpl{
+				logger:        log.NewNoop(),
+				metricsScope:  metrics.NoopScope,
+				status:        common.DaemonStatusStarted,
+				virtualQueues: virtualQueues,
+			}
+
+			err := manager.MergeVirtualQueue(tt.sourceQueueID, tt.targetQueueID)
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotContains(t, manager.virtualQueues, tt.sourceQueueID)
+			assert.Contains(t, manager.virtualQueues, tt.targetQueueID)
+		})
+	}
+}
+
+func TestVirtualQueueManager_StartWithIsolationRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	testRange := Range{
+		InclusiveMinTaskKey: persistence.NewImmediateTaskKey(1),
+		ExclusiveMaxTaskKey: persistence.NewImmediateTaskKey(10),
+	}
+	mockTaskInitializer := func(t persistence.Task) task.Task {
+		return task.NewMockTask(ctrl)
+	}
+	mockQueueReader := NewMockQueueReader(ctrl)
+	logger := log.NewNoop()
+
+	var remaining []VirtualSliceState
+	rootQueue := NewMockVirtualQueue(ctrl)
+	rootQueue.EXPECT().SplitSlices(gomock.Any()).Do(func(f func(VirtualSlice) ([]VirtualSlice, bool)) {
+		slice := NewVirtualSlice(VirtualSliceState{Range: testRange, Predicate: NewUniversalPredicate()}, mockTaskInitializer, mockQueueReader, NewPendingTaskTracker(), logger)
+		remainingSlices, _ := f(slice)
+		for _, s := range remainingSlices {
+			remaining = append(remaining, s.GetState())
+		}
+	})
+	rootQueue.EXPECT().Start()
+
+	var moved []VirtualSliceState
+	isolatedQueue := NewMockVirtualQueue(ctrl)
+	isolatedQueue.EXPECT().MergeSlices(gomock.Any()).Do(func(slices ...VirtualSlice) {
+		for _, s := range slices {
+			moved = append(moved, s.GetState())
+		}
+	})
+	// started once on creation and once more by the manager, which is a no-op for a started virtual queue
+	isolatedQueue.EXPECT().Start().Times(2)
+
+	manager := &virtualQueueManagerImpl{
+		taskInitializer: mockTaskInitializer,
+		queueReader:     mockQueueReader,
+		logger:          logger,
+		metricsScope:    metrics.NoopScope,
+		queueManagerOptions: &VirtualQueueManagerOptions{
+			IsolationRules: func() []IsolationRule {
+				return []IsolationRule{{DomainID: "domain1", VirtualQueueID: 2}}
+			},
+		},
+		status:        common.DaemonStatusInitialized,
+		virtualQueues: map[int64]VirtualQueue{rootQueueID: rootQueue},
+		createVirtualQueueFn: func(queueID int64, s ...VirtualSlice) VirtualQueue {
+			assert.Equal(t, int64(2), queueID)
+			return isolatedQueue
+		},
+	}
+
+	manager.Start()
+
+	assert.Equal(t, []VirtualSliceState{
+		{Range: testRange, Predicate: NewDomainIDPredicate([]string{"domain1"}, true)},
+	}, remaining)
+	assert.Equal(t, []VirtualSliceState{
+		{Range: testRange, Predicate: NewDomainIDPredicate([]string{"domain1"}, false)},
+	}, moved)
+	assert.Contains(t, manager.virtualQueues, int64(2))
+}
+
+func TestVirtualQueueManager_AddNewVirtualSliceWithIsolationRules(t *testing.T) {
+	testRange := Range{
+		InclusiveMinTaskKey: persistence.NewImmediateTaskKey(1),
+		ExclusiveMaxTaskKey: persistence.NewImmediateTaskKey(10),
+	}
+
+	tests := []struct {
+		name             string
+		rules            []IsolationRule
+		newSlicePred     Predicate
+		forceAppend      bool
+		expectedPerQueue map[int64][]VirtualSliceState
+	}{
+		{
+			name:         "tasks of isolated domains are appended to their dedicated queues",
+			rules:        []IsolationRule{{DomainID: "domain1", VirtualQueueID: 2}, {DomainID: "domain2", VirtualQueueID: 3}},
+			newSlicePred: NewUniversalPredicate(),
+			forceAppend:  true,
+			expectedPerQueue: map[int64][]VirtualSliceState{
+				rootQueueID: {{Range: testRange, Predicate: NewDomainIDPredicate([]string{"domain1", "domain2"}, true)}},
+				2:           {{Range: testRange, Predicate: NewDomainIDPredicate([]string{"domain1"}, false)}},
+				3:           {{Range: testRange, Predicate: NewDomainIDPredicate([]string{"domain2"}, false)}},
+			},
+		},
+		{
+			name:         "tasks of isolated domains are merged into the last slice of their dedicated queues",
+			rules:        []IsolationRule{{DomainID: "domain1", VirtualQueueID: 2}},
+			newSlicePred: NewUniversalPredicate(),
+			expectedPerQueue: map[int64][]VirtualSliceState{
+				rootQueueID: {{Range: testRange, Predicate: NewDomainIDPredicate([]string{"domain1"}, true)}},
+				2:           {{Range: testRange, Predicate: NewDomainIDPredicate([]string{"domain1"}, false)}},
+			},
+		},
+		{
+			name:         "slice that only contains an isolated domain is not added to the root queue",
+			rules:        []IsolationRule{{DomainID: "domain1", VirtualQueueID: 2}},
+			newSlicePred: NewDomainIDPredicate([]string{"domain1"}, false),
+			forceAppend:  true,
+			expectedPerQueue: map[int64][]VirtualSliceState{
+				2: {{Range: testRange, Predicate: NewDomainIDPredicate([]string{"domain1"}, false)}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockTaskInitializer := func(t persistence.Task) task.Task {
+				return task.NewMockTask(ctrl)
+			}
+			mockQueueReader := NewMockQueueReader(ctrl)
+			logger := log.NewNoop()
+			mockTimeSource := clock.NewMockedTimeSource()
+
+			added := make(map[int64][]VirtualSliceState)
+			newMockQueue := func(queueID int64) *MockVirtualQueue {
+				vq := NewMockVirtualQueue(ctrl)
+				record := func(slices ...VirtualSlice) {
+					for _, s := range slices {
+						added[queueID] = append(added[queueID], s.GetState())
+					}
+				}
+				if tt.forceAppend {
+					vq.EXPECT().AppendSlices(gomock.Any()).Do(record).AnyTimes()
+				} else {
+					vq.EXPECT().MergeWithLastSlice(gomock.Any()).Do(func(s VirtualSlice) { record(s) }).AnyTimes()
+				}
+				return vq
+			}
+
+			forceNewSliceDuration := time.Minute
+			manager := &virtualQueueManagerImpl{
+				taskInitializer: mockTaskInitializer,
+				queueReader:     mockQueueReader,
+				logger:          logger,
+				metricsScope:    metrics.NoopScope,
+				timeSource:      mockTimeSource,
+				queueManagerOptions: &VirtualQueueManagerOptions{
+					VirtualSliceForceAppendInterval: dynamicproperties.GetDurationPropertyFn(forceNewSliceDuration),
+					IsolationRules:                  func() []IsolationRule { return tt.rules },
+				},
+				status:        common.DaemonStatusStarted,
+				virtualQueues: map[int64]VirtualQueue{rootQueueID: newMockQueue(rootQueueID)},
+				createVirtualQueueFn: func(queueID int64, s ...VirtualSlice) VirtualQueue {
+					vq := newMockQueue(queueID)
+					vq.EXPECT().Start()
+					return vq
+				},
+				nextForceNewSliceTime: mockTimeSource.Now(),
+			}
+
+			if tt.forceAppend {
+				mockTimeSource.Advance(forceNewSliceDuration)
+			}
+			manager.AddNewVirtualSliceToRootQueue(NewVirtualSlice(VirtualSliceState{Range: testRange, Predicate: tt.newSlicePred}, mockTaskInitializer, mockQueueReader, NewPendingTaskTracker(), logger))
+
+			assert.Equal(t, tt.expectedPerQueue, added)
+		})
+	}
+}
@@ -119,7 +119,9 @@ func TestVirtualQueueImpl_UpdateAndGetState(t *testing.T) {
 	})
 	mockVirtualSlice1.EXPECT().GetPendingTaskCount().Return(1)
 	mockVirtualSlice1.EXPECT().IsEmpty().Return(false)
+	mockVirtualSlice1.EXPECT().PendingTaskStats().Return(PendingTaskStats{OldestPendingTaskTime: time.Unix(0, 0)})
 	mockMonitor.EXPECT().SetSlicePendingTaskCount(mockVirtualSlice1, 1)
+	mockMonitor.EXPECT().SetSliceProgress(mockVirtualSlice1, persistence.NewImmediateTaskKey(1), time.Unix(0, 0))
 
 	mockVirtualSlice2.EXPECT().UpdateAndGetState().Return(VirtualSliceState{
 		Range: Range{
@@ -383,6 +385,53 @@ func TestVirtualQueue_MergeSlices(t *testing.T) {
 	}
 }
 
+func TestVirtualQueue_CompactSlices(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	slice1 := NewMockVirtualSlice(ctrl)
+	slice2 := NewMockVirtualSlice(ctrl)
+	slice3 := NewMockVirtualSlice(ctrl)
+	mergedSlice := NewMockVirtualSlice(ctrl)
+	monitor := NewMockMonitor(ctrl)
+
+	// slice1 and slice2 are merged, the merged slice can't be merged with slice3
+	slice1.EXPECT().GetPendingTaskCount().Return(1)
+	monitor.EXPECT().SetSlicePendingTaskCount(slice1, 1)
+	slice1.EXPECT().TryMergeWithVirtualSlice(slice2).Return([]VirtualSlice{mergedSlice}, true)
+	monitor.EXPECT().RemoveSlice(slice1)
+	monitor.EXPECT().RemoveSlice(slice2)
+	mergedSlice.EXPECT().GetPendingTaskCount().Return(3)
+	monitor.EXPECT().SetSlicePendingTaskCount(mergedSlice, 3)
+	mergedSlice.EXPECT().TryMergeWithVirtualSlice(slice3).Return(nil, false)
+	slice3.EXPECT().GetPendingTaskCount().Return(4)
+	monitor.EXPECT().SetSlicePendingTaskCount(slice3, 4)
+	mergedSlice.EXPECT().HasMoreTasks().Return(false)
+	slice3.EXPECT().HasMoreTasks().Return(false)
+
+	queue := NewVirtualQueue(
+		task.NewMockProcessor(ctrl),
+		task.NewMockRescheduler(ctrl),
+		testlogger.New(t),
+		metrics.NoopScope,
+		clock.NewMockedTimeSource(),
+		quotas.NewMockLimiter(ctrl),
+		monitor,
+		[]VirtualSlice{slice1, slice2, slice3},
+		&VirtualQueueOptions{
+			PageSize:                             dynamicproperties.GetIntPropertyFn(10),
+			MaxPendingTasksCount:                 dynamicproperties.GetIntPropertyFn(100),
+			PollBackoffInterval:                  dynamicproperties.GetDurationPropertyFn(time.Second * 10),
+			PollBackoffIntervalJitterCoefficient: dynamicproperties.GetFloatPropertyFn(0.0),
+		},
+	).(*virtualQueueImpl)
+
+	assert.Equal(t, 2, queue.CompactSlices())
+	assert.Equal(t, 2, queue.virtualSlices.Len())
+	assert.Equal(t, mergedSlice, queue.virtualSlices.Front().Value)
+	assert.Equal(t, slice3, queue.virtualSlices.Back().Value)
+	assert.Nil(t, queue.sliceToRead)
+}
+
 func TestAppendOrMergeSlice(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -25,6 +25,7 @@ package queuev2
 
 import (
 	"context"
+	"time"
 
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -50,6 +51,8 @@ type (
 
 	PendingTaskStats struct {
 		PendingTaskCountPerDomain map[string]int
+		// OldestPendingTaskTime is the visibility timestamp of the pending task with the minimum task key, zero if there is no pending task
+		OldestPendingTaskTime time.Time
 	}
 
 	virtualSliceImpl struct {
@@ -162,9 +165,15 @@ func (s *virtualSliceImpl) HasMoreTasks() bool {
 }
 
 func (s *virtualSliceImpl) PendingTaskStats() PendingTaskStats {
-	return PendingTaskStats{
+	stats := PendingTaskStats{
 		PendingTaskCountPerDomain: s.pendingTaskTracker.GetPerDomainPendingTaskCount(),
 	}
+	if minTaskKey, ok := s.pendingTaskTracker.GetMinimumTaskKey(); ok {
+		if pendingTask, ok := s.pendingTaskTracker.GetTasks()[minTaskKey]; ok {
+			stats.OldestPendingTaskTime = pendingTask.GetVisibilityTimestamp()
+		}
+	}
+	return stats
 }
 
 func (s *virtualSliceImpl) UpdateAndGetState() VirtualSliceState {
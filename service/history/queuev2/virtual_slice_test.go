@@ -3,6 +3,7 @@ package queuev2
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -3014,6 +3015,7 @@ func TestPendingTaskStats(t *testing.T) {
 		{
 			name: "Empty pending task tracker - should return empty stats",
 			mockSetup: func(mock *MockPendingTaskTracker) {
+				mock.EXPECT().GetMinimumTaskKey().Return(persistence.MaximumHistoryTaskKey, false)
 				mock.EXPECT().GetPerDomainPendingTaskCount().Return(map[string]int{})
 			},
 			expectedStats: PendingTaskStats{
@@ -3023,6 +3025,7 @@ func TestPendingTaskStats(t *testing.T) {
 		{
 			name: "Single domain with tasks - should return correct stats",
 			mockSetup: func(mock *MockPendingTaskTracker) {
+				mock.EXPECT().GetMinimumTaskKey().Return(persistence.MaximumHistoryTaskKey, false)
 				mock.EXPECT().GetPerDomainPendingTaskCount().Return(map[string]int{
 					"domain1": 5,
 				})
@@ -3036,6 +3039,7 @@ func TestPendingTaskStats(t *testing.T) {
 		{
 			name: "Multiple domains with tasks - should return correct stats",
 			mockSetup: func(mock *MockPendingTaskTracker) {
+				mock.EXPECT().GetMinimumTaskKey().Return(persistence.MaximumHistoryTaskKey, false)
 				mock.EXPECT().GetPerDomainPendingTaskCount().Return(map[string]int{
 					"domain1": 3,
 					"domain2": 7,
@@ -3053,6 +3057,7 @@ func TestPendingTaskStats(t *testing.T) {
 		{
 			name: "Domain with zero tasks - should include zero counts",
 			mockSetup: func(mock *MockPendingTaskTracker) {
+				mock.EXPECT().GetMinimumTaskKey().Return(persistence.MaximumHistoryTaskKey, false)
 				mock.EXPECT().GetPerDomainPendingTaskCount().Return(map[string]int{
 					"domain1": 5,
 					"domain2": 0,
@@ -3098,6 +3103,28 @@ func TestPendingTaskStats(t *testing.T) {
 	}
 }
 
+func TestPendingTaskStats_OldestPendingTaskTime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockPendingTaskTracker := NewMockPendingTaskTracker(ctrl)
+	mockTask := task.NewMockTask(ctrl)
+
+	now := time.Unix(0, 0).UTC()
+	minTaskKey := persistence.NewImmediateTaskKey(1)
+	mockPendingTaskTracker.EXPECT().GetPerDomainPendingTaskCount().Return(map[string]int{"domain1": 1})
+	mockPendingTaskTracker.EXPECT().GetMinimumTaskKey().Return(minTaskKey, true)
+	mockPendingTaskTracker.EXPECT().GetTasks().Return(map[persistence.HistoryTaskKey]task.Task{minTaskKey: mockTask})
+	mockTask.EXPECT().GetVisibilityTimestamp().Return(now)
+
+	slice := &virtualSliceImpl{
+		pendingTaskTracker: mockPendingTaskTracker,
+	}
+
+	assert.Equal(t, PendingTaskStats{
+		PendingTaskCountPerDomain: map[string]int{"domain1": 1},
+		OldestPendingTaskTime:     now,
+	}, slice.PendingTaskStats())
+}
+
 func TestMergeVirtualSlicesWithDifferentPredicate(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -102,12 +102,15 @@ type (
 		activityScheduleToStartTimeout int32
 		tasklist                       types.TaskList
 		partitionConfig                map[string]string
+		priority                       int32
+		fairnessKey                    string
 	}
 
 	pushDecisionToMatchingInfo struct {
 		decisionScheduleToStartTimeout int32
 		tasklist                       types.TaskList
 		partitionConfig                map[string]string
+		priority                       int32
 	}
 )
 
@@ -115,12 +118,16 @@ func newPushActivityToMatchingInfo(
 	activityScheduleToStartTimeout int32,
 	tasklist types.TaskList,
 	partitionConfig map[string]string,
+	priority int32,
+	fairnessKey string,
 ) *pushActivityToMatchingInfo {
 
 	return &pushActivityToMatchingInfo{
 		activityScheduleToStartTimeout: activityScheduleToStartTimeout,
 		tasklist:                       tasklist,
 		partitionConfig:                partitionConfig,
+		priority:                       priority,
+		fairnessKey:                    fairnessKey,
 	}
 }
 
@@ -128,12 +135,14 @@ func newPushDecisionToMatchingInfo(
 	decisionScheduleToStartTimeout int32,
 	tasklist types.TaskList,
 	partitionConfig map[string]string,
+	priority int32,
 ) *pushDecisionToMatchingInfo {
 
 	return &pushDecisionToMatchingInfo{
 		decisionScheduleToStartTimeout: decisionScheduleToStartTimeout,
 		tasklist:                       tasklist,
 		partitionConfig:                partitionConfig,
+		priority:                       priority,
 	}
 }
 
@@ -327,6 +327,22 @@ func getWorkflowExecution(
 	}
 }
 
+// getDecisionTaskPriority returns the matching priority of a decision task. Workflows are not scheduled
+// with a priority of their own, so the decision task takes the highest priority of the pending activities,
+// which keeps a workflow waiting on urgent activities from queuing behind less urgent ones
+func getDecisionTaskPriority(
+	mutableState execution.MutableState,
+) int32 {
+	var priority int32
+	found := false
+	for _, ai := range mutableState.GetPendingActivityInfos() {
+		if !found || ai.Priority > priority {
+			priority, found = ai.Priority, true
+		}
+	}
+	return priority
+}
+
 func shouldPushToMatching(
 	ctx context.Context,
 	shard shard.Context,
@@ -735,6 +735,8 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		Name: activityInfo.TaskList,
 	}
 	scheduleToStartTimeout := activityInfo.ScheduleToStartTimeout
+	priority := activityInfo.Priority
+	fairnessKey := activityInfo.FairnessKey
 
 	release(nil) // release earlier as we don't need the lock anymore
 
@@ -754,6 +756,8 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		ScheduleID:                    scheduledID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(scheduleToStartTimeout),
 		PartitionConfig:               mutableState.GetExecutionInfo().PartitionConfig,
+		Priority:                      priority,
+		FairnessKey:                   fairnessKey,
 	})
 	return err
 }
@@ -224,6 +224,8 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 		activityScheduleToStartTimeout: timeout,
 		tasklist:                       taskList,
 		partitionConfig:                mutableState.GetExecutionInfo().PartitionConfig,
+		priority:                       ai.Priority,
+		fairnessKey:                    ai.FairnessKey,
 	}
 	err = t.pushActivity(ctx, task, pushActivityInfo)
 	if err == nil {
@@ -299,6 +301,7 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 	// or even lost the decision if there's originally no timeout timer task
 	// for the decision. Using MaxTaskTimeout here for now so at least no
 	// decision will be lost.
+	priority := getDecisionTaskPriority(mutableState)
 
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
@@ -313,6 +316,7 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 		decisionScheduleToStartTimeout: decisionTimeout,
 		tasklist:                       taskList,
 		partitionConfig:                mutableState.GetExecutionInfo().PartitionConfig,
+		priority:                       priority,
 	})
 	if _, ok := err.(*types.StickyWorkerUnavailableError); ok {
 		// sticky worker is unavailable, switch to non-sticky task list
@@ -329,6 +333,7 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 			decisionScheduleToStartTimeout: decisionTimeout,
 			tasklist:                       taskList,
 			partitionConfig:                mutableState.GetExecutionInfo().PartitionConfig,
+			priority:                       priority,
 		})
 	}
 	if err == nil {
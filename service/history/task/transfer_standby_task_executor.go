@@ -170,6 +170,8 @@ func (t *transferStandbyTaskExecutor) processActivityTask(
 				activityInfo.ScheduleToStartTimeout,
 				taskList,
 				mutableState.GetExecutionInfo().PartitionConfig,
+				activityInfo.Priority,
+				activityInfo.FairnessKey,
 			), nil
 		}
 
@@ -227,6 +229,7 @@ func (t *transferStandbyTaskExecutor) processDecisionTask(
 				decisionTimeout,
 				types.TaskList{Name: executionInfo.TaskList, Kind: executionInfo.TaskListKind.Ptr()}, // at standby, always use non-sticky tasklist
 				mutableState.GetExecutionInfo().PartitionConfig,
+				getDecisionTaskPriority(mutableState),
 			), nil
 		}
 
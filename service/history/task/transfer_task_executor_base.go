@@ -133,6 +133,8 @@ func (t *transferTaskExecutorBase) pushActivity(
 		ScheduleID:                    task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(activityScheduleToStartTimeout),
 		PartitionConfig:               partitionConfig,
+		Priority:                      pushActivityInfo.priority,
+		FairnessKey:                   pushActivityInfo.fairnessKey,
 	})
 	return err
 }
@@ -176,6 +178,7 @@ func (t *transferTaskExecutorBase) pushDecision(
 		ScheduleID:                    task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(decisionScheduleToStartTimeout),
 		PartitionConfig:               partitionConfig,
+		Priority:                      pushDecisionInfo.priority,
 	})
 	return err
 }
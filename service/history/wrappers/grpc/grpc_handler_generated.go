@@ -239,3 +239,8 @@ func (g GRPCHandler) TerminateWorkflowExecution(ctx context.Context, request *hi
 	err := g.h.TerminateWorkflowExecution(ctx, proto.ToHistoryTerminateWorkflowExecutionRequest(request))
 	return &historyv1.TerminateWorkflowExecutionResponse{}, proto.FromError(err)
 }
+
+func (g GRPCHandler) UpdateVirtualQueue(ctx context.Context, request *historyv1.UpdateVirtualQueueRequest) (*historyv1.UpdateVirtualQueueResponse, error) {
+	err := g.h.UpdateVirtualQueue(ctx, proto.ToHistoryUpdateVirtualQueueRequest(request))
+	return &historyv1.UpdateVirtualQueueResponse{}, proto.FromError(err)
+}
@@ -327,3 +327,7 @@ func (h *historyHandler) SyncShardStatus(ctx context.Context, sp1 *types.SyncSha
 func (h *historyHandler) TerminateWorkflowExecution(ctx context.Context, hp1 *types.HistoryTerminateWorkflowExecutionRequest) (err error) {
 	return h.wrapped.TerminateWorkflowExecution(ctx, hp1)
 }
+
+func (h *historyHandler) UpdateVirtualQueue(ctx context.Context, up1 *types.UpdateVirtualQueueRequest) (err error) {
+	return h.wrapped.UpdateVirtualQueue(ctx, up1)
+}
@@ -227,3 +227,8 @@ func (g ThriftHandler) TerminateWorkflowExecution(ctx context.Context, Terminate
 	err = g.h.TerminateWorkflowExecution(ctx, thrift.ToHistoryTerminateWorkflowExecutionRequest(TerminateRequest))
 	return thrift.FromError(err)
 }
+
+func (g ThriftHandler) UpdateVirtualQueue(ctx context.Context, Request *shared.UpdateVirtualQueueRequest) (err error) {
+	err = g.h.UpdateVirtualQueue(ctx, thrift.ToHistoryUpdateVirtualQueueRequest(Request))
+	return thrift.FromError(err)
+}
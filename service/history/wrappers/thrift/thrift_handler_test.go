@@ -301,4 +301,9 @@ func TestThriftHandler(t *testing.T) {
 		err := th.TerminateWorkflowExecution(ctx, &hist.TerminateWorkflowExecutionRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("UpdateVirtualQueue", func(t *testing.T) {
+		h.EXPECT().UpdateVirtualQueue(ctx, &types.UpdateVirtualQueueRequest{}).Return(internalErr).Times(1)
+		err := th.UpdateVirtualQueue(ctx, &shared.UpdateVirtualQueueRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 }
@@ -73,6 +73,8 @@ type (
 		QPSTrackerInterval                        dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
 		OverrideTaskListRPS                       dynamicproperties.FloatPropertyFnWithTaskListInfoFilters
 		EnablePartitionIsolationGroupAssignment   dynamicproperties.BoolPropertyFnWithTaskListInfoFilters
+		PriorityStarvationPreventionInterval      dynamicproperties.IntPropertyFnWithTaskListInfoFilters
+		BacklogReadAheadLimit                     dynamicproperties.IntPropertyFnWithTaskListInfoFilters
 		IsolationGroupUpscaleSustainedDuration    dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
 		IsolationGroupDownscaleSustainedDuration  dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
 		IsolationGroupHasPollersSustainedDuration dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
@@ -96,6 +98,10 @@ type (
 
 		ActivityTaskSyncMatchWaitTime dynamicproperties.DurationPropertyFnWithDomainFilter
 
+		// fairness configuration
+		FairnessKeyWeights     dynamicproperties.MapPropertyFnWithDomainFilter
+		FairnessKeyDispatchRPS dynamicproperties.MapPropertyFnWithDomainFilter
+
 		// isolation configuration
 		EnableTasklistIsolation dynamicproperties.BoolPropertyFnWithDomainFilter
 		AllIsolationGroups      func() []string
@@ -147,6 +153,10 @@ type (
 		QPSTrackerInterval                        func() time.Duration
 		OverrideTaskListRPS                       func() float64
 		EnablePartitionIsolationGroupAssignment   func() bool
+		PriorityStarvationPreventionInterval      func() int
+		BacklogReadAheadLimit                     func() int
+		FairnessKeyWeights                        func() map[string]interface{}
+		FairnessKeyDispatchRPS                    func() map[string]interface{}
 		IsolationGroupUpscaleSustainedDuration    func() time.Duration
 		IsolationGroupDownscaleSustainedDuration  func() time.Duration
 		IsolationGroupHasPollersSustainedDuration func() time.Duration
@@ -213,6 +223,8 @@ func NewConfig(dc *dynamicconfig.Collection, hostName string, rpcConfig config.R
 		EnableTaskInfoLogByDomainID:                dc.GetBoolPropertyFilteredByDomainID(dynamicproperties.MatchingEnableTaskInfoLogByDomainID),
 		ActivityTaskSyncMatchWaitTime:              dc.GetDurationPropertyFilteredByDomain(dynamicproperties.MatchingActivityTaskSyncMatchWaitTime),
 		EnableTasklistIsolation:                    dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableTasklistIsolation),
+		FairnessKeyWeights:                         dc.GetMapPropertyFilteredByDomain(dynamicproperties.MatchingFairnessKeyWeights),
+		FairnessKeyDispatchRPS:                     dc.GetMapPropertyFilteredByDomain(dynamicproperties.MatchingFairnessKeyDispatchRPS),
 		AppendTaskTimeout:                          dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.AppendTaskTimeout),
 		AsyncTaskDispatchTimeout:                   dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.AsyncTaskDispatchTimeout),
 		EnableTasklistOwnershipGuard:               dc.GetBoolProperty(dynamicproperties.MatchingEnableTasklistGuardAgainstOwnershipShardLoss),
@@ -228,6 +240,8 @@ func NewConfig(dc *dynamicconfig.Collection, hostName string, rpcConfig config.R
 		QPSTrackerInterval:                         dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.MatchingQPSTrackerInterval),
 		OverrideTaskListRPS:                        dc.GetFloat64PropertyFilteredByTaskListInfo(dynamicproperties.MatchingOverrideTaskListRPS),
 		EnablePartitionIsolationGroupAssignment:    dc.GetBoolPropertyFilteredByTaskListInfo(dynamicproperties.EnablePartitionIsolationGroupAssignment),
+		PriorityStarvationPreventionInterval:       dc.GetIntPropertyFilteredByTaskListInfo(dynamicproperties.MatchingPriorityStarvationPreventionInterval),
+		BacklogReadAheadLimit:                      dc.GetIntPropertyFilteredByTaskListInfo(dynamicproperties.MatchingBacklogReadAheadLimit),
 		IsolationGroupUpscaleSustainedDuration:     dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.MatchingIsolationGroupUpscaleSustainedDuration),
 		IsolationGroupDownscaleSustainedDuration:   dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.MatchingIsolationGroupDownscaleSustainedDuration),
 		IsolationGroupHasPollersSustainedDuration:  dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.MatchingIsolationGroupHasPollersSustainedDuration),
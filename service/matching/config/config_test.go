@@ -108,6 +108,10 @@ func TestNewConfig(t *testing.T) {
 		"AppendTaskTimeout":                          {dynamicproperties.AppendTaskTimeout, time.Duration(42)},
 		"ExcludeShortLivedTaskListsFromShardManager": {dynamicproperties.MatchingExcludeShortLivedTaskListsFromShardManager, false},
 		"PercentageOnboardedToShardManager":          {dynamicproperties.MatchingPercentageOnboardedToShardManager, 0},
+		"PriorityStarvationPreventionInterval":       {dynamicproperties.MatchingPriorityStarvationPreventionInterval, 43},
+		"BacklogReadAheadLimit":                      {dynamicproperties.MatchingBacklogReadAheadLimit, 44},
+		"FairnessKeyWeights":                         {dynamicproperties.MatchingFairnessKeyWeights, map[string]interface{}{"tenant": 3}},
+		"FairnessKeyDispatchRPS":                     {dynamicproperties.MatchingFairnessKeyDispatchRPS, map[string]interface{}{"tenant": 10.0}},
 	}
 	client := dynamicconfig.NewInMemoryClient()
 	for fieldName, expected := range fields {
@@ -174,6 +178,8 @@ func getValue(f *reflect.Value) interface{} {
 			return fn()
 		case dynamicproperties.MapPropertyFn:
 			return fn()
+		case dynamicproperties.MapPropertyFnWithDomainFilter:
+			return fn("domain")
 		case dynamicproperties.StringPropertyFn:
 			return fn()
 		case dynamicproperties.FloatPropertyFnWithTaskListInfoFilters:
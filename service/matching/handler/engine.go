@@ -440,6 +440,7 @@ func (e *matchingEngineImpl) AddDecisionTask(
 		ScheduleToStartTimeoutSeconds: request.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:                   e.timeSource.Now(),
 		PartitionConfig:               request.GetPartitionConfig(),
+		Priority:                      request.GetPriority(),
 	}
 
 	syncMatched, err := tlMgr.AddTask(hCtx.Context, tasklist.AddTaskParams{
@@ -515,6 +516,8 @@ func (e *matchingEngineImpl) AddActivityTask(
 		ScheduleToStartTimeoutSeconds: request.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:                   e.timeSource.Now(),
 		PartitionConfig:               request.GetPartitionConfig(),
+		Priority:                      request.GetPriority(),
+		FairnessKey:                   request.GetFairnessKey(),
 	}
 
 	syncMatched, err := tlMgr.AddTask(hCtx.Context, tasklist.AddTaskParams{
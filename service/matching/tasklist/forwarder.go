@@ -145,6 +145,7 @@ func (fwdr *forwarderImpl) ForwardTask(ctx context.Context, task *InternalTask)
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.GetName(),
 			PartitionConfig:               task.Event.PartitionConfig,
+			Priority:                      task.Event.Priority,
 		})
 	case persistence.TaskListTypeActivity:
 		_, err = fwdr.client.AddActivityTask(ctx, &types.AddActivityTaskRequest{
@@ -160,6 +161,8 @@ func (fwdr *forwarderImpl) ForwardTask(ctx context.Context, task *InternalTask)
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.GetName(),
 			PartitionConfig:               task.Event.PartitionConfig,
+			Priority:                      task.Event.Priority,
+			FairnessKey:                   task.Event.FairnessKey,
 		})
 	default:
 		return ErrInvalidTaskListType
@@ -118,6 +118,7 @@ func (t *ForwarderTestSuite) TestForwardActivityTask() {
 	).Return(&types.AddActivityTaskResponse{}, nil).Times(1)
 
 	taskInfo := t.newTaskInfo()
+	taskInfo.FairnessKey = "tenant"
 	task := newInternalTask(taskInfo, nil, types.TaskSourceHistory, "", false, nil, "")
 	t.NoError(t.fwdr.ForwardTask(context.Background(), task))
 	t.NotNil(request)
@@ -125,6 +126,7 @@ func (t *ForwarderTestSuite) TestForwardActivityTask() {
 	t.Equal(t.fwdr.taskListKind, request.TaskList.GetKind())
 	t.Equal(t.taskList.domainID, request.GetDomainUUID())
 	t.Equal(taskInfo.DomainID, request.GetSourceDomainUUID())
+	t.Equal("tenant", request.GetFairnessKey())
 	t.Equal(taskInfo.WorkflowID, request.GetExecution().GetWorkflowID())
 	t.Equal(taskInfo.RunID, request.GetExecution().GetRunID())
 	t.Equal(taskInfo.ScheduleID, request.GetScheduleID())
@@ -25,6 +25,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/uber/cadence/common/clock"
@@ -47,6 +48,13 @@ type taskMatcherImpl struct {
 	// tasks having no isolation requirement are added to this channel
 	// and pollers from all isolation groups read from this channel
 	taskC chan *InternalTask
+	// synchronous task channel to match producer/consumer for tasks having no isolation
+	// requirement and a priority above the default. Pollers check this channel before
+	// the others so that urgent tasks don't wait behind a backlog of default ones
+	priorityTaskC chan *InternalTask
+	// number of polls served, used to periodically skip the priority check so that
+	// tasks of default priority are not starved
+	numPolls atomic.Int64
 	// synchronos task channels to match producer/consumer for a certain isolation group
 	// the key is the name of the isolation group
 	isolatedTaskC map[string]chan *InternalTask
@@ -100,6 +108,7 @@ func newTaskMatcher(
 		scope:         scope,
 		fwdr:          fwdr,
 		taskC:         make(chan *InternalTask),
+		priorityTaskC: make(chan *InternalTask),
 		isolatedTaskC: isolatedTaskC,
 		queryTaskC:    make(chan *InternalTask),
 		config:        config,
@@ -435,8 +444,13 @@ func (tm *taskMatcherImpl) Poll(ctx context.Context, isolationGroup string) (*In
 		}
 	}()
 
+	if task = tm.pollPriorityNonBlocking(); task != nil {
+		tm.scope.RecordTimer(metrics.PollLocalMatchLatencyPerTaskList, time.Since(startT))
+		return task, nil
+	}
+
 	// try local match first without blocking until context timeout
-	if task, err = tm.pollNonBlocking(ctxWithCancelPropagation, isolatedTaskC, tm.taskC, tm.queryTaskC); err == nil {
+	if task, err = tm.pollNonBlocking(ctxWithCancelPropagation, isolatedTaskC, tm.taskC, tm.priorityTaskC, tm.queryTaskC); err == nil {
 		tm.scope.RecordTimer(metrics.PollLocalMatchLatencyPerTaskList, time.Since(startT))
 		return task, nil
 	}
@@ -454,7 +468,7 @@ func (tm *taskMatcherImpl) Poll(ctx context.Context, isolationGroup string) (*In
 		TaskListKind: tm.tasklistKind.Ptr(),
 		EventName:    "Matcher Falling Back to Non-Local Polling",
 	})
-	task, err = tm.pollOrForward(ctxWithCancelPropagation, startT, isolationGroup, isolatedTaskC, tm.taskC, tm.queryTaskC)
+	task, err = tm.pollOrForward(ctxWithCancelPropagation, startT, isolationGroup, isolatedTaskC, tm.taskC, tm.priorityTaskC, tm.queryTaskC)
 	return task, err
 }
 
@@ -464,7 +478,7 @@ func (tm *taskMatcherImpl) Poll(ctx context.Context, isolationGroup string) (*In
 func (tm *taskMatcherImpl) PollForQuery(ctx context.Context) (*InternalTask, error) {
 	startT := time.Now()
 	// try local match first without blocking until context timeout
-	if task, err := tm.pollNonBlocking(ctx, nil, nil, tm.queryTaskC); err == nil {
+	if task, err := tm.pollNonBlocking(ctx, nil, nil, nil, tm.queryTaskC); err == nil {
 		tm.scope.RecordTimer(metrics.PollLocalMatchLatencyPerTaskList, time.Since(startT))
 		return task, nil
 	}
@@ -475,7 +489,7 @@ func (tm *taskMatcherImpl) PollForQuery(ctx context.Context) (*InternalTask, err
 	// there is no local poller available to pickup this task. Now block waiting
 	// either for a local poller or a forwarding token to be available. When a
 	// forwarding token becomes available, send this poll to a parent partition
-	return tm.pollOrForward(ctxWithCancelPropagation, startT, "", nil, nil, tm.queryTaskC)
+	return tm.pollOrForward(ctxWithCancelPropagation, startT, "", nil, nil, nil, tm.queryTaskC)
 }
 
 func (tm *taskMatcherImpl) RefreshCancelContext() {
@@ -490,6 +504,7 @@ func (tm *taskMatcherImpl) pollOrForward(
 	isolationGroup string,
 	isolatedTaskC <-chan *InternalTask,
 	taskC <-chan *InternalTask,
+	priorityTaskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
 	select {
@@ -533,6 +548,10 @@ func (tm *taskMatcherImpl) pollOrForward(
 			},
 		})
 		return task, nil
+	case task := <-priorityTaskC:
+		tm.scope.RecordTimer(metrics.PollLocalMatchLatencyPerTaskList, time.Since(startT))
+		tm.onPriorityTaskMatched(task, "Matched Priority Task (pollOrForward)")
+		return task, nil
 	case task := <-queryTaskC:
 		tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
 		tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
@@ -568,7 +587,7 @@ func (tm *taskMatcherImpl) pollOrForward(
 			return task, nil
 		}
 		token.release()
-		return tm.poll(ctx, startT, isolatedTaskC, taskC, queryTaskC)
+		return tm.poll(ctx, startT, isolatedTaskC, taskC, priorityTaskC, queryTaskC)
 	}
 }
 
@@ -577,6 +596,7 @@ func (tm *taskMatcherImpl) poll(
 	startT time.Time,
 	isolatedTaskC <-chan *InternalTask,
 	taskC <-chan *InternalTask,
+	priorityTaskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
 	select {
@@ -620,6 +640,10 @@ func (tm *taskMatcherImpl) poll(
 			},
 		})
 		return task, nil
+	case task := <-priorityTaskC:
+		tm.scope.RecordTimer(metrics.PollLocalMatchAfterForwardFailedLatencyPerTaskList, time.Since(startT))
+		tm.onPriorityTaskMatched(task, "Matched Priority Task (poll)")
+		return task, nil
 	case task := <-queryTaskC:
 		tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
 		tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
@@ -640,6 +664,7 @@ func (tm *taskMatcherImpl) pollLocalWait(
 	ctx context.Context,
 	isolatedTaskC <-chan *InternalTask,
 	taskC <-chan *InternalTask,
+	priorityTaskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
 	select {
@@ -681,6 +706,9 @@ func (tm *taskMatcherImpl) pollLocalWait(
 			},
 		})
 		return task, nil
+	case task := <-priorityTaskC:
+		tm.onPriorityTaskMatched(task, "Matched Priority Task Nonblocking")
+		return task, nil
 	case task := <-queryTaskC:
 		tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
 		tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
@@ -700,13 +728,14 @@ func (tm *taskMatcherImpl) pollNonBlocking(
 	ctx context.Context,
 	isolatedTaskC <-chan *InternalTask,
 	taskC <-chan *InternalTask,
+	priorityTaskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
 	waitTime := tm.config.LocalPollWaitTime()
 	if waitTime > 0 {
 		childCtx, cancel := context.WithTimeout(ctx, waitTime)
 		defer cancel()
-		return tm.pollLocalWait(childCtx, isolatedTaskC, taskC, queryTaskC)
+		return tm.pollLocalWait(childCtx, isolatedTaskC, taskC, priorityTaskC, queryTaskC)
 	}
 	select {
 	case task := <-isolatedTaskC:
@@ -747,6 +776,9 @@ func (tm *taskMatcherImpl) pollNonBlocking(
 			},
 		})
 		return task, nil
+	case task := <-priorityTaskC:
+		tm.onPriorityTaskMatched(task, "Matched Priority Task Nonblocking")
+		return task, nil
 	case task := <-queryTaskC:
 		tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
 		tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
@@ -762,6 +794,41 @@ func (tm *taskMatcherImpl) pollNonBlocking(
 	}
 }
 
+// pollPriorityNonBlocking returns a task of elevated priority if one is waiting to be matched,
+// except on every n-th poll where it returns nil to give tasks of default priority a chance
+func (tm *taskMatcherImpl) pollPriorityNonBlocking() *InternalTask {
+	numPolls := tm.numPolls.Add(1)
+	if interval := tm.config.PriorityStarvationPreventionInterval(); interval > 0 && numPolls%int64(interval) == 0 {
+		return nil
+	}
+	select {
+	case task := <-tm.priorityTaskC:
+		tm.onPriorityTaskMatched(task, "Matched Priority Task Nonblocking")
+		return task
+	default:
+		return nil
+	}
+}
+
+func (tm *taskMatcherImpl) onPriorityTaskMatched(task *InternalTask, eventName string) {
+	if task.ResponseC != nil {
+		tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+	}
+	tm.scope.IncCounter(metrics.PollSuccessPerTaskListCounter)
+	event.Log(event.E{
+		TaskListName: tm.tasklist.GetName(),
+		TaskListType: tm.tasklist.GetType(),
+		TaskListKind: tm.tasklistKind.Ptr(),
+		TaskInfo:     task.Info(),
+		EventName:    eventName,
+		Payload: map[string]any{
+			"TaskIsForwarded": task.IsForwarded(),
+			"SyncMatched":     task.ResponseC != nil,
+			"Priority":        task.Priority(),
+		},
+	})
+}
+
 func (tm *taskMatcherImpl) fwdrPollReqTokenC() <-chan *ForwarderReqToken {
 	if tm.fwdr == nil {
 		return noopForwarderTokenC
@@ -797,6 +864,9 @@ func (tm *taskMatcherImpl) getTaskC(task *InternalTask) chan<- *InternalTask {
 	taskC := tm.taskC
 	if isolatedTaskC, ok := tm.isolatedTaskC[task.isolationGroup]; ok && task.isolationGroup != "" {
 		taskC = isolatedTaskC
+	} else if task.Priority() > 0 {
+		// isolation takes precedence over priority
+		taskC = tm.priorityTaskC
 	}
 	return taskC
 }
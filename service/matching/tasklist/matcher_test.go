@@ -1054,7 +1054,7 @@ func (t *MatcherTestSuite) Test_pollOrForward_PollIsolatedTask() {
 	// Test pollOrForward for isolated task - poll
 	isolatedTask := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, isolationGroup)
 	isolatedTaskC <- isolatedTask
-	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, isolatedTaskC, nil, nil)
+	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, isolatedTaskC, nil, nil, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(isolatedTask, retTask)
@@ -1077,7 +1077,7 @@ func (t *MatcherTestSuite) Test_pollOrForward_PollTask() {
 	// Test pollOrForward for regular task - poll
 	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
 	taskC <- task
-	retTask, err := t.matcher.pollOrForward(ctx, startT, "", nil, taskC, nil)
+	retTask, err := t.matcher.pollOrForward(ctx, startT, "", nil, taskC, nil, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(task, retTask)
@@ -1097,7 +1097,7 @@ func (t *MatcherTestSuite) Test_pollOrForward_PollQueryTask() {
 	// Test pollOrForward for query task - poll
 	queryTask := newInternalQueryTask(uuid.New(), &types.MatchingQueryWorkflowRequest{})
 	queryTaskC <- queryTask
-	retTask, err := t.matcher.pollOrForward(ctx, startT, "", nil, nil, queryTaskC)
+	retTask, err := t.matcher.pollOrForward(ctx, startT, "", nil, nil, nil, queryTaskC)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(queryTask, retTask)
@@ -1122,7 +1122,7 @@ func (t *MatcherTestSuite) Test_pollOrForward_ForwardTask() {
 	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
 	mockForwarder.EXPECT().ForwardPoll(ctx).Return(task, nil).Times(1)
 
-	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, nil, nil, nil)
+	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, nil, nil, nil, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(task, retTask)
@@ -1157,7 +1157,7 @@ func (t *MatcherTestSuite) Test_pollOrForward_ForwardTaskThenPoll() {
 		}
 	}()
 
-	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, nil, taskC, nil)
+	retTask, err := t.matcher.pollOrForward(ctx, startT, isolationGroup, nil, taskC, nil, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(task, retTask)
@@ -1171,7 +1171,7 @@ func (t *MatcherTestSuite) Test_poll_IsolatedTask() {
 
 	isolatedTask := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, isolationGroup)
 	isolatedTaskC <- isolatedTask
-	retTask, err := t.matcher.poll(ctx, startT, isolatedTaskC, nil, nil)
+	retTask, err := t.matcher.poll(ctx, startT, isolatedTaskC, nil, nil, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(isolatedTask, retTask)
@@ -1184,7 +1184,7 @@ func (t *MatcherTestSuite) Test_poll_Task() {
 
 	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
 	taskC <- task
-	retTask, err := t.matcher.poll(ctx, startT, nil, taskC, nil)
+	retTask, err := t.matcher.poll(ctx, startT, nil, taskC, nil, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(task, retTask)
@@ -1197,7 +1197,7 @@ func (t *MatcherTestSuite) Test_poll_QueryTask() {
 
 	queryTask := newInternalQueryTask(uuid.New(), &types.MatchingQueryWorkflowRequest{})
 	queryTaskC <- queryTask
-	retTask, err := t.matcher.poll(ctx, startT, nil, nil, queryTaskC)
+	retTask, err := t.matcher.poll(ctx, startT, nil, nil, nil, queryTaskC)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(queryTask, retTask)
@@ -1209,7 +1209,7 @@ func (t *MatcherTestSuite) Test_poll_ContextExpired() {
 
 	startT := time.Now()
 
-	retTask, err := t.matcher.poll(ctx, startT, nil, nil, nil)
+	retTask, err := t.matcher.poll(ctx, startT, nil, nil, nil, nil)
 
 	t.ErrorIs(err, ErrNoTasks)
 	t.Nil(retTask)
@@ -1224,7 +1224,7 @@ func (t *MatcherTestSuite) Test_pollNonBlocking_IsolatedTask() {
 
 	isolatedTask := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, isolationGroup)
 	isolatedTaskC <- isolatedTask
-	retTask, err := t.matcher.pollNonBlocking(ctx, isolatedTaskC, nil, nil)
+	retTask, err := t.matcher.pollNonBlocking(ctx, isolatedTaskC, nil, nil, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(isolatedTask, retTask)
@@ -1238,7 +1238,7 @@ func (t *MatcherTestSuite) Test_pollNonBlocking_Task() {
 
 	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
 	taskC <- task
-	retTask, err := t.matcher.pollNonBlocking(ctx, nil, taskC, nil)
+	retTask, err := t.matcher.pollNonBlocking(ctx, nil, taskC, nil, nil)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(task, retTask)
@@ -1252,7 +1252,7 @@ func (t *MatcherTestSuite) Test_pollNonBlocking_QueryTask() {
 
 	queryTask := newInternalQueryTask(uuid.New(), &types.MatchingQueryWorkflowRequest{})
 	queryTaskC <- queryTask
-	retTask, err := t.matcher.pollNonBlocking(ctx, nil, nil, queryTaskC)
+	retTask, err := t.matcher.pollNonBlocking(ctx, nil, nil, nil, queryTaskC)
 	t.NoError(err)
 	t.NotNil(retTask)
 	t.Equal(queryTask, retTask)
@@ -1263,12 +1263,60 @@ func (t *MatcherTestSuite) Test_pollNonBlocking_NoTasks() {
 
 	ctx := context.Background()
 
-	retTask, err := t.matcher.pollNonBlocking(ctx, nil, nil, nil)
+	retTask, err := t.matcher.pollNonBlocking(ctx, nil, nil, nil, nil)
 
 	t.ErrorIs(err, ErrNoTasks)
 	t.Nil(retTask)
 }
 
+func (t *MatcherTestSuite) Test_pollNonBlocking_PriorityTask() {
+	t.matcher.config.LocalPollWaitTime = func() time.Duration { return 0 }
+
+	ctx := context.Background()
+	priorityTaskC := make(chan *InternalTask, 1)
+
+	taskInfo := t.newTaskInfo()
+	taskInfo.Priority = 5
+	task := newInternalTask(taskInfo, nil, types.TaskSourceHistory, "", true, nil, "")
+	priorityTaskC <- task
+	retTask, err := t.matcher.pollNonBlocking(ctx, nil, nil, priorityTaskC, nil)
+	t.NoError(err)
+	t.Equal(task, retTask)
+}
+
+func (t *MatcherTestSuite) TestPoll_PrefersPriorityTask() {
+	t.matcher.config.PriorityStarvationPreventionInterval = func() int { return 2 }
+	t.matcher.taskC = make(chan *InternalTask, 1)
+	t.matcher.priorityTaskC = make(chan *InternalTask, 2)
+
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true, nil, "")
+	priorityTasks := make([]*InternalTask, 2)
+	for i := range priorityTasks {
+		taskInfo := t.newTaskInfo()
+		taskInfo.Priority = 5
+		priorityTasks[i] = newInternalTask(taskInfo, nil, types.TaskSourceHistory, "", true, nil, "")
+		t.Equal((chan<- *InternalTask)(t.matcher.priorityTaskC), t.matcher.getTaskC(priorityTasks[i]))
+		t.matcher.priorityTaskC <- priorityTasks[i]
+	}
+	t.Equal((chan<- *InternalTask)(t.matcher.taskC), t.matcher.getTaskC(task))
+	t.matcher.taskC <- task
+
+	retTask, err := t.matcher.Poll(context.Background(), "")
+	t.NoError(err)
+	t.Equal(priorityTasks[0], retTask)
+
+	// every second poll skips the priority check so that tasks of default priority are not starved
+	t.Nil(t.matcher.pollPriorityNonBlocking())
+	t.Equal(priorityTasks[1], t.matcher.pollPriorityNonBlocking())
+}
+
+func (t *MatcherTestSuite) TestGetTaskC_IsolationTakesPrecedenceOverPriority() {
+	taskInfo := t.newTaskInfo()
+	taskInfo.Priority = 5
+	task := newInternalTask(taskInfo, nil, types.TaskSourceHistory, "", true, nil, "dca1")
+	t.Equal((chan<- *InternalTask)(t.matcher.isolatedTaskC["dca1"]), t.matcher.getTaskC(task))
+}
+
 func (t *MatcherTestSuite) Test_fwdrPollReqTokenC() {
 	t.matcher.fwdr = nil
 	t.Equal(noopForwarderTokenC, t.matcher.fwdrPollReqTokenC())
@@ -148,6 +148,15 @@ func (task *InternalTask) Info() persistence.TaskInfo {
 	return *task.Event.TaskInfo
 }
 
+// Priority returns the priority of an activity or decision task, higher values are more urgent.
+// Query tasks and tasks received from another host have the default priority of 0
+func (task *InternalTask) Priority() int32 {
+	if task == nil || task.Event == nil || task.Event.TaskInfo == nil {
+		return 0
+	}
+	return task.Event.TaskInfo.Priority
+}
+
 func (task *InternalTask) WorkflowExecution() *types.WorkflowExecution {
 	switch {
 	case task.Event != nil:
@@ -0,0 +1,405 @@
+package tasklist
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/persistence"
+	ctask "github.com/uber/cadence/common/task"
+)
+
+type (
+	// taskBuffer is a bounded in-memory queue of backlog tasks waiting to be dispatched.
+	// Tasks are dequeued highest priority first. Within the same priority, tasks are grouped by
+	// fairness key and the keys take turns following a weighted round-robin schedule, so that one
+	// key with a large backlog cannot monopolize dispatch. Tasks of the same key are dequeued in read order.
+	// Keys that are over their dispatch rate are skipped until they are allowed again.
+	// To keep lower priorities from being starved, every n-th dequeue returns the oldest
+	// buffered task regardless of its priority, where n is given by starvationInterval.
+	// Tasks offered to a full buffer displace lower priority ones, which are spilled: only their
+	// IDs are kept, so that the task reader can read them again from persistence once there is room.
+	// Within the same priority, the key with the most buffered tasks for its weight is spilled first.
+	taskBuffer struct {
+		sync.Mutex
+		// items holds one token per buffered task and slots holds one token per used slot,
+		// together they make put and get block the same way a buffered channel would
+		items              chan struct{}
+		slots              chan struct{}
+		added              chan struct{} // wakes up a get waiting for throttled fairness keys
+		levels             map[int32]*fairTaskQueue
+		spilled            []spilledTask // sorted by task ID
+		dequeued           int
+		starvationInterval func() int
+		fairnessKeyWeights func() map[string]interface{}
+		// allowFairnessKey returns zero if a task of the key can be dispatched now,
+		// otherwise how long until it can
+		allowFairnessKey func(key string) time.Duration
+	}
+
+	// spilledTask is a task that was read from persistence but did not fit in the buffer
+	spilledTask struct {
+		taskID      int64
+		priority    int32
+		fairnessKey string
+	}
+
+	// fairTaskQueue holds the buffered tasks of a single priority grouped by fairness key
+	fairTaskQueue struct {
+		tasks map[string][]*persistence.TaskInfo
+		// iter walks a weighted round-robin schedule over the fairness keys. It is rebuilt once
+		// exhausted, which is when new keys and weight changes are picked up
+		iter ctask.Iterator[string]
+	}
+)
+
+func newTaskBuffer(
+	capacity int,
+	starvationInterval func() int,
+	fairnessKeyWeights func() map[string]interface{},
+	allowFairnessKey func(key string) time.Duration,
+) *taskBuffer {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &taskBuffer{
+		items:              make(chan struct{}, capacity),
+		slots:              make(chan struct{}, capacity),
+		added:              make(chan struct{}, 1),
+		levels:             make(map[int32]*fairTaskQueue),
+		starvationInterval: starvationInterval,
+		fairnessKeyWeights: fairnessKeyWeights,
+		allowFairnessKey:   allowFairnessKey,
+	}
+}
+
+// put blocks until there is room in the buffer or ctx is done.
+// Returns false if the task was not added.
+func (b *taskBuffer) put(ctx context.Context, task *persistence.TaskInfo) bool {
+	select {
+	case b.slots <- struct{}{}:
+	case <-ctx.Done():
+		return false
+	}
+	b.Lock()
+	b.addLocked(task)
+	b.Unlock()
+	b.items <- struct{}{}
+	return true
+}
+
+// offer adds the task to the buffer without blocking. If the buffer is full, the lowest priority task
+// among the buffered tasks and the offered one is spilled. Within the same priority, the newest task
+// of the key with the most buffered tasks for its weight is spilled
+func (b *taskBuffer) offer(task *persistence.TaskInfo) {
+	select {
+	case b.slots <- struct{}{}:
+		b.Lock()
+		b.addLocked(task)
+		b.Unlock()
+		b.items <- struct{}{}
+		return
+	default:
+	}
+
+	b.Lock()
+	defer b.Unlock()
+	lowest, found := int32(0), false
+	for priority := range b.levels {
+		if !found || priority < lowest {
+			lowest, found = priority, true
+		}
+	}
+	if !found || lowest > task.Priority {
+		b.spillLocked(task)
+		return
+	}
+	weights := b.fairnessKeyWeights()
+	level := b.levels[lowest]
+	heaviest := level.heaviest(weights)
+	if lowest == task.Priority {
+		// the offered task displaces a buffered one of the same priority only if its key
+		// would still have fewer tasks for its weight than the heaviest key
+		count, weight := len(level.tasks[heaviest]), fairnessKeyWeight(weights, heaviest)
+		offeredCount, offeredWeight := len(level.tasks[task.FairnessKey])+1, fairnessKeyWeight(weights, task.FairnessKey)
+		if heaviest == task.FairnessKey || count*offeredWeight <= offeredCount*weight {
+			b.spillLocked(task)
+			return
+		}
+	}
+	displaced := level.removeNewest(heaviest)
+	if len(level.tasks) == 0 {
+		delete(b.levels, lowest)
+	}
+	b.addLocked(task)
+	b.spillLocked(displaced)
+}
+
+// spilledToRefill returns the oldest spilled tasks that fit in the free room of the buffer
+func (b *taskBuffer) spilledToRefill() []spilledTask {
+	b.Lock()
+	defer b.Unlock()
+	n := min(cap(b.slots)-len(b.slots), len(b.spilled))
+	return slices.Clone(b.spilled[:n])
+}
+
+// unspill removes a task from the spilled tasks. Returns false if the task is not spilled
+func (b *taskBuffer) unspill(taskID int64) bool {
+	b.Lock()
+	defer b.Unlock()
+	i, found := slices.BinarySearchFunc(b.spilled, taskID, func(t spilledTask, id int64) int {
+		return cmp.Compare(t.taskID, id)
+	})
+	if found {
+		b.spilled = slices.Delete(b.spilled, i, i+1)
+	}
+	return found
+}
+
+// spilledCount returns the number of spilled tasks
+func (b *taskBuffer) spilledCount() int {
+	b.Lock()
+	defer b.Unlock()
+	return len(b.spilled)
+}
+
+func (b *taskBuffer) addLocked(task *persistence.TaskInfo) {
+	level, ok := b.levels[task.Priority]
+	if !ok {
+		level = &fairTaskQueue{tasks: make(map[string][]*persistence.TaskInfo)}
+		b.levels[task.Priority] = level
+	}
+	level.tasks[task.FairnessKey] = append(level.tasks[task.FairnessKey], task)
+	select {
+	case b.added <- struct{}{}:
+	default:
+	}
+}
+
+func (b *taskBuffer) spillLocked(task *persistence.TaskInfo) {
+	i, _ := slices.BinarySearchFunc(b.spilled, task.TaskID, func(t spilledTask, id int64) int {
+		return cmp.Compare(t.taskID, id)
+	})
+	b.spilled = slices.Insert(b.spilled, i, spilledTask{taskID: task.TaskID, priority: task.Priority, fairnessKey: task.FairnessKey})
+}
+
+// get blocks until a task is available or ctx is done.
+// Returns false if no task was dequeued.
+func (b *taskBuffer) get(ctx context.Context) (*persistence.TaskInfo, bool) {
+	for {
+		select {
+		case <-b.items:
+		case <-ctx.Done():
+			return nil, false
+		}
+		b.Lock()
+		task, delay := b.dequeueLocked()
+		b.Unlock()
+		if task != nil {
+			<-b.slots
+			return task, true
+		}
+
+		// every buffered fairness key is throttled, wait until one is allowed again or a task is added
+		b.items <- struct{}{}
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-b.added:
+			timer.Stop()
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, false
+		}
+	}
+}
+
+// len returns the number of buffered tasks
+func (b *taskBuffer) len() int {
+	return len(b.items)
+}
+
+// capacity returns the maximum number of tasks the buffer holds
+func (b *taskBuffer) capacity() int {
+	return cap(b.items)
+}
+
+// dequeueLocked removes and returns the next task to dispatch. If the keys of all buffered tasks
+// are throttled, it returns nil and how long until the first of them is allowed again
+func (b *taskBuffer) dequeueLocked() (*persistence.TaskInfo, time.Duration) {
+	throttled := make(map[string]time.Duration)
+	var task *persistence.TaskInfo
+	if interval := b.starvationInterval(); interval > 0 && (b.dequeued+1)%interval == 0 {
+		task = b.dequeueOldestLocked(throttled)
+	} else {
+		priorities := make([]int32, 0, len(b.levels))
+		for priority := range b.levels {
+			priorities = append(priorities, priority)
+		}
+		slices.Sort(priorities)
+		for i := len(priorities) - 1; i >= 0 && task == nil; i-- {
+			level := b.levels[priorities[i]]
+			task = level.next(b.fairnessKeyWeights, b.allowKey(throttled))
+			if len(level.tasks) == 0 {
+				delete(b.levels, priorities[i])
+			}
+		}
+	}
+	if task != nil {
+		b.dequeued++
+		return task, 0
+	}
+
+	delay := time.Duration(math.MaxInt64)
+	for _, d := range throttled {
+		delay = min(delay, d)
+	}
+	return nil, max(delay, time.Millisecond)
+}
+
+func (b *taskBuffer) dequeueOldestLocked(throttled map[string]time.Duration) *persistence.TaskInfo {
+	type head struct {
+		priority int32
+		task     *persistence.TaskInfo
+	}
+	var heads []head
+	for priority, level := range b.levels {
+		for _, tasks := range level.tasks {
+			heads = append(heads, head{priority: priority, task: tasks[0]})
+		}
+	}
+	slices.SortFunc(heads, func(a, b head) int {
+		return cmp.Compare(a.task.TaskID, b.task.TaskID)
+	})
+	allow := b.allowKey(throttled)
+	for _, h := range heads {
+		if !allow(h.task.FairnessKey) {
+			continue
+		}
+		level := b.levels[h.priority]
+		task := level.remove(h.task.FairnessKey)
+		if len(level.tasks) == 0 {
+			delete(b.levels, h.priority)
+		}
+		return task
+	}
+	return nil
+}
+
+// allowKey returns a function that reports whether a task of the key can be dispatched now,
+// recording the keys that are throttled and their delay
+func (b *taskBuffer) allowKey(throttled map[string]time.Duration) func(key string) bool {
+	return func(key string) bool {
+		if _, ok := throttled[key]; ok {
+			return false
+		}
+		if delay := b.allowFairnessKey(key); delay > 0 {
+			throttled[key] = delay
+			return false
+		}
+		return true
+	}
+}
+
+// next returns the head task of the next fairness key in the schedule that has buffered tasks and
+// is allowed by allow. Returns nil if no key is allowed, the queue must not be empty
+func (q *fairTaskQueue) next(fairnessKeyWeights func() map[string]interface{}, allow func(key string) bool) *persistence.TaskInfo {
+	denied := make(map[string]struct{})
+	for len(denied) < len(q.tasks) {
+		if q.iter == nil {
+			dcWeights := fairnessKeyWeights()
+			weights := make(map[string]int, len(q.tasks))
+			for key := range q.tasks {
+				weights[key] = fairnessKeyWeight(dcWeights, key)
+			}
+			q.iter = ctask.NewWeightedSchedule(weights).NewIterator()
+		}
+		key, ok := q.iter.TryNext()
+		if !ok {
+			q.iter = nil
+			continue
+		}
+		if _, ok := q.tasks[key]; !ok {
+			continue
+		}
+		if _, ok := denied[key]; ok {
+			continue
+		}
+		if !allow(key) {
+			denied[key] = struct{}{}
+			continue
+		}
+		return q.remove(key)
+	}
+	return nil
+}
+
+// heaviest returns the fairness key with the most buffered tasks for its weight, ties go to the
+// key with the most recently read task. The queue must not be empty
+func (q *fairTaskQueue) heaviest(weights map[string]interface{}) string {
+	var (
+		heaviestKey    string
+		heaviestCount  int
+		heaviestWeight int
+		newestTaskID   int64
+	)
+	for key, tasks := range q.tasks {
+		count, weight := len(tasks), fairnessKeyWeight(weights, key)
+		taskID := tasks[len(tasks)-1].TaskID
+		// compare count/weight without dividing
+		if heaviestCount == 0 || count*heaviestWeight > heaviestCount*weight ||
+			(count*heaviestWeight == heaviestCount*weight && taskID > newestTaskID) {
+			heaviestKey, heaviestCount, heaviestWeight, newestTaskID = key, count, weight, taskID
+		}
+	}
+	return heaviestKey
+}
+
+// removeNewest removes and returns the most recently read task of the given fairness key
+func (q *fairTaskQueue) removeNewest(key string) *persistence.TaskInfo {
+	tasks := q.tasks[key]
+	newest := tasks[len(tasks)-1]
+	tasks[len(tasks)-1] = nil
+	if len(tasks) == 1 {
+		delete(q.tasks, key)
+	} else {
+		q.tasks[key] = tasks[:len(tasks)-1]
+	}
+	return newest
+}
+
+// remove removes and returns the head task of the given fairness key
+func (q *fairTaskQueue) remove(key string) *persistence.TaskInfo {
+	tasks := q.tasks[key]
+	task := tasks[0]
+	tasks[0] = nil
+	if len(tasks) == 1 {
+		delete(q.tasks, key)
+	} else {
+		q.tasks[key] = tasks[1:]
+	}
+	return task
+}
+
+// fairnessKeyWeight returns the configured weight of a fairness key. Keys without a valid
+// positive weight get a weight of 1, so that every key is eventually served
+func fairnessKeyWeight(weights map[string]interface{}, key string) int {
+	weight := 1
+	switch value := weights[key].(type) {
+	case float64:
+		weight = int(value)
+	case int:
+		weight = value
+	case int32:
+		weight = int(value)
+	case int64:
+		weight = int(value)
+	}
+	if weight < 1 {
+		return 1
+	}
+	return weight
+}
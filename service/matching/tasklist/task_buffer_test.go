@@ -0,0 +1,291 @@
+package tasklist
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestTaskBuffer_Get(t *testing.T) {
+	cases := []struct {
+		name               string
+		starvationInterval int
+		priorities         []int32
+		expectedTaskIDs    []int64
+	}{
+		{
+			name:               "read order without priorities",
+			starvationInterval: 0,
+			priorities:         []int32{0, 0, 0},
+			expectedTaskIDs:    []int64{1, 2, 3},
+		},
+		{
+			name:               "highest priority first, read order within a priority",
+			starvationInterval: 0,
+			priorities:         []int32{0, 1, 5, 1, 5},
+			expectedTaskIDs:    []int64{3, 5, 2, 4, 1},
+		},
+		{
+			name:               "every n-th dequeue serves the oldest task",
+			starvationInterval: 3,
+			priorities:         []int32{0, 0, 5, 5, 5, 5},
+			expectedTaskIDs:    []int64{3, 4, 1, 5, 6, 2},
+		},
+		{
+			name:               "interval of one serves in read order",
+			starvationInterval: 1,
+			priorities:         []int32{0, 5, 1},
+			expectedTaskIDs:    []int64{1, 2, 3},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			buffer := newTaskBuffer(len(tc.priorities), func() int { return tc.starvationInterval }, noFairnessKeyWeights, noFairnessKeyLimit)
+			for i, priority := range tc.priorities {
+				require.True(t, buffer.put(context.Background(), &persistence.TaskInfo{TaskID: int64(i + 1), Priority: priority}))
+			}
+			assert.Equal(t, len(tc.priorities), buffer.len())
+
+			var taskIDs []int64
+			for range tc.priorities {
+				task, ok := buffer.get(context.Background())
+				require.True(t, ok)
+				taskIDs = append(taskIDs, task.TaskID)
+			}
+			assert.Equal(t, tc.expectedTaskIDs, taskIDs)
+			assert.Equal(t, 0, buffer.len())
+		})
+	}
+}
+
+func TestTaskBuffer_GetWeightedFairnessKeys(t *testing.T) {
+	weights := func() map[string]interface{} {
+		return map[string]interface{}{"tenant-a": 3, "tenant-b": 1.0}
+	}
+	buffer := newTaskBuffer(8, func() int { return 0 }, weights, noFairnessKeyLimit)
+	fairnessKeys := []string{"tenant-a", "tenant-a", "tenant-a", "tenant-a", "tenant-a", "tenant-a", "tenant-b", "tenant-b"}
+	for i, key := range fairnessKeys {
+		require.True(t, buffer.put(context.Background(), &persistence.TaskInfo{TaskID: int64(i + 1), FairnessKey: key}))
+	}
+
+	var taskIDs []int64
+	for range fairnessKeys {
+		task, ok := buffer.get(context.Background())
+		require.True(t, ok)
+		taskIDs = append(taskIDs, task.TaskID)
+	}
+	// tenant-a is served three times for every time tenant-b is served
+	assert.Equal(t, []int64{1, 2, 3, 7, 4, 5, 6, 8}, taskIDs)
+}
+
+func TestTaskBuffer_GetFairnessKeyIsNotStarvedByLargeBacklog(t *testing.T) {
+	buffer := newTaskBuffer(101, func() int { return 0 }, noFairnessKeyWeights, noFairnessKeyLimit)
+	for i := 1; i <= 100; i++ {
+		require.True(t, buffer.put(context.Background(), &persistence.TaskInfo{TaskID: int64(i), FairnessKey: "noisy"}))
+	}
+	require.True(t, buffer.put(context.Background(), &persistence.TaskInfo{TaskID: 101, FairnessKey: "quiet"}))
+
+	var fairnessKeys []string
+	for i := 0; i < 3; i++ {
+		task, ok := buffer.get(context.Background())
+		require.True(t, ok)
+		fairnessKeys = append(fairnessKeys, task.FairnessKey)
+	}
+	assert.Contains(t, fairnessKeys, "quiet")
+}
+
+func TestTaskBuffer_GetPriorityBeforeFairness(t *testing.T) {
+	buffer := newTaskBuffer(4, func() int { return 0 }, noFairnessKeyWeights, noFairnessKeyLimit)
+	for i, task := range []*persistence.TaskInfo{
+		{FairnessKey: "tenant-a"},
+		{FairnessKey: "tenant-b"},
+		{FairnessKey: "tenant-a", Priority: 5},
+		{FairnessKey: "tenant-a", Priority: 5},
+	} {
+		task.TaskID = int64(i + 1)
+		require.True(t, buffer.put(context.Background(), task))
+	}
+
+	var taskIDs []int64
+	for i := 0; i < 2; i++ {
+		task, ok := buffer.get(context.Background())
+		require.True(t, ok)
+		taskIDs = append(taskIDs, task.TaskID)
+	}
+	assert.Equal(t, []int64{3, 4}, taskIDs)
+}
+
+func TestTaskBuffer_GetSkipsThrottledFairnessKeys(t *testing.T) {
+	throttled := map[string]bool{"tenant-a": true}
+	var lock sync.Mutex
+	allow := func(key string) time.Duration {
+		lock.Lock()
+		defer lock.Unlock()
+		if throttled[key] {
+			return 10 * time.Millisecond
+		}
+		return 0
+	}
+	buffer := newTaskBuffer(4, func() int { return 0 }, noFairnessKeyWeights, allow)
+	for i, task := range []*persistence.TaskInfo{
+		{FairnessKey: "tenant-a", Priority: 5},
+		{FairnessKey: "tenant-a"},
+		{FairnessKey: "tenant-b"},
+	} {
+		task.TaskID = int64(i + 1)
+		require.True(t, buffer.put(context.Background(), task))
+	}
+
+	// tenant-a is over its rate, so the lower priority task of tenant-b goes first
+	task, ok := buffer.get(context.Background())
+	require.True(t, ok)
+	assert.Equal(t, int64(3), task.TaskID)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, ok = buffer.get(ctx)
+	assert.False(t, ok, "get should wait while every buffered key is throttled")
+	assert.Equal(t, 2, buffer.len())
+
+	lock.Lock()
+	throttled["tenant-a"] = false
+	lock.Unlock()
+	var taskIDs []int64
+	for i := 0; i < 2; i++ {
+		task, ok := buffer.get(context.Background())
+		require.True(t, ok)
+		taskIDs = append(taskIDs, task.TaskID)
+	}
+	assert.Equal(t, []int64{1, 2}, taskIDs)
+}
+
+func TestTaskBuffer_OfferSpillsHeaviestFairnessKey(t *testing.T) {
+	weights := func() map[string]interface{} {
+		return map[string]interface{}{"heavy-weight": 3}
+	}
+	buffer := newTaskBuffer(4, func() int { return 0 }, weights, noFairnessKeyLimit)
+	for i, key := range []string{"tenant-a", "tenant-a", "heavy-weight", "heavy-weight"} {
+		buffer.offer(&persistence.TaskInfo{TaskID: int64(i + 1), FairnessKey: key})
+	}
+
+	// tenant-a has the most tasks for its weight, so a new key displaces its newest task
+	buffer.offer(&persistence.TaskInfo{TaskID: 5, FairnessKey: "tenant-b"})
+	// a key with more tasks for its weight than the heaviest key spills its own task
+	buffer.offer(&persistence.TaskInfo{TaskID: 6, FairnessKey: "tenant-a"})
+	buffer.offer(&persistence.TaskInfo{TaskID: 7, FairnessKey: "tenant-b"})
+	assert.Equal(t, 4, buffer.len())
+
+	var fairnessKeys []string
+	for i := 0; i < 4; i++ {
+		task, ok := buffer.get(context.Background())
+		require.True(t, ok)
+		fairnessKeys = append(fairnessKeys, task.FairnessKey)
+	}
+	assert.ElementsMatch(t, []string{"tenant-a", "tenant-b", "heavy-weight", "heavy-weight"}, fairnessKeys)
+	assert.Equal(t, []spilledTask{
+		{taskID: 2, fairnessKey: "tenant-a"},
+		{taskID: 6, fairnessKey: "tenant-a"},
+		{taskID: 7, fairnessKey: "tenant-b"},
+	}, buffer.spilledToRefill())
+}
+
+func TestFairnessKeyWeight(t *testing.T) {
+	weights := map[string]interface{}{
+		"float":    2.0,
+		"int":      3,
+		"int64":    int64(4),
+		"zero":     0,
+		"negative": -1,
+		"invalid":  "5",
+	}
+	cases := map[string]int{
+		"float":    2,
+		"int":      3,
+		"int64":    4,
+		"zero":     1,
+		"negative": 1,
+		"invalid":  1,
+		"missing":  1,
+	}
+	for key, expected := range cases {
+		assert.Equal(t, expected, fairnessKeyWeight(weights, key), key)
+	}
+}
+
+func TestTaskBuffer_Offer(t *testing.T) {
+	buffer := newTaskBuffer(3, func() int { return 0 }, noFairnessKeyWeights, noFairnessKeyLimit)
+	for i, priority := range []int32{0, 0, 3} {
+		buffer.offer(&persistence.TaskInfo{TaskID: int64(i + 1), Priority: priority})
+	}
+	assert.Equal(t, 3, buffer.len())
+	assert.Equal(t, 0, buffer.spilledCount())
+
+	// a full buffer spills the offered task if nothing buffered has a lower priority
+	buffer.offer(&persistence.TaskInfo{TaskID: 4, Priority: 0})
+	// and the newest task of the lowest priority otherwise
+	buffer.offer(&persistence.TaskInfo{TaskID: 5, Priority: 5})
+	assert.Equal(t, 3, buffer.len())
+	assert.Equal(t, 2, buffer.spilledCount())
+	assert.Empty(t, buffer.spilledToRefill(), "there is no room for spilled tasks")
+
+	var taskIDs []int64
+	for i := 0; i < 2; i++ {
+		task, ok := buffer.get(context.Background())
+		require.True(t, ok)
+		taskIDs = append(taskIDs, task.TaskID)
+	}
+	assert.Equal(t, []int64{5, 3}, taskIDs)
+	assert.Equal(t, []spilledTask{{taskID: 2}, {taskID: 4}}, buffer.spilledToRefill())
+
+	assert.True(t, buffer.unspill(2))
+	assert.False(t, buffer.unspill(2), "task is no longer spilled")
+	assert.Equal(t, 1, buffer.spilledCount())
+}
+
+func TestTaskBuffer_Blocking(t *testing.T) {
+	buffer := newTaskBuffer(1, func() int { return 0 }, noFairnessKeyWeights, noFairnessKeyLimit)
+	assert.Equal(t, 1, buffer.capacity())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	task, ok := buffer.get(ctx)
+	assert.False(t, ok, "get should give up when the buffer stays empty")
+	assert.Nil(t, task)
+
+	require.True(t, buffer.put(context.Background(), &persistence.TaskInfo{TaskID: 1}))
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.False(t, buffer.put(ctx, &persistence.TaskInfo{TaskID: 2}), "put should give up when the buffer stays full")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.True(t, buffer.put(context.Background(), &persistence.TaskInfo{TaskID: 3}))
+	}()
+	task, ok = buffer.get(context.Background())
+	require.True(t, ok)
+	assert.Equal(t, int64(1), task.TaskID)
+	<-done
+	task, ok = buffer.get(context.Background())
+	require.True(t, ok)
+	assert.Equal(t, int64(3), task.TaskID)
+}
+
+func TestNewTaskBuffer_MinimumCapacity(t *testing.T) {
+	assert.Equal(t, 1, newTaskBuffer(0, func() int { return 0 }, noFairnessKeyWeights, noFairnessKeyLimit).capacity())
+}
+
+func noFairnessKeyWeights() map[string]interface{} {
+	return nil
+}
+
+func noFairnessKeyLimit(string) time.Duration {
+	return 0
+}
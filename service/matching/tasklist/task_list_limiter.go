@@ -28,6 +28,11 @@ type taskListLimiter struct {
 	lastUpdate        atomic.Time
 	countPartitions   func() int
 	partitions        int
+
+	// fairnessKeyRPS limits the dispatch rate of each fairness key on top of the task list limit
+	fairnessKeyRPS      func() map[string]interface{}
+	fairnessKeyLock     sync.Mutex
+	fairnessKeyLimiters map[string]clock.Ratelimiter
 }
 
 func newTaskListLimiter(timeSource clock.TimeSource, scope metrics.Scope, config *config.TaskListConfig, numPartitions func() int) *taskListLimiter {
@@ -37,6 +42,9 @@ func newTaskListLimiter(timeSource clock.TimeSource, scope metrics.Scope, config
 		ttl:             config.TaskDispatchRPSTTL,
 		countPartitions: numPartitions,
 		minBurst:        config.MinTaskThrottlingBurstSize(),
+
+		fairnessKeyRPS:      config.FairnessKeyDispatchRPS,
+		fairnessKeyLimiters: make(map[string]clock.Ratelimiter),
 	}
 	l.value.Store(config.TaskDispatchRPS)
 	l.partitions = numPartitions()
@@ -63,6 +71,37 @@ func (l *taskListLimiter) Limit() rate.Limit {
 	return l.backing.Limit()
 }
 
+// AllowFairnessKey takes a dispatch token of the fairness key and returns zero if a task of the key
+// can be dispatched now. Otherwise it returns how long until it can, without taking a token.
+// Fairness keys without a configured rate are always allowed
+func (l *taskListLimiter) AllowFairnessKey(key string) time.Duration {
+	rps := fairnessKeyRPS(l.fairnessKeyRPS(), key)
+	l.lock.Lock()
+	partitions := l.partitions
+	l.lock.Unlock()
+	l.fairnessKeyLock.Lock()
+	defer l.fairnessKeyLock.Unlock()
+	if rps <= 0 {
+		delete(l.fairnessKeyLimiters, key)
+		return 0
+	}
+
+	rps = rps / float64(max(partitions, 1))
+	limit, burst := rate.Limit(rps), max(int(math.Ceil(rps)), l.minBurst)
+	limiter, ok := l.fairnessKeyLimiters[key]
+	if !ok {
+		limiter = clock.NewRateLimiterWithTimeSource(l.timeSource, limit, burst)
+		l.fairnessKeyLimiters[key] = limiter
+	} else if limiter.Limit() != limit || limiter.Burst() != burst {
+		limiter.SetLimitAndBurst(limit, burst)
+	}
+	if tokens := limiter.Tokens(); tokens < 1 {
+		return time.Duration((1 - tokens) / rps * float64(time.Second))
+	}
+	limiter.Allow()
+	return 0
+}
+
 func (l *taskListLimiter) ReportLimit(rps float64) {
 	now := l.timeSource.Now()
 	// Optimistically reject it without locking if it's >= current and within the TTL
@@ -126,3 +165,18 @@ func (l *taskListLimiter) getLimitAndBurst() (rate.Limit, int) {
 	}
 	return rate.Limit(rps), burst
 }
+
+// fairnessKeyRPS returns the configured dispatch rate of a fairness key, zero if there is none
+func fairnessKeyRPS(values map[string]interface{}, key string) float64 {
+	switch value := values[key].(type) {
+	case float64:
+		return value
+	case int:
+		return float64(value)
+	case int32:
+		return float64(value)
+	case int64:
+		return float64(value)
+	}
+	return 0
+}
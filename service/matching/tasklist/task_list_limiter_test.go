@@ -149,3 +149,52 @@ func TestTaskListLimiter(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskListLimiter_AllowFairnessKey(t *testing.T) {
+	mockClock := clock.NewMockedTimeSource()
+	numPartitions := 2
+	tlConfig := &config.TaskListConfig{
+		TaskDispatchRPSTTL: time.Second,
+		TaskDispatchRPS:    100,
+		MinTaskThrottlingBurstSize: func() int {
+			return 1
+		},
+		FairnessKeyDispatchRPS: func() map[string]interface{} {
+			return map[string]interface{}{"limited": 4, "disabled": 0.0}
+		},
+	}
+	limiter := newTaskListLimiter(mockClock, metrics.NoopScope, tlConfig, func() int {
+		return numPartitions
+	})
+
+	// 4 rps split across 2 partitions allows a burst of 2 tasks
+	assert.Zero(t, limiter.AllowFairnessKey("limited"))
+	assert.Zero(t, limiter.AllowFairnessKey("limited"))
+	assert.Equal(t, 500*time.Millisecond, limiter.AllowFairnessKey("limited"))
+	mockClock.Advance(500 * time.Millisecond)
+	assert.Zero(t, limiter.AllowFairnessKey("limited"))
+
+	for i := 0; i < 10; i++ {
+		assert.Zero(t, limiter.AllowFairnessKey("disabled"))
+		assert.Zero(t, limiter.AllowFairnessKey("missing"))
+	}
+}
+
+func TestFairnessKeyRPS(t *testing.T) {
+	values := map[string]interface{}{
+		"float":   2.5,
+		"int":     3,
+		"int64":   int64(4),
+		"invalid": "5",
+	}
+	cases := map[string]float64{
+		"float":   2.5,
+		"int":     3,
+		"int64":   4,
+		"invalid": 0,
+		"missing": 0,
+	}
+	for key, expected := range cases {
+		assert.Equal(t, expected, fairnessKeyRPS(values, key), key)
+	}
+}
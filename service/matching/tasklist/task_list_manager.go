@@ -791,6 +791,10 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 		IsolationGroupMetrics: isolationGroupMetrics,
 		NewTasksPerSecond:     c.qpsTracker.QPS(),
 		Empty:                 c.taskAckManager.GetAckLevel() == c.taskWriter.GetMaxReadLevel(),
+		// only tasks already read into memory have a known priority and fairness key,
+		// the rest of the backlog is still in persistence
+		BacklogCountByPriority:    c.taskReader.getBacklogCountByPriority(),
+		BacklogCountByFairnessKey: c.taskReader.getBacklogCountByFairnessKey(),
 	}
 
 	return response
@@ -1106,6 +1110,18 @@ func newTaskListConfig(id *Identifier, cfg *config.Config, domainName string) *c
 		EnablePartitionIsolationGroupAssignment: func() bool {
 			return cfg.EnablePartitionIsolationGroupAssignment(domainName, taskListName, taskType)
 		},
+		PriorityStarvationPreventionInterval: func() int {
+			return cfg.PriorityStarvationPreventionInterval(domainName, taskListName, taskType)
+		},
+		BacklogReadAheadLimit: func() int {
+			return cfg.BacklogReadAheadLimit(domainName, taskListName, taskType)
+		},
+		FairnessKeyWeights: func() map[string]interface{} {
+			return cfg.FairnessKeyWeights(domainName)
+		},
+		FairnessKeyDispatchRPS: func() map[string]interface{} {
+			return cfg.FairnessKeyDispatchRPS(domainName)
+		},
 		IsolationGroupUpscaleSustainedDuration: func() time.Duration {
 			return cfg.IsolationGroupUpscaleSustainedDuration(domainName, taskListName, taskType)
 		},
@@ -132,7 +132,7 @@ func TestDeliverBufferTasks(t *testing.T) {
 		func(tlm *taskListManagerImpl) { tlm.taskReader.cancelFunc() },
 		func(tlm *taskListManagerImpl) {
 			tlm.limiter.ReportLimit(0.1)
-			tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup] <- &persistence.TaskInfo{}
+			tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].put(context.Background(), &persistence.TaskInfo{})
 			err := tlm.matcher.(*taskMatcherImpl).ratelimit(context.Background()) // consume the token
 			assert.NoError(t, err)
 			tlm.taskReader.cancelFunc()
@@ -166,7 +166,7 @@ func TestDeliverBufferTasks_NoPollers(t *testing.T) {
 	logger := testlogger.New(t)
 
 	tlm := createTestTaskListManager(t, logger, controller)
-	tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup] <- &persistence.TaskInfo{}
+	tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].put(context.Background(), &persistence.TaskInfo{})
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
@@ -386,6 +386,31 @@ func TestDescribeTaskList(t *testing.T) {
 				Empty: false,
 			},
 		},
+		{
+			name:          "with status, buffered tasks by priority and fairness key",
+			includeStatus: true,
+			allowance: func(_ *gomock.Controller, tlm *taskListManagerImpl) {
+				for i, priority := range []int32{0, 5, 5} {
+					fairnessKey := "tenant-a"
+					if i == 0 {
+						fairnessKey = "tenant-b"
+					}
+					require.True(t, tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].put(context.Background(), &persistence.TaskInfo{Priority: priority, FairnessKey: fairnessKey}))
+					tlm.taskReader.updateBacklogCount(priority, fairnessKey, 1)
+				}
+			},
+			expectedStatus: &types.TaskListStatus{
+				RatePerSecond: defaultRps,
+				TaskIDBlock:   firstIDBlock,
+				IsolationGroupMetrics: map[string]*types.IsolationGroupMetrics{
+					"datacenterA": {},
+					"datacenterB": {},
+				},
+				Empty:                     true,
+				BacklogCountByPriority:    map[int32]int64{0: 1, 5: 2},
+				BacklogCountByFairnessKey: map[string]int64{"tenant-a": 2, "tenant-b": 1},
+			},
+		},
 		{
 			name:          "with status, pollers and metrics",
 			includeStatus: true,
@@ -964,6 +989,8 @@ func TestTaskListManagerGetTaskBatch(t *testing.T) {
 	cfg := defaultTestConfig()
 	cfg.RangeSize = rangeSize
 	cfg.ReadRangeSize = dynamicproperties.GetIntPropertyFn(rangeSize / 2)
+	// read only as far as the buffer allows so the backlog is left in persistence
+	cfg.BacklogReadAheadLimit = dynamicproperties.GetIntPropertyFilteredByTaskListInfo(0)
 	mockRegistry := NewMockTaskListRegistry(controller)
 	mockRegistry.EXPECT().Unregister(gomock.Any()).AnyTimes()
 	params := ManagerParams{
@@ -1007,9 +1034,9 @@ func TestTaskListManagerGetTaskBatch(t *testing.T) {
 
 	// wait until all tasks are read by the task pump and enqeued into the in-memory buffer
 	// at the end of this step, ackManager readLevel will also be equal to the buffer size
-	expectedBufSize := min(cap(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]), taskCount)
+	expectedBufSize := min(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].capacity(), taskCount)
 	assert.True(t, awaitCondition(func() bool {
-		return len(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]) == expectedBufSize
+		return tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].len() == expectedBufSize
 	}, 10*time.Second))
 
 	// stop all goroutines that read / write tasks in the background
@@ -1169,6 +1196,57 @@ func TestTaskListReaderPumpAdvancesAckLevelAfterEmptyReads(t *testing.T) {
 	}
 }
 
+func TestTaskListManagerBacklogReadAhead(t *testing.T) {
+	controller := gomock.NewController(t)
+	cfg := defaultTestConfig()
+	// buffer capacity of 3 tasks
+	cfg.GetTasksBatchSize = dynamicproperties.GetIntPropertyFilteredByTaskListInfo(4)
+	tlm := createTestTaskListManagerWithConfig(t, testlogger.New(t), controller, cfg, clock.NewMockedTimeSource())
+	buffer := tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]
+
+	var created []*persistence.CreateTaskInfo
+	for i, priority := range []int32{0, 0, 0, 0, 5} {
+		created = append(created, &persistence.CreateTaskInfo{
+			TaskID: int64(i + 1),
+			Data:   &persistence.TaskInfo{DomainID: "domain", WorkflowID: "wf", RunID: "run", ScheduleID: int64(i), Priority: priority},
+		})
+	}
+	_, err := tlm.db.CreateTasks(created)
+	require.NoError(t, err)
+	tasks, err := tlm.taskReader.getTaskBatchWithRange(0, int64(len(created)))
+	require.NoError(t, err)
+	for _, task := range tasks {
+		require.True(t, tlm.taskReader.addSingleTaskToBuffer(task))
+	}
+	// task 4 did not fit and task 3 made room for the higher priority task 5
+	assert.Equal(t, 3, buffer.len())
+	assert.Equal(t, 2, tlm.taskReader.spilledTaskCount())
+	assert.Equal(t, map[int32]int64{0: 4, 5: 1}, tlm.taskReader.getBacklogCountByPriority())
+
+	var dispatched []int64
+	for i := 0; i < 2; i++ {
+		task, ok := buffer.get(context.Background())
+		require.True(t, ok)
+		dispatched = append(dispatched, task.TaskID)
+	}
+	assert.Equal(t, []int64{5, 1}, dispatched)
+
+	// task 4 was completed elsewhere in the meantime, so only task 3 is read back
+	require.NoError(t, tlm.db.store.CompleteTask(context.Background(), &persistence.CompleteTaskRequest{
+		TaskList: &persistence.TaskListInfo{DomainID: "domain", Name: "tl", TaskType: persistence.TaskListTypeActivity},
+		TaskID:   4,
+	}))
+	require.NoError(t, tlm.taskReader.refillSpilledTasks())
+	assert.Equal(t, 0, tlm.taskReader.spilledTaskCount())
+	assert.Equal(t, map[int32]int64{0: 3, 5: 1}, tlm.taskReader.getBacklogCountByPriority())
+	assert.Equal(t, map[string]int64{"": 4}, tlm.taskReader.getBacklogCountByFairnessKey())
+	for _, expected := range []int64{2, 3} {
+		task, ok := buffer.get(context.Background())
+		require.True(t, ok)
+		assert.Equal(t, expected, task.TaskID)
+	}
+}
+
 func TestTaskListManagerGetTaskBatch_ReadBatchDone(t *testing.T) {
 	const rangeSize = 10
 	const maxReadLevel = int64(120)
@@ -1244,6 +1322,8 @@ func TestTaskExpiryAndCompletion(t *testing.T) {
 			cfg.RangeSize = rangeSize
 			cfg.ReadRangeSize = dynamicproperties.GetIntPropertyFn(rangeSize / 2)
 			cfg.MaxTaskDeleteBatchSize = dynamicproperties.GetIntPropertyFilteredByTaskListInfo(tc.batchSize)
+			// read only as far as the buffer allows so the backlog is left in persistence
+			cfg.BacklogReadAheadLimit = dynamicproperties.GetIntPropertyFilteredByTaskListInfo(0)
 			cfg.MaxTimeBetweenTaskDeletes = tc.maxTimeBtwnDeletes
 			// set idle timer check to a really small value to assert that we don't accidentally drop tasks while blocking
 			// on enqueuing a task to task buffer
@@ -1294,7 +1374,7 @@ func TestTaskExpiryAndCompletion(t *testing.T) {
 			// wait until all tasks are loaded by into in-memory buffers by task list manager
 			// the buffer size should be one less than expected because dispatcher will dequeue the head
 			assert.True(t, awaitCondition(func() bool {
-				return len(tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]) >= (taskCount/2 - 1)
+				return tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup].len() >= (taskCount/2 - 1)
 			}, time.Second))
 
 			remaining := taskCount
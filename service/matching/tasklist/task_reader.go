@@ -25,6 +25,8 @@ package tasklist
 import (
 	"context"
 	"errors"
+	"maps"
+	"math"
 	"runtime"
 	"sync"
 	"sync/atomic"
@@ -60,18 +62,23 @@ type (
 		// that are enqueued for pollers to pickup. It's written to by
 		// - getTasksPump - the primary means of loading async matching tasks
 		// - task dispatch redirection - when a task is redirected from another isolation group
-		taskBuffers     map[string]chan *persistence.TaskInfo
-		notifyC         chan struct{} // Used as signal to notify pump of new tasks
-		tlMgr           *taskListManagerImpl
-		taskListID      *Identifier
-		config          *config.TaskListConfig
-		db              *taskListDB
-		taskWriter      *taskWriter
-		taskGC          *taskGC
-		taskAckManager  messaging.AckManager
-		domainCache     cache.DomainCache
-		clusterMetadata cluster.Metadata
-		timeSource      clock.TimeSource
+		taskBuffers map[string]*taskBuffer
+		// backlogByPriority and backlogByFairnessKey count the tasks read from persistence that are
+		// not completed yet, whether they are buffered, spilled or being dispatched
+		backlogLock          sync.Mutex
+		backlogByPriority    map[int32]int64
+		backlogByFairnessKey map[string]int64
+		notifyC              chan struct{} // Used as signal to notify pump of new tasks
+		tlMgr                *taskListManagerImpl
+		taskListID           *Identifier
+		config               *config.TaskListConfig
+		db                   *taskListDB
+		taskWriter           *taskWriter
+		taskGC               *taskGC
+		taskAckManager       messaging.AckManager
+		domainCache          cache.DomainCache
+		clusterMetadata      cluster.Metadata
+		timeSource           clock.TimeSource
 		// The cancel objects are to cancel the ratelimiter Wait in dispatchBufferedTasks. The ideal
 		// approach is to use request-scoped contexts and use a unique one for each call to Wait. However
 		// in order to cancel it on shutdown, we need a new goroutine for each call that would wait on
@@ -96,7 +103,7 @@ type (
 
 func newTaskReader(tlMgr *taskListManagerImpl, isolationGroups []string) *taskReader {
 	ctx, cancel := context.WithCancel(context.Background())
-	taskBuffers := make(map[string]chan *persistence.TaskInfo)
+	taskBuffers := make(map[string]*taskBuffer)
 
 	// Validate batch size to prevent system failures
 	batchSize := tlMgr.config.GetTasksBatchSize()
@@ -108,21 +115,23 @@ func newTaskReader(tlMgr *taskListManagerImpl, isolationGroups []string) *taskRe
 		batchSize = fallback
 	}
 
-	taskBuffers[defaultTaskBufferIsolationGroup] = make(chan *persistence.TaskInfo, batchSize-1)
+	taskBuffers[defaultTaskBufferIsolationGroup] = newTaskBuffer(batchSize-1, tlMgr.config.PriorityStarvationPreventionInterval, tlMgr.config.FairnessKeyWeights, tlMgr.limiter.AllowFairnessKey)
 	for _, g := range isolationGroups {
-		taskBuffers[g] = make(chan *persistence.TaskInfo, batchSize-1)
+		taskBuffers[g] = newTaskBuffer(batchSize-1, tlMgr.config.PriorityStarvationPreventionInterval, tlMgr.config.FairnessKeyWeights, tlMgr.limiter.AllowFairnessKey)
 	}
 	return &taskReader{
-		tlMgr:          tlMgr,
-		taskListID:     tlMgr.taskListID,
-		config:         tlMgr.config,
-		db:             tlMgr.db,
-		taskWriter:     tlMgr.taskWriter,
-		taskGC:         tlMgr.taskGC,
-		taskAckManager: tlMgr.taskAckManager,
-		cancelCtx:      ctx,
-		cancelFunc:     cancel,
-		notifyC:        make(chan struct{}, 1),
+		tlMgr:                tlMgr,
+		taskListID:           tlMgr.taskListID,
+		config:               tlMgr.config,
+		db:                   tlMgr.db,
+		taskWriter:           tlMgr.taskWriter,
+		taskGC:               tlMgr.taskGC,
+		taskAckManager:       tlMgr.taskAckManager,
+		cancelCtx:            ctx,
+		cancelFunc:           cancel,
+		notifyC:              make(chan struct{}, 1),
+		backlogByPriority:    make(map[int32]int64),
+		backlogByFairnessKey: make(map[string]int64),
 		// we always dequeue the head of the buffer and try to dispatch it to a poller
 		// so allocate one less than desired target buffer size
 		taskBuffers:              taskBuffers,
@@ -182,27 +191,26 @@ func (tr *taskReader) Signal() {
 }
 
 func (tr *taskReader) dispatchBufferedTasks(isolationGroup string) {
-dispatchLoop:
 	for {
-		select {
-		case taskInfo, ok := <-tr.taskBuffers[isolationGroup]:
-			if !ok { // Task list getTasks pump is shutdown
-				break dispatchLoop
-			}
-			event.Log(event.E{
-				TaskListName: tr.taskListID.GetName(),
-				TaskListType: tr.taskListID.GetType(),
-				TaskListKind: &tr.tlMgr.taskListKind,
-				TaskInfo:     *taskInfo,
-				EventName:    "Attempting to Dispatch Buffered Task",
-			})
-			breakDispatchLoop := tr.dispatchSingleTaskFromBufferWithRetries(taskInfo)
-			if breakDispatchLoop {
-				// shutting down
-				break dispatchLoop
-			}
-		case <-tr.cancelCtx.Done():
-			break dispatchLoop
+		taskInfo, ok := tr.taskBuffers[isolationGroup].get(tr.cancelCtx)
+		if !ok { // task list is shutting down
+			return
+		}
+		if tr.hasSpilledTasks() {
+			// there is room to read spilled tasks again
+			tr.Signal()
+		}
+		event.Log(event.E{
+			TaskListName: tr.taskListID.GetName(),
+			TaskListType: tr.taskListID.GetType(),
+			TaskListKind: &tr.tlMgr.taskListKind,
+			TaskInfo:     *taskInfo,
+			EventName:    "Attempting to Dispatch Buffered Task",
+		})
+		breakDispatchLoop := tr.dispatchSingleTaskFromBufferWithRetries(taskInfo)
+		if breakDispatchLoop {
+			// shutting down
+			return
 		}
 	}
 }
@@ -218,6 +226,17 @@ getTasksPumpLoop:
 			break getTasksPumpLoop
 		case <-tr.notifyC:
 			{
+				if tr.hasSpilledTasks() {
+					if err := tr.refillSpilledTasks(); err != nil {
+						tr.Signal() // re-enqueue the event
+						continue getTasksPumpLoop
+					}
+					// stop reading ahead until dispatching makes room for the spilled tasks
+					if tr.spilledTaskCount() >= tr.config.BacklogReadAheadLimit() {
+						continue getTasksPumpLoop
+					}
+				}
+
 				initialReadLevel := tr.taskAckManager.GetReadLevel()
 				maxReadLevel := tr.taskWriter.GetMaxReadLevel()
 
@@ -348,18 +367,112 @@ func (tr *taskReader) addSingleTaskToBuffer(task *persistence.TaskInfo) bool {
 	if err != nil {
 		tr.logger.Fatal("critical bug when adding item to ackManager", tag.Error(err))
 	}
+	tr.updateBacklogCount(task.Priority, task.FairnessKey, 1)
 	// Ignore the isolation duration as we're just putting it into a buffer to be dispatched later.
 	isolationGroup, _ := tr.getIsolationGroupForTask(tr.cancelCtx, task)
 	buffer, ok := tr.taskBuffers[isolationGroup]
 	if !ok {
 		buffer = tr.taskBuffers[defaultTaskBufferIsolationGroup]
 	}
-	select {
-	case buffer <- task:
-		return true
-	case <-tr.cancelCtx.Done():
-		return false
+	if tr.config.BacklogReadAheadLimit() <= 0 {
+		return buffer.put(tr.cancelCtx, task)
+	}
+	// read ahead: a full buffer spills its lowest priority task instead of blocking the pump,
+	// so that higher priority tasks further in the backlog are dispatched first
+	buffer.offer(task)
+	return true
+}
+
+func (tr *taskReader) hasSpilledTasks() bool {
+	return tr.spilledTaskCount() > 0
+}
+
+func (tr *taskReader) spilledTaskCount() int {
+	count := 0
+	for _, buffer := range tr.taskBuffers {
+		count += buffer.spilledCount()
+	}
+	return count
+}
+
+// refillSpilledTasks reads the oldest spilled tasks again from persistence, as many as there is room for
+// in their buffers. Spilled tasks are never acked, so they are still in persistence unless another owner of
+// the task list completed them, in which case they are acked here
+func (tr *taskReader) refillSpilledTasks() error {
+	spilled := make(map[int64]*taskBuffer)
+	spilledTasks := make(map[int64]spilledTask)
+	minTaskID, maxTaskID := int64(math.MaxInt64), int64(math.MinInt64)
+	for _, buffer := range tr.taskBuffers {
+		for _, t := range buffer.spilledToRefill() {
+			spilled[t.taskID] = buffer
+			spilledTasks[t.taskID] = t
+			minTaskID = min(minTaskID, t.taskID)
+			maxTaskID = max(maxTaskID, t.taskID)
+		}
+	}
+
+	readLevel := minTaskID - 1
+	for len(spilled) > 0 && readLevel < maxTaskID {
+		tasks, err := tr.getTaskBatchWithRange(readLevel, maxTaskID)
+		if err != nil {
+			return err
+		}
+		if len(tasks) == 0 {
+			break
+		}
+		for _, task := range tasks {
+			if buffer, ok := spilled[task.TaskID]; ok {
+				delete(spilled, task.TaskID)
+				if buffer.unspill(task.TaskID) {
+					buffer.offer(task)
+				}
+			}
+		}
+		readLevel = tasks[len(tasks)-1].TaskID
+	}
+
+	for taskID, buffer := range spilled {
+		if buffer.unspill(taskID) {
+			tr.updateBacklogCount(spilledTasks[taskID].priority, spilledTasks[taskID].fairnessKey, -1)
+			tr.taskGC.Run(tr.taskAckManager.AckItem(taskID))
+		}
+	}
+	return nil
+}
+
+func (tr *taskReader) updateBacklogCount(priority int32, fairnessKey string, delta int64) {
+	tr.backlogLock.Lock()
+	defer tr.backlogLock.Unlock()
+	tr.backlogByPriority[priority] += delta
+	if tr.backlogByPriority[priority] <= 0 {
+		delete(tr.backlogByPriority, priority)
+	}
+	tr.backlogByFairnessKey[fairnessKey] += delta
+	if tr.backlogByFairnessKey[fairnessKey] <= 0 {
+		delete(tr.backlogByFairnessKey, fairnessKey)
+	}
+}
+
+// getBacklogCountByPriority returns the number of tasks read from persistence and not completed yet,
+// for each task priority. Tasks past the read ahead limit are counted once read. Returns nil if there are none
+func (tr *taskReader) getBacklogCountByPriority() map[int32]int64 {
+	tr.backlogLock.Lock()
+	defer tr.backlogLock.Unlock()
+	if len(tr.backlogByPriority) == 0 {
+		return nil
+	}
+	return maps.Clone(tr.backlogByPriority)
+}
+
+// getBacklogCountByFairnessKey returns the number of tasks read from persistence and not completed yet,
+// for each fairness key. Tasks without a fairness key are counted under the empty key. Returns nil if there are none
+func (tr *taskReader) getBacklogCountByFairnessKey() map[string]int64 {
+	tr.backlogLock.Lock()
+	defer tr.backlogLock.Unlock()
+	if len(tr.backlogByFairnessKey) == 0 {
+		return nil
 	}
+	return maps.Clone(tr.backlogByFairnessKey)
 }
 
 func (tr *taskReader) persistAckLevel() error {
@@ -403,6 +516,7 @@ func (tr *taskReader) completeTask(task *persistence.TaskInfo, err error) {
 		}
 		tr.Signal()
 	}
+	tr.updateBacklogCount(task.Priority, task.FairnessKey, -1)
 	ackLevel := tr.taskAckManager.AckItem(task.TaskID)
 	tr.taskGC.Run(ackLevel)
 }
@@ -463,6 +577,7 @@ func (tr *taskReader) dispatchSingleTaskFromBuffer(taskInfo *persistence.TaskInf
 		e.EventName = "Task Expired"
 		event.Log(e)
 		tr.scope.IncCounter(metrics.ExpiredTasksPerTaskListCounter)
+		tr.updateBacklogCount(taskInfo.Priority, taskInfo.FairnessKey, -1)
 		tr.taskAckManager.AckItem(taskInfo.TaskID)
 		return false, true
 	}
@@ -2,6 +2,7 @@ package clientcommon
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -9,15 +10,32 @@ import (
 	sdconfig "github.com/uber/cadence/service/sharddistributor/config"
 )
 
-const defaultPeerTTL = 2 * time.Minute
+const (
+	defaultPeerTTL = 2 * time.Minute
+)
 
 // NamespaceConfig represents configuration for a single namespace
 type NamespaceConfig struct {
 	Namespace         string        `yaml:"namespace"`
 	HeartBeatInterval time.Duration `yaml:"heartbeat_interval"`
 	MigrationMode     string        `yaml:"migration_mode"`
-	TTLShard          time.Duration `yaml:"ttl_shard"`  // time after which shards are stopped if they are not used
-	TTLReport         time.Duration `yaml:"ttl_report"` // time after which the shard report status (including load) needs to be updated
+	TTLShard          time.Duration `yaml:"ttl_shard"`     // time after which shards are stopped if they are not used
+	TTLReport         time.Duration `yaml:"ttl_report"`    // time after which the shard report status (including load) needs to be updated
+	DrainTimeout      time.Duration `yaml:"drain_timeout"` // maximum time to wait on shutdown for the shards to be handed over to other executors, shards are stopped right away if zero
+	Capacity          float64       `yaml:"capacity"`      // relative capacity reported to the capacity placement strategy, not reported if zero
+	Zone              string        `yaml:"zone"`          // zone reported to the zone placement strategy, not reported if empty
+}
+
+// GetPlacementMetadata returns the executor metadata read by the placement strategies of the shard distributor.
+func (nc *NamespaceConfig) GetPlacementMetadata() map[string]string {
+	metadata := make(map[string]string)
+	if nc.Capacity > 0 {
+		metadata[CapacityMetadataKey] = strconv.FormatFloat(nc.Capacity, 'f', -1, 64)
+	}
+	if nc.Zone != "" {
+		metadata[ZoneMetadataKey] = nc.Zone
+	}
+	return metadata
 }
 
 // GetMigrationMode converts the string migration mode to types.MigrationMode using the shared configMode map
@@ -79,6 +97,12 @@ func (c *Config) Validate() error {
 		if ns.HeartBeatInterval <= 0 {
 			return fmt.Errorf("namespace %d (%s): heartbeat_interval must be greater than 0", i, ns.Namespace)
 		}
+		if ns.DrainTimeout < 0 {
+			return fmt.Errorf("namespace %d (%s): drain_timeout cannot be negative", i, ns.Namespace)
+		}
+		if ns.Capacity < 0 {
+			return fmt.Errorf("namespace %d (%s): capacity cannot be negative", i, ns.Namespace)
+		}
 		if seenNamespaces[ns.Namespace] {
 			return fmt.Errorf("duplicate namespace: %s", ns.Namespace)
 		}
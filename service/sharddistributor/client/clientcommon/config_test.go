@@ -106,3 +106,25 @@ func TestConfig_GetPeerTTL(t *testing.T) {
 		})
 	}
 }
+
+func TestNamespaceConfig_GetPlacementMetadata(t *testing.T) {
+	assert.Empty(t, (&NamespaceConfig{}).GetPlacementMetadata())
+	assert.Equal(t,
+		map[string]string{CapacityMetadataKey: "2.5", ZoneMetadataKey: "zone-a"},
+		(&NamespaceConfig{Capacity: 2.5, Zone: "zone-a"}).GetPlacementMetadata(),
+	)
+}
+
+func TestConfig_Validate_NegativeCapacity(t *testing.T) {
+	cfg := Config{Namespaces: []NamespaceConfig{
+		{Namespace: "ns", HeartBeatInterval: time.Second, Capacity: -1},
+	}}
+	assert.EqualError(t, cfg.Validate(), "namespace 0 (ns): capacity cannot be negative")
+}
+
+func TestConfig_Validate_NegativeDrainTimeout(t *testing.T) {
+	cfg := Config{Namespaces: []NamespaceConfig{
+		{Namespace: "ns", HeartBeatInterval: time.Second, DrainTimeout: -time.Second},
+	}}
+	assert.EqualError(t, cfg.Validate(), "namespace 0 (ns): drain_timeout cannot be negative")
+}
@@ -2,4 +2,8 @@ package clientcommon
 
 const (
 	GrpcAddressMetadataKey = "grpc_address"
+	// CapacityMetadataKey holds the relative capacity of the executor, used by the capacity placement strategy.
+	CapacityMetadataKey = "capacity"
+	// ZoneMetadataKey holds the zone or isolation group of the executor, used by the zone placement strategy.
+	ZoneMetadataKey = "zone"
 )
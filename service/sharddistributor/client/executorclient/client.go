@@ -29,6 +29,7 @@ var ErrShardProcessNotFound = errors.New("shard process not found")
 
 type Client interface {
 	Heartbeat(context.Context, *types.ExecutorHeartbeatRequest, ...yarpc.CallOption) (*types.ExecutorHeartbeatResponse, error)
+	Drain(context.Context, *types.ExecutorDrainRequest, ...yarpc.CallOption) (*types.ExecutorDrainResponse, error)
 }
 
 type ExecutorMetadata map[string]string
@@ -146,6 +147,7 @@ func newExecutorWithConfig[SP ShardProcessor](params Params[SP], namespaceConfig
 		shardProcessorFactory:  params.ShardProcessorFactory,
 		heartBeatInterval:      namespaceConfig.HeartBeatInterval,
 		ttlShard:               namespaceConfig.TTLShard,
+		drainTimeout:           namespaceConfig.DrainTimeout,
 		namespace:              namespaceConfig.Namespace,
 		executorID:             executorID,
 		timeSource:             params.TimeSource,
@@ -155,7 +157,8 @@ func newExecutorWithConfig[SP ShardProcessor](params Params[SP], namespaceConfig
 		metadata: syncExecutorMetadata{
 			data: params.Metadata,
 		},
-		drainObserver: params.DrainObserver,
+		placementMetadata: namespaceConfig.GetPlacementMetadata(),
+		drainObserver:     params.DrainObserver,
 	}
 	executor.setMigrationMode(namespaceConfig.GetMigrationMode())
 
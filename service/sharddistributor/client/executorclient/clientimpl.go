@@ -101,6 +101,7 @@ type executorImpl[SP ShardProcessor] struct {
 	stopC                  chan struct{}
 	heartBeatInterval      time.Duration
 	ttlShard               time.Duration
+	drainTimeout           time.Duration
 	managedProcessors      syncgeneric.Map[string, *managedProcessor[SP]]
 	processorsToLastUse    syncgeneric.Map[string, time.Time]
 	executorID             string
@@ -111,6 +112,7 @@ type executorImpl[SP ShardProcessor] struct {
 	hostMetrics            tally.Scope
 	migrationMode          atomic.Int32
 	metadata               syncExecutorMetadata
+	placementMetadata      map[string]string
 	drainObserver          clientcommon.DrainSignalObserver
 }
 
@@ -230,6 +232,7 @@ func (e *executorImpl[SP]) heartbeatloop(ctx context.Context) {
 			return
 		case <-e.stopC:
 			e.logger.Info("shard distributor executor stopped")
+			e.drain()
 			e.stopShardProcessors()
 			e.sendDrainingHeartbeat()
 			return
@@ -371,7 +374,7 @@ func (e *executorImpl[SP]) sendHeartbeat(ctx context.Context, status types.Execu
 		ExecutorID:         e.executorID,
 		Status:             status,
 		ShardStatusReports: shardStatusReports,
-		Metadata:           e.metadata.Get(),
+		Metadata:           e.heartbeatMetadata(),
 	}
 
 	// Send the request
@@ -404,6 +407,61 @@ func (e *executorImpl[SP]) sendDrainingHeartbeat() {
 	}
 }
 
+// drain asks the shard distributor to hand the shards of the executor over to other executors,
+// and keeps processing the shards that are not handed over yet. It returns once the executor is drained
+// or the drain timeout expires, the remaining shards are then stopped by the caller.
+// Draining is opt-in: without a drain timeout Stop does not wait for the handover.
+func (e *executorImpl[SP]) drain() {
+	if e.drainTimeout <= 0 {
+		return
+	}
+
+	// Shards are only handed over by the shard distributor when the namespace is onboarded
+	if e.getMigrationMode() != types.MigrationModeONBOARDED {
+		return
+	}
+
+	ctx, cancel := e.timeSource.ContextWithTimeout(context.Background(), e.drainTimeout)
+	defer cancel()
+
+	timer := e.timeSource.NewTimer(e.heartBeatInterval)
+	defer timer.Stop()
+
+	for {
+		response, err := e.shardDistributorClient.Drain(ctx, &types.ExecutorDrainRequest{
+			Namespace:  e.namespace,
+			ExecutorID: e.executorID,
+		})
+		if err != nil {
+			e.logger.Error("failed to drain executor", tag.Error(err))
+			return
+		}
+		if response.Status == types.ExecutorStatusDRAINED {
+			e.logger.Info("executor drained", tag.ShardNamespace(e.namespace), tag.ShardExecutor(e.executorID))
+			return
+		}
+		e.logger.Info("waiting for shards to be handed over", tag.Dynamic("remaining_shards", response.RemainingShards))
+
+		select {
+		case <-ctx.Done():
+			e.logger.Warn("drain timed out, stopping the remaining shards", tag.Dynamic("remaining_shards", response.RemainingShards))
+			return
+		case <-timer.Chan():
+			timer.Reset(e.heartBeatInterval)
+		}
+
+		// The heartbeat returns the shards still assigned to the executor, the ones handed over are stopped
+		shardAssignments, _, err := e.sendHeartbeat(ctx, types.ExecutorStatusDRAINING)
+		if err != nil {
+			e.logger.Error("failed to send draining heartbeat", tag.Error(err))
+			continue
+		}
+		e.assignmentMutex.Lock()
+		e.updateShardAssignment(ctx, shardAssignments)
+		e.assignmentMutex.Unlock()
+	}
+}
+
 func (e *executorImpl[SP]) updateShardAssignment(ctx context.Context, shardAssignments map[string]*types.ShardAssignment) {
 	// Stop shards no longer assigned. Each call fires 2 goroutines: one for the
 	// Stop() call and one per-shard timeout watcher.
@@ -635,3 +693,15 @@ func (e *executorImpl[SP]) SetMetadata(metadata map[string]string) {
 func (e *executorImpl[SP]) GetMetadata() map[string]string {
 	return e.metadata.Get()
 }
+
+// heartbeatMetadata returns the metadata of the executor with the capacity and zone of the namespace config,
+// unless they were set explicitly.
+func (e *executorImpl[SP]) heartbeatMetadata() map[string]string {
+	metadata := e.metadata.Get()
+	for k, v := range e.placementMetadata {
+		if _, ok := metadata[k]; !ok {
+			metadata[k] = v
+		}
+	}
+	return metadata
+}
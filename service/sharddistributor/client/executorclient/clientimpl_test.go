@@ -118,6 +118,9 @@ func TestHeartBeatLoop(t *testing.T) {
 			},
 			MigrationMode: types.MigrationModeONBOARDED,
 		}, nil)
+	// The namespace is onboarded and a drain timeout is set, so the executor drains before stopping
+	mockShardDistributorClient.EXPECT().Drain(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&types.ExecutorDrainResponse{Status: types.ExecutorStatusDRAINED}, nil)
 	expectDrainingHeartbeat(t, mockShardDistributorClient)
 
 	// The two shards are assigned to the executor, so we expect them to be created, started and stopped
@@ -138,6 +141,7 @@ func TestHeartBeatLoop(t *testing.T) {
 
 	// Create the executor
 	executor := newTestExecutor(mockShardDistributorClient, mockShardProcessorFactory, mockTimeSource)
+	executor.drainTimeout = time.Minute
 
 	// Start the executor, and defer stopping it
 	executor.Start(context.Background())
@@ -584,6 +588,124 @@ func TestHeartbeatLoop_StopSignalSendsDrainingHeartbeat(t *testing.T) {
 	<-done
 }
 
+func TestDrain(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	ctrl := gomock.NewController(t)
+	mockShardDistributorClient := sharddistributorexecutor.NewMockClient(ctrl)
+	mockTimeSource := clock.NewMockedTimeSource()
+
+	executor := newTestExecutor(mockShardDistributorClient, nil, mockTimeSource)
+	executor.drainTimeout = time.Minute
+	executor.setMigrationMode(types.MigrationModeONBOARDED)
+
+	shardProcessorMock1 := NewMockShardProcessor(ctrl)
+	shardProcessorMock1.EXPECT().GetShardReport().Return(ShardReport{Status: types.ShardStatusREADY}).AnyTimes()
+	shardProcessorMock2 := NewMockShardProcessor(ctrl)
+	shardProcessorMock2.EXPECT().GetShardReport().Return(ShardReport{Status: types.ShardStatusREADY}).AnyTimes()
+	executor.managedProcessors.Store("test-shard-id1", newManagedProcessor(shardProcessorMock1, processorStateStarted))
+	executor.managedProcessors.Store("test-shard-id2", newManagedProcessor(shardProcessorMock2, processorStateStarted))
+
+	drainRequest := &types.ExecutorDrainRequest{Namespace: "test-namespace", ExecutorID: "test-executor-id"}
+	gomock.InOrder(
+		mockShardDistributorClient.EXPECT().Drain(gomock.Any(), drainRequest, gomock.Any()).
+			Return(&types.ExecutorDrainResponse{Status: types.ExecutorStatusDRAINING, RemainingShards: 1}, nil),
+		// The heartbeat reports both shards, shard 1 has been handed over
+		mockShardDistributorClient.EXPECT().Heartbeat(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *types.ExecutorHeartbeatRequest, _ ...yarpc.CallOption) (*types.ExecutorHeartbeatResponse, error) {
+				assert.Equal(t, types.ExecutorStatusDRAINING, req.Status)
+				assert.Len(t, req.ShardStatusReports, 2)
+				return &types.ExecutorHeartbeatResponse{
+					ShardAssignments: map[string]*types.ShardAssignment{
+						"test-shard-id2": {Status: types.AssignmentStatusREADY},
+					},
+					MigrationMode: types.MigrationModeONBOARDED,
+				}, nil
+			}),
+		mockShardDistributorClient.EXPECT().Drain(gomock.Any(), drainRequest, gomock.Any()).
+			Return(&types.ExecutorDrainResponse{Status: types.ExecutorStatusDRAINED}, nil),
+	)
+	shardProcessorMock1.EXPECT().Stop()
+
+	done := make(chan struct{})
+	go func() {
+		executor.drain()
+		close(done)
+	}()
+
+	// Wait for the drain timeout and the heartbeat timer
+	mockTimeSource.BlockUntil(2)
+	mockTimeSource.Advance(10 * time.Second)
+	<-done
+
+	assert.Eventually(t, func() bool {
+		_, ok := executor.managedProcessors.Load("test-shard-id1")
+		return !ok
+	}, time.Second, time.Millisecond, "the handed over shard should be stopped")
+	_, ok := executor.managedProcessors.Load("test-shard-id2")
+	assert.True(t, ok, "the remaining shard should still be processed")
+}
+
+func TestDrain_Timeout(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	ctrl := gomock.NewController(t)
+	mockShardDistributorClient := sharddistributorexecutor.NewMockClient(ctrl)
+	mockTimeSource := clock.NewMockedTimeSource()
+
+	executor := newTestExecutor(mockShardDistributorClient, nil, mockTimeSource)
+	executor.drainTimeout = 5 * time.Second
+	executor.setMigrationMode(types.MigrationModeONBOARDED)
+
+	mockShardDistributorClient.EXPECT().Drain(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&types.ExecutorDrainResponse{Status: types.ExecutorStatusDRAINING, RemainingShards: 1}, nil)
+
+	done := make(chan struct{})
+	go func() {
+		executor.drain()
+		close(done)
+	}()
+
+	mockTimeSource.BlockUntil(2)
+	mockTimeSource.Advance(5 * time.Second)
+	<-done
+}
+
+func TestDrain_NotOnboarded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	// No drain call is expected
+	mockShardDistributorClient := sharddistributorexecutor.NewMockClient(ctrl)
+
+	executor := newTestExecutor(mockShardDistributorClient, nil, nil)
+	executor.drainTimeout = time.Minute
+	executor.setMigrationMode(types.MigrationModeDISTRIBUTEDPASSTHROUGH)
+
+	executor.drain()
+}
+
+func TestDrain_Disabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	// No drain call is expected
+	mockShardDistributorClient := sharddistributorexecutor.NewMockClient(ctrl)
+
+	executor := newTestExecutor(mockShardDistributorClient, nil, nil)
+	executor.setMigrationMode(types.MigrationModeONBOARDED)
+
+	executor.drain()
+}
+
+func TestDrain_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockShardDistributorClient := sharddistributorexecutor.NewMockClient(ctrl)
+	mockShardDistributorClient.EXPECT().Drain(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+
+	executor := newTestExecutor(mockShardDistributorClient, nil, nil)
+	executor.drainTimeout = time.Minute
+	executor.setMigrationMode(types.MigrationModeONBOARDED)
+
+	executor.drain()
+}
+
 func TestHeartbeatLoop_ContextCancelSendsDrainingHeartbeat(t *testing.T) {
 	defer goleak.VerifyNone(t)
 
@@ -1506,3 +1628,25 @@ func TestHeartbeatLoop_DrainSignal(t *testing.T) {
 		})
 	}
 }
+
+func TestExecutorHeartbeatMetadata(t *testing.T) {
+	executor := &executorImpl[*MockShardProcessor]{
+		metadata: syncExecutorMetadata{
+			data: map[string]string{"key1": "value1", "zone": "zone-explicit"},
+		},
+		placementMetadata: map[string]string{"capacity": "2", "zone": "zone-config"},
+	}
+
+	assert.Equal(t,
+		map[string]string{"key1": "value1", "capacity": "2", "zone": "zone-explicit"},
+		executor.heartbeatMetadata(),
+	)
+
+	// Replacing the metadata keeps the placement metadata of the namespace config
+	executor.SetMetadata(map[string]string{"key2": "value2"})
+	assert.Equal(t,
+		map[string]string{"key2": "value2", "capacity": "2", "zone": "zone-config"},
+		executor.heartbeatMetadata(),
+	)
+	assert.Equal(t, map[string]string{"key2": "value2"}, executor.GetMetadata())
+}
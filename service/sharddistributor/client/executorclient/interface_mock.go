@@ -43,6 +43,26 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// Drain mocks base method.
+func (m *MockClient) Drain(arg0 context.Context, arg1 *types.ExecutorDrainRequest, arg2 ...yarpc.CallOption) (*types.ExecutorDrainResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Drain", varargs...)
+	ret0, _ := ret[0].(*types.ExecutorDrainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Drain indicates an expected call of Drain.
+func (mr *MockClientMockRecorder) Drain(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Drain", reflect.TypeOf((*MockClient)(nil).Drain), varargs...)
+}
+
 // Heartbeat mocks base method.
 func (m *MockClient) Heartbeat(arg0 context.Context, arg1 *types.ExecutorHeartbeatRequest, arg2 ...yarpc.CallOption) (*types.ExecutorHeartbeatResponse, error) {
 	m.ctrl.T.Helper()
@@ -43,3 +43,21 @@ func (c *meteredShardDistributorExecutorClient) Heartbeat(ctx context.Context, e
 	}
 	return ep2, err
 }
+
+func (c *meteredShardDistributorExecutorClient) Drain(ctx context.Context, ep1 *types.ExecutorDrainRequest, p1 ...yarpc.CallOption) (ep2 *types.ExecutorDrainResponse, err error) {
+	var scope tally.Scope
+	scope = c.metricsScope.Tagged(map[string]string{
+		metrics.OperationTagName: metricsconstants.ShardDistributorExecutorDrainOperationTagName,
+	})
+
+	scope.Counter(metricsconstants.ShardDistributorExecutorClientRequests).Inc(1)
+
+	sw := scope.Timer(metricsconstants.ShardDistributorExecutorClientLatency).Start()
+	ep2, err = c.client.Drain(ctx, ep1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.Counter(metricsconstants.ShardDistributorExecutorClientFailures).Inc(1)
+	}
+	return ep2, err
+}
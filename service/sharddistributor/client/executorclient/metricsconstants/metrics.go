@@ -10,6 +10,7 @@ const (
 	// Operation tag names for ShardDistributorExecutor metrics
 	ShardDistributorExecutorOperationTagName          = "ShardDistributorExecutor"
 	ShardDistributorExecutorHeartbeatOperationTagName = "ShardDistributorExecutorHeartbeat"
+	ShardDistributorExecutorDrainOperationTagName     = "ShardDistributorExecutorDrain"
 
 	// Counter metrics
 	ShardDistributorExecutorHeartbeatSkipped          = "shard_distributor_executor_heartbeat_skipped"
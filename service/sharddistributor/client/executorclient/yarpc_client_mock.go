@@ -43,6 +43,26 @@ func (m *MockShardDistributorExecutorAPIYARPCClient) EXPECT() *MockShardDistribu
 	return m.recorder
 }
 
+// Drain mocks base method.
+func (m *MockShardDistributorExecutorAPIYARPCClient) Drain(arg0 context.Context, arg1 *sharddistributorv1.DrainRequest, arg2 ...yarpc.CallOption) (*sharddistributorv1.DrainResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Drain", varargs...)
+	ret0, _ := ret[0].(*sharddistributorv1.DrainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Drain indicates an expected call of Drain.
+func (mr *MockShardDistributorExecutorAPIYARPCClientMockRecorder) Drain(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Drain", reflect.TypeOf((*MockShardDistributorExecutorAPIYARPCClient)(nil).Drain), varargs...)
+}
+
 // Heartbeat mocks base method.
 func (m *MockShardDistributorExecutorAPIYARPCClient) Heartbeat(arg0 context.Context, arg1 *sharddistributorv1.HeartbeatRequest, arg2 ...yarpc.CallOption) (*sharddistributorv1.HeartbeatResponse, error) {
 	m.ctrl.T.Helper()
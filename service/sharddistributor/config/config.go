@@ -23,6 +23,7 @@
 package config
 
 import (
+	"fmt"
 	"time"
 
 	"gopkg.in/yaml.v2"
@@ -62,6 +63,9 @@ type (
 
 	// Store is a generic container for any storage configuration that should be parsed by the implementation.
 	Store struct {
+		// Type selects the storage implementation. Supported values: etcd|sql.
+		// Default: etcd
+		Type          string    `yaml:"type"`
 		StorageParams *YamlNode `yaml:"storageParams"`
 	}
 
@@ -71,6 +75,26 @@ type (
 		Mode string `yaml:"mode"` // TODO: this should be an ENUM with possible modes: enabled, read_only, proxy, disabled
 		// ShardNum is defined for fixed namespace.
 		ShardNum int64 `yaml:"shardNum"`
+		// Placement selects how shards are placed on the executors of the namespace.
+		Placement Placement `yaml:"placement"`
+	}
+
+	Placement struct {
+		// Strategy is the placement strategy. Supported values: default|capacity|zone|consistent_hash.
+		// Default: default, which spreads shards evenly and moves shards away from the hottest executor.
+		// Executors report the capacity and zone read by the capacity and zone strategies through
+		// the capacity and zone settings of their executor client namespace config.
+		Strategy string `yaml:"strategy"`
+
+		// LoadFactor bounds the number of shards of an executor to LoadFactor times the average
+		// in the consistent_hash strategy. It must be greater than 1.
+		// Default: 1.25
+		LoadFactor float64 `yaml:"loadFactor"`
+
+		// AntiAffinitySeparator groups shards of the zone strategy: shards whose IDs share the prefix
+		// before the last separator are spread over different zones when possible.
+		// Default: no grouping
+		AntiAffinitySeparator string `yaml:"antiAffinitySeparator"`
 	}
 
 	Election struct {
@@ -96,6 +120,12 @@ type (
 		// the executor is considered stale and its shards are eligible for redistribution.
 		// Default: 10 seconds
 		HeartbeatTTL time.Duration `yaml:"heartbeatTTL"`
+
+		// DrainBatchSize is the maximum number of shards of each draining executor that are being handed over
+		// at the same time. A handover completes when the new owner reports the shard as ready, so the drain
+		// proceeds as fast as the new owners pick the shards up.
+		// Default: 10
+		DrainBatchSize int `yaml:"drainBatchSize"`
 	}
 
 	// YamlNode is a lazy-unmarshaler, because *yaml.Node only exists in gopkg.in/yaml.v3, not v2,
@@ -110,6 +140,18 @@ const (
 	NamespaceTypeEphemeral = "ephemeral"
 )
 
+const (
+	StoreTypeEtcd = "etcd"
+	StoreTypeSQL  = "sql"
+)
+
+const (
+	PlacementStrategyDefault        = "default"
+	PlacementStrategyCapacity       = "capacity"
+	PlacementStrategyZone           = "zone"
+	PlacementStrategyConsistentHash = "consistent_hash"
+)
+
 const (
 	MigrationModeINVALID                = "invalid"
 	MigrationModeLOCALPASSTHROUGH       = "local_pass"
@@ -184,6 +226,54 @@ func (s *ShardDistribution) GetMigrationMode(namespace string) types.MigrationMo
 	return MigrationMode[MigrationModeONBOARDED]
 }
 
+// Validate validates the shard distribution config, it is called when the server config is loaded
+// so that a bad store or placement configuration fails the startup instead of being ignored.
+func (s *ShardDistribution) Validate() error {
+	if err := s.LeaderStore.validate(); err != nil {
+		return fmt.Errorf("shardDistribution.leaderStore: %w", err)
+	}
+	if err := s.Store.validate(); err != nil {
+		return fmt.Errorf("shardDistribution.store: %w", err)
+	}
+	for _, ns := range s.Namespaces {
+		if err := ns.Placement.validate(); err != nil {
+			return fmt.Errorf("shardDistribution namespace %q: %w", ns.Name, err)
+		}
+	}
+	return nil
+}
+
+func (s Store) validate() error {
+	switch s.GetType() {
+	case StoreTypeEtcd, StoreTypeSQL:
+		return nil
+	default:
+		return fmt.Errorf("unknown store type %q", s.Type)
+	}
+}
+
+func (p Placement) validate() error {
+	switch p.Strategy {
+	case "", PlacementStrategyDefault, PlacementStrategyCapacity, PlacementStrategyZone:
+		return nil
+	case PlacementStrategyConsistentHash:
+		if p.LoadFactor != 0 && p.LoadFactor <= 1 {
+			return fmt.Errorf("placement load factor must be greater than 1, got %v", p.LoadFactor)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown placement strategy %q", p.Strategy)
+	}
+}
+
+// GetType returns the storage implementation of the store, defaulting to etcd.
+func (s Store) GetType() string {
+	if s.Type == "" {
+		return StoreTypeEtcd
+	}
+	return s.Type
+}
+
 var _ yaml.Unmarshaler = (*YamlNode)(nil)
 
 func (y *YamlNode) UnmarshalYAML(unmarshal func(interface{}) error) error {
@@ -115,3 +115,63 @@ func TestGetLoadBalancingMode(t *testing.T) {
 		})
 	}
 }
+
+func TestShardDistributionValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         ShardDistribution
+		expectedErr string
+	}{
+		{
+			name: "empty config",
+		},
+		{
+			name: "valid config",
+			cfg: ShardDistribution{
+				LeaderStore: Store{Type: StoreTypeEtcd},
+				Store:       Store{Type: StoreTypeSQL},
+				Namespaces: []Namespace{
+					{Name: "default"},
+					{Name: "capacity", Placement: Placement{Strategy: PlacementStrategyCapacity}},
+					{Name: "zone", Placement: Placement{Strategy: PlacementStrategyZone, AntiAffinitySeparator: "-"}},
+					{Name: "hash", Placement: Placement{Strategy: PlacementStrategyConsistentHash, LoadFactor: 1.5}},
+				},
+			},
+		},
+		{
+			name:        "unknown leader store type",
+			cfg:         ShardDistribution{LeaderStore: Store{Type: "cassandra"}},
+			expectedErr: `shardDistribution.leaderStore: unknown store type "cassandra"`,
+		},
+		{
+			name:        "unknown store type",
+			cfg:         ShardDistribution{Store: Store{Type: "cassandra"}},
+			expectedErr: `shardDistribution.store: unknown store type "cassandra"`,
+		},
+		{
+			name: "unknown placement strategy",
+			cfg: ShardDistribution{Namespaces: []Namespace{
+				{Name: "ns", Placement: Placement{Strategy: "round_robin"}},
+			}},
+			expectedErr: `shardDistribution namespace "ns": unknown placement strategy "round_robin"`,
+		},
+		{
+			name: "invalid load factor",
+			cfg: ShardDistribution{Namespaces: []Namespace{
+				{Name: "ns", Placement: Placement{Strategy: PlacementStrategyConsistentHash, LoadFactor: 0.5}},
+			}},
+			expectedErr: `shardDistribution namespace "ns": placement load factor must be greater than 1, got 0.5`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
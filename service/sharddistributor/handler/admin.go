@@ -0,0 +1,211 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/store"
+)
+
+func (h *handlerImpl) ListNamespaces(ctx context.Context, request *types.ListNamespacesRequest) (resp *types.ListNamespacesResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	resp = &types.ListNamespacesResponse{
+		Namespaces: make([]*types.NamespaceInfo, 0, len(h.shardDistributionCfg.Namespaces)),
+	}
+	for _, namespace := range h.shardDistributionCfg.Namespaces {
+		resp.Namespaces = append(resp.Namespaces, &types.NamespaceInfo{
+			Name:     namespace.Name,
+			Type:     namespace.Type,
+			Mode:     namespace.Mode,
+			ShardNum: namespace.ShardNum,
+		})
+	}
+	return resp, nil
+}
+
+func (h *handlerImpl) ListExecutors(ctx context.Context, request *types.ListExecutorsRequest) (resp *types.ListExecutorsResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	if err := h.validateNamespace(request.Namespace); err != nil {
+		return nil, err
+	}
+
+	state, err := h.storage.GetState(ctx, request.Namespace)
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: fmt.Sprintf("failed to get namespace state: %v", err)}
+	}
+
+	resp = &types.ListExecutorsResponse{
+		Executors: make([]*types.ExecutorSummary, 0, len(state.Executors)),
+	}
+	for _, executorID := range slices.Sorted(maps.Keys(state.Executors)) {
+		heartbeat := state.Executors[executorID]
+		resp.Executors = append(resp.Executors, &types.ExecutorSummary{
+			ExecutorID:     executorID,
+			Status:         heartbeat.Status,
+			LastHeartbeat:  common.Int64Ptr(heartbeat.LastHeartbeat.UnixNano()),
+			AssignedShards: int64(len(state.ShardAssignments[executorID].AssignedShards)),
+			ReportedShards: int64(len(heartbeat.ReportedShards)),
+			Metadata:       heartbeat.Metadata,
+		})
+	}
+	return resp, nil
+}
+
+func (h *handlerImpl) DescribeShard(ctx context.Context, request *types.DescribeShardRequest) (resp *types.DescribeShardResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	if err := h.validateNamespace(request.Namespace); err != nil {
+		return nil, err
+	}
+
+	shardOwner, err := h.storage.GetShardOwner(ctx, request.Namespace, request.ShardKey)
+	if errors.Is(err, store.ErrShardNotFound) {
+		return nil, &types.ShardNotFoundError{
+			Namespace: request.Namespace,
+			ShardKey:  request.ShardKey,
+		}
+	}
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: fmt.Sprintf("failed to get shard owner: %v", err)}
+	}
+
+	state, err := h.storage.GetState(ctx, request.Namespace)
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: fmt.Sprintf("failed to get namespace state: %v", err)}
+	}
+
+	resp = &types.DescribeShardResponse{
+		Owner:    shardOwner.ExecutorID,
+		Metadata: shardOwner.Metadata,
+	}
+	if stats, ok := state.ShardStats[request.ShardKey]; ok {
+		resp.Statistics = &types.ShardStatistics{
+			SmoothedLoad:   stats.SmoothedLoad,
+			LastUpdateTime: timeToUnixNano(stats.LastUpdateTime),
+			LastMoveTime:   timeToUnixNano(stats.LastMoveTime),
+		}
+	}
+	return resp, nil
+}
+
+func (h *handlerImpl) TriggerRebalance(ctx context.Context, request *types.TriggerRebalanceRequest) (resp *types.TriggerRebalanceResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	if err := h.validateNamespace(request.Namespace); err != nil {
+		return nil, err
+	}
+
+	if err := h.storage.TriggerRebalance(ctx, request.Namespace); err != nil {
+		return nil, &types.InternalServiceError{Message: fmt.Sprintf("failed to trigger rebalance: %v", err)}
+	}
+	return &types.TriggerRebalanceResponse{}, nil
+}
+
+// MoveShard reassigns a shard to the given executor, which must be active.
+// The leader may move the shard again on a later rebalance.
+func (h *handlerImpl) MoveShard(ctx context.Context, request *types.MoveShardRequest) (resp *types.MoveShardResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	if err := h.validateNamespace(request.Namespace); err != nil {
+		return nil, err
+	}
+
+	state, err := h.storage.GetState(ctx, request.Namespace)
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: fmt.Sprintf("failed to get namespace state: %v", err)}
+	}
+
+	target, ok := state.Executors[request.ExecutorID]
+	if !ok || target.Status != types.ExecutorStatusACTIVE {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("executor %q is not active in namespace %q", request.ExecutorID, request.Namespace)}
+	}
+
+	sourceID := ""
+	for executorID, assigned := range state.ShardAssignments {
+		if _, ok := assigned.AssignedShards[request.ShardKey]; ok {
+			sourceID = executorID
+			break
+		}
+	}
+	if sourceID == "" {
+		return nil, &types.ShardNotFoundError{
+			Namespace: request.Namespace,
+			ShardKey:  request.ShardKey,
+		}
+	}
+	if sourceID == request.ExecutorID {
+		return &types.MoveShardResponse{}, nil
+	}
+
+	source := state.ShardAssignments[sourceID]
+	source.AssignedShards = maps.Clone(source.AssignedShards)
+	delete(source.AssignedShards, request.ShardKey)
+
+	destination := state.ShardAssignments[request.ExecutorID]
+	destination.AssignedShards = maps.Clone(destination.AssignedShards)
+	if destination.AssignedShards == nil {
+		destination.AssignedShards = make(map[string]*types.ShardAssignment)
+	}
+	destination.AssignedShards[request.ShardKey] = &types.ShardAssignment{Status: types.AssignmentStatusREADY}
+
+	err = h.storage.AssignShards(ctx, request.Namespace, store.AssignShardsRequest{
+		NewState: &store.NamespaceState{
+			ShardAssignments: map[string]store.AssignedState{
+				sourceID:           source,
+				request.ExecutorID: destination,
+			},
+		},
+	}, store.NopGuard())
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: fmt.Sprintf("failed to move shard: %v", err)}
+	}
+	return &types.MoveShardResponse{}, nil
+}
+
+func (h *handlerImpl) validateNamespace(namespace string) error {
+	if !slices.ContainsFunc(h.shardDistributionCfg.Namespaces, func(ns config.Namespace) bool {
+		return ns.Name == namespace
+	}) {
+		return &types.NamespaceNotFoundError{Namespace: namespace}
+	}
+	return nil
+}
+
+func timeToUnixNano(t time.Time) *int64 {
+	if t.IsZero() {
+		return nil
+	}
+	return common.Int64Ptr(t.UnixNano())
+}
@@ -0,0 +1,393 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/store"
+)
+
+var _testAdminConfig = config.ShardDistribution{
+	Namespaces: []config.Namespace{
+		{
+			Name:     _testNamespaceFixed,
+			Type:     config.NamespaceTypeFixed,
+			Mode:     "onboarded",
+			ShardNum: 32,
+		},
+		{
+			Name: _testNamespaceEphemeral,
+			Type: config.NamespaceTypeEphemeral,
+		},
+	},
+}
+
+func TestListNamespaces(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler := newTestHandler(t, _testAdminConfig, store.NewMockStore(ctrl))
+
+	resp, err := handler.ListNamespaces(context.Background(), &types.ListNamespacesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, &types.ListNamespacesResponse{
+		Namespaces: []*types.NamespaceInfo{
+			{Name: _testNamespaceFixed, Type: config.NamespaceTypeFixed, Mode: "onboarded", ShardNum: 32},
+			{Name: _testNamespaceEphemeral, Type: config.NamespaceTypeEphemeral},
+		},
+	}, resp)
+}
+
+func TestListExecutors(t *testing.T) {
+	heartbeat := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name       string
+		namespace  string
+		setupMocks func(mockStore *store.MockStore)
+		expected   *types.ListExecutorsResponse
+		expectErr  error
+	}{
+		{
+			name:      "namespace not found",
+			namespace: "unknown",
+			expectErr: &types.NamespaceNotFoundError{Namespace: "unknown"},
+		},
+		{
+			name:      "store error",
+			namespace: _testNamespaceFixed,
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetState(gomock.Any(), _testNamespaceFixed).Return(nil, errors.New("store error"))
+			},
+			expectErr: &types.InternalServiceError{Message: "failed to get namespace state: store error"},
+		},
+		{
+			name:      "success",
+			namespace: _testNamespaceFixed,
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetState(gomock.Any(), _testNamespaceFixed).Return(&store.NamespaceState{
+					Executors: map[string]store.HeartbeatState{
+						"exec-2": {LastHeartbeat: heartbeat, Status: types.ExecutorStatusDRAINING},
+						"exec-1": {
+							LastHeartbeat:  heartbeat,
+							Status:         types.ExecutorStatusACTIVE,
+							ReportedShards: map[string]*types.ShardStatusReport{"1": {}},
+							Metadata:       map[string]string{"ip": "127.0.0.1"},
+						},
+					},
+					ShardAssignments: map[string]store.AssignedState{
+						"exec-1": {AssignedShards: map[string]*types.ShardAssignment{"1": {}, "2": {}}},
+					},
+				}, nil)
+			},
+			expected: &types.ListExecutorsResponse{
+				Executors: []*types.ExecutorSummary{
+					{
+						ExecutorID:     "exec-1",
+						Status:         types.ExecutorStatusACTIVE,
+						LastHeartbeat:  common.Int64Ptr(heartbeat.UnixNano()),
+						AssignedShards: 2,
+						ReportedShards: 1,
+						Metadata:       map[string]string{"ip": "127.0.0.1"},
+					},
+					{
+						ExecutorID:    "exec-2",
+						Status:        types.ExecutorStatusDRAINING,
+						LastHeartbeat: common.Int64Ptr(heartbeat.UnixNano()),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockStorage := store.NewMockStore(ctrl)
+			handler := newTestHandler(t, _testAdminConfig, mockStorage)
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockStorage)
+			}
+
+			resp, err := handler.ListExecutors(context.Background(), &types.ListExecutorsRequest{Namespace: tt.namespace})
+			assert.Equal(t, tt.expectErr, err)
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestDescribeShard(t *testing.T) {
+	lastMove := time.Unix(1700000000, 0)
+	owner := &store.ShardOwner{ExecutorID: "exec-1", Metadata: map[string]string{"ip": "127.0.0.1"}}
+
+	tests := []struct {
+		name       string
+		namespace  string
+		setupMocks func(mockStore *store.MockStore)
+		expected   *types.DescribeShardResponse
+		expectErr  error
+	}{
+		{
+			name:      "namespace not found",
+			namespace: "unknown",
+			expectErr: &types.NamespaceNotFoundError{Namespace: "unknown"},
+		},
+		{
+			name:      "shard not found",
+			namespace: _testNamespaceFixed,
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetShardOwner(gomock.Any(), _testNamespaceFixed, "1").Return(nil, store.ErrShardNotFound)
+			},
+			expectErr: &types.ShardNotFoundError{Namespace: _testNamespaceFixed, ShardKey: "1"},
+		},
+		{
+			name:      "state error",
+			namespace: _testNamespaceFixed,
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetShardOwner(gomock.Any(), _testNamespaceFixed, "1").Return(owner, nil)
+				mockStore.EXPECT().GetState(gomock.Any(), _testNamespaceFixed).Return(nil, errors.New("store error"))
+			},
+			expectErr: &types.InternalServiceError{Message: "failed to get namespace state: store error"},
+		},
+		{
+			name:      "without statistics",
+			namespace: _testNamespaceFixed,
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetShardOwner(gomock.Any(), _testNamespaceFixed, "1").Return(owner, nil)
+				mockStore.EXPECT().GetState(gomock.Any(), _testNamespaceFixed).Return(&store.NamespaceState{}, nil)
+			},
+			expected: &types.DescribeShardResponse{Owner: "exec-1", Metadata: map[string]string{"ip": "127.0.0.1"}},
+		},
+		{
+			name:      "with statistics",
+			namespace: _testNamespaceFixed,
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetShardOwner(gomock.Any(), _testNamespaceFixed, "1").Return(owner, nil)
+				mockStore.EXPECT().GetState(gomock.Any(), _testNamespaceFixed).Return(&store.NamespaceState{
+					ShardStats: map[string]store.ShardStatistics{
+						"1": {SmoothedLoad: 1.5, LastMoveTime: lastMove},
+					},
+				}, nil)
+			},
+			expected: &types.DescribeShardResponse{
+				Owner:    "exec-1",
+				Metadata: map[string]string{"ip": "127.0.0.1"},
+				Statistics: &types.ShardStatistics{
+					SmoothedLoad: 1.5,
+					LastMoveTime: common.Int64Ptr(lastMove.UnixNano()),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockStorage := store.NewMockStore(ctrl)
+			handler := newTestHandler(t, _testAdminConfig, mockStorage)
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockStorage)
+			}
+
+			resp, err := handler.DescribeShard(context.Background(), &types.DescribeShardRequest{Namespace: tt.namespace, ShardKey: "1"})
+			assert.Equal(t, tt.expectErr, err)
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestTriggerRebalance(t *testing.T) {
+	tests := []struct {
+		name       string
+		namespace  string
+		setupMocks func(mockStore *store.MockStore)
+		expectErr  error
+	}{
+		{
+			name:      "namespace not found",
+			namespace: "unknown",
+			expectErr: &types.NamespaceNotFoundError{Namespace: "unknown"},
+		},
+		{
+			name:      "store error",
+			namespace: _testNamespaceFixed,
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().TriggerRebalance(gomock.Any(), _testNamespaceFixed).Return(errors.New("store error"))
+			},
+			expectErr: &types.InternalServiceError{Message: "failed to trigger rebalance: store error"},
+		},
+		{
+			name:      "success",
+			namespace: _testNamespaceFixed,
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().TriggerRebalance(gomock.Any(), _testNamespaceFixed).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockStorage := store.NewMockStore(ctrl)
+			handler := newTestHandler(t, _testAdminConfig, mockStorage)
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockStorage)
+			}
+
+			resp, err := handler.TriggerRebalance(context.Background(), &types.TriggerRebalanceRequest{Namespace: tt.namespace})
+			assert.Equal(t, tt.expectErr, err)
+			if tt.expectErr == nil {
+				assert.NotNil(t, resp)
+			}
+		})
+	}
+}
+
+func TestMoveShard(t *testing.T) {
+	state := func() *store.NamespaceState {
+		return &store.NamespaceState{
+			Executors: map[string]store.HeartbeatState{
+				"exec-1": {Status: types.ExecutorStatusACTIVE},
+				"exec-2": {Status: types.ExecutorStatusACTIVE},
+				"exec-3": {Status: types.ExecutorStatusDRAINING},
+			},
+			ShardAssignments: map[string]store.AssignedState{
+				"exec-1": {
+					AssignedShards: map[string]*types.ShardAssignment{
+						"1": {Status: types.AssignmentStatusREADY},
+						"2": {Status: types.AssignmentStatusREADY},
+					},
+					ModRevision: 10,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		request    *types.MoveShardRequest
+		setupMocks func(mockStore *store.MockStore)
+		expectErr  error
+	}{
+		{
+			name:      "namespace not found",
+			request:   &types.MoveShardRequest{Namespace: "unknown", ShardKey: "1", ExecutorID: "exec-2"},
+			expectErr: &types.NamespaceNotFoundError{Namespace: "unknown"},
+		},
+		{
+			name:    "state error",
+			request: &types.MoveShardRequest{Namespace: _testNamespaceFixed, ShardKey: "1", ExecutorID: "exec-2"},
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetState(gomock.Any(), _testNamespaceFixed).Return(nil, errors.New("store error"))
+			},
+			expectErr: &types.InternalServiceError{Message: "failed to get namespace state: store error"},
+		},
+		{
+			name:    "unknown executor",
+			request: &types.MoveShardRequest{Namespace: _testNamespaceFixed, ShardKey: "1", ExecutorID: "exec-4"},
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetState(gomock.Any(), _testNamespaceFixed).Return(state(), nil)
+			},
+			expectErr: &types.BadRequestError{Message: `executor "exec-4" is not active in namespace "test-fixed"`},
+		},
+		{
+			name:    "executor not active",
+			request: &types.MoveShardRequest{Namespace: _testNamespaceFixed, ShardKey: "1", ExecutorID: "exec-3"},
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetState(gomock.Any(), _testNamespaceFixed).Return(state(), nil)
+			},
+			expectErr: &types.BadRequestError{Message: `executor "exec-3" is not active in namespace "test-fixed"`},
+		},
+		{
+			name:    "shard not found",
+			request: &types.MoveShardRequest{Namespace: _testNamespaceFixed, ShardKey: "3", ExecutorID: "exec-2"},
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetState(gomock.Any(), _testNamespaceFixed).Return(state(), nil)
+			},
+			expectErr: &types.ShardNotFoundError{Namespace: _testNamespaceFixed, ShardKey: "3"},
+		},
+		{
+			name:    "already on executor",
+			request: &types.MoveShardRequest{Namespace: _testNamespaceFixed, ShardKey: "1", ExecutorID: "exec-1"},
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetState(gomock.Any(), _testNamespaceFixed).Return(state(), nil)
+			},
+		},
+		{
+			name:    "assign error",
+			request: &types.MoveShardRequest{Namespace: _testNamespaceFixed, ShardKey: "1", ExecutorID: "exec-2"},
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetState(gomock.Any(), _testNamespaceFixed).Return(state(), nil)
+				mockStore.EXPECT().AssignShards(gomock.Any(), _testNamespaceFixed, gomock.Any(), gomock.Any()).Return(store.ErrVersionConflict)
+			},
+			expectErr: &types.InternalServiceError{Message: "failed to move shard: version conflict"},
+		},
+		{
+			name:    "success",
+			request: &types.MoveShardRequest{Namespace: _testNamespaceFixed, ShardKey: "1", ExecutorID: "exec-2"},
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetState(gomock.Any(), _testNamespaceFixed).Return(state(), nil)
+				mockStore.EXPECT().AssignShards(gomock.Any(), _testNamespaceFixed, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, request store.AssignShardsRequest, _ store.GuardFunc) error {
+						assert.Equal(t, map[string]store.AssignedState{
+							"exec-1": {
+								AssignedShards: map[string]*types.ShardAssignment{"2": {Status: types.AssignmentStatusREADY}},
+								ModRevision:    10,
+							},
+							"exec-2": {
+								AssignedShards: map[string]*types.ShardAssignment{"1": {Status: types.AssignmentStatusREADY}},
+							},
+						}, request.NewState.ShardAssignments)
+						assert.Empty(t, request.ExecutorsToDelete)
+						return nil
+					})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockStorage := store.NewMockStore(ctrl)
+			handler := newTestHandler(t, _testAdminConfig, mockStorage)
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockStorage)
+			}
+
+			resp, err := handler.MoveShard(context.Background(), tt.request)
+			assert.Equal(t, tt.expectErr, err)
+			if tt.expectErr == nil {
+				assert.NotNil(t, resp)
+			}
+		})
+	}
+}
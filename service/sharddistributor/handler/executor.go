@@ -101,6 +101,56 @@ func (h *executor) Heartbeat(ctx context.Context, request *types.ExecutorHeartbe
 	return _convertResponse(assignedShards, mode), nil
 }
 
+// Drain marks the executor as draining, so the leader hands its shards over to the other executors,
+// and reports how many shards are still assigned to it. The executor is DRAINED once no shards are left.
+func (h *executor) Drain(ctx context.Context, request *types.ExecutorDrainRequest) (*types.ExecutorDrainResponse, error) {
+	previousHeartbeat, assignedShards, err := h.storage.GetHeartbeat(ctx, request.GetNamespace(), request.GetExecutorID())
+	if errors.Is(err, store.ErrExecutorNotFound) {
+		// An unknown executor does not own any shards
+		return &types.ExecutorDrainResponse{Status: types.ExecutorStatusDRAINED}, nil
+	}
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: fmt.Sprintf("failed to get heartbeat: %v", err)}
+	}
+
+	remainingShards := 0
+	if assignedShards != nil {
+		remainingShards = len(assignedShards.AssignedShards)
+	}
+
+	status := types.ExecutorStatusDRAINING
+	// Shards are only handed over by the leader when the namespace is onboarded,
+	// in the other modes there is nothing to wait for.
+	if remainingShards == 0 || h.cfg.GetMigrationMode(request.GetNamespace()) != types.MigrationModeONBOARDED {
+		status = types.ExecutorStatusDRAINED
+	}
+
+	newHeartbeat := store.HeartbeatState{
+		LastHeartbeat: h.timeSource.Now().UTC(),
+		Status:        status,
+	}
+	if previousHeartbeat != nil {
+		newHeartbeat.ReportedShards = previousHeartbeat.ReportedShards
+		newHeartbeat.Metadata = previousHeartbeat.Metadata
+	}
+
+	if err := h.storage.RecordHeartbeat(ctx, request.GetNamespace(), request.GetExecutorID(), newHeartbeat); err != nil {
+		return nil, &types.InternalServiceError{Message: fmt.Sprintf("failed to record heartbeat: %v", err)}
+	}
+
+	h.logger.Info("Executor drain progress",
+		tag.ShardNamespace(request.GetNamespace()),
+		tag.ShardExecutor(request.GetExecutorID()),
+		tag.Dynamic("status", status.String()),
+		tag.Dynamic("remaining_shards", remainingShards),
+	)
+
+	return &types.ExecutorDrainResponse{
+		Status:          status,
+		RemainingShards: int64(remainingShards),
+	}, nil
+}
+
 // emitShardAssignmentMetrics emits the following metrics for newly assigned shards:
 // - ShardAssignmentDistributionLatency: time taken since the shard was assigned to heartbeat time
 // - ShardHandoverLatency: time taken since the previous executor's last heartbeat to heartbeat time
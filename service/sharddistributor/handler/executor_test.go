@@ -330,6 +330,112 @@ func TestHeartbeat(t *testing.T) {
 
 }
 
+func TestDrain(t *testing.T) {
+	namespace := "test-namespace"
+	executorID := "test-executor"
+	now := time.Now().UTC()
+	reportedShards := map[string]*types.ShardStatusReport{"shard-1": {Status: types.ShardStatusREADY}}
+	metadata := map[string]string{"key": "value"}
+	previousHeartbeat := &store.HeartbeatState{
+		LastHeartbeat:  now.Add(-time.Second),
+		Status:         types.ExecutorStatusACTIVE,
+		ReportedShards: reportedShards,
+		Metadata:       metadata,
+	}
+
+	tests := []struct {
+		name          string
+		migrationMode string
+		setupMocks    func(mockStore *store.MockStore)
+		expected      *types.ExecutorDrainResponse
+		expectedErr   string
+	}{
+		{
+			name: "executor with shards is draining",
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetHeartbeat(gomock.Any(), namespace, executorID).Return(previousHeartbeat, &store.AssignedState{
+					AssignedShards: map[string]*types.ShardAssignment{"shard-1": {}, "shard-2": {}},
+				}, nil)
+				mockStore.EXPECT().RecordHeartbeat(gomock.Any(), namespace, executorID, store.HeartbeatState{
+					LastHeartbeat:  now,
+					Status:         types.ExecutorStatusDRAINING,
+					ReportedShards: reportedShards,
+					Metadata:       metadata,
+				})
+			},
+			expected: &types.ExecutorDrainResponse{Status: types.ExecutorStatusDRAINING, RemainingShards: 2},
+		},
+		{
+			name: "executor without shards is drained",
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetHeartbeat(gomock.Any(), namespace, executorID).Return(previousHeartbeat, &store.AssignedState{}, nil)
+				mockStore.EXPECT().RecordHeartbeat(gomock.Any(), namespace, executorID, store.HeartbeatState{
+					LastHeartbeat:  now,
+					Status:         types.ExecutorStatusDRAINED,
+					ReportedShards: reportedShards,
+					Metadata:       metadata,
+				})
+			},
+			expected: &types.ExecutorDrainResponse{Status: types.ExecutorStatusDRAINED},
+		},
+		{
+			name:          "executor is drained right away when the namespace is not onboarded",
+			migrationMode: config.MigrationModeDISTRIBUTEDPASSTHROUGH,
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetHeartbeat(gomock.Any(), namespace, executorID).Return(previousHeartbeat, &store.AssignedState{
+					AssignedShards: map[string]*types.ShardAssignment{"shard-1": {}},
+				}, nil)
+				mockStore.EXPECT().RecordHeartbeat(gomock.Any(), namespace, executorID, gomock.Any())
+			},
+			expected: &types.ExecutorDrainResponse{Status: types.ExecutorStatusDRAINED, RemainingShards: 1},
+		},
+		{
+			name: "unknown executor is drained",
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetHeartbeat(gomock.Any(), namespace, executorID).Return(nil, nil, store.ErrExecutorNotFound)
+			},
+			expected: &types.ExecutorDrainResponse{Status: types.ExecutorStatusDRAINED},
+		},
+		{
+			name: "get heartbeat error",
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetHeartbeat(gomock.Any(), namespace, executorID).Return(nil, nil, errors.New("storage is down"))
+			},
+			expectedErr: "failed to get heartbeat: storage is down",
+		},
+		{
+			name: "record heartbeat error",
+			setupMocks: func(mockStore *store.MockStore) {
+				mockStore.EXPECT().GetHeartbeat(gomock.Any(), namespace, executorID).Return(previousHeartbeat, nil, nil)
+				mockStore.EXPECT().RecordHeartbeat(gomock.Any(), namespace, executorID, gomock.Any()).Return(errors.New("storage is down"))
+			},
+			expectedErr: "failed to record heartbeat: storage is down",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockStore := store.NewMockStore(ctrl)
+			tt.setupMocks(mockStore)
+
+			var configEntries []configEntry
+			if tt.migrationMode != "" {
+				configEntries = append(configEntries, configEntry{dynamicproperties.ShardDistributorMigrationMode, tt.migrationMode})
+			}
+			handler := NewExecutorHandler(testlogger.New(t), mockStore, clock.NewMockedTimeSourceAt(now), config.ShardDistribution{}, newConfig(t, configEntries), metrics.NoopClient)
+
+			resp, err := handler.Drain(context.Background(), &types.ExecutorDrainRequest{Namespace: namespace, ExecutorID: executorID})
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
 func TestValidateMetadata(t *testing.T) {
 	// Helper function to generate metadata with N keys
 	makeMetadataWithKeys := func(n int) map[string]string {
@@ -44,10 +44,22 @@ type Handler interface {
 	GetShardOwner(context.Context, *types.GetShardOwnerRequest) (*types.GetShardOwnerResponse, error)
 
 	WatchNamespaceState(*types.WatchNamespaceStateRequest, WatchNamespaceStateServer) error
+
+	ListNamespaces(context.Context, *types.ListNamespacesRequest) (*types.ListNamespacesResponse, error)
+
+	ListExecutors(context.Context, *types.ListExecutorsRequest) (*types.ListExecutorsResponse, error)
+
+	DescribeShard(context.Context, *types.DescribeShardRequest) (*types.DescribeShardResponse, error)
+
+	TriggerRebalance(context.Context, *types.TriggerRebalanceRequest) (*types.TriggerRebalanceResponse, error)
+
+	MoveShard(context.Context, *types.MoveShardRequest) (*types.MoveShardResponse, error)
 }
 
 type Executor interface {
 	Heartbeat(context.Context, *types.ExecutorHeartbeatRequest) (*types.ExecutorHeartbeatResponse, error)
+
+	Drain(context.Context, *types.ExecutorDrainRequest) (*types.ExecutorDrainResponse, error)
 }
 
 type WatchNamespaceStateServer interface {
@@ -42,6 +42,21 @@ func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
 	return m.recorder
 }
 
+// DescribeShard mocks base method.
+func (m *MockHandler) DescribeShard(arg0 context.Context, arg1 *types.DescribeShardRequest) (*types.DescribeShardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeShard", arg0, arg1)
+	ret0, _ := ret[0].(*types.DescribeShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeShard indicates an expected call of DescribeShard.
+func (mr *MockHandlerMockRecorder) DescribeShard(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShard", reflect.TypeOf((*MockHandler)(nil).DescribeShard), arg0, arg1)
+}
+
 // GetShardOwner mocks base method.
 func (m *MockHandler) GetShardOwner(arg0 context.Context, arg1 *types.GetShardOwnerRequest) (*types.GetShardOwnerResponse, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +87,51 @@ func (mr *MockHandlerMockRecorder) Health(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Health", reflect.TypeOf((*MockHandler)(nil).Health), arg0)
 }
 
+// ListExecutors mocks base method.
+func (m *MockHandler) ListExecutors(arg0 context.Context, arg1 *types.ListExecutorsRequest) (*types.ListExecutorsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExecutors", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListExecutorsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExecutors indicates an expected call of ListExecutors.
+func (mr *MockHandlerMockRecorder) ListExecutors(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExecutors", reflect.TypeOf((*MockHandler)(nil).ListExecutors), arg0, arg1)
+}
+
+// ListNamespaces mocks base method.
+func (m *MockHandler) ListNamespaces(arg0 context.Context, arg1 *types.ListNamespacesRequest) (*types.ListNamespacesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNamespaces", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListNamespacesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNamespaces indicates an expected call of ListNamespaces.
+func (mr *MockHandlerMockRecorder) ListNamespaces(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaces", reflect.TypeOf((*MockHandler)(nil).ListNamespaces), arg0, arg1)
+}
+
+// MoveShard mocks base method.
+func (m *MockHandler) MoveShard(arg0 context.Context, arg1 *types.MoveShardRequest) (*types.MoveShardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveShard", arg0, arg1)
+	ret0, _ := ret[0].(*types.MoveShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveShard indicates an expected call of MoveShard.
+func (mr *MockHandlerMockRecorder) MoveShard(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveShard", reflect.TypeOf((*MockHandler)(nil).MoveShard), arg0, arg1)
+}
+
 // Start mocks base method.
 func (m *MockHandler) Start() {
 	m.ctrl.T.Helper()
@@ -96,6 +156,21 @@ func (mr *MockHandlerMockRecorder) Stop() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockHandler)(nil).Stop))
 }
 
+// TriggerRebalance mocks base method.
+func (m *MockHandler) TriggerRebalance(arg0 context.Context, arg1 *types.TriggerRebalanceRequest) (*types.TriggerRebalanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerRebalance", arg0, arg1)
+	ret0, _ := ret[0].(*types.TriggerRebalanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerRebalance indicates an expected call of TriggerRebalance.
+func (mr *MockHandlerMockRecorder) TriggerRebalance(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerRebalance", reflect.TypeOf((*MockHandler)(nil).TriggerRebalance), arg0, arg1)
+}
+
 // WatchNamespaceState mocks base method.
 func (m *MockHandler) WatchNamespaceState(arg0 *types.WatchNamespaceStateRequest, arg1 WatchNamespaceStateServer) error {
 	m.ctrl.T.Helper()
@@ -134,6 +209,21 @@ func (m *MockExecutor) EXPECT() *MockExecutorMockRecorder {
 	return m.recorder
 }
 
+// Drain mocks base method.
+func (m *MockExecutor) Drain(arg0 context.Context, arg1 *types.ExecutorDrainRequest) (*types.ExecutorDrainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Drain", arg0, arg1)
+	ret0, _ := ret[0].(*types.ExecutorDrainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Drain indicates an expected call of Drain.
+func (mr *MockExecutorMockRecorder) Drain(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Drain", reflect.TypeOf((*MockExecutor)(nil).Drain), arg0, arg1)
+}
+
 // Heartbeat mocks base method.
 func (m *MockExecutor) Heartbeat(arg0 context.Context, arg1 *types.ExecutorHeartbeatRequest) (*types.ExecutorHeartbeatResponse, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,62 @@
+package placement
+
+// capacityStrategy spreads shards in proportion to the capacity reported by the executors.
+// The utilization of an executor is its number of shards divided by its capacity.
+type capacityStrategy struct{}
+
+func (s *capacityStrategy) Place(state *State) bool {
+	if len(state.Executors) == 0 {
+		return false
+	}
+
+	changed := false
+	// Each unassigned shard goes to the executor with the lowest utilization once it owns the shard.
+	for _, shardID := range sortedUnassigned(state) {
+		target := s.leastUtilized(state)
+		state.Assignments[target.ID] = append(state.Assignments[target.ID], shardID)
+		changed = true
+	}
+
+	// Then shards are moved from the most utilized executor as long as its utilization stays above
+	// the utilization of the receiving executor, every move is bounded by the number of shards.
+	numShards := 0
+	for _, shards := range state.Assignments {
+		numShards += len(shards)
+	}
+	for i := 0; i < numShards; i++ {
+		from, to := s.mostUtilized(state), s.leastUtilized(state)
+		if from.ID == to.ID || s.utilization(state, from, 0) <= s.utilization(state, to, 1) {
+			break
+		}
+		shards := state.Assignments[from.ID]
+		moveShard(state.Assignments, shards[len(shards)-1], from.ID, to.ID)
+		changed = true
+	}
+	return changed
+}
+
+// leastUtilized returns the executor with the lowest utilization after taking one more shard.
+func (s *capacityStrategy) leastUtilized(state *State) Executor {
+	result := state.Executors[0]
+	for _, executor := range state.Executors[1:] {
+		if s.utilization(state, executor, 1) < s.utilization(state, result, 1) {
+			result = executor
+		}
+	}
+	return result
+}
+
+// mostUtilized returns the executor with the highest utilization.
+func (s *capacityStrategy) mostUtilized(state *State) Executor {
+	result := state.Executors[0]
+	for _, executor := range state.Executors[1:] {
+		if s.utilization(state, executor, 0) > s.utilization(state, result, 0) {
+			result = executor
+		}
+	}
+	return result
+}
+
+func (*capacityStrategy) utilization(state *State, executor Executor, extraShards int) float64 {
+	return float64(len(state.Assignments[executor.ID])+extraShards) / executor.Capacity
+}
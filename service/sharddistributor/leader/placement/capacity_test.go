@@ -0,0 +1,84 @@
+package placement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapacityStrategy_Place(t *testing.T) {
+	tests := []struct {
+		name            string
+		executors       []Executor
+		assignments     map[string][]string
+		unassigned      []string
+		expectedCounts  map[string]int
+		expectedChanged bool
+	}{
+		{
+			name:            "no executors",
+			unassigned:      []string{"0"},
+			assignments:     map[string][]string{},
+			expectedCounts:  map[string]int{},
+			expectedChanged: false,
+		},
+		{
+			name:            "initial placement in proportion to capacity",
+			executors:       []Executor{{ID: "exec-1", Capacity: 2}, {ID: "exec-2", Capacity: 1}},
+			assignments:     map[string][]string{},
+			unassigned:      makeShardIDs("shard-", 9),
+			expectedCounts:  map[string]int{"exec-1": 6, "exec-2": 3},
+			expectedChanged: true,
+		},
+		{
+			name:      "rebalances to a new executor",
+			executors: []Executor{{ID: "exec-1", Capacity: 1}, {ID: "exec-2", Capacity: 1}, {ID: "exec-3", Capacity: 2}},
+			assignments: map[string][]string{
+				"exec-1": makeShardIDs("a-", 4),
+				"exec-2": makeShardIDs("b-", 4),
+			},
+			expectedCounts:  map[string]int{"exec-1": 2, "exec-2": 2, "exec-3": 4},
+			expectedChanged: true,
+		},
+		{
+			name:      "balanced assignments are kept",
+			executors: []Executor{{ID: "exec-1", Capacity: 3}, {ID: "exec-2", Capacity: 1}},
+			assignments: map[string][]string{
+				"exec-1": makeShardIDs("a-", 6),
+				"exec-2": makeShardIDs("b-", 2),
+			},
+			expectedCounts:  map[string]int{"exec-1": 6, "exec-2": 2},
+			expectedChanged: false,
+		},
+		{
+			name:      "unassigned shards go to the least utilized executor",
+			executors: []Executor{{ID: "exec-1", Capacity: 1}, {ID: "exec-2", Capacity: 1}},
+			assignments: map[string][]string{
+				"exec-1": makeShardIDs("a-", 3),
+				"exec-2": makeShardIDs("b-", 1),
+			},
+			unassigned:      []string{"c-0", "c-1"},
+			expectedCounts:  map[string]int{"exec-1": 3, "exec-2": 3},
+			expectedChanged: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &State{Executors: tt.executors, Assignments: tt.assignments, Unassigned: tt.unassigned}
+			var shards []string
+			for _, assigned := range tt.assignments {
+				shards = append(shards, assigned...)
+			}
+			shards = append(shards, tt.unassigned...)
+
+			changed := (&capacityStrategy{}).Place(state)
+
+			assert.Equal(t, tt.expectedChanged, changed)
+			assert.Equal(t, tt.expectedCounts, countShards(state))
+			if len(tt.executors) > 0 {
+				requireAllPlaced(t, state, shards)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package placement
+
+import (
+	"cmp"
+	"maps"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+
+	"github.com/dgryski/go-farm"
+)
+
+// _virtualNodes is the number of points of each executor on the hash ring.
+const _virtualNodes = 100
+
+// consistentHashStrategy places shards with consistent hashing with bounded loads:
+// a shard goes to the first executor clockwise from its hash on the ring that owns fewer than
+// loadFactor times the average number of shards. The placement only depends on the executors
+// and the shards, so executor churn moves few shards besides the ones of the executors that left.
+type consistentHashStrategy struct {
+	loadFactor float64
+}
+
+type ringPoint struct {
+	hash       uint64
+	executorID string
+}
+
+func (s *consistentHashStrategy) Place(state *State) bool {
+	if len(state.Executors) == 0 {
+		return false
+	}
+
+	owners := make(map[string]string)
+	for _, executor := range state.Executors {
+		for _, shardID := range state.Assignments[executor.ID] {
+			owners[shardID] = executor.ID
+		}
+	}
+	shards := append(slices.Collect(maps.Keys(owners)), state.Unassigned...)
+	slices.Sort(shards)
+
+	ring := newRing(state.Executors)
+	maxShards := int(math.Ceil(s.loadFactor * float64(len(shards)) / float64(len(state.Executors))))
+
+	changed := len(state.Unassigned) > 0
+	newAssignments := make(map[string][]string, len(state.Executors))
+	for _, executor := range state.Executors {
+		newAssignments[executor.ID] = []string{}
+	}
+	for _, shardID := range shards {
+		hash := farm.Fingerprint64([]byte(shardID))
+		i := sort.Search(len(ring), func(i int) bool { return ring[i].hash >= hash })
+		for ; ; i++ {
+			executorID := ring[i%len(ring)].executorID
+			if len(newAssignments[executorID]) < maxShards {
+				newAssignments[executorID] = append(newAssignments[executorID], shardID)
+				if owner, ok := owners[shardID]; ok && owner != executorID {
+					changed = true
+				}
+				break
+			}
+		}
+	}
+
+	for executorID, assigned := range newAssignments {
+		state.Assignments[executorID] = assigned
+	}
+	return changed
+}
+
+func newRing(executors []Executor) []ringPoint {
+	ring := make([]ringPoint, 0, len(executors)*_virtualNodes)
+	for _, executor := range executors {
+		for i := 0; i < _virtualNodes; i++ {
+			ring = append(ring, ringPoint{
+				hash:       farm.Fingerprint64([]byte(executor.ID + "#" + strconv.Itoa(i))),
+				executorID: executor.ID,
+			})
+		}
+	}
+	slices.SortFunc(ring, func(a, b ringPoint) int { return cmp.Compare(a.hash, b.hash) })
+	return ring
+}
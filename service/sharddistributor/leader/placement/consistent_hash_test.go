@@ -0,0 +1,59 @@
+package placement
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsistentHashStrategy_Place(t *testing.T) {
+	strategy := &consistentHashStrategy{loadFactor: 1.25}
+	shards := makeShardIDs("shard-", 100)
+	executors := []Executor{{ID: "exec-1"}, {ID: "exec-2"}, {ID: "exec-3"}, {ID: "exec-4"}}
+
+	state := &State{Executors: executors, Assignments: map[string][]string{}, Unassigned: shards}
+	require.True(t, strategy.Place(state))
+	requireAllPlaced(t, state, shards)
+
+	// Every executor stays within the load bound
+	maxShards := int(math.Ceil(1.25 * 100 / 4))
+	for executorID, count := range countShards(state) {
+		assert.LessOrEqual(t, count, maxShards, "executor %s", executorID)
+		assert.Positive(t, count, "executor %s", executorID)
+	}
+
+	// The placement is stable
+	state.Unassigned = nil
+	before := owners(state.Assignments)
+	assert.False(t, strategy.Place(state))
+	assert.Equal(t, before, owners(state.Assignments))
+
+	// Removing an executor mostly moves its own shards
+	state.Unassigned = state.Assignments["exec-4"]
+	delete(state.Assignments, "exec-4")
+	state.Executors = executors[:3]
+	assert.True(t, strategy.Place(state))
+	requireAllPlaced(t, state, shards)
+
+	moved := 0
+	for shardID, executorID := range owners(state.Assignments) {
+		if before[shardID] != "exec-4" && before[shardID] != executorID {
+			moved++
+		}
+	}
+	assert.Less(t, moved, len(shards)/4, "shards of the remaining executors should mostly stay")
+}
+
+func TestConsistentHashStrategy_NoExecutors(t *testing.T) {
+	state := &State{Assignments: map[string][]string{}, Unassigned: []string{"0"}}
+	assert.False(t, (&consistentHashStrategy{loadFactor: 1.25}).Place(state))
+	assert.Empty(t, state.Assignments)
+}
+
+func TestConsistentHashStrategy_NoShards(t *testing.T) {
+	state := &State{Executors: []Executor{{ID: "exec-1"}}, Assignments: map[string][]string{}}
+	assert.False(t, (&consistentHashStrategy{loadFactor: 1.25}).Place(state))
+	assert.Equal(t, map[string][]string{"exec-1": {}}, state.Assignments)
+}
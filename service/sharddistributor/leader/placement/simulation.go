@@ -0,0 +1,131 @@
+package placement
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"text/tabwriter"
+)
+
+// _maxRoundsPerStep bounds the placement rounds run after each step of a simulation.
+const _maxRoundsPerStep = 10
+
+// Scenario is a sequence of executor changes to replay against a placement strategy.
+type Scenario struct {
+	// Shards are the shards of the namespace.
+	Shards []string
+	// Executors are the executors the shards are initially placed on.
+	Executors []Executor
+	// Steps are applied in order after the initial placement.
+	Steps []Step
+}
+
+// Step adds and removes executors.
+type Step struct {
+	Name   string
+	Add    []Executor
+	Remove []string
+}
+
+// Report is the outcome of a simulation.
+type Report struct {
+	Steps []StepReport
+	// TotalMoved is the number of shards moved between live executors over all steps.
+	TotalMoved int
+}
+
+// StepReport is the outcome of one step of a simulation.
+type StepReport struct {
+	Name string
+	// Orphaned is the number of shards whose executor was removed, which have to be placed again.
+	Orphaned int
+	// Moved is the number of shards moved from an executor that was not removed.
+	Moved int
+	// MinShards and MaxShards are the fewest and the most shards owned by an executor after the step.
+	MinShards int
+	MaxShards int
+	// Rounds is the number of placement rounds until the strategy made no change.
+	Rounds int
+}
+
+// Simulate places the shards of scenario on its executors, then replays its steps and reports
+// how many shards every step moved. After each step the placement is run until it makes no change,
+// as the leader does over successive rebalancing loops.
+func Simulate(strategy Strategy, scenario Scenario) Report {
+	state := &State{
+		Executors:   slices.Clone(scenario.Executors),
+		Assignments: make(map[string][]string),
+		Unassigned:  slices.Clone(scenario.Shards),
+	}
+	for _, executor := range state.Executors {
+		state.Assignments[executor.ID] = []string{}
+	}
+
+	var report Report
+	steps := append([]Step{{Name: "initial"}}, scenario.Steps...)
+	for _, step := range steps {
+		before := owners(state.Assignments)
+
+		for _, executorID := range step.Remove {
+			state.Unassigned = append(state.Unassigned, state.Assignments[executorID]...)
+			delete(state.Assignments, executorID)
+			state.Executors = slices.DeleteFunc(state.Executors, func(e Executor) bool { return e.ID == executorID })
+		}
+		for _, executor := range step.Add {
+			state.Executors = append(state.Executors, executor)
+			state.Assignments[executor.ID] = []string{}
+		}
+		slices.SortFunc(state.Executors, func(a, b Executor) int { return strings.Compare(a.ID, b.ID) })
+
+		stepReport := StepReport{Name: step.Name, Orphaned: len(state.Unassigned)}
+		for stepReport.Rounds < _maxRoundsPerStep && strategy.Place(state) {
+			state.Unassigned = nil
+			stepReport.Rounds++
+		}
+
+		for shardID, executorID := range owners(state.Assignments) {
+			if previous, ok := before[shardID]; ok && previous != executorID && !slices.Contains(step.Remove, previous) {
+				stepReport.Moved++
+			}
+		}
+		stepReport.MinShards, stepReport.MaxShards = shardRange(state)
+		report.Steps = append(report.Steps, stepReport)
+		report.TotalMoved += stepReport.Moved
+	}
+	return report
+}
+
+func (r Report) String() string {
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "STEP\tORPHANED\tMOVED\tMIN SHARDS\tMAX SHARDS\tROUNDS")
+	for _, step := range r.Steps {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\n", step.Name, step.Orphaned, step.Moved, step.MinShards, step.MaxShards, step.Rounds)
+	}
+	fmt.Fprintf(w, "total moved\t\t%d\t\t\t\n", r.TotalMoved)
+	w.Flush()
+	return sb.String()
+}
+
+func owners(assignments map[string][]string) map[string]string {
+	result := make(map[string]string)
+	for executorID, shards := range assignments {
+		for _, shardID := range shards {
+			result[shardID] = executorID
+		}
+	}
+	return result
+}
+
+func shardRange(state *State) (minShards, maxShards int) {
+	for i, executor := range state.Executors {
+		n := len(state.Assignments[executor.ID])
+		if i == 0 || n < minShards {
+			minShards = n
+		}
+		if n > maxShards {
+			maxShards = n
+		}
+	}
+	return minShards, maxShards
+}
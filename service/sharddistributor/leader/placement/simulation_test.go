@@ -0,0 +1,84 @@
+package placement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/service/sharddistributor/config"
+)
+
+func churnScenario() Scenario {
+	zones := []string{"zone-a", "zone-b", "zone-c"}
+	executor := func(id string, i int) Executor {
+		return Executor{ID: id, Capacity: 1, Zone: zones[i%len(zones)]}
+	}
+
+	return Scenario{
+		Shards: makeShardIDs("shard-", 300),
+		Executors: []Executor{
+			executor("exec-0", 0), executor("exec-1", 1), executor("exec-2", 2),
+			executor("exec-3", 0), executor("exec-4", 1), executor("exec-5", 2),
+		},
+		Steps: []Step{
+			{Name: "executor removed", Remove: []string{"exec-0"}},
+			{Name: "executor added", Add: []Executor{executor("exec-6", 0)}},
+			{Name: "rolling restart", Remove: []string{"exec-1"}, Add: []Executor{executor("exec-7", 1)}},
+			{Name: "scale out", Add: []Executor{executor("exec-8", 2), executor("exec-9", 0)}},
+			{Name: "zone lost", Remove: []string{"exec-2", "exec-5", "exec-8"}},
+		},
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	for _, name := range []string{config.PlacementStrategyCapacity, config.PlacementStrategyZone, config.PlacementStrategyConsistentHash} {
+		t.Run(name, func(t *testing.T) {
+			strategy, err := New(config.Placement{Strategy: name})
+			require.NoError(t, err)
+
+			scenario := churnScenario()
+			report := Simulate(strategy, scenario)
+			t.Logf("\n%s", report)
+
+			require.Len(t, report.Steps, len(scenario.Steps)+1)
+			assert.Equal(t, "initial", report.Steps[0].Name)
+			assert.Equal(t, len(scenario.Shards), report.Steps[0].Orphaned)
+			assert.Zero(t, report.Steps[0].Moved)
+
+			total := 0
+			for _, step := range report.Steps {
+				assert.Less(t, step.Rounds, _maxRoundsPerStep, "step %s should converge", step.Name)
+				assert.Positive(t, step.MinShards, "step %s should not leave idle executors", step.Name)
+				total += step.Moved
+			}
+			assert.Equal(t, total, report.TotalMoved)
+		})
+	}
+}
+
+func TestSimulate_ExecutorRemovedOnlyMovesItsShards(t *testing.T) {
+	// Removing an executor from a balanced placement only requires placing its own shards again
+	for _, strategy := range []Strategy{&capacityStrategy{}, &zoneStrategy{}} {
+		report := Simulate(strategy, churnScenario())
+		assert.Equal(t, 50, report.Steps[1].Orphaned, "%T", strategy)
+		assert.Zero(t, report.Steps[1].Moved, "%T", strategy)
+	}
+}
+
+func TestReport_String(t *testing.T) {
+	report := Report{
+		Steps: []StepReport{
+			{Name: "initial", Orphaned: 10, MinShards: 5, MaxShards: 5, Rounds: 1},
+			{Name: "executor removed", Orphaned: 5, Moved: 1, MinShards: 10, MaxShards: 10, Rounds: 1},
+		},
+		TotalMoved: 1,
+	}
+
+	assert.Equal(t, ""+
+		"STEP              ORPHANED  MOVED  MIN SHARDS  MAX SHARDS  ROUNDS\n"+
+		"initial           10        0      5           5           1\n"+
+		"executor removed  5         1      10          10          1\n"+
+		"total moved                 1                              \n",
+		report.String())
+}
@@ -0,0 +1,108 @@
+package placement
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/uber/cadence/service/sharddistributor/client/clientcommon"
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/store"
+)
+
+const (
+	// MetadataKeyCapacity is the executor metadata key holding the relative capacity of the executor.
+	MetadataKeyCapacity = clientcommon.CapacityMetadataKey
+	// MetadataKeyZone is the executor metadata key holding the zone or isolation group of the executor.
+	MetadataKeyZone = clientcommon.ZoneMetadataKey
+
+	_defaultCapacity   = 1.0
+	_defaultLoadFactor = 1.25
+)
+
+// Strategy decides which executor owns each shard of a namespace.
+type Strategy interface {
+	// Place assigns the unassigned shards of state to executors, and may move assigned shards
+	// between executors. It updates state.Assignments in place and returns true if it changed.
+	Place(state *State) bool
+}
+
+// State is the input of a placement.
+type State struct {
+	// Executors are the executors that can own shards, sorted by ID.
+	Executors []Executor
+	// Assignments holds the shards currently owned by each executor.
+	// Key: ExecutorID
+	Assignments map[string][]string
+	// Unassigned are the shards without an owner.
+	Unassigned []string
+}
+
+// Executor is an executor that can own shards.
+type Executor struct {
+	ID string
+	// Capacity is the relative capacity reported by the executor, 1 if not reported.
+	Capacity float64
+	// Zone is the zone reported by the executor, empty if not reported.
+	Zone string
+}
+
+// New creates the placement strategy configured for a namespace.
+// It returns nil for the default strategy, which is implemented by the leader processor.
+func New(cfg config.Placement) (Strategy, error) {
+	switch cfg.Strategy {
+	case "", config.PlacementStrategyDefault:
+		return nil, nil
+	case config.PlacementStrategyCapacity:
+		return &capacityStrategy{}, nil
+	case config.PlacementStrategyZone:
+		return &zoneStrategy{antiAffinitySeparator: cfg.AntiAffinitySeparator}, nil
+	case config.PlacementStrategyConsistentHash:
+		loadFactor := cfg.LoadFactor
+		if loadFactor == 0 {
+			loadFactor = _defaultLoadFactor
+		}
+		if loadFactor <= 1 {
+			return nil, fmt.Errorf("load factor must be greater than 1, got %v", loadFactor)
+		}
+		return &consistentHashStrategy{loadFactor: loadFactor}, nil
+	default:
+		return nil, fmt.Errorf("unknown placement strategy %q", cfg.Strategy)
+	}
+}
+
+// NewExecutors returns the given executors with the capacity and zone they reported in their metadata.
+func NewExecutors(executorIDs []string, executors map[string]store.HeartbeatState) []Executor {
+	result := make([]Executor, 0, len(executorIDs))
+	for _, executorID := range executorIDs {
+		metadata := executors[executorID].Metadata
+
+		capacity, err := strconv.ParseFloat(metadata[MetadataKeyCapacity], 64)
+		if err != nil || capacity <= 0 {
+			capacity = _defaultCapacity
+		}
+		result = append(result, Executor{
+			ID:       executorID,
+			Capacity: capacity,
+			Zone:     metadata[MetadataKeyZone],
+		})
+	}
+	slices.SortFunc(result, func(a, b Executor) int { return strings.Compare(a.ID, b.ID) })
+	return result
+}
+
+// moveShard moves a shard between two executors of assignments.
+func moveShard(assignments map[string][]string, shardID, from, to string) {
+	if i := slices.Index(assignments[from], shardID); i >= 0 {
+		assignments[from] = slices.Delete(assignments[from], i, i+1)
+	}
+	assignments[to] = append(assignments[to], shardID)
+}
+
+// sortedUnassigned returns the unassigned shards of state in a deterministic order.
+func sortedUnassigned(state *State) []string {
+	shards := slices.Clone(state.Unassigned)
+	slices.Sort(shards)
+	return shards
+}
@@ -0,0 +1,125 @@
+package placement
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/store"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       config.Placement
+		expected  Strategy
+		expectErr string
+	}{
+		{
+			name:     "empty is the default strategy",
+			cfg:      config.Placement{},
+			expected: nil,
+		},
+		{
+			name:     "default",
+			cfg:      config.Placement{Strategy: config.PlacementStrategyDefault},
+			expected: nil,
+		},
+		{
+			name:     "capacity",
+			cfg:      config.Placement{Strategy: config.PlacementStrategyCapacity},
+			expected: &capacityStrategy{},
+		},
+		{
+			name:     "zone",
+			cfg:      config.Placement{Strategy: config.PlacementStrategyZone, AntiAffinitySeparator: "/"},
+			expected: &zoneStrategy{antiAffinitySeparator: "/"},
+		},
+		{
+			name:     "consistent hash with default load factor",
+			cfg:      config.Placement{Strategy: config.PlacementStrategyConsistentHash},
+			expected: &consistentHashStrategy{loadFactor: 1.25},
+		},
+		{
+			name:     "consistent hash",
+			cfg:      config.Placement{Strategy: config.PlacementStrategyConsistentHash, LoadFactor: 1.5},
+			expected: &consistentHashStrategy{loadFactor: 1.5},
+		},
+		{
+			name:      "consistent hash with invalid load factor",
+			cfg:       config.Placement{Strategy: config.PlacementStrategyConsistentHash, LoadFactor: 0.5},
+			expectErr: "load factor must be greater than 1",
+		},
+		{
+			name:      "unknown strategy",
+			cfg:       config.Placement{Strategy: "random"},
+			expectErr: `unknown placement strategy "random"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy, err := New(tt.cfg)
+			if tt.expectErr != "" {
+				require.ErrorContains(t, err, tt.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, strategy)
+		})
+	}
+}
+
+func TestNewExecutors(t *testing.T) {
+	executors := map[string]store.HeartbeatState{
+		"exec-2": {Metadata: map[string]string{MetadataKeyCapacity: "2.5", MetadataKeyZone: "zone-a"}},
+		"exec-1": {},
+		"exec-3": {Metadata: map[string]string{MetadataKeyCapacity: "invalid"}},
+		"exec-4": {Metadata: map[string]string{MetadataKeyCapacity: "-1"}},
+		"exec-5": {Metadata: map[string]string{MetadataKeyCapacity: "10"}},
+	}
+
+	result := NewExecutors([]string{"exec-4", "exec-3", "exec-2", "exec-1"}, executors)
+	assert.Equal(t, []Executor{
+		{ID: "exec-1", Capacity: 1},
+		{ID: "exec-2", Capacity: 2.5, Zone: "zone-a"},
+		{ID: "exec-3", Capacity: 1},
+		{ID: "exec-4", Capacity: 1},
+	}, result)
+}
+
+// countShards returns the number of shards of each executor of state.
+func countShards(state *State) map[string]int {
+	result := make(map[string]int)
+	for _, executor := range state.Executors {
+		result[executor.ID] = len(state.Assignments[executor.ID])
+	}
+	return result
+}
+
+// requireAllPlaced checks that every shard is owned by exactly one executor of state.
+func requireAllPlaced(t *testing.T, state *State, shards []string) {
+	t.Helper()
+
+	owned := make(map[string]int)
+	for _, executor := range state.Executors {
+		for _, shardID := range state.Assignments[executor.ID] {
+			owned[shardID]++
+		}
+	}
+	require.Len(t, owned, len(shards))
+	for _, shardID := range shards {
+		require.Equal(t, 1, owned[shardID], "shard %s", shardID)
+	}
+}
+
+func makeShardIDs(prefix string, n int) []string {
+	result := make([]string, n)
+	for i := range result {
+		result[i] = prefix + strconv.Itoa(i)
+	}
+	return result
+}
@@ -0,0 +1,208 @@
+package placement
+
+import (
+	"slices"
+	"strings"
+)
+
+// zoneStrategy spreads shards evenly over the zones of the executors, then evenly over the executors of each zone,
+// so losing a zone loses the same share of shards whatever its size.
+// Shards of the same anti-affinity group are placed in different zones when possible.
+type zoneStrategy struct {
+	antiAffinitySeparator string
+}
+
+// zonePlacement tracks the shards of each zone and anti-affinity group during a placement.
+type zonePlacement struct {
+	state *State
+	// zones holds the executors of each zone.
+	zones map[string][]Executor
+	// zoneNames are the zones, sorted.
+	zoneNames []string
+	// groups holds the number of shards of each anti-affinity group per zone.
+	groups map[string]map[string]int
+	group  func(shardID string) string
+}
+
+func (s *zoneStrategy) Place(state *State) bool {
+	if len(state.Executors) == 0 {
+		return false
+	}
+
+	p := s.newZonePlacement(state)
+	changed := false
+
+	for _, shardID := range sortedUnassigned(state) {
+		zone := p.zoneForShard(shardID)
+		p.add(shardID, p.leastLoaded(zone).ID, zone)
+		changed = true
+	}
+
+	// Spread over zones: the zones differ by at most one shard.
+	numShards := p.numShards()
+	for i := 0; i < numShards; i++ {
+		from, to := p.mostLoadedZone(), p.leastLoadedZone()
+		if p.zoneShards(from)-p.zoneShards(to) <= 1 {
+			break
+		}
+		shardID, executorID := p.shardToMove(from, to)
+		p.move(shardID, executorID, from, p.leastLoaded(to).ID, to)
+		changed = true
+	}
+
+	// Spread over the executors of each zone: they differ by at most one shard.
+	for _, zone := range p.zoneNames {
+		for i := 0; i < numShards; i++ {
+			from, to := p.mostLoaded(zone), p.leastLoaded(zone)
+			if len(state.Assignments[from.ID])-len(state.Assignments[to.ID]) <= 1 {
+				break
+			}
+			shards := state.Assignments[from.ID]
+			moveShard(state.Assignments, shards[len(shards)-1], from.ID, to.ID)
+			changed = true
+		}
+	}
+	return changed
+}
+
+func (s *zoneStrategy) newZonePlacement(state *State) *zonePlacement {
+	p := &zonePlacement{
+		state:  state,
+		zones:  make(map[string][]Executor),
+		groups: make(map[string]map[string]int),
+		group:  s.group,
+	}
+	for _, executor := range state.Executors {
+		if _, ok := p.zones[executor.Zone]; !ok {
+			p.zoneNames = append(p.zoneNames, executor.Zone)
+		}
+		p.zones[executor.Zone] = append(p.zones[executor.Zone], executor)
+
+		for _, shardID := range state.Assignments[executor.ID] {
+			p.addToGroup(shardID, executor.Zone, 1)
+		}
+	}
+	slices.Sort(p.zoneNames)
+	return p
+}
+
+// group returns the anti-affinity group of a shard, every shard is its own group without a separator.
+func (s *zoneStrategy) group(shardID string) string {
+	if s.antiAffinitySeparator == "" {
+		return shardID
+	}
+	if i := strings.LastIndex(shardID, s.antiAffinitySeparator); i >= 0 {
+		return shardID[:i]
+	}
+	return shardID
+}
+
+// zoneForShard returns the zone with the fewest shards of the group of shardID, then the fewest shards.
+func (p *zonePlacement) zoneForShard(shardID string) string {
+	group := p.group(shardID)
+	result := p.zoneNames[0]
+	for _, zone := range p.zoneNames[1:] {
+		if p.groups[group][zone] < p.groups[group][result] ||
+			p.groups[group][zone] == p.groups[group][result] && p.zoneShards(zone) < p.zoneShards(result) {
+			result = zone
+		}
+	}
+	return result
+}
+
+// shardToMove picks a shard of the most loaded executor of zone from, preferring shards whose
+// group is not in zone to, then shards whose group has the most shards in zone from.
+func (p *zonePlacement) shardToMove(from, to string) (shardID string, executorID string) {
+	executor := p.mostLoaded(from)
+	shards := slices.Clone(p.state.Assignments[executor.ID])
+	slices.Sort(shards)
+
+	best := shards[0]
+	for _, candidate := range shards[1:] {
+		candidateGroup, bestGroup := p.group(candidate), p.group(best)
+		if p.groups[candidateGroup][to] < p.groups[bestGroup][to] ||
+			p.groups[candidateGroup][to] == p.groups[bestGroup][to] && p.groups[candidateGroup][from] > p.groups[bestGroup][from] {
+			best = candidate
+		}
+	}
+	return best, executor.ID
+}
+
+func (p *zonePlacement) add(shardID, executorID, zone string) {
+	p.state.Assignments[executorID] = append(p.state.Assignments[executorID], shardID)
+	p.addToGroup(shardID, zone, 1)
+}
+
+func (p *zonePlacement) move(shardID, fromExecutor, fromZone, toExecutor, toZone string) {
+	moveShard(p.state.Assignments, shardID, fromExecutor, toExecutor)
+	p.addToGroup(shardID, fromZone, -1)
+	p.addToGroup(shardID, toZone, 1)
+}
+
+func (p *zonePlacement) addToGroup(shardID, zone string, delta int) {
+	group := p.group(shardID)
+	if p.groups[group] == nil {
+		p.groups[group] = make(map[string]int)
+	}
+	p.groups[group][zone] += delta
+}
+
+func (p *zonePlacement) numShards() int {
+	result := 0
+	for _, zone := range p.zoneNames {
+		result += p.zoneShards(zone)
+	}
+	return result
+}
+
+func (p *zonePlacement) zoneShards(zone string) int {
+	result := 0
+	for _, executor := range p.zones[zone] {
+		result += len(p.state.Assignments[executor.ID])
+	}
+	return result
+}
+
+func (p *zonePlacement) mostLoadedZone() string {
+	result := p.zoneNames[0]
+	for _, zone := range p.zoneNames[1:] {
+		if p.zoneShards(zone) > p.zoneShards(result) {
+			result = zone
+		}
+	}
+	return result
+}
+
+func (p *zonePlacement) leastLoadedZone() string {
+	result := p.zoneNames[0]
+	for _, zone := range p.zoneNames[1:] {
+		if p.zoneShards(zone) < p.zoneShards(result) {
+			result = zone
+		}
+	}
+	return result
+}
+
+// mostLoaded returns the executor of zone with the most shards.
+func (p *zonePlacement) mostLoaded(zone string) Executor {
+	executors := p.zones[zone]
+	result := executors[0]
+	for _, executor := range executors[1:] {
+		if len(p.state.Assignments[executor.ID]) > len(p.state.Assignments[result.ID]) {
+			result = executor
+		}
+	}
+	return result
+}
+
+// leastLoaded returns the executor of zone with the fewest shards.
+func (p *zonePlacement) leastLoaded(zone string) Executor {
+	executors := p.zones[zone]
+	result := executors[0]
+	for _, executor := range executors[1:] {
+		if len(p.state.Assignments[executor.ID]) < len(p.state.Assignments[result.ID]) {
+			result = executor
+		}
+	}
+	return result
+}
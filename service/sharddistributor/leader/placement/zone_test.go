@@ -0,0 +1,156 @@
+package placement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZoneStrategy_Place(t *testing.T) {
+	tests := []struct {
+		name            string
+		executors       []Executor
+		assignments     map[string][]string
+		unassigned      []string
+		expectedCounts  map[string]int
+		expectedChanged bool
+	}{
+		{
+			name:            "no executors",
+			unassigned:      []string{"0"},
+			assignments:     map[string][]string{},
+			expectedCounts:  map[string]int{},
+			expectedChanged: false,
+		},
+		{
+			name: "spreads evenly over zones, then over executors",
+			executors: []Executor{
+				{ID: "exec-1", Zone: "zone-a"},
+				{ID: "exec-2", Zone: "zone-b"},
+				{ID: "exec-3", Zone: "zone-b"},
+			},
+			assignments:     map[string][]string{},
+			unassigned:      makeShardIDs("shard-", 8),
+			expectedCounts:  map[string]int{"exec-1": 4, "exec-2": 2, "exec-3": 2},
+			expectedChanged: true,
+		},
+		{
+			name: "moves shards to a new zone",
+			executors: []Executor{
+				{ID: "exec-1", Zone: "zone-a"},
+				{ID: "exec-2", Zone: "zone-a"},
+				{ID: "exec-3", Zone: "zone-b"},
+			},
+			assignments: map[string][]string{
+				"exec-1": makeShardIDs("a-", 3),
+				"exec-2": makeShardIDs("b-", 3),
+			},
+			expectedCounts:  map[string]int{"exec-1": 1, "exec-2": 2, "exec-3": 3},
+			expectedChanged: true,
+		},
+		{
+			name: "balances executors within a zone",
+			executors: []Executor{
+				{ID: "exec-1", Zone: "zone-a"},
+				{ID: "exec-2", Zone: "zone-a"},
+			},
+			assignments: map[string][]string{
+				"exec-1": makeShardIDs("a-", 4),
+			},
+			expectedCounts:  map[string]int{"exec-1": 2, "exec-2": 2},
+			expectedChanged: true,
+		},
+		{
+			name: "balanced assignments are kept",
+			executors: []Executor{
+				{ID: "exec-1", Zone: "zone-a"},
+				{ID: "exec-2", Zone: "zone-b"},
+			},
+			assignments: map[string][]string{
+				"exec-1": makeShardIDs("a-", 2),
+				"exec-2": makeShardIDs("b-", 3),
+			},
+			expectedCounts:  map[string]int{"exec-1": 2, "exec-2": 3},
+			expectedChanged: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &State{Executors: tt.executors, Assignments: tt.assignments, Unassigned: tt.unassigned}
+			var shards []string
+			for _, assigned := range tt.assignments {
+				shards = append(shards, assigned...)
+			}
+			shards = append(shards, tt.unassigned...)
+
+			changed := (&zoneStrategy{}).Place(state)
+
+			assert.Equal(t, tt.expectedChanged, changed)
+			assert.Equal(t, tt.expectedCounts, countShards(state))
+			if len(tt.executors) > 0 {
+				requireAllPlaced(t, state, shards)
+			}
+		})
+	}
+}
+
+func TestZoneStrategy_AntiAffinity(t *testing.T) {
+	executors := []Executor{
+		{ID: "exec-1", Zone: "zone-a"},
+		{ID: "exec-2", Zone: "zone-a"},
+		{ID: "exec-3", Zone: "zone-b"},
+		{ID: "exec-4", Zone: "zone-b"},
+		{ID: "exec-5", Zone: "zone-c"},
+		{ID: "exec-6", Zone: "zone-c"},
+	}
+	zoneOf := map[string]string{}
+	for _, executor := range executors {
+		zoneOf[executor.ID] = executor.Zone
+	}
+
+	// Three partitions of each task list
+	var shards []string
+	for _, taskList := range []string{"tl-1", "tl-2", "tl-3", "tl-4"} {
+		for _, partition := range []string{"0", "1", "2"} {
+			shards = append(shards, taskList+"/"+partition)
+		}
+	}
+
+	state := &State{Executors: executors, Assignments: map[string][]string{}, Unassigned: shards}
+	assert.True(t, (&zoneStrategy{antiAffinitySeparator: "/"}).Place(state))
+	requireAllPlaced(t, state, shards)
+
+	// Every partition of a task list is in a different zone
+	zonesOfGroup := map[string]map[string]bool{}
+	for executorID, assigned := range state.Assignments {
+		for _, shardID := range assigned {
+			group := shardID[:len(shardID)-2]
+			if zonesOfGroup[group] == nil {
+				zonesOfGroup[group] = map[string]bool{}
+			}
+			assert.False(t, zonesOfGroup[group][zoneOf[executorID]], "partitions of %s share zone %s", group, zoneOf[executorID])
+			zonesOfGroup[group][zoneOf[executorID]] = true
+		}
+	}
+	assert.Len(t, zonesOfGroup, 4)
+}
+
+func TestZoneStrategy_Group(t *testing.T) {
+	tests := []struct {
+		separator string
+		shardID   string
+		expected  string
+	}{
+		{separator: "", shardID: "tl-1/0", expected: "tl-1/0"},
+		{separator: "/", shardID: "tl-1/0", expected: "tl-1"},
+		{separator: "/", shardID: "domain/tl-1/0", expected: "domain/tl-1"},
+		{separator: "/", shardID: "tl-1", expected: "tl-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.separator+"_"+tt.shardID, func(t *testing.T) {
+			assert.Equal(t, tt.expected, (&zoneStrategy{antiAffinitySeparator: tt.separator}).group(tt.shardID))
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/leader/placement"
 	"github.com/uber/cadence/service/sharddistributor/store"
 )
 
@@ -50,6 +51,7 @@ const (
 	_defaultHeartbeatTTL = 10 * time.Second
 	_defaultTimeout      = 1 * time.Second
 	_defaultCooldown     = 250 * time.Millisecond
+	_defaultDrainBatch   = 10
 )
 
 type processorFactory struct {
@@ -72,6 +74,8 @@ type namespaceProcessor struct {
 	wg            sync.WaitGroup
 	shardStore    store.Store
 	election      store.Election
+	// placement is nil for the default placement strategy.
+	placement placement.Strategy
 }
 
 // NewProcessorFactory creates a new processor factory
@@ -94,6 +98,9 @@ func NewProcessorFactory(
 	if cfg.Process.RebalanceCooldown == 0 {
 		cfg.Process.RebalanceCooldown = _defaultCooldown
 	}
+	if cfg.Process.DrainBatchSize == 0 {
+		cfg.Process.DrainBatchSize = _defaultDrainBatch
+	}
 
 	return &processorFactory{
 		logger:        logger,
@@ -106,15 +113,22 @@ func NewProcessorFactory(
 
 // CreateProcessor creates a new processor for the given namespace
 func (f *processorFactory) CreateProcessor(cfg config.Namespace, shardStore store.Store, election store.Election) Processor {
+	logger := f.logger.WithTags(tag.ComponentLeaderProcessor, tag.ShardNamespace(cfg.Name))
+	placementStrategy, err := placement.New(cfg.Placement)
+	if err != nil {
+		logger.Error("Invalid placement strategy, using the default one", tag.Error(err))
+	}
+
 	return &namespaceProcessor{
 		namespaceCfg:  cfg,
-		logger:        f.logger.WithTags(tag.ComponentLeaderProcessor, tag.ShardNamespace(cfg.Name)),
+		logger:        logger,
 		timeSource:    f.timeSource,
 		cfg:           f.cfg,
 		shardStore:    shardStore,
 		election:      election, // Store the election object
 		metricsClient: f.metricsClient,
 		sdConfig:      f.sdConfig,
+		placement:     placementStrategy,
 	}
 }
 
@@ -439,17 +453,21 @@ func (p *namespaceProcessor) rebalanceShardsImpl(ctx context.Context, metricsLoo
 	}
 	metricsLoopScope.AddCounter(metrics.ShardDistributorAssignLoopDeletedShards, int64(len(deletedShards)))
 
-	shardsToReassign, currentAssignments := p.findShardsToReassign(activeExecutors, namespaceState, deletedShards, staleExecutors)
+	shardsToReassign, currentAssignments, drainingAssignments := p.findShardsToReassign(activeExecutors, namespaceState, deletedShards, staleExecutors)
 
 	metricsLoopScope.AddCounter(metrics.ShardDistributorAssignLoopNumRebalancedShards, int64(len(shardsToReassign)))
 
-	// If there are deleted shards or stale executors, the distribution has changed.
-	assignedToEmptyExecutors := assignShardsToEmptyExecutors(currentAssignments)
-	updatedAssignments := p.updateAssignments(shardsToReassign, activeExecutors, currentAssignments)
-	isRebalancedByShardLoad := p.rebalanceByShardLoad(calcShardLoad(namespaceState), currentAssignments, metricsLoopScope)
+	placementChanged := p.placeShards(namespaceState, shardsToReassign, activeExecutors, currentAssignments, metricsLoopScope)
+
+	// Draining executors keep the shards that are not handed over in this round,
+	// they are added after the placement so no shard is placed on them.
+	for executorID, shardIDs := range drainingAssignments {
+		currentAssignments[executorID] = shardIDs
+	}
 	p.emitExecutorMetric(namespaceState, metricsLoopScope)
 
-	distributionChanged := len(deletedShards) > 0 || len(staleExecutors) > 0 || assignedToEmptyExecutors || updatedAssignments || isRebalancedByShardLoad
+	// If there are deleted shards or stale executors, the distribution has changed.
+	distributionChanged := len(deletedShards) > 0 || len(staleExecutors) > 0 || placementChanged
 	if !distributionChanged {
 		p.logger.Info("No changes to distribution detected. Skipping rebalance.")
 		return nil
@@ -534,46 +552,130 @@ func (p *namespaceProcessor) findDeletedShards(namespaceState *store.NamespaceSt
 	return deletedShards
 }
 
+// findShardsToReassign returns the shards that need a new owner and the assignments to keep.
+// Shards of draining executors are handed over incrementally: each draining executor has at most DrainBatchSize
+// shards in flight, that is handed over to a new owner that does not report them as ready yet.
+// Its other shards are kept in drainingAssignments until earlier handovers complete, so the handover rate
+// follows how fast the new owners pick the shards up.
 func (p *namespaceProcessor) findShardsToReassign(
 	activeExecutors []string,
 	namespaceState *store.NamespaceState,
 	deletedShards map[string]store.ShardState,
 	staleExecutors map[string]int64,
-) ([]string, map[string][]string) {
+) (shardsToReassign []string, currentAssignments map[string][]string, drainingAssignments map[string][]string) {
 	allShards := make(map[string]struct{})
 	for _, shardID := range getShards(p.namespaceCfg, namespaceState, deletedShards) {
 		allShards[shardID] = struct{}{}
 	}
 
-	shardsToReassign := make([]string, 0)
-	currentAssignments := make(map[string][]string)
+	shardsToReassign = make([]string, 0)
+	currentAssignments = make(map[string][]string)
+	drainingAssignments = make(map[string][]string)
+	inFlightHandovers := countInFlightHandovers(namespaceState)
 
 	for _, executorID := range activeExecutors {
 		currentAssignments[executorID] = []string{}
 	}
 
 	for executorID, state := range namespaceState.ShardAssignments {
-		isActive := namespaceState.Executors[executorID].Status == types.ExecutorStatusACTIVE
+		status := namespaceState.Executors[executorID].Status
 		_, isStale := staleExecutors[executorID]
+		isDraining := status == types.ExecutorStatusDRAINING && !isStale
 
+		var drainingShards []string
 		for shardID := range state.AssignedShards {
 			if _, ok := allShards[shardID]; ok {
 				delete(allShards, shardID)
-				// If executor is active AND not stale, keep the assignment
-				if isActive && !isStale {
+				switch {
+				case status == types.ExecutorStatusACTIVE && !isStale:
+					// If executor is active AND not stale, keep the assignment
 					currentAssignments[executorID] = append(currentAssignments[executorID], shardID)
-				} else {
+				case isDraining:
+					drainingShards = append(drainingShards, shardID)
+				default:
 					// Otherwise, reassign the shard (executor is either inactive or stale)
 					shardsToReassign = append(shardsToReassign, shardID)
 				}
 			}
 		}
+
+		if isDraining {
+			handedOver, kept := p.splitDrainingShards(drainingShards, namespaceState.Executors[executorID].ReportedShards, inFlightHandovers[executorID])
+			shardsToReassign = append(shardsToReassign, handedOver...)
+			drainingAssignments[executorID] = kept
+		}
 	}
 
 	for shardID := range allShards {
 		shardsToReassign = append(shardsToReassign, shardID)
 	}
-	return shardsToReassign, currentAssignments
+	return shardsToReassign, currentAssignments, drainingAssignments
+}
+
+// splitDrainingShards splits the shards of a draining executor into the ones handed over in this round and the ones it keeps.
+// Shards the executor does not report anymore are no longer processed by it, so they are handed over right away.
+// The others are handed over until the executor has DrainBatchSize handovers in flight.
+func (p *namespaceProcessor) splitDrainingShards(shardIDs []string, reportedShards map[string]*types.ShardStatusReport, inFlight int) (handedOver []string, kept []string) {
+	sort.Strings(shardIDs)
+
+	for _, shardID := range shardIDs {
+		_, isReported := reportedShards[shardID]
+		switch {
+		case !isReported:
+			handedOver = append(handedOver, shardID)
+		case inFlight < p.cfg.DrainBatchSize:
+			handedOver = append(handedOver, shardID)
+			inFlight++
+		default:
+			kept = append(kept, shardID)
+		}
+	}
+	return handedOver, kept
+}
+
+// countInFlightHandovers returns the number of graceful handovers that are not complete yet per previous owner.
+// A handover is complete when the new owner reports the shard as ready.
+func countInFlightHandovers(namespaceState *store.NamespaceState) map[string]int {
+	inFlight := make(map[string]int)
+	for executorID, assignedState := range namespaceState.ShardAssignments {
+		reportedShards := namespaceState.Executors[executorID].ReportedShards
+		for shardID, stats := range assignedState.ShardHandoverStats {
+			if stats.HandoverType != types.HandoverTypeGRACEFUL || stats.PreviousExecutorID == "" {
+				continue
+			}
+			if _, ok := assignedState.AssignedShards[shardID]; !ok {
+				continue
+			}
+			if report, ok := reportedShards[shardID]; ok && report.Status == types.ShardStatusREADY {
+				continue
+			}
+			inFlight[stats.PreviousExecutorID]++
+		}
+	}
+	return inFlight
+}
+
+// placeShards places the shards to reassign on the active executors and rebalances the current assignments
+// with the placement strategy of the namespace. It updates currentAssignments and returns true if it changed.
+func (p *namespaceProcessor) placeShards(
+	namespaceState *store.NamespaceState,
+	shardsToReassign []string,
+	activeExecutors []string,
+	currentAssignments map[string][]string,
+	metricsLoopScope metrics.Scope,
+) bool {
+	if p.placement != nil {
+		return p.placement.Place(&placement.State{
+			Executors:   placement.NewExecutors(activeExecutors, namespaceState.Executors),
+			Assignments: currentAssignments,
+			Unassigned:  shardsToReassign,
+		})
+	}
+
+	assignedToEmptyExecutors := assignShardsToEmptyExecutors(currentAssignments)
+	updatedAssignments := p.updateAssignments(shardsToReassign, activeExecutors, currentAssignments)
+	isRebalancedByShardLoad := p.rebalanceByShardLoad(calcShardLoad(namespaceState), currentAssignments, metricsLoopScope)
+	return assignedToEmptyExecutors || updatedAssignments || isRebalancedByShardLoad
 }
 
 func (*namespaceProcessor) updateAssignments(shardsToReassign []string, activeExecutors []string, currentAssignments map[string][]string) (distributionChanged bool) {
@@ -723,6 +825,13 @@ func (p *namespaceProcessor) addHandoverStatsToExecutorAssignedState(
 		// If there is no handover (first assignment), we skip adding handover stats
 		if handoverStats != nil {
 			newStats[shardID] = *handoverStats
+			continue
+		}
+
+		// Keep the stats of the last handover of a shard that stays on the executor,
+		// they tell whether the handover completed
+		if previousStats, ok := namespaceState.ShardAssignments[executorID].ShardHandoverStats[shardID]; ok {
+			newStats[shardID] = previousStats
 		}
 	}
 
@@ -781,6 +890,7 @@ func (p *namespaceProcessor) newHandoverStats(
 	return &store.ShardHandoverStats{
 		HandoverType:                      handoverType,
 		PreviousExecutorLastHeartbeatTime: prevExecutorHeartbeat.LastHeartbeat,
+		PreviousExecutorID:                prevExecutor.ExecutorID,
 	}
 }
 
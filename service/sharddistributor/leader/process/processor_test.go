@@ -137,6 +137,43 @@ func TestRebalanceShards_InitialDistribution(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestRebalanceShards_PlacementStrategy(t *testing.T) {
+	mocks := setupProcessorTest(t, config.NamespaceTypeFixed)
+	defer mocks.ctrl.Finish()
+	mocks.cfg.ShardNum = 4
+	mocks.cfg.Placement = config.Placement{Strategy: config.PlacementStrategyCapacity}
+	processor := mocks.factory.CreateProcessor(mocks.cfg, mocks.store, mocks.election).(*namespaceProcessor)
+	require.NotNil(t, processor.placement)
+
+	now := mocks.timeSource.Now()
+	state := map[string]store.HeartbeatState{
+		"exec-1": {Status: types.ExecutorStatusACTIVE, LastHeartbeat: now, Metadata: map[string]string{"capacity": "3"}},
+		"exec-2": {Status: types.ExecutorStatusACTIVE, LastHeartbeat: now, Metadata: map[string]string{"capacity": "1"}},
+	}
+	mocks.store.EXPECT().GetState(gomock.Any(), mocks.cfg.Name).Return(&store.NamespaceState{Executors: state}, nil)
+	mocks.store.EXPECT().GetShardOwner(gomock.Any(), mocks.cfg.Name, gomock.Any()).Return(nil, nil).AnyTimes()
+	mocks.election.EXPECT().Guard().Return(store.NopGuard())
+	mocks.store.EXPECT().AssignShards(gomock.Any(), mocks.cfg.Name, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, request store.AssignShardsRequest, _ store.GuardFunc) error {
+			assert.Len(t, request.NewState.ShardAssignments["exec-1"].AssignedShards, 3)
+			assert.Len(t, request.NewState.ShardAssignments["exec-2"].AssignedShards, 1)
+			return nil
+		},
+	)
+
+	err := processor.rebalanceShards(context.Background())
+	require.NoError(t, err)
+}
+
+func TestCreateProcessor_InvalidPlacementStrategy(t *testing.T) {
+	mocks := setupProcessorTest(t, config.NamespaceTypeFixed)
+	defer mocks.ctrl.Finish()
+	mocks.cfg.Placement = config.Placement{Strategy: "unknown"}
+
+	processor := mocks.factory.CreateProcessor(mocks.cfg, mocks.store, mocks.election).(*namespaceProcessor)
+	assert.Nil(t, processor.placement, "the default placement should be used")
+}
+
 func TestRebalanceShards_ExecutorRemoved(t *testing.T) {
 	mocks := setupProcessorTest(t, config.NamespaceTypeFixed)
 	defer mocks.ctrl.Finish()
@@ -176,6 +213,163 @@ func TestRebalanceShards_ExecutorRemoved(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestRebalanceShards_DrainingExecutorHandsOverIncrementally(t *testing.T) {
+	mocks := setupProcessorTest(t, config.NamespaceTypeFixed)
+	defer mocks.ctrl.Finish()
+	mocks.cfg.ShardNum = 3
+	processor := mocks.factory.CreateProcessor(mocks.cfg, mocks.store, mocks.election).(*namespaceProcessor)
+	processor.cfg.DrainBatchSize = 2
+
+	now := mocks.timeSource.Now()
+	heartbeats := map[string]store.HeartbeatState{
+		"exec-1": {Status: types.ExecutorStatusACTIVE, LastHeartbeat: now},
+		"exec-2": {
+			Status:        types.ExecutorStatusDRAINING,
+			LastHeartbeat: now,
+			ReportedShards: map[string]*types.ShardStatusReport{
+				"0": {Status: types.ShardStatusREADY},
+				"1": {Status: types.ShardStatusREADY},
+				"2": {Status: types.ShardStatusREADY},
+			},
+		},
+	}
+	assignments := map[string]store.AssignedState{
+		"exec-1": {AssignedShards: map[string]*types.ShardAssignment{}},
+		"exec-2": {
+			AssignedShards: map[string]*types.ShardAssignment{
+				"0": {Status: types.AssignmentStatusREADY},
+				"1": {Status: types.AssignmentStatusREADY},
+				"2": {Status: types.AssignmentStatusREADY},
+			},
+		},
+	}
+	mocks.store.EXPECT().GetState(gomock.Any(), mocks.cfg.Name).Return(&store.NamespaceState{
+		Executors:        heartbeats,
+		ShardAssignments: assignments,
+	}, nil)
+	mocks.store.EXPECT().GetShardOwner(gomock.Any(), mocks.cfg.Name, gomock.Any()).Return(&store.ShardOwner{ExecutorID: "exec-2"}, nil).AnyTimes()
+	mocks.election.EXPECT().Guard().Return(store.NopGuard())
+	mocks.store.EXPECT().AssignShards(gomock.Any(), mocks.cfg.Name, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, request store.AssignShardsRequest, _ store.GuardFunc) error {
+			handedOver := request.NewState.ShardAssignments["exec-1"]
+			assert.Equal(t, map[string]*types.ShardAssignment{
+				"0": {Status: types.AssignmentStatusREADY},
+				"1": {Status: types.AssignmentStatusREADY},
+			}, handedOver.AssignedShards)
+			for shardID, stats := range handedOver.ShardHandoverStats {
+				assert.Equal(t, types.HandoverTypeGRACEFUL, stats.HandoverType, "shard %s", shardID)
+			}
+			assert.Len(t, handedOver.ShardHandoverStats, 2)
+
+			// The draining executor keeps the rest of its shards until the next rebalance
+			assert.Equal(t, map[string]*types.ShardAssignment{
+				"2": {Status: types.AssignmentStatusREADY},
+			}, request.NewState.ShardAssignments["exec-2"].AssignedShards)
+			assert.Empty(t, request.ExecutorsToDelete)
+			return nil
+		},
+	)
+
+	err := processor.rebalanceShards(context.Background())
+	require.NoError(t, err)
+}
+
+func TestFindShardsToReassign_Draining(t *testing.T) {
+	processor := &namespaceProcessor{
+		namespaceCfg: config.Namespace{Name: "test-ns", ShardNum: 7, Type: config.NamespaceTypeFixed},
+		cfg:          config.LeaderProcess{DrainBatchSize: 2},
+	}
+	namespaceState := &store.NamespaceState{
+		Executors: map[string]store.HeartbeatState{
+			"exec-1": {Status: types.ExecutorStatusACTIVE},
+			"exec-2": {
+				Status: types.ExecutorStatusDRAINING,
+				ReportedShards: map[string]*types.ShardStatusReport{
+					"1": {Status: types.ShardStatusREADY},
+					"2": {Status: types.ShardStatusREADY},
+					"3": {Status: types.ShardStatusREADY},
+				},
+			},
+			// exec-3 stopped processing its shards
+			"exec-3": {Status: types.ExecutorStatusDRAINING},
+		},
+		ShardAssignments: map[string]store.AssignedState{
+			"exec-1": {AssignedShards: map[string]*types.ShardAssignment{"0": {}}},
+			"exec-2": {AssignedShards: map[string]*types.ShardAssignment{"3": {}, "1": {}, "2": {}}},
+			"exec-3": {AssignedShards: map[string]*types.ShardAssignment{"4": {}, "5": {}, "6": {}}},
+		},
+	}
+
+	t.Run("draining executors hand over a batch of shards", func(t *testing.T) {
+		shardsToReassign, currentAssignments, drainingAssignments := processor.findShardsToReassign([]string{"exec-1"}, namespaceState, nil, nil)
+		assert.ElementsMatch(t, []string{"1", "2", "4", "5", "6"}, shardsToReassign)
+		assert.Equal(t, map[string][]string{"exec-1": {"0"}}, currentAssignments)
+		assert.Equal(t, map[string][]string{"exec-2": {"3"}, "exec-3": nil}, drainingAssignments)
+	})
+
+	t.Run("stale draining executors hand over all shards", func(t *testing.T) {
+		shardsToReassign, _, drainingAssignments := processor.findShardsToReassign([]string{"exec-1"}, namespaceState, nil, map[string]int64{"exec-2": 0})
+		assert.ElementsMatch(t, []string{"1", "2", "3", "4", "5", "6"}, shardsToReassign)
+		assert.Equal(t, map[string][]string{"exec-3": nil}, drainingAssignments)
+	})
+
+	t.Run("draining executors wait for in-flight handovers", func(t *testing.T) {
+		inFlightState := &store.NamespaceState{
+			Executors: map[string]store.HeartbeatState{
+				"exec-1": {Status: types.ExecutorStatusACTIVE},
+				"exec-2": namespaceState.Executors["exec-2"],
+			},
+			ShardAssignments: map[string]store.AssignedState{
+				"exec-1": {
+					AssignedShards: map[string]*types.ShardAssignment{"0": {}},
+					ShardHandoverStats: map[string]store.ShardHandoverStats{
+						"0": {HandoverType: types.HandoverTypeGRACEFUL, PreviousExecutorID: "exec-2"},
+					},
+				},
+				"exec-2": namespaceState.ShardAssignments["exec-2"],
+			},
+		}
+		shardsToReassign, _, drainingAssignments := processor.findShardsToReassign([]string{"exec-1"}, inFlightState, nil, nil)
+		assert.ElementsMatch(t, []string{"1", "4", "5", "6"}, shardsToReassign)
+		assert.Equal(t, map[string][]string{"exec-2": {"2", "3"}}, drainingAssignments)
+	})
+}
+
+func TestCountInFlightHandovers(t *testing.T) {
+	graceful := func(previousExecutorID string) store.ShardHandoverStats {
+		return store.ShardHandoverStats{HandoverType: types.HandoverTypeGRACEFUL, PreviousExecutorID: previousExecutorID}
+	}
+	namespaceState := &store.NamespaceState{
+		Executors: map[string]store.HeartbeatState{
+			"exec-1": {
+				Status: types.ExecutorStatusACTIVE,
+				ReportedShards: map[string]*types.ShardStatusReport{
+					"0": {Status: types.ShardStatusREADY},
+					"1": {Status: types.ShardStatusINVALID},
+				},
+			},
+		},
+		ShardAssignments: map[string]store.AssignedState{
+			"exec-1": {
+				AssignedShards: map[string]*types.ShardAssignment{"0": {}, "1": {}, "2": {}, "3": {}, "4": {}},
+				ShardHandoverStats: map[string]store.ShardHandoverStats{
+					// ready on the new owner, the handover is complete
+					"0": graceful("exec-2"),
+					"1": graceful("exec-2"),
+					"2": graceful("exec-3"),
+					"3": {HandoverType: types.HandoverTypeEMERGENCY, PreviousExecutorID: "exec-2"},
+					// stats written before the previous owner was recorded
+					"4": {HandoverType: types.HandoverTypeGRACEFUL},
+					// no longer assigned to the executor
+					"5": graceful("exec-2"),
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, map[string]int{"exec-2": 1, "exec-3": 1}, countInFlightHandovers(namespaceState))
+}
+
 func TestRebalanceShards_ExecutorStale(t *testing.T) {
 	mocks := setupProcessorTest(t, config.NamespaceTypeFixed)
 	defer mocks.ctrl.Finish()
@@ -863,6 +1057,7 @@ func TestNewHandoverStats(t *testing.T) {
 			expectShardStats: &store.ShardHandoverStats{
 				HandoverType:                      types.HandoverTypeEMERGENCY,
 				PreviousExecutorLastHeartbeatTime: now.Add(-10 * time.Second),
+				PreviousExecutorID:                "old-active",
 			},
 		},
 		{
@@ -878,6 +1073,7 @@ func TestNewHandoverStats(t *testing.T) {
 			expectShardStats: &store.ShardHandoverStats{
 				HandoverType:                      types.HandoverTypeGRACEFUL,
 				PreviousExecutorLastHeartbeatTime: now.Add(-10 * time.Second),
+				PreviousExecutorID:                "old-draining",
 			},
 		},
 		{
@@ -893,6 +1089,7 @@ func TestNewHandoverStats(t *testing.T) {
 			expectShardStats: &store.ShardHandoverStats{
 				HandoverType:                      types.HandoverTypeGRACEFUL,
 				PreviousExecutorLastHeartbeatTime: now.Add(-10 * time.Second),
+				PreviousExecutorID:                "old-drained",
 			},
 		},
 	}
@@ -920,8 +1117,9 @@ func TestAddHandoverStatsToExecutorAssignedState(t *testing.T) {
 		name      string
 		executors map[string]store.HeartbeatState
 
-		getOwners    map[string]*store.ShardOwner
-		getOwnerErrs map[string]error
+		getOwners     map[string]*store.ShardOwner
+		getOwnerErrs  map[string]error
+		previousStats map[string]store.ShardHandoverStats
 
 		expected map[string]store.ShardHandoverStats
 	}{
@@ -950,6 +1148,7 @@ func TestAddHandoverStatsToExecutorAssignedState(t *testing.T) {
 				"shard-1": {
 					HandoverType:                      types.HandoverTypeEMERGENCY,
 					PreviousExecutorLastHeartbeatTime: now.Add(-10 * time.Second),
+					PreviousExecutorID:                "old-active",
 				},
 			},
 		},
@@ -971,6 +1170,7 @@ func TestAddHandoverStatsToExecutorAssignedState(t *testing.T) {
 				"shard-1": {
 					HandoverType:                      types.HandoverTypeGRACEFUL,
 					PreviousExecutorLastHeartbeatTime: now.Add(-20 * time.Second),
+					PreviousExecutorID:                "old-draining",
 				},
 			},
 		},
@@ -990,6 +1190,35 @@ func TestAddHandoverStatsToExecutorAssignedState(t *testing.T) {
 			},
 			expected: map[string]store.ShardHandoverStats{},
 		},
+		"same executor as previous, stats of the last handover are kept": {
+			getOwners: map[string]*store.ShardOwner{
+				"shard-1": {ExecutorID: executorID},
+				"shard-2": nil,
+			},
+			getOwnerErrs: map[string]error{
+				"shard-1": nil,
+			},
+			executors: map[string]store.HeartbeatState{
+				executorID: {
+					Status:        types.ExecutorStatusACTIVE,
+					LastHeartbeat: now,
+				},
+			},
+			previousStats: map[string]store.ShardHandoverStats{
+				"shard-1": {
+					HandoverType:                      types.HandoverTypeGRACEFUL,
+					PreviousExecutorLastHeartbeatTime: now.Add(-30 * time.Second),
+					PreviousExecutorID:                "old-draining",
+				},
+			},
+			expected: map[string]store.ShardHandoverStats{
+				"shard-1": {
+					HandoverType:                      types.HandoverTypeGRACEFUL,
+					PreviousExecutorLastHeartbeatTime: now.Add(-30 * time.Second),
+					PreviousExecutorID:                "old-draining",
+				},
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			mocks := setupProcessorTest(t, config.NamespaceTypeFixed)
@@ -1001,6 +1230,9 @@ func TestAddHandoverStatsToExecutorAssignedState(t *testing.T) {
 			}
 			namespaceState := &store.NamespaceState{
 				Executors: tc.executors,
+				ShardAssignments: map[string]store.AssignedState{
+					executorID: {ShardHandoverStats: tc.previousStats},
+				},
 			}
 			stats := processor.addHandoverStatsToExecutorAssignedState(namespaceState, executorID, shardIDs)
 			assert.Equal(t, tc.expected, stats)
@@ -59,3 +59,25 @@ func (c *meteredShardDistributorClient) WatchNamespaceState(ctx context.Context,
 	}
 	return stream, err
 }
+
+// The admin operations below are not used by spectators, so they are passed through without metrics.
+
+func (c *meteredShardDistributorClient) DescribeShard(ctx context.Context, request *types.DescribeShardRequest, opts ...yarpc.CallOption) (*types.DescribeShardResponse, error) {
+	return c.client.DescribeShard(ctx, request, opts...)
+}
+
+func (c *meteredShardDistributorClient) ListExecutors(ctx context.Context, request *types.ListExecutorsRequest, opts ...yarpc.CallOption) (*types.ListExecutorsResponse, error) {
+	return c.client.ListExecutors(ctx, request, opts...)
+}
+
+func (c *meteredShardDistributorClient) ListNamespaces(ctx context.Context, request *types.ListNamespacesRequest, opts ...yarpc.CallOption) (*types.ListNamespacesResponse, error) {
+	return c.client.ListNamespaces(ctx, request, opts...)
+}
+
+func (c *meteredShardDistributorClient) MoveShard(ctx context.Context, request *types.MoveShardRequest, opts ...yarpc.CallOption) (*types.MoveShardResponse, error) {
+	return c.client.MoveShard(ctx, request, opts...)
+}
+
+func (c *meteredShardDistributorClient) TriggerRebalance(ctx context.Context, request *types.TriggerRebalanceRequest, opts ...yarpc.CallOption) (*types.TriggerRebalanceResponse, error) {
+	return c.client.TriggerRebalance(ctx, request, opts...)
+}
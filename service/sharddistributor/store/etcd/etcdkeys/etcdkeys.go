@@ -13,6 +13,11 @@ func BuildNamespacePrefix(prefix, namespace string) string {
 
 // BuildExecutorsPrefix constructs the etcd key prefix for executors within a given namespace.
 // result: <prefix>/<namespace>/executors/
+// BuildRebalanceKey returns the key written to request a rebalance of the namespace.
+func BuildRebalanceKey(prefix, namespace string) string {
+	return fmt.Sprintf("%s/rebalance", BuildNamespacePrefix(prefix, namespace))
+}
+
 func BuildExecutorsPrefix(prefix, namespace string) string {
 	return fmt.Sprintf("%s/executors/", BuildNamespacePrefix(prefix, namespace))
 }
@@ -58,3 +58,8 @@ func TestParseExecutorKey_InvalidKeyType(t *testing.T) {
 	_, _, err := ParseExecutorKey("/cadence", "test-ns", key)
 	assert.ErrorContains(t, err, "invalid executor key type: invalid_type")
 }
+
+func TestBuildRebalanceKey(t *testing.T) {
+	got := BuildRebalanceKey("/cadence", "test-ns")
+	assert.Equal(t, "/cadence/test-ns/rebalance", got)
+}
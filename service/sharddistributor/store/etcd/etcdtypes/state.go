@@ -8,7 +8,7 @@ import (
 type AssignedState struct {
 	AssignedShards     map[string]*types.ShardAssignment `json:"assigned_shards"`
 	ShardHandoverStats map[string]ShardHandoverStats     `json:"shard_handover_stats,omitempty"`
-	LastUpdated        Time                              `json:"last_updated"`
+	LastUpdated        store.Time                        `json:"last_updated"`
 	// ModRevision is the etcd mod revision for this record. It is not serialized.
 	ModRevision int64 `json:"-"`
 }
@@ -35,15 +35,16 @@ func FromAssignedState(src *store.AssignedState) *AssignedState {
 
 	return &AssignedState{
 		AssignedShards:     src.AssignedShards,
-		LastUpdated:        Time(src.LastUpdated),
+		LastUpdated:        store.Time(src.LastUpdated),
 		ShardHandoverStats: convertMap(src.ShardHandoverStats, FromShardHandoverStats),
 		ModRevision:        src.ModRevision,
 	}
 }
 
 type ShardHandoverStats struct {
-	PreviousExecutorLastHeartbeatTime Time               `json:"previous_executor_last_heartbeat_time"`
+	PreviousExecutorLastHeartbeatTime store.Time         `json:"previous_executor_last_heartbeat_time"`
 	HandoverType                      types.HandoverType `json:"handover_type"`
+	PreviousExecutorID                string             `json:"previous_executor_id,omitempty"`
 }
 
 // FromShardHandoverStats creates an ShardHandoverStats from a store.ShardHandoverStats.
@@ -53,8 +54,9 @@ func FromShardHandoverStats(src *store.ShardHandoverStats) *ShardHandoverStats {
 	}
 
 	return &ShardHandoverStats{
-		PreviousExecutorLastHeartbeatTime: Time(src.PreviousExecutorLastHeartbeatTime),
+		PreviousExecutorLastHeartbeatTime: store.Time(src.PreviousExecutorLastHeartbeatTime),
 		HandoverType:                      src.HandoverType,
+		PreviousExecutorID:                src.PreviousExecutorID,
 	}
 }
 
@@ -67,13 +69,14 @@ func ToShardHandoverStats(src *ShardHandoverStats) *store.ShardHandoverStats {
 	return &store.ShardHandoverStats{
 		PreviousExecutorLastHeartbeatTime: src.PreviousExecutorLastHeartbeatTime.ToTime(),
 		HandoverType:                      src.HandoverType,
+		PreviousExecutorID:                src.PreviousExecutorID,
 	}
 }
 
 type ShardStatistics struct {
-	SmoothedLoad   float64 `json:"smoothed_load"`
-	LastUpdateTime Time    `json:"last_update_time"`
-	LastMoveTime   Time    `json:"last_move_time"`
+	SmoothedLoad   float64    `json:"smoothed_load"`
+	LastUpdateTime store.Time `json:"last_update_time"`
+	LastMoveTime   store.Time `json:"last_move_time"`
 }
 
 // ToShardStatistics converts the current ShardStatistics to store.ShardStatistics.
@@ -97,8 +100,8 @@ func FromShardStatistics(src *store.ShardStatistics) *ShardStatistics {
 
 	return &ShardStatistics{
 		SmoothedLoad:   src.SmoothedLoad,
-		LastUpdateTime: Time(src.LastUpdateTime),
-		LastMoveTime:   Time(src.LastMoveTime),
+		LastUpdateTime: store.Time(src.LastUpdateTime),
+		LastMoveTime:   store.Time(src.LastMoveTime),
 	}
 }
 
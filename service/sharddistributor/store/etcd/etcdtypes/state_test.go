@@ -34,7 +34,7 @@ func TestAssignedState_ToAssignedState(t *testing.T) {
 				AssignedShards: map[string]*types.ShardAssignment{
 					"1": {Status: types.AssignmentStatusREADY},
 				},
-				LastUpdated: Time(time.Date(2025, 11, 18, 12, 0, 0, 123456789, time.UTC)),
+				LastUpdated: store.Time(time.Date(2025, 11, 18, 12, 0, 0, 123456789, time.UTC)),
 				ModRevision: 42,
 			},
 			expect: &store.AssignedState{
@@ -52,11 +52,11 @@ func TestAssignedState_ToAssignedState(t *testing.T) {
 				},
 				ShardHandoverStats: map[string]ShardHandoverStats{
 					"1": {
-						PreviousExecutorLastHeartbeatTime: Time(time.Date(2025, 11, 18, 12, 0, 0, 123456789, time.UTC)),
+						PreviousExecutorLastHeartbeatTime: store.Time(time.Date(2025, 11, 18, 12, 0, 0, 123456789, time.UTC)),
 						HandoverType:                      types.HandoverTypeGRACEFUL,
 					},
 				},
-				LastUpdated: Time(time.Date(2025, 11, 18, 12, 0, 0, 123456789, time.UTC)),
+				LastUpdated: store.Time(time.Date(2025, 11, 18, 12, 0, 0, 123456789, time.UTC)),
 				ModRevision: 42,
 			},
 			expect: &store.AssignedState{
@@ -115,7 +115,7 @@ func TestAssignedState_FromAssignedState(t *testing.T) {
 				AssignedShards: map[string]*types.ShardAssignment{
 					"9": {Status: types.AssignmentStatusREADY},
 				},
-				LastUpdated: Time(time.Date(2025, 11, 18, 13, 0, 0, 987654321, time.UTC)),
+				LastUpdated: store.Time(time.Date(2025, 11, 18, 13, 0, 0, 987654321, time.UTC)),
 				ModRevision: 77,
 			},
 		},
@@ -139,11 +139,11 @@ func TestAssignedState_FromAssignedState(t *testing.T) {
 				},
 				ShardHandoverStats: map[string]ShardHandoverStats{
 					"9": {
-						PreviousExecutorLastHeartbeatTime: Time(time.Date(2025, 11, 18, 13, 0, 0, 987654321, time.UTC)),
+						PreviousExecutorLastHeartbeatTime: store.Time(time.Date(2025, 11, 18, 13, 0, 0, 987654321, time.UTC)),
 						HandoverType:                      types.HandoverTypeGRACEFUL,
 					},
 				},
-				LastUpdated: Time(time.Date(2025, 11, 18, 13, 0, 0, 987654321, time.UTC)),
+				LastUpdated: store.Time(time.Date(2025, 11, 18, 13, 0, 0, 987654321, time.UTC)),
 				ModRevision: 77,
 			},
 		},
@@ -177,7 +177,7 @@ func TestAssignedState_JSONMarshalling(t *testing.T) {
 				AssignedShards: map[string]*types.ShardAssignment{
 					"1": {Status: types.AssignmentStatusREADY},
 				},
-				LastUpdated: Time(time.Date(2025, 11, 18, 12, 0, 0, 123456789, time.UTC)),
+				LastUpdated: store.Time(time.Date(2025, 11, 18, 12, 0, 0, 123456789, time.UTC)),
 				ModRevision: 42,
 			},
 			jsonStr: `{"assigned_shards":{"1":{"status":"AssignmentStatusREADY"}},"last_updated":"2025-11-18T12:00:00.123456789Z"}`,
@@ -189,11 +189,11 @@ func TestAssignedState_JSONMarshalling(t *testing.T) {
 				},
 				ShardHandoverStats: map[string]ShardHandoverStats{
 					"1": {
-						PreviousExecutorLastHeartbeatTime: Time(time.Date(2025, 11, 18, 12, 0, 0, 123456789, time.UTC)),
+						PreviousExecutorLastHeartbeatTime: store.Time(time.Date(2025, 11, 18, 12, 0, 0, 123456789, time.UTC)),
 						HandoverType:                      types.HandoverTypeGRACEFUL,
 					},
 				},
-				LastUpdated: Time(time.Date(2025, 11, 18, 12, 0, 0, 123456789, time.UTC)),
+				LastUpdated: store.Time(time.Date(2025, 11, 18, 12, 0, 0, 123456789, time.UTC)),
 				ModRevision: 42,
 			},
 			jsonStr: `{"assigned_shards":{"1":{"status":"AssignmentStatusREADY"}},"shard_handover_stats":{"1":{"previous_executor_last_heartbeat_time":"2025-11-18T12:00:00.123456789Z","handover_type":"HandoverTypeGRACEFUL"}},"last_updated":"2025-11-18T12:00:00.123456789Z"}`,
@@ -243,8 +243,8 @@ func TestShardStatistics_ToShardStatistics(t *testing.T) {
 		"success": {
 			input: &ShardStatistics{
 				SmoothedLoad:   12.34,
-				LastUpdateTime: Time(time.Date(2025, 11, 18, 14, 0, 0, 111111111, time.UTC)),
-				LastMoveTime:   Time(time.Date(2025, 11, 18, 15, 0, 0, 222222222, time.UTC)),
+				LastUpdateTime: store.Time(time.Date(2025, 11, 18, 14, 0, 0, 111111111, time.UTC)),
+				LastMoveTime:   store.Time(time.Date(2025, 11, 18, 15, 0, 0, 222222222, time.UTC)),
 			},
 			expect: &store.ShardStatistics{
 				SmoothedLoad:   12.34,
@@ -286,8 +286,8 @@ func TestShardStatistics_FromShardStatistics(t *testing.T) {
 			},
 			expect: &ShardStatistics{
 				SmoothedLoad:   99.01,
-				LastUpdateTime: Time(time.Date(2025, 11, 18, 16, 0, 0, 333333333, time.UTC)),
-				LastMoveTime:   Time(time.Date(2025, 11, 18, 17, 0, 0, 444444444, time.UTC)),
+				LastUpdateTime: store.Time(time.Date(2025, 11, 18, 16, 0, 0, 333333333, time.UTC)),
+				LastMoveTime:   store.Time(time.Date(2025, 11, 18, 17, 0, 0, 444444444, time.UTC)),
 			},
 		},
 	}
@@ -312,8 +312,8 @@ func TestShardStatistics_JSONMarshalling(t *testing.T) {
 
 	state := &ShardStatistics{
 		SmoothedLoad:   12.34,
-		LastUpdateTime: Time(time.Date(2025, 11, 18, 14, 0, 0, 111111111, time.UTC)),
-		LastMoveTime:   Time(time.Date(2025, 11, 18, 15, 0, 0, 222222222, time.UTC)),
+		LastUpdateTime: store.Time(time.Date(2025, 11, 18, 14, 0, 0, 111111111, time.UTC)),
+		LastMoveTime:   store.Time(time.Date(2025, 11, 18, 15, 0, 0, 222222222, time.UTC)),
 	}
 
 	// Marshal to JSON
@@ -349,7 +349,7 @@ func TestShardHandoverStats_ToShardHandoverStats(t *testing.T) {
 		},
 		"success": {
 			input: &ShardHandoverStats{
-				PreviousExecutorLastHeartbeatTime: Time(time.Date(2025, 11, 18, 18, 0, 0, 555555555, time.UTC)),
+				PreviousExecutorLastHeartbeatTime: store.Time(time.Date(2025, 11, 18, 18, 0, 0, 555555555, time.UTC)),
 				HandoverType:                      types.HandoverTypeGRACEFUL,
 			},
 			expect: &store.ShardHandoverStats{
@@ -388,7 +388,7 @@ func TestShardHandoverStats_FromShardHandoverStats(t *testing.T) {
 				HandoverType:                      types.HandoverTypeGRACEFUL,
 			},
 			expect: &ShardHandoverStats{
-				PreviousExecutorLastHeartbeatTime: Time(time.Date(2025, 11, 18, 19, 0, 0, 666666666, time.UTC)),
+				PreviousExecutorLastHeartbeatTime: store.Time(time.Date(2025, 11, 18, 19, 0, 0, 666666666, time.UTC)),
 				HandoverType:                      types.HandoverTypeGRACEFUL,
 			},
 		},
@@ -412,7 +412,7 @@ func TestShardHandoverStats_JSONMarshalling(t *testing.T) {
 	const jsonStr = `{"previous_executor_last_heartbeat_time":"2025-11-18T20:00:00.777777777Z","handover_type":"HandoverTypeGRACEFUL"}`
 
 	stats := &ShardHandoverStats{
-		PreviousExecutorLastHeartbeatTime: Time(time.Date(2025, 11, 18, 20, 0, 0, 777777777, time.UTC)),
+		PreviousExecutorLastHeartbeatTime: store.Time(time.Date(2025, 11, 18, 20, 0, 0, 777777777, time.UTC)),
 		HandoverType:                      types.HandoverTypeGRACEFUL,
 	}
 
@@ -133,7 +133,7 @@ func (s *executorStoreImpl) RecordHeartbeat(ctx context.Context, namespace, exec
 
 	// Build all operations including metadata
 	ops := []clientv3.Op{
-		clientv3.OpPut(heartbeatKey, etcdtypes.FormatTime(request.LastHeartbeat)),
+		clientv3.OpPut(heartbeatKey, store.FormatTime(request.LastHeartbeat)),
 		clientv3.OpPut(stateKey, string(compressedState)),
 		clientv3.OpPut(reportedShardsKey, string(compressedReportedShards)),
 	}
@@ -179,7 +179,7 @@ func (s *executorStoreImpl) GetHeartbeat(ctx context.Context, namespace string,
 		found = true // We found at least one valid key part for the executor.
 		switch keyType {
 		case etcdkeys.ExecutorHeartbeatKey:
-			heartbeatState.LastHeartbeat, err = etcdtypes.ParseTime(value)
+			heartbeatState.LastHeartbeat, err = store.ParseTime(value)
 			if err != nil {
 				return nil, nil, fmt.Errorf("parse heartbeat timestamp: %w", err)
 			}
@@ -232,7 +232,7 @@ func (s *executorStoreImpl) GetState(ctx context.Context, namespace string) (*st
 
 		switch keyType {
 		case etcdkeys.ExecutorHeartbeatKey:
-			heartbeat.LastHeartbeat, err = etcdtypes.ParseTime(value)
+			heartbeat.LastHeartbeat, err = store.ParseTime(value)
 			if err != nil {
 				return nil, fmt.Errorf("parse heartbeat timestamp: %w", err)
 			}
@@ -294,8 +294,20 @@ func (s *executorStoreImpl) SubscribeToExecutorStatusChanges(ctx context.Context
 			clientv3.WithPrefix(),
 			clientv3.WithPrevKV(),
 		)
+		// Rebalances requested through TriggerRebalance are notified like status changes.
+		rebalanceChan := s.client.Watch(ctx, etcdkeys.BuildRebalanceKey(s.prefix, namespace))
 
-		for watchResp := range watchChan {
+		for {
+			var watchResp clientv3.WatchResponse
+			var ok, rebalanceRequested bool
+			select {
+			case watchResp, ok = <-watchChan:
+			case watchResp, ok = <-rebalanceChan:
+				rebalanceRequested = true
+			}
+			if !ok {
+				return
+			}
 			if err := watchResp.Err(); err != nil {
 				return
 			}
@@ -304,7 +316,7 @@ func (s *executorStoreImpl) SubscribeToExecutorStatusChanges(ctx context.Context
 			sw := scope.StartTimer(metrics.ShardDistributorWatchProcessingLatency)
 			scope.AddCounter(metrics.ShardDistributorWatchEventsReceived, int64(len(watchResp.Events)))
 
-			if !s.hasExecutorStatusChanged(watchResp, namespace) {
+			if !rebalanceRequested && !s.hasExecutorStatusChanged(watchResp, namespace) {
 				sw.Stop()
 				continue
 			}
@@ -324,6 +336,15 @@ func (s *executorStoreImpl) SubscribeToExecutorStatusChanges(ctx context.Context
 	return revisionChan, nil
 }
 
+// TriggerRebalance writes the rebalance key of the namespace, which is watched by SubscribeToExecutorStatusChanges.
+func (s *executorStoreImpl) TriggerRebalance(ctx context.Context, namespace string) error {
+	rebalanceKey := etcdkeys.BuildRebalanceKey(s.prefix, namespace)
+	if _, err := s.client.Put(ctx, rebalanceKey, store.FormatTime(s.timeSource.Now())); err != nil {
+		return fmt.Errorf("put rebalance key: %w", err)
+	}
+	return nil
+}
+
 // hasExecutorStatusChanged checks if any of the events in the watch response correspond to changes in executor status.
 func (s *executorStoreImpl) hasExecutorStatusChanged(watchResp clientv3.WatchResponse, namespace string) bool {
 	for _, event := range watchResp.Events {
@@ -524,7 +545,7 @@ func (s *executorStoreImpl) AssignShard(ctx context.Context, namespace, shardID,
 
 		// Update the last updated timestamp.
 		now := s.timeSource.Now().UTC()
-		state.LastUpdated = etcdtypes.Time(now)
+		state.LastUpdated = store.Time(now)
 
 		// Compress new state value
 		newStateValue, err := json.Marshal(state)
@@ -578,9 +599,9 @@ func (s *executorStoreImpl) AssignShard(ctx context.Context, namespace, shardID,
 			shardStats, ok := executorShardStats[shardID]
 			if !ok {
 				shardStats.SmoothedLoad = 0
-				shardStats.LastUpdateTime = etcdtypes.Time(now)
+				shardStats.LastUpdateTime = store.Time(now)
 			}
-			shardStats.LastMoveTime = etcdtypes.Time(now)
+			shardStats.LastMoveTime = store.Time(now)
 			executorShardStats[shardID] = shardStats
 
 			newStatsValue, err := json.Marshal(executorShardStats)
@@ -836,10 +857,10 @@ func (s *executorStoreImpl) prepareShardStatisticsUpdates(ctx context.Context, n
 				changedExecutors[oldOwner.ExecutorID] = struct{}{}
 			} else {
 				stats.SmoothedLoad = 0
-				stats.LastUpdateTime = etcdtypes.Time(now)
+				stats.LastUpdateTime = store.Time(now)
 			}
 
-			stats.LastMoveTime = etcdtypes.Time(now)
+			stats.LastMoveTime = store.Time(now)
 
 			newStats, err := s.getOrLoadExecutorShardStatistics(ctx, namespace, executorID, executorStatsCache)
 			if err != nil {
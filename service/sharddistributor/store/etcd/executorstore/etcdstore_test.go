@@ -4,12 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strconv"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/fx/fxtest"
 	"go.uber.org/mock/gomock"
@@ -28,9 +28,14 @@ import (
 	"github.com/uber/cadence/service/sharddistributor/store/etcd/executorstore/common"
 	"github.com/uber/cadence/service/sharddistributor/store/etcd/leaderstore"
 	"github.com/uber/cadence/service/sharddistributor/store/etcd/testhelper"
+	"github.com/uber/cadence/service/sharddistributor/store/storetest"
 )
 
-// TestRecordHeartbeat verifies that an executor's heartbeat is correctly stored.
+func TestExecutorStore(t *testing.T) {
+	suite.Run(t, &storetest.ExecutorStoreSuite{NewTestCluster: newTestCluster})
+}
+
+// TestRecordHeartbeat verifies the etcd keys an executor's heartbeat is stored under.
 func TestRecordHeartbeat(t *testing.T) {
 	tc := testhelper.SetupStoreTestCluster(t)
 	executorStore := createStore(t, tc)
@@ -66,7 +71,7 @@ func TestRecordHeartbeat(t *testing.T) {
 	resp, err := tc.Client.Get(ctx, heartbeatKey)
 	require.NoError(t, err)
 	assert.Equal(t, int64(1), resp.Count, "Heartbeat key should exist")
-	assert.Equal(t, etcdtypes.FormatTime(now), string(resp.Kvs[0].Value))
+	assert.Equal(t, store.FormatTime(now), string(resp.Kvs[0].Value))
 
 	resp, err = tc.Client.Get(ctx, stateKey)
 	require.NoError(t, err)
@@ -155,262 +160,7 @@ func TestRecordHeartbeat_NoCompression(t *testing.T) {
 	assert.Equal(t, string(reportedJSON), string(reportedResp.Kvs[0].Value))
 }
 
-func TestGetHeartbeat(t *testing.T) {
-	tc := testhelper.SetupStoreTestCluster(t)
-	executorStore := createStore(t, tc)
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	now := time.Now().UTC()
-
-	executorID := "executor-get"
-	req := store.HeartbeatState{
-		Status:        types.ExecutorStatusDRAINING,
-		LastHeartbeat: now,
-	}
-
-	// 1. Record a heartbeat
-	err := executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, req)
-	require.NoError(t, err)
-
-	// Assign shards to one executor
-	assignState := map[string]store.AssignedState{
-		executorID: {
-			AssignedShards: map[string]*types.ShardAssignment{
-				"shard-1": {Status: types.AssignmentStatusREADY},
-			},
-		},
-	}
-	require.NoError(t, executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{
-		NewState: &store.NamespaceState{
-			ShardAssignments: assignState,
-		},
-	}, store.NopGuard()))
-
-	// 2. Get the heartbeat back
-	hb, assignedFromDB, err := executorStore.GetHeartbeat(ctx, tc.Namespace, executorID)
-	require.NoError(t, err)
-	require.NotNil(t, hb)
-
-	// 3. Verify the state
-	assert.Equal(t, types.ExecutorStatusDRAINING, hb.Status)
-	assert.Equal(t, now, hb.LastHeartbeat)
-	require.NotNil(t, assignedFromDB.AssignedShards)
-	assert.Equal(t, assignState[executorID].AssignedShards, assignedFromDB.AssignedShards)
-
-	// 4. Test getting a non-existent executor
-	_, _, err = executorStore.GetHeartbeat(ctx, tc.Namespace, "executor-non-existent")
-	require.Error(t, err)
-	assert.ErrorIs(t, err, store.ErrExecutorNotFound)
-}
-
-// TestGetState verifies that the store can accurately retrieve the state of all executors.
-func TestGetState(t *testing.T) {
-	tc := testhelper.SetupStoreTestCluster(t)
-	executorStore := createStore(t, tc)
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	executorID1 := "exec-TestGetState-1"
-	executorID2 := "exec-TestGetState-2"
-	shardID1 := "shard-1"
-	shardID2 := "shard-2"
-
-	// Setup: Record heartbeats and assign shards.
-	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID1, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
-	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID2, store.HeartbeatState{Status: types.ExecutorStatusDRAINING}))
-	require.NoError(t, executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{
-		NewState: &store.NamespaceState{
-			ShardAssignments: map[string]store.AssignedState{
-				executorID1: {AssignedShards: map[string]*types.ShardAssignment{shardID1: {}}},
-				executorID2: {AssignedShards: map[string]*types.ShardAssignment{shardID2: {}}},
-			},
-		},
-	}, store.NopGuard()))
-
-	// Action: Get the state.
-	namespaceState, err := executorStore.GetState(ctx, tc.Namespace)
-	require.NoError(t, err)
-
-	// Verification:
-	// Check Executors
-	require.Len(t, namespaceState.Executors, 2, "Should retrieve two heartbeat states")
-	assert.Equal(t, types.ExecutorStatusACTIVE, namespaceState.Executors[executorID1].Status)
-	assert.Equal(t, types.ExecutorStatusDRAINING, namespaceState.Executors[executorID2].Status)
-
-	// Check ShardAssignments (from executor records)
-	require.Len(t, namespaceState.ShardAssignments, 2, "Should retrieve two assignment states")
-	assert.Contains(t, namespaceState.ShardAssignments[executorID1].AssignedShards, shardID1)
-	assert.Contains(t, namespaceState.ShardAssignments[executorID2].AssignedShards, shardID2)
-}
-
-// TestAssignShards_WithRevisions tests the optimistic locking logic of AssignShards.
-func TestAssignShards_WithRevisions(t *testing.T) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	executorID1 := "exec-rev-1"
-	executorID2 := "exec-rev-2"
-
-	t.Run("Success", func(t *testing.T) {
-		tc := testhelper.SetupStoreTestCluster(t)
-		executorStore := createStore(t, tc)
-		recordHeartbeats(ctx, t, executorStore, tc.Namespace, executorID1, executorID2)
-
-		// Define a new state: assign shard1 to exec1
-		newState := &store.NamespaceState{
-			ShardAssignments: map[string]store.AssignedState{
-				executorID1: {AssignedShards: map[string]*types.ShardAssignment{"shard-1": {}}},
-			},
-		}
-
-		// Assign - should succeed
-		err := executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: newState}, store.NopGuard())
-		require.NoError(t, err)
-
-		// Verify the assignment
-		state, err := executorStore.GetState(ctx, tc.Namespace)
-		require.NoError(t, err)
-		assert.Contains(t, state.ShardAssignments[executorID1].AssignedShards, "shard-1")
-	})
-
-	t.Run("ConflictOnNewShard", func(t *testing.T) {
-		tc := testhelper.SetupStoreTestCluster(t)
-		executorStore := createStore(t, tc)
-		recordHeartbeats(ctx, t, executorStore, tc.Namespace, executorID1, executorID2)
-
-		// Process A defines its desired state: assign shard-new to exec1
-		processAState := &store.NamespaceState{
-			ShardAssignments: map[string]store.AssignedState{
-				executorID1: {AssignedShards: map[string]*types.ShardAssignment{"shard-new": {}}},
-				executorID2: {},
-			},
-		}
-
-		// Process B defines its desired state: assign shard-new to exec2
-		processBState := &store.NamespaceState{
-			ShardAssignments: map[string]store.AssignedState{
-				executorID1: {},
-				executorID2: {AssignedShards: map[string]*types.ShardAssignment{"shard-new": {}}},
-			},
-		}
-
-		// Process A succeeds
-		err := executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: processAState}, store.NopGuard())
-		require.NoError(t, err)
-
-		// Process B tries to commit, but its revision check for shard-new (rev=0) will fail.
-		err = executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: processBState}, store.NopGuard())
-		require.Error(t, err)
-		assert.ErrorIs(t, err, store.ErrVersionConflict)
-	})
-
-	t.Run("ConflictOnExistingShard", func(t *testing.T) {
-		tc := testhelper.SetupStoreTestCluster(t)
-		executorStore := createStore(t, tc)
-		recordHeartbeats(ctx, t, executorStore, tc.Namespace, executorID1, executorID2)
-
-		shardID := "shard-to-move"
-		// 1. Setup: Assign the shard to executor1
-		setupState, err := executorStore.GetState(ctx, tc.Namespace)
-		require.NoError(t, err)
-		setupState.ShardAssignments = map[string]store.AssignedState{
-			executorID1: {AssignedShards: map[string]*types.ShardAssignment{shardID: {}}},
-		}
-		require.NoError(t, executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: setupState}, store.NopGuard()))
-
-		// 2. Process A reads the state, intending to move the shard to executor2
-		stateForProcA, err := executorStore.GetState(ctx, tc.Namespace)
-		require.NoError(t, err)
-		stateForProcA.ShardAssignments = map[string]store.AssignedState{
-			executorID1: {ModRevision: stateForProcA.ShardAssignments[executorID1].ModRevision},
-			executorID2: {AssignedShards: map[string]*types.ShardAssignment{shardID: {}}, ModRevision: 0},
-		}
-
-		// 3. In the meantime, another process makes a different change (e.g., re-assigns to same executor, which changes revision)
-		intermediateState, err := executorStore.GetState(ctx, tc.Namespace)
-		require.NoError(t, err)
-		intermediateState.ShardAssignments = map[string]store.AssignedState{
-			executorID1: {
-				AssignedShards: map[string]*types.ShardAssignment{shardID: {}},
-				ModRevision:    intermediateState.ShardAssignments[executorID1].ModRevision,
-			},
-		}
-		require.NoError(t, executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: intermediateState}, store.NopGuard()))
-
-		// 4. Process A tries to commit its change. It will fail because its stored revision for the shard is now stale.
-		err = executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: stateForProcA}, store.NopGuard())
-		require.Error(t, err)
-		assert.ErrorIs(t, err, store.ErrVersionConflict)
-	})
-
-	t.Run("NoChanges", func(t *testing.T) {
-		tc := testhelper.SetupStoreTestCluster(t)
-		executorStore := createStore(t, tc)
-		recordHeartbeats(ctx, t, executorStore, tc.Namespace, executorID1, executorID2)
-
-		// Get the current state
-		state, err := executorStore.GetState(ctx, tc.Namespace)
-		require.NoError(t, err)
-
-		// Call AssignShards with the same assignments
-		err = executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: state}, store.NopGuard())
-		require.NoError(t, err, "Assigning with no changes should succeed")
-	})
-}
-
-// TestGuardedOperations verifies that AssignShards and DeleteExecutors respect the leader guard.
-func TestGuardedOperations(t *testing.T) {
-	tc := testhelper.SetupStoreTestCluster(t)
-	executorStore := createStore(t, tc)
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
-	defer cancel()
-
-	namespace := "test-guarded-ns"
-	executorID := "exec-to-delete"
-
-	// 1. Create two potential leaders
-	leaderCfg, err := etcdclient.NewLeaderStoreConfig(tc.SDConfig)
-	require.NoError(t, err)
-	elector, err := leaderstore.NewLeaderStore(leaderstore.StoreParams{Client: tc.Client, Cfg: leaderCfg})
-	require.NoError(t, err)
-	election1, err := elector.CreateElection(ctx, namespace)
-	defer election1.Cleanup(ctx)
-	defer func() { _ = election1.Cleanup(ctx) }()
-	election2, err := elector.CreateElection(ctx, namespace)
-	defer election2.Cleanup(ctx)
-	defer func() { _ = election2.Cleanup(ctx) }()
-
-	// 2. First node becomes leader
-	require.NoError(t, election1.Campaign(ctx, "host-1"))
-	validGuard := election1.Guard()
-
-	// 3. Use the valid guard to assign shards - should succeed
-	assignState := map[string]store.AssignedState{"exec-1": {}}
-	err = executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: &store.NamespaceState{ShardAssignments: assignState}}, validGuard)
-	require.NoError(t, err, "Assigning shards with a valid leader guard should succeed")
-
-	// 4. First node resigns, second node becomes leader
-	require.NoError(t, election1.Resign(ctx))
-	require.NoError(t, election2.Campaign(ctx, "host-2"))
-
-	// 5. Use the now-invalid guard from the first leader - should fail
-	err = executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: &store.NamespaceState{ShardAssignments: assignState}}, validGuard)
-	require.Error(t, err, "Assigning shards with a stale leader guard should fail")
-
-	// 6. Use the NopGuard to delete an executor - should succeed
-	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
-	err = executorStore.DeleteExecutors(ctx, tc.Namespace, []string{executorID}, store.NopGuard())
-	require.NoError(t, err, "Deleting an executor without a guard should succeed")
-
-	// Verify deletion
-	newState, err := executorStore.GetState(ctx, namespace)
-	require.NoError(t, err)
-	_, ok := newState.ShardAssignments[executorID]
-	require.False(t, ok, "Executor should have been deleted")
-}
-
-// TestSubscribe verifies that the subscription channel receives notifications for significant changes.
+// TestSubscribeToExecutorStatusChanges verifies which writes to the executor keys are notified to the subscription channel.
 func TestSubscribeToExecutorStatusChanges(t *testing.T) {
 	tc := testhelper.SetupStoreTestCluster(t)
 	executorStore := createStore(t, tc)
@@ -502,73 +252,6 @@ func TestSubscribeToExecutorStatusChanges(t *testing.T) {
 	}
 }
 
-func TestDeleteExecutors_Empty(t *testing.T) {
-	tc := testhelper.SetupStoreTestCluster(t)
-	executorStore := createStore(t, tc)
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	err := executorStore.DeleteExecutors(ctx, tc.Namespace, []string{}, store.NopGuard())
-	require.NoError(t, err)
-}
-
-// TestDeleteExecutors covers various scenarios for the DeleteExecutors method.
-func TestDeleteExecutors(t *testing.T) {
-	tc := testhelper.SetupStoreTestCluster(t)
-	executorStore := createStore(t, tc)
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	// Setup: Create two active executors for the tests.
-	executorID1 := "executor-to-delete-1"
-	executorID2 := "executor-to-delete-2"
-	survivingExecutorID := "executor-survivor"
-	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID1, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
-	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID2, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
-	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, survivingExecutorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
-
-	t.Run("SucceedsForNonExistentExecutor", func(t *testing.T) {
-		// Action: Delete a non-existent executor.
-		err := executorStore.DeleteExecutors(ctx, tc.Namespace, []string{"non-existent-executor"}, store.NopGuard())
-		// Verification: Should not return an error.
-		require.NoError(t, err)
-	})
-
-	t.Run("DeletesMultipleExecutors", func(t *testing.T) {
-		// Setup: Create and assign shards to multiple executors.
-		execToDelete1 := "multi-delete-1"
-		execToDelete2 := "multi-delete-2"
-		execToKeep := "multi-keep-1"
-		shardOfDeletedExecutor1 := "multi-shard-1"
-		shardOfDeletedExecutor2 := "multi-shard-2"
-		shardOfSurvivingExecutor := "multi-shard-keep"
-
-		require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, execToDelete1, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
-		require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, execToDelete2, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
-		require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, execToKeep, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
-
-		require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, shardOfDeletedExecutor1, execToDelete1))
-		require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, shardOfDeletedExecutor2, execToDelete2))
-		require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, shardOfSurvivingExecutor, execToKeep))
-
-		// Action: Delete two of the three executors in one call.
-		err := executorStore.DeleteExecutors(ctx, tc.Namespace, []string{execToDelete1, execToDelete2}, store.NopGuard())
-		require.NoError(t, err)
-
-		// Verification:
-		// 1. Check deleted executors are gone.
-		_, _, err = executorStore.GetHeartbeat(ctx, tc.Namespace, execToDelete1)
-		assert.ErrorIs(t, err, store.ErrExecutorNotFound, "Executor 1 should be gone")
-
-		_, _, err = executorStore.GetHeartbeat(ctx, tc.Namespace, execToDelete2)
-		assert.ErrorIs(t, err, store.ErrExecutorNotFound, "Executor 2 should be gone")
-
-		// 2. Check that the surviving executor remain.
-		_, _, err = executorStore.GetHeartbeat(ctx, tc.Namespace, execToKeep)
-		assert.NoError(t, err, "Surviving executor should still exist")
-	})
-}
-
 func TestParseExecutorKey_Errors(t *testing.T) {
 	tc := testhelper.SetupStoreTestCluster(t)
 
@@ -582,181 +265,6 @@ func TestParseExecutorKey_Errors(t *testing.T) {
 	assert.Contains(t, err.Error(), "unexpected key format")
 }
 
-// TestAssignAndGetShardOwnerRoundtrip verifies the successful assignment and retrieval of a shard owner.
-func TestAssignAndGetShardOwnerRoundtrip(t *testing.T) {
-	tc := testhelper.SetupStoreTestCluster(t)
-	executorStore := createStore(t, tc).(*executorStoreImpl)
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	now := executorStore.timeSource.Now().UTC()
-	executorID := "executor-roundtrip"
-	shardID := "shard-roundtrip"
-
-	// Setup: Create an active executor.
-	err := executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE})
-	require.NoError(t, err)
-
-	// 1. Assign a shard to the active executor.
-	err = executorStore.AssignShard(ctx, tc.Namespace, shardID, executorID)
-	require.NoError(t, err, "Should successfully assign shard to an active executor")
-
-	// 2. Get the owner and verify it's the correct executor.
-	state, err := executorStore.GetState(ctx, tc.Namespace)
-	require.NoError(t, err)
-	assert.Contains(t, state.ShardAssignments[executorID].AssignedShards, shardID)
-	assert.Equal(t, now, state.ShardAssignments[executorID].LastUpdated)
-}
-
-// TestAssignShardErrors tests the various error conditions when assigning a shard.
-func TestAssignShardErrors(t *testing.T) {
-	tc := testhelper.SetupStoreTestCluster(t)
-	executorStore := createStore(t, tc)
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	activeExecutorID := "executor-active-errors"
-	drainingExecutorID := "executor-draining-errors"
-	shardID1 := "shard-err-1"
-	shardID2 := "shard-err-2"
-
-	// Setup: Create an active and a draining executor, and assign one shard.
-	err := executorStore.RecordHeartbeat(ctx, tc.Namespace, activeExecutorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE})
-	require.NoError(t, err)
-	err = executorStore.RecordHeartbeat(ctx, tc.Namespace, drainingExecutorID, store.HeartbeatState{Status: types.ExecutorStatusDRAINING})
-	require.NoError(t, err)
-	err = executorStore.AssignShard(ctx, tc.Namespace, shardID1, activeExecutorID)
-	require.NoError(t, err)
-
-	// Case 1: Assigning an already-assigned shard.
-	err = executorStore.AssignShard(ctx, tc.Namespace, shardID1, activeExecutorID)
-	require.Error(t, err, "Should fail to assign an already-assigned shard")
-	var alreadyAssigned *store.ErrShardAlreadyAssigned
-	require.ErrorAs(t, err, &alreadyAssigned)
-	assert.Equal(t, shardID1, alreadyAssigned.ShardID)
-	assert.Equal(t, activeExecutorID, alreadyAssigned.AssignedTo)
-	assert.NotNil(t, alreadyAssigned.Metadata)
-
-	// Case 2: Assigning to a non-existent executor.
-	err = executorStore.AssignShard(ctx, tc.Namespace, shardID2, "non-existent-executor")
-	require.Error(t, err, "Should fail to assign to a non-existent executor")
-	assert.ErrorIs(t, err, store.ErrExecutorNotFound, "Error should be ErrExecutorNotFound")
-
-	// Case 3: Assigning to a non-active (draining) executor.
-	err = executorStore.AssignShard(ctx, tc.Namespace, shardID2, drainingExecutorID)
-	require.Error(t, err, "Should fail to assign to a draining executor")
-	assert.ErrorIs(t, err, store.ErrVersionConflict, "Error should be ErrVersionConflict for non-active executor")
-}
-
-// TestShardStatisticsPersistence verifies that shard statistics are preserved on assignment
-// when they already exist, and that GetState exposes them.
-func TestShardStatisticsPersistence(t *testing.T) {
-	tc := testhelper.SetupStoreTestCluster(t)
-	executorStore := createStore(t, tc)
-	executorStore.(*executorStoreImpl).cfg = &config.Config{
-		LoadBalancingMode: func(namespace string) string {
-			return config.LoadBalancingModeGREEDY
-		},
-		MaxEtcdTxnOps: dynamicproperties.GetIntPropertyFn(128),
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	executorID := "exec-stats"
-	shardID := "shard-stats"
-
-	// 1. Setup: ensure executor is ACTIVE
-	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
-
-	// 2. Pre-create shard statistics as if coming from prior history
-	stats := store.ShardStatistics{SmoothedLoad: 12.5, LastUpdateTime: time.Unix(1234, 0).UTC(), LastMoveTime: time.Unix(5678, 0).UTC()}
-	// The stats the executor should have after assignment
-	executorStats := map[string]etcdtypes.ShardStatistics{
-		shardID: *etcdtypes.FromShardStatistics(&stats),
-	}
-	payload, err := json.Marshal(executorStats)
-	require.NoError(t, err)
-	statsKey := etcdkeys.BuildExecutorKey(tc.EtcdPrefix, tc.Namespace, executorID, etcdkeys.ExecutorShardStatisticsKey)
-	// Write those stats to etcd under the executor (exec-stats) stats key
-	_, err = tc.Client.Put(ctx, statsKey, string(payload))
-	require.NoError(t, err)
-
-	// 3. Assign the shard via AssignShard (should not clobber existing metrics)
-	require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, shardID, executorID))
-
-	// 4. Verify via GetState that metrics are preserved and exposed
-	nsState, err := executorStore.GetState(ctx, tc.Namespace)
-	require.NoError(t, err)
-	require.Contains(t, nsState.ShardStats, shardID)
-	updatedStats := nsState.ShardStats[shardID]
-	assert.Equal(t, stats.SmoothedLoad, updatedStats.SmoothedLoad)
-	assert.Equal(t, stats.LastUpdateTime, updatedStats.LastUpdateTime)
-	// This should be greater than the last move time
-	assert.Greater(t, updatedStats.LastMoveTime, stats.LastMoveTime)
-
-	// 5. Also ensure assignment recorded correctly
-	require.Contains(t, nsState.ShardAssignments[executorID].AssignedShards, shardID)
-}
-
-// TestGetShardStatisticsForMissingShard verifies GetState does not report statistics for unknown shards.
-func TestGetShardStatisticsForMissingShard(t *testing.T) {
-	tc := testhelper.SetupStoreTestCluster(t)
-	executorStore := createStore(t, tc)
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	// No metrics are written; GetState should not contain unknown shard
-	st, err := executorStore.GetState(ctx, tc.Namespace)
-	require.NoError(t, err)
-	assert.NotContains(t, st.ShardStats, "unknown")
-}
-
-// TestDeleteShardStatsDeletesAllStats verifies that shard statistics are correctly deleted.
-func TestDeleteShardStatsDeletesAllStats(t *testing.T) {
-	tc := testhelper.SetupStoreTestCluster(t)
-	executorStore := createStore(t, tc)
-
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
-	totalShardStats := 135 // number of stats to add and make stale
-	shardIDs := make([]string, 0, totalShardStats)
-	executorID := "exec-delete-stats"
-
-	// ensure executor exists
-	ctxHeartbeat, cancelHb := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancelHb()
-	require.NoError(t, executorStore.RecordHeartbeat(ctxHeartbeat, tc.Namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
-
-	// Create stale stats
-	executorStats := make(map[string]etcdtypes.ShardStatistics)
-	for i := 0; i < totalShardStats; i++ {
-		shardID := "stale-stats-" + strconv.Itoa(i)
-		shardIDs = append(shardIDs, shardID)
-
-		stats := store.ShardStatistics{
-			SmoothedLoad:   float64(i),
-			LastUpdateTime: time.Unix(int64(i), 0).UTC(),
-			LastMoveTime:   time.Unix(int64(i), 0).UTC(),
-		}
-		executorStats[shardID] = *etcdtypes.FromShardStatistics(&stats)
-	}
-
-	statsKey := etcdkeys.BuildExecutorKey(tc.EtcdPrefix, tc.Namespace, executorID, etcdkeys.ExecutorShardStatisticsKey)
-	payload, err := json.Marshal(executorStats)
-	require.NoError(t, err)
-	_, err = tc.Client.Put(ctx, statsKey, string(payload))
-	require.NoError(t, err)
-
-	require.NoError(t, executorStore.DeleteShardStats(ctx, tc.Namespace, shardIDs, store.NopGuard()))
-
-	nsState, err := executorStore.GetState(ctx, tc.Namespace)
-	require.NoError(t, err)
-	// All stats should be deleted
-	assert.Empty(t, nsState.ShardStats)
-}
-
 // --- Test Setup ---
 
 func stringStatus(s types.ExecutorStatus) string {
@@ -767,14 +275,6 @@ func stringStatus(s types.ExecutorStatus) string {
 	return string(res)
 }
 
-func recordHeartbeats(ctx context.Context, t *testing.T, executorStore store.Store, namespace string, executorIDs ...string) {
-	t.Helper()
-
-	for _, executorID := range executorIDs {
-		require.NoError(t, executorStore.RecordHeartbeat(ctx, namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
-	}
-}
-
 // trackingTxn implements clientv3.Txn to record operations per batch for testing.
 type trackingTxn struct {
 	opsCount int
@@ -975,6 +475,18 @@ func TestCommitGuardedOps_GuardError(t *testing.T) {
 func createStore(t *testing.T, tc *testhelper.StoreTestCluster) store.Store {
 	t.Helper()
 
+	return createStoreWithParams(t, tc, storetest.ExecutorStoreParams{
+		TimeSource: clock.NewMockedTimeSourceAt(time.Now()),
+		Config: &config.Config{
+			LoadBalancingMode: func(namespace string) string { return config.LoadBalancingModeNAIVE },
+			MaxEtcdTxnOps:     dynamicproperties.GetIntPropertyFn(128),
+		},
+	})
+}
+
+func createStoreWithParams(t *testing.T, tc *testhelper.StoreTestCluster, params storetest.ExecutorStoreParams) store.Store {
+	t.Helper()
+
 	etcdConfig, err := etcdclient.NewExecutorStoreConfig(tc.SDConfig)
 	require.NoError(t, err)
 
@@ -983,13 +495,41 @@ func createStore(t *testing.T, tc *testhelper.StoreTestCluster) store.Store {
 		ETCDConfig:    etcdConfig,
 		Lifecycle:     fxtest.NewLifecycle(t),
 		Logger:        testlogger.New(t),
-		TimeSource:    clock.NewMockedTimeSourceAt(time.Now()),
+		TimeSource:    params.TimeSource,
 		MetricsClient: metrics.NewNoopMetricsClient(),
-		Config: &config.Config{
-			LoadBalancingMode: func(namespace string) string { return config.LoadBalancingModeNAIVE },
-			MaxEtcdTxnOps:     dynamicproperties.GetIntPropertyFn(128),
-		},
+		Config:        params.Config,
 	})
 	require.NoError(t, err)
 	return store
 }
+
+// newTestCluster creates an etcd-backed store for the executor store suite.
+func newTestCluster(t *testing.T, params storetest.ExecutorStoreParams) *storetest.ExecutorStoreTestCluster {
+	t.Helper()
+
+	tc := testhelper.SetupStoreTestCluster(t)
+
+	leaderCfg, err := etcdclient.NewLeaderStoreConfig(tc.SDConfig)
+	require.NoError(t, err)
+	elector, err := leaderstore.NewLeaderStore(leaderstore.StoreParams{Client: tc.Client, Cfg: leaderCfg})
+	require.NoError(t, err)
+
+	return &storetest.ExecutorStoreTestCluster{
+		Namespace: tc.Namespace,
+		Store:     createStoreWithParams(t, tc, params),
+		Elector:   elector,
+		WriteShardStatistics: func(t *testing.T, executorID string, stats map[string]store.ShardStatistics) {
+			t.Helper()
+
+			executorStats := make(map[string]etcdtypes.ShardStatistics, len(stats))
+			for shardID, shardStats := range stats {
+				executorStats[shardID] = *etcdtypes.FromShardStatistics(&shardStats)
+			}
+			payload, err := json.Marshal(executorStats)
+			require.NoError(t, err)
+			statsKey := etcdkeys.BuildExecutorKey(tc.EtcdPrefix, tc.Namespace, executorID, etcdkeys.ExecutorShardStatisticsKey)
+			_, err = tc.Client.Put(context.Background(), statsKey, string(payload))
+			require.NoError(t, err)
+		},
+	}
+}
@@ -0,0 +1,1065 @@
+package executorstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/fx"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/store"
+	"github.com/uber/cadence/service/sharddistributor/store/etcd/etcdtypes"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/sqlclient"
+)
+
+const (
+	// defaultPollInterval is how often subscriptions poll the database when not configured.
+	defaultPollInterval = time.Second
+
+	// maxRowsPerStatement bounds the number of rows a single IN list or multi-row insert touches,
+	// to stay below the bind parameter limits of all supported databases.
+	maxRowsPerStatement = 200
+)
+
+const (
+	_nextRevisionQuery = `UPDATE shard_distributor_namespaces SET revision = revision + 1 WHERE namespace = ?`
+	_getRevisionQuery  = `SELECT revision FROM shard_distributor_namespaces WHERE namespace = ?`
+	_initRevisionQuery = `INSERT INTO shard_distributor_namespaces (namespace, revision) VALUES (?, 1)`
+
+	_requestRebalanceQuery     = `UPDATE shard_distributor_namespaces SET rebalance_requests = rebalance_requests + 1 WHERE namespace = ?`
+	_initRebalanceQuery        = `INSERT INTO shard_distributor_namespaces (namespace, revision, rebalance_requests) VALUES (?, 0, 1)`
+	_getRebalanceRequestsQuery = `SELECT rebalance_requests FROM shard_distributor_namespaces WHERE namespace = ?`
+
+	_getExecutorQuery     = `SELECT executor_id, last_heartbeat, status, reported_shards, metadata FROM shard_distributor_executors WHERE namespace = ? AND executor_id = ?`
+	_getExecutorsQuery    = `SELECT executor_id, last_heartbeat, status, reported_shards, metadata FROM shard_distributor_executors WHERE namespace = ?`
+	_insertExecutorQuery  = `INSERT INTO shard_distributor_executors (namespace, executor_id, last_heartbeat, status, reported_shards, metadata) VALUES (?, ?, ?, ?, ?, ?)`
+	_updateExecutorQuery  = `UPDATE shard_distributor_executors SET last_heartbeat = ?, status = ?, reported_shards = ?, metadata = ? WHERE namespace = ? AND executor_id = ?`
+	_deleteExecutorsQuery = `DELETE FROM shard_distributor_executors WHERE namespace = ? AND executor_id IN (?)`
+	_getStatusQuery       = `SELECT status FROM shard_distributor_executors WHERE namespace = ? AND executor_id = ?`
+	_getStatusesQuery     = `SELECT executor_id, status FROM shard_distributor_executors WHERE namespace = ?`
+
+	_getAssignedStateQuery       = `SELECT executor_id, data, revision FROM shard_distributor_assigned_states WHERE namespace = ? AND executor_id = ?`
+	_getAssignedStatesQuery      = `SELECT executor_id, data, revision FROM shard_distributor_assigned_states WHERE namespace = ?`
+	_getAssignedRevisionsQuery   = `SELECT executor_id, revision FROM shard_distributor_assigned_states WHERE namespace = ?`
+	_insertAssignedStateQuery    = `INSERT INTO shard_distributor_assigned_states (namespace, executor_id, data, revision) VALUES (?, ?, ?, ?)`
+	_deleteAssignedStatesQuery   = `DELETE FROM shard_distributor_assigned_states WHERE namespace = ? AND executor_id IN (?)`
+	_getAssignmentsAndOwnerQuery = `SELECT a.executor_id, a.data, a.revision, e.metadata FROM shard_distributor_assigned_states a ` +
+		`LEFT JOIN shard_distributor_executors e ON e.namespace = a.namespace AND e.executor_id = a.executor_id WHERE a.namespace = ?`
+
+	_getShardStatsQuery    = `SELECT executor_id, data FROM shard_distributor_shard_stats WHERE namespace = ?`
+	_insertShardStatsQuery = `INSERT INTO shard_distributor_shard_stats (namespace, executor_id, data) VALUES (?, ?, ?)`
+	_deleteShardStatsQuery = `DELETE FROM shard_distributor_shard_stats WHERE namespace = ? AND executor_id IN (?)`
+	_getShardOwnerQuery    = `SELECT s.executor_id, e.metadata FROM shard_distributor_shards s ` +
+		`LEFT JOIN shard_distributor_executors e ON e.namespace = s.namespace AND e.executor_id = s.executor_id WHERE s.namespace = ? AND s.shard_id = ?`
+	_getShardOwnersQuery          = `SELECT shard_id, executor_id FROM shard_distributor_shards WHERE namespace = ? AND shard_id IN (?)`
+	_insertShardsQueryPrefix      = `INSERT INTO shard_distributor_shards (namespace, shard_id, executor_id) VALUES `
+	_deleteShardsQuery            = `DELETE FROM shard_distributor_shards WHERE namespace = ? AND shard_id IN (?)`
+	_deleteShardsByExecutorsQuery = `DELETE FROM shard_distributor_shards WHERE namespace = ? AND executor_id IN (?)`
+	_assignedStateExistsQuery     = `SELECT COUNT(*) FROM shard_distributor_assigned_states WHERE namespace = ? AND executor_id = ?`
+)
+
+var (
+	_executorStatusRunningJSON = fmt.Sprintf(`"%s"`, types.ExecutorStatusACTIVE)
+)
+
+type executorStoreImpl struct {
+	db            *sqlx.DB
+	logger        log.Logger
+	timeSource    clock.TimeSource
+	cfg           *config.Config
+	metricsClient metrics.Client
+	pollInterval  time.Duration
+
+	stopC chan struct{}
+	wg    sync.WaitGroup
+}
+
+// ExecutorStoreParams defines the dependencies for the SQL store, for use with fx.
+type ExecutorStoreParams struct {
+	fx.In
+
+	DB            *sqlx.DB `name:"executorstore"`
+	SQLConfig     sqlclient.ExecutorStoreConfig
+	Lifecycle     fx.Lifecycle
+	Logger        log.Logger
+	TimeSource    clock.TimeSource
+	Config        *config.Config
+	MetricsClient metrics.Client
+}
+
+// executorRow is a row of shard_distributor_executors.
+type executorRow struct {
+	ExecutorID     string `db:"executor_id"`
+	LastHeartbeat  string `db:"last_heartbeat"`
+	Status         string `db:"status"`
+	ReportedShards []byte `db:"reported_shards"`
+	Metadata       []byte `db:"metadata"`
+}
+
+// assignedStateRow is a row of shard_distributor_assigned_states.
+type assignedStateRow struct {
+	ExecutorID string `db:"executor_id"`
+	Data       []byte `db:"data"`
+	Revision   int64  `db:"revision"`
+}
+
+// shardStatsRow is a row of shard_distributor_shard_stats.
+type shardStatsRow struct {
+	ExecutorID string `db:"executor_id"`
+	Data       []byte `db:"data"`
+}
+
+// ownerRow is a shard or assignment joined with the metadata of its executor.
+type ownerRow struct {
+	ExecutorID string `db:"executor_id"`
+	Data       []byte `db:"data"`
+	Revision   int64  `db:"revision"`
+	Metadata   []byte `db:"metadata"`
+}
+
+// NewStore creates a new SQL-backed store and provides it to the fx application.
+func NewStore(p ExecutorStoreParams) (store.Store, error) {
+	timeSource := p.TimeSource
+	if timeSource == nil {
+		timeSource = clock.NewRealTimeSource()
+	}
+
+	pollInterval := p.SQLConfig.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+
+	store := &executorStoreImpl{
+		db:            p.DB,
+		logger:        p.Logger,
+		timeSource:    timeSource,
+		cfg:           p.Config,
+		metricsClient: p.MetricsClient,
+		pollInterval:  pollInterval,
+		stopC:         make(chan struct{}),
+	}
+
+	p.Lifecycle.Append(fx.StartStopHook(store.Start, store.Stop))
+
+	return store, nil
+}
+
+func (s *executorStoreImpl) Start() {}
+
+func (s *executorStoreImpl) Stop() {
+	close(s.stopC)
+	s.wg.Wait()
+}
+
+// --- HeartbeatStore Implementation ---
+
+func (s *executorStoreImpl) RecordHeartbeat(ctx context.Context, namespace, executorID string, request store.HeartbeatState) error {
+	reportedShardsData, err := json.Marshal(request.ReportedShards)
+	if err != nil {
+		return fmt.Errorf("marshal reported shards: %w", err)
+	}
+
+	jsonState, err := json.Marshal(request.Status)
+	if err != nil {
+		return fmt.Errorf("marshal executor status: %w", err)
+	}
+
+	err = sqlclient.RunInTx(ctx, s.db, func(tx *sqlx.Tx) error {
+		var row executorRow
+		err := tx.GetContext(ctx, &row, tx.Rebind(_getExecutorQuery+sqlclient.ForUpdate(s.db)), namespace, executorID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("get executor: %w", err)
+		}
+		found := err == nil
+
+		// Metadata keys are only ever added or overwritten by heartbeats, never removed.
+		metadata := make(map[string]string)
+		if found && len(row.Metadata) > 0 {
+			if err := json.Unmarshal(row.Metadata, &metadata); err != nil {
+				return fmt.Errorf("parse executor metadata: %w", err)
+			}
+		}
+		for key, value := range request.Metadata {
+			metadata[key] = value
+		}
+		metadataData, err := json.Marshal(metadata)
+		if err != nil {
+			return fmt.Errorf("marshal executor metadata: %w", err)
+		}
+
+		heartbeat := store.FormatTime(request.LastHeartbeat)
+		if found {
+			_, err = tx.ExecContext(ctx, tx.Rebind(_updateExecutorQuery), heartbeat, string(jsonState), reportedShardsData, metadataData, namespace, executorID)
+		} else {
+			_, err = tx.ExecContext(ctx, tx.Rebind(_insertExecutorQuery), namespace, executorID, heartbeat, string(jsonState), reportedShardsData, metadataData)
+		}
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("record heartbeat: %w", err)
+	}
+	return nil
+}
+
+// GetHeartbeat retrieves the last known heartbeat state for a single executor.
+func (s *executorStoreImpl) GetHeartbeat(ctx context.Context, namespace string, executorID string) (*store.HeartbeatState, *store.AssignedState, error) {
+	heartbeatState := &store.HeartbeatState{}
+	assignedState := &store.AssignedState{}
+	found := false
+
+	var row executorRow
+	err := s.db.GetContext(ctx, &row, s.db.Rebind(_getExecutorQuery), namespace, executorID)
+	switch {
+	case err == nil:
+		found = true
+		if heartbeatState, err = toHeartbeatState(row); err != nil {
+			return nil, nil, err
+		}
+	case !errors.Is(err, sql.ErrNoRows):
+		return nil, nil, fmt.Errorf("get executor %s: %w", executorID, err)
+	}
+
+	var assignedRow assignedStateRow
+	err = s.db.GetContext(ctx, &assignedRow, s.db.Rebind(_getAssignedStateQuery), namespace, executorID)
+	switch {
+	case err == nil:
+		found = true
+		if assignedState, err = toAssignedState(assignedRow.Data, assignedRow.Revision); err != nil {
+			return nil, nil, err
+		}
+	case !errors.Is(err, sql.ErrNoRows):
+		return nil, nil, fmt.Errorf("get assigned state of executor %s: %w", executorID, err)
+	}
+
+	if !found {
+		return nil, nil, store.ErrExecutorNotFound
+	}
+
+	return heartbeatState, assignedState, nil
+}
+
+// --- ShardStore Implementation ---
+
+func (s *executorStoreImpl) GetState(ctx context.Context, namespace string) (*store.NamespaceState, error) {
+	heartbeatStates := make(map[string]store.HeartbeatState)
+	assignedStates := make(map[string]store.AssignedState)
+	shardStats := make(map[string]store.ShardStatistics)
+
+	var executorRows []executorRow
+	if err := s.db.SelectContext(ctx, &executorRows, s.db.Rebind(_getExecutorsQuery), namespace); err != nil {
+		return nil, fmt.Errorf("get executors: %w", err)
+	}
+	for _, row := range executorRows {
+		heartbeat, err := toHeartbeatState(row)
+		if err != nil {
+			return nil, err
+		}
+		heartbeatStates[row.ExecutorID] = *heartbeat
+		assignedStates[row.ExecutorID] = store.AssignedState{}
+	}
+
+	var assignedRows []assignedStateRow
+	if err := s.db.SelectContext(ctx, &assignedRows, s.db.Rebind(_getAssignedStatesQuery), namespace); err != nil {
+		return nil, fmt.Errorf("get assigned states: %w", err)
+	}
+	for _, row := range assignedRows {
+		assigned, err := toAssignedState(row.Data, row.Revision)
+		if err != nil {
+			return nil, err
+		}
+		assignedStates[row.ExecutorID] = *assigned
+		heartbeatStates[row.ExecutorID] = heartbeatStates[row.ExecutorID]
+	}
+
+	// Only load shard statistics if the load balancing mode requires it
+	// TODO: refactor this code to not have a dependency on dynamic config in the store layer
+	if s.cfg.GetLoadBalancingMode(namespace) == types.LoadBalancingModeGREEDY {
+		stats, err := s.getShardStatistics(ctx, s.db, namespace)
+		if err != nil {
+			return nil, err
+		}
+		for _, executorShardStats := range stats {
+			for shardID, stat := range executorShardStats {
+				shardStats[shardID] = *stat.ToShardStatistics()
+			}
+		}
+	}
+
+	return &store.NamespaceState{
+		Executors:        heartbeatStates,
+		ShardStats:       shardStats,
+		ShardAssignments: assignedStates,
+	}, nil
+}
+
+// SubscribeToAssignmentChanges polls the assignments of the namespace. The subscriber receives
+// the current assignments first and then every time an assignment or an owner's metadata changes.
+func (s *executorStoreImpl) SubscribeToAssignmentChanges(ctx context.Context, namespace string) (<-chan map[*store.ShardOwner][]string, func(), error) {
+	ctx, cancel := context.WithCancel(ctx)
+	ch := make(chan map[*store.ShardOwner][]string)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := s.timeSource.NewTicker(s.pollInterval)
+		defer ticker.Stop()
+
+		var lastVersion map[string]string
+		for {
+			state, version, err := s.getAssignments(ctx, namespace)
+			if err != nil {
+				s.logger.Warn("failed to poll shard assignments", tag.ShardNamespace(namespace), tag.Error(err))
+			} else if lastVersion == nil || !equalVersions(lastVersion, version) {
+				select {
+				case ch <- state:
+					lastVersion = version
+				case <-ctx.Done():
+					return
+				case <-s.stopC:
+					return
+				}
+			}
+
+			select {
+			case <-ticker.Chan():
+			case <-ctx.Done():
+				return
+			case <-s.stopC:
+				return
+			}
+		}
+	}()
+
+	return ch, cancel, nil
+}
+
+// SubscribeToExecutorStatusChanges polls the executor statuses of the namespace and notifies the
+// subscriber when any of them changes, or when a rebalance is triggered. The values sent are
+// increasing sequence numbers of the changes.
+func (s *executorStoreImpl) SubscribeToExecutorStatusChanges(ctx context.Context, namespace string) (<-chan int64, error) {
+	statuses, err := s.getStatuses(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("get executor statuses: %w", err)
+	}
+	rebalanceRequests, err := s.getRebalanceRequests(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("get rebalance requests: %w", err)
+	}
+
+	revisionChan := make(chan int64, 1)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer close(revisionChan)
+
+		scope := s.metricsClient.Scope(metrics.ShardDistributorWatchScope).
+			Tagged(metrics.NamespaceTag(namespace)).
+			Tagged(metrics.ShardDistributorWatchTypeTag("rebalance"))
+
+		ticker := s.timeSource.NewTicker(s.pollInterval)
+		defer ticker.Stop()
+
+		var revision int64
+		for {
+			select {
+			case <-ticker.Chan():
+			case <-ctx.Done():
+				return
+			case <-s.stopC:
+				return
+			}
+
+			sw := scope.StartTimer(metrics.ShardDistributorWatchProcessingLatency)
+			current, err := s.getStatuses(ctx, namespace)
+			if err != nil {
+				s.logger.Warn("failed to poll executor statuses", tag.ShardNamespace(namespace), tag.Error(err))
+				sw.Stop()
+				continue
+			}
+			currentRequests, err := s.getRebalanceRequests(ctx, namespace)
+			if err != nil {
+				s.logger.Warn("failed to poll rebalance requests", tag.ShardNamespace(namespace), tag.Error(err))
+				sw.Stop()
+				continue
+			}
+			if equalVersions(statuses, current) && rebalanceRequests == currentRequests {
+				sw.Stop()
+				continue
+			}
+			statuses = current
+			rebalanceRequests = currentRequests
+			revision++
+
+			// If the channel is full, it means the previous revision hasn't been processed yet.
+			// Pop the old revision to make room for the new one, ensuring we always have the latest revision.
+			select {
+			case <-revisionChan:
+			default:
+			}
+
+			revisionChan <- revision
+			sw.Stop()
+		}
+	}()
+
+	return revisionChan, nil
+}
+
+// TriggerRebalance increments the rebalance requests of the namespace, which is picked up by the
+// next poll of SubscribeToExecutorStatusChanges.
+func (s *executorStoreImpl) TriggerRebalance(ctx context.Context, namespace string) error {
+	return sqlclient.RunInTx(ctx, s.db, func(tx *sqlx.Tx) error {
+		result, err := tx.ExecContext(ctx, tx.Rebind(_requestRebalanceQuery), namespace)
+		if err != nil {
+			return fmt.Errorf("increment rebalance requests: %w", err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("increment rebalance requests: %w", err)
+		}
+		if rowsAffected == 0 {
+			if _, err := tx.ExecContext(ctx, tx.Rebind(_initRebalanceQuery), namespace); err != nil {
+				return fmt.Errorf("init rebalance requests: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+func (s *executorStoreImpl) AssignShards(ctx context.Context, namespace string, request store.AssignShardsRequest, guard store.GuardFunc) error {
+	if len(request.ExecutorsToDelete) == 0 && len(request.NewState.ShardAssignments) == 0 {
+		return nil
+	}
+
+	txn, err := applyGuard(guard)
+	if err != nil {
+		return err
+	}
+
+	assignedData := make(map[string][]byte, len(request.NewState.ShardAssignments))
+	for executorID, state := range request.NewState.ShardAssignments {
+		value, err := json.Marshal(etcdtypes.FromAssignedState(&state))
+		if err != nil {
+			return fmt.Errorf("marshal assigned shards for executor %s: %w", executorID, err)
+		}
+		assignedData[executorID] = value
+	}
+
+	return sqlclient.RunInTx(ctx, s.db, func(tx *sqlx.Tx) error {
+		revision, err := s.lockNamespace(ctx, tx, namespace, txn)
+		if err != nil {
+			return err
+		}
+
+		// 1. Check that the assigned states of all executors we are about to change or delete
+		// have not been modified since the caller read them. A revision of 0 means the state doesn't exist.
+		var revisionRows []assignedStateRow
+		if err := tx.SelectContext(ctx, &revisionRows, tx.Rebind(_getAssignedRevisionsQuery), namespace); err != nil {
+			return fmt.Errorf("get assigned state revisions: %w", err)
+		}
+		currentRevisions := make(map[string]int64, len(revisionRows))
+		for _, row := range revisionRows {
+			currentRevisions[row.ExecutorID] = row.Revision
+		}
+
+		failingRevisionString := ""
+		checkRevision := func(executorID string, expected int64) {
+			if actual := currentRevisions[executorID]; actual != expected {
+				failingRevisionString = failingRevisionString + fmt.Sprintf("{ executor: %s, expected:%v, actual: %v }", executorID, expected, actual)
+			}
+		}
+		for executorID, expectedModRevision := range request.ExecutorsToDelete {
+			checkRevision(executorID, expectedModRevision)
+		}
+		for executorID, state := range request.NewState.ShardAssignments {
+			checkRevision(executorID, state.ModRevision)
+		}
+		if failingRevisionString != "" {
+			return fmt.Errorf("%w: transaction failed, a shard may have been concurrently assigned, %v", store.ErrVersionConflict, failingRevisionString)
+		}
+
+		// TODO: Should be extracted to a higher level so that statistics updates are prepared
+		if s.cfg.GetLoadBalancingMode(namespace) == types.LoadBalancingModeGREEDY {
+			if err := s.updateShardStatistics(ctx, tx, namespace, request.NewState.ShardAssignments); err != nil {
+				return fmt.Errorf("update shard statistics: %w", err)
+			}
+		}
+
+		// 2. Delete stale executors.
+		toDelete := make([]string, 0, len(request.ExecutorsToDelete))
+		for executorID := range request.ExecutorsToDelete {
+			toDelete = append(toDelete, executorID)
+		}
+		if err := s.deleteExecutors(ctx, tx, namespace, toDelete); err != nil {
+			return err
+		}
+
+		// 3. Replace the assigned states and the shard ownership of the updated executors.
+		executorIDs := make([]string, 0, len(request.NewState.ShardAssignments))
+		var shardIDs, owners []string
+		for executorID, state := range request.NewState.ShardAssignments {
+			executorIDs = append(executorIDs, executorID)
+			for shardID := range state.AssignedShards {
+				shardIDs = append(shardIDs, shardID)
+				owners = append(owners, executorID)
+			}
+		}
+		if err := execIn(ctx, tx, _deleteAssignedStatesQuery, namespace, executorIDs); err != nil {
+			return fmt.Errorf("delete assigned states: %w", err)
+		}
+		if err := execIn(ctx, tx, _deleteShardsByExecutorsQuery, namespace, executorIDs); err != nil {
+			return fmt.Errorf("delete shard owners: %w", err)
+		}
+		for executorID, data := range assignedData {
+			if _, err := tx.ExecContext(ctx, tx.Rebind(_insertAssignedStateQuery), namespace, executorID, data, revision); err != nil {
+				return fmt.Errorf("insert assigned state for executor %s: %w", executorID, err)
+			}
+		}
+		if err := insertShards(ctx, tx, namespace, shardIDs, owners); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (s *executorStoreImpl) AssignShard(ctx context.Context, namespace, shardID, executorID string) error {
+	return sqlclient.RunInTx(ctx, s.db, func(tx *sqlx.Tx) error {
+		revision, err := s.lockNamespace(ctx, tx, namespace, sqlclient.NewTxn())
+		if err != nil {
+			return err
+		}
+
+		// 1. Check the executor is ACTIVE.
+		var status string
+		err = tx.GetContext(ctx, &status, tx.Rebind(_getStatusQuery), namespace, executorID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return store.ErrExecutorNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("get executor status: %w", err)
+		}
+		if status != _executorStatusRunningJSON {
+			return fmt.Errorf("%w: executor status is %s", store.ErrVersionConflict, status)
+		}
+
+		// 2. Check the shard is not already assigned.
+		var owner ownerRow
+		err = tx.GetContext(ctx, &owner, tx.Rebind(_getShardOwnerQuery), namespace, shardID)
+		if err == nil {
+			metadata, err := parseMetadata(owner.Metadata)
+			if err != nil {
+				return err
+			}
+			return &store.ErrShardAlreadyAssigned{ShardID: shardID, AssignedTo: owner.ExecutorID, Metadata: metadata}
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("checking shard owner: %w", err)
+		}
+
+		// 3. Add the shard to the assigned state of the executor.
+		state := etcdtypes.AssignedState{AssignedShards: make(map[string]*types.ShardAssignment)}
+		var assignedRow assignedStateRow
+		err = tx.GetContext(ctx, &assignedRow, tx.Rebind(_getAssignedStateQuery), namespace, executorID)
+		switch {
+		case err == nil:
+			if err := json.Unmarshal(assignedRow.Data, &state); err != nil {
+				return fmt.Errorf("parse assigned state: %w", err)
+			}
+			if state.AssignedShards == nil {
+				state.AssignedShards = make(map[string]*types.ShardAssignment)
+			}
+		case !errors.Is(err, sql.ErrNoRows):
+			return fmt.Errorf("get executor assigned state: %w", err)
+		}
+		state.AssignedShards[shardID] = &types.ShardAssignment{Status: types.AssignmentStatusREADY}
+
+		now := s.timeSource.Now().UTC()
+		state.LastUpdated = store.Time(now)
+
+		newStateValue, err := json.Marshal(state)
+		if err != nil {
+			return fmt.Errorf("marshal new assigned state: %w", err)
+		}
+		if err := execIn(ctx, tx, _deleteAssignedStatesQuery, namespace, []string{executorID}); err != nil {
+			return fmt.Errorf("delete assigned state: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, tx.Rebind(_insertAssignedStateQuery), namespace, executorID, newStateValue, revision); err != nil {
+			return fmt.Errorf("insert assigned state: %w", err)
+		}
+		if err := insertShards(ctx, tx, namespace, []string{shardID}, []string{executorID}); err != nil {
+			return err
+		}
+
+		// TODO: Extract to higher level so that statistics updates are prepared
+		if s.cfg.GetLoadBalancingMode(namespace) == types.LoadBalancingModeGREEDY {
+			stats, err := s.getShardStatistics(ctx, tx, namespace)
+			if err != nil {
+				return err
+			}
+			executorShardStats := stats[executorID]
+			if executorShardStats == nil {
+				executorShardStats = make(map[string]etcdtypes.ShardStatistics)
+			}
+			shardStats, ok := executorShardStats[shardID]
+			if !ok {
+				shardStats.SmoothedLoad = 0
+				shardStats.LastUpdateTime = store.Time(now)
+			}
+			shardStats.LastMoveTime = store.Time(now)
+			executorShardStats[shardID] = shardStats
+
+			if err := s.writeShardStatistics(ctx, tx, namespace, map[string]map[string]etcdtypes.ShardStatistics{executorID: executorShardStats}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteExecutors deletes the given executors from the store. It does not delete the shards owned by the executors, this
+// should be handled by the namespace processor loop as we want to reassign, not delete the shards.
+func (s *executorStoreImpl) DeleteExecutors(ctx context.Context, namespace string, executorIDs []string, guard store.GuardFunc) error {
+	if len(executorIDs) == 0 {
+		return nil
+	}
+	if err := s.runGuarded(ctx, namespace, guard, func(tx *sqlx.Tx) error {
+		return s.deleteExecutors(ctx, tx, namespace, executorIDs)
+	}); err != nil {
+		return fmt.Errorf("delete executors: %w", err)
+	}
+	return nil
+}
+
+func (s *executorStoreImpl) DeleteAssignedStates(ctx context.Context, namespace string, executorIDs []string, guard store.GuardFunc) error {
+	if len(executorIDs) == 0 {
+		return nil
+	}
+	if err := s.runGuarded(ctx, namespace, guard, func(tx *sqlx.Tx) error {
+		if err := execIn(ctx, tx, _deleteAssignedStatesQuery, namespace, executorIDs); err != nil {
+			return err
+		}
+		return execIn(ctx, tx, _deleteShardsByExecutorsQuery, namespace, executorIDs)
+	}); err != nil {
+		return fmt.Errorf("delete assigned states: %w", err)
+	}
+	return nil
+}
+
+// DeleteShardStats deletes shard statistics for the given shard IDs.
+func (s *executorStoreImpl) DeleteShardStats(ctx context.Context, namespace string, shardIDs []string, guard store.GuardFunc) error {
+	if len(shardIDs) == 0 {
+		return nil
+	}
+
+	// Build a lookup for shard IDs to delete.
+	toDelete := make(map[string]struct{}, len(shardIDs))
+	for _, shardID := range shardIDs {
+		toDelete[shardID] = struct{}{}
+	}
+
+	if err := s.runGuarded(ctx, namespace, guard, func(tx *sqlx.Tx) error {
+		stats, err := s.getShardStatistics(ctx, tx, namespace)
+		if err != nil {
+			return err
+		}
+
+		changed := make(map[string]map[string]etcdtypes.ShardStatistics)
+		for executorID, executorStats := range stats {
+			for shardID := range executorStats {
+				if _, ok := toDelete[shardID]; ok {
+					delete(executorStats, shardID)
+					changed[executorID] = executorStats
+				}
+			}
+		}
+		return s.writeShardStatistics(ctx, tx, namespace, changed)
+	}); err != nil {
+		return fmt.Errorf("delete shard stats: %w", err)
+	}
+	return nil
+}
+
+func (s *executorStoreImpl) GetShardOwner(ctx context.Context, namespace, shardID string) (*store.ShardOwner, error) {
+	var row ownerRow
+	err := s.db.GetContext(ctx, &row, s.db.Rebind(_getShardOwnerQuery), namespace, shardID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, store.ErrShardNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get shard owner: %w", err)
+	}
+	metadata, err := parseMetadata(row.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	return &store.ShardOwner{ExecutorID: row.ExecutorID, Metadata: metadata}, nil
+}
+
+func (s *executorStoreImpl) GetExecutor(ctx context.Context, namespace string, executorID string) (*store.ShardOwner, error) {
+	var row executorRow
+	err := s.db.GetContext(ctx, &row, s.db.Rebind(_getExecutorQuery), namespace, executorID)
+	if err == nil {
+		metadata, err := parseMetadata(row.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		return &store.ShardOwner{ExecutorID: executorID, Metadata: metadata}, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("get executor: %w", err)
+	}
+
+	// An executor whose heartbeat was removed still owns its assigned shards.
+	var count int
+	if err := s.db.GetContext(ctx, &count, s.db.Rebind(_assignedStateExistsQuery), namespace, executorID); err != nil {
+		return nil, fmt.Errorf("get executor assigned state: %w", err)
+	}
+	if count == 0 {
+		return nil, store.ErrExecutorNotFound
+	}
+	return &store.ShardOwner{ExecutorID: executorID, Metadata: make(map[string]string)}, nil
+}
+
+// runGuarded runs fn in a transaction holding the namespace lock, after checking the guard.
+func (s *executorStoreImpl) runGuarded(ctx context.Context, namespace string, guard store.GuardFunc, fn func(tx *sqlx.Tx) error) error {
+	txn, err := applyGuard(guard)
+	if err != nil {
+		return err
+	}
+	return sqlclient.RunInTx(ctx, s.db, func(tx *sqlx.Tx) error {
+		if _, err := s.lockNamespace(ctx, tx, namespace, txn); err != nil {
+			return err
+		}
+		return fn(tx)
+	})
+}
+
+// lockNamespace increments the revision of the namespace, which locks it until tx ends, and
+// checks the preconditions of txn. It returns the new revision of the namespace.
+func (s *executorStoreImpl) lockNamespace(ctx context.Context, tx *sqlx.Tx, namespace string, txn *sqlclient.Txn) (int64, error) {
+	result, err := tx.ExecContext(ctx, tx.Rebind(_nextRevisionQuery), namespace)
+	if err != nil {
+		return 0, fmt.Errorf("increment namespace revision: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("increment namespace revision: %w", err)
+	}
+
+	revision := int64(1)
+	if rowsAffected == 0 {
+		if _, err := tx.ExecContext(ctx, tx.Rebind(_initRevisionQuery), namespace); err != nil {
+			return 0, fmt.Errorf("init namespace revision: %w", err)
+		}
+	} else if err := tx.GetContext(ctx, &revision, tx.Rebind(_getRevisionQuery), namespace); err != nil {
+		return 0, fmt.Errorf("get namespace revision: %w", err)
+	}
+
+	ok, err := txn.Check(ctx, tx)
+	if err != nil {
+		return 0, fmt.Errorf("check transaction guard: %w", err)
+	}
+	if !ok {
+		// This means the guard's condition (e.g., leadership) failed.
+		return 0, fmt.Errorf("%w: transaction failed, leadership may have changed", store.ErrVersionConflict)
+	}
+	return revision, nil
+}
+
+func (s *executorStoreImpl) deleteExecutors(ctx context.Context, tx *sqlx.Tx, namespace string, executorIDs []string) error {
+	for _, query := range []string{_deleteExecutorsQuery, _deleteAssignedStatesQuery, _deleteShardStatsQuery, _deleteShardsByExecutorsQuery} {
+		if err := execIn(ctx, tx, query, namespace, executorIDs); err != nil {
+			return fmt.Errorf("delete executors: %w", err)
+		}
+	}
+	return nil
+}
+
+// updateShardStatistics moves the statistics of shards changing owner to their new executor,
+// and creates statistics for shards that had no owner.
+func (s *executorStoreImpl) updateShardStatistics(ctx context.Context, tx *sqlx.Tx, namespace string, newAssignments map[string]store.AssignedState) error {
+	var shardIDs []string
+	for _, state := range newAssignments {
+		for shardID := range state.AssignedShards {
+			shardIDs = append(shardIDs, shardID)
+		}
+	}
+	oldOwners := make(map[string]string, len(shardIDs))
+	for _, chunk := range chunks(shardIDs) {
+		var rows []struct {
+			ShardID    string `db:"shard_id"`
+			ExecutorID string `db:"executor_id"`
+		}
+		query, args, err := sqlx.In(_getShardOwnersQuery, namespace, chunk)
+		if err != nil {
+			return err
+		}
+		if err := tx.SelectContext(ctx, &rows, tx.Rebind(query), args...); err != nil {
+			return fmt.Errorf("get shard owners: %w", err)
+		}
+		for _, row := range rows {
+			oldOwners[row.ShardID] = row.ExecutorID
+		}
+	}
+
+	stats, err := s.getShardStatistics(ctx, tx, namespace)
+	if err != nil {
+		return err
+	}
+	statsOf := func(executorID string) map[string]etcdtypes.ShardStatistics {
+		if stats[executorID] == nil {
+			stats[executorID] = make(map[string]etcdtypes.ShardStatistics)
+		}
+		return stats[executorID]
+	}
+
+	now := s.timeSource.Now().UTC()
+	changed := make(map[string]map[string]etcdtypes.ShardStatistics)
+	for executorID, state := range newAssignments {
+		for shardID := range state.AssignedShards {
+			oldOwner, owned := oldOwners[shardID]
+			if owned && oldOwner == executorID {
+				continue
+			}
+
+			var shardStats etcdtypes.ShardStatistics
+			if owned {
+				oldStats := statsOf(oldOwner)
+				if existing, ok := oldStats[shardID]; ok {
+					shardStats = existing
+				}
+				delete(oldStats, shardID)
+				changed[oldOwner] = oldStats
+			} else {
+				shardStats.SmoothedLoad = 0
+				shardStats.LastUpdateTime = store.Time(now)
+			}
+			shardStats.LastMoveTime = store.Time(now)
+
+			newStats := statsOf(executorID)
+			newStats[shardID] = shardStats
+			changed[executorID] = newStats
+		}
+	}
+	return s.writeShardStatistics(ctx, tx, namespace, changed)
+}
+
+// getShardStatistics returns the shard statistics of the namespace keyed by executor ID.
+func (s *executorStoreImpl) getShardStatistics(ctx context.Context, q sqlx.QueryerContext, namespace string) (map[string]map[string]etcdtypes.ShardStatistics, error) {
+	var rows []shardStatsRow
+	if err := sqlx.SelectContext(ctx, q, &rows, s.db.Rebind(_getShardStatsQuery), namespace); err != nil {
+		return nil, fmt.Errorf("get shard statistics: %w", err)
+	}
+	stats := make(map[string]map[string]etcdtypes.ShardStatistics, len(rows))
+	for _, row := range rows {
+		executorStats := make(map[string]etcdtypes.ShardStatistics)
+		if err := json.Unmarshal(row.Data, &executorStats); err != nil {
+			return nil, fmt.Errorf("parse executor shard statistics: %w", err)
+		}
+		stats[row.ExecutorID] = executorStats
+	}
+	return stats, nil
+}
+
+// writeShardStatistics replaces the shard statistics of the given executors, executors left without statistics are deleted.
+func (s *executorStoreImpl) writeShardStatistics(ctx context.Context, tx *sqlx.Tx, namespace string, stats map[string]map[string]etcdtypes.ShardStatistics) error {
+	executorIDs := make([]string, 0, len(stats))
+	for executorID := range stats {
+		executorIDs = append(executorIDs, executorID)
+	}
+	if err := execIn(ctx, tx, _deleteShardStatsQuery, namespace, executorIDs); err != nil {
+		return fmt.Errorf("delete shard statistics: %w", err)
+	}
+	for executorID, executorStats := range stats {
+		if len(executorStats) == 0 {
+			continue
+		}
+		payload, err := json.Marshal(executorStats)
+		if err != nil {
+			return fmt.Errorf("marshal shard statistics: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, tx.Rebind(_insertShardStatsQuery), namespace, executorID, payload); err != nil {
+			return fmt.Errorf("insert shard statistics: %w", err)
+		}
+	}
+	return nil
+}
+
+// getAssignments returns the shards of every executor in the namespace, along with a version
+// of each executor's assignment and metadata used to detect changes.
+func (s *executorStoreImpl) getAssignments(ctx context.Context, namespace string) (map[*store.ShardOwner][]string, map[string]string, error) {
+	var rows []ownerRow
+	if err := s.db.SelectContext(ctx, &rows, s.db.Rebind(_getAssignmentsAndOwnerQuery), namespace); err != nil {
+		return nil, nil, fmt.Errorf("get assignments: %w", err)
+	}
+
+	state := make(map[*store.ShardOwner][]string, len(rows))
+	version := make(map[string]string, len(rows))
+	for _, row := range rows {
+		metadata, err := parseMetadata(row.Metadata)
+		if err != nil {
+			return nil, nil, err
+		}
+		var assigned etcdtypes.AssignedState
+		if err := json.Unmarshal(row.Data, &assigned); err != nil {
+			return nil, nil, fmt.Errorf("parse assigned state: %w", err)
+		}
+		shardIDs := make([]string, 0, len(assigned.AssignedShards))
+		for shardID := range assigned.AssignedShards {
+			shardIDs = append(shardIDs, shardID)
+		}
+		sort.Strings(shardIDs)
+
+		state[&store.ShardOwner{ExecutorID: row.ExecutorID, Metadata: metadata}] = shardIDs
+		version[row.ExecutorID] = fmt.Sprintf("%d/%s", row.Revision, row.Metadata)
+	}
+	return state, version, nil
+}
+
+func (s *executorStoreImpl) getStatuses(ctx context.Context, namespace string) (map[string]string, error) {
+	var rows []executorRow
+	if err := s.db.SelectContext(ctx, &rows, s.db.Rebind(_getStatusesQuery), namespace); err != nil {
+		return nil, err
+	}
+	statuses := make(map[string]string, len(rows))
+	for _, row := range rows {
+		statuses[row.ExecutorID] = row.Status
+	}
+	return statuses, nil
+}
+
+func (s *executorStoreImpl) getRebalanceRequests(ctx context.Context, namespace string) (int64, error) {
+	var requests int64
+	err := s.db.GetContext(ctx, &requests, s.db.Rebind(_getRebalanceRequestsQuery), namespace)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return requests, err
+}
+
+// applyGuard applies the guard to a new SQL transaction.
+func applyGuard(guard store.GuardFunc) (*sqlclient.Txn, error) {
+	guardedTxn, err := guard(sqlclient.NewTxn())
+	if err != nil {
+		return nil, fmt.Errorf("apply transaction guard: %w", err)
+	}
+	txn, ok := guardedTxn.(*sqlclient.Txn)
+	if !ok {
+		return nil, fmt.Errorf("guard function returned invalid transaction type")
+	}
+	return txn, nil
+}
+
+// execIn runs a statement with a namespace and an IN list of ids, in chunks.
+func execIn(ctx context.Context, tx *sqlx.Tx, query string, namespace string, ids []string) error {
+	for _, chunk := range chunks(ids) {
+		expanded, args, err := sqlx.In(query, namespace, chunk)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, tx.Rebind(expanded), args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertShards records owners[i] as the owner of shardIDs[i], replacing any previous owner.
+func insertShards(ctx context.Context, tx *sqlx.Tx, namespace string, shardIDs, owners []string) error {
+	if err := execIn(ctx, tx, _deleteShardsQuery, namespace, shardIDs); err != nil {
+		return fmt.Errorf("delete shard owners: %w", err)
+	}
+	for start := 0; start < len(shardIDs); start += maxRowsPerStatement {
+		end := start + maxRowsPerStatement
+		if end > len(shardIDs) {
+			end = len(shardIDs)
+		}
+		values := make([]string, 0, end-start)
+		args := make([]interface{}, 0, 3*(end-start))
+		for i := start; i < end; i++ {
+			values = append(values, "(?, ?, ?)")
+			args = append(args, namespace, shardIDs[i], owners[i])
+		}
+		if _, err := tx.ExecContext(ctx, tx.Rebind(_insertShardsQueryPrefix+strings.Join(values, ", ")), args...); err != nil {
+			return fmt.Errorf("insert shard owners: %w", err)
+		}
+	}
+	return nil
+}
+
+func chunks(ids []string) [][]string {
+	var result [][]string
+	for start := 0; start < len(ids); start += maxRowsPerStatement {
+		end := start + maxRowsPerStatement
+		if end > len(ids) {
+			end = len(ids)
+		}
+		result = append(result, ids[start:end])
+	}
+	return result
+}
+
+func toHeartbeatState(row executorRow) (*store.HeartbeatState, error) {
+	var err error
+	state := &store.HeartbeatState{}
+	if state.LastHeartbeat, err = store.ParseTime(row.LastHeartbeat); err != nil {
+		return nil, fmt.Errorf("parse heartbeat timestamp: %w", err)
+	}
+	if err := json.Unmarshal([]byte(row.Status), &state.Status); err != nil {
+		return nil, fmt.Errorf("parse executor status: %w", err)
+	}
+	if err := json.Unmarshal(row.ReportedShards, &state.ReportedShards); err != nil {
+		return nil, fmt.Errorf("parse reported shards: %w", err)
+	}
+	if state.Metadata, err = parseMetadata(row.Metadata); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func toAssignedState(data []byte, revision int64) (*store.AssignedState, error) {
+	var assigned etcdtypes.AssignedState
+	if err := json.Unmarshal(data, &assigned); err != nil {
+		return nil, fmt.Errorf("parse assigned shards: %w", err)
+	}
+	assigned.ModRevision = revision
+	return assigned.ToAssignedState(), nil
+}
+
+func parseMetadata(data []byte) (map[string]string, error) {
+	metadata := make(map[string]string)
+	if len(data) == 0 {
+		return metadata, nil
+	}
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return nil, fmt.Errorf("parse executor metadata: %w", err)
+	}
+	return metadata, nil
+}
+
+func equalVersions(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, value := range a {
+		if other, ok := b[key]; !ok || other != value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,365 @@
+package executorstore
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/fx/fxtest"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/store"
+	"github.com/uber/cadence/service/sharddistributor/store/etcd/etcdtypes"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/leaderstore"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/sqlclient"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/testhelper"
+	"github.com/uber/cadence/service/sharddistributor/store/storetest"
+)
+
+// executorStoreSuite runs the executor store suite shared with the other stores, and the tests specific to SQL.
+type executorStoreSuite struct {
+	storetest.ExecutorStoreSuite
+	pluginName string
+}
+
+func newExecutorStoreSuite(pluginName string) *executorStoreSuite {
+	s := &executorStoreSuite{pluginName: pluginName}
+	s.NewTestCluster = s.newTestCluster
+	return s
+}
+
+func TestSQLiteExecutorStore(t *testing.T) {
+	suite.Run(t, newExecutorStoreSuite(sqlclient.PluginSQLite))
+}
+
+func TestMySQLExecutorStore(t *testing.T) {
+	suite.Run(t, newExecutorStoreSuite(sqlclient.PluginMySQL))
+}
+
+func TestPostgresExecutorStore(t *testing.T) {
+	suite.Run(t, newExecutorStoreSuite(sqlclient.PluginPostgres))
+}
+
+// TestRecordHeartbeatRow verifies the row an executor's heartbeat is stored in.
+func (s *executorStoreSuite) TestRecordHeartbeatRow() {
+	t := s.T()
+	tc := testhelper.SetupStoreTestCluster(t, s.pluginName)
+	executorStore := createStore(t, tc)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Now().UTC()
+
+	executorID := "executor-TestRecordHeartbeat"
+	req := store.HeartbeatState{
+		LastHeartbeat: now,
+		Status:        types.ExecutorStatusACTIVE,
+		ReportedShards: map[string]*types.ShardStatusReport{
+			"shard-TestRecordHeartbeat": {Status: types.ShardStatusREADY},
+		},
+		Metadata: map[string]string{
+			"key-1": "value-1",
+			"key-2": "value-2",
+		},
+	}
+
+	err := executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, req)
+	require.NoError(t, err)
+
+	// Verify directly in the database
+	var row executorRow
+	require.NoError(t, tc.DB.Get(&row, tc.DB.Rebind(_getExecutorQuery), tc.Namespace, executorID))
+	assert.Equal(t, store.FormatTime(now), row.LastHeartbeat)
+	assert.Equal(t, stringStatus(types.ExecutorStatusACTIVE), row.Status)
+
+	var reportedShards map[string]*types.ShardStatusReport
+	require.NoError(t, json.Unmarshal(row.ReportedShards, &reportedShards))
+	require.Len(t, reportedShards, 1)
+	assert.Equal(t, types.ShardStatusREADY, reportedShards["shard-TestRecordHeartbeat"].Status)
+
+	var metadata map[string]string
+	require.NoError(t, json.Unmarshal(row.Metadata, &metadata))
+	assert.Equal(t, req.Metadata, metadata)
+
+	// A later heartbeat without metadata keeps the existing metadata
+	req.Metadata = map[string]string{"key-2": "value-2-updated"}
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, req))
+	owner, err := executorStore.GetExecutor(ctx, tc.Namespace, executorID)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"key-1": "value-1", "key-2": "value-2-updated"}, owner.Metadata)
+}
+
+// TestGetShardOwner verifies that shard owners are read from the database, without the delay of a cache.
+func (s *executorStoreSuite) TestGetShardOwner() {
+	t := s.T()
+	tc := testhelper.SetupStoreTestCluster(t, s.pluginName)
+	executorStore := createStore(t, tc)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID1 := "exec-owner-1"
+	executorID2 := "exec-owner-2"
+	owner1 := &store.ShardOwner{ExecutorID: executorID1, Metadata: map[string]string{"key": "value"}}
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID1, store.HeartbeatState{
+		Status:   types.ExecutorStatusACTIVE,
+		Metadata: owner1.Metadata,
+	}))
+	recordHeartbeats(ctx, t, executorStore, tc.Namespace, executorID2)
+
+	require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, "shard-1", executorID1))
+	require.NoError(t, executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{
+		NewState: &store.NamespaceState{
+			ShardAssignments: map[string]store.AssignedState{
+				executorID2: {AssignedShards: map[string]*types.ShardAssignment{"shard-2": {}}},
+			},
+		},
+	}, store.NopGuard()))
+
+	owner, err := executorStore.GetShardOwner(ctx, tc.Namespace, "shard-1")
+	require.NoError(t, err)
+	assert.Equal(t, owner1, owner)
+	owner, err = executorStore.GetExecutor(ctx, tc.Namespace, executorID1)
+	require.NoError(t, err)
+	assert.Equal(t, owner1, owner)
+	owner, err = executorStore.GetShardOwner(ctx, tc.Namespace, "shard-2")
+	require.NoError(t, err)
+	assert.Equal(t, executorID2, owner.ExecutorID)
+
+	// A conflicting assignment leaves the owners untouched.
+	err = executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{
+		NewState: &store.NamespaceState{
+			ShardAssignments: map[string]store.AssignedState{
+				executorID2: {AssignedShards: map[string]*types.ShardAssignment{"shard-1": {}}},
+			},
+		},
+	}, store.NopGuard())
+	assert.ErrorIs(t, err, store.ErrVersionConflict)
+	owner, err = executorStore.GetShardOwner(ctx, tc.Namespace, "shard-1")
+	require.NoError(t, err)
+	assert.Equal(t, executorID1, owner.ExecutorID)
+
+	// Shards of deleted assigned states and executors have no owner.
+	require.NoError(t, executorStore.DeleteAssignedStates(ctx, tc.Namespace, []string{executorID1}, store.NopGuard()))
+	_, err = executorStore.GetShardOwner(ctx, tc.Namespace, "shard-1")
+	assert.ErrorIs(t, err, store.ErrShardNotFound)
+	require.NoError(t, executorStore.DeleteExecutors(ctx, tc.Namespace, []string{executorID2}, store.NopGuard()))
+	_, err = executorStore.GetShardOwner(ctx, tc.Namespace, "shard-2")
+	assert.ErrorIs(t, err, store.ErrShardNotFound)
+
+	_, err = executorStore.GetShardOwner(ctx, tc.Namespace, "shard-unknown")
+	assert.ErrorIs(t, err, store.ErrShardNotFound)
+	_, err = executorStore.GetExecutor(ctx, tc.Namespace, "executor-unknown")
+	assert.ErrorIs(t, err, store.ErrExecutorNotFound)
+}
+
+func (s *executorStoreSuite) TestSubscribeToAssignmentChanges() {
+	t := s.T()
+	tc := testhelper.SetupStoreTestCluster(t, s.pluginName)
+	timeSource := clock.NewMockedTimeSourceAt(time.Now())
+	executorStore := createStoreWithTimeSource(t, tc, timeSource)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID := "exec-assignment-sub"
+	require.NoError(t, executorStore.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{
+		Status:   types.ExecutorStatusACTIVE,
+		Metadata: map[string]string{"address": "host:1234"},
+	}))
+	require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, "shard-1", executorID))
+
+	sub, unsubscribe, err := executorStore.SubscribeToAssignmentChanges(ctx, tc.Namespace)
+	require.NoError(t, err)
+	defer unsubscribe()
+
+	receive := func() map[string][]string {
+		t.Helper()
+		select {
+		case state := <-sub:
+			result := make(map[string][]string, len(state))
+			for owner, shardIDs := range state {
+				assert.Equal(t, "host:1234", owner.Metadata["address"])
+				result[owner.ExecutorID] = shardIDs
+			}
+			return result
+		case <-time.After(time.Second):
+			t.Fatal("Should have received the assignments")
+			return nil
+		}
+	}
+
+	// The initial state is sent right away
+	assert.Equal(t, map[string][]string{executorID: {"shard-1"}}, receive())
+
+	// Nothing changed - should NOT trigger notification
+	timeSource.BlockUntil(1)
+	timeSource.Advance(time.Second)
+	select {
+	case <-sub:
+		t.Fatal("Should not receive notification without changes")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// A new assignment is sent on the next poll
+	require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, "shard-2", executorID))
+	timeSource.BlockUntil(1)
+	timeSource.Advance(time.Second)
+	assert.Equal(t, map[string][]string{executorID: {"shard-1", "shard-2"}}, receive())
+}
+
+// TestShardStatisticsMoveWithShard verifies that AssignShards moves the statistics of a shard to its new owner.
+func (s *executorStoreSuite) TestShardStatisticsMoveWithShard() {
+	t := s.T()
+	tc := testhelper.SetupStoreTestCluster(t, s.pluginName)
+	executorStore := createStore(t, tc)
+	executorStore.(*executorStoreImpl).cfg = greedyConfig()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID1 := "exec-stats-1"
+	executorID2 := "exec-stats-2"
+	recordHeartbeats(ctx, t, executorStore, tc.Namespace, executorID1, executorID2)
+	require.NoError(t, executorStore.AssignShard(ctx, tc.Namespace, "shard-1", executorID1))
+
+	stats := store.ShardStatistics{SmoothedLoad: 3, LastUpdateTime: time.Unix(1234, 0).UTC(), LastMoveTime: time.Unix(5678, 0).UTC()}
+	writeShardStats(t, tc, executorID1, map[string]etcdtypes.ShardStatistics{
+		"shard-1": *etcdtypes.FromShardStatistics(&stats),
+	})
+
+	state, err := executorStore.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	require.NoError(t, executorStore.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{
+		NewState: &store.NamespaceState{
+			ShardAssignments: map[string]store.AssignedState{
+				executorID1: {ModRevision: state.ShardAssignments[executorID1].ModRevision},
+				executorID2: {AssignedShards: map[string]*types.ShardAssignment{"shard-1": {}}},
+			},
+		},
+	}, store.NopGuard()))
+
+	executorStats, err := executorStore.(*executorStoreImpl).getShardStatistics(ctx, tc.DB, tc.Namespace)
+	require.NoError(t, err)
+	assert.NotContains(t, executorStats, executorID1)
+	require.Contains(t, executorStats[executorID2], "shard-1")
+	assert.Equal(t, stats.SmoothedLoad, executorStats[executorID2]["shard-1"].SmoothedLoad)
+	assert.Greater(t, executorStats[executorID2]["shard-1"].LastMoveTime.ToTime(), stats.LastMoveTime)
+}
+
+// --- Test Setup ---
+
+// newTestCluster creates a store backed by an empty database for the executor store suite.
+func (s *executorStoreSuite) newTestCluster(t *testing.T, params storetest.ExecutorStoreParams) *storetest.ExecutorStoreTestCluster {
+	t.Helper()
+
+	tc := testhelper.SetupStoreTestCluster(t, s.pluginName)
+
+	leaderCfg, err := sqlclient.NewLeaderStoreConfig(tc.SDConfig)
+	require.NoError(t, err)
+	elector, err := leaderstore.NewLeaderStore(leaderstore.StoreParams{DB: tc.DB, Cfg: leaderCfg, Logger: testlogger.New(t)})
+	require.NoError(t, err)
+
+	return &storetest.ExecutorStoreTestCluster{
+		Namespace: tc.Namespace,
+		Store:     createStoreWithParams(t, tc, params),
+		Elector:   elector,
+		WriteShardStatistics: func(t *testing.T, executorID string, stats map[string]store.ShardStatistics) {
+			t.Helper()
+
+			executorStats := make(map[string]etcdtypes.ShardStatistics, len(stats))
+			for shardID, shardStats := range stats {
+				executorStats[shardID] = *etcdtypes.FromShardStatistics(&shardStats)
+			}
+			writeShardStats(t, tc, executorID, executorStats)
+		},
+		// The store polls the database on every tick of the time source for its subscriptions.
+		Poll: func() {
+			params.TimeSource.BlockUntil(1)
+			params.TimeSource.Advance(time.Second)
+		},
+	}
+}
+
+func stringStatus(s types.ExecutorStatus) string {
+	res, err := json.Marshal(s)
+	if err != nil {
+		panic(err)
+	}
+	return string(res)
+}
+
+func recordHeartbeats(ctx context.Context, t *testing.T, executorStore store.Store, namespace string, executorIDs ...string) {
+	t.Helper()
+
+	for _, executorID := range executorIDs {
+		require.NoError(t, executorStore.RecordHeartbeat(ctx, namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+	}
+}
+
+func writeShardStats(t *testing.T, tc *testhelper.StoreTestCluster, executorID string, stats map[string]etcdtypes.ShardStatistics) {
+	t.Helper()
+
+	payload, err := json.Marshal(stats)
+	require.NoError(t, err)
+	_, err = tc.DB.Exec(tc.DB.Rebind(`DELETE FROM shard_distributor_shard_stats WHERE namespace = ? AND executor_id = ?`), tc.Namespace, executorID)
+	require.NoError(t, err)
+	_, err = tc.DB.Exec(tc.DB.Rebind(_insertShardStatsQuery), tc.Namespace, executorID, payload)
+	require.NoError(t, err)
+}
+
+func greedyConfig() *config.Config {
+	return &config.Config{
+		LoadBalancingMode: func(namespace string) string {
+			return config.LoadBalancingModeGREEDY
+		},
+		MaxEtcdTxnOps: dynamicproperties.GetIntPropertyFn(128),
+	}
+}
+
+func createStore(t *testing.T, tc *testhelper.StoreTestCluster) store.Store {
+	t.Helper()
+	return createStoreWithTimeSource(t, tc, clock.NewMockedTimeSourceAt(time.Now()))
+}
+
+func createStoreWithTimeSource(t *testing.T, tc *testhelper.StoreTestCluster, timeSource clock.MockedTimeSource) store.Store {
+	t.Helper()
+	return createStoreWithParams(t, tc, storetest.ExecutorStoreParams{
+		TimeSource: timeSource,
+		Config: &config.Config{
+			LoadBalancingMode: func(namespace string) string { return config.LoadBalancingModeNAIVE },
+			MaxEtcdTxnOps:     dynamicproperties.GetIntPropertyFn(128),
+		},
+	})
+}
+
+func createStoreWithParams(t *testing.T, tc *testhelper.StoreTestCluster, params storetest.ExecutorStoreParams) store.Store {
+	t.Helper()
+
+	sqlConfig, err := sqlclient.NewExecutorStoreConfig(tc.SDConfig)
+	require.NoError(t, err)
+	sqlConfig.PollInterval = time.Second
+
+	lifecycle := fxtest.NewLifecycle(t)
+	store, err := NewStore(ExecutorStoreParams{
+		DB:            tc.DB,
+		SQLConfig:     sqlConfig,
+		Lifecycle:     lifecycle,
+		Logger:        testlogger.New(t),
+		TimeSource:    params.TimeSource,
+		MetricsClient: metrics.NewNoopMetricsClient(),
+		Config:        params.Config,
+	})
+	require.NoError(t, err)
+	lifecycle.RequireStart()
+	t.Cleanup(lifecycle.RequireStop)
+	return store
+}
@@ -0,0 +1,295 @@
+package leaderstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/fx"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/service/sharddistributor/store"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/sqlclient"
+)
+
+const (
+	// defaultElectionTTL is the default time-to-live of a leadership.
+	// If the leader does not renew it within this time, it will lose leadership.
+	defaultElectionTTL = 10 * time.Second
+)
+
+const (
+	_getLeaderQuery    = `SELECT leader_id, term, expires_at FROM shard_distributor_leaders WHERE namespace = ?`
+	_insertLeaderQuery = `INSERT INTO shard_distributor_leaders (namespace, leader_id, hostname, term, expires_at) VALUES (?, ?, ?, 1, ?)`
+	_updateLeaderQuery = `UPDATE shard_distributor_leaders SET leader_id = ?, hostname = ?, term = term + 1, expires_at = ? WHERE namespace = ? AND term = ?`
+	_renewLeaderQuery  = `UPDATE shard_distributor_leaders SET expires_at = ? WHERE namespace = ? AND leader_id = ? AND term = ?`
+	_deleteLeaderQuery = `DELETE FROM shard_distributor_leaders WHERE namespace = ? AND leader_id = ? AND term = ?`
+)
+
+type LeaderStore struct {
+	db         *sqlx.DB
+	config     sqlclient.LeaderStoreConfig
+	timeSource clock.TimeSource
+	logger     log.Logger
+}
+
+// StoreParams defines the dependencies for the SQL store, for use with fx.
+type StoreParams struct {
+	fx.In
+
+	DB         *sqlx.DB `name:"leaderstore"`
+	Cfg        sqlclient.LeaderStoreConfig
+	Logger     log.Logger
+	TimeSource clock.TimeSource `optional:"true"`
+}
+
+// leaderRow is a row of shard_distributor_leaders.
+type leaderRow struct {
+	LeaderID  string `db:"leader_id"`
+	Term      int64  `db:"term"`
+	ExpiresAt int64  `db:"expires_at"`
+}
+
+// NewLeaderStore creates a new leaderstore backed by a SQL database.
+// Leadership of a namespace is a row holding the leader and an expiry, which the leader keeps renewing.
+func NewLeaderStore(p StoreParams) (store.Elector, error) {
+	cfg := p.Cfg
+	if cfg.ElectionTTL == 0 {
+		cfg.ElectionTTL = defaultElectionTTL
+	}
+
+	timeSource := p.TimeSource
+	if timeSource == nil {
+		timeSource = clock.NewRealTimeSource()
+	}
+
+	return &LeaderStore{
+		db:         p.DB,
+		config:     cfg,
+		timeSource: timeSource,
+		logger:     p.Logger,
+	}, nil
+}
+
+func (ls *LeaderStore) CreateElection(ctx context.Context, namespace string) (store.Election, error) {
+	return &election{
+		db:         ls.db,
+		namespace:  namespace,
+		id:         uuid.New().String(),
+		ttl:        ls.config.ElectionTTL,
+		timeSource: ls.timeSource,
+		logger:     ls.logger,
+		done:       make(chan struct{}),
+	}, nil
+}
+
+// election campaigns for the leadership of a namespace. Once elected it renews the leadership
+// every third of the TTL, and closes Done when the leadership is lost or the election is cleaned up.
+type election struct {
+	db         *sqlx.DB
+	namespace  string
+	id         string
+	ttl        time.Duration
+	timeSource clock.TimeSource
+	logger     log.Logger
+
+	mu            sync.Mutex
+	term          int64
+	stopKeepAlive chan struct{}
+	wg            sync.WaitGroup
+	done          chan struct{}
+	doneOnce      sync.Once
+}
+
+func (e *election) Campaign(ctx context.Context, host string) error {
+	ticker := e.timeSource.NewTicker(e.ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-e.done:
+			return fmt.Errorf("election session closed")
+		default:
+		}
+
+		term, err := e.tryAcquire(ctx, host)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			e.logger.Warn("failed to campaign for leadership, retrying", tag.ShardNamespace(e.namespace), tag.Error(err))
+		}
+		if term > 0 {
+			e.startKeepAlive(term)
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-e.done:
+			return fmt.Errorf("election session closed")
+		case <-ticker.Chan():
+		}
+	}
+}
+
+func (e *election) Resign(ctx context.Context) error {
+	term := e.stopLeading()
+	if term == 0 {
+		return nil
+	}
+	if _, err := e.db.ExecContext(ctx, e.db.Rebind(_deleteLeaderQuery), e.namespace, e.id, term); err != nil {
+		return fmt.Errorf("resign: %w", err)
+	}
+	return nil
+}
+
+func (e *election) Cleanup(ctx context.Context) error {
+	defer e.closeDone()
+	if err := e.Resign(ctx); err != nil {
+		return fmt.Errorf("close session: %w", err)
+	}
+	return nil
+}
+
+func (e *election) Done() <-chan struct{} {
+	return e.done
+}
+
+func (e *election) Guard() store.GuardFunc {
+	e.mu.Lock()
+	term := e.term
+	e.mu.Unlock()
+
+	return func(txn store.Txn) (store.Txn, error) {
+		// The guard receives the generic Txn and asserts it to the concrete type it expects.
+		sqlTxn, ok := txn.(*sqlclient.Txn)
+		if !ok {
+			return nil, fmt.Errorf("invalid transaction type for sql guard: expected *sqlclient.Txn, got %T", txn)
+		}
+		// It applies the sql-specific condition and returns the modified generic Txn.
+		return sqlTxn.If(func(ctx context.Context, tx *sqlx.Tx) (bool, error) {
+			var row leaderRow
+			err := tx.GetContext(ctx, &row, tx.Rebind(_getLeaderQuery+sqlclient.ForUpdate(e.db)), e.namespace)
+			if errors.Is(err, sql.ErrNoRows) {
+				return false, nil
+			}
+			if err != nil {
+				return false, fmt.Errorf("get leader: %w", err)
+			}
+			return row.LeaderID == e.id && row.Term == term && row.ExpiresAt > e.timeSource.Now().UnixNano(), nil
+		}), nil
+	}
+}
+
+// tryAcquire takes the leadership if nobody holds it or the current leadership expired.
+// It returns the term of the new leadership, or 0 if it is held by someone else.
+func (e *election) tryAcquire(ctx context.Context, host string) (int64, error) {
+	var term int64
+	err := sqlclient.RunInTx(ctx, e.db, func(tx *sqlx.Tx) error {
+		now := e.timeSource.Now()
+		expiresAt := now.Add(e.ttl).UnixNano()
+
+		var row leaderRow
+		err := tx.GetContext(ctx, &row, tx.Rebind(_getLeaderQuery+sqlclient.ForUpdate(e.db)), e.namespace)
+		if errors.Is(err, sql.ErrNoRows) {
+			if _, err := tx.ExecContext(ctx, tx.Rebind(_insertLeaderQuery), e.namespace, e.id, host, expiresAt); err != nil {
+				return fmt.Errorf("insert leader: %w", err)
+			}
+			term = 1
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("get leader: %w", err)
+		}
+		if row.ExpiresAt > now.UnixNano() {
+			return nil
+		}
+
+		result, err := tx.ExecContext(ctx, tx.Rebind(_updateLeaderQuery), e.id, host, expiresAt, e.namespace, row.Term)
+		if err != nil {
+			return fmt.Errorf("update leader: %w", err)
+		}
+		if rowsAffected, err := result.RowsAffected(); err != nil || rowsAffected == 0 {
+			return fmt.Errorf("update leader: leadership changed concurrently")
+		}
+		term = row.Term + 1
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return term, nil
+}
+
+func (e *election) startKeepAlive(term int64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.term = term
+	e.stopKeepAlive = make(chan struct{})
+	e.wg.Add(1)
+	go e.keepAlive(term, e.stopKeepAlive)
+}
+
+// stopLeading stops renewing the leadership and returns its term, or 0 if not leading.
+func (e *election) stopLeading() int64 {
+	e.mu.Lock()
+	term, stop := e.term, e.stopKeepAlive
+	e.term, e.stopKeepAlive = 0, nil
+	e.mu.Unlock()
+
+	if stop != nil {
+		close(stop)
+		e.wg.Wait()
+	}
+	return term
+}
+
+func (e *election) keepAlive(term int64, stop <-chan struct{}) {
+	defer e.wg.Done()
+
+	ticker := e.timeSource.NewTicker(e.ttl / 3)
+	defer ticker.Stop()
+
+	expiresAt := e.timeSource.Now().Add(e.ttl)
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.Chan():
+		}
+
+		now := e.timeSource.Now()
+		result, err := e.db.Exec(e.db.Rebind(_renewLeaderQuery), now.Add(e.ttl).UnixNano(), e.namespace, e.id, term)
+		if err == nil {
+			var rowsAffected int64
+			if rowsAffected, err = result.RowsAffected(); err == nil && rowsAffected == 0 {
+				e.logger.Warn("leadership lost", tag.ShardNamespace(e.namespace))
+				e.closeDone()
+				return
+			}
+		}
+		if err != nil {
+			e.logger.Warn("failed to renew leadership", tag.ShardNamespace(e.namespace), tag.Error(err))
+			if !now.Before(expiresAt) {
+				e.closeDone()
+				return
+			}
+			continue
+		}
+		expiresAt = now.Add(e.ttl)
+	}
+}
+
+func (e *election) closeDone() {
+	e.doneOnce.Do(func() { close(e.done) })
+}
@@ -0,0 +1,316 @@
+package leaderstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/service/sharddistributor/store"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/sqlclient"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/testhelper"
+)
+
+type leaderStoreSuite struct {
+	suite.Suite
+	pluginName string
+}
+
+func TestSQLiteLeaderStore(t *testing.T) {
+	suite.Run(t, &leaderStoreSuite{pluginName: sqlclient.PluginSQLite})
+}
+
+func TestMySQLLeaderStore(t *testing.T) {
+	suite.Run(t, &leaderStoreSuite{pluginName: sqlclient.PluginMySQL})
+}
+
+func TestPostgresLeaderStore(t *testing.T) {
+	suite.Run(t, &leaderStoreSuite{pluginName: sqlclient.PluginPostgres})
+}
+
+// TestCreateElection tests that an election can be created successfully
+func (s *leaderStoreSuite) TestCreateElection() {
+	t := s.T()
+	tc := setupTestCluster(t, s.pluginName, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	elect, err := tc.store.CreateElection(ctx, "test-namespace")
+	require.NoError(t, err)
+	require.NotNil(t, elect)
+
+	// Clean up
+	err = elect.Cleanup(ctx)
+	require.NoError(t, err)
+}
+
+// TestCampaign tests that a node can campaign for leadership
+func (s *leaderStoreSuite) TestCampaign() {
+	t := s.T()
+	tc := setupTestCluster(t, s.pluginName, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-namespace-campaign"
+	election, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election.Cleanup(ctx)
+
+	// Start campaigning for leadership
+	err = election.Campaign(ctx, "test-host-1")
+	require.NoError(t, err)
+
+	// Verify leadership was obtained by checking the leader row
+	var hostname string
+	err = tc.db.Get(&hostname, tc.db.Rebind(`SELECT hostname FROM shard_distributor_leaders WHERE namespace = ?`), namespace)
+	require.NoError(t, err, "Leader row should exist")
+	assert.Equal(t, "test-host-1", hostname)
+}
+
+// TestResign tests resigning leadership
+func (s *leaderStoreSuite) TestResign() {
+	t := s.T()
+	tc := setupTestCluster(t, s.pluginName, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-namespace-resign"
+	election, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election.Cleanup(ctx)
+
+	// Start campaigning for leadership
+	err = election.Campaign(ctx, "test-host-1")
+	require.NoError(t, err)
+
+	// Resign the leadership
+	err = election.Resign(ctx)
+	require.NoError(t, err)
+
+	// Verify leadership was resigned by checking that someone else can become leader
+	election2, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election2.Cleanup(ctx)
+
+	err = election2.Campaign(ctx, "host-2")
+	require.NoError(t, err, "Second host should be able to become leader after first resigned")
+}
+
+// TestMultipleNodes tests multiple nodes competing for leadership
+func (s *leaderStoreSuite) TestMultipleNodes() {
+	t := s.T()
+	tc := setupTestCluster(t, s.pluginName, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	namespace := "test-namespace-multiple"
+
+	election1, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election1.Cleanup(ctx)
+
+	election2, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election2.Cleanup(ctx)
+
+	// First node campaigns
+	err = election1.Campaign(ctx, "host1")
+	require.NoError(t, err)
+
+	// Second node campaigns - this should block as first node already has leadership
+	ctxTimeout, cancelTimeout := context.WithTimeout(ctx, 1*time.Second)
+	defer cancelTimeout()
+
+	campaignErr := make(chan error, 1)
+	go func() {
+		campaignErr <- election2.Campaign(ctxTimeout, "host2")
+	}()
+
+	select {
+	case err := <-campaignErr:
+		require.Error(t, err, "Second node should not have been able to become leader while first node holds leadership")
+		require.Contains(t, err.Error(), "context deadline exceeded", "Expected a context deadline error")
+	case <-time.After(2 * time.Second):
+		t.Error("Expected the second campaign to timeout quickly")
+	}
+
+	// First node resigns
+	err = election1.Resign(ctx)
+	require.NoError(t, err)
+
+	// Now a third node should be able to become leader
+	election3, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election3.Cleanup(ctx)
+
+	err = election3.Campaign(ctx, "host3")
+	require.NoError(t, err, "Third host should be able to become leader after first host resigned")
+}
+
+// TestExpiredLeadership tests that an expired leadership can be taken over
+func (s *leaderStoreSuite) TestExpiredLeadership() {
+	t := s.T()
+	tc := setupTestCluster(t, s.pluginName, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-namespace-expired"
+
+	// A leader that crashed without resigning
+	_, err := tc.db.Exec(tc.db.Rebind(_insertLeaderQuery), namespace, "crashed-leader", "crashed-host", time.Now().Add(-time.Second).UnixNano())
+	require.NoError(t, err)
+
+	election, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election.Cleanup(ctx)
+
+	err = election.Campaign(ctx, "host-1")
+	require.NoError(t, err)
+
+	var row leaderRow
+	require.NoError(t, tc.db.Get(&row, tc.db.Rebind(_getLeaderQuery), namespace))
+	assert.Equal(t, int64(2), row.Term)
+}
+
+// TestSessionDone tests the Done channel behavior
+func (s *leaderStoreSuite) TestSessionDone() {
+	t := s.T()
+	tc := setupTestCluster(t, s.pluginName, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	election, err := tc.store.CreateElection(ctx, "test-namespace-done")
+	require.NoError(t, err)
+
+	doneCh := election.Done()
+	require.NotNil(t, doneCh)
+
+	// Clean up should close the session, which should close the Done channel
+	err = election.Cleanup(ctx)
+	require.NoError(t, err)
+
+	select {
+	case <-doneCh:
+		// Expected - channel should be closed
+	case <-time.After(2 * time.Second):
+		t.Error("Done channel should be closed after Cleanup")
+	}
+}
+
+// TestLeadershipLost tests that Done is closed once the leadership can no longer be renewed
+func (s *leaderStoreSuite) TestLeadershipLost() {
+	t := s.T()
+	timeSource := clock.NewMockedTimeSourceAt(time.Now())
+	tc := setupTestCluster(t, s.pluginName, timeSource)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-namespace-lost"
+	election, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election.Cleanup(ctx)
+
+	require.NoError(t, election.Campaign(ctx, "host-1"))
+
+	// Renewing the leadership keeps the election open
+	timeSource.BlockUntil(1)
+	timeSource.Advance(tc.ttl / 3)
+	timeSource.BlockUntil(1)
+	select {
+	case <-election.Done():
+		t.Fatal("Done channel should not be closed while the leadership is renewed")
+	default:
+	}
+
+	// Someone else removes the leadership
+	_, err = tc.db.Exec(tc.db.Rebind(`DELETE FROM shard_distributor_leaders WHERE namespace = ?`), namespace)
+	require.NoError(t, err)
+	timeSource.Advance(tc.ttl / 3)
+
+	select {
+	case <-election.Done():
+		// Expected - the renewal failed
+	case <-time.After(2 * time.Second):
+		t.Error("Done channel should be closed after the leadership is lost")
+	}
+}
+
+// TestGuard tests that the guard only holds for the current leader
+func (s *leaderStoreSuite) TestGuard() {
+	t := s.T()
+	tc := setupTestCluster(t, s.pluginName, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-namespace-guard"
+	election, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election.Cleanup(ctx)
+
+	checkGuard := func(guard store.GuardFunc) bool {
+		t.Helper()
+		txn, err := guard(sqlclient.NewTxn())
+		require.NoError(t, err)
+
+		var ok bool
+		require.NoError(t, sqlclient.RunInTx(ctx, tc.db, func(tx *sqlx.Tx) error {
+			ok, err = txn.(*sqlclient.Txn).Check(ctx, tx)
+			return err
+		}))
+		return ok
+	}
+
+	assert.False(t, checkGuard(election.Guard()), "Guard should not hold before campaigning")
+
+	require.NoError(t, election.Campaign(ctx, "host-1"))
+	guard := election.Guard()
+	assert.True(t, checkGuard(guard), "Guard should hold for the leader")
+
+	require.NoError(t, election.Resign(ctx))
+	assert.False(t, checkGuard(guard), "Guard should not hold after resigning")
+
+	_, err = guard("not a transaction")
+	require.Error(t, err)
+}
+
+type testCluster struct {
+	store store.Elector
+	db    *sqlx.DB
+	ttl   time.Duration
+}
+
+func setupTestCluster(t *testing.T, pluginName string, timeSource clock.TimeSource) *testCluster {
+	t.Helper()
+
+	tc := testhelper.SetupStoreTestCluster(t, pluginName)
+	cfg, err := sqlclient.NewLeaderStoreConfig(tc.SDConfig)
+	require.NoError(t, err)
+
+	elector, err := NewLeaderStore(StoreParams{
+		DB:         tc.DB,
+		Cfg:        cfg,
+		Logger:     testlogger.New(t),
+		TimeSource: timeSource,
+	})
+	require.NoError(t, err)
+
+	return &testCluster{
+		store: elector,
+		db:    tc.DB,
+		ttl:   cfg.ElectionTTL,
+	}
+}
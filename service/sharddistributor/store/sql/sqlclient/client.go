@@ -0,0 +1,115 @@
+package sqlclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/fx"
+
+	"github.com/uber/cadence/common/persistence/sql"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin/mysql"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin/postgres"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin/sqlite"
+)
+
+const (
+	PluginMySQL    = mysql.PluginName
+	PluginPostgres = postgres.PluginName
+	PluginSQLite   = sqlite.PluginName
+
+	// driverSQLite is the database/sql driver name used by the sqlite plugin.
+	driverSQLite = "sqlite3"
+	// sqliteTxLockAttr makes SQLite take the write lock when a transaction begins, so concurrent
+	// read-then-write transactions wait on the busy timeout instead of failing on lock upgrade.
+	sqliteTxLockAttr = "_txlock"
+)
+
+// NewDBFromConfig opens a new connection pool from configuration.
+func NewDBFromConfig(cfg BaseConfig, lc fx.Lifecycle) (*sqlx.DB, error) {
+	db, err := NewDB(cfg)
+	if err != nil {
+		return nil, err
+	}
+	lc.Append(fx.StopHook(db.Close))
+	return db, nil
+}
+
+// NewDB opens a new connection pool through the SQL persistence plugin of cfg,
+// the caller is responsible for closing it.
+func NewDB(cfg BaseConfig) (*sqlx.DB, error) {
+	sqlCfg := cfg.SQL
+	if sqlCfg.PluginName == PluginSQLite {
+		attrs := make(map[string]string, len(sqlCfg.ConnectAttributes)+1)
+		for k, v := range sqlCfg.ConnectAttributes {
+			attrs[k] = v
+		}
+		if _, ok := attrs[sqliteTxLockAttr]; !ok {
+			attrs[sqliteTxLockAttr] = "immediate"
+		}
+		sqlCfg.ConnectAttributes = attrs
+	}
+	db, err := sql.NewSQLDBConn(&sqlCfg)
+	if err != nil {
+		return nil, fmt.Errorf("connect to %s: %w", cfg.PluginName, err)
+	}
+	return db, nil
+}
+
+// ForUpdate returns the row locking clause for the driver of db.
+// SQLite serializes write transactions, so it does not need one.
+func ForUpdate(db *sqlx.DB) string {
+	if db.DriverName() == driverSQLite {
+		return ""
+	}
+	return " FOR UPDATE"
+}
+
+// RunInTx runs fn in a transaction, which is committed if fn succeeds and rolled back otherwise.
+func RunInTx(ctx context.Context, db *sqlx.DB, fn func(tx *sqlx.Tx) error) error {
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Condition is a precondition evaluated inside a transaction before any write is applied.
+// It returns false if the precondition does not hold.
+type Condition func(ctx context.Context, tx *sqlx.Tx) (bool, error)
+
+// Txn is the transaction handed to store.GuardFunc by the SQL stores.
+// Guards add their preconditions with If, the store evaluates them with Check
+// inside the database transaction that applies the guarded writes.
+type Txn struct {
+	conditions []Condition
+}
+
+// NewTxn creates a Txn without preconditions.
+func NewTxn() *Txn {
+	return &Txn{}
+}
+
+// If adds preconditions to the transaction.
+func (t *Txn) If(conditions ...Condition) *Txn {
+	t.conditions = append(t.conditions, conditions...)
+	return t
+}
+
+// Check evaluates all preconditions in tx, it returns false as soon as one of them does not hold.
+func (t *Txn) Check(ctx context.Context, tx *sqlx.Tx) (bool, error) {
+	for _, condition := range t.conditions {
+		ok, err := condition(ctx, tx)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
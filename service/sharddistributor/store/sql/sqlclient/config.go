@@ -0,0 +1,67 @@
+package sqlclient
+
+import (
+	"fmt"
+	"time"
+
+	commonConfig "github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/service/sharddistributor/config"
+)
+
+// BaseConfig has common SQL connection settings.
+// They are the settings of the SQL persistence plugins, the connection is opened by the plugin named by PluginName.
+type BaseConfig struct {
+	commonConfig.SQL `yaml:",inline"`
+}
+
+// ExecutorStoreConfig extends BaseConfig with executor-specific settings.
+type ExecutorStoreConfig struct {
+	BaseConfig `yaml:",inline"`
+	// PollInterval is how often subscriptions poll the database for changes.
+	PollInterval time.Duration `yaml:"pollInterval"`
+}
+
+// LeaderStoreConfig extends BaseConfig with leader-specific settings.
+type LeaderStoreConfig struct {
+	BaseConfig  `yaml:",inline"`
+	ElectionTTL time.Duration `yaml:"electionTTL"`
+}
+
+// NewExecutorStoreConfig parses ExecutorStoreConfig from ShardDistribution config.
+func NewExecutorStoreConfig(cfg config.ShardDistribution) (ExecutorStoreConfig, error) {
+	var out ExecutorStoreConfig
+	if err := cfg.Store.StorageParams.Decode(&out); err != nil {
+		return out, fmt.Errorf("bad config for executor store: %w", err)
+	}
+	if err := out.validate(); err != nil {
+		return out, fmt.Errorf("bad config for executor store: %w", err)
+	}
+	return out, nil
+}
+
+// NewLeaderStoreConfig parses LeaderStoreConfig from ShardDistribution config.
+func NewLeaderStoreConfig(cfg config.ShardDistribution) (LeaderStoreConfig, error) {
+	var out LeaderStoreConfig
+	if err := cfg.LeaderStore.StorageParams.Decode(&out); err != nil {
+		return out, fmt.Errorf("bad config for leader store: %w", err)
+	}
+	if err := out.validate(); err != nil {
+		return out, fmt.Errorf("bad config for leader store: %w", err)
+	}
+	return out, nil
+}
+
+func (c BaseConfig) validate() error {
+	switch c.PluginName {
+	case PluginMySQL, PluginPostgres, PluginSQLite:
+	default:
+		return fmt.Errorf("unsupported pluginName %q", c.PluginName)
+	}
+	if c.UseMultipleDatabases {
+		return fmt.Errorf("useMultipleDatabases is not supported")
+	}
+	if c.PluginName != PluginSQLite && c.ConnectAddr == "" {
+		return fmt.Errorf("connectAddr is required")
+	}
+	return nil
+}
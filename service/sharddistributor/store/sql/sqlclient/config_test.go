@@ -0,0 +1,106 @@
+package sqlclient
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+
+	commonConfig "github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/service/sharddistributor/config"
+)
+
+func TestNewExecutorStoreConfig(t *testing.T) {
+	expected := ExecutorStoreConfig{
+		BaseConfig: BaseConfig{SQL: commonConfig.SQL{
+			PluginName:   PluginMySQL,
+			User:         "user",
+			Password:     "password",
+			ConnectAddr:  "127.0.0.1:3306",
+			DatabaseName: "cadence_sharddistributor",
+			MaxConns:     10,
+		}},
+		PollInterval: time.Second,
+	}
+
+	sdConfig := config.ShardDistribution{
+		Store: config.Store{StorageParams: createYamlNode(t, map[string]any{
+			"pluginName":   PluginMySQL,
+			"user":         "user",
+			"password":     "password",
+			"connectAddr":  "127.0.0.1:3306",
+			"databaseName": "cadence_sharddistributor",
+			"maxConns":     10,
+			"pollInterval": "1s",
+		})},
+	}
+	result, err := NewExecutorStoreConfig(sdConfig)
+
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+}
+
+func TestNewExecutorStoreConfig_InvalidConfig(t *testing.T) {
+	tests := map[string]*config.YamlNode{
+		"empty config":            createYamlNode(t, ""),
+		"unsupported plugin":      createYamlNode(t, map[string]any{"pluginName": "cassandra", "connectAddr": "127.0.0.1:9042"}),
+		"missing connect address": createYamlNode(t, map[string]any{"pluginName": PluginPostgres}),
+		"multiple databases":      createYamlNode(t, map[string]any{"pluginName": PluginMySQL, "connectAddr": "127.0.0.1:3306", "useMultipleDatabases": true}),
+	}
+	for name, node := range tests {
+		t.Run(name, func(t *testing.T) {
+			sdConfig := config.ShardDistribution{
+				Store: config.Store{StorageParams: node},
+			}
+
+			_, err := NewExecutorStoreConfig(sdConfig)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestNewLeaderStoreConfig(t *testing.T) {
+	expected := LeaderStoreConfig{
+		BaseConfig: BaseConfig{SQL: commonConfig.SQL{
+			PluginName:   PluginPostgres,
+			ConnectAddr:  "127.0.0.1:5432",
+			DatabaseName: "cadence_sharddistributor",
+		}},
+		ElectionTTL: 10 * time.Second,
+	}
+
+	sdConfig := config.ShardDistribution{
+		LeaderStore: config.Store{StorageParams: createYamlNode(t, map[string]any{
+			"pluginName":   PluginPostgres,
+			"connectAddr":  "127.0.0.1:5432",
+			"databaseName": "cadence_sharddistributor",
+			"electionTTL":  "10s",
+		})},
+	}
+	result, err := NewLeaderStoreConfig(sdConfig)
+
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+}
+
+func TestNewLeaderStoreConfig_InvalidConfig(t *testing.T) {
+	sdConfig := config.ShardDistribution{
+		LeaderStore: config.Store{StorageParams: createYamlNode(t, "")},
+	}
+
+	_, err := NewLeaderStoreConfig(sdConfig)
+	require.Error(t, err)
+}
+
+func createYamlNode(t *testing.T, v any) *config.YamlNode {
+	t.Helper()
+	encoded, err := yaml.Marshal(v)
+	require.NoError(t, err)
+
+	var node *config.YamlNode
+	err = yaml.Unmarshal(encoded, &node)
+	require.NoError(t, err)
+
+	return node
+}
@@ -0,0 +1,157 @@
+package testhelper
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+
+	commonConfig "github.com/uber/cadence/common/config"
+	persistencetests "github.com/uber/cadence/common/persistence/persistence-tests"
+	"github.com/uber/cadence/common/persistence/sql"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin/mysql"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin/postgres"
+	mysqlschema "github.com/uber/cadence/schema/mysql"
+	postgresschema "github.com/uber/cadence/schema/postgres"
+	sqliteschema "github.com/uber/cadence/schema/sqlite"
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/sqlclient"
+	"github.com/uber/cadence/testflags"
+)
+
+type StoreTestCluster struct {
+	Namespace string
+	SDConfig  config.ShardDistribution
+	DB        *sqlx.DB
+}
+
+// SetupStoreTestCluster creates a database with the shard distributor schema for the given SQL plugin.
+// SQLite databases are created in a temporary directory, MySQL and Postgres databases are created on the
+// servers of the persistence tests and dropped when the test finishes. Those tests are skipped unless
+// MYSQL=1 or POSTGRES=1 is set.
+func SetupStoreTestCluster(t *testing.T, pluginName string) *StoreTestCluster {
+	t.Helper()
+
+	namespace := fmt.Sprintf("ns-%s", strings.ToLower(t.Name()))
+
+	sqlConfig := setupDatabase(t, pluginName)
+
+	sqlConfigRaw := map[string]interface{}{
+		"pluginName":   sqlConfig.PluginName,
+		"user":         sqlConfig.User,
+		"password":     sqlConfig.Password,
+		"connectAddr":  sqlConfig.ConnectAddr,
+		"databaseName": sqlConfig.DatabaseName,
+		"pollInterval": "100ms",
+		"electionTTL":  "3s", // Needed for leader config part
+	}
+
+	yamlCfg, err := yaml.Marshal(sqlConfigRaw)
+	require.NoError(t, err)
+	var yamlNode *config.YamlNode
+	err = yaml.Unmarshal(yamlCfg, &yamlNode)
+	require.NoError(t, err)
+
+	sdConfig := config.ShardDistribution{
+		Store:       config.Store{Type: config.StoreTypeSQL, StorageParams: yamlNode},
+		LeaderStore: config.Store{Type: config.StoreTypeSQL, StorageParams: yamlNode},
+	}
+
+	db, err := sqlclient.NewDB(sqlclient.BaseConfig{SQL: sqlConfig})
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	schema, err := readSchema(pluginName)
+	require.NoError(t, err)
+	for _, stmt := range strings.Split(string(schema), ";") {
+		if strings.TrimSpace(stripComments(stmt)) == "" {
+			continue
+		}
+		_, err := db.Exec(stmt)
+		require.NoError(t, err)
+	}
+
+	return &StoreTestCluster{
+		Namespace: namespace,
+		SDConfig:  sdConfig,
+		DB:        db,
+	}
+}
+
+// setupDatabase creates an empty database and returns the configuration to connect to it.
+func setupDatabase(t *testing.T, pluginName string) commonConfig.SQL {
+	t.Helper()
+
+	var options *persistencetests.TestBaseOptions
+	switch pluginName {
+	case sqlclient.PluginSQLite:
+		return commonConfig.SQL{
+			PluginName:   pluginName,
+			DatabaseName: filepath.Join(t.TempDir(), "sharddistributor.db"),
+		}
+	case sqlclient.PluginMySQL:
+		testflags.RequireMySQL(t)
+		var err error
+		options, err = mysql.GetTestClusterOption()
+		require.NoError(t, err)
+	case sqlclient.PluginPostgres:
+		testflags.RequirePostgres(t)
+		var err error
+		options, err = postgres.GetTestClusterOption()
+		require.NoError(t, err)
+	default:
+		t.Fatalf("unsupported plugin %q", pluginName)
+	}
+
+	sqlConfig := commonConfig.SQL{
+		PluginName:      pluginName,
+		User:            options.DBUsername,
+		Password:        options.DBPassword,
+		ConnectAddr:     fmt.Sprintf("%v:%v", options.DBHost, options.DBPort),
+		ConnectProtocol: "tcp",
+	}
+
+	// The admin connection needs an empty database name to create and drop databases
+	adminDB, err := sql.NewSQLAdminDB(&sqlConfig)
+	require.NoError(t, err)
+	defer adminDB.Close()
+
+	sqlConfig.DatabaseName = "sd_" + persistencetests.GenerateRandomDBName(10)
+	require.NoError(t, adminDB.CreateDatabase(sqlConfig.DatabaseName))
+
+	adminConfig := sqlConfig
+	adminConfig.DatabaseName = ""
+	t.Cleanup(func() {
+		adminDB, err := sql.NewSQLAdminDB(&adminConfig)
+		require.NoError(t, err)
+		defer adminDB.Close()
+		require.NoError(t, adminDB.DropDatabase(sqlConfig.DatabaseName))
+	})
+
+	return sqlConfig
+}
+
+func readSchema(pluginName string) ([]byte, error) {
+	switch pluginName {
+	case sqlclient.PluginMySQL:
+		return mysqlschema.SchemaFS.ReadFile("v8/sharddistributor/schema.sql")
+	case sqlclient.PluginPostgres:
+		return postgresschema.SchemaFS.ReadFile("sharddistributor/schema.sql")
+	default:
+		return sqliteschema.SchemaFS.ReadFile("sharddistributor/schema.sql")
+	}
+}
+
+func stripComments(stmt string) string {
+	var lines []string
+	for _, line := range strings.Split(stmt, "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), "--") {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
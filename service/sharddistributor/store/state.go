@@ -39,6 +39,9 @@ type ShardHandoverStats struct {
 
 	// HandoverType indicates the type of handover that occurred during the last shard reassignment.
 	HandoverType types.HandoverType
+
+	// PreviousExecutorID is the executor that owned the shard before the last shard reassignment.
+	PreviousExecutorID string
 }
 
 type NamespaceState struct {
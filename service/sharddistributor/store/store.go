@@ -73,6 +73,11 @@ type Store interface {
 
 	// SubscribeToExecutorStatusChanges subscribes to changes of executors' status key within a namespace.
 	SubscribeToExecutorStatusChanges(ctx context.Context, namespace string) (<-chan int64, error)
+
+	// TriggerRebalance notifies the subscribers of SubscribeToExecutorStatusChanges,
+	// so the shards of the namespace are rebalanced without waiting for the next period.
+	TriggerRebalance(ctx context.Context, namespace string) error
+
 	DeleteExecutors(ctx context.Context, namespace string, executorIDs []string, guard GuardFunc) error
 
 	// DeleteAssignedStates deletes the assigned states of multiple executors within a namespace.
@@ -239,3 +239,17 @@ func (mr *MockStoreMockRecorder) SubscribeToExecutorStatusChanges(ctx, namespace
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToExecutorStatusChanges", reflect.TypeOf((*MockStore)(nil).SubscribeToExecutorStatusChanges), ctx, namespace)
 }
+
+// TriggerRebalance mocks base method.
+func (m *MockStore) TriggerRebalance(ctx context.Context, namespace string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerRebalance", ctx, namespace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TriggerRebalance indicates an expected call of TriggerRebalance.
+func (mr *MockStoreMockRecorder) TriggerRebalance(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerRebalance", reflect.TypeOf((*MockStore)(nil).TriggerRebalance), ctx, namespace)
+}
@@ -0,0 +1,122 @@
+package storefx
+
+import (
+	"fmt"
+
+	"go.uber.org/fx"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/store"
+	"github.com/uber/cadence/service/sharddistributor/store/etcd"
+	"github.com/uber/cadence/service/sharddistributor/store/etcd/etcdclient"
+	etcdexecutorstore "github.com/uber/cadence/service/sharddistributor/store/etcd/executorstore"
+	etcdleaderstore "github.com/uber/cadence/service/sharddistributor/store/etcd/leaderstore"
+	"github.com/uber/cadence/service/sharddistributor/store/sql"
+	sqlexecutorstore "github.com/uber/cadence/service/sharddistributor/store/sql/executorstore"
+	sqlleaderstore "github.com/uber/cadence/service/sharddistributor/store/sql/leaderstore"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/sqlclient"
+)
+
+// Module provides the shard distributor store and leader store selected by the type
+// of the store and leader store in the ShardDistribution config.
+// Only the selected implementations are created, so only their backends need to be reachable.
+var Module = fx.Module("store",
+	fx.Provide(NewStore),
+	fx.Provide(NewElector),
+)
+
+// Params defines the dependencies shared by the store implementations, for use with fx.
+type Params struct {
+	fx.In
+
+	SDConfig      config.ShardDistribution
+	Lifecycle     fx.Lifecycle
+	Logger        log.Logger
+	TimeSource    clock.TimeSource
+	Config        *config.Config
+	MetricsClient metrics.Client
+}
+
+// NewStore creates the executor store of the type configured in ShardDistribution.Store.
+func NewStore(p Params) (store.Store, error) {
+	switch storeType := p.SDConfig.Store.GetType(); storeType {
+	case config.StoreTypeEtcd:
+		cfg, err := etcdclient.NewExecutorStoreConfig(p.SDConfig)
+		if err != nil {
+			return nil, err
+		}
+		client, err := etcd.NewExecutorStoreClient(cfg, p.Lifecycle)
+		if err != nil {
+			return nil, err
+		}
+		return etcdexecutorstore.NewStore(etcdexecutorstore.ExecutorStoreParams{
+			Client:        client.Client,
+			ETCDConfig:    cfg,
+			Lifecycle:     p.Lifecycle,
+			Logger:        p.Logger,
+			TimeSource:    p.TimeSource,
+			Config:        p.Config,
+			MetricsClient: p.MetricsClient,
+		})
+	case config.StoreTypeSQL:
+		cfg, err := sqlclient.NewExecutorStoreConfig(p.SDConfig)
+		if err != nil {
+			return nil, err
+		}
+		db, err := sql.NewExecutorStoreDB(cfg, p.Lifecycle)
+		if err != nil {
+			return nil, err
+		}
+		return sqlexecutorstore.NewStore(sqlexecutorstore.ExecutorStoreParams{
+			DB:            db.DB,
+			SQLConfig:     cfg,
+			Lifecycle:     p.Lifecycle,
+			Logger:        p.Logger,
+			TimeSource:    p.TimeSource,
+			Config:        p.Config,
+			MetricsClient: p.MetricsClient,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported store type %q", storeType)
+	}
+}
+
+// NewElector creates the leader store of the type configured in ShardDistribution.LeaderStore.
+func NewElector(p Params) (store.Elector, error) {
+	switch storeType := p.SDConfig.LeaderStore.GetType(); storeType {
+	case config.StoreTypeEtcd:
+		cfg, err := etcdclient.NewLeaderStoreConfig(p.SDConfig)
+		if err != nil {
+			return nil, err
+		}
+		client, err := etcd.NewLeaderStoreClient(cfg, p.Lifecycle)
+		if err != nil {
+			return nil, err
+		}
+		return etcdleaderstore.NewLeaderStore(etcdleaderstore.StoreParams{
+			Client: client.Client,
+			Cfg:    cfg,
+			Logger: p.Logger,
+		})
+	case config.StoreTypeSQL:
+		cfg, err := sqlclient.NewLeaderStoreConfig(p.SDConfig)
+		if err != nil {
+			return nil, err
+		}
+		db, err := sql.NewLeaderStoreDB(cfg, p.Lifecycle)
+		if err != nil {
+			return nil, err
+		}
+		return sqlleaderstore.NewLeaderStore(sqlleaderstore.StoreParams{
+			DB:         db.DB,
+			Cfg:        cfg,
+			Logger:     p.Logger,
+			TimeSource: p.TimeSource,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported leader store type %q", storeType)
+	}
+}
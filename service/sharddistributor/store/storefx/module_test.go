@@ -0,0 +1,70 @@
+package storefx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx/fxtest"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/sqlclient"
+	"github.com/uber/cadence/service/sharddistributor/store/sql/testhelper"
+)
+
+func TestNewStoreAndElector_SQL(t *testing.T) {
+	tc := testhelper.SetupStoreTestCluster(t, sqlclient.PluginSQLite)
+	params := newParams(t, tc.SDConfig)
+
+	executorStore, err := NewStore(params)
+	require.NoError(t, err)
+	assert.NotNil(t, executorStore)
+
+	elector, err := NewElector(params)
+	require.NoError(t, err)
+	assert.NotNil(t, elector)
+}
+
+func TestNewStoreAndElector_UnsupportedType(t *testing.T) {
+	sdConfig := config.ShardDistribution{
+		Store:       config.Store{Type: "cassandra"},
+		LeaderStore: config.Store{Type: "cassandra"},
+	}
+	params := newParams(t, sdConfig)
+
+	_, err := NewStore(params)
+	assert.ErrorContains(t, err, `unsupported store type "cassandra"`)
+
+	_, err = NewElector(params)
+	assert.ErrorContains(t, err, `unsupported leader store type "cassandra"`)
+}
+
+func TestNewStoreAndElector_InvalidSQLConfig(t *testing.T) {
+	sdConfig := config.ShardDistribution{
+		Store:       config.Store{Type: config.StoreTypeSQL},
+		LeaderStore: config.Store{Type: config.StoreTypeSQL},
+	}
+	params := newParams(t, sdConfig)
+
+	_, err := NewStore(params)
+	assert.ErrorContains(t, err, "bad config for executor store")
+
+	_, err = NewElector(params)
+	assert.ErrorContains(t, err, "bad config for leader store")
+}
+
+func newParams(t *testing.T, sdConfig config.ShardDistribution) Params {
+	lifecycle := fxtest.NewLifecycle(t)
+	t.Cleanup(lifecycle.RequireStop)
+	return Params{
+		SDConfig:      sdConfig,
+		Lifecycle:     lifecycle,
+		Logger:        testlogger.New(t),
+		TimeSource:    clock.NewRealTimeSource(),
+		Config:        &config.Config{},
+		MetricsClient: metrics.NewNoopMetricsClient(),
+	}
+}
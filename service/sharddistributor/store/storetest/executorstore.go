@@ -0,0 +1,751 @@
+// Package storetest contains the tests every store.Store implementation has to pass.
+package storetest
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/store"
+)
+
+// ExecutorStoreParams are the parameters the suite creates the store under test with.
+type ExecutorStoreParams struct {
+	TimeSource clock.MockedTimeSource
+	Config     *config.Config
+}
+
+// ExecutorStoreTestCluster is a store under test together with the backend specific helpers the suite needs.
+type ExecutorStoreTestCluster struct {
+	// Namespace is a namespace without any data in the backend.
+	Namespace string
+	Store     store.Store
+	// Elector runs leader elections whose guards are accepted by Store.
+	Elector store.Elector
+	// WriteShardStatistics replaces the shard statistics of an executor directly in the backend.
+	WriteShardStatistics func(t *testing.T, executorID string, stats map[string]store.ShardStatistics)
+	// Poll makes the store pick up changes for its subscriptions.
+	// It is nil for stores that are notified of changes by the backend.
+	Poll func()
+}
+
+// ExecutorStoreSuite verifies the behavior of a store.Store implementation against its backend.
+type ExecutorStoreSuite struct {
+	suite.Suite
+
+	// NewTestCluster creates the store under test for a single test, it is called once per test and subtest.
+	NewTestCluster func(t *testing.T, params ExecutorStoreParams) *ExecutorStoreTestCluster
+}
+
+// TestRecordHeartbeat verifies that an executor's heartbeat is correctly stored.
+func (s *ExecutorStoreSuite) TestRecordHeartbeat() {
+	t := s.T()
+	tc, _ := s.setupTestCluster(t, config.LoadBalancingModeNAIVE)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Now().UTC()
+
+	executorID := "executor-TestRecordHeartbeat"
+	req := store.HeartbeatState{
+		LastHeartbeat: now,
+		Status:        types.ExecutorStatusACTIVE,
+		ReportedShards: map[string]*types.ShardStatusReport{
+			"shard-TestRecordHeartbeat": {Status: types.ShardStatusREADY},
+		},
+		Metadata: map[string]string{
+			"key-1": "value-1",
+			"key-2": "value-2",
+		},
+	}
+
+	err := tc.Store.RecordHeartbeat(ctx, tc.Namespace, executorID, req)
+	require.NoError(t, err)
+
+	hb, _, err := tc.Store.GetHeartbeat(ctx, tc.Namespace, executorID)
+	require.NoError(t, err)
+	assert.Equal(t, now, hb.LastHeartbeat)
+	assert.Equal(t, types.ExecutorStatusACTIVE, hb.Status)
+	require.Len(t, hb.ReportedShards, 1)
+	assert.Equal(t, types.ShardStatusREADY, hb.ReportedShards["shard-TestRecordHeartbeat"].Status)
+}
+
+func (s *ExecutorStoreSuite) TestGetHeartbeat() {
+	t := s.T()
+	tc, _ := s.setupTestCluster(t, config.LoadBalancingModeNAIVE)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Now().UTC()
+
+	executorID := "executor-get"
+	req := store.HeartbeatState{
+		Status:        types.ExecutorStatusDRAINING,
+		LastHeartbeat: now,
+	}
+
+	// 1. Record a heartbeat
+	err := tc.Store.RecordHeartbeat(ctx, tc.Namespace, executorID, req)
+	require.NoError(t, err)
+
+	// Assign shards to one executor
+	assignState := map[string]store.AssignedState{
+		executorID: {
+			AssignedShards: map[string]*types.ShardAssignment{
+				"shard-1": {Status: types.AssignmentStatusREADY},
+			},
+		},
+	}
+	require.NoError(t, tc.Store.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{
+		NewState: &store.NamespaceState{
+			ShardAssignments: assignState,
+		},
+	}, store.NopGuard()))
+
+	// 2. Get the heartbeat back
+	hb, assignedFromDB, err := tc.Store.GetHeartbeat(ctx, tc.Namespace, executorID)
+	require.NoError(t, err)
+	require.NotNil(t, hb)
+
+	// 3. Verify the state
+	assert.Equal(t, types.ExecutorStatusDRAINING, hb.Status)
+	assert.Equal(t, now, hb.LastHeartbeat)
+	require.NotNil(t, assignedFromDB.AssignedShards)
+	assert.Equal(t, assignState[executorID].AssignedShards, assignedFromDB.AssignedShards)
+	assert.Greater(t, assignedFromDB.ModRevision, int64(0))
+
+	// 4. Test getting a non-existent executor
+	_, _, err = tc.Store.GetHeartbeat(ctx, tc.Namespace, "executor-non-existent")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, store.ErrExecutorNotFound)
+}
+
+// TestGetState verifies that the store can accurately retrieve the state of all executors.
+func (s *ExecutorStoreSuite) TestGetState() {
+	t := s.T()
+	tc, _ := s.setupTestCluster(t, config.LoadBalancingModeNAIVE)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID1 := "exec-TestGetState-1"
+	executorID2 := "exec-TestGetState-2"
+	shardID1 := "shard-1"
+	shardID2 := "shard-2"
+
+	// Setup: Record heartbeats and assign shards.
+	require.NoError(t, tc.Store.RecordHeartbeat(ctx, tc.Namespace, executorID1, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+	require.NoError(t, tc.Store.RecordHeartbeat(ctx, tc.Namespace, executorID2, store.HeartbeatState{Status: types.ExecutorStatusDRAINING}))
+	require.NoError(t, tc.Store.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{
+		NewState: &store.NamespaceState{
+			ShardAssignments: map[string]store.AssignedState{
+				executorID1: {AssignedShards: map[string]*types.ShardAssignment{shardID1: {}}},
+				executorID2: {AssignedShards: map[string]*types.ShardAssignment{shardID2: {}}},
+			},
+		},
+	}, store.NopGuard()))
+
+	// Action: Get the state.
+	namespaceState, err := tc.Store.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+
+	// Verification:
+	// Check Executors
+	require.Len(t, namespaceState.Executors, 2, "Should retrieve two heartbeat states")
+	assert.Equal(t, types.ExecutorStatusACTIVE, namespaceState.Executors[executorID1].Status)
+	assert.Equal(t, types.ExecutorStatusDRAINING, namespaceState.Executors[executorID2].Status)
+
+	// Check ShardAssignments (from executor records)
+	require.Len(t, namespaceState.ShardAssignments, 2, "Should retrieve two assignment states")
+	assert.Contains(t, namespaceState.ShardAssignments[executorID1].AssignedShards, shardID1)
+	assert.Contains(t, namespaceState.ShardAssignments[executorID2].AssignedShards, shardID2)
+
+	// Other namespaces are not affected.
+	otherState, err := tc.Store.GetState(ctx, tc.Namespace+"-other")
+	require.NoError(t, err)
+	assert.Empty(t, otherState.Executors)
+	assert.Empty(t, otherState.ShardAssignments)
+}
+
+// TestAssignShards_WithRevisions tests the optimistic locking logic of AssignShards.
+func (s *ExecutorStoreSuite) TestAssignShards_WithRevisions() {
+	t := s.T()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID1 := "exec-rev-1"
+	executorID2 := "exec-rev-2"
+
+	t.Run("Success", func(t *testing.T) {
+		tc, _ := s.setupTestCluster(t, config.LoadBalancingModeNAIVE)
+		recordHeartbeats(ctx, t, tc.Store, tc.Namespace, executorID1, executorID2)
+
+		// Define a new state: assign shard1 to exec1
+		newState := &store.NamespaceState{
+			ShardAssignments: map[string]store.AssignedState{
+				executorID1: {AssignedShards: map[string]*types.ShardAssignment{"shard-1": {}}},
+			},
+		}
+
+		// Assign - should succeed
+		err := tc.Store.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: newState}, store.NopGuard())
+		require.NoError(t, err)
+
+		// Verify the assignment
+		state, err := tc.Store.GetState(ctx, tc.Namespace)
+		require.NoError(t, err)
+		assert.Contains(t, state.ShardAssignments[executorID1].AssignedShards, "shard-1")
+	})
+
+	t.Run("ConflictOnNewShard", func(t *testing.T) {
+		tc, _ := s.setupTestCluster(t, config.LoadBalancingModeNAIVE)
+		recordHeartbeats(ctx, t, tc.Store, tc.Namespace, executorID1, executorID2)
+
+		// Process A defines its desired state: assign shard-new to exec1
+		processAState := &store.NamespaceState{
+			ShardAssignments: map[string]store.AssignedState{
+				executorID1: {AssignedShards: map[string]*types.ShardAssignment{"shard-new": {}}},
+				executorID2: {},
+			},
+		}
+
+		// Process B defines its desired state: assign shard-new to exec2
+		processBState := &store.NamespaceState{
+			ShardAssignments: map[string]store.AssignedState{
+				executorID1: {},
+				executorID2: {AssignedShards: map[string]*types.ShardAssignment{"shard-new": {}}},
+			},
+		}
+
+		// Process A succeeds
+		err := tc.Store.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: processAState}, store.NopGuard())
+		require.NoError(t, err)
+
+		// Process B tries to commit, but its revision check for shard-new (rev=0) will fail.
+		err = tc.Store.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: processBState}, store.NopGuard())
+		require.Error(t, err)
+		assert.ErrorIs(t, err, store.ErrVersionConflict)
+
+		// The failed transaction left the assignments untouched.
+		state, err := tc.Store.GetState(ctx, tc.Namespace)
+		require.NoError(t, err)
+		assert.Contains(t, state.ShardAssignments[executorID1].AssignedShards, "shard-new")
+		assert.Empty(t, state.ShardAssignments[executorID2].AssignedShards)
+	})
+
+	t.Run("ConflictOnExistingShard", func(t *testing.T) {
+		tc, _ := s.setupTestCluster(t, config.LoadBalancingModeNAIVE)
+		recordHeartbeats(ctx, t, tc.Store, tc.Namespace, executorID1, executorID2)
+
+		shardID := "shard-to-move"
+		// 1. Setup: Assign the shard to executor1
+		setupState, err := tc.Store.GetState(ctx, tc.Namespace)
+		require.NoError(t, err)
+		setupState.ShardAssignments = map[string]store.AssignedState{
+			executorID1: {AssignedShards: map[string]*types.ShardAssignment{shardID: {}}},
+		}
+		require.NoError(t, tc.Store.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: setupState}, store.NopGuard()))
+
+		// 2. Process A reads the state, intending to move the shard to executor2
+		stateForProcA, err := tc.Store.GetState(ctx, tc.Namespace)
+		require.NoError(t, err)
+		stateForProcA.ShardAssignments = map[string]store.AssignedState{
+			executorID1: {ModRevision: stateForProcA.ShardAssignments[executorID1].ModRevision},
+			executorID2: {AssignedShards: map[string]*types.ShardAssignment{shardID: {}}, ModRevision: 0},
+		}
+
+		// 3. In the meantime, another process makes a different change (e.g., re-assigns to same executor, which changes revision)
+		intermediateState, err := tc.Store.GetState(ctx, tc.Namespace)
+		require.NoError(t, err)
+		intermediateState.ShardAssignments = map[string]store.AssignedState{
+			executorID1: {
+				AssignedShards: map[string]*types.ShardAssignment{shardID: {}},
+				ModRevision:    intermediateState.ShardAssignments[executorID1].ModRevision,
+			},
+		}
+		require.NoError(t, tc.Store.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: intermediateState}, store.NopGuard()))
+
+		// 4. Process A tries to commit its change. It will fail because its stored revision for the shard is now stale.
+		err = tc.Store.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: stateForProcA}, store.NopGuard())
+		require.Error(t, err)
+		assert.ErrorIs(t, err, store.ErrVersionConflict)
+	})
+
+	t.Run("MoveShardAndDeleteExecutor", func(t *testing.T) {
+		tc, _ := s.setupTestCluster(t, config.LoadBalancingModeNAIVE)
+		recordHeartbeats(ctx, t, tc.Store, tc.Namespace, executorID1, executorID2)
+		require.NoError(t, tc.Store.AssignShard(ctx, tc.Namespace, "shard-1", executorID1))
+
+		state, err := tc.Store.GetState(ctx, tc.Namespace)
+		require.NoError(t, err)
+
+		// Move the shard to executor2 and delete executor1 in one transaction.
+		err = tc.Store.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{
+			NewState: &store.NamespaceState{
+				ShardAssignments: map[string]store.AssignedState{
+					executorID2: {AssignedShards: map[string]*types.ShardAssignment{"shard-1": {}}},
+				},
+			},
+			ExecutorsToDelete: map[string]int64{executorID1: state.ShardAssignments[executorID1].ModRevision},
+		}, store.NopGuard())
+		require.NoError(t, err)
+
+		state, err = tc.Store.GetState(ctx, tc.Namespace)
+		require.NoError(t, err)
+		assert.Contains(t, state.ShardAssignments[executorID2].AssignedShards, "shard-1")
+		assert.NotContains(t, state.ShardAssignments, executorID1)
+		_, _, err = tc.Store.GetHeartbeat(ctx, tc.Namespace, executorID1)
+		assert.ErrorIs(t, err, store.ErrExecutorNotFound)
+	})
+
+	t.Run("ConflictOnExecutorToDelete", func(t *testing.T) {
+		tc, _ := s.setupTestCluster(t, config.LoadBalancingModeNAIVE)
+		recordHeartbeats(ctx, t, tc.Store, tc.Namespace, executorID1, executorID2)
+		require.NoError(t, tc.Store.AssignShard(ctx, tc.Namespace, "shard-1", executorID1))
+
+		// The executor received a shard since we decided to delete it.
+		err := tc.Store.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{
+			NewState:          &store.NamespaceState{},
+			ExecutorsToDelete: map[string]int64{executorID1: 0},
+		}, store.NopGuard())
+		require.Error(t, err)
+		assert.ErrorIs(t, err, store.ErrVersionConflict)
+
+		_, _, err = tc.Store.GetHeartbeat(ctx, tc.Namespace, executorID1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("NoChanges", func(t *testing.T) {
+		tc, _ := s.setupTestCluster(t, config.LoadBalancingModeNAIVE)
+		recordHeartbeats(ctx, t, tc.Store, tc.Namespace, executorID1, executorID2)
+
+		// Get the current state
+		state, err := tc.Store.GetState(ctx, tc.Namespace)
+		require.NoError(t, err)
+
+		// Call AssignShards with the same assignments
+		err = tc.Store.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: state}, store.NopGuard())
+		require.NoError(t, err, "Assigning with no changes should succeed")
+	})
+}
+
+// TestGuardedOperations verifies that AssignShards and DeleteExecutors respect the leader guard.
+func (s *ExecutorStoreSuite) TestGuardedOperations() {
+	t := s.T()
+	tc, _ := s.setupTestCluster(t, config.LoadBalancingModeNAIVE)
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	executorID := "exec-to-delete"
+
+	// 1. Create two potential leaders
+	election1, err := tc.Elector.CreateElection(ctx, tc.Namespace)
+	require.NoError(t, err)
+	defer func() { _ = election1.Cleanup(ctx) }()
+	election2, err := tc.Elector.CreateElection(ctx, tc.Namespace)
+	require.NoError(t, err)
+	defer func() { _ = election2.Cleanup(ctx) }()
+
+	// 2. First node becomes leader
+	require.NoError(t, election1.Campaign(ctx, "host-1"))
+	validGuard := election1.Guard()
+
+	// 3. Use the valid guard to assign shards - should succeed
+	assignState := map[string]store.AssignedState{"exec-1": {}}
+	err = tc.Store.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: &store.NamespaceState{ShardAssignments: assignState}}, validGuard)
+	require.NoError(t, err, "Assigning shards with a valid leader guard should succeed")
+
+	// 4. First node resigns, second node becomes leader
+	require.NoError(t, election1.Resign(ctx))
+	require.NoError(t, election2.Campaign(ctx, "host-2"))
+
+	// 5. Use the now-invalid guard from the first leader - should fail
+	state, err := tc.Store.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	err = tc.Store.AssignShards(ctx, tc.Namespace, store.AssignShardsRequest{NewState: state}, validGuard)
+	require.Error(t, err, "Assigning shards with a stale leader guard should fail")
+	assert.ErrorIs(t, err, store.ErrVersionConflict)
+	err = tc.Store.DeleteExecutors(ctx, tc.Namespace, []string{"exec-1"}, validGuard)
+	require.Error(t, err, "Deleting executors with a stale leader guard should fail")
+
+	// 6. Use the NopGuard to delete an executor - should succeed
+	require.NoError(t, tc.Store.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+	err = tc.Store.DeleteExecutors(ctx, tc.Namespace, []string{executorID}, store.NopGuard())
+	require.NoError(t, err, "Deleting an executor without a guard should succeed")
+
+	// Verify deletion
+	newState, err := tc.Store.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	_, ok := newState.ShardAssignments[executorID]
+	require.False(t, ok, "Executor should have been deleted")
+}
+
+func (s *ExecutorStoreSuite) TestGuardError() {
+	t := s.T()
+	tc, _ := s.setupTestCluster(t, config.LoadBalancingModeNAIVE)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	failingGuard := func(store.Txn) (store.Txn, error) { return nil, fmt.Errorf("guard failed") }
+	invalidGuard := func(store.Txn) (store.Txn, error) { return "not a transaction", nil }
+
+	err := tc.Store.DeleteExecutors(ctx, tc.Namespace, []string{"exec-1"}, failingGuard)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "apply transaction guard")
+
+	err = tc.Store.DeleteAssignedStates(ctx, tc.Namespace, []string{"exec-1"}, invalidGuard)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid transaction type")
+}
+
+// TestSubscribeToExecutorStatusChanges verifies that the subscription channel receives notifications for significant changes.
+func (s *ExecutorStoreSuite) TestSubscribeToExecutorStatusChanges() {
+	t := s.T()
+	tc, _ := s.setupTestCluster(t, config.LoadBalancingModeNAIVE)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID := "exec-sub"
+	require.NoError(t, tc.Store.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+
+	// Start subscription
+	sub, err := tc.Store.SubscribeToExecutorStatusChanges(ctx, tc.Namespace)
+	require.NoError(t, err)
+
+	// Test case #1: Update heartbeat and reported shards without changing status - should NOT trigger notification
+	{
+		require.NoError(t, tc.Store.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{
+			LastHeartbeat:  time.Now(),
+			Status:         types.ExecutorStatusACTIVE,
+			ReportedShards: map[string]*types.ShardStatusReport{"shard-1": {Status: types.ShardStatusREADY}},
+		}))
+		poll(tc)
+
+		select {
+		case <-sub:
+			t.Fatal("Should not receive notification for a heartbeat-only update")
+		case <-time.After(100 * time.Millisecond):
+			// Expected behavior
+		}
+	}
+
+	// Test case #2: Update status - should trigger notification
+	{
+		require.NoError(t, tc.Store.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusDRAINING}))
+		poll(tc)
+
+		select {
+		case rev, ok := <-sub:
+			require.True(t, ok, "Channel should be open")
+			assert.Greater(t, rev, int64(0), "Should receive a valid revision for status change")
+		case <-time.After(1 * time.Second):
+			t.Fatal("Should have received a notification for a status change")
+		}
+	}
+
+	// Test case #3: A new executor - should trigger notification
+	{
+		require.NoError(t, tc.Store.RecordHeartbeat(ctx, tc.Namespace, "exec-sub-2", store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+		poll(tc)
+
+		select {
+		case _, ok := <-sub:
+			require.True(t, ok, "Channel should be open")
+		case <-time.After(1 * time.Second):
+			t.Fatal("Should have received a notification for a new executor")
+		}
+	}
+
+	// Test case #4: Trigger a rebalance - should trigger notification
+	{
+		require.NoError(t, tc.Store.TriggerRebalance(ctx, tc.Namespace))
+		poll(tc)
+
+		select {
+		case rev, ok := <-sub:
+			require.True(t, ok, "Channel should be open")
+			assert.Greater(t, rev, int64(0), "Should receive a valid revision for the rebalance request")
+		case <-time.After(1 * time.Second):
+			t.Fatal("Should have received a notification for a triggered rebalance")
+		}
+	}
+
+	// Cancelling the subscription closes the channel
+	cancel()
+	select {
+	case _, ok := <-sub:
+		assert.False(t, ok, "Channel should be closed")
+	case <-time.After(1 * time.Second):
+		t.Fatal("Channel should be closed after the context is cancelled")
+	}
+}
+
+func (s *ExecutorStoreSuite) TestDeleteExecutors_Empty() {
+	t := s.T()
+	tc, _ := s.setupTestCluster(t, config.LoadBalancingModeNAIVE)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	err := tc.Store.DeleteExecutors(ctx, tc.Namespace, []string{}, store.NopGuard())
+	require.NoError(t, err)
+}
+
+// TestDeleteExecutors covers various scenarios for the DeleteExecutors method.
+func (s *ExecutorStoreSuite) TestDeleteExecutors() {
+	t := s.T()
+	tc, _ := s.setupTestCluster(t, config.LoadBalancingModeNAIVE)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	t.Run("SucceedsForNonExistentExecutor", func(t *testing.T) {
+		// Action: Delete a non-existent executor.
+		err := tc.Store.DeleteExecutors(ctx, tc.Namespace, []string{"non-existent-executor"}, store.NopGuard())
+		// Verification: Should not return an error.
+		require.NoError(t, err)
+	})
+
+	t.Run("DeletesMultipleExecutors", func(t *testing.T) {
+		// Setup: Create and assign shards to multiple executors.
+		execToDelete1 := "multi-delete-1"
+		execToDelete2 := "multi-delete-2"
+		execToKeep := "multi-keep-1"
+		shardOfDeletedExecutor1 := "multi-shard-1"
+		shardOfDeletedExecutor2 := "multi-shard-2"
+		shardOfSurvivingExecutor := "multi-shard-keep"
+
+		recordHeartbeats(ctx, t, tc.Store, tc.Namespace, execToDelete1, execToDelete2, execToKeep)
+
+		require.NoError(t, tc.Store.AssignShard(ctx, tc.Namespace, shardOfDeletedExecutor1, execToDelete1))
+		require.NoError(t, tc.Store.AssignShard(ctx, tc.Namespace, shardOfDeletedExecutor2, execToDelete2))
+		require.NoError(t, tc.Store.AssignShard(ctx, tc.Namespace, shardOfSurvivingExecutor, execToKeep))
+
+		// Action: Delete two of the three executors in one call.
+		err := tc.Store.DeleteExecutors(ctx, tc.Namespace, []string{execToDelete1, execToDelete2}, store.NopGuard())
+		require.NoError(t, err)
+
+		// Verification:
+		// 1. Check deleted executors are gone.
+		_, _, err = tc.Store.GetHeartbeat(ctx, tc.Namespace, execToDelete1)
+		assert.ErrorIs(t, err, store.ErrExecutorNotFound, "Executor 1 should be gone")
+
+		_, _, err = tc.Store.GetHeartbeat(ctx, tc.Namespace, execToDelete2)
+		assert.ErrorIs(t, err, store.ErrExecutorNotFound, "Executor 2 should be gone")
+
+		// 2. Check that the surviving executor remain.
+		_, _, err = tc.Store.GetHeartbeat(ctx, tc.Namespace, execToKeep)
+		assert.NoError(t, err, "Surviving executor should still exist")
+	})
+}
+
+func (s *ExecutorStoreSuite) TestDeleteAssignedStates() {
+	t := s.T()
+	tc, _ := s.setupTestCluster(t, config.LoadBalancingModeNAIVE)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID := "exec-delete-assigned"
+	recordHeartbeats(ctx, t, tc.Store, tc.Namespace, executorID)
+	require.NoError(t, tc.Store.AssignShard(ctx, tc.Namespace, "shard-1", executorID))
+
+	require.NoError(t, tc.Store.DeleteAssignedStates(ctx, tc.Namespace, []string{executorID}, store.NopGuard()))
+
+	hb, assigned, err := tc.Store.GetHeartbeat(ctx, tc.Namespace, executorID)
+	require.NoError(t, err, "The heartbeat should be kept")
+	assert.Equal(t, types.ExecutorStatusACTIVE, hb.Status)
+	assert.Empty(t, assigned.AssignedShards)
+}
+
+// TestAssignAndGetShardOwnerRoundtrip verifies the successful assignment and retrieval of a shard owner.
+func (s *ExecutorStoreSuite) TestAssignAndGetShardOwnerRoundtrip() {
+	t := s.T()
+	tc, timeSource := s.setupTestCluster(t, config.LoadBalancingModeNAIVE)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := timeSource.Now().UTC()
+	executorID := "executor-roundtrip"
+	shardID := "shard-roundtrip"
+
+	// Setup: Create an active executor.
+	err := tc.Store.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE})
+	require.NoError(t, err)
+
+	// 1. Assign a shard to the active executor.
+	err = tc.Store.AssignShard(ctx, tc.Namespace, shardID, executorID)
+	require.NoError(t, err, "Should successfully assign shard to an active executor")
+
+	// 2. Get the owner and verify it's the correct executor.
+	state, err := tc.Store.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	assert.Contains(t, state.ShardAssignments[executorID].AssignedShards, shardID)
+	assert.Equal(t, now, state.ShardAssignments[executorID].LastUpdated)
+}
+
+// TestAssignShardErrors tests the various error conditions when assigning a shard.
+func (s *ExecutorStoreSuite) TestAssignShardErrors() {
+	t := s.T()
+	tc, _ := s.setupTestCluster(t, config.LoadBalancingModeNAIVE)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	activeExecutorID := "executor-active-errors"
+	drainingExecutorID := "executor-draining-errors"
+	shardID1 := "shard-err-1"
+	shardID2 := "shard-err-2"
+
+	// Setup: Create an active and a draining executor, and assign one shard.
+	err := tc.Store.RecordHeartbeat(ctx, tc.Namespace, activeExecutorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE})
+	require.NoError(t, err)
+	err = tc.Store.RecordHeartbeat(ctx, tc.Namespace, drainingExecutorID, store.HeartbeatState{Status: types.ExecutorStatusDRAINING})
+	require.NoError(t, err)
+	err = tc.Store.AssignShard(ctx, tc.Namespace, shardID1, activeExecutorID)
+	require.NoError(t, err)
+
+	// Case 1: Assigning an already-assigned shard.
+	err = tc.Store.AssignShard(ctx, tc.Namespace, shardID1, activeExecutorID)
+	require.Error(t, err, "Should fail to assign an already-assigned shard")
+	var alreadyAssigned *store.ErrShardAlreadyAssigned
+	require.ErrorAs(t, err, &alreadyAssigned)
+	assert.Equal(t, shardID1, alreadyAssigned.ShardID)
+	assert.Equal(t, activeExecutorID, alreadyAssigned.AssignedTo)
+	assert.NotNil(t, alreadyAssigned.Metadata)
+
+	// Case 2: Assigning to a non-existent executor.
+	err = tc.Store.AssignShard(ctx, tc.Namespace, shardID2, "non-existent-executor")
+	require.Error(t, err, "Should fail to assign to a non-existent executor")
+	assert.ErrorIs(t, err, store.ErrExecutorNotFound, "Error should be ErrExecutorNotFound")
+
+	// Case 3: Assigning to a non-active (draining) executor.
+	err = tc.Store.AssignShard(ctx, tc.Namespace, shardID2, drainingExecutorID)
+	require.Error(t, err, "Should fail to assign to a draining executor")
+	assert.ErrorIs(t, err, store.ErrVersionConflict, "Error should be ErrVersionConflict for non-active executor")
+}
+
+// TestShardStatisticsPersistence verifies that shard statistics are preserved on assignment
+// when they already exist, and that GetState exposes them.
+func (s *ExecutorStoreSuite) TestShardStatisticsPersistence() {
+	t := s.T()
+	tc, _ := s.setupTestCluster(t, config.LoadBalancingModeGREEDY)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	executorID := "exec-stats"
+	shardID := "shard-stats"
+
+	// 1. Setup: ensure executor is ACTIVE
+	require.NoError(t, tc.Store.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+
+	// 2. Pre-create shard statistics as if coming from prior history
+	stats := store.ShardStatistics{SmoothedLoad: 12.5, LastUpdateTime: time.Unix(1234, 0).UTC(), LastMoveTime: time.Unix(5678, 0).UTC()}
+	tc.WriteShardStatistics(t, executorID, map[string]store.ShardStatistics{shardID: stats})
+
+	// 3. Assign the shard via AssignShard (should not clobber existing metrics)
+	require.NoError(t, tc.Store.AssignShard(ctx, tc.Namespace, shardID, executorID))
+
+	// 4. Verify via GetState that metrics are preserved and exposed
+	nsState, err := tc.Store.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	require.Contains(t, nsState.ShardStats, shardID)
+	updatedStats := nsState.ShardStats[shardID]
+	assert.Equal(t, stats.SmoothedLoad, updatedStats.SmoothedLoad)
+	assert.Equal(t, stats.LastUpdateTime, updatedStats.LastUpdateTime)
+	// This should be greater than the last move time
+	assert.Greater(t, updatedStats.LastMoveTime, stats.LastMoveTime)
+
+	// 5. Also ensure assignment recorded correctly
+	require.Contains(t, nsState.ShardAssignments[executorID].AssignedShards, shardID)
+}
+
+// TestGetShardStatisticsForMissingShard verifies GetState does not report statistics for unknown shards.
+func (s *ExecutorStoreSuite) TestGetShardStatisticsForMissingShard() {
+	t := s.T()
+	tc, _ := s.setupTestCluster(t, config.LoadBalancingModeGREEDY)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// No metrics are written; GetState should not contain unknown shard
+	st, err := tc.Store.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	assert.NotContains(t, st.ShardStats, "unknown")
+}
+
+// TestDeleteShardStatsDeletesAllStats verifies that shard statistics are correctly deleted.
+func (s *ExecutorStoreSuite) TestDeleteShardStatsDeletesAllStats() {
+	t := s.T()
+	tc, _ := s.setupTestCluster(t, config.LoadBalancingModeGREEDY)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	totalShardStats := 135 // number of stats to add and make stale
+	shardIDs := make([]string, 0, totalShardStats)
+	executorID := "exec-delete-stats"
+
+	// ensure executor exists
+	require.NoError(t, tc.Store.RecordHeartbeat(ctx, tc.Namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+
+	// Create stale stats
+	executorStats := make(map[string]store.ShardStatistics)
+	for i := 0; i < totalShardStats; i++ {
+		shardID := "stale-stats-" + strconv.Itoa(i)
+		shardIDs = append(shardIDs, shardID)
+
+		executorStats[shardID] = store.ShardStatistics{
+			SmoothedLoad:   float64(i),
+			LastUpdateTime: time.Unix(int64(i), 0).UTC(),
+			LastMoveTime:   time.Unix(int64(i), 0).UTC(),
+		}
+	}
+	tc.WriteShardStatistics(t, executorID, executorStats)
+
+	nsState, err := tc.Store.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	require.Len(t, nsState.ShardStats, totalShardStats)
+
+	require.NoError(t, tc.Store.DeleteShardStats(ctx, tc.Namespace, shardIDs, store.NopGuard()))
+
+	nsState, err = tc.Store.GetState(ctx, tc.Namespace)
+	require.NoError(t, err)
+	// All stats should be deleted
+	assert.Empty(t, nsState.ShardStats)
+}
+
+// --- Test Setup ---
+
+// setupTestCluster creates the store under test with the given load balancing mode and a mocked time source.
+func (s *ExecutorStoreSuite) setupTestCluster(t *testing.T, loadBalancingMode string) (*ExecutorStoreTestCluster, clock.MockedTimeSource) {
+	t.Helper()
+
+	timeSource := clock.NewMockedTimeSourceAt(time.Now())
+	tc := s.NewTestCluster(t, ExecutorStoreParams{
+		TimeSource: timeSource,
+		Config: &config.Config{
+			LoadBalancingMode: func(namespace string) string { return loadBalancingMode },
+			MaxEtcdTxnOps:     dynamicproperties.GetIntPropertyFn(128),
+		},
+	})
+	return tc, timeSource
+}
+
+func poll(tc *ExecutorStoreTestCluster) {
+	if tc.Poll != nil {
+		tc.Poll()
+	}
+}
+
+func recordHeartbeats(ctx context.Context, t *testing.T, executorStore store.Store, namespace string, executorIDs ...string) {
+	t.Helper()
+
+	for _, executorID := range executorIDs {
+		require.NoError(t, executorStore.RecordHeartbeat(ctx, namespace, executorID, store.HeartbeatState{Status: types.ExecutorStatusACTIVE}))
+	}
+}
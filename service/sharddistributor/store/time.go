@@ -1,9 +1,9 @@
-package etcdtypes
+package store
 
 import "time"
 
 // Time is a wrapper around time that implements JSON marshalling/unmarshalling
-// in time.RFC3339Nano format to keep precision when storing in etcd.
+// in time.RFC3339Nano format to keep precision when storing in the backing store.
 // Convert to UTC before storing/parsing to ensure consistency.
 type Time time.Time
 
@@ -1,4 +1,4 @@
-package etcdtypes
+package store
 
 import (
 	"encoding/json"
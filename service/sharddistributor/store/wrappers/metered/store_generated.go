@@ -158,3 +158,13 @@ func (c *meteredStore) SubscribeToExecutorStatusChanges(ctx context.Context, nam
 	err = c.call(metrics.ShardDistributorStoreSubscribeToExecutorStatusChangesScope, op, metrics.NamespaceTag(namespace))
 	return
 }
+
+func (c *meteredStore) TriggerRebalance(ctx context.Context, namespace string) (err error) {
+	op := func() error {
+		err = c.wrapped.TriggerRebalance(ctx, namespace)
+		return err
+	}
+
+	err = c.call(metrics.ShardDistributorStoreTriggerRebalanceScope, op, metrics.NamespaceTag(namespace))
+	return
+}
@@ -20,6 +20,11 @@ func NewExecutorGRPCExecutor(h handler.Executor) ExecutorGRPCExecutor {
 	return ExecutorGRPCExecutor{h}
 }
 
+func (g ExecutorGRPCExecutor) Drain(ctx context.Context, request *sharddistributorv1.DrainRequest) (*sharddistributorv1.DrainResponse, error) {
+	response, err := g.h.Drain(ctx, proto.ToShardDistributorExecutorDrainRequest(request))
+	return proto.FromShardDistributorExecutorDrainResponse(response), proto.FromError(err)
+}
+
 func (g ExecutorGRPCExecutor) Heartbeat(ctx context.Context, request *sharddistributorv1.HeartbeatRequest) (*sharddistributorv1.HeartbeatResponse, error) {
 	response, err := g.h.Heartbeat(ctx, proto.ToShardDistributorExecutorHeartbeatRequest(request))
 	return proto.FromShardDistributorExecutorHeartbeatResponse(response), proto.FromError(err)
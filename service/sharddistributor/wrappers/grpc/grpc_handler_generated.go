@@ -21,11 +21,36 @@ func NewGRPCHandler(h handler.Handler) GRPCHandler {
 	return GRPCHandler{h}
 }
 
+func (g GRPCHandler) DescribeShard(ctx context.Context, request *sharddistributorv1.DescribeShardRequest) (*sharddistributorv1.DescribeShardResponse, error) {
+	response, err := g.h.DescribeShard(ctx, proto.ToShardDistributorDescribeShardRequest(request))
+	return proto.FromShardDistributorDescribeShardResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) GetShardOwner(ctx context.Context, request *sharddistributorv1.GetShardOwnerRequest) (*sharddistributorv1.GetShardOwnerResponse, error) {
 	response, err := g.h.GetShardOwner(ctx, proto.ToShardDistributorGetShardOwnerRequest(request))
 	return proto.FromShardDistributorGetShardOwnerResponse(response), proto.FromError(err)
 }
 
+func (g GRPCHandler) ListExecutors(ctx context.Context, request *sharddistributorv1.ListExecutorsRequest) (*sharddistributorv1.ListExecutorsResponse, error) {
+	response, err := g.h.ListExecutors(ctx, proto.ToShardDistributorListExecutorsRequest(request))
+	return proto.FromShardDistributorListExecutorsResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) ListNamespaces(ctx context.Context, request *sharddistributorv1.ListNamespacesRequest) (*sharddistributorv1.ListNamespacesResponse, error) {
+	response, err := g.h.ListNamespaces(ctx, proto.ToShardDistributorListNamespacesRequest(request))
+	return proto.FromShardDistributorListNamespacesResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) MoveShard(ctx context.Context, request *sharddistributorv1.MoveShardRequest) (*sharddistributorv1.MoveShardResponse, error) {
+	response, err := g.h.MoveShard(ctx, proto.ToShardDistributorMoveShardRequest(request))
+	return proto.FromShardDistributorMoveShardResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) TriggerRebalance(ctx context.Context, request *sharddistributorv1.TriggerRebalanceRequest) (*sharddistributorv1.TriggerRebalanceResponse, error) {
+	response, err := g.h.TriggerRebalance(ctx, proto.ToShardDistributorTriggerRebalanceRequest(request))
+	return proto.FromShardDistributorTriggerRebalanceResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) WatchNamespaceState(request *sharddistributorv1.WatchNamespaceStateRequest, server sharddistributorv1.ShardDistributorAPIServiceWatchNamespaceStateYARPCServer) error {
 	err := g.h.WatchNamespaceState(proto.ToShardDistributorWatchNamespaceStateRequest(request), &watchnamespacestateServer{server: server})
 	return err
@@ -28,6 +28,25 @@ func NewMetricsHandler(handler handler.Handler, logger log.Logger, metricsClient
 	}
 }
 
+func (h *metricsHandler) DescribeShard(ctx context.Context, gp1 *types.DescribeShardRequest) (gp2 *types.DescribeShardResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+
+	scope := h.metricsClient.Scope(metrics.ShardDistributorDescribeShardScope)
+	scope = scope.Tagged(metrics.NamespaceTag(gp1.GetNamespace()))
+	scope.IncCounter(metrics.ShardDistributorRequests)
+	sw := scope.StartTimer(metrics.ShardDistributorLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tag.ShardNamespace(gp1.GetNamespace()))
+
+	gp2, err = h.handler.DescribeShard(ctx, gp1)
+
+	if err != nil {
+		handleErr(err, scope, logger)
+	}
+
+	return gp2, err
+}
+
 func (h *metricsHandler) GetShardOwner(ctx context.Context, gp1 *types.GetShardOwnerRequest) (gp2 *types.GetShardOwnerResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 
@@ -51,6 +70,62 @@ func (h *metricsHandler) Health(ctx context.Context) (hp1 *types.HealthStatus, e
 	return h.handler.Health(ctx)
 }
 
+func (h *metricsHandler) ListExecutors(ctx context.Context, gp1 *types.ListExecutorsRequest) (gp2 *types.ListExecutorsResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+
+	scope := h.metricsClient.Scope(metrics.ShardDistributorListExecutorsScope)
+	scope = scope.Tagged(metrics.NamespaceTag(gp1.GetNamespace()))
+	scope.IncCounter(metrics.ShardDistributorRequests)
+	sw := scope.StartTimer(metrics.ShardDistributorLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tag.ShardNamespace(gp1.GetNamespace()))
+
+	gp2, err = h.handler.ListExecutors(ctx, gp1)
+
+	if err != nil {
+		handleErr(err, scope, logger)
+	}
+
+	return gp2, err
+}
+
+func (h *metricsHandler) ListNamespaces(ctx context.Context, gp1 *types.ListNamespacesRequest) (gp2 *types.ListNamespacesResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+
+	scope := h.metricsClient.Scope(metrics.ShardDistributorListNamespacesScope)
+	scope.IncCounter(metrics.ShardDistributorRequests)
+	sw := scope.StartTimer(metrics.ShardDistributorLatency)
+	defer sw.Stop()
+	logger := h.logger
+
+	gp2, err = h.handler.ListNamespaces(ctx, gp1)
+
+	if err != nil {
+		handleErr(err, scope, logger)
+	}
+
+	return gp2, err
+}
+
+func (h *metricsHandler) MoveShard(ctx context.Context, gp1 *types.MoveShardRequest) (gp2 *types.MoveShardResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+
+	scope := h.metricsClient.Scope(metrics.ShardDistributorMoveShardScope)
+	scope = scope.Tagged(metrics.NamespaceTag(gp1.GetNamespace()))
+	scope.IncCounter(metrics.ShardDistributorRequests)
+	sw := scope.StartTimer(metrics.ShardDistributorLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tag.ShardNamespace(gp1.GetNamespace()))
+
+	gp2, err = h.handler.MoveShard(ctx, gp1)
+
+	if err != nil {
+		handleErr(err, scope, logger)
+	}
+
+	return gp2, err
+}
+
 func (h *metricsHandler) Start() {
 	h.handler.Start()
 	return
@@ -61,6 +136,25 @@ func (h *metricsHandler) Stop() {
 	return
 }
 
+func (h *metricsHandler) TriggerRebalance(ctx context.Context, gp1 *types.TriggerRebalanceRequest) (gp2 *types.TriggerRebalanceResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+
+	scope := h.metricsClient.Scope(metrics.ShardDistributorTriggerRebalanceScope)
+	scope = scope.Tagged(metrics.NamespaceTag(gp1.GetNamespace()))
+	scope.IncCounter(metrics.ShardDistributorRequests)
+	sw := scope.StartTimer(metrics.ShardDistributorLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tag.ShardNamespace(gp1.GetNamespace()))
+
+	gp2, err = h.handler.TriggerRebalance(ctx, gp1)
+
+	if err != nil {
+		handleErr(err, scope, logger)
+	}
+
+	return gp2, err
+}
+
 func (h *metricsHandler) WatchNamespaceState(wp1 *types.WatchNamespaceStateRequest, w1 handler.WatchNamespaceStateServer) (err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 
@@ -28,6 +28,25 @@ func NewExecutorMetricsExecutor(handler handler.Executor, logger log.Logger, met
 	}
 }
 
+func (h *executormetricsExecutor) Drain(ctx context.Context, ep1 *types.ExecutorDrainRequest) (ep2 *types.ExecutorDrainResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+
+	scope := h.metricsClient.Scope(metrics.ShardDistributorDrainScope)
+	scope = scope.Tagged(metrics.NamespaceTag(ep1.GetNamespace()))
+	scope.IncCounter(metrics.ShardDistributorRequests)
+	sw := scope.StartTimer(metrics.ShardDistributorLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tag.ShardNamespace(ep1.GetNamespace()))
+
+	ep2, err = h.handler.Drain(ctx, ep1)
+
+	if err != nil {
+		handleErr(err, scope, logger)
+	}
+
+	return ep2, err
+}
+
 func (h *executormetricsExecutor) Heartbeat(ctx context.Context, ep1 *types.ExecutorHeartbeatRequest) (ep2 *types.ExecutorHeartbeatResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 
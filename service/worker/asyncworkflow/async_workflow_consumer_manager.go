@@ -36,6 +36,7 @@ import (
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/types"
 )
@@ -71,6 +72,20 @@ func WithEmitConsumerCountMetrifFn(fn func(int)) ConsumerManagerOptions {
 	}
 }
 
+// WithQueueManagerProvider sets the provider of persistence queue managers used by persistence backed queues
+func WithQueueManagerProvider(queueManagerProvider provider.QueueManagerProvider) ConsumerManagerOptions {
+	return func(c *ConsumerManager) {
+		c.queueManagerProvider = queueManagerProvider
+	}
+}
+
+// WithMembershipResolver sets the resolver used by queues which are consumed by a single worker host
+func WithMembershipResolver(membershipResolver membership.Resolver) ConsumerManagerOptions {
+	return func(c *ConsumerManager) {
+		c.membershipResolver = membershipResolver
+	}
+}
+
 func NewConsumerManager(
 	logger log.Logger,
 	metricsClient metrics.Client,
@@ -112,6 +127,8 @@ type ConsumerManager struct {
 	domainCache               cache.DomainCache
 	queueProvider             queue.Provider
 	frontendClient            frontend.Client
+	queueManagerProvider      provider.QueueManagerProvider
+	membershipResolver        membership.Resolver
 	refreshInterval           time.Duration
 	shutdownTimeout           time.Duration
 	ctx                       context.Context
@@ -220,9 +237,11 @@ func (c *ConsumerManager) refreshConsumers() {
 
 		c.logger.Info("Starting consumer", tag.WorkflowDomainName(domain.GetInfo().Name), tag.AsyncWFQueueID(queue.ID()))
 		consumer, err := queue.CreateConsumer(&provider.Params{
-			Logger:         c.logger,
-			MetricsClient:  c.metricsClient,
-			FrontendClient: c.frontendClient,
+			Logger:               c.logger,
+			MetricsClient:        c.metricsClient,
+			FrontendClient:       c.frontendClient,
+			QueueManagerProvider: c.queueManagerProvider,
+			MembershipResolver:   c.membershipResolver,
 		})
 		if err != nil {
 			c.logger.Error("Failed to create consumer", tag.Error(err), tag.WorkflowDomainName(domain.GetInfo().Name), tag.AsyncWFQueueID(queue.ID()))
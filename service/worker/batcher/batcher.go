@@ -29,6 +29,7 @@ import (
 	"go.uber.org/cadence/worker"
 
 	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
@@ -59,17 +60,20 @@ type (
 		TallyScope tally.Scope
 		// ClientBean is an instance of client.Bean for a collection of clients
 		ClientBean client.Bean
+		// BlobstoreClient is used to store the reports of batch jobs, reports are not written if it's nil
+		BlobstoreClient blobstore.Client
 	}
 
 	// Batcher is the background sub-system that execute workflow for batch operations
 	// It is also the context object that get's passed around within the scanner workflows / activities
 	Batcher struct {
-		cfg           Config
-		svcClient     workflowserviceclient.Interface
-		clientBean    client.Bean
-		metricsClient metrics.Client
-		tallyScope    tally.Scope
-		logger        log.Logger
+		cfg             Config
+		svcClient       workflowserviceclient.Interface
+		clientBean      client.Bean
+		blobstoreClient blobstore.Client
+		metricsClient   metrics.Client
+		tallyScope      tally.Scope
+		logger          log.Logger
 	}
 )
 
@@ -77,12 +81,13 @@ type (
 func New(params *BootstrapParams) *Batcher {
 	cfg := params.Config
 	return &Batcher{
-		cfg:           cfg,
-		svcClient:     params.ServiceClient,
-		metricsClient: params.MetricsClient,
-		tallyScope:    params.TallyScope,
-		logger:        params.Logger.WithTags(tag.ComponentBatcher),
-		clientBean:    params.ClientBean,
+		cfg:             cfg,
+		svcClient:       params.ServiceClient,
+		metricsClient:   params.MetricsClient,
+		tallyScope:      params.TallyScope,
+		logger:          params.Logger.WithTags(tag.ComponentBatcher),
+		clientBean:      params.ClientBean,
+		blobstoreClient: params.BlobstoreClient,
 	}
 }
 
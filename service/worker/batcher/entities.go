@@ -56,6 +56,35 @@ type ReplicateParams struct {
 	TargetCluster string
 }
 
+// ResetParams is the parameters for resetting workflow
+type ResetParams struct {
+	// ResetType is where to reset the workflows to, one of AllResetTypes
+	ResetType string
+	// DecisionOffset moves the reset point of LastDecisionCompleted and LastDecisionScheduled back by the number of decisions.
+	// Only zero or negative values are supported.
+	DecisionOffset int
+	// BadBinaryChecksum is required for ResetTypeBadBinary
+	BadBinaryChecksum string
+	// EarliestTime in unix nanoseconds is required for ResetTypeDecisionCompletedTime
+	EarliestTime int64
+	// SkipSignalReapply indicates whether to skip reapplying the signals after the reset point
+	SkipSignalReapply bool
+	// SkipCurrentOpen skips the workflow if its current run is open
+	SkipCurrentOpen bool
+	// SkipCurrentCompleted skips the workflow if its current run is completed
+	SkipCurrentCompleted bool
+	// SkipBaseNotCurrent skips the workflow if the run returned by the query is not the current run
+	SkipBaseNotCurrent bool
+	// NonDeterministicOnly only resets the workflow if its last decision failed with a non deterministic error
+	NonDeterministicOnly bool
+}
+
+// DeleteParams is the parameters for deleting workflow
+type DeleteParams struct {
+	// SkipErrors indicates whether to keep deleting the rest of the workflow data when deleting some of it fails
+	SkipErrors bool
+}
+
 // BatchParams is the parameters for batch operation workflow
 type BatchParams struct {
 	// Target domain to execute batch operation
@@ -64,10 +93,17 @@ type BatchParams struct {
 	Query string
 	// Reason for the operation
 	Reason string
-	// Supporting: reset,terminate
+	// One of AllBatchTypes
 	BatchType string
 
 	// Below are all optional
+	// DryRun only counts the workflows matching the query and returns a sample of them in the result without processing them
+	DryRun bool
+	// RetryFailedJobID processes the workflows that failed in the batch job with this ID instead of the ones matching the query.
+	// It requires the report of that batch job to be in the blobstore.
+	RetryFailedJobID string
+	// RetryFailedPageCount is the number of pages in the report of the batch job to retry, that is its CurrentPage when it completed
+	RetryFailedPageCount int
 	// TerminateParams is params only for BatchTypeTerminate
 	TerminateParams TerminateParams
 	// CancelParams is params only for BatchTypeCancel
@@ -76,6 +112,10 @@ type BatchParams struct {
 	SignalParams SignalParams
 	// ReplicateParams is params only for BatchTypeReplicate
 	ReplicateParams ReplicateParams
+	// ResetParams is params only for BatchTypeReset
+	ResetParams ResetParams
+	// DeleteParams is params only for BatchTypeDelete
+	DeleteParams DeleteParams
 	// RPS of processing. Default to DefaultRPS
 	// TODO we will implement smarter way than this static rate limiter: https://github.com/uber/cadence/issues/2138
 	RPS int
@@ -83,6 +123,8 @@ type BatchParams struct {
 	Concurrency int
 	// Number of workflows processed in a batch
 	PageSize int
+	// Number of pages processed before the workflow continues as new with the progress. Default to DefaultPagesPerRun
+	PagesPerRun int
 	// Number of attempts for each workflow to process in case of retryable error before giving up
 	AttemptsOnRetryableError int
 	// timeout for activity heartbeat
@@ -94,9 +136,9 @@ type BatchParams struct {
 	// internal conversion for NonRetryableErrors
 	_nonRetryableErrors map[string]struct{}
 
-	// Progress carries forward HeartBeatDetails from a cancelled activity
-	// so the next activity invocation can resume where the previous left off.
-	// Used only by BatchWorkflowV2; nil means no prior progress.
+	// Progress carries forward HeartBeatDetails from a cancelled activity or a previous run
+	// of the workflow, so the next activity invocation can resume where the previous left off.
+	// nil means no prior progress.
 	Progress *HeartBeatDetails
 }
 
@@ -110,6 +152,12 @@ type HeartBeatDetails struct {
 	SuccessCount int
 	// Number of workflows that give up due to errors.
 	ErrorCount int
+	// Number of workflows skipped by the batch operation
+	SkippedCount int
+	// The last MaxRecentFailures workflows that give up due to errors, all the outcomes are in the report of the batch job
+	RecentFailures []WorkflowOutcome `json:",omitempty"`
+	// Sample of the workflows matching the query, only set by dry run
+	Sample []types.WorkflowExecution `json:",omitempty"`
 }
 
 type taskDetail struct {
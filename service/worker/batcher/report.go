@@ -0,0 +1,199 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// OutcomeSucceeded is the outcome of a workflow that the batch operation is applied to
+	OutcomeSucceeded = "succeeded"
+	// OutcomeSkipped is the outcome of a workflow that is skipped by the batch operation, e.g. by the skip conditions of reset
+	OutcomeSkipped = "skipped"
+	// OutcomeFailed is the outcome of a workflow that the batch operation failed to be applied to
+	OutcomeFailed = "failed"
+
+	// MaxRecentFailures is the max number of failures kept in HeartBeatDetails, the full list is in the report
+	MaxRecentFailures = 100
+
+	reportExtension = "batchreport"
+)
+
+type (
+	// WorkflowOutcome is the outcome of the batch operation on a workflow, recorded in the report of the batch job
+	WorkflowOutcome struct {
+		WorkflowID string
+		RunID      string
+		// One of OutcomeSucceeded, OutcomeSkipped and OutcomeFailed
+		Outcome string
+		// Reason of skipping or failing the workflow
+		Reason string `json:",omitempty"`
+	}
+
+	// skippedError is returned by the operation of a batch type when a workflow doesn't need to be processed
+	skippedError struct {
+		reason string
+	}
+)
+
+func newSkippedError(reason string) error {
+	return &skippedError{reason: reason}
+}
+
+func (e *skippedError) Error() string {
+	return "workflow is skipped: " + e.reason
+}
+
+func newWorkflowOutcome(execution types.WorkflowExecution, err error) WorkflowOutcome {
+	outcome := WorkflowOutcome{
+		WorkflowID: execution.GetWorkflowID(),
+		RunID:      execution.GetRunID(),
+		Outcome:    OutcomeSucceeded,
+	}
+	if skipped, ok := err.(*skippedError); ok {
+		outcome.Outcome = OutcomeSkipped
+		outcome.Reason = skipped.reason
+	} else if err != nil {
+		outcome.Outcome = OutcomeFailed
+		outcome.Reason = err.Error()
+	}
+	return outcome
+}
+
+// appendRecentFailures appends the failed outcomes to failures and keeps the last MaxRecentFailures of them
+func appendRecentFailures(failures []WorkflowOutcome, outcomes []WorkflowOutcome) []WorkflowOutcome {
+	for _, o := range outcomes {
+		if o.Outcome == OutcomeFailed {
+			failures = append(failures, o)
+		}
+	}
+	if len(failures) > MaxRecentFailures {
+		failures = failures[len(failures)-MaxRecentFailures:]
+	}
+	return failures
+}
+
+// ReportKey returns the blobstore key of a page of the report of a batch job
+func ReportKey(jobID string, page int) string {
+	return fmt.Sprintf("%v_%v.%v", jobID, page, reportExtension)
+}
+
+// writeReport writes the outcomes of a page of workflows to blobstore
+func writeReport(
+	ctx context.Context,
+	client blobstore.Client,
+	jobID string,
+	page int,
+	outcomes []WorkflowOutcome,
+) error {
+	body, err := json.Marshal(outcomes)
+	if err != nil {
+		return err
+	}
+	_, err = client.Put(ctx, &blobstore.PutRequest{
+		Key: ReportKey(jobID, page),
+		Blob: blobstore.Blob{
+			Tags: map[string]string{"jobID": jobID, "page": strconv.Itoa(page)},
+			Body: body,
+		},
+	})
+	return err
+}
+
+// readReport reads the outcomes of a page of workflows from blobstore, it returns false if the page doesn't exist
+func readReport(
+	ctx context.Context,
+	client blobstore.Client,
+	jobID string,
+	page int,
+) ([]WorkflowOutcome, bool, error) {
+	key := ReportKey(jobID, page)
+	existsResp, err := client.Exists(ctx, &blobstore.ExistsRequest{Key: key})
+	if err != nil {
+		return nil, false, err
+	}
+	if !existsResp.Exists {
+		return nil, false, nil
+	}
+	resp, err := client.Get(ctx, &blobstore.GetRequest{Key: key})
+	if err != nil {
+		return nil, false, err
+	}
+	var outcomes []WorkflowOutcome
+	if err := json.Unmarshal(resp.Blob.Body, &outcomes); err != nil {
+		return nil, false, err
+	}
+	return outcomes, true, nil
+}
+
+// listFailedWorkflows returns the failed workflows of the first page of the report of jobID at or after the page in pageToken
+// that has any failure, and the page token of the next page. The returned page token is empty when the pageCount pages of the
+// report are exhausted. Pages missing from the report, e.g. because writing them failed, are skipped with a warning.
+func listFailedWorkflows(
+	ctx context.Context,
+	client blobstore.Client,
+	logger log.Logger,
+	jobID string,
+	pageCount int,
+	pageToken []byte,
+) ([]types.WorkflowExecution, []byte, error) {
+	page := 0
+	if len(pageToken) > 0 {
+		var err error
+		if page, err = strconv.Atoi(string(pageToken)); err != nil {
+			return nil, nil, fmt.Errorf("invalid report page token %q: %w", pageToken, err)
+		}
+	}
+	for ; page < pageCount; page++ {
+		outcomes, exists, err := readReport(ctx, client, jobID, page)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !exists {
+			logger.Warn("Page of the batch operation report is missing, its failed workflows are not retried", tag.Dynamic("retry-failed-job-id", jobID), tag.Counter(page))
+			continue
+		}
+		var executions []types.WorkflowExecution
+		for _, o := range outcomes {
+			if o.Outcome == OutcomeFailed {
+				executions = append(executions, types.WorkflowExecution{WorkflowID: o.WorkflowID, RunID: o.RunID})
+			}
+		}
+		if len(executions) > 0 {
+			if page+1 == pageCount {
+				return executions, nil, nil
+			}
+			return executions, []byte(strconv.Itoa(page + 1)), nil
+		}
+	}
+	return nil, nil, nil
+}
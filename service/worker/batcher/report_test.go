@@ -0,0 +1,149 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestNewWorkflowOutcome(t *testing.T) {
+	execution := types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"}
+	tests := []struct {
+		name     string
+		err      error
+		expected WorkflowOutcome
+	}{
+		{
+			name:     "succeeded",
+			expected: WorkflowOutcome{WorkflowID: "wid", RunID: "rid", Outcome: OutcomeSucceeded},
+		},
+		{
+			name:     "skipped",
+			err:      newSkippedError("current run is open"),
+			expected: WorkflowOutcome{WorkflowID: "wid", RunID: "rid", Outcome: OutcomeSkipped, Reason: "current run is open"},
+		},
+		{
+			name:     "failed",
+			err:      errors.New("some error"),
+			expected: WorkflowOutcome{WorkflowID: "wid", RunID: "rid", Outcome: OutcomeFailed, Reason: "some error"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, newWorkflowOutcome(execution, tt.err))
+		})
+	}
+}
+
+func TestAppendRecentFailures(t *testing.T) {
+	var failures []WorkflowOutcome
+	failures = appendRecentFailures(failures, []WorkflowOutcome{
+		{WorkflowID: "wid0", Outcome: OutcomeSucceeded},
+		{WorkflowID: "wid1", Outcome: OutcomeFailed},
+		{WorkflowID: "wid2", Outcome: OutcomeSkipped},
+	})
+	assert.Equal(t, []WorkflowOutcome{{WorkflowID: "wid1", Outcome: OutcomeFailed}}, failures)
+
+	outcomes := make([]WorkflowOutcome, MaxRecentFailures)
+	for i := range outcomes {
+		outcomes[i] = WorkflowOutcome{WorkflowID: fmt.Sprintf("wid%v", i+2), Outcome: OutcomeFailed}
+	}
+	failures = appendRecentFailures(failures, outcomes)
+	assert.Len(t, failures, MaxRecentFailures)
+	assert.Equal(t, "wid2", failures[0].WorkflowID)
+	assert.Equal(t, fmt.Sprintf("wid%v", MaxRecentFailures+1), failures[MaxRecentFailures-1].WorkflowID)
+}
+
+func TestReport(t *testing.T) {
+	client, err := filestore.NewFilestoreClient(&config.FileBlobstore{OutputDirectory: t.TempDir()})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	pages := [][]WorkflowOutcome{
+		{
+			{WorkflowID: "wid0", RunID: "rid0", Outcome: OutcomeFailed, Reason: "some error"},
+			{WorkflowID: "wid1", RunID: "rid1", Outcome: OutcomeSucceeded},
+		},
+		{
+			{WorkflowID: "wid2", RunID: "rid2", Outcome: OutcomeSkipped, Reason: "current run is open"},
+		},
+		{
+			{WorkflowID: "wid3", RunID: "rid3", Outcome: OutcomeFailed, Reason: "some error"},
+			{WorkflowID: "wid4", RunID: "rid4", Outcome: OutcomeFailed, Reason: "some error"},
+		},
+	}
+	for page, outcomes := range pages {
+		require.NoError(t, writeReport(ctx, client, "job-id", page, outcomes))
+	}
+
+	outcomes, exists, err := readReport(ctx, client, "job-id", 1)
+	require.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, pages[1], outcomes)
+
+	_, exists, err = readReport(ctx, client, "job-id", len(pages))
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// pages without failures are skipped when listing the failed workflows
+	logger := testlogger.New(t)
+	executions, pageToken, err := listFailedWorkflows(ctx, client, logger, "job-id", len(pages), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []types.WorkflowExecution{{WorkflowID: "wid0", RunID: "rid0"}}, executions)
+	assert.Equal(t, []byte("1"), pageToken)
+
+	executions, pageToken, err = listFailedWorkflows(ctx, client, logger, "job-id", len(pages), pageToken)
+	require.NoError(t, err)
+	assert.Equal(t, []types.WorkflowExecution{{WorkflowID: "wid3", RunID: "rid3"}, {WorkflowID: "wid4", RunID: "rid4"}}, executions)
+	assert.Empty(t, pageToken, "the last page of the report is listed")
+
+	// missing pages are skipped up to the page count of the report
+	require.NoError(t, writeReport(ctx, client, "job-id", len(pages)+1, []WorkflowOutcome{
+		{WorkflowID: "wid5", RunID: "rid5", Outcome: OutcomeFailed, Reason: "some error"},
+	}))
+	executions, pageToken, err = listFailedWorkflows(ctx, client, logger, "job-id", len(pages)+3, []byte(strconv.Itoa(len(pages))))
+	require.NoError(t, err)
+	assert.Equal(t, []types.WorkflowExecution{{WorkflowID: "wid5", RunID: "rid5"}}, executions)
+	assert.Equal(t, []byte(strconv.Itoa(len(pages)+2)), pageToken)
+
+	executions, pageToken, err = listFailedWorkflows(ctx, client, logger, "job-id", len(pages)+3, pageToken)
+	require.NoError(t, err)
+	assert.Empty(t, executions)
+	assert.Empty(t, pageToken)
+
+	_, _, err = listFailedWorkflows(ctx, client, logger, "job-id", len(pages), []byte("invalid"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,117 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/resetpoint"
+	"github.com/uber/cadence/common/types"
+)
+
+// The reset types supported by the reset batch type, they are the same as the ones of the CLI
+const (
+	ResetTypeFirstDecisionCompleted = resetpoint.TypeFirstDecisionCompleted
+	ResetTypeLastDecisionCompleted  = resetpoint.TypeLastDecisionCompleted
+	ResetTypeLastContinuedAsNew     = resetpoint.TypeLastContinuedAsNew
+	ResetTypeBadBinary              = resetpoint.TypeBadBinary
+	ResetTypeDecisionCompletedTime  = resetpoint.TypeDecisionCompletedTime
+	ResetTypeFirstDecisionScheduled = resetpoint.TypeFirstDecisionScheduled
+	ResetTypeLastDecisionScheduled  = resetpoint.TypeLastDecisionScheduled
+)
+
+// AllResetTypes is the reset types supported by the reset batch type
+var AllResetTypes = resetpoint.AllTypes
+
+// resetWorkflow resets a workflow to the reset point of the reset type, a skippedError is returned
+// if the workflow is skipped by the skip conditions in ResetParams
+func resetWorkflow(
+	ctx context.Context,
+	client frontend.Client,
+	batchParams BatchParams,
+	workflowID string,
+	runID string,
+	identity string,
+) error {
+	params := batchParams.ResetParams
+	domain := batchParams.DomainName
+
+	resp, err := client.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	info := resp.GetWorkflowExecutionInfo()
+	currentRunID := info.GetExecution().GetRunID()
+	if params.SkipBaseNotCurrent && currentRunID != runID {
+		return newSkippedError("base run is not the current run")
+	}
+	if runID == "" {
+		runID = currentRunID
+	}
+	if params.SkipCurrentOpen && (info == nil || info.CloseStatus == nil) {
+		return newSkippedError("current run is open")
+	}
+	if params.SkipCurrentCompleted && info.GetCloseStatus() == types.WorkflowExecutionCloseStatusCompleted {
+		return newSkippedError("current run is completed")
+	}
+	if params.NonDeterministicOnly {
+		nonDeterministic, err := resetpoint.IsLastDecisionFailedWithNonDeterminism(ctx, client, domain, workflowID, runID)
+		if err != nil {
+			return err
+		}
+		if !nonDeterministic {
+			return newSkippedError("last decision didn't fail with non deterministic error")
+		}
+	}
+
+	baseRunID, decisionFinishEventID, err := resetpoint.Get(ctx, client, domain, workflowID, runID, resetpoint.Params{
+		Type:              params.ResetType,
+		DecisionOffset:    params.DecisionOffset,
+		BadBinaryChecksum: params.BadBinaryChecksum,
+		EarliestTime:      params.EarliestTime,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = client.ResetWorkflowExecution(ctx, &types.ResetWorkflowExecutionRequest{
+		Domain: domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      baseRunID,
+		},
+		Reason:                fmt.Sprintf("%v:%v", identity, batchParams.Reason),
+		DecisionFinishEventID: decisionFinishEventID,
+		RequestID:             uuid.New().String(),
+		SkipSignalReapply:     params.SkipSignalReapply,
+	})
+	return err
+}
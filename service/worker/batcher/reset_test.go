@@ -0,0 +1,130 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestResetWorkflow_SkipConditions(t *testing.T) {
+	completed := types.WorkflowExecutionCloseStatusCompleted
+	tests := []struct {
+		name      string
+		params    ResetParams
+		runID     string
+		info      *types.WorkflowExecutionInfo
+		history   []*types.HistoryEvent
+		wantReset bool
+	}{
+		{
+			name:      "reset open workflow",
+			params:    ResetParams{ResetType: ResetTypeLastDecisionCompleted},
+			runID:     "rid",
+			info:      &types.WorkflowExecutionInfo{Execution: &types.WorkflowExecution{RunID: "rid"}},
+			wantReset: true,
+		},
+		{
+			name:   "skip base not current",
+			params: ResetParams{ResetType: ResetTypeLastDecisionCompleted, SkipBaseNotCurrent: true},
+			runID:  "old-rid",
+			info:   &types.WorkflowExecutionInfo{Execution: &types.WorkflowExecution{RunID: "rid"}},
+		},
+		{
+			name:   "skip current open",
+			params: ResetParams{ResetType: ResetTypeLastDecisionCompleted, SkipCurrentOpen: true},
+			runID:  "rid",
+			info:   &types.WorkflowExecutionInfo{Execution: &types.WorkflowExecution{RunID: "rid"}},
+		},
+		{
+			name:   "skip current completed",
+			params: ResetParams{ResetType: ResetTypeLastDecisionCompleted, SkipCurrentCompleted: true},
+			runID:  "rid",
+			info: &types.WorkflowExecutionInfo{
+				Execution:   &types.WorkflowExecution{RunID: "rid"},
+				CloseStatus: &completed,
+				CloseTime:   common.Int64Ptr(1),
+			},
+		},
+		{
+			name:   "skip deterministic workflow",
+			params: ResetParams{ResetType: ResetTypeLastDecisionCompleted, NonDeterministicOnly: true},
+			runID:  "rid",
+			info:   &types.WorkflowExecutionInfo{Execution: &types.WorkflowExecution{RunID: "rid"}},
+		},
+		{
+			name:   "reset non deterministic workflow",
+			params: ResetParams{ResetType: ResetTypeLastDecisionCompleted, NonDeterministicOnly: true},
+			runID:  "rid",
+			info:   &types.WorkflowExecutionInfo{Execution: &types.WorkflowExecution{RunID: "rid"}},
+			history: []*types.HistoryEvent{
+				{ID: 8, EventType: types.EventTypeDecisionTaskFailed.Ptr(), DecisionTaskFailedEventAttributes: &types.DecisionTaskFailedEventAttributes{
+					Cause: types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.Ptr(),
+				}},
+			},
+			wantReset: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := frontend.NewMockClient(ctrl)
+			events := append([]*types.HistoryEvent{
+				{ID: 4, EventType: types.EventTypeDecisionTaskCompleted.Ptr()},
+			}, tt.history...)
+			client.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
+				WorkflowExecutionInfo: tt.info,
+			}, nil).Times(1)
+			client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
+				History: &types.History{Events: events},
+			}, nil).AnyTimes()
+			if tt.wantReset {
+				client.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, req *types.ResetWorkflowExecutionRequest, _ ...interface{}) (*types.ResetWorkflowExecutionResponse, error) {
+						assert.Equal(t, "test-domain", req.Domain)
+						assert.Equal(t, &types.WorkflowExecution{WorkflowID: "wid", RunID: tt.runID}, req.WorkflowExecution)
+						assert.Equal(t, "test-identity:test-reason", req.Reason)
+						assert.Equal(t, int64(4), req.DecisionFinishEventID)
+						assert.NotEmpty(t, req.RequestID)
+						return &types.ResetWorkflowExecutionResponse{}, nil
+					}).Times(1)
+			}
+
+			batchParams := BatchParams{DomainName: "test-domain", Reason: "test-reason", ResetParams: tt.params}
+			err := resetWorkflow(context.Background(), client, batchParams, "wid", tt.runID, "test-identity")
+			if tt.wantReset {
+				assert.NoError(t, err)
+			} else {
+				assert.IsType(t, &skippedError{}, err)
+			}
+		})
+	}
+}
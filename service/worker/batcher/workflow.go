@@ -68,6 +68,8 @@ const (
 	DefaultActivityHeartBeatTimeout = time.Second * 10
 	// DefaultMaxActivityRetries is the default value for MaxActivityRetries
 	DefaultMaxActivityRetries = 4
+	// DefaultPagesPerRun is the default value for PagesPerRun
+	DefaultPagesPerRun = 100
 )
 
 const (
@@ -79,10 +81,16 @@ const (
 	BatchTypeSignal = "signal"
 	// BatchTypeReplicate is batch type for replicating workflows
 	BatchTypeReplicate = "replicate"
+	// BatchTypeReset is batch type for resetting workflows
+	BatchTypeReset = "reset"
+	// BatchTypeDelete is batch type for deleting workflows
+	BatchTypeDelete = "delete"
+	// BatchTypeRefreshTasks is batch type for regenerating the tasks of workflows
+	BatchTypeRefreshTasks = "refresh_tasks"
 )
 
 // AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReplicate}
+var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReplicate, BatchTypeReset, BatchTypeDelete, BatchTypeRefreshTasks}
 
 var (
 	BatchActivityRetryPolicy = cadence.RetryPolicy{
@@ -117,6 +125,11 @@ func BatchWorkflow(ctx workflow.Context, batchParams BatchParams) (HeartBeatDeta
 	opt := workflow.WithActivityOptions(ctx, batchActivityOptions)
 	var result HeartBeatDetails
 	err = workflow.ExecuteActivity(opt, batchActivityName, batchParams).Get(ctx, &result)
+	if err == nil && len(result.PageToken) > 0 {
+		// the activity stopped after PagesPerRun pages, continue as new so that the progress survives in the input of the next run
+		batchParams.Progress = &result
+		return result, workflow.NewContinueAsNewError(ctx, BatchWFTypeName, batchParams)
+	}
 	return result, err
 }
 
@@ -124,17 +137,9 @@ func BatchWorkflow(ctx workflow.Context, batchParams BatchParams) (HeartBeatDeta
 func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetails, error) {
 	batcher := ctx.Value(BatcherContextKey).(*Batcher)
 	client := batcher.clientBean.GetFrontendClient()
-	var adminClient admin.Client
-	if batchParams.BatchType == BatchTypeReplicate {
-		currentCluster := batcher.cfg.ClusterMetadata.GetCurrentClusterName()
-		if currentCluster != batchParams.ReplicateParams.SourceCluster {
-			return HeartBeatDetails{}, cadence.NewCustomError(_nonRetriableReason, fmt.Sprintf("the activity must run in the source cluster, current cluster is %s", currentCluster))
-		}
-		var err error
-		adminClient, err = batcher.clientBean.GetRemoteAdminClient(batchParams.ReplicateParams.TargetCluster)
-		if err != nil {
-			return HeartBeatDetails{}, cadence.NewCustomError(_nonRetriableReason, err.Error())
-		}
+	adminClient, err := getAdminClient(batcher, batchParams)
+	if err != nil {
+		return HeartBeatDetails{}, err
 	}
 
 	domainResp, err := client.DescribeDomain(ctx, &types.DescribeDomainRequest{
@@ -145,8 +150,14 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	}
 	domainID := domainResp.GetDomainInfo().GetUUID()
 	hbd, ok := getHeartBeatDetails(ctx)
+	// the progress of the previous run is used only when the activity is not retried in this run
+	if !ok && batchParams.Progress != nil {
+		hbd = *batchParams.Progress
+		ok = true
+	}
 
-	if !ok {
+	// the workflows to retry are not known until the report is read, so they are not counted
+	if !ok && batchParams.RetryFailedJobID == "" {
 		resp, err := client.CountWorkflowExecutions(ctx, &types.CountWorkflowExecutionsRequest{
 			Domain: batchParams.DomainName,
 			Query:  batchParams.Query,
@@ -156,64 +167,52 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 		}
 		hbd.TotalEstimate = resp.GetCount()
 	}
+	if batchParams.DryRun {
+		return dryRun(ctx, batcher, client, batchParams, hbd)
+	}
 	rateLimiter := rate.NewLimiter(rate.Limit(batchParams.RPS), batchParams.RPS)
 	taskCh := make(chan taskDetail, batchParams.PageSize)
-	respCh := make(chan error, batchParams.PageSize)
+	respCh := make(chan WorkflowOutcome, batchParams.PageSize)
 	for i := 0; i < batchParams.Concurrency; i++ {
 		go startTaskProcessor(ctx, batchParams, domainID, taskCh, respCh, rateLimiter, client, adminClient, BatchWFTypeName)
 	}
 
-	for {
+	for pages := 0; ; pages++ {
+		if batchParams.PagesPerRun > 0 && pages >= batchParams.PagesPerRun {
+			// return with the page token, the workflow continues as new from here
+			return hbd, nil
+		}
+
 		// TODO https://github.com/uber/cadence/issues/2154
 		//  Need to improve scan concurrency because it will hold an ES resource until the workflow finishes.
 		//  And we can't use list API because terminate / reset will mutate the result.
-		resp, err := client.ScanWorkflowExecutions(ctx, &types.ListWorkflowExecutionsRequest{
-			Domain:        batchParams.DomainName,
-			PageSize:      int32(batchParams.PageSize),
-			NextPageToken: hbd.PageToken,
-			Query:         batchParams.Query,
-		})
+		executions, nextPageToken, err := listWorkflows(ctx, batcher, client, batchParams, hbd.PageToken)
 		if err != nil {
 			return HeartBeatDetails{}, err
 		}
-		batchCount := len(resp.Executions)
+		batchCount := len(executions)
 		if batchCount <= 0 {
 			break
 		}
 
 		// send all tasks
-		for _, wf := range resp.Executions {
+		for _, wf := range executions {
 			taskCh <- taskDetail{
-				execution: *wf.Execution,
+				execution: wf,
 				attempts:  0,
 				hbd:       hbd,
 			}
 		}
 
-		succCount := 0
-		errCount := 0
-		// wait for counters indicate this batch is done
-	Loop:
-		for {
-			select {
-			case err := <-respCh:
-				if err == nil {
-					succCount++
-				} else {
-					errCount++
-				}
-				if succCount+errCount == batchCount {
-					break Loop
-				}
-			case <-ctx.Done():
-				return HeartBeatDetails{}, ctx.Err()
-			}
+		// wait for outcomes indicate this batch is done
+		outcomes, ok := waitForOutcomes(ctx, respCh, batchCount)
+		if !ok {
+			return HeartBeatDetails{}, ctx.Err()
 		}
 
+		recordOutcomes(ctx, batcher, &hbd, outcomes)
 		hbd.CurrentPage++
-		hbd.PageToken = resp.NextPageToken
-		hbd.SuccessCount += succCount
-		hbd.ErrorCount += errCount
+		hbd.PageToken = nextPageToken
 		activity.RecordHeartbeat(ctx, hbd)
 
 		if len(hbd.PageToken) == 0 {
@@ -224,6 +223,115 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	return hbd, nil
 }
 
+// listWorkflows returns a page of the workflows to process and the token of the next page
+func listWorkflows(
+	ctx context.Context,
+	batcher *Batcher,
+	client frontend.Client,
+	batchParams BatchParams,
+	pageToken []byte,
+) ([]types.WorkflowExecution, []byte, error) {
+	if batchParams.RetryFailedJobID != "" {
+		if batcher.blobstoreClient == nil {
+			return nil, nil, cadence.NewCustomError(_nonRetriableReason, "blobstore is not configured, the report of the batch job to retry is not available")
+		}
+		return listFailedWorkflows(ctx, batcher.blobstoreClient, getActivityLogger(ctx), batchParams.RetryFailedJobID, batchParams.RetryFailedPageCount, pageToken)
+	}
+
+	resp, err := client.ScanWorkflowExecutions(ctx, &types.ListWorkflowExecutionsRequest{
+		Domain:        batchParams.DomainName,
+		PageSize:      int32(batchParams.PageSize),
+		NextPageToken: pageToken,
+		Query:         batchParams.Query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]types.WorkflowExecution, 0, len(resp.Executions))
+	for _, wf := range resp.Executions {
+		executions = append(executions, *wf.Execution)
+	}
+	return executions, resp.NextPageToken, nil
+}
+
+// dryRun returns a page of the workflows to process as a sample without processing them
+func dryRun(
+	ctx context.Context,
+	batcher *Batcher,
+	client frontend.Client,
+	batchParams BatchParams,
+	hbd HeartBeatDetails,
+) (HeartBeatDetails, error) {
+	executions, _, err := listWorkflows(ctx, batcher, client, batchParams, nil)
+	if err != nil {
+		return HeartBeatDetails{}, err
+	}
+	hbd.Sample = executions
+	return hbd, nil
+}
+
+// waitForOutcomes waits for the outcomes of count workflows sent to the task processors, it returns false if ctx is done first
+func waitForOutcomes(ctx context.Context, respCh chan WorkflowOutcome, count int) ([]WorkflowOutcome, bool) {
+	outcomes := make([]WorkflowOutcome, 0, count)
+	for len(outcomes) < count {
+		select {
+		case outcome := <-respCh:
+			outcomes = append(outcomes, outcome)
+		case <-ctx.Done():
+			return nil, false
+		}
+	}
+	return outcomes, true
+}
+
+// recordOutcomes adds the outcomes of the current page to the counters of hbd and writes them to the report of the batch job
+func recordOutcomes(ctx context.Context, batcher *Batcher, hbd *HeartBeatDetails, outcomes []WorkflowOutcome) {
+	for _, outcome := range outcomes {
+		switch outcome.Outcome {
+		case OutcomeSucceeded:
+			hbd.SuccessCount++
+		case OutcomeSkipped:
+			hbd.SkippedCount++
+		case OutcomeFailed:
+			hbd.ErrorCount++
+		}
+	}
+	hbd.RecentFailures = appendRecentFailures(hbd.RecentFailures, outcomes)
+
+	if batcher.blobstoreClient == nil {
+		return
+	}
+	jobID := activity.GetInfo(ctx).WorkflowExecution.ID
+	if err := writeReport(ctx, batcher.blobstoreClient, jobID, hbd.CurrentPage, outcomes); err != nil {
+		// the report is best effort, the batch job keeps going without it
+		getActivityLogger(ctx).Error("Failed to write batch operation report", tag.Error(err), tag.Counter(hbd.CurrentPage))
+	}
+}
+
+// getAdminClient returns the admin client needed by the batch type, it returns nil if the batch type doesn't need one
+func getAdminClient(batcher *Batcher, batchParams BatchParams) (admin.Client, error) {
+	currentCluster := batcher.cfg.ClusterMetadata.GetCurrentClusterName()
+	switch batchParams.BatchType {
+	case BatchTypeReplicate:
+		if currentCluster != batchParams.ReplicateParams.SourceCluster {
+			return nil, cadence.NewCustomError(_nonRetriableReason, fmt.Sprintf("the activity must run in the source cluster, current cluster is %s", currentCluster))
+		}
+		adminClient, err := batcher.clientBean.GetRemoteAdminClient(batchParams.ReplicateParams.TargetCluster)
+		if err != nil {
+			return nil, cadence.NewCustomError(_nonRetriableReason, err.Error())
+		}
+		return adminClient, nil
+	case BatchTypeDelete:
+		adminClient, err := batcher.clientBean.GetRemoteAdminClient(currentCluster)
+		if err != nil {
+			return nil, cadence.NewCustomError(_nonRetriableReason, err.Error())
+		}
+		return adminClient, nil
+	default:
+		return nil, nil
+	}
+}
+
 func getHeartBeatDetails(ctx context.Context) (hbd HeartBeatDetails, ok bool) {
 	if activity.HasHeartbeatDetails(ctx) {
 		if err := activity.GetHeartbeatDetails(ctx, &hbd); err != nil {
@@ -243,7 +351,7 @@ func startTaskProcessor(
 	batchParams BatchParams,
 	domainID string,
 	taskCh chan taskDetail,
-	respCh chan error,
+	respCh chan WorkflowOutcome,
 	limiter *rate.Limiter,
 	client frontend.Client,
 	adminClient admin.Client,
@@ -315,22 +423,56 @@ func startTaskProcessor(
 							RemoteCluster: batchParams.ReplicateParams.SourceCluster,
 						})
 					})
+			case BatchTypeReset:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return resetWorkflow(ctx, client, batchParams, workflowID, runID, identity)
+					})
+			case BatchTypeDelete:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						_, err := adminClient.DeleteWorkflow(ctx, &types.AdminDeleteWorkflowRequest{
+							Domain: batchParams.DomainName,
+							Execution: &types.WorkflowExecution{
+								WorkflowID: workflowID,
+								RunID:      runID,
+							},
+							SkipErrors: batchParams.DeleteParams.SkipErrors,
+						})
+						return err
+					})
+			case BatchTypeRefreshTasks:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return client.RefreshWorkflowTasks(ctx, &types.RefreshWorkflowTasksRequest{
+							Domain: batchParams.DomainName,
+							Execution: &types.WorkflowExecution{
+								WorkflowID: workflowID,
+								RunID:      runID,
+							},
+						})
+					})
 			}
-			if err != nil {
+			switch err.(type) {
+			case nil:
+				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorSuccess)
+				respCh <- newWorkflowOutcome(task.execution, nil)
+			case *skippedError:
+				respCh <- newWorkflowOutcome(task.execution, err)
+			default:
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
 				getActivityLogger(ctx).Error("Failed to process batch operation task", tag.Error(err))
 
 				_, ok := batchParams._nonRetryableErrors[err.Error()]
-				if ok || task.attempts >= batchParams.AttemptsOnRetryableError {
-					respCh <- err
+				// BadRequestError is not going to succeed on retries, e.g. when the workflow has no reset point of the reset type
+				_, isBadRequest := err.(*types.BadRequestError)
+				if ok || isBadRequest || task.attempts >= batchParams.AttemptsOnRetryableError {
+					respCh <- newWorkflowOutcome(task.execution, err)
 				} else {
 					// put back to the channel if less than attemptsOnError
 					task.attempts++
 					taskCh <- task
 				}
-			} else {
-				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorSuccess)
-				respCh <- nil
 			}
 		}
 	}
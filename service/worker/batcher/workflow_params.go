@@ -30,9 +30,12 @@ func validateParams(params BatchParams) error {
 	if params.BatchType == "" ||
 		params.Reason == "" ||
 		params.DomainName == "" ||
-		params.Query == "" {
+		(params.Query == "" && params.RetryFailedJobID == "") {
 		return fmt.Errorf("must provide required parameters: BatchType/Reason/DomainName/Query")
 	}
+	if params.RetryFailedJobID != "" && params.RetryFailedPageCount <= 0 {
+		return fmt.Errorf("must provide the page count of the report of the batch job to retry")
+	}
 	switch params.BatchType {
 	case BatchTypeSignal:
 		if params.SignalParams.SignalName == "" {
@@ -47,15 +50,39 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide target cluster")
 		}
 		return nil
-	case BatchTypeCancel:
-		fallthrough
-	case BatchTypeTerminate:
+	case BatchTypeReset:
+		return validateResetParams(params.ResetParams)
+	case BatchTypeCancel, BatchTypeTerminate, BatchTypeDelete, BatchTypeRefreshTasks:
 		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
 	}
 }
 
+func validateResetParams(params ResetParams) error {
+	switch params.ResetType {
+	case ResetTypeBadBinary:
+		if params.BadBinaryChecksum == "" {
+			return fmt.Errorf("must provide bad binary checksum")
+		}
+	case ResetTypeDecisionCompletedTime:
+		if params.EarliestTime <= 0 {
+			return fmt.Errorf("must provide earliest time")
+		}
+	case ResetTypeFirstDecisionCompleted,
+		ResetTypeLastDecisionCompleted,
+		ResetTypeLastContinuedAsNew,
+		ResetTypeFirstDecisionScheduled,
+		ResetTypeLastDecisionScheduled:
+	default:
+		return fmt.Errorf("not supported reset type: %v", params.ResetType)
+	}
+	if params.DecisionOffset > 0 {
+		return fmt.Errorf("only decision offset <= 0 is supported")
+	}
+	return nil
+}
+
 func setDefaultParams(params BatchParams) BatchParams {
 	if params.RPS <= 0 {
 		params.RPS = DefaultRPS
@@ -66,6 +93,9 @@ func setDefaultParams(params BatchParams) BatchParams {
 	if params.PageSize <= 0 {
 		params.PageSize = DefaultPageSize
 	}
+	if params.PagesPerRun <= 0 {
+		params.PagesPerRun = DefaultPagesPerRun
+	}
 	if params.AttemptsOnRetryableError <= 0 {
 		params.AttemptsOnRetryableError = DefaultAttemptsOnRetryableError
 	}
@@ -32,19 +32,24 @@ import (
 	"github.com/uber-go/tally"
 	"go.uber.org/cadence/testsuite"
 	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
 	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/metrics"
 	mmocks "github.com/uber/cadence/common/metrics/mocks"
+	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/types"
 )
 
 type workflowSuite struct {
 	suite.Suite
 	testsuite.WorkflowTestSuite
-	workflowEnv *testsuite.TestWorkflowEnvironment
-	activityEnv *testsuite.TestActivityEnvironment
+	workflowEnv  *testsuite.TestWorkflowEnvironment
+	activityEnv  *testsuite.TestActivityEnvironment
+	batcher      *Batcher
+	mockResource *resource.Test
 }
 
 func TestWorkflowSuite(t *testing.T) {
@@ -59,6 +64,8 @@ func (s *workflowSuite) SetupTest() {
 	s.activityEnv.RegisterActivity(BatchActivity)
 
 	batcher, mockResource := setuptest(s.T())
+	s.batcher = batcher
+	s.mockResource = mockResource
 
 	metricsMock := &mmocks.Client{}
 	metricsMock.On("IncCounter", metrics.BatcherScope, metrics.BatcherProcessorSuccess).Once()
@@ -74,8 +81,14 @@ func (s *workflowSuite) SetupTest() {
 	mockResource.FrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{}, nil).AnyTimes()
 	mockResource.FrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	mockResource.FrontendClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockResource.FrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{Events: []*types.HistoryEvent{{ID: 4, EventType: types.EventTypeDecisionTaskCompleted.Ptr()}}},
+	}, nil).AnyTimes()
+	mockResource.FrontendClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.ResetWorkflowExecutionResponse{}, nil).AnyTimes()
+	mockResource.FrontendClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	mockResource.RemoteAdminClient.EXPECT().ResendReplicationTasks(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockResource.RemoteAdminClient.EXPECT().DeleteWorkflow(gomock.Any(), gomock.Any()).Return(&types.AdminDeleteWorkflowResponse{}, nil).AnyTimes()
 
 	ctx := context.WithValue(context.Background(), BatcherContextKey, batcher)
 	workerOpts := worker.Options{
@@ -96,6 +109,17 @@ func (s *workflowSuite) TestWorkflow() {
 	s.NoError(s.workflowEnv.GetWorkflowError())
 }
 
+func (s *workflowSuite) TestWorkflow_ContinueAsNew() {
+	params := createParams(BatchTypeCancel)
+	activityHeartBeatDeatils := HeartBeatDetails{PageToken: []byte("next-page"), CurrentPage: 1, SuccessCount: 10}
+	s.workflowEnv.OnActivity(batchActivityName, mock.Anything, mock.Anything).Return(activityHeartBeatDeatils, nil)
+	s.workflowEnv.ExecuteWorkflow(BatchWorkflow, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	var continueAsNewErr *workflow.ContinueAsNewError
+	s.ErrorAs(s.workflowEnv.GetWorkflowError(), &continueAsNewErr)
+	s.Equal(BatchWFTypeName, continueAsNewErr.WorkflowType().Name)
+}
+
 func (s *workflowSuite) TestActivity_BatchCancel() {
 	params := createParams(BatchTypeCancel)
 	_, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
@@ -120,6 +144,104 @@ func (s *workflowSuite) TestActivity_BatchReplicate() {
 	s.NoError(err)
 }
 
+func (s *workflowSuite) TestActivity_BatchReset() {
+	params := createParams(BatchTypeReset)
+	_, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.NoError(err)
+}
+
+func (s *workflowSuite) TestActivity_BatchDelete() {
+	params := createParams(BatchTypeDelete)
+	_, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.NoError(err)
+}
+
+func (s *workflowSuite) TestActivity_BatchRefreshTasks() {
+	params := createParams(BatchTypeRefreshTasks)
+	_, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.NoError(err)
+}
+
+func (s *workflowSuite) TestActivity_ResumeFromProgress() {
+	params := createParams(BatchTypeCancel)
+	params.Progress = &HeartBeatDetails{PageToken: []byte("next-page"), CurrentPage: 1, TotalEstimate: 2, SuccessCount: 1}
+	val, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.NoError(err)
+	var hbd HeartBeatDetails
+	s.NoError(val.Get(&hbd))
+	s.Equal(2, hbd.CurrentPage)
+	s.Equal(2, hbd.SuccessCount)
+	s.Equal(int64(2), hbd.TotalEstimate)
+}
+
+func (s *workflowSuite) TestActivity_DryRun() {
+	params := createParams(BatchTypeTerminate)
+	params.DryRun = true
+	val, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.NoError(err)
+	var hbd HeartBeatDetails
+	s.NoError(val.Get(&hbd))
+	s.Equal(int64(1), hbd.TotalEstimate)
+	s.Equal([]types.WorkflowExecution{{WorkflowID: "wid", RunID: "rid"}}, hbd.Sample)
+	s.Zero(hbd.SuccessCount)
+	s.Zero(hbd.CurrentPage)
+}
+
+func (s *workflowSuite) TestActivity_WriteReport() {
+	s.batcher.blobstoreClient = s.mockResource.BlobstoreClient
+	s.mockResource.BlobstoreClient.EXPECT().Put(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *blobstore.PutRequest) (*blobstore.PutResponse, error) {
+			s.Contains(req.Key, "_0."+reportExtension)
+			s.JSONEq(`[{"WorkflowID":"wid","RunID":"rid","Outcome":"succeeded"}]`, string(req.Blob.Body))
+			return &blobstore.PutResponse{}, nil
+		}).Times(1)
+
+	params := createParams(BatchTypeTerminate)
+	val, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.NoError(err)
+	var hbd HeartBeatDetails
+	s.NoError(val.Get(&hbd))
+	s.Equal(1, hbd.SuccessCount)
+}
+
+func (s *workflowSuite) TestActivity_RetryFailed() {
+	s.batcher.blobstoreClient = s.mockResource.BlobstoreClient
+	s.mockResource.BlobstoreClient.EXPECT().Exists(gomock.Any(), &blobstore.ExistsRequest{Key: ReportKey("failed-job-id", 0)}).
+		Return(&blobstore.ExistsResponse{Exists: true}, nil).Times(1)
+	s.mockResource.BlobstoreClient.EXPECT().Get(gomock.Any(), &blobstore.GetRequest{Key: ReportKey("failed-job-id", 0)}).
+		Return(&blobstore.GetResponse{Blob: blobstore.Blob{
+			Body: []byte(`[{"WorkflowID":"wid1","RunID":"rid1","Outcome":"failed","Reason":"some error"},{"WorkflowID":"wid2","RunID":"rid2","Outcome":"succeeded"}]`),
+		}}, nil).Times(1)
+	s.mockResource.BlobstoreClient.EXPECT().Exists(gomock.Any(), &blobstore.ExistsRequest{Key: ReportKey("failed-job-id", 1)}).
+		Return(&blobstore.ExistsResponse{Exists: false}, nil).Times(1)
+	s.mockResource.BlobstoreClient.EXPECT().Put(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *blobstore.PutRequest) (*blobstore.PutResponse, error) {
+			s.JSONEq(`[{"WorkflowID":"wid1","RunID":"rid1","Outcome":"succeeded"}]`, string(req.Blob.Body))
+			return &blobstore.PutResponse{}, nil
+		}).Times(1)
+
+	params := createParams(BatchTypeTerminate)
+	params.Query = ""
+	params.RetryFailedJobID = "failed-job-id"
+	// page 1 of the report is missing and skipped
+	params.RetryFailedPageCount = 2
+	val, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.NoError(err)
+	var hbd HeartBeatDetails
+	s.NoError(val.Get(&hbd))
+	s.Equal(1, hbd.SuccessCount)
+	s.Equal(1, hbd.CurrentPage)
+	s.Zero(hbd.TotalEstimate)
+}
+
+func (s *workflowSuite) TestActivity_RetryFailedWithoutBlobstore() {
+	params := createParams(BatchTypeTerminate)
+	params.RetryFailedJobID = "failed-job-id"
+	params.RetryFailedPageCount = 1
+	_, err := s.activityEnv.ExecuteActivity(BatchActivity, params)
+	s.ErrorContains(err, _nonRetriableReason)
+}
+
 func (s *workflowSuite) TestWorkflow_BatchTypeCancelValidationError() {
 	params := createParams(BatchTypeCancel)
 	params.Query = ""
@@ -128,6 +250,15 @@ func (s *workflowSuite) TestWorkflow_BatchTypeCancelValidationError() {
 	s.ErrorContains(s.workflowEnv.GetWorkflowError(), "must provide required parameters: BatchType/Reason/DomainName/Query")
 }
 
+func (s *workflowSuite) TestWorkflow_RetryFailedWithoutPageCount() {
+	params := createParams(BatchTypeTerminate)
+	params.Query = ""
+	params.RetryFailedJobID = "failed-job-id"
+	s.workflowEnv.ExecuteWorkflow(BatchWorkflow, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.ErrorContains(s.workflowEnv.GetWorkflowError(), "must provide the page count of the report of the batch job to retry")
+}
+
 func (s *workflowSuite) TestWorkflow_UnsupportedBatchType() {
 	params := createParams("invalid-batch-type")
 	s.workflowEnv.ExecuteWorkflow(BatchWorkflow, params)
@@ -159,6 +290,14 @@ func (s *workflowSuite) TestWorkflow_BatchTypeReplicateTargetCLusterValidation()
 	s.ErrorContains(s.workflowEnv.GetWorkflowError(), "must provide target cluster")
 }
 
+func (s *workflowSuite) TestWorkflow_BatchTypeResetValidation() {
+	params := createParams(BatchTypeReset)
+	params.ResetParams.ResetType = ResetTypeBadBinary
+	s.workflowEnv.ExecuteWorkflow(BatchWorkflow, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.ErrorContains(s.workflowEnv.GetWorkflowError(), "must provide bad binary checksum")
+}
+
 func (s *workflowSuite) TearDownTest() {
 	s.workflowEnv.AssertExpectations(s.T())
 }
@@ -182,6 +321,9 @@ func createParams(batchType string) BatchParams {
 			SourceCluster: "test-primary-cluster",
 			TargetCluster: "test-secondary-cluster",
 		},
+		ResetParams: ResetParams{
+			ResetType: ResetTypeLastDecisionCompleted,
+		},
 		RPS:                      5,
 		Concurrency:              5,
 		PageSize:                 10,
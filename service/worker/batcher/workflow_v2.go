@@ -22,7 +22,6 @@ package batcher
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"go.uber.org/cadence"
@@ -30,7 +29,6 @@ import (
 	"go.uber.org/cadence/workflow"
 	"golang.org/x/time/rate"
 
-	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -110,6 +108,11 @@ func BatchWorkflowV2(ctx workflow.Context, batchParams BatchParams) (HeartBeatDe
 
 		if activityDone {
 			cancel()
+			if actErr == nil && len(result.PageToken) > 0 {
+				// the activity stopped after PagesPerRun pages, continue as new with the progress and the tuned parameters
+				params.Progress = &result
+				return result, workflow.NewContinueAsNewError(ctx, BatchWFV2TypeName, params)
+			}
 			return result, actErr
 		}
 
@@ -137,23 +140,15 @@ func BatchWorkflowV2(ctx workflow.Context, batchParams BatchParams) (HeartBeatDe
 
 // batchActivityV2 is the V2 activity for processing batch operations.
 // Compared to V1 (BatchActivity), it:
-//   - Accepts progress from a prior cancelled activity via batchParamsV2.Progress
+//   - Accepts progress from a prior cancelled activity or a previous run via BatchParams.Progress
 //   - Returns current HeartBeatDetails on scan errors for resumability
 //   - Returns CanceledError with progress when context is cancelled
 func batchActivityV2(ctx context.Context, params BatchParams) (HeartBeatDetails, error) {
 	batcher := ctx.Value(BatcherContextKey).(*Batcher)
 	client := batcher.clientBean.GetFrontendClient()
-	var adminClient admin.Client
-	if params.BatchType == BatchTypeReplicate {
-		currentCluster := batcher.cfg.ClusterMetadata.GetCurrentClusterName()
-		if currentCluster != params.ReplicateParams.SourceCluster {
-			return HeartBeatDetails{}, cadence.NewCustomError(_nonRetriableReason, fmt.Sprintf("the activity must run in the source cluster, current cluster is %s", currentCluster))
-		}
-		var err error
-		adminClient, err = batcher.clientBean.GetRemoteAdminClient(params.ReplicateParams.TargetCluster)
-		if err != nil {
-			return HeartBeatDetails{}, cadence.NewCustomError(_nonRetriableReason, err.Error())
-		}
+	adminClient, err := getAdminClient(batcher, params)
+	if err != nil {
+		return HeartBeatDetails{}, err
 	}
 
 	domainResp, err := client.DescribeDomain(ctx, &types.DescribeDomainRequest{
@@ -171,7 +166,8 @@ func batchActivityV2(ctx context.Context, params BatchParams) (HeartBeatDetails,
 		hbd = *params.Progress
 	}
 
-	if hbd.TotalEstimate == 0 {
+	// the workflows to retry are not known until the report is read, so they are not counted
+	if hbd.TotalEstimate == 0 && params.RetryFailedJobID == "" {
 		resp, err := client.CountWorkflowExecutions(ctx, &types.CountWorkflowExecutionsRequest{
 			Domain: params.DomainName,
 			Query:  params.Query,
@@ -181,59 +177,46 @@ func batchActivityV2(ctx context.Context, params BatchParams) (HeartBeatDetails,
 		}
 		hbd.TotalEstimate = resp.GetCount()
 	}
+	if params.DryRun {
+		return dryRun(ctx, batcher, client, params, hbd)
+	}
 
 	rateLimiter := rate.NewLimiter(rate.Limit(params.RPS), params.RPS)
 	taskCh := make(chan taskDetail, params.PageSize)
-	respCh := make(chan error, params.PageSize)
+	respCh := make(chan WorkflowOutcome, params.PageSize)
 	for i := 0; i < params.Concurrency; i++ {
 		go startTaskProcessor(ctx, params, domainID, taskCh, respCh, rateLimiter, client, adminClient, BatchWFV2TypeName)
 	}
 
-	for {
-		resp, err := client.ScanWorkflowExecutions(ctx, &types.ListWorkflowExecutionsRequest{
-			Domain:        params.DomainName,
-			PageSize:      int32(params.PageSize),
-			NextPageToken: hbd.PageToken,
-			Query:         params.Query,
-		})
+	for pages := 0; ; pages++ {
+		if params.PagesPerRun > 0 && pages >= params.PagesPerRun {
+			// return with the page token, the workflow continues as new from here
+			return hbd, nil
+		}
+		executions, nextPageToken, err := listWorkflows(ctx, batcher, client, params, hbd.PageToken)
 		if err != nil {
 			return hbd, err
 		}
-		batchCount := len(resp.Executions)
+		batchCount := len(executions)
 		if batchCount <= 0 {
 			break
 		}
 
-		for _, wf := range resp.Executions {
+		for _, wf := range executions {
 			taskCh <- taskDetail{
-				execution: *wf.Execution,
+				execution: wf,
 				attempts:  0,
 				hbd:       hbd,
 			}
 		}
 
-		succCount := 0
-		errCount := 0
-	Loop:
-		for {
-			select {
-			case err := <-respCh:
-				if err == nil {
-					succCount++
-				} else {
-					errCount++
-				}
-				if succCount+errCount == batchCount {
-					break Loop
-				}
-			case <-ctx.Done():
-				return hbd, cadence.NewCanceledError(hbd)
-			}
+		outcomes, ok := waitForOutcomes(ctx, respCh, batchCount)
+		if !ok {
+			return hbd, cadence.NewCanceledError(hbd)
 		}
-		hbd.SuccessCount += succCount
-		hbd.ErrorCount += errCount
+		recordOutcomes(ctx, batcher, &hbd, outcomes)
 		hbd.CurrentPage++
-		hbd.PageToken = resp.NextPageToken
+		hbd.PageToken = nextPageToken
 		activity.RecordHeartbeat(ctx, hbd)
 
 		if ctx.Err() != nil {
@@ -99,6 +99,15 @@ func TestBatchWorkflowV2(t *testing.T) {
 				assert.Equal(t, int64(12), result.TotalEstimate)
 			},
 		},
+		{
+			name:   "activity stops with a page token and continues as new",
+			params: createParams(BatchTypeTerminate),
+			setupEnv: func(env *testsuite.TestWorkflowEnvironment) {
+				env.OnActivity(batchActivityV2Name, mock.Anything, mock.Anything).
+					Return(HeartBeatDetails{PageToken: []byte("next-page"), SuccessCount: 10, CurrentPage: 1}, nil)
+			},
+			wantErr: "ContinueAsNew",
+		},
 	}
 
 	for _, tt := range tests {
@@ -58,10 +58,19 @@ func (w *dw) identifyIssues(ctx context.Context, info identifyIssuesParams) ([]i
 		return nil, err
 	}
 
+	description, err := w.clientBean.GetFrontendClient().DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    info.Domain,
+		Execution: info.Execution,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe workflow: %w", err)
+	}
+
 	for _, inv := range w.invariants {
 		issues, err := inv.Check(ctx, invariant.InvariantCheckInput{
-			WorkflowExecutionHistory: history,
-			Domain:                   info.Domain,
+			WorkflowExecutionHistory:     history,
+			WorkflowExecutionDescription: description,
+			Domain:                       info.Domain,
 		})
 		if err != nil {
 			return nil, err
@@ -25,6 +25,7 @@ package diagnostics
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
@@ -138,6 +139,7 @@ func testDiagnosticWorkflow(t *testing.T, history *types.GetWorkflowExecutionHis
 	mockFrontendClient := frontend.NewMockClient(ctrl)
 	mockClientBean.EXPECT().GetFrontendClient().Return(mockFrontendClient).AnyTimes()
 	mockFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(history, nil).AnyTimes()
+	mockFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{}, nil).AnyTimes()
 	return &dw{
 		clientBean: mockClientBean,
 		invariants: []invariant.Invariant{failure.NewInvariant(), retry.NewInvariant()},
@@ -295,6 +297,9 @@ func Test__identifyIssuesWithPaginatedHistory(t *testing.T) {
 		HistoryEventFilterType: types.HistoryEventFilterTypeAllEvent.Ptr(),
 		SkipArchival:           true,
 	}).Return(remainingWFHistoryResponse, nil).After(firstCall)
+	mockFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &types.DescribeWorkflowExecutionRequest{
+		Execution: testExecution,
+	}).Return(&types.DescribeWorkflowExecutionResponse{}, nil)
 
 	retryMetadata := retry.RetryMetadata{
 		EventID: 1,
@@ -331,3 +336,23 @@ func Test__identifyIssuesWithPaginatedHistory(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedResult, result)
 }
+
+func Test__identifyIssuesDescribeError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockClientBean := client.NewMockBean(ctrl)
+	mockFrontendClient := frontend.NewMockClient(ctrl)
+	mockClientBean.EXPECT().GetFrontendClient().Return(mockFrontendClient).AnyTimes()
+	mockFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(testWorkflowExecutionHistoryResponse(), nil)
+	mockFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, errors.New("describe error"))
+
+	dwtest := &dw{
+		clientBean: mockClientBean,
+		invariants: []invariant.Invariant{retry.NewInvariant(), failure.NewInvariant()},
+	}
+
+	_, err := dwtest.identifyIssues(context.Background(), identifyIssuesParams{Execution: &types.WorkflowExecution{
+		WorkflowID: "123",
+		RunID:      "abc",
+	}})
+	require.ErrorContains(t, err, "describe error")
+}
@@ -55,6 +55,7 @@ func (et *emitter) EmitUsageData(ctx context.Context, data WfDiagnosticsUsageDat
 	msg[Identity] = data.Identity
 	msg[SatisfactionFeedback] = data.SatisfactionFeedback
 	msg[IssueType] = data.IssueType
+	msg[RootCauses] = data.RootCauses
 	msg[DiagnosticsWfID] = data.DiagnosticsWorkflowID
 	msg[DiagnosticsWfRunID] = data.DiagnosticsRunID
 	msg[Environment] = data.Environment
@@ -24,6 +24,7 @@ package analytics
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -43,6 +44,7 @@ func Test__EmitUsageData(t *testing.T) {
 		RunID:                 "rid",
 		Identity:              "test@uber.com",
 		IssueType:             "timeout",
+		RootCauses:            []string{"There are no pollers for the tasklist"},
 		DiagnosticsWorkflowID: "diagnostics-wid",
 		DiagnosticsRunID:      "diagnostics-rid",
 		Environment:           "test-env",
@@ -61,6 +63,9 @@ func Test__EmitUsageData(t *testing.T) {
 			producerMockAffordance: func(mockProducer *mocks.KafkaProducer) {
 				mockProducer.On("Publish", mock.Anything, mock.MatchedBy(func(input *indexer.PinotMessage) bool {
 					require.Equal(t, testdata.DiagnosticsWorkflowID, input.GetWorkflowID())
+					var payload map[string]interface{}
+					require.NoError(t, json.Unmarshal(input.Payload, &payload))
+					require.Equal(t, []interface{}{"There are no pollers for the tasklist"}, payload[RootCauses])
 					return true
 				})).Return(nil).Once()
 			},
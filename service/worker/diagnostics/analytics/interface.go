@@ -33,6 +33,7 @@ type WfDiagnosticsUsageData struct {
 	RunID                 string
 	Identity              string
 	IssueType             string
+	RootCauses            []string
 	DiagnosticsWorkflowID string
 	DiagnosticsRunID      string
 	Environment           string
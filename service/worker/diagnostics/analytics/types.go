@@ -28,6 +28,7 @@ const (
 	RunID                = "runID"
 	Identity             = "identity"
 	IssueType            = "issue_type"
+	RootCauses           = "root_causes"
 	DiagnosticsWfID      = "diagnostics_workflowID"
 	DiagnosticsWfRunID   = "diagnostics_workflow_runID"
 	SatisfactionFeedback = "satisfaction_feedback"
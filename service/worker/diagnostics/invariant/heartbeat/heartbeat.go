@@ -0,0 +1,138 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package heartbeat
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+)
+
+const (
+	// activities without heartbeat timeout that haven't recorded a heartbeat for longer than this are reported
+	_noHeartbeatThreshold = 10 * time.Minute
+)
+
+// Heartbeat is an invariant that will be used to identify the pending activities of the workflow execution that are not making heartbeat progress
+type Heartbeat invariant.Invariant
+
+type heartbeat struct {
+	timeSource clock.TimeSource
+}
+
+type Params struct {
+	TimeSource clock.TimeSource
+}
+
+func NewInvariant(p Params) Heartbeat {
+	return &heartbeat{
+		timeSource: p.TimeSource,
+	}
+}
+
+func (h *heartbeat) Check(ctx context.Context, params invariant.InvariantCheckInput) ([]invariant.InvariantCheckResult, error) {
+	result := make([]invariant.InvariantCheckResult, 0)
+	events := params.WorkflowExecutionHistory.GetHistory().GetEvents()
+	now := h.timeSource.Now()
+	issueID := 0
+	for _, activity := range params.WorkflowExecutionDescription.GetPendingActivities() {
+		if activity.GetState() != types.PendingActivityStateStarted || activity.LastStartedTimestamp == nil {
+			continue
+		}
+		scheduled := fetchActivityScheduledEvent(activity.GetScheduleID(), events)
+		metadata := HeartbeatMetadata{
+			ActivityID:            activity.GetActivityID(),
+			ActivityType:          activity.ActivityType.GetName(),
+			ScheduledEventID:      activity.GetScheduleID(),
+			Attempt:               activity.GetAttempt(),
+			HeartbeatTimeout:      time.Duration(scheduled.GetHeartbeatTimeoutSeconds()) * time.Second,
+			StartedWorkerIdentity: activity.GetStartedWorkerIdentity(),
+		}
+
+		var reason IssueType
+		if metadata.HeartbeatTimeout == 0 {
+			// without heartbeat timeout, an activity that stopped making progress is not detected until it times out
+			lastStarted := *activity.LastStartedTimestamp
+			lastHeartbeat := activity.GetLastHeartbeatTimestamp()
+			reason = NoHeartbeatRecorded
+			if lastHeartbeat > lastStarted {
+				reason = HeartbeatStalled
+			} else {
+				lastHeartbeat = lastStarted
+			}
+			metadata.TimeSinceLastHeartbeat = now.Sub(time.Unix(0, lastHeartbeat))
+			if metadata.TimeSinceLastHeartbeat < _noHeartbeatThreshold {
+				continue
+			}
+		} else if activity.GetAttempt() > 0 && len(activity.GetHeartbeatDetails()) == 0 {
+			reason = RetriedWithoutHeartbeatDetails
+		} else {
+			continue
+		}
+
+		result = append(result, invariant.InvariantCheckResult{
+			IssueID:       issueID,
+			InvariantType: ActivityNoHeartbeatProgress.String(),
+			Reason:        reason.String(),
+			Metadata:      invariant.MarshalData(metadata),
+		})
+		issueID++
+	}
+	return result, nil
+}
+
+func fetchActivityScheduledEvent(scheduledEventID int64, events []*types.HistoryEvent) *types.ActivityTaskScheduledEventAttributes {
+	for _, event := range events {
+		if event.ID == scheduledEventID {
+			return event.GetActivityTaskScheduledEventAttributes()
+		}
+	}
+	return nil
+}
+
+func (h *heartbeat) RootCause(ctx context.Context, params invariant.InvariantRootCauseInput) ([]invariant.InvariantRootCauseResult, error) {
+	result := make([]invariant.InvariantRootCauseResult, 0)
+	for _, issue := range params.Issues {
+		if issue.InvariantType != ActivityNoHeartbeatProgress.String() {
+			continue
+		}
+		switch issue.Reason {
+		case NoHeartbeatRecorded.String(), HeartbeatStalled.String():
+			result = append(result, invariant.InvariantRootCauseResult{
+				IssueID:   issue.IssueID,
+				RootCause: invariant.RootCauseTypeNoHeartbeatTimeoutActivityStuck,
+				Metadata:  issue.Metadata,
+			})
+		case RetriedWithoutHeartbeatDetails.String():
+			result = append(result, invariant.InvariantRootCauseResult{
+				IssueID:   issue.IssueID,
+				RootCause: invariant.RootCauseTypeHeartbeatDetailsNotRecorded,
+				Metadata:  issue.Metadata,
+			})
+		}
+	}
+	return result, nil
+}
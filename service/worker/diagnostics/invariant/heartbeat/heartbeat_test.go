@@ -0,0 +1,204 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package heartbeat
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+)
+
+const (
+	testDomain   = "test-domain"
+	testIdentity = "test-identity"
+)
+
+var testNow = time.Unix(1700000000, 0)
+
+func Test__Check(t *testing.T) {
+	startedTime := testNow.Add(-time.Hour).UnixNano()
+	history := &types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{
+			Events: []*types.HistoryEvent{
+				{ID: 5, ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{ActivityID: "1"}},
+				{ID: 6, ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+					ActivityID:              "2",
+					HeartbeatTimeoutSeconds: common.Int32Ptr(60),
+				}},
+			},
+		},
+	}
+	testCases := []struct {
+		name           string
+		activity       *types.PendingActivityInfo
+		expectedResult []invariant.InvariantCheckResult
+	}{
+		{
+			name: "activity not started",
+			activity: &types.PendingActivityInfo{
+				ActivityID: "1",
+				State:      types.PendingActivityStateScheduled.Ptr(),
+				ScheduleID: 5,
+			},
+			expectedResult: []invariant.InvariantCheckResult{},
+		},
+		{
+			name: "no heartbeat recorded without heartbeat timeout",
+			activity: &types.PendingActivityInfo{
+				ActivityID:             "1",
+				ActivityType:           &types.ActivityType{Name: "test-activity"},
+				State:                  types.PendingActivityStateStarted.Ptr(),
+				LastStartedTimestamp:   common.Int64Ptr(startedTime),
+				LastHeartbeatTimestamp: common.Int64Ptr(startedTime),
+				StartedWorkerIdentity:  testIdentity,
+				ScheduleID:             5,
+			},
+			expectedResult: []invariant.InvariantCheckResult{
+				{
+					IssueID:       0,
+					InvariantType: ActivityNoHeartbeatProgress.String(),
+					Reason:        NoHeartbeatRecorded.String(),
+					Metadata: invariant.MarshalData(HeartbeatMetadata{
+						ActivityID:             "1",
+						ActivityType:           "test-activity",
+						ScheduledEventID:       5,
+						TimeSinceLastHeartbeat: time.Hour,
+						StartedWorkerIdentity:  testIdentity,
+					}),
+				},
+			},
+		},
+		{
+			name: "heartbeat stalled without heartbeat timeout",
+			activity: &types.PendingActivityInfo{
+				ActivityID:             "1",
+				State:                  types.PendingActivityStateStarted.Ptr(),
+				LastStartedTimestamp:   common.Int64Ptr(startedTime),
+				LastHeartbeatTimestamp: common.Int64Ptr(testNow.Add(-30 * time.Minute).UnixNano()),
+				ScheduleID:             5,
+			},
+			expectedResult: []invariant.InvariantCheckResult{
+				{
+					IssueID:       0,
+					InvariantType: ActivityNoHeartbeatProgress.String(),
+					Reason:        HeartbeatStalled.String(),
+					Metadata: invariant.MarshalData(HeartbeatMetadata{
+						ActivityID:             "1",
+						ScheduledEventID:       5,
+						TimeSinceLastHeartbeat: 30 * time.Minute,
+					}),
+				},
+			},
+		},
+		{
+			name: "recent heartbeat without heartbeat timeout",
+			activity: &types.PendingActivityInfo{
+				ActivityID:             "1",
+				State:                  types.PendingActivityStateStarted.Ptr(),
+				LastStartedTimestamp:   common.Int64Ptr(startedTime),
+				LastHeartbeatTimestamp: common.Int64Ptr(testNow.Add(-time.Minute).UnixNano()),
+				ScheduleID:             5,
+			},
+			expectedResult: []invariant.InvariantCheckResult{},
+		},
+		{
+			name: "retried without heartbeat details",
+			activity: &types.PendingActivityInfo{
+				ActivityID:           "2",
+				State:                types.PendingActivityStateStarted.Ptr(),
+				LastStartedTimestamp: common.Int64Ptr(startedTime),
+				Attempt:              2,
+				ScheduleID:           6,
+			},
+			expectedResult: []invariant.InvariantCheckResult{
+				{
+					IssueID:       0,
+					InvariantType: ActivityNoHeartbeatProgress.String(),
+					Reason:        RetriedWithoutHeartbeatDetails.String(),
+					Metadata: invariant.MarshalData(HeartbeatMetadata{
+						ActivityID:       "2",
+						ScheduledEventID: 6,
+						Attempt:          2,
+						HeartbeatTimeout: time.Minute,
+					}),
+				},
+			},
+		},
+		{
+			name: "retried with heartbeat details",
+			activity: &types.PendingActivityInfo{
+				ActivityID:           "2",
+				State:                types.PendingActivityStateStarted.Ptr(),
+				LastStartedTimestamp: common.Int64Ptr(startedTime),
+				HeartbeatDetails:     []byte("progress"),
+				Attempt:              2,
+				ScheduleID:           6,
+			},
+			expectedResult: []invariant.InvariantCheckResult{},
+		},
+	}
+
+	inv := NewInvariant(Params{
+		TimeSource: clock.NewMockedTimeSourceAt(testNow),
+	})
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := inv.Check(context.Background(), invariant.InvariantCheckInput{
+				WorkflowExecutionHistory: history,
+				WorkflowExecutionDescription: &types.DescribeWorkflowExecutionResponse{
+					PendingActivities: []*types.PendingActivityInfo{tc.activity},
+				},
+				Domain: testDomain,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedResult, result)
+		})
+	}
+}
+
+func Test__RootCause(t *testing.T) {
+	metadata := invariant.MarshalData(HeartbeatMetadata{ActivityID: "1"})
+	inv := NewInvariant(Params{})
+	result, err := inv.RootCause(context.Background(), invariant.InvariantRootCauseInput{
+		Domain: testDomain,
+		Issues: []invariant.InvariantCheckResult{
+			{IssueID: 0, InvariantType: ActivityNoHeartbeatProgress.String(), Reason: NoHeartbeatRecorded.String(), Metadata: metadata},
+			{IssueID: 1, InvariantType: ActivityNoHeartbeatProgress.String(), Reason: HeartbeatStalled.String(), Metadata: metadata},
+			{IssueID: 2, InvariantType: ActivityNoHeartbeatProgress.String(), Reason: RetriedWithoutHeartbeatDetails.String(), Metadata: metadata},
+			{IssueID: 3, InvariantType: "other invariant", Reason: NoHeartbeatRecorded.String()},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []invariant.InvariantRootCauseResult{
+		{IssueID: 0, RootCause: invariant.RootCauseTypeNoHeartbeatTimeoutActivityStuck, Metadata: metadata},
+		{IssueID: 1, RootCause: invariant.RootCauseTypeNoHeartbeatTimeoutActivityStuck, Metadata: metadata},
+		{IssueID: 2, RootCause: invariant.RootCauseTypeHeartbeatDetailsNotRecorded, Metadata: metadata},
+	}, result)
+}
@@ -0,0 +1,57 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package heartbeat
+
+import "time"
+
+type HeartbeatType string
+
+const (
+	ActivityNoHeartbeatProgress HeartbeatType = "Activity is not making heartbeat progress"
+)
+
+func (h HeartbeatType) String() string {
+	return string(h)
+}
+
+type IssueType string
+
+const (
+	NoHeartbeatRecorded            IssueType = "Activity has been running without recording any heartbeat"
+	HeartbeatStalled               IssueType = "Activity has stopped recording heartbeats"
+	RetriedWithoutHeartbeatDetails IssueType = "Activity is retried without heartbeat details to resume from"
+)
+
+func (i IssueType) String() string {
+	return string(i)
+}
+
+type HeartbeatMetadata struct {
+	ActivityID             string
+	ActivityType           string
+	ScheduledEventID       int64
+	Attempt                int32
+	HeartbeatTimeout       time.Duration
+	TimeSinceLastHeartbeat time.Duration
+	StartedWorkerIdentity  string
+}
@@ -45,8 +45,9 @@ type InvariantRootCauseResult struct {
 }
 
 type InvariantCheckInput struct {
-	WorkflowExecutionHistory *types.GetWorkflowExecutionHistoryResponse
-	Domain                   string
+	WorkflowExecutionHistory     *types.GetWorkflowExecutionHistoryResponse
+	WorkflowExecutionDescription *types.DescribeWorkflowExecutionResponse
+	Domain                       string
 }
 
 type InvariantRootCauseInput struct {
@@ -66,12 +67,46 @@ const (
 	RootCauseTypeServiceSidePanic                      RootCause = "There is a panic in the activity/workflow that is causing a failure"
 	RootCauseTypeServiceSideCustomError                RootCause = "Customised error returned by the activity/workflow"
 	RootCauseTypeBlobSizeLimit                         RootCause = "Workflow has exceeded the blob size limits configured for the domain"
+	RootCauseTypeNoRecentPollers                       RootCause = "There have been no pollers for the tasklist since the task was scheduled"
+	RootCauseTypePendingTaskBacklog                    RootCause = "There are pollers for the tasklist but the task has not been started. Check backlog status"
+	RootCauseTypeNonDeterministicWorkflowCode          RootCause = "Workflow code is not deterministic or has been changed in a backward incompatible way"
+	RootCauseTypeHistoryCountLimit                     RootCause = "Workflow history is approaching the history count limit configured for the domain"
+	RootCauseTypeHistorySizeLimit                      RootCause = "Workflow history is approaching the history size limit configured for the domain"
+	RootCauseTypePayloadSizeLimit                      RootCause = "Payload of an event is approaching the blob size limit configured for the domain"
+	RootCauseTypeNoHeartbeatTimeoutActivityStuck       RootCause = "Activity is not heartbeating and there is no heartbeat timeout configured to detect it"
+	RootCauseTypeHeartbeatDetailsNotRecorded           RootCause = "Activity does not record its progress in heartbeat details so retries start from the beginning"
 )
 
+var remediations = map[RootCause]string{
+	RootCauseTypeMissingPollers:                        "Start workers polling the tasklist or check the tasklist name configured in the workers",
+	RootCauseTypePollersStatus:                         "Scale up the workers or increase their concurrency if the backlog keeps growing",
+	RootCauseTypeNoHeartBeatTimeoutNoRetryPolicy:       "Configure a heartbeat timeout and a retry policy for long running activities",
+	RootCauseTypeHeartBeatingNotEnabledWithRetryPolicy: "Configure a heartbeat timeout so that stuck activities are detected and retried earlier",
+	RootCauseTypeHeartBeatingEnabledWithoutRetryPolicy: "Configure a retry policy so that the activity is retried after a heartbeat timeout",
+	RootCauseTypeHeartBeatingEnabledMissingHeartbeat:   "Record heartbeats more frequently than the heartbeat timeout or increase the heartbeat timeout",
+	RootCauseTypeServiceSideIssue:                      "Check the worker logs of the identity for the error returned",
+	RootCauseTypeServiceSidePanic:                      "Check the worker logs of the identity for the stack trace of the panic",
+	RootCauseTypeServiceSideCustomError:                "Check the reason and details of the custom error returned by the activity/workflow",
+	RootCauseTypeBlobSizeLimit:                         "Store large data outside of the workflow and pass references to it instead",
+	RootCauseTypeNoRecentPollers:                       "Check that the workers polling the tasklist are running and healthy",
+	RootCauseTypePendingTaskBacklog:                    "Scale up the workers or increase their concurrency if the backlog keeps growing",
+	RootCauseTypeNonDeterministicWorkflowCode:          "Guard the changes of the workflow code with versioning and reset the workflow to a point before the failed decision",
+	RootCauseTypeHistoryCountLimit:                     "Use continue as new to start a new run with a fresh history before the limit is reached",
+	RootCauseTypeHistorySizeLimit:                      "Reduce the size of the payloads and use continue as new to start a new run with a fresh history",
+	RootCauseTypePayloadSizeLimit:                      "Store large data outside of the workflow and pass references to it instead",
+	RootCauseTypeNoHeartbeatTimeoutActivityStuck:       "Record heartbeats in the activity and configure a heartbeat timeout so that stuck activities are retried",
+	RootCauseTypeHeartbeatDetailsNotRecorded:           "Record the progress in heartbeat details and resume from them when the activity is retried",
+}
+
 func (r RootCause) String() string {
 	return string(r)
 }
 
+// Remediation returns the suggested remediation for the root cause
+func (r RootCause) Remediation() string {
+	return remediations[r]
+}
+
 // Invariant represents a condition of a workflow execution.
 type Invariant interface {
 	Check(context.Context, InvariantCheckInput) ([]InvariantCheckResult, error)
@@ -0,0 +1,208 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package limits
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+)
+
+// Limits is an invariant that will be used to identify the workflow histories and payloads approaching the limits configured for the domain
+type Limits invariant.Invariant
+
+type limits struct {
+	historyCountLimitWarn  dynamicproperties.IntPropertyFnWithDomainFilter
+	historyCountLimitError dynamicproperties.IntPropertyFnWithDomainFilter
+	historySizeLimitWarn   dynamicproperties.IntPropertyFnWithDomainFilter
+	historySizeLimitError  dynamicproperties.IntPropertyFnWithDomainFilter
+	blobSizeLimitWarn      dynamicproperties.IntPropertyFnWithDomainFilter
+	blobSizeLimitError     dynamicproperties.IntPropertyFnWithDomainFilter
+}
+
+type Params struct {
+	HistoryCountLimitWarn  dynamicproperties.IntPropertyFnWithDomainFilter
+	HistoryCountLimitError dynamicproperties.IntPropertyFnWithDomainFilter
+	HistorySizeLimitWarn   dynamicproperties.IntPropertyFnWithDomainFilter
+	HistorySizeLimitError  dynamicproperties.IntPropertyFnWithDomainFilter
+	BlobSizeLimitWarn      dynamicproperties.IntPropertyFnWithDomainFilter
+	BlobSizeLimitError     dynamicproperties.IntPropertyFnWithDomainFilter
+}
+
+func NewInvariant(p Params) Limits {
+	return &limits{
+		historyCountLimitWarn:  p.HistoryCountLimitWarn,
+		historyCountLimitError: p.HistoryCountLimitError,
+		historySizeLimitWarn:   p.HistorySizeLimitWarn,
+		historySizeLimitError:  p.HistorySizeLimitError,
+		blobSizeLimitWarn:      p.BlobSizeLimitWarn,
+		blobSizeLimitError:     p.BlobSizeLimitError,
+	}
+}
+
+func (l *limits) Check(ctx context.Context, params invariant.InvariantCheckInput) ([]invariant.InvariantCheckResult, error) {
+	result := make([]invariant.InvariantCheckResult, 0)
+	events := params.WorkflowExecutionHistory.GetHistory().GetEvents()
+	blobSizeLimitWarn := l.blobSizeLimitWarn(params.Domain)
+	blobSizeLimitError := l.blobSizeLimitError(params.Domain)
+	issueID := 0
+
+	if issue, ok := checkLimit(len(events), l.historyCountLimitWarn(params.Domain), l.historyCountLimitError(params.Domain)); ok {
+		result = append(result, invariant.InvariantCheckResult{
+			IssueID:       issueID,
+			InvariantType: HistoryCountLimit.String(),
+			Reason:        issue.Reason,
+			Metadata:      invariant.MarshalData(issue.Metadata),
+		})
+		issueID++
+	}
+
+	// the size of the history is approximated by the total size of the payloads which takes up most of it
+	historySize := 0
+	for _, event := range events {
+		historySize += payloadSize(event)
+	}
+	if issue, ok := checkLimit(historySize, l.historySizeLimitWarn(params.Domain), l.historySizeLimitError(params.Domain)); ok {
+		result = append(result, invariant.InvariantCheckResult{
+			IssueID:       issueID,
+			InvariantType: HistorySizeLimit.String(),
+			Reason:        issue.Reason,
+			Metadata:      invariant.MarshalData(issue.Metadata),
+		})
+		issueID++
+	}
+
+	for _, event := range events {
+		issue, ok := checkLimit(payloadSize(event), blobSizeLimitWarn, blobSizeLimitError)
+		if !ok {
+			continue
+		}
+		issue.Metadata.EventID = event.ID
+		issue.Metadata.EventType = event.GetEventType().String()
+		result = append(result, invariant.InvariantCheckResult{
+			IssueID:       issueID,
+			InvariantType: PayloadSizeLimit.String(),
+			Reason:        issue.Reason,
+			Metadata:      invariant.MarshalData(issue.Metadata),
+		})
+		issueID++
+	}
+	return result, nil
+}
+
+type limitIssue struct {
+	Reason   string
+	Metadata LimitMetadata
+}
+
+// checkLimit returns an issue if the value exceeds the warn limit, limits that are not positive are not enforced
+func checkLimit(value, warnLimit, errorLimit int) (limitIssue, bool) {
+	metadata := LimitMetadata{
+		Value:      value,
+		WarnLimit:  warnLimit,
+		ErrorLimit: errorLimit,
+	}
+	if errorLimit > 0 && value > errorLimit {
+		return limitIssue{Reason: ErrorLimitExceeded.String(), Metadata: metadata}, true
+	}
+	if warnLimit > 0 && value > warnLimit {
+		return limitIssue{Reason: WarnLimitExceeded.String(), Metadata: metadata}, true
+	}
+	return limitIssue{}, false
+}
+
+// payloadSize returns the total size of the payloads provided by the user in the event
+func payloadSize(event *types.HistoryEvent) int {
+	size := 0
+	if attr := event.WorkflowExecutionStartedEventAttributes; attr != nil {
+		size += len(attr.Input)
+	}
+	if attr := event.WorkflowExecutionCompletedEventAttributes; attr != nil {
+		size += len(attr.Result)
+	}
+	if attr := event.WorkflowExecutionFailedEventAttributes; attr != nil {
+		size += len(attr.Details)
+	}
+	if attr := event.WorkflowExecutionSignaledEventAttributes; attr != nil {
+		size += len(attr.Input)
+	}
+	if attr := event.WorkflowExecutionContinuedAsNewEventAttributes; attr != nil {
+		size += len(attr.Input)
+	}
+	if attr := event.ActivityTaskScheduledEventAttributes; attr != nil {
+		size += len(attr.Input)
+	}
+	if attr := event.ActivityTaskCompletedEventAttributes; attr != nil {
+		size += len(attr.Result)
+	}
+	if attr := event.ActivityTaskFailedEventAttributes; attr != nil {
+		size += len(attr.Details)
+	}
+	if attr := event.ActivityTaskTimedOutEventAttributes; attr != nil {
+		size += len(attr.Details)
+	}
+	if attr := event.ActivityTaskCanceledEventAttributes; attr != nil {
+		size += len(attr.Details)
+	}
+	if attr := event.MarkerRecordedEventAttributes; attr != nil {
+		size += len(attr.Details)
+	}
+	if attr := event.StartChildWorkflowExecutionInitiatedEventAttributes; attr != nil {
+		size += len(attr.Input)
+	}
+	if attr := event.ChildWorkflowExecutionCompletedEventAttributes; attr != nil {
+		size += len(attr.Result)
+	}
+	if attr := event.ChildWorkflowExecutionFailedEventAttributes; attr != nil {
+		size += len(attr.Details)
+	}
+	if attr := event.SignalExternalWorkflowExecutionInitiatedEventAttributes; attr != nil {
+		size += len(attr.Input)
+	}
+	return size
+}
+
+func (l *limits) RootCause(ctx context.Context, params invariant.InvariantRootCauseInput) ([]invariant.InvariantRootCauseResult, error) {
+	result := make([]invariant.InvariantRootCauseResult, 0)
+	for _, issue := range params.Issues {
+		var rootCause invariant.RootCause
+		switch issue.InvariantType {
+		case HistoryCountLimit.String():
+			rootCause = invariant.RootCauseTypeHistoryCountLimit
+		case HistorySizeLimit.String():
+			rootCause = invariant.RootCauseTypeHistorySizeLimit
+		case PayloadSizeLimit.String():
+			rootCause = invariant.RootCauseTypePayloadSizeLimit
+		default:
+			continue
+		}
+		result = append(result, invariant.InvariantRootCauseResult{
+			IssueID:   issue.IssueID,
+			RootCause: rootCause,
+			Metadata:  issue.Metadata,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,150 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package limits
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+)
+
+const testDomain = "test-domain"
+
+func Test__Check(t *testing.T) {
+	testCases := []struct {
+		name           string
+		events         []*types.HistoryEvent
+		expectedResult []invariant.InvariantCheckResult
+	}{
+		{
+			name: "within limits",
+			events: []*types.HistoryEvent{
+				{ID: 1, WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{Input: make([]byte, 10)}},
+			},
+			expectedResult: []invariant.InvariantCheckResult{},
+		},
+		{
+			name: "history count exceeds warn limit",
+			events: []*types.HistoryEvent{
+				{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4},
+			},
+			expectedResult: []invariant.InvariantCheckResult{
+				{
+					IssueID:       0,
+					InvariantType: HistoryCountLimit.String(),
+					Reason:        WarnLimitExceeded.String(),
+					Metadata:      invariant.MarshalData(LimitMetadata{Value: 4, WarnLimit: 3, ErrorLimit: 5}),
+				},
+			},
+		},
+		{
+			name: "history size and payload size exceed limits",
+			events: []*types.HistoryEvent{
+				{ID: 1, WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{Input: make([]byte, 60)}},
+				{
+					ID:                                   2,
+					EventType:                            types.EventTypeActivityTaskCompleted.Ptr(),
+					ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{Result: make([]byte, 120)},
+				},
+			},
+			expectedResult: []invariant.InvariantCheckResult{
+				{
+					IssueID:       0,
+					InvariantType: HistorySizeLimit.String(),
+					Reason:        WarnLimitExceeded.String(),
+					Metadata:      invariant.MarshalData(LimitMetadata{Value: 180, WarnLimit: 150, ErrorLimit: 200}),
+				},
+				{
+					IssueID:       1,
+					InvariantType: PayloadSizeLimit.String(),
+					Reason:        WarnLimitExceeded.String(),
+					Metadata: invariant.MarshalData(LimitMetadata{
+						EventID:    1,
+						EventType:  types.EventTypeWorkflowExecutionStarted.String(),
+						Value:      60,
+						WarnLimit:  50,
+						ErrorLimit: 100,
+					}),
+				},
+				{
+					IssueID:       2,
+					InvariantType: PayloadSizeLimit.String(),
+					Reason:        ErrorLimitExceeded.String(),
+					Metadata: invariant.MarshalData(LimitMetadata{
+						EventID:    2,
+						EventType:  types.EventTypeActivityTaskCompleted.String(),
+						Value:      120,
+						WarnLimit:  50,
+						ErrorLimit: 100,
+					}),
+				},
+			},
+		},
+	}
+
+	inv := NewInvariant(Params{
+		HistoryCountLimitWarn:  dynamicproperties.GetIntPropertyFilteredByDomain(3),
+		HistoryCountLimitError: dynamicproperties.GetIntPropertyFilteredByDomain(5),
+		HistorySizeLimitWarn:   dynamicproperties.GetIntPropertyFilteredByDomain(150),
+		HistorySizeLimitError:  dynamicproperties.GetIntPropertyFilteredByDomain(200),
+		BlobSizeLimitWarn:      dynamicproperties.GetIntPropertyFilteredByDomain(50),
+		BlobSizeLimitError:     dynamicproperties.GetIntPropertyFilteredByDomain(100),
+	})
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := inv.Check(context.Background(), invariant.InvariantCheckInput{
+				WorkflowExecutionHistory: &types.GetWorkflowExecutionHistoryResponse{
+					History: &types.History{Events: tc.events},
+				},
+				Domain: testDomain,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedResult, result)
+		})
+	}
+}
+
+func Test__RootCause(t *testing.T) {
+	metadata := invariant.MarshalData(LimitMetadata{Value: 4, WarnLimit: 3, ErrorLimit: 5})
+	inv := NewInvariant(Params{})
+	result, err := inv.RootCause(context.Background(), invariant.InvariantRootCauseInput{
+		Domain: testDomain,
+		Issues: []invariant.InvariantCheckResult{
+			{IssueID: 0, InvariantType: HistoryCountLimit.String(), Metadata: metadata},
+			{IssueID: 1, InvariantType: HistorySizeLimit.String(), Metadata: metadata},
+			{IssueID: 2, InvariantType: PayloadSizeLimit.String(), Metadata: metadata},
+			{IssueID: 3, InvariantType: "other invariant"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []invariant.InvariantRootCauseResult{
+		{IssueID: 0, RootCause: invariant.RootCauseTypeHistoryCountLimit, Metadata: metadata},
+		{IssueID: 1, RootCause: invariant.RootCauseTypeHistorySizeLimit, Metadata: metadata},
+		{IssueID: 2, RootCause: invariant.RootCauseTypePayloadSizeLimit, Metadata: metadata},
+	}, result)
+}
@@ -0,0 +1,54 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package limits
+
+type LimitType string
+
+const (
+	HistoryCountLimit LimitType = "Workflow history count is approaching the limit"
+	HistorySizeLimit  LimitType = "Workflow history size is approaching the limit"
+	PayloadSizeLimit  LimitType = "Payload size of an event is approaching the limit"
+)
+
+func (l LimitType) String() string {
+	return string(l)
+}
+
+type IssueType string
+
+const (
+	WarnLimitExceeded  IssueType = "The warn limit configured for the domain is exceeded"
+	ErrorLimitExceeded IssueType = "The error limit configured for the domain is exceeded"
+)
+
+func (i IssueType) String() string {
+	return string(i)
+}
+
+type LimitMetadata struct {
+	EventID    int64
+	EventType  string
+	Value      int
+	WarnLimit  int
+	ErrorLimit int
+}
@@ -0,0 +1,125 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package nondeterminism
+
+import (
+	"context"
+	"strings"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+)
+
+const (
+	_maxDetailsLength = 1000 // maximum length of the failure details kept in the metadata
+)
+
+// NonDeterminism is an invariant that will be used to identify the decision task failures caused by non-determinism in the workflow execution history
+type NonDeterminism invariant.Invariant
+
+type nonDeterminism struct{}
+
+func NewInvariant() NonDeterminism {
+	return &nonDeterminism{}
+}
+
+func (n *nonDeterminism) Check(ctx context.Context, params invariant.InvariantCheckInput) ([]invariant.InvariantCheckResult, error) {
+	result := make([]invariant.InvariantCheckResult, 0)
+	events := params.WorkflowExecutionHistory.GetHistory().GetEvents()
+	failureCount := 0
+	var lastFailed *types.HistoryEvent
+	// failing until a decision task is completed after the last failure
+	failing := false
+	for _, event := range events {
+		if attr := event.GetDecisionTaskFailedEventAttributes(); attr != nil && isNonDeterministic(attr) {
+			failureCount++
+			lastFailed = event
+			failing = true
+		}
+		if event.GetDecisionTaskCompletedEventAttributes() != nil {
+			failing = false
+		}
+	}
+	if lastFailed == nil {
+		return result, nil
+	}
+
+	attr := lastFailed.GetDecisionTaskFailedEventAttributes()
+	metadata := NonDeterminismMetadata{
+		FailureCount:      failureCount,
+		LastFailedEventID: lastFailed.ID,
+		Identity:          attr.Identity,
+		BinaryChecksum:    attr.BinaryChecksum,
+		Details:           truncate(string(attr.GetDetails())),
+	}
+	reason := DecisionRecovered
+	if failing {
+		reason = DecisionKeepsFailing
+		// the failures of the retried decision tasks are not recorded in the history, but counted in the attempt of the pending decision
+		if description := params.WorkflowExecutionDescription; description != nil && description.PendingDecision != nil {
+			metadata.DecisionAttempt = description.PendingDecision.Attempt
+		}
+	}
+	result = append(result, invariant.InvariantCheckResult{
+		IssueID:       0,
+		InvariantType: DecisionNonDeterministic.String(),
+		Reason:        reason.String(),
+		Metadata:      invariant.MarshalData(metadata),
+	})
+	return result, nil
+}
+
+func isNonDeterministic(attr *types.DecisionTaskFailedEventAttributes) bool {
+	var reason string
+	if attr.Reason != nil {
+		reason = *attr.Reason
+	}
+	for _, s := range []string{string(attr.GetDetails()), reason} {
+		s = strings.ToLower(s)
+		if strings.Contains(s, "nondeterministic") || strings.Contains(s, "non-deterministic") {
+			return true
+		}
+	}
+	return false
+}
+
+func truncate(details string) string {
+	if len(details) > _maxDetailsLength {
+		return details[:_maxDetailsLength]
+	}
+	return details
+}
+
+func (n *nonDeterminism) RootCause(ctx context.Context, params invariant.InvariantRootCauseInput) ([]invariant.InvariantRootCauseResult, error) {
+	result := make([]invariant.InvariantRootCauseResult, 0)
+	for _, issue := range params.Issues {
+		if issue.InvariantType == DecisionNonDeterministic.String() {
+			result = append(result, invariant.InvariantRootCauseResult{
+				IssueID:   issue.IssueID,
+				RootCause: invariant.RootCauseTypeNonDeterministicWorkflowCode,
+				Metadata:  issue.Metadata,
+			})
+		}
+	}
+	return result, nil
+}
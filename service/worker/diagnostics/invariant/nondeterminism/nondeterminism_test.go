@@ -0,0 +1,169 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package nondeterminism
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+)
+
+const (
+	testDomain   = "test-domain"
+	testIdentity = "test-identity"
+	testChecksum = "test-checksum"
+	testDetails  = "nondeterministic workflow: history event is ActivityTaskScheduled, replay decision is ScheduleTimer"
+)
+
+func Test__Check(t *testing.T) {
+	testCases := []struct {
+		name           string
+		events         []*types.HistoryEvent
+		description    *types.DescribeWorkflowExecutionResponse
+		expectedResult []invariant.InvariantCheckResult
+	}{
+		{
+			name: "no decision task failures",
+			events: []*types.HistoryEvent{
+				{ID: 4, DecisionTaskCompletedEventAttributes: &types.DecisionTaskCompletedEventAttributes{}},
+			},
+			expectedResult: []invariant.InvariantCheckResult{},
+		},
+		{
+			name: "decision task failure not caused by non-determinism",
+			events: []*types.HistoryEvent{
+				decisionFailedEvent(4, "workflow panic"),
+			},
+			expectedResult: []invariant.InvariantCheckResult{},
+		},
+		{
+			name: "decision tasks keep failing",
+			events: []*types.HistoryEvent{
+				decisionFailedEvent(4, testDetails),
+				{ID: 7, DecisionTaskCompletedEventAttributes: &types.DecisionTaskCompletedEventAttributes{}},
+				decisionFailedEvent(10, testDetails),
+			},
+			description: &types.DescribeWorkflowExecutionResponse{
+				PendingDecision: &types.PendingDecisionInfo{Attempt: 5},
+			},
+			expectedResult: []invariant.InvariantCheckResult{
+				{
+					IssueID:       0,
+					InvariantType: DecisionNonDeterministic.String(),
+					Reason:        DecisionKeepsFailing.String(),
+					Metadata: invariant.MarshalData(NonDeterminismMetadata{
+						FailureCount:      2,
+						LastFailedEventID: 10,
+						DecisionAttempt:   5,
+						Identity:          testIdentity,
+						BinaryChecksum:    testChecksum,
+						Details:           testDetails,
+					}),
+				},
+			},
+		},
+		{
+			name: "decision task recovered",
+			events: []*types.HistoryEvent{
+				decisionFailedEvent(4, strings.Repeat("Non-Deterministic ", 100)),
+				{ID: 7, DecisionTaskCompletedEventAttributes: &types.DecisionTaskCompletedEventAttributes{}},
+			},
+			expectedResult: []invariant.InvariantCheckResult{
+				{
+					IssueID:       0,
+					InvariantType: DecisionNonDeterministic.String(),
+					Reason:        DecisionRecovered.String(),
+					Metadata: invariant.MarshalData(NonDeterminismMetadata{
+						FailureCount:      1,
+						LastFailedEventID: 4,
+						Identity:          testIdentity,
+						BinaryChecksum:    testChecksum,
+						Details:           strings.Repeat("Non-Deterministic ", 100)[:_maxDetailsLength],
+					}),
+				},
+			},
+		},
+	}
+
+	inv := NewInvariant()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := inv.Check(context.Background(), invariant.InvariantCheckInput{
+				WorkflowExecutionHistory: &types.GetWorkflowExecutionHistoryResponse{
+					History: &types.History{Events: tc.events},
+				},
+				WorkflowExecutionDescription: tc.description,
+				Domain:                       testDomain,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedResult, result)
+		})
+	}
+}
+
+func Test__RootCause(t *testing.T) {
+	metadata := invariant.MarshalData(NonDeterminismMetadata{FailureCount: 1})
+	inv := NewInvariant()
+	result, err := inv.RootCause(context.Background(), invariant.InvariantRootCauseInput{
+		Domain: testDomain,
+		Issues: []invariant.InvariantCheckResult{
+			{
+				IssueID:       0,
+				InvariantType: DecisionNonDeterministic.String(),
+				Reason:        DecisionKeepsFailing.String(),
+				Metadata:      metadata,
+			},
+			{
+				IssueID:       1,
+				InvariantType: "other invariant",
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []invariant.InvariantRootCauseResult{
+		{
+			IssueID:   0,
+			RootCause: invariant.RootCauseTypeNonDeterministicWorkflowCode,
+			Metadata:  metadata,
+		},
+	}, result)
+}
+
+func decisionFailedEvent(id int64, details string) *types.HistoryEvent {
+	return &types.HistoryEvent{
+		ID: id,
+		DecisionTaskFailedEventAttributes: &types.DecisionTaskFailedEventAttributes{
+			Cause:          types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.Ptr(),
+			Details:        []byte(details),
+			Identity:       testIdentity,
+			BinaryChecksum: testChecksum,
+			Reason:         common.StringPtr("WORKFLOW_WORKER_UNHANDLED_FAILURE"),
+		},
+	}
+}
@@ -0,0 +1,216 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package pollers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/.gen/go/shared"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+)
+
+const (
+	// tasks pending for a shorter time are expected to be picked up by workers soon
+	_pendingTaskThreshold = time.Minute
+)
+
+// Pollers is an invariant that will be used to identify the tasks of an open workflow execution that are not picked up by any worker
+type Pollers invariant.Invariant
+
+type pollers struct {
+	client     workflowserviceclient.Interface
+	timeSource clock.TimeSource
+}
+
+type Params struct {
+	Client     workflowserviceclient.Interface
+	TimeSource clock.TimeSource
+}
+
+func NewInvariant(p Params) Pollers {
+	return &pollers{
+		client:     p.Client,
+		timeSource: p.TimeSource,
+	}
+}
+
+func (p *pollers) Check(ctx context.Context, params invariant.InvariantCheckInput) ([]invariant.InvariantCheckResult, error) {
+	result := make([]invariant.InvariantCheckResult, 0)
+	description := params.WorkflowExecutionDescription
+	info := description.GetWorkflowExecutionInfo()
+	if info == nil || info.CloseStatus != nil {
+		return result, nil
+	}
+	events := params.WorkflowExecutionHistory.GetHistory().GetEvents()
+	now := p.timeSource.Now()
+	issueID := 0
+
+	if decision := description.PendingDecision; decision != nil && decision.State != nil && *decision.State == types.PendingDecisionStateScheduled {
+		if pending, ok := timePending(now, decision.ScheduledTimestamp); ok {
+			result = append(result, invariant.InvariantCheckResult{
+				IssueID:       issueID,
+				InvariantType: DecisionTaskNotStarted.String(),
+				Reason:        fmt.Sprintf("Task has been pending for %v", pending),
+				Metadata: invariant.MarshalData(PendingTaskMetadata{
+					TaskListName:       workflowTaskList(description),
+					ScheduledEventID:   decision.ScheduleID,
+					ScheduledTimestamp: *decision.ScheduledTimestamp,
+					TimePending:        pending,
+				}),
+			})
+			issueID++
+		}
+	}
+
+	for _, activity := range description.GetPendingActivities() {
+		if activity.GetState() != types.PendingActivityStateScheduled {
+			continue
+		}
+		pending, ok := timePending(now, activity.ScheduledTimestamp)
+		if !ok {
+			continue
+		}
+		taskList := workflowTaskList(description)
+		if scheduled := fetchActivityScheduledEvent(activity.GetScheduleID(), events); scheduled.GetTaskList() != nil {
+			taskList = scheduled.GetTaskList().GetName()
+		}
+		result = append(result, invariant.InvariantCheckResult{
+			IssueID:       issueID,
+			InvariantType: ActivityTaskNotStarted.String(),
+			Reason:        fmt.Sprintf("Task has been pending for %v", pending),
+			Metadata: invariant.MarshalData(PendingTaskMetadata{
+				TaskListName:       taskList,
+				ScheduledEventID:   activity.GetScheduleID(),
+				ActivityID:         activity.GetActivityID(),
+				ActivityType:       activity.ActivityType.GetName(),
+				ScheduledTimestamp: *activity.ScheduledTimestamp,
+				TimePending:        pending,
+			}),
+		})
+		issueID++
+	}
+	return result, nil
+}
+
+// timePending returns how long the task has been scheduled for, and whether it is longer than the threshold to report it
+func timePending(now time.Time, scheduledTimestamp *int64) (time.Duration, bool) {
+	if scheduledTimestamp == nil {
+		return 0, false
+	}
+	pending := now.Sub(time.Unix(0, *scheduledTimestamp))
+	return pending, pending >= _pendingTaskThreshold
+}
+
+func workflowTaskList(description *types.DescribeWorkflowExecutionResponse) string {
+	if description.ExecutionConfiguration == nil {
+		return ""
+	}
+	return description.ExecutionConfiguration.TaskList.GetName()
+}
+
+func fetchActivityScheduledEvent(scheduledEventID int64, events []*types.HistoryEvent) *types.ActivityTaskScheduledEventAttributes {
+	for _, event := range events {
+		if event.ID == scheduledEventID {
+			return event.GetActivityTaskScheduledEventAttributes()
+		}
+	}
+	return nil
+}
+
+func (p *pollers) RootCause(ctx context.Context, params invariant.InvariantRootCauseInput) ([]invariant.InvariantRootCauseResult, error) {
+	result := make([]invariant.InvariantRootCauseResult, 0)
+	for _, issue := range params.Issues {
+		var taskListType *shared.TaskListType
+		switch issue.InvariantType {
+		case DecisionTaskNotStarted.String():
+			taskListType = shared.TaskListTypeDecision.Ptr()
+		case ActivityTaskNotStarted.String():
+			taskListType = shared.TaskListTypeActivity.Ptr()
+		default:
+			continue
+		}
+		pollersStatus, err := p.checkPollers(ctx, issue, taskListType, params.Domain)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, pollersStatus)
+	}
+	return result, nil
+}
+
+func (p *pollers) checkPollers(
+	ctx context.Context,
+	issue invariant.InvariantCheckResult,
+	taskListType *shared.TaskListType,
+	domain string,
+) (invariant.InvariantRootCauseResult, error) {
+	var metadata PendingTaskMetadata
+	err := json.Unmarshal(issue.Metadata, &metadata)
+	if err != nil {
+		return invariant.InvariantRootCauseResult{}, err
+	}
+	if metadata.TaskListName == "" {
+		return invariant.InvariantRootCauseResult{}, fmt.Errorf("tasklist not set")
+	}
+
+	resp, err := p.client.DescribeTaskList(ctx, &shared.DescribeTaskListRequest{
+		Domain: &domain,
+		TaskList: &shared.TaskList{
+			Name: &metadata.TaskListName,
+			Kind: shared.TaskListKindNormal.Ptr(),
+		},
+		TaskListType: taskListType,
+	})
+	if err != nil {
+		return invariant.InvariantRootCauseResult{}, err
+	}
+
+	pollersMetadata := PollersMetadata{
+		TaskListName:    metadata.TaskListName,
+		TaskListBacklog: resp.GetTaskListStatus().GetBacklogCountHint(),
+	}
+	for _, poller := range resp.GetPollers() {
+		pollersMetadata.PollerIdentities = append(pollersMetadata.PollerIdentities, poller.GetIdentity())
+		if poller.GetLastAccessTime() > pollersMetadata.LastPollTimestamp {
+			pollersMetadata.LastPollTimestamp = poller.GetLastAccessTime()
+		}
+	}
+
+	// the task would have been dispatched to a poller that polled the tasklist after it was scheduled
+	rootCause := invariant.RootCauseTypePendingTaskBacklog
+	if pollersMetadata.LastPollTimestamp < metadata.ScheduledTimestamp {
+		rootCause = invariant.RootCauseTypeNoRecentPollers
+	}
+	return invariant.InvariantRootCauseResult{
+		IssueID:   issue.IssueID,
+		RootCause: rootCause,
+		Metadata:  invariant.MarshalData(pollersMetadata),
+	}, nil
+}
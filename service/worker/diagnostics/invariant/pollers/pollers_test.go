@@ -0,0 +1,261 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package pollers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	publicservicetest "go.uber.org/cadence/.gen/go/cadence/workflowservicetest"
+	"go.uber.org/cadence/.gen/go/shared"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+)
+
+const (
+	testDomain           = "test-domain"
+	testTasklist         = "test-tasklist"
+	testActivityTasklist = "test-activity-tasklist"
+	testTaskListBacklog  = int64(10)
+)
+
+var testNow = time.Unix(1700000000, 0)
+
+func Test__Check(t *testing.T) {
+	scheduledTime := testNow.Add(-5 * time.Minute).UnixNano()
+	recentlyScheduledTime := testNow.Add(-10 * time.Second).UnixNano()
+	history := &types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{
+			Events: []*types.HistoryEvent{
+				{
+					ID: 5,
+					ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+						ActivityID: "1",
+						TaskList:   &types.TaskList{Name: testActivityTasklist},
+					},
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		name           string
+		description    *types.DescribeWorkflowExecutionResponse
+		expectedResult []invariant.InvariantCheckResult
+	}{
+		{
+			name: "closed workflow",
+			description: &types.DescribeWorkflowExecutionResponse{
+				WorkflowExecutionInfo: &types.WorkflowExecutionInfo{CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr()},
+				PendingDecision: &types.PendingDecisionInfo{
+					State:              types.PendingDecisionStateScheduled.Ptr(),
+					ScheduledTimestamp: common.Int64Ptr(scheduledTime),
+				},
+			},
+			expectedResult: []invariant.InvariantCheckResult{},
+		},
+		{
+			name: "decision task not started",
+			description: &types.DescribeWorkflowExecutionResponse{
+				ExecutionConfiguration: &types.WorkflowExecutionConfiguration{TaskList: &types.TaskList{Name: testTasklist}},
+				WorkflowExecutionInfo:  &types.WorkflowExecutionInfo{},
+				PendingDecision: &types.PendingDecisionInfo{
+					State:              types.PendingDecisionStateScheduled.Ptr(),
+					ScheduledTimestamp: common.Int64Ptr(scheduledTime),
+					ScheduleID:         2,
+				},
+			},
+			expectedResult: []invariant.InvariantCheckResult{
+				{
+					IssueID:       0,
+					InvariantType: DecisionTaskNotStarted.String(),
+					Reason:        "Task has been pending for 5m0s",
+					Metadata: invariant.MarshalData(PendingTaskMetadata{
+						TaskListName:       testTasklist,
+						ScheduledEventID:   2,
+						ScheduledTimestamp: scheduledTime,
+						TimePending:        5 * time.Minute,
+					}),
+				},
+			},
+		},
+		{
+			name: "activity tasks not started",
+			description: &types.DescribeWorkflowExecutionResponse{
+				ExecutionConfiguration: &types.WorkflowExecutionConfiguration{TaskList: &types.TaskList{Name: testTasklist}},
+				WorkflowExecutionInfo:  &types.WorkflowExecutionInfo{},
+				PendingDecision: &types.PendingDecisionInfo{
+					State:              types.PendingDecisionStateStarted.Ptr(),
+					ScheduledTimestamp: common.Int64Ptr(scheduledTime),
+				},
+				PendingActivities: []*types.PendingActivityInfo{
+					{
+						ActivityID:         "1",
+						ActivityType:       &types.ActivityType{Name: "test-activity"},
+						State:              types.PendingActivityStateScheduled.Ptr(),
+						ScheduledTimestamp: common.Int64Ptr(scheduledTime),
+						ScheduleID:         5,
+					},
+					{
+						ActivityID:         "2",
+						State:              types.PendingActivityStateScheduled.Ptr(),
+						ScheduledTimestamp: common.Int64Ptr(recentlyScheduledTime),
+						ScheduleID:         6,
+					},
+					{
+						ActivityID:         "3",
+						State:              types.PendingActivityStateStarted.Ptr(),
+						ScheduledTimestamp: common.Int64Ptr(scheduledTime),
+						ScheduleID:         7,
+					},
+				},
+			},
+			expectedResult: []invariant.InvariantCheckResult{
+				{
+					IssueID:       0,
+					InvariantType: ActivityTaskNotStarted.String(),
+					Reason:        "Task has been pending for 5m0s",
+					Metadata: invariant.MarshalData(PendingTaskMetadata{
+						TaskListName:       testActivityTasklist,
+						ScheduledEventID:   5,
+						ActivityID:         "1",
+						ActivityType:       "test-activity",
+						ScheduledTimestamp: scheduledTime,
+						TimePending:        5 * time.Minute,
+					}),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			inv := NewInvariant(Params{
+				TimeSource: clock.NewMockedTimeSourceAt(testNow),
+			})
+			result, err := inv.Check(context.Background(), invariant.InvariantCheckInput{
+				WorkflowExecutionHistory:     history,
+				WorkflowExecutionDescription: tc.description,
+				Domain:                       testDomain,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedResult, result)
+		})
+	}
+}
+
+func Test__RootCause(t *testing.T) {
+	scheduledTime := testNow.Add(-5 * time.Minute).UnixNano()
+	issue := invariant.InvariantCheckResult{
+		IssueID:       0,
+		InvariantType: ActivityTaskNotStarted.String(),
+		Metadata: invariant.MarshalData(PendingTaskMetadata{
+			TaskListName:       testActivityTasklist,
+			ScheduledTimestamp: scheduledTime,
+		}),
+	}
+	testCases := []struct {
+		name           string
+		pollers        []*shared.PollerInfo
+		expectedResult []invariant.InvariantRootCauseResult
+	}{
+		{
+			name: "no pollers",
+			expectedResult: []invariant.InvariantRootCauseResult{
+				{
+					IssueID:   0,
+					RootCause: invariant.RootCauseTypeNoRecentPollers,
+					Metadata:  invariant.MarshalData(PollersMetadata{TaskListName: testActivityTasklist, TaskListBacklog: testTaskListBacklog}),
+				},
+			},
+		},
+		{
+			name: "no pollers since the task was scheduled",
+			pollers: []*shared.PollerInfo{
+				{Identity: common.StringPtr("dca24-xy"), LastAccessTime: common.Int64Ptr(scheduledTime - 1)},
+			},
+			expectedResult: []invariant.InvariantRootCauseResult{
+				{
+					IssueID:   0,
+					RootCause: invariant.RootCauseTypeNoRecentPollers,
+					Metadata: invariant.MarshalData(PollersMetadata{
+						TaskListName:      testActivityTasklist,
+						TaskListBacklog:   testTaskListBacklog,
+						PollerIdentities:  []string{"dca24-xy"},
+						LastPollTimestamp: scheduledTime - 1,
+					}),
+				},
+			},
+		},
+		{
+			name: "recent pollers",
+			pollers: []*shared.PollerInfo{
+				{Identity: common.StringPtr("dca24-xy"), LastAccessTime: common.Int64Ptr(scheduledTime - 1)},
+				{Identity: common.StringPtr("dca24-yz"), LastAccessTime: common.Int64Ptr(scheduledTime + 1)},
+			},
+			expectedResult: []invariant.InvariantRootCauseResult{
+				{
+					IssueID:   0,
+					RootCause: invariant.RootCauseTypePendingTaskBacklog,
+					Metadata: invariant.MarshalData(PollersMetadata{
+						TaskListName:      testActivityTasklist,
+						TaskListBacklog:   testTaskListBacklog,
+						PollerIdentities:  []string{"dca24-xy", "dca24-yz"},
+						LastPollTimestamp: scheduledTime + 1,
+					}),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := publicservicetest.NewMockClient(ctrl)
+			mockClient.EXPECT().DescribeTaskList(gomock.Any(), &shared.DescribeTaskListRequest{
+				Domain:       common.StringPtr(testDomain),
+				TaskList:     &shared.TaskList{Name: common.StringPtr(testActivityTasklist), Kind: shared.TaskListKindNormal.Ptr()},
+				TaskListType: shared.TaskListTypeActivity.Ptr(),
+			}).Return(&shared.DescribeTaskListResponse{
+				Pollers: tc.pollers,
+				TaskListStatus: &shared.TaskListStatus{
+					BacklogCountHint: common.Int64Ptr(testTaskListBacklog),
+				},
+			}, nil)
+			inv := NewInvariant(Params{
+				Client: mockClient,
+			})
+			result, err := inv.RootCause(context.Background(), invariant.InvariantRootCauseInput{
+				Domain: testDomain,
+				Issues: []invariant.InvariantCheckResult{issue, {IssueID: 1, InvariantType: "other invariant"}},
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedResult, result)
+		})
+	}
+}
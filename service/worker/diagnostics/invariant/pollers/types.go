@@ -0,0 +1,52 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package pollers
+
+import "time"
+
+type TaskType string
+
+const (
+	DecisionTaskNotStarted TaskType = "Decision task has been scheduled but not started by any worker"
+	ActivityTaskNotStarted TaskType = "Activity task has been scheduled but not started by any worker"
+)
+
+func (t TaskType) String() string {
+	return string(t)
+}
+
+type PendingTaskMetadata struct {
+	TaskListName       string
+	ScheduledEventID   int64
+	ActivityID         string
+	ActivityType       string
+	ScheduledTimestamp int64
+	TimePending        time.Duration
+}
+
+type PollersMetadata struct {
+	TaskListName      string
+	TaskListBacklog   int64
+	PollerIdentities  []string
+	LastPollTimestamp int64
+}
@@ -37,9 +37,13 @@ const (
 	emitUsageLogsActivity      = "emitUsageLogs"
 	queryDiagnosticsReport     = "query-diagnostics-report"
 
-	issueTypeTimeouts = "Timeout"
-	issueTypeFailures = "Failure"
-	issueTypeRetry    = "Retry"
+	issueTypeTimeouts       = "Timeout"
+	issueTypeFailures       = "Failure"
+	issueTypeRetry          = "Retry"
+	issueTypePollers        = "Pollers"
+	issueTypeNonDeterminism = "NonDeterminism"
+	issueTypeLimits         = "Limits"
+	issueTypeHeartbeat      = "Heartbeat"
 )
 
 type DiagnosticsStarterWorkflowInput struct {
@@ -99,6 +103,7 @@ func (w *dw) DiagnosticsStarterWorkflow(ctx workflow.Context, params Diagnostics
 		RunID:                 params.RunID,
 		Identity:              params.Identity,
 		IssueType:             getIssueType(diagWfResult),
+		RootCauses:            getRootCauses(diagWfResult),
 		Environment:           w.clusterMetadata.GetCurrentClusterName(),
 		DiagnosticsWorkflowID: childWfExec.ID,
 		DiagnosticsRunID:      childWfExec.RunID,
@@ -126,5 +131,60 @@ func getIssueType(result DiagnosticsWorkflowResult) string {
 	if result.Retries != nil {
 		issueType = fmt.Sprintf("%s-%s", issueType, issueTypeRetry)
 	}
+	if result.Pollers != nil {
+		issueType = fmt.Sprintf("%s-%s", issueType, issueTypePollers)
+	}
+	if result.NonDeterminism != nil {
+		issueType = fmt.Sprintf("%s-%s", issueType, issueTypeNonDeterminism)
+	}
+	if result.Limits != nil {
+		issueType = fmt.Sprintf("%s-%s", issueType, issueTypeLimits)
+	}
+	if result.Heartbeats != nil {
+		issueType = fmt.Sprintf("%s-%s", issueType, issueTypeHeartbeat)
+	}
 	return issueType
 }
+
+// getRootCauses returns the distinct types of the root causes identified for the issues
+func getRootCauses(result DiagnosticsWorkflowResult) []string {
+	var rootCauses []string
+	seen := make(map[string]bool)
+	add := func(rootCauseType string) {
+		if !seen[rootCauseType] {
+			seen[rootCauseType] = true
+			rootCauses = append(rootCauses, rootCauseType)
+		}
+	}
+	if result.Timeouts != nil {
+		for _, rc := range result.Timeouts.RootCause {
+			add(rc.RootCauseType)
+		}
+	}
+	if result.Failures != nil {
+		for _, rc := range result.Failures.RootCause {
+			add(rc.RootCauseType)
+		}
+	}
+	if result.Pollers != nil {
+		for _, rc := range result.Pollers.RootCause {
+			add(rc.RootCauseType)
+		}
+	}
+	if result.NonDeterminism != nil {
+		for _, rc := range result.NonDeterminism.RootCause {
+			add(rc.RootCauseType)
+		}
+	}
+	if result.Limits != nil {
+		for _, rc := range result.Limits.RootCause {
+			add(rc.RootCauseType)
+		}
+	}
+	if result.Heartbeats != nil {
+		for _, rc := range result.Heartbeats.RootCause {
+			add(rc.RootCauseType)
+		}
+	}
+	return rootCauses
+}
@@ -33,6 +33,10 @@ import (
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/failure"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/heartbeat"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/limits"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/nondeterminism"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/pollers"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/retry"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/timeout"
 )
@@ -52,9 +56,13 @@ type DiagnosticsWorkflowInput struct {
 }
 
 type DiagnosticsWorkflowResult struct {
-	Timeouts *timeoutDiagnostics
-	Failures *failureDiagnostics
-	Retries  *retryDiagnostics
+	Timeouts       *timeoutDiagnostics
+	Failures       *failureDiagnostics
+	Retries        *retryDiagnostics
+	Pollers        *pollersDiagnostics
+	NonDeterminism *nonDeterminismDiagnostics
+	Limits         *limitsDiagnostics
+	Heartbeats     *heartbeatDiagnostics
 }
 
 type timeoutDiagnostics struct {
@@ -73,6 +81,7 @@ type timeoutIssuesResult struct {
 type timeoutRootCauseResult struct {
 	IssueID       int
 	RootCauseType string
+	Remediation   string
 	Metadata      *timeout.TimeoutRootcauseMetadata
 }
 
@@ -92,6 +101,7 @@ type failureIssuesResult struct {
 type failureRootCauseResult struct {
 	IssueID       int
 	RootCauseType string
+	Remediation   string
 	Metadata      *failure.FailureRootcauseMetadata
 }
 
@@ -107,6 +117,79 @@ type retryIssuesResult struct {
 	Metadata      retry.RetryMetadata
 }
 
+type pollersDiagnostics struct {
+	Issues    []*pollersIssuesResult
+	RootCause []*pollersRootCauseResult
+}
+
+type pollersIssuesResult struct {
+	IssueID       int
+	InvariantType string
+	Reason        string
+	Metadata      *pollers.PendingTaskMetadata
+}
+
+type pollersRootCauseResult struct {
+	IssueID       int
+	RootCauseType string
+	Remediation   string
+	Metadata      *pollers.PollersMetadata
+}
+
+type nonDeterminismDiagnostics struct {
+	Issues    []*nonDeterminismIssuesResult
+	RootCause []*nonDeterminismRootCauseResult
+}
+
+type nonDeterminismIssuesResult struct {
+	IssueID       int
+	InvariantType string
+	Reason        string
+	Metadata      *nondeterminism.NonDeterminismMetadata
+}
+
+type nonDeterminismRootCauseResult struct {
+	IssueID       int
+	RootCauseType string
+	Remediation   string
+}
+
+type limitsDiagnostics struct {
+	Issues    []*limitsIssuesResult
+	RootCause []*limitsRootCauseResult
+}
+
+type limitsIssuesResult struct {
+	IssueID       int
+	InvariantType string
+	Reason        string
+	Metadata      *limits.LimitMetadata
+}
+
+type limitsRootCauseResult struct {
+	IssueID       int
+	RootCauseType string
+	Remediation   string
+}
+
+type heartbeatDiagnostics struct {
+	Issues    []*heartbeatIssuesResult
+	RootCause []*heartbeatRootCauseResult
+}
+
+type heartbeatIssuesResult struct {
+	IssueID       int
+	InvariantType string
+	Reason        string
+	Metadata      *heartbeat.HeartbeatMetadata
+}
+
+type heartbeatRootCauseResult struct {
+	IssueID       int
+	RootCauseType string
+	Remediation   string
+}
+
 func (w *dw) DiagnosticsWorkflow(ctx workflow.Context, params DiagnosticsWorkflowInput) (*DiagnosticsWorkflowResult, error) {
 	scope := w.metricsClient.Scope(metrics.DiagnosticsWorkflowScope, metrics.DomainTag(params.Domain))
 	scope.IncCounter(metrics.DiagnosticsWorkflowStartedCount)
@@ -116,6 +199,10 @@ func (w *dw) DiagnosticsWorkflow(ctx workflow.Context, params DiagnosticsWorkflo
 	var timeoutsResult *timeoutDiagnostics
 	var failureResult *failureDiagnostics
 	var retryResult *retryDiagnostics
+	var pollersResult *pollersDiagnostics
+	var nonDeterminismResult *nonDeterminismDiagnostics
+	var limitsResult *limitsDiagnostics
+	var heartbeatResult *heartbeatDiagnostics
 	var checkResult []invariant.InvariantCheckResult
 	var rootCauseResult []invariant.InvariantRootCauseResult
 
@@ -191,11 +278,67 @@ func (w *dw) DiagnosticsWorkflow(ctx workflow.Context, params DiagnosticsWorkflo
 		}
 	}
 
+	pollersIssues, err := retrievePollersIssues(checkResult)
+	if err != nil {
+		return nil, fmt.Errorf("RetrievePollersIssues: %w", err)
+	}
+
+	if len(pollersIssues) > 0 {
+		pollersRootCause, err := retrievePollersRootCause(rootCauseResult)
+		if err != nil {
+			return nil, fmt.Errorf("RetrievePollersRootCause: %w", err)
+		}
+		pollersResult = &pollersDiagnostics{
+			Issues:    pollersIssues,
+			RootCause: pollersRootCause,
+		}
+	}
+
+	nonDeterminismIssues, err := retrieveNonDeterminismIssues(checkResult)
+	if err != nil {
+		return nil, fmt.Errorf("RetrieveNonDeterminismIssues: %w", err)
+	}
+
+	if len(nonDeterminismIssues) > 0 {
+		nonDeterminismResult = &nonDeterminismDiagnostics{
+			Issues:    nonDeterminismIssues,
+			RootCause: retrieveNonDeterminismRootCause(rootCauseResult),
+		}
+	}
+
+	limitsIssues, err := retrieveLimitsIssues(checkResult)
+	if err != nil {
+		return nil, fmt.Errorf("RetrieveLimitsIssues: %w", err)
+	}
+
+	if len(limitsIssues) > 0 {
+		limitsResult = &limitsDiagnostics{
+			Issues:    limitsIssues,
+			RootCause: retrieveLimitsRootCause(rootCauseResult),
+		}
+	}
+
+	heartbeatIssues, err := retrieveHeartbeatIssues(checkResult)
+	if err != nil {
+		return nil, fmt.Errorf("RetrieveHeartbeatIssues: %w", err)
+	}
+
+	if len(heartbeatIssues) > 0 {
+		heartbeatResult = &heartbeatDiagnostics{
+			Issues:    heartbeatIssues,
+			RootCause: retrieveHeartbeatRootCause(rootCauseResult),
+		}
+	}
+
 	scope.IncCounter(metrics.DiagnosticsWorkflowSuccess)
 	return &DiagnosticsWorkflowResult{
-		Timeouts: timeoutsResult,
-		Failures: failureResult,
-		Retries:  retryResult,
+		Timeouts:       timeoutsResult,
+		Failures:       failureResult,
+		Retries:        retryResult,
+		Pollers:        pollersResult,
+		NonDeterminism: nonDeterminismResult,
+		Limits:         limitsResult,
+		Heartbeats:     heartbeatResult,
 	}, nil
 }
 
@@ -276,6 +419,7 @@ func retrieveTimeoutRootCause(rootCause []invariant.InvariantRootCauseResult) ([
 			result = append(result, &timeoutRootCauseResult{
 				IssueID:       rc.IssueID,
 				RootCauseType: rc.RootCause.String(),
+				Remediation:   rc.RootCause.Remediation(),
 				Metadata: &timeout.TimeoutRootcauseMetadata{
 					PollersMetadata: &metadata,
 				},
@@ -289,6 +433,7 @@ func retrieveTimeoutRootCause(rootCause []invariant.InvariantRootCauseResult) ([
 			result = append(result, &timeoutRootCauseResult{
 				IssueID:       rc.IssueID,
 				RootCauseType: rc.RootCause.String(),
+				Remediation:   rc.RootCause.Remediation(),
 				Metadata: &timeout.TimeoutRootcauseMetadata{
 					HeartBeatingMetadata: &metadata,
 				},
@@ -326,6 +471,7 @@ func retrieveFailureRootCause(rootCause []invariant.InvariantRootCauseResult) ([
 			result = append(result, &failureRootCauseResult{
 				IssueID:       rc.IssueID,
 				RootCauseType: rc.RootCause.String(),
+				Remediation:   rc.RootCause.Remediation(),
 			})
 		}
 		if rc.RootCause == invariant.RootCauseTypeBlobSizeLimit {
@@ -337,6 +483,7 @@ func retrieveFailureRootCause(rootCause []invariant.InvariantRootCauseResult) ([
 			result = append(result, &failureRootCauseResult{
 				IssueID:       rc.IssueID,
 				RootCauseType: rc.RootCause.String(),
+				Remediation:   rc.RootCause.Remediation(),
 				Metadata: &failure.FailureRootcauseMetadata{
 					BlobSizeMetadata: metadata.BlobSizeMetadata,
 				},
@@ -366,6 +513,148 @@ func retrieveRetryIssues(issues []invariant.InvariantCheckResult) ([]*retryIssue
 	return result, nil
 }
 
+func retrievePollersIssues(issues []invariant.InvariantCheckResult) ([]*pollersIssuesResult, error) {
+	result := make([]*pollersIssuesResult, 0)
+	for _, issue := range issues {
+		if issue.InvariantType == pollers.DecisionTaskNotStarted.String() || issue.InvariantType == pollers.ActivityTaskNotStarted.String() {
+			var data pollers.PendingTaskMetadata
+			err := json.Unmarshal(issue.Metadata, &data)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, &pollersIssuesResult{
+				IssueID:       issue.IssueID,
+				InvariantType: issue.InvariantType,
+				Reason:        issue.Reason,
+				Metadata:      &data,
+			})
+		}
+	}
+	return result, nil
+}
+
+func retrievePollersRootCause(rootCause []invariant.InvariantRootCauseResult) ([]*pollersRootCauseResult, error) {
+	result := make([]*pollersRootCauseResult, 0)
+	for _, rc := range rootCause {
+		if rc.RootCause == invariant.RootCauseTypeNoRecentPollers || rc.RootCause == invariant.RootCauseTypePendingTaskBacklog {
+			var metadata pollers.PollersMetadata
+			err := json.Unmarshal(rc.Metadata, &metadata)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, &pollersRootCauseResult{
+				IssueID:       rc.IssueID,
+				RootCauseType: rc.RootCause.String(),
+				Remediation:   rc.RootCause.Remediation(),
+				Metadata:      &metadata,
+			})
+		}
+	}
+	return result, nil
+}
+
+func retrieveNonDeterminismIssues(issues []invariant.InvariantCheckResult) ([]*nonDeterminismIssuesResult, error) {
+	result := make([]*nonDeterminismIssuesResult, 0)
+	for _, issue := range issues {
+		if issue.InvariantType == nondeterminism.DecisionNonDeterministic.String() {
+			var data nondeterminism.NonDeterminismMetadata
+			err := json.Unmarshal(issue.Metadata, &data)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, &nonDeterminismIssuesResult{
+				IssueID:       issue.IssueID,
+				InvariantType: issue.InvariantType,
+				Reason:        issue.Reason,
+				Metadata:      &data,
+			})
+		}
+	}
+	return result, nil
+}
+
+func retrieveNonDeterminismRootCause(rootCause []invariant.InvariantRootCauseResult) []*nonDeterminismRootCauseResult {
+	result := make([]*nonDeterminismRootCauseResult, 0)
+	for _, rc := range rootCause {
+		if rc.RootCause == invariant.RootCauseTypeNonDeterministicWorkflowCode {
+			result = append(result, &nonDeterminismRootCauseResult{
+				IssueID:       rc.IssueID,
+				RootCauseType: rc.RootCause.String(),
+				Remediation:   rc.RootCause.Remediation(),
+			})
+		}
+	}
+	return result
+}
+
+func retrieveLimitsIssues(issues []invariant.InvariantCheckResult) ([]*limitsIssuesResult, error) {
+	result := make([]*limitsIssuesResult, 0)
+	for _, issue := range issues {
+		if issue.InvariantType == limits.HistoryCountLimit.String() || issue.InvariantType == limits.HistorySizeLimit.String() || issue.InvariantType == limits.PayloadSizeLimit.String() {
+			var data limits.LimitMetadata
+			err := json.Unmarshal(issue.Metadata, &data)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, &limitsIssuesResult{
+				IssueID:       issue.IssueID,
+				InvariantType: issue.InvariantType,
+				Reason:        issue.Reason,
+				Metadata:      &data,
+			})
+		}
+	}
+	return result, nil
+}
+
+func retrieveLimitsRootCause(rootCause []invariant.InvariantRootCauseResult) []*limitsRootCauseResult {
+	result := make([]*limitsRootCauseResult, 0)
+	for _, rc := range rootCause {
+		if rc.RootCause == invariant.RootCauseTypeHistoryCountLimit || rc.RootCause == invariant.RootCauseTypeHistorySizeLimit || rc.RootCause == invariant.RootCauseTypePayloadSizeLimit {
+			result = append(result, &limitsRootCauseResult{
+				IssueID:       rc.IssueID,
+				RootCauseType: rc.RootCause.String(),
+				Remediation:   rc.RootCause.Remediation(),
+			})
+		}
+	}
+	return result
+}
+
+func retrieveHeartbeatIssues(issues []invariant.InvariantCheckResult) ([]*heartbeatIssuesResult, error) {
+	result := make([]*heartbeatIssuesResult, 0)
+	for _, issue := range issues {
+		if issue.InvariantType == heartbeat.ActivityNoHeartbeatProgress.String() {
+			var data heartbeat.HeartbeatMetadata
+			err := json.Unmarshal(issue.Metadata, &data)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, &heartbeatIssuesResult{
+				IssueID:       issue.IssueID,
+				InvariantType: issue.InvariantType,
+				Reason:        issue.Reason,
+				Metadata:      &data,
+			})
+		}
+	}
+	return result, nil
+}
+
+func retrieveHeartbeatRootCause(rootCause []invariant.InvariantRootCauseResult) []*heartbeatRootCauseResult {
+	result := make([]*heartbeatRootCauseResult, 0)
+	for _, rc := range rootCause {
+		if rc.RootCause == invariant.RootCauseTypeNoHeartbeatTimeoutActivityStuck || rc.RootCause == invariant.RootCauseTypeHeartbeatDetailsNotRecorded {
+			result = append(result, &heartbeatRootCauseResult{
+				IssueID:       rc.IssueID,
+				RootCauseType: rc.RootCause.String(),
+				Remediation:   rc.RootCause.Remediation(),
+			})
+		}
+	}
+	return result
+}
+
 func rootCauseHeartBeatRelated(rootCause invariant.RootCause) bool {
 	for _, rc := range []invariant.RootCause{invariant.RootCauseTypeNoHeartBeatTimeoutNoRetryPolicy,
 		invariant.RootCauseTypeHeartBeatingNotEnabledWithRetryPolicy,
@@ -42,6 +42,10 @@ import (
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/failure"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/heartbeat"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/limits"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/nondeterminism"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/pollers"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/retry"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/timeout"
 )
@@ -161,6 +165,7 @@ func (s *diagnosticsWorkflowTestSuite) TestWorkflow() {
 		{
 			IssueID:       2,
 			RootCauseType: invariant.RootCauseTypeBlobSizeLimit.String(),
+			Remediation:   invariant.RootCauseTypeBlobSizeLimit.Remediation(),
 			Metadata: &failure.FailureRootcauseMetadata{
 				BlobSizeMetadata: &failure.BlobSizeMetadata{
 					BlobSizeWarnLimit:  5,
@@ -173,6 +178,7 @@ func (s *diagnosticsWorkflowTestSuite) TestWorkflow() {
 		{
 			IssueID:       1,
 			RootCauseType: invariant.RootCauseTypePollersStatus.String(),
+			Remediation:   invariant.RootCauseTypePollersStatus.Remediation(),
 			Metadata: &timeout.TimeoutRootcauseMetadata{
 				PollersMetadata: &timeout.PollersMetadata{TaskListName: "test", TaskListBacklog: taskListBacklog},
 			},
@@ -355,6 +361,7 @@ func (s *diagnosticsWorkflowTestSuite) Test__retrieveTimeoutRootCause() {
 		{
 			IssueID:       1,
 			RootCauseType: invariant.RootCauseTypePollersStatus.String(),
+			Remediation:   invariant.RootCauseTypePollersStatus.Remediation(),
 			Metadata: &timeout.TimeoutRootcauseMetadata{
 				PollersMetadata: &timeout.PollersMetadata{TaskListBacklog: taskListBacklog},
 			},
@@ -362,6 +369,7 @@ func (s *diagnosticsWorkflowTestSuite) Test__retrieveTimeoutRootCause() {
 		{
 			IssueID:       2,
 			RootCauseType: invariant.RootCauseTypeNoHeartBeatTimeoutNoRetryPolicy.String(),
+			Remediation:   invariant.RootCauseTypeNoHeartBeatTimeoutNoRetryPolicy.Remediation(),
 			Metadata: &timeout.TimeoutRootcauseMetadata{
 				HeartBeatingMetadata: &timeout.HeartbeatingMetadata{TimeElapsed: 5 * time.Second},
 			},
@@ -424,10 +432,12 @@ func (s *diagnosticsWorkflowTestSuite) Test__retrieveFailureRootCause() {
 		{
 			IssueID:       1,
 			RootCauseType: invariant.RootCauseTypeServiceSideIssue.String(),
+			Remediation:   invariant.RootCauseTypeServiceSideIssue.Remediation(),
 		},
 		{
 			IssueID:       2,
 			RootCauseType: invariant.RootCauseTypeBlobSizeLimit.String(),
+			Remediation:   invariant.RootCauseTypeBlobSizeLimit.Remediation(),
 			Metadata: &failure.FailureRootcauseMetadata{
 				BlobSizeMetadata: &failure.BlobSizeMetadata{
 					BlobSizeWarnLimit:  5,
@@ -482,3 +492,171 @@ func (s *diagnosticsWorkflowTestSuite) Test__retrieveRetryIssues() {
 	s.NoError(err)
 	s.ElementsMatch(retryIssues, result)
 }
+
+func (s *diagnosticsWorkflowTestSuite) Test__retrievePollersIssues() {
+	pendingTaskMetadata := pollers.PendingTaskMetadata{
+		TaskListName:     "test",
+		ScheduledEventID: 2,
+		TimePending:      5 * time.Minute,
+	}
+	issues := []invariant.InvariantCheckResult{
+		{
+			IssueID:       0,
+			InvariantType: pollers.DecisionTaskNotStarted.String(),
+			Reason:        "Task has been pending for 5m0s",
+			Metadata:      invariant.MarshalData(pendingTaskMetadata),
+		},
+		{
+			IssueID:       0,
+			InvariantType: retry.WorkflowRetryIssue.String(),
+		},
+	}
+	result, err := retrievePollersIssues(issues)
+	s.NoError(err)
+	s.ElementsMatch([]*pollersIssuesResult{
+		{
+			IssueID:       0,
+			InvariantType: pollers.DecisionTaskNotStarted.String(),
+			Reason:        "Task has been pending for 5m0s",
+			Metadata:      &pendingTaskMetadata,
+		},
+	}, result)
+}
+
+func (s *diagnosticsWorkflowTestSuite) Test__retrievePollersRootCause() {
+	pollersMetadata := pollers.PollersMetadata{TaskListName: "test", TaskListBacklog: 10}
+	rootCause := []invariant.InvariantRootCauseResult{
+		{
+			IssueID:   0,
+			RootCause: invariant.RootCauseTypeNoRecentPollers,
+			Metadata:  invariant.MarshalData(pollersMetadata),
+		},
+		{
+			IssueID:   1,
+			RootCause: invariant.RootCauseTypeServiceSideIssue,
+		},
+	}
+	result, err := retrievePollersRootCause(rootCause)
+	s.NoError(err)
+	s.ElementsMatch([]*pollersRootCauseResult{
+		{
+			IssueID:       0,
+			RootCauseType: invariant.RootCauseTypeNoRecentPollers.String(),
+			Remediation:   invariant.RootCauseTypeNoRecentPollers.Remediation(),
+			Metadata:      &pollersMetadata,
+		},
+	}, result)
+}
+
+func (s *diagnosticsWorkflowTestSuite) Test__retrieveNonDeterminismIssues() {
+	metadata := nondeterminism.NonDeterminismMetadata{FailureCount: 2, LastFailedEventID: 10}
+	issues := []invariant.InvariantCheckResult{
+		{
+			IssueID:       0,
+			InvariantType: nondeterminism.DecisionNonDeterministic.String(),
+			Reason:        nondeterminism.DecisionKeepsFailing.String(),
+			Metadata:      invariant.MarshalData(metadata),
+		},
+	}
+	result, err := retrieveNonDeterminismIssues(issues)
+	s.NoError(err)
+	s.ElementsMatch([]*nonDeterminismIssuesResult{
+		{
+			IssueID:       0,
+			InvariantType: nondeterminism.DecisionNonDeterministic.String(),
+			Reason:        nondeterminism.DecisionKeepsFailing.String(),
+			Metadata:      &metadata,
+		},
+	}, result)
+	s.ElementsMatch([]*nonDeterminismRootCauseResult{
+		{
+			IssueID:       0,
+			RootCauseType: invariant.RootCauseTypeNonDeterministicWorkflowCode.String(),
+			Remediation:   invariant.RootCauseTypeNonDeterministicWorkflowCode.Remediation(),
+		},
+	}, retrieveNonDeterminismRootCause([]invariant.InvariantRootCauseResult{
+		{IssueID: 0, RootCause: invariant.RootCauseTypeNonDeterministicWorkflowCode},
+		{IssueID: 1, RootCause: invariant.RootCauseTypeServiceSideIssue},
+	}))
+}
+
+func (s *diagnosticsWorkflowTestSuite) Test__retrieveLimitsIssues() {
+	metadata := limits.LimitMetadata{Value: 4, WarnLimit: 3, ErrorLimit: 5}
+	issues := []invariant.InvariantCheckResult{
+		{
+			IssueID:       0,
+			InvariantType: limits.HistoryCountLimit.String(),
+			Reason:        limits.WarnLimitExceeded.String(),
+			Metadata:      invariant.MarshalData(metadata),
+		},
+	}
+	result, err := retrieveLimitsIssues(issues)
+	s.NoError(err)
+	s.ElementsMatch([]*limitsIssuesResult{
+		{
+			IssueID:       0,
+			InvariantType: limits.HistoryCountLimit.String(),
+			Reason:        limits.WarnLimitExceeded.String(),
+			Metadata:      &metadata,
+		},
+	}, result)
+	s.ElementsMatch([]*limitsRootCauseResult{
+		{
+			IssueID:       0,
+			RootCauseType: invariant.RootCauseTypeHistoryCountLimit.String(),
+			Remediation:   invariant.RootCauseTypeHistoryCountLimit.Remediation(),
+		},
+	}, retrieveLimitsRootCause([]invariant.InvariantRootCauseResult{
+		{IssueID: 0, RootCause: invariant.RootCauseTypeHistoryCountLimit},
+		{IssueID: 1, RootCause: invariant.RootCauseTypeServiceSideIssue},
+	}))
+}
+
+func (s *diagnosticsWorkflowTestSuite) Test__retrieveHeartbeatIssues() {
+	metadata := heartbeat.HeartbeatMetadata{ActivityID: "1", TimeSinceLastHeartbeat: time.Hour}
+	issues := []invariant.InvariantCheckResult{
+		{
+			IssueID:       0,
+			InvariantType: heartbeat.ActivityNoHeartbeatProgress.String(),
+			Reason:        heartbeat.NoHeartbeatRecorded.String(),
+			Metadata:      invariant.MarshalData(metadata),
+		},
+	}
+	result, err := retrieveHeartbeatIssues(issues)
+	s.NoError(err)
+	s.ElementsMatch([]*heartbeatIssuesResult{
+		{
+			IssueID:       0,
+			InvariantType: heartbeat.ActivityNoHeartbeatProgress.String(),
+			Reason:        heartbeat.NoHeartbeatRecorded.String(),
+			Metadata:      &metadata,
+		},
+	}, result)
+	s.ElementsMatch([]*heartbeatRootCauseResult{
+		{
+			IssueID:       0,
+			RootCauseType: invariant.RootCauseTypeNoHeartbeatTimeoutActivityStuck.String(),
+			Remediation:   invariant.RootCauseTypeNoHeartbeatTimeoutActivityStuck.Remediation(),
+		},
+	}, retrieveHeartbeatRootCause([]invariant.InvariantRootCauseResult{
+		{IssueID: 0, RootCause: invariant.RootCauseTypeNoHeartbeatTimeoutActivityStuck},
+		{IssueID: 1, RootCause: invariant.RootCauseTypeServiceSideIssue},
+	}))
+}
+
+func (s *diagnosticsWorkflowTestSuite) Test__getIssueTypeAndRootCauses() {
+	result := DiagnosticsWorkflowResult{
+		Timeouts: &timeoutDiagnostics{
+			RootCause: []*timeoutRootCauseResult{{RootCauseType: invariant.RootCauseTypeMissingPollers.String()}},
+		},
+		Pollers: &pollersDiagnostics{
+			RootCause: []*pollersRootCauseResult{
+				{RootCauseType: invariant.RootCauseTypeNoRecentPollers.String()},
+				{RootCauseType: invariant.RootCauseTypeNoRecentPollers.String()},
+			},
+		},
+		Heartbeats: &heartbeatDiagnostics{},
+	}
+	s.Equal("-Timeout-Pollers-Heartbeat", getIssueType(result))
+	s.Equal([]string{invariant.RootCauseTypeMissingPollers.String(), invariant.RootCauseTypeNoRecentPollers.String()}, getRootCauses(result))
+}
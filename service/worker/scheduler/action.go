@@ -0,0 +1,54 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"errors"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// ValidateScheduleAction reports whether the action can be executed by the scheduler workflow.
+// Exactly one action must be set.
+func ValidateScheduleAction(action *types.ScheduleAction) error {
+	startWorkflow := action.GetStartWorkflow()
+	signalWithStart := action.GetSignalWithStartWorkflow()
+	switch {
+	case startWorkflow == nil && signalWithStart == nil:
+		return errors.New("one of StartWorkflow or SignalWithStartWorkflow must be set")
+	case startWorkflow != nil && signalWithStart != nil:
+		return errors.New("only one of StartWorkflow or SignalWithStartWorkflow can be set")
+	case signalWithStart != nil:
+		if signalWithStart.GetWorkflowID() == "" {
+			return errors.New("SignalWithStartWorkflow.WorkflowID is not set")
+		}
+		if signalWithStart.GetWorkflowType().GetName() == "" {
+			return errors.New("SignalWithStartWorkflow.WorkflowType is not set")
+		}
+		if signalWithStart.GetTaskList().GetName() == "" {
+			return errors.New("SignalWithStartWorkflow.TaskList is not set")
+		}
+		if signalWithStart.GetSignalName() == "" {
+			return errors.New("SignalWithStartWorkflow.SignalName is not set")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestValidateScheduleAction(t *testing.T) {
+	validSignalWithStart := func() *types.SignalWithStartWorkflowAction {
+		return &types.SignalWithStartWorkflowAction{
+			WorkflowID:   "entity-wf",
+			WorkflowType: &types.WorkflowType{Name: "entity"},
+			TaskList:     &types.TaskList{Name: "tl"},
+			SignalName:   "tick",
+		}
+	}
+
+	tests := map[string]struct {
+		action  *types.ScheduleAction
+		wantErr bool
+	}{
+		"nil action": {
+			wantErr: true,
+		},
+		"no action set": {
+			action:  &types.ScheduleAction{},
+			wantErr: true,
+		},
+		"start workflow": {
+			action: &types.ScheduleAction{StartWorkflow: &types.StartWorkflowAction{}},
+		},
+		"signal with start": {
+			action: &types.ScheduleAction{SignalWithStartWorkflow: validSignalWithStart()},
+		},
+		"both actions set": {
+			action: &types.ScheduleAction{
+				StartWorkflow:           &types.StartWorkflowAction{},
+				SignalWithStartWorkflow: validSignalWithStart(),
+			},
+			wantErr: true,
+		},
+		"signal with start without workflow ID": {
+			action: &types.ScheduleAction{SignalWithStartWorkflow: func() *types.SignalWithStartWorkflowAction {
+				a := validSignalWithStart()
+				a.WorkflowID = ""
+				return a
+			}()},
+			wantErr: true,
+		},
+		"signal with start without workflow type": {
+			action: &types.ScheduleAction{SignalWithStartWorkflow: func() *types.SignalWithStartWorkflowAction {
+				a := validSignalWithStart()
+				a.WorkflowType = nil
+				return a
+			}()},
+			wantErr: true,
+		},
+		"signal with start without task list": {
+			action: &types.ScheduleAction{SignalWithStartWorkflow: func() *types.SignalWithStartWorkflowAction {
+				a := validSignalWithStart()
+				a.TaskList = nil
+				return a
+			}()},
+			wantErr: true,
+		},
+		"signal with start without signal name": {
+			action: &types.ScheduleAction{SignalWithStartWorkflow: func() *types.SignalWithStartWorkflowAction {
+				a := validSignalWithStart()
+				a.SignalName = ""
+				return a
+			}()},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateScheduleAction(tc.action)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
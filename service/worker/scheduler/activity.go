@@ -58,7 +58,13 @@ func processScheduleFireActivity(ctx context.Context, req ProcessFireRequest) (*
 		return nil, fmt.Errorf("scheduler context not found in activity context")
 	}
 
-	result := &ProcessFireResult{}
+	result := &ProcessFireResult{
+		ClosedRuns: lookupClosedRuns(ctx, sc.FrontendClient, req.Domain, req.PendingRuns),
+	}
+
+	if req.SignalWithStart != nil {
+		return signalWithStartWorkflow(ctx, sc.FrontendClient, req, result)
+	}
 
 	policy := req.OverlapPolicy
 	if policy == types.ScheduleOverlapPolicyInvalid {
@@ -77,8 +83,8 @@ func processScheduleFireActivity(ctx context.Context, req ProcessFireRequest) (*
 				result.StartedWorkflow = req.LastStartedWorkflow
 				return result, nil
 			case types.ScheduleOverlapPolicyBuffer:
-				// TODO(overlap-buffer): implement sequential buffered execution
-				result.SkippedDelta = 1
+				// the workflow keeps the fire and retries it once the previous run completes
+				result.Buffered = true
 				result.StartedWorkflow = req.LastStartedWorkflow
 				return result, nil
 			case types.ScheduleOverlapPolicyCancelPrevious:
@@ -93,7 +99,7 @@ func processScheduleFireActivity(ctx context.Context, req ProcessFireRequest) (*
 		}
 	}
 
-	workflowID := generateWorkflowID(req.Action.WorkflowIDPrefix, req.ScheduleID, req.ScheduledTime)
+	workflowID := generateWorkflowID(req.Action.WorkflowIDPrefix, req.ScheduleID, req.ScheduledTime, req.TriggerID)
 	reusePolicy := types.WorkflowIDReusePolicyAllowDuplicate
 	startReq := &types.StartWorkflowExecutionRequest{
 		Domain:                              req.Domain,
@@ -103,7 +109,7 @@ func processScheduleFireActivity(ctx context.Context, req ProcessFireRequest) (*
 		Input:                               req.Action.Input,
 		ExecutionStartToCloseTimeoutSeconds: req.Action.ExecutionStartToCloseTimeoutSeconds,
 		TaskStartToCloseTimeoutSeconds:      req.Action.TaskStartToCloseTimeoutSeconds,
-		RequestID:                           generateRequestID(req.ScheduleID, req.ScheduledTime.UnixNano(), req.TriggerSource),
+		RequestID:                           generateRequestID(req.ScheduleID, req.ScheduledTime.UnixNano(), req.TriggerSource, req.TriggerID),
 		WorkflowIDReusePolicy:               &reusePolicy,
 		RetryPolicy:                         req.Action.RetryPolicy,
 		Memo:                                req.Action.Memo,
@@ -125,6 +131,7 @@ func processScheduleFireActivity(ctx context.Context, req ProcessFireRequest) (*
 	}
 
 	result.TotalDelta = 1
+	result.StartTime = time.Now()
 	result.StartedWorkflow = &RunningWorkflowInfo{
 		WorkflowID: workflowID,
 		RunID:      resp.GetRunID(),
@@ -132,22 +139,67 @@ func processScheduleFireActivity(ctx context.Context, req ProcessFireRequest) (*
 	return result, nil
 }
 
+// signalWithStartWorkflow signals the schedule's target workflow, starting it first
+// if it is not running. The overlap policy does not apply: the target is expected to
+// be a long-lived workflow and every fire is delivered to it as a signal.
+func signalWithStartWorkflow(ctx context.Context, client frontend.Client, req ProcessFireRequest, result *ProcessFireResult) (*ProcessFireResult, error) {
+	action := req.SignalWithStart
+	reusePolicy := types.WorkflowIDReusePolicyAllowDuplicate
+	resp, err := client.SignalWithStartWorkflowExecution(ctx, &types.SignalWithStartWorkflowExecutionRequest{
+		Domain:                              req.Domain,
+		WorkflowID:                          action.WorkflowID,
+		WorkflowType:                        action.WorkflowType,
+		TaskList:                            action.TaskList,
+		Input:                               action.Input,
+		ExecutionStartToCloseTimeoutSeconds: action.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      action.TaskStartToCloseTimeoutSeconds,
+		RequestID:                           generateRequestID(req.ScheduleID, req.ScheduledTime.UnixNano(), req.TriggerSource, req.TriggerID),
+		SignalName:                          action.SignalName,
+		SignalInput:                         action.SignalInput,
+		WorkflowIDReusePolicy:               &reusePolicy,
+		RetryPolicy:                         action.RetryPolicy,
+		Memo:                                action.Memo,
+		SearchAttributes:                    action.SearchAttributes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to signal with start workflow: %w", err)
+	}
+
+	result.TotalDelta = 1
+	result.StartTime = time.Now()
+	result.Signaled = true
+	result.StartedWorkflow = &RunningWorkflowInfo{
+		WorkflowID: action.WorkflowID,
+		RunID:      resp.GetRunID(),
+	}
+	return result, nil
+}
+
 // generateWorkflowID creates a deterministic workflow ID from the
-// schedule's prefix (or schedule ID) and the scheduled time.
-// Example: "my-prefix-2026-01-15T10:00:00Z"
-func generateWorkflowID(prefix, scheduleID string, scheduledTime time.Time) string {
+// schedule's prefix (or schedule ID) and the scheduled time. Triggered
+// fires are suffixed with their trigger ID.
+// Example: "my-prefix-2026-01-15T10:00:00Z", "my-prefix-2026-01-15T10:00:00Z-trigger-3"
+func generateWorkflowID(prefix, scheduleID string, scheduledTime time.Time, triggerID int64) string {
 	if prefix == "" {
 		prefix = scheduleID
 	}
-	return fmt.Sprintf("%s-%s", prefix, scheduledTime.UTC().Format(time.RFC3339))
+	workflowID := fmt.Sprintf("%s-%s", prefix, scheduledTime.UTC().Format(time.RFC3339))
+	if triggerID > 0 {
+		workflowID = fmt.Sprintf("%s-%s-%d", workflowID, TriggerSourceTrigger, triggerID)
+	}
+	return workflowID
 }
 
 // generateRequestID produces a deterministic UUID from the schedule ID,
 // scheduled time, and trigger source. Including the trigger source ensures
 // that a backfill for the same timestamp as a normal schedule fire produces
-// a distinct RequestID, avoiding unintended server-side deduplication.
-func generateRequestID(scheduleID string, scheduledTimeNanos int64, source TriggerSource) string {
+// a distinct RequestID, avoiding unintended server-side deduplication. The
+// trigger ID does the same for triggers sharing a scheduled time.
+func generateRequestID(scheduleID string, scheduledTimeNanos int64, source TriggerSource, triggerID int64) string {
 	name := fmt.Sprintf("%s-%d-%s", scheduleID, scheduledTimeNanos, source)
+	if triggerID > 0 {
+		name = fmt.Sprintf("%s-%d", name, triggerID)
+	}
 	return uuid.NewSHA1(schedulerRequestIDNamespace, []byte(name)).String()
 }
 
@@ -171,6 +223,43 @@ func isWorkflowRunning(ctx context.Context, client frontend.Client, domain strin
 	return running, nil
 }
 
+// lookupClosedRunsActivity looks up which of the recently started workflows have completed.
+func lookupClosedRunsActivity(ctx context.Context, req LookupClosedRunsRequest) (map[string]types.WorkflowExecutionCloseStatus, error) {
+	sc, ok := ctx.Value(schedulerContextKey).(schedulerContext)
+	if !ok {
+		return nil, fmt.Errorf("scheduler context not found in activity context")
+	}
+	return lookupClosedRuns(ctx, sc.FrontendClient, req.Domain, req.Runs), nil
+}
+
+// lookupClosedRuns returns the close status of each of the given runs that has
+// completed. Lookups are best effort: a run that cannot be described stays pending
+// and is retried on a later check, so a lookup failure never fails the fire itself.
+func lookupClosedRuns(ctx context.Context, client frontend.Client, domain string, runs []RunningWorkflowInfo) map[string]types.WorkflowExecutionCloseStatus {
+	var closed map[string]types.WorkflowExecutionCloseStatus
+	for _, run := range runs {
+		resp, err := client.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+			Domain: domain,
+			Execution: &types.WorkflowExecution{
+				WorkflowID: run.WorkflowID,
+				RunID:      run.RunID,
+			},
+		})
+		if err != nil {
+			continue
+		}
+		info := resp.GetWorkflowExecutionInfo()
+		if info == nil || info.CloseStatus == nil {
+			continue
+		}
+		if closed == nil {
+			closed = make(map[string]types.WorkflowExecutionCloseStatus, len(runs))
+		}
+		closed[run.RunID] = *info.CloseStatus
+	}
+	return closed
+}
+
 // Cancel is cooperative: the previous workflow receives a cancellation signal
 // but may continue running while it handles cleanup. A brief overlap with the
 // new run is expected. Use TERMINATE_PREVIOUS for a hard guarantee of no
@@ -30,6 +30,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common/types"
@@ -42,6 +43,7 @@ func TestGenerateWorkflowID(t *testing.T) {
 		prefix     string
 		scheduleID string
 		time       time.Time
+		triggerID  int64
 		want       string
 	}{
 		{
@@ -65,10 +67,18 @@ func TestGenerateWorkflowID(t *testing.T) {
 			time:       ts,
 			want:       "wf-2026-01-15T10:00:00Z",
 		},
+		{
+			name:       "triggered fires are suffixed with the trigger ID",
+			prefix:     "wf",
+			scheduleID: "sched-789",
+			time:       ts,
+			triggerID:  3,
+			want:       "wf-2026-01-15T10:00:00Z-trigger-3",
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			got := generateWorkflowID(tc.prefix, tc.scheduleID, tc.time)
+			got := generateWorkflowID(tc.prefix, tc.scheduleID, tc.time, tc.triggerID)
 			assert.Equal(t, tc.want, got)
 		})
 	}
@@ -76,28 +86,33 @@ func TestGenerateWorkflowID(t *testing.T) {
 
 func TestGenerateRequestID(t *testing.T) {
 	t.Run("returns valid UUID", func(t *testing.T) {
-		id := generateRequestID("sched-1", 1000000000, TriggerSourceSchedule)
+		id := generateRequestID("sched-1", 1000000000, TriggerSourceSchedule, 0)
 		_, err := uuid.Parse(id)
 		assert.NoError(t, err)
 	})
 	t.Run("deterministic for same inputs", func(t *testing.T) {
-		a := generateRequestID("sched-1", 1000000000, TriggerSourceSchedule)
-		b := generateRequestID("sched-1", 1000000000, TriggerSourceSchedule)
+		a := generateRequestID("sched-1", 1000000000, TriggerSourceSchedule, 0)
+		b := generateRequestID("sched-1", 1000000000, TriggerSourceSchedule, 0)
 		assert.Equal(t, a, b)
 	})
 	t.Run("different for different scheduleID", func(t *testing.T) {
-		a := generateRequestID("sched-1", 1000000000, TriggerSourceSchedule)
-		b := generateRequestID("sched-2", 1000000000, TriggerSourceSchedule)
+		a := generateRequestID("sched-1", 1000000000, TriggerSourceSchedule, 0)
+		b := generateRequestID("sched-2", 1000000000, TriggerSourceSchedule, 0)
 		assert.NotEqual(t, a, b)
 	})
 	t.Run("different for different time", func(t *testing.T) {
-		a := generateRequestID("sched-1", 1000000000, TriggerSourceSchedule)
-		b := generateRequestID("sched-1", 2000000000, TriggerSourceSchedule)
+		a := generateRequestID("sched-1", 1000000000, TriggerSourceSchedule, 0)
+		b := generateRequestID("sched-1", 2000000000, TriggerSourceSchedule, 0)
 		assert.NotEqual(t, a, b)
 	})
 	t.Run("different for different trigger source", func(t *testing.T) {
-		a := generateRequestID("sched-1", 1000000000, TriggerSourceSchedule)
-		b := generateRequestID("sched-1", 1000000000, TriggerSourceBackfill)
+		a := generateRequestID("sched-1", 1000000000, TriggerSourceSchedule, 0)
+		b := generateRequestID("sched-1", 1000000000, TriggerSourceBackfill, 0)
+		assert.NotEqual(t, a, b)
+	})
+	t.Run("different for different trigger ID", func(t *testing.T) {
+		a := generateRequestID("sched-1", 1000000000, TriggerSourceTrigger, 1)
+		b := generateRequestID("sched-1", 1000000000, TriggerSourceTrigger, 2)
 		assert.NotEqual(t, a, b)
 	})
 }
@@ -327,6 +342,109 @@ func TestProcessScheduleFireActivity(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "close status of pending runs is resolved best effort",
+			req: func() ProcessFireRequest {
+				r := baseReq
+				r.OverlapPolicy = types.ScheduleOverlapPolicyConcurrent
+				r.PendingRuns = []RunningWorkflowInfo{
+					{WorkflowID: "failed-wf", RunID: "failed-run"},
+					{WorkflowID: "running-wf", RunID: "running-run"},
+					{WorkflowID: "unknown-wf", RunID: "unknown-run"},
+				}
+				return r
+			}(),
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.DescribeWorkflowExecutionRequest, _ ...interface{}) (*types.DescribeWorkflowExecutionResponse, error) {
+						switch req.Execution.RunID {
+						case "failed-run":
+							return &types.DescribeWorkflowExecutionResponse{
+								WorkflowExecutionInfo: &types.WorkflowExecutionInfo{CloseStatus: types.WorkflowExecutionCloseStatusFailed.Ptr()},
+							}, nil
+						case "running-run":
+							return &types.DescribeWorkflowExecutionResponse{
+								WorkflowExecutionInfo: &types.WorkflowExecutionInfo{},
+							}, nil
+						default:
+							return nil, errors.New("connection refused")
+						}
+					}).Times(3)
+				m.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.StartWorkflowExecutionResponse{RunID: "new-run"}, nil)
+			},
+			wantResult: &ProcessFireResult{
+				TotalDelta:      1,
+				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: expectedWfID, RunID: "new-run"},
+				ClosedRuns:      map[string]types.WorkflowExecutionCloseStatus{"failed-run": types.WorkflowExecutionCloseStatusFailed},
+			},
+		},
+		{
+			name: "BUFFER holds the fire back when previous is running",
+			req: func() ProcessFireRequest {
+				r := baseReq
+				r.OverlapPolicy = types.ScheduleOverlapPolicyBuffer
+				r.LastStartedWorkflow = &RunningWorkflowInfo{WorkflowID: "old-wf", RunID: "old-run"}
+				return r
+			}(),
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeWorkflowExecutionResponse{
+						WorkflowExecutionInfo: &types.WorkflowExecutionInfo{CloseStatus: nil},
+					}, nil)
+			},
+			wantResult: &ProcessFireResult{
+				Buffered:        true,
+				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: "old-wf", RunID: "old-run"},
+			},
+		},
+		{
+			name: "signal with start ignores the overlap policy",
+			req: func() ProcessFireRequest {
+				r := baseReq
+				r.Action = types.StartWorkflowAction{}
+				r.SignalWithStart = &types.SignalWithStartWorkflowAction{
+					WorkflowID:   "entity-wf",
+					WorkflowType: &types.WorkflowType{Name: "entity"},
+					TaskList:     &types.TaskList{Name: "my-tasklist"},
+					SignalName:   "tick",
+					SignalInput:  []byte("signal-input"),
+				}
+				r.OverlapPolicy = types.ScheduleOverlapPolicySkipNew
+				r.LastStartedWorkflow = &RunningWorkflowInfo{WorkflowID: "entity-wf", RunID: "entity-run"}
+				return r
+			}(),
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.SignalWithStartWorkflowExecutionRequest, _ ...interface{}) (*types.StartWorkflowExecutionResponse, error) {
+						assert.Equal(t, "test-domain", req.Domain)
+						assert.Equal(t, "entity-wf", req.WorkflowID)
+						assert.Equal(t, "entity", req.WorkflowType.GetName())
+						assert.Equal(t, "tick", req.SignalName)
+						assert.Equal(t, []byte("signal-input"), req.SignalInput)
+						assert.Equal(t, generateRequestID("sched-1", scheduledTime.UnixNano(), TriggerSourceSchedule, 0), req.RequestID)
+						return &types.StartWorkflowExecutionResponse{RunID: "entity-run"}, nil
+					})
+			},
+			wantResult: &ProcessFireResult{
+				TotalDelta:      1,
+				Signaled:        true,
+				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: "entity-wf", RunID: "entity-run"},
+			},
+		},
+		{
+			name: "signal with start error",
+			req: func() ProcessFireRequest {
+				r := baseReq
+				r.SignalWithStart = &types.SignalWithStartWorkflowAction{WorkflowID: "entity-wf", SignalName: "tick"}
+				return r
+			}(),
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(nil, &types.InternalServiceError{Message: "oops"})
+			},
+			wantErr: true,
+		},
 		{
 			name:      "missing context returns error",
 			req:       baseReq,
@@ -357,11 +475,57 @@ func TestProcessScheduleFireActivity(t *testing.T) {
 				return
 			}
 			require.NoError(t, err)
+			if tc.wantResult.TotalDelta > 0 {
+				assert.False(t, result.StartTime.IsZero())
+				result.StartTime = time.Time{}
+			}
 			assert.Equal(t, tc.wantResult, result)
 		})
 	}
 }
 
+func TestLookupClosedRunsActivity(t *testing.T) {
+	req := LookupClosedRunsRequest{
+		Domain: "test-domain",
+		Runs: []RunningWorkflowInfo{
+			{WorkflowID: "wf-1", RunID: "run-1"},
+			{WorkflowID: "wf-2", RunID: "run-2"},
+			{WorkflowID: "wf-3", RunID: "run-3"},
+		},
+	}
+
+	t.Run("returns the close status of completed runs", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := frontend.NewMockClient(ctrl)
+		mockClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *types.DescribeWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error) {
+				assert.Equal(t, "test-domain", req.Domain)
+				switch req.Execution.RunID {
+				case "run-1":
+					return &types.DescribeWorkflowExecutionResponse{
+						WorkflowExecutionInfo: &types.WorkflowExecutionInfo{CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr()},
+					}, nil
+				case "run-2":
+					return &types.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: &types.WorkflowExecutionInfo{}}, nil
+				default:
+					return nil, errors.New("describe failed")
+				}
+			}).Times(3)
+		ctx := context.WithValue(context.Background(), schedulerContextKey, schedulerContext{FrontendClient: mockClient})
+
+		closedRuns, err := lookupClosedRunsActivity(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]types.WorkflowExecutionCloseStatus{
+			"run-1": types.WorkflowExecutionCloseStatusCompleted,
+		}, closedRuns)
+	})
+
+	t.Run("missing context returns error", func(t *testing.T) {
+		_, err := lookupClosedRunsActivity(context.Background(), req)
+		require.Error(t, err)
+	})
+}
+
 func TestIsEntityNotExistsError(t *testing.T) {
 	assert.True(t, isEntityNotExistsError(&types.EntityNotExistsError{Message: "not found"}))
 	assert.False(t, isEntityNotExistsError(errors.New("other")))
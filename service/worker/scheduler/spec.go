@@ -0,0 +1,177 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	// Embed the IANA time zone database so that every worker resolves schedule
+	// time zones identically, independent of the tzdata installed on the host.
+	// Next-fire computation must not differ between workers replaying the same history.
+	_ "time/tzdata"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// excludedDateLayout is the format of ScheduleSpec.ExcludedDates entries.
+const excludedDateLayout = "2006-01-02"
+
+// specSchedule is the cron.Schedule built from a types.ScheduleSpec. It fires at
+// the union of its cron and interval schedules, skipping excluded calendar dates.
+// It depends only on the spec and the time passed to Next, so it is safe to
+// evaluate inside workflow code.
+type specSchedule struct {
+	schedules []cron.Schedule
+	location  *time.Location
+	excluded  map[string]struct{}
+}
+
+// intervalSchedule fires at every multiple of every since the Unix epoch, shifted by offset.
+type intervalSchedule struct {
+	every  time.Duration
+	offset time.Duration
+}
+
+// specParser builds the schedule evaluated by the scheduler workflow from a spec.
+type specParser func(types.ScheduleSpec) (cron.Schedule, error)
+
+// ValidateScheduleSpec reports whether the spec can be evaluated by the scheduler workflow.
+func ValidateScheduleSpec(spec *types.ScheduleSpec) error {
+	if spec == nil {
+		return errors.New("schedule spec is not set")
+	}
+	_, err := parseScheduleSpec(*spec)
+	return err
+}
+
+// parseScheduleSpec builds the schedule evaluated by the scheduler workflow.
+// Cron expressions without their own CRON_TZ prefix and the excluded dates are
+// interpreted in the spec's time zone, UTC if none is set.
+func parseScheduleSpec(spec types.ScheduleSpec) (cron.Schedule, error) {
+	location := time.UTC
+	if spec.TimeZone != "" {
+		var err error
+		location, err = time.LoadLocation(spec.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %w", spec.TimeZone, err)
+		}
+	}
+
+	s := &specSchedule{location: location}
+
+	cronExpressions := spec.AdditionalCronExpressions
+	if spec.CronExpression != "" {
+		cronExpressions = append([]string{spec.CronExpression}, cronExpressions...)
+	}
+	for _, expr := range cronExpressions {
+		sched, err := cron.ParseStandard(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+		}
+		s.schedules = append(s.schedules, sched)
+	}
+
+	for _, interval := range spec.Intervals {
+		if interval == nil {
+			continue
+		}
+		if interval.Every < time.Second {
+			return nil, fmt.Errorf("invalid interval %v: must be at least one second", interval.Every)
+		}
+		if interval.Offset < 0 || interval.Offset >= interval.Every {
+			return nil, fmt.Errorf("invalid interval offset %v: must be in [0, %v)", interval.Offset, interval.Every)
+		}
+		s.schedules = append(s.schedules, intervalSchedule{every: interval.Every, offset: interval.Offset})
+	}
+
+	if len(s.schedules) == 0 {
+		return nil, errors.New("schedule spec has no cron expression or interval")
+	}
+
+	for _, date := range spec.ExcludedDates {
+		if _, err := time.ParseInLocation(excludedDateLayout, date, location); err != nil {
+			return nil, fmt.Errorf("invalid excluded date %q, expected YYYY-MM-DD: %w", date, err)
+		}
+		if s.excluded == nil {
+			s.excluded = make(map[string]struct{}, len(spec.ExcludedDates))
+		}
+		s.excluded[date] = struct{}{}
+	}
+
+	return s, nil
+}
+
+// parseCronOnlySpec is how executions started before scheduleSpecChangeID
+// evaluate their spec: the primary cron expression alone, in the location of
+// the time passed to Next.
+func parseCronOnlySpec(spec types.ScheduleSpec) (cron.Schedule, error) {
+	return cron.ParseStandard(spec.CronExpression)
+}
+
+// Next returns the earliest fire time of any underlying schedule strictly after t
+// that does not fall on an excluded date, or the zero time if there is none.
+func (s *specSchedule) Next(t time.Time) time.Time {
+	for {
+		next := s.nextUnfiltered(t)
+		if next.IsZero() {
+			return next
+		}
+		local := next.In(s.location)
+		if _, ok := s.excluded[local.Format(excludedDateLayout)]; !ok {
+			return next
+		}
+		// Skip the rest of the excluded day in one step rather than walking
+		// every fire time within it.
+		y, m, d := local.Date()
+		t = time.Date(y, m, d+1, 0, 0, 0, 0, s.location).Add(-time.Nanosecond)
+	}
+}
+
+func (s *specSchedule) nextUnfiltered(t time.Time) time.Time {
+	// Cron schedules without an explicit CRON_TZ are evaluated in the location
+	// of the time they are given.
+	t = t.In(s.location)
+	var earliest time.Time
+	for _, sched := range s.schedules {
+		next := sched.Next(t)
+		if next.IsZero() {
+			continue
+		}
+		if earliest.IsZero() || next.Before(earliest) {
+			earliest = next
+		}
+	}
+	return earliest
+}
+
+// Next returns the first interval boundary strictly after t.
+func (i intervalSchedule) Next(t time.Time) time.Time {
+	every := i.every.Nanoseconds()
+	elapsed := t.UnixNano() - i.offset.Nanoseconds()
+	periods := elapsed / every
+	if elapsed < 0 && elapsed%every != 0 {
+		periods--
+	}
+	return time.Unix(0, (periods+1)*every+i.offset.Nanoseconds()).In(t.Location())
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestParseScheduleSpecNext(t *testing.T) {
+	tests := []struct {
+		name  string
+		spec  types.ScheduleSpec
+		now   time.Time
+		wants []time.Time
+	}{
+		{
+			name: "union of several cron expressions",
+			spec: types.ScheduleSpec{
+				CronExpression:            "0 9 * * *",
+				AdditionalCronExpressions: []string{"30 17 * * *"},
+			},
+			now: time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC),
+			wants: []time.Time{
+				time.Date(2026, 1, 15, 17, 30, 0, 0, time.UTC),
+				time.Date(2026, 1, 16, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "additional cron expressions without primary expression",
+			spec: types.ScheduleSpec{AdditionalCronExpressions: []string{"0 12 * * *"}},
+			now:  time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC),
+			wants: []time.Time{
+				time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "interval with offset",
+			spec: types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Every: 90 * time.Minute, Offset: 10 * time.Minute}}},
+			now:  time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
+			wants: []time.Time{
+				time.Date(2026, 1, 15, 0, 10, 0, 0, time.UTC),
+				time.Date(2026, 1, 15, 1, 40, 0, 0, time.UTC),
+				time.Date(2026, 1, 15, 3, 10, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "cron and interval matching the same time fire once",
+			spec: types.ScheduleSpec{
+				CronExpression: "0 * * * *",
+				Intervals:      []*types.ScheduleIntervalSpec{{Every: time.Hour}},
+			},
+			now: time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC),
+			wants: []time.Time{
+				time.Date(2026, 1, 15, 11, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "cron evaluated in time zone across daylight saving change",
+			spec: types.ScheduleSpec{CronExpression: "0 9 * * *", TimeZone: "America/New_York"},
+			now:  time.Date(2026, 3, 7, 15, 0, 0, 0, time.UTC),
+			wants: []time.Time{
+				time.Date(2026, 3, 8, 13, 0, 0, 0, time.UTC), // 09:00 EDT
+				time.Date(2026, 3, 9, 13, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "CRON_TZ prefix takes precedence over spec time zone",
+			spec: types.ScheduleSpec{CronExpression: "CRON_TZ=Asia/Tokyo 0 9 * * *", TimeZone: "America/New_York"},
+			now:  time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
+			wants: []time.Time{
+				time.Date(2026, 1, 16, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "excluded date is skipped",
+			spec: types.ScheduleSpec{CronExpression: "0 9 * * *", ExcludedDates: []string{"2026-12-25"}},
+			now:  time.Date(2026, 12, 24, 10, 0, 0, 0, time.UTC),
+			wants: []time.Time{
+				time.Date(2026, 12, 26, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "excluded date is evaluated in the spec time zone",
+			spec: types.ScheduleSpec{
+				Intervals:     []*types.ScheduleIntervalSpec{{Every: time.Hour}},
+				ExcludedDates: []string{"2026-01-16"},
+				TimeZone:      "Asia/Tokyo",
+			},
+			now: time.Date(2026, 1, 15, 14, 30, 0, 0, time.UTC), // 23:30 JST
+			wants: []time.Time{
+				time.Date(2026, 1, 16, 15, 0, 0, 0, time.UTC), // 00:00 JST on the 17th
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sched, err := parseScheduleSpec(tt.spec)
+			require.NoError(t, err)
+
+			now := tt.now
+			for _, want := range tt.wants {
+				got := sched.Next(now)
+				assert.Equal(t, want, got.UTC())
+				// The result must not depend on the location of the input time,
+				// which differs between workers.
+				assert.True(t, got.Equal(sched.Next(now.In(time.FixedZone("worker", -7*3600)))))
+				now = got
+			}
+		})
+	}
+}
+
+func TestValidateScheduleSpec(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    *types.ScheduleSpec
+		wantErr string
+	}{
+		{
+			name:    "nil spec",
+			wantErr: "schedule spec is not set",
+		},
+		{
+			name:    "no cron expression or interval",
+			spec:    &types.ScheduleSpec{TimeZone: "UTC"},
+			wantErr: "no cron expression or interval",
+		},
+		{
+			name:    "invalid cron expression",
+			spec:    &types.ScheduleSpec{CronExpression: "0 9 * * *", AdditionalCronExpressions: []string{"not a cron"}},
+			wantErr: `invalid cron expression "not a cron"`,
+		},
+		{
+			name:    "unknown time zone",
+			spec:    &types.ScheduleSpec{CronExpression: "0 9 * * *", TimeZone: "Mars/Olympus_Mons"},
+			wantErr: `invalid time zone "Mars/Olympus_Mons"`,
+		},
+		{
+			name:    "interval below one second",
+			spec:    &types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Every: time.Millisecond}}},
+			wantErr: "must be at least one second",
+		},
+		{
+			name:    "offset not smaller than interval",
+			spec:    &types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Every: time.Hour, Offset: time.Hour}}},
+			wantErr: "invalid interval offset",
+		},
+		{
+			name:    "negative offset",
+			spec:    &types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Every: time.Hour, Offset: -time.Minute}}},
+			wantErr: "invalid interval offset",
+		},
+		{
+			name:    "malformed excluded date",
+			spec:    &types.ScheduleSpec{CronExpression: "0 9 * * *", ExcludedDates: []string{"12/25/2026"}},
+			wantErr: `invalid excluded date "12/25/2026"`,
+		},
+		{
+			name: "valid multi-spec",
+			spec: &types.ScheduleSpec{
+				CronExpression:            "0 9 * * 1-5",
+				AdditionalCronExpressions: []string{"0 12 * * 6"},
+				Intervals:                 []*types.ScheduleIntervalSpec{{Every: 90 * time.Minute, Offset: 10 * time.Minute}},
+				ExcludedDates:             []string{"2026-12-25", "2027-01-01"},
+				TimeZone:                  "Europe/Istanbul",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateScheduleSpec(tt.spec)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -29,12 +29,15 @@ import (
 const (
 	WorkflowTypeName = "cadence-scheduler"
 	TaskListName     = "cadence-scheduler"
+	// WorkflowIDPrefix is prepended to the schedule ID to build the ID of its scheduler workflow
+	WorkflowIDPrefix = "cadence-scheduler:"
 
 	SignalNamePause    = "scheduler-pause"
 	SignalNameUnpause  = "scheduler-unpause"
 	SignalNameUpdate   = "scheduler-update"
 	SignalNameBackfill = "scheduler-backfill"
 	SignalNameDelete   = "scheduler-delete"
+	SignalNameTrigger  = "scheduler-trigger"
 
 	QueryTypeDescribe = "scheduler-describe"
 
@@ -42,6 +45,33 @@ const (
 	maxCatchUpFiresPerExecution      = 10
 	maxBackfillFiresPerExecution     = 10
 	maxPendingBackfills              = 10
+	maxRecentActions                 = 10
+	defaultBufferLimit               = 1
+
+	// bufferCheckInterval is how often buffered fires are retried while the
+	// workflow started by an earlier fire is still running.
+	bufferCheckInterval = 30 * time.Second
+	// runStatusCheckInterval is how often the close status of recently started
+	// workflows is first refreshed while any of them is still running. The
+	// interval doubles after every check that finds no closed run, up to
+	// maxRunStatusCheckInterval, and is reset when a workflow is started.
+	runStatusCheckInterval    = time.Minute
+	maxRunStatusCheckInterval = time.Hour
+
+	// runStatusTrackingChangeID versions the run status refresh timer, which
+	// executions started before it was introduced do not have in their history.
+	// Version 2 backs the timer off, version 1 refreshes every runStatusCheckInterval.
+	runStatusTrackingChangeID = "scheduler-run-status-tracking"
+	// scheduleSpecChangeID versions the evaluation of the full schedule spec.
+	// Executions started before it evaluate the primary cron expression only.
+	scheduleSpecChangeID = "scheduler-schedule-spec"
+	// listEntrySearchAttributeChangeID versions the upsert of the schedule list
+	// entry search attribute.
+	listEntrySearchAttributeChangeID = "scheduler-list-entry-search-attribute"
+
+	// maxListEntrySize matches the default size limit of a search attribute value.
+	// The spec is left out of larger list entries.
+	maxListEntrySize = 2 * 1024
 
 	localActivityScheduleToCloseTimeout = 60 * time.Second
 	localActivityMaxRetries             = 3
@@ -73,12 +103,27 @@ type SchedulerWorkflowState struct {
 	MissedRuns        int64             `json:"missedRuns"`
 	SkippedRuns       int64             `json:"skippedRuns"`
 	Iterations        int               `json:"iterations"`
-	BufferedRuns      int               `json:"bufferedRuns"`
 	PendingBackfills  []BackfillRequest `json:"pendingBackfills,omitempty"`
+	// BufferedFires are fires held back by the BUFFER overlap policy, oldest first.
+	// They are started one at a time once the previously started workflow completes.
+	BufferedFires []BufferedFire `json:"bufferedFires,omitempty"`
+	// PendingTriggers are trigger signals received in the current iteration. They are
+	// fired before the iteration ends so they never need to survive ContinueAsNew.
+	PendingTriggers []TriggerSignal `json:"-"`
+	// TriggerCount is the number of trigger signals fired over the schedule's
+	// lifetime. It numbers each triggered fire, see BufferedFire.TriggerID.
+	TriggerCount int64 `json:"triggerCount,omitempty"`
 	// LastStartedWorkflow tracks the most recently started target workflow so
 	// the overlap policy can check whether it is still running before starting
 	// the next one. Nil when no workflow has been started yet.
 	LastStartedWorkflow *RunningWorkflowInfo `json:"lastStartedWorkflow,omitempty"`
+	// RecentActions is a bounded history of the last maxRecentActions workflows
+	// started by the schedule, oldest first. Close statuses are filled in once
+	// the runs complete, see runStatusCheckInterval.
+	RecentActions []types.ScheduleActionResult `json:"recentActions,omitempty"`
+	// RunStatusCheckBackoff is the current wait between run status refreshes.
+	// Zero means runStatusCheckInterval.
+	RunStatusCheckBackoff time.Duration `json:"runStatusCheckBackoff,omitempty"`
 }
 
 // RunningWorkflowInfo identifies a target workflow started by the scheduler,
@@ -96,6 +141,15 @@ type BackfillRequest struct {
 	BackfillID    string                      `json:"backfillId,omitempty"`
 }
 
+// BufferedFire is a fire waiting for the previously started workflow to complete.
+type BufferedFire struct {
+	ScheduledTime time.Time     `json:"scheduledTime"`
+	TriggerSource TriggerSource `json:"triggerSource"`
+	// TriggerID numbers fires caused by a trigger signal, which may share a
+	// scheduled time. Zero for schedule and backfill fires.
+	TriggerID int64 `json:"triggerId,omitempty"`
+}
+
 // PauseSignal is the payload sent with a pause signal.
 type PauseSignal struct {
 	Reason   string `json:"reason,omitempty"`
@@ -123,6 +177,18 @@ type BackfillSignal struct {
 	BackfillID    string                      `json:"backfillId,omitempty"`
 }
 
+// WorkflowID returns the ID of the scheduler workflow of a schedule.
+func WorkflowID(scheduleID string) string {
+	return WorkflowIDPrefix + scheduleID
+}
+
+// TriggerSignal is the payload sent with a trigger signal. The schedule fires
+// immediately, even when paused, using the given overlap policy if set and the
+// schedule's overlap policy otherwise.
+type TriggerSignal struct {
+	OverlapPolicy types.ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
+}
+
 // ScheduleDescription is the query result returned by the describe query handler.
 // It provides a snapshot of the schedule's current configuration and runtime state.
 type ScheduleDescription struct {
@@ -139,6 +205,8 @@ type ScheduleDescription struct {
 	TotalRuns   int64                  `json:"totalRuns"`
 	MissedRuns  int64                  `json:"missedRuns"`
 	SkippedRuns int64                  `json:"skippedRuns"`
+	// RecentActions lists the most recent workflows started by the schedule, oldest first.
+	RecentActions []*types.ScheduleActionResult `json:"recentActions,omitempty"`
 }
 
 // TriggerSource identifies what caused a schedule fire, used to differentiate
@@ -149,6 +217,7 @@ type TriggerSource string
 const (
 	TriggerSourceSchedule TriggerSource = "schedule"
 	TriggerSourceBackfill TriggerSource = "backfill"
+	TriggerSourceTrigger  TriggerSource = "trigger"
 )
 
 // ProcessFireRequest is the input to processScheduleFireActivity. It contains
@@ -162,8 +231,20 @@ type ProcessFireRequest struct {
 	Action              types.StartWorkflowAction   `json:"action"`
 	ScheduledTime       time.Time                   `json:"scheduledTime"`
 	TriggerSource       TriggerSource               `json:"triggerSource"`
+	TriggerID           int64                       `json:"triggerId,omitempty"`
 	OverlapPolicy       types.ScheduleOverlapPolicy `json:"overlapPolicy"`
 	LastStartedWorkflow *RunningWorkflowInfo        `json:"lastStartedWorkflow,omitempty"`
+	// PendingRuns are previously started runs whose close status is not known
+	// yet. The activity looks them up so the workflow can record how they ended.
+	PendingRuns []RunningWorkflowInfo `json:"pendingRuns,omitempty"`
+	// SignalWithStart is set instead of Action when the schedule signals a workflow.
+	SignalWithStart *types.SignalWithStartWorkflowAction `json:"signalWithStart,omitempty"`
+}
+
+// LookupClosedRunsRequest is the input to lookupClosedRunsActivity.
+type LookupClosedRunsRequest struct {
+	Domain string                `json:"domain"`
+	Runs   []RunningWorkflowInfo `json:"runs"`
 }
 
 // ProcessFireResult is the output of processScheduleFireActivity. The workflow
@@ -172,4 +253,14 @@ type ProcessFireResult struct {
 	StartedWorkflow *RunningWorkflowInfo `json:"startedWorkflow,omitempty"`
 	TotalDelta      int64                `json:"totalDelta"`
 	SkippedDelta    int64                `json:"skippedDelta"`
+	// Buffered is set when the BUFFER overlap policy held the fire back because the
+	// previously started workflow is still running. Nothing was started.
+	Buffered bool `json:"buffered,omitempty"`
+	// Signaled is set when StartedWorkflow was signaled rather than started by this
+	// fire, so it is not tracked for overlap checks.
+	Signaled bool `json:"signaled,omitempty"`
+	// StartTime is when the target workflow was started, set together with TotalDelta.
+	StartTime time.Time `json:"startTime,omitempty"`
+	// ClosedRuns maps the run IDs of closed PendingRuns to their close status.
+	ClosedRuns map[string]types.WorkflowExecutionCloseStatus `json:"closedRuns,omitempty"`
 }
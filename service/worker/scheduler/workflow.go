@@ -21,6 +21,7 @@
 package scheduler
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -28,6 +29,7 @@ import (
 	"go.uber.org/cadence/workflow"
 	"go.uber.org/zap"
 
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -38,12 +40,13 @@ type signalChannels struct {
 	update   workflow.Channel
 	backfill workflow.Channel
 	delete   workflow.Channel
+	trigger  workflow.Channel
 }
 
 // SchedulerWorkflow is a long-running workflow that manages a single schedule.
-// It computes the next fire time from the cron expression, waits via a timer,
+// It computes the next fire time from the schedule spec, waits via a timer,
 // and dispatches the configured action. Signals control pause/unpause, update,
-// backfill, and deletion.
+// backfill, trigger, and deletion.
 //
 // The main loop follows a state-machine pattern: all inputs (signals and timer)
 // uniformly mutate state, and then a single decision point inspects the resulting
@@ -73,12 +76,18 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 		update:   workflow.GetSignalChannel(ctx, SignalNameUpdate),
 		backfill: workflow.GetSignalChannel(ctx, SignalNameBackfill),
 		delete:   workflow.GetSignalChannel(ctx, SignalNameDelete),
+		trigger:  workflow.GetSignalChannel(ctx, SignalNameTrigger),
 	}
 
-	sched, err := cron.ParseStandard(input.Spec.CronExpression)
+	parseSpec := specParser(parseScheduleSpec)
+	if workflow.GetVersion(ctx, scheduleSpecChangeID, workflow.DefaultVersion, 1) == workflow.DefaultVersion {
+		parseSpec = parseCronOnlySpec
+	}
+
+	sched, err := parseSpec(input.Spec)
 	if err != nil {
-		logger.Error("invalid cron expression, terminating", zap.String("cron", input.Spec.CronExpression), zap.Error(err))
-		return fmt.Errorf("invalid cron expression %q: %w", input.Spec.CronExpression, err)
+		logger.Error("invalid schedule spec, terminating", zap.String("cron", input.Spec.CronExpression), zap.Error(err))
+		return fmt.Errorf("invalid schedule spec: %w", err)
 	}
 
 	// On the first iteration (after ContinueAsNew or fresh start), check for
@@ -95,15 +104,27 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 		return safeContinueAsNew(ctx, logger, chs.delete, input, state)
 	}
 
+	runStatusVersion := workflow.GetVersion(ctx, runStatusTrackingChangeID, workflow.DefaultVersion, 2)
+	trackRunStatus := runStatusVersion >= 1
+	backOffRunStatus := runStatusVersion >= 2
+	upsertListEntry := workflow.GetVersion(ctx, listEntrySearchAttributeChangeID, workflow.DefaultVersion, 1) == 1
+	var recordedListEntry string
+
 	for {
 		state.Iterations++
 
 		// Set up timer only when not paused. When paused, applyAllInputs
 		// blocks on signals alone until an unpause or delete arrives.
+		// While fires are buffered the timer wakes up early to retry them,
+		// and while started workflows are running it wakes up early to
+		// refresh their status, even when paused.
 		var timerFuture workflow.Future
 		var timerCancel func()
+		bufferCheck := false
+		runStatusCheck := false
+		now := workflow.Now(ctx)
+		var wakeUp time.Time
 		if !state.Paused {
-			now := workflow.Now(ctx)
 			nextRun := computeNextRunTime(sched, now, input.Spec)
 			if nextRun.IsZero() {
 				logger.Info("schedule has no more runs (past end time), completing")
@@ -111,7 +132,23 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 			}
 			state.NextRunTime = nextRun
 
-			dur := nextRun.Sub(now)
+			wakeUp = nextRun
+			if len(state.BufferedFires) > 0 && now.Add(bufferCheckInterval).Before(nextRun) {
+				wakeUp = now.Add(bufferCheckInterval)
+				bufferCheck = true
+			}
+		}
+		checkInterval := runStatusCheckInterval
+		if backOffRunStatus && state.RunStatusCheckBackoff > 0 {
+			checkInterval = state.RunStatusCheckBackoff
+		}
+		if trackRunStatus && !bufferCheck && len(pendingRuns(state)) > 0 &&
+			(wakeUp.IsZero() || now.Add(checkInterval).Before(wakeUp)) {
+			wakeUp = now.Add(checkInterval)
+			runStatusCheck = true
+		}
+		if !wakeUp.IsZero() {
+			dur := wakeUp.Sub(now)
 			if dur < 0 {
 				dur = 0
 			}
@@ -120,7 +157,11 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 			timerFuture = workflow.NewTimer(timerCtx, dur)
 		}
 
-		changed, timerFired := applyAllInputs(ctx, logger, timerFuture, chs, state, &input)
+		if upsertListEntry {
+			recordedListEntry = upsertScheduleListEntry(ctx, logger, &input, state, recordedListEntry)
+		}
+
+		changed, timerFired := applyAllInputs(ctx, logger, parseSpec, timerFuture, chs, state, &input)
 
 		if timerCancel != nil {
 			timerCancel()
@@ -131,10 +172,24 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 			return nil
 		}
 
-		if timerFired && !state.Paused {
-			processScheduleFire(ctx, logger, &input, state, state.NextRunTime, TriggerSourceSchedule)
+		if timerFired {
+			switch {
+			case runStatusCheck:
+				closed := refreshRunStatus(ctx, logger, &input, state)
+				if backOffRunStatus && !closed {
+					backOffRunStatusCheck(state)
+				}
+			case state.Paused:
+				// paused by a signal that arrived together with the timer
+			case bufferCheck:
+				processBufferedFires(ctx, logger, &input, state)
+			default:
+				processScheduleFire(ctx, logger, &input, state, state.NextRunTime, TriggerSourceSchedule, 0, types.ScheduleOverlapPolicyInvalid)
+			}
 		}
 
+		processTriggers(ctx, logger, &input, state)
+
 		if changed || state.Iterations >= maxIterationsBeforeContinueAsNew {
 			return safeContinueAsNew(ctx, logger, chs.delete, input, state)
 		}
@@ -151,6 +206,7 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 func applyAllInputs(
 	ctx workflow.Context,
 	logger *zap.Logger,
+	parseSpec specParser,
 	timerFuture workflow.Future,
 	chs signalChannels,
 	state *SchedulerWorkflowState,
@@ -188,7 +244,7 @@ func applyAllInputs(
 	selector.AddReceive(chs.update, func(c workflow.Channel, more bool) {
 		var sig UpdateSignal
 		c.Receive(ctx, &sig)
-		if handleUpdate(logger, sig, input, state) {
+		if handleUpdate(logger, parseSpec, sig, input, state) {
 			stateChanged = true
 		}
 	})
@@ -201,6 +257,12 @@ func applyAllInputs(
 		}
 	})
 
+	selector.AddReceive(chs.trigger, func(c workflow.Channel, more bool) {
+		var sig TriggerSignal
+		c.Receive(ctx, &sig)
+		handleTrigger(logger, sig, state)
+	})
+
 	selector.AddReceive(chs.delete, func(c workflow.Channel, more bool) {
 		c.Receive(ctx, nil)
 		state.Deleted = true
@@ -208,7 +270,7 @@ func applyAllInputs(
 
 	selector.Select(ctx)
 
-	if drainBufferedSignals(logger, chs, state, input) {
+	if drainBufferedSignals(logger, parseSpec, chs, state, input) {
 		stateChanged = true
 	}
 
@@ -220,6 +282,7 @@ func applyAllInputs(
 // Returns true if a state-changing signal was found.
 func drainBufferedSignals(
 	logger *zap.Logger,
+	parseSpec specParser,
 	chs signalChannels,
 	state *SchedulerWorkflowState,
 	input *SchedulerWorkflowInput,
@@ -253,7 +316,7 @@ func drainBufferedSignals(
 		if !chs.update.ReceiveAsync(&sig) {
 			break
 		}
-		if handleUpdate(logger, sig, input, state) {
+		if handleUpdate(logger, parseSpec, sig, input, state) {
 			stateChanged = true
 		}
 	}
@@ -266,6 +329,13 @@ func drainBufferedSignals(
 			stateChanged = true
 		}
 	}
+	for {
+		var sig TriggerSignal
+		if !chs.trigger.ReceiveAsync(&sig) {
+			break
+		}
+		handleTrigger(logger, sig, state)
+	}
 
 	return stateChanged
 }
@@ -290,15 +360,15 @@ func handleUnpause(logger *zap.Logger, sig UnpauseSignal, state *SchedulerWorkfl
 	return true
 }
 
-func handleUpdate(logger *zap.Logger, sig UpdateSignal, input *SchedulerWorkflowInput, state *SchedulerWorkflowState) bool {
+func handleUpdate(logger *zap.Logger, parseSpec specParser, sig UpdateSignal, input *SchedulerWorkflowInput, state *SchedulerWorkflowState) bool {
 	if sig.Spec == nil && sig.Action == nil && sig.Policies == nil {
 		logger.Info("ignoring empty update signal")
 		return false
 	}
 	changed := false
 	if sig.Spec != nil {
-		if _, err := cron.ParseStandard(sig.Spec.CronExpression); err != nil {
-			logger.Error("ignoring update with invalid cron expression",
+		if _, err := parseSpec(*sig.Spec); err != nil {
+			logger.Error("ignoring update with invalid schedule spec",
 				zap.String("cron", sig.Spec.CronExpression), zap.Error(err))
 		} else {
 			input.Spec = *sig.Spec
@@ -366,33 +436,116 @@ func handleBackfill(logger *zap.Logger, sig BackfillSignal, state *SchedulerWork
 	return true
 }
 
+func handleTrigger(logger *zap.Logger, sig TriggerSignal, state *SchedulerWorkflowState) {
+	state.PendingTriggers = append(state.PendingTriggers, sig)
+	logger.Info("schedule trigger received", zap.String("overlapPolicy", sig.OverlapPolicy.String()))
+}
+
+// processTriggers fires the schedule once for every trigger received in this iteration.
+// Triggers are explicit operator requests to run now, so unlike backfills they fire
+// even while the schedule is paused.
+func processTriggers(ctx workflow.Context, logger *zap.Logger, input *SchedulerWorkflowInput, state *SchedulerWorkflowState) {
+	for _, sig := range state.PendingTriggers {
+		// Triggers handled in the same iteration share a scheduled time, so each one is
+		// numbered to start its own workflow instead of being deduplicated.
+		state.TriggerCount++
+		processScheduleFire(ctx, logger, input, state, workflow.Now(ctx), TriggerSourceTrigger, state.TriggerCount, sig.OverlapPolicy)
+	}
+	state.PendingTriggers = nil
+}
+
 // processScheduleFire executes the configured action for a single schedule fire.
-// All side effects (overlap check, cancel/terminate, start) are encapsulated in
-// a single activity so that the overlap logic can evolve without introducing
-// nondeterminism in the workflow history.
-func processScheduleFire(ctx workflow.Context, logger *zap.Logger, input *SchedulerWorkflowInput, state *SchedulerWorkflowState, scheduledTime time.Time, trigger TriggerSource) {
+// triggerID is only set for fires caused by a trigger signal. An unset overlapPolicy means the schedule's overlap policy applies. Under the
+// BUFFER policy a fire arriving while earlier fires are buffered queues up behind
+// them so buffered fires start in order.
+func processScheduleFire(ctx workflow.Context, logger *zap.Logger, input *SchedulerWorkflowInput, state *SchedulerWorkflowState, scheduledTime time.Time, trigger TriggerSource, triggerID int64, overlapPolicy types.ScheduleOverlapPolicy) {
 	state.LastRunTime = scheduledTime
 
 	logger.Info("schedule fired",
 		zap.Time("scheduledTime", scheduledTime),
+		zap.String("triggerSource", string(trigger)),
 	)
 
-	if input.Action.StartWorkflow == nil {
-		state.MissedRuns++
-		logger.Error("schedule action has no StartWorkflow configuration")
+	if overlapPolicy == types.ScheduleOverlapPolicyInvalid {
+		overlapPolicy = input.Policies.OverlapPolicy
+	}
+	if overlapPolicy == types.ScheduleOverlapPolicyBuffer && len(state.BufferedFires) > 0 {
+		bufferFire(logger, input, state, scheduledTime, trigger, triggerID)
+		processBufferedFires(ctx, logger, input, state)
 		return
 	}
 
+	result := executeScheduleFire(ctx, logger, input, state, scheduledTime, trigger, triggerID, overlapPolicy)
+	if result != nil && result.Buffered {
+		bufferFire(logger, input, state, scheduledTime, trigger, triggerID)
+	}
+}
+
+// bufferFire holds a fire back until the previously started workflow completes.
+// Fires beyond the schedule's buffer limit are skipped.
+func bufferFire(logger *zap.Logger, input *SchedulerWorkflowInput, state *SchedulerWorkflowState, scheduledTime time.Time, trigger TriggerSource, triggerID int64) {
+	limit := int(input.Policies.BufferLimit)
+	if limit <= 0 {
+		limit = defaultBufferLimit
+	}
+	if len(state.BufferedFires) >= limit {
+		state.SkippedRuns++
+		logger.Info("schedule fire skipped, buffer is full",
+			zap.Time("scheduledTime", scheduledTime),
+			zap.Int("bufferLimit", limit),
+		)
+		return
+	}
+	state.BufferedFires = append(state.BufferedFires, BufferedFire{
+		ScheduledTime: scheduledTime,
+		TriggerSource: trigger,
+		TriggerID:     triggerID,
+	})
+	logger.Info("schedule fire buffered",
+		zap.Time("scheduledTime", scheduledTime),
+		zap.Int("bufferedCount", len(state.BufferedFires)),
+	)
+}
+
+// processBufferedFires starts buffered fires in order until one is held back
+// again because the workflow started before it is still running.
+func processBufferedFires(ctx workflow.Context, logger *zap.Logger, input *SchedulerWorkflowInput, state *SchedulerWorkflowState) {
+	for len(state.BufferedFires) > 0 {
+		fire := state.BufferedFires[0]
+		result := executeScheduleFire(ctx, logger, input, state, fire.ScheduledTime, fire.TriggerSource, fire.TriggerID, types.ScheduleOverlapPolicyBuffer)
+		if result != nil && result.Buffered {
+			return
+		}
+		state.BufferedFires = state.BufferedFires[1:]
+	}
+}
+
+// executeScheduleFire runs the configured action and applies the outcome to the state.
+// All side effects (overlap check, cancel/terminate, start or signal) are encapsulated
+// in a single activity so that the overlap logic can evolve without introducing
+// nondeterminism in the workflow history. Returns nil if the fire failed.
+func executeScheduleFire(ctx workflow.Context, logger *zap.Logger, input *SchedulerWorkflowInput, state *SchedulerWorkflowState, scheduledTime time.Time, trigger TriggerSource, triggerID int64, overlapPolicy types.ScheduleOverlapPolicy) *ProcessFireResult {
+	if input.Action.StartWorkflow == nil && input.Action.SignalWithStartWorkflow == nil {
+		state.MissedRuns++
+		logger.Error("schedule action has no StartWorkflow or SignalWithStartWorkflow configuration")
+		return nil
+	}
+
 	actCtx := workflow.WithLocalActivityOptions(ctx, defaultActivityOptions())
 
 	req := ProcessFireRequest{
 		Domain:              input.Domain,
 		ScheduleID:          input.ScheduleID,
-		Action:              *input.Action.StartWorkflow,
+		SignalWithStart:     input.Action.SignalWithStartWorkflow,
 		ScheduledTime:       scheduledTime,
 		TriggerSource:       trigger,
-		OverlapPolicy:       input.Policies.OverlapPolicy,
+		TriggerID:           triggerID,
+		OverlapPolicy:       overlapPolicy,
 		LastStartedWorkflow: state.LastStartedWorkflow,
+		PendingRuns:         pendingRuns(state),
+	}
+	if input.Action.StartWorkflow != nil {
+		req.Action = *input.Action.StartWorkflow
 	}
 
 	var result ProcessFireResult
@@ -402,14 +555,25 @@ func processScheduleFire(ctx workflow.Context, logger *zap.Logger, input *Schedu
 			zap.Time("scheduledTime", scheduledTime),
 			zap.Error(err),
 		)
-		return
+		return nil
 	}
 
 	state.TotalRuns += result.TotalDelta
 	state.SkippedRuns += result.SkippedDelta
-	if result.StartedWorkflow != nil {
+	if result.StartedWorkflow != nil && !result.Signaled {
 		state.LastStartedWorkflow = result.StartedWorkflow
 	}
+	applyClosedRuns(state, result.ClosedRuns)
+	// Signaled workflows are long-lived and not expected to close, so they are left
+	// out of the recent actions whose close status is polled.
+	if result.TotalDelta > 0 && result.StartedWorkflow != nil && !result.Signaled {
+		recordRecentAction(state, types.ScheduleActionResult{
+			ScheduledTime: scheduledTime,
+			ActualTime:    result.StartTime,
+			WorkflowID:    result.StartedWorkflow.WorkflowID,
+			RunID:         result.StartedWorkflow.RunID,
+		})
+	}
 
 	if result.TotalDelta > 0 && result.StartedWorkflow != nil {
 		logger.Info("scheduled workflow started",
@@ -421,6 +585,68 @@ func processScheduleFire(ctx workflow.Context, logger *zap.Logger, input *Schedu
 			zap.Time("scheduledTime", scheduledTime),
 		)
 	}
+	return &result
+}
+
+// pendingRuns returns the recently started runs whose close status is not known yet.
+func pendingRuns(state *SchedulerWorkflowState) []RunningWorkflowInfo {
+	var pending []RunningWorkflowInfo
+	for _, action := range state.RecentActions {
+		if action.CloseStatus == nil {
+			pending = append(pending, RunningWorkflowInfo{WorkflowID: action.WorkflowID, RunID: action.RunID})
+		}
+	}
+	return pending
+}
+
+// refreshRunStatus records the close status of recently started workflows that
+// completed since they were last checked, so the describe query reports them
+// without the caller looking each run up. Returns whether any of them closed.
+func refreshRunStatus(ctx workflow.Context, logger *zap.Logger, input *SchedulerWorkflowInput, state *SchedulerWorkflowState) bool {
+	actCtx := workflow.WithLocalActivityOptions(ctx, defaultActivityOptions())
+	req := LookupClosedRunsRequest{
+		Domain: input.Domain,
+		Runs:   pendingRuns(state),
+	}
+
+	var closedRuns map[string]types.WorkflowExecutionCloseStatus
+	if err := workflow.ExecuteLocalActivity(actCtx, lookupClosedRunsActivity, req).Get(ctx, &closedRuns); err != nil {
+		logger.Warn("lookupClosedRunsActivity failed", zap.Error(err))
+		return false
+	}
+	applyClosedRuns(state, closedRuns)
+	return len(closedRuns) > 0
+}
+
+// applyClosedRuns records the close status of recent actions that have completed.
+func applyClosedRuns(state *SchedulerWorkflowState, closedRuns map[string]types.WorkflowExecutionCloseStatus) {
+	for i := range state.RecentActions {
+		action := &state.RecentActions[i]
+		if status, ok := closedRuns[action.RunID]; ok && action.CloseStatus == nil {
+			action.CloseStatus = status.Ptr()
+		}
+	}
+}
+
+// backOffRunStatusCheck doubles the wait before the next run status refresh,
+// up to maxRunStatusCheckInterval.
+func backOffRunStatusCheck(state *SchedulerWorkflowState) {
+	backoff := state.RunStatusCheckBackoff
+	if backoff <= 0 {
+		backoff = runStatusCheckInterval
+	}
+	state.RunStatusCheckBackoff = min(2*backoff, maxRunStatusCheckInterval)
+}
+
+// recordRecentAction appends a started run to the recent actions, dropping the
+// oldest entries once more than maxRecentActions are kept. The run status
+// refresh backoff is reset so the new run is checked promptly.
+func recordRecentAction(state *SchedulerWorkflowState, action types.ScheduleActionResult) {
+	state.RecentActions = append(state.RecentActions, action)
+	state.RunStatusCheckBackoff = 0
+	if overflow := len(state.RecentActions) - maxRecentActions; overflow > 0 {
+		state.RecentActions = append([]types.ScheduleActionResult(nil), state.RecentActions[overflow:]...)
+	}
 }
 
 // defaultActivityOptions returns the standard local activity options used by
@@ -437,7 +663,7 @@ func defaultActivityOptions() workflow.LocalActivityOptions {
 	}
 }
 
-// computeNextRunTime determines the next fire time for the cron schedule,
+// computeNextRunTime determines the next fire time for the schedule,
 // respecting the spec's StartTime and EndTime boundaries.
 func computeNextRunTime(sched cron.Schedule, now time.Time, spec types.ScheduleSpec) time.Time {
 	if !spec.StartTime.IsZero() && now.Before(spec.StartTime) {
@@ -558,7 +784,7 @@ func processMissedRuns(ctx workflow.Context, logger *zap.Logger, sched cron.Sche
 		if fired >= maxCatchUpFiresPerExecution {
 			break
 		}
-		processScheduleFire(ctx, logger, input, state, t, TriggerSourceSchedule)
+		processScheduleFire(ctx, logger, input, state, t, TriggerSourceSchedule, 0, types.ScheduleOverlapPolicyInvalid)
 		fired++
 	}
 	unfired := int64(len(result.toFire) - fired)
@@ -613,7 +839,7 @@ func processBackfills(ctx workflow.Context, logger *zap.Logger, sched cron.Sched
 				)
 				return true
 			}
-			processScheduleFire(ctx, logger, input, state, t, TriggerSourceBackfill)
+			processScheduleFire(ctx, logger, input, state, t, TriggerSourceBackfill, 0, bf.OverlapPolicy)
 			fired++
 		}
 
@@ -644,20 +870,103 @@ func processBackfills(ctx workflow.Context, logger *zap.Logger, sched cron.Sched
 // configuration and runtime state for the describe query handler.
 func buildScheduleDescription(input *SchedulerWorkflowInput, state *SchedulerWorkflowState) *ScheduleDescription {
 	return &ScheduleDescription{
-		ScheduleID:  input.ScheduleID,
-		Domain:      input.Domain,
-		Spec:        input.Spec,
-		Action:      input.Action,
-		Policies:    input.Policies,
-		Paused:      state.Paused,
-		PauseReason: state.PauseReason,
-		PausedBy:    state.PausedBy,
-		LastRunTime: state.LastRunTime,
-		NextRunTime: state.NextRunTime,
-		TotalRuns:   state.TotalRuns,
-		MissedRuns:  state.MissedRuns,
-		SkippedRuns: state.SkippedRuns,
+		ScheduleID:    input.ScheduleID,
+		Domain:        input.Domain,
+		Spec:          input.Spec,
+		Action:        input.Action,
+		Policies:      input.Policies,
+		Paused:        state.Paused,
+		PauseReason:   state.PauseReason,
+		PausedBy:      state.PausedBy,
+		LastRunTime:   state.LastRunTime,
+		NextRunTime:   state.NextRunTime,
+		TotalRuns:     state.TotalRuns,
+		MissedRuns:    state.MissedRuns,
+		SkippedRuns:   state.SkippedRuns,
+		RecentActions: recentActions(state),
+	}
+}
+
+// recentActions copies the recent actions of the state into the describe query result.
+func recentActions(state *SchedulerWorkflowState) []*types.ScheduleActionResult {
+	if len(state.RecentActions) == 0 {
+		return nil
+	}
+	actions := make([]*types.ScheduleActionResult, len(state.RecentActions))
+	for i := range state.RecentActions {
+		action := state.RecentActions[i]
+		actions[i] = &action
+	}
+	return actions
+}
+
+// upsertScheduleListEntry records the schedule as listed by ListSchedules in the
+// search attributes of the scheduler workflow, so schedules are listed from visibility
+// without querying every scheduler workflow. The entry is only upserted when it differs
+// from the recorded one. Returns the entry recorded after the call.
+func upsertScheduleListEntry(ctx workflow.Context, logger *zap.Logger, input *SchedulerWorkflowInput, state *SchedulerWorkflowState, recorded string) string {
+	entry, err := encodeScheduleListEntry(buildScheduleListEntry(input, state))
+	if err != nil {
+		logger.Warn("failed to encode schedule list entry", zap.Error(err))
+		return recorded
+	}
+	if entry == recorded {
+		return recorded
+	}
+	if err := workflow.UpsertSearchAttributes(ctx, map[string]interface{}{definition.CadenceScheduleListEntry: entry}); err != nil {
+		logger.Warn("failed to upsert schedule list entry", zap.Error(err))
+		return recorded
+	}
+	return entry
+}
+
+// buildScheduleListEntry builds the list entry of the schedule.
+func buildScheduleListEntry(input *SchedulerWorkflowInput, state *SchedulerWorkflowState) *types.ScheduleListEntry {
+	return buildScheduleDescription(input, state).ListEntry()
+}
+
+// ListEntry returns the schedule's entry in ListSchedules results. Recent actions
+// are left out as they are only reported by DescribeSchedule.
+func (d *ScheduleDescription) ListEntry() *types.ScheduleListEntry {
+	entry := &types.ScheduleListEntry{
+		ScheduleID:     d.ScheduleID,
+		WorkflowType:   d.Action.GetWorkflowType(),
+		State:          &types.ScheduleState{Paused: d.Paused},
+		CronExpression: d.Spec.CronExpression,
+		Spec:           &d.Spec,
+		Info: &types.ScheduleInfo{
+			LastRunTime: d.LastRunTime,
+			NextRunTime: d.NextRunTime,
+			TotalRuns:   d.TotalRuns,
+		},
+	}
+	if d.Paused {
+		entry.State.PauseInfo = &types.SchedulePauseInfo{
+			Reason:   d.PauseReason,
+			PausedBy: d.PausedBy,
+		}
+	}
+	return entry
+}
+
+// encodeScheduleListEntry encodes the list entry as JSON, leaving the spec out when
+// the entry would not fit in a search attribute value.
+func encodeScheduleListEntry(entry *types.ScheduleListEntry) (string, error) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return "", err
+	}
+	if len(data) > maxListEntrySize {
+		withoutSpec := *entry
+		withoutSpec.Spec = nil
+		if data, err = json.Marshal(&withoutSpec); err != nil {
+			return "", err
+		}
+	}
+	if len(data) > maxListEntrySize {
+		return "", fmt.Errorf("schedule list entry of %d bytes exceeds %d bytes", len(data), maxListEntrySize)
 	}
+	return string(data), nil
 }
 
 // safeContinueAsNew drains the delete channel before performing ContinueAsNew.
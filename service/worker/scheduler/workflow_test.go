@@ -21,6 +21,9 @@
 package scheduler
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -371,6 +374,9 @@ func TestBuildScheduleDescription(t *testing.T) {
 				TotalRuns:   42,
 				MissedRuns:  1,
 				SkippedRuns: 3,
+				RecentActions: []types.ScheduleActionResult{
+					{ScheduledTime: lastRun, ActualTime: lastRun, WorkflowID: "wf", RunID: "run", CloseStatus: types.WorkflowExecutionCloseStatusFailed.Ptr()},
+				},
 			},
 			want: &ScheduleDescription{
 				ScheduleID: "sched-1",
@@ -387,6 +393,9 @@ func TestBuildScheduleDescription(t *testing.T) {
 				TotalRuns:   42,
 				MissedRuns:  1,
 				SkippedRuns: 3,
+				RecentActions: []*types.ScheduleActionResult{
+					{ScheduledTime: lastRun, ActualTime: lastRun, WorkflowID: "wf", RunID: "run", CloseStatus: types.WorkflowExecutionCloseStatusFailed.Ptr()},
+				},
 			},
 		},
 		{
@@ -428,6 +437,173 @@ func TestBuildScheduleDescription(t *testing.T) {
 	}
 }
 
+func TestBuildScheduleListEntry(t *testing.T) {
+	lastRun := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	nextRun := time.Date(2026, 1, 15, 11, 0, 0, 0, time.UTC)
+	input := SchedulerWorkflowInput{
+		ScheduleID: "sched-1",
+		Domain:     "test-domain",
+		Spec:       types.ScheduleSpec{CronExpression: "0 * * * *"},
+		Action: types.ScheduleAction{
+			SignalWithStartWorkflow: &types.SignalWithStartWorkflowAction{
+				WorkflowType: &types.WorkflowType{Name: "my-wf"},
+			},
+		},
+	}
+
+	t.Run("running schedule", func(t *testing.T) {
+		state := SchedulerWorkflowState{
+			LastRunTime: lastRun,
+			NextRunTime: nextRun,
+			TotalRuns:   42,
+			RecentActions: []types.ScheduleActionResult{
+				{ScheduledTime: lastRun, WorkflowID: "wf", RunID: "run"},
+			},
+		}
+		assert.Equal(t, &types.ScheduleListEntry{
+			ScheduleID:     "sched-1",
+			WorkflowType:   &types.WorkflowType{Name: "my-wf"},
+			State:          &types.ScheduleState{},
+			CronExpression: "0 * * * *",
+			Spec:           &types.ScheduleSpec{CronExpression: "0 * * * *"},
+			Info: &types.ScheduleInfo{
+				LastRunTime: lastRun,
+				NextRunTime: nextRun,
+				TotalRuns:   42,
+			},
+		}, buildScheduleListEntry(&input, &state))
+	})
+
+	t.Run("paused schedule", func(t *testing.T) {
+		state := SchedulerWorkflowState{Paused: true, PauseReason: "maintenance", PausedBy: "admin"}
+		entry := buildScheduleListEntry(&input, &state)
+		assert.Equal(t, &types.ScheduleState{
+			Paused:    true,
+			PauseInfo: &types.SchedulePauseInfo{Reason: "maintenance", PausedBy: "admin"},
+		}, entry.State)
+	})
+}
+
+func TestEncodeScheduleListEntry(t *testing.T) {
+	decode := func(t *testing.T, encoded string) *types.ScheduleListEntry {
+		var entry types.ScheduleListEntry
+		require.NoError(t, json.Unmarshal([]byte(encoded), &entry))
+		return &entry
+	}
+
+	t.Run("small entry keeps the spec", func(t *testing.T) {
+		entry := &types.ScheduleListEntry{
+			ScheduleID:     "sched-1",
+			CronExpression: "0 * * * *",
+			Spec:           &types.ScheduleSpec{CronExpression: "0 * * * *"},
+		}
+		encoded, err := encodeScheduleListEntry(entry)
+		require.NoError(t, err)
+		assert.Equal(t, entry, decode(t, encoded))
+	})
+
+	t.Run("large entry leaves the spec out", func(t *testing.T) {
+		entry := &types.ScheduleListEntry{
+			ScheduleID:     "sched-1",
+			CronExpression: "0 * * * *",
+			Spec: &types.ScheduleSpec{
+				CronExpression:            "0 * * * *",
+				AdditionalCronExpressions: []string{strings.Repeat("0", maxListEntrySize)},
+			},
+		}
+		encoded, err := encodeScheduleListEntry(entry)
+		require.NoError(t, err)
+		assert.Equal(t, &types.ScheduleListEntry{ScheduleID: "sched-1", CronExpression: "0 * * * *"}, decode(t, encoded))
+	})
+
+	t.Run("entry too large without the spec", func(t *testing.T) {
+		entry := &types.ScheduleListEntry{
+			ScheduleID: "sched-1",
+			State: &types.ScheduleState{
+				Paused:    true,
+				PauseInfo: &types.SchedulePauseInfo{Reason: strings.Repeat("r", maxListEntrySize)},
+			},
+		}
+		_, err := encodeScheduleListEntry(entry)
+		assert.Error(t, err)
+	})
+}
+
+func TestRecentActions(t *testing.T) {
+	base := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	action := func(i int) types.ScheduleActionResult {
+		return types.ScheduleActionResult{
+			ScheduledTime: base.Add(time.Duration(i) * time.Minute),
+			WorkflowID:    fmt.Sprintf("wf-%d", i),
+			RunID:         fmt.Sprintf("run-%d", i),
+		}
+	}
+
+	t.Run("bounded to the most recent actions", func(t *testing.T) {
+		state := &SchedulerWorkflowState{}
+		for i := 0; i < maxRecentActions+3; i++ {
+			recordRecentAction(state, action(i))
+		}
+		require.Len(t, state.RecentActions, maxRecentActions)
+		assert.Equal(t, "run-3", state.RecentActions[0].RunID)
+		assert.Equal(t, fmt.Sprintf("run-%d", maxRecentActions+2), state.RecentActions[maxRecentActions-1].RunID)
+	})
+
+	t.Run("close statuses are applied to pending runs only", func(t *testing.T) {
+		completed := action(0)
+		completed.CloseStatus = types.WorkflowExecutionCloseStatusCompleted.Ptr()
+		state := &SchedulerWorkflowState{RecentActions: []types.ScheduleActionResult{completed, action(1), action(2)}}
+
+		assert.Equal(t, []RunningWorkflowInfo{
+			{WorkflowID: "wf-1", RunID: "run-1"},
+			{WorkflowID: "wf-2", RunID: "run-2"},
+		}, pendingRuns(state))
+
+		applyClosedRuns(state, map[string]types.WorkflowExecutionCloseStatus{
+			"run-0": types.WorkflowExecutionCloseStatusFailed,
+			"run-1": types.WorkflowExecutionCloseStatusFailed,
+		})
+		assert.Equal(t, types.WorkflowExecutionCloseStatusCompleted.Ptr(), state.RecentActions[0].CloseStatus)
+		assert.Equal(t, types.WorkflowExecutionCloseStatusFailed.Ptr(), state.RecentActions[1].CloseStatus)
+		assert.Nil(t, state.RecentActions[2].CloseStatus)
+		assert.Equal(t, []RunningWorkflowInfo{{WorkflowID: "wf-2", RunID: "run-2"}}, pendingRuns(state))
+	})
+
+	t.Run("survives ContinueAsNew serialization", func(t *testing.T) {
+		state := SchedulerWorkflowState{}
+		recordRecentAction(&state, action(0))
+		state.RecentActions[0].CloseStatus = types.WorkflowExecutionCloseStatusTimedOut.Ptr()
+
+		data, err := json.Marshal(SchedulerWorkflowInput{State: state})
+		require.NoError(t, err)
+		var decoded SchedulerWorkflowInput
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, state.RecentActions, decoded.State.RecentActions)
+	})
+}
+
+func TestBackOffRunStatusCheck(t *testing.T) {
+	state := &SchedulerWorkflowState{}
+	var got []time.Duration
+	for i := 0; i < 8; i++ {
+		backOffRunStatusCheck(state)
+		got = append(got, state.RunStatusCheckBackoff)
+	}
+	assert.Equal(t, []time.Duration{
+		2 * time.Minute, 4 * time.Minute, 8 * time.Minute, 16 * time.Minute,
+		32 * time.Minute, time.Hour, time.Hour, time.Hour,
+	}, got)
+
+	data, err := json.Marshal(SchedulerWorkflowInput{State: *state})
+	require.NoError(t, err)
+	var decoded SchedulerWorkflowInput
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, time.Hour, decoded.State.RunStatusCheckBackoff, "backoff survives ContinueAsNew")
+
+	recordRecentAction(state, types.ScheduleActionResult{WorkflowID: "wf", RunID: "run"})
+	assert.Zero(t, state.RunStatusCheckBackoff, "starting a workflow resets the backoff")
+}
+
 func TestHandlePause(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -612,7 +788,7 @@ func TestHandleUpdate(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			input := original
 			state := &SchedulerWorkflowState{}
-			changed := handleUpdate(testLogger, tt.sig, &input, state)
+			changed := handleUpdate(testLogger, parseScheduleSpec, tt.sig, &input, state)
 			assert.Equal(t, tt.wantChanged, changed)
 			assert.Equal(t, tt.wantCron, input.Spec.CronExpression)
 			assert.Equal(t, tt.wantWF, input.Action.StartWorkflow.WorkflowType.Name)
@@ -628,7 +804,7 @@ func TestHandleUpdate(t *testing.T) {
 				{StartTime: time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC), EndTime: time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC)},
 			},
 		}
-		changed := handleUpdate(testLogger, UpdateSignal{
+		changed := handleUpdate(testLogger, parseScheduleSpec, UpdateSignal{
 			Spec: &types.ScheduleSpec{CronExpression: "*/5 * * * *"},
 		}, &input, state)
 		assert.True(t, changed)
@@ -643,13 +819,27 @@ func TestHandleUpdate(t *testing.T) {
 				{StartTime: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), EndTime: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)},
 			},
 		}
-		changed := handleUpdate(testLogger, UpdateSignal{
+		changed := handleUpdate(testLogger, parseScheduleSpec, UpdateSignal{
 			Action: &types.ScheduleAction{StartWorkflow: &types.StartWorkflowAction{WorkflowType: &types.WorkflowType{Name: "new-workflow"}}},
 		}, &input, state)
 		assert.True(t, changed)
 		assert.Len(t, state.PendingBackfills, 1)
 	})
 
+	t.Run("interval spec is rejected by the cron-only parser", func(t *testing.T) {
+		input := original
+		state := &SchedulerWorkflowState{}
+		sig := UpdateSignal{
+			Spec: &types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Every: time.Hour}}},
+		}
+		assert.False(t, handleUpdate(testLogger, parseCronOnlySpec, sig, &input, state))
+		assert.Equal(t, "0 * * * *", input.Spec.CronExpression)
+
+		assert.True(t, handleUpdate(testLogger, parseScheduleSpec, sig, &input, state))
+		assert.Empty(t, input.Spec.CronExpression)
+		assert.Len(t, input.Spec.Intervals, 1)
+	})
+
 	t.Run("invalid cron does not clear pending backfills", func(t *testing.T) {
 		input := original
 		state := &SchedulerWorkflowState{
@@ -657,7 +847,7 @@ func TestHandleUpdate(t *testing.T) {
 				{StartTime: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), EndTime: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)},
 			},
 		}
-		changed := handleUpdate(testLogger, UpdateSignal{
+		changed := handleUpdate(testLogger, parseScheduleSpec, UpdateSignal{
 			Spec: &types.ScheduleSpec{CronExpression: "not-a-cron"},
 		}, &input, state)
 		assert.False(t, changed)
@@ -753,6 +943,92 @@ func TestHandleBackfill(t *testing.T) {
 	}
 }
 
+func TestHandleTrigger(t *testing.T) {
+	state := &SchedulerWorkflowState{Paused: true}
+	handleTrigger(testLogger, TriggerSignal{}, state)
+	handleTrigger(testLogger, TriggerSignal{OverlapPolicy: types.ScheduleOverlapPolicyConcurrent}, state)
+	assert.Equal(t, []TriggerSignal{{}, {OverlapPolicy: types.ScheduleOverlapPolicyConcurrent}}, state.PendingTriggers)
+	assert.True(t, state.Paused, "triggers do not change the pause state")
+
+	// pending triggers are fired within the iteration and never carried over ContinueAsNew
+	data, err := json.Marshal(SchedulerWorkflowInput{State: *state})
+	require.NoError(t, err)
+	var decoded SchedulerWorkflowInput
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Empty(t, decoded.State.PendingTriggers)
+}
+
+func TestTriggerIDsSurviveContinueAsNew(t *testing.T) {
+	input := &SchedulerWorkflowInput{Policies: types.SchedulePolicies{BufferLimit: 2}}
+	state := &SchedulerWorkflowState{TriggerCount: 2}
+	now := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	bufferFire(testLogger, input, state, now, TriggerSourceTrigger, 1)
+	bufferFire(testLogger, input, state, now, TriggerSourceTrigger, 2)
+
+	data, err := json.Marshal(SchedulerWorkflowInput{State: *state})
+	require.NoError(t, err)
+	var decoded SchedulerWorkflowInput
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, int64(2), decoded.State.TriggerCount, "trigger IDs keep increasing after ContinueAsNew")
+	assert.Equal(t, []BufferedFire{
+		{ScheduledTime: now, TriggerSource: TriggerSourceTrigger, TriggerID: 1},
+		{ScheduledTime: now, TriggerSource: TriggerSourceTrigger, TriggerID: 2},
+	}, decoded.State.BufferedFires, "triggers sharing a scheduled time stay distinct when buffered")
+}
+
+func TestBufferFire(t *testing.T) {
+	base := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		bufferLimit int32
+		fires       int
+		wantBuffer  int
+		wantSkipped int64
+	}{
+		{
+			name:        "default limit buffers a single fire",
+			fires:       3,
+			wantBuffer:  defaultBufferLimit,
+			wantSkipped: 3 - defaultBufferLimit,
+		},
+		{
+			name:        "configured limit",
+			bufferLimit: 2,
+			fires:       3,
+			wantBuffer:  2,
+			wantSkipped: 1,
+		},
+		{
+			name:        "below the limit nothing is skipped",
+			bufferLimit: 5,
+			fires:       3,
+			wantBuffer:  3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &SchedulerWorkflowInput{Policies: types.SchedulePolicies{BufferLimit: tt.bufferLimit}}
+			state := &SchedulerWorkflowState{}
+			for i := 0; i < tt.fires; i++ {
+				bufferFire(testLogger, input, state, base.Add(time.Duration(i)*time.Hour), TriggerSourceSchedule, 0)
+			}
+			require.Len(t, state.BufferedFires, tt.wantBuffer)
+			assert.Equal(t, tt.wantSkipped, state.SkippedRuns)
+			for i, fire := range state.BufferedFires {
+				assert.Equal(t, base.Add(time.Duration(i)*time.Hour), fire.ScheduledTime, "buffered fires are kept oldest first")
+			}
+
+			data, err := json.Marshal(SchedulerWorkflowInput{State: *state})
+			require.NoError(t, err)
+			var decoded SchedulerWorkflowInput
+			require.NoError(t, json.Unmarshal(data, &decoded))
+			assert.Equal(t, state.BufferedFires, decoded.State.BufferedFires, "buffered fires survive ContinueAsNew")
+		})
+	}
+}
+
 func TestProcessBackfillsRespectsPause(t *testing.T) {
 	sched := mustParseCron(t, "0 * * * *")
 	input := &SchedulerWorkflowInput{
@@ -816,3 +1092,8 @@ func TestBackfillFireComputation(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkflowID(t *testing.T) {
+	assert.Equal(t, "cadence-scheduler:my-schedule", WorkflowID("my-schedule"))
+	assert.Equal(t, "cadence-scheduler:", WorkflowID(""))
+}
@@ -333,12 +333,13 @@ func (s *Service) startESAnalyzer() {
 
 func (s *Service) startBatcher() {
 	params := &batcher.BootstrapParams{
-		Config:        *s.config.BatcherCfg,
-		ServiceClient: s.params.PublicClient,
-		MetricsClient: s.GetMetricsClient(),
-		Logger:        s.GetLogger(),
-		TallyScope:    s.params.MetricScope,
-		ClientBean:    s.GetClientBean(),
+		Config:          *s.config.BatcherCfg,
+		ServiceClient:   s.params.PublicClient,
+		MetricsClient:   s.GetMetricsClient(),
+		Logger:          s.GetLogger(),
+		TallyScope:      s.params.MetricScope,
+		ClientBean:      s.GetClientBean(),
+		BlobstoreClient: s.GetBlobstoreClient(),
 	}
 	if err := batcher.New(params).Start(); err != nil {
 		s.GetLogger().Fatal("error starting batcher", tag.Error(err))
@@ -496,6 +497,8 @@ func (s *Service) startAsyncWorkflowConsumerManager() common.Daemon {
 		s.Resource.GetAsyncWorkflowQueueProvider(),
 		s.GetFrontendClient(),
 		asyncworkflow.WithEnabledPropertyFn(s.config.EnableAsyncWorkflowConsumption),
+		asyncworkflow.WithQueueManagerProvider(s.GetPersistenceBean()),
+		asyncworkflow.WithMembershipResolver(s.GetMembershipResolver()),
 	)
 	cm.Start()
 	return cm
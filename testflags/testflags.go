@@ -35,6 +35,7 @@ import (
 
 var (
 	cassandra = "CASSANDRA"
+	dynamodb  = "DYNAMODB"
 	mongodb   = "MONGODB"
 	mysql     = "MYSQL"
 	postgres  = "POSTGRES"
@@ -59,6 +60,10 @@ func RequireMongoDB(t *testing.T) {
 	require(t, mongodb)
 }
 
+func RequireDynamoDB(t *testing.T) {
+	require(t, dynamodb)
+}
+
 func RequireCassandra(t *testing.T) {
 	require(t, cassandra)
 }
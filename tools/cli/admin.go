@@ -315,6 +315,68 @@ func newAdminShardManagementCommands() []*cli.Command {
 			),
 			Action: AdminTimers,
 		},
+		{
+			Name:        "queue",
+			Aliases:     []string{"q"},
+			Usage:       "Inspect virtual queues of the history task queues in a shard",
+			Subcommands: newAdminShardQueueCommands(),
+		},
+	}
+}
+
+func newAdminShardQueueCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List virtual queues and their slices with ranges, predicates and pending task counts",
+			Flags:   append(getQueueCommandFlags(), getFormatFlag()),
+			Action:  AdminListShardVirtualQueues,
+		},
+		{
+			Name:    "move-domains",
+			Aliases: []string{"md"},
+			Usage:   "Move the tasks of the given domains from a virtual queue to a new or existing virtual queue",
+			Flags: append(getVirtualQueueCommandFlags(),
+				&cli.Int64Flag{
+					Name:  FlagTargetVirtualQueueID,
+					Usage: "ID of the virtual queue the domains are moved to, created if it does not exist",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagDomainIDs,
+					Usage: "IDs of the domains to move, eg id1,id2..,idn",
+				},
+			),
+			Action: AdminMoveDomainsToVirtualQueue,
+		},
+		{
+			Name:  "pause",
+			Usage: "Pause task processing of a virtual queue for the given duration",
+			Flags: append(getVirtualQueueCommandFlags(),
+				&cli.DurationFlag{
+					Name:  FlagPauseDuration,
+					Usage: "How long the virtual queue is paused for, e.g. 10m",
+				},
+			),
+			Action: AdminPauseVirtualQueue,
+		},
+		{
+			Name:   "resume",
+			Usage:  "Resume task processing of a paused virtual queue",
+			Flags:  getVirtualQueueCommandFlags(),
+			Action: AdminResumeVirtualQueue,
+		},
+		{
+			Name:  "merge",
+			Usage: "Merge the slices of a virtual queue into the target virtual queue",
+			Flags: append(getVirtualQueueCommandFlags(),
+				&cli.Int64Flag{
+					Name:  FlagTargetVirtualQueueID,
+					Usage: "ID of the virtual queue the slices are merged into",
+				},
+			),
+			Action: AdminMergeVirtualQueue,
+		},
 	}
 }
 
@@ -1052,6 +1114,15 @@ func getQueueCommandFlags() []cli.Flag {
 	}
 }
 
+func getVirtualQueueCommandFlags() []cli.Flag {
+	return append(getQueueCommandFlags(),
+		&cli.Int64Flag{
+			Name:  FlagVirtualQueueID,
+			Usage: "ID of the virtual queue to update",
+		},
+	)
+}
+
 func newAdminFailoverCommands() []*cli.Command {
 	return []*cli.Command{
 		{
@@ -1436,3 +1507,114 @@ func newAdminIsolationGroupCommands() []*cli.Command {
 		},
 	}
 }
+
+func newAdminShardDistributorCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:    "namespace",
+			Aliases: []string{"ns"},
+			Usage:   "Inspect shard distributor namespaces",
+			Subcommands: []*cli.Command{
+				{
+					Name:    "list",
+					Aliases: []string{"l"},
+					Usage:   "List namespaces with their type, mode and number of shards",
+					Flags:   []cli.Flag{getShardDistributorAddressFlag(), getFormatFlag()},
+					Action:  AdminShardDistributorListNamespaces,
+				},
+			},
+		},
+		{
+			Name:    "executor",
+			Aliases: []string{"ex"},
+			Usage:   "Inspect executors of a shard distributor namespace",
+			Subcommands: []*cli.Command{
+				{
+					Name:    "list",
+					Aliases: []string{"l"},
+					Usage:   "List executors with their status, last heartbeat and shard counts",
+					Flags: []cli.Flag{
+						getShardDistributorAddressFlag(),
+						getShardDistributorNamespaceFlag(),
+						getFormatFlag(),
+					},
+					Action: AdminShardDistributorListExecutors,
+				},
+			},
+		},
+		{
+			Name:    "shard",
+			Aliases: []string{"s"},
+			Usage:   "Inspect or move shards of a shard distributor namespace",
+			Subcommands: []*cli.Command{
+				{
+					Name:    "describe",
+					Aliases: []string{"d"},
+					Usage:   "Show the executor a shard is assigned to and its load statistics",
+					Flags: []cli.Flag{
+						getShardDistributorAddressFlag(),
+						getShardDistributorNamespaceFlag(),
+						&cli.StringFlag{
+							Name:     FlagShardKey,
+							Aliases:  []string{"sk"},
+							Usage:    "Key of the shard",
+							Required: true,
+						},
+						getFormatFlag(),
+					},
+					Action: AdminShardDistributorDescribeShard,
+				},
+				{
+					Name:    "move",
+					Aliases: []string{"m"},
+					Usage:   "Force a shard onto an active executor. The leader may move it again on a later rebalance",
+					Flags: []cli.Flag{
+						getShardDistributorAddressFlag(),
+						getShardDistributorNamespaceFlag(),
+						&cli.StringFlag{
+							Name:     FlagShardKey,
+							Aliases:  []string{"sk"},
+							Usage:    "Key of the shard",
+							Required: true,
+						},
+						&cli.StringFlag{
+							Name:     FlagExecutorID,
+							Aliases:  []string{"eid"},
+							Usage:    "ID of the executor to move the shard to",
+							Required: true,
+						},
+					},
+					Action: AdminShardDistributorMoveShard,
+				},
+			},
+		},
+		{
+			Name:    "rebalance",
+			Aliases: []string{"rb"},
+			Usage:   "Rebalance the shards of a namespace without waiting for the next rebalance period",
+			Flags: []cli.Flag{
+				getShardDistributorAddressFlag(),
+				getShardDistributorNamespaceFlag(),
+			},
+			Action: AdminShardDistributorTriggerRebalance,
+		},
+	}
+}
+
+func getShardDistributorAddressFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:    FlagShardDistributorAddress,
+		Aliases: []string{"sda"},
+		Value:   defaultShardDistributorAddress,
+		Usage:   "host:port of the shard distributor gRPC endpoint",
+	}
+}
+
+func getShardDistributorNamespaceFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:     FlagNamespace,
+		Aliases:  []string{"ns"},
+		Usage:    "Shard distributor namespace",
+		Required: true,
+	}
+}
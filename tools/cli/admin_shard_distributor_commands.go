@@ -0,0 +1,239 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+type (
+	// ShardDistributorNamespaceRow is a namespace of the shard distributor
+	ShardDistributorNamespaceRow struct {
+		Name     string `header:"Name"`
+		Type     string `header:"Type"`
+		Mode     string `header:"Mode"`
+		ShardNum int64  `header:"Shard Num"`
+	}
+
+	// ShardDistributorExecutorRow is an executor of a shard distributor namespace
+	ShardDistributorExecutorRow struct {
+		ExecutorID     string            `header:"Executor ID"`
+		Status         string            `header:"Status"`
+		LastHeartbeat  time.Time         `header:"Last Heartbeat"`
+		AssignedShards int64             `header:"Assigned Shards"`
+		ReportedShards int64             `header:"Reported Shards"`
+		Metadata       map[string]string `header:"Metadata"`
+	}
+
+	// ShardDistributorShardRow is the assignment and load of a shard
+	ShardDistributorShardRow struct {
+		ShardKey       string            `header:"Shard Key"`
+		Owner          string            `header:"Owner"`
+		SmoothedLoad   float64           `header:"Smoothed Load"`
+		LastUpdateTime time.Time         `header:"Last Load Update"`
+		LastMoveTime   time.Time         `header:"Last Move"`
+		Metadata       map[string]string `header:"Metadata"`
+	}
+)
+
+// AdminShardDistributorListNamespaces lists the namespaces served by the shard distributor
+func AdminShardDistributorListNamespaces(c *cli.Context) error {
+	sdClient, err := getDeps(c).ServerShardDistributorClient(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	resp, err := sdClient.ListNamespaces(ctx, &types.ListNamespacesRequest{})
+	if err != nil {
+		return commoncli.Problem("Failed to list namespaces", err)
+	}
+
+	table := make([]ShardDistributorNamespaceRow, 0, len(resp.GetNamespaces()))
+	for _, namespace := range resp.GetNamespaces() {
+		table = append(table, ShardDistributorNamespaceRow{
+			Name:     namespace.GetName(),
+			Type:     namespace.GetType(),
+			Mode:     namespace.GetMode(),
+			ShardNum: namespace.GetShardNum(),
+		})
+	}
+	return Render(c, table, RenderOptions{DefaultTemplate: templateTable, Color: true})
+}
+
+// AdminShardDistributorListExecutors lists the executors of a namespace with their status and shard counts
+func AdminShardDistributorListExecutors(c *cli.Context) error {
+	sdClient, err := getDeps(c).ServerShardDistributorClient(c)
+	if err != nil {
+		return err
+	}
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	resp, err := sdClient.ListExecutors(ctx, &types.ListExecutorsRequest{Namespace: namespace})
+	if err != nil {
+		return commoncli.Problem("Failed to list executors", err)
+	}
+
+	table := make([]ShardDistributorExecutorRow, 0, len(resp.GetExecutors()))
+	for _, executor := range resp.GetExecutors() {
+		table = append(table, ShardDistributorExecutorRow{
+			ExecutorID:     executor.GetExecutorID(),
+			Status:         executor.GetStatus().String(),
+			LastHeartbeat:  time.Unix(0, executor.GetLastHeartbeat()),
+			AssignedShards: executor.GetAssignedShards(),
+			ReportedShards: executor.GetReportedShards(),
+			Metadata:       executor.GetMetadata(),
+		})
+	}
+	return Render(c, table, RenderOptions{DefaultTemplate: templateTable, Color: true, PrintDateTime: true})
+}
+
+// AdminShardDistributorDescribeShard shows the executor a shard is assigned to and its load statistics
+func AdminShardDistributorDescribeShard(c *cli.Context) error {
+	sdClient, err := getDeps(c).ServerShardDistributorClient(c)
+	if err != nil {
+		return err
+	}
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	shardKey, err := getRequiredOption(c, FlagShardKey)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	resp, err := sdClient.DescribeShard(ctx, &types.DescribeShardRequest{Namespace: namespace, ShardKey: shardKey})
+	if err != nil {
+		return commoncli.Problem("Failed to describe shard", err)
+	}
+
+	row := ShardDistributorShardRow{
+		ShardKey: shardKey,
+		Owner:    resp.GetOwner(),
+		Metadata: resp.GetMetadata(),
+	}
+	if stats := resp.GetStatistics(); stats != nil {
+		row.SmoothedLoad = stats.GetSmoothedLoad()
+		if stats.LastUpdateTime != nil {
+			row.LastUpdateTime = time.Unix(0, stats.GetLastUpdateTime())
+		}
+		if stats.LastMoveTime != nil {
+			row.LastMoveTime = time.Unix(0, stats.GetLastMoveTime())
+		}
+	}
+	return Render(c, []ShardDistributorShardRow{row}, RenderOptions{DefaultTemplate: templateTable, Color: true, PrintDateTime: true})
+}
+
+// AdminShardDistributorTriggerRebalance asks the leader to rebalance the shards of a namespace immediately
+func AdminShardDistributorTriggerRebalance(c *cli.Context) error {
+	sdClient, err := getDeps(c).ServerShardDistributorClient(c)
+	if err != nil {
+		return err
+	}
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	if _, err := sdClient.TriggerRebalance(ctx, &types.TriggerRebalanceRequest{Namespace: namespace}); err != nil {
+		return commoncli.Problem("Failed to trigger rebalance", err)
+	}
+
+	fmt.Fprintf(getDeps(c).Output(), "Triggered rebalance of namespace %s\n", namespace)
+	return nil
+}
+
+// AdminShardDistributorMoveShard forces a shard onto the given executor
+func AdminShardDistributorMoveShard(c *cli.Context) error {
+	sdClient, err := getDeps(c).ServerShardDistributorClient(c)
+	if err != nil {
+		return err
+	}
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	shardKey, err := getRequiredOption(c, FlagShardKey)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	executorID, err := getRequiredOption(c, FlagExecutorID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	_, err = sdClient.MoveShard(ctx, &types.MoveShardRequest{
+		Namespace:  namespace,
+		ShardKey:   shardKey,
+		ExecutorID: executorID,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to move shard", err)
+	}
+
+	fmt.Fprintf(getDeps(c).Output(), "Moved shard %s of namespace %s to executor %s\n", shardKey, namespace, executorID)
+	return nil
+}
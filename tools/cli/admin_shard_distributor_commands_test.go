@@ -0,0 +1,340 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/cli/clitest"
+)
+
+const (
+	testSDNamespace = "test-namespace"
+	testSDShardKey  = "shard-1"
+	testSDExecutor  = "executor-1"
+)
+
+func TestAdminShardDistributorListNamespaces(t *testing.T) {
+	tests := []struct {
+		name           string
+		testSetup      func(td *cliTestData) *cli.Context
+		errContains    string // empty if no error is expected
+		expectedOutput string
+	}{
+		{
+			name: "success",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockShardDistributorClient.EXPECT().ListNamespaces(gomock.Any(), &types.ListNamespacesRequest{}).
+					Return(&types.ListNamespacesResponse{
+						Namespaces: []*types.NamespaceInfo{
+							{Name: "fixed", Type: "fixed", Mode: "onboarded", ShardNum: 32},
+							{Name: "ephemeral", Type: "ephemeral"},
+						},
+					}, nil)
+				return clitest.NewCLIContext(t, td.app,
+					clitest.StringArgument(FlagFormat, "{{.Name}} {{.Type}} {{.Mode}} {{.ShardNum}}"),
+				)
+			},
+			expectedOutput: "fixed fixed onboarded 32\nephemeral ephemeral  0\n",
+		},
+		{
+			name: "ListNamespaces returns an error",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockShardDistributorClient.EXPECT().ListNamespaces(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("critical error"))
+				return clitest.NewCLIContext(t, td.app)
+			},
+			errContains: "Failed to list namespaces",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			cliCtx := tt.testSetup(td)
+
+			err := AdminShardDistributorListNamespaces(cliCtx)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+			assert.Equal(t, tt.expectedOutput, td.consoleOutput())
+		})
+	}
+}
+
+func TestAdminShardDistributorListExecutors(t *testing.T) {
+	heartbeat := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		testSetup      func(td *cliTestData) *cli.Context
+		errContains    string // empty if no error is expected
+		expectedOutput string
+	}{
+		{
+			name: "missing namespace argument",
+			testSetup: func(td *cliTestData) *cli.Context {
+				return clitest.NewCLIContext(t, td.app)
+			},
+			errContains: "Required flag not found",
+		},
+		{
+			name: "success",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockShardDistributorClient.EXPECT().ListExecutors(gomock.Any(), &types.ListExecutorsRequest{Namespace: testSDNamespace}).
+					Return(&types.ListExecutorsResponse{
+						Executors: []*types.ExecutorSummary{
+							{
+								ExecutorID:     testSDExecutor,
+								Status:         types.ExecutorStatusACTIVE,
+								LastHeartbeat:  common.Int64Ptr(heartbeat.UnixNano()),
+								AssignedShards: 3,
+								ReportedShards: 2,
+							},
+						},
+					}, nil)
+				return clitest.NewCLIContext(t, td.app,
+					clitest.StringArgument(FlagNamespace, testSDNamespace),
+					clitest.StringArgument(FlagFormat, "{{.ExecutorID}} {{.Status}} {{.LastHeartbeat.UTC.Unix}} {{.AssignedShards}} {{.ReportedShards}}"),
+				)
+			},
+			expectedOutput: "executor-1 ACTIVE 1704164645 3 2\n",
+		},
+		{
+			name: "ListExecutors returns an error",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockShardDistributorClient.EXPECT().ListExecutors(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("critical error"))
+				return clitest.NewCLIContext(t, td.app, clitest.StringArgument(FlagNamespace, testSDNamespace))
+			},
+			errContains: "Failed to list executors",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			cliCtx := tt.testSetup(td)
+
+			err := AdminShardDistributorListExecutors(cliCtx)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+			assert.Equal(t, tt.expectedOutput, td.consoleOutput())
+		})
+	}
+}
+
+func TestAdminShardDistributorDescribeShard(t *testing.T) {
+	lastMove := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	rowFormat := "{{.ShardKey}} {{.Owner}} {{.SmoothedLoad}} {{.LastMoveTime.UTC.Unix}}"
+
+	tests := []struct {
+		name           string
+		testSetup      func(td *cliTestData) *cli.Context
+		errContains    string // empty if no error is expected
+		expectedOutput string
+	}{
+		{
+			name: "missing shard key argument",
+			testSetup: func(td *cliTestData) *cli.Context {
+				return clitest.NewCLIContext(t, td.app, clitest.StringArgument(FlagNamespace, testSDNamespace))
+			},
+			errContains: "Required flag not found",
+		},
+		{
+			name: "with statistics",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockShardDistributorClient.EXPECT().DescribeShard(gomock.Any(), &types.DescribeShardRequest{
+					Namespace: testSDNamespace,
+					ShardKey:  testSDShardKey,
+				}).Return(&types.DescribeShardResponse{
+					Owner: testSDExecutor,
+					Statistics: &types.ShardStatistics{
+						SmoothedLoad: 1.5,
+						LastMoveTime: common.Int64Ptr(lastMove.UnixNano()),
+					},
+				}, nil)
+				return clitest.NewCLIContext(t, td.app,
+					clitest.StringArgument(FlagNamespace, testSDNamespace),
+					clitest.StringArgument(FlagShardKey, testSDShardKey),
+					clitest.StringArgument(FlagFormat, rowFormat),
+				)
+			},
+			expectedOutput: "shard-1 executor-1 1.5 1704164645\n",
+		},
+		{
+			name: "DescribeShard returns an error",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockShardDistributorClient.EXPECT().DescribeShard(gomock.Any(), gomock.Any()).
+					Return(nil, &types.ShardNotFoundError{Namespace: testSDNamespace, ShardKey: testSDShardKey})
+				return clitest.NewCLIContext(t, td.app,
+					clitest.StringArgument(FlagNamespace, testSDNamespace),
+					clitest.StringArgument(FlagShardKey, testSDShardKey),
+				)
+			},
+			errContains: "Failed to describe shard",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			cliCtx := tt.testSetup(td)
+
+			err := AdminShardDistributorDescribeShard(cliCtx)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+			assert.Equal(t, tt.expectedOutput, td.consoleOutput())
+		})
+	}
+}
+
+func TestAdminShardDistributorTriggerRebalance(t *testing.T) {
+	tests := []struct {
+		name           string
+		testSetup      func(td *cliTestData) *cli.Context
+		errContains    string // empty if no error is expected
+		expectedOutput string
+	}{
+		{
+			name: "missing namespace argument",
+			testSetup: func(td *cliTestData) *cli.Context {
+				return clitest.NewCLIContext(t, td.app)
+			},
+			errContains: "Required flag not found",
+		},
+		{
+			name: "success",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockShardDistributorClient.EXPECT().TriggerRebalance(gomock.Any(), &types.TriggerRebalanceRequest{Namespace: testSDNamespace}).
+					Return(&types.TriggerRebalanceResponse{}, nil)
+				return clitest.NewCLIContext(t, td.app, clitest.StringArgument(FlagNamespace, testSDNamespace))
+			},
+			expectedOutput: "Triggered rebalance of namespace test-namespace\n",
+		},
+		{
+			name: "TriggerRebalance returns an error",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockShardDistributorClient.EXPECT().TriggerRebalance(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("critical error"))
+				return clitest.NewCLIContext(t, td.app, clitest.StringArgument(FlagNamespace, testSDNamespace))
+			},
+			errContains: "Failed to trigger rebalance",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			cliCtx := tt.testSetup(td)
+
+			err := AdminShardDistributorTriggerRebalance(cliCtx)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+			assert.Equal(t, tt.expectedOutput, td.consoleOutput())
+		})
+	}
+}
+
+func TestAdminShardDistributorMoveShard(t *testing.T) {
+	newMoveContext := func(td *cliTestData) *cli.Context {
+		return clitest.NewCLIContext(t, td.app,
+			clitest.StringArgument(FlagNamespace, testSDNamespace),
+			clitest.StringArgument(FlagShardKey, testSDShardKey),
+			clitest.StringArgument(FlagExecutorID, testSDExecutor),
+		)
+	}
+
+	tests := []struct {
+		name           string
+		testSetup      func(td *cliTestData) *cli.Context
+		errContains    string // empty if no error is expected
+		expectedOutput string
+	}{
+		{
+			name: "missing executor argument",
+			testSetup: func(td *cliTestData) *cli.Context {
+				return clitest.NewCLIContext(t, td.app,
+					clitest.StringArgument(FlagNamespace, testSDNamespace),
+					clitest.StringArgument(FlagShardKey, testSDShardKey),
+				)
+			},
+			errContains: "Required flag not found",
+		},
+		{
+			name: "success",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockShardDistributorClient.EXPECT().MoveShard(gomock.Any(), &types.MoveShardRequest{
+					Namespace:  testSDNamespace,
+					ShardKey:   testSDShardKey,
+					ExecutorID: testSDExecutor,
+				}).Return(&types.MoveShardResponse{}, nil)
+				return newMoveContext(td)
+			},
+			expectedOutput: "Moved shard shard-1 of namespace test-namespace to executor executor-1\n",
+		},
+		{
+			name: "MoveShard returns an error",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockShardDistributorClient.EXPECT().MoveShard(gomock.Any(), gomock.Any()).
+					Return(nil, &types.BadRequestError{Message: "executor is not active"})
+				return newMoveContext(td)
+			},
+			errContains: "Failed to move shard",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			cliCtx := tt.testSetup(td)
+
+			err := AdminShardDistributorMoveShard(cliCtx)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+			assert.Equal(t, tt.expectedOutput, td.consoleOutput())
+		})
+	}
+}
@@ -0,0 +1,294 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+type (
+	// virtualQueueDescription is the per virtual queue entry returned by DescribeQueue
+	// for queues backed by virtual queues
+	virtualQueueDescription struct {
+		QueueID int64
+		Paused  bool
+		Slices  []*virtualSliceDescription
+	}
+
+	virtualSliceDescription struct {
+		State            *types.VirtualSliceState
+		PendingTaskCount int
+	}
+
+	// VirtualSliceRow is a row of the virtual queue list table
+	VirtualSliceRow struct {
+		QueueID          int64  `header:"Queue ID"`
+		Paused           bool   `header:"Paused"`
+		InclusiveMin     string `header:"Inclusive Min"`
+		ExclusiveMax     string `header:"Exclusive Max"`
+		Predicate        string `header:"Predicate"`
+		PendingTaskCount int    `header:"Pending Tasks"`
+	}
+)
+
+// AdminListShardVirtualQueues lists the virtual queues and slices of a history queue in a shard
+func AdminListShardVirtualQueues(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	shardID, err := getRequiredIntOption(c, FlagShardID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	clusterName, err := getRequiredOption(c, FlagCluster)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	typeID, err := getRequiredIntOption(c, FlagQueueType)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	resp, err := adminClient.DescribeQueue(ctx, &types.DescribeQueueRequest{
+		ShardID:     int32(shardID),
+		ClusterName: clusterName,
+		Type:        common.Int32Ptr(int32(typeID)),
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to describe queue", err)
+	}
+
+	table := []VirtualSliceRow{}
+	for _, state := range resp.ProcessingQueueStates {
+		var virtualQueue virtualQueueDescription
+		if err := json.Unmarshal([]byte(state), &virtualQueue); err != nil {
+			return commoncli.Problem("Queue is not backed by virtual queues", err)
+		}
+		if len(virtualQueue.Slices) == 0 {
+			table = append(table, VirtualSliceRow{QueueID: virtualQueue.QueueID, Paused: virtualQueue.Paused})
+			continue
+		}
+		for _, slice := range virtualQueue.Slices {
+			row := VirtualSliceRow{
+				QueueID:          virtualQueue.QueueID,
+				Paused:           virtualQueue.Paused,
+				PendingTaskCount: slice.PendingTaskCount,
+			}
+			if slice.State != nil {
+				if slice.State.TaskRange != nil {
+					row.InclusiveMin = formatTaskKey(slice.State.TaskRange.InclusiveMin)
+					row.ExclusiveMax = formatTaskKey(slice.State.TaskRange.ExclusiveMax)
+				}
+				row.Predicate = formatPredicate(slice.State.Predicate)
+			}
+			table = append(table, row)
+		}
+	}
+	return Render(c, table, RenderOptions{DefaultTemplate: templateTable, Color: true})
+}
+
+// AdminMoveDomainsToVirtualQueue moves the tasks of the given domains from a virtual queue
+// to a new or existing virtual queue
+func AdminMoveDomainsToVirtualQueue(c *cli.Context) error {
+	targetQueueID, err := getRequiredInt64Option(c, FlagTargetVirtualQueueID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	domainIDs := c.StringSlice(FlagDomainIDs)
+	if len(domainIDs) == 0 {
+		return commoncli.Problem("Required flag not found", fmt.Errorf("option %s is required", FlagDomainIDs))
+	}
+	return updateVirtualQueue(c, &types.UpdateVirtualQueueRequest{
+		Operation:            types.VirtualQueueOperationMoveDomains.Ptr(),
+		TargetVirtualQueueID: targetQueueID,
+		DomainIDs:            domainIDs,
+	})
+}
+
+// AdminPauseVirtualQueue pauses a virtual queue for the given duration
+func AdminPauseVirtualQueue(c *cli.Context) error {
+	pauseDuration := c.Duration(FlagPauseDuration)
+	if pauseDuration < time.Second {
+		return commoncli.Problem("Invalid flag value", fmt.Errorf("option %s must be at least 1s", FlagPauseDuration))
+	}
+	return updateVirtualQueue(c, &types.UpdateVirtualQueueRequest{
+		Operation:              types.VirtualQueueOperationPause.Ptr(),
+		PauseDurationInSeconds: int32(pauseDuration / time.Second),
+	})
+}
+
+// AdminResumeVirtualQueue resumes a paused virtual queue
+func AdminResumeVirtualQueue(c *cli.Context) error {
+	return updateVirtualQueue(c, &types.UpdateVirtualQueueRequest{
+		Operation: types.VirtualQueueOperationResume.Ptr(),
+	})
+}
+
+// AdminMergeVirtualQueue merges the slices of a virtual queue into the target virtual queue
+func AdminMergeVirtualQueue(c *cli.Context) error {
+	targetQueueID, err := getRequiredInt64Option(c, FlagTargetVirtualQueueID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	return updateVirtualQueue(c, &types.UpdateVirtualQueueRequest{
+		Operation:            types.VirtualQueueOperationMerge.Ptr(),
+		TargetVirtualQueueID: targetQueueID,
+	})
+}
+
+// updateVirtualQueue fills in the queue identifying flags of the request and sends it
+func updateVirtualQueue(c *cli.Context, request *types.UpdateVirtualQueueRequest) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	shardID, err := getRequiredIntOption(c, FlagShardID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	clusterName, err := getRequiredOption(c, FlagCluster)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	typeID, err := getRequiredIntOption(c, FlagQueueType)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	queueID, err := getRequiredInt64Option(c, FlagVirtualQueueID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	request.ShardID = int32(shardID)
+	request.ClusterName = clusterName
+	request.Type = common.Int32Ptr(int32(typeID))
+	request.VirtualQueueID = queueID
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	if err := adminClient.UpdateVirtualQueue(ctx, request); err != nil {
+		return commoncli.Problem("Failed to update virtual queue", err)
+	}
+
+	fmt.Fprintf(getDeps(c).Output(), "Update virtual queue %d succeeded\n", queueID)
+	return nil
+}
+
+func formatTaskKey(key *types.TaskKey) string {
+	if key == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d", key.ScheduledTimeNano, key.TaskID)
+}
+
+func formatPredicate(p *types.Predicate) string {
+	if p == nil {
+		return ""
+	}
+	switch p.PredicateType {
+	case types.PredicateTypeUniversal:
+		return "universal"
+	case types.PredicateTypeEmpty:
+		return "empty"
+	case types.PredicateTypeDomainID:
+		attr := p.DomainIDPredicateAttributes
+		if attr == nil {
+			break
+		}
+		return formatSetPredicate("domainID", attr.DomainIDs, attr.GetIsExclusive())
+	case types.PredicateTypeTaskType:
+		attr := p.TaskTypePredicateAttributes
+		if attr == nil {
+			break
+		}
+		taskTypes := make([]string, 0, len(attr.TaskTypes))
+		for _, taskType := range attr.TaskTypes {
+			taskTypes = append(taskTypes, fmt.Sprint(taskType))
+		}
+		return formatSetPredicate("taskType", taskTypes, attr.GetIsExclusive())
+	case types.PredicateTypeTaskList:
+		attr := p.TaskListPredicateAttributes
+		if attr == nil {
+			break
+		}
+		return formatSetPredicate("taskList", attr.TaskLists, attr.GetIsExclusive())
+	case types.PredicateTypeWorkflowID:
+		attr := p.WorkflowIDPredicateAttributes
+		if attr == nil {
+			break
+		}
+		return formatSetPredicate("workflowID", attr.WorkflowIDs, attr.GetIsExclusive())
+	case types.PredicateTypeWorkflowIDPrefix:
+		attr := p.WorkflowIDPrefixPredicateAttributes
+		if attr == nil {
+			break
+		}
+		return formatSetPredicate("workflowIDPrefix", attr.Prefixes, attr.GetIsExclusive())
+	case types.PredicateTypeAnd:
+		if p.AndPredicateAttributes != nil {
+			return formatCompositePredicate("and", p.AndPredicateAttributes.Predicates)
+		}
+	case types.PredicateTypeOr:
+		if p.OrPredicateAttributes != nil {
+			return formatCompositePredicate("or", p.OrPredicateAttributes.Predicates)
+		}
+	case types.PredicateTypeNot:
+		if p.NotPredicateAttributes != nil {
+			return fmt.Sprintf("not(%s)", formatPredicate(p.NotPredicateAttributes.Predicate))
+		}
+	}
+	return fmt.Sprintf("unknown(%d)", p.PredicateType)
+}
+
+func formatSetPredicate(name string, values []string, isExclusive bool) string {
+	operator := "in"
+	if isExclusive {
+		operator = "not in"
+	}
+	return fmt.Sprintf("%s %s [%s]", name, operator, strings.Join(values, ", "))
+}
+
+func formatCompositePredicate(name string, predicates []*types.Predicate) string {
+	formatted := make([]string, 0, len(predicates))
+	for _, p := range predicates {
+		formatted = append(formatted, formatPredicate(p))
+	}
+	return fmt.Sprintf("%s(%s)", name, strings.Join(formatted, ", "))
+}
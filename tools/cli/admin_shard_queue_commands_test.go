@@ -0,0 +1,276 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/cli/clitest"
+)
+
+func TestAdminListShardVirtualQueues(t *testing.T) {
+	rowFormat := "{{.QueueID}} {{.Paused}} {{.InclusiveMin}} {{.ExclusiveMax}} {{.Predicate}} {{.PendingTaskCount}}"
+	newQueueListContext := func(td *cliTestData) *cli.Context {
+		return clitest.NewCLIContext(
+			t,
+			td.app,
+			clitest.IntArgument(FlagShardID, testShardID),
+			clitest.StringArgument(FlagCluster, testCluster),
+			clitest.IntArgument(FlagQueueType, testQueueType),
+			clitest.StringArgument(FlagFormat, rowFormat),
+		)
+	}
+
+	tests := []struct {
+		name           string
+		testSetup      func(td *cliTestData) *cli.Context
+		errContains    string // empty if no error is expected
+		expectedOutput string
+	}{
+		{
+			name: "missing queue type argument",
+			testSetup: func(td *cliTestData) *cli.Context {
+				return clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.IntArgument(FlagShardID, testShardID),
+					clitest.StringArgument(FlagCluster, testCluster),
+				)
+			},
+			errContains: "Required flag not found",
+		},
+		{
+			name: "virtual queues with slices",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockAdminClient.EXPECT().DescribeQueue(gomock.Any(), &types.DescribeQueueRequest{
+					ShardID:     testShardID,
+					ClusterName: testCluster,
+					Type:        common.Int32Ptr(testQueueType),
+				}).Return(&types.DescribeQueueResponse{
+					ProcessingQueueStates: []string{
+						`{"QueueID":0,"Paused":false,"Slices":[{"State":{"TaskRange":{"InclusiveMin":{"TaskID":1},"ExclusiveMax":{"TaskID":10}},"Predicate":{"PredicateType":2,"DomainIDPredicateAttributes":{"DomainIDs":["domain1"],"IsExclusive":true}}},"PendingTaskCount":5}]}`,
+						`{"QueueID":1,"Paused":true,"Slices":[{"State":{"TaskRange":{"InclusiveMin":{"TaskID":1},"ExclusiveMax":{"TaskID":10}},"Predicate":{"PredicateType":7,"AndPredicateAttributes":{"Predicates":[{"PredicateType":2,"DomainIDPredicateAttributes":{"DomainIDs":["domain1"]}},{"PredicateType":9,"NotPredicateAttributes":{"Predicate":{"PredicateType":3,"TaskTypePredicateAttributes":{"TaskTypes":[1,2]}}}}]}}},"PendingTaskCount":2}]}`,
+						`{"QueueID":2,"Paused":false,"Slices":null}`,
+					},
+				}, nil)
+				return newQueueListContext(td)
+			},
+			expectedOutput: "0 false 0/1 0/10 domainID not in [domain1] 5\n" +
+				"1 true 0/1 0/10 and(domainID in [domain1], not(taskType in [1, 2])) 2\n" +
+				"2 false    0\n",
+		},
+		{
+			name: "queue is not backed by virtual queues",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockAdminClient.EXPECT().DescribeQueue(gomock.Any(), gomock.Any()).Return(&types.DescribeQueueResponse{
+					ProcessingQueueStates: []string{"state1"},
+				}, nil)
+				return newQueueListContext(td)
+			},
+			errContains: "Queue is not backed by virtual queues",
+		},
+		{
+			name: "DescribeQueue returns an error",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockAdminClient.EXPECT().DescribeQueue(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("critical error"))
+				return newQueueListContext(td)
+			},
+			errContains: "Failed to describe queue",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			cliCtx := tt.testSetup(td)
+
+			err := AdminListShardVirtualQueues(cliCtx)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+			assert.Equal(t, tt.expectedOutput, td.consoleOutput())
+		})
+	}
+}
+
+func TestAdminUpdateVirtualQueue(t *testing.T) {
+	queueArguments := func(td *cliTestData, args ...clitest.CliArgument) *cli.Context {
+		return clitest.NewCLIContext(
+			t,
+			td.app,
+			append([]clitest.CliArgument{
+				clitest.IntArgument(FlagShardID, testShardID),
+				clitest.StringArgument(FlagCluster, testCluster),
+				clitest.IntArgument(FlagQueueType, testQueueType),
+				clitest.Int64Argument(FlagVirtualQueueID, 1),
+			}, args...)...,
+		)
+	}
+
+	tests := []struct {
+		name           string
+		command        func(c *cli.Context) error
+		testSetup      func(td *cliTestData) *cli.Context
+		errContains    string // empty if no error is expected
+		expectedOutput string
+	}{
+		{
+			name:    "move domains",
+			command: AdminMoveDomainsToVirtualQueue,
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockAdminClient.EXPECT().UpdateVirtualQueue(gomock.Any(), &types.UpdateVirtualQueueRequest{
+					ShardID:              testShardID,
+					ClusterName:          testCluster,
+					Type:                 common.Int32Ptr(testQueueType),
+					Operation:            types.VirtualQueueOperationMoveDomains.Ptr(),
+					VirtualQueueID:       1,
+					TargetVirtualQueueID: 2,
+					DomainIDs:            []string{"domain1", "domain2"},
+				}).Return(nil)
+				return queueArguments(td,
+					clitest.Int64Argument(FlagTargetVirtualQueueID, 2),
+					clitest.StringSliceArgument(FlagDomainIDs, "domain1", "domain2"),
+				)
+			},
+			expectedOutput: "Update virtual queue 1 succeeded\n",
+		},
+		{
+			name:    "move domains without domain IDs",
+			command: AdminMoveDomainsToVirtualQueue,
+			testSetup: func(td *cliTestData) *cli.Context {
+				return queueArguments(td, clitest.Int64Argument(FlagTargetVirtualQueueID, 2))
+			},
+			errContains: "Required flag not found",
+		},
+		{
+			name:    "pause",
+			command: AdminPauseVirtualQueue,
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockAdminClient.EXPECT().UpdateVirtualQueue(gomock.Any(), &types.UpdateVirtualQueueRequest{
+					ShardID:                testShardID,
+					ClusterName:            testCluster,
+					Type:                   common.Int32Ptr(testQueueType),
+					Operation:              types.VirtualQueueOperationPause.Ptr(),
+					VirtualQueueID:         1,
+					PauseDurationInSeconds: 600,
+				}).Return(nil)
+				return queueArguments(td, clitest.StringArgument(FlagPauseDuration, "10m"))
+			},
+			expectedOutput: "Update virtual queue 1 succeeded\n",
+		},
+		{
+			name:    "pause without duration",
+			command: AdminPauseVirtualQueue,
+			testSetup: func(td *cliTestData) *cli.Context {
+				return queueArguments(td)
+			},
+			errContains: "Invalid flag value",
+		},
+		{
+			name:    "resume",
+			command: AdminResumeVirtualQueue,
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockAdminClient.EXPECT().UpdateVirtualQueue(gomock.Any(), &types.UpdateVirtualQueueRequest{
+					ShardID:        testShardID,
+					ClusterName:    testCluster,
+					Type:           common.Int32Ptr(testQueueType),
+					Operation:      types.VirtualQueueOperationResume.Ptr(),
+					VirtualQueueID: 1,
+				}).Return(nil)
+				return queueArguments(td)
+			},
+			expectedOutput: "Update virtual queue 1 succeeded\n",
+		},
+		{
+			name:    "merge",
+			command: AdminMergeVirtualQueue,
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockAdminClient.EXPECT().UpdateVirtualQueue(gomock.Any(), &types.UpdateVirtualQueueRequest{
+					ShardID:              testShardID,
+					ClusterName:          testCluster,
+					Type:                 common.Int32Ptr(testQueueType),
+					Operation:            types.VirtualQueueOperationMerge.Ptr(),
+					VirtualQueueID:       1,
+					TargetVirtualQueueID: 0,
+				}).Return(nil)
+				return queueArguments(td, clitest.Int64Argument(FlagTargetVirtualQueueID, 0))
+			},
+			expectedOutput: "Update virtual queue 1 succeeded\n",
+		},
+		{
+			name:    "merge without target queue",
+			command: AdminMergeVirtualQueue,
+			testSetup: func(td *cliTestData) *cli.Context {
+				return queueArguments(td)
+			},
+			errContains: "Required flag not found",
+		},
+		{
+			name:    "missing virtual queue ID argument",
+			command: AdminResumeVirtualQueue,
+			testSetup: func(td *cliTestData) *cli.Context {
+				return clitest.NewCLIContext(
+					t,
+					td.app,
+					clitest.IntArgument(FlagShardID, testShardID),
+					clitest.StringArgument(FlagCluster, testCluster),
+					clitest.IntArgument(FlagQueueType, testQueueType),
+				)
+			},
+			errContains: "Required flag not found",
+		},
+		{
+			name:    "UpdateVirtualQueue returns an error",
+			command: AdminResumeVirtualQueue,
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockAdminClient.EXPECT().UpdateVirtualQueue(gomock.Any(), gomock.Any()).
+					Return(errors.New("critical error"))
+				return queueArguments(td)
+			},
+			errContains: "Failed to update virtual queue",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			cliCtx := tt.testSetup(td)
+
+			err := tt.command(cliCtx)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+			assert.Equal(t, tt.expectedOutput, td.consoleOutput())
+		})
+	}
+}
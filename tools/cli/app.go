@@ -145,6 +145,12 @@ func NewCliApp(cf ClientFactory, opts ...CLIAppOptions) *cli.App {
 			Usage:       "Operate cadence tasklist",
 			Subcommands: newTaskListCommands(),
 		},
+		{
+			Name:        "schedule",
+			Aliases:     []string{"sch"},
+			Usage:       "Operate cadence schedule",
+			Subcommands: newScheduleCommands(),
+		},
 		{
 			Name:    "admin",
 			Aliases: []string{"adm"},
@@ -233,6 +239,12 @@ func NewCliApp(cf ClientFactory, opts ...CLIAppOptions) *cli.App {
 					Usage:       "Run admin operation on config store",
 					Subcommands: newAdminConfigStoreCommands(),
 				},
+				{
+					Name:        "shard-distributor",
+					Aliases:     []string{"sd"},
+					Usage:       "Run admin operations on shard distributor namespaces, executors and shards",
+					Subcommands: newAdminShardDistributorCommands(),
+				},
 			},
 		},
 		{
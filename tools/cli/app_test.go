@@ -39,6 +39,7 @@ import (
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/client/sharddistributor"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/types"
@@ -66,9 +67,10 @@ type (
 var _ ClientFactory = (*clientFactoryMock)(nil)
 
 type clientFactoryMock struct {
-	serverFrontendClient frontend.Client
-	serverAdminClient    admin.Client
-	config               *config.Config
+	serverFrontendClient         frontend.Client
+	serverAdminClient            admin.Client
+	serverShardDistributorClient sharddistributor.Client
+	config                       *config.Config
 }
 
 func (m *clientFactoryMock) ServerFrontendClient(c *cli.Context) (frontend.Client, error) {
@@ -87,6 +89,10 @@ func (m *clientFactoryMock) ServerAdminClientForMigration(c *cli.Context) (admin
 	panic("not implemented")
 }
 
+func (m *clientFactoryMock) ServerShardDistributorClient(c *cli.Context) (sharddistributor.Client, error) {
+	return m.serverShardDistributorClient, nil
+}
+
 func (m *clientFactoryMock) ElasticSearchClient(c *cli.Context) (*elastic.Client, error) {
 	panic("not implemented")
 }
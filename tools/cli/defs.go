@@ -26,6 +26,7 @@ import (
 
 	"github.com/fatih/color"
 
+	"github.com/uber/cadence/common/resetpoint"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -80,13 +81,13 @@ var envKeysForUserName = []string{
 	"HOME",
 }
 
-const resetTypeFirstDecisionCompleted = "FirstDecisionCompleted"
-const resetTypeLastDecisionCompleted = "LastDecisionCompleted"
-const resetTypeLastContinuedAsNew = "LastContinuedAsNew"
-const resetTypeBadBinary = "BadBinary"
-const resetTypeDecisionCompletedTime = "DecisionCompletedTime"
-const resetTypeFirstDecisionScheduled = "FirstDecisionScheduled"
-const resetTypeLastDecisionScheduled = "LastDecisionScheduled"
+const resetTypeFirstDecisionCompleted = resetpoint.TypeFirstDecisionCompleted
+const resetTypeLastDecisionCompleted = resetpoint.TypeLastDecisionCompleted
+const resetTypeLastContinuedAsNew = resetpoint.TypeLastContinuedAsNew
+const resetTypeBadBinary = resetpoint.TypeBadBinary
+const resetTypeDecisionCompletedTime = resetpoint.TypeDecisionCompletedTime
+const resetTypeFirstDecisionScheduled = resetpoint.TypeFirstDecisionScheduled
+const resetTypeLastDecisionScheduled = resetpoint.TypeLastDecisionScheduled
 
 var resetTypesMap = map[string]string{
 	resetTypeFirstDecisionCompleted: "",
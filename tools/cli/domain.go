@@ -181,5 +181,31 @@ func newDomainCommands() []*cli.Command {
 				})
 			},
 		},
+		{
+			Name:  "history",
+			Usage: "List the changes made to the configuration of a domain, field by field",
+			Flags: domainHistoryFlags,
+			Action: func(c *cli.Context) error {
+				err := checkNoAdditionalArgsPassed(c)
+				if err != nil {
+					return err
+				}
+				return withDomainClient(c, false, func(dc *domainCLIImpl) error {
+					return dc.ListDomainChanges(c)
+				})
+			},
+		},
+		{
+			Name:  "rollback",
+			Usage: "Restore the configuration of a domain to its state after a domain history event",
+			Flags: rollbackDomainFlags,
+			Action: func(c *cli.Context) error {
+				err := checkNoAdditionalArgsPassed(c)
+				if err != nil {
+					return err
+				}
+				return RollbackDomain(c)
+			},
+		},
 	}
 }
@@ -850,6 +850,47 @@ func renderFailoverHistoryTableToWriter(writer interface{ Write([]byte) (int, er
 	table.Render()
 }
 
+// renderDomainChangesTableToWriter renders a row per changed field, the change itself is only shown on its first row
+func renderDomainChangesTableToWriter(writer interface{ Write([]byte) (int, error) }, response *types.ListDomainChangesResponse) {
+	table := tablewriter.NewWriter(writer)
+	table.SetBorder(true)
+	table.SetAutoWrapText(false)
+	table.SetAutoFormatHeaders(true)
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeader([]string{"Event ID", "Timestamp", "Operation", "Actor", "Field", "Before", "After"})
+
+	valueOrDash := func(value string) string {
+		if value == "" {
+			return "-"
+		}
+		return value
+	}
+	for _, change := range response.GetDomainChanges() {
+		actor := valueOrDash(change.GetIdentity())
+		if change.IdentityType != "" {
+			actor = fmt.Sprintf("%s (%s)", actor, change.IdentityType)
+		}
+		row := []string{
+			change.GetID(),
+			time.Unix(0, change.GetCreatedTime()).Format(time.RFC3339),
+			change.GetOperationType(),
+			actor,
+		}
+		if len(change.GetFieldChanges()) == 0 {
+			table.Append(append(row, "-", "-", "-"))
+			continue
+		}
+		for i, fieldChange := range change.GetFieldChanges() {
+			if i > 0 {
+				row = []string{"", "", "", ""}
+			}
+			table.Append(append(row, fieldChange.Field, valueOrDash(fieldChange.Before), valueOrDash(fieldChange.After)))
+		}
+	}
+	table.Render()
+}
+
 func domainTableOptions(c *cli.Context) RenderOptions {
 	printAll := c.Bool(FlagAll)
 	printFull := c.Bool(FlagPrintFullyDetail)
@@ -1079,6 +1120,103 @@ func (d *domainCLIImpl) ListFailoverHistory(c *cli.Context) error {
 	return nil
 }
 
+// ListDomainChanges lists the changes made to the configuration of a domain
+func (d *domainCLIImpl) ListDomainChanges(c *cli.Context) error {
+	domainName, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+
+	limit := 10
+	if c.Bool(FlagAll) {
+		limit = -1
+	}
+
+	allResponses := &types.ListDomainChangesResponse{}
+	var nextPageToken []byte
+	for {
+		ctx, cancel, err := newContext(c)
+		if err != nil {
+			return commoncli.Problem("Error in creating context: ", err)
+		}
+		resp, err := d.frontendClient.ListDomainChanges(ctx, &types.ListDomainChangesRequest{
+			Domain: domainName,
+			Pagination: &types.PaginationOptions{
+				PageSize:      common.Int32Ptr(int32(10)),
+				NextPageToken: nextPageToken,
+			},
+		})
+		cancel()
+		if err != nil {
+			if _, ok := err.(*types.EntityNotExistsError); ok {
+				return commoncli.Problem(fmt.Sprintf("Domain %s does not exist.", domainName), err)
+			}
+			return commoncli.Problem("Failed to list domain changes.", err)
+		}
+		allResponses.DomainChanges = append(allResponses.DomainChanges, resp.DomainChanges...)
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+		if limit > 0 && len(allResponses.DomainChanges) >= limit {
+			break
+		}
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		output, err := json.Marshal(allResponses)
+		if err != nil {
+			return commoncli.Problem("Failed to encode domain changes into JSON.", err)
+		}
+		fmt.Println(string(output))
+		return nil
+	}
+
+	if len(allResponses.GetDomainChanges()) == 0 {
+		fmt.Println("No changes found for domain:", domainName)
+		return nil
+	}
+
+	renderDomainChangesTableToWriter(os.Stdout, allResponses)
+	return nil
+}
+
+// RollbackDomain restores the configuration of a domain to its state after a domain history event
+func RollbackDomain(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	domainName, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	eventID, err := getRequiredOption(c, FlagToEvent)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	_, err = adminClient.RollbackDomain(ctx, &types.RollbackDomainRequest{
+		Domain:  domainName,
+		EventID: eventID,
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return commoncli.Problem(fmt.Sprintf("Domain %s or history event %s does not exist.", domainName, eventID), err)
+		}
+		return commoncli.Problem("Operation RollbackDomain failed.", err)
+	}
+	fmt.Printf("Domain %s successfully rolled back to history event %s.\n", domainName, eventID)
+	return nil
+}
+
 func (d *domainCLIImpl) describeDomain(
 	ctx context.Context,
 	request *types.DescribeDomainRequest,
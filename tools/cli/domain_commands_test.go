@@ -396,6 +396,117 @@ func (s *cliAppSuite) TestListDomains() {
 	}
 }
 
+func (s *cliAppSuite) TestDomainHistory() {
+	domainChange := func(id string) *types.DomainChange {
+		return &types.DomainChange{
+			ID:            id,
+			CreatedTime:   1700000000000000000,
+			OperationType: "Update",
+			Identity:      "cadence-cli",
+			FieldChanges:  []*types.DomainFieldChange{{Field: "retentionDays", Before: "3", After: "7"}},
+		}
+	}
+	testCases := []testcase{
+		{
+			"first page by default",
+			"cadence --do test-domain domain history",
+			"",
+			func() {
+				s.serverFrontendClient.EXPECT().ListDomainChanges(gomock.Any(), &types.ListDomainChangesRequest{
+					Domain:     "test-domain",
+					Pagination: &types.PaginationOptions{PageSize: common.Int32Ptr(10)},
+				}).Return(&types.ListDomainChangesResponse{
+					DomainChanges: []*types.DomainChange{domainChange("event-1")},
+					NextPageToken: []byte("next"),
+				}, nil)
+			},
+		},
+		{
+			"all pages",
+			"cadence --do test-domain domain history --all --pjson",
+			"",
+			func() {
+				s.serverFrontendClient.EXPECT().ListDomainChanges(gomock.Any(), gomock.Any()).Return(&types.ListDomainChangesResponse{
+					DomainChanges: []*types.DomainChange{domainChange("event-2")},
+					NextPageToken: []byte("next"),
+				}, nil)
+				s.serverFrontendClient.EXPECT().ListDomainChanges(gomock.Any(), &types.ListDomainChangesRequest{
+					Domain:     "test-domain",
+					Pagination: &types.PaginationOptions{PageSize: common.Int32Ptr(10), NextPageToken: []byte("next")},
+				}).Return(&types.ListDomainChangesResponse{
+					DomainChanges: []*types.DomainChange{domainChange("event-1")},
+				}, nil)
+			},
+		},
+		{
+			"domain not exist",
+			"cadence --do test-domain domain history",
+			"does not exist",
+			func() {
+				s.serverFrontendClient.EXPECT().ListDomainChanges(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+			},
+		},
+		{
+			"list failure",
+			"cadence --do test-domain domain history",
+			"list error",
+			func() {
+				s.serverFrontendClient.EXPECT().ListDomainChanges(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("list error"))
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			s.runTestCase(tt)
+		})
+	}
+}
+
+func (s *cliAppSuite) TestDomainRollback() {
+	testCases := []testcase{
+		{
+			"rollback",
+			"cadence --do test-domain domain rollback --to-event event-id",
+			"",
+			func() {
+				s.serverAdminClient.EXPECT().RollbackDomain(gomock.Any(), &types.RollbackDomainRequest{
+					Domain:  "test-domain",
+					EventID: "event-id",
+				}).Return(&types.RollbackDomainResponse{}, nil)
+			},
+		},
+		{
+			"missing event",
+			"cadence --do test-domain domain rollback",
+			"Required flag",
+			func() {},
+		},
+		{
+			"event not exist",
+			"cadence --do test-domain domain rollback --to-event event-id",
+			"does not exist",
+			func() {
+				s.serverAdminClient.EXPECT().RollbackDomain(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+			},
+		},
+		{
+			"rollback failure",
+			"cadence --do test-domain domain rollback --to-event event-id",
+			"rollback error",
+			func() {
+				s.serverAdminClient.EXPECT().RollbackDomain(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("rollback error"))
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			s.runTestCase(tt)
+		})
+	}
+}
+
 func TestParseActiveClustersByClusterAttribute(t *testing.T) {
 
 	testCases := map[string]struct {
@@ -761,3 +872,42 @@ func TestRenderFailoverHistoryTable(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderDomainChangesTable(t *testing.T) {
+	response := &types.ListDomainChangesResponse{
+		DomainChanges: []*types.DomainChange{
+			{
+				ID:            "event-2",
+				CreatedTime:   1700000000000000000,
+				OperationType: "Update",
+				Identity:      "cadence-cli",
+				IdentityType:  "cli",
+				FieldChanges: []*types.DomainFieldChange{
+					{Field: "historyArchivalURI", Before: "s3://old", After: "s3://new"},
+					{Field: "retentionDays", Before: "3", After: "7"},
+				},
+			},
+			{
+				ID:            "event-1",
+				CreatedTime:   1600000000000000000,
+				OperationType: "Create",
+				FieldChanges:  []*types.DomainFieldChange{{Field: "name", After: "test-domain"}},
+			},
+		},
+	}
+
+	var output strings.Builder
+	renderDomainChangesTableToWriter(&output, response)
+
+	result := output.String()
+	for _, expected := range []string{
+		"EVENT ID", "TIMESTAMP", "OPERATION", "ACTOR", "FIELD", "BEFORE", "AFTER",
+		"event-2", "2023-11-14", "Update", "cadence-cli (cli)",
+		"historyArchivalURI", "s3://old", "s3://new",
+		"retentionDays", "3", "7",
+		"event-1", "Create", "test-domain",
+	} {
+		assert.Contains(t, result, expected)
+	}
+	assert.Equal(t, 1, strings.Count(result, "event-2"), "a change is only shown on its first row")
+}
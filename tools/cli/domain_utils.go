@@ -301,6 +301,27 @@ var (
 		getFormatFlag(),
 	}
 
+	domainHistoryFlags = []cli.Flag{
+		&cli.BoolFlag{
+			Name:    FlagAll,
+			Aliases: []string{"a"},
+			Usage:   "List all domain changes",
+		},
+		&cli.BoolFlag{
+			Name:    FlagPrintJSON,
+			Aliases: []string{"pjson"},
+			Usage:   "Print in raw JSON format",
+		},
+	}
+
+	rollbackDomainFlags = []cli.Flag{
+		&cli.StringFlag{
+			Name:     FlagToEvent,
+			Usage:    "ID of the domain history event to restore the domain configuration to, as listed by 'domain history'",
+			Required: true,
+		},
+	}
+
 	adminDomainCommonFlags = getDBFlags()
 
 	adminRegisterDomainFlags = append(
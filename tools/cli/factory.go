@@ -45,13 +45,16 @@ import (
 
 	serverAdmin "github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	serverFrontend "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	sharddistributorv1 "github.com/uber/cadence/.gen/proto/sharddistributor/v1"
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/client/sharddistributor"
 	grpcClient "github.com/uber/cadence/client/wrappers/grpc"
 	"github.com/uber/cadence/client/wrappers/thrift"
 	"github.com/uber/cadence/common"
 	cc "github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/tools/common/commoncli"
 )
@@ -59,6 +62,8 @@ import (
 const (
 	cadenceClientName      = "cadence-client"
 	cadenceFrontendService = "cadence-frontend"
+
+	defaultShardDistributorAddress = localHost + ":7943"
 )
 
 // ContextKey is an alias for string, used as context key
@@ -79,6 +84,9 @@ type ClientFactory interface {
 	// ServerAdminClientForMigration admin client of the migration destination
 	ServerAdminClientForMigration(c *cli.Context) (admin.Client, error)
 
+	// ServerShardDistributorClient shard distributor client, which is only reachable over gRPC
+	ServerShardDistributorClient(c *cli.Context) (sharddistributor.Client, error)
+
 	ElasticSearchClient(c *cli.Context) (*elastic.Client, error)
 
 	ServerConfig(c *cli.Context) (*config.Config, error)
@@ -87,6 +95,7 @@ type ClientFactory interface {
 type clientFactory struct {
 	dispatcher          *yarpc.Dispatcher // lazy, via ensureDispatcher
 	dispatcherMigration *yarpc.Dispatcher // lazy, via ensureDispatcherForMigration
+	dispatcherSD        *yarpc.Dispatcher // lazy, via ensureDispatcherForShardDistributor
 	logger              *zap.Logger
 }
 
@@ -182,6 +191,16 @@ func (b *clientFactory) ServerAdminClientForMigration(c *cli.Context) (admin.Cli
 	return thrift.NewAdminClient(serverAdmin.New(clientConfig)), nil
 }
 
+// ServerShardDistributorClient builds a shard distributor client (based on server side proto interface)
+func (b *clientFactory) ServerShardDistributorClient(c *cli.Context) (sharddistributor.Client, error) {
+	err := b.ensureDispatcherForShardDistributor(c)
+	if err != nil {
+		return nil, commoncli.Problem("failed to create shard distributor client dependency", err)
+	}
+	clientConfig := b.dispatcherSD.ClientConfig(service.ShardDistributor)
+	return grpcClient.NewShardDistributorClient(sharddistributorv1.NewShardDistributorAPIYARPCClient(clientConfig)), nil
+}
+
 // ElasticSearchClient builds an ElasticSearch client
 func (b *clientFactory) ElasticSearchClient(c *cli.Context) (*elastic.Client, error) {
 
@@ -237,6 +256,25 @@ func (b *clientFactory) ensureDispatcherForMigration(c *cli.Context) error {
 	return nil
 }
 
+func (b *clientFactory) ensureDispatcherForShardDistributor(c *cli.Context) error {
+	if b.dispatcherSD != nil {
+		return nil
+	}
+	hostPort := c.String(FlagShardDistributorAddress)
+	if hostPort == "" {
+		hostPort = defaultShardDistributorAddress
+	}
+	d, err := b.newDispatcher(c, service.ShardDistributor, hostPort, true)
+	if err != nil {
+		return commoncli.Problem(
+			fmt.Sprintf("failed to create dispatcher (for --%v %q)", FlagShardDistributorAddress, hostPort),
+			err,
+		)
+	}
+	b.dispatcherSD = d
+	return nil
+}
+
 func (b *clientFactory) newClientDispatcher(c *cli.Context, hostPortOverride string) (*yarpc.Dispatcher, error) {
 	shouldUseGrpc := c.String(FlagTransport) == grpcTransport
 
@@ -247,6 +285,10 @@ func (b *clientFactory) newClientDispatcher(c *cli.Context, hostPortOverride str
 	if hostPortOverride != "" {
 		hostPort = hostPortOverride
 	}
+	return b.newDispatcher(c, cadenceFrontendService, hostPort, shouldUseGrpc)
+}
+
+func (b *clientFactory) newDispatcher(c *cli.Context, serviceName, hostPort string, shouldUseGrpc bool) (*yarpc.Dispatcher, error) {
 	var outbounds transport.Outbounds
 	if shouldUseGrpc {
 		grpcTransport := grpc.NewTransport()
@@ -282,7 +324,7 @@ func (b *clientFactory) newClientDispatcher(c *cli.Context, hostPortOverride str
 
 	dispatcher := yarpc.NewDispatcher(yarpc.Config{
 		Name:      cadenceClientName,
-		Outbounds: yarpc.Outbounds{cadenceFrontendService: outbounds},
+		Outbounds: yarpc.Outbounds{serviceName: outbounds},
 		OutboundMiddleware: yarpc.OutboundMiddleware{
 			Unary: &versionMiddleware{},
 		},
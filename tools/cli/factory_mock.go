@@ -18,6 +18,7 @@ import (
 
 	admin "github.com/uber/cadence/client/admin"
 	frontend "github.com/uber/cadence/client/frontend"
+	sharddistributor "github.com/uber/cadence/client/sharddistributor"
 	config "github.com/uber/cadence/common/config"
 )
 
@@ -134,3 +135,18 @@ func (mr *MockClientFactoryMockRecorder) ServerFrontendClientForMigration(c any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServerFrontendClientForMigration", reflect.TypeOf((*MockClientFactory)(nil).ServerFrontendClientForMigration), c)
 }
+
+// ServerShardDistributorClient mocks base method.
+func (m *MockClientFactory) ServerShardDistributorClient(c *cli.Context) (sharddistributor.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServerShardDistributorClient", c)
+	ret0, _ := ret[0].(sharddistributor.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServerShardDistributorClient indicates an expected call of ServerShardDistributorClient.
+func (mr *MockClientFactoryMockRecorder) ServerShardDistributorClient(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServerShardDistributorClient", reflect.TypeOf((*MockClientFactory)(nil).ServerShardDistributorClient), c)
+}
@@ -134,6 +134,7 @@ const (
 	FlagIsGlobalDomain                 = "global_domain" // active-passive domain
 	FlagDomainData                     = "domain_data"
 	FlagEventID                        = "event_id"
+	FlagToEvent                        = "to-event"
 	FlagActivityID                     = "activity_id"
 	FlagMaxFieldLength                 = "max_field_length"
 	FlagSecurityToken                  = "security_token"
@@ -166,6 +167,10 @@ const (
 	FlagTaskType                       = "task_type"
 	FlagTaskVisibilityTimestamp        = "task_timestamp"
 	FlagQueueType                      = "queue_type"
+	FlagVirtualQueueID                 = "virtual_queue_id"
+	FlagTargetVirtualQueueID           = "target_virtual_queue_id"
+	FlagDomainIDs                      = "domain_ids"
+	FlagPauseDuration                  = "pause_duration"
 	FlagStartingRPS                    = "starting_rps"
 	FlagRPS                            = "rps"
 	FlagRPSScaleUpSeconds              = "rps_scale_up_seconds"
@@ -231,6 +236,28 @@ const (
 	FlagClusterAttributeScope          = "cluster_attribute_scope"
 	FlagClusterAttributeName           = "cluster_attribute_name"
 	FlagBatchV2                        = "v2"
+	FlagPagesPerRun                    = "pages_per_run"
+	FlagRetryFailedJobID               = "retry_failed_job_id"
+	FlagScheduleID                     = "schedule_id"
+	FlagScheduleFile                   = "schedule_file"
+	FlagScheduleStartTime              = "start_time"
+	FlagScheduleEndTime                = "end_time"
+	FlagJitter                         = "jitter"
+	FlagScheduleInterval               = "interval"
+	FlagExcludeDate                    = "exclude_date"
+	FlagTimeZone                       = "time_zone"
+	FlagWorkflowIDPrefix               = "workflow_id_prefix"
+	FlagOverlapPolicy                  = "overlap_policy"
+	FlagCatchUpPolicy                  = "catch_up_policy"
+	FlagCatchUpWindow                  = "catch_up_window"
+	FlagPauseOnFailure                 = "pause_on_failure"
+	FlagBufferLimit                    = "buffer_limit"
+	FlagConcurrencyLimit               = "concurrency_limit"
+	FlagBackfillID                     = "backfill_id"
+	FlagShardDistributorAddress        = "sd_address"
+	FlagNamespace                      = "namespace"
+	FlagShardKey                       = "shard_key"
+	FlagExecutorID                     = "executor_id"
 
 	FlagClustersUsage = "Clusters (example: --clusters clusterA,clusterB or --cl clusterA --cl clusterB)"
 )
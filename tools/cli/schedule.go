@@ -0,0 +1,268 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import "github.com/urfave/cli/v2"
+
+func newScheduleCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:    "create",
+			Aliases: []string{"c"},
+			Usage:   "Create a new schedule",
+			Flags: append(
+				[]cli.Flag{getScheduleIDFlag()},
+				getFlagsForScheduleDefinition()...,
+			),
+			Action: CreateSchedule,
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Describe a schedule's configuration and runtime state",
+			Flags: []cli.Flag{
+				getScheduleIDFlag(),
+				&cli.BoolFlag{
+					Name:    FlagPrintDateTime,
+					Aliases: []string{"pdt"},
+					Usage:   "Print full date time in '2006-01-02T15:04:05Z07:00' format",
+				},
+				getFormatFlag(),
+			},
+			Action: DescribeSchedule,
+		},
+		{
+			Name:    "update",
+			Aliases: []string{"u"},
+			Usage:   "Update a schedule's spec, action or policies. Only the provided parts are replaced.",
+			Flags: append(
+				[]cli.Flag{getScheduleIDFlag()},
+				getFlagsForScheduleDefinition()...,
+			),
+			Action: UpdateSchedule,
+		},
+		{
+			Name:  "pause",
+			Usage: "Pause a schedule",
+			Flags: []cli.Flag{
+				getScheduleIDFlag(),
+				&cli.StringFlag{
+					Name:    FlagReason,
+					Aliases: []string{"re"},
+					Usage:   "Reason for pausing the schedule",
+				},
+			},
+			Action: PauseSchedule,
+		},
+		{
+			Name:  "unpause",
+			Usage: "Unpause a schedule",
+			Flags: []cli.Flag{
+				getScheduleIDFlag(),
+				&cli.StringFlag{
+					Name:    FlagReason,
+					Aliases: []string{"re"},
+					Usage:   "Reason for unpausing the schedule",
+				},
+				&cli.StringFlag{
+					Name:  FlagCatchUpPolicy,
+					Usage: "Optional catch-up policy for runs missed while paused [skip|one|all]",
+				},
+			},
+			Action: UnpauseSchedule,
+		},
+		{
+			Name:  "backfill",
+			Usage: "Trigger the schedule's action for every fire time in a past time range",
+			Flags: []cli.Flag{
+				getScheduleIDFlag(),
+				&cli.StringFlag{
+					Name:  FlagScheduleStartTime,
+					Usage: "Start of the backfill range, in UTC format '2006-01-02T15:04:05Z', a time range or raw UnixNano",
+				},
+				&cli.StringFlag{
+					Name:  FlagScheduleEndTime,
+					Usage: "End of the backfill range, in UTC format '2006-01-02T15:04:05Z', a time range or raw UnixNano",
+				},
+				&cli.StringFlag{
+					Name:  FlagOverlapPolicy,
+					Usage: "Optional overlap policy for the backfilled runs [skip_new|buffer|concurrent|cancel_previous|terminate_previous]",
+				},
+				&cli.StringFlag{
+					Name:  FlagBackfillID,
+					Usage: "Optional ID used to identify and deduplicate the backfill",
+				},
+			},
+			Action: BackfillSchedule,
+		},
+		{
+			Name:  "trigger",
+			Usage: "Fire a schedule's action immediately, even when the schedule is paused",
+			Flags: []cli.Flag{
+				getScheduleIDFlag(),
+				&cli.StringFlag{
+					Name:  FlagOverlapPolicy,
+					Usage: "Optional overlap policy for the triggered run, defaults to the schedule's [skip_new|buffer|concurrent|cancel_previous|terminate_previous]",
+				},
+			},
+			Action: TriggerSchedule,
+		},
+		{
+			Name:    "delete",
+			Aliases: []string{"del"},
+			Usage:   "Delete a schedule",
+			Flags:   []cli.Flag{getScheduleIDFlag()},
+			Action:  DeleteSchedule,
+		},
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List schedules in a domain",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:    FlagPageSize,
+					Aliases: []string{"ps"},
+					Value:   10,
+					Usage:   "Result page size",
+				},
+				&cli.BoolFlag{
+					Name:    FlagAll,
+					Aliases: []string{"a"},
+					Usage:   "List all pages instead of only the first one",
+				},
+				&cli.BoolFlag{
+					Name:    FlagPrintDateTime,
+					Aliases: []string{"pdt"},
+					Usage:   "Print full date time in '2006-01-02T15:04:05Z07:00' format",
+				},
+				getFormatFlag(),
+			},
+			Action: ListSchedules,
+		},
+	}
+}
+
+func getScheduleIDFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:    FlagScheduleID,
+		Aliases: []string{"sid"},
+		Usage:   "ScheduleID",
+	}
+}
+
+// getFlagsForScheduleDefinition returns the flags that describe a schedule's spec,
+// action and policies. Each of them overrides the matching value read from --schedule_file.
+func getFlagsForScheduleDefinition() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    FlagScheduleFile,
+			Aliases: []string{"sf"},
+			Usage: "Optional JSON or YAML file (detected by the .yaml/.yml extension) with the schedule's " +
+				"spec, action and policies. Values passed as flags take precedence over the file",
+		},
+		&cli.StringFlag{
+			Name:  FlagCronSchedule,
+			Usage: "Cron expression of the schedule, e.g. '*/5 * * * *'",
+		},
+		&cli.StringSliceFlag{
+			Name:  FlagScheduleInterval,
+			Usage: "Interval the schedule fires at, in the form 'every <duration> [offset <duration>]', e.g. 'every 90m offset 10m'. Can be repeated. Not supported by the server yet",
+		},
+		&cli.StringSliceFlag{
+			Name:  FlagExcludeDate,
+			Usage: "Calendar date in YYYY-MM-DD format on which the schedule does not fire, e.g. a holiday. Can be repeated. Not supported by the server yet",
+		},
+		&cli.StringFlag{
+			Name:  FlagTimeZone,
+			Usage: "IANA time zone the cron expressions and excluded dates are evaluated in, e.g. America/New_York. Defaults to UTC. Not supported by the server yet",
+		},
+		&cli.StringFlag{
+			Name:  FlagScheduleStartTime,
+			Usage: "Optional time before which the schedule does not fire, in UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+		},
+		&cli.StringFlag{
+			Name:  FlagScheduleEndTime,
+			Usage: "Optional time after which the schedule stops firing, in UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+		},
+		&cli.DurationFlag{
+			Name:  FlagJitter,
+			Usage: "Optional random delay added to each fire, e.g. 30s",
+		},
+		&cli.StringFlag{
+			Name:    FlagWorkflowType,
+			Aliases: []string{"wt"},
+			Usage:   "Workflow type started by the schedule",
+		},
+		&cli.StringFlag{
+			Name:    FlagTaskList,
+			Aliases: []string{"tl"},
+			Usage:   "TaskList of the workflows started by the schedule",
+		},
+		&cli.StringFlag{
+			Name:  FlagWorkflowIDPrefix,
+			Usage: "Optional prefix of the IDs of workflows started by the schedule",
+		},
+		&cli.IntFlag{
+			Name:    FlagExecutionTimeout,
+			Aliases: []string{"et"},
+			Usage:   "Execution start to close timeout in seconds of the workflows started by the schedule",
+		},
+		&cli.IntFlag{
+			Name:    FlagDecisionTimeout,
+			Aliases: []string{"dt"},
+			Usage:   "Decision task start to close timeout in seconds of the workflows started by the schedule",
+		},
+		&cli.StringFlag{
+			Name:    FlagInput,
+			Aliases: []string{"i"},
+			Usage:   "Optional input for the started workflows, in JSON format",
+		},
+		&cli.StringFlag{
+			Name:    FlagInputFile,
+			Aliases: []string{"if"},
+			Usage:   "Optional input for the started workflows from JSON file",
+		},
+		&cli.StringFlag{
+			Name:  FlagOverlapPolicy,
+			Usage: "Optional overlap policy [skip_new|buffer|concurrent|cancel_previous|terminate_previous]",
+		},
+		&cli.StringFlag{
+			Name:  FlagCatchUpPolicy,
+			Usage: "Optional catch-up policy for missed runs [skip|one|all]",
+		},
+		&cli.DurationFlag{
+			Name:  FlagCatchUpWindow,
+			Usage: "Optional window in which missed runs are still caught up, e.g. 1h",
+		},
+		&cli.BoolFlag{
+			Name:  FlagPauseOnFailure,
+			Usage: "Pause the schedule when a started workflow fails",
+		},
+		&cli.IntFlag{
+			Name:  FlagBufferLimit,
+			Usage: "Optional maximum number of buffered runs for the buffer overlap policy",
+		},
+		&cli.IntFlag{
+			Name:  FlagConcurrencyLimit,
+			Usage: "Optional maximum number of concurrent runs for the concurrent overlap policy",
+		},
+	}
+}
@@ -0,0 +1,751 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v2"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/scheduler"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+type (
+	// scheduleDefinition is the format of the file passed with --schedule_file.
+	// Time values use RFC3339 and durations are given in nanoseconds.
+	scheduleDefinition struct {
+		Spec     *types.ScheduleSpec     `json:"spec,omitempty"`
+		Action   *types.ScheduleAction   `json:"action,omitempty"`
+		Policies *types.SchedulePolicies `json:"policies,omitempty"`
+	}
+
+	// ScheduleRow is a presentation layer entry for a single schedule
+	ScheduleRow struct {
+		ScheduleID    string    `header:"Schedule ID"`
+		Cron          string    `header:"Cron"`
+		WorkflowType  string    `header:"Workflow Type"`
+		TaskList      string    `header:"Task List"`
+		Paused        bool      `header:"Paused"`
+		PauseReason   string    `header:"Pause Reason"`
+		OverlapPolicy string    `header:"Overlap Policy"`
+		CatchUpPolicy string    `header:"Catch Up Policy"`
+		LastRunTime   time.Time `header:"Last Run"`
+		NextRunTime   time.Time `header:"Next Run"`
+		TotalRuns     int64     `header:"Total Runs"`
+	}
+
+	// ScheduleActionRow is a presentation layer entry for a workflow started by a schedule
+	ScheduleActionRow struct {
+		ScheduledTime time.Time `header:"Scheduled Time"`
+		ActualTime    time.Time `header:"Actual Time"`
+		WorkflowID    string    `header:"Workflow ID"`
+		RunID         string    `header:"Run ID"`
+		CloseStatus   string    `header:"Close Status"`
+	}
+)
+
+// CreateSchedule creates a new schedule
+func CreateSchedule(c *cli.Context) error {
+	frontendClient, err := getScheduleClient(c)
+	if err != nil {
+		return err
+	}
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	scheduleID, err := getRequiredOption(c, FlagScheduleID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	definition, err := buildScheduleDefinition(c)
+	if err != nil {
+		return commoncli.Problem("Invalid schedule definition: ", err)
+	}
+	if err := validateScheduleSpecSupported(definition.Spec); err != nil {
+		return commoncli.Problem("Invalid schedule definition: ", err)
+	}
+	if definition.Spec.GetCronExpression() == "" {
+		return commoncli.Problem(fmt.Sprintf("Cron expression is required, set it with --%s or in the schedule file.", FlagCronSchedule), nil)
+	}
+	if definition.Action.GetSignalWithStartWorkflow() != nil {
+		return commoncli.Problem("SignalWithStartWorkflow actions are not supported by the server yet, use a start workflow action.", nil)
+	}
+	startWorkflow := definition.Action.GetStartWorkflow()
+	if startWorkflow.GetWorkflowType().GetName() == "" || startWorkflow.GetTaskList().GetName() == "" {
+		return commoncli.Problem(fmt.Sprintf("Workflow type and task list are required, set them with --%s and --%s or in the schedule file.", FlagWorkflowType, FlagTaskList), nil)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+	_, err = frontendClient.CreateSchedule(ctx, &types.CreateScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Spec:       definition.Spec,
+		Action:     definition.Action,
+		Policies:   definition.Policies,
+	})
+	if err != nil {
+		return commoncli.Problem("Operation CreateSchedule failed.", err)
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Schedule %s successfully created.\n", scheduleID)
+	return nil
+}
+
+// DescribeSchedule prints the configuration and runtime state of a schedule
+func DescribeSchedule(c *cli.Context) error {
+	frontendClient, err := getScheduleClient(c)
+	if err != nil {
+		return err
+	}
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	scheduleID, err := getRequiredOption(c, FlagScheduleID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+	resp, err := frontendClient.DescribeSchedule(ctx, &types.DescribeScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+	})
+	if err != nil {
+		return commoncli.Problem("Operation DescribeSchedule failed.", err)
+	}
+
+	if c.String(FlagFormat) == formatJSON {
+		prettyPrintJSONObject(getDeps(c).Output(), resp)
+		return nil
+	}
+	if err := Render(c, newScheduleRow(scheduleID, resp.GetSpec(), resp.GetAction(), resp.GetPolicies(), resp.GetState(), resp.GetInfo()), scheduleTableOptions(c)); err != nil {
+		return err
+	}
+
+	recentActions := resp.GetInfo().GetRecentActions()
+	if len(recentActions) == 0 {
+		return nil
+	}
+	table := make([]ScheduleActionRow, 0, len(recentActions))
+	for _, action := range recentActions {
+		closeStatus := "RUNNING"
+		if action.CloseStatus != nil {
+			closeStatus = action.CloseStatus.String()
+		}
+		table = append(table, ScheduleActionRow{
+			ScheduledTime: action.GetScheduledTime(),
+			ActualTime:    action.GetActualTime(),
+			WorkflowID:    action.GetWorkflowID(),
+			RunID:         action.GetRunID(),
+			CloseStatus:   closeStatus,
+		})
+	}
+	fmt.Fprintln(getDeps(c).Output(), "\nRecent actions:")
+	return Render(c, table, RenderOptions{DefaultTemplate: templateTable, Color: true, PrintDateTime: c.Bool(FlagPrintDateTime)})
+}
+
+// UpdateSchedule replaces the spec, action and/or policies of a schedule
+func UpdateSchedule(c *cli.Context) error {
+	frontendClient, err := getScheduleClient(c)
+	if err != nil {
+		return err
+	}
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	scheduleID, err := getRequiredOption(c, FlagScheduleID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	definition, err := buildScheduleDefinition(c)
+	if err != nil {
+		return commoncli.Problem("Invalid schedule definition: ", err)
+	}
+	if err := validateScheduleSpecSupported(definition.Spec); err != nil {
+		return commoncli.Problem("Invalid schedule definition: ", err)
+	}
+	if definition.Action.GetSignalWithStartWorkflow() != nil {
+		return commoncli.Problem("SignalWithStartWorkflow actions are not supported by the server yet, use a start workflow action.", nil)
+	}
+	if definition.Spec == nil && definition.Action == nil && definition.Policies == nil {
+		return commoncli.Problem("Nothing to update, provide a schedule file or at least one spec, action or policy flag.", nil)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+	_, err = frontendClient.UpdateSchedule(ctx, &types.UpdateScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Spec:       definition.Spec,
+		Action:     definition.Action,
+		Policies:   definition.Policies,
+	})
+	if err != nil {
+		return commoncli.Problem("Operation UpdateSchedule failed.", err)
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Schedule %s successfully updated.\n", scheduleID)
+	return nil
+}
+
+// PauseSchedule pauses a schedule
+func PauseSchedule(c *cli.Context) error {
+	frontendClient, err := getScheduleClient(c)
+	if err != nil {
+		return err
+	}
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	scheduleID, err := getRequiredOption(c, FlagScheduleID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+	_, err = frontendClient.PauseSchedule(ctx, &types.PauseScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Reason:     c.String(FlagReason),
+		Identity:   getCliIdentity(),
+	})
+	if err != nil {
+		return commoncli.Problem("Operation PauseSchedule failed.", err)
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Schedule %s successfully paused.\n", scheduleID)
+	return nil
+}
+
+// UnpauseSchedule unpauses a schedule
+func UnpauseSchedule(c *cli.Context) error {
+	frontendClient, err := getScheduleClient(c)
+	if err != nil {
+		return err
+	}
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	scheduleID, err := getRequiredOption(c, FlagScheduleID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	var catchUpPolicy types.ScheduleCatchUpPolicy
+	if c.IsSet(FlagCatchUpPolicy) {
+		if err := catchUpPolicy.UnmarshalText([]byte(c.String(FlagCatchUpPolicy))); err != nil {
+			return commoncli.Problem("Invalid catch-up policy: ", err)
+		}
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+	_, err = frontendClient.UnpauseSchedule(ctx, &types.UnpauseScheduleRequest{
+		Domain:        domain,
+		ScheduleID:    scheduleID,
+		Reason:        c.String(FlagReason),
+		CatchUpPolicy: catchUpPolicy,
+	})
+	if err != nil {
+		return commoncli.Problem("Operation UnpauseSchedule failed.", err)
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Schedule %s successfully unpaused.\n", scheduleID)
+	return nil
+}
+
+// BackfillSchedule triggers the schedule's action for each fire time in a past time range
+func BackfillSchedule(c *cli.Context) error {
+	frontendClient, err := getScheduleClient(c)
+	if err != nil {
+		return err
+	}
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	scheduleID, err := getRequiredOption(c, FlagScheduleID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	startTime, err := getRequiredScheduleTime(c, FlagScheduleStartTime)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	endTime, err := getRequiredScheduleTime(c, FlagScheduleEndTime)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	var overlapPolicy types.ScheduleOverlapPolicy
+	if c.IsSet(FlagOverlapPolicy) {
+		if err := overlapPolicy.UnmarshalText([]byte(c.String(FlagOverlapPolicy))); err != nil {
+			return commoncli.Problem("Invalid overlap policy: ", err)
+		}
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+	_, err = frontendClient.BackfillSchedule(ctx, &types.BackfillScheduleRequest{
+		Domain:        domain,
+		ScheduleID:    scheduleID,
+		StartTime:     startTime,
+		EndTime:       endTime,
+		OverlapPolicy: overlapPolicy,
+		BackfillID:    c.String(FlagBackfillID),
+	})
+	if err != nil {
+		return commoncli.Problem("Operation BackfillSchedule failed.", err)
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Backfill for schedule %s successfully requested.\n", scheduleID)
+	return nil
+}
+
+// TriggerSchedule fires a schedule immediately, even when it is paused. There is no schedule API
+// for it yet, so the trigger signal is sent to the scheduler workflow directly.
+func TriggerSchedule(c *cli.Context) error {
+	frontendClient, err := getWorkflowClient(c)
+	if err != nil {
+		return err
+	}
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	scheduleID, err := getRequiredOption(c, FlagScheduleID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	var overlapPolicy types.ScheduleOverlapPolicy
+	if c.IsSet(FlagOverlapPolicy) {
+		if err := overlapPolicy.UnmarshalText([]byte(c.String(FlagOverlapPolicy))); err != nil {
+			return commoncli.Problem("Invalid overlap policy: ", err)
+		}
+	}
+	input, err := json.Marshal(scheduler.TriggerSignal{OverlapPolicy: overlapPolicy})
+	if err != nil {
+		return commoncli.Problem("Failed to serialize trigger signal: ", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+	err = frontendClient.SignalWorkflowExecution(ctx, &types.SignalWorkflowExecutionRequest{
+		Domain: domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: scheduler.WorkflowID(scheduleID),
+		},
+		SignalName: scheduler.SignalNameTrigger,
+		Input:      input,
+		Identity:   getCliIdentity(),
+		RequestID:  uuid.New(),
+	})
+	var notExists *types.EntityNotExistsError
+	if errors.As(err, &notExists) {
+		return commoncli.Problem(fmt.Sprintf("Schedule %s not found in domain %s.", scheduleID, domain), err)
+	}
+	if err != nil {
+		return commoncli.Problem("Operation TriggerSchedule failed.", err)
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Schedule %s successfully triggered.\n", scheduleID)
+	return nil
+}
+
+// DeleteSchedule deletes a schedule
+func DeleteSchedule(c *cli.Context) error {
+	frontendClient, err := getScheduleClient(c)
+	if err != nil {
+		return err
+	}
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	scheduleID, err := getRequiredOption(c, FlagScheduleID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+	_, err = frontendClient.DeleteSchedule(ctx, &types.DeleteScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+	})
+	if err != nil {
+		return commoncli.Problem("Operation DeleteSchedule failed.", err)
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Schedule %s successfully deleted.\n", scheduleID)
+	return nil
+}
+
+// ListSchedules lists the schedules of a domain
+func ListSchedules(c *cli.Context) error {
+	frontendClient, err := getScheduleClient(c)
+	if err != nil {
+		return err
+	}
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+
+	var (
+		schedules []*types.ScheduleListEntry
+		pageToken []byte
+	)
+	for {
+		ctx, cancel, err := newContext(c)
+		if err != nil {
+			return commoncli.Problem("Error in creating context:", err)
+		}
+		resp, err := frontendClient.ListSchedules(ctx, &types.ListSchedulesRequest{
+			Domain:        domain,
+			PageSize:      int32(c.Int(FlagPageSize)),
+			NextPageToken: pageToken,
+		})
+		cancel()
+		if err != nil {
+			return commoncli.Problem("Operation ListSchedules failed.", err)
+		}
+		schedules = append(schedules, resp.GetSchedules()...)
+		pageToken = resp.GetNextPageToken()
+		if len(pageToken) == 0 || !c.Bool(FlagAll) {
+			break
+		}
+	}
+
+	if c.String(FlagFormat) == formatJSON {
+		prettyPrintJSONObject(getDeps(c).Output(), schedules)
+		return nil
+	}
+	table := make([]ScheduleRow, 0, len(schedules))
+	for _, entry := range schedules {
+		spec := entry.GetSpec()
+		if spec == nil && entry.GetCronExpression() != "" {
+			spec = &types.ScheduleSpec{CronExpression: entry.GetCronExpression()}
+		}
+		action := &types.ScheduleAction{StartWorkflow: &types.StartWorkflowAction{WorkflowType: entry.GetWorkflowType()}}
+		table = append(table, newScheduleRow(entry.GetScheduleID(), spec, action, nil, entry.GetState(), entry.GetInfo()))
+	}
+	return Render(c, table, scheduleTableOptions(c))
+}
+
+func newScheduleRow(
+	scheduleID string,
+	spec *types.ScheduleSpec,
+	action *types.ScheduleAction,
+	policies *types.SchedulePolicies,
+	state *types.ScheduleState,
+	info *types.ScheduleInfo,
+) ScheduleRow {
+	row := ScheduleRow{
+		ScheduleID:   scheduleID,
+		Cron:         spec.GetCronExpression(),
+		WorkflowType: action.GetStartWorkflow().GetWorkflowType().GetName(),
+		TaskList:     action.GetStartWorkflow().GetTaskList().GetName(),
+		Paused:       state.GetPaused(),
+		PauseReason:  state.GetPauseInfo().GetReason(),
+		LastRunTime:  info.GetLastRunTime(),
+		NextRunTime:  info.GetNextRunTime(),
+		TotalRuns:    info.GetTotalRuns(),
+	}
+	if policies != nil {
+		row.OverlapPolicy = policies.GetOverlapPolicy().String()
+		row.CatchUpPolicy = policies.GetCatchUpPolicy().String()
+	}
+	return row
+}
+
+func scheduleTableOptions(c *cli.Context) RenderOptions {
+	return RenderOptions{
+		DefaultTemplate: templateTable,
+		Color:           true,
+		PrintDateTime:   c.Bool(FlagPrintDateTime),
+		OptionalColumns: map[string]bool{
+			"Overlap Policy":  c.Command.Name == "describe",
+			"Catch Up Policy": c.Command.Name == "describe",
+		},
+	}
+}
+
+// buildScheduleDefinition reads the optional schedule file and applies flag overrides on top of it.
+// Parts of the definition that are neither in the file nor set by flags are left nil.
+func buildScheduleDefinition(c *cli.Context) (*scheduleDefinition, error) {
+	definition := &scheduleDefinition{}
+	if c.IsSet(FlagScheduleFile) {
+		var err error
+		definition, err = readScheduleDefinitionFile(c.String(FlagScheduleFile))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.IsSet(FlagCronSchedule) || c.IsSet(FlagScheduleInterval) || c.IsSet(FlagExcludeDate) || c.IsSet(FlagTimeZone) ||
+		c.IsSet(FlagScheduleStartTime) || c.IsSet(FlagScheduleEndTime) || c.IsSet(FlagJitter) {
+		if definition.Spec == nil {
+			definition.Spec = &types.ScheduleSpec{}
+		}
+		if c.IsSet(FlagCronSchedule) {
+			definition.Spec.CronExpression = c.String(FlagCronSchedule)
+		}
+		if c.IsSet(FlagScheduleInterval) {
+			definition.Spec.Intervals = nil
+			for _, value := range c.StringSlice(FlagScheduleInterval) {
+				interval, err := parseScheduleInterval(value)
+				if err != nil {
+					return nil, err
+				}
+				definition.Spec.Intervals = append(definition.Spec.Intervals, interval)
+			}
+		}
+		if c.IsSet(FlagExcludeDate) {
+			definition.Spec.ExcludedDates = c.StringSlice(FlagExcludeDate)
+		}
+		if c.IsSet(FlagTimeZone) {
+			definition.Spec.TimeZone = c.String(FlagTimeZone)
+		}
+		if c.IsSet(FlagScheduleStartTime) {
+			startTime, err := getRequiredScheduleTime(c, FlagScheduleStartTime)
+			if err != nil {
+				return nil, err
+			}
+			definition.Spec.StartTime = startTime
+		}
+		if c.IsSet(FlagScheduleEndTime) {
+			endTime, err := getRequiredScheduleTime(c, FlagScheduleEndTime)
+			if err != nil {
+				return nil, err
+			}
+			definition.Spec.EndTime = endTime
+		}
+		if c.IsSet(FlagJitter) {
+			definition.Spec.Jitter = c.Duration(FlagJitter)
+		}
+	}
+
+	if c.IsSet(FlagWorkflowType) || c.IsSet(FlagTaskList) || c.IsSet(FlagWorkflowIDPrefix) ||
+		c.IsSet(FlagExecutionTimeout) || c.IsSet(FlagDecisionTimeout) || c.IsSet(FlagInput) || c.IsSet(FlagInputFile) {
+		if definition.Action == nil {
+			definition.Action = &types.ScheduleAction{}
+		}
+		if definition.Action.StartWorkflow == nil {
+			definition.Action.StartWorkflow = &types.StartWorkflowAction{}
+		}
+		startWorkflow := definition.Action.StartWorkflow
+		if c.IsSet(FlagWorkflowType) {
+			startWorkflow.WorkflowType = &types.WorkflowType{Name: c.String(FlagWorkflowType)}
+		}
+		if c.IsSet(FlagTaskList) {
+			startWorkflow.TaskList = &types.TaskList{Name: c.String(FlagTaskList)}
+		}
+		if c.IsSet(FlagWorkflowIDPrefix) {
+			startWorkflow.WorkflowIDPrefix = c.String(FlagWorkflowIDPrefix)
+		}
+		if c.IsSet(FlagExecutionTimeout) {
+			startWorkflow.ExecutionStartToCloseTimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagExecutionTimeout)))
+		}
+		if c.IsSet(FlagDecisionTimeout) {
+			startWorkflow.TaskStartToCloseTimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagDecisionTimeout)))
+		}
+		if c.IsSet(FlagInput) || c.IsSet(FlagInputFile) {
+			input, err := processJSONInput(c)
+			if err != nil {
+				return nil, err
+			}
+			startWorkflow.Input = []byte(input)
+		}
+	}
+
+	if c.IsSet(FlagOverlapPolicy) || c.IsSet(FlagCatchUpPolicy) || c.IsSet(FlagCatchUpWindow) ||
+		c.IsSet(FlagPauseOnFailure) || c.IsSet(FlagBufferLimit) || c.IsSet(FlagConcurrencyLimit) {
+		if definition.Policies == nil {
+			definition.Policies = &types.SchedulePolicies{}
+		}
+		if c.IsSet(FlagOverlapPolicy) {
+			if err := definition.Policies.OverlapPolicy.UnmarshalText([]byte(c.String(FlagOverlapPolicy))); err != nil {
+				return nil, err
+			}
+		}
+		if c.IsSet(FlagCatchUpPolicy) {
+			if err := definition.Policies.CatchUpPolicy.UnmarshalText([]byte(c.String(FlagCatchUpPolicy))); err != nil {
+				return nil, err
+			}
+		}
+		if c.IsSet(FlagCatchUpWindow) {
+			definition.Policies.CatchUpWindow = c.Duration(FlagCatchUpWindow)
+		}
+		if c.IsSet(FlagPauseOnFailure) {
+			definition.Policies.PauseOnFailure = c.Bool(FlagPauseOnFailure)
+		}
+		if c.IsSet(FlagBufferLimit) {
+			definition.Policies.BufferLimit = int32(c.Int(FlagBufferLimit))
+		}
+		if c.IsSet(FlagConcurrencyLimit) {
+			definition.Policies.ConcurrencyLimit = int32(c.Int(FlagConcurrencyLimit))
+		}
+	}
+
+	return definition, nil
+}
+
+// readScheduleDefinitionFile parses a JSON or YAML schedule file. YAML is converted to JSON
+// first so that both formats share the json field names of the schedule types.
+func readScheduleDefinitionFile(path string) (*scheduleDefinition, error) {
+	// This method is purely used to parse input from the CLI. The input comes from a trusted user
+	// #nosec
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading schedule file: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		var raw interface{}
+		if err := yaml.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("schedule file is not valid YAML: %w", err)
+		}
+		data, err = json.Marshal(yamlToJSONCompatible(raw))
+		if err != nil {
+			return nil, fmt.Errorf("schedule file cannot be converted to JSON: %w", err)
+		}
+	}
+
+	var definition scheduleDefinition
+	if err := json.Unmarshal(data, &definition); err != nil {
+		return nil, fmt.Errorf("schedule file is not a valid schedule definition: %w", err)
+	}
+	return &definition, nil
+}
+
+// yamlToJSONCompatible converts the map[interface{}]interface{} values produced by
+// the YAML decoder into map[string]interface{} so they can be JSON encoded.
+func yamlToJSONCompatible(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			converted[fmt.Sprintf("%v", key)] = yamlToJSONCompatible(item)
+		}
+		return converted
+	case []interface{}:
+		for i, item := range v {
+			v[i] = yamlToJSONCompatible(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// validateScheduleSpecSupported rejects the spec fields the schedule API cannot carry
+// yet. They would otherwise be dropped before the request reaches the server.
+// getScheduleClient returns the frontend client of the schedule commands. The schedule APIs
+// are only served over gRPC, so the commands fail before any call on other transports.
+func getScheduleClient(c *cli.Context) (frontend.Client, error) {
+	if c.String(FlagTransport) != grpcTransport {
+		return nil, commoncli.Problem(fmt.Sprintf("Schedule commands require the gRPC transport, rerun them with --%s %s.", FlagTransport, grpcTransport), nil)
+	}
+	return getWorkflowClient(c)
+}
+
+func validateScheduleSpecSupported(spec *types.ScheduleSpec) error {
+	if len(spec.GetAdditionalCronExpressions()) > 0 || len(spec.GetIntervals()) > 0 ||
+		len(spec.GetExcludedDates()) > 0 || spec.GetTimeZone() != "" {
+		return fmt.Errorf("additional cron expressions, intervals (--%s), excluded dates (--%s) and time zones (--%s) are not supported by the server yet",
+			FlagScheduleInterval, FlagExcludeDate, FlagTimeZone)
+	}
+	return nil
+}
+
+// parseScheduleInterval parses an interval in the form "every <duration> [offset <duration>]".
+// The leading "every" keyword is optional, so "90m" is the same as "every 90m".
+func parseScheduleInterval(value string) (*types.ScheduleIntervalSpec, error) {
+	fields := strings.Fields(strings.ToLower(value))
+	if len(fields) > 0 && fields[0] == "every" {
+		fields = fields[1:]
+	}
+	if len(fields) != 1 && (len(fields) != 3 || fields[1] != "offset") {
+		return nil, fmt.Errorf("invalid interval %q, expected 'every <duration> [offset <duration>]'", value)
+	}
+
+	every, err := time.ParseDuration(fields[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid interval %q: %w", value, err)
+	}
+	interval := &types.ScheduleIntervalSpec{Every: every}
+	if len(fields) == 3 {
+		interval.Offset, err = time.ParseDuration(fields[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval offset %q: %w", value, err)
+		}
+	}
+	return interval, nil
+}
+
+func getRequiredScheduleTime(c *cli.Context, optionName string) (time.Time, error) {
+	value, err := getRequiredOption(c, optionName)
+	if err != nil {
+		return time.Time{}, err
+	}
+	unixNano, err := parseTime(value, 0)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, unixNano).UTC(), nil
+}
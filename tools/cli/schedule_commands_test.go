@@ -0,0 +1,440 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/scheduler"
+	"github.com/uber/cadence/tools/cli/clitest"
+)
+
+func TestScheduleCommands(t *testing.T) {
+	describeResponse := &types.DescribeScheduleResponse{
+		Spec: &types.ScheduleSpec{CronExpression: "*/5 * * * *"},
+		Action: &types.ScheduleAction{StartWorkflow: &types.StartWorkflowAction{
+			WorkflowType: &types.WorkflowType{Name: "report-workflow"},
+			TaskList:     &types.TaskList{Name: "reports"},
+		}},
+		Policies: &types.SchedulePolicies{OverlapPolicy: types.ScheduleOverlapPolicyBuffer},
+		State:    &types.ScheduleState{Paused: true, PauseInfo: &types.SchedulePauseInfo{Reason: "maintenance"}},
+		Info:     &types.ScheduleInfo{TotalRuns: 12},
+	}
+
+	signalWithStartFile := filepath.Join(t.TempDir(), "schedule.json")
+	require.NoError(t, os.WriteFile(signalWithStartFile, []byte(`{"spec": {"cronExpression": "* * * * *"},
+		"action": {"signalWithStartWorkflow": {"workflowId": "entity", "workflowType": {"name": "wf"}, "taskList": {"name": "tl"}, "signalName": "tick"}}}`), 0o600))
+
+	tests := []struct {
+		name          string
+		cmdline       string
+		setupMocks    func(*frontend.MockClient)
+		expectedError string
+		expectedStr   string
+	}{
+		{
+			name:    "create",
+			cmdline: "cadence --transport grpc --domain test-domain schedule create --schedule_id s1 --cron '*/5 * * * *' --wt report-workflow --tl reports --overlap_policy buffer --jitter 30s",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().CreateSchedule(gomock.Any(), &types.CreateScheduleRequest{
+					Domain:     "test-domain",
+					ScheduleID: "s1",
+					Spec:       &types.ScheduleSpec{CronExpression: "*/5 * * * *", Jitter: 30 * time.Second},
+					Action: &types.ScheduleAction{StartWorkflow: &types.StartWorkflowAction{
+						WorkflowType: &types.WorkflowType{Name: "report-workflow"},
+						TaskList:     &types.TaskList{Name: "reports"},
+					}},
+					Policies: &types.SchedulePolicies{OverlapPolicy: types.ScheduleOverlapPolicyBuffer},
+				}).Return(&types.CreateScheduleResponse{ScheduleID: "s1"}, nil)
+			},
+			expectedStr: "Schedule s1 successfully created.",
+		},
+		{
+			name:          "create with interval, exclusions and time zone",
+			cmdline:       "cadence --transport grpc --domain test-domain schedule create --schedule_id s1 --interval 'every 90m offset 10m' --exclude_date 2026-12-25 --time_zone America/New_York --wt report-workflow --tl reports",
+			setupMocks:    func(client *frontend.MockClient) {},
+			expectedError: "not supported by the server yet",
+		},
+		{
+			name:          "create with malformed interval",
+			cmdline:       "cadence --transport grpc --domain test-domain schedule create --schedule_id s1 --interval 'every 90m after 10m' --wt wf --tl tl",
+			setupMocks:    func(client *frontend.MockClient) {},
+			expectedError: "Invalid schedule definition",
+		},
+		{
+			name:          "create over tchannel",
+			cmdline:       "cadence --domain test-domain schedule create --schedule_id s1 --cron '*/5 * * * *' --wt report-workflow --tl reports",
+			setupMocks:    func(client *frontend.MockClient) {},
+			expectedError: "Schedule commands require the gRPC transport",
+		},
+		{
+			name:          "create with signal with start action",
+			cmdline:       "cadence --transport grpc --domain test-domain schedule create --schedule_id s1 --schedule_file " + signalWithStartFile,
+			setupMocks:    func(client *frontend.MockClient) {},
+			expectedError: "SignalWithStartWorkflow actions are not supported by the server yet",
+		},
+		{
+			name:          "create without cron",
+			cmdline:       "cadence --transport grpc --domain test-domain schedule create --schedule_id s1 --wt report-workflow --tl reports",
+			setupMocks:    func(client *frontend.MockClient) {},
+			expectedError: "Cron expression is required",
+		},
+		{
+			name:          "create without schedule id",
+			cmdline:       "cadence --transport grpc --domain test-domain schedule create --cron '* * * * *'",
+			setupMocks:    func(client *frontend.MockClient) {},
+			expectedError: "Required flag not found",
+		},
+		{
+			name:          "create with invalid overlap policy",
+			cmdline:       "cadence --transport grpc --domain test-domain schedule create --schedule_id s1 --cron '* * * * *' --wt wf --tl tl --overlap_policy sometimes",
+			setupMocks:    func(client *frontend.MockClient) {},
+			expectedError: "Invalid schedule definition",
+		},
+		{
+			name:    "describe",
+			cmdline: "cadence --transport grpc --domain test-domain schedule describe --schedule_id s1",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().DescribeSchedule(gomock.Any(), &types.DescribeScheduleRequest{
+					Domain:     "test-domain",
+					ScheduleID: "s1",
+				}).Return(describeResponse, nil)
+			},
+			expectedStr: "maintenance",
+		},
+		{
+			name:    "describe with recent actions",
+			cmdline: "cadence --transport grpc --domain test-domain schedule describe --schedule_id s1",
+			setupMocks: func(client *frontend.MockClient) {
+				withActions := *describeResponse
+				withActions.Info = &types.ScheduleInfo{RecentActions: []*types.ScheduleActionResult{
+					{WorkflowID: "report-workflow-1", RunID: "run-1", CloseStatus: types.WorkflowExecutionCloseStatusFailed.Ptr()},
+				}}
+				client.EXPECT().DescribeSchedule(gomock.Any(), gomock.Any()).Return(&withActions, nil)
+			},
+			expectedStr: "FAILED",
+		},
+		{
+			name:    "describe as json",
+			cmdline: "cadence --transport grpc --domain test-domain schedule describe --schedule_id s1 --format json",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().DescribeSchedule(gomock.Any(), gomock.Any()).Return(describeResponse, nil)
+			},
+			expectedStr: `"cronExpression": "*/5 * * * *"`,
+		},
+		{
+			name:    "describe failure",
+			cmdline: "cadence --transport grpc --domain test-domain schedule describe --schedule_id s1",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().DescribeSchedule(gomock.Any(), gomock.Any()).Return(nil, errors.New("boom"))
+			},
+			expectedError: "Operation DescribeSchedule failed.",
+		},
+		{
+			name:    "update policies only",
+			cmdline: "cadence --transport grpc --domain test-domain schedule update --schedule_id s1 --catch_up_policy all --catch_up_window 1h",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().UpdateSchedule(gomock.Any(), &types.UpdateScheduleRequest{
+					Domain:     "test-domain",
+					ScheduleID: "s1",
+					Policies:   &types.SchedulePolicies{CatchUpPolicy: types.ScheduleCatchUpPolicyAll, CatchUpWindow: time.Hour},
+				}).Return(&types.UpdateScheduleResponse{}, nil)
+			},
+			expectedStr: "Schedule s1 successfully updated.",
+		},
+		{
+			name:          "update with time zone",
+			cmdline:       "cadence --transport grpc --domain test-domain schedule update --schedule_id s1 --time_zone America/New_York",
+			setupMocks:    func(client *frontend.MockClient) {},
+			expectedError: "not supported by the server yet",
+		},
+		{
+			name:          "update without changes",
+			cmdline:       "cadence --transport grpc --domain test-domain schedule update --schedule_id s1",
+			setupMocks:    func(client *frontend.MockClient) {},
+			expectedError: "Nothing to update",
+		},
+		{
+			name:    "pause",
+			cmdline: "cadence --transport grpc --domain test-domain schedule pause --schedule_id s1 --reason maintenance",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().PauseSchedule(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, req *types.PauseScheduleRequest, _ ...interface{}) (*types.PauseScheduleResponse, error) {
+						assert.Equal(t, "s1", req.ScheduleID)
+						assert.Equal(t, "maintenance", req.Reason)
+						return &types.PauseScheduleResponse{}, nil
+					})
+			},
+			expectedStr: "Schedule s1 successfully paused.",
+		},
+		{
+			name:    "unpause",
+			cmdline: "cadence --transport grpc --domain test-domain schedule unpause --schedule_id s1 --catch_up_policy one",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().UnpauseSchedule(gomock.Any(), &types.UnpauseScheduleRequest{
+					Domain:        "test-domain",
+					ScheduleID:    "s1",
+					CatchUpPolicy: types.ScheduleCatchUpPolicyOne,
+				}).Return(&types.UnpauseScheduleResponse{}, nil)
+			},
+			expectedStr: "Schedule s1 successfully unpaused.",
+		},
+		{
+			name:    "backfill",
+			cmdline: "cadence --transport grpc --domain test-domain schedule backfill --schedule_id s1 --start_time 2026-01-01T00:00:00Z --end_time 2026-01-02T00:00:00Z --overlap_policy concurrent --backfill_id bf",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().BackfillSchedule(gomock.Any(), &types.BackfillScheduleRequest{
+					Domain:        "test-domain",
+					ScheduleID:    "s1",
+					StartTime:     time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+					EndTime:       time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+					OverlapPolicy: types.ScheduleOverlapPolicyConcurrent,
+					BackfillID:    "bf",
+				}).Return(&types.BackfillScheduleResponse{}, nil)
+			},
+			expectedStr: "Backfill for schedule s1 successfully requested.",
+		},
+		{
+			name:          "backfill without end time",
+			cmdline:       "cadence --transport grpc --domain test-domain schedule backfill --schedule_id s1 --start_time 2026-01-01T00:00:00Z",
+			setupMocks:    func(client *frontend.MockClient) {},
+			expectedError: "Required flag not found",
+		},
+		{
+			name:    "trigger",
+			cmdline: "cadence --domain test-domain schedule trigger --schedule_id s1 --overlap_policy concurrent",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, req *types.SignalWorkflowExecutionRequest, _ ...interface{}) error {
+						assert.Equal(t, "test-domain", req.Domain)
+						assert.Equal(t, "cadence-scheduler:s1", req.WorkflowExecution.WorkflowID)
+						assert.Equal(t, scheduler.SignalNameTrigger, req.SignalName)
+						assert.JSONEq(t, `{"overlapPolicy": "CONCURRENT"}`, string(req.Input))
+						assert.NotEmpty(t, req.RequestID)
+						return nil
+					})
+			},
+			expectedStr: "Schedule s1 successfully triggered.",
+		},
+		{
+			name:    "trigger unknown schedule",
+			cmdline: "cadence --domain test-domain schedule trigger --schedule_id s1",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.EntityNotExistsError{})
+			},
+			expectedError: "Schedule s1 not found in domain test-domain.",
+		},
+		{
+			name:          "trigger with invalid overlap policy",
+			cmdline:       "cadence --domain test-domain schedule trigger --schedule_id s1 --overlap_policy sometimes",
+			setupMocks:    func(client *frontend.MockClient) {},
+			expectedError: "Invalid overlap policy",
+		},
+		{
+			name:    "delete",
+			cmdline: "cadence --transport grpc --domain test-domain schedule delete --schedule_id s1",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().DeleteSchedule(gomock.Any(), &types.DeleteScheduleRequest{
+					Domain:     "test-domain",
+					ScheduleID: "s1",
+				}).Return(&types.DeleteScheduleResponse{}, nil)
+			},
+			expectedStr: "Schedule s1 successfully deleted.",
+		},
+		{
+			name:    "list all pages",
+			cmdline: "cadence --transport grpc --domain test-domain schedule list --all",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().ListSchedules(gomock.Any(), &types.ListSchedulesRequest{
+					Domain:   "test-domain",
+					PageSize: 10,
+				}).Return(&types.ListSchedulesResponse{
+					Schedules:     []*types.ScheduleListEntry{{ScheduleID: "first-schedule", CronExpression: "* * * * *"}},
+					NextPageToken: []byte("next"),
+				}, nil)
+				client.EXPECT().ListSchedules(gomock.Any(), &types.ListSchedulesRequest{
+					Domain:        "test-domain",
+					PageSize:      10,
+					NextPageToken: []byte("next"),
+				}).Return(&types.ListSchedulesResponse{
+					Schedules: []*types.ScheduleListEntry{{ScheduleID: "second-schedule"}},
+				}, nil)
+			},
+			expectedStr: "second-schedule",
+		},
+		{
+			name:    "list failure",
+			cmdline: "cadence --transport grpc --domain test-domain schedule list",
+			setupMocks: func(client *frontend.MockClient) {
+				client.EXPECT().ListSchedules(gomock.Any(), gomock.Any()).Return(nil, errors.New("boom"))
+			},
+			expectedError: "Operation ListSchedules failed.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			frontendClient := frontend.NewMockClient(ctrl)
+			tt.setupMocks(frontendClient)
+			ioHandler := &testIOHandler{}
+			app := NewCliApp(&clientFactoryMock{
+				serverFrontendClient: frontendClient,
+			}, WithIOHandler(ioHandler))
+
+			err := clitest.RunCommandLine(t, app, tt.cmdline)
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Contains(t, ioHandler.outputBytes.String(), tt.expectedStr)
+			}
+		})
+	}
+}
+
+func TestParseScheduleInterval(t *testing.T) {
+	tests := map[string]struct {
+		value   string
+		want    *types.ScheduleIntervalSpec
+		wantErr bool
+	}{
+		"every with offset": {
+			value: "every 90m offset 10m",
+			want:  &types.ScheduleIntervalSpec{Every: 90 * time.Minute, Offset: 10 * time.Minute},
+		},
+		"every without offset": {
+			value: "Every 1h",
+			want:  &types.ScheduleIntervalSpec{Every: time.Hour},
+		},
+		"bare duration": {
+			value: "15m",
+			want:  &types.ScheduleIntervalSpec{Every: 15 * time.Minute},
+		},
+		"empty": {
+			value:   "",
+			wantErr: true,
+		},
+		"unknown keyword": {
+			value:   "every 90m after 10m",
+			wantErr: true,
+		},
+		"invalid duration": {
+			value:   "every ninety minutes",
+			wantErr: true,
+		},
+		"invalid offset": {
+			value:   "every 90m offset soon",
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseScheduleInterval(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReadScheduleDefinitionFile(t *testing.T) {
+	expected := &scheduleDefinition{
+		Spec: &types.ScheduleSpec{
+			CronExpression: "0 9 * * 1-5",
+			StartTime:      time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		Action: &types.ScheduleAction{StartWorkflow: &types.StartWorkflowAction{
+			WorkflowType: &types.WorkflowType{Name: "report-workflow"},
+			TaskList:     &types.TaskList{Name: "reports"},
+		}},
+		Policies: &types.SchedulePolicies{OverlapPolicy: types.ScheduleOverlapPolicySkipNew},
+	}
+
+	tests := map[string]struct {
+		fileName string
+		content  string
+		wantErr  bool
+	}{
+		"json": {
+			fileName: "schedule.json",
+			content: `{"spec": {"cronExpression": "0 9 * * 1-5", "startTime": "2026-01-01T00:00:00Z"},
+				"action": {"startWorkflow": {"workflowType": {"name": "report-workflow"}, "taskList": {"name": "reports"}}},
+				"policies": {"overlapPolicy": "SKIP_NEW"}}`,
+		},
+		"yaml": {
+			fileName: "schedule.yaml",
+			content: `
+spec:
+  cronExpression: "0 9 * * 1-5"
+  startTime: "2026-01-01T00:00:00Z"
+action:
+  startWorkflow:
+    workflowType:
+      name: report-workflow
+    taskList:
+      name: reports
+policies:
+  overlapPolicy: skip_new
+`,
+		},
+		"invalid yaml": {
+			fileName: "schedule.yml",
+			content:  "spec: [",
+			wantErr:  true,
+		},
+		"invalid json": {
+			fileName: "schedule.json",
+			content:  "{",
+			wantErr:  true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.fileName)
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+			definition, err := readScheduleDefinitionFile(path)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, expected, definition)
+		})
+	}
+}
@@ -348,7 +348,7 @@ func newWorkflowCommands() []*cli.Command {
 			Name:        "batch",
 			Usage:       "batch operation on a list of workflows from query.",
 			Subcommands: newBatchCommands(),
-			ArgsUsage: "\n\t To make a batch operation use wf batch start command and specify --batch_type to terminate/signal/cancel workflows.\n" +
+			ArgsUsage: "\n\t To make a batch operation use wf batch start command and specify --batch_type to terminate/signal/cancel/reset/delete/refresh_tasks workflows.\n" +
 				"\t ex: to batch terminate workflows run: cadence batch start --batch_type terminate --query <targeted_workflows_query>\n" +
 				"\t cadence wf batch terminate - is used to terminate a batch operation not workflows.\n" +
 				"\t To inspect the progress run: cadence wf batch desc --job_id <your_job_id>",
@@ -492,6 +492,14 @@ func newBatchCommands() []*cli.Command {
 					Usage:   "Types supported: " + strings.Join(batcher.AllBatchTypes, ","),
 				},
 				// below are optional
+				&cli.BoolFlag{
+					Name:  FlagDryRun,
+					Usage: "Only count the workflows matching the query and sample a page of them without operating on them, the result is shown by batch desc",
+				},
+				&cli.StringFlag{
+					Name:  FlagRetryFailedJobID,
+					Usage: "Operate on the workflows that failed in the batch job of this job ID instead of the ones matching the query",
+				},
 				&cli.StringFlag{
 					Name:    FlagSignalName,
 					Aliases: []string{"sn"},
@@ -512,6 +520,50 @@ func newBatchCommands() []*cli.Command {
 					Aliases: []string{"tc"},
 					Usage:   "Required for batch replicate",
 				},
+				&cli.StringFlag{
+					Name:  FlagResetType,
+					Usage: "Required for batch reset, where to reset. Support one of these: " + strings.Join(batcher.AllResetTypes, ","),
+				},
+				&cli.IntFlag{
+					Name:  FlagDecisionOffset,
+					Usage: "Optional for batch reset, moves the reset point of LastDecisionCompleted and LastDecisionScheduled back by the number of decisions. Only zero or negative numbers are supported.",
+				},
+				&cli.StringFlag{
+					Name:  FlagResetBadBinaryChecksum,
+					Usage: "Binary checksum for batch reset with resetType of BadBinary",
+				},
+				&cli.StringFlag{
+					Name:    FlagEarliestTime,
+					Aliases: []string{"et"},
+					Usage: "EarliestTime of decision start time, required for batch reset with resetType of DecisionCompletedTime. " +
+						"Supported formats are '2006-01-02T15:04:05+07:00', raw UnixNano and time range (N<duration>), " +
+						"for example, '15m' resets to the first decision that completed in last 15 minutes.",
+				},
+				&cli.BoolFlag{
+					Name:  FlagSkipSignalReapply,
+					Usage: "Optional for batch reset, whether or not skipping signals reapply after the reset point",
+				},
+				&cli.BoolFlag{
+					Name:  FlagSkipCurrentOpen,
+					Usage: "Optional for batch reset, skip the workflow if the current run is open",
+				},
+				&cli.BoolFlag{
+					Name:  FlagSkipCurrentCompleted,
+					Usage: "Optional for batch reset, skip the workflow if the current run is completed",
+				},
+				&cli.BoolFlag{
+					Name:  FlagSkipBaseIsNotCurrent,
+					Usage: "Optional for batch reset, skip the workflow if the run returned by the query is not the current run",
+				},
+				&cli.BoolFlag{
+					Name:  FlagNonDeterministicOnly,
+					Usage: "Optional for batch reset, only reset workflows whose last decision failed with non deterministic error",
+				},
+				&cli.BoolFlag{
+					Name:    FlagSkipErrorMode,
+					Aliases: []string{"serr"},
+					Usage:   "Optional for batch delete, skip errors and try to delete as much as possible from the DB",
+				},
 				&cli.IntFlag{
 					Name:  FlagRPS,
 					Value: batcher.DefaultRPS,
@@ -526,6 +578,11 @@ func newBatchCommands() []*cli.Command {
 					Value: batcher.DefaultPageSize,
 					Usage: "PageSize of processiing",
 				},
+				&cli.IntFlag{
+					Name:  FlagPagesPerRun,
+					Value: batcher.DefaultPagesPerRun,
+					Usage: "Number of pages processed before the batch workflow continues as new",
+				},
 				&cli.IntFlag{
 					Name:  FlagRetryAttempts,
 					Value: batcher.DefaultAttemptsOnRetryableError,
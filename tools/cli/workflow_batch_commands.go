@@ -22,6 +22,7 @@ package cli
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -31,6 +32,7 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/urfave/cli/v2"
 
+	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/types"
@@ -116,6 +118,11 @@ func DescribeBatchJob(c *cli.Context) error {
 			output["msg"] = "batch job stopped status: " + wf.WorkflowExecutionInfo.GetCloseStatus().String()
 		} else {
 			output["msg"] = "batch job is finished successfully"
+			result, err := getBatchJobResult(tcCtx, svcClient, wf.WorkflowExecutionInfo.GetExecution())
+			if err != nil {
+				return commoncli.Problem("Failed to get batch job result", err)
+			}
+			output["result"] = result
 		}
 	} else {
 		output["msg"] = "batch job is running"
@@ -133,6 +140,32 @@ func DescribeBatchJob(c *cli.Context) error {
 	return nil
 }
 
+// getBatchJobResult returns the result of a completed batch job, which includes the counters,
+// the recent failures and the sample of a dry run
+func getBatchJobResult(ctx context.Context, svcClient frontend.Client, execution *types.WorkflowExecution) (batcher.HeartBeatDetails, error) {
+	resp, err := svcClient.GetWorkflowExecutionHistory(ctx, &types.GetWorkflowExecutionHistoryRequest{
+		Domain:                 constants.BatcherLocalDomainName,
+		Execution:              execution,
+		HistoryEventFilterType: types.HistoryEventFilterTypeCloseEvent.Ptr(),
+	})
+	if err != nil {
+		return batcher.HeartBeatDetails{}, err
+	}
+	events := resp.GetHistory().GetEvents()
+	if len(events) == 0 {
+		return batcher.HeartBeatDetails{}, fmt.Errorf("close event of batch job is not found")
+	}
+	attributes := events[len(events)-1].GetWorkflowExecutionCompletedEventAttributes()
+	if attributes == nil {
+		return batcher.HeartBeatDetails{}, fmt.Errorf("batch job is not completed successfully")
+	}
+	var result batcher.HeartBeatDetails
+	if err := json.Unmarshal(attributes.Result, &result); err != nil {
+		return batcher.HeartBeatDetails{}, err
+	}
+	return result, nil
+}
+
 // ListBatchJobs list the started batch jobs
 func ListBatchJobs(c *cli.Context) error {
 	domain, err := getRequiredOption(c, FlagDomain)
@@ -190,9 +223,13 @@ func StartBatchJob(c *cli.Context) error {
 	if err != nil {
 		return commoncli.Problem("Required flag not found: ", err)
 	}
-	query, err := getRequiredOption(c, FlagListQuery)
-	if err != nil {
-		return commoncli.Problem("Required flag not found: ", err)
+	retryFailedJobID := c.String(FlagRetryFailedJobID)
+	query := c.String(FlagListQuery)
+	if retryFailedJobID == "" {
+		query, err = getRequiredOption(c, FlagListQuery)
+		if err != nil {
+			return commoncli.Problem("Required flag not found: ", err)
+		}
 	}
 	reason, err := getRequiredOption(c, FlagReason)
 	if err != nil {
@@ -228,8 +265,34 @@ func StartBatchJob(c *cli.Context) error {
 			return commoncli.Problem("Required flag not found: ", err)
 		}
 	}
+	var resetParams batcher.ResetParams
+	if batchType == batcher.BatchTypeReset {
+		resetType, err := getRequiredOption(c, FlagResetType)
+		if err != nil {
+			return commoncli.Problem("Required flag not found: ", err)
+		}
+		if !validateResetType(resetType) {
+			return commoncli.Problem("resetType is not valid, supported:"+strings.Join(batcher.AllResetTypes, ","), nil)
+		}
+		earliestTime, err := parseTime(c.String(FlagEarliestTime), 0)
+		if err != nil {
+			return commoncli.Problem("Failed to parse earliest time", err)
+		}
+		resetParams = batcher.ResetParams{
+			ResetType:            resetType,
+			DecisionOffset:       c.Int(FlagDecisionOffset),
+			BadBinaryChecksum:    c.String(FlagResetBadBinaryChecksum),
+			EarliestTime:         earliestTime,
+			SkipSignalReapply:    c.Bool(FlagSkipSignalReapply),
+			SkipCurrentOpen:      c.Bool(FlagSkipCurrentOpen),
+			SkipCurrentCompleted: c.Bool(FlagSkipCurrentCompleted),
+			SkipBaseNotCurrent:   c.Bool(FlagSkipBaseIsNotCurrent),
+			NonDeterministicOnly: c.Bool(FlagNonDeterministicOnly),
+		}
+	}
 	rps := c.Int(FlagRPS)
 	pageSize := c.Int(FlagPageSize)
+	pagesPerRun := c.Int(FlagPagesPerRun)
 	concurrency := c.Int(FlagConcurrency)
 	retryAttempt := c.Int(FlagRetryAttempts)
 	heartBeatTimeout := time.Duration(c.Int(FlagActivityHeartBeatTimeout)) * time.Second
@@ -245,18 +308,30 @@ func StartBatchJob(c *cli.Context) error {
 		return commoncli.Problem("Error in creating context:", err)
 	}
 
-	resp, err := svcClient.CountWorkflowExecutions(
-		tcCtx,
-		&types.CountWorkflowExecutionsRequest{
-			Domain: domain,
-			Query:  query,
-		},
-	)
-	if err != nil {
-		return commoncli.Problem("Failed to count impacting workflows for starting a batch job", err)
+	dryRun := c.Bool(FlagDryRun)
+	var retryFailedPageCount int
+	if retryFailedJobID != "" {
+		retriedResult, err := getBatchJobResult(tcCtx, svcClient, &types.WorkflowExecution{WorkflowID: retryFailedJobID})
+		if err != nil {
+			return commoncli.Problem("Failed to get the result of the batch job to retry", err)
+		}
+		retryFailedPageCount = retriedResult.CurrentPage
+		fmt.Printf("This batch job will be operating on the %v failed workflows of batch job %v.\n", retriedResult.ErrorCount, retryFailedJobID)
+	} else {
+		resp, err := svcClient.CountWorkflowExecutions(
+			tcCtx,
+			&types.CountWorkflowExecutionsRequest{
+				Domain: domain,
+				Query:  query,
+			},
+		)
+		if err != nil {
+			return commoncli.Problem("Failed to count impacting workflows for starting a batch job", err)
+		}
+		fmt.Printf("This batch job will be operating on %v workflows.\n", resp.GetCount())
 	}
-	fmt.Printf("This batch job will be operating on %v workflows.\n", resp.GetCount())
-	if !c.Bool(FlagYes) {
+	// dry run doesn't operate on any workflow, so no confirmation is needed
+	if !c.Bool(FlagYes) && !dryRun {
 		reader := bufio.NewReader(os.Stdin)
 		for {
 			fmt.Print("Please confirm[Yes/No]:")
@@ -280,10 +355,13 @@ func StartBatchJob(c *cli.Context) error {
 	}
 
 	params := batcher.BatchParams{
-		DomainName: domain,
-		Query:      query,
-		Reason:     reason,
-		BatchType:  batchType,
+		DomainName:           domain,
+		Query:                query,
+		Reason:               reason,
+		BatchType:            batchType,
+		DryRun:               dryRun,
+		RetryFailedJobID:     retryFailedJobID,
+		RetryFailedPageCount: retryFailedPageCount,
 		SignalParams: batcher.SignalParams{
 			SignalName: sigName,
 			Input:      sigVal,
@@ -292,9 +370,14 @@ func StartBatchJob(c *cli.Context) error {
 			SourceCluster: sourceCluster,
 			TargetCluster: targetCluster,
 		},
+		ResetParams: resetParams,
+		DeleteParams: batcher.DeleteParams{
+			SkipErrors: c.Bool(FlagSkipErrorMode),
+		},
 		RPS:                      rps,
 		Concurrency:              concurrency,
 		PageSize:                 pageSize,
+		PagesPerRun:              pagesPerRun,
 		AttemptsOnRetryableError: retryAttempt,
 		ActivityHeartBeatTimeout: heartBeatTimeout,
 		MaxActivityRetries:       maxActivityRetries,
@@ -339,8 +422,12 @@ func StartBatchJob(c *cli.Context) error {
 	if err != nil {
 		return commoncli.Problem("Failed to start batch job", err)
 	}
+	msg := "batch job is started"
+	if dryRun {
+		msg = "dry run batch job is started, describe the job to see the sample of the workflows"
+	}
 	output := map[string]interface{}{
-		"msg":   "batch job is started",
+		"msg":   msg,
 		"jobID": workflowID,
 	}
 	prettyPrintJSONObject(getDeps(c).Output(), output)
@@ -365,3 +452,12 @@ func validateBatchType(bt string) bool {
 	}
 	return false
 }
+
+func validateResetType(rt string) bool {
+	for _, r := range batcher.AllResetTypes {
+		if r == rt {
+			return true
+		}
+	}
+	return false
+}
@@ -21,17 +21,21 @@
 package cli
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"flag"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli/v2"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/batcher"
 )
@@ -128,7 +132,140 @@ func TestStartBatchJob(t *testing.T) {
 				FlagReason:    "Testing batch job",
 				FlagBatchType: "invalidBatchType",
 			},
-			expectedError: "batchType is not valid, supported:terminate,cancel,signal,replicate",
+			expectedError: "batchType is not valid, supported:terminate,cancel,signal,replicate,reset,delete,refresh_tasks",
+		},
+		{
+			name: "Valid Start Reset Batch Job",
+			setup: func(mockClient *frontend.MockClient) {
+				mockClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{
+					Count: 100,
+				}, nil)
+				mockClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.StartWorkflowExecutionResponse{
+					RunID: "run-id-example",
+				}, nil)
+			},
+			flags: map[string]interface{}{
+				FlagDomain:          "test-domain",
+				FlagListQuery:       "workflowType='batch'",
+				FlagReason:          "Testing batch job",
+				FlagBatchType:       batcher.BatchTypeReset,
+				FlagResetType:       batcher.ResetTypeLastDecisionCompleted,
+				FlagDecisionOffset:  -1,
+				FlagSkipCurrentOpen: true,
+				FlagYes:             true,
+			},
+			expectedError:  "",
+			expectedOutput: "batch job is started",
+		},
+		{
+			name: "Valid Start Dry Run Batch Job",
+			setup: func(mockClient *frontend.MockClient) {
+				mockClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{
+					Count: 100,
+				}, nil)
+				mockClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.StartWorkflowExecutionResponse{
+					RunID: "run-id-example",
+				}, nil)
+			},
+			flags: map[string]interface{}{
+				FlagDomain:    "test-domain",
+				FlagListQuery: "workflowType='batch'",
+				FlagReason:    "Testing batch job",
+				FlagBatchType: batcher.BatchTypeTerminate,
+				FlagDryRun:    true,
+			},
+			expectedError:  "",
+			expectedOutput: "dry run batch job is started, describe the job to see the sample of the workflows",
+		},
+		{
+			name: "Valid Start Retry Failed Batch Job",
+			setup: func(mockClient *frontend.MockClient) {
+				mockClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
+					Domain:                 constants.BatcherLocalDomainName,
+					Execution:              &types.WorkflowExecution{WorkflowID: "failed-job-id"},
+					HistoryEventFilterType: types.HistoryEventFilterTypeCloseEvent.Ptr(),
+				}).Return(&types.GetWorkflowExecutionHistoryResponse{
+					History: &types.History{Events: []*types.HistoryEvent{{
+						WorkflowExecutionCompletedEventAttributes: &types.WorkflowExecutionCompletedEventAttributes{
+							Result: []byte(`{"CurrentPage": 3, "SuccessCount": 10, "ErrorCount": 2}`),
+						},
+					}}},
+				}, nil)
+				mockClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, req *types.StartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						var params batcher.BatchParams
+						if err := json.Unmarshal(req.Input, &params); err != nil {
+							return nil, err
+						}
+						if params.RetryFailedJobID != "failed-job-id" || params.RetryFailedPageCount != 3 {
+							return nil, fmt.Errorf("unexpected retry params %q %v", params.RetryFailedJobID, params.RetryFailedPageCount)
+						}
+						return &types.StartWorkflowExecutionResponse{RunID: "run-id-example"}, nil
+					})
+			},
+			flags: map[string]interface{}{
+				FlagDomain:           "test-domain",
+				FlagReason:           "Testing batch job",
+				FlagBatchType:        batcher.BatchTypeTerminate,
+				FlagRetryFailedJobID: "failed-job-id",
+				FlagYes:              true,
+			},
+			expectedError:  "",
+			expectedOutput: "batch job is started",
+		},
+		{
+			name:  "Missing Reset Type",
+			setup: func(mockClient *frontend.MockClient) {},
+			flags: map[string]interface{}{
+				FlagDomain:    "test-domain",
+				FlagListQuery: "workflowType='batch'",
+				FlagReason:    "Testing batch job",
+				FlagBatchType: batcher.BatchTypeReset,
+			},
+			expectedError: "Required flag not found: : option reset_type is required",
+		},
+		{
+			name:  "Invalid Reset Type",
+			setup: func(mockClient *frontend.MockClient) {},
+			flags: map[string]interface{}{
+				FlagDomain:    "test-domain",
+				FlagListQuery: "workflowType='batch'",
+				FlagReason:    "Testing batch job",
+				FlagBatchType: batcher.BatchTypeReset,
+				FlagResetType: "invalidResetType",
+			},
+			expectedError: "resetType is not valid",
+		},
+		{
+			name:  "Invalid Earliest Time",
+			setup: func(mockClient *frontend.MockClient) {},
+			flags: map[string]interface{}{
+				FlagDomain:       "test-domain",
+				FlagListQuery:    "workflowType='batch'",
+				FlagReason:       "Testing batch job",
+				FlagBatchType:    batcher.BatchTypeReset,
+				FlagResetType:    batcher.ResetTypeDecisionCompletedTime,
+				FlagEarliestTime: "invalid-time",
+			},
+			expectedError: "Failed to parse earliest time",
+		},
+		{
+			name: "Retry Failed Batch Job Not Completed",
+			setup: func(mockClient *frontend.MockClient) {
+				mockClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
+					History: &types.History{Events: []*types.HistoryEvent{{
+						WorkflowExecutionFailedEventAttributes: &types.WorkflowExecutionFailedEventAttributes{},
+					}}},
+				}, nil)
+			},
+			flags: map[string]interface{}{
+				FlagDomain:           "test-domain",
+				FlagReason:           "Testing batch job",
+				FlagBatchType:        batcher.BatchTypeTerminate,
+				FlagRetryFailedJobID: "failed-job-id",
+				FlagYes:              true,
+			},
+			expectedError: "Failed to get the result of the batch job to retry",
 		},
 		{
 			name: "Count Workflow Executions Failure",
@@ -320,6 +457,18 @@ func TestDescribeBatchJob(t *testing.T) {
 						},
 					},
 				}, nil)
+				mockClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
+					Domain:                 constants.BatcherLocalDomainName,
+					Execution:              &types.WorkflowExecution{WorkflowID: "example-workflow-1"},
+					HistoryEventFilterType: types.HistoryEventFilterTypeCloseEvent.Ptr(),
+				}).Return(&types.GetWorkflowExecutionHistoryResponse{
+					History: &types.History{Events: []*types.HistoryEvent{{
+						WorkflowExecutionCompletedEventAttributes: &types.WorkflowExecutionCompletedEventAttributes{
+							Result: []byte(`{"CurrentPage": 10, "TotalEstimate": 100, "SuccessCount": 97, "ErrorCount": 1, "SkippedCount": 2,` +
+								`"RecentFailures": [{"WorkflowID": "wid", "RunID": "rid", "Outcome": "failed", "Reason": "some error"}]}`),
+						},
+					}}},
+				}, nil)
 			},
 			flags: map[string]interface{}{
 				FlagJobID: "example-workflow-1",
@@ -327,7 +476,35 @@ func TestDescribeBatchJob(t *testing.T) {
 			expectedError: "",
 			expectedOutput: map[string]interface{}{
 				"msg": "batch job is finished successfully",
+				"result": batcher.HeartBeatDetails{
+					CurrentPage:   10,
+					TotalEstimate: 100,
+					SuccessCount:  97,
+					ErrorCount:    1,
+					SkippedCount:  2,
+					RecentFailures: []batcher.WorkflowOutcome{
+						{WorkflowID: "wid", RunID: "rid", Outcome: batcher.OutcomeFailed, Reason: "some error"},
+					},
+				},
+			},
+		},
+		{
+			name: "Error when getting result",
+			setup: func(mockClient *frontend.MockClient) {
+				mockClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
+					WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+						CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+						Execution: &types.WorkflowExecution{
+							WorkflowID: "example-workflow-1",
+						},
+					},
+				}, nil)
+				mockClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(nil, errors.New("service error"))
+			},
+			flags: map[string]interface{}{
+				FlagJobID: "example-workflow-1",
 			},
+			expectedError: "Failed to get batch job result: service error",
 		},
 		{
 			name: "Batch Job Running",
@@ -411,12 +588,14 @@ func TestDescribeBatchJob(t *testing.T) {
 				var actualOutput map[string]interface{}
 				err = json.Unmarshal(ioHandler.outputBytes.Bytes(), &actualOutput)
 				assert.NoError(t, err)
-				if progressRaw, exists := actualOutput["progress"]; exists {
-					var progress batcher.HeartBeatDetails
-					progressBytes, err := json.Marshal(progressRaw)
-					if err == nil {
-						json.Unmarshal(progressBytes, &progress)
-						actualOutput["progress"] = progress
+				for _, key := range []string{"progress", "result"} {
+					if raw, exists := actualOutput[key]; exists {
+						var hbd batcher.HeartBeatDetails
+						hbdBytes, err := json.Marshal(raw)
+						if err == nil {
+							json.Unmarshal(hbdBytes, &hbd)
+							actualOutput[key] = hbd
+						}
 					}
 				}
 				assert.Equal(t, tt.expectedOutput, actualOutput)
@@ -27,7 +27,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"math"
 	"math/rand"
 	"os"
 	"regexp"
@@ -43,9 +42,8 @@ import (
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
-	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/resetpoint"
 	"github.com/uber/cadence/common/types"
-	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/tools/common/commoncli"
 )
 
@@ -2238,9 +2236,9 @@ func doReset(c *cli.Context, domain, wid, rid string, params batchResetParamsTyp
 	}
 
 	if params.nonDeterministicOnly {
-		isLDN, err := isLastEventDecisionTaskFailedWithNonDeterminism(ctx, domain, wid, rid, frontendClient)
+		isLDN, err := resetpoint.IsLastDecisionFailedWithNonDeterminism(ctx, frontendClient, domain, wid, rid)
 		if err != nil {
-			return printErrorAndReturn("check isLastEventDecisionTaskFailedWithNonDeterminism failed", err)
+			return printErrorAndReturn("check IsLastDecisionFailedWithNonDeterminism failed", err)
 		}
 		if !isLDN {
 			fmt.Println("skip because last event is not DecisionTaskFailedWithNonDeterminism")
@@ -2278,52 +2276,6 @@ func doReset(c *cli.Context, domain, wid, rid string, params batchResetParamsTyp
 	return nil
 }
 
-func isLastEventDecisionTaskFailedWithNonDeterminism(ctx context.Context, domain, wid, rid string, frontendClient frontend.Client) (bool, error) {
-	req := &types.GetWorkflowExecutionHistoryRequest{
-		Domain: domain,
-		Execution: &types.WorkflowExecution{
-			WorkflowID: wid,
-			RunID:      rid,
-		},
-		MaximumPageSize: 1000,
-		NextPageToken:   nil,
-	}
-
-	var firstEvent, decisionFailed *types.HistoryEvent
-	for {
-		resp, err := frontendClient.GetWorkflowExecutionHistory(ctx, req)
-		if err != nil {
-			return false, printErrorAndReturn("GetWorkflowExecutionHistory failed", err)
-		}
-		for _, e := range resp.GetHistory().GetEvents() {
-			if firstEvent == nil {
-				firstEvent = e
-			}
-			if e.GetEventType() == types.EventTypeDecisionTaskFailed {
-				decisionFailed = e
-			} else if e.GetEventType() == types.EventTypeDecisionTaskCompleted {
-				decisionFailed = nil
-			}
-		}
-		if len(resp.NextPageToken) != 0 {
-			req.NextPageToken = resp.NextPageToken
-		} else {
-			break
-		}
-	}
-
-	if decisionFailed != nil {
-		attr := decisionFailed.GetDecisionTaskFailedEventAttributes()
-		if attr.GetCause() == types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure ||
-			strings.Contains(string(attr.GetDetails()), "nondeterministic") {
-			fmt.Printf("found non-deterministic workflow wid:%v, rid:%v, originalStartTime:%v \n", wid, rid, time.Unix(0, firstEvent.GetTimestamp()))
-			return true, nil
-		}
-	}
-
-	return false, nil
-}
-
 func getResetEventIDByType(
 	ctx context.Context,
 	c *cli.Context,
@@ -2331,101 +2283,19 @@ func getResetEventIDByType(
 	domain, wid, rid string,
 	frontendClient frontend.Client,
 ) (resetBaseRunID string, decisionFinishID int64, err error) {
-	// default to the same runID
-	resetBaseRunID = rid
-
 	fmt.Println("resetType:", resetType)
-	switch resetType {
-	case resetTypeLastDecisionCompleted:
-		decisionFinishID, err = getLastDecisionTaskByType(ctx, domain, wid, rid, frontendClient, types.EventTypeDecisionTaskCompleted, decisionOffset)
-		if err != nil {
-			return
-		}
-	case resetTypeLastContinuedAsNew:
-		// this reset type may change the base runID
-		resetBaseRunID, decisionFinishID, err = getLastContinueAsNewID(ctx, domain, wid, rid, frontendClient)
-		if err != nil {
-			return
-		}
-	case resetTypeFirstDecisionCompleted:
-		decisionFinishID, err = getFirstDecisionTaskByType(ctx, domain, wid, rid, frontendClient, types.EventTypeDecisionTaskCompleted)
-		if err != nil {
-			return
-		}
-	case resetTypeBadBinary:
-		binCheckSum := c.String(FlagResetBadBinaryChecksum)
-		decisionFinishID, err = getBadDecisionCompletedID(ctx, domain, wid, rid, binCheckSum, frontendClient)
-		if err != nil {
-			return
-		}
-	case resetTypeDecisionCompletedTime:
-		earliestTime, err := parseTime(c.String(FlagEarliestTime), 0)
-		if err != nil {
-			return "", 0, fmt.Errorf("Get reset event id by type failed: %w", err)
-		}
-		decisionFinishID, err = getEarliestDecisionID(ctx, domain, wid, rid, earliestTime, frontendClient)
-		if err != nil {
-			return "", 0, fmt.Errorf("Get reset event id by type failed: %w", err)
-		}
-	case resetTypeFirstDecisionScheduled:
-		decisionFinishID, err = getFirstDecisionTaskByType(ctx, domain, wid, rid, frontendClient, types.EventTypeDecisionTaskScheduled)
-		if err != nil {
-			return
-		}
-		// decisionFinishID is exclusive in reset API
-		decisionFinishID++
-	case resetTypeLastDecisionScheduled:
-		decisionFinishID, err = getLastDecisionTaskByType(ctx, domain, wid, rid, frontendClient, types.EventTypeDecisionTaskScheduled, decisionOffset)
-		if err != nil {
-			return
-		}
-		// decisionFinishID is exclusive in reset API
-		decisionFinishID++
-	default:
-		panic("not supported resetType")
-	}
-	return
-}
-
-func getFirstDecisionTaskByType(
-	ctx context.Context,
-	domain string,
-	workflowID string,
-	runID string,
-	frontendClient frontend.Client,
-	decisionType types.EventType,
-) (decisionFinishID int64, err error) {
-
-	req := &types.GetWorkflowExecutionHistoryRequest{
-		Domain: domain,
-		Execution: &types.WorkflowExecution{
-			WorkflowID: workflowID,
-			RunID:      runID,
-		},
-		MaximumPageSize: 1000,
-		NextPageToken:   nil,
+	params := resetpoint.Params{
+		Type:              resetType,
+		DecisionOffset:    decisionOffset,
+		BadBinaryChecksum: c.String(FlagResetBadBinaryChecksum),
 	}
-
-	for {
-		resp, err := frontendClient.GetWorkflowExecutionHistory(ctx, req)
+	if resetType == resetTypeDecisionCompletedTime {
+		params.EarliestTime, err = parseTime(c.String(FlagEarliestTime), 0)
 		if err != nil {
-			return 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", err)
-		}
-
-		for _, e := range resp.GetHistory().GetEvents() {
-			if e.GetEventType() == decisionType {
-				decisionFinishID = e.ID
-				return decisionFinishID, nil
-			}
-		}
-
-		if len(resp.NextPageToken) != 0 {
-			req.NextPageToken = resp.NextPageToken
-		} else {
-			break
+			return "", 0, fmt.Errorf("Get reset event id by type failed: %w", err)
 		}
 	}
-	return decisionFinishID, printErrorAndReturn("Get DecisionFinishID failed", fmt.Errorf("no DecisionFinishID"))
+	return resetpoint.Get(ctx, frontendClient, domain, wid, rid, params)
 }
 
 func getCurrentRunID(ctx context.Context, domain, wid string, frontendClient frontend.Client) (string, error) {
@@ -2441,137 +2311,6 @@ func getCurrentRunID(ctx context.Context, domain, wid string, frontendClient fro
 	return resp.WorkflowExecutionInfo.Execution.GetRunID(), nil
 }
 
-func getBadDecisionCompletedID(ctx context.Context, domain, wid, rid, binChecksum string, frontendClient frontend.Client) (decisionFinishID int64, err error) {
-	resp, err := frontendClient.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
-		Domain: domain,
-		Execution: &types.WorkflowExecution{
-			WorkflowID: wid,
-			RunID:      rid,
-		},
-	})
-	if err != nil {
-		return 0, printErrorAndReturn("DescribeWorkflowExecution failed", err)
-	}
-
-	_, p := execution.FindAutoResetPoint(clock.NewRealTimeSource(), &types.BadBinaries{
-		Binaries: map[string]*types.BadBinaryInfo{
-			binChecksum: {},
-		},
-	}, resp.WorkflowExecutionInfo.AutoResetPoints)
-	if p != nil {
-		decisionFinishID = p.GetFirstDecisionCompletedID()
-	}
-
-	if decisionFinishID == 0 {
-		return 0, printErrorAndReturn("Get DecisionFinishID failed", &types.BadRequestError{Message: "no DecisionFinishID"})
-	}
-	return
-}
-
-func getLastDecisionTaskByType(
-	ctx context.Context,
-	domain string,
-	workflowID string,
-	runID string,
-	frontendClient frontend.Client,
-	decisionType types.EventType,
-	decisionOffset int,
-) (int64, error) {
-
-	// this fixedSizeQueue is for remembering the offset decision eventID
-	fixedSizeQueue := make([]int64, 0)
-	size := int(math.Abs(float64(decisionOffset))) + 1
-
-	req := &types.GetWorkflowExecutionHistoryRequest{
-		Domain: domain,
-		Execution: &types.WorkflowExecution{
-			WorkflowID: workflowID,
-			RunID:      runID,
-		},
-		MaximumPageSize: 1000,
-		NextPageToken:   nil,
-	}
-
-	for {
-		resp, err := frontendClient.GetWorkflowExecutionHistory(ctx, req)
-		if err != nil {
-			return 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", err)
-		}
-
-		for _, e := range resp.GetHistory().GetEvents() {
-			if e.GetEventType() == decisionType {
-				decisionEventID := e.ID
-				fixedSizeQueue = append(fixedSizeQueue, decisionEventID)
-				if len(fixedSizeQueue) > size {
-					fixedSizeQueue = fixedSizeQueue[1:]
-				}
-			}
-		}
-
-		if len(resp.NextPageToken) != 0 {
-			req.NextPageToken = resp.NextPageToken
-		} else {
-			break
-		}
-	}
-	if len(fixedSizeQueue) == 0 {
-		return 0, printErrorAndReturn("Get DecisionFinishID failed", fmt.Errorf("no DecisionFinishID"))
-	}
-	return fixedSizeQueue[0], nil
-}
-
-func getLastContinueAsNewID(ctx context.Context, domain, wid, rid string, frontendClient frontend.Client) (resetBaseRunID string, decisionFinishID int64, err error) {
-	// get first event
-	req := &types.GetWorkflowExecutionHistoryRequest{
-		Domain: domain,
-		Execution: &types.WorkflowExecution{
-			WorkflowID: wid,
-			RunID:      rid,
-		},
-		MaximumPageSize: 1,
-		NextPageToken:   nil,
-	}
-	resp, err := frontendClient.GetWorkflowExecutionHistory(ctx, req)
-	if err != nil {
-		return "", 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", err)
-	}
-	firstEvent := resp.History.Events[0]
-	resetBaseRunID = firstEvent.GetWorkflowExecutionStartedEventAttributes().GetContinuedExecutionRunID()
-	if resetBaseRunID == "" {
-		return "", 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", fmt.Errorf("cannot get resetBaseRunID"))
-	}
-
-	req = &types.GetWorkflowExecutionHistoryRequest{
-		Domain: domain,
-		Execution: &types.WorkflowExecution{
-			WorkflowID: wid,
-			RunID:      resetBaseRunID,
-		},
-		MaximumPageSize: 1000,
-		NextPageToken:   nil,
-	}
-	for {
-		resp, err := frontendClient.GetWorkflowExecutionHistory(ctx, req)
-		if err != nil {
-			return "", 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", err)
-		}
-		for _, e := range resp.GetHistory().GetEvents() {
-			if e.GetEventType() == types.EventTypeDecisionTaskCompleted {
-				decisionFinishID = e.ID
-			}
-		}
-		if len(resp.NextPageToken) != 0 {
-			req.NextPageToken = resp.NextPageToken
-		} else {
-			break
-		}
-	}
-	if decisionFinishID == 0 {
-		return "", 0, printErrorAndReturn("Get DecisionFinishID failed", fmt.Errorf("no DecisionFinishID"))
-	}
-	return
-}
-
 // CompleteActivity completes an activity
 func CompleteActivity(c *cli.Context) error {
 	domain, err := getRequiredOption(c, FlagDomain)
@@ -2697,48 +2436,6 @@ func ObserveHistoryWithID(c *cli.Context) error {
 	return nil
 }
 
-func getEarliestDecisionID(
-	ctx context.Context,
-	domain string, wid string,
-	rid string, earliestTime int64,
-	frontendClient frontend.Client,
-) (decisionFinishID int64, err error) {
-	req := &types.GetWorkflowExecutionHistoryRequest{
-		Domain: domain,
-		Execution: &types.WorkflowExecution{
-			WorkflowID: wid,
-			RunID:      rid,
-		},
-		MaximumPageSize: 1000,
-		NextPageToken:   nil,
-	}
-
-OuterLoop:
-	for {
-		resp, err := frontendClient.GetWorkflowExecutionHistory(ctx, req)
-		if err != nil {
-			return 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", err)
-		}
-		for _, e := range resp.GetHistory().GetEvents() {
-			if e.GetEventType() == types.EventTypeDecisionTaskCompleted {
-				if e.GetTimestamp() >= earliestTime {
-					decisionFinishID = e.ID
-					break OuterLoop
-				}
-			}
-		}
-		if len(resp.NextPageToken) != 0 {
-			req.NextPageToken = resp.NextPageToken
-		} else {
-			break
-		}
-	}
-	if decisionFinishID == 0 {
-		return 0, printErrorAndReturn("Get DecisionFinishID failed", fmt.Errorf("no DecisionFinishID"))
-	}
-	return
-}
-
 func mapQueryConsistencyLevelFromFlag(flag string) (types.QueryConsistencyLevel, error) {
 	var consistencyLevel types.QueryConsistencyLevel
 	switch flag {
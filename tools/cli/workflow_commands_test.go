@@ -1576,75 +1576,6 @@ func Test_ResetInBatch_InvalidexcludeFile(t *testing.T) {
 	assert.Error(t, err)
 }
 
-func Test_GetFirstDecisionTaskByType(t *testing.T) {
-	mockCtrl := gomock.NewController(t)
-	serverFrontendClient := frontend.NewMockClient(mockCtrl)
-	serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
-		Domain: "test-domain",
-		Execution: &types.WorkflowExecution{
-			WorkflowID: "test-workflow-id",
-			RunID:      "test-run-id",
-		},
-		MaximumPageSize: 1000,
-	}).Return(&types.GetWorkflowExecutionHistoryResponse{
-		History: &types.History{
-			Events: []*types.HistoryEvent{
-				{
-					ID:        1,
-					EventType: types.EventTypeDecisionTaskCompleted.Ptr(),
-				},
-			},
-		},
-	}, nil).Times(1)
-	// get desision type successfully
-	decisionFinishID, err := getFirstDecisionTaskByType(context.Background(), "test-domain", "test-workflow-id", "test-run-id", serverFrontendClient, 6)
-	assert.Equal(t, int64(1), decisionFinishID)
-	assert.NoError(t, err)
-
-	// failed to get workflow history
-	serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(nil, errors.New("test-error")).Times(1)
-	decisionFinishID, err = getFirstDecisionTaskByType(context.Background(), "test-domain", "test-workflow-id", "test-run-id", serverFrontendClient, 6)
-	assert.Equal(t, int64(0), decisionFinishID)
-	assert.Error(t, err)
-
-	// not found decision task
-	serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
-		Domain: "test-domain",
-		Execution: &types.WorkflowExecution{
-			WorkflowID: "test-workflow-id",
-			RunID:      "test-run-id",
-		},
-		MaximumPageSize: 1000,
-	}).Return(&types.GetWorkflowExecutionHistoryResponse{}, nil).Times(1)
-	decisionFinishID, err = getFirstDecisionTaskByType(context.Background(), "test-domain", "test-workflow-id", "test-run-id", serverFrontendClient, 6)
-	assert.Equal(t, int64(0), decisionFinishID)
-	assert.Error(t, err)
-
-	// next page token
-	serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
-		Domain: "test-domain",
-		Execution: &types.WorkflowExecution{
-			WorkflowID: "test-workflow-id",
-			RunID:      "test-run-id",
-		},
-		MaximumPageSize: 1000,
-	}).Return(&types.GetWorkflowExecutionHistoryResponse{
-		NextPageToken: []byte("test-next-page-token"),
-	}, nil).Times(1)
-	serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
-		Domain: "test-domain",
-		Execution: &types.WorkflowExecution{
-			WorkflowID: "test-workflow-id",
-			RunID:      "test-run-id",
-		},
-		MaximumPageSize: 1000,
-		NextPageToken:   []byte("test-next-page-token"),
-	}).Return(&types.GetWorkflowExecutionHistoryResponse{}, nil).Times(1)
-	decisionFinishID, err = getFirstDecisionTaskByType(context.Background(), "test-domain", "test-workflow-id", "test-run-id", serverFrontendClient, 6)
-	assert.Equal(t, int64(0), decisionFinishID)
-	assert.Error(t, err)
-}
-
 func Test_GetCurrentRunID(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	serverFrontendClient := frontend.NewMockClient(mockCtrl)
@@ -1666,236 +1597,6 @@ func Test_GetCurrentRunID(t *testing.T) {
 	assert.Equal(t, "test-run-id", runID)
 }
 
-func Test_GetBadDecisionCompletedID(t *testing.T) {
-	mockCtrl := gomock.NewController(t)
-	serverFrontendClient := frontend.NewMockClient(mockCtrl)
-	serverFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &types.DescribeWorkflowExecutionRequest{
-		Domain: "test-domain",
-		Execution: &types.WorkflowExecution{
-			WorkflowID: "test-workflow-id",
-			RunID:      "test-run-id",
-		},
-	}).Return(&types.DescribeWorkflowExecutionResponse{}, errors.New("test-error")).Times(1)
-
-	decision, err := getBadDecisionCompletedID(context.Background(), "test-domain", "test-workflow-id", "test-run-id", "", serverFrontendClient)
-	assert.Equal(t, int64(0), decision)
-	assert.Error(t, err)
-
-	serverFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &types.DescribeWorkflowExecutionRequest{
-		Domain: "test-domain",
-		Execution: &types.WorkflowExecution{
-			WorkflowID: "test-workflow-id",
-			RunID:      "test-run-id",
-		},
-	}).Return(&types.DescribeWorkflowExecutionResponse{
-		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
-			AutoResetPoints: &types.ResetPoints{
-				Points: []*types.ResetPointInfo{
-					{
-						BinaryChecksum:           "test-bad-binary-checksum",
-						FirstDecisionCompletedID: 5,
-						Resettable:               true,
-					},
-				},
-			},
-		},
-	}, nil).Times(2)
-	decision, err = getBadDecisionCompletedID(context.Background(), "test-domain", "test-workflow-id", "test-run-id", "", serverFrontendClient)
-	assert.Equal(t, int64(0), decision)
-	assert.Error(t, err)
-
-	decision, err = getBadDecisionCompletedID(context.Background(), "test-domain", "test-workflow-id", "test-run-id", "test-bad-binary-checksum", serverFrontendClient)
-	assert.Equal(t, int64(5), decision)
-	assert.NoError(t, err)
-}
-
-func Test_GetLastContinueAsNewID(t *testing.T) {
-	mockCtrl := gomock.NewController(t)
-	serverFrontendClient := frontend.NewMockClient(mockCtrl)
-	serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
-		Domain: "test-domain",
-		Execution: &types.WorkflowExecution{
-			WorkflowID: "test-workflow-id",
-			RunID:      "test-run-id",
-		},
-		MaximumPageSize: 1,
-	}).Return(nil, errors.New("test-error")).Times(1)
-	// get workflow history failed
-	runID, decisionID, err := getLastContinueAsNewID(context.Background(), "test-domain", "test-workflow-id", "test-run-id", serverFrontendClient)
-	assert.Equal(t, "", runID)
-	assert.Equal(t, int64(0), decisionID)
-	assert.Error(t, err)
-
-	serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
-		History: &types.History{
-			Events: []*types.HistoryEvent{
-				{
-					WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
-						ContinuedExecutionRunID: "",
-					},
-				},
-			},
-		},
-	}, nil).Times(1)
-	// cannot get reset base runID
-	runID, decisionID, err = getLastContinueAsNewID(context.Background(), "test-domain", "test-workflow-id", "test-run-id", serverFrontendClient)
-	assert.Equal(t, "", runID)
-	assert.Equal(t, int64(0), decisionID)
-	assert.Error(t, err)
-
-	serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
-		History: &types.History{
-			Events: []*types.HistoryEvent{
-				{
-					WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
-						ContinuedExecutionRunID: "test-run-id",
-					},
-				},
-			},
-		},
-	}, nil).Times(1)
-	serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
-		Domain: "test-domain",
-		Execution: &types.WorkflowExecution{
-			WorkflowID: "test-workflow-id",
-			RunID:      "test-run-id",
-		},
-		MaximumPageSize: 1000,
-	}).Return(&types.GetWorkflowExecutionHistoryResponse{
-		History: &types.History{
-			Events: []*types.HistoryEvent{
-				{
-					EventType: types.EventTypeDecisionTaskCompleted.Ptr(),
-					ID:        10,
-				},
-			},
-		},
-	}, nil).Times(1)
-	runID, decisionID, err = getLastContinueAsNewID(context.Background(), "test-domain", "test-workflow-id", "test-run-id", serverFrontendClient)
-	assert.Equal(t, "test-run-id", runID)
-	assert.Equal(t, int64(10), decisionID)
-	assert.NoError(t, err)
-
-	serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
-		History: &types.History{
-			Events: []*types.HistoryEvent{
-				{
-					WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
-						ContinuedExecutionRunID: "test-run-id",
-					},
-				},
-			},
-		},
-	}, nil).Times(1)
-	serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
-		Domain: "test-domain",
-		Execution: &types.WorkflowExecution{
-			WorkflowID: "test-workflow-id",
-			RunID:      "test-run-id",
-		},
-		MaximumPageSize: 1000,
-	}).Return(nil, errors.New("test-error")).Times(1)
-	// fail to get workflow history after getting the reset base runID
-	runID, decisionID, err = getLastContinueAsNewID(context.Background(), "test-domain", "test-workflow-id", "test-run-id", serverFrontendClient)
-	assert.Equal(t, "", runID)
-	assert.Equal(t, int64(0), decisionID)
-	assert.Error(t, err)
-
-	serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
-		History: &types.History{
-			Events: []*types.HistoryEvent{
-				{
-					WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
-						ContinuedExecutionRunID: "test-run-id",
-					},
-				},
-			},
-		},
-	}, nil).Times(1)
-	serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
-		History: &types.History{
-			Events: []*types.HistoryEvent{},
-		},
-		NextPageToken: []byte("test-next-page-token"),
-	}, nil).Times(1)
-	serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
-		History: &types.History{
-			Events: []*types.HistoryEvent{},
-		},
-	}, nil).Times(1)
-	// fail to get workflow history after getting the reset base runID
-	runID, decisionID, err = getLastContinueAsNewID(context.Background(), "test-domain", "test-workflow-id", "test-run-id", serverFrontendClient)
-	assert.Equal(t, "", runID)
-	assert.Equal(t, int64(0), decisionID)
-	assert.Error(t, err)
-}
-
-func Test_GetEarliestDecisionID(t *testing.T) {
-	mockCtrl := gomock.NewController(t)
-	serverFrontendClient := frontend.NewMockClient(mockCtrl)
-	serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
-		Domain: "test-domain",
-		Execution: &types.WorkflowExecution{
-			WorkflowID: "test-workflow-id",
-			RunID:      "test-run-id",
-		},
-		MaximumPageSize: 1000,
-	}).Return(nil, errors.New("test-error")).Times(1)
-
-	// get workflow execution history failed
-	decisionID, err := getEarliestDecisionID(context.Background(), "test-domain", "test-workflow-id", "test-run-id", int64(100), serverFrontendClient)
-	assert.Equal(t, int64(0), decisionID)
-	assert.Error(t, err)
-
-	serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
-		History: &types.History{
-			Events: []*types.HistoryEvent{},
-		},
-		NextPageToken: []byte("test-next-page-token"),
-	}, nil).Times(1)
-	serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
-		Domain: "test-domain",
-		Execution: &types.WorkflowExecution{
-			WorkflowID: "test-workflow-id",
-			RunID:      "test-run-id",
-		},
-		MaximumPageSize: 1000,
-		NextPageToken:   []byte("test-next-page-token"),
-	}).Return(&types.GetWorkflowExecutionHistoryResponse{
-		History: &types.History{
-			Events: []*types.HistoryEvent{},
-		},
-	}, nil).Times(1)
-	// no DecisionFinishID
-	decisionID, err = getEarliestDecisionID(context.Background(), "test-domain", "test-workflow-id", "test-run-id", int64(100), serverFrontendClient)
-	assert.Equal(t, int64(0), decisionID)
-	assert.Error(t, err)
-
-	testEarliestTime := int64(110)
-	serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
-		Domain: "test-domain",
-		Execution: &types.WorkflowExecution{
-			WorkflowID: "test-workflow-id",
-			RunID:      "test-run-id",
-		},
-		MaximumPageSize: 1000,
-	}).Return(&types.GetWorkflowExecutionHistoryResponse{
-		History: &types.History{
-			Events: []*types.HistoryEvent{
-				{
-					EventType: types.EventTypeDecisionTaskCompleted.Ptr(),
-					ID:        10,
-					Timestamp: &testEarliestTime,
-				},
-			},
-		},
-	}, nil).Times(1)
-	// no DecisionFinishID
-	decisionID, err = getEarliestDecisionID(context.Background(), "test-domain", "test-workflow-id", "test-run-id", int64(100), serverFrontendClient)
-	assert.Equal(t, int64(10), decisionID)
-	assert.NoError(t, err)
-}
-
 func Test_FailActivity_CompleteActivity_Errors(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	serverFrontendClient := frontend.NewMockClient(mockCtrl)
@@ -2009,7 +1710,7 @@ func Test_GetResetEventIDByType_LastContinuedAsNew(t *testing.T) {
 	}, nil).Times(1)
 	_, _, err = getResetEventIDByType(context.Background(), c, resetTypeLastContinuedAsNew, -1, "test-domain",
 		"test-workflow-id", "test-run-id", serverFrontendClient)
-	assert.ErrorContains(t, err, "cannot get resetBaseRunID")
+	assert.ErrorContains(t, err, "workflow is not continued as new from another run")
 }
 
 func Test_GetResetEventIDByType_FirstDecisionCompleted(t *testing.T) {
@@ -2035,11 +1736,10 @@ func Test_GetResetEventIDByType_FirstDecisionCompleted(t *testing.T) {
 	}, nil).Times(1)
 
 	// reset type last decision completed
-	runID, decisionID, err := getResetEventIDByType(context.Background(), c, resetTypeFirstDecisionCompleted, -1, "test-domain",
+	_, decisionID, err := getResetEventIDByType(context.Background(), c, resetTypeFirstDecisionCompleted, -1, "test-domain",
 		"test-workflow-id", "test-run-id", serverFrontendClient)
-	assert.Equal(t, "test-run-id", runID)
 	assert.Equal(t, int64(0), decisionID)
-	assert.ErrorContains(t, err, "no DecisionFinishID")
+	assert.ErrorContains(t, err, "no DecisionTaskCompleted event found to reset to")
 }
 
 func Test_GetResetEventIDByType_BadBinary(t *testing.T) {
@@ -2060,11 +1760,10 @@ func Test_GetResetEventIDByType_BadBinary(t *testing.T) {
 	}).Return(&types.DescribeWorkflowExecutionResponse{
 		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{},
 	}, nil).Times(1)
-	runID, decisionID, err := getResetEventIDByType(context.Background(), c, resetTypeBadBinary, -1, "test-domain",
+	_, decisionID, err := getResetEventIDByType(context.Background(), c, resetTypeBadBinary, -1, "test-domain",
 		"test-workflow-id", "test-run-id", serverFrontendClient)
-	assert.Equal(t, "test-run-id", runID)
 	assert.Equal(t, int64(0), decisionID)
-	assert.ErrorContains(t, err, "no DecisionFinishID")
+	assert.ErrorContains(t, err, "no reset point found for binary checksum test-bad-binary-checksum")
 }
 
 func Test_GetResetEventIDByType_DecisionCompletedTime(t *testing.T) {
@@ -2087,7 +1786,7 @@ func Test_GetResetEventIDByType_DecisionCompletedTime(t *testing.T) {
 	_, decisionID, err := getResetEventIDByType(context.Background(), c, resetTypeDecisionCompletedTime, -1, "test-domain",
 		"test-workflow-id", "test-run-id", serverFrontendClient)
 	assert.Equal(t, int64(0), decisionID)
-	assert.ErrorContains(t, err, "no DecisionFinishID")
+	assert.ErrorContains(t, err, "no DecisionTaskCompleted event found to reset to")
 
 	set.String(FlagEarliestTime, "20201025Test", "earliest_time")
 	_, _, err = getResetEventIDByType(context.Background(), c, resetTypeDecisionCompletedTime, -1, "test-domain",
@@ -2112,21 +1811,18 @@ func Test_GetResetEventIDByType_FirstDecisionScheduled_LastDecisionScheduled(t *
 		},
 	}, nil).Times(2)
 	// reset type first decision scheduled
-	runID, decisionID, err := getResetEventIDByType(context.Background(), c, resetTypeFirstDecisionScheduled, -1, "test-domain",
+	_, decisionID, err := getResetEventIDByType(context.Background(), c, resetTypeFirstDecisionScheduled, -1, "test-domain",
 		"test-workflow-id", "test-run-id", serverFrontendClient)
-	assert.Equal(t, "test-run-id", runID)
 	assert.Equal(t, int64(0), decisionID)
-	assert.ErrorContains(t, err, "no DecisionFinishID")
+	assert.ErrorContains(t, err, "no DecisionTaskScheduled event found to reset to")
 	// reset type Last decision scheduled
-	runID, decisionID, err = getResetEventIDByType(context.Background(), c, resetTypeLastDecisionScheduled, -1, "test-domain",
+	_, decisionID, err = getResetEventIDByType(context.Background(), c, resetTypeLastDecisionScheduled, -1, "test-domain",
 		"test-workflow-id", "test-run-id", serverFrontendClient)
-	assert.Equal(t, "test-run-id", runID)
 	assert.Equal(t, int64(0), decisionID)
-	assert.ErrorContains(t, err, "no DecisionFinishID")
+	assert.ErrorContains(t, err, "no DecisionTaskScheduled event found to reset to")
 	// unsupported reset type
-	assert.Panics(t, func() {
-		getResetEventIDByType(context.Background(), c, "test reset type", -1, "test-domain", "test-workflow-id", "test-run-id", serverFrontendClient)
-	})
+	_, _, err = getResetEventIDByType(context.Background(), c, "test reset type", -1, "test-domain", "test-workflow-id", "test-run-id", serverFrontendClient)
+	assert.ErrorContains(t, err, "not supported reset type")
 
 	serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
 		History: &types.History{
@@ -2138,13 +1834,13 @@ func Test_GetResetEventIDByType_FirstDecisionScheduled_LastDecisionScheduled(t *
 			},
 		},
 	}, nil).Times(2)
-	_, decisionID, err = getResetEventIDByType(context.Background(), c, resetTypeFirstDecisionScheduled, -1, "test-domain",
+	runID, decisionID, err := getResetEventIDByType(context.Background(), c, resetTypeFirstDecisionScheduled, -1, "test-domain",
 		"test-workflow-id", "test-run-id", serverFrontendClient)
 	assert.Equal(t, "test-run-id", runID)
 	assert.Equal(t, int64(16), decisionID)
 	assert.NoError(t, err)
 
-	_, decisionID, err = getResetEventIDByType(context.Background(), c, resetTypeLastDecisionScheduled, -1, "test-domain",
+	runID, decisionID, err = getResetEventIDByType(context.Background(), c, resetTypeLastDecisionScheduled, -1, "test-domain",
 		"test-workflow-id", "test-run-id", serverFrontendClient)
 	assert.Equal(t, "test-run-id", runID)
 	assert.Equal(t, int64(16), decisionID)
@@ -29,6 +29,21 @@ import (
 
 const newLineDelim = '\n'
 
+// StatementParser is implemented by the SchemaClient of a database whose schema files
+// are not made of CQL / SQL statements. When implemented, it's used in place of ParseFile
+// and the statements are not validated against the CQL whitelist.
+type StatementParser interface {
+	// ParseStatements returns the statements in the given schema file
+	ParseStatements(file fs.File) ([]string, error)
+}
+
+func parseStatements(db SchemaClient, file fs.File) ([]string, error) {
+	if parser, ok := db.(StatementParser); ok {
+		return parser.ParseStatements(file)
+	}
+	return ParseFile(file)
+}
+
 // ParseFile takes a cql / sql file as input
 // and returns an array of cql / sql statements on
 // success.
@@ -68,3 +68,21 @@ func TestParseFile(t *testing.T) {
 	}
 	assert.Equal(t, expectedOutput, res)
 }
+
+type statementParserClient struct {
+	SchemaClient
+}
+
+func (c *statementParserClient) ParseStatements(file fs.File) ([]string, error) {
+	return []string{"parsed"}, nil
+}
+
+func TestParseStatements(t *testing.T) {
+	res, err := parseStatements(&statementParserClient{}, &mockfile{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"parsed"}, res)
+
+	res, err = parseStatements(NewMockSchemaClient(nil), &mockfile{})
+	assert.NoError(t, err)
+	assert.Len(t, res, 3)
+}
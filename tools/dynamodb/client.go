@@ -0,0 +1,246 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+
+	"github.com/uber/cadence/common/config"
+	ddbplugin "github.com/uber/cadence/common/persistence/nosql/nosqlplugin/dynamodb"
+	"github.com/uber/cadence/tools/common/schema"
+)
+
+type (
+	// DynamoClient executes the schema commands against the DynamoDB tables of a keyspace
+	DynamoClient struct {
+		client dynamodbiface.DynamoDBAPI
+		cfg    *DynamoClientConfig
+	}
+
+	// DynamoClientConfig contains the configuration for the DynamoDB client
+	DynamoClientConfig struct {
+		Endpoint string
+		Port     int
+		Region   string
+		User     string
+		Password string
+		// Keyspace is the prefix of the table names, the same as the keyspace of the persistence config
+		Keyspace string
+		Timeout  int
+	}
+)
+
+const (
+	DefaultTimeout = 30 // Timeout in seconds
+
+	schemaVersionTableName       = "schema_version"
+	schemaUpdateHistoryTableName = "schema_update_history"
+
+	attrKeyspace             = "keyspace"
+	attrCreationTime         = "creation_time"
+	attrCurrVersion          = "curr_version"
+	attrMinCompatibleVersion = "min_compatible_version"
+	attrUpdateTime           = "update_time"
+	attrOldVersion           = "old_version"
+	attrNewVersion           = "new_version"
+	attrManifestMD5          = "manifest_md5"
+	attrDescription          = "description"
+)
+
+var (
+	_ schema.SchemaClient    = (*DynamoClient)(nil)
+	_ schema.StatementParser = (*DynamoClient)(nil)
+
+	schemaVersionTables = []*ddbplugin.TableSchema{
+		{CreateTableInput: dynamodb.CreateTableInput{
+			TableName: aws.String(schemaVersionTableName),
+			AttributeDefinitions: []*dynamodb.AttributeDefinition{
+				{AttributeName: aws.String(attrKeyspace), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+			},
+			KeySchema: []*dynamodb.KeySchemaElement{
+				{AttributeName: aws.String(attrKeyspace), KeyType: aws.String(dynamodb.KeyTypeHash)},
+			},
+			BillingMode: aws.String(dynamodb.BillingModePayPerRequest),
+		}},
+		{CreateTableInput: dynamodb.CreateTableInput{
+			TableName: aws.String(schemaUpdateHistoryTableName),
+			AttributeDefinitions: []*dynamodb.AttributeDefinition{
+				{AttributeName: aws.String(attrKeyspace), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+				{AttributeName: aws.String(attrUpdateTime), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+			},
+			KeySchema: []*dynamodb.KeySchemaElement{
+				{AttributeName: aws.String(attrKeyspace), KeyType: aws.String(dynamodb.KeyTypeHash)},
+				{AttributeName: aws.String(attrUpdateTime), KeyType: aws.String(dynamodb.KeyTypeRange)},
+			},
+			BillingMode: aws.String(dynamodb.BillingModePayPerRequest),
+		}},
+	}
+)
+
+// NewDynamoClient returns a new instance of DynamoClient
+func NewDynamoClient(cfg *DynamoClientConfig) (*DynamoClient, error) {
+	client, err := ddbplugin.NewClient(cfg.noSQLConfig())
+	if err != nil {
+		return nil, err
+	}
+	return &DynamoClient{client: client, cfg: cfg}, nil
+}
+
+// ParseStatements parses a schema file which is a JSON list of tables, each table is returned as a statement
+func (client *DynamoClient) ParseStatements(file fs.File) ([]string, error) {
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := ddbplugin.ParseSchema(content)
+	if err != nil {
+		return nil, err
+	}
+	stmts := make([]string, 0, len(tables))
+	for _, table := range tables {
+		stmt, err := json.Marshal(table)
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, string(stmt))
+	}
+	return stmts, nil
+}
+
+// ExecDDLQuery creates the table given as a statement, only table creations are supported
+func (client *DynamoClient) ExecDDLQuery(stmt string, args ...interface{}) error {
+	var table ddbplugin.TableSchema
+	if err := json.Unmarshal([]byte(stmt), &table); err != nil {
+		return fmt.Errorf("parsing table %v: %w", stmt, err)
+	}
+	ctx, cancel := client.cfg.context()
+	defer cancel()
+	return ddbplugin.CreateTable(ctx, client.client, client.cfg.tablePrefix(), &table)
+}
+
+// DropAllTables drops all the tables of the keyspace
+func (client *DynamoClient) DropAllTables() error {
+	ctx, cancel := client.cfg.context()
+	defer cancel()
+	log.Printf("Dropping all tables with prefix %v\n", client.cfg.tablePrefix())
+	return ddbplugin.DeleteTables(ctx, client.client, client.cfg.tablePrefix())
+}
+
+// CreateSchemaVersionTables sets up the schema version tables
+func (client *DynamoClient) CreateSchemaVersionTables() error {
+	ctx, cancel := client.cfg.context()
+	defer cancel()
+	for _, table := range schemaVersionTables {
+		if err := ddbplugin.CreateTable(ctx, client.client, client.cfg.tablePrefix(), table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadSchemaVersion returns the current schema version for the keyspace
+func (client *DynamoClient) ReadSchemaVersion() (string, error) {
+	ctx, cancel := client.cfg.context()
+	defer cancel()
+	out, err := client.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(client.cfg.tablePrefix() + schemaVersionTableName),
+		Key:            map[string]*dynamodb.AttributeValue{attrKeyspace: {S: aws.String(client.cfg.Keyspace)}},
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return "", fmt.Errorf("reading schema version: %w", err)
+	}
+	version, ok := out.Item[attrCurrVersion]
+	if !ok {
+		return "", fmt.Errorf("reading schema version: no version found for keyspace %v", client.cfg.Keyspace)
+	}
+	return aws.StringValue(version.S), nil
+}
+
+// UpdateSchemaVersion updates the schema version for the keyspace
+func (client *DynamoClient) UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error {
+	ctx, cancel := client.cfg.context()
+	defer cancel()
+	_, err := client.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(client.cfg.tablePrefix() + schemaVersionTableName),
+		Item: map[string]*dynamodb.AttributeValue{
+			attrKeyspace:             {S: aws.String(client.cfg.Keyspace)},
+			attrCreationTime:         {S: aws.String(time.Now().UTC().Format(time.RFC3339Nano))},
+			attrCurrVersion:          {S: aws.String(newVersion)},
+			attrMinCompatibleVersion: {S: aws.String(minCompatibleVersion)},
+		},
+	})
+	return err
+}
+
+// WriteSchemaUpdateLog adds an entry to the schema update history table
+func (client *DynamoClient) WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error {
+	ctx, cancel := client.cfg.context()
+	defer cancel()
+	_, err := client.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(client.cfg.tablePrefix() + schemaUpdateHistoryTableName),
+		Item: map[string]*dynamodb.AttributeValue{
+			attrKeyspace:    {S: aws.String(client.cfg.Keyspace)},
+			attrUpdateTime:  {S: aws.String(time.Now().UTC().Format(time.RFC3339Nano))},
+			attrOldVersion:  {S: aws.String(oldVersion)},
+			attrNewVersion:  {S: aws.String(newVersion)},
+			attrManifestMD5: {S: aws.String(manifestMD5)},
+			attrDescription: {S: aws.String(desc)},
+		},
+	})
+	return err
+}
+
+// Close is a noop, the AWS client doesn't hold any connection that needs to be released
+func (client *DynamoClient) Close() {}
+
+// noSQLConfig maps the tool config onto the persistence config, so that the tool connects the same way as the server
+func (cfg *DynamoClientConfig) noSQLConfig() *config.NoSQL {
+	return &config.NoSQL{
+		PluginName: ddbplugin.PluginName,
+		Hosts:      cfg.Endpoint,
+		Port:       cfg.Port,
+		Region:     cfg.Region,
+		User:       cfg.User,
+		Password:   cfg.Password,
+		Keyspace:   cfg.Keyspace,
+		Timeout:    time.Duration(cfg.Timeout) * time.Second,
+	}
+}
+
+// tablePrefix is the prefix of the table names of the keyspace, it matches the prefix used by the persistence plugin
+func (cfg *DynamoClientConfig) tablePrefix() string {
+	return cfg.Keyspace + "_"
+}
+
+func (cfg *DynamoClientConfig) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), time.Duration(cfg.Timeout)*time.Second)
+}
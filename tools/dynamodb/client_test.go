@@ -0,0 +1,134 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDynamoDB keeps the created tables and the items in memory, every other call panics on the nil embedded interface
+type fakeDynamoDB struct {
+	dynamodbiface.DynamoDBAPI
+
+	tables map[string]*dynamodb.CreateTableInput
+	ttl    map[string]string
+	items  map[string]map[string]*dynamodb.AttributeValue
+}
+
+func newFakeDynamoDB() *fakeDynamoDB {
+	return &fakeDynamoDB{
+		tables: make(map[string]*dynamodb.CreateTableInput),
+		ttl:    make(map[string]string),
+		items:  make(map[string]map[string]*dynamodb.AttributeValue),
+	}
+}
+
+func (c *fakeDynamoDB) CreateTableWithContext(_ context.Context, input *dynamodb.CreateTableInput, _ ...request.Option) (*dynamodb.CreateTableOutput, error) {
+	c.tables[aws.StringValue(input.TableName)] = input
+	return &dynamodb.CreateTableOutput{}, nil
+}
+
+func (c *fakeDynamoDB) WaitUntilTableExistsWithContext(context.Context, *dynamodb.DescribeTableInput, ...request.WaiterOption) error {
+	return nil
+}
+
+func (c *fakeDynamoDB) UpdateTimeToLiveWithContext(_ context.Context, input *dynamodb.UpdateTimeToLiveInput, _ ...request.Option) (*dynamodb.UpdateTimeToLiveOutput, error) {
+	c.ttl[aws.StringValue(input.TableName)] = aws.StringValue(input.TimeToLiveSpecification.AttributeName)
+	return &dynamodb.UpdateTimeToLiveOutput{}, nil
+}
+
+func (c *fakeDynamoDB) PutItemWithContext(_ context.Context, input *dynamodb.PutItemInput, _ ...request.Option) (*dynamodb.PutItemOutput, error) {
+	c.items[aws.StringValue(input.TableName)] = input.Item
+	return &dynamodb.PutItemOutput{}, nil
+}
+
+func (c *fakeDynamoDB) GetItemWithContext(_ context.Context, input *dynamodb.GetItemInput, _ ...request.Option) (*dynamodb.GetItemOutput, error) {
+	return &dynamodb.GetItemOutput{Item: c.items[aws.StringValue(input.TableName)]}, nil
+}
+
+func newTestClient(fake *fakeDynamoDB) *DynamoClient {
+	return &DynamoClient{client: fake, cfg: &DynamoClientConfig{Keyspace: "cadence", Timeout: DefaultTimeout}}
+}
+
+func TestValidateDynamoClientConfig(t *testing.T) {
+	config := new(DynamoClientConfig)
+	assert.Error(t, validateDynamoClientConfig(config))
+
+	config.Keyspace = "cadence"
+	assert.Error(t, validateDynamoClientConfig(config))
+
+	config.Region = "us-east-1"
+	require.NoError(t, validateDynamoClientConfig(config))
+	assert.Equal(t, DefaultTimeout, config.Timeout)
+}
+
+func TestParseAndCreateSchemaFiles(t *testing.T) {
+	for _, path := range []string{
+		"../../schema/dynamodb/cadence/schema.json",
+		"../../schema/dynamodb/cadence/versioned/v0.1/base.json",
+	} {
+		fake := newFakeDynamoDB()
+		client := newTestClient(fake)
+
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		stmts, err := client.ParseStatements(f)
+		f.Close()
+		require.NoError(t, err, path)
+		require.NotEmpty(t, stmts, path)
+
+		for _, stmt := range stmts {
+			require.NoError(t, client.ExecDDLQuery(stmt), path)
+		}
+		assert.Len(t, fake.tables, len(stmts), path)
+		assert.Contains(t, fake.tables, "cadence_history_node", path)
+		assert.Equal(t, "ttl", fake.ttl["cadence_task"], path)
+	}
+}
+
+func TestSchemaVersion(t *testing.T) {
+	fake := newFakeDynamoDB()
+	client := newTestClient(fake)
+
+	require.NoError(t, client.CreateSchemaVersionTables())
+	assert.Contains(t, fake.tables, "cadence_schema_version")
+	assert.Contains(t, fake.tables, "cadence_schema_update_history")
+
+	_, err := client.ReadSchemaVersion()
+	assert.Error(t, err)
+
+	require.NoError(t, client.UpdateSchemaVersion("0.1", "0.1"))
+	version, err := client.ReadSchemaVersion()
+	require.NoError(t, err)
+	assert.Equal(t, "0.1", version)
+
+	require.NoError(t, client.WriteSchemaUpdateLog("0.0", "0.1", "md5", "base version of schema"))
+	assert.Equal(t, "0.1", aws.StringValue(fake.items["cadence_schema_update_history"][attrNewVersion].S))
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/tools/common/schema"
+)
+
+// cliFlagRegion is the cli flag for the AWS region
+const cliFlagRegion = "region"
+
+// SetupSchemaConfig contains the configuration params needed to setup schema tables
+type SetupSchemaConfig struct {
+	DynamoClientConfig
+	schema.SetupConfig
+}
+
+// setupSchema executes the setupSchemaTask
+// using the given command line arguments
+// as input
+func setupSchema(cli *cli.Context) error {
+	config, err := newDynamoClientConfig(cli)
+	if err != nil {
+		return handleErr(schema.NewConfigError(err.Error()))
+	}
+	client, err := NewDynamoClient(config)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer client.Close()
+	if err := schema.Setup(cli, client); err != nil {
+		return handleErr(err)
+	}
+	return nil
+}
+
+// updateSchema executes the updateSchemaTask
+// using the given command line args as input
+func updateSchema(cli *cli.Context) error {
+	config, err := newDynamoClientConfig(cli)
+	if err != nil {
+		return handleErr(schema.NewConfigError(err.Error()))
+	}
+	client, err := NewDynamoClient(config)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer client.Close()
+	if err := schema.Update(cli, client); err != nil {
+		return handleErr(err)
+	}
+	return nil
+}
+
+// dropKeyspace drops every table of a keyspace
+func dropKeyspace(cli *cli.Context) error {
+	config, err := newDynamoClientConfig(cli)
+	if err != nil {
+		return handleErr(schema.NewConfigError(err.Error()))
+	}
+	client, err := NewDynamoClient(config)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer client.Close()
+	if err := client.DropAllTables(); err != nil {
+		return handleErr(fmt.Errorf("error dropping keyspace:%v", err))
+	}
+	return nil
+}
+
+func newDynamoClientConfig(cli *cli.Context) (*DynamoClientConfig, error) {
+	dynamoConfig := new(DynamoClientConfig)
+	dynamoConfig.Endpoint = cli.String(schema.CLIOptEndpoint)
+	dynamoConfig.Port = cli.Int(schema.CLIOptPort)
+	dynamoConfig.Region = cli.String(cliFlagRegion)
+	dynamoConfig.User = cli.String(schema.CLIOptUser)
+	dynamoConfig.Password = cli.String(schema.CLIOptPassword)
+	dynamoConfig.Timeout = cli.Int(schema.CLIOptTimeout)
+	dynamoConfig.Keyspace = cli.String(schema.CLIOptKeyspace)
+
+	if err := validateDynamoClientConfig(dynamoConfig); err != nil {
+		return nil, err
+	}
+	return dynamoConfig, nil
+}
+
+func validateDynamoClientConfig(config *DynamoClientConfig) error {
+	if config.Keyspace == "" {
+		return schema.NewConfigError("missing " + flag(schema.CLIOptKeyspace) + " argument ")
+	}
+	if config.Endpoint == "" && config.Region == "" {
+		return schema.NewConfigError("missing " + flag(schema.CLIOptEndpoint) + " or " + flag(cliFlagRegion) + " argument ")
+	}
+	if config.Timeout == 0 {
+		config.Timeout = DefaultTimeout
+	}
+	return nil
+}
+
+func flag(opt string) string {
+	return "(-" + opt + ")"
+}
+
+func handleErr(err error) error {
+	log.Println(err)
+	return err
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/tools/common/schema"
+)
+
+// RunTool runs the cadence-dynamodb-tool command line tool
+func RunTool(args []string) error {
+	app := BuildCLIOptions()
+	return app.Run(args)
+}
+
+// SetupSchema setups the dynamodb schema
+func SetupSchema(config *SetupSchemaConfig) error {
+	if err := validateDynamoClientConfig(&config.DynamoClientConfig); err != nil {
+		return err
+	}
+	db, err := NewDynamoClient(&config.DynamoClientConfig)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return schema.SetupFromConfig(&config.SetupConfig, db)
+}
+
+// root handler for all cli commands
+func cliHandler(c *cli.Context, handler func(c *cli.Context) error) error {
+	quiet := c.Bool(schema.CLIOptQuiet)
+	err := handler(c)
+	if err != nil {
+		if quiet { // if quiet, don't return error
+			fmt.Println("fail to run tool: ", err)
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// BuildCLIOptions builds the options for cli
+func BuildCLIOptions() *cli.App {
+
+	app := cli.NewApp()
+	app.Name = "cadence-dynamodb-tool"
+	app.Usage = "Command line tool for cadence dynamodb operations"
+	app.Version = "0.0.1"
+
+	app.Flags = []cli.Flag{
+		&cli.StringFlag{
+			Name:    schema.CLIFlagEndpoint,
+			Aliases: []string{"ep"},
+			Usage:   "hostname or url of the dynamodb endpoint, leave it empty to use the regional AWS endpoint",
+			EnvVars: []string{"DYNAMODB_ENDPOINT"},
+		},
+		&cli.IntFlag{
+			Name:    schema.CLIFlagPort,
+			Aliases: []string{"p"},
+			Usage:   "Port of the dynamodb endpoint",
+			EnvVars: []string{"DYNAMODB_PORT"},
+		},
+		&cli.StringFlag{
+			Name:    cliFlagRegion,
+			Aliases: []string{"r"},
+			Usage:   "AWS region of the dynamodb tables",
+			EnvVars: []string{"AWS_REGION"},
+		},
+		&cli.StringFlag{
+			Name:    schema.CLIFlagUser,
+			Aliases: []string{"u"},
+			Usage:   "AWS access key ID, the default AWS credential chain is used when empty",
+			EnvVars: []string{"DYNAMODB_USER"},
+		},
+		&cli.StringFlag{
+			Name:    schema.CLIFlagPassword,
+			Aliases: []string{"pw"},
+			Usage:   "AWS secret access key",
+			EnvVars: []string{"DYNAMODB_PASSWORD"},
+		},
+		&cli.IntFlag{
+			Name:    schema.CLIFlagTimeout,
+			Aliases: []string{"t"},
+			Value:   DefaultTimeout,
+			Usage:   "request Timeout in seconds used for dynamodb client",
+			EnvVars: []string{"DYNAMODB_TIMEOUT"},
+		},
+		&cli.StringFlag{
+			Name:    schema.CLIFlagKeyspace,
+			Aliases: []string{"k"},
+			Value:   "cadence",
+			Usage:   "name of the keyspace, used as the prefix of the table names",
+			EnvVars: []string{"DYNAMODB_KEYSPACE"},
+		},
+		&cli.BoolFlag{
+			Name:    schema.CLIFlagQuiet,
+			Aliases: []string{"q"},
+			Usage:   "Don't set exit status to 1 on error",
+		},
+	}
+
+	app.Commands = []*cli.Command{
+		{
+			Name:    "setup-schema",
+			Aliases: []string{"setup"},
+			Usage:   "setup initial version of dynamodb schema",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    schema.CLIFlagVersion,
+					Aliases: []string{"v"},
+					Usage:   "initial version of the schema, cannot be used with disable-versioning",
+				},
+				&cli.StringFlag{
+					Name:    schema.CLIFlagSchemaFile,
+					Aliases: []string{"f"},
+					Usage:   "path to the .json schema file; if un-specified, will just setup versioning tables",
+				},
+				&cli.BoolFlag{
+					Name:    schema.CLIFlagDisableVersioning,
+					Aliases: []string{"d"},
+					Usage:   "disable setup of schema versioning",
+				},
+				&cli.BoolFlag{
+					Name:    schema.CLIFlagOverwrite,
+					Aliases: []string{"o"},
+					Usage:   "drop all existing tables of the keyspace before setting up new schema",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return cliHandler(c, setupSchema)
+			},
+		},
+		{
+			Name:    "update-schema",
+			Aliases: []string{"update"},
+			Usage:   "update dynamodb schema to a specific version",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    schema.CLIFlagTargetVersion,
+					Aliases: []string{"v"},
+					Usage:   "target version for the schema update, defaults to latest",
+				},
+				&cli.StringFlag{
+					Name:    schema.CLIFlagSchemaDir,
+					Aliases: []string{"d"},
+					Usage:   "path to directory containing versioned schema",
+				},
+				&cli.BoolFlag{
+					Name:  schema.CLIFlagDryrun,
+					Usage: "do a dryrun",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return cliHandler(c, updateSchema)
+			},
+		},
+		{
+			Name:  "drop-keyspace",
+			Usage: "drops every table of a keyspace",
+			Action: func(c *cli.Context) error {
+				return cliHandler(c, dropKeyspace)
+			},
+		},
+	}
+
+	return app
+}
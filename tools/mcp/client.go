@@ -0,0 +1,81 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/transport/grpc"
+
+	"github.com/uber/cadence/client/frontend"
+	grpcClient "github.com/uber/cadence/client/wrappers/grpc"
+	"github.com/uber/cadence/common"
+	cc "github.com/uber/cadence/common/client"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	cadenceClientName      = "cadence-mcp"
+	cadenceFrontendService = "cadence-frontend"
+)
+
+// newFrontendClient creates a gRPC frontend client for the given address.
+// The returned dispatcher must be stopped by the caller.
+func newFrontendClient(address string) (frontend.Client, *yarpc.Dispatcher, error) {
+	dispatcher := yarpc.NewDispatcher(yarpc.Config{
+		Name: cadenceClientName,
+		Outbounds: yarpc.Outbounds{
+			cadenceFrontendService: {Unary: grpc.NewTransport().NewSingleOutbound(address)},
+		},
+		OutboundMiddleware: yarpc.OutboundMiddleware{
+			Unary: &versionMiddleware{},
+		},
+	})
+	if err := dispatcher.Start(); err != nil {
+		return nil, nil, fmt.Errorf("starting dispatcher: %w", err)
+	}
+
+	clientConfig := dispatcher.ClientConfig(cadenceFrontendService)
+	client := grpcClient.NewFrontendClient(
+		apiv1.NewDomainAPIYARPCClient(clientConfig),
+		apiv1.NewWorkflowAPIYARPCClient(clientConfig),
+		apiv1.NewWorkerAPIYARPCClient(clientConfig),
+		apiv1.NewVisibilityAPIYARPCClient(clientConfig),
+		apiv1.NewScheduleAPIYARPCClient(clientConfig),
+	)
+	return client, dispatcher, nil
+}
+
+// versionMiddleware identifies the MCP server as a CLI so that the frontend accepts its calls
+type versionMiddleware struct{}
+
+func (vm *versionMiddleware) Call(ctx context.Context, request *transport.Request, out transport.UnaryOutbound) (*transport.Response, error) {
+	request.Headers = request.Headers.
+		With(common.ClientImplHeaderName, cc.CLI).
+		With(common.FeatureVersionHeaderName, cc.SupportedCLIVersion).
+		With(common.ClientFeatureFlagsHeaderName, cc.FeatureFlagsHeader(cc.DefaultCLIFeatureFlags)).
+		With(common.CallerTypeHeaderName, types.CallerTypeCLI.String())
+	return out.Call(ctx, request)
+}
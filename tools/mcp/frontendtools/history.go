@@ -0,0 +1,295 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontendtools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// a decision task that fails this many times in a row is reported as stuck
+	stuckDecisionFailures = 3
+	// a decision task that is not picked up by a worker for this long is reported as stuck
+	stuckDecisionScheduleToStart = 5 * time.Minute
+)
+
+type (
+	historySummary struct {
+		WorkflowType      string             `json:"workflowType,omitempty"`
+		Status            string             `json:"status"`
+		EventCount        int                `json:"eventCount"`
+		Truncated         bool               `json:"truncated,omitempty"`
+		FirstEventTime    string             `json:"firstEventTime,omitempty"`
+		LastEventTime     string             `json:"lastEventTime,omitempty"`
+		EventTypeCounts   map[string]int     `json:"eventTypeCounts"`
+		PendingActivities []*historyActivity `json:"pendingActivities,omitempty"`
+		LastFailure       *historyFailure    `json:"lastFailure,omitempty"`
+		Decision          *historyDecision   `json:"decision,omitempty"`
+		activities        map[int64]*historyActivity
+	}
+
+	historyActivity struct {
+		ActivityID       string `json:"activityId"`
+		ActivityType     string `json:"activityType,omitempty"`
+		ScheduledEventID int64  `json:"scheduledEventId"`
+		ScheduledTime    string `json:"scheduledTime,omitempty"`
+		Started          bool   `json:"started"`
+		Attempt          int32  `json:"attempt,omitempty"`
+	}
+
+	historyFailure struct {
+		EventID   int64  `json:"eventId"`
+		EventType string `json:"eventType"`
+		Time      string `json:"time,omitempty"`
+		Reason    string `json:"reason,omitempty"`
+		Details   string `json:"details,omitempty"`
+	}
+
+	historyDecision struct {
+		ScheduledEventID    int64  `json:"scheduledEventId,omitempty"`
+		ScheduledTime       string `json:"scheduledTime,omitempty"`
+		Started             bool   `json:"started"`
+		ConsecutiveFailures int    `json:"consecutiveFailures"`
+		LastFailureCause    string `json:"lastFailureCause,omitempty"`
+		Stuck               bool   `json:"stuck"`
+		StuckReason         string `json:"stuckReason,omitempty"`
+		scheduledTimestamp  int64
+	}
+)
+
+func (h *handler) summarizeHistory(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	domain, execution, err := executionArgs(request)
+	if err != nil {
+		return nil, err
+	}
+	maxEvents := int(optionalInt(request, "max_events", defaultHistorySize))
+	if maxEvents <= 0 {
+		maxEvents = defaultHistorySize
+	}
+
+	var (
+		events    []*types.HistoryEvent
+		pageToken []byte
+		truncated bool
+	)
+	for {
+		callCtx, cancel := h.context(ctx)
+		resp, err := h.client.GetWorkflowExecutionHistory(callCtx, &types.GetWorkflowExecutionHistoryRequest{
+			Domain:        domain,
+			Execution:     execution,
+			NextPageToken: pageToken,
+		})
+		cancel()
+		if err != nil {
+			return errorResult("GetWorkflowExecutionHistory", err)
+		}
+		if resp.History != nil {
+			events = append(events, resp.History.Events...)
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			break
+		}
+		if len(events) >= maxEvents {
+			truncated = true
+			break
+		}
+	}
+	if len(events) > maxEvents {
+		events = events[:maxEvents]
+		truncated = true
+	}
+
+	summary := summarizeHistory(events, time.Now())
+	summary.Truncated = truncated
+	return jsonResult(summary)
+}
+
+// summarizeHistory extracts what's usually needed to investigate a workflow from its history events
+func summarizeHistory(events []*types.HistoryEvent, now time.Time) *historySummary {
+	s := &historySummary{
+		Status:          "RUNNING",
+		EventCount:      len(events),
+		EventTypeCounts: make(map[string]int),
+		activities:      make(map[int64]*historyActivity),
+	}
+	if len(events) == 0 {
+		return s
+	}
+	s.FirstEventTime = formatTime(events[0].Timestamp)
+	s.LastEventTime = formatTime(events[len(events)-1].Timestamp)
+
+	for _, e := range events {
+		s.EventTypeCounts[e.GetEventType().String()]++
+		s.apply(e)
+	}
+
+	for _, a := range s.activities {
+		s.PendingActivities = append(s.PendingActivities, a)
+	}
+	sort.Slice(s.PendingActivities, func(i, j int) bool {
+		return s.PendingActivities[i].ScheduledEventID < s.PendingActivities[j].ScheduledEventID
+	})
+
+	if d := s.Decision; d != nil && s.Status == "RUNNING" {
+		switch {
+		case d.ConsecutiveFailures >= stuckDecisionFailures:
+			d.Stuck = true
+			d.StuckReason = fmt.Sprintf("the decision task failed or timed out %v times in a row, last cause: %v", d.ConsecutiveFailures, d.LastFailureCause)
+		case d.ScheduledEventID != 0 && !d.Started && now.Sub(time.Unix(0, d.scheduledTimestamp)) > stuckDecisionScheduleToStart:
+			d.Stuck = true
+			d.StuckReason = fmt.Sprintf("the decision task is not picked up by a worker for more than %v, check the pollers of the task list", stuckDecisionScheduleToStart)
+		}
+	}
+	return s
+}
+
+func (s *historySummary) apply(e *types.HistoryEvent) {
+	switch e.GetEventType() {
+	case types.EventTypeWorkflowExecutionStarted:
+		if attr := e.WorkflowExecutionStartedEventAttributes; attr != nil {
+			s.WorkflowType = attr.WorkflowType.GetName()
+		}
+	case types.EventTypeWorkflowExecutionCompleted,
+		types.EventTypeWorkflowExecutionCanceled,
+		types.EventTypeWorkflowExecutionContinuedAsNew:
+		s.Status = e.GetEventType().String()
+	case types.EventTypeWorkflowExecutionFailed:
+		s.Status = e.GetEventType().String()
+		if attr := e.WorkflowExecutionFailedEventAttributes; attr != nil {
+			s.failure(e, attr.GetReason(), attr.Details)
+		}
+	case types.EventTypeWorkflowExecutionTimedOut:
+		s.Status = e.GetEventType().String()
+		if attr := e.WorkflowExecutionTimedOutEventAttributes; attr != nil {
+			s.failure(e, attr.GetTimeoutType().String(), nil)
+		}
+	case types.EventTypeWorkflowExecutionTerminated:
+		s.Status = e.GetEventType().String()
+		if attr := e.WorkflowExecutionTerminatedEventAttributes; attr != nil {
+			s.failure(e, attr.Reason, attr.Details)
+		}
+
+	case types.EventTypeActivityTaskScheduled:
+		if attr := e.ActivityTaskScheduledEventAttributes; attr != nil {
+			s.activities[e.ID] = &historyActivity{
+				ActivityID:       attr.GetActivityID(),
+				ActivityType:     attr.GetActivityType().GetName(),
+				ScheduledEventID: e.ID,
+				ScheduledTime:    formatTime(e.Timestamp),
+			}
+		}
+	case types.EventTypeActivityTaskStarted:
+		if attr := e.ActivityTaskStartedEventAttributes; attr != nil {
+			if a, ok := s.activities[attr.ScheduledEventID]; ok {
+				a.Started = true
+				a.Attempt = attr.Attempt
+			}
+		}
+	case types.EventTypeActivityTaskCompleted:
+		if attr := e.ActivityTaskCompletedEventAttributes; attr != nil {
+			delete(s.activities, attr.ScheduledEventID)
+		}
+	case types.EventTypeActivityTaskFailed:
+		if attr := e.ActivityTaskFailedEventAttributes; attr != nil {
+			delete(s.activities, attr.ScheduledEventID)
+			s.failure(e, common.StringDefault(attr.Reason), attr.Details)
+		}
+	case types.EventTypeActivityTaskTimedOut:
+		if attr := e.ActivityTaskTimedOutEventAttributes; attr != nil {
+			delete(s.activities, attr.ScheduledEventID)
+			reason := attr.GetTimeoutType().String()
+			if attr.LastFailureReason != nil {
+				reason += ", last failure: " + *attr.LastFailureReason
+			}
+			s.failure(e, reason, attr.LastFailureDetails)
+		}
+	case types.EventTypeActivityTaskCanceled:
+		if attr := e.ActivityTaskCanceledEventAttributes; attr != nil {
+			delete(s.activities, attr.ScheduledEventID)
+		}
+
+	case types.EventTypeDecisionTaskScheduled:
+		d := s.decision()
+		d.ScheduledEventID = e.ID
+		d.ScheduledTime = formatTime(e.Timestamp)
+		d.scheduledTimestamp = e.GetTimestamp()
+		d.Started = false
+	case types.EventTypeDecisionTaskStarted:
+		s.decision().Started = true
+	case types.EventTypeDecisionTaskCompleted:
+		s.Decision = &historyDecision{}
+	case types.EventTypeDecisionTaskFailed:
+		d := s.closeDecision()
+		d.ConsecutiveFailures++
+		if attr := e.DecisionTaskFailedEventAttributes; attr != nil {
+			d.LastFailureCause = attr.GetCause().String()
+			s.failure(e, common.StringDefault(attr.Reason), attr.Details)
+		}
+	case types.EventTypeDecisionTaskTimedOut:
+		d := s.closeDecision()
+		d.ConsecutiveFailures++
+		if attr := e.DecisionTaskTimedOutEventAttributes; attr != nil {
+			d.LastFailureCause = attr.GetTimeoutType().String()
+			s.failure(e, attr.GetTimeoutType().String(), nil)
+		}
+
+	case types.EventTypeChildWorkflowExecutionFailed:
+		if attr := e.ChildWorkflowExecutionFailedEventAttributes; attr != nil {
+			s.failure(e, common.StringDefault(attr.Reason), attr.Details)
+		}
+	}
+}
+
+func (s *historySummary) decision() *historyDecision {
+	if s.Decision == nil {
+		s.Decision = &historyDecision{}
+	}
+	return s.Decision
+}
+
+// closeDecision clears the pending decision but keeps the failure streak
+func (s *historySummary) closeDecision() *historyDecision {
+	d := s.decision()
+	d.ScheduledEventID = 0
+	d.ScheduledTime = ""
+	d.scheduledTimestamp = 0
+	d.Started = false
+	return d
+}
+
+func (s *historySummary) failure(e *types.HistoryEvent, reason string, details []byte) {
+	s.LastFailure = &historyFailure{
+		EventID:   e.ID,
+		EventType: e.GetEventType().String(),
+		Time:      formatTime(e.Timestamp),
+		Reason:    reason,
+		Details:   string(details),
+	}
+}
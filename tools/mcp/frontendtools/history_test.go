@@ -0,0 +1,223 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontendtools
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+var testNow = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func TestSummarizeHistory(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []*types.HistoryEvent
+		assert func(t *testing.T, s *historySummary)
+	}{
+		{
+			name:   "empty history",
+			events: nil,
+			assert: func(t *testing.T, s *historySummary) {
+				assert.Equal(t, "RUNNING", s.Status)
+				assert.Equal(t, 0, s.EventCount)
+				assert.Nil(t, s.Decision)
+			},
+		},
+		{
+			name: "completed workflow",
+			events: []*types.HistoryEvent{
+				startedEvent(1, testNow.Add(-time.Hour)),
+				event(2, types.EventTypeDecisionTaskScheduled, testNow.Add(-time.Hour)),
+				event(3, types.EventTypeDecisionTaskStarted, testNow.Add(-time.Hour)),
+				event(4, types.EventTypeDecisionTaskCompleted, testNow.Add(-time.Hour)),
+				event(5, types.EventTypeWorkflowExecutionCompleted, testNow.Add(-time.Hour)),
+			},
+			assert: func(t *testing.T, s *historySummary) {
+				assert.Equal(t, "test-workflow", s.WorkflowType)
+				assert.Equal(t, types.EventTypeWorkflowExecutionCompleted.String(), s.Status)
+				assert.Equal(t, 5, s.EventCount)
+				assert.Equal(t, 1, s.EventTypeCounts[types.EventTypeDecisionTaskCompleted.String()])
+				assert.Empty(t, s.PendingActivities)
+				assert.Nil(t, s.LastFailure)
+				assert.False(t, s.Decision.Stuck)
+			},
+		},
+		{
+			name: "pending and failed activities",
+			events: []*types.HistoryEvent{
+				startedEvent(1, testNow.Add(-time.Hour)),
+				activityScheduledEvent(5, "activity-1"),
+				activityScheduledEvent(6, "activity-2"),
+				activityScheduledEvent(7, "activity-3"),
+				{
+					ID:                                 8,
+					EventType:                          types.EventTypeActivityTaskStarted.Ptr(),
+					ActivityTaskStartedEventAttributes: &types.ActivityTaskStartedEventAttributes{ScheduledEventID: 6, Attempt: 2},
+				},
+				{
+					ID:                                9,
+					EventType:                         types.EventTypeActivityTaskFailed.Ptr(),
+					ActivityTaskFailedEventAttributes: &types.ActivityTaskFailedEventAttributes{ScheduledEventID: 5, Reason: common.StringPtr("boom"), Details: []byte("details")},
+				},
+				{
+					ID:                                   10,
+					EventType:                            types.EventTypeActivityTaskCompleted.Ptr(),
+					ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{ScheduledEventID: 7},
+				},
+			},
+			assert: func(t *testing.T, s *historySummary) {
+				assert.Equal(t, "RUNNING", s.Status)
+				if assert.Len(t, s.PendingActivities, 1) {
+					assert.Equal(t, "activity-2", s.PendingActivities[0].ActivityID)
+					assert.True(t, s.PendingActivities[0].Started)
+					assert.Equal(t, int32(2), s.PendingActivities[0].Attempt)
+				}
+				if assert.NotNil(t, s.LastFailure) {
+					assert.Equal(t, int64(9), s.LastFailure.EventID)
+					assert.Equal(t, "boom", s.LastFailure.Reason)
+					assert.Equal(t, "details", s.LastFailure.Details)
+				}
+			},
+		},
+		{
+			name: "decision task failing repeatedly",
+			events: []*types.HistoryEvent{
+				startedEvent(1, testNow.Add(-time.Hour)),
+				event(2, types.EventTypeDecisionTaskScheduled, testNow),
+				event(3, types.EventTypeDecisionTaskStarted, testNow),
+				decisionFailedEvent(4),
+				event(5, types.EventTypeDecisionTaskScheduled, testNow),
+				event(6, types.EventTypeDecisionTaskStarted, testNow),
+				decisionFailedEvent(7),
+				event(8, types.EventTypeDecisionTaskScheduled, testNow),
+				event(9, types.EventTypeDecisionTaskStarted, testNow),
+				decisionFailedEvent(10),
+			},
+			assert: func(t *testing.T, s *historySummary) {
+				assert.Equal(t, 3, s.Decision.ConsecutiveFailures)
+				assert.Equal(t, types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.String(), s.Decision.LastFailureCause)
+				assert.True(t, s.Decision.Stuck)
+				assert.Equal(t, int64(10), s.LastFailure.EventID)
+			},
+		},
+		{
+			name: "decision task failure streak is reset by a completed decision",
+			events: []*types.HistoryEvent{
+				startedEvent(1, testNow.Add(-time.Hour)),
+				event(2, types.EventTypeDecisionTaskScheduled, testNow),
+				event(3, types.EventTypeDecisionTaskStarted, testNow),
+				decisionFailedEvent(4),
+				event(5, types.EventTypeDecisionTaskScheduled, testNow),
+				event(6, types.EventTypeDecisionTaskStarted, testNow),
+				event(7, types.EventTypeDecisionTaskCompleted, testNow),
+			},
+			assert: func(t *testing.T, s *historySummary) {
+				assert.Equal(t, 0, s.Decision.ConsecutiveFailures)
+				assert.False(t, s.Decision.Stuck)
+			},
+		},
+		{
+			name: "decision task not picked up",
+			events: []*types.HistoryEvent{
+				startedEvent(1, testNow.Add(-time.Hour)),
+				event(2, types.EventTypeDecisionTaskScheduled, testNow.Add(-time.Hour)),
+			},
+			assert: func(t *testing.T, s *historySummary) {
+				assert.Equal(t, int64(2), s.Decision.ScheduledEventID)
+				assert.False(t, s.Decision.Started)
+				assert.True(t, s.Decision.Stuck)
+			},
+		},
+		{
+			name: "decision task recently scheduled",
+			events: []*types.HistoryEvent{
+				startedEvent(1, testNow.Add(-time.Minute)),
+				event(2, types.EventTypeDecisionTaskScheduled, testNow.Add(-time.Minute)),
+			},
+			assert: func(t *testing.T, s *historySummary) {
+				assert.False(t, s.Decision.Stuck)
+			},
+		},
+		{
+			name: "terminated workflow",
+			events: []*types.HistoryEvent{
+				startedEvent(1, testNow.Add(-time.Hour)),
+				event(2, types.EventTypeDecisionTaskScheduled, testNow.Add(-time.Hour)),
+				{
+					ID:        3,
+					EventType: types.EventTypeWorkflowExecutionTerminated.Ptr(),
+					WorkflowExecutionTerminatedEventAttributes: &types.WorkflowExecutionTerminatedEventAttributes{Reason: "operator"},
+				},
+			},
+			assert: func(t *testing.T, s *historySummary) {
+				assert.Equal(t, types.EventTypeWorkflowExecutionTerminated.String(), s.Status)
+				assert.Equal(t, "operator", s.LastFailure.Reason)
+				assert.False(t, s.Decision.Stuck)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.assert(t, summarizeHistory(tc.events, testNow))
+		})
+	}
+}
+
+func event(id int64, eventType types.EventType, ts time.Time) *types.HistoryEvent {
+	return &types.HistoryEvent{
+		ID:        id,
+		Timestamp: common.Int64Ptr(ts.UnixNano()),
+		EventType: eventType.Ptr(),
+	}
+}
+
+func startedEvent(id int64, ts time.Time) *types.HistoryEvent {
+	e := event(id, types.EventTypeWorkflowExecutionStarted, ts)
+	e.WorkflowExecutionStartedEventAttributes = &types.WorkflowExecutionStartedEventAttributes{
+		WorkflowType: &types.WorkflowType{Name: "test-workflow"},
+	}
+	return e
+}
+
+func activityScheduledEvent(id int64, activityID string) *types.HistoryEvent {
+	e := event(id, types.EventTypeActivityTaskScheduled, testNow)
+	e.ActivityTaskScheduledEventAttributes = &types.ActivityTaskScheduledEventAttributes{
+		ActivityID:   activityID,
+		ActivityType: &types.ActivityType{Name: "test-activity"},
+	}
+	return e
+}
+
+func decisionFailedEvent(id int64) *types.HistoryEvent {
+	e := event(id, types.EventTypeDecisionTaskFailed, testNow)
+	e.DecisionTaskFailedEventAttributes = &types.DecisionTaskFailedEventAttributes{
+		Cause:  types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.Ptr(),
+		Reason: common.StringPtr("panic"),
+	}
+	return e
+}
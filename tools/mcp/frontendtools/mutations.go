@@ -0,0 +1,134 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontendtools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type mutationResult struct {
+	Operation  string `json:"operation"`
+	Domain     string `json:"domain"`
+	WorkflowID string `json:"workflowId"`
+	RunID      string `json:"runId,omitempty"`
+	NewRunID   string `json:"newRunId,omitempty"`
+}
+
+func (h *handler) signalWorkflow(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	domain, execution, err := executionArgs(request)
+	if err != nil {
+		return nil, err
+	}
+	signalName, err := requiredString(request, "signal_name")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := h.context(ctx)
+	defer cancel()
+	err = h.client.SignalWorkflowExecution(ctx, &types.SignalWorkflowExecutionRequest{
+		Domain:            domain,
+		WorkflowExecution: execution,
+		SignalName:        signalName,
+		Input:             []byte(optionalString(request, "input")),
+		Identity:          h.options.Identity,
+		RequestID:         uuid.New(),
+	})
+	if err != nil {
+		return errorResult("SignalWorkflowExecution", err)
+	}
+	return jsonResult(&mutationResult{
+		Operation:  "signal",
+		Domain:     domain,
+		WorkflowID: execution.WorkflowID,
+		RunID:      execution.RunID,
+	})
+}
+
+func (h *handler) terminateWorkflow(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	domain, execution, err := executionArgs(request)
+	if err != nil {
+		return nil, err
+	}
+	reason, err := requiredString(request, "reason")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := h.context(ctx)
+	defer cancel()
+	err = h.client.TerminateWorkflowExecution(ctx, &types.TerminateWorkflowExecutionRequest{
+		Domain:            domain,
+		WorkflowExecution: execution,
+		Reason:            reason,
+		Identity:          h.options.Identity,
+	})
+	if err != nil {
+		return errorResult("TerminateWorkflowExecution", err)
+	}
+	return jsonResult(&mutationResult{
+		Operation:  "terminate",
+		Domain:     domain,
+		WorkflowID: execution.WorkflowID,
+		RunID:      execution.RunID,
+	})
+}
+
+func (h *handler) resetWorkflow(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	domain, execution, err := executionArgs(request)
+	if err != nil {
+		return nil, err
+	}
+	reason, err := requiredString(request, "reason")
+	if err != nil {
+		return nil, err
+	}
+	eventID := optionalInt(request, "decision_finish_event_id", 0)
+	if eventID <= 0 {
+		return nil, errors.New("decision_finish_event_id is required and must be a positive number")
+	}
+
+	ctx, cancel := h.context(ctx)
+	defer cancel()
+	resp, err := h.client.ResetWorkflowExecution(ctx, &types.ResetWorkflowExecutionRequest{
+		Domain:                domain,
+		WorkflowExecution:     execution,
+		Reason:                reason,
+		DecisionFinishEventID: eventID,
+		RequestID:             uuid.New(),
+	})
+	if err != nil {
+		return errorResult("ResetWorkflowExecution", err)
+	}
+	return jsonResult(&mutationResult{
+		Operation:  "reset",
+		Domain:     domain,
+		WorkflowID: execution.WorkflowID,
+		RunID:      execution.RunID,
+		NewRunID:   resp.GetRunID(),
+	})
+}
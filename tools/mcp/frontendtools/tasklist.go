@@ -0,0 +1,100 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontendtools
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	describeTaskListResult struct {
+		TaskList     string            `json:"taskList"`
+		TaskListType string            `json:"taskListType"`
+		Pollers      []*taskListPoller `json:"pollers"`
+		Backlog      *taskListBacklog  `json:"backlog,omitempty"`
+	}
+
+	taskListPoller struct {
+		Identity       string  `json:"identity"`
+		LastAccessTime string  `json:"lastAccessTime,omitempty"`
+		RatePerSecond  float64 `json:"ratePerSecond,omitempty"`
+	}
+
+	taskListBacklog struct {
+		BacklogCountHint int64   `json:"backlogCountHint"`
+		ReadLevel        int64   `json:"readLevel"`
+		AckLevel         int64   `json:"ackLevel"`
+		RatePerSecond    float64 `json:"ratePerSecond"`
+	}
+)
+
+func (h *handler) describeTaskList(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	domain, err := requiredString(request, "domain")
+	if err != nil {
+		return nil, err
+	}
+	taskList, err := requiredString(request, "task_list")
+	if err != nil {
+		return nil, err
+	}
+	taskListType := types.TaskListTypeDecision
+	if optionalString(request, "task_list_type") == "activity" {
+		taskListType = types.TaskListTypeActivity
+	}
+
+	ctx, cancel := h.context(ctx)
+	defer cancel()
+	resp, err := h.client.DescribeTaskList(ctx, &types.DescribeTaskListRequest{
+		Domain:                domain,
+		TaskList:              &types.TaskList{Name: taskList},
+		TaskListType:          &taskListType,
+		IncludeTaskListStatus: true,
+	})
+	if err != nil {
+		return errorResult("DescribeTaskList", err)
+	}
+
+	result := &describeTaskListResult{
+		TaskList:     taskList,
+		TaskListType: taskListType.String(),
+		Pollers:      []*taskListPoller{},
+	}
+	for _, p := range resp.GetPollers() {
+		result.Pollers = append(result.Pollers, &taskListPoller{
+			Identity:       p.GetIdentity(),
+			LastAccessTime: formatTime(p.LastAccessTime),
+			RatePerSecond:  p.GetRatePerSecond(),
+		})
+	}
+	if status := resp.TaskListStatus; status != nil {
+		result.Backlog = &taskListBacklog{
+			BacklogCountHint: status.GetBacklogCountHint(),
+			ReadLevel:        status.GetReadLevel(),
+			AckLevel:         status.GetAckLevel(),
+			RatePerSecond:    status.GetRatePerSecond(),
+		}
+	}
+	return jsonResult(result)
+}
@@ -0,0 +1,267 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package frontendtools contains the MCP tools that call the Cadence frontend directly.
+// Read-only tools are always registered, the tools that change workflow state are only
+// registered when explicitly enabled.
+package frontendtools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/uber/cadence/client/frontend"
+)
+
+const (
+	defaultPageSize    = 20
+	maxPageSize        = 1000
+	defaultHistorySize = 10000
+	defaultIdentity    = "cadence-mcp"
+)
+
+type (
+	// Options configures the registered tools
+	Options struct {
+		// EnableMutations registers the tools that change workflow state, i.e. signal, terminate and reset
+		EnableMutations bool
+		// Identity is sent as the identity of the mutating requests
+		Identity string
+		// Timeout is the timeout of a single frontend call
+		Timeout time.Duration
+	}
+
+	handler struct {
+		client  frontend.Client
+		options Options
+	}
+)
+
+// Register adds the frontend tools to the MCP server
+func Register(s *server.MCPServer, client frontend.Client, options Options) {
+	if options.Identity == "" {
+		options.Identity = defaultIdentity
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = 10 * time.Second
+	}
+	h := &handler{client: client, options: options}
+	for _, t := range h.readTools() {
+		s.AddTool(t.Tool, t.Handler)
+	}
+	if options.EnableMutations {
+		for _, t := range h.mutationTools() {
+			s.AddTool(t.Tool, t.Handler)
+		}
+	}
+}
+
+func (h *handler) readTools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Tool: mcp.NewTool("describe_workflow",
+				mcp.WithDescription("Describe a Cadence workflow execution: its type, status, timestamps, pending activities, pending children and pending decision."),
+				domainArg(),
+				workflowIDArg(),
+				runIDArg(),
+			),
+			Handler: h.describeWorkflow,
+		},
+		{
+			Tool: mcp.NewTool("summarize_workflow_history",
+				mcp.WithDescription("Fetch the history of a Cadence workflow execution and summarize it: event counts, pending activities, the last failure and whether the decision task looks stuck."),
+				domainArg(),
+				workflowIDArg(),
+				runIDArg(),
+				mcp.WithNumber("max_events",
+					mcp.DefaultNumber(defaultHistorySize),
+					mcp.Description("Maximum number of history events to read"),
+				),
+			),
+			Handler: h.summarizeHistory,
+		},
+		{
+			Tool: mcp.NewTool("list_workflows",
+				mcp.WithDescription("List Cadence workflow executions matching a visibility query, e.g. \"WorkflowType = 'MyWorkflow' AND CloseTime = missing\"."),
+				domainArg(),
+				queryArg(),
+				mcp.WithNumber("page_size",
+					mcp.DefaultNumber(defaultPageSize),
+					mcp.Description("Maximum number of workflows to return"),
+				),
+				mcp.WithString("next_page_token",
+					mcp.Description("Page token returned by a previous call to continue listing"),
+				),
+			),
+			Handler: h.listWorkflows,
+		},
+		{
+			Tool: mcp.NewTool("count_workflows",
+				mcp.WithDescription("Count Cadence workflow executions matching a visibility query."),
+				domainArg(),
+				queryArg(),
+			),
+			Handler: h.countWorkflows,
+		},
+		{
+			Tool: mcp.NewTool("describe_task_list",
+				mcp.WithDescription("Describe a Cadence task list: its pollers and its backlog."),
+				domainArg(),
+				mcp.WithString("task_list",
+					mcp.Required(),
+					mcp.Description("Name of the task list"),
+				),
+				mcp.WithString("task_list_type",
+					mcp.DefaultString("decision"),
+					mcp.Enum("decision", "activity"),
+					mcp.Description("Type of the task list"),
+				),
+			),
+			Handler: h.describeTaskList,
+		},
+	}
+}
+
+func (h *handler) mutationTools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Tool: mcp.NewTool("signal_workflow",
+				mcp.WithDescription("Send a signal to a running Cadence workflow execution. This changes the workflow state."),
+				domainArg(),
+				workflowIDArg(),
+				runIDArg(),
+				mcp.WithString("signal_name",
+					mcp.Required(),
+					mcp.Description("Name of the signal"),
+				),
+				mcp.WithString("input",
+					mcp.Description("Input of the signal, usually JSON"),
+				),
+			),
+			Handler: h.signalWorkflow,
+		},
+		{
+			Tool: mcp.NewTool("terminate_workflow",
+				mcp.WithDescription("Terminate a running Cadence workflow execution. This cannot be undone."),
+				domainArg(),
+				workflowIDArg(),
+				runIDArg(),
+				reasonArg(),
+			),
+			Handler: h.terminateWorkflow,
+		},
+		{
+			Tool: mcp.NewTool("reset_workflow",
+				mcp.WithDescription("Reset a Cadence workflow execution to the given decision task completed/failed/timed out event. The current run is terminated and a new run is started."),
+				domainArg(),
+				workflowIDArg(),
+				runIDArg(),
+				reasonArg(),
+				mcp.WithNumber("decision_finish_event_id",
+					mcp.Required(),
+					mcp.Description("ID of the decision task completed, failed or timed out event to reset to"),
+				),
+			),
+			Handler: h.resetWorkflow,
+		},
+	}
+}
+
+func domainArg() mcp.ToolOption {
+	return mcp.WithString("domain",
+		mcp.Required(),
+		mcp.Description("Name of the cadence domain"),
+	)
+}
+
+func workflowIDArg() mcp.ToolOption {
+	return mcp.WithString("workflow_id",
+		mcp.Required(),
+		mcp.Description("ID of the workflow"),
+	)
+}
+
+func runIDArg() mcp.ToolOption {
+	return mcp.WithString("run_id",
+		mcp.Description("Run ID of the workflow, the current run is used if empty"),
+	)
+}
+
+func queryArg() mcp.ToolOption {
+	return mcp.WithString("query",
+		mcp.Description("Visibility query, all workflows of the domain if empty"),
+	)
+}
+
+func reasonArg() mcp.ToolOption {
+	return mcp.WithString("reason",
+		mcp.Required(),
+		mcp.Description("Reason of the operation, it is recorded in the workflow history"),
+	)
+}
+
+func (h *handler) context(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, h.options.Timeout)
+}
+
+func requiredString(request mcp.CallToolRequest, name string) (string, error) {
+	value, ok := request.Params.Arguments[name].(string)
+	if !ok || value == "" {
+		return "", fmt.Errorf("%v is required and must be a string", name)
+	}
+	return value, nil
+}
+
+func optionalString(request mcp.CallToolRequest, name string) string {
+	value, _ := request.Params.Arguments[name].(string)
+	return value
+}
+
+// optionalInt reads a number argument, JSON numbers are decoded as float64
+func optionalInt(request mcp.CallToolRequest, name string, defaultValue int64) int64 {
+	switch value := request.Params.Arguments[name].(type) {
+	case float64:
+		return int64(value)
+	case int:
+		return int64(value)
+	case int64:
+		return value
+	default:
+		return defaultValue
+	}
+}
+
+func jsonResult(v interface{}) (*mcp.CallToolResult, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encoding result: %w", err)
+	}
+	return mcp.NewToolResultText(string(data)), nil
+}
+
+// errorResult reports a failed frontend call to the model, the MCP call itself succeeds
+func errorResult(operation string, err error) (*mcp.CallToolResult, error) {
+	return mcp.NewToolResultError(fmt.Sprintf("%v failed: %v", operation, err)), nil
+}
@@ -0,0 +1,299 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontendtools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestRegister(t *testing.T) {
+	tests := []struct {
+		name            string
+		enableMutations bool
+		expectedTools   []string
+	}{
+		{
+			name:          "read only",
+			expectedTools: []string{"count_workflows", "describe_task_list", "describe_workflow", "list_workflows", "summarize_workflow_history"},
+		},
+		{
+			name:            "mutations enabled",
+			enableMutations: true,
+			expectedTools: []string{"count_workflows", "describe_task_list", "describe_workflow", "list_workflows", "reset_workflow",
+				"signal_workflow", "summarize_workflow_history", "terminate_workflow"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s := server.NewMCPServer("test", "1.0.0", server.WithToolCapabilities(true))
+			Register(s, frontend.NewMockClient(gomock.NewController(t)), Options{EnableMutations: tc.enableMutations})
+
+			resp := s.HandleMessage(context.Background(), []byte(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`))
+			rpcResp, ok := resp.(mcp.JSONRPCResponse)
+			require.True(t, ok, "unexpected response %#v", resp)
+			result, ok := rpcResp.Result.(mcp.ListToolsResult)
+			require.True(t, ok, "unexpected result %#v", rpcResp.Result)
+
+			var names []string
+			for _, tool := range result.Tools {
+				names = append(names, tool.Name)
+			}
+			assert.ElementsMatch(t, tc.expectedTools, names)
+		})
+	}
+}
+
+func TestDescribeWorkflow(t *testing.T) {
+	h, client := setupHandler(t)
+	client.EXPECT().DescribeWorkflowExecution(gomock.Any(), &types.DescribeWorkflowExecutionRequest{
+		Domain:    "test-domain",
+		Execution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+	}).Return(&types.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+			Execution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+			Type:      &types.WorkflowType{Name: "test-workflow"},
+		},
+		PendingActivities: []*types.PendingActivityInfo{
+			{ActivityID: "activity-1", State: types.PendingActivityStateStarted.Ptr(), Attempt: 3, LastFailureReason: common.StringPtr("boom")},
+		},
+		PendingDecision: &types.PendingDecisionInfo{State: types.PendingDecisionStateScheduled.Ptr()},
+	}, nil)
+
+	var result describeWorkflowResult
+	callTool(t, h.describeWorkflow, map[string]interface{}{"domain": "test-domain", "workflow_id": "wid", "run_id": "rid"}, &result)
+	assert.Equal(t, "wid", result.Workflow.WorkflowID)
+	assert.Equal(t, "test-workflow", result.Workflow.WorkflowType)
+	assert.Equal(t, "RUNNING", result.Workflow.Status)
+	require.Len(t, result.PendingActivities, 1)
+	assert.Equal(t, "boom", result.PendingActivities[0].LastFailureReason)
+	assert.Equal(t, types.PendingDecisionStateScheduled.String(), result.PendingDecision.State)
+}
+
+func TestDescribeWorkflow_Errors(t *testing.T) {
+	h, client := setupHandler(t)
+
+	_, err := h.describeWorkflow(context.Background(), newRequest(map[string]interface{}{"domain": "test-domain"}))
+	assert.EqualError(t, err, "workflow_id is required and must be a string")
+
+	client.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{Message: "not found"})
+	result, err := h.describeWorkflow(context.Background(), newRequest(map[string]interface{}{"domain": "test-domain", "workflow_id": "wid"}))
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(t, result), "DescribeWorkflowExecution failed")
+}
+
+func TestSummarizeWorkflowHistory(t *testing.T) {
+	h, client := setupHandler(t)
+	gomock.InOrder(
+		client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
+			History:       &types.History{Events: []*types.HistoryEvent{startedEvent(1, testNow)}},
+			NextPageToken: []byte("next"),
+		}, nil),
+		client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
+			Domain:        "test-domain",
+			Execution:     &types.WorkflowExecution{WorkflowID: "wid"},
+			NextPageToken: []byte("next"),
+		}).Return(&types.GetWorkflowExecutionHistoryResponse{
+			History: &types.History{Events: []*types.HistoryEvent{
+				event(2, types.EventTypeDecisionTaskScheduled, testNow),
+				event(3, types.EventTypeDecisionTaskStarted, testNow),
+			}},
+		}, nil),
+	)
+
+	var result historySummary
+	callTool(t, h.summarizeHistory, map[string]interface{}{"domain": "test-domain", "workflow_id": "wid"}, &result)
+	assert.Equal(t, 3, result.EventCount)
+	assert.False(t, result.Truncated)
+	assert.True(t, result.Decision.Started)
+}
+
+func TestSummarizeWorkflowHistory_Truncated(t *testing.T) {
+	h, client := setupHandler(t)
+	client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{Events: []*types.HistoryEvent{
+			startedEvent(1, testNow),
+			event(2, types.EventTypeDecisionTaskScheduled, testNow),
+		}},
+		NextPageToken: []byte("next"),
+	}, nil)
+
+	var result historySummary
+	callTool(t, h.summarizeHistory, map[string]interface{}{"domain": "test-domain", "workflow_id": "wid", "max_events": float64(1)}, &result)
+	assert.Equal(t, 1, result.EventCount)
+	assert.True(t, result.Truncated)
+}
+
+func TestListWorkflows(t *testing.T) {
+	h, client := setupHandler(t)
+	client.EXPECT().ListWorkflowExecutions(gomock.Any(), &types.ListWorkflowExecutionsRequest{
+		Domain:        "test-domain",
+		PageSize:      5,
+		NextPageToken: []byte("token"),
+		Query:         "CloseTime = missing",
+	}).Return(&types.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{
+			{
+				Execution:   &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+				CloseStatus: types.WorkflowExecutionCloseStatusFailed.Ptr(),
+				CloseTime:   common.Int64Ptr(testNow.UnixNano()),
+			},
+		},
+		NextPageToken: []byte("next"),
+	}, nil)
+
+	var result listWorkflowsResult
+	callTool(t, h.listWorkflows, map[string]interface{}{
+		"domain":          "test-domain",
+		"query":           "CloseTime = missing",
+		"page_size":       float64(5),
+		"next_page_token": "token",
+	}, &result)
+	require.Len(t, result.Workflows, 1)
+	assert.Equal(t, types.WorkflowExecutionCloseStatusFailed.String(), result.Workflows[0].Status)
+	assert.Equal(t, testNow.Format(time.RFC3339Nano), result.Workflows[0].CloseTime)
+	assert.Equal(t, "next", result.NextPageToken)
+}
+
+func TestCountWorkflows(t *testing.T) {
+	h, client := setupHandler(t)
+	client.EXPECT().CountWorkflowExecutions(gomock.Any(), &types.CountWorkflowExecutionsRequest{
+		Domain: "test-domain",
+		Query:  "WorkflowType = 'test'",
+	}).Return(&types.CountWorkflowExecutionsResponse{Count: 42}, nil)
+
+	var result countWorkflowsResult
+	callTool(t, h.countWorkflows, map[string]interface{}{"domain": "test-domain", "query": "WorkflowType = 'test'"}, &result)
+	assert.Equal(t, int64(42), result.Count)
+}
+
+func TestDescribeTaskList(t *testing.T) {
+	h, client := setupHandler(t)
+	client.EXPECT().DescribeTaskList(gomock.Any(), &types.DescribeTaskListRequest{
+		Domain:                "test-domain",
+		TaskList:              &types.TaskList{Name: "test-tl"},
+		TaskListType:          types.TaskListTypeActivity.Ptr(),
+		IncludeTaskListStatus: true,
+	}).Return(&types.DescribeTaskListResponse{
+		Pollers: []*types.PollerInfo{
+			{Identity: "worker-1", LastAccessTime: common.Int64Ptr(testNow.UnixNano()), RatePerSecond: 100},
+		},
+		TaskListStatus: &types.TaskListStatus{BacklogCountHint: 7, ReadLevel: 10, AckLevel: 3},
+	}, nil)
+
+	var result describeTaskListResult
+	callTool(t, h.describeTaskList, map[string]interface{}{"domain": "test-domain", "task_list": "test-tl", "task_list_type": "activity"}, &result)
+	assert.Equal(t, types.TaskListTypeActivity.String(), result.TaskListType)
+	require.Len(t, result.Pollers, 1)
+	assert.Equal(t, "worker-1", result.Pollers[0].Identity)
+	assert.Equal(t, int64(7), result.Backlog.BacklogCountHint)
+}
+
+func TestMutations(t *testing.T) {
+	h, client := setupHandler(t)
+
+	client.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.SignalWorkflowExecutionRequest, _ ...interface{}) error {
+			assert.Equal(t, "test-signal", request.SignalName)
+			assert.Equal(t, []byte(`{"a":1}`), request.Input)
+			assert.Equal(t, defaultIdentity, request.Identity)
+			assert.NotEmpty(t, request.RequestID)
+			return nil
+		})
+	var signalResult mutationResult
+	callTool(t, h.signalWorkflow, map[string]interface{}{
+		"domain": "test-domain", "workflow_id": "wid", "signal_name": "test-signal", "input": `{"a":1}`,
+	}, &signalResult)
+	assert.Equal(t, "signal", signalResult.Operation)
+
+	client.EXPECT().TerminateWorkflowExecution(gomock.Any(), &types.TerminateWorkflowExecutionRequest{
+		Domain:            "test-domain",
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+		Reason:            "stuck",
+		Identity:          defaultIdentity,
+	}).Return(nil)
+	var terminateResult mutationResult
+	callTool(t, h.terminateWorkflow, map[string]interface{}{"domain": "test-domain", "workflow_id": "wid", "run_id": "rid", "reason": "stuck"}, &terminateResult)
+	assert.Equal(t, "terminate", terminateResult.Operation)
+
+	client.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.ResetWorkflowExecutionRequest, _ ...interface{}) (*types.ResetWorkflowExecutionResponse, error) {
+			assert.Equal(t, int64(4), request.DecisionFinishEventID)
+			assert.Equal(t, "bad deploy", request.Reason)
+			return &types.ResetWorkflowExecutionResponse{RunID: "new-rid"}, nil
+		})
+	var resetResult mutationResult
+	callTool(t, h.resetWorkflow, map[string]interface{}{
+		"domain": "test-domain", "workflow_id": "wid", "reason": "bad deploy", "decision_finish_event_id": float64(4),
+	}, &resetResult)
+	assert.Equal(t, "new-rid", resetResult.NewRunID)
+
+	_, err := h.resetWorkflow(context.Background(), newRequest(map[string]interface{}{"domain": "test-domain", "workflow_id": "wid", "reason": "bad deploy"}))
+	assert.Error(t, err)
+
+	client.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(errors.New("unavailable"))
+	result, err := h.terminateWorkflow(context.Background(), newRequest(map[string]interface{}{"domain": "test-domain", "workflow_id": "wid", "reason": "stuck"}))
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+}
+
+func setupHandler(t *testing.T) (*handler, *frontend.MockClient) {
+	client := frontend.NewMockClient(gomock.NewController(t))
+	return &handler{
+		client:  client,
+		options: Options{Identity: defaultIdentity, Timeout: time.Second},
+	}, client
+}
+
+func newRequest(arguments map[string]interface{}) mcp.CallToolRequest {
+	var request mcp.CallToolRequest
+	request.Params.Arguments = arguments
+	return request
+}
+
+func callTool(t *testing.T, handler server.ToolHandlerFunc, arguments map[string]interface{}, out interface{}) {
+	result, err := handler(context.Background(), newRequest(arguments))
+	require.NoError(t, err)
+	require.False(t, result.IsError, resultText(t, result))
+	require.NoError(t, json.Unmarshal([]byte(resultText(t, result)), out))
+}
+
+func resultText(t *testing.T, result *mcp.CallToolResult) string {
+	require.Len(t, result.Content, 1)
+	content, ok := result.Content[0].(mcp.TextContent)
+	require.True(t, ok)
+	return content.Text
+}
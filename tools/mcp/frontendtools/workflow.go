@@ -0,0 +1,252 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontendtools
+
+import (
+	"context"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	workflowSummary struct {
+		WorkflowID    string            `json:"workflowId"`
+		RunID         string            `json:"runId"`
+		WorkflowType  string            `json:"workflowType,omitempty"`
+		TaskList      string            `json:"taskList,omitempty"`
+		Status        string            `json:"status"`
+		StartTime     string            `json:"startTime,omitempty"`
+		CloseTime     string            `json:"closeTime,omitempty"`
+		HistoryLength int64             `json:"historyLength,omitempty"`
+		IsCron        bool              `json:"isCron,omitempty"`
+		Parent        *workflowIdentity `json:"parent,omitempty"`
+	}
+
+	workflowIdentity struct {
+		Domain     string `json:"domain,omitempty"`
+		WorkflowID string `json:"workflowId"`
+		RunID      string `json:"runId"`
+	}
+
+	pendingActivity struct {
+		ActivityID         string `json:"activityId"`
+		ActivityType       string `json:"activityType,omitempty"`
+		State              string `json:"state,omitempty"`
+		Attempt            int32  `json:"attempt,omitempty"`
+		MaximumAttempts    int32  `json:"maximumAttempts,omitempty"`
+		ScheduledTime      string `json:"scheduledTime,omitempty"`
+		LastStartedTime    string `json:"lastStartedTime,omitempty"`
+		LastHeartbeatTime  string `json:"lastHeartbeatTime,omitempty"`
+		LastFailureReason  string `json:"lastFailureReason,omitempty"`
+		LastWorkerIdentity string `json:"lastWorkerIdentity,omitempty"`
+	}
+
+	pendingChild struct {
+		WorkflowID   string `json:"workflowId"`
+		RunID        string `json:"runId"`
+		WorkflowType string `json:"workflowType,omitempty"`
+	}
+
+	pendingDecision struct {
+		State         string `json:"state,omitempty"`
+		Attempt       int64  `json:"attempt"`
+		ScheduledTime string `json:"scheduledTime,omitempty"`
+		StartedTime   string `json:"startedTime,omitempty"`
+	}
+
+	describeWorkflowResult struct {
+		Workflow          *workflowSummary   `json:"workflow"`
+		PendingActivities []*pendingActivity `json:"pendingActivities,omitempty"`
+		PendingChildren   []*pendingChild    `json:"pendingChildren,omitempty"`
+		PendingDecision   *pendingDecision   `json:"pendingDecision,omitempty"`
+	}
+
+	listWorkflowsResult struct {
+		Workflows     []*workflowSummary `json:"workflows"`
+		NextPageToken string             `json:"nextPageToken,omitempty"`
+	}
+
+	countWorkflowsResult struct {
+		Count int64 `json:"count"`
+	}
+)
+
+func (h *handler) describeWorkflow(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	domain, execution, err := executionArgs(request)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := h.context(ctx)
+	defer cancel()
+	resp, err := h.client.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    domain,
+		Execution: execution,
+	})
+	if err != nil {
+		return errorResult("DescribeWorkflowExecution", err)
+	}
+
+	result := &describeWorkflowResult{
+		Workflow: newWorkflowSummary(resp.WorkflowExecutionInfo),
+	}
+	for _, a := range resp.PendingActivities {
+		result.PendingActivities = append(result.PendingActivities, &pendingActivity{
+			ActivityID:         a.GetActivityID(),
+			ActivityType:       a.ActivityType.GetName(),
+			State:              a.GetState().String(),
+			Attempt:            a.GetAttempt(),
+			MaximumAttempts:    a.GetMaximumAttempts(),
+			ScheduledTime:      formatTime(a.ScheduledTimestamp),
+			LastStartedTime:    formatTime(a.LastStartedTimestamp),
+			LastHeartbeatTime:  formatTime(a.LastHeartbeatTimestamp),
+			LastFailureReason:  a.GetLastFailureReason(),
+			LastWorkerIdentity: a.GetLastWorkerIdentity(),
+		})
+	}
+	for _, c := range resp.PendingChildren {
+		result.PendingChildren = append(result.PendingChildren, &pendingChild{
+			WorkflowID:   c.WorkflowID,
+			RunID:        c.RunID,
+			WorkflowType: c.WorkflowTypeName,
+		})
+	}
+	if d := resp.PendingDecision; d != nil {
+		result.PendingDecision = &pendingDecision{
+			Attempt:       d.Attempt,
+			ScheduledTime: formatTime(d.ScheduledTimestamp),
+			StartedTime:   formatTime(d.StartedTimestamp),
+		}
+		if d.State != nil {
+			result.PendingDecision.State = d.State.String()
+		}
+	}
+	return jsonResult(result)
+}
+
+func (h *handler) listWorkflows(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	domain, err := requiredString(request, "domain")
+	if err != nil {
+		return nil, err
+	}
+	pageSize := optionalInt(request, "page_size", defaultPageSize)
+	if pageSize <= 0 || pageSize > maxPageSize {
+		pageSize = defaultPageSize
+	}
+	var pageToken []byte
+	if token := optionalString(request, "next_page_token"); token != "" {
+		pageToken = []byte(token)
+	}
+
+	ctx, cancel := h.context(ctx)
+	defer cancel()
+	resp, err := h.client.ListWorkflowExecutions(ctx, &types.ListWorkflowExecutionsRequest{
+		Domain:        domain,
+		PageSize:      int32(pageSize),
+		NextPageToken: pageToken,
+		Query:         optionalString(request, "query"),
+	})
+	if err != nil {
+		return errorResult("ListWorkflowExecutions", err)
+	}
+
+	result := &listWorkflowsResult{
+		Workflows:     make([]*workflowSummary, 0, len(resp.Executions)),
+		NextPageToken: string(resp.NextPageToken),
+	}
+	for _, e := range resp.Executions {
+		result.Workflows = append(result.Workflows, newWorkflowSummary(e))
+	}
+	return jsonResult(result)
+}
+
+func (h *handler) countWorkflows(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	domain, err := requiredString(request, "domain")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := h.context(ctx)
+	defer cancel()
+	resp, err := h.client.CountWorkflowExecutions(ctx, &types.CountWorkflowExecutionsRequest{
+		Domain: domain,
+		Query:  optionalString(request, "query"),
+	})
+	if err != nil {
+		return errorResult("CountWorkflowExecutions", err)
+	}
+	return jsonResult(&countWorkflowsResult{Count: resp.GetCount()})
+}
+
+func executionArgs(request mcp.CallToolRequest) (string, *types.WorkflowExecution, error) {
+	domain, err := requiredString(request, "domain")
+	if err != nil {
+		return "", nil, err
+	}
+	workflowID, err := requiredString(request, "workflow_id")
+	if err != nil {
+		return "", nil, err
+	}
+	return domain, &types.WorkflowExecution{
+		WorkflowID: workflowID,
+		RunID:      optionalString(request, "run_id"),
+	}, nil
+}
+
+func newWorkflowSummary(info *types.WorkflowExecutionInfo) *workflowSummary {
+	if info == nil {
+		return nil
+	}
+	summary := &workflowSummary{
+		WorkflowID:    info.GetExecution().GetWorkflowID(),
+		RunID:         info.GetExecution().GetRunID(),
+		WorkflowType:  info.GetType().GetName(),
+		TaskList:      info.TaskList.GetName(),
+		Status:        "RUNNING",
+		StartTime:     formatTime(info.StartTime),
+		CloseTime:     formatTime(info.CloseTime),
+		HistoryLength: info.HistoryLength,
+		IsCron:        info.IsCron,
+	}
+	if info.CloseStatus != nil {
+		summary.Status = info.CloseStatus.String()
+	}
+	if info.ParentExecution != nil {
+		summary.Parent = &workflowIdentity{
+			Domain:     common.StringDefault(info.ParentDomain),
+			WorkflowID: info.ParentExecution.GetWorkflowID(),
+			RunID:      info.ParentExecution.GetRunID(),
+		}
+	}
+	return summary
+}
+
+// formatTime formats a unix nano timestamp, the zero value is omitted
+func formatTime(ts *int64) string {
+	if ts == nil || *ts == 0 {
+		return ""
+	}
+	return time.Unix(0, *ts).UTC().Format(time.RFC3339Nano)
+}
@@ -26,18 +26,27 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"flag"
 	"fmt"
 	"os"
 	"os/exec"
 	"path"
 	"runtime/debug"
+	"strconv"
 	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/uber/cadence/tools/mcp/frontendtools"
 )
 
 func main() {
+	address := flag.String("address", envOrDefault("CADENCE_MCP_ADDRESS", "localhost:7833"),
+		"gRPC address of the cadence frontend used by the frontend tools")
+	enableMutations := flag.Bool("enable-mutations", envBool("CADENCE_MCP_ENABLE_MUTATIONS"),
+		"register the tools that change workflow state (signal, terminate and reset)")
+	flag.Parse()
 
 	// Create MCP server
 	s := server.NewMCPServer(
@@ -83,6 +92,14 @@ func main() {
 		),
 	), cadenceCommandGeneratorHandler)
 
+	client, dispatcher, err := newFrontendClient(*address)
+	if err != nil {
+		debugLog("Failed to create frontend client: %v\n", err)
+	} else {
+		defer dispatcher.Stop()
+		frontendtools.Register(s, client, frontendtools.Options{EnableMutations: *enableMutations})
+	}
+
 	debugLog("Cadence MCP started")
 
 	// Start the stdio server
@@ -93,6 +110,18 @@ func main() {
 	debugLog("Cadence MCP stopped")
 }
 
+func envOrDefault(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+func envBool(key string) bool {
+	value, _ := strconv.ParseBool(os.Getenv(key))
+	return value
+}
+
 func domainRRHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	defer func() {
 		// recover from panic
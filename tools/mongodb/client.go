@@ -0,0 +1,234 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/tools/common/schema"
+)
+
+type (
+	// MongoClient executes the schema commands against a MongoDB database
+	MongoClient struct {
+		client *mongo.Client
+		db     *mongo.Database
+		cfg    *MongoClientConfig
+	}
+
+	// MongoClientConfig contains the configuration for the mongo client
+	MongoClientConfig struct {
+		Hosts    string
+		Port     int
+		User     string
+		Password string
+		Database string
+		Timeout  int
+	}
+
+	schemaVersionEntry struct {
+		Database             string    `bson:"_id"`
+		CreationTime         time.Time `bson:"creationtime"`
+		CurrVersion          string    `bson:"currversion"`
+		MinCompatibleVersion string    `bson:"mincompatibleversion"`
+	}
+
+	schemaUpdateHistoryEntry struct {
+		UpdateTime  time.Time `bson:"updatetime"`
+		OldVersion  string    `bson:"oldversion"`
+		NewVersion  string    `bson:"newversion"`
+		ManifestMD5 string    `bson:"manifestmd5"`
+		Description string    `bson:"description"`
+	}
+)
+
+const (
+	DefaultTimeout   = 30 // Timeout in seconds
+	DefaultMongoPort = 27017
+
+	schemaVersionCollectionName       = "schema_version"
+	schemaUpdateHistoryCollectionName = "schema_update_history"
+)
+
+var (
+	_ schema.SchemaClient    = (*MongoClient)(nil)
+	_ schema.StatementParser = (*MongoClient)(nil)
+)
+
+// NewMongoClient returns a new instance of MongoClient
+func NewMongoClient(cfg *MongoClientConfig) (*MongoClient, error) {
+	ctx, cancel := cfg.context()
+	defer cancel()
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(cfg.uri()))
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		client.Disconnect(context.Background()) //nolint:errcheck
+		return nil, err
+	}
+	return &MongoClient{
+		client: client,
+		db:     client.Database(cfg.Database),
+		cfg:    cfg,
+	}, nil
+}
+
+// ParseStatements parses a schema file which is a JSON array of database commands,
+// each command is returned as a statement in MongoDB extended JSON
+func (client *MongoClient) ParseStatements(file fs.File) ([]string, error) {
+	return parseCommands(file)
+}
+
+// ExecDDLQuery runs a database command given in MongoDB extended JSON
+func (client *MongoClient) ExecDDLQuery(stmt string, args ...interface{}) error {
+	var cmd bson.D
+	if err := bson.UnmarshalExtJSON([]byte(stmt), false, &cmd); err != nil {
+		return fmt.Errorf("parsing command %v: %w", stmt, err)
+	}
+	ctx, cancel := client.cfg.context()
+	defer cancel()
+	return client.db.RunCommand(ctx, cmd).Err()
+}
+
+// DropAllTables drops all the collections of the database
+func (client *MongoClient) DropAllTables() error {
+	ctx, cancel := client.cfg.context()
+	defer cancel()
+	names, err := client.db.ListCollectionNames(ctx, bson.D{})
+	if err != nil {
+		return err
+	}
+	log.Printf("Dropping following collections: %v\n", names)
+	for _, name := range names {
+		if err := client.db.Collection(name).Drop(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateSchemaVersionTables sets up the schema version collections
+func (client *MongoClient) CreateSchemaVersionTables() error {
+	ctx, cancel := client.cfg.context()
+	defer cancel()
+	if err := client.db.CreateCollection(ctx, schemaVersionCollectionName); err != nil {
+		return err
+	}
+	return client.db.CreateCollection(ctx, schemaUpdateHistoryCollectionName)
+}
+
+// ReadSchemaVersion returns the current schema version for the database
+func (client *MongoClient) ReadSchemaVersion() (string, error) {
+	ctx, cancel := client.cfg.context()
+	defer cancel()
+	var entry schemaVersionEntry
+	err := client.db.Collection(schemaVersionCollectionName).FindOne(ctx, bson.D{{Key: "_id", Value: client.cfg.Database}}).Decode(&entry)
+	if err != nil {
+		return "", fmt.Errorf("reading schema version: %w", err)
+	}
+	return entry.CurrVersion, nil
+}
+
+// UpdateSchemaVersion updates the schema version for the database
+func (client *MongoClient) UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error {
+	ctx, cancel := client.cfg.context()
+	defer cancel()
+	entry := &schemaVersionEntry{
+		Database:             client.cfg.Database,
+		CreationTime:         time.Now(),
+		CurrVersion:          newVersion,
+		MinCompatibleVersion: minCompatibleVersion,
+	}
+	_, err := client.db.Collection(schemaVersionCollectionName).ReplaceOne(ctx,
+		bson.D{{Key: "_id", Value: client.cfg.Database}}, entry, options.Replace().SetUpsert(true))
+	return err
+}
+
+// WriteSchemaUpdateLog adds an entry to the schema update history collection
+func (client *MongoClient) WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error {
+	ctx, cancel := client.cfg.context()
+	defer cancel()
+	_, err := client.db.Collection(schemaUpdateHistoryCollectionName).InsertOne(ctx, &schemaUpdateHistoryEntry{
+		UpdateTime:  time.Now().UTC(),
+		OldVersion:  oldVersion,
+		NewVersion:  newVersion,
+		ManifestMD5: manifestMD5,
+		Description: desc,
+	})
+	return err
+}
+
+// DropDatabase drops the given database
+func (client *MongoClient) DropDatabase(name string) error {
+	ctx, cancel := client.cfg.context()
+	defer cancel()
+	return client.client.Database(name).Drop(ctx)
+}
+
+// Close closes the mongo client
+func (client *MongoClient) Close() {
+	if client.client != nil {
+		client.client.Disconnect(context.Background()) //nolint:errcheck
+	}
+}
+
+func (cfg *MongoClientConfig) uri() string {
+	if cfg.User == "" {
+		return fmt.Sprintf("mongodb://%v:%v/", cfg.Hosts, cfg.Port)
+	}
+	return fmt.Sprintf("mongodb://%v:%v@%v:%v/", cfg.User, cfg.Password, cfg.Hosts, cfg.Port)
+}
+
+func (cfg *MongoClientConfig) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), time.Duration(cfg.Timeout)*time.Second)
+}
+
+// parseCommands reads a JSON array of database commands, the same format that is
+// used by schema/mongodb and the SetupTestDatabase of the persistence plugin
+func parseCommands(file fs.File) ([]string, error) {
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+	var cmds []bson.D
+	if err := bson.UnmarshalExtJSON(content, false, &cmds); err != nil {
+		return nil, fmt.Errorf("parsing commands: %w", err)
+	}
+	stmts := make([]string, 0, len(cmds))
+	for _, cmd := range cmds {
+		stmt, err := bson.MarshalExtJSON(cmd, false, false)
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, string(stmt))
+	}
+	return stmts, nil
+}
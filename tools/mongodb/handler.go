@@ -0,0 +1,150 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/schema/mongodb"
+	"github.com/uber/cadence/tools/common/schema"
+)
+
+// SetupSchemaConfig contains the configuration params needed to setup schema collections
+type SetupSchemaConfig struct {
+	MongoClientConfig
+	schema.SetupConfig
+}
+
+// CheckCompatibleVersion checks that the schema version of the database is compatible with this binary
+func CheckCompatibleVersion(cfg config.NoSQL) error {
+	client, err := NewMongoClient(&MongoClientConfig{
+		Hosts:    cfg.Hosts,
+		Port:     cfg.Port,
+		User:     cfg.User,
+		Password: cfg.Password,
+		Database: cfg.Keyspace,
+		Timeout:  DefaultTimeout,
+	})
+	if err != nil {
+		return fmt.Errorf("creating mongo client: %w", err)
+	}
+	defer client.Close()
+
+	return schema.VerifyCompatibleVersion(client, cfg.Keyspace, mongodb.Version)
+}
+
+// setupSchema executes the setupSchemaTask
+// using the given command line arguments
+// as input
+func setupSchema(cli *cli.Context) error {
+	config, err := newMongoClientConfig(cli)
+	if err != nil {
+		return handleErr(schema.NewConfigError(err.Error()))
+	}
+	client, err := NewMongoClient(config)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer client.Close()
+	if err := schema.Setup(cli, client); err != nil {
+		return handleErr(err)
+	}
+	return nil
+}
+
+// updateSchema executes the updateSchemaTask
+// using the given command line args as input
+func updateSchema(cli *cli.Context) error {
+	config, err := newMongoClientConfig(cli)
+	if err != nil {
+		return handleErr(schema.NewConfigError(err.Error()))
+	}
+	client, err := NewMongoClient(config)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer client.Close()
+	if err := schema.Update(cli, client); err != nil {
+		return handleErr(err)
+	}
+	return nil
+}
+
+// dropDatabase drops a mongodb database
+func dropDatabase(cli *cli.Context) error {
+	config, err := newMongoClientConfig(cli)
+	if err != nil {
+		return handleErr(schema.NewConfigError(err.Error()))
+	}
+	client, err := NewMongoClient(config)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer client.Close()
+	if err := client.DropDatabase(config.Database); err != nil {
+		return handleErr(fmt.Errorf("error dropping database:%v", err))
+	}
+	return nil
+}
+
+func newMongoClientConfig(cli *cli.Context) (*MongoClientConfig, error) {
+	mongoConfig := new(MongoClientConfig)
+	mongoConfig.Hosts = cli.String(schema.CLIOptEndpoint)
+	mongoConfig.Port = cli.Int(schema.CLIOptPort)
+	mongoConfig.User = cli.String(schema.CLIOptUser)
+	mongoConfig.Password = cli.String(schema.CLIOptPassword)
+	mongoConfig.Timeout = cli.Int(schema.CLIOptTimeout)
+	mongoConfig.Database = cli.String(schema.CLIOptDatabase)
+
+	if err := validateMongoClientConfig(mongoConfig); err != nil {
+		return nil, err
+	}
+	return mongoConfig, nil
+}
+
+func validateMongoClientConfig(config *MongoClientConfig) error {
+	if len(config.Hosts) == 0 {
+		return schema.NewConfigError("missing mongodb endpoint argument " + flag(schema.CLIOptEndpoint))
+	}
+	if config.Database == "" {
+		return schema.NewConfigError("missing " + flag(schema.CLIOptDatabase) + " argument ")
+	}
+	if config.Port == 0 {
+		config.Port = DefaultMongoPort
+	}
+	if config.Timeout == 0 {
+		config.Timeout = DefaultTimeout
+	}
+	return nil
+}
+
+func flag(opt string) string {
+	return "(-" + opt + ")"
+}
+
+func handleErr(err error) error {
+	log.Println(err)
+	return err
+}